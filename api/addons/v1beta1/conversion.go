@@ -82,6 +82,11 @@ func Convert_v1beta1_ClusterResourceSetStatus_To_v1beta2_ClusterResourceSetStatu
 	return nil
 }
 
+func Convert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec(in *addonsv1.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s apimachineryconversion.Scope) error {
+	// NOTE: v1beta1 ClusterResourceSetSpec does not have DependsOn, Reconcile and Templating, they are restored by the conversion webhook.
+	return autoConvert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec(in, out, s)
+}
+
 func Convert_Pointer_v1beta1_ResourceSetBinding_To_v1beta2_ResourceSetBinding(in **ResourceSetBinding, out *addonsv1.ResourceSetBinding, s apimachineryconversion.Scope) error {
 	if in == nil || *in == nil {
 		return nil
@@ -93,11 +93,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterResourceSetSpec)(nil), (*ClusterResourceSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec(a.(*v1beta2.ClusterResourceSetSpec), b.(*ClusterResourceSetSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceRef)(nil), (*v1beta2.ResourceRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceRef_To_v1beta2_ResourceRef(a.(*ResourceRef), b.(*v1beta2.ResourceRef), scope)
 	}); err != nil {
@@ -143,6 +138,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ClusterResourceSetSpec)(nil), (*ClusterResourceSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec(a.(*v1beta2.ClusterResourceSetSpec), b.(*ClusterResourceSetSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ClusterResourceSetStatus)(nil), (*ClusterResourceSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ClusterResourceSetStatus_To_v1beta1_ClusterResourceSetStatus(a.(*v1beta2.ClusterResourceSetStatus), b.(*ClusterResourceSetStatus), scope)
 	}); err != nil {
@@ -361,14 +361,12 @@ func autoConvert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpe
 	out.ClusterSelector = in.ClusterSelector
 	out.Resources = *(*[]ResourceRef)(unsafe.Pointer(&in.Resources))
 	out.Strategy = in.Strategy
+	// WARNING: in.DependsOn requires manual conversion: does not exist in peer-type
+	// WARNING: in.Reconcile requires manual conversion: does not exist in peer-type
+	// WARNING: in.Templating requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec is an autogenerated conversion function.
-func Convert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec(in *v1beta2.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClusterResourceSetSpec_To_v1beta1_ClusterResourceSetSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_ClusterResourceSetStatus_To_v1beta2_ClusterResourceSetStatus(in *ClusterResourceSetStatus, out *v1beta2.ClusterResourceSetStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	if in.Conditions != nil {
@@ -424,6 +422,7 @@ func autoConvert_v1beta2_ResourceBinding_To_v1beta1_ResourceBinding(in *v1beta2.
 	if err := v1.Convert_Pointer_bool_To_bool(&in.Applied, &out.Applied, s); err != nil {
 		return err
 	}
+	// WARNING: in.LastApplyError requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -39,6 +39,14 @@ const (
 	// ClusterResourceSetResourcesAppliedWrongSecretTypeReason is the reason used when the Secret's type in the resource list is not supported.
 	ClusterResourceSetResourcesAppliedWrongSecretTypeReason = "WrongSecretType"
 
+	// ClusterResourceSetResourcesAppliedWaitingForDependenciesReason is the reason used when the ClusterResourceSet is not applied
+	// to at least one of the matching clusters because the ClusterResourceSets it depends on are not yet applied to the cluster.
+	ClusterResourceSetResourcesAppliedWaitingForDependenciesReason = "WaitingForDependencies"
+
+	// ClusterResourceSetResourcesAppliedDependencyCycleReason is the reason used when the ClusterResourceSet
+	// is part of a dependency cycle, and thus it can't be applied.
+	ClusterResourceSetResourcesAppliedDependencyCycleReason = "DependencyCycle"
+
 	// ClusterResourceSetResourcesAppliedInternalErrorReason surfaces unexpected failures when reconciling a ClusterResourceSet.
 	ClusterResourceSetResourcesAppliedInternalErrorReason = clusterv1.InternalErrorReason
 )
@@ -71,6 +79,79 @@ type ClusterResourceSetSpec struct {
 	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile
 	// +optional
 	Strategy string `json:"strategy,omitempty"`
+
+	// dependsOn is a list of names of ClusterResourceSets in the same namespace that must be applied to a Cluster
+	// before this ClusterResourceSet is applied to the same Cluster, e.g. a ClusterResourceSet deploying metrics-server
+	// can depend on the ClusterResourceSet deploying the CNI.
+	// A ClusterResourceSet is considered applied to a Cluster when all its resources are applied according to the
+	// ClusterResourceSetBinding of the Cluster.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// reconcile contains options for the Reconcile strategy.
+	// This field can only be set when strategy is Reconcile.
+	// +optional
+	Reconcile ClusterResourceSetReconcile `json:"reconcile,omitempty,omitzero"`
+
+	// templating defines how the content of resources is rendered for each Cluster before being applied to it,
+	// so a single ClusterResourceSet can apply Cluster specific configuration, e.g. the Cluster name in the CNI configuration.
+	// If not set, the content of resources is applied as is.
+	// +optional
+	Templating ClusterResourceSetTemplating `json:"templating,omitempty,omitzero"`
+}
+
+// ClusterResourceSetTemplatingFormat is the format of templates in ClusterResourceSet resources.
+// +kubebuilder:validation:Enum=GoTemplate
+type ClusterResourceSetTemplatingFormat string
+
+const (
+	// ClusterResourceSetTemplatingFormatGoTemplate renders the content of resources as Go templates.
+	// Templates can use the Sprig functions and the following variables:
+	// - .Cluster.Name
+	// - .Cluster.Namespace
+	// - .Cluster.InfrastructureKind
+	// - .Cluster.Labels, containing the Cluster labels listed in templating.clusterLabels.
+	ClusterResourceSetTemplatingFormatGoTemplate ClusterResourceSetTemplatingFormat = "GoTemplate"
+)
+
+// ClusterResourceSetTemplating defines how the content of resources is rendered before being applied to a Cluster.
+type ClusterResourceSetTemplating struct {
+	// format is the format of the templates in the content of all the resources of the ClusterResourceSet.
+	// +required
+	Format ClusterResourceSetTemplatingFormat `json:"format,omitempty"`
+
+	// clusterLabels is a list of keys of Cluster labels which are made available to templates.
+	// Labels which are not set on a Cluster are not available to templates.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=317
+	ClusterLabels []string `json:"clusterLabels,omitempty"`
+}
+
+// ClusterResourceSetReconcile contains options for the Reconcile strategy.
+// +kubebuilder:validation:MinProperties=1
+type ClusterResourceSetReconcile struct {
+	// prune defines if objects previously applied by the ClusterResourceSet to a Cluster must be deleted from the Cluster
+	// when they are removed from the ClusterResourceSet, e.g. because a resource is removed from the resources list
+	// or an object is removed from a resource.
+	// Applied objects are tracked in the ClusterResourceSetBinding of the Cluster; objects applied before
+	// prune is enabled are not tracked, and thus they are never deleted.
+	// Defaults to false.
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// driftCorrectionIntervalSeconds is the interval in seconds after which resources are re-applied to a Cluster
+	// even if they did not change, thus reverting changes applied to the objects in the Cluster by other actors.
+	// If not set, resources are re-applied only when they change.
+	// +optional
+	// +kubebuilder:validation:Minimum=60
+	DriftCorrectionIntervalSeconds *int32 `json:"driftCorrectionIntervalSeconds,omitempty"`
 }
 
 // ClusterResourceSetResourceKind is a string representation of a ClusterResourceSet resource kind.
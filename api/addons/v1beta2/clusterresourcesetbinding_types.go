@@ -22,6 +22,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ClusterResourceSetBindingInventoryAnnotation is the annotation on the ClusterResourceSetBinding used to track
+// the objects applied to the Cluster by ClusterResourceSets with prune enabled.
+// NOTE: The value of this annotation is managed by the ClusterResourceSet controller and it must not be changed.
+const ClusterResourceSetBindingInventoryAnnotation = "addons.cluster.x-k8s.io/inventory"
+
 // ResourceBinding shows the status of a resource that belongs to a ClusterResourceSet matched by the owner cluster of the ClusterResourceSetBinding object.
 type ResourceBinding struct {
 	// ResourceRef specifies a resource.
@@ -41,6 +46,13 @@ type ResourceBinding struct {
 	// applied is to track if a resource is applied to the cluster or not.
 	// +required
 	Applied *bool `json:"applied,omitempty"`
+
+	// lastApplyError is the error of the last attempt to apply the resource to the cluster.
+	// It is empty if the last attempt succeeded.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	LastApplyError string `json:"lastApplyError,omitempty"`
 }
 
 // ResourceSetBinding keeps info on all of the resources in a ClusterResourceSet.
@@ -38,4 +38,11 @@ const (
 
 	// WrongSecretTypeV1Beta1Reason (Severity=Warning) documents at least one of the Secret's type in the resource list is not supported.
 	WrongSecretTypeV1Beta1Reason = "WrongSecretType"
+
+	// WaitingForDependenciesV1Beta1Reason (Severity=Info) documents the ClusterResourceSet is waiting for the
+	// ClusterResourceSets it depends on to be applied to at least one of the matching clusters.
+	WaitingForDependenciesV1Beta1Reason = "WaitingForDependencies"
+
+	// DependencyCycleV1Beta1Reason (Severity=Error) documents the ClusterResourceSet is part of a dependency cycle.
+	DependencyCycleV1Beta1Reason = "DependencyCycle"
 )
@@ -185,6 +185,31 @@ func (in *ClusterResourceSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetReconcile) DeepCopyInto(out *ClusterResourceSetReconcile) {
+	*out = *in
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DriftCorrectionIntervalSeconds != nil {
+		in, out := &in.DriftCorrectionIntervalSeconds, &out.DriftCorrectionIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetReconcile.
+func (in *ClusterResourceSetReconcile) DeepCopy() *ClusterResourceSetReconcile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetReconcile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceSetSpec) DeepCopyInto(out *ClusterResourceSetSpec) {
 	*out = *in
@@ -194,6 +219,13 @@ func (in *ClusterResourceSetSpec) DeepCopyInto(out *ClusterResourceSetSpec) {
 		*out = make([]ResourceRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Reconcile.DeepCopyInto(&out.Reconcile)
+	in.Templating.DeepCopyInto(&out.Templating)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetSpec.
@@ -233,6 +265,26 @@ func (in *ClusterResourceSetStatus) DeepCopy() *ClusterResourceSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetTemplating) DeepCopyInto(out *ClusterResourceSetTemplating) {
+	*out = *in
+	if in.ClusterLabels != nil {
+		in, out := &in.ClusterLabels, &out.ClusterLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetTemplating.
+func (in *ClusterResourceSetTemplating) DeepCopy() *ClusterResourceSetTemplating {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetTemplating)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceSetV1Beta1DeprecatedStatus) DeepCopyInto(out *ClusterResourceSetV1Beta1DeprecatedStatus) {
 	*out = *in
@@ -683,7 +683,8 @@ func Convert_v1beta2_File_To_v1beta1_File(in *bootstrapv1.File, out *File, s api
 	if err := autoConvert_v1beta2_File_To_v1beta1_File(in, out, s); err != nil {
 		return err
 	}
-	if in.ContentFrom.IsDefined() {
+	// Note: contentFrom.containerd does not exist in v1beta1, it is restored from the conversion annotation.
+	if in.ContentFrom.Secret != (bootstrapv1.SecretFileSource{}) {
 		out.ContentFrom = &FileSource{}
 		if err := Convert_v1beta2_FileSource_To_v1beta1_FileSource(&in.ContentFrom, out.ContentFrom, s); err != nil {
 			return err
@@ -692,6 +693,10 @@ func Convert_v1beta2_File_To_v1beta1_File(in *bootstrapv1.File, out *File, s api
 	return nil
 }
 
+func Convert_v1beta2_FileSource_To_v1beta1_FileSource(in *bootstrapv1.FileSource, out *FileSource, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_FileSource_To_v1beta1_FileSource(in, out, s)
+}
+
 func deref[T any](ptr *T, def T) T {
 	if ptr != nil {
 		return *ptr
@@ -115,11 +115,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.FileSource)(nil), (*FileSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_FileSource_To_v1beta1_FileSource(a.(*v1beta2.FileSource), b.(*FileSource), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Filesystem)(nil), (*v1beta2.Filesystem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Filesystem_To_v1beta2_Filesystem(a.(*Filesystem), b.(*v1beta2.Filesystem), scope)
 	}); err != nil {
@@ -485,6 +480,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.FileSource)(nil), (*FileSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_FileSource_To_v1beta1_FileSource(a.(*v1beta2.FileSource), b.(*FileSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IgnitionSpec)(nil), (*IgnitionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IgnitionSpec_To_v1beta1_IgnitionSpec(a.(*v1beta2.IgnitionSpec), b.(*IgnitionSpec), scope)
 	}); err != nil {
@@ -952,14 +952,10 @@ func autoConvert_v1beta2_FileSource_To_v1beta1_FileSource(in *v1beta2.FileSource
 	if err := Convert_v1beta2_SecretFileSource_To_v1beta1_SecretFileSource(&in.Secret, &out.Secret, s); err != nil {
 		return err
 	}
+	// WARNING: in.Containerd requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_FileSource_To_v1beta1_FileSource is an autogenerated conversion function.
-func Convert_v1beta2_FileSource_To_v1beta1_FileSource(in *v1beta2.FileSource, out *FileSource, s conversion.Scope) error {
-	return autoConvert_v1beta2_FileSource_To_v1beta1_FileSource(in, out, s)
-}
-
 func autoConvert_v1beta1_Filesystem_To_v1beta2_Filesystem(in *Filesystem, out *v1beta2.Filesystem, s conversion.Scope) error {
 	out.Device = in.Device
 	out.Filesystem = in.Filesystem
@@ -1412,6 +1408,10 @@ func autoConvert_v1beta2_KubeadmConfigSpec_To_v1beta1_KubeadmConfigSpec(in *v1be
 	out.Format = Format(in.Format)
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	// WARNING: in.Ignition requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2.IgnitionSpec vs *sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1.IgnitionSpec)
+	// WARNING: in.Encryption requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapTokenPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.GeneratedFiles requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapFailureLog requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1454,6 +1454,7 @@ func autoConvert_v1beta2_KubeadmConfigStatus_To_v1beta1_KubeadmConfigStatus(in *
 	if err := v1.Convert_string_To_Pointer_string(&in.DataSecretName, &out.DataSecretName, s); err != nil {
 		return err
 	}
+	// WARNING: in.BootstrapTokenExpirationTime requires manual conversion: does not exist in peer-type
 	out.ObservedGeneration = in.ObservedGeneration
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
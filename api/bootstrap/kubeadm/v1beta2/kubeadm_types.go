@@ -71,6 +71,30 @@ const (
 	KubeadmConfigDataSecretNotAvailableReason = clusterv1.NotAvailableReason
 )
 
+// KubeadmConfig's BootstrapFailed condition and corresponding reasons.
+const (
+	// KubeadmConfigBootstrapFailedCondition is true if kubeadm init or kubeadm join failed on the machine
+	// bootstrapped using the KubeadmConfig, as reported by a node bootstrap reporter via the ConfigMap
+	// named after the KubeadmConfig with the KubeadmConfigBootstrapFailureReportSuffix.
+	// Note: this condition is not set if no failure has been reported, and it is removed when the node is joined.
+	KubeadmConfigBootstrapFailedCondition = "BootstrapFailed"
+
+	// KubeadmConfigBootstrapFailedReason surfaces when kubeadm init or kubeadm join failed on the machine.
+	KubeadmConfigBootstrapFailedReason = "BootstrapFailed"
+)
+
+const (
+	// KubeadmConfigBootstrapFailureReportSuffix is the suffix of the name of the ConfigMap that a node bootstrap reporter,
+	// e.g. an infrastructure provider, can create in the namespace of a KubeadmConfig to report that kubeadm init
+	// or kubeadm join failed; the ConfigMap name is the KubeadmConfig name followed by this suffix.
+	KubeadmConfigBootstrapFailureReportSuffix = "-bootstrap-failure"
+
+	// KubeadmConfigBootstrapFailureReportOutputKey is the key of the bootstrap failure report ConfigMap
+	// containing the kubeadm output, usually the content of the /run/cluster-api/bootstrap-failure.log file
+	// written on the machine when kubeadm fails.
+	KubeadmConfigBootstrapFailureReportOutputKey = "output"
+)
+
 // EncryptionAlgorithmType can define an asymmetric encryption algorithm type.
 // +kubebuilder:validation:Enum=ECDSA-P256;ECDSA-P384;RSA-2048;RSA-3072;RSA-4096
 type EncryptionAlgorithmType string
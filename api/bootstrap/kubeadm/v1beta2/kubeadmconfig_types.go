@@ -127,6 +127,135 @@ type KubeadmConfigSpec struct {
 	// ignition contains Ignition specific configuration.
 	// +optional
 	Ignition IgnitionSpec `json:"ignition,omitempty,omitzero"`
+
+	// encryption specifies how to encrypt the bootstrap data.
+	// If set, the bootstrap data is encrypted before being stored in the bootstrap data secret,
+	// and infrastructure providers or node agents must decrypt it before using it on the machine.
+	// This field can be set only if the KubeadmBootstrapDataEncryption feature gate is enabled.
+	// +optional
+	Encryption BootstrapDataEncryption `json:"encryption,omitempty,omitzero"`
+
+	// bootstrapTokenPolicy specifies the policy for the bootstrap token generated by the kubeadm bootstrap provider
+	// to join nodes to the cluster.
+	// This field is ignored if joinConfiguration.discovery.file is set.
+	// +optional
+	BootstrapTokenPolicy BootstrapTokenPolicy `json:"bootstrapTokenPolicy,omitempty,omitzero"`
+
+	// generatedFiles specifies the ownership and permissions of the files generated by the kubeadm bootstrap provider,
+	// i.e. the kubeadm configuration file and the certificates.
+	// +optional
+	GeneratedFiles GeneratedFiles `json:"generatedFiles,omitempty,omitzero"`
+
+	// bootstrapFailureLog specifies if the bootstrap data must write the bootstrap output to a log file
+	// on the machine when kubeadm init or kubeadm join fails.
+	// +optional
+	BootstrapFailureLog BootstrapFailureLog `json:"bootstrapFailureLog,omitempty,omitzero"`
+}
+
+// BootstrapFailureLog defines if the bootstrap output is written to a log file when kubeadm init or kubeadm join fails.
+// +kubebuilder:validation:MinProperties=1
+type BootstrapFailureLog struct {
+	// enabled specifies if the last lines of the bootstrap output are written to the /run/cluster-api/bootstrap-failure.log
+	// file on the machine when kubeadm init or kubeadm join fails, so they can be reported back by a node bootstrap reporter.
+	// This must not be enabled for Windows machines, because the file is written using Linux commands.
+	// If not set, the file is not written.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// IsDefined returns true if the BootstrapFailureLog is defined.
+func (r *BootstrapFailureLog) IsDefined() bool {
+	return !reflect.DeepEqual(r, &BootstrapFailureLog{})
+}
+
+// GeneratedFilesProfile is a hardening profile for the files generated by the kubeadm bootstrap provider.
+// +kubebuilder:validation:Enum=Default;CIS
+type GeneratedFilesProfile string
+
+const (
+	// DefaultGeneratedFilesProfile applies the default permissions to the generated files, i.e. 0640 for the
+	// kubeadm configuration file and the certificates, and 0600 for the private keys.
+	DefaultGeneratedFilesProfile GeneratedFilesProfile = "Default"
+
+	// CISGeneratedFilesProfile applies the permissions recommended by the CIS Kubernetes Benchmark to the
+	// generated files, i.e. 0600 for the kubeadm configuration file, the certificates and the private keys.
+	CISGeneratedFilesProfile GeneratedFilesProfile = "CIS"
+)
+
+// GeneratedFiles defines the ownership and permissions of the files generated by the kubeadm bootstrap provider.
+// +kubebuilder:validation:MinProperties=1
+type GeneratedFiles struct {
+	// profile is the hardening profile applied to the generated files.
+	// If not set, the Default profile is used.
+	// +optional
+	Profile GeneratedFilesProfile `json:"profile,omitempty"`
+
+	// owner specifies the ownership of the generated files, e.g. "root:root".
+	// If not set, the generated files are owned by root:root.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Owner string `json:"owner,omitempty"`
+
+	// permissions specifies the permissions to assign to the generated files, e.g. "0600".
+	// If set, it takes precedence over the permissions defined by the profile.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^0?[0-7]{3}$`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=16
+	Permissions string `json:"permissions,omitempty"`
+}
+
+// IsDefined returns true if the GeneratedFiles is defined.
+func (r *GeneratedFiles) IsDefined() bool {
+	return !reflect.DeepEqual(r, &GeneratedFiles{})
+}
+
+// BootstrapTokenPolicy defines the policy for the bootstrap token used to join nodes to the cluster.
+// +kubebuilder:validation:MinProperties=1
+type BootstrapTokenPolicy struct {
+	// ttlSeconds is the amount of time a bootstrap token is valid.
+	// The bootstrap token is refreshed until the Machine joins the cluster, and it is rotated for MachinePools,
+	// so it does not expire before being consumed.
+	// If not set, the TTL configured on the kubeadm bootstrap provider with the --bootstrap-token-ttl flag is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=60
+	TTLSeconds *int32 `json:"ttlSeconds,omitempty"`
+
+	// rotationIntervalSeconds is the interval after which the bootstrap token of a MachinePool is replaced by a new one.
+	// It must be lower than the TTL of the bootstrap token.
+	// If not set, the bootstrap token is rotated when half of its TTL elapsed.
+	// +optional
+	// +kubebuilder:validation:Minimum=60
+	RotationIntervalSeconds *int32 `json:"rotationIntervalSeconds,omitempty"`
+
+	// oneTimeUse specifies if the bootstrap token must be deleted from the workload cluster as soon as the Machine
+	// joined the cluster, instead of waiting for the bootstrap token to expire.
+	// This field is ignored for MachinePools, because the bootstrap token is shared by all the MachinePool instances.
+	// +optional
+	OneTimeUse *bool `json:"oneTimeUse,omitempty"`
+}
+
+// IsDefined returns true if the BootstrapTokenPolicy is defined.
+func (r *BootstrapTokenPolicy) IsDefined() bool {
+	return !reflect.DeepEqual(r, &BootstrapTokenPolicy{})
+}
+
+// BootstrapDataEncryption defines how to encrypt the bootstrap data.
+// +kubebuilder:validation:MinProperties=1
+type BootstrapDataEncryption struct {
+	// provider is the name of the encryption provider to be used to encrypt the bootstrap data.
+	// The encryption provider must be configured on the kubeadm bootstrap provider, e.g. by using
+	// the --bootstrap-data-encryption-public-key flag.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Provider string `json:"provider,omitempty"`
+}
+
+// IsDefined returns true if the BootstrapDataEncryption is defined.
+func (r *BootstrapDataEncryption) IsDefined() bool {
+	return !reflect.DeepEqual(r, &BootstrapDataEncryption{})
 }
 
 // IgnitionSpec contains Ignition specific configuration.
@@ -188,6 +317,12 @@ type KubeadmConfigStatus struct {
 	// +kubebuilder:validation:MaxLength=253
 	DataSecretName string `json:"dataSecretName,omitempty"`
 
+	// bootstrapTokenExpirationTime is the time the bootstrap token used to join nodes to the cluster expires.
+	// It is updated every time the bootstrap token is created, refreshed or rotated, and it is cleared when
+	// a one-time-use bootstrap token is deleted.
+	// +optional
+	BootstrapTokenExpirationTime metav1.Time `json:"bootstrapTokenExpirationTime,omitempty,omitzero"`
+
 	// observedGeneration is the latest generation observed by the controller.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
@@ -382,6 +517,7 @@ type File struct {
 	// Available template variables:
 	//   - .controlPlane.version: the Kubernetes version of the control plane (e.g. "v1.35.0").
 	//     Only set when the cluster has a control plane reference that exposes spec.version.
+	//   - .cluster.name, .cluster.namespace, .cluster.labels and .cluster.annotations: the metadata of the Cluster.
 	// When set to "Raw" or omitted, content is used verbatim.
 	// +optional
 	ContentFormat FileContentFormat `json:"contentFormat,omitempty"`
@@ -390,10 +526,17 @@ type File struct {
 // FileSource is a union of all possible external source types for file data.
 // Only one field may be populated in any given instance. Developers adding new
 // sources of data for target systems should add them here.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
 type FileSource struct {
 	// secret represents a secret that should populate this file.
-	// +required
+	// +optional
 	Secret SecretFileSource `json:"secret,omitempty,omitzero"`
+
+	// containerd represents a containerd configuration that should populate this file.
+	// The configuration is generated using the containerd configuration version 2 format.
+	// +optional
+	Containerd ContainerdFileSource `json:"containerd,omitempty,omitzero"`
 }
 
 // IsDefined returns true if the FileSource is defined.
@@ -419,6 +562,52 @@ type SecretFileSource struct {
 	Key string `json:"key,omitempty"`
 }
 
+// ContainerdFileSource defines a containerd configuration used to populate a file.
+//
+// String values can reference the same template variables available for files using contentFormat "Template",
+// e.g. "{{ .cluster.labels.registry }}/pause:3.10".
+// +kubebuilder:validation:MinProperties=1
+type ContainerdFileSource struct {
+	// sandboxImage is the image used by containerd for the pod sandbox container, e.g. "registry.k8s.io/pause:3.10".
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	SandboxImage string `json:"sandboxImage,omitempty"`
+
+	// registryMirrors defines the mirrors to be used when pulling images from a registry.
+	// They are written to a hosts.toml file for each registry in the certs.d directory next to the file,
+	// which is set as the config_path of the containerd registry configuration.
+	// +optional
+	// +listType=map
+	// +listMapKey=registry
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	RegistryMirrors []ContainerdRegistryMirror `json:"registryMirrors,omitempty"`
+}
+
+// IsDefined returns true if the ContainerdFileSource is defined.
+func (r *ContainerdFileSource) IsDefined() bool {
+	return !reflect.DeepEqual(r, &ContainerdFileSource{})
+}
+
+// ContainerdRegistryMirror defines the mirrors of a container image registry.
+type ContainerdRegistryMirror struct {
+	// registry is the host of the registry to mirror, e.g. "docker.io".
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Registry string `json:"registry,omitempty"`
+
+	// endpoints are the URLs of the mirrors, e.g. "https://mirror.example.com"; they are tried in order.
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
 // PasswdSource is a union of all possible external source types for passwd data.
 // Only one field may be populated in any given instance. Developers adding new
 // sources of data for target systems should add them here.
@@ -107,6 +107,41 @@ func (in *Arg) DeepCopy() *Arg {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapDataEncryption) DeepCopyInto(out *BootstrapDataEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapDataEncryption.
+func (in *BootstrapDataEncryption) DeepCopy() *BootstrapDataEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapDataEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapFailureLog) DeepCopyInto(out *BootstrapFailureLog) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapFailureLog.
+func (in *BootstrapFailureLog) DeepCopy() *BootstrapFailureLog {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapFailureLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BootstrapToken) DeepCopyInto(out *BootstrapToken) {
 	*out = *in
@@ -164,6 +199,36 @@ func (in *BootstrapTokenDiscovery) DeepCopy() *BootstrapTokenDiscovery {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapTokenPolicy) DeepCopyInto(out *BootstrapTokenPolicy) {
+	*out = *in
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RotationIntervalSeconds != nil {
+		in, out := &in.RotationIntervalSeconds, &out.RotationIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OneTimeUse != nil {
+		in, out := &in.OneTimeUse, &out.OneTimeUse
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapTokenPolicy.
+func (in *BootstrapTokenPolicy) DeepCopy() *BootstrapTokenPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapTokenPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BootstrapTokenString) DeepCopyInto(out *BootstrapTokenString) {
 	*out = *in
@@ -226,6 +291,48 @@ func (in *ContainerLinuxConfig) DeepCopy() *ContainerLinuxConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdFileSource) DeepCopyInto(out *ContainerdFileSource) {
+	*out = *in
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]ContainerdRegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdFileSource.
+func (in *ContainerdFileSource) DeepCopy() *ContainerdFileSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdFileSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistryMirror) DeepCopyInto(out *ContainerdRegistryMirror) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistryMirror.
+func (in *ContainerdRegistryMirror) DeepCopy() *ContainerdRegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdRegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerManager) DeepCopyInto(out *ControllerManager) {
 	*out = *in
@@ -388,7 +495,7 @@ func (in *File) DeepCopyInto(out *File) {
 		*out = new(bool)
 		**out = **in
 	}
-	out.ContentFrom = in.ContentFrom
+	in.ContentFrom.DeepCopyInto(&out.ContentFrom)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
@@ -438,6 +545,7 @@ func (in *FileDiscoveryKubeConfig) DeepCopy() *FileDiscoveryKubeConfig {
 func (in *FileSource) DeepCopyInto(out *FileSource) {
 	*out = *in
 	out.Secret = in.Secret
+	in.Containerd.DeepCopyInto(&out.Containerd)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSource.
@@ -475,6 +583,21 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedFiles) DeepCopyInto(out *GeneratedFiles) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedFiles.
+func (in *GeneratedFiles) DeepCopy() *GeneratedFiles {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedFiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostPathMount) DeepCopyInto(out *HostPathMount) {
 	*out = *in
@@ -849,6 +972,10 @@ func (in *KubeadmConfigSpec) DeepCopyInto(out *KubeadmConfigSpec) {
 		**out = **in
 	}
 	in.Ignition.DeepCopyInto(&out.Ignition)
+	out.Encryption = in.Encryption
+	in.BootstrapTokenPolicy.DeepCopyInto(&out.BootstrapTokenPolicy)
+	out.GeneratedFiles = in.GeneratedFiles
+	in.BootstrapFailureLog.DeepCopyInto(&out.BootstrapFailureLog)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmConfigSpec.
@@ -872,6 +999,7 @@ func (in *KubeadmConfigStatus) DeepCopyInto(out *KubeadmConfigStatus) {
 		}
 	}
 	in.Initialization.DeepCopyInto(&out.Initialization)
+	in.BootstrapTokenExpirationTime.DeepCopyInto(&out.BootstrapTokenExpirationTime)
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(KubeadmConfigDeprecatedStatus)
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+// KubeadmControlPlane event reasons are stable, machine-readable values for the Reason field
+// of the Kubernetes Events emitted by the KubeadmControlPlane controller.
+const (
+	// KubeadmControlPlaneFailedInitializationEventReason is emitted when the first control plane Machine could not be created.
+	KubeadmControlPlaneFailedInitializationEventReason = "FailedInitialization"
+
+	// KubeadmControlPlaneFailedScaleUpEventReason is emitted when an additional control plane Machine could not be created.
+	KubeadmControlPlaneFailedScaleUpEventReason = "FailedScaleUp"
+
+	// KubeadmControlPlaneFailedScaleDownEventReason is emitted when a control plane Machine could not be deleted during scale down.
+	KubeadmControlPlaneFailedScaleDownEventReason = "FailedScaleDown"
+
+	// KubeadmControlPlaneFailedDeleteEventReason is emitted when control plane Machines could not be deleted during KubeadmControlPlane deletion.
+	KubeadmControlPlaneFailedDeleteEventReason = "FailedDelete"
+
+	// KubeadmControlPlaneRolloutMachineCreatedEventReason is emitted when a control plane Machine has been created.
+	KubeadmControlPlaneRolloutMachineCreatedEventReason = "RolloutMachineCreated"
+
+	// KubeadmControlPlaneRolloutMachineDeletedEventReason is emitted when a control plane Machine has been deleted.
+	KubeadmControlPlaneRolloutMachineDeletedEventReason = "RolloutMachineDeleted"
+
+	// KubeadmControlPlaneUnhealthyEventReason is emitted when a control plane operation is blocked by unhealthy components.
+	KubeadmControlPlaneUnhealthyEventReason = "ControlPlaneUnhealthy"
+
+	// KubeadmControlPlaneEtcdMemberRemovedEventReason is emitted when an etcd member has been removed.
+	KubeadmControlPlaneEtcdMemberRemovedEventReason = "EtcdMemberRemoved"
+
+	// KubeadmControlPlaneEtcdLeadershipForwardedEventReason is emitted when etcd leadership has been moved away from a deleting Machine.
+	KubeadmControlPlaneEtcdLeadershipForwardedEventReason = "EtcdLeadershipForwarded"
+
+	// KubeadmControlPlaneInvalidEtcdLeadershipTransferStrategyEventReason is emitted when the etcd leadership transfer strategy
+	// annotation has an invalid value and the default strategy is used instead.
+	KubeadmControlPlaneInvalidEtcdLeadershipTransferStrategyEventReason = "InvalidEtcdLeadershipTransferStrategy"
+
+	// KubeadmControlPlaneEtcdSnapshotTakenEventReason is emitted when an etcd snapshot has been taken before hibernation.
+	KubeadmControlPlaneEtcdSnapshotTakenEventReason = "EtcdSnapshotTaken"
+
+	// KubeadmControlPlaneWakingUpEventReason is emitted when a hibernated control plane starts waking up.
+	KubeadmControlPlaneWakingUpEventReason = "WakingUp"
+
+	// KubeadmControlPlaneWokeUpEventReason is emitted when a hibernated control plane has woken up.
+	KubeadmControlPlaneWokeUpEventReason = "WokeUp"
+
+	// KubeadmControlPlaneAdoptionFailedEventReason is emitted when a Machine could not be adopted.
+	KubeadmControlPlaneAdoptionFailedEventReason = "AdoptionFailed"
+
+	// KubeadmControlPlaneMachineLabeledForAdoptionEventReason is emitted when a Machine has been labeled for adoption.
+	KubeadmControlPlaneMachineLabeledForAdoptionEventReason = "MachineLabeledForAdoption"
+
+	// KubeadmControlPlaneSuccessfulStartInPlaceUpdateEventReason is emitted when an in-place update of a Machine has been started.
+	KubeadmControlPlaneSuccessfulStartInPlaceUpdateEventReason = "SuccessfulStartInPlaceUpdate"
+
+	// KubeadmControlPlaneCARotationPhaseStartedEventReason is emitted when a phase of a CA rotation has been started.
+	KubeadmControlPlaneCARotationPhaseStartedEventReason = "CARotationPhaseStarted"
+
+	// KubeadmControlPlaneCARotationCompletedEventReason is emitted when a CA rotation has been completed.
+	KubeadmControlPlaneCARotationCompletedEventReason = "CARotationCompleted"
+)
@@ -65,6 +65,39 @@ const (
 	// ensure it runs last (thus ensuring that kubelet is still working while other pre-terminate hooks run).
 	PreTerminateHookCleanupAnnotation = clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/kcp-cleanup"
 
+	// MachineRolloutHoldAnnotation can be set on control plane Machines to prevent KCP from rolling them out;
+	// Machines with this annotation are skipped during rollouts until the annotation is removed, and
+	// they are reported in the RollingOut condition.
+	// NOTE: the upgrade of Machines with this annotation is considered to be managed externally.
+	MachineRolloutHoldAnnotation = "controlplane.cluster.x-k8s.io/rollout-hold"
+
+	// AdoptMachinesAnnotation can be set on a KubeadmControlPlane to request the adoption of stand alone control plane
+	// Machines that do not have the labels required for adoption, e.g. Machines created by older tooling.
+	// The value of the annotation is a label selector identifying those Machines in the KubeadmControlPlane namespace;
+	// KCP adds the cluster.x-k8s.io/cluster-name and cluster.x-k8s.io/control-plane labels to matching Machines
+	// and to their KubeadmConfigs, and then adopts them; the annotation is removed once adoption completes.
+	// Note: The label selector must not be empty, and matching Machines are adopted only if their KubeadmConfig
+	// is for a control plane Machine.
+	AdoptMachinesAnnotation = "controlplane.cluster.x-k8s.io/adopt-machines"
+
+	// EtcdLeadershipTransferStrategyAnnotation can be set on a KubeadmControlPlane or on one of its Machines to
+	// configure how KCP picks the Machine to forward etcd leadership to before deleting a Machine hosting the etcd leader.
+	// The annotation on the deleting Machine takes precedence over the annotation on the KubeadmControlPlane.
+	// Supported values are oldest, newest and same-failure-domain-preferred; if not set, newest is used.
+	// An invalid value on a Machine is ignored and newest is used instead.
+	// NOTE: Machines with a healthy etcd member are always preferred, no matter of the strategy.
+	EtcdLeadershipTransferStrategyAnnotation = "controlplane.cluster.x-k8s.io/etcd-leadership-transfer-strategy"
+
+	// EtcdLeadershipTransferStrategyOldest forwards etcd leadership to the oldest Machine.
+	EtcdLeadershipTransferStrategyOldest = "oldest"
+
+	// EtcdLeadershipTransferStrategyNewest forwards etcd leadership to the newest Machine.
+	EtcdLeadershipTransferStrategyNewest = "newest"
+
+	// EtcdLeadershipTransferStrategySameFailureDomainPreferred forwards etcd leadership to the newest Machine
+	// in the same failure domain of the deleting Machine, if any, otherwise to the newest Machine.
+	EtcdLeadershipTransferStrategySameFailureDomainPreferred = "same-failure-domain-preferred"
+
 	// DefaultMinHealthyPeriodSeconds defines the default minimum period before we consider a remediation on a
 	// machine unrelated from the previous remediation.
 	DefaultMinHealthyPeriodSeconds = int32(60 * 60)
@@ -189,6 +222,106 @@ const (
 	KubeadmControlPlaneControlPlaneComponentsHealthUnknownReason = "HealthUnknown"
 )
 
+// KubeadmControlPlane's Adopting condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneAdoptingCondition is true while KubeadmControlPlane is adopting stand alone control plane Machines.
+	// Note: this condition is set only when adoption happens or when the controlplane.cluster.x-k8s.io/adopt-machines
+	// annotation is set.
+	KubeadmControlPlaneAdoptingCondition = "Adopting"
+
+	// KubeadmControlPlaneAdoptingReason surfaces when KubeadmControlPlane is adopting stand alone control plane Machines.
+	KubeadmControlPlaneAdoptingReason = "Adopting"
+
+	// KubeadmControlPlaneNotAdoptingReason surfaces when there are no stand alone control plane Machines to adopt.
+	KubeadmControlPlaneNotAdoptingReason = "NotAdopting"
+
+	// KubeadmControlPlaneAdoptingInternalErrorReason surfaces unexpected failures when adopting stand alone
+	// control plane Machines.
+	KubeadmControlPlaneAdoptingInternalErrorReason = clusterv1.InternalErrorReason
+)
+
+// KubeadmControlPlane's ConfigurationUpToDate condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneConfigurationUpToDateCondition is true if the ClusterConfiguration in the kubeadm-config ConfigMap
+	// in the workload cluster is consistent with spec.kubeadmConfigSpec. This allows to detect out-of-band changes to the
+	// kubeadm-config ConfigMap which are going to be overwritten at the next rollout.
+	KubeadmControlPlaneConfigurationUpToDateCondition = "ConfigurationUpToDate"
+
+	// KubeadmControlPlaneConfigurationUpToDateReason surfaces when the ClusterConfiguration in the kubeadm-config ConfigMap
+	// is consistent with spec.kubeadmConfigSpec.
+	KubeadmControlPlaneConfigurationUpToDateReason = clusterv1.UpToDateReason
+
+	// KubeadmControlPlaneConfigurationNotUpToDateReason surfaces when the ClusterConfiguration in the kubeadm-config ConfigMap
+	// is not consistent with spec.kubeadmConfigSpec.
+	KubeadmControlPlaneConfigurationNotUpToDateReason = clusterv1.NotUpToDateReason
+
+	// KubeadmControlPlaneConfigurationUpToDateInspectionFailedReason documents a failure when comparing the
+	// kubeadm-config ConfigMap with spec.kubeadmConfigSpec.
+	KubeadmControlPlaneConfigurationUpToDateInspectionFailedReason = clusterv1.InspectionFailedReason
+)
+
+// KubeadmControlPlane's Hibernated condition and corresponding reasons.
+// Note: This condition is set only if the KubeadmControlPlaneHibernation feature gate is enabled.
+const (
+	// KubeadmControlPlaneHibernatedCondition is true if the KubeadmControlPlane has been scaled to zero replicas
+	// and all the control plane Machines have been deleted.
+	KubeadmControlPlaneHibernatedCondition = "Hibernated"
+
+	// KubeadmControlPlaneHibernatedReason surfaces when the KubeadmControlPlane has been scaled to zero replicas
+	// and all the control plane Machines have been deleted.
+	KubeadmControlPlaneHibernatedReason = "Hibernated"
+
+	// KubeadmControlPlaneHibernatingReason surfaces when the KubeadmControlPlane is scaling down to zero replicas.
+	KubeadmControlPlaneHibernatingReason = "Hibernating"
+
+	// KubeadmControlPlaneWakingUpReason surfaces when the KubeadmControlPlane is scaling up from zero replicas
+	// and the first control plane Machine is not yet provisioned.
+	KubeadmControlPlaneWakingUpReason = "WakingUp"
+
+	// KubeadmControlPlaneNotHibernatedReason surfaces when the KubeadmControlPlane is not hibernated.
+	KubeadmControlPlaneNotHibernatedReason = "NotHibernated"
+)
+
+// KubeadmControlPlane's CARotation condition and corresponding reasons.
+// Note: This condition is set only if spec.caRotation.requestedAt is set.
+const (
+	// KubeadmControlPlaneCARotationCondition is true while the certificate authority of the Cluster is being rotated.
+	KubeadmControlPlaneCARotationCondition = "CARotation"
+
+	// KubeadmControlPlaneCARotationInProgressReason surfaces when a CA rotation is in progress.
+	KubeadmControlPlaneCARotationInProgressReason = "InProgress"
+
+	// KubeadmControlPlaneCARotationCompletedReason surfaces when the last CA rotation is completed.
+	KubeadmControlPlaneCARotationCompletedReason = "Completed"
+
+	// KubeadmControlPlaneCARotationNotSupportedReason surfaces when the CA of the Cluster has not been
+	// generated by the KubeadmControlPlane and thus it cannot be rotated.
+	KubeadmControlPlaneCARotationNotSupportedReason = "NotSupported"
+
+	// KubeadmControlPlaneCARotationInternalErrorReason surfaces unexpected failures when rotating the CA.
+	KubeadmControlPlaneCARotationInternalErrorReason = clusterv1.InternalErrorReason
+)
+
+// KubeadmControlPlane's MachinesFieldsCoOwned condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneMachinesFieldsCoOwnedCondition is true if fields managed by the KubeadmControlPlane controller
+	// on the controlled Machines, KubeadmConfigs or InfraMachines are also owned by other field managers,
+	// e.g. because a user edited them with kubectl.
+	// Note: The KubeadmControlPlane controller can't remove co-owned fields when they are dropped from its spec.
+	KubeadmControlPlaneMachinesFieldsCoOwnedCondition = "MachinesFieldsCoOwned"
+
+	// KubeadmControlPlaneMachinesFieldsCoOwnedReason surfaces when fields managed by the KubeadmControlPlane controller
+	// are also owned by other field managers.
+	KubeadmControlPlaneMachinesFieldsCoOwnedReason = "FieldsCoOwned"
+
+	// KubeadmControlPlaneMachinesFieldsNotCoOwnedReason surfaces when fields managed by the KubeadmControlPlane controller
+	// are not owned by other field managers.
+	KubeadmControlPlaneMachinesFieldsNotCoOwnedReason = "FieldsNotCoOwned"
+
+	// KubeadmControlPlaneMachinesFieldsCoOwnedInternalErrorReason surfaces unexpected failures when detecting co-owned fields.
+	KubeadmControlPlaneMachinesFieldsCoOwnedInternalErrorReason = clusterv1.InternalErrorReason
+)
+
 // KubeadmControlPlane's MachinesReady condition and corresponding reasons.
 const (
 	// KubeadmControlPlaneMachinesReadyCondition surfaces detail of issues on the controlled machines, if any.
@@ -443,6 +576,7 @@ const (
 type KubeadmControlPlaneSpec struct {
 	// replicas is the number of desired machines. Defaults to 1. When stacked etcd is used only
 	// odd numbers are permitted, as per [etcd best practice](https://etcd.io/docs/v3.3.12/faq/#why-an-odd-number-of-cluster-members).
+	// Zero is permitted only when the KubeadmControlPlaneHibernation feature gate is enabled.
 	// This is a pointer to distinguish between explicit zero and not specified.
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
@@ -477,6 +611,62 @@ type KubeadmControlPlaneSpec struct {
 	// InfraMachines & KubeadmConfigs will use the same name as the corresponding Machines.
 	// +optional
 	MachineNaming MachineNamingSpec `json:"machineNaming,omitempty,omitzero"`
+
+	// caRotation allows to rotate the certificate authority of the Cluster, i.e. the CA stored in the
+	// <cluster-name>-ca Secret, if it has been generated by the KubeadmControlPlane.
+	// +optional
+	CARotation KubeadmControlPlaneCARotationSpec `json:"caRotation,omitempty,omitzero"`
+
+	// hibernation defines how the control plane is hibernated when it is scaled down to zero replicas.
+	// Note: This field is used only if the KubeadmControlPlaneHibernation feature gate is enabled.
+	// +optional
+	Hibernation KubeadmControlPlaneHibernationSpec `json:"hibernation,omitempty,omitzero"`
+}
+
+// KubeadmControlPlaneCARotationSpec allows to request the rotation of the certificate authority of the Cluster.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneCARotationSpec struct {
+	// requestedAt requests a rotation of the certificate authority of the Cluster. A rotation is started when
+	// requestedAt is set to a time later than the requestedAt of the last rotation, i.e. status.caRotation.requestedAt.
+	//
+	// The rotation is performed in phases; at the end of each phase all the Machines of the Cluster must be replaced.
+	// Control plane Machines are rolled out by the KubeadmControlPlane, while Machines of MachineDeployments,
+	// MachineSets and MachinePools must be rolled out by the user, e.g. by setting spec.rollout.after on MachineDeployments.
+	//
+	// Note: only the cluster CA is rotated; the etcd CA, the front-proxy CA and the service account keys are not changed.
+	// +optional
+	RequestedAt metav1.Time `json:"requestedAt,omitempty,omitzero"`
+}
+
+// KubeadmControlPlaneHibernationSpec defines how the control plane is hibernated.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneHibernationSpec struct {
+	// etcdSnapshot defines where the etcd snapshot taken before deleting the last control plane Machine is stored.
+	// It is required to scale down to zero replicas a control plane with etcd managed by the KubeadmControlPlane.
+	// +optional
+	EtcdSnapshot EtcdSnapshotStore `json:"etcdSnapshot,omitempty,omitzero"`
+}
+
+// EtcdSnapshotStore defines an HTTPS object store for the etcd snapshot of a hibernated control plane.
+type EtcdSnapshotStore struct {
+	// url is the https URL of the object storing the gzip compressed etcd snapshot, e.g.
+	// https://storage.example.com/my-bucket/my-cluster-etcd-snapshot.db.gz.
+	// The host of the URL must be allowed with the --etcd-snapshot-store-allowed-hosts flag of the KubeadmControlPlane controller.
+	// The snapshot is uploaded by the KubeadmControlPlane controller with an HTTP PUT request when hibernating
+	// the control plane, and downloaded by the first control plane Machine with an HTTP GET request when waking up.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url,omitempty"`
+
+	// headersSecretName is the name of a Secret in the namespace of the KubeadmControlPlane with the HTTP headers
+	// to send when uploading and downloading the etcd snapshot, e.g. for authentication; the headers must be
+	// stored in the headers key, one per line in the "Name: value" format.
+	// Note: The headers are written to the first control plane Machine when waking up the control plane.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	HeadersSecretName string `json:"headersSecretName,omitempty"`
 }
 
 // KubeadmControlPlaneMachineTemplate defines the template for Machines
@@ -536,6 +726,11 @@ type KubeadmControlPlaneMachineTemplateSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=64
 	Taints []clusterv1.MachineTaint `json:"taints,omitempty"`
+
+	// spreadConstraints are used to compute the placement hints of new control plane Machines,
+	// which allow infrastructure providers to spread Machines across hosts.
+	// +optional
+	SpreadConstraints clusterv1.MachineSpreadConstraints `json:"spreadConstraints,omitempty,omitzero"`
 }
 
 // KubeadmControlPlaneMachineTemplateDeletionSpec contains configuration options for Machine deletion.
@@ -764,6 +959,10 @@ type KubeadmControlPlaneStatus struct {
 	// +optional
 	LastRemediation LastRemediationStatus `json:"lastRemediation,omitempty,omitzero"`
 
+	// caRotation reports the progress of the rotation of the certificate authority of the Cluster.
+	// +optional
+	CARotation KubeadmControlPlaneCARotationStatus `json:"caRotation,omitempty,omitzero"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *KubeadmControlPlaneDeprecatedStatus `json:"deprecated,omitempty"`
@@ -865,6 +1064,45 @@ type LastRemediationStatus struct {
 	RetryCount *int32 `json:"retryCount,omitempty"`
 }
 
+// KubeadmControlPlaneCARotationPhase is a phase of the rotation of the certificate authority of a Cluster.
+// +kubebuilder:validation:Enum=TrustingNewCA;SigningWithNewCA;RemovingOldCA
+type KubeadmControlPlaneCARotationPhase string
+
+const (
+	// KubeadmControlPlaneCARotationTrustingNewCAPhase is the first phase of a CA rotation; the new CA is added to
+	// the CA bundle trusted by the Cluster, while certificates are still signed by the old CA.
+	KubeadmControlPlaneCARotationTrustingNewCAPhase = KubeadmControlPlaneCARotationPhase("TrustingNewCA")
+
+	// KubeadmControlPlaneCARotationSigningWithNewCAPhase is the second phase of a CA rotation; certificates
+	// are signed by the new CA, while the old CA is still trusted.
+	KubeadmControlPlaneCARotationSigningWithNewCAPhase = KubeadmControlPlaneCARotationPhase("SigningWithNewCA")
+
+	// KubeadmControlPlaneCARotationRemovingOldCAPhase is the last phase of a CA rotation; the old CA is removed
+	// from the CA bundle trusted by the Cluster.
+	KubeadmControlPlaneCARotationRemovingOldCAPhase = KubeadmControlPlaneCARotationPhase("RemovingOldCA")
+)
+
+// KubeadmControlPlaneCARotationStatus reports the progress of the rotation of the certificate authority of a Cluster.
+// +kubebuilder:validation:MinProperties=1
+type KubeadmControlPlaneCARotationStatus struct {
+	// requestedAt is the spec.caRotation.requestedAt of the rotation in progress or of the last rotation.
+	// +optional
+	RequestedAt metav1.Time `json:"requestedAt,omitempty,omitzero"`
+
+	// phase is the phase of the rotation in progress; it is not set if no rotation is in progress.
+	// +optional
+	Phase KubeadmControlPlaneCARotationPhase `json:"phase,omitempty"`
+
+	// phaseStartTime is the time the current phase started; all the Machines of the Cluster created before
+	// this time must be replaced before the rotation can proceed to the next phase.
+	// +optional
+	PhaseStartTime metav1.Time `json:"phaseStartTime,omitempty,omitzero"`
+
+	// completionTime is the time the last rotation completed.
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty,omitzero"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=kubeadmcontrolplanes,shortName=kcp,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
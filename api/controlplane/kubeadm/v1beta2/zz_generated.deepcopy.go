@@ -27,6 +27,21 @@ import (
 	corev1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotStore) DeepCopyInto(out *EtcdSnapshotStore) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotStore.
+func (in *EtcdSnapshotStore) DeepCopy() *EtcdSnapshotStore {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlane) DeepCopyInto(out *KubeadmControlPlane) {
 	*out = *in
@@ -54,6 +69,40 @@ func (in *KubeadmControlPlane) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneCARotationSpec) DeepCopyInto(out *KubeadmControlPlaneCARotationSpec) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneCARotationSpec.
+func (in *KubeadmControlPlaneCARotationSpec) DeepCopy() *KubeadmControlPlaneCARotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneCARotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneCARotationStatus) DeepCopyInto(out *KubeadmControlPlaneCARotationStatus) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	in.PhaseStartTime.DeepCopyInto(&out.PhaseStartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneCARotationStatus.
+func (in *KubeadmControlPlaneCARotationStatus) DeepCopy() *KubeadmControlPlaneCARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneCARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlaneDeprecatedStatus) DeepCopyInto(out *KubeadmControlPlaneDeprecatedStatus) {
 	*out = *in
@@ -74,6 +123,22 @@ func (in *KubeadmControlPlaneDeprecatedStatus) DeepCopy() *KubeadmControlPlaneDe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmControlPlaneHibernationSpec) DeepCopyInto(out *KubeadmControlPlaneHibernationSpec) {
+	*out = *in
+	out.EtcdSnapshot = in.EtcdSnapshot
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneHibernationSpec.
+func (in *KubeadmControlPlaneHibernationSpec) DeepCopy() *KubeadmControlPlaneHibernationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmControlPlaneHibernationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlaneInitializationStatus) DeepCopyInto(out *KubeadmControlPlaneInitializationStatus) {
 	*out = *in
@@ -188,6 +253,7 @@ func (in *KubeadmControlPlaneMachineTemplateSpec) DeepCopyInto(out *KubeadmContr
 		*out = make([]corev1beta2.MachineTaint, len(*in))
 		copy(*out, *in)
 	}
+	out.SpreadConstraints = in.SpreadConstraints
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneMachineTemplateSpec.
@@ -312,6 +378,8 @@ func (in *KubeadmControlPlaneSpec) DeepCopyInto(out *KubeadmControlPlaneSpec) {
 	in.Rollout.DeepCopyInto(&out.Rollout)
 	in.Remediation.DeepCopyInto(&out.Remediation)
 	out.MachineNaming = in.MachineNaming
+	in.CARotation.DeepCopyInto(&out.CARotation)
+	out.Hibernation = in.Hibernation
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneSpec.
@@ -361,6 +429,7 @@ func (in *KubeadmControlPlaneStatus) DeepCopyInto(out *KubeadmControlPlaneStatus
 		copy(*out, *in)
 	}
 	in.LastRemediation.DeepCopyInto(&out.LastRemediation)
+	in.CARotation.DeepCopyInto(&out.CARotation)
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(KubeadmControlPlaneDeprecatedStatus)
@@ -890,6 +890,10 @@ func Convert_v1beta2_WorkersStatus_To_v1beta1_WorkersStatus(in *clusterv1.Worker
 	return autoConvert_v1beta2_WorkersStatus_To_v1beta1_WorkersStatus(in, out, s)
 }
 
+func Convert_v1beta2_WorkersTopology_To_v1beta1_WorkersTopology(in *clusterv1.WorkersTopology, out *WorkersTopology, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_WorkersTopology_To_v1beta1_WorkersTopology(in, out, s)
+}
+
 func Convert_v1beta2_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(in *clusterv1.MachineDeploymentStatus, out *MachineDeploymentStatus, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta2_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(in, out, s); err != nil {
 		return err
@@ -1315,6 +1319,15 @@ func Convert_v1beta2_MachineSpec_To_v1beta1_MachineSpec(in *clusterv1.MachineSpe
 	return nil
 }
 
+func Convert_v1beta2_MachineDrainRuleSpec_To_v1beta1_MachineDrainRuleSpec(in *clusterv1.MachineDrainRuleSpec, out *MachineDrainRuleSpec, s apimachineryconversion.Scope) error {
+	// NOTE: v1beta1 MachineDrainRuleSpec does not have Precedence, it is restored by the conversion webhook.
+	return autoConvert_v1beta2_MachineDrainRuleSpec_To_v1beta1_MachineDrainRuleSpec(in, out, s)
+}
+
+func Convert_v1beta2_MachinePoolSpec_To_v1beta1_MachinePoolSpec(in *clusterv1.MachinePoolSpec, out *MachinePoolSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_MachinePoolSpec_To_v1beta1_MachinePoolSpec(in, out, s)
+}
+
 func Convert_v1beta2_MachinePoolStatus_To_v1beta1_MachinePoolStatus(in *clusterv1.MachinePoolStatus, out *MachinePoolStatus, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta2_MachinePoolStatus_To_v1beta1_MachinePoolStatus(in, out, s); err != nil {
 		return err
@@ -1792,6 +1805,11 @@ func Convert_v1beta2_JSONSchemaProps_To_v1beta1_JSONSchemaProps(in *clusterv1.JS
 	return nil
 }
 
+func Convert_v1beta2_ValidationRule_To_v1beta1_ValidationRule(in *clusterv1.ValidationRule, out *ValidationRule, s apimachineryconversion.Scope) error {
+	// NOTE: optionalOldSelf does not exist in v1beta1, it is restored by the conversion webhook.
+	return autoConvert_v1beta2_ValidationRule_To_v1beta1_ValidationRule(in, out, s)
+}
+
 func Convert_v1beta1_MachineDeletionStatus_To_v1beta2_MachineDeletionStatus(in *MachineDeletionStatus, out *clusterv1.MachineDeletionStatus, _ apimachineryconversion.Scope) error {
 	if in.NodeDrainStartTime != nil && !reflect.DeepEqual(in.NodeDrainStartTime, &metav1.Time{}) {
 		out.NodeDrainStartTime = *in.NodeDrainStartTime
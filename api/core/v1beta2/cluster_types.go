@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -65,6 +66,10 @@ const (
 
 	// ClusterAvailableInternalErrorReason surfaces unexpected error when computing the Available condition.
 	ClusterAvailableInternalErrorReason = InternalErrorReason
+
+	// ClusterAvailabilityRollupInternalErrorReason surfaces unexpected failures when computing a condition
+	// rolled up from provider objects, e.g. when listing the objects or reading their conditions.
+	ClusterAvailabilityRollupInternalErrorReason = InternalErrorReason
 )
 
 // Cluster's TopologyReconciled condition and corresponding reasons.
@@ -133,11 +138,22 @@ const (
 	// ClusterTopologyReconciledClusterUpgradingReason documents reconciliation of a Cluster topology
 	// not yet completed because a cluster upgrade is still in progress.
 	ClusterTopologyReconciledClusterUpgradingReason = "ClusterUpgrading"
+
+	// ClusterTopologyReconciledExternalControlPlaneUpgradePendingReason documents reconciliation of a Cluster topology
+	// not yet completed because an externally managed Control Plane is not yet upgraded out-of-band to the desired version.
+	ClusterTopologyReconciledExternalControlPlaneUpgradePendingReason = "ExternalControlPlaneUpgradePending"
+
 	// ClusterTopologyReconciledClusterClassNotReconciledReason documents reconciliation of a Cluster topology not
 	// yet completed because the ClusterClass has not reconciled yet. If this condition persists there may be an issue
 	// with the ClusterClass surfaced in the ClusterClass status or controller logs.
 	ClusterTopologyReconciledClusterClassNotReconciledReason = "ClusterClassNotReconciled"
 
+	// ClusterTopologyReconciledClusterClassNotCompatibleReason documents reconciliation of a Cluster topology not
+	// performed because the objects computed from the ClusterClass are not compatible with the current objects
+	// of the Cluster topology, e.g. after changing the ClusterClass referenced by the Cluster to an incompatible one.
+	// The condition message lists all the incompatible changes; no object is changed until they are solved.
+	ClusterTopologyReconciledClusterClassNotCompatibleReason = "ClusterClassNotCompatible"
+
 	// ClusterTopologyReconciledDeletingReason surfaces when the Cluster is deleting because the
 	// DeletionTimestamp is set.
 	ClusterTopologyReconciledDeletingReason = DeletingReason
@@ -146,6 +162,23 @@ const (
 	ClusterTopologyReconcilePausedReason = PausedReason
 )
 
+// Cluster's TopologyFieldsCoOwned condition and corresponding reasons.
+const (
+	// ClusterTopologyFieldsCoOwnedCondition is true if fields managed by the topology controller on the objects of
+	// a managed topology are also owned by other field managers, e.g. because a user edited them with kubectl.
+	// Note: The topology controller can't remove co-owned fields when they are dropped from the topology.
+	ClusterTopologyFieldsCoOwnedCondition = "TopologyFieldsCoOwned"
+
+	// ClusterTopologyFieldsCoOwnedReason surfaces when fields managed by the topology controller are also owned by other field managers.
+	ClusterTopologyFieldsCoOwnedReason = "FieldsCoOwned"
+
+	// ClusterTopologyFieldsNotCoOwnedReason surfaces when fields managed by the topology controller are not owned by other field managers.
+	ClusterTopologyFieldsNotCoOwnedReason = "FieldsNotCoOwned"
+
+	// ClusterTopologyFieldsCoOwnedInternalErrorReason surfaces unexpected failures when detecting co-owned fields.
+	ClusterTopologyFieldsCoOwnedInternalErrorReason = InternalErrorReason
+)
+
 // Cluster's InfrastructureReady condition and corresponding reasons.
 const (
 	// ClusterInfrastructureReadyCondition mirrors Cluster's infrastructure Ready condition.
@@ -613,6 +646,61 @@ type ClusterClassRef struct {
 	// +kubebuilder:validation:MaxLength=63
 	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
 	Namespace string `json:"namespace,omitempty"`
+
+	// channel is the release channel followed by the Cluster, e.g. stable or fast.
+	// When set, the Cluster is progressively updated to the newest release published to the channel,
+	// i.e. name is set to the name of the ClusterClass with the highest spec.release.version among the
+	// ClusterClasses with the same spec.release.family as the ClusterClass currently referenced by name
+	// and with the channel in spec.release.channels.
+	// channel cannot be set together with oci.
+	// This field can only be used if the ClusterClassChannels feature gate is enabled.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Channel string `json:"channel,omitempty"`
+
+	// oci configures the ClusterClass to be pulled from a versioned bundle stored in an OCI registry.
+	// When set, the ClusterClass and the templates it references are pulled from the OCI artifact,
+	// the cosign signature of the artifact is verified, and the objects are created in the namespace
+	// of the Cluster; namespace must be empty or equal to the namespace of the Cluster.
+	// The OCI artifact must contain exactly one ClusterClass with the name defined in name.
+	// This field can only be used if the ClusterClassOCISource feature gate is enabled.
+	// +optional
+	OCI ClusterClassOCISource `json:"oci,omitempty,omitzero"`
+}
+
+// ClusterClassOCISource defines the OCI artifact containing a ClusterClass and the templates it references.
+// +kubebuilder:validation:MinProperties=1
+type ClusterClassOCISource struct {
+	// url is the URL of the OCI artifact, in the form oci://<registry>/<repository>:<tag>
+	// or oci://<registry>/<repository>@sha256:<digest>.
+	// If a tag is used, the artifact is pulled again when the tag points to a different digest.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:Pattern=`^oci://`
+	URL string `json:"url,omitempty"`
+
+	// publicKeySecretName is the name of a Secret in the namespace of the Cluster with the public key
+	// used to verify the cosign signature of the OCI artifact under the cosign.pub key.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	PublicKeySecretName string `json:"publicKeySecretName,omitempty"`
+
+	// credentialsSecretName is the name of a Secret in the namespace of the Cluster with the username
+	// and password keys used to authenticate to the OCI registry.
+	// If not set, the OCI artifact is pulled anonymously.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// IsDefined returns true if the ClusterClassOCISource is defined.
+func (r *ClusterClassOCISource) IsDefined() bool {
+	return !reflect.DeepEqual(r, &ClusterClassOCISource{})
 }
 
 // ControlPlaneTopology specifies the parameters for the control plane nodes in the cluster.
@@ -681,6 +769,23 @@ type ControlPlaneTopology struct {
 	// variables can be used to customize the ControlPlane through patches.
 	// +optional
 	Variables ControlPlaneVariables `json:"variables,omitempty,omitzero"`
+
+	// externallyManaged specifies if the ControlPlane referenced by spec.controlPlaneRef is managed out-of-band,
+	// e.g. a hosted control plane managed by a service outside of Cluster API.
+	//
+	// If true, spec.controlPlaneRef must be set, and the topology controller does not create or update the ControlPlane,
+	// its InfrastructureMachineTemplate and its MachineHealthCheck; the topology controller only manages the InfrastructureCluster
+	// and the workers. The version of the ControlPlane is read-only: the ControlPlane is expected to be upgraded out-of-band
+	// to spec.topology.version, and workers are upgraded only after the ControlPlane reached the new version.
+	//
+	// This field cannot be changed after the Cluster has been created.
+	// +optional
+	ExternallyManaged *bool `json:"externallyManaged,omitempty"`
+}
+
+// IsExternallyManaged returns true if the ControlPlane is managed out-of-band.
+func (t *ControlPlaneTopology) IsExternallyManaged() bool {
+	return t.ExternallyManaged != nil && *t.ExternallyManaged
 }
 
 // ControlPlaneTopologyRolloutSpec defines the rollout behavior.
@@ -695,6 +800,21 @@ type ControlPlaneTopologyRolloutSpec struct {
 	After metav1.Time `json:"after,omitempty,omitzero"`
 }
 
+// HealthCheckOverrideStrategy defines how a health check defined in a Cluster topology is combined with
+// the health check defined in ClusterClass.
+// +kubebuilder:validation:Enum=Replace;Merge
+type HealthCheckOverrideStrategy string
+
+const (
+	// ReplaceHealthCheckOverrideStrategy replaces the health check defined in ClusterClass
+	// with the health check defined in the Cluster topology.
+	ReplaceHealthCheckOverrideStrategy HealthCheckOverrideStrategy = "Replace"
+
+	// MergeHealthCheckOverrideStrategy merges the health check defined in the Cluster topology
+	// into the health check defined in ClusterClass.
+	MergeHealthCheckOverrideStrategy HealthCheckOverrideStrategy = "Merge"
+)
+
 // ControlPlaneTopologyHealthCheck defines a MachineHealthCheck for control plane machines.
 // +kubebuilder:validation:MinProperties=1
 type ControlPlaneTopologyHealthCheck struct {
@@ -710,6 +830,19 @@ type ControlPlaneTopologyHealthCheck struct {
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
 
+	// overrideStrategy defines how checks and remediation defined in the Cluster topology are combined with
+	// the corresponding fields in ClusterClass.
+	//
+	// If Replace (default): checks and remediation from Cluster are used instead of the
+	// corresponding fields in ClusterClass.
+	//
+	// If Merge: fields set in checks and remediation from Cluster override the corresponding fields in
+	// ClusterClass, while fields which are not set are inherited from ClusterClass. Entries of unhealthyNodeConditions
+	// and unhealthyMachineConditions override the entries of the ClusterClass with the same type and status,
+	// while other entries are added; triggerIf is overridden as a whole if any of its fields is set.
+	// +optional
+	OverrideStrategy HealthCheckOverrideStrategy `json:"overrideStrategy,omitempty"`
+
 	// checks are the checks that are used to evaluate if a Machine is healthy.
 	//
 	// If one of checks and remediation fields are set, the system assumes that an healthCheck override is defined,
@@ -872,6 +1005,25 @@ type WorkersTopology struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=2000
 	MachinePools []MachinePoolTopology `json:"machinePools,omitempty"`
+
+	// upgrade allows to tune the upgrade of MachineDeployments and MachinePools in the cluster.
+	// +optional
+	Upgrade WorkersTopologyUpgrade `json:"upgrade,omitempty,omitzero"`
+}
+
+// WorkersTopologyUpgrade allows to tune the upgrade of MachineDeployments and MachinePools in the cluster.
+// +kubebuilder:validation:MinProperties=1
+type WorkersTopologyUpgrade struct {
+	// maxConcurrency is the maximum number of MachineDeployments and the maximum number of MachinePools
+	// that can be upgraded concurrently; the limit applies separately to MachineDeployments and MachinePools.
+	// MachineDeployments and MachinePools waiting for other upgrades to complete are reported in the
+	// TopologyReconciled condition.
+	// If not set, the value of the topology.cluster.x-k8s.io/upgrade-concurrency annotation is used,
+	// or 1 if the annotation is not set either.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2000
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
 }
 
 // MachineDeploymentTopology specifies the different parameters for a set of worker nodes in the topology.
@@ -914,6 +1066,13 @@ type MachineDeploymentTopology struct {
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// autoscaling configures the cluster autoscaler node group for this MachineDeployment.
+	// If set, the min and max size and the capacity of the node group are reconciled into the
+	// corresponding cluster autoscaler annotations of the MachineDeployment, and replicas must not be set
+	// because they are managed by the cluster autoscaler.
+	// +optional
+	Autoscaling NodeGroupAutoscaling `json:"autoscaling,omitempty,omitzero"`
+
 	// healthCheck allows to enable, disable and override MachineDeployment health check
 	// configuration from the ClusterClass for this MachineDeployment.
 	// +optional
@@ -967,11 +1126,63 @@ type MachineDeploymentTopology struct {
 	// +optional
 	Rollout MachineDeploymentTopologyRolloutSpec `json:"rollout,omitempty,omitzero"`
 
+	// upgradeGroup is the group of the MachineDeployment in the upgrade sequence of the Cluster's MachineDeployments.
+	// When the Kubernetes version of the Cluster is upgraded, MachineDeployments are upgraded in ascending order of
+	// upgradeGroup; the MachineDeployments of a group are upgraded only after all the MachineDeployments of the
+	// lower groups have been upgraded and are available.
+	// MachineDeployments without an upgradeGroup belong to group 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	UpgradeGroup *int32 `json:"upgradeGroup,omitempty"`
+
 	// variables can be used to customize the MachineDeployment through patches.
 	// +optional
 	Variables MachineDeploymentVariables `json:"variables,omitempty,omitzero"`
 }
 
+// NodeGroupAutoscaling defines the cluster autoscaler configuration of a node group, i.e. of a
+// MachineDeployment or a MachinePool.
+type NodeGroupAutoscaling struct {
+	// minSize is the minimum number of replicas of the node group.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// maxSize is the maximum number of replicas of the node group.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// capacity is the resource capacity of a single replica of the node group.
+	// It is used by the cluster autoscaler to scale the node group from zero; cpu, memory, ephemeral-storage,
+	// pods and GPU extended resources (named <vendor>/gpu, e.g. nvidia.com/gpu) are supported.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+}
+
+// NodeGroupAutoscalingStatus defines the observed state of the cluster autoscaler node group of a
+// MachineDeployment or a MachinePool.
+type NodeGroupAutoscalingStatus struct {
+	// minSize is the minimum number of replicas of the node group, as defined by the cluster autoscaler
+	// min size annotation.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// maxSize is the maximum number of replicas of the node group, as defined by the cluster autoscaler
+	// max size annotation.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// currentSize is the current number of replicas of the node group, i.e. the number of replicas
+	// set by the cluster autoscaler.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	CurrentSize *int32 `json:"currentSize,omitempty"`
+}
+
 // MachineDeploymentTopologyHealthCheck defines a MachineHealthCheck for MachineDeployment machines.
 // +kubebuilder:validation:MinProperties=1
 type MachineDeploymentTopologyHealthCheck struct {
@@ -987,6 +1198,19 @@ type MachineDeploymentTopologyHealthCheck struct {
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
 
+	// overrideStrategy defines how checks and remediation defined in the Cluster topology are combined with
+	// the corresponding fields in ClusterClass.
+	//
+	// If Replace (default): checks and remediation from Cluster are used instead of the
+	// corresponding fields in ClusterClass.
+	//
+	// If Merge: fields set in checks and remediation from Cluster override the corresponding fields in
+	// ClusterClass, while fields which are not set are inherited from ClusterClass. Entries of unhealthyNodeConditions
+	// and unhealthyMachineConditions override the entries of the ClusterClass with the same type and status,
+	// while other entries are added; triggerIf is overridden as a whole if any of its fields is set.
+	// +optional
+	OverrideStrategy HealthCheckOverrideStrategy `json:"overrideStrategy,omitempty"`
+
 	// checks are the checks that are used to evaluate if a Machine is healthy.
 	//
 	// If one of checks and remediation fields are set, the system assumes that an healthCheck override is defined,
@@ -1129,7 +1353,7 @@ type MachineDeploymentTopologyHealthCheckRemediationTriggerIf struct {
 // +kubebuilder:validation:MinProperties=1
 type MachineDeploymentTopologyMachineDeletionSpec struct {
 	// order defines the order in which Machines are deleted when downscaling.
-	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest"
+	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest", "LeastUtilized"
 	// +optional
 	Order MachineSetDeletionOrder `json:"order,omitempty"`
 
@@ -1293,6 +1517,13 @@ type MachinePoolTopology struct {
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// autoscaling configures the cluster autoscaler node group for this MachinePool.
+	// If set, the min and max size and the capacity of the node group are reconciled into the
+	// corresponding cluster autoscaler annotations of the MachinePool, and replicas must not be set
+	// because they are managed by the cluster autoscaler.
+	// +optional
+	Autoscaling NodeGroupAutoscaling `json:"autoscaling,omitempty,omitzero"`
+
 	// variables can be used to customize the MachinePool through patches.
 	// +optional
 	Variables MachinePoolVariables `json:"variables,omitempty,omitzero"`
@@ -151,6 +151,13 @@ type ClusterClassSpec struct {
 	// +kubebuilder:validation:items:MinLength=1
 	// +kubebuilder:validation:items:MaxLength=256
 	KubernetesVersions []string `json:"kubernetesVersions,omitempty"`
+
+	// release defines the release of the ClusterClass and the channels the release is published to.
+	// Clusters following one of the channels via spec.topology.classRef.channel are progressively
+	// updated to the newest release of the same family published to the channel.
+	// This field can only be used if the ClusterClassChannels feature gate is enabled.
+	// +optional
+	Release ClusterClassRelease `json:"release,omitempty,omitzero"`
 }
 
 // InfrastructureClass defines the class for the infrastructure cluster.
@@ -662,7 +669,7 @@ type MachineDeploymentClassHealthCheckRemediationTriggerIf struct {
 // +kubebuilder:validation:MinProperties=1
 type MachineDeploymentClassMachineDeletionSpec struct {
 	// order defines the order in which Machines are deleted when downscaling.
-	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest"
+	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest", "LeastUtilized"
 	// +optional
 	Order MachineSetDeletionOrder `json:"order,omitempty"`
 
@@ -908,6 +915,38 @@ type ClusterClassVariable struct {
 	// schema defines the schema of the variable.
 	// +required
 	Schema VariableSchema `json:"schema,omitempty,omitzero"`
+
+	// migration defines how the value of this variable is migrated when the classRef of a Cluster
+	// is changed to this ClusterClass, e.g. when a Cluster is moved to a new version of a ClusterClass
+	// in which the variable has been renamed, its default value has changed or its type has been widened.
+	// +optional
+	Migration ClusterClassVariableMigration `json:"migration,omitempty,omitzero"`
+}
+
+// ClusterClassVariableMigration defines how the value of a variable is migrated when the classRef of a Cluster
+// is changed to the ClusterClass defining the variable.
+// Migrations are applied to Cluster.spec.topology.variables and to all variable overrides.
+// +kubebuilder:validation:MinProperties=1
+type ClusterClassVariableMigration struct {
+	// renamedFrom is the name of the variable in the ClusterClass previously used by the Cluster.
+	// If the Cluster sets a value for renamedFrom, the value is moved to this variable.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	RenamedFrom string `json:"renamedFrom,omitempty"`
+
+	// previousDefault is the default value of the variable in the ClusterClass previously used by the Cluster.
+	// If the Cluster sets the variable to previousDefault, the value is dropped, so the default value
+	// defined in this ClusterClass is applied instead.
+	// +optional
+	PreviousDefault *apiextensionsv1.JSON `json:"previousDefault,omitempty"`
+
+	// previousType is the type of the variable in the ClusterClass previously used by the Cluster.
+	// Supported type widenings are from integer to number, and from a scalar type to an array
+	// with items of the same type; in the latter case the value is wrapped into an array.
+	// +optional
+	// +kubebuilder:validation:Enum=string;integer;number;boolean
+	PreviousType string `json:"previousType,omitempty"`
 }
 
 // ClusterClassVariableMetadata is the metadata of a variable.
@@ -1219,7 +1258,8 @@ type ValidationRule struct {
 	// By default, the `oldSelf` variable is the same type as `self`.
 	//
 	// Transition rules by default are applied only on UPDATE requests and are
-	// skipped if an old value could not be found.
+	// skipped if an old value could not be found. You can opt a transition
+	// rule into unconditional evaluation by setting `optionalOldSelf` to true.
 	//
 	// +required
 	// +kubebuilder:validation:MinLength=1
@@ -1268,6 +1308,21 @@ type ValidationRule struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=512
 	FieldPath string `json:"fieldPath,omitempty"`
+	// optionalOldSelf is used to opt a transition rule into evaluation
+	// even when the variable value is first set, or if the old value is
+	// missing the value.
+	//
+	// When enabled `oldSelf` will be a CEL optional whose value will be
+	// `None` if there is no old value, or when the variable value is initially set.
+	//
+	// You may check for presence of oldSelf using `oldSelf.hasValue()` and
+	// unwrap it after checking using `oldSelf.value()`. Check the CEL
+	// documentation for Optional types for more information:
+	// https://pkg.go.dev/github.com/google/cel-go/cel#OptionalTypes
+	//
+	// May not be set unless `oldSelf` is used in `rule`.
+	// +optional
+	OptionalOldSelf *bool `json:"optionalOldSelf,omitempty"`
 }
 
 // FieldValueErrorReason is a machine-readable value providing more detail about why a field failed the validation.
@@ -1345,6 +1400,56 @@ type ClusterClassUpgradeExternal struct {
 	GenerateUpgradePlanExtension string `json:"generateUpgradePlanExtension,omitempty"`
 }
 
+// ClusterClassRelease defines the release of a ClusterClass.
+type ClusterClassRelease struct {
+	// family is the name shared by all the releases of a ClusterClass, e.g. quick-start.
+	// Clusters following a channel are only updated to releases of the same family in the same namespace.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Family string `json:"family,omitempty"`
+
+	// version is the version of the release, e.g. v1.2.0.
+	// version must be a valid semantic version; releases of the same family are ordered by version.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Version string `json:"version,omitempty"`
+
+	// channels is the list of channels the release is published to, e.g. stable or fast.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Channels []string `json:"channels,omitempty"`
+
+	// rollout defines how Clusters following a channel are updated to the release.
+	// +optional
+	Rollout ClusterClassReleaseRollout `json:"rollout,omitempty,omitzero"`
+}
+
+// ClusterClassReleaseRollout defines how Clusters following a channel are updated to a ClusterClass release.
+// +kubebuilder:validation:MinProperties=1
+type ClusterClassReleaseRollout struct {
+	// maxConcurrency is the maximum number of Clusters being updated to the release at the same time.
+	// A Cluster is considered as being updated until its topology is reconciled, it is available,
+	// it is not rolling out and soakTimeSeconds have passed.
+	// If not set, Clusters are updated one at a time.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+
+	// soakTimeSeconds is the time a Cluster updated to the release must be stable before it is no
+	// longer considered as being updated.
+	// If not set, a Cluster is no longer considered as being updated as soon as it is stable.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SoakTimeSeconds *int32 `json:"soakTimeSeconds,omitempty"`
+}
+
 // PatchDefinition defines a patch which is applied to customize the referenced templates.
 type PatchDefinition struct {
 	// selector defines on which templates the patch should be applied.
@@ -1625,6 +1730,14 @@ type ClusterClassStatus struct {
 	// +kubebuilder:validation:MaxItems=1000
 	Variables []ClusterClassStatusVariable `json:"variables,omitempty"`
 
+	// discoveredVariables lists the variables discovered from Runtime Extensions via the DiscoverVariables hook
+	// for each external patch of the ClusterClass.
+	// +optional
+	// +listType=map
+	// +listMapKey=patchName
+	// +kubebuilder:validation:MaxItems=100
+	DiscoveredVariables []ClusterClassStatusDiscoveredVariables `json:"discoveredVariables,omitempty"`
+
 	// observedGeneration is the latest generation observed by the controller.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
@@ -1654,6 +1767,29 @@ type ClusterClassV1Beta1DeprecatedStatus struct {
 	Conditions Conditions `json:"conditions,omitempty"`
 }
 
+// ClusterClassStatusDiscoveredVariables defines the variables discovered from a Runtime Extension for an external patch.
+type ClusterClassStatusDiscoveredVariables struct {
+	// patchName is the name of the external patch the variables have been discovered for.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	PatchName string `json:"patchName,omitempty"`
+
+	// extension is the name of the DiscoverVariables extension handler which returned the variables.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	Extension string `json:"extension,omitempty"`
+
+	// variables is the list of the names of the variables returned by the extension.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=1000
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	Variables []string `json:"variables,omitempty"`
+}
+
 // ClusterClassStatusVariable defines a variable which appears in the status of a ClusterClass.
 type ClusterClassStatusVariable struct {
 	// name is the name of the variable.
@@ -64,6 +64,20 @@ const (
 	// a classy Cluster to define the maximum concurrency while upgrading MachineDeployments.
 	ClusterTopologyUpgradeConcurrencyAnnotation = "topology.cluster.x-k8s.io/upgrade-concurrency"
 
+	// ClusterTopologyOrphanedSinceAnnotation is the annotation set by the topology controller on templates owned by
+	// a Cluster topology which are not referenced anymore, to track since when the template is orphaned (in RFC3339 format).
+	// Orphaned templates are deleted once the orphaned template grace period has elapsed.
+	ClusterTopologyOrphanedSinceAnnotation = "topology.cluster.x-k8s.io/orphaned-since"
+
+	// ClusterClassOCISourceAnnotation is the annotation set on the ClusterClass and on the templates pulled
+	// from an OCI artifact, to track the URL and the digest of the artifact in the form <url>@<digest>.
+	ClusterClassOCISourceAnnotation = "topology.cluster.x-k8s.io/oci-source"
+
+	// ClusterClassChannelUpdatedAtAnnotation is the annotation set on a Cluster by the ClusterClassChannels controller
+	// when the Cluster is updated to a new ClusterClass release of the channel it follows, to track when the update
+	// happened (in RFC3339 format).
+	ClusterClassChannelUpdatedAtAnnotation = "topology.cluster.x-k8s.io/channel-updated-at"
+
 	// ClusterTopologyMachinePoolNameLabel is the label set on the generated  MachinePool objects
 	// to track the name of the MachinePool topology it represents.
 	ClusterTopologyMachinePoolNameLabel = "topology.cluster.x-k8s.io/pool-name"
@@ -76,6 +90,30 @@ const (
 	// update that disallows updating the .topology.spec.version on certain conditions.
 	ClusterTopologyUnsafeUpdateVersionAnnotation = "unsafe.topology.cluster.x-k8s.io/disable-update-version-check"
 
+	// ClusterCacheClientQPSAnnotation can be set on the Cluster object to override the QPS used by the
+	// ClusterCache for the client, cache and health probe of this workload cluster (e.g. "50" or "12.5").
+	ClusterCacheClientQPSAnnotation = "clustercache.cluster.x-k8s.io/client-qps"
+
+	// ClusterCacheClientBurstAnnotation can be set on the Cluster object to override the burst used by the
+	// ClusterCache for the client, cache and health probe of this workload cluster (e.g. "100").
+	ClusterCacheClientBurstAnnotation = "clustercache.cluster.x-k8s.io/client-burst"
+
+	// ClusterCacheClientTimeoutAnnotation can be set on the Cluster object to override the timeout used by the
+	// ClusterCache for the client of this workload cluster, in Go duration format (e.g. "30s").
+	ClusterCacheClientTimeoutAnnotation = "clustercache.cluster.x-k8s.io/client-timeout"
+
+	// ClusterCacheClientProxyURLAnnotation can be set on the Cluster object to route all the connections to this
+	// workload cluster through a HTTP(S) or SOCKS5 proxy, e.g. "socks5://proxy.example.com:1080".
+	// This applies to the client, cache and health probe of the ClusterCache and to the connections tunneled
+	// via port-forward through the API server of the workload cluster, e.g. the etcd connections of KubeadmControlPlane.
+	// If not set, the proxy-url of the cluster in the kubeconfig Secret is used, if any.
+	ClusterCacheClientProxyURLAnnotation = "clustercache.cluster.x-k8s.io/client-proxy-url"
+
+	// ClusterCacheDisableNodeCachingAnnotation can be set to "true" on the Cluster object to disable caching of
+	// Nodes of this workload cluster in the ClusterCache, e.g. for very large clusters where keeping all Nodes
+	// in memory is too costly. Nodes are then always read from the API server and Node watches are not created.
+	ClusterCacheDisableNodeCachingAnnotation = "clustercache.cluster.x-k8s.io/disable-node-caching"
+
 	// ProviderNameLabel is the label set on components in the provider manifest.
 	// This label allows to easily identify all the components belonging to a provider; the clusterctl
 	// tool uses this label for implementing provider's lifecycle operations.
@@ -112,6 +150,27 @@ const (
 	// on the reconciled object.
 	PausedAnnotation = "cluster.x-k8s.io/paused"
 
+	// PausedReasonAnnotation is an annotation that can be set on a Cluster together with spec.paused
+	// to document why the Cluster has been paused. The reason is surfaced in the Paused condition
+	// of the Cluster and of all the objects belonging to the Cluster.
+	PausedReasonAnnotation = "cluster.x-k8s.io/paused-reason"
+
+	// AvailabilityConditionsAnnotation is an annotation that can be set on a CustomResourceDefinition to declare a
+	// comma separated list of condition types, e.g. "Ready,NetworkReady", of the objects of this kind that should be
+	// rolled up into the Available condition of the Cluster the objects belong to.
+	// The objects are linked to a Cluster via the cluster.x-k8s.io/cluster-name label.
+	//
+	// NOTE: This annotation is only considered when the ClusterAvailabilityRollup feature flag is enabled.
+	AvailabilityConditionsAnnotation = "cluster.x-k8s.io/availability-conditions"
+
+	// AvailabilityRollupConditionsAnnotation is an annotation set by the Cluster controller on a Cluster to keep track
+	// of the condition types rolled up from provider objects, so the corresponding conditions can be removed when the
+	// AvailabilityConditionsAnnotation is removed from a CustomResourceDefinition or the ClusterAvailabilityRollup
+	// feature flag is disabled.
+	//
+	// NOTE: This annotation is managed by the Cluster controller and should not be set by users.
+	AvailabilityRollupConditionsAnnotation = "cluster.x-k8s.io/availability-rollup-conditions"
+
 	// DisableMachineCreateAnnotation is an annotation that can be used to signal a MachineSet to stop creating new machines.
 	// It is utilized in the OnDelete rollout strategy to allow the MachineDeployment controller to scale down
 	// older MachineSets when Machines are deleted and add the new replicas to the latest MachineSet.
@@ -123,10 +182,26 @@ const (
 	// with reconciliation of the object only if this label and a configured value is present.
 	WatchLabel = "cluster.x-k8s.io/watch-filter"
 
+	// ShardLabel is a label that can be applied to Clusters to assign them to a shard of the core manager.
+	//
+	// If sharding is enabled, all objects belonging to a Cluster are reconciled only by the replica of the
+	// core manager responsible for the shard of the Cluster. If the label is not set, the shard assignment
+	// controller sets it based on a hash of the namespace or of the namespace and name of the Cluster.
+	ShardLabel = "cluster.x-k8s.io/shard"
+
 	// DeleteMachineAnnotation marks control plane and worker nodes that will be given priority for deletion
 	// when KCP or a machineset scales down. This annotation is given top priority on all delete policies.
 	DeleteMachineAnnotation = "cluster.x-k8s.io/delete-machine"
 
+	// DeleteMachineLastAnnotation marks worker Machines that will be deleted last when a MachineSet scales down,
+	// unless they are already deleting. This annotation takes precedence over all the other criteria defined by delete policies.
+	DeleteMachineLastAnnotation = "cluster.x-k8s.io/delete-machine-last"
+
+	// MachineDeletingMessageAnnotation can be set on a Machine by the controller owning one of its pre-terminate hooks
+	// to surface additional details in the Machine's Deleting condition message while waiting for pre-terminate hooks.
+	// NOTE: KCP uses this annotation to surface where etcd leadership has been forwarded to.
+	MachineDeletingMessageAnnotation = "cluster.x-k8s.io/deleting-message"
+
 	// TemplateClonedFromNameAnnotation is the infrastructure machine annotation that stores the name of the infrastructure template resource
 	// that was cloned for the machine. This annotation is set only during cloning a template. Older/adopted machines will not have this annotation.
 	TemplateClonedFromNameAnnotation = "cluster.x-k8s.io/cloned-from-name"
@@ -141,6 +216,10 @@ const (
 	// RemediateMachineAnnotation request the MachineHealthCheck reconciler to mark a Machine as unhealthy. CAPI builtin remediation will prioritize Machines with the annotation to be remediated.
 	RemediateMachineAnnotation = "cluster.x-k8s.io/remediate-machine"
 
+	// ExternalRemediationFailuresAnnotation is set by the MachineHealthCheck reconciler on Machines to count the failed
+	// external remediation requests for the Machine. The annotation is removed when the Machine is healthy again.
+	ExternalRemediationFailuresAnnotation = "cluster.x-k8s.io/external-remediation-failures"
+
 	// MachineSetSkipPreflightChecksAnnotation is the annotation used to provide a comma-separated list of
 	// preflight checks that should be skipped during the MachineSet reconciliation.
 	// Supported items are:
@@ -204,6 +283,36 @@ const (
 	// Note: It can be used by setting as top level annotation on MachineDeployment and MachineSets.
 	AutoscalerMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
 
+	// AutoscalerCapacityCPUAnnotation defines the CPU capacity of a single replica, used by the autoscaler to scale from zero.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Note: It is set by the MachinePool controller on MachinePools, see MachinePoolStatus.Resources.
+	AutoscalerCapacityCPUAnnotation = "capacity.cluster-autoscaler.kubernetes.io/cpu"
+
+	// AutoscalerCapacityMemoryAnnotation defines the memory capacity of a single replica, used by the autoscaler to scale from zero.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Note: It is set by the MachinePool controller on MachinePools, see MachinePoolStatus.Resources.
+	AutoscalerCapacityMemoryAnnotation = "capacity.cluster-autoscaler.kubernetes.io/memory"
+
+	// AutoscalerCapacityEphemeralDiskAnnotation defines the ephemeral storage capacity of a single replica, used by the autoscaler to scale from zero.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Note: It is set by the MachinePool controller on MachinePools, see MachinePoolStatus.Resources.
+	AutoscalerCapacityEphemeralDiskAnnotation = "capacity.cluster-autoscaler.kubernetes.io/ephemeral-disk"
+
+	// AutoscalerCapacityMaxPodsAnnotation defines the maximum number of Pods of a single replica, used by the autoscaler to scale from zero.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Note: It is set by the MachinePool controller on MachinePools, see MachinePoolStatus.Resources.
+	AutoscalerCapacityMaxPodsAnnotation = "capacity.cluster-autoscaler.kubernetes.io/maxPods"
+
+	// AutoscalerCapacityGPUTypeAnnotation defines the name of the GPU resource of a single replica, used by the autoscaler to scale from zero.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Note: It is set by the MachinePool controller on MachinePools, see MachinePoolStatus.Resources.
+	AutoscalerCapacityGPUTypeAnnotation = "capacity.cluster-autoscaler.kubernetes.io/gpu-type"
+
+	// AutoscalerCapacityGPUCountAnnotation defines the number of GPUs of a single replica, used by the autoscaler to scale from zero.
+	// The annotation definition is copied from kubernetes/autoscaler.
+	// Note: It is set by the MachinePool controller on MachinePools, see MachinePoolStatus.Resources.
+	AutoscalerCapacityGPUCountAnnotation = "capacity.cluster-autoscaler.kubernetes.io/gpu-count"
+
 	// VariableDefinitionFromInline indicates a patch or variable was defined in the `.spec` of a ClusterClass
 	// rather than from an external patch extension.
 	VariableDefinitionFromInline = "inline"
@@ -257,6 +366,12 @@ const (
 	// The preflight check is only run if the Cluster has a managed topology, a ControlPlane is used (controlPlaneRef
 	// must exist in the Cluster), the ControlPlane has a version and the MachineSet has a version.
 	MachineSetPreflightCheckControlPlaneVersionSkew MachineSetPreflightCheck = "ControlPlaneVersionSkew"
+
+	// MachineSetPreflightCheckCanCreateMachine is the name of the preflight check
+	// that calls the CanCreateMachine hook of Runtime Extensions, which can veto the creation of machines
+	// for the MachineSet, e.g. to implement quota checks or cost guardrails.
+	// The preflight check is only run when the MachineSet is scaling up and if the RuntimeSDK feature flag is enabled.
+	MachineSetPreflightCheckCanCreateMachine MachineSetPreflightCheck = "CanCreateMachine"
 )
 
 // NodeOutdatedRevisionTaint can be added to Nodes at rolling updates in general triggered by updating MachineDeployment
@@ -281,6 +396,20 @@ type StatusVersion struct {
 	Replicas int32 `json:"replicas,omitempty"`
 }
 
+// StatusFailureDomain groups failure domain-related status information.
+type StatusFailureDomain struct {
+	// name is the name of the failure domain.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name,omitempty"`
+
+	// replicas is the number of replicas in this failure domain.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
 // StatusUpgradePlanVersion groups upgrade plan version-related status information.
 type StatusUpgradePlanVersion struct {
 	// version is the Kubernetes version.
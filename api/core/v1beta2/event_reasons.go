@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+// Event reasons are stable, machine-readable values for the Reason field of the Kubernetes Events
+// emitted by Cluster API controllers. Consumers can rely on them to filter or alert on Events.
+
+// Machine event reasons.
+const (
+	// MachineSuccessfulSetNodeRefEventReason is emitted when the Machine's nodeRef has been set.
+	MachineSuccessfulSetNodeRefEventReason = "SuccessfulSetNodeRef"
+
+	// MachineFailedGetNodeEventReason is emitted when the Machine's Node could not be retrieved.
+	MachineFailedGetNodeEventReason = "FailedGetNode"
+
+	// MachineSuccessfulSetInterruptibleNodeLabelEventReason is emitted when the interruptible label has been set on the Machine's Node.
+	MachineSuccessfulSetInterruptibleNodeLabelEventReason = "SuccessfulSetInterruptibleNodeLabel"
+
+	// MachineBootstrapConfigSwappedEventReason is emitted when the Machine's bootstrap data secret changed.
+	MachineBootstrapConfigSwappedEventReason = "BootstrapConfigSwapped"
+
+	// MachinePreDrainHookTimeoutEventReason is emitted when pre-drain hooks are ignored after their timeout expired.
+	MachinePreDrainHookTimeoutEventReason = "PreDrainHookTimeout"
+
+	// MachineDrainNodeStartedEventReason is emitted when the drain of the Machine's Node has been started.
+	MachineDrainNodeStartedEventReason = "DrainNodeStarted"
+
+	// MachineFailedDrainNodeEventReason is emitted when the drain of the Machine's Node failed.
+	MachineFailedDrainNodeEventReason = "FailedDrainNode"
+
+	// MachineSuccessfulDrainNodeEventReason is emitted when the Machine's Node has been drained.
+	MachineSuccessfulDrainNodeEventReason = "SuccessfulDrainNode"
+
+	// MachineFailedWaitForVolumeDetachEventReason is emitted when waiting for volumes to detach from the Machine's Node failed.
+	MachineFailedWaitForVolumeDetachEventReason = "FailedWaitForVolumeDetach"
+
+	// MachineNodeVolumesDetachedEventReason is emitted when all volumes have been detached from the Machine's Node.
+	MachineNodeVolumesDetachedEventReason = "NodeVolumesDetached"
+
+	// MachineForceDetachNodeVolumesEventReason is emitted when VolumeAttachments have been deleted after the volume detach timeout expired.
+	MachineForceDetachNodeVolumesEventReason = "ForceDetachNodeVolumes"
+
+	// MachinePreTerminateHookTimeoutEventReason is emitted when pre-terminate hooks are ignored after their timeout expired.
+	MachinePreTerminateHookTimeoutEventReason = "PreTerminateHookTimeout"
+
+	// MachineFailedDeleteNodeEventReason is emitted when the Machine's Node could not be deleted.
+	MachineFailedDeleteNodeEventReason = "FailedDeleteNode"
+)
+
+// Cluster topology event reasons.
+const (
+	// TopologyCreateEventReason is emitted when an object of a managed topology has been created.
+	TopologyCreateEventReason = "TopologyCreate"
+
+	// TopologyUpdateEventReason is emitted when an object of a managed topology has been updated.
+	TopologyUpdateEventReason = "TopologyUpdate"
+
+	// TopologyDeleteEventReason is emitted when an object of a managed topology has been deleted.
+	TopologyDeleteEventReason = "TopologyDelete"
+)
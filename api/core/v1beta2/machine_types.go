@@ -36,6 +36,13 @@ const (
 	// ExcludeWaitForNodeVolumeDetachAnnotation annotation explicitly skips the waiting for node volume detaching if set.
 	ExcludeWaitForNodeVolumeDetachAnnotation = "machine.cluster.x-k8s.io/exclude-wait-for-node-volume-detach"
 
+	// BootstrapConfigSwapSupportedAnnotation can be set by infrastructure providers on InfrastructureMachines
+	// which support re-bootstrapping the machine with new bootstrap data, e.g. by rebooting into a new ignition config.
+	// When the bootstrap config of a Machine is changed and the InfrastructureMachine has this annotation, the Machine
+	// controller sets spec.bootstrap.dataSecretName to the data secret of the new bootstrap config.
+	// Note: Changing the bootstrap config of existing Machines requires the MachineBootstrapConfigSwap feature gate.
+	BootstrapConfigSwapSupportedAnnotation = "machine.cluster.x-k8s.io/bootstrap-config-swap-supported"
+
 	// MachineSetNameLabel is the label set on machines if they're controlled by MachineSet.
 	// Note: The value of this label may be a hash if the MachineSet name is longer than 63 characters.
 	MachineSetNameLabel = "cluster.x-k8s.io/set-name"
@@ -47,6 +54,17 @@ const (
 	// Note: The value of this label may be a hash if the MachinePool name is longer than 63 characters.
 	MachinePoolNameLabel = "cluster.x-k8s.io/pool-name"
 
+	// MachinePoolSynthesizedMachineAnnotation is set by the MachinePool controller on Machines it creates for the replicas
+	// of a MachinePool whose infrastructure provider does not implement MachinePool Machines.
+	// The infrastructureRef of those Machines points to the InfraMachinePool, which is never deleted by the Machine controller;
+	// instead, when deleting those Machines, the Machine controller scales down the MachinePool and removes the providerID
+	// of the Machine from the InfraMachinePool.
+	MachinePoolSynthesizedMachineAnnotation = "cluster.x-k8s.io/machine-pool-synthesized"
+
+	// MachinePoolScaledDownAnnotation is set by the Machine controller on a deleting Machine synthesized by the MachinePool
+	// controller once the MachinePool has been scaled down, so the MachinePool is scaled down only once for each deleted Machine.
+	MachinePoolScaledDownAnnotation = "cluster.x-k8s.io/machine-pool-scaled-down"
+
 	// MachineControlPlaneNameLabel is the label set on machines if they're controlled by a ControlPlane.
 	// Note: The value of this label may be a hash if the control plane name is longer than 63 characters.
 	MachineControlPlaneNameLabel = "cluster.x-k8s.io/control-plane-name"
@@ -72,6 +90,25 @@ const (
 	//   starts failing after the etcd member is removed. We need the ControlPlaneKubeletLocalMode feature with 1.31+ to adhere to the kubelet skew policy.
 	PreTerminateDeleteHookAnnotationPrefix = "pre-terminate.delete.hook.machine.cluster.x-k8s.io"
 
+	// PreDrainDeleteHookTimeoutAnnotation can be set on a Machine to define how long the Machine controller waits for
+	// pre-drain.delete hooks to be removed, e.g. "30m". When the timeout expires, the Machine controller acts according to
+	// the policy defined by the DeleteHookTimeoutPolicyAnnotation.
+	// Note: The timeout is computed from status.deletion.waitForPreDrainHookStartTime.
+	PreDrainDeleteHookTimeoutAnnotation = "machine.cluster.x-k8s.io/pre-drain-delete-hook-timeout"
+
+	// PreTerminateDeleteHookTimeoutAnnotation can be set on a Machine to define how long the Machine controller waits for
+	// pre-terminate.delete hooks to be removed, e.g. "30m". When the timeout expires, the Machine controller acts according to
+	// the policy defined by the DeleteHookTimeoutPolicyAnnotation.
+	// Note: The timeout is computed from status.deletion.waitForPreTerminateHookStartTime.
+	// Note: The annotation cannot be set on control plane Machines, because their pre-terminate hooks are never
+	// skipped, e.g. KCP's own pre-terminate hook removing the etcd member of the Machine.
+	PreTerminateDeleteHookTimeoutAnnotation = "machine.cluster.x-k8s.io/pre-terminate-delete-hook-timeout"
+
+	// DeleteHookTimeoutPolicyAnnotation can be set on a Machine to define what the Machine controller does when the
+	// timeout defined by PreDrainDeleteHookTimeoutAnnotation or PreTerminateDeleteHookTimeoutAnnotation expires.
+	// Valid values are Proceed (default) and Wait.
+	DeleteHookTimeoutPolicyAnnotation = "machine.cluster.x-k8s.io/delete-hook-timeout-policy"
+
 	// MachineCertificatesExpiryDateAnnotation annotation specifies the expiry date of the machine certificates in RFC3339 format.
 	// This annotation can be used on control plane machines to trigger rollout before certificates expire.
 	// This annotation can be set on BootstrapConfig or Machine objects. The value set on the Machine object takes precedence.
@@ -100,6 +137,18 @@ const (
 	UpdateInProgressAnnotation = "in-place-updates.internal.cluster.x-k8s.io/update-in-progress"
 )
 
+// DeleteHookTimeoutPolicy defines what the Machine controller does when the timeout of deletion lifecycle hooks expires.
+type DeleteHookTimeoutPolicy string
+
+const (
+	// DeleteHookTimeoutPolicyProceed ignores the remaining hooks and proceeds with Machine deletion.
+	DeleteHookTimeoutPolicyProceed DeleteHookTimeoutPolicy = "Proceed"
+
+	// DeleteHookTimeoutPolicyWait keeps waiting for the remaining hooks and surfaces that the timeout expired
+	// in the Machine's Deleting condition.
+	DeleteHookTimeoutPolicyWait DeleteHookTimeoutPolicy = "Wait"
+)
+
 // Machine's Available condition and corresponding reasons.
 const (
 	// MachineAvailableCondition is true if the machine is Ready for at least MinReadySeconds, as defined by the Machine's MinReadySeconds field.
@@ -313,6 +362,10 @@ const (
 	// defined by a MachineHealthCheck object.
 	MachineHealthCheckUnhealthyMachineReason = "UnhealthyMachine"
 
+	// MachineHealthCheckUnhealthyPodReason surfaces when Pods running on the node hosted on the machine do not pass the
+	// health checks defined by a MachineHealthCheck object.
+	MachineHealthCheckUnhealthyPodReason = "UnhealthyPod"
+
 	// MachineHealthCheckNodeStartupTimeoutReason surfaces when the node hosted on the machine does not appear within
 	// the timeout defined by a MachineHealthCheck object.
 	MachineHealthCheckNodeStartupTimeoutReason = "NodeStartupTimeout"
@@ -341,7 +394,8 @@ const (
 
 // Machine's ExternallyRemediated conditions and corresponding reasons.
 // Note: ExternallyRemediated condition is initially set by the MachineHealthCheck controller; then it is up to the external
-// remediation controller to update or delete this condition.
+// remediation controller to update or delete this condition, or to surface progress via the Remediated condition of the
+// external remediation request, which is then reflected to this condition by the MachineHealthCheck controller.
 const (
 	// MachineExternallyRemediatedCondition is only present if MHC instances targeting this machine
 	// determine that an external controller should perform remediation.
@@ -358,6 +412,23 @@ const (
 	// MachineExternallyRemediatedRemediationRequestCreationFailedReason surfaces that the MachineHealthCheck cannot
 	// create a request for the external remediation controller.
 	MachineExternallyRemediatedRemediationRequestCreationFailedReason = "RemediationRequestCreationFailed"
+
+	// MachineExternallyRemediatedRemediationInProgressReason surfaces that the external remediation controller
+	// is remediating the machine.
+	MachineExternallyRemediatedRemediationInProgressReason = "RemediationInProgress"
+
+	// MachineExternallyRemediatedRemediationSucceededReason surfaces that the external remediation controller
+	// reported that remediation of the machine succeeded.
+	MachineExternallyRemediatedRemediationSucceededReason = "RemediationSucceeded"
+
+	// MachineExternallyRemediatedRemediationFailedReason surfaces that the external remediation controller
+	// reported that remediation of the machine failed; a new external remediation request will be created.
+	MachineExternallyRemediatedRemediationFailedReason = "RemediationFailed"
+
+	// MachineExternallyRemediatedRemediationEscalatedReason surfaces that external remediation of the machine failed
+	// more than the number of times allowed by the MachineHealthCheck, and remediation has been escalated
+	// to the owner of the machine.
+	MachineExternallyRemediatedRemediationEscalatedReason = "RemediationEscalated"
 )
 
 // Machine's Deleting condition and corresponding reasons.
@@ -412,6 +483,22 @@ const (
 	MachineDeletingDeletionCompletedReason = DeletionCompletedReason
 )
 
+// Machine's DeletionBlocked condition and corresponding reasons.
+// Note: The DeletionBlocked condition is only set on deleting Machines, and only if the Machine controller is configured
+// with a threshold for detecting stuck deletions.
+const (
+	// MachineDeletionBlockedCondition is true if the Machine has been deleting for longer than the threshold
+	// configured for the Machine controller. The condition message surfaces what is blocking the deletion,
+	// e.g. the current step of the deletion workflow, deletion hooks and finalizers.
+	MachineDeletionBlockedCondition = "DeletionBlocked"
+
+	// MachineDeletionBlockedReason surfaces when the Machine has been deleting for longer than the configured threshold.
+	MachineDeletionBlockedReason = "DeletionBlocked"
+
+	// MachineDeletionNotBlockedReason surfaces when the Machine has been deleting for less than the configured threshold.
+	MachineDeletionNotBlockedReason = "DeletionNotBlocked"
+)
+
 // MachineSpec defines the desired state of Machine.
 type MachineSpec struct {
 	// clusterName is the name of the Cluster this object belongs to.
@@ -459,6 +546,13 @@ type MachineSpec struct {
 	// +kubebuilder:validation:MaxLength=256
 	FailureDomain string `json:"failureDomain,omitempty"`
 
+	// placementHints are hints for the infrastructure provider on where the Machine should be placed.
+	// placementHints are set by the MachineSet or KubeadmControlPlane controller when creating the Machine,
+	// based on the failure domain and on the spread constraints of the owner; infrastructure providers
+	// can use them to implement soft anti-affinity between the Machines of the same owner.
+	// +optional
+	PlacementHints MachinePlacementHints `json:"placementHints,omitempty,omitzero"`
+
 	// minReadySeconds is the minimum number of seconds for which a Machine should be ready before considering it available.
 	// Defaults to 0 (Machine will be considered available as soon as the Machine is ready)
 	// +optional
@@ -507,6 +601,30 @@ type MachineSpec struct {
 	Taints []MachineTaint `json:"taints,omitempty"`
 }
 
+// MachinePlacementHints are hints for the infrastructure provider on where a Machine should be placed.
+// +kubebuilder:validation:MinProperties=1
+type MachinePlacementHints struct {
+	// zone is the failure domain the Machine should be placed in.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Zone string `json:"zone,omitempty"`
+
+	// hostGroup is the name of the group of hosts the Machine should be placed on,
+	// e.g. a dedicated host group or a placement group.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	HostGroup string `json:"hostGroup,omitempty"`
+
+	// spreadKey identifies the set of Machines which should be spread across hosts.
+	// Infrastructure providers should avoid placing Machines with the same spreadKey on the same host if possible.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	SpreadKey string `json:"spreadKey,omitempty"`
+}
+
 // MachineDeletionSpec contains configuration options for Machine deletion.
 // +kubebuilder:validation:MinProperties=1
 type MachineDeletionSpec struct {
@@ -523,6 +641,16 @@ type MachineDeletionSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	NodeVolumeDetachTimeoutSeconds *int32 `json:"nodeVolumeDetachTimeoutSeconds,omitempty"`
 
+	// nodeVolumeForceDetachTimeoutSeconds is the total amount of time that the controller will wait for volumes
+	// to be detached before force-detaching them. When the timeout expires, the controller deletes the VolumeAttachments
+	// of the volumes still attached to the Node, so that the CSI driver detaches them.
+	// The default value is 0, meaning that volumes are never force-detached.
+	// NOTE: if nodeVolumeDetachTimeoutSeconds is set and expires before this timeout, the controller stops waiting for
+	// volumes to be detached before volumes are force-detached.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	NodeVolumeForceDetachTimeoutSeconds *int32 `json:"nodeVolumeForceDetachTimeoutSeconds,omitempty"`
+
 	// nodeDeletionTimeoutSeconds defines how long the controller will attempt to delete the Node that the Machine
 	// hosts after the Machine is marked for deletion. A duration of 0 will retry deletion indefinitely.
 	// Defaults to 10 seconds.
@@ -580,6 +708,12 @@ type MachineStatus struct {
 	// +optional
 	NodeInfo *corev1.NodeSystemInfo `json:"nodeInfo,omitempty"`
 
+	// nodeCapabilities are the capabilities of the Node, e.g. architecture and GPUs.
+	// This field is computed from the Node and can be used to compute the capabilities of the Machines
+	// of a MachineSet or MachineDeployment, e.g. for autoscaling from zero.
+	// +optional
+	NodeCapabilities MachineNodeCapabilities `json:"nodeCapabilities,omitempty,omitzero"`
+
 	// lastUpdated identifies when the phase of the Machine last transitioned.
 	// +optional
 	LastUpdated metav1.Time `json:"lastUpdated,omitempty,omitzero"`
@@ -605,6 +739,12 @@ type MachineStatus struct {
 	// +optional
 	CertificatesExpiryDate metav1.Time `json:"certificatesExpiryDate,omitempty,omitzero"`
 
+	// diagnostics provides references to boot and console logs of the Machine.
+	// This field is copied from the infrastructure provider reference and can be used
+	// to debug bootstrap failures without accessing the Machine via SSH.
+	// +optional
+	Diagnostics MachineDiagnosticsStatus `json:"diagnostics,omitempty,omitzero"`
+
 	// observedGeneration is the latest generation observed by the controller.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
@@ -639,6 +779,65 @@ func (r *MachineNodeReference) IsDefined() bool {
 	return r.Name != ""
 }
 
+// MachineNodeCapabilities are the capabilities of the Node of a Machine.
+// +kubebuilder:validation:MinProperties=1
+type MachineNodeCapabilities struct {
+	// architecture is the architecture reported by the Node, e.g. amd64 or arm64.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Architecture string `json:"architecture,omitempty"`
+
+	// operatingSystem is the operating system reported by the Node, e.g. linux or windows.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+
+	// osImage is the OS image reported by the Node, e.g. Ubuntu 24.04 LTS.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	OSImage string `json:"osImage,omitempty"`
+
+	// gpuCount is the number of GPUs in the capacity of the Node.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GPUCount *int32 `json:"gpuCount,omitempty"`
+
+	// gpuType is the name of the resource of the GPUs in the capacity of the Node, e.g. nvidia.com/gpu.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	GPUType string `json:"gpuType,omitempty"`
+}
+
+// MachineDiagnosticsStatus provides references to boot and console logs of a Machine.
+// NOTE: Fields in this struct are part of the Cluster API contract and are copied from the InfrastructureMachine.
+// +kubebuilder:validation:MinProperties=1
+type MachineDiagnosticsStatus struct {
+	// logsSecretName is the name of a Secret in the same namespace as the Machine containing
+	// boot or console logs of the Machine; each key of the Secret is the name of a log.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	LogsSecretName string `json:"logsSecretName,omitempty"`
+
+	// logsURL is a link to boot or console logs of the Machine, e.g. a link to the console of the cloud provider.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	LogsURL string `json:"logsURL,omitempty"`
+}
+
+// IsDefined returns true if the MachineDiagnosticsStatus is set.
+func (d *MachineDiagnosticsStatus) IsDefined() bool {
+	if d == nil {
+		return false
+	}
+	return d.LogsSecretName != "" || d.LogsURL != ""
+}
+
 // MachineInitializationStatus provides observations of the Machine initialization process.
 // NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial Machine provisioning.
 // +kubebuilder:validation:MinProperties=1
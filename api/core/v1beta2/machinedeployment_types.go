@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -71,6 +72,12 @@ const (
 	// A random string is appended at the end of the label value (label value format is "<hash>-<random string>"))
 	// to distinguish duplicate MachineSets that have the exact same spec but were created as a result of rolloutAfter.
 	MachineDeploymentUniqueLabel = "machine-template-hash"
+
+	// MachineDeploymentCanaryApprovedRevisionAnnotation is the annotation to be set on a MachineDeployment using
+	// a canary rollout to approve the rollout of a revision, i.e. to resume a rollout paused after creating
+	// the canary Machines. The annotation value must be the revision being rolled out, as reported by the
+	// machinedeployment.clusters.x-k8s.io/revision annotation of the MachineDeployment.
+	MachineDeploymentCanaryApprovedRevisionAnnotation = "machinedeployment.cluster.x-k8s.io/canary-approved-revision"
 )
 
 // MachineDeployment's Available condition and corresponding reasons.
@@ -156,6 +163,10 @@ const (
 	// MachineDeploymentNotRollingOutReason surfaces when all the machines are up-to-date.
 	MachineDeploymentNotRollingOutReason = NotRollingOutReason
 
+	// MachineDeploymentRollingOutWaitingForCanaryApprovalReason surfaces when a rollout using a canary is paused
+	// until the revision being rolled out is approved.
+	MachineDeploymentRollingOutWaitingForCanaryApprovalReason = "WaitingForCanaryApproval"
+
 	// MachineDeploymentRollingOutInternalErrorReason surfaces unexpected failures when listing machines.
 	MachineDeploymentRollingOutInternalErrorReason = InternalErrorReason
 )
@@ -281,6 +292,10 @@ type MachineDeploymentSpec struct {
 	// +optional
 	MachineNaming MachineNamingSpec `json:"machineNaming,omitempty,omitzero"`
 
+	// placement allows spreading Machines across failure domains and hosts.
+	// +optional
+	Placement MachinePlacementSpec `json:"placement,omitempty,omitzero"`
+
 	// remediation controls how unhealthy Machines are remediated.
 	// +optional
 	Remediation MachineDeploymentRemediationSpec `json:"remediation,omitempty,omitzero"`
@@ -309,6 +324,23 @@ type MachineDeploymentRolloutSpec struct {
 	// strategy specifies how to roll out control plane Machines.
 	// +optional
 	Strategy MachineDeploymentRolloutStrategy `json:"strategy,omitempty,omitzero"`
+
+	// canary configures a canary phase for rollouts using the RollingUpdate strategy.
+	// When set, a rollout first creates the configured number of Machines with the new spec and
+	// then pauses until the revision being rolled out is approved by setting the
+	// machinedeployment.cluster.x-k8s.io/canary-approved-revision annotation on the MachineDeployment.
+	// +optional
+	Canary MachineDeploymentRolloutCanary `json:"canary,omitempty,omitzero"`
+}
+
+// MachineDeploymentRolloutCanary configures the canary phase of a rollout.
+// +kubebuilder:validation:MinProperties=1
+type MachineDeploymentRolloutCanary struct {
+	// replicas is the number of Machines with the new spec to be created before pausing the rollout.
+	// If greater than the MachineDeployment's replicas, the MachineDeployment's replicas are used instead.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 // MachineDeploymentRolloutStrategy describes how to replace existing machines
@@ -410,11 +442,67 @@ type MachineNamingSpec struct {
 	Template string `json:"template,omitempty"`
 }
 
+// MachinePlacementSpec allows spreading Machines across failure domains and hosts.
+// +kubebuilder:validation:MinProperties=1
+type MachinePlacementSpec struct {
+	// failureDomains is the list of failure domains Machines are spread across.
+	// When set, new Machines are created in the failure domain with the fewest Machines,
+	// and when scaling down Machines are deleted from the failure domain with the most Machines.
+	// Machines which are not in any of those failure domains are deleted first.
+	// Each failure domain must match the name of a FailureDomain from the Cluster status.
+	// spec.template.spec.failureDomain must not be set when failureDomains is set.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	FailureDomains []string `json:"failureDomains,omitempty"`
+
+	// spreadConstraints are used to compute the placement hints of new Machines,
+	// which allow infrastructure providers to spread Machines across hosts.
+	// +optional
+	SpreadConstraints MachineSpreadConstraints `json:"spreadConstraints,omitempty,omitzero"`
+}
+
+// MachineSpreadConstraints are used to compute the placement hints of Machines.
+// +kubebuilder:validation:MinProperties=1
+type MachineSpreadConstraints struct {
+	// hostGroup is the name of the group of hosts Machines should be placed on,
+	// e.g. a dedicated host group or a placement group.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	HostGroup string `json:"hostGroup,omitempty"`
+
+	// spreadKey identifies the set of Machines which should be spread across hosts.
+	// If not set, the name of the MachineDeployment, of the MachineSet if it does not belong to a
+	// MachineDeployment, or of the control plane is used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	SpreadKey string `json:"spreadKey,omitempty"`
+}
+
+// PlacementHints returns the placement hints for a Machine in the given failure domain.
+// defaultSpreadKey is used if spreadKey is not set.
+func (c MachineSpreadConstraints) PlacementHints(failureDomain, defaultSpreadKey string) MachinePlacementHints {
+	spreadKey := c.SpreadKey
+	if spreadKey == "" {
+		spreadKey = defaultSpreadKey
+	}
+	return MachinePlacementHints{
+		Zone:      failureDomain,
+		HostGroup: c.HostGroup,
+		SpreadKey: spreadKey,
+	}
+}
+
 // MachineDeploymentDeletionSpec contains configuration options for MachineDeployment deletion.
 // +kubebuilder:validation:MinProperties=1
 type MachineDeploymentDeletionSpec struct {
 	// order defines the order in which Machines are deleted when downscaling.
-	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest"
+	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest", "LeastUtilized"
 	// +optional
 	Order MachineSetDeletionOrder `json:"order,omitempty"`
 }
@@ -468,6 +556,36 @@ type MachineDeploymentStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	Versions []StatusVersion `json:"versions,omitempty"`
 
+	// failureDomains is the aggregated number of replicas per failure domain in this MachineDeployment.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	FailureDomains []StatusFailureDomain `json:"failureDomains,omitempty"`
+
+	// rollout surfaces the progress of the rollout of the MachineDeployment.
+	// +optional
+	Rollout MachineDeploymentRolloutStatus `json:"rollout,omitempty,omitzero"`
+
+	// nodeCapabilities are the capabilities of the Nodes of the Machines of this MachineDeployment, e.g. architecture and GPUs.
+	// This field is computed from the most recently created MachineSet with node capabilities and it is preserved when the
+	// MachineDeployment is scaled to zero, so it can be used e.g. for autoscaling from zero.
+	// +optional
+	NodeCapabilities MachineNodeCapabilities `json:"nodeCapabilities,omitempty,omitzero"`
+
+	// capacity is the resource capacity of a single replica of this MachineDeployment, as reported in status.capacity
+	// of the InfraMachineTemplate; it is used to compute the annotations used by the cluster autoscaler to scale
+	// the MachineDeployment from zero. The last known value is preserved if the InfraMachineTemplate does not report it.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// autoscaling surfaces the min, max and current size of the cluster autoscaler node group of this MachineDeployment.
+	// It is set only when both the cluster autoscaler min size and max size annotations are set on the MachineDeployment,
+	// e.g. via spec.topology.workers.machineDeployments[].autoscaling of the Cluster.
+	// +optional
+	Autoscaling *NodeGroupAutoscalingStatus `json:"autoscaling,omitempty"`
+
 	// phase represents the current phase of a MachineDeployment (ScalingUp, ScalingDown, Running, Failed, or Unknown).
 	// +optional
 	// +kubebuilder:validation:Enum=ScalingUp;ScalingDown;Running;Failed;Unknown
@@ -478,6 +596,71 @@ type MachineDeploymentStatus struct {
 	Deprecated *MachineDeploymentDeprecatedStatus `json:"deprecated,omitempty"`
 }
 
+// MachineDeploymentRolloutStatus surfaces the progress of the rollout of a MachineDeployment.
+// +kubebuilder:validation:MinProperties=1
+type MachineDeploymentRolloutStatus struct {
+	// newReplicas is the number of replicas of the new MachineSet, i.e. the MachineSet matching spec.template.
+	// +optional
+	NewReplicas *int32 `json:"newReplicas,omitempty"`
+
+	// oldReplicas is the number of replicas of the old MachineSets, i.e. the MachineSets not matching spec.template.
+	// +optional
+	OldReplicas *int32 `json:"oldReplicas,omitempty"`
+
+	// machineSets surfaces the new MachineSet and the old MachineSets which still have replicas.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	MachineSets []MachineDeploymentRolloutMachineSetStatus `json:"machineSets,omitempty"`
+
+	// estimatedCompletionTime is the time the rollout is estimated to complete, based on the pace at which
+	// Machines of the new MachineSet became available since the rollout started.
+	// It is only set while a rollout is in progress and at least one Machine of the new MachineSet became available.
+	// +optional
+	EstimatedCompletionTime metav1.Time `json:"estimatedCompletionTime,omitempty,omitzero"`
+}
+
+// MachineDeploymentRolloutMachineSetStatus surfaces the state of a MachineSet during the rollout of a MachineDeployment.
+type MachineDeploymentRolloutMachineSetStatus struct {
+	// name is the name of the MachineSet.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// phase is the phase of the MachineSet in the rollout.
+	// +required
+	Phase MachineDeploymentRolloutMachineSetPhase `json:"phase,omitempty"`
+
+	// replicas is the number of replicas of the MachineSet.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// availableReplicas is the number of available replicas of the MachineSet.
+	// +optional
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+}
+
+// MachineDeploymentRolloutMachineSetPhase is the phase of a MachineSet in the rollout of a MachineDeployment.
+// +kubebuilder:validation:Enum=ScalingUp;ScalingDown;Stable
+type MachineDeploymentRolloutMachineSetPhase string
+
+const (
+	// MachineDeploymentRolloutMachineSetPhaseScalingUp is the phase of the new MachineSet when it has
+	// fewer replicas than the desired replicas of the MachineDeployment.
+	MachineDeploymentRolloutMachineSetPhaseScalingUp = MachineDeploymentRolloutMachineSetPhase("ScalingUp")
+
+	// MachineDeploymentRolloutMachineSetPhaseScalingDown is the phase of old MachineSets which still have replicas,
+	// and of the new MachineSet when it has more replicas than the desired replicas of the MachineDeployment.
+	MachineDeploymentRolloutMachineSetPhaseScalingDown = MachineDeploymentRolloutMachineSetPhase("ScalingDown")
+
+	// MachineDeploymentRolloutMachineSetPhaseStable is the phase of the new MachineSet when it has
+	// the desired replicas of the MachineDeployment.
+	MachineDeploymentRolloutMachineSetPhaseStable = MachineDeploymentRolloutMachineSetPhase("Stable")
+)
+
 // MachineDeploymentDeprecatedStatus groups all the status fields that are deprecated and will be removed in a future version.
 // See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
 type MachineDeploymentDeprecatedStatus struct {
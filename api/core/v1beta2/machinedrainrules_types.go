@@ -113,6 +113,18 @@ type MachineDrainRuleSpec struct {
 	// +kubebuilder:validation:MaxItems=32
 	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x == y))",message="entries in pods must be unique"
 	Pods []MachineDrainRulePodSelector `json:"pods,omitempty"`
+
+	// precedence defines the precedence of this rule over other MachineDrainRules and
+	// ClusterMachineDrainRules that apply to the same Pod.
+	// If multiple rules apply to a Pod, the rule with the highest precedence is used.
+	// If multiple rules have the same precedence, MachineDrainRules are used before
+	// ClusterMachineDrainRules and rules of the same kind are used in alphabetical order of their names.
+	// If precedence is not set, 0 will be used.
+	// Valid values for precedence are from -1000 to 1000 (inclusive).
+	// +optional
+	// +kubebuilder:validation:Minimum=-1000
+	// +kubebuilder:validation:Maximum=1000
+	Precedence *int32 `json:"precedence,omitempty"`
 }
 
 // MachineDrainRuleDrainConfig configures if and how Pods are drained.
@@ -199,6 +211,7 @@ type MachineDrainRulePodSelector struct {
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Behavior",type="string",JSONPath=".spec.drain.behavior",description="Drain behavior"
 // +kubebuilder:printcolumn:name="Order",type="string",JSONPath=".spec.drain.order",description="Drain order"
+// +kubebuilder:printcolumn:name="Precedence",type="string",JSONPath=".spec.precedence",description="Precedence over other rules"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of the MachineDrainRule"
 
 // MachineDrainRule is the Schema for the MachineDrainRule API.
@@ -230,6 +243,46 @@ type MachineDrainRuleList struct {
 	Items []MachineDrainRule `json:"items"`
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clustermachinedrainrules,scope=Cluster,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Behavior",type="string",JSONPath=".spec.drain.behavior",description="Drain behavior"
+// +kubebuilder:printcolumn:name="Order",type="string",JSONPath=".spec.drain.order",description="Drain order"
+// +kubebuilder:printcolumn:name="Precedence",type="string",JSONPath=".spec.precedence",description="Precedence over other rules"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of the ClusterMachineDrainRule"
+
+// ClusterMachineDrainRule is the Schema for the ClusterMachineDrainRule API.
+// A ClusterMachineDrainRule is a cluster-scoped MachineDrainRule which applies to Machines in all Namespaces,
+// e.g. to define organization-wide drain behavior for Pods once instead of in every Namespace.
+type ClusterMachineDrainRule struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +required
+	metav1.ObjectMeta `json:"metadata"`
+
+	// spec defines the spec of a ClusterMachineDrainRule.
+	// If spec.machines is not set, the ClusterMachineDrainRule applies to all Machines in all Namespaces.
+	// +required
+	Spec MachineDrainRuleSpec `json:"spec,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterMachineDrainRuleList contains a list of ClusterMachineDrainRules.
+type ClusterMachineDrainRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +required
+	metav1.ListMeta `json:"metadata"`
+
+	// items contains the items of the ClusterMachineDrainRuleList.
+	Items []ClusterMachineDrainRule `json:"items"`
+}
+
 func init() {
-	objectTypes = append(objectTypes, &MachineDrainRule{}, &MachineDrainRuleList{})
+	objectTypes = append(objectTypes, &MachineDrainRule{}, &MachineDrainRuleList{}, &ClusterMachineDrainRule{}, &ClusterMachineDrainRuleList{})
 }
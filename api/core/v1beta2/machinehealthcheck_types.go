@@ -38,6 +38,35 @@ const (
 	MachineHealthCheckRemediationAllowedReason = "RemediationAllowed"
 )
 
+// MachineHealthCheck's RemediationThrottled condition and corresponding reasons.
+const (
+	// MachineHealthCheckRemediationThrottledCondition surfaces whether remediations are throttled by the
+	// triggerIf configuration of the MachineHealthCheck, including the exact numbers used to take the decision.
+	MachineHealthCheckRemediationThrottledCondition = "RemediationThrottled"
+
+	// MachineHealthCheckRemediationThrottledReason surfaces when remediations are throttled because the number of unhealthy
+	// Machines is not within the limits defined by the MachineHealthCheck.
+	MachineHealthCheckRemediationThrottledReason = "RemediationThrottled"
+
+	// MachineHealthCheckRemediationNotThrottledReason surfaces when remediations are not throttled because the number of unhealthy
+	// Machines is within the limits defined by the MachineHealthCheck.
+	MachineHealthCheckRemediationNotThrottledReason = "RemediationNotThrottled"
+)
+
+// MachineHealthCheckPercentageOf defines the number of Machines percentages in unhealthyLessThanOrEqualTo are computed against.
+// +kubebuilder:validation:Enum=ExpectedMachines;DesiredMachines
+type MachineHealthCheckPercentageOf string
+
+const (
+	// MachineHealthCheckPercentageOfExpectedMachines computes percentages against the number of Machines
+	// currently targeted by the MachineHealthCheck.
+	MachineHealthCheckPercentageOfExpectedMachines MachineHealthCheckPercentageOf = "ExpectedMachines"
+
+	// MachineHealthCheckPercentageOfDesiredMachines computes percentages against the desired number of Machines
+	// targeted by the MachineHealthCheck, i.e. the replicas of the MachineSets and of the control plane owning them.
+	MachineHealthCheckPercentageOfDesiredMachines MachineHealthCheckPercentageOf = "DesiredMachines"
+)
+
 var (
 	// DefaultNodeStartupTimeoutSeconds is the time allowed for a node to start up.
 	// Can be made longer as part of spec if required for particular provider.
@@ -102,6 +131,16 @@ type MachineHealthCheckChecks struct {
 	// +kubebuilder:validation:Minimum=0
 	NodeStartupTimeoutSeconds *int32 `json:"nodeStartupTimeoutSeconds,omitempty"`
 
+	// nodeStartupTimeoutOverrides allows to override nodeStartupTimeoutSeconds for Machines
+	// in specific failure domains, e.g. failure domains at edge sites where provisioning is slower.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=failureDomain
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	NodeStartupTimeoutOverrides []MachineHealthCheckNodeStartupTimeoutOverride `json:"nodeStartupTimeoutOverrides,omitempty"`
+
 	// unhealthyNodeConditions contains a list of conditions that determine
 	// whether a node is considered unhealthy. The conditions are combined in a
 	// logical OR, i.e. if any of the conditions is met, the node is unhealthy.
@@ -112,6 +151,17 @@ type MachineHealthCheckChecks struct {
 	// +kubebuilder:validation:MaxItems=100
 	UnhealthyNodeConditions []UnhealthyNodeCondition `json:"unhealthyNodeConditions,omitempty"`
 
+	// nodeStartupTaints contains a list of taints that are present on Nodes while they are starting up,
+	// e.g. taints added by the infrastructure provider or by a DaemonSet preparing the Node.
+	// While any of these taints is present on a Node, the corresponding node conditions
+	// in unhealthyNodeConditions are not evaluated.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	NodeStartupTaints []MachineHealthCheckNodeStartupTaint `json:"nodeStartupTaints,omitempty"`
+
 	// unhealthyMachineConditions contains a list of the machine conditions that determine
 	// whether a machine is considered unhealthy.  The conditions are combined in a
 	// logical OR, i.e. if any of the conditions is met, the machine is unhealthy.
@@ -121,6 +171,61 @@ type MachineHealthCheckChecks struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=100
 	UnhealthyMachineConditions []UnhealthyMachineCondition `json:"unhealthyMachineConditions,omitempty"`
+
+	// unhealthyPodConditions contains a list of the conditions of Pods running on the Node of a machine
+	// that determine whether a machine is considered unhealthy, e.g. if critical DaemonSet Pods are not Ready.
+	// The conditions are combined in a logical OR, i.e. if any of the conditions is met, the machine is unhealthy.
+	//
+	// Pods are read from the workload cluster every time the MachineHealthCheck is reconciled, and
+	// the MachineHealthCheck is reconciled at least every minute when this field is set.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyPodConditions []UnhealthyPodCondition `json:"unhealthyPodConditions,omitempty"`
+}
+
+// MachineHealthCheckNodeStartupTimeoutOverride overrides the node startup timeout for Machines in a failure domain.
+type MachineHealthCheckNodeStartupTimeoutOverride struct {
+	// failureDomain is the failure domain of the Machines this override applies to.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	FailureDomain string `json:"failureDomain,omitempty"`
+
+	// nodeStartupTimeoutSeconds is the maximum time for MachineHealthCheck to consider a Machine
+	// in the failure domain unhealthy if a corresponding Node isn't associated through a `Spec.ProviderID` field.
+	// If you wish to disable the node startup timeout for the failure domain, set the value explicitly to 0.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	NodeStartupTimeoutSeconds *int32 `json:"nodeStartupTimeoutSeconds,omitempty"`
+}
+
+// MachineHealthCheckNodeStartupTaint is a taint present on Nodes while they are starting up, and the
+// node conditions which are not evaluated while the taint is present.
+type MachineHealthCheckNodeStartupTaint struct {
+	// key of the taint.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	// +kubebuilder:validation:Pattern=^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\/)?([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$
+	Key string `json:"key,omitempty"`
+
+	// effect of the taint. Valid values are NoSchedule, PreferNoSchedule and NoExecute.
+	// If not set, taints with the key are matched independently of their effect.
+	// +optional
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// ignoredNodeConditions is the list of node condition types which are not evaluated
+	// while the taint is present on the Node.
+	// +required
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	IgnoredNodeConditions []corev1.NodeConditionType `json:"ignoredNodeConditions,omitempty"`
 }
 
 // MachineHealthCheckRemediation configures if and how remediations are triggered if a Machine is unhealthy.
@@ -139,6 +244,20 @@ type MachineHealthCheckRemediation struct {
 	// a controller that lives outside of Cluster API.
 	// +optional
 	TemplateRef MachineHealthCheckRemediationTemplateReference `json:"templateRef,omitempty,omitzero"`
+
+	// maxExternalRemediationFailures is the number of failed external remediation requests for a Machine
+	// after which the MachineHealthCheck controller stops creating external remediation requests and
+	// escalates remediation to the owner of the Machine, e.g. a MachineSet or a KubeadmControlPlane,
+	// which deletes the Machine.
+	//
+	// An external remediation request is considered failed when it reports the Remediated condition
+	// with status False and reason RemediationFailed.
+	//
+	// If not set, a new external remediation request is created every time the previous one fails.
+	// This field is ignored if templateRef is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxExternalRemediationFailures *int32 `json:"maxExternalRemediationFailures,omitempty"`
 }
 
 // MachineHealthCheckRemediationTriggerIf configures if remediations are triggered.
@@ -151,6 +270,15 @@ type MachineHealthCheckRemediationTriggerIf struct {
 	// +optional
 	UnhealthyLessThanOrEqualTo *intstr.IntOrString `json:"unhealthyLessThanOrEqualTo,omitempty"`
 
+	// percentageOf defines the number of Machines a percentage in unhealthyLessThanOrEqualTo is computed against.
+	// When set to ExpectedMachines, the number of Machines currently targeted by the MachineHealthCheck is used.
+	// When set to DesiredMachines, the greater of the number of Machines currently targeted and the desired number
+	// of Machines, i.e. the sum of the replicas of the MachineSets and of the control plane owning the targeted Machines,
+	// is used; this prevents remediation from being blocked when many Machines have been deleted, e.g. manually.
+	// Defaults to ExpectedMachines.
+	// +optional
+	PercentageOf MachineHealthCheckPercentageOf `json:"percentageOf,omitempty"`
+
 	// unhealthyInRange specifies that remediations are only triggered if the number of
 	// unhealthy Machines is in the configured range.
 	// Takes precedence over unhealthyLessThanOrEqualTo.
@@ -270,11 +398,47 @@ type UnhealthyMachineCondition struct {
 	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
 }
 
+// UnhealthyPodCondition represents a Pod condition type and value with a timeout
+// specified as a duration, for the Pods selected by namespace and podSelector.
+// When the named condition has been in the given status for at least the timeout value
+// for any of the selected Pods running on the Node of a machine, the machine is considered unhealthy.
+type UnhealthyPodCondition struct {
+	// namespace is the namespace of the Pods in the workload cluster.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// podSelector is a label selector to select the Pods in the namespace.
+	// +required
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty,omitzero"`
+
+	// type of Pod condition
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Type corev1.PodConditionType `json:"type,omitempty"`
+
+	// status of the condition, one of True, False, Unknown.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Status corev1.ConditionStatus `json:"status,omitempty"`
+
+	// timeoutSeconds is the duration that a Pod must be in a given status for,
+	// after which the machine is considered unhealthy.
+	// For example, with a value of "600", the Pod must match the status
+	// for at least 10 minutes before the machine is considered unhealthy.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
 // MachineHealthCheckStatus defines the observed state of MachineHealthCheck.
 // +kubebuilder:validation:MinProperties=1
 type MachineHealthCheckStatus struct {
 	// conditions represents the observations of a MachineHealthCheck's current state.
-	// Known condition types are RemediationAllowed, Paused.
+	// Known condition types are RemediationAllowed, RemediationThrottled, Paused.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -286,6 +450,13 @@ type MachineHealthCheckStatus struct {
 	// +optional
 	ExpectedMachines *int32 `json:"expectedMachines,omitempty"`
 
+	// desiredMachines is the desired number of machines targeted by this machine health check, computed
+	// from the replicas of the MachineSets and of the control plane owning them.
+	// This field is only set when remediation.triggerIf.percentageOf is DesiredMachines.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DesiredMachines *int32 `json:"desiredMachines,omitempty"`
+
 	// currentHealthy is the total number of healthy machines counted by this machine health check
 	// +kubebuilder:validation:Minimum=0
 	// +optional
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	capierrors "sigs.k8s.io/cluster-api/api/deprecated/errors"
 )
@@ -26,6 +27,27 @@ import (
 const (
 	// MachinePoolFinalizer is used to ensure deletion of dependencies (nodes, infra).
 	MachinePoolFinalizer = "machinepool.cluster.x-k8s.io"
+
+	// MachinePoolSurgeReplicasAnnotation is set by the MachinePool controller on the InfraMachinePool during a rollout
+	// using the RollingUpdate strategy, to the number of replicas the infrastructure provider is allowed to create above
+	// the MachinePool's replicas. The annotation is removed when the rollout is completed.
+	// Note: Honoring this annotation is optional for infrastructure providers; if it is not honored, a rollout
+	// only progresses by replacing up to maxUnavailable Machines at a time, and it is reported as waiting for
+	// surge replicas on the MachinePool's RollingOut condition when it cannot progress.
+	MachinePoolSurgeReplicasAnnotation = "machinepool.cluster.x-k8s.io/surge-replicas"
+)
+
+// MachinePoolRolloutStrategyType defines the type of MachinePool rollout strategies.
+// +kubebuilder:validation:Enum=Provider;RollingUpdate
+type MachinePoolRolloutStrategyType string
+
+const (
+	// ProviderMachinePoolRolloutStrategyType delegates rollouts of MachinePool replicas to the infrastructure provider.
+	ProviderMachinePoolRolloutStrategyType MachinePoolRolloutStrategyType = "Provider"
+
+	// RollingUpdateMachinePoolRolloutStrategyType replaces outdated MachinePool Machines by deleting them, which drains
+	// their Nodes, and relying on the infrastructure provider to replace the deleted instances with up-to-date ones.
+	RollingUpdateMachinePoolRolloutStrategyType MachinePoolRolloutStrategyType = "RollingUpdate"
 )
 
 /*
@@ -64,6 +86,27 @@ const (
 ).
 */
 
+// MachinePool's RollingOut condition and corresponding reasons.
+// Note: The RollingOut condition is set only when using the RollingUpdate rollout strategy.
+const (
+	// MachinePoolRollingOutCondition is true if there is at least one outdated MachinePool Machine.
+	MachinePoolRollingOutCondition = RollingOutCondition
+
+	// MachinePoolRollingOutReason surfaces when there is at least one outdated MachinePool Machine.
+	MachinePoolRollingOutReason = RollingOutReason
+
+	// MachinePoolRollingOutWaitingForSurgeReplicasReason surfaces when a rollout is not progressing because
+	// no outdated MachinePool Machine can be deleted without exceeding maxUnavailable, and the infrastructure
+	// provider did not create surge replicas, e.g. because it does not honor the MachinePoolSurgeReplicasAnnotation.
+	MachinePoolRollingOutWaitingForSurgeReplicasReason = "WaitingForSurgeReplicas"
+
+	// MachinePoolNotRollingOutReason surfaces when all the MachinePool Machines are up-to-date.
+	MachinePoolNotRollingOutReason = NotRollingOutReason
+
+	// MachinePoolRollingOutInternalErrorReason surfaces unexpected failures when rolling out MachinePool Machines.
+	MachinePoolRollingOutInternalErrorReason = InternalErrorReason
+)
+
 // MachinePoolSpec defines the desired state of MachinePool.
 type MachinePoolSpec struct {
 	// clusterName is the name of the Cluster this object belongs to.
@@ -97,6 +140,61 @@ type MachinePoolSpec struct {
 	// +kubebuilder:validation:items:MinLength=1
 	// +kubebuilder:validation:items:MaxLength=256
 	FailureDomains []string `json:"failureDomains,omitempty"`
+
+	// rollout allows you to configure the behaviour of rolling updates to the MachinePool replicas.
+	// +optional
+	Rollout MachinePoolRolloutSpec `json:"rollout,omitempty,omitzero"`
+}
+
+// MachinePoolRolloutSpec defines the rollout behavior.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolRolloutSpec struct {
+	// strategy specifies how to roll out MachinePool replicas.
+	// +optional
+	Strategy MachinePoolRolloutStrategy `json:"strategy,omitempty,omitzero"`
+}
+
+// MachinePoolRolloutStrategy describes how to replace existing MachinePool replicas with new ones.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolRolloutStrategy struct {
+	// type of rollout. Allowed values are Provider and RollingUpdate.
+	// Default is Provider, i.e. rollouts are managed by the infrastructure provider.
+	//
+	// With RollingUpdate, the MachinePool controller replaces MachinePool Machines whose version is not the
+	// version of the MachinePool. This requires an infrastructure provider implementing MachinePool Machines
+	// with support for the deletion of single Machines.
+	// +required
+	Type MachinePoolRolloutStrategyType `json:"type,omitempty"`
+
+	// rollingUpdate is the rolling update config params. Present only if
+	// type = RollingUpdate.
+	// +optional
+	RollingUpdate MachinePoolRolloutStrategyRollingUpdate `json:"rollingUpdate,omitempty,omitzero"`
+}
+
+// MachinePoolRolloutStrategyRollingUpdate is used to control the desired behavior of rolling update.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolRolloutStrategyRollingUpdate struct {
+	// maxUnavailable is the maximum number of machines that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired
+	// machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if MaxSurge is 0.
+	// Defaults to 0.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// maxSurge is the maximum number of machines that can be created above the
+	// desired number of machines.
+	// Value can be an absolute number (ex: 5) or a percentage of
+	// desired machines (ex: 10%).
+	// This can not be 0 if MaxUnavailable is 0.
+	// Absolute number is calculated from percentage by rounding up.
+	// Defaults to 1.
+	// The value is surfaced to the infrastructure provider via the machinepool.cluster.x-k8s.io/surge-replicas
+	// annotation on the InfraMachinePool.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 }
 
 // MachinePoolStatus defines the observed state of MachinePool.
@@ -146,6 +244,20 @@ type MachinePoolStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	Versions []StatusVersion `json:"versions,omitempty"`
 
+	// resources are the resources of a single replica of this MachinePool, as used by the cluster autoscaler
+	// to scale the MachinePool from zero.
+	// They are surfaced from status.capacity and status.nodeInfo of the InfraMachinePool if reported by the
+	// infrastructure provider, otherwise from the Nodes of the MachinePool; the last known value is preserved
+	// when the MachinePool is scaled to zero.
+	// +optional
+	Resources MachinePoolResources `json:"resources,omitempty,omitzero"`
+
+	// autoscaling surfaces the min, max and current size of the cluster autoscaler node group of this MachinePool.
+	// It is set only when both the cluster autoscaler min size and max size annotations are set on the MachinePool,
+	// e.g. via spec.topology.workers.machinePools[].autoscaling of the Cluster.
+	// +optional
+	Autoscaling *NodeGroupAutoscalingStatus `json:"autoscaling,omitempty"`
+
 	// phase represents the current phase of cluster actuation.
 	// +optional
 	// +kubebuilder:validation:Enum=Pending;Provisioning;Provisioned;Running;ScalingUp;ScalingDown;Scaling;Deleting;Failed;Unknown
@@ -161,6 +273,34 @@ type MachinePoolStatus struct {
 	Deprecated *MachinePoolDeprecatedStatus `json:"deprecated,omitempty"`
 }
 
+// MachinePoolResources are the resources of a single replica of a MachinePool.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolResources struct {
+	// capacity is the resource capacity of a single replica of the MachinePool.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// nodeInfo is the architecture and the operating system of the Nodes of the MachinePool.
+	// +optional
+	NodeInfo MachinePoolNodeInfo `json:"nodeInfo,omitempty,omitzero"`
+}
+
+// MachinePoolNodeInfo contains information about the architecture and the operating system of the Nodes of a MachinePool.
+// +kubebuilder:validation:MinProperties=1
+type MachinePoolNodeInfo struct {
+	// architecture is the CPU architecture of the Nodes, e.g. amd64 or arm64.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Architecture string `json:"architecture,omitempty"`
+
+	// operatingSystem is the operating system of the Nodes, e.g. linux or windows.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+}
+
 // MachinePoolInitializationStatus provides observations of the MachinePool initialization process.
 // NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial MachinePool provisioning.
 // +kubebuilder:validation:MinProperties=1
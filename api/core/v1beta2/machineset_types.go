@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
@@ -104,6 +105,10 @@ type MachineSetSpec struct {
 	// +optional
 	MachineNaming MachineNamingSpec `json:"machineNaming,omitempty,omitzero"`
 
+	// placement allows spreading Machines across failure domains and hosts.
+	// +optional
+	Placement MachinePlacementSpec `json:"placement,omitempty,omitzero"`
+
 	// deletion contains configuration options for MachineSet deletion.
 	// +optional
 	Deletion MachineSetDeletionSpec `json:"deletion,omitempty,omitzero"`
@@ -113,7 +118,7 @@ type MachineSetSpec struct {
 // +kubebuilder:validation:MinProperties=1
 type MachineSetDeletionSpec struct {
 	// order defines the order in which Machines are deleted when downscaling.
-	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest"
+	// Defaults to "Random". Valid values are "Random", "Newest", "Oldest", "LeastUtilized"
 	// +optional
 	Order MachineSetDeletionOrder `json:"order,omitempty"`
 }
@@ -271,7 +276,7 @@ type MachineTemplateSpec struct {
 
 // MachineSetDeletionOrder defines how priority is assigned to nodes to delete when
 // downscaling a MachineSet. Defaults to "Random".
-// +kubebuilder:validation:Enum=Random;Newest;Oldest
+// +kubebuilder:validation:Enum=Random;Newest;Oldest;LeastUtilized
 type MachineSetDeletionOrder string
 
 const (
@@ -295,6 +300,15 @@ const (
 	// or NodeHealthy type of Status.Conditions is not true).
 	// It then prioritizes the oldest Machines for deletion based on the Machine's CreationTimestamp.
 	OldestMachineSetDeletionOrder MachineSetDeletionOrder = "Oldest"
+
+	// LeastUtilizedMachineSetDeletionOrder prioritizes both Machines that have the annotation
+	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
+	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
+	// or NodeHealthy type of Status.Conditions is not true).
+	// It then prioritizes the Machines whose Node has the lowest utilization for deletion, where the
+	// utilization of a Node is the highest ratio between the CPU or memory requested by the Pods running
+	// on the Node and the allocatable CPU or memory of the Node.
+	LeastUtilizedMachineSetDeletionOrder MachineSetDeletionOrder = "LeastUtilized"
 )
 
 // MachineSetStatus defines the observed state of MachineSet.
@@ -340,6 +354,27 @@ type MachineSetStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	Versions []StatusVersion `json:"versions,omitempty"`
 
+	// failureDomains is the number of replicas per failure domain in this MachineSet.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	FailureDomains []StatusFailureDomain `json:"failureDomains,omitempty"`
+
+	// nodeCapabilities are the capabilities of the Nodes of the Machines of this MachineSet, e.g. architecture and GPUs.
+	// This field is computed from the most recently created Machine with a Node and it is preserved when the
+	// MachineSet is scaled to zero, so it can be used e.g. for autoscaling from zero.
+	// +optional
+	NodeCapabilities MachineNodeCapabilities `json:"nodeCapabilities,omitempty,omitzero"`
+
+	// capacity is the resource capacity of a single replica of this MachineSet, as reported in status.capacity
+	// of the InfraMachineTemplate; it is used to compute the annotations used by the cluster autoscaler to scale
+	// the MachineSet from zero. The last known value is preserved if the InfraMachineTemplate does not report it.
+	// Note: this field is set only for MachineSets which are not part of a MachineDeployment.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
 	// observedGeneration reflects the generation of the most recently observed MachineSet.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
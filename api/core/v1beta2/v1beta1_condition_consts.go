@@ -354,11 +354,20 @@ const (
 	// not yet completed because a cluster upgrade is still in progress.
 	TopologyReconciledClusterUpgradingV1Beta1Reason = "ClusterUpgrading"
 
+	// TopologyReconciledExternalControlPlaneUpgradePendingV1Beta1Reason (Severity=Info) documents reconciliation of a Cluster topology
+	// not yet completed because an externally managed Control Plane is not yet upgraded out-of-band to the desired version.
+	TopologyReconciledExternalControlPlaneUpgradePendingV1Beta1Reason = "ExternalControlPlaneUpgradePending"
+
 	// TopologyReconciledClusterClassNotReconciledV1Beta1Reason (Severity=Info) documents reconciliation of a Cluster topology not
 	// yet completed because the ClusterClass has not reconciled yet. If this condition persists there may be an issue
 	// with the ClusterClass surfaced in the ClusterClass status or controller logs.
 	TopologyReconciledClusterClassNotReconciledV1Beta1Reason = "ClusterClassNotReconciled"
 
+	// TopologyReconciledClusterClassNotCompatibleV1Beta1Reason (Severity=Error) documents reconciliation of a Cluster topology not
+	// performed because the objects computed from the ClusterClass are not compatible with the current objects
+	// of the Cluster topology, e.g. after changing the ClusterClass referenced by the Cluster to an incompatible one.
+	TopologyReconciledClusterClassNotCompatibleV1Beta1Reason = "ClusterClassNotCompatible"
+
 	// TopologyReconciledPausedV1Beta1Reason (Severity=Info) surfaces when the Cluster is paused.
 	TopologyReconciledPausedV1Beta1Reason = "Paused"
 )
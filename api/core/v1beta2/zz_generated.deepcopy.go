@@ -186,6 +186,21 @@ func (in *ClusterClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassOCISource) DeepCopyInto(out *ClusterClassOCISource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassOCISource.
+func (in *ClusterClassOCISource) DeepCopy() *ClusterClassOCISource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassOCISource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterClassPatch) DeepCopyInto(out *ClusterClassPatch) {
 	*out = *in
@@ -216,6 +231,7 @@ func (in *ClusterClassPatch) DeepCopy() *ClusterClassPatch {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterClassRef) DeepCopyInto(out *ClusterClassRef) {
 	*out = *in
+	out.OCI = in.OCI
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRef.
@@ -228,6 +244,52 @@ func (in *ClusterClassRef) DeepCopy() *ClusterClassRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassRelease) DeepCopyInto(out *ClusterClassRelease) {
+	*out = *in
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Rollout.DeepCopyInto(&out.Rollout)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassRelease.
+func (in *ClusterClassRelease) DeepCopy() *ClusterClassRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassReleaseRollout) DeepCopyInto(out *ClusterClassReleaseRollout) {
+	*out = *in
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SoakTimeSeconds != nil {
+		in, out := &in.SoakTimeSeconds, &out.SoakTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassReleaseRollout.
+func (in *ClusterClassReleaseRollout) DeepCopy() *ClusterClassReleaseRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassReleaseRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterClassSpec) DeepCopyInto(out *ClusterClassSpec) {
 	*out = *in
@@ -259,6 +321,7 @@ func (in *ClusterClassSpec) DeepCopyInto(out *ClusterClassSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Release.DeepCopyInto(&out.Release)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassSpec.
@@ -288,6 +351,13 @@ func (in *ClusterClassStatus) DeepCopyInto(out *ClusterClassStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DiscoveredVariables != nil {
+		in, out := &in.DiscoveredVariables, &out.DiscoveredVariables
+		*out = make([]ClusterClassStatusDiscoveredVariables, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(ClusterClassDeprecatedStatus)
@@ -305,6 +375,26 @@ func (in *ClusterClassStatus) DeepCopy() *ClusterClassStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassStatusDiscoveredVariables) DeepCopyInto(out *ClusterClassStatusDiscoveredVariables) {
+	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassStatusDiscoveredVariables.
+func (in *ClusterClassStatusDiscoveredVariables) DeepCopy() *ClusterClassStatusDiscoveredVariables {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassStatusDiscoveredVariables)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterClassStatusVariable) DeepCopyInto(out *ClusterClassStatusVariable) {
 	*out = *in
@@ -432,6 +522,7 @@ func (in *ClusterClassVariable) DeepCopyInto(out *ClusterClassVariable) {
 	}
 	in.DeprecatedV1Beta1Metadata.DeepCopyInto(&out.DeprecatedV1Beta1Metadata)
 	in.Schema.DeepCopyInto(&out.Schema)
+	in.Migration.DeepCopyInto(&out.Migration)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassVariable.
@@ -444,6 +535,26 @@ func (in *ClusterClassVariable) DeepCopy() *ClusterClassVariable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterClassVariableMigration) DeepCopyInto(out *ClusterClassVariableMigration) {
+	*out = *in
+	if in.PreviousDefault != nil {
+		in, out := &in.PreviousDefault, &out.PreviousDefault
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassVariableMigration.
+func (in *ClusterClassVariableMigration) DeepCopy() *ClusterClassVariableMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterClassVariableMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterClassVariableMetadata) DeepCopyInto(out *ClusterClassVariableMetadata) {
 	*out = *in
@@ -600,6 +711,64 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMachineDrainRule) DeepCopyInto(out *ClusterMachineDrainRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMachineDrainRule.
+func (in *ClusterMachineDrainRule) DeepCopy() *ClusterMachineDrainRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMachineDrainRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMachineDrainRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMachineDrainRuleList) DeepCopyInto(out *ClusterMachineDrainRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterMachineDrainRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMachineDrainRuleList.
+func (in *ClusterMachineDrainRuleList) DeepCopy() *ClusterMachineDrainRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMachineDrainRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMachineDrainRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
 	*out = *in
@@ -995,6 +1164,11 @@ func (in *ControlPlaneTopology) DeepCopyInto(out *ControlPlaneTopology) {
 		copy(*out, *in)
 	}
 	in.Variables.DeepCopyInto(&out.Variables)
+	if in.ExternallyManaged != nil {
+		in, out := &in.ExternallyManaged, &out.ExternallyManaged
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneTopology.
@@ -1394,7 +1568,9 @@ func (in *JSONSchemaProps) DeepCopyInto(out *JSONSchemaProps) {
 	if in.XValidations != nil {
 		in, out := &in.XValidations, &out.XValidations
 		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	in.XMetadata.DeepCopyInto(&out.XMetadata)
 	if in.XIntOrString != nil {
@@ -1514,6 +1690,11 @@ func (in *MachineDeletionSpec) DeepCopyInto(out *MachineDeletionSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.NodeVolumeForceDetachTimeoutSeconds != nil {
+		in, out := &in.NodeVolumeForceDetachTimeoutSeconds, &out.NodeVolumeForceDetachTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.NodeDeletionTimeoutSeconds != nil {
 		in, out := &in.NodeDeletionTimeoutSeconds, &out.NodeDeletionTimeoutSeconds
 		*out = new(int32)
@@ -1928,11 +2109,57 @@ func (in *MachineDeploymentRemediationSpec) DeepCopy() *MachineDeploymentRemedia
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentRolloutCanary) DeepCopyInto(out *MachineDeploymentRolloutCanary) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutCanary.
+func (in *MachineDeploymentRolloutCanary) DeepCopy() *MachineDeploymentRolloutCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentRolloutCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentRolloutMachineSetStatus) DeepCopyInto(out *MachineDeploymentRolloutMachineSetStatus) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AvailableReplicas != nil {
+		in, out := &in.AvailableReplicas, &out.AvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutMachineSetStatus.
+func (in *MachineDeploymentRolloutMachineSetStatus) DeepCopy() *MachineDeploymentRolloutMachineSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentRolloutMachineSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentRolloutSpec) DeepCopyInto(out *MachineDeploymentRolloutSpec) {
 	*out = *in
 	in.After.DeepCopyInto(&out.After)
 	in.Strategy.DeepCopyInto(&out.Strategy)
+	in.Canary.DeepCopyInto(&out.Canary)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutSpec.
@@ -1945,6 +2172,39 @@ func (in *MachineDeploymentRolloutSpec) DeepCopy() *MachineDeploymentRolloutSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentRolloutStatus) DeepCopyInto(out *MachineDeploymentRolloutStatus) {
+	*out = *in
+	if in.NewReplicas != nil {
+		in, out := &in.NewReplicas, &out.NewReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OldReplicas != nil {
+		in, out := &in.OldReplicas, &out.OldReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MachineSets != nil {
+		in, out := &in.MachineSets, &out.MachineSets
+		*out = make([]MachineDeploymentRolloutMachineSetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.EstimatedCompletionTime.DeepCopyInto(&out.EstimatedCompletionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutStatus.
+func (in *MachineDeploymentRolloutStatus) DeepCopy() *MachineDeploymentRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentRolloutStrategy) DeepCopyInto(out *MachineDeploymentRolloutStrategy) {
 	*out = *in
@@ -1998,6 +2258,7 @@ func (in *MachineDeploymentSpec) DeepCopyInto(out *MachineDeploymentSpec) {
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
 	out.MachineNaming = in.MachineNaming
+	in.Placement.DeepCopyInto(&out.Placement)
 	in.Remediation.DeepCopyInto(&out.Remediation)
 	out.Deletion = in.Deletion
 	if in.Paused != nil {
@@ -2052,6 +2313,25 @@ func (in *MachineDeploymentStatus) DeepCopyInto(out *MachineDeploymentStatus) {
 		*out = make([]StatusVersion, len(*in))
 		copy(*out, *in)
 	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]StatusFailureDomain, len(*in))
+		copy(*out, *in)
+	}
+	in.Rollout.DeepCopyInto(&out.Rollout)
+	in.NodeCapabilities.DeepCopyInto(&out.NodeCapabilities)
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(NodeGroupAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineDeploymentDeprecatedStatus)
@@ -2078,6 +2358,7 @@ func (in *MachineDeploymentTopology) DeepCopyInto(out *MachineDeploymentTopology
 		*out = new(int32)
 		**out = **in
 	}
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
 	in.Deletion.DeepCopyInto(&out.Deletion)
 	if in.Taints != nil {
@@ -2096,6 +2377,11 @@ func (in *MachineDeploymentTopology) DeepCopyInto(out *MachineDeploymentTopology
 		copy(*out, *in)
 	}
 	in.Rollout.DeepCopyInto(&out.Rollout)
+	if in.UpgradeGroup != nil {
+		in, out := &in.UpgradeGroup, &out.UpgradeGroup
+		*out = new(int32)
+		**out = **in
+	}
 	in.Variables.DeepCopyInto(&out.Variables)
 }
 
@@ -2359,6 +2645,21 @@ func (in *MachineDeprecatedStatus) DeepCopy() *MachineDeprecatedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDiagnosticsStatus) DeepCopyInto(out *MachineDiagnosticsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDiagnosticsStatus.
+func (in *MachineDiagnosticsStatus) DeepCopy() *MachineDiagnosticsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDiagnosticsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDrainRule) DeepCopyInto(out *MachineDrainRule) {
 	*out = *in
@@ -2505,6 +2806,11 @@ func (in *MachineDrainRuleSpec) DeepCopyInto(out *MachineDrainRuleSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Precedence != nil {
+		in, out := &in.Precedence, &out.Precedence
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDrainRuleSpec.
@@ -2552,6 +2858,13 @@ func (in *MachineHealthCheckChecks) DeepCopyInto(out *MachineHealthCheckChecks)
 		*out = new(int32)
 		**out = **in
 	}
+	if in.NodeStartupTimeoutOverrides != nil {
+		in, out := &in.NodeStartupTimeoutOverrides, &out.NodeStartupTimeoutOverrides
+		*out = make([]MachineHealthCheckNodeStartupTimeoutOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.UnhealthyNodeConditions != nil {
 		in, out := &in.UnhealthyNodeConditions, &out.UnhealthyNodeConditions
 		*out = make([]UnhealthyNodeCondition, len(*in))
@@ -2559,6 +2872,13 @@ func (in *MachineHealthCheckChecks) DeepCopyInto(out *MachineHealthCheckChecks)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeStartupTaints != nil {
+		in, out := &in.NodeStartupTaints, &out.NodeStartupTaints
+		*out = make([]MachineHealthCheckNodeStartupTaint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.UnhealthyMachineConditions != nil {
 		in, out := &in.UnhealthyMachineConditions, &out.UnhealthyMachineConditions
 		*out = make([]UnhealthyMachineCondition, len(*in))
@@ -2566,6 +2886,13 @@ func (in *MachineHealthCheckChecks) DeepCopyInto(out *MachineHealthCheckChecks)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnhealthyPodConditions != nil {
+		in, out := &in.UnhealthyPodConditions, &out.UnhealthyPodConditions
+		*out = make([]UnhealthyPodCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckChecks.
@@ -2630,11 +2957,56 @@ func (in *MachineHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckNodeStartupTaint) DeepCopyInto(out *MachineHealthCheckNodeStartupTaint) {
+	*out = *in
+	if in.IgnoredNodeConditions != nil {
+		in, out := &in.IgnoredNodeConditions, &out.IgnoredNodeConditions
+		*out = make([]corev1.NodeConditionType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckNodeStartupTaint.
+func (in *MachineHealthCheckNodeStartupTaint) DeepCopy() *MachineHealthCheckNodeStartupTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckNodeStartupTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckNodeStartupTimeoutOverride) DeepCopyInto(out *MachineHealthCheckNodeStartupTimeoutOverride) {
+	*out = *in
+	if in.NodeStartupTimeoutSeconds != nil {
+		in, out := &in.NodeStartupTimeoutSeconds, &out.NodeStartupTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckNodeStartupTimeoutOverride.
+func (in *MachineHealthCheckNodeStartupTimeoutOverride) DeepCopy() *MachineHealthCheckNodeStartupTimeoutOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckNodeStartupTimeoutOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineHealthCheckRemediation) DeepCopyInto(out *MachineHealthCheckRemediation) {
 	*out = *in
 	in.TriggerIf.DeepCopyInto(&out.TriggerIf)
 	out.TemplateRef = in.TemplateRef
+	if in.MaxExternalRemediationFailures != nil {
+		in, out := &in.MaxExternalRemediationFailures, &out.MaxExternalRemediationFailures
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediation.
@@ -2715,6 +3087,11 @@ func (in *MachineHealthCheckStatus) DeepCopyInto(out *MachineHealthCheckStatus)
 		*out = new(int32)
 		**out = **in
 	}
+	if in.DesiredMachines != nil {
+		in, out := &in.DesiredMachines, &out.DesiredMachines
+		*out = new(int32)
+		**out = **in
+	}
 	if in.CurrentHealthy != nil {
 		in, out := &in.CurrentHealthy, &out.CurrentHealthy
 		*out = new(int32)
@@ -2841,6 +3218,26 @@ func (in *MachineNamingSpec) DeepCopy() *MachineNamingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNodeCapabilities) DeepCopyInto(out *MachineNodeCapabilities) {
+	*out = *in
+	if in.GPUCount != nil {
+		in, out := &in.GPUCount, &out.GPUCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNodeCapabilities.
+func (in *MachineNodeCapabilities) DeepCopy() *MachineNodeCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNodeCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineNodeReference) DeepCopyInto(out *MachineNodeReference) {
 	*out = *in
@@ -2856,6 +3253,42 @@ func (in *MachineNodeReference) DeepCopy() *MachineNodeReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePlacementHints) DeepCopyInto(out *MachinePlacementHints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePlacementHints.
+func (in *MachinePlacementHints) DeepCopy() *MachinePlacementHints {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePlacementHints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePlacementSpec) DeepCopyInto(out *MachinePlacementSpec) {
+	*out = *in
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.SpreadConstraints = in.SpreadConstraints
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePlacementSpec.
+func (in *MachinePlacementSpec) DeepCopy() *MachinePlacementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePlacementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachinePool) DeepCopyInto(out *MachinePool) {
 	*out = *in
@@ -3072,6 +3505,101 @@ func (in *MachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolNodeInfo) DeepCopyInto(out *MachinePoolNodeInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolNodeInfo.
+func (in *MachinePoolNodeInfo) DeepCopy() *MachinePoolNodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolNodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolResources) DeepCopyInto(out *MachinePoolResources) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	out.NodeInfo = in.NodeInfo
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolResources.
+func (in *MachinePoolResources) DeepCopy() *MachinePoolResources {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolRolloutSpec) DeepCopyInto(out *MachinePoolRolloutSpec) {
+	*out = *in
+	in.Strategy.DeepCopyInto(&out.Strategy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolRolloutSpec.
+func (in *MachinePoolRolloutSpec) DeepCopy() *MachinePoolRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolRolloutStrategy) DeepCopyInto(out *MachinePoolRolloutStrategy) {
+	*out = *in
+	in.RollingUpdate.DeepCopyInto(&out.RollingUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolRolloutStrategy.
+func (in *MachinePoolRolloutStrategy) DeepCopy() *MachinePoolRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolRolloutStrategyRollingUpdate) DeepCopyInto(out *MachinePoolRolloutStrategyRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolRolloutStrategyRollingUpdate.
+func (in *MachinePoolRolloutStrategyRollingUpdate) DeepCopy() *MachinePoolRolloutStrategyRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolRolloutStrategyRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
 	*out = *in
@@ -3091,6 +3619,7 @@ func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Rollout.DeepCopyInto(&out.Rollout)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSpec.
@@ -3144,6 +3673,12 @@ func (in *MachinePoolStatus) DeepCopyInto(out *MachinePoolStatus) {
 		*out = make([]StatusVersion, len(*in))
 		copy(*out, *in)
 	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(NodeGroupAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachinePoolDeprecatedStatus)
@@ -3186,6 +3721,7 @@ func (in *MachinePoolTopology) DeepCopyInto(out *MachinePoolTopology) {
 		*out = new(int32)
 		**out = **in
 	}
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	in.Variables.DeepCopyInto(&out.Variables)
 }
 
@@ -3403,6 +3939,7 @@ func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
 	out.MachineNaming = in.MachineNaming
+	in.Placement.DeepCopyInto(&out.Placement)
 	out.Deletion = in.Deletion
 }
 
@@ -3451,6 +3988,19 @@ func (in *MachineSetStatus) DeepCopyInto(out *MachineSetStatus) {
 		*out = make([]StatusVersion, len(*in))
 		copy(*out, *in)
 	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]StatusFailureDomain, len(*in))
+		copy(*out, *in)
+	}
+	in.NodeCapabilities.DeepCopyInto(&out.NodeCapabilities)
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineSetDeprecatedStatus)
@@ -3515,6 +4065,7 @@ func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
 		*out = make([]MachineReadinessGate, len(*in))
 		copy(*out, *in)
 	}
+	out.PlacementHints = in.PlacementHints
 	in.Deletion.DeepCopyInto(&out.Deletion)
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
@@ -3533,6 +4084,21 @@ func (in *MachineSpec) DeepCopy() *MachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSpreadConstraints) DeepCopyInto(out *MachineSpreadConstraints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpreadConstraints.
+func (in *MachineSpreadConstraints) DeepCopy() *MachineSpreadConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSpreadConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 	*out = *in
@@ -3550,6 +4116,7 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 		*out = new(corev1.NodeSystemInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	in.NodeCapabilities.DeepCopyInto(&out.NodeCapabilities)
 	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
@@ -3557,6 +4124,7 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 		copy(*out, *in)
 	}
 	in.CertificatesExpiryDate.DeepCopyInto(&out.CertificatesExpiryDate)
+	out.Diagnostics = in.Diagnostics
 	if in.Deletion != nil {
 		in, out := &in.Deletion, &out.Deletion
 		*out = new(MachineDeletionStatus)
@@ -3663,6 +4231,68 @@ func (in *NetworkRanges) DeepCopy() *NetworkRanges {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupAutoscaling) DeepCopyInto(out *NodeGroupAutoscaling) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupAutoscaling.
+func (in *NodeGroupAutoscaling) DeepCopy() *NodeGroupAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupAutoscalingStatus) DeepCopyInto(out *NodeGroupAutoscalingStatus) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CurrentSize != nil {
+		in, out := &in.CurrentSize, &out.CurrentSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupAutoscalingStatus.
+func (in *NodeGroupAutoscalingStatus) DeepCopy() *NodeGroupAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
 	*out = *in
@@ -3806,6 +4436,21 @@ func (in *PatchSelectorMatchMachinePoolClass) DeepCopy() *PatchSelectorMatchMach
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusFailureDomain) DeepCopyInto(out *StatusFailureDomain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusFailureDomain.
+func (in *StatusFailureDomain) DeepCopy() *StatusFailureDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusFailureDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusUpgradePlanVersion) DeepCopyInto(out *StatusUpgradePlanVersion) {
 	*out = *in
@@ -3901,9 +4546,35 @@ func (in *UnhealthyNodeCondition) DeepCopy() *UnhealthyNodeCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyPodCondition) DeepCopyInto(out *UnhealthyPodCondition) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyPodCondition.
+func (in *UnhealthyPodCondition) DeepCopy() *UnhealthyPodCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyPodCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidationRule) DeepCopyInto(out *ValidationRule) {
 	*out = *in
+	if in.OptionalOldSelf != nil {
+		in, out := &in.OptionalOldSelf, &out.OptionalOldSelf
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationRule.
@@ -4057,6 +4728,7 @@ func (in *WorkersTopology) DeepCopyInto(out *WorkersTopology) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkersTopology.
@@ -4068,3 +4740,23 @@ func (in *WorkersTopology) DeepCopy() *WorkersTopology {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkersTopologyUpgrade) DeepCopyInto(out *WorkersTopologyUpgrade) {
+	*out = *in
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkersTopologyUpgrade.
+func (in *WorkersTopologyUpgrade) DeepCopy() *WorkersTopologyUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkersTopologyUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// InClusterIPPoolKind is the kind of InClusterIPPool.
+	InClusterIPPoolKind = "InClusterIPPool"
+
+	// GlobalInClusterIPPoolKind is the kind of GlobalInClusterIPPool.
+	GlobalInClusterIPPoolKind = "GlobalInClusterIPPool"
+
+	// InClusterIPPoolFinalizer is added to InClusterIPPools and GlobalInClusterIPPools to prevent their deletion
+	// while IP addresses allocated from them still exist.
+	InClusterIPPoolFinalizer = "ipam.cluster.x-k8s.io/in-cluster-ip-pool"
+)
+
+// InClusterIPPoolSpec defines the desired state of InClusterIPPool and GlobalInClusterIPPool.
+type InClusterIPPoolSpec struct {
+	// addresses is a list of IP addresses that can be allocated by the pool.
+	// Single addresses (e.g. 10.0.0.10), ranges (e.g. 10.0.0.10-10.0.0.20) and CIDRs (e.g. 10.0.0.0/24) are supported;
+	// all the addresses must be of the same IP family.
+	// The network and broadcast addresses of IPv4 CIDRs with prefix length lower than 31 are not allocated.
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1024
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=79
+	Addresses []string `json:"addresses,omitempty"`
+
+	// prefix is the network prefix length of the addresses allocated by the pool.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	Prefix *int32 `json:"prefix,omitempty"`
+
+	// gateway is the network gateway of the addresses allocated by the pool.
+	// The gateway is never allocated.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=39
+	Gateway string `json:"gateway,omitempty"`
+
+	// excludedAddresses is a list of IP addresses that are never allocated by the pool, e.g. because they are
+	// statically assigned to other hosts. Single addresses, ranges and CIDRs are supported.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=1024
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=79
+	ExcludedAddresses []string `json:"excludedAddresses,omitempty"`
+
+	// reservations is a list of IP addresses reserved for specific IPAddressClaims.
+	// A reserved address is allocated only to the IPAddressClaim it is reserved for.
+	// +optional
+	// +listType=map
+	// +listMapKey=address
+	// +kubebuilder:validation:MaxItems=1024
+	Reservations []InClusterIPPoolReservation `json:"reservations,omitempty"`
+}
+
+// InClusterIPPoolReservation reserves an IP address of the pool for an IPAddressClaim.
+type InClusterIPPoolReservation struct {
+	// address is the reserved IP address; it must be one of the addresses of the pool.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=39
+	Address string `json:"address,omitempty"`
+
+	// claimRef is a reference to the IPAddressClaim the address is reserved for.
+	// +required
+	ClaimRef InClusterIPPoolReservationClaimReference `json:"claimRef,omitempty,omitzero"`
+}
+
+// InClusterIPPoolReservationClaimReference is a reference to the IPAddressClaim an IP address is reserved for.
+type InClusterIPPoolReservationClaimReference struct {
+	// name of the IPAddressClaim.
+	// name must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Name string `json:"name,omitempty"`
+
+	// namespace of the IPAddressClaim.
+	// namespace is required for GlobalInClusterIPPools, while for InClusterIPPools it defaults to the namespace of the pool.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// InClusterIPPoolStatus defines the observed state of InClusterIPPool and GlobalInClusterIPPool.
+// +kubebuilder:validation:MinProperties=1
+type InClusterIPPoolStatus struct {
+	// addresses reports the utilization of the pool.
+	// +optional
+	Addresses InClusterIPPoolAddressesStatus `json:"addresses,omitempty,omitzero"`
+}
+
+// InClusterIPPoolAddressesStatus reports the utilization of an InClusterIPPool or GlobalInClusterIPPool.
+type InClusterIPPoolAddressesStatus struct {
+	// total is the number of addresses that can be allocated by the pool, excluding the gateway and the excluded addresses.
+	// +optional
+	Total *int64 `json:"total,omitempty"`
+
+	// used is the number of addresses of the pool that are allocated.
+	// +optional
+	Used *int64 `json:"used,omitempty"`
+
+	// free is the number of addresses of the pool that are not allocated.
+	// +optional
+	Free *int64 `json:"free,omitempty"`
+
+	// outOfRange is the number of addresses allocated by the pool which are no longer part of it,
+	// e.g. because the addresses of the pool have been changed after allocation.
+	// +optional
+	OutOfRange *int64 `json:"outOfRange,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=inclusterippools,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Prefix",type="integer",JSONPath=".spec.prefix",description="Network prefix length of the addresses"
+// +kubebuilder:printcolumn:name="Gateway",type="string",JSONPath=".spec.gateway",description="Network gateway of the addresses"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.addresses.total",description="Number of addresses that can be allocated"
+// +kubebuilder:printcolumn:name="Free",type="integer",JSONPath=".status.addresses.free",description="Number of addresses that are not allocated"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.addresses.used",description="Number of addresses that are allocated"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of InClusterIPPool"
+
+// InClusterIPPool is the Schema for the inclusterippools API.
+// InClusterIPPool allocates IP addresses to IPAddressClaims in the same namespace.
+type InClusterIPPool struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of InClusterIPPool.
+	// +required
+	Spec InClusterIPPoolSpec `json:"spec,omitempty,omitzero"`
+	// status is the observed state of InClusterIPPool.
+	// +optional
+	Status InClusterIPPoolStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// InClusterIPPoolList is a list of InClusterIPPools.
+type InClusterIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of InClusterIPPools.
+	Items []InClusterIPPool `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=globalinclusterippools,scope=Cluster,categories=cluster-api
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Prefix",type="integer",JSONPath=".spec.prefix",description="Network prefix length of the addresses"
+// +kubebuilder:printcolumn:name="Gateway",type="string",JSONPath=".spec.gateway",description="Network gateway of the addresses"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.addresses.total",description="Number of addresses that can be allocated"
+// +kubebuilder:printcolumn:name="Free",type="integer",JSONPath=".status.addresses.free",description="Number of addresses that are not allocated"
+// +kubebuilder:printcolumn:name="Used",type="integer",JSONPath=".status.addresses.used",description="Number of addresses that are allocated"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of GlobalInClusterIPPool"
+
+// GlobalInClusterIPPool is the Schema for the globalinclusterippools API.
+// GlobalInClusterIPPool allocates IP addresses to IPAddressClaims in any namespace.
+type GlobalInClusterIPPool struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of GlobalInClusterIPPool.
+	// +required
+	Spec InClusterIPPoolSpec `json:"spec,omitempty,omitzero"`
+	// status is the observed state of GlobalInClusterIPPool.
+	// +optional
+	Status InClusterIPPoolStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalInClusterIPPoolList is a list of GlobalInClusterIPPools.
+type GlobalInClusterIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of GlobalInClusterIPPools.
+	Items []GlobalInClusterIPPool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&InClusterIPPool{}, &InClusterIPPoolList{},
+		&GlobalInClusterIPPool{}, &GlobalInClusterIPPoolList{},
+	)
+}
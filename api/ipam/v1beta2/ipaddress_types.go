@@ -20,6 +20,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// OrphanedSinceAnnotation is the annotation set on IPAddresses which are leaked, i.e. the IPAddressClaim
+	// they were created for no longer exists, to track since when the IPAddress is leaked (in RFC3339 format).
+	// Leaked IPAddresses are deleted once the leaked objects garbage collection grace period has elapsed, if enabled.
+	OrphanedSinceAnnotation = "ipam.cluster.x-k8s.io/orphaned-since"
+)
+
 // IPAddressSpec is the desired state of an IPAddress.
 type IPAddressSpec struct {
 	// claimRef is a reference to the claim this IPAddress was created for.
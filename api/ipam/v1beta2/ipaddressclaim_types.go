@@ -27,6 +27,9 @@ const (
 	// IPAddressClaimReadyCondition is true if the IPAddressClaim allocation succeeded.
 	IPAddressClaimReadyCondition = clusterv1.ReadyCondition
 
+	// IPAddressClaimReadyReason is the reason used when an IP address has been allocated for the claim.
+	IPAddressClaimReadyReason = clusterv1.ReadyReason
+
 	// IPAddressClaimReadyAllocationFailedReason is the reason used when allocating an IP address for a claim fails.
 	// More details should be provided in the condition's message.
 	// When the IP pool is full, [PoolExhaustedReason] should be used for better visibility instead.
@@ -40,6 +43,20 @@ const (
 	IPAddressClaimReadyPoolExhaustedReason = "PoolExhausted"
 )
 
+// IPAddressClaim's Orphaned condition and corresponding reasons.
+const (
+	// IPAddressClaimOrphanedCondition is true if the IPAddressClaim is leaked, i.e. the Cluster it belongs to
+	// or all of its owners, e.g. the Machine or the infrastructure machine it was created for, no longer exist.
+	// NOTE: This condition is surfaced only if the IPAddressLeakDetection feature gate is enabled.
+	IPAddressClaimOrphanedCondition = "Orphaned"
+
+	// IPAddressClaimOrphanedReason surfaces when the IPAddressClaim is leaked.
+	IPAddressClaimOrphanedReason = "Orphaned"
+
+	// IPAddressClaimNotOrphanedReason surfaces when the IPAddressClaim is not leaked.
+	IPAddressClaimNotOrphanedReason = "NotOrphaned"
+)
+
 // IPAddressClaimSpec is the desired state of an IPAddressClaim.
 type IPAddressClaimSpec struct {
 	// clusterName is the name of the Cluster this object belongs to.
@@ -57,7 +74,7 @@ type IPAddressClaimSpec struct {
 // +kubebuilder:validation:MinProperties=1
 type IPAddressClaimStatus struct {
 	// conditions represents the observations of a IPAddressClaim's current state.
-	// Known condition types are Ready.
+	// Known condition types are Ready, Orphaned.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -26,6 +26,65 @@ import (
 	corev1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalInClusterIPPool) DeepCopyInto(out *GlobalInClusterIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalInClusterIPPool.
+func (in *GlobalInClusterIPPool) DeepCopy() *GlobalInClusterIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalInClusterIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalInClusterIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalInClusterIPPoolList) DeepCopyInto(out *GlobalInClusterIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalInClusterIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalInClusterIPPoolList.
+func (in *GlobalInClusterIPPoolList) DeepCopy() *GlobalInClusterIPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalInClusterIPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalInClusterIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAddress) DeepCopyInto(out *IPAddress) {
 	*out = *in
@@ -295,3 +354,179 @@ func (in *IPPoolReference) DeepCopy() *IPPoolReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPool) DeepCopyInto(out *InClusterIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPool.
+func (in *InClusterIPPool) DeepCopy() *InClusterIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InClusterIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolAddressesStatus) DeepCopyInto(out *InClusterIPPoolAddressesStatus) {
+	*out = *in
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Free != nil {
+		in, out := &in.Free, &out.Free
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OutOfRange != nil {
+		in, out := &in.OutOfRange, &out.OutOfRange
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolAddressesStatus.
+func (in *InClusterIPPoolAddressesStatus) DeepCopy() *InClusterIPPoolAddressesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolAddressesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolList) DeepCopyInto(out *InClusterIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InClusterIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolList.
+func (in *InClusterIPPoolList) DeepCopy() *InClusterIPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InClusterIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolReservation) DeepCopyInto(out *InClusterIPPoolReservation) {
+	*out = *in
+	out.ClaimRef = in.ClaimRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolReservation.
+func (in *InClusterIPPoolReservation) DeepCopy() *InClusterIPPoolReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolReservationClaimReference) DeepCopyInto(out *InClusterIPPoolReservationClaimReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolReservationClaimReference.
+func (in *InClusterIPPoolReservationClaimReference) DeepCopy() *InClusterIPPoolReservationClaimReference {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolReservationClaimReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolSpec) DeepCopyInto(out *InClusterIPPoolSpec) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExcludedAddresses != nil {
+		in, out := &in.ExcludedAddresses, &out.ExcludedAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]InClusterIPPoolReservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolSpec.
+func (in *InClusterIPPoolSpec) DeepCopy() *InClusterIPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InClusterIPPoolStatus) DeepCopyInto(out *InClusterIPPoolStatus) {
+	*out = *in
+	in.Addresses.DeepCopyInto(&out.Addresses)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InClusterIPPoolStatus.
+func (in *InClusterIPPoolStatus) DeepCopy() *InClusterIPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InClusterIPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
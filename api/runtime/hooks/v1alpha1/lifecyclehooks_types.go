@@ -214,6 +214,43 @@ type AfterControlPlaneUpgradeResponse struct {
 // Kubernetes version and before the target version is propagated to the workload machines.
 func AfterControlPlaneUpgrade(*AfterControlPlaneUpgradeRequest, *AfterControlPlaneUpgradeResponse) {}
 
+// AfterControlPlaneMachineUpgradeRequest is the request of the AfterControlPlaneMachineUpgrade hook.
+// +kubebuilder:object:root=true
+type AfterControlPlaneMachineUpgradeRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the lifecycle hook corresponds to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// machine is the control plane machine that completed the upgrade.
+	// +required
+	Machine clusterv1.Machine `json:"machine"`
+
+	// kubernetesVersion is the Kubernetes version of the control plane machine after the upgrade.
+	// +required
+	KubernetesVersion string `json:"kubernetesVersion"`
+}
+
+var _ RetryResponseObject = &AfterControlPlaneMachineUpgradeResponse{}
+
+// AfterControlPlaneMachineUpgradeResponse is the response of the AfterControlPlaneMachineUpgrade hook.
+// +kubebuilder:object:root=true
+type AfterControlPlaneMachineUpgradeResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// AfterControlPlaneMachineUpgrade is the hook called after a control plane machine is successfully upgraded to a new
+// Kubernetes version and before the upgrade of the next control plane machine starts.
+func AfterControlPlaneMachineUpgrade(*AfterControlPlaneMachineUpgradeRequest, *AfterControlPlaneMachineUpgradeResponse) {
+}
+
 // BeforeWorkersUpgradeRequest is the request of the BeforeWorkersUpgrade hook.
 // +kubebuilder:object:root=true
 type BeforeWorkersUpgradeRequest struct {
@@ -341,6 +378,46 @@ type BeforeClusterDeleteRequest struct {
 	// cluster is the cluster object the lifecycle hook corresponds to.
 	// +required
 	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// descendants is the inventory of the objects belonging to the Cluster that are going to be deleted
+	// together with the Cluster, e.g. the InfrastructureCluster, the ControlPlane, MachineDeployments, MachinePools and Machines.
+	// +optional
+	Descendants []ClusterDescendant `json:"descendants,omitempty"`
+}
+
+// ClusterDescendant is a reference to an object belonging to a Cluster.
+// Note: descendants always belong to the same namespace of the Cluster.
+type ClusterDescendant struct {
+	// apiVersion of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// kind of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind,omitempty"`
+
+	// name of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+}
+
+// BlockingReason describes why a Runtime Extension is blocking an operation.
+type BlockingReason struct {
+	// reason is a CamelCase, machine-readable reason for blocking the operation.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// message is a human-readable description of why the operation is blocked.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Message string `json:"message,omitempty"`
+
+	// object is the object blocking the operation, if any.
+	// +optional
+	Object ClusterDescendant `json:"object,omitempty,omitzero"`
 }
 
 var _ RetryResponseObject = &BeforeClusterDeleteResponse{}
@@ -352,6 +429,12 @@ type BeforeClusterDeleteResponse struct {
 
 	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
 	CommonRetryResponse `json:",inline"`
+
+	// blockingReasons is a structured list of the reasons why the Cluster deletion is blocked.
+	// Blocking reasons are considered only when retryAfterSeconds is set to a non-zero value, and they
+	// are surfaced in the Deleting condition of the Cluster.
+	// +optional
+	BlockingReasons []BlockingReason `json:"blockingReasons,omitempty"`
 }
 
 // BeforeClusterDelete is the hook that is called after delete is issued on a cluster
@@ -423,6 +506,20 @@ func init() {
 			"tasks before the new version is propagated to the MachineDeployments and Machine Pools",
 	})
 
+	catalogBuilder.RegisterHook(AfterControlPlaneMachineUpgrade, &runtimecatalog.HookMeta{
+		Tags:    []string{"Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook after each control plane machine is upgraded",
+		Description: "Cluster API Runtime will call this hook after a control plane machine has been upgraded to a new Kubernetes version, " +
+			"either by replacing the machine or by updating it in-place. " +
+			"A control plane machine upgrade is completed when the machine has a node and all the control plane components on it are healthy.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook is called by the KubeadmControlPlane controller, also for Clusters without a managed topology\n" +
+			"- The call's request contains the Cluster object, the upgraded Machine object and the Kubernetes version we upgraded to\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute per-machine validations, " +
+			"e.g. etcd consistency checks, and to prevent the upgrade of the next control plane machine to start",
+	})
+
 	catalogBuilder.RegisterHook(BeforeWorkersUpgrade, &runtimecatalog.HookMeta{
 		Tags:    []string{"Lifecycle Hooks"},
 		Summary: "Cluster API Runtime will call this hook before the workers are upgraded",
@@ -469,8 +566,10 @@ func init() {
 			"\n" +
 			"Notes:\n" +
 			"- This hook will be called only for Clusters with a managed topology\n" +
-			"- The call's request contains the Cluster object \n" +
+			"- The call's request contains the Cluster object and the inventory of the objects belonging to the Cluster\n" +
 			"- This is a blocking hook; Runtime Extension implementers can use this hook  to execute " +
-			"tasks before objects of the Cluster are deleted",
+			"tasks before objects of the Cluster are deleted\n" +
+			"- When blocking, Runtime Extension implementers can return a structured list of blocking reasons " +
+			"that are surfaced in the Deleting condition of the Cluster",
 	})
 }
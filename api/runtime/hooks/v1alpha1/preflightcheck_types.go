@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+)
+
+// CanCreateMachineRequest is the request of the CanCreateMachine hook.
+// +kubebuilder:object:root=true
+type CanCreateMachineRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the Cluster object the MachineSet belongs to.
+	// +required
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// machineSet is the MachineSet object which is going to create Machines.
+	// +required
+	MachineSet clusterv1.MachineSet `json:"machineSet"`
+}
+
+var _ RetryResponseObject = &CanCreateMachineResponse{}
+
+// CanCreateMachineResponse is the response of the CanCreateMachine hook.
+// +kubebuilder:object:root=true
+type CanCreateMachineResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// CanCreateMachine is the hook that will be called before a MachineSet creates Machines.
+func CanCreateMachine(*CanCreateMachineRequest, *CanCreateMachineResponse) {}
+
+func init() {
+	catalogBuilder.RegisterHook(CanCreateMachine, &runtimecatalog.HookMeta{
+		Tags:    []string{"Preflight Check Hooks"},
+		Summary: "Cluster API Runtime will call this hook before a MachineSet creates Machines",
+		Description: "Cluster API Runtime will call this hook as part of the MachineSet preflight checks, before a MachineSet " +
+			"creates Machines when scaling up.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only if the MachineSetPreflightChecks feature flag is enabled and the CanCreateMachine\n" +
+			"preflight check is not skipped\n" +
+			"- The call's request contains the Cluster and the MachineSet objects\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to veto the creation of Machines,\n" +
+			"e.g. to implement quota checks or cost guardrails, by returning a non-zero retryAfterSeconds; the message of the\n" +
+			"response is surfaced in the ScalingUp condition of the MachineSet",
+	})
+}
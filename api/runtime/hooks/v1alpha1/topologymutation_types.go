@@ -39,6 +39,8 @@ type GeneratePatchesRequest struct {
 	Variables []Variable `json:"variables,omitempty"`
 
 	// items is the list of templates to generate patches for.
+	// Items are sorted: first the templates of the Cluster and of the ControlPlane, then the templates
+	// of the MachineDeployments and eventually the templates of the MachinePools, each sorted by topology name.
 	// +required
 	Items []GeneratePatchesRequestItem `json:"items"`
 }
@@ -59,9 +61,9 @@ type GeneratePatchesRequestItem struct {
 	Object runtime.RawExtension `json:"object"`
 
 	// variables are variables specific for the current template.
-	// For example some builtin variables like MachineDeployment replicas and version are context-sensitive
-	// and thus are only added to templates for MachineDeployments and with values which correspond to the
-	// current MachineDeployment.
+	// For example some builtin variables like MachineDeployment or MachinePool replicas and version are context-sensitive
+	// and thus are only added to templates for MachineDeployments or MachinePools and with values which correspond to the
+	// current MachineDeployment or MachinePool.
 	// +optional
 	Variables []Variable `json:"variables,omitempty"`
 }
@@ -132,9 +134,9 @@ type ValidateTopologyRequestItem struct {
 	Object runtime.RawExtension `json:"object"`
 
 	// variables are variables specific for the current template.
-	// For example some builtin variables like MachineDeployment replicas and version are context-sensitive
-	// and thus are only added to templates for MachineDeployments and with values which correspond to the
-	// current MachineDeployment.
+	// For example some builtin variables like MachineDeployment or MachinePool replicas and version are context-sensitive
+	// and thus are only added to templates for MachineDeployments or MachinePools and with values which correspond to the
+	// current MachineDeployment or MachinePool.
 	// +optional
 	Variables []Variable `json:"variables,omitempty"`
 }
@@ -161,7 +163,22 @@ type Variable struct {
 	Value apiextensionsv1.JSON `json:"value"`
 }
 
+const (
+	// BootstrapConfigRefHolderFieldPath is the fieldPath of the HolderReference of the bootstrap templates
+	// of MachineDeployments and MachinePools.
+	BootstrapConfigRefHolderFieldPath = "spec.template.spec.bootstrap.configRef"
+
+	// InfrastructureRefHolderFieldPath is the fieldPath of the HolderReference of the InfrastructureMachineTemplates
+	// of MachineDeployments and of the InfrastructureMachinePoolTemplates of MachinePools.
+	InfrastructureRefHolderFieldPath = "spec.template.spec.infrastructureRef"
+)
+
 // HolderReference represents a reference to an object which holds a template.
+// MachineDeployments and MachinePools use the same fieldPaths for their bootstrap and infrastructure templates,
+// see BootstrapConfigRefHolderFieldPath and InfrastructureRefHolderFieldPath.
+// NOTE: Templates of a MachineDeployment are used to generate templates, while templates of a MachinePool are used
+// to generate the BootstrapConfig and the InfrastructureMachinePool objects. Thus patches to the metadata of templates
+// held by MachinePools are dropped, while patches to spec.template.metadata are applied to the metadata of the objects.
 type HolderReference struct {
 	// apiVersion of the referent.
 	// +required
@@ -127,6 +127,58 @@ func (in *AfterControlPlaneInitializedResponse) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterControlPlaneMachineUpgradeRequest) DeepCopyInto(out *AfterControlPlaneMachineUpgradeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Machine.DeepCopyInto(&out.Machine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterControlPlaneMachineUpgradeRequest.
+func (in *AfterControlPlaneMachineUpgradeRequest) DeepCopy() *AfterControlPlaneMachineUpgradeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterControlPlaneMachineUpgradeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AfterControlPlaneMachineUpgradeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterControlPlaneMachineUpgradeResponse) DeepCopyInto(out *AfterControlPlaneMachineUpgradeResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterControlPlaneMachineUpgradeResponse.
+func (in *AfterControlPlaneMachineUpgradeResponse) DeepCopy() *AfterControlPlaneMachineUpgradeResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterControlPlaneMachineUpgradeResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AfterControlPlaneMachineUpgradeResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AfterControlPlaneUpgradeRequest) DeepCopyInto(out *AfterControlPlaneUpgradeRequest) {
 	*out = *in
@@ -306,6 +358,11 @@ func (in *BeforeClusterDeleteRequest) DeepCopyInto(out *BeforeClusterDeleteReque
 	out.TypeMeta = in.TypeMeta
 	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
 	in.Cluster.DeepCopyInto(&out.Cluster)
+	if in.Descendants != nil {
+		in, out := &in.Descendants, &out.Descendants
+		*out = make([]ClusterDescendant, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeClusterDeleteRequest.
@@ -331,6 +388,11 @@ func (in *BeforeClusterDeleteResponse) DeepCopyInto(out *BeforeClusterDeleteResp
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.CommonRetryResponse = in.CommonRetryResponse
+	if in.BlockingReasons != nil {
+		in, out := &in.BlockingReasons, &out.BlockingReasons
+		*out = make([]BlockingReason, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeClusterDeleteResponse.
@@ -534,6 +596,22 @@ func (in *BeforeWorkersUpgradeResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockingReason) DeepCopyInto(out *BlockingReason) {
+	*out = *in
+	out.Object = in.Object
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockingReason.
+func (in *BlockingReason) DeepCopy() *BlockingReason {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockingReason)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Builtins) DeepCopyInto(out *Builtins) {
 	*out = *in
@@ -569,6 +647,58 @@ func (in *Builtins) DeepCopy() *Builtins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanCreateMachineRequest) DeepCopyInto(out *CanCreateMachineRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.MachineSet.DeepCopyInto(&out.MachineSet)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanCreateMachineRequest.
+func (in *CanCreateMachineRequest) DeepCopy() *CanCreateMachineRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CanCreateMachineRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanCreateMachineRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanCreateMachineResponse) DeepCopyInto(out *CanCreateMachineResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanCreateMachineResponse.
+func (in *CanCreateMachineResponse) DeepCopy() *CanCreateMachineResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CanCreateMachineResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanCreateMachineResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanUpdateMachineRequest) DeepCopyInto(out *CanUpdateMachineRequest) {
 	*out = *in
@@ -745,6 +875,21 @@ func (in *ClusterBuiltins) DeepCopy() *ClusterBuiltins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDescendant) DeepCopyInto(out *ClusterDescendant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDescendant.
+func (in *ClusterDescendant) DeepCopy() *ClusterDescendant {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDescendant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetworkBuiltins) DeepCopyInto(out *ClusterNetworkBuiltins) {
 	*out = *in
@@ -146,6 +146,7 @@ func autoConvert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(in *v1beta2.Clien
 	}
 	// WARNING: in.Service requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api/api/runtime/v1beta2.ServiceReference vs *sigs.k8s.io/cluster-api/api/runtime/v1alpha1.ServiceReference)
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	// WARNING: in.ClientCertificateSecretRef requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -81,6 +81,33 @@ type ClientConfig struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=51200
 	CABundle []byte `json:"caBundle,omitempty"`
+
+	// clientCertificateSecretRef is a reference to a Secret of type kubernetes.io/tls containing
+	// the client certificate (tls.crt) and key (tls.key) to present to the Extension server for mutual TLS.
+	// The Secret is re-read periodically, so rotated certificates are picked up without restarts.
+	// If not set, no client certificate is presented.
+	// +optional
+	ClientCertificateSecretRef SecretReference `json:"clientCertificateSecretRef,omitempty,omitzero"`
+}
+
+// SecretReference holds a reference to a Kubernetes Secret.
+type SecretReference struct {
+	// namespace is the namespace of the secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// name is the name of the secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+}
+
+// IsDefined returns true if the SecretReference is set.
+func (r *SecretReference) IsDefined() bool {
+	return !reflect.DeepEqual(r, &SecretReference{})
 }
 
 // ServiceReference holds a reference to a Kubernetes Service of an Extension server.
@@ -120,7 +147,7 @@ func (r *ServiceReference) IsDefined() bool {
 // +kubebuilder:validation:MinProperties=1
 type ExtensionConfigStatus struct {
 	// conditions represents the observations of a ExtensionConfig's current state.
-	// Known condition types are Discovered, Paused.
+	// Known condition types are Discovered, Degraded, Paused.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -299,6 +326,21 @@ const (
 	ExtensionConfigNotDiscoveredReason = "NotDiscovered"
 )
 
+// ExtensionConfig's Degraded conditions and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// ExtensionConfigDegradedCondition is true if calls to some of the ExtensionHandlers of the runtime extension
+	// are temporarily bypassed because they are failing repeatedly.
+	// Note: Only ExtensionHandlers with FailurePolicy Ignore can be bypassed.
+	ExtensionConfigDegradedCondition = "Degraded"
+
+	// ExtensionConfigDegradedReason surfaces that calls to some of the ExtensionHandlers of the runtime extension
+	// are temporarily bypassed.
+	ExtensionConfigDegradedReason = "Degraded"
+
+	// ExtensionConfigNotDegradedReason surfaces that all the ExtensionHandlers of the runtime extension are called.
+	ExtensionConfigNotDegradedReason = "NotDegraded"
+)
+
 const (
 	// RuntimeExtensionDiscoveredV1Beta1Condition is a condition set on an ExtensionConfig object once it has been discovered by the Runtime SDK client.
 	RuntimeExtensionDiscoveredV1Beta1Condition clusterv1.ConditionType = "Discovered"
@@ -311,6 +353,17 @@ const (
 	// as <namespace>/<name>.
 	InjectCAFromSecretAnnotation string = "runtime.cluster.x-k8s.io/inject-ca-from-secret"
 
+	// CertManagerInjectCAFromSecretAnnotation is the cert-manager annotation that specifies that an
+	// ExtensionConfig object wants injection of CAs. The value is a reference to a Secret
+	// as <namespace>/<name>. It is handled the same way as InjectCAFromSecretAnnotation.
+	CertManagerInjectCAFromSecretAnnotation string = "cert-manager.io/inject-ca-from-secret"
+
+	// CertManagerInjectCAFromAnnotation is the cert-manager annotation that specifies that an
+	// ExtensionConfig object wants injection of CAs from a cert-manager Certificate. The value is
+	// a reference to a Certificate as <namespace>/<name>; the CA is read from the Secret the
+	// Certificate writes to (spec.secretName).
+	CertManagerInjectCAFromAnnotation string = "cert-manager.io/inject-ca-from"
+
 	// PendingHooksAnnotation is the annotation used to keep track of pending runtime hooks.
 	// The annotation will be used to track the intent to call a hook as soon as an operation completes;
 	// the intent will be removed as soon as the hook call completes successfully.
@@ -319,4 +372,9 @@ const (
 	// OkToDeleteAnnotation is the annotation used to indicate if a cluster is ready to be fully deleted.
 	// This annotation is added to the cluster after the BeforeClusterDelete hook has passed.
 	OkToDeleteAnnotation string = "runtime.cluster.x-k8s.io/ok-to-delete"
+
+	// BeforeClusterDeleteBlockingReasonsAnnotation is the annotation used to keep track of the blocking reasons
+	// returned by the BeforeClusterDelete hook; the value is the JSON encoded list of blocking reasons.
+	// This annotation is removed from the cluster after the BeforeClusterDelete hook has passed.
+	BeforeClusterDeleteBlockingReasonsAnnotation string = "runtime.cluster.x-k8s.io/before-cluster-delete-blocking-reasons"
 )
@@ -35,6 +35,7 @@ func (in *ClientConfig) DeepCopyInto(out *ClientConfig) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	out.ClientCertificateSecretRef = in.ClientCertificateSecretRef
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfig.
@@ -239,6 +240,21 @@ func (in *GroupVersionHook) DeepCopy() *GroupVersionHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	*out = *in
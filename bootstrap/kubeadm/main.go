@@ -45,6 +45,7 @@ import (
 	bootstrapv1beta1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta1"
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/reconcilers/kubeadmconfig"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/setup"
 	bootstrapadmission "sigs.k8s.io/cluster-api/bootstrap/kubeadm/webhooks/admission"
@@ -52,6 +53,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/crdmigrator"
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
 	"sigs.k8s.io/cluster-api/util/flags"
 	"sigs.k8s.io/cluster-api/version"
@@ -80,6 +82,7 @@ var (
 	webhookCertDir              string
 	webhookCertName             string
 	webhookKeyName              string
+	webhookDenyDeprecatedFields bool
 	healthAddr                  string
 	managerOptions              = flags.ManagerOptions{}
 	logOptions                  = logs.NewOptions()
@@ -88,6 +91,8 @@ var (
 	kubeadmConfigConcurrency int
 	skipCRDMigrationPhases   []string
 	tokenTTL                 time.Duration
+	// bootstrapDataEncryptionPublicKeys maps encryption provider names to public key file paths.
+	bootstrapDataEncryptionPublicKeys map[string]string
 )
 
 func init() {
@@ -153,6 +158,10 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&tokenTTL, "bootstrap-token-ttl", kubeadmconfig.DefaultTokenTTL,
 		"The amount of time the bootstrap token will be valid")
 
+	fs.StringToStringVar(&bootstrapDataEncryptionPublicKeys, "bootstrap-data-encryption-public-key", map[string]string{},
+		"Encryption providers which can be used to encrypt bootstrap data, as comma separated list of name=path pairs, where path is the path to a PEM encoded RSA public key. "+
+			"Requires the KubeadmBootstrapDataEncryption feature gate to be enabled.")
+
 	fs.IntVar(&webhookPort, "webhook-port", 9443,
 		"Webhook Server port")
 
@@ -165,6 +174,9 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&webhookKeyName, "webhook-key-name", "tls.key",
 		"Webhook key name.")
 
+	fs.BoolVar(&webhookDenyDeprecatedFields, "webhook-deny-deprecated-fields", false,
+		"If true, validating webhooks reject requests starting to use deprecated fields instead of returning a warning.")
+
 	fs.StringVar(&healthAddr, "health-addr", ":9440",
 		"The address the health endpoint binds to.")
 
@@ -325,6 +337,21 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		os.Exit(1)
 	}
 
+	encryptors := map[string]encryption.Encryptor{}
+	for name, path := range bootstrapDataEncryptionPublicKeys {
+		publicKeyPEM, err := os.ReadFile(path) //nolint:gosec // path is set by the operator via command line flag
+		if err != nil {
+			setupLog.Error(err, "Unable to read bootstrap data encryption public key", "provider", name)
+			os.Exit(1)
+		}
+		encryptor, err := encryption.NewEnvelopeEncryptor(publicKeyPEM)
+		if err != nil {
+			setupLog.Error(err, "Unable to create bootstrap data encryptor", "provider", name)
+			os.Exit(1)
+		}
+		encryptors[name] = encryptor
+	}
+
 	if err := (&kubeadmconfig.Reconciler{
 		Client:              mgr.GetClient(),
 		SecretCachingClient: secretCachingClient,
@@ -332,6 +359,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		ClusterCache:        clusterCache,
 		WatchFilterValue:    watchFilterValue,
 		TokenTTL:            tokenTTL,
+		Encryptors:          encryptors,
 	}).SetupWithManager(ctx, mgr, concurrency(kubeadmConfigConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KubeadmConfig")
 		os.Exit(1)
@@ -339,11 +367,17 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 }
 
 func setupWebhooks(mgr ctrl.Manager) {
-	if err := (&bootstrapadmission.KubeadmConfig{}).SetupWebhookWithManager(mgr); err != nil {
+	// Setup how validating webhooks handle the usage of deprecated fields.
+	deprecatedFieldsPolicy := deprecation.WarnPolicy
+	if webhookDenyDeprecatedFields {
+		deprecatedFieldsPolicy = deprecation.DenyPolicy
+	}
+
+	if err := (&bootstrapadmission.KubeadmConfig{DeprecatedFieldsPolicy: deprecatedFieldsPolicy}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "KubeadmConfig")
 		os.Exit(1)
 	}
-	if err := (&bootstrapadmission.KubeadmConfigTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&bootstrapadmission.KubeadmConfigTemplate{DeprecatedFieldsPolicy: deprecatedFieldsPolicy}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "KubeadmConfigTemplate")
 		os.Exit(1)
 	}
@@ -32,7 +32,12 @@ const (
 	// sentinelFileCommand writes a file to /run/cluster-api to signal successful Kubernetes bootstrapping in a way that
 	// works both for Linux and Windows OS.
 	sentinelFileCommand = "echo success > /run/cluster-api/bootstrap-success.complete"
-	cloudConfigHeader   = `## template: jinja
+	// failureFileCommand writes the tail of the cloud-init output, which includes the kubeadm output, to a file in
+	// /run/cluster-api to signal failed Kubernetes bootstrapping, so it can be picked up by a node bootstrap reporter.
+	// Note: this works only for Linux OS, so it is used only if BootstrapFailureLog is set; the command always fails
+	// to preserve the exit status of the failed kubeadm command.
+	failureFileCommand = "{ tail -n 100 /var/log/cloud-init-output.log > /run/cluster-api/bootstrap-failure.log; false; }"
+	cloudConfigHeader  = `## template: jinja
 #cloud-config
 `
 )
@@ -53,7 +58,17 @@ type BaseUserData struct {
 	KubeadmCommand      string
 	KubeadmVerbosity    string
 	SentinelFileCommand string
+	FailureFileCommand  string
 	KubernetesVersion   semver.Version
+
+	// BootstrapFailureLog enables writing the bootstrap output to /run/cluster-api/bootstrap-failure.log
+	// when the kubeadm command fails.
+	BootstrapFailureLog bool
+
+	// GeneratedFiles defines the ownership and permissions of the generated files.
+	GeneratedFiles               bootstrapv1.GeneratedFiles
+	KubeadmConfigFileOwner       string
+	KubeadmConfigFilePermissions string
 }
 
 func (input *BaseUserData) prepare() {
@@ -61,6 +76,16 @@ func (input *BaseUserData) prepare() {
 	input.WriteFiles = append(input.WriteFiles, input.AdditionalFiles...)
 	input.KubeadmCommand = fmt.Sprintf(standardJoinCommand, input.KubeadmVerbosity)
 	input.SentinelFileCommand = sentinelFileCommand
+	input.setFailureFileCommand()
+	input.setKubeadmConfigFileMode()
+}
+
+// setFailureFileCommand sets the command writing the bootstrap failure log, if enabled.
+func (input *BaseUserData) setFailureFileCommand() {
+	input.FailureFileCommand = ""
+	if input.BootstrapFailureLog {
+		input.FailureFileCommand = failureFileCommand
+	}
 }
 
 func generate(kind string, tpl string, data interface{}) ([]byte, error) {
@@ -418,6 +418,33 @@ func TestNewJoinNodeCommands(t *testing.T) {
 	g.Expect(out).To(ContainSubstring(expectedRunCmd))
 }
 
+func TestBootstrapFailureLog(t *testing.T) {
+	g := NewWithT(t)
+
+	initOut, err := NewInitControlPlane(&ControlPlaneInput{
+		BaseUserData: BaseUserData{BootstrapFailureLog: true},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(initOut).To(ContainSubstring(`  - 'kubeadm init --config /run/kubeadm/kubeadm.yaml  && echo success > /run/cluster-api/bootstrap-success.complete || { tail -n 100 /var/log/cloud-init-output.log > /run/cluster-api/bootstrap-failure.log; false; }'`))
+
+	joinOut, err := NewJoinControlPlane(&ControlPlaneJoinInput{
+		BaseUserData: BaseUserData{BootstrapFailureLog: true},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(joinOut).To(ContainSubstring(`  - kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml  && echo success > /run/cluster-api/bootstrap-success.complete || { tail -n 100 /var/log/cloud-init-output.log > /run/cluster-api/bootstrap-failure.log; false; }`))
+
+	nodeOut, err := NewNode(&NodeInput{
+		BaseUserData: BaseUserData{BootstrapFailureLog: true},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(nodeOut).To(ContainSubstring(`  - kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml  && echo success > /run/cluster-api/bootstrap-success.complete || { tail -n 100 /var/log/cloud-init-output.log > /run/cluster-api/bootstrap-failure.log; false; }`))
+
+	// The bootstrap failure log is not written if not enabled, e.g. for Windows machines.
+	nodeOut, err = NewNode(&NodeInput{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(nodeOut).ToNot(ContainSubstring("bootstrap-failure.log"))
+}
+
 func TestOmittableFields(t *testing.T) {
 	tests := []struct {
 		name string
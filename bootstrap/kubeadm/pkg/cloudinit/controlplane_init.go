@@ -24,8 +24,8 @@ const (
 	controlPlaneCloudInit = `{{.Header}}
 {{template "files" .WriteFiles}}
 -   path: /run/kubeadm/kubeadm.yaml
-    owner: root:root
-    permissions: '0640'
+    owner: {{.KubeadmConfigFileOwner}}
+    permissions: '{{.KubeadmConfigFilePermissions}}'
     content: |
       ---
 {{.ClusterConfiguration | Indent 6}}
@@ -38,7 +38,7 @@ const (
 {{- template "boot_commands" .BootCommands }}
 runcmd:
 {{- template "commands" .PreKubeadmCommands }}
-  - 'kubeadm init --config /run/kubeadm/kubeadm.yaml {{.KubeadmVerbosity}} && {{ .SentinelFileCommand }}'
+  - 'kubeadm init --config /run/kubeadm/kubeadm.yaml {{.KubeadmVerbosity}} && {{ .SentinelFileCommand }}{{ with .FailureFileCommand }} || {{ . }}{{ end }}'
 {{- template "commands" .PostKubeadmCommands }}
 {{- template "ntp" .NTP }}
 {{- template "users" .Users }}
@@ -60,9 +60,11 @@ type ControlPlaneInput struct {
 // NewInitControlPlane returns the user data string to be used on a controlplane instance.
 func NewInitControlPlane(input *ControlPlaneInput) ([]byte, error) {
 	input.Header = cloudConfigHeader
-	input.WriteFiles = input.AsFiles()
+	input.WriteFiles = CertificateFiles(input.Certificates, input.GeneratedFiles)
 	input.WriteFiles = append(input.WriteFiles, input.AdditionalFiles...)
 	input.SentinelFileCommand = sentinelFileCommand
+	input.setFailureFileCommand()
+	input.setKubeadmConfigFileMode()
 	userData, err := generate("InitControlplane", controlPlaneCloudInit, input)
 	if err != nil {
 		return nil, err
@@ -26,8 +26,8 @@ const (
 	controlPlaneJoinCloudInit = `{{.Header}}
 {{template "files" .WriteFiles}}
 -   path: /run/kubeadm/kubeadm-join-config.yaml
-    owner: root:root
-    permissions: '0640'
+    owner: {{.KubeadmConfigFileOwner}}
+    permissions: '{{.KubeadmConfigFilePermissions}}'
     content: |
 {{.JoinConfiguration | Indent 6}}
 -   path: /run/cluster-api/placeholder
@@ -37,7 +37,7 @@ const (
 {{- template "boot_commands" .BootCommands }}
 runcmd:
 {{- template "commands" .PreKubeadmCommands }}
-  - {{ .KubeadmCommand }} && {{ .SentinelFileCommand }}
+  - {{ .KubeadmCommand }} && {{ .SentinelFileCommand }}{{ with .FailureFileCommand }} || {{ . }}{{ end }}
 {{- template "commands" .PostKubeadmCommands }}
 {{- template "ntp" .NTP }}
 {{- template "users" .Users }}
@@ -58,7 +58,7 @@ type ControlPlaneJoinInput struct {
 // NewJoinControlPlane returns the user data string to be used on a new control plane instance.
 func NewJoinControlPlane(input *ControlPlaneJoinInput) ([]byte, error) {
 	// TODO: Consider validating that the correct certificates exist. It is different for external/stacked etcd
-	input.WriteFiles = input.AsFiles()
+	input.WriteFiles = CertificateFiles(input.Certificates, input.GeneratedFiles)
 	input.ControlPlane = true
 	input.prepare()
 
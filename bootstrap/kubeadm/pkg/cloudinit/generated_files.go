@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+const (
+	defaultGeneratedFileOwner = "root:root"
+	// defaultKubeadmConfigFilePermissions are the default permissions of the kubeadm configuration file generated for cloud-init.
+	defaultKubeadmConfigFilePermissions = "0640"
+	// cisFilePermissions are the permissions recommended by the CIS Kubernetes Benchmark for the kubeadm configuration
+	// file, the certificates and the private keys.
+	cisFilePermissions = "0600"
+)
+
+// GeneratedFileOwner returns the owner of the files generated by the kubeadm bootstrap provider.
+func GeneratedFileOwner(generatedFiles bootstrapv1.GeneratedFiles) string {
+	if generatedFiles.Owner != "" {
+		return generatedFiles.Owner
+	}
+	return defaultGeneratedFileOwner
+}
+
+// GeneratedFilePermissions returns the permissions of a file generated by the kubeadm bootstrap provider,
+// given the permissions used for the file when no hardening is required.
+func GeneratedFilePermissions(generatedFiles bootstrapv1.GeneratedFiles, defaultPermissions string) string {
+	if generatedFiles.Permissions != "" {
+		return generatedFiles.Permissions
+	}
+	if generatedFiles.Profile == bootstrapv1.CISGeneratedFilesProfile {
+		return cisFilePermissions
+	}
+	return defaultPermissions
+}
+
+// CertificateFiles returns the files for the given certificates, using the ownership and permissions
+// defined for the generated files.
+func CertificateFiles(certificates secret.Certificates, generatedFiles bootstrapv1.GeneratedFiles) []bootstrapv1.File {
+	files := certificates.AsFiles()
+	for i := range files {
+		files[i].Owner = GeneratedFileOwner(generatedFiles)
+		files[i].Permissions = GeneratedFilePermissions(generatedFiles, files[i].Permissions)
+	}
+	return files
+}
+
+// setKubeadmConfigFileMode sets the ownership and permissions of the kubeadm configuration file.
+func (input *BaseUserData) setKubeadmConfigFileMode() {
+	input.KubeadmConfigFileOwner = GeneratedFileOwner(input.GeneratedFiles)
+	input.KubeadmConfigFilePermissions = GeneratedFilePermissions(input.GeneratedFiles, defaultKubeadmConfigFilePermissions)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestGeneratedFiles(t *testing.T) {
+	tests := []struct {
+		name                   string
+		generatedFiles         bootstrapv1.GeneratedFiles
+		expectedOwner          string
+		expectedKubeadmConfig  string
+		expectedCertificate    string
+		expectedCertificateKey string
+	}{
+		{
+			name:                   "defaults",
+			expectedOwner:          "root:root",
+			expectedKubeadmConfig:  "0640",
+			expectedCertificate:    "0640",
+			expectedCertificateKey: "0600",
+		},
+		{
+			name: "CIS profile",
+			generatedFiles: bootstrapv1.GeneratedFiles{
+				Profile: bootstrapv1.CISGeneratedFilesProfile,
+			},
+			expectedOwner:          "root:root",
+			expectedKubeadmConfig:  "0600",
+			expectedCertificate:    "0600",
+			expectedCertificateKey: "0600",
+		},
+		{
+			name: "explicit owner and permissions take precedence over the profile",
+			generatedFiles: bootstrapv1.GeneratedFiles{
+				Profile:     bootstrapv1.CISGeneratedFilesProfile,
+				Owner:       "kube:kube",
+				Permissions: "0400",
+			},
+			expectedOwner:          "kube:kube",
+			expectedKubeadmConfig:  "0400",
+			expectedCertificate:    "0400",
+			expectedCertificateKey: "0400",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cpinput := &ControlPlaneInput{
+				BaseUserData: BaseUserData{
+					GeneratedFiles: tt.generatedFiles,
+				},
+				Certificates: secret.Certificates{
+					&secret.Certificate{
+						Purpose:  secret.ClusterCA,
+						CertFile: "/etc/kubernetes/pki/ca.crt",
+						KeyFile:  "/etc/kubernetes/pki/ca.key",
+						KeyPair: &certs.KeyPair{
+							Cert: []byte("some certificate"),
+							Key:  []byte("some key"),
+						},
+					},
+				},
+				ClusterConfiguration: "my-cluster-config",
+				InitConfiguration:    "my-init-config",
+			}
+
+			out, err := NewInitControlPlane(cpinput)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(string(out)).To(ContainSubstring(`-   path: /etc/kubernetes/pki/ca.crt
+    owner: ` + tt.expectedOwner + `
+    permissions: '` + tt.expectedCertificate + `'`))
+			g.Expect(string(out)).To(ContainSubstring(`-   path: /etc/kubernetes/pki/ca.key
+    owner: ` + tt.expectedOwner + `
+    permissions: '` + tt.expectedCertificateKey + `'`))
+			g.Expect(string(out)).To(ContainSubstring(`-   path: /run/kubeadm/kubeadm.yaml
+    owner: ` + tt.expectedOwner + `
+    permissions: '` + tt.expectedKubeadmConfig + `'`))
+
+			joinInput := &NodeInput{
+				BaseUserData: BaseUserData{
+					GeneratedFiles: tt.generatedFiles,
+				},
+				JoinConfiguration: "my-join-config",
+			}
+			out, err = NewNode(joinInput)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(out)).To(ContainSubstring(`-   path: /run/kubeadm/kubeadm-join-config.yaml
+    owner: ` + tt.expectedOwner + `
+    permissions: '` + tt.expectedKubeadmConfig + `'`))
+		})
+	}
+}
@@ -20,8 +20,8 @@ const (
 	nodeCloudInit = `{{.Header}}
 {{template "files" .WriteFiles}}
 -   path: /run/kubeadm/kubeadm-join-config.yaml
-    owner: root:root
-    permissions: '0640'
+    owner: {{.KubeadmConfigFileOwner}}
+    permissions: '{{.KubeadmConfigFilePermissions}}'
     content: |
       ---
 {{.JoinConfiguration | Indent 6}}
@@ -32,7 +32,7 @@ const (
 {{- template "boot_commands" .BootCommands }}
 runcmd:
 {{- template "commands" .PreKubeadmCommands }}
-  - {{ .KubeadmCommand }} && {{ .SentinelFileCommand }}
+  - {{ .KubeadmCommand }} && {{ .SentinelFileCommand }}{{ with .FailureFileCommand }} || {{ . }}{{ end }}
 {{- template "commands" .PostKubeadmCommands }}
 {{- template "ntp" .NTP }}
 {{- template "users" .Users }}
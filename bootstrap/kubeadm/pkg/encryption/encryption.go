@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements encryption of the bootstrap data generated by the kubeadm bootstrap provider.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// SecretKey is the key of the bootstrap data secret containing the format of the encrypted bootstrap data.
+	// The key is not set if the bootstrap data is not encrypted.
+	SecretKey = "encryption"
+
+	// EnvelopeFormat is the format of bootstrap data encrypted by the envelope Encryptor.
+	EnvelopeFormat = "envelope.v1"
+
+	envelopeVersion = "v1"
+	dataKeySize     = 32
+)
+
+// Encryptor encrypts bootstrap data.
+type Encryptor interface {
+	// Format returns the format of the encrypted data; it is stored in the bootstrap data secret
+	// so consumers know how to decrypt the bootstrap data.
+	Format() string
+
+	// Encrypt encrypts the given data.
+	Encrypt(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// Envelope is the JSON document generated by the envelope Encryptor.
+// The data is encrypted with a random AES-256-GCM data key, and the data key is encrypted
+// with RSA-OAEP-SHA256 using the public key of the encryption provider.
+type Envelope struct {
+	// Version is the version of the envelope.
+	Version string `json:"version"`
+
+	// KeyID is the SHA-256 fingerprint of the public key used to encrypt the data key,
+	// computed on its PKIX, ASN.1 DER form.
+	KeyID string `json:"keyID"`
+
+	// EncryptedKey is the encrypted data key.
+	EncryptedKey []byte `json:"encryptedKey"`
+
+	// Nonce is the nonce used to encrypt the data.
+	Nonce []byte `json:"nonce"`
+
+	// Ciphertext is the encrypted data.
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type envelopeEncryptor struct {
+	publicKey *rsa.PublicKey
+	keyID     string
+}
+
+// NewEnvelopeEncryptor returns an Encryptor using envelope encryption with the given PEM encoded RSA public key.
+func NewEnvelopeEncryptor(publicKeyPEM []byte) (Encryptor, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, pkgerrors.New("failed to decode public key: no PEM data found")
+	}
+
+	var publicKey *rsa.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse public key")
+		}
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, pkgerrors.Errorf("unsupported public key type %T, only RSA public keys are supported", key)
+		}
+		publicKey = rsaKey
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse public key")
+		}
+		publicKey = key
+	default:
+		return nil, pkgerrors.Errorf("unsupported PEM block type %q, expected \"PUBLIC KEY\" or \"RSA PUBLIC KEY\"", block.Type)
+	}
+
+	keyID, err := KeyID(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &envelopeEncryptor{
+		publicKey: publicKey,
+		keyID:     keyID,
+	}, nil
+}
+
+// Format returns the format of the encrypted data.
+func (e *envelopeEncryptor) Format() string {
+	return EnvelopeFormat
+}
+
+// Encrypt encrypts the given data.
+func (e *envelopeEncryptor) Encrypt(_ context.Context, data []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate data key")
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate nonce")
+	}
+
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, e.publicKey, dataKey, nil)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to encrypt data key")
+	}
+
+	envelope, err := json.Marshal(Envelope{
+		Version:      envelopeVersion,
+		KeyID:        e.keyID,
+		EncryptedKey: encryptedKey,
+		Nonce:        nonce,
+		Ciphertext:   gcm.Seal(nil, nonce, data, nil),
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to marshal envelope")
+	}
+	return envelope, nil
+}
+
+// Decrypt decrypts data encrypted by the envelope Encryptor using the given RSA private key.
+// It is intended to be used by infrastructure providers and node agents consuming the bootstrap data.
+func Decrypt(privateKey *rsa.PrivateKey, data []byte) ([]byte, error) {
+	envelope := Envelope{}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to unmarshal envelope")
+	}
+	if envelope.Version != envelopeVersion {
+		return nil, pkgerrors.Errorf("unsupported envelope version %q", envelope.Version)
+	}
+
+	keyID, err := KeyID(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if envelope.KeyID != keyID {
+		return nil, pkgerrors.Errorf("data has been encrypted with key %s, not with key %s", envelope.KeyID, keyID)
+	}
+
+	dataKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, envelope.EncryptedKey, nil)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decrypt data key")
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decrypt data")
+	}
+	return plaintext, nil
+}
+
+// Decrypter decrypts bootstrap data secrets generated by the kubeadm bootstrap provider.
+// It is intended to be used by infrastructure providers and node agents consuming the bootstrap data.
+type Decrypter struct {
+	// privateKeys are the private keys which can be used to decrypt the bootstrap data, indexed by key ID.
+	privateKeys map[string]*rsa.PrivateKey
+}
+
+// NewDecrypter returns a Decrypter using the given PEM encoded RSA private keys.
+// The private key used to decrypt the bootstrap data is picked using the key ID stored in the envelope,
+// so private keys can be rotated by configuring both the old and the new private key.
+func NewDecrypter(privateKeysPEM ...[]byte) (*Decrypter, error) {
+	d := &Decrypter{privateKeys: map[string]*rsa.PrivateKey{}}
+	for _, privateKeyPEM := range privateKeysPEM {
+		privateKey, err := parsePrivateKey(privateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		keyID, err := KeyID(&privateKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		d.privateKeys[keyID] = privateKey
+	}
+	return d, nil
+}
+
+// DecryptSecretData returns the bootstrap data stored in the value key of a bootstrap data secret.
+// If the secret has the encryption key, the bootstrap data is decrypted; a nil Decrypter can only
+// be used for bootstrap data which is not encrypted.
+func (d *Decrypter) DecryptSecretData(secretData map[string][]byte) ([]byte, error) {
+	value, ok := secretData["value"]
+	if !ok {
+		return nil, pkgerrors.New("secret value key is missing")
+	}
+
+	format, encrypted := secretData[SecretKey]
+	if !encrypted {
+		return value, nil
+	}
+	if string(format) != EnvelopeFormat {
+		return nil, pkgerrors.Errorf("unsupported bootstrap data encryption format %q", string(format))
+	}
+
+	envelope := Envelope{}
+	if err := json.Unmarshal(value, &envelope); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to unmarshal envelope")
+	}
+	if d == nil || d.privateKeys[envelope.KeyID] == nil {
+		return nil, pkgerrors.Errorf("bootstrap data has been encrypted with key %s, but no private key is configured for it", envelope.KeyID)
+	}
+	return Decrypt(d.privateKeys[envelope.KeyID], value)
+}
+
+// parsePrivateKey parses a PEM encoded RSA private key.
+func parsePrivateKey(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, pkgerrors.New("failed to decode private key: no PEM data found")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse private key")
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, pkgerrors.Errorf("unsupported private key type %T, only RSA private keys are supported", key)
+		}
+		return rsaKey, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse private key")
+		}
+		return key, nil
+	default:
+		return nil, pkgerrors.Errorf("unsupported PEM block type %q, expected \"PRIVATE KEY\" or \"RSA PRIVATE KEY\"", block.Type)
+	}
+}
+
+// KeyID returns the SHA-256 fingerprint of the given public key.
+func KeyID(publicKey *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", pkgerrors.Wrap(err, "failed to marshal public key")
+	}
+	sum := sha256.Sum256(der)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create GCM")
+	}
+	return gcm, nil
+}
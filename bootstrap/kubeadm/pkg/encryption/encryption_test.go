@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvelopeEncryptor(t *testing.T) {
+	g := NewWithT(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).ToNot(HaveOccurred())
+	pkixDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	pkixPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixDER})
+	pkcs1PEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)})
+
+	data := []byte("#cloud-config\nruncmd:\n- kubeadm init\n")
+
+	t.Run("encrypted data can be decrypted with the private key", func(t *testing.T) {
+		for _, publicKeyPEM := range [][]byte{pkixPEM, pkcs1PEM} {
+			g := NewWithT(t)
+
+			encryptor, err := NewEnvelopeEncryptor(publicKeyPEM)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(encryptor.Format()).To(Equal(EnvelopeFormat))
+
+			encrypted, err := encryptor.Encrypt(context.Background(), data)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(encrypted)).ToNot(ContainSubstring("kubeadm"))
+
+			decrypted, err := Decrypt(privateKey, encrypted)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(decrypted).To(Equal(data))
+		}
+	})
+
+	t.Run("data cannot be decrypted with another private key", func(t *testing.T) {
+		g := NewWithT(t)
+
+		otherPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		encryptor, err := NewEnvelopeEncryptor(pkixPEM)
+		g.Expect(err).ToNot(HaveOccurred())
+		encrypted, err := encryptor.Encrypt(context.Background(), data)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = Decrypt(otherPrivateKey, encrypted)
+		g.Expect(err).To(MatchError(ContainSubstring("data has been encrypted with key")))
+	})
+
+	t.Run("tampered data cannot be decrypted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		encryptor, err := NewEnvelopeEncryptor(pkixPEM)
+		g.Expect(err).ToNot(HaveOccurred())
+		encrypted, err := encryptor.Encrypt(context.Background(), data)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		envelope := Envelope{}
+		g.Expect(json.Unmarshal(encrypted, &envelope)).To(Succeed())
+		envelope.Ciphertext[0] ^= 0xff
+		tampered, err := json.Marshal(envelope)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = Decrypt(privateKey, tampered)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to decrypt data")))
+	})
+
+	t.Run("fails with invalid public keys", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewEnvelopeEncryptor([]byte("not a PEM"))
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = NewEnvelopeEncryptor(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pkixDER}))
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestDecrypter(t *testing.T) {
+	g := NewWithT(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).ToNot(HaveOccurred())
+	pkixDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	pkcs8PEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER})
+
+	otherPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).ToNot(HaveOccurred())
+	otherPKCS1PEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(otherPrivateKey)})
+
+	data := []byte("#cloud-config\nruncmd:\n- kubeadm init\n")
+	encryptor, err := NewEnvelopeEncryptor(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixDER}))
+	g.Expect(err).ToNot(HaveOccurred())
+	encrypted, err := encryptor.Encrypt(context.Background(), data)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	t.Run("returns data which is not encrypted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var decrypter *Decrypter
+		got, err := decrypter.DecryptSecretData(map[string][]byte{"value": data})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(data))
+	})
+
+	t.Run("decrypts data with the private key matching the key ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		decrypter, err := NewDecrypter(otherPKCS1PEM, pkcs8PEM)
+		g.Expect(err).ToNot(HaveOccurred())
+		got, err := decrypter.DecryptSecretData(map[string][]byte{"value": encrypted, SecretKey: []byte(EnvelopeFormat)})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(data))
+	})
+
+	t.Run("fails if no private key matches the key ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		decrypter, err := NewDecrypter(otherPKCS1PEM)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = decrypter.DecryptSecretData(map[string][]byte{"value": encrypted, SecretKey: []byte(EnvelopeFormat)})
+		g.Expect(err).To(MatchError(ContainSubstring("no private key is configured")))
+
+		var nilDecrypter *Decrypter
+		_, err = nilDecrypter.DecryptSecretData(map[string][]byte{"value": encrypted, SecretKey: []byte(EnvelopeFormat)})
+		g.Expect(err).To(MatchError(ContainSubstring("no private key is configured")))
+	})
+
+	t.Run("fails with unsupported formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		decrypter, err := NewDecrypter(pkcs8PEM)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = decrypter.DecryptSecretData(map[string][]byte{"value": encrypted, SecretKey: []byte("envelope.v2")})
+		g.Expect(err).To(MatchError(ContainSubstring("unsupported bootstrap data encryption format")))
+	})
+
+	t.Run("fails with invalid private keys", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewDecrypter([]byte("not a PEM"))
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = NewDecrypter(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixDER}))
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -25,6 +25,10 @@ limitations under the License.
 // reboot. This is to align the implementation with cloud-init, which places kubeadm configuration in
 // /tmp directory directly, which is not possible with Ignition.
 //
+// If the bootstrap failure log is enabled and the kubeadm command fails, the last lines of the kubeadm.service
+// unit logs are written to the /run/cluster-api/bootstrap-failure.log file, so they can be picked up by a node
+// bootstrap reporter.
+//
 // /etc/kubeadm.yml file contains generated kubeadm configuration and can be customized using pre kubeadm
 // commands if needed, as a replacement for Jinja templates supported by cloud-init, for example
 // using 'envsubst' or 'sed'.
@@ -39,6 +43,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 	"text/template"
 
@@ -64,7 +69,7 @@ passwd:
       {{- end }}
       {{- if .Groups }}
       groups:
-        {{- range Split .Groups ", " }}
+        {{- range SplitList .Groups }}
         - {{ . }}
         {{- end }}
       {{- end }}
@@ -104,25 +109,26 @@ systemd:
         ExecStart=/etc/kubeadm.sh
         [Install]
         WantedBy=multi-user.target
-    {{- if .NTP }}{{ if .NTP.Enabled }}
+    {{- if .NTPEnabled }}
     - name: ntpd.service
       enabled: true
-    {{- end }}{{- end }}
-    {{- range .Mounts }}
-    {{- $label := index . 0 }}
-    {{- $mountpoint := index . 1 }}
-    {{- $disk := index $.FilesystemDevicesByLabel $label }}
-    {{- $mountOptions := slice . 2 }}
-    - name: {{ $mountpoint | MountpointName }}.mount
+    {{- end }}
+    {{- range .MountUnits }}
+    - name: {{ .Name }}
       enabled: true
       contents: |
         [Unit]
-        Description = Mount {{ $label }}
+        Description = Mount {{ .Description }}
 
         [Mount]
-        What={{ $disk }}
-        Where={{ $mountpoint }}
-        Options={{ Join $mountOptions "," }}
+        What={{ .What }}
+        Where={{ .Where }}
+        {{- with .Type }}
+        Type={{ . }}
+        {{- end }}
+        {{- with .Options }}
+        Options={{ . }}
+        {{- end }}
 
         [Install]
         WantedBy=multi-user.target
@@ -141,23 +147,27 @@ storage:
       {{- end }}
     {{- end }}
   {{- end }}{{- end }}
-  {{- if .DiskSetup }}{{- if .DiskSetup.Filesystems }}
+  {{- if .Filesystems }}
   filesystems:
-    {{- range .DiskSetup.Filesystems }}
-    - name: {{ .Label }}
+    {{- range .Filesystems }}
+    - name: {{ .Name }}
       mount:
         device: {{ .Device }}
-        format: {{ .Filesystem }}
-        wipe_filesystem: {{ .Overwrite }}
-        label: {{ .Label }}
-        {{- if .ExtraOpts }}
+        format: {{ .Format }}
+        {{- with .WipeFilesystem }}
+        wipe_filesystem: {{ . }}
+        {{- end }}
+        {{- with .Label }}
+        label: {{ . }}
+        {{- end }}
+        {{- if .Options }}
         options:
-          {{- range .ExtraOpts }}
+          {{- range .Options }}
           - {{ . }}
           {{- end }}
         {{- end }}
     {{- end }}
-  {{- end }}{{- end }}
+  {{- end }}
   files:
     {{- range .Users }}
     {{- if .Sudo }}
@@ -217,19 +227,37 @@ storage:
           {{ . | Indent 10 }}
           {{- end }}
 
+          {{ if .BootstrapFailureLog -}}
+          if ! {{ .KubeadmCommand }}; then
+            mkdir -p /run/cluster-api && journalctl --unit kubeadm.service --no-pager --lines 100 > /run/cluster-api/bootstrap-failure.log
+            exit 1
+          fi
+          {{- else -}}
           {{ .KubeadmCommand }}
+          {{- end }}
           mkdir -p /run/cluster-api && echo success > /run/cluster-api/bootstrap-success.complete
           mv /etc/kubeadm.yml /tmp/
           {{range .PostKubeadmCommands }}
           {{ . | Indent 10 }}
           {{- end }}
     - path: /etc/kubeadm.yml
-      mode: 0600
+      {{- with .GeneratedFiles.Owner }}
+      {{- $owner := ParseOwner . }}
+      {{- if $owner.User }}
+      user:
+        name: {{ $owner.User }}
+      {{- end }}
+      {{- if $owner.Group }}
+      group:
+        name: {{ $owner.Group }}
+      {{- end }}
+      {{- end }}
+      mode: {{ .KubeadmConfigFilePermissions }}
       contents:
         inline: |
           ---
           {{ .KubeadmConfig | Indent 10 }}
-    {{- if .NTP }}{{- if and .NTP.Enabled .NTP.Servers }}
+    {{- if and .NTPEnabled .NTP.Servers }}
     - path: /etc/ntp.conf
       mode: 0644
       contents:
@@ -252,30 +280,181 @@ storage:
           restrict default nomodify nopeer noquery notrap limited kod
           restrict 127.0.0.1
           restrict [::1]
-    {{- end }}{{- end }}
+    {{- end }}
 `
+
+	// kubeadmConfigFilePermissions are the default permissions of the kubeadm configuration file.
+	kubeadmConfigFilePermissions = "0600"
 )
 
 type render struct {
 	*cloudinit.BaseUserData
 
-	KubeadmConfig            string
-	UsersWithPasswordAuth    string
-	FilesystemDevicesByLabel map[string]string
+	KubeadmConfig         string
+	UsersWithPasswordAuth string
+	NTPEnabled            bool
+	Filesystems           []filesystem
+	MountUnits            []mountUnit
+}
+
+// filesystem is a filesystem to be created, translated from the KubeadmConfigSpec diskSetup.filesystems field.
+type filesystem struct {
+	Name           string
+	Device         string
+	Format         string
+	Label          string
+	WipeFilesystem *bool
+	Options        []string
+}
+
+// mountUnit is a systemd mount unit, translated from an entry of the KubeadmConfigSpec mounts field.
+type mountUnit struct {
+	Name        string
+	Description string
+	What        string
+	Where       string
+	Type        string
+	Options     string
 }
 
 func defaultTemplateFuncMap() template.FuncMap {
 	return template.FuncMap{
-		"Indent":         templateYAMLIndent,
-		"Split":          strings.Split,
-		"Join":           strings.Join,
-		"MountpointName": mountpointName,
-		"ParseOwner":     parseOwner,
+		"Indent":     templateYAMLIndent,
+		"SplitList":  splitList,
+		"ParseOwner": parseOwner,
+	}
+}
+
+// splitList splits a comma separated list, e.g. the groups of a user, trimming spaces and dropping empty items.
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// systemdEscapePath escapes a path the same way as systemd-escape --path does,
+// e.g. to compute the name of the mount unit for a mount point.
+func systemdEscapePath(p string) string {
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == "" {
+		return "-"
+	}
+
+	var b strings.Builder
+	for i := range len(p) {
+		c := p[i]
+		switch {
+		case c == '/':
+			b.WriteByte('-')
+		case c == '.' && (i == 0 || p[i-1] == '/'):
+			fmt.Fprintf(&b, `\x%02x`, c)
+		case c == ':' || c == '_' || c == '.' ||
+			(c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		}
+	}
+	return b.String()
+}
+
+// filesystemDevice returns the device to create a filesystem on, taking into account
+// the partition to use, following the same conventions as cloud-init.
+func filesystemDevice(fs bootstrapv1.Filesystem) string {
+	if fs.Partition == "" || fs.Partition == "none" {
+		return fs.Device
+	}
+	switch {
+	// Devices like /dev/disk/by-id/foo have partitions like /dev/disk/by-id/foo-part1.
+	case strings.HasPrefix(fs.Device, "/dev/disk/"):
+		return fs.Device + "-part" + fs.Partition
+	// Devices like /dev/nvme0n1 have partitions like /dev/nvme0n1p1.
+	case fs.Device != "" && fs.Device[len(fs.Device)-1] >= '0' && fs.Device[len(fs.Device)-1] <= '9':
+		return fs.Device + "p" + fs.Partition
+	default:
+		return fs.Device + fs.Partition
+	}
+}
+
+// filesystems translates the KubeadmConfigSpec diskSetup.filesystems field into filesystems to be created.
+func filesystems(diskSetup *bootstrapv1.DiskSetup) []filesystem {
+	if diskSetup == nil {
+		return nil
+	}
+
+	fss := make([]filesystem, 0, len(diskSetup.Filesystems))
+	for _, fs := range diskSetup.Filesystems {
+		device := filesystemDevice(fs)
+		f := filesystem{
+			Name:           systemdEscapePath(device),
+			Device:         device,
+			Format:         fs.Filesystem,
+			WipeFilesystem: fs.Overwrite,
+			Options:        fs.ExtraOpts,
+		}
+		// Note: cloud-init does not set a label if it is set to None.
+		if fs.Label != "" && fs.Label != "None" {
+			f.Name = fs.Label
+			f.Label = fs.Label
+		}
+		fss = append(fss, f)
 	}
+	return fss
 }
 
-func mountpointName(name string) string {
-	return strings.TrimPrefix(strings.ReplaceAll(name, "/", "-"), "-")
+// mountUnits translates the KubeadmConfigSpec mounts field into systemd mount units.
+// Mount entries follow the cloud-init format, i.e. [fs_spec, fs_file, fs_vfstype, fs_mntops],
+// where fs_spec is a device, a filesystem label defined in diskSetup.filesystems, LABEL=<label> or UUID=<uuid>.
+func mountUnits(mounts []bootstrapv1.MountPoints, fss []filesystem) []mountUnit {
+	devicesByLabel := map[string]string{}
+	for _, fs := range fss {
+		if fs.Label != "" {
+			devicesByLabel[fs.Label] = fs.Device
+		}
+	}
+
+	units := make([]mountUnit, 0, len(mounts))
+	for _, mount := range mounts {
+		// Note: mounts with less than two entries are rejected by the KubeadmConfig webhook.
+		if len(mount) < 2 {
+			continue
+		}
+		unit := mountUnit{
+			Name:        systemdEscapePath(mount[1]) + ".mount",
+			Description: mount[0],
+			What:        mountDevice(mount[0], devicesByLabel),
+			Where:       mount[1],
+		}
+		if len(mount) > 2 && mount[2] != "auto" {
+			unit.Type = mount[2]
+		}
+		if len(mount) > 3 {
+			unit.Options = mount[3]
+		}
+		units = append(units, unit)
+	}
+	return units
+}
+
+// mountDevice returns the device for the fs_spec of a mount entry.
+func mountDevice(spec string, devicesByLabel map[string]string) string {
+	if device, ok := devicesByLabel[spec]; ok {
+		return device
+	}
+	switch {
+	case strings.HasPrefix(spec, "/"):
+		return spec
+	case strings.HasPrefix(spec, "LABEL="):
+		return "/dev/disk/by-label/" + strings.TrimPrefix(spec, "LABEL=")
+	case strings.HasPrefix(spec, "UUID="):
+		return "/dev/disk/by-uuid/" + strings.TrimPrefix(spec, "UUID=")
+	default:
+		return "/dev/" + spec
+	}
 }
 
 func templateYAMLIndent(i int, input string) string {
@@ -328,18 +507,19 @@ func renderCLC(input *cloudinit.BaseUserData, kubeadmConfig string) ([]byte, err
 		}
 	}
 
-	filesystemDevicesByLabel := map[string]string{}
-	if input.DiskSetup != nil {
-		for _, filesystem := range input.DiskSetup.Filesystems {
-			filesystemDevicesByLabel[filesystem.Label] = filesystem.Device
-		}
-	}
+	fss := filesystems(input.DiskSetup)
+
+	// Note: differently from cloud-init, the kubeadm configuration file is readable only by its owner by default.
+	input.KubeadmConfigFilePermissions = cloudinit.GeneratedFilePermissions(input.GeneratedFiles, kubeadmConfigFilePermissions)
 
 	data := render{
-		BaseUserData:             input,
-		KubeadmConfig:            kubeadmConfig,
-		UsersWithPasswordAuth:    strings.Join(usersWithPasswordAuth, ","),
-		FilesystemDevicesByLabel: filesystemDevicesByLabel,
+		BaseUserData:          input,
+		KubeadmConfig:         kubeadmConfig,
+		UsersWithPasswordAuth: strings.Join(usersWithPasswordAuth, ","),
+		// Note: unlike cloud-init, NTP is enabled only if explicitly enabled, to preserve the output for existing configs.
+		NTPEnabled:  input.NTP != nil && ptr.Deref(input.NTP.Enabled, false),
+		Filesystems: fss,
+		MountUnits:  mountUnits(input.Mounts, fss),
 	}
 
 	var out bytes.Buffer
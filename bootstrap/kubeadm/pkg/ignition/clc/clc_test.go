@@ -18,6 +18,7 @@ limitations under the License.
 package clc_test
 
 import (
+	"strings"
 	"testing"
 
 	ignition "github.com/flatcar/ignition/config/v2_3"
@@ -119,7 +120,7 @@ func TestRender(t *testing.T) {
 				},
 				Mounts: []bootstrapv1.MountPoints{
 					{
-						"test_disk", "/var/lib/testdir", "foo",
+						"test_disk", "/var/lib/testdir", "ext4", "defaults,nofail",
 					},
 				},
 				WriteFiles: []bootstrapv1.File{
@@ -257,7 +258,7 @@ func TestRender(t *testing.T) {
 							Name:    "ntpd.service",
 						},
 						{
-							Contents: "[Unit]\nDescription = Mount test_disk\n\n[Mount]\nWhat=/dev/disk/azure/scsi1/lun0\nWhere=/var/lib/testdir\nOptions=foo\n\n[Install]\nWantedBy=multi-user.target\n",
+							Contents: "[Unit]\nDescription = Mount test_disk\n\n[Mount]\nWhat=/dev/disk/azure/scsi1/lun0\nWhere=/var/lib/testdir\nType=ext4\nOptions=defaults,nofail\n\n[Install]\nWantedBy=multi-user.target\n",
 							Enabled:  ptr.To(true),
 							Name:     "var-lib-testdir.mount",
 						},
@@ -347,6 +348,94 @@ func TestRender(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "disk setup and mounts translated like cloud-init, ntp not enabled if enabled is not set",
+			input: &cloudinit.BaseUserData{
+				PreKubeadmCommands:  preKubeadmCommands,
+				PostKubeadmCommands: postKubeadmCommands,
+				KubeadmCommand:      "kubeadm join",
+				NTP:                 &bootstrapv1.NTP{},
+				DiskSetup: &bootstrapv1.DiskSetup{
+					Filesystems: []bootstrapv1.Filesystem{
+						{
+							Device:     "/dev/nvme1n1",
+							Partition:  "1",
+							Filesystem: "xfs",
+							Label:      "None",
+						},
+					},
+				},
+				Mounts: []bootstrapv1.MountPoints{
+					{
+						"/dev/nvme1n1p1", "/var/lib/foo-bar",
+					},
+					{
+						"LABEL=data", "/mnt/data", "auto", "defaults",
+					},
+				},
+			},
+			wantIgnition: types.Config{
+				Ignition: types.Ignition{
+					Version: "2.3.0",
+				},
+				Storage: types.Storage{
+					Files: []types.File{
+						{
+							Node: types.Node{
+								Filesystem: "root",
+								Path:       "/etc/kubeadm.sh",
+							},
+							FileEmbedded1: types.FileEmbedded1{
+								Contents: types.FileContents{
+									Source: "data:,%23!%2Fbin%2Fbash%0Aset%20-e%0A%0Apre-command%0Aanother-pre-command%0Acat%20%3C%3CEOF%20%3E%20%2Fetc%2Fmodules-load.d%2Fcontainerd.conf%0Aoverlay%0Abr_netfilter%0AEOF%0A%0A%0Akubeadm%20join%0Amkdir%20-p%20%2Frun%2Fcluster-api%20%26%26%20echo%20success%20%3E%20%2Frun%2Fcluster-api%2Fbootstrap-success.complete%0Amv%20%2Fetc%2Fkubeadm.yml%20%2Ftmp%2F%0A%0Apost-kubeadm-command%0Aanother-post-kubeamd-command%0Acat%20%3C%3CEOF%20%3E%20%2Fetc%2Fmodules-load.d%2Fcontainerd.conf%0Aoverlay%0Abr_netfilter%0AEOF%0A",
+								},
+								Mode: ptr.To(448),
+							},
+						},
+						{
+							Node: types.Node{
+								Filesystem: "root",
+								Path:       "/etc/kubeadm.yml",
+							},
+							FileEmbedded1: types.FileEmbedded1{
+								Contents: types.FileContents{
+									Source: "data:,---%0Afoo%0A",
+								},
+								Mode: ptr.To(384),
+							},
+						},
+					},
+					Filesystems: []types.Filesystem{
+						{
+							Mount: &types.Mount{
+								Device: "/dev/nvme1n1p1",
+								Format: "xfs",
+							},
+							Name: "dev-nvme1n1p1",
+						},
+					},
+				},
+				Systemd: types.Systemd{
+					Units: []types.Unit{
+						{
+							Contents: "[Unit]\nDescription=kubeadm\n# Run only once. After successful run, this file is moved to /tmp/.\nConditionPathExists=/etc/kubeadm.yml\nAfter=network.target\n[Service]\n# To not restart the unit when it exits, as it is expected.\nType=oneshot\nExecStart=/etc/kubeadm.sh\n[Install]\nWantedBy=multi-user.target\n",
+							Enabled:  ptr.To(true),
+							Name:     "kubeadm.service",
+						},
+						{
+							Contents: "[Unit]\nDescription = Mount /dev/nvme1n1p1\n\n[Mount]\nWhat=/dev/nvme1n1p1\nWhere=/var/lib/foo-bar\n\n[Install]\nWantedBy=multi-user.target\n",
+							Enabled:  ptr.To(true),
+							Name:     `var-lib-foo\x2dbar.mount`,
+						},
+						{
+							Contents: "[Unit]\nDescription = Mount LABEL=data\n\n[Mount]\nWhat=/dev/disk/by-label/data\nWhere=/mnt/data\nOptions=defaults\n\n[Install]\nWantedBy=multi-user.target\n",
+							Enabled:  ptr.To(true),
+							Name:     "mnt-data.mount",
+						},
+					},
+				},
+			},
+		},
 		{
 			desc: "base64 encoded content",
 			input: &cloudinit.BaseUserData{
@@ -647,4 +736,26 @@ func TestRender(t *testing.T) {
 			t.Errorf("expected data to be returned on config with warnings")
 		}
 	})
+
+	t.Run("writes the bootstrap failure log only if enabled", func(t *testing.T) {
+		t.Parallel()
+
+		failureLog := "%2Frun%2Fcluster-api%2Fbootstrap-failure.log"
+
+		data, _, err := clc.Render(&cloudinit.BaseUserData{KubeadmCommand: "kubeadm join"}, nil, "foo")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Contains(string(data), failureLog) {
+			t.Errorf("expected bootstrap failure log not to be written by default")
+		}
+
+		data, _, err = clc.Render(&cloudinit.BaseUserData{KubeadmCommand: "kubeadm join", BootstrapFailureLog: true}, nil, "foo")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(string(data), "if%20!%20kubeadm%20join%3B%20then") || !strings.Contains(string(data), failureLog) {
+			t.Errorf("expected bootstrap failure log to be written if enabled, got %s", data)
+		}
+	})
 }
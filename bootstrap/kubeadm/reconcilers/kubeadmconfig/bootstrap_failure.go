@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// bootstrapFailureOutputMaxLines is the max number of lines of the kubeadm output surfaced in the BootstrapFailed condition.
+	bootstrapFailureOutputMaxLines = 5
+
+	// bootstrapFailureOutputMaxLength is the max length in bytes of the kubeadm output surfaced in the BootstrapFailed condition.
+	bootstrapFailureOutputMaxLength = 512
+)
+
+// reconcileBootstrapFailure surfaces in the BootstrapFailed condition the kubeadm output reported by a node bootstrap
+// reporter for a KubeadmConfig whose Machine did not join the cluster yet; the condition contains only a truncated
+// summary of the output, the full output is left in the report ConfigMap.
+// Note: MachinePools are not supported, because a KubeadmConfig is shared by all the MachinePool instances.
+func (r *Reconciler) reconcileBootstrapFailure(ctx context.Context, scope *Scope) error {
+	if scope.ConfigOwner.IsMachinePool() {
+		return nil
+	}
+
+	if scope.ConfigOwner.HasNodeRefs() {
+		conditions.Delete(scope.Config, bootstrapv1.KubeadmConfigBootstrapFailedCondition)
+		return nil
+	}
+
+	report := &corev1.ConfigMap{}
+	reportKey := client.ObjectKey{Namespace: scope.Config.Namespace, Name: bootstrapFailureReportName(scope.Config.Name)}
+	// Note: The report is read from the cache, which contains only ConfigMaps with the cluster name label.
+	if err := r.SecretCachingClient.Get(ctx, reportKey, report); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return pkgerrors.Wrapf(err, "failed to get bootstrap failure report ConfigMap %s", reportKey.Name)
+	}
+
+	if !conditions.IsTrue(scope.Config, bootstrapv1.KubeadmConfigBootstrapFailedCondition) {
+		scope.Info("Bootstrap failure reported for the machine", "ConfigMap", klog.KObj(report))
+	}
+	conditions.Set(scope.Config, metav1.Condition{
+		Type:    bootstrapv1.KubeadmConfigBootstrapFailedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  bootstrapv1.KubeadmConfigBootstrapFailedReason,
+		Message: bootstrapFailureMessage(reportKey.Name, report.Data[bootstrapv1.KubeadmConfigBootstrapFailureReportOutputKey]),
+	})
+	return nil
+}
+
+// bootstrapFailureReportToKubeadmConfig enqueues a request for the KubeadmConfig a bootstrap failure report ConfigMap
+// is named after.
+func (r *Reconciler) bootstrapFailureReportToKubeadmConfig(_ context.Context, o client.Object) []ctrl.Request {
+	configName, ok := strings.CutSuffix(o.GetName(), bootstrapv1.KubeadmConfigBootstrapFailureReportSuffix)
+	if !ok || configName == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: o.GetNamespace(), Name: configName}}}
+}
+
+// bootstrapFailureReportName returns the name of the bootstrap failure report ConfigMap for a KubeadmConfig.
+func bootstrapFailureReportName(configName string) string {
+	return configName + bootstrapv1.KubeadmConfigBootstrapFailureReportSuffix
+}
+
+// bootstrapFailureMessage returns the condition message for the given kubeadm output reported in the given ConfigMap,
+// keeping only the last lines which usually contain the error that made kubeadm fail.
+func bootstrapFailureMessage(reportName, output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return "kubeadm failed, no output reported"
+	}
+	if len(lines) > bootstrapFailureOutputMaxLines {
+		lines = lines[len(lines)-bootstrapFailureOutputMaxLines:]
+	}
+	summary := strings.Join(lines, "\n")
+	if len(summary) > bootstrapFailureOutputMaxLength {
+		// Keep the end of the output, starting at a rune boundary.
+		start := len(summary) - bootstrapFailureOutputMaxLength
+		for start < len(summary) && !utf8.RuneStart(summary[start]) {
+			start++
+		}
+		summary = "..." + summary[start:]
+	}
+	return fmt.Sprintf("kubeadm failed, last lines of output (see ConfigMap %s for the full output):\n%s", reportName, summary)
+}
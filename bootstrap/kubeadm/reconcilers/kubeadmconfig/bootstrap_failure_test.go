@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmconfig
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestReconcileBootstrapFailure(t *testing.T) {
+	report := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cfg-bootstrap-failure",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Data: map[string]string{
+			bootstrapv1.KubeadmConfigBootstrapFailureReportOutputKey: "[preflight] Running pre-flight checks\nerror execution phase preflight: couldn't validate the identity of the API Server\n",
+		},
+	}
+	bootstrapFailedCondition := metav1.Condition{
+		Type:   bootstrapv1.KubeadmConfigBootstrapFailedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: bootstrapv1.KubeadmConfigBootstrapFailedReason,
+	}
+
+	tests := []struct {
+		name            string
+		hasNodeRef      bool
+		report          *corev1.ConfigMap
+		condition       *metav1.Condition
+		expectCondition bool
+		expectMessage   string
+	}{
+		{
+			name:            "no-op if no failure has been reported",
+			expectCondition: false,
+		},
+		{
+			name:            "surfaces the kubeadm output reported for the machine",
+			report:          report,
+			expectCondition: true,
+			expectMessage:   "kubeadm failed, last lines of output (see ConfigMap cfg-bootstrap-failure for the full output):\n[preflight] Running pre-flight checks\nerror execution phase preflight: couldn't validate the identity of the API Server",
+		},
+		{
+			name:            "removes the condition when the machine has a node",
+			hasNodeRef:      true,
+			report:          report,
+			condition:       &bootstrapFailedCondition,
+			expectCondition: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := builder.Machine(metav1.NamespaceDefault, "m1").WithClusterName("cluster1").Build()
+			if tt.hasNodeRef {
+				machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "node1"}
+			}
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(machine)
+			g.Expect(err).ToNot(HaveOccurred())
+			configOwner := &ConfigOwner{&unstructured.Unstructured{Object: content}}
+
+			config := newKubeadmConfig(metav1.NamespaceDefault, "cfg")
+			if tt.condition != nil {
+				conditions.Set(config, *tt.condition)
+			}
+
+			objects := []client.Object{config}
+			if tt.report != nil {
+				objects = append(objects, tt.report)
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+			r := &Reconciler{
+				Client:              fakeClient,
+				SecretCachingClient: fakeClient,
+			}
+			scope := &Scope{
+				Logger:      ctrl.LoggerFrom(ctx),
+				Config:      config,
+				ConfigOwner: configOwner,
+			}
+
+			g.Expect(r.reconcileBootstrapFailure(ctx, scope)).To(Succeed())
+
+			c := conditions.Get(config, bootstrapv1.KubeadmConfigBootstrapFailedCondition)
+			if !tt.expectCondition {
+				g.Expect(c).To(BeNil())
+				return
+			}
+			g.Expect(c).ToNot(BeNil())
+			g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+			g.Expect(c.Reason).To(Equal(bootstrapv1.KubeadmConfigBootstrapFailedReason))
+			g.Expect(c.Message).To(Equal(tt.expectMessage))
+		})
+	}
+}
+
+func TestBootstrapFailureMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(bootstrapFailureMessage("cfg-bootstrap-failure", "")).To(Equal("kubeadm failed, no output reported"))
+
+	lines := make([]string, 0, bootstrapFailureOutputMaxLines+5)
+	for i := range bootstrapFailureOutputMaxLines + 5 {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	g.Expect(bootstrapFailureMessage("cfg-bootstrap-failure", strings.Join(lines, "\n"))).To(Equal(
+		"kubeadm failed, last lines of output (see ConfigMap cfg-bootstrap-failure for the full output):\n" + strings.Join(lines[5:], "\n")))
+
+	// Long output is truncated at a rune boundary, keeping the end of the output.
+	message := bootstrapFailureMessage("cfg-bootstrap-failure", strings.Repeat("é", bootstrapFailureOutputMaxLength)+"error")
+	summary := strings.SplitN(message, "\n", 2)[1]
+	g.Expect(utf8.ValidString(summary)).To(BeTrue())
+	g.Expect(summary).To(HavePrefix("..."))
+	g.Expect(summary).To(HaveSuffix("error"))
+	g.Expect(len(summary)).To(BeNumerically("<=", len("...")+bootstrapFailureOutputMaxLength))
+}
+
+func TestBootstrapFailureReportToKubeadmConfig(t *testing.T) {
+	r := &Reconciler{}
+
+	tests := []struct {
+		name   string
+		object client.Object
+		want   []ctrl.Request
+	}{
+		{
+			name: "bootstrap failure report",
+			object: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Name:      "cfg-bootstrap-failure",
+				Namespace: metav1.NamespaceDefault,
+			}},
+			want: []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cfg"}}},
+		},
+		{
+			name: "other ConfigMap",
+			object: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Name:      "cfg",
+				Namespace: metav1.NamespaceDefault,
+			}},
+			want: nil,
+		},
+		{
+			name: "ConfigMap named as the suffix",
+			object: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Name:      bootstrapv1.KubeadmConfigBootstrapFailureReportSuffix,
+				Namespace: metav1.NamespaceDefault,
+			}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(r.bootstrapFailureReportToKubeadmConfig(ctx, tt.object)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmconfig
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pkgerrors "github.com/pkg/errors"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+const (
+	// containerdCRIPlugin is the name of the CRI plugin in the containerd configuration version 2 format.
+	containerdCRIPlugin = `plugins."io.containerd.grpc.v1.cri"`
+
+	// containerdHostsDir is the name of the directory, next to the containerd configuration file, containing
+	// a <registry>/hosts.toml file for each registry with mirrors.
+	containerdHostsDir = "certs.d"
+)
+
+// renderContainerdFiles renders the containerd configuration defined for the given file, and a hosts.toml file
+// for each of its registry mirrors, substituting the template variables in their values with the given data.
+// Note: Mirrors are configured using config_path and hosts.toml files, because the registry.mirrors section
+// of the CRI plugin configuration is deprecated and it is not supported by containerd 2.x.
+func renderContainerdFiles(file bootstrapv1.File, data map[string]interface{}) ([]bootstrapv1.File, error) {
+	source := file.ContentFrom.Containerd
+	hostsDir := path.Join(path.Dir(file.Path), containerdHostsDir)
+
+	var sb strings.Builder
+	sb.WriteString("version = 2\n")
+
+	if source.SandboxImage != "" {
+		sandboxImage, err := renderContainerdValue(file.Path, source.SandboxImage, data)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to render sandboxImage")
+		}
+		fmt.Fprintf(&sb, "\n[%s]\n  sandbox_image = %s\n", containerdCRIPlugin, strconv.Quote(sandboxImage))
+	}
+
+	hostsFiles := make([]bootstrapv1.File, 0, len(source.RegistryMirrors))
+	registries := map[string]struct{}{}
+	for _, mirror := range source.RegistryMirrors {
+		registry, err := renderContainerdValue(file.Path, mirror.Registry, data)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to render registry %q", mirror.Registry)
+		}
+		if err := validateContainerdRegistry(registry); err != nil {
+			return nil, pkgerrors.Wrapf(err, "invalid registry %q", registry)
+		}
+		if _, ok := registries[registry]; ok {
+			return nil, pkgerrors.Errorf("registry %q is defined more than once", registry)
+		}
+		registries[registry] = struct{}{}
+
+		var hosts strings.Builder
+		for _, endpoint := range mirror.Endpoints {
+			rendered, err := renderContainerdValue(file.Path, endpoint, data)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to render endpoint %q of registry %q", endpoint, mirror.Registry)
+			}
+			if err := validateContainerdMirrorEndpoint(rendered); err != nil {
+				return nil, pkgerrors.Wrapf(err, "invalid endpoint %q of registry %q", rendered, mirror.Registry)
+			}
+			if hosts.Len() > 0 {
+				hosts.WriteString("\n")
+			}
+			fmt.Fprintf(&hosts, "[host.%s]\n  capabilities = [\"pull\", \"resolve\"]\n", strconv.Quote(rendered))
+		}
+		hostsFiles = append(hostsFiles, bootstrapv1.File{
+			Path:        path.Join(hostsDir, registry, "hosts.toml"),
+			Owner:       file.Owner,
+			Permissions: file.Permissions,
+			Content:     hosts.String(),
+		})
+	}
+	if len(hostsFiles) > 0 {
+		fmt.Fprintf(&sb, "\n[%s.registry]\n  config_path = %s\n", containerdCRIPlugin, strconv.Quote(hostsDir))
+	}
+
+	file.ContentFrom = bootstrapv1.FileSource{}
+	file.Content = sb.String()
+	return append([]bootstrapv1.File{file}, hostsFiles...), nil
+}
+
+// renderContainerdValue renders a value of the containerd configuration, ensuring it can be written as a TOML string.
+// Referencing a missing template variable, e.g. a label not set on the Cluster, is an error.
+func renderContainerdValue(path, value string, data map[string]interface{}) (string, error) {
+	rendered, err := renderTemplate(path, value, data, "missingkey=error")
+	if err != nil {
+		return "", err
+	}
+	if rendered == "" {
+		return "", pkgerrors.New("value must not be empty")
+	}
+	if !utf8.ValidString(rendered) || strings.IndexFunc(rendered, unicode.IsControl) >= 0 {
+		return "", pkgerrors.Errorf("value %q must not contain invalid or control characters", rendered)
+	}
+	return rendered, nil
+}
+
+// validateContainerdRegistry validates a registry host, which is used as the name of the directory of its hosts.toml file.
+func validateContainerdRegistry(registry string) error {
+	if registry == "." || registry == ".." || strings.ContainsAny(registry, "/\\") {
+		return pkgerrors.New("registry must be a host, optionally with a port")
+	}
+	return nil
+}
+
+// validateContainerdMirrorEndpoint validates a registry mirror endpoint, which must be an http or https URL.
+func validateContainerdMirrorEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return pkgerrors.New("endpoint must be an http or https URL")
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestRenderContainerdFiles(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				"registry": "registry.example.com",
+				"empty":    "",
+			},
+			Annotations: map[string]string{
+				"mirror": "https://mirror.example.com",
+			},
+		},
+	}
+	data := templateData(cluster, "v1.35.0")
+
+	tests := []struct {
+		name        string
+		source      bootstrapv1.ContainerdFileSource
+		expected    []bootstrapv1.File
+		expectedErr string
+	}{
+		{
+			name: "sandbox image",
+			source: bootstrapv1.ContainerdFileSource{
+				SandboxImage: "registry.k8s.io/pause:3.10",
+			},
+			expected: []bootstrapv1.File{
+				{
+					Path:        "/etc/containerd/config.toml",
+					Owner:       "root:root",
+					Permissions: "0644",
+					Content: `version = 2
+
+[plugins."io.containerd.grpc.v1.cri"]
+  sandbox_image = "registry.k8s.io/pause:3.10"
+`,
+				},
+			},
+		},
+		{
+			name: "sandbox image and registry mirrors with template variables",
+			source: bootstrapv1.ContainerdFileSource{
+				SandboxImage: "{{ .cluster.labels.registry }}/pause:3.10",
+				RegistryMirrors: []bootstrapv1.ContainerdRegistryMirror{
+					{
+						Registry:  "docker.io",
+						Endpoints: []string{"{{ .cluster.annotations.mirror }}", "https://registry-1.docker.io"},
+					},
+					{
+						Registry:  "registry.k8s.io",
+						Endpoints: []string{"https://{{ .cluster.name }}.mirror.example.com"},
+					},
+				},
+			},
+			expected: []bootstrapv1.File{
+				{
+					Path:        "/etc/containerd/config.toml",
+					Owner:       "root:root",
+					Permissions: "0644",
+					Content: `version = 2
+
+[plugins."io.containerd.grpc.v1.cri"]
+  sandbox_image = "registry.example.com/pause:3.10"
+
+[plugins."io.containerd.grpc.v1.cri".registry]
+  config_path = "/etc/containerd/certs.d"
+`,
+				},
+				{
+					Path:        "/etc/containerd/certs.d/docker.io/hosts.toml",
+					Owner:       "root:root",
+					Permissions: "0644",
+					Content: `[host."https://mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+
+[host."https://registry-1.docker.io"]
+  capabilities = ["pull", "resolve"]
+`,
+				},
+				{
+					Path:        "/etc/containerd/certs.d/registry.k8s.io/hosts.toml",
+					Owner:       "root:root",
+					Permissions: "0644",
+					Content: `[host."https://cluster1.mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+`,
+				},
+			},
+		},
+		{
+			name: "fails if a value references a missing variable",
+			source: bootstrapv1.ContainerdFileSource{
+				SandboxImage: "{{ .cluster.labels.missing }}/pause:3.10",
+			},
+			expectedErr: `map has no entry for key "missing"`,
+		},
+		{
+			name: "fails if a value renders to an empty string",
+			source: bootstrapv1.ContainerdFileSource{
+				SandboxImage: "{{ .cluster.labels.empty }}",
+			},
+			expectedErr: "value must not be empty",
+		},
+		{
+			name: "fails if a value contains control characters",
+			source: bootstrapv1.ContainerdFileSource{
+				SandboxImage: "registry.k8s.io/pause:3.10\n[plugins]",
+			},
+			expectedErr: "must not contain invalid or control characters",
+		},
+		{
+			name: "fails if an endpoint is not an URL",
+			source: bootstrapv1.ContainerdFileSource{
+				RegistryMirrors: []bootstrapv1.ContainerdRegistryMirror{
+					{
+						Registry:  "docker.io",
+						Endpoints: []string{"{{ .cluster.labels.registry }}"},
+					},
+				},
+			},
+			expectedErr: `invalid endpoint "registry.example.com"`,
+		},
+		{
+			name: "fails if a registry is not a host",
+			source: bootstrapv1.ContainerdFileSource{
+				RegistryMirrors: []bootstrapv1.ContainerdRegistryMirror{
+					{
+						Registry:  "../{{ .cluster.name }}",
+						Endpoints: []string{"https://mirror.example.com"},
+					},
+				},
+			},
+			expectedErr: `invalid registry "../cluster1"`,
+		},
+		{
+			name: "fails if a registry is rendered more than once",
+			source: bootstrapv1.ContainerdFileSource{
+				RegistryMirrors: []bootstrapv1.ContainerdRegistryMirror{
+					{
+						Registry:  "registry.example.com",
+						Endpoints: []string{"https://mirror.example.com"},
+					},
+					{
+						Registry:  "{{ .cluster.labels.registry }}",
+						Endpoints: []string{"https://mirror.example.com"},
+					},
+				},
+			},
+			expectedErr: `registry "registry.example.com" is defined more than once`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			file := bootstrapv1.File{
+				Path:        "/etc/containerd/config.toml",
+				Owner:       "root:root",
+				Permissions: "0644",
+				ContentFrom: bootstrapv1.FileSource{Containerd: tt.source},
+			}
+			files, err := renderContainerdFiles(file, data)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(files).To(BeComparableTo(tt.expected))
+		})
+	}
+}
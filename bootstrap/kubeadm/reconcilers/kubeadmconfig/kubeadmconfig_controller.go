@@ -45,6 +45,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/cloudinit"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/ignition"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/locking"
 	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/types"
@@ -98,6 +99,9 @@ type Reconciler struct {
 
 	// TokenTTL is the amount of time a bootstrap token (and therefore a KubeadmConfig) will be valid.
 	TokenTTL time.Duration
+
+	// Encryptors are the encryption providers which can be used to encrypt the bootstrap data, indexed by name.
+	Encryptors map[string]encryption.Encryptor
 }
 
 // Scope is a scoped struct used during reconciliation.
@@ -128,6 +132,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		Watches(
 			&clusterv1.Machine{},
 			handler.EnqueueRequestsFromMapFunc(r.MachineToBootstrapMapFunc),
+		).
+		WatchesMetadata(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.bootstrapFailureReportToKubeadmConfig),
 		).WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue))
 
 	if feature.Gates.Enabled(feature.MachinePool) {
@@ -259,6 +267,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 				bootstrapv1.KubeadmConfigReadyCondition,
 				bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
 				bootstrapv1.KubeadmConfigCertificatesAvailableCondition,
+				bootstrapv1.KubeadmConfigBootstrapFailedCondition,
 			}},
 		}
 		if rerr == nil {
@@ -331,6 +340,10 @@ func (r *Reconciler) reconcile(ctx context.Context, scope *Scope, cluster *clust
 			Status: metav1.ConditionTrue,
 			Reason: bootstrapv1.KubeadmConfigCertificatesAvailableReason,
 		})
+		// Surface bootstrap failures reported for the machine, if any.
+		if err := r.reconcileBootstrapFailure(ctx, scope); err != nil {
+			return ctrl.Result{}, err
+		}
 		if config.Spec.JoinConfiguration.Discovery.BootstrapToken.IsDefined() {
 			if !configOwner.HasNodeRefs() {
 				// If the BootstrapToken has been generated for a join but the config owner has no nodeRefs,
@@ -343,6 +356,10 @@ func (r *Reconciler) reconcile(ctx context.Context, scope *Scope, cluster *clust
 				// we rotate the token to keep it fresh for future scale ups.
 				return r.rotateMachinePoolBootstrapToken(ctx, config, cluster, scope)
 			}
+			if ptr.Deref(config.Spec.BootstrapTokenPolicy.OneTimeUse, false) {
+				// If the BootstrapToken is a one-time-use token and the node has joined, the token is not required anymore.
+				return ctrl.Result{}, r.deleteOneTimeUseBootstrapToken(ctx, config, cluster)
+			}
 		}
 		// In any other case just return as the config is already generated and need not be generated again.
 		return ctrl.Result{}, nil
@@ -399,19 +416,20 @@ func (r *Reconciler) refreshBootstrapTokenIfNeeded(ctx context.Context, config *
 		}
 
 		now := time.Now().UTC()
-		skipTokenRefreshIfExpiringAfter := now.Add(r.skipTokenRefreshIfExpiringAfter())
+		skipTokenRefreshIfExpiringAfter := now.Add(r.skipTokenRefreshIfExpiringAfter(config))
 		if expiration.After(skipTokenRefreshIfExpiringAfter) {
 			log.V(3).Info("Token needs no refresh", "tokenExpiresInSeconds", expiration.Sub(now).Seconds())
+			config.Status.BootstrapTokenExpirationTime = metav1.NewTime(expiration)
 			return ctrl.Result{
-				RequeueAfter: r.tokenCheckRefreshOrRotationInterval(),
+				RequeueAfter: r.tokenCheckRefreshOrRotationInterval(config),
 			}, nil
 		}
 	}
 
 	// Extend TTL for existing token
-	newExpiration := time.Now().UTC().Add(r.TokenTTL).Format(time.RFC3339)
-	secret.Data[bootstrapapi.BootstrapTokenExpirationKey] = []byte(newExpiration)
-	log.Info("Refreshing token until the infrastructure has a chance to consume it", "oldExpiration", secretExpiration, "newExpiration", newExpiration)
+	newExpiration := time.Now().UTC().Add(r.tokenTTL(config)).Truncate(time.Second)
+	secret.Data[bootstrapapi.BootstrapTokenExpirationKey] = []byte(newExpiration.Format(time.RFC3339))
+	log.Info("Refreshing token until the infrastructure has a chance to consume it", "oldExpiration", secretExpiration, "newExpiration", newExpiration.Format(time.RFC3339))
 	err = remoteClient.Update(ctx, secret)
 	if err != nil {
 		if apierrors.IsNotFound(err) && scope.ConfigOwner.IsMachinePool() {
@@ -421,20 +439,23 @@ func (r *Reconciler) refreshBootstrapTokenIfNeeded(ctx context.Context, config *
 		}
 		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to refresh bootstrap token")
 	}
+	config.Status.BootstrapTokenExpirationTime = metav1.NewTime(newExpiration)
 	return ctrl.Result{
-		RequeueAfter: r.tokenCheckRefreshOrRotationInterval(),
+		RequeueAfter: r.tokenCheckRefreshOrRotationInterval(config),
 	}, nil
 }
 
 func (r *Reconciler) recreateBootstrapToken(ctx context.Context, config *bootstrapv1.KubeadmConfig, scope *Scope, remoteClient client.Client) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 
-	token, err := createToken(ctx, remoteClient, r.TokenTTL)
+	expiration := time.Now().UTC().Add(r.tokenTTL(config)).Truncate(time.Second)
+	token, err := createToken(ctx, remoteClient, expiration)
 	if err != nil {
 		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to create new bootstrap token")
 	}
 
 	config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token = token
+	config.Status.BootstrapTokenExpirationTime = metav1.NewTime(expiration)
 	log.V(3).Info("Altering JoinConfiguration.Discovery.BootstrapToken.Token")
 
 	// Update the bootstrap data
@@ -450,7 +471,7 @@ func (r *Reconciler) rotateMachinePoolBootstrapToken(ctx context.Context, config
 	}
 
 	token := config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
-	shouldRotate, err := shouldRotate(ctx, remoteClient, token, r.TokenTTL)
+	shouldRotate, err := shouldRotate(ctx, remoteClient, token, r.tokenTTL(config), r.tokenRotationInterval(config))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -459,10 +480,44 @@ func (r *Reconciler) rotateMachinePoolBootstrapToken(ctx context.Context, config
 		return r.recreateBootstrapToken(ctx, config, scope, remoteClient)
 	}
 	return ctrl.Result{
-		RequeueAfter: r.tokenCheckRefreshOrRotationInterval(),
+		RequeueAfter: r.tokenCheckRefreshOrRotationInterval(config),
 	}, nil
 }
 
+// deleteOneTimeUseBootstrapToken deletes the bootstrap token of a KubeadmConfig whose Machine joined the cluster.
+func (r *Reconciler) deleteOneTimeUseBootstrapToken(ctx context.Context, config *bootstrapv1.KubeadmConfig, cluster *clusterv1.Cluster) error {
+	remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return err
+	}
+
+	// If the expiration time is not recorded in the status, e.g. for tokens generated before the expiration time
+	// was surfaced in the status, fall back to the expiration time of the token Secret.
+	// If the token Secret does not exist, the token has already been deleted.
+	if config.Status.BootstrapTokenExpirationTime.IsZero() {
+		token := config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
+		secret, err := getToken(ctx, remoteClient, token)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return pkgerrors.Wrapf(err, "failed to get one-time-use bootstrap token")
+		}
+		// Tokens generated by CABPK always have an expiration time; a token without it has not been generated
+		// by CABPK and it is not deleted.
+		if _, ok := secret.Data[bootstrapapi.BootstrapTokenExpirationKey]; !ok {
+			return nil
+		}
+	}
+
+	if err := deleteToken(ctx, remoteClient, config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token); err != nil {
+		return pkgerrors.Wrapf(err, "failed to delete one-time-use bootstrap token")
+	}
+	ctrl.LoggerFrom(ctx).Info("Deleted one-time-use bootstrap token, the node joined the cluster")
+	config.Status.BootstrapTokenExpirationTime = metav1.Time{}
+	return nil
+}
+
 func (r *Reconciler) handleClusterNotInitialized(ctx context.Context, scope *Scope) (_ ctrl.Result, reterr error) {
 	// initialize the DataSecretAvailableCondition if missing.
 	// this is required in order to avoid the condition's LastTransitionTime to flicker in case of errors surfacing
@@ -615,8 +670,10 @@ func (r *Reconciler) handleClusterNotInitialized(ctx context.Context, scope *Sco
 				}
 				return nil
 			}(),
-			KubeadmVerbosity:  verbosityFlag,
-			KubernetesVersion: parsedVersion,
+			KubeadmVerbosity:    verbosityFlag,
+			KubernetesVersion:   parsedVersion,
+			GeneratedFiles:      scope.Config.Spec.GeneratedFiles,
+			BootstrapFailureLog: ptr.Deref(scope.Config.Spec.BootstrapFailureLog.Enabled, false),
 		},
 		InitConfiguration:    initdata,
 		ClusterConfiguration: clusterdata,
@@ -786,8 +843,10 @@ func (r *Reconciler) joinWorker(ctx context.Context, scope *Scope) (ctrl.Result,
 				}
 				return nil
 			}(),
-			KubeadmVerbosity:  verbosityFlag,
-			KubernetesVersion: parsedVersion,
+			KubeadmVerbosity:    verbosityFlag,
+			KubernetesVersion:   parsedVersion,
+			GeneratedFiles:      scope.Config.Spec.GeneratedFiles,
+			BootstrapFailureLog: ptr.Deref(scope.Config.Spec.BootstrapFailureLog.Enabled, false),
 		},
 		JoinConfiguration: joinData,
 	}
@@ -814,7 +873,7 @@ func (r *Reconciler) joinWorker(ctx context.Context, scope *Scope) (ctrl.Result,
 	}
 
 	// Ensure reconciling this object again so we keep refreshing the bootstrap token until it is consumed
-	return ctrl.Result{RequeueAfter: r.tokenCheckRefreshOrRotationInterval()}, nil
+	return ctrl.Result{RequeueAfter: r.tokenCheckRefreshOrRotationInterval(scope.Config)}, nil
 }
 
 // getControlPlaneVersion returns the control plane version from the cluster's ControlPlaneRef,
@@ -977,8 +1036,10 @@ func (r *Reconciler) joinControlplane(ctx context.Context, scope *Scope) (ctrl.R
 				}
 				return nil
 			}(),
-			KubeadmVerbosity:  verbosityFlag,
-			KubernetesVersion: parsedVersion,
+			KubeadmVerbosity:    verbosityFlag,
+			KubernetesVersion:   parsedVersion,
+			GeneratedFiles:      scope.Config.Spec.GeneratedFiles,
+			BootstrapFailureLog: ptr.Deref(scope.Config.Spec.BootstrapFailureLog.Enabled, false),
 		},
 	}
 
@@ -1004,11 +1065,11 @@ func (r *Reconciler) joinControlplane(ctx context.Context, scope *Scope) (ctrl.R
 	}
 
 	// Ensure reconciling this object again so we keep refreshing the bootstrap token until it is consumed
-	return ctrl.Result{RequeueAfter: r.tokenCheckRefreshOrRotationInterval()}, nil
+	return ctrl.Result{RequeueAfter: r.tokenCheckRefreshOrRotationInterval(scope.Config)}, nil
 }
 
-// resolveFiles maps .Spec.Files into cloudinit.Files, resolving any object references and rendering
-// template content. The control plane version used by templates is read from the cluster's ControlPlaneRef
+// resolveFiles maps .Spec.Files into cloudinit.Files, resolving any object references, generating containerd
+// configurations and rendering template content. The control plane version used by templates is read from the cluster's ControlPlaneRef
 // via getControlPlaneVersion, so callers do not need to compute it: there is one place where the
 // "controlPlane.version" template variable is sourced.
 //
@@ -1031,21 +1092,31 @@ func (r *Reconciler) resolveFiles(ctx context.Context, cfg *bootstrapv1.KubeadmC
 		cpVersion = "v" + parsed.String()
 	}
 
+	data := templateData(cluster, cpVersion)
+
 	collected := make([]bootstrapv1.File, 0, len(cfg.Spec.Files))
 	for i := range cfg.Spec.Files {
 		in := cfg.Spec.Files[i]
-		if in.ContentFrom.IsDefined() {
-			data, err := r.resolveSecretFileContent(ctx, cfg.Namespace, in)
+		switch {
+		case in.ContentFrom.Containerd.IsDefined():
+			files, err := renderContainerdFiles(in, data)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to render containerd configuration for file %q", in.Path)
+			}
+			collected = append(collected, files...)
+			continue
+		case in.ContentFrom.IsDefined():
+			content, err := r.resolveSecretFileContent(ctx, cfg.Namespace, in)
 			if err != nil {
 				return nil, pkgerrors.Wrapf(err, "failed to resolve file source")
 			}
 			in.ContentFrom = bootstrapv1.FileSource{}
-			in.Content = string(data)
+			in.Content = string(content)
 		}
 		collected = append(collected, in)
 	}
 
-	rendered, err := renderTemplates(collected, templateData(cpVersion))
+	rendered, err := renderTemplates(collected, data)
 	if err != nil {
 		return nil, pkgerrors.Wrapf(err, "failed to render templates")
 	}
@@ -1166,25 +1237,51 @@ func (r *Reconciler) resolveSecretPasswordContent(ctx context.Context, ns string
 	return data, nil
 }
 
+// tokenTTL returns the TTL of the bootstrap tokens generated for a KubeadmConfig.
+func (r *Reconciler) tokenTTL(config *bootstrapv1.KubeadmConfig) time.Duration {
+	if config.Spec.BootstrapTokenPolicy.TTLSeconds != nil {
+		return time.Duration(*config.Spec.BootstrapTokenPolicy.TTLSeconds) * time.Second
+	}
+	return r.TokenTTL
+}
+
+// tokenRotationInterval returns the interval after which the bootstrap token of a KubeadmConfig owned by a MachinePool
+// is rotated. It defaults to half of the token TTL, which is also used if the configured interval is not lower than the TTL.
+func (r *Reconciler) tokenRotationInterval(config *bootstrapv1.KubeadmConfig) time.Duration {
+	ttl := r.tokenTTL(config)
+	if config.Spec.BootstrapTokenPolicy.RotationIntervalSeconds != nil {
+		if rotationInterval := time.Duration(*config.Spec.BootstrapTokenPolicy.RotationIntervalSeconds) * time.Second; rotationInterval < ttl {
+			return rotationInterval
+		}
+	}
+	return ttl / 2
+}
+
 // skipTokenRefreshIfExpiringAfter returns a duration. If the token's expiry timestamp is after
 // `now + skipTokenRefreshIfExpiringAfter()`, it does not yet need a refresh.
-func (r *Reconciler) skipTokenRefreshIfExpiringAfter() time.Duration {
+func (r *Reconciler) skipTokenRefreshIfExpiringAfter(config *bootstrapv1.KubeadmConfig) time.Duration {
 	// Choose according to how often reconciliation is "woken up" by `tokenCheckRefreshOrRotationInterval`.
 	// Reconciliation should get triggered at least two times, i.e. have two chances to refresh the token (in case of
 	// one temporary failure), while the token is not refreshed.
-	return r.TokenTTL * 5 / 6
+	return r.tokenTTL(config) * 5 / 6
 }
 
 // tokenCheckRefreshOrRotationInterval defines when to trigger a reconciliation loop again to refresh or rotate a token.
-func (r *Reconciler) tokenCheckRefreshOrRotationInterval() time.Duration {
+func (r *Reconciler) tokenCheckRefreshOrRotationInterval(config *bootstrapv1.KubeadmConfig) time.Duration {
 	// This interval defines how often the reconciler should get triggered.
 	//
-	// `r.TokenTTL / 3` means reconciliation gets triggered at least 3 times within the expiry time of the token. The
+	// `tokenTTL / 3` means reconciliation gets triggered at least 3 times within the expiry time of the token. The
 	// third call may be too late, so the first/second call have a chance to extend the expiry (refresh/rotate),
 	// allowing for one temporary failure.
 	//
 	// Related to `skipTokenRefreshIfExpiringAfter` and also token rotation (which is different from refreshing).
-	return r.TokenTTL / 3
+	interval := r.tokenTTL(config) / 3
+
+	// If a rotation interval is configured, ensure the token gets rotated shortly after the interval elapsed.
+	if config.Spec.BootstrapTokenPolicy.RotationIntervalSeconds != nil {
+		interval = min(interval, r.tokenRotationInterval(config)/2)
+	}
+	return interval
 }
 
 // ClusterToKubeadmConfigs is a handler.ToRequestsFunc to be used to enqueue
@@ -1312,12 +1409,14 @@ func (r *Reconciler) reconcileDiscovery(ctx context.Context, cluster *clusterv1.
 			return ctrl.Result{}, err
 		}
 
-		token, err := createToken(ctx, remoteClient, r.TokenTTL)
+		expiration := time.Now().UTC().Add(r.tokenTTL(config)).Truncate(time.Second)
+		token, err := createToken(ctx, remoteClient, expiration)
 		if err != nil {
 			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to create new bootstrap token")
 		}
 
 		config.Spec.JoinConfiguration.Discovery.BootstrapToken.Token = token
+		config.Status.BootstrapTokenExpirationTime = metav1.NewTime(expiration)
 		log.V(3).Info("Altering JoinConfiguration.Discovery.BootstrapToken.Token")
 	}
 
@@ -1412,6 +1511,20 @@ func (r *Reconciler) storeBootstrapData(ctx context.Context, scope *Scope, data
 		format = bootstrapv1.CloudConfig
 	}
 
+	var encryptionFormat string
+	if scope.Config.Spec.Encryption.IsDefined() {
+		encryptor, ok := r.Encryptors[scope.Config.Spec.Encryption.Provider]
+		if !ok {
+			return pkgerrors.Errorf("failed to encrypt bootstrap data: encryption provider %q is not configured", scope.Config.Spec.Encryption.Provider)
+		}
+		encryptedData, err := encryptor.Encrypt(ctx, data)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to encrypt bootstrap data with encryption provider %q", scope.Config.Spec.Encryption.Provider)
+		}
+		data = encryptedData
+		encryptionFormat = encryptor.Format()
+	}
+
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      scope.Config.Name,
@@ -1435,6 +1548,9 @@ func (r *Reconciler) storeBootstrapData(ctx context.Context, scope *Scope, data
 		},
 		Type: clusterv1.ClusterSecretType,
 	}
+	if encryptionFormat != "" {
+		secret.Data[encryption.SecretKey] = []byte(encryptionFormat)
+	}
 
 	// as secret creation and scope.Config status patch are not atomic operations
 	// it is possible that secret creation happens but the config.Status patches are not applied
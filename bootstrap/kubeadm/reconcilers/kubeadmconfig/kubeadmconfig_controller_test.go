@@ -42,6 +42,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	bootstrapbuilder "sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/builder"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util"
@@ -928,6 +929,80 @@ func TestBootstrapDataFormat(t *testing.T) {
 	}
 }
 
+type fakeEncryptor struct{}
+
+func (fakeEncryptor) Format() string { return "fake.v1" }
+
+func (fakeEncryptor) Encrypt(_ context.Context, data []byte) ([]byte, error) {
+	return append([]byte("encrypted:"), data...), nil
+}
+
+// Ensure bootstrap data is encrypted with the encryption provider specified in the KubeadmConfig resource.
+func TestBootstrapDataEncryption(t *testing.T) {
+	testcases := []struct {
+		name                   string
+		provider               string
+		expectErr              bool
+		expectValue            string
+		expectEncryptionFormat string
+	}{
+		{
+			name:        "bootstrap data is not encrypted if encryption is not set",
+			expectValue: "data",
+		},
+		{
+			name:                   "bootstrap data is encrypted with the encryption provider",
+			provider:               "fake",
+			expectValue:            "encrypted:data",
+			expectEncryptionFormat: "fake.v1",
+		},
+		{
+			name:      "fails if the encryption provider is not configured",
+			provider:  "unknown",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := builder.Cluster(metav1.NamespaceDefault, "cluster").Build()
+			config := newKubeadmConfig(metav1.NamespaceDefault, "cfg")
+			config.Spec.Encryption.Provider = tc.provider
+
+			myclient := fake.NewClientBuilder().WithObjects(config).Build()
+			k := &Reconciler{
+				Client: myclient,
+				Encryptors: map[string]encryption.Encryptor{
+					"fake": fakeEncryptor{},
+				},
+			}
+			scope := &Scope{
+				Logger:  ctrl.LoggerFrom(ctx),
+				Config:  config,
+				Cluster: cluster,
+			}
+
+			err := k.storeBootstrapData(ctx, scope, []byte("data"))
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			g.Expect(myclient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cfg"}, secret)).To(Succeed())
+			g.Expect(string(secret.Data["value"])).To(Equal(tc.expectValue))
+			if tc.expectEncryptionFormat == "" {
+				g.Expect(secret.Data).ToNot(HaveKey(encryption.SecretKey))
+			} else {
+				g.Expect(string(secret.Data[encryption.SecretKey])).To(Equal(tc.expectEncryptionFormat))
+			}
+		})
+	}
+}
+
 // during kubeadmconfig reconcile it is possible that bootstrap secret gets created
 // but kubeadmconfig is not patched, do not error if secret already exists.
 // ignore the alreadyexists error and update the status to ready.
@@ -1247,6 +1322,122 @@ func TestBootstrapTokenTTLExtension(t *testing.T) {
 	}
 }
 
+func TestBootstrapTokenPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster").Build()
+	cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+	cluster.Status.Conditions = []metav1.Condition{{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue}}
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "100.105.150.1", Port: 6443}
+
+	controlPlaneInitMachine := newControlPlaneMachine(cluster, "control-plane-init-machine")
+	initConfig := newControlPlaneInitKubeadmConfig(controlPlaneInitMachine.Namespace, "control-plane-init-config")
+	addKubeadmConfigToMachine(initConfig, controlPlaneInitMachine)
+
+	workerMachine := newWorkerMachineForCluster(cluster)
+	workerJoinConfig := newWorkerJoinKubeadmConfig(metav1.NamespaceDefault, "worker-join-cfg")
+	workerJoinConfig.Spec.BootstrapTokenPolicy = bootstrapv1.BootstrapTokenPolicy{
+		TTLSeconds: ptr.To[int32](3600),
+		OneTimeUse: ptr.To(true),
+	}
+	addKubeadmConfigToMachine(workerJoinConfig, workerMachine)
+	objects := []client.Object{
+		cluster,
+		workerMachine,
+		workerJoinConfig,
+	}
+
+	objects = append(objects, createSecrets(t, cluster, initConfig)...)
+	myclient := fake.NewClientBuilder().WithObjects(objects...).WithStatusSubresource(&bootstrapv1.KubeadmConfig{}, &clusterv1.Machine{}).Build()
+	remoteClient := fake.NewClientBuilder().Build()
+	k := &Reconciler{
+		Client:              myclient,
+		SecretCachingClient: myclient,
+		KubeadmInitLock:     &myInitLocker{},
+		TokenTTL:            DefaultTokenTTL,
+		ClusterCache:        clustercache.NewFakeClusterCache(remoteClient, client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace}),
+	}
+	request := ctrl.Request{
+		NamespacedName: client.ObjectKey{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "worker-join-cfg",
+		},
+	}
+
+	t.Log("Ensure the token is created with the TTL from the bootstrap token policy")
+
+	result, err := k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Hour / 3))
+
+	cfg, err := getKubeadmConfig(myclient, "worker-join-cfg", metav1.NamespaceDefault)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cfg.Status.BootstrapTokenExpirationTime.IsZero()).To(BeFalse())
+
+	l := &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(HaveLen(1))
+	expiration, err := time.Parse(time.RFC3339, string(l.Items[0].Data[bootstrapapi.BootstrapTokenExpirationKey]))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(expiration).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	g.Expect(cfg.Status.BootstrapTokenExpirationTime.Time).To(BeTemporally("==", expiration))
+
+	t.Log("Ensure the one-time-use token is deleted when the Node has joined the cluster")
+
+	patchHelper, err := patch.NewHelper(workerMachine, myclient)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	workerMachine.Status.NodeRef = clusterv1.MachineNodeReference{
+		Name: "worker-node",
+	}
+	g.Expect(patchHelper.Patch(ctx, workerMachine)).To(Succeed())
+
+	result, err = k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+
+	l = &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(BeEmpty())
+
+	cfg, err = getKubeadmConfig(myclient, "worker-join-cfg", metav1.NamespaceDefault)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cfg.Status.BootstrapTokenExpirationTime.IsZero()).To(BeTrue())
+
+	t.Log("Ensure the one-time-use token is deleted when the expiration time is not recorded in the status")
+
+	token, err := createToken(ctx, remoteClient, time.Now().Add(time.Hour))
+	g.Expect(err).ToNot(HaveOccurred())
+	patchHelper, err = patch.NewHelper(cfg, myclient)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	cfg.Spec.JoinConfiguration.Discovery.BootstrapToken.Token = token
+	g.Expect(patchHelper.Patch(ctx, cfg)).To(Succeed())
+
+	result, err = k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+
+	l = &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(BeEmpty())
+}
+
+func TestBootstrapTokenRotationInterval(t *testing.T) {
+	g := NewWithT(t)
+
+	k := &Reconciler{TokenTTL: 15 * time.Minute}
+	config := &bootstrapv1.KubeadmConfig{}
+	g.Expect(k.tokenRotationInterval(config)).To(Equal(15 * time.Minute / 2))
+	g.Expect(k.tokenCheckRefreshOrRotationInterval(config)).To(Equal(5 * time.Minute))
+
+	config.Spec.BootstrapTokenPolicy.RotationIntervalSeconds = ptr.To[int32](240)
+	g.Expect(k.tokenRotationInterval(config)).To(Equal(4 * time.Minute))
+	g.Expect(k.tokenCheckRefreshOrRotationInterval(config)).To(Equal(2 * time.Minute))
+
+	// A rotation interval not lower than the TTL is ignored.
+	config.Spec.BootstrapTokenPolicy.RotationIntervalSeconds = ptr.To[int32](3600)
+	g.Expect(k.tokenRotationInterval(config)).To(Equal(15 * time.Minute / 2))
+}
+
 func TestBootstrapTokenRotationMachinePool(t *testing.T) {
 	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
 	g := NewWithT(t)
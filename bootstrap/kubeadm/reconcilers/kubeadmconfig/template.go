@@ -24,6 +24,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
 // maxRenderedTemplateBytes bounds the size of a single rendered spec.files entry.
@@ -46,19 +47,29 @@ func (l *limitedWriter) Write(p []byte) (int, error) {
 // contentFormat "Template". The map uses lowercase keys to match CAPI's builtin variable naming convention
 // (e.g. {{ .controlPlane.version }}).
 //
+// The cluster key exposes the name, namespace, labels and annotations of the Cluster, and it is only set
+// when the KubeadmConfig belongs to a Cluster.
+//
 // The controlPlane key is only set when the control plane version is known. When it is empty (the cluster
 // has no control plane ref or the referenced object does not expose spec.version) the key is omitted, so
 // template authors can detect its absence with {{ if .controlPlane }}, consistent with how builtin variables
 // behave.
-func templateData(controlPlaneVersion string) map[string]interface{} {
-	if controlPlaneVersion == "" {
-		return map[string]interface{}{}
+func templateData(cluster *clusterv1.Cluster, controlPlaneVersion string) map[string]interface{} {
+	data := map[string]interface{}{}
+	if cluster != nil {
+		data["cluster"] = map[string]interface{}{
+			"name":        cluster.Name,
+			"namespace":   cluster.Namespace,
+			"labels":      cluster.Labels,
+			"annotations": cluster.Annotations,
+		}
 	}
-	return map[string]interface{}{
-		"controlPlane": map[string]interface{}{
+	if controlPlaneVersion != "" {
+		data["controlPlane"] = map[string]interface{}{
 			"version": controlPlaneVersion,
-		},
+		}
 	}
+	return data
 }
 
 // renderTemplates renders template file contents and clears contentFormat on those entries.
@@ -69,16 +80,25 @@ func renderTemplates(files []bootstrapv1.File, data map[string]interface{}) ([]b
 		if out[i].ContentFormat != bootstrapv1.FileContentFormatTemplate {
 			continue
 		}
-		tpl, err := template.New(out[i].Path).Parse(out[i].Content)
+		content, err := renderTemplate(out[i].Path, out[i].Content, data)
 		if err != nil {
-			return nil, pkgerrors.Wrapf(err, "failed to parse template for file %q", out[i].Path)
-		}
-		var buf bytes.Buffer
-		if err := tpl.Execute(&limitedWriter{w: &buf, remaining: maxRenderedTemplateBytes}, data); err != nil {
-			return nil, pkgerrors.Wrapf(err, "failed to execute template for file %q", out[i].Path)
+			return nil, err
 		}
-		out[i].Content = buf.String()
+		out[i].Content = content
 		out[i].ContentFormat = ""
 	}
 	return out, nil
 }
+
+// renderTemplate renders a Go text/template defined for the file with the given path, using the given template options.
+func renderTemplate(path, text string, data map[string]interface{}, options ...string) (string, error) {
+	tpl, err := template.New(path).Option(options...).Parse(text)
+	if err != nil {
+		return "", pkgerrors.Wrapf(err, "failed to parse template for file %q", path)
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&limitedWriter{w: &buf, remaining: maxRenderedTemplateBytes}, data); err != nil {
+		return "", pkgerrors.Wrapf(err, "failed to execute template for file %q", path)
+	}
+	return buf.String(), nil
+}
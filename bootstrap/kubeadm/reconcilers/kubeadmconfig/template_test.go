@@ -26,7 +26,7 @@ import (
 )
 
 func TestRenderTemplates(t *testing.T) {
-	data := templateData("v1.29.0")
+	data := templateData(nil, "v1.29.0")
 
 	t.Run("plain files unchanged", func(t *testing.T) {
 		g := NewWithT(t)
@@ -65,7 +65,7 @@ func TestRenderTemplates(t *testing.T) {
 		// In that case the controlPlane key is omitted from the template data, and
 		// template authors can detect its absence with {{ if .controlPlane }}.
 		g := NewWithT(t)
-		emptyData := templateData("")
+		emptyData := templateData(nil, "")
 		g.Expect(emptyData).ToNot(HaveKey("controlPlane"))
 		in := []bootstrapv1.File{
 			{Path: "/e", ContentFormat: bootstrapv1.FileContentFormatTemplate, Content: "{{ if .controlPlane }}v={{ .controlPlane.version }}{{ end }}done"},
@@ -29,8 +29,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// createToken attempts to create a token with the given ID.
-func createToken(ctx context.Context, c client.Client, ttl time.Duration) (string, error) {
+// createToken attempts to create a token which expires at the given time.
+func createToken(ctx context.Context, c client.Client, expiration time.Time) (string, error) {
 	token, err := bootstraputil.GenerateBootstrapToken()
 	if err != nil {
 		return "", pkgerrors.Wrap(err, "unable to generate bootstrap token")
@@ -53,7 +53,7 @@ func createToken(ctx context.Context, c client.Client, ttl time.Duration) (strin
 		Data: map[string][]byte{
 			bootstrapapi.BootstrapTokenIDKey:               []byte(tokenID),
 			bootstrapapi.BootstrapTokenSecretKey:           []byte(tokenSecret),
-			bootstrapapi.BootstrapTokenExpirationKey:       []byte(time.Now().UTC().Add(ttl).Format(time.RFC3339)),
+			bootstrapapi.BootstrapTokenExpirationKey:       []byte(expiration.UTC().Format(time.RFC3339)),
 			bootstrapapi.BootstrapTokenUsageSigningKey:     []byte("true"),
 			bootstrapapi.BootstrapTokenUsageAuthentication: []byte("true"),
 			bootstrapapi.BootstrapTokenExtraGroupsKey:      []byte("system:bootstrappers:kubeadm:default-node-token"),
@@ -87,8 +87,28 @@ func getToken(ctx context.Context, c client.Client, token string) (*corev1.Secre
 	return secret, nil
 }
 
-// shouldRotate returns true if an existing token is past half of its TTL and should to be rotated.
-func shouldRotate(ctx context.Context, c client.Client, token string, ttl time.Duration) (bool, error) {
+// deleteToken deletes the token Secret, if it exists.
+func deleteToken(ctx context.Context, c client.Client, token string) error {
+	substrs := bootstraputil.BootstrapTokenRegexp.FindStringSubmatch(token)
+	if len(substrs) != 3 {
+		return pkgerrors.Errorf("the bootstrap token %q was not of the form %q", token, bootstrapapi.BootstrapTokenPattern)
+	}
+	tokenID := substrs[1]
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstraputil.BootstrapTokenSecretName(tokenID),
+			Namespace: metav1.NamespaceSystem,
+		},
+	}
+	if err := c.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// shouldRotate returns true if an existing token has been created more than rotationInterval ago and should be rotated.
+func shouldRotate(ctx context.Context, c client.Client, token string, ttl, rotationInterval time.Duration) (bool, error) {
 	secret, err := getToken(ctx, c, token)
 	if err != nil {
 		// If the secret is deleted before due to unknown reasons, machine pools cannot be scaled up.
@@ -104,5 +124,5 @@ func shouldRotate(ctx context.Context, c client.Client, token string, ttl time.D
 	if err != nil {
 		return false, err
 	}
-	return expiration.Before(time.Now().UTC().Add(ttl / 2)), nil
+	return expiration.Before(time.Now().UTC().Add(ttl - rotationInterval)), nil
 }
@@ -45,7 +45,7 @@ func ManagerCacheOptions(scheme *runtime.Scheme, controllerName string, watchNam
 	}
 
 	req, _ := labels.NewRequirement(clusterv1.ClusterNameLabel, selection.Exists, nil)
-	clusterSecretCacheSelector := labels.NewSelector().Add(*req)
+	clusterNameCacheSelector := labels.NewSelector().Add(*req)
 
 	return cache.Options{
 		DefaultNamespaces: watchNamespaces,
@@ -56,7 +56,7 @@ func ManagerCacheOptions(scheme *runtime.Scheme, controllerName string, watchNam
 			// The default client of the manager won't use the cache for secrets at all (see Client.Cache.DisableFor).
 			// The cached secrets will only be used by the secretCachingClient we create below.
 			&corev1.Secret{}: {
-				Label: clusterSecretCacheSelector,
+				Label: clusterNameCacheSelector,
 				// Drop data of secrets that we don't use.
 				Transform: func(in any) (any, error) {
 					if s, ok := in.(*corev1.Secret); ok {
@@ -68,6 +68,11 @@ func ManagerCacheOptions(scheme *runtime.Scheme, controllerName string, watchNam
 					return in, nil
 				},
 			},
+			// Note: Only ConfigMaps with the cluster name label are cached, e.g. bootstrap failure reports.
+			// The default client of the manager won't use the cache for ConfigMaps at all (see Client.Cache.DisableFor).
+			&corev1.ConfigMap{}: {
+				Label: clusterNameCacheSelector,
+			},
 			&clusterv1.MachineSet{}: {
 				// Drop data of MachineSets as we only use ownerRefs of MachineSets in clog.AddOwners.
 				Transform: func(in any) (any, error) {
@@ -19,6 +19,10 @@ package admission
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -28,6 +32,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/webhooks/conversion"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 )
 
 func (webhook *KubeadmConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
@@ -40,18 +45,21 @@ func (webhook *KubeadmConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:webhook:verbs=create;update,path=/validate-bootstrap-cluster-x-k8s-io-v1beta2-kubeadmconfig,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=bootstrap.cluster.x-k8s.io,resources=kubeadmconfigs,versions=v1beta2,name=validation.kubeadmconfig.bootstrap.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
 
 // KubeadmConfig implements a validation and defaulting webhook for KubeadmConfig.
-type KubeadmConfig struct{}
+type KubeadmConfig struct {
+	// DeprecatedFieldsPolicy defines how the usage of deprecated fields is handled.
+	DeprecatedFieldsPolicy deprecation.Policy
+}
 
 var _ admission.Validator[*bootstrapv1.KubeadmConfig] = &KubeadmConfig{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (webhook *KubeadmConfig) ValidateCreate(_ context.Context, c *bootstrapv1.KubeadmConfig) (admission.Warnings, error) {
-	return nil, webhook.validate(&c.Spec, c.Name)
+	return webhook.validate(nil, c)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (webhook *KubeadmConfig) ValidateUpdate(_ context.Context, _, newC *bootstrapv1.KubeadmConfig) (admission.Warnings, error) {
-	return nil, webhook.validate(&newC.Spec, newC.Name)
+func (webhook *KubeadmConfig) ValidateUpdate(_ context.Context, oldC, newC *bootstrapv1.KubeadmConfig) (admission.Warnings, error) {
+	return webhook.validate(oldC, newC)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -59,21 +67,32 @@ func (webhook *KubeadmConfig) ValidateDelete(_ context.Context, _ *bootstrapv1.K
 	return nil, nil
 }
 
-func (webhook *KubeadmConfig) validate(c *bootstrapv1.KubeadmConfigSpec, name string) error {
-	allErrs := Validate(c, false, field.NewPath("spec"))
+func (webhook *KubeadmConfig) validate(oldC, newC *bootstrapv1.KubeadmConfig) (admission.Warnings, error) {
+	specPath := field.NewPath("spec")
+	allErrs := Validate(&newC.Spec, false, specPath)
+
+	var oldDeprecatedFields []deprecation.Field
+	if oldC != nil {
+		oldDeprecatedFields = DeprecatedFields(&oldC.Spec, specPath)
+	}
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(oldDeprecatedFields, DeprecatedFields(&newC.Spec, specPath))
+	allErrs = append(allErrs, deprecationErrs...)
 
 	if len(allErrs) == 0 {
-		return nil
+		return allWarnings, nil
 	}
 
-	return apierrors.NewInvalid(bootstrapv1.GroupVersion.WithKind("KubeadmConfig").GroupKind(), name, allErrs)
+	return allWarnings, apierrors.NewInvalid(bootstrapv1.GroupVersion.WithKind("KubeadmConfig").GroupKind(), newC.Name, allErrs)
 }
 
 var (
 	cannotUseWithIgnition                            = fmt.Sprintf("not supported when spec.format is set to: %q", bootstrapv1.Ignition)
 	conflictingFileSourceMsg                         = "only one of content or contentFrom may be specified for a single file"
+	conflictingFileContentFromMsg                    = "only one of secret or containerd may be specified for contentFrom"
 	conflictingUserSourceMsg                         = "only one of passwd or passwdFrom may be specified for a single user"
+	invalidMirrorEndpointMsg                         = "registry mirror endpoint must be an http or https URL"
 	kubeadmBootstrapFormatIgnitionFeatureDisabledMsg = "can be set only if the KubeadmBootstrapFormatIgnition feature gate is enabled"
+	kubeadmBootstrapDataEncryptionFeatureDisabledMsg = "can be set only if the KubeadmBootstrapDataEncryption feature gate is enabled"
 	missingSecretNameMsg                             = "secret file source must specify non-empty secret name"
 	missingSecretKeyMsg                              = "secret file source must specify non-empty secret key"
 	pathConflictMsg                                  = "path property must be unique among all files"
@@ -87,6 +106,8 @@ func Validate(c *bootstrapv1.KubeadmConfigSpec, isKCP bool, pathPrefix *field.Pa
 	allErrs = append(allErrs, validateUsers(c, pathPrefix)...)
 	allErrs = append(allErrs, validateIgnition(c, pathPrefix)...)
 	allErrs = append(allErrs, validateDiskSetup(c, pathPrefix)...)
+	allErrs = append(allErrs, validateEncryption(c, pathPrefix)...)
+	allErrs = append(allErrs, validateBootstrapTokenPolicy(c, pathPrefix)...)
 
 	// Validate JoinConfiguration.
 	if c.JoinConfiguration.IsDefined() {
@@ -137,6 +158,28 @@ func Validate(c *bootstrapv1.KubeadmConfigSpec, isKCP bool, pathPrefix *field.Pa
 	return allErrs
 }
 
+// DeprecatedFields returns the deprecated fields used by a KubeadmConfigSpec.
+func DeprecatedFields(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) []deprecation.Field {
+	var fields []deprecation.Field
+
+	// kubeadm automatically prepends the unix scheme to CRI endpoints without a URL scheme, but this
+	// behavior is deprecated.
+	criSocketDetail := "use an endpoint with a URL scheme instead, e.g. unix:///var/run/containerd/containerd.sock"
+	if c.InitConfiguration.NodeRegistration.CRISocket != "" && !strings.Contains(c.InitConfiguration.NodeRegistration.CRISocket, "://") {
+		fields = append(fields, deprecation.Field{
+			Path:   pathPrefix.Child("initConfiguration", "nodeRegistration", "criSocket"),
+			Detail: criSocketDetail,
+		})
+	}
+	if c.JoinConfiguration.NodeRegistration.CRISocket != "" && !strings.Contains(c.JoinConfiguration.NodeRegistration.CRISocket, "://") {
+		fields = append(fields, deprecation.Field{
+			Path:   pathPrefix.Child("joinConfiguration", "nodeRegistration", "criSocket"),
+			Detail: criSocketDetail,
+		})
+	}
+	return fields
+}
+
 func validateFiles(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -154,10 +197,19 @@ func validateFiles(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) fie
 				),
 			)
 		}
-		// n.b.: if we ever add types besides Secret as a ContentFrom
-		// Source, we must add webhook validation here for one of the
-		// sources being non-nil.
-		if file.ContentFrom.IsDefined() {
+		if file.ContentFrom.Containerd.IsDefined() {
+			if file.ContentFrom.Secret != (bootstrapv1.SecretFileSource{}) {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						pathPrefix.Child("files").Index(i).Child("contentFrom"),
+						file.ContentFrom,
+						conflictingFileContentFromMsg,
+					),
+				)
+			}
+			allErrs = append(allErrs, validateContainerdFileSource(file.ContentFrom.Containerd, pathPrefix.Child("files").Index(i).Child("contentFrom", "containerd"))...)
+		} else if file.ContentFrom.IsDefined() {
 			if file.ContentFrom.Secret.Name == "" {
 				allErrs = append(
 					allErrs,
@@ -194,6 +246,35 @@ func validateFiles(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) fie
 	return allErrs
 }
 
+func validateContainerdFileSource(c bootstrapv1.ContainerdFileSource, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if _, err := template.New("").Parse(c.SandboxImage); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sandboxImage"), c.SandboxImage, fmt.Sprintf("invalid template: %v", err)))
+	}
+	for i, mirror := range c.RegistryMirrors {
+		if _, err := template.New("").Parse(mirror.Registry); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("registryMirrors").Index(i).Child("registry"), mirror.Registry, fmt.Sprintf("invalid template: %v", err)))
+		}
+		for j, endpoint := range mirror.Endpoints {
+			endpointPath := fldPath.Child("registryMirrors").Index(i).Child("endpoints").Index(j)
+			if _, err := template.New("").Parse(endpoint); err != nil {
+				allErrs = append(allErrs, field.Invalid(endpointPath, endpoint, fmt.Sprintf("invalid template: %v", err)))
+				continue
+			}
+			// Endpoints using template variables can only be validated once rendered.
+			if strings.Contains(endpoint, "{{") {
+				continue
+			}
+			if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(endpointPath, endpoint, invalidMirrorEndpointMsg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateUsers(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -304,6 +385,17 @@ func validateIgnition(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path)
 	}
 
 	for i, partition := range c.DiskSetup.Partitions {
+		// Note: partition sizes in Ignition are absolute, so percentages cannot be translated.
+		if len(partition.DiskLayout) > 0 {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					pathPrefix.Child("diskSetup", "partitions").Index(i).Child("diskLayout"),
+					cannotUseWithIgnition,
+				),
+			)
+		}
+
 		if partition.TableType != "" && partition.TableType != "gpt" {
 			allErrs = append(
 				allErrs,
@@ -331,12 +423,33 @@ func validateIgnition(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path)
 			)
 		}
 
-		if fs.Partition != "" {
+		// Note: partitions are picked up by cloud-init when using auto or any, which cannot be translated to Ignition.
+		if fs.Partition != "" && fs.Partition != "none" {
+			if _, err := strconv.Atoi(fs.Partition); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						pathPrefix.Child("diskSetup", "filesystems").Index(i).Child("partition"),
+						fs.Partition,
+						fmt.Sprintf(
+							"only %q or a partition number are supported when spec.format is set to %q",
+							"none",
+							bootstrapv1.Ignition,
+						),
+					),
+				)
+			}
+		}
+	}
+
+	for i, mount := range c.Mounts {
+		if len(mount) < 2 {
 			allErrs = append(
 				allErrs,
-				field.Forbidden(
-					pathPrefix.Child("diskSetup", "filesystems").Index(i).Child("partition"),
-					cannotUseWithIgnition,
+				field.Invalid(
+					pathPrefix.Child("mounts").Index(i),
+					mount,
+					fmt.Sprintf("must specify at least the device and the mount point when spec.format is set to %q", bootstrapv1.Ignition),
 				),
 			)
 		}
@@ -345,6 +458,31 @@ func validateIgnition(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path)
 	return allErrs
 }
 
+func validateEncryption(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !feature.Gates.Enabled(feature.KubeadmBootstrapDataEncryption) && c.Encryption.IsDefined() {
+		allErrs = append(allErrs, field.Forbidden(
+			pathPrefix.Child("encryption"), kubeadmBootstrapDataEncryptionFeatureDisabledMsg))
+	}
+
+	return allErrs
+}
+
+func validateBootstrapTokenPolicy(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	policy := c.BootstrapTokenPolicy
+	if policy.TTLSeconds != nil && policy.RotationIntervalSeconds != nil && *policy.RotationIntervalSeconds >= *policy.TTLSeconds {
+		allErrs = append(allErrs, field.Invalid(
+			pathPrefix.Child("bootstrapTokenPolicy", "rotationIntervalSeconds"),
+			*policy.RotationIntervalSeconds,
+			"must be lower than ttlSeconds"))
+	}
+
+	return allErrs
+}
+
 func validateDiskSetup(c *bootstrapv1.KubeadmConfigSpec, pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
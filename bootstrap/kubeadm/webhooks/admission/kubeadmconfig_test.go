@@ -24,18 +24,21 @@ import (
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 )
 
 var ctx = ctrl.SetupSignalHandler()
 
 func TestKubeadmConfigValidate(t *testing.T) {
 	cases := map[string]struct {
-		in                    *bootstrapv1.KubeadmConfig
-		enableIgnitionFeature bool
-		expectErr             bool
+		in                      *bootstrapv1.KubeadmConfig
+		enableIgnitionFeature   bool
+		enableEncryptionFeature bool
+		expectErr               bool
 	}{
 		"valid content": {
 			in: &bootstrapv1.KubeadmConfig{
@@ -154,6 +157,104 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"valid containerd contentFrom": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Files: []bootstrapv1.File{
+						{
+							Path: "/etc/containerd/config.toml",
+							ContentFrom: bootstrapv1.FileSource{
+								Containerd: bootstrapv1.ContainerdFileSource{
+									SandboxImage: "{{ .cluster.labels.registry }}/pause:3.10",
+									RegistryMirrors: []bootstrapv1.ContainerdRegistryMirror{
+										{
+											Registry:  "docker.io",
+											Endpoints: []string{"https://mirror.example.com", "{{ .cluster.annotations.mirror }}"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid contentFrom with both secret and containerd": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Files: []bootstrapv1.File{
+						{
+							Path: "/etc/containerd/config.toml",
+							ContentFrom: bootstrapv1.FileSource{
+								Secret: bootstrapv1.SecretFileSource{
+									Name: "foo",
+									Key:  "bar",
+								},
+								Containerd: bootstrapv1.ContainerdFileSource{
+									SandboxImage: "registry.k8s.io/pause:3.10",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"invalid containerd contentFrom with invalid template": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Files: []bootstrapv1.File{
+						{
+							Path: "/etc/containerd/config.toml",
+							ContentFrom: bootstrapv1.FileSource{
+								Containerd: bootstrapv1.ContainerdFileSource{
+									SandboxImage: "{{ .cluster.labels.registry /pause:3.10",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"invalid containerd contentFrom with invalid mirror endpoint": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Files: []bootstrapv1.File{
+						{
+							Path: "/etc/containerd/config.toml",
+							ContentFrom: bootstrapv1.FileSource{
+								Containerd: bootstrapv1.ContainerdFileSource{
+									RegistryMirrors: []bootstrapv1.ContainerdRegistryMirror{
+										{
+											Registry:  "docker.io",
+											Endpoints: []string{"mirror.example.com"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		"valid template contentFormat": {
 			in: &bootstrapv1.KubeadmConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -269,6 +370,64 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"valid bootstrap token policy": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					BootstrapTokenPolicy: bootstrapv1.BootstrapTokenPolicy{
+						TTLSeconds:              ptr.To[int32](3600),
+						RotationIntervalSeconds: ptr.To[int32](600),
+						OneTimeUse:              ptr.To(true),
+					},
+				},
+			},
+		},
+		"returns error if bootstrap token rotation interval is not lower than the TTL": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					BootstrapTokenPolicy: bootstrapv1.BootstrapTokenPolicy{
+						TTLSeconds:              ptr.To[int32](600),
+						RotationIntervalSeconds: ptr.To[int32](600),
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"returns error if encryption is set and the feature gate is disabled": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Encryption: bootstrapv1.BootstrapDataEncryption{
+						Provider: "foo",
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"valid encryption": {
+			enableEncryptionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Encryption: bootstrapv1.BootstrapDataEncryption{
+						Provider: "foo",
+					},
+				},
+			},
+		},
 		"Ignition field is set, format is not Ignition": {
 			enableIgnitionFeature: true,
 			in: &bootstrapv1.KubeadmConfig{
@@ -385,7 +544,27 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
-		"filesystem partition specified with Ignition": {
+		"filesystem partition auto specified with Ignition": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					DiskSetup: bootstrapv1.DiskSetup{
+						Filesystems: []bootstrapv1.Filesystem{
+							{
+								Partition: "auto",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"filesystem partition number specified with Ignition": {
 			enableIgnitionFeature: true,
 			in: &bootstrapv1.KubeadmConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -403,6 +582,44 @@ func TestKubeadmConfigValidate(t *testing.T) {
 					},
 				},
 			},
+		},
+		"DiskLayout specified with Ignition": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					DiskSetup: bootstrapv1.DiskSetup{
+						Partitions: []bootstrapv1.Partition{
+							{
+								Device: "test-device",
+								DiskLayout: []bootstrapv1.PartitionSpec{
+									{Percentage: 100},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"mount without mount point specified with Ignition": {
+			enableIgnitionFeature: true,
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: "default",
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Format: bootstrapv1.Ignition,
+					Mounts: []bootstrapv1.MountPoints{
+						{"/dev/sdb"},
+					},
+				},
+			},
 			expectErr: true,
 		},
 		"file encoding gzip specified with Ignition": {
@@ -592,6 +809,9 @@ func TestKubeadmConfigValidate(t *testing.T) {
 				// Enabling the feature flag temporarily for this test.
 				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmBootstrapFormatIgnition, true)
 			}
+			if tt.enableEncryptionFeature {
+				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmBootstrapDataEncryption, true)
+			}
 			g := NewWithT(t)
 
 			webhook := &KubeadmConfig{}
@@ -614,3 +834,73 @@ func TestKubeadmConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestKubeadmConfigValidateDeprecatedFields(t *testing.T) {
+	withCRISocket := func(criSocket string) *bootstrapv1.KubeadmConfig {
+		return &bootstrapv1.KubeadmConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "baz",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: bootstrapv1.KubeadmConfigSpec{
+				JoinConfiguration: bootstrapv1.JoinConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+						CRISocket: criSocket,
+					},
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		old          *bootstrapv1.KubeadmConfig
+		in           *bootstrapv1.KubeadmConfig
+		policy       deprecation.Policy
+		wantWarnings []string
+		wantErr      string
+	}{
+		"no warnings for a criSocket with a URL scheme": {
+			in:     withCRISocket("unix:///var/run/containerd/containerd.sock"),
+			policy: deprecation.DenyPolicy,
+		},
+		"warning for a criSocket without a URL scheme": {
+			in:           withCRISocket("/var/run/containerd/containerd.sock"),
+			policy:       deprecation.WarnPolicy,
+			wantWarnings: []string{"spec.joinConfiguration.nodeRegistration.criSocket is deprecated: use an endpoint with a URL scheme instead, e.g. unix:///var/run/containerd/containerd.sock"},
+		},
+		"error for a criSocket without a URL scheme with the Deny policy": {
+			in:      withCRISocket("/var/run/containerd/containerd.sock"),
+			policy:  deprecation.DenyPolicy,
+			wantErr: "spec.joinConfiguration.nodeRegistration.criSocket: Forbidden: is deprecated",
+		},
+		"warning for a criSocket without a URL scheme already in use with the Deny policy": {
+			old:          withCRISocket("/var/run/containerd/containerd.sock"),
+			in:           withCRISocket("/run/containerd/containerd.sock"),
+			policy:       deprecation.DenyPolicy,
+			wantWarnings: []string{"spec.joinConfiguration.nodeRegistration.criSocket is deprecated: use an endpoint with a URL scheme instead, e.g. unix:///var/run/containerd/containerd.sock"},
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			webhook := &KubeadmConfig{DeprecatedFieldsPolicy: tt.policy}
+
+			var warnings admission.Warnings
+			var err error
+			if tt.old == nil {
+				warnings, err = webhook.ValidateCreate(ctx, tt.in)
+			} else {
+				warnings, err = webhook.ValidateUpdate(ctx, tt.old, tt.in)
+			}
+			g.Expect(warnings).To(Equal(admission.Warnings(tt.wantWarnings)))
+			if tt.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/webhooks/conversion"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/topology"
 )
 
@@ -42,7 +43,10 @@ func (webhook *KubeadmConfigTemplate) SetupWebhookWithManager(mgr ctrl.Manager)
 // +kubebuilder:webhook:verbs=create;update,path=/validate-bootstrap-cluster-x-k8s-io-v1beta2-kubeadmconfigtemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=bootstrap.cluster.x-k8s.io,resources=kubeadmconfigtemplates,versions=v1beta2,name=validation.kubeadmconfigtemplate.bootstrap.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
 
 // KubeadmConfigTemplate implements a validation and defaulting webhook for KubeadmConfigTemplate.
-type KubeadmConfigTemplate struct{}
+type KubeadmConfigTemplate struct {
+	// DeprecatedFieldsPolicy defines how the usage of deprecated fields is handled.
+	DeprecatedFieldsPolicy deprecation.Policy
+}
 
 var _ admission.Defaulter[*bootstrapv1.KubeadmConfigTemplate] = &KubeadmConfigTemplate{}
 var _ admission.Validator[*bootstrapv1.KubeadmConfigTemplate] = &KubeadmConfigTemplate{}
@@ -85,12 +89,12 @@ func ApplyPreviousKubeadmConfigDefaults(c *bootstrapv1.KubeadmConfigSpec) {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (webhook *KubeadmConfigTemplate) ValidateCreate(_ context.Context, c *bootstrapv1.KubeadmConfigTemplate) (admission.Warnings, error) {
-	return nil, webhook.validate(&c.Spec, c.Name)
+	return webhook.validate(nil, c)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (webhook *KubeadmConfigTemplate) ValidateUpdate(_ context.Context, _, newC *bootstrapv1.KubeadmConfigTemplate) (admission.Warnings, error) {
-	return nil, webhook.validate(&newC.Spec, newC.Name)
+func (webhook *KubeadmConfigTemplate) ValidateUpdate(_ context.Context, oldC, newC *bootstrapv1.KubeadmConfigTemplate) (admission.Warnings, error) {
+	return webhook.validate(oldC, newC)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -98,16 +102,24 @@ func (webhook *KubeadmConfigTemplate) ValidateDelete(_ context.Context, _ *boots
 	return nil, nil
 }
 
-func (webhook *KubeadmConfigTemplate) validate(r *bootstrapv1.KubeadmConfigTemplateSpec, name string) error {
+func (webhook *KubeadmConfigTemplate) validate(oldC, newC *bootstrapv1.KubeadmConfigTemplate) (admission.Warnings, error) {
 	var allErrs field.ErrorList //nolint:prealloc // Not all paths append
 
-	allErrs = append(allErrs, Validate(&r.Template.Spec, false, field.NewPath("spec", "template", "spec"))...)
+	specPath := field.NewPath("spec", "template", "spec")
+	allErrs = append(allErrs, Validate(&newC.Spec.Template.Spec, false, specPath)...)
 	// Validate the metadata of the template.
-	allErrs = append(allErrs, r.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))...)
+	allErrs = append(allErrs, newC.Spec.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))...)
+
+	var oldDeprecatedFields []deprecation.Field
+	if oldC != nil {
+		oldDeprecatedFields = DeprecatedFields(&oldC.Spec.Template.Spec, specPath)
+	}
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(oldDeprecatedFields, DeprecatedFields(&newC.Spec.Template.Spec, specPath))
+	allErrs = append(allErrs, deprecationErrs...)
 
 	if len(allErrs) == 0 {
-		return nil
+		return allWarnings, nil
 	}
 
-	return apierrors.NewInvalid(bootstrapv1.GroupVersion.WithKind("KubeadmConfigTemplate").GroupKind(), name, allErrs)
+	return allWarnings, apierrors.NewInvalid(bootstrapv1.GroupVersion.WithKind("KubeadmConfigTemplate").GroupKind(), newC.Name, allErrs)
 }
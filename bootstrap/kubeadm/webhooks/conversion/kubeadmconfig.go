@@ -61,6 +61,7 @@ func ConvertKubeadmConfigV1Beta1ToHub(ctx context.Context, src *bootstrapv1beta1
 	// Recover other values
 	if ok {
 		RestoreKubeadmConfigSpec(&restored.Spec, &dst.Spec)
+		dst.Status.BootstrapTokenExpirationTime = restored.Status.BootstrapTokenExpirationTime
 	}
 
 	// Override restored data with timeouts values already existing in v1beta1 but in other structs.
@@ -79,6 +80,18 @@ func RestoreKubeadmConfigSpec(restored *bootstrapv1.KubeadmConfigSpec, dst *boot
 	if restored.JoinConfiguration.IsDefined() && !reflect.DeepEqual(restored.JoinConfiguration.Timeouts, bootstrapv1.Timeouts{}) {
 		dst.JoinConfiguration.Timeouts = restored.JoinConfiguration.Timeouts
 	}
+	dst.Encryption = restored.Encryption
+	dst.BootstrapTokenPolicy = restored.BootstrapTokenPolicy
+	dst.GeneratedFiles = restored.GeneratedFiles
+	dst.BootstrapFailureLog = restored.BootstrapFailureLog
+	for i := range dst.Files {
+		for _, f := range restored.Files {
+			if f.Path == dst.Files[i].Path {
+				dst.Files[i].ContentFrom.Containerd = f.ContentFrom.Containerd
+				break
+			}
+		}
+	}
 }
 
 // RestoreBoolIntentKubeadmConfigSpec restores bool intent of a KubeadmConfigSpec.
@@ -52,6 +52,14 @@ type ReleaseSeries struct {
 	// The value is an API Version, e.g. `v1alpha3`.
 	// +optional
 	Contract string `json:"contract,omitempty"`
+
+	// runtimeHooksAPIVersions defines the Runtime Hooks API versions, e.g. `hooks.runtime.cluster.x-k8s.io/v1alpha1`,
+	// supported by this series. This field applies only to the core provider.
+	//
+	// If not set, clusterctl does not check the compatibility of the Runtime Extensions registered in the management cluster
+	// when upgrading to this series.
+	// +optional
+	RuntimeHooksAPIVersions []string `json:"runtimeHooksAPIVersions,omitempty"`
 }
 
 func (rs ReleaseSeries) newer(release ReleaseSeries) bool {
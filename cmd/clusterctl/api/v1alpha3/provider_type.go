@@ -24,6 +24,7 @@ import (
 // +kubebuilder:resource:path=providers,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of Provider"
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".type"
 // +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".providerName"
@@ -56,6 +57,95 @@ type Provider struct {
 	// Deprecated: providers complying with the Cluster API v1alpha4 contract or above must watch all namespaces; this field will be removed in a future version of this API
 	// +optional
 	WatchedNamespace string `json:"watchedNamespace,omitempty"`
+
+	// status is the observed state of the provider installation.
+	// Please note that status is set by the Cluster API core controller when the ProviderInventoryConditions
+	// feature gate is enabled; clusterctl never sets it.
+	// +optional
+	Status ProviderStatus `json:"status,omitempty"`
+}
+
+// ProviderStatus defines the observed state of a provider installation.
+type ProviderStatus struct {
+	// conditions represents the observations of the provider installation.
+	// Known condition types are Ready, DeploymentsAvailable, ImagesUpToDate, CustomResourceDefinitionsEstablished.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Provider's Ready condition and corresponding reasons.
+const (
+	// ProviderReadyCondition is true if the provider Deployments are available and the provider
+	// CustomResourceDefinitions are established.
+	ProviderReadyCondition = "Ready"
+
+	// ProviderReadyReason surfaces when the provider installation is ready.
+	ProviderReadyReason = "Ready"
+
+	// ProviderNotReadyReason surfaces when the provider installation is not ready.
+	ProviderNotReadyReason = "NotReady"
+)
+
+// Provider's DeploymentsAvailable condition and corresponding reasons.
+const (
+	// ProviderDeploymentsAvailableCondition is true if all the Deployments of the provider have
+	// all the desired replicas available and up to date.
+	ProviderDeploymentsAvailableCondition = "DeploymentsAvailable"
+
+	// ProviderDeploymentsAvailableReason surfaces when all the Deployments of the provider are available.
+	ProviderDeploymentsAvailableReason = "Available"
+
+	// ProviderDeploymentsNotAvailableReason surfaces when one or more Deployments of the provider are not available.
+	ProviderDeploymentsNotAvailableReason = "NotAvailable"
+
+	// ProviderNoDeploymentsReason surfaces when no Deployments exist for the provider.
+	ProviderNoDeploymentsReason = "NoDeployments"
+
+	// ProviderInternalErrorReason surfaces unexpected failures when reading the provider installation.
+	ProviderInternalErrorReason = "InternalError"
+)
+
+// Provider's ImagesUpToDate condition and corresponding reasons.
+const (
+	// ProviderImagesUpToDateCondition is true if the image tag of the manager container of all the provider
+	// Deployments matches the provider version.
+	ProviderImagesUpToDateCondition = "ImagesUpToDate"
+
+	// ProviderImagesUpToDateReason surfaces when the provider images match the provider version.
+	ProviderImagesUpToDateReason = "UpToDate"
+
+	// ProviderImagesNotUpToDateReason surfaces when one or more provider images do not match the provider version.
+	ProviderImagesNotUpToDateReason = "NotUpToDate"
+
+	// ProviderImagesVersionUnknownReason surfaces when it is not possible to determine the version of
+	// the provider images, e.g. because images are referenced by digest only.
+	ProviderImagesVersionUnknownReason = "VersionUnknown"
+)
+
+// Provider's CustomResourceDefinitionsEstablished condition and corresponding reasons.
+const (
+	// ProviderCRDsEstablishedCondition is true if all the CustomResourceDefinitions of the provider
+	// are established and serve their storage version.
+	ProviderCRDsEstablishedCondition = "CustomResourceDefinitionsEstablished"
+
+	// ProviderCRDsEstablishedReason surfaces when all the provider CustomResourceDefinitions are established.
+	ProviderCRDsEstablishedReason = "Established"
+
+	// ProviderCRDsNotEstablishedReason surfaces when one or more provider CustomResourceDefinitions are not established.
+	ProviderCRDsNotEstablishedReason = "NotEstablished"
+)
+
+// GetConditions returns the set of conditions for this object.
+func (p *Provider) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (p *Provider) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
 }
 
 // ManifestLabel returns the cluster.x-k8s.io/provider label value for an entry in the provider inventory.
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -32,7 +33,9 @@ func (in *Metadata) DeepCopyInto(out *Metadata) {
 	if in.ReleaseSeries != nil {
 		in, out := &in.ReleaseSeries, &out.ReleaseSeries
 		*out = make([]ReleaseSeries, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -59,6 +62,7 @@ func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
@@ -111,9 +115,36 @@ func (in *ProviderList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderStatus.
+func (in *ProviderStatus) DeepCopy() *ProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseSeries) DeepCopyInto(out *ReleaseSeries) {
 	*out = *in
+	if in.RuntimeHooksAPIVersions != nil {
+		in, out := &in.RuntimeHooksAPIVersions, &out.RuntimeHooksAPIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSeries.
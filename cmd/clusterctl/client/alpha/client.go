@@ -19,11 +19,13 @@ package alpha
 // Client is the alpha client.
 type Client interface {
 	Rollout() Rollout
+	Scale() Scale
 }
 
 // alphaClient implements Client.
 type alphaClient struct {
 	rollout Rollout
+	scale   Scale
 }
 
 // ensure alphaClient implements Client.
@@ -39,6 +41,13 @@ func InjectRollout(rollout Rollout) Option {
 	}
 }
 
+// InjectScale allows to override the scale implementation to use.
+func InjectScale(scale Scale) Option {
+	return func(c *alphaClient) {
+		c.scale = scale
+	}
+}
+
 // New returns a Client.
 func New(options ...Option) Client {
 	return newAlphaClient(options...)
@@ -55,9 +64,18 @@ func newAlphaClient(options ...Option) *alphaClient {
 		client.rollout = newRolloutClient()
 	}
 
+	// if there is an injected scale, use it, otherwise use a default one
+	if client.scale == nil {
+		client.scale = newScaleClient()
+	}
+
 	return client
 }
 
 func (c *alphaClient) Rollout() Rollout {
 	return c.rollout
 }
+
+func (c *alphaClient) Scale() Scale {
+	return c.scale
+}
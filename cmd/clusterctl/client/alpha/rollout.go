@@ -41,6 +41,7 @@ type Rollout interface {
 	ObjectRestarter(context.Context, cluster.Proxy, corev1.ObjectReference) error
 	ObjectPauser(context.Context, cluster.Proxy, corev1.ObjectReference) error
 	ObjectResumer(context.Context, cluster.Proxy, corev1.ObjectReference) error
+	ObjectStatusViewer(context.Context, cluster.Proxy, corev1.ObjectReference) (*RolloutStatus, error)
 }
 
 var _ Rollout = &rollout{}
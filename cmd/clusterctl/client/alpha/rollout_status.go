@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/util/annotations"
+)
+
+// RolloutStatus describes the status of the rollout of a cluster-api resource.
+type RolloutStatus struct {
+	// Ref is the reference to the resource.
+	Ref corev1.ObjectReference
+
+	// Paused is true if the rollout of the resource is paused.
+	Paused bool
+
+	// Replicas is the desired number of replicas.
+	Replicas int32
+
+	// UpToDateReplicas is the number of replicas up-to-date with the desired spec.
+	UpToDateReplicas int32
+
+	// AvailableReplicas is the number of available replicas.
+	AvailableReplicas int32
+
+	// CurrentReplicas is the number of replicas currently existing, including the ones not yet up-to-date.
+	CurrentReplicas int32
+
+	// Done is true if the rollout of the resource is completed.
+	Done bool
+
+	// Message describes the status of the rollout.
+	Message string
+}
+
+// ObjectStatusViewer returns the rollout status of the specified cluster-api resource.
+func (r *rollout) ObjectStatusViewer(ctx context.Context, proxy cluster.Proxy, ref corev1.ObjectReference) (*RolloutStatus, error) {
+	status := &RolloutStatus{Ref: ref}
+	var generation, observedGeneration int64
+	switch ref.Kind {
+	case MachineDeployment:
+		deployment, err := getMachineDeployment(ctx, proxy, ref.Name, ref.Namespace)
+		if err != nil || deployment == nil {
+			return nil, pkgerrors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
+		}
+		generation = deployment.Generation
+		observedGeneration = deployment.Status.ObservedGeneration
+		status.Paused = ptr.Deref(deployment.Spec.Paused, false)
+		status.Replicas = ptr.Deref(deployment.Spec.Replicas, 0)
+		status.CurrentReplicas = ptr.Deref(deployment.Status.Replicas, 0)
+		status.UpToDateReplicas = ptr.Deref(deployment.Status.UpToDateReplicas, 0)
+		status.AvailableReplicas = ptr.Deref(deployment.Status.AvailableReplicas, 0)
+	case KubeadmControlPlane:
+		kcp, err := getKubeadmControlPlane(ctx, proxy, ref.Name, ref.Namespace)
+		if err != nil || kcp == nil {
+			return nil, pkgerrors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
+		}
+		generation = kcp.Generation
+		observedGeneration = kcp.Status.ObservedGeneration
+		status.Paused = annotations.HasPaused(kcp.GetObjectMeta())
+		status.Replicas = ptr.Deref(kcp.Spec.Replicas, 0)
+		status.CurrentReplicas = ptr.Deref(kcp.Status.Replicas, 0)
+		status.UpToDateReplicas = ptr.Deref(kcp.Status.UpToDateReplicas, 0)
+		status.AvailableReplicas = ptr.Deref(kcp.Status.AvailableReplicas, 0)
+	default:
+		return nil, pkgerrors.Errorf("Invalid resource type %q, valid values are %v", ref.Kind, validResourceTypes)
+	}
+
+	resource := fmt.Sprintf("%s %q", ref.Kind, ref.Name)
+	switch {
+	case observedGeneration < generation:
+		status.Message = fmt.Sprintf("Waiting for %s spec update to be observed...", resource)
+	case status.UpToDateReplicas < status.Replicas:
+		status.Message = fmt.Sprintf("Waiting for %s rollout to finish: %d out of %d new replicas have been updated...", resource, status.UpToDateReplicas, status.Replicas)
+	case status.CurrentReplicas > status.UpToDateReplicas:
+		status.Message = fmt.Sprintf("Waiting for %s rollout to finish: %d old replicas are pending termination...", resource, status.CurrentReplicas-status.UpToDateReplicas)
+	case status.AvailableReplicas < status.UpToDateReplicas:
+		status.Message = fmt.Sprintf("Waiting for %s rollout to finish: %d of %d updated replicas are available...", resource, status.AvailableReplicas, status.UpToDateReplicas)
+	default:
+		status.Done = true
+		status.Message = fmt.Sprintf("%s successfully rolled out", resource)
+	}
+	if status.Paused {
+		status.Message = fmt.Sprintf("%s (rollout is paused)", status.Message)
+	}
+	return status, nil
+}
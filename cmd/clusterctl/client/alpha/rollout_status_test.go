@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_ObjectStatusViewer(t *testing.T) {
+	kcp := func(generation, observedGeneration int64, replicas, current, upToDate, available int32, paused bool) *controlplanev1.KubeadmControlPlane {
+		kcp := &controlplanev1.KubeadmControlPlane{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "KubeadmControlPlane",
+				APIVersion: clusterv1.GroupVersionControlPlane.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "default",
+				Name:       "kcp",
+				Generation: generation,
+			},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				Replicas: ptr.To(replicas),
+			},
+			Status: controlplanev1.KubeadmControlPlaneStatus{
+				ObservedGeneration: observedGeneration,
+				Replicas:           ptr.To(current),
+				UpToDateReplicas:   ptr.To(upToDate),
+				AvailableReplicas:  ptr.To(available),
+			},
+		}
+		if paused {
+			kcp.Annotations = map[string]string{clusterv1.PausedAnnotation: "true"}
+		}
+		return kcp
+	}
+	kcpRef := corev1.ObjectReference{
+		Kind:      KubeadmControlPlane,
+		Name:      "kcp",
+		Namespace: "default",
+	}
+
+	tests := []struct {
+		name        string
+		objs        []client.Object
+		ref         corev1.ObjectReference
+		wantErr     bool
+		wantDone    bool
+		wantPaused  bool
+		wantMessage string
+	}{
+		{
+			name: "machinedeployment rollout completed",
+			objs: []client.Object{
+				&clusterv1.MachineDeployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "MachineDeployment",
+						APIVersion: clusterv1.GroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:  "default",
+						Name:       "md-1",
+						Generation: 2,
+					},
+					Spec: clusterv1.MachineDeploymentSpec{
+						Replicas: ptr.To[int32](3),
+					},
+					Status: clusterv1.MachineDeploymentStatus{
+						ObservedGeneration: 2,
+						Replicas:           ptr.To[int32](3),
+						UpToDateReplicas:   ptr.To[int32](3),
+						AvailableReplicas:  ptr.To[int32](3),
+					},
+				},
+			},
+			ref: corev1.ObjectReference{
+				Kind:      MachineDeployment,
+				Name:      "md-1",
+				Namespace: "default",
+			},
+			wantDone:    true,
+			wantMessage: `machinedeployment "md-1" successfully rolled out`,
+		},
+		{
+			name:        "kubeadmcontrolplane spec update not yet observed",
+			objs:        []client.Object{kcp(2, 1, 3, 3, 3, 3, false)},
+			ref:         kcpRef,
+			wantMessage: `Waiting for kubeadmcontrolplane "kcp" spec update to be observed...`,
+		},
+		{
+			name:        "kubeadmcontrolplane replicas not yet updated",
+			objs:        []client.Object{kcp(2, 2, 3, 4, 1, 3, false)},
+			ref:         kcpRef,
+			wantMessage: `Waiting for kubeadmcontrolplane "kcp" rollout to finish: 1 out of 3 new replicas have been updated...`,
+		},
+		{
+			name:        "kubeadmcontrolplane old replicas pending termination",
+			objs:        []client.Object{kcp(2, 2, 3, 4, 3, 3, false)},
+			ref:         kcpRef,
+			wantMessage: `Waiting for kubeadmcontrolplane "kcp" rollout to finish: 1 old replicas are pending termination...`,
+		},
+		{
+			name:        "kubeadmcontrolplane updated replicas not yet available",
+			objs:        []client.Object{kcp(2, 2, 3, 3, 3, 2, false)},
+			ref:         kcpRef,
+			wantMessage: `Waiting for kubeadmcontrolplane "kcp" rollout to finish: 2 of 3 updated replicas are available...`,
+		},
+		{
+			name:        "paused kubeadmcontrolplane",
+			objs:        []client.Object{kcp(2, 2, 3, 3, 1, 3, true)},
+			ref:         kcpRef,
+			wantPaused:  true,
+			wantMessage: `Waiting for kubeadmcontrolplane "kcp" rollout to finish: 1 out of 3 new replicas have been updated... (rollout is paused)`,
+		},
+		{
+			name:        "kubeadmcontrolplane rollout completed",
+			objs:        []client.Object{kcp(2, 2, 3, 3, 3, 3, false)},
+			ref:         kcpRef,
+			wantDone:    true,
+			wantMessage: `kubeadmcontrolplane "kcp" successfully rolled out`,
+		},
+		{
+			name:    "return error if kubeadmcontrolplane does not exist",
+			ref:     kcpRef,
+			wantErr: true,
+		},
+		{
+			name: "return error for invalid resource type",
+			ref: corev1.ObjectReference{
+				Kind:      "invalid",
+				Name:      "foo",
+				Namespace: "default",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			r := newRolloutClient()
+			proxy := test.NewFakeProxy().WithObjs(tt.objs...)
+			got, err := r.ObjectStatusViewer(context.Background(), proxy, tt.ref)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.Done).To(Equal(tt.wantDone))
+			g.Expect(got.Paused).To(Equal(tt.wantPaused))
+			g.Expect(got.Message).To(Equal(tt.wantMessage))
+		})
+	}
+}
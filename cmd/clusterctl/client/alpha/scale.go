@@ -0,0 +1,479 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ScaleRunLabel is the label applied to all the Clusters generated by a scale test run;
+// the value of the label is the name prefix of the run.
+const ScaleRunLabel = "clusterctl.cluster.x-k8s.io/scale-run"
+
+// scalePollInterval is the interval used to check the Clusters and the Machines of a scale test run.
+var scalePollInterval = 10 * time.Second
+
+// Scale defines the behavior of a scale test implementation.
+type Scale interface {
+	// Run generates the Clusters of a scale test run, waits for them and their Machines to become available,
+	// and returns a report with the time it took for them to become available and the API server load.
+	Run(ctx context.Context, proxy cluster.Proxy, input ScaleInput) (*ScaleReport, error)
+}
+
+// ScaleInput defines the inputs of a scale test run.
+type ScaleInput struct {
+	// Namespace where the Clusters are generated.
+	Namespace string
+
+	// NamePrefix is the prefix of the names of the generated Clusters; it also identifies the scale test run.
+	NamePrefix string
+
+	// ClusterClassName is the name of the ClusterClass used by the generated Clusters, e.g. a ClusterClass
+	// using the in-memory backend of CAPD.
+	ClusterClassName string
+
+	// KubernetesVersion is the Kubernetes version of the generated Clusters.
+	KubernetesVersion string
+
+	// Clusters is the number of Clusters to generate.
+	Clusters int
+
+	// ControlPlaneMachines is the number of control plane Machines of each Cluster.
+	ControlPlaneMachines int32
+
+	// WorkerMachines is the number of worker Machines of each Cluster.
+	WorkerMachines int32
+
+	// MachineDeploymentClass is the class of the MachineDeployment hosting the worker Machines.
+	MachineDeploymentClass string
+
+	// Concurrency is the number of Clusters created in parallel.
+	Concurrency int
+
+	// Timeout defines how long to wait for the Clusters and the Machines to become available.
+	Timeout time.Duration
+
+	// SkipCleanup instructs Run to not delete the generated Clusters at the end of the scale test run.
+	SkipCleanup bool
+}
+
+// ScaleReport is the report of a scale test run.
+type ScaleReport struct {
+	// Clusters is the number of generated Clusters.
+	Clusters int
+
+	// AvailableClusters is the number of Clusters that became available.
+	AvailableClusters int
+
+	// Machines is the number of expected Machines.
+	Machines int
+
+	// AvailableMachines is the number of Machines that became available.
+	AvailableMachines int
+
+	// NotAvailableClusters are the names of the Clusters that did not become available before the timeout.
+	NotAvailableClusters []string
+
+	// Duration is the time from the creation of the first Cluster until all the Clusters and
+	// Machines became available, or until the timeout.
+	Duration time.Duration
+
+	// ClusterTimeToAvailable are the percentiles of the time it took for Clusters to become available, measured
+	// from the creation of each Cluster; this is an end-to-end measure, not the reconcile time of the controllers.
+	ClusterTimeToAvailable ScaleDurations
+
+	// MachineTimeToAvailable are the percentiles of the time it took for Machines to become available.
+	MachineTimeToAvailable ScaleDurations
+
+	// APIServerRequests is the number of requests served by the API server of the management cluster during
+	// the scale test run; it is nil if the metrics of the API server cannot be read.
+	APIServerRequests *int64
+}
+
+// ScaleDurations are the percentiles of a set of durations.
+type ScaleDurations struct {
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+var _ Scale = &scale{}
+
+type scale struct{}
+
+func newScaleClient() Scale {
+	return &scale{}
+}
+
+// Run generates the Clusters of a scale test run, waits for them and their Machines to become available,
+// and returns a report with the time it took for them to become available and the API server load.
+func (s *scale) Run(ctx context.Context, proxy cluster.Proxy, input ScaleInput) (*ScaleReport, error) {
+	if err := validateScaleInput(input); err != nil {
+		return nil, err
+	}
+
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: input.Namespace, Name: input.ClusterClassName}, &clusterv1.ClusterClass{}); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get ClusterClass %s/%s", input.Namespace, input.ClusterClassName)
+	}
+
+	existing := &clusterv1.ClusterList{}
+	if err := c.List(ctx, existing, client.InNamespace(input.Namespace), client.MatchingLabels{ScaleRunLabel: input.NamePrefix}); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Clusters")
+	}
+	if len(existing.Items) > 0 {
+		return nil, pkgerrors.Errorf("Clusters of the scale test run %s already exist in namespace %s, delete them or use a different name prefix", input.NamePrefix, input.Namespace)
+	}
+
+	requestsBefore, metricsErr := apiServerRequests(ctx, proxy)
+
+	start := time.Now()
+	createErr := createScaleClusters(ctx, c, input)
+	if createErr == nil {
+		createErr = waitForScaleClusters(ctx, c, input)
+	}
+
+	report, err := getScaleReport(ctx, c, input)
+	if err != nil {
+		return nil, kerrors.NewAggregate([]error{createErr, err})
+	}
+	report.Duration = time.Since(start)
+
+	if metricsErr == nil {
+		if requestsAfter, err := apiServerRequests(ctx, proxy); err == nil {
+			report.APIServerRequests = ptr.To(requestsAfter - requestsBefore)
+		}
+	}
+
+	if !input.SkipCleanup {
+		if err := deleteScaleClusters(ctx, c, input); err != nil {
+			return report, kerrors.NewAggregate([]error{createErr, err})
+		}
+	}
+	return report, createErr
+}
+
+func validateScaleInput(input ScaleInput) error {
+	var errs []error
+	if input.Namespace == "" {
+		errs = append(errs, pkgerrors.New("namespace must be specified"))
+	}
+	if input.NamePrefix == "" {
+		errs = append(errs, pkgerrors.New("name prefix must be specified"))
+	}
+	if input.ClusterClassName == "" {
+		errs = append(errs, pkgerrors.New("name of the ClusterClass must be specified"))
+	}
+	if input.KubernetesVersion == "" {
+		errs = append(errs, pkgerrors.New("Kubernetes version must be specified"))
+	}
+	if input.Clusters <= 0 {
+		errs = append(errs, pkgerrors.New("number of Clusters must be greater than zero"))
+	}
+	if input.ControlPlaneMachines <= 0 {
+		errs = append(errs, pkgerrors.New("number of control plane Machines must be greater than zero"))
+	}
+	if input.WorkerMachines < 0 {
+		errs = append(errs, pkgerrors.New("number of worker Machines must not be negative"))
+	}
+	if input.WorkerMachines > 0 && input.MachineDeploymentClass == "" {
+		errs = append(errs, pkgerrors.New("MachineDeployment class must be specified when generating worker Machines"))
+	}
+	if input.Concurrency <= 0 {
+		errs = append(errs, pkgerrors.New("concurrency must be greater than zero"))
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// newScaleCluster returns the i-th Cluster of a scale test run.
+func newScaleCluster(input ScaleInput, i int) *clusterv1.Cluster {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: input.Namespace,
+			Name:      fmt.Sprintf("%s-%d", input.NamePrefix, i),
+			Labels: map[string]string{
+				ScaleRunLabel: input.NamePrefix,
+			},
+		},
+		Spec: clusterv1.ClusterSpec{
+			Topology: clusterv1.Topology{
+				ClassRef: clusterv1.ClusterClassRef{
+					Name: input.ClusterClassName,
+				},
+				Version: input.KubernetesVersion,
+				ControlPlane: clusterv1.ControlPlaneTopology{
+					Replicas: ptr.To(input.ControlPlaneMachines),
+				},
+			},
+		},
+	}
+	if input.WorkerMachines > 0 {
+		cluster.Spec.Topology.Workers.MachineDeployments = []clusterv1.MachineDeploymentTopology{
+			{
+				Class:    input.MachineDeploymentClass,
+				Name:     "md-0",
+				Replicas: ptr.To(input.WorkerMachines),
+			},
+		}
+	}
+	return cluster
+}
+
+// createScaleClusters creates the Clusters of a scale test run using Concurrency workers.
+func createScaleClusters(ctx context.Context, c client.Client, input ScaleInput) error {
+	indexes := make(chan int)
+	errCh := make(chan error, input.Clusters)
+
+	wg := &sync.WaitGroup{}
+	for range min(input.Concurrency, input.Clusters) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				cluster := newScaleCluster(input, i)
+				if err := c.Create(ctx, cluster); err != nil {
+					errCh <- pkgerrors.Wrapf(err, "failed to create Cluster %s/%s", cluster.Namespace, cluster.Name)
+				}
+			}
+		}()
+	}
+	for i := range input.Clusters {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	close(errCh)
+
+	errs := []error{}
+	for err := range errCh {
+		errs = append(errs, err)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// waitForScaleClusters waits for the Clusters of a scale test run and their Machines to become available.
+func waitForScaleClusters(ctx context.Context, c client.Client, input ScaleInput) error {
+	if input.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, input.Timeout)
+		defer cancel()
+	}
+
+	if err := wait.PollUntilContextCancel(ctx, scalePollInterval, false, func(ctx context.Context) (bool, error) {
+		report, err := getScaleReport(ctx, c, input)
+		if err != nil {
+			return false, err
+		}
+		return report.AvailableClusters == report.Clusters && report.AvailableMachines == report.Machines, nil
+	}); err != nil {
+		if wait.Interrupted(err) {
+			return pkgerrors.New("timed out waiting for the Clusters and Machines of the scale test run to become available")
+		}
+		return err
+	}
+	return nil
+}
+
+// getScaleReport computes the report for the current state of the Clusters and Machines of a scale test run.
+// Note: The time to available is computed as the time between the creation of an object and the last transition of its
+// Available condition, so it does not depend on how frequently the objects are checked.
+func getScaleReport(ctx context.Context, c client.Client, input ScaleInput) (*ScaleReport, error) {
+	report := &ScaleReport{
+		Clusters: input.Clusters,
+		Machines: input.Clusters * int(input.ControlPlaneMachines+input.WorkerMachines),
+	}
+
+	clusters := &clusterv1.ClusterList{}
+	if err := c.List(ctx, clusters, client.InNamespace(input.Namespace), client.MatchingLabels{ScaleRunLabel: input.NamePrefix}); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Clusters")
+	}
+
+	clusterDurations := []time.Duration{}
+	machineDurations := []time.Duration{}
+	available := map[string]bool{}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if d, ok := timeToAvailable(cluster); ok {
+			clusterDurations = append(clusterDurations, d)
+			available[cluster.Name] = true
+		}
+
+		machines := &clusterv1.MachineList{}
+		if err := c.List(ctx, machines, client.InNamespace(input.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to list Machines for Cluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+		for j := range machines.Items {
+			if d, ok := timeToAvailable(&machines.Items[j]); ok {
+				machineDurations = append(machineDurations, d)
+			}
+		}
+	}
+
+	for i := range input.Clusters {
+		name := newScaleCluster(input, i).Name
+		if !available[name] {
+			report.NotAvailableClusters = append(report.NotAvailableClusters, name)
+		}
+	}
+	report.AvailableClusters = len(clusterDurations)
+	report.AvailableMachines = len(machineDurations)
+	report.ClusterTimeToAvailable = durationPercentiles(clusterDurations)
+	report.MachineTimeToAvailable = durationPercentiles(machineDurations)
+	return report, nil
+}
+
+// timeToAvailable returns the time it took for an object to become available.
+func timeToAvailable(obj interface {
+	conditions.Getter
+	metav1.Object
+}) (time.Duration, bool) {
+	condition := conditions.Get(obj, clusterv1.AvailableCondition)
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return 0, false
+	}
+	return max(condition.LastTransitionTime.Sub(obj.GetCreationTimestamp().Time), 0), true
+}
+
+// durationPercentiles computes percentiles of a set of durations using the nearest-rank method.
+func durationPercentiles(durations []time.Duration) ScaleDurations {
+	if len(durations) == 0 {
+		return ScaleDurations{}
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	percentile := func(p float64) time.Duration {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		return sorted[max(rank-1, 0)]
+	}
+	return ScaleDurations{
+		P50: percentile(50),
+		P90: percentile(90),
+		P99: percentile(99),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// deleteScaleClusters deletes the Clusters of a scale test run and waits for them to be gone.
+func deleteScaleClusters(ctx context.Context, c client.Client, input ScaleInput) error {
+	if err := c.DeleteAllOf(ctx, &clusterv1.Cluster{}, client.InNamespace(input.Namespace), client.MatchingLabels{ScaleRunLabel: input.NamePrefix}); err != nil && !apierrors.IsNotFound(err) {
+		return pkgerrors.Wrap(err, "failed to delete the Clusters of the scale test run")
+	}
+
+	if input.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, input.Timeout)
+		defer cancel()
+	}
+
+	if err := wait.PollUntilContextCancel(ctx, scalePollInterval, true, func(ctx context.Context) (bool, error) {
+		clusters := &clusterv1.ClusterList{}
+		if err := c.List(ctx, clusters, client.InNamespace(input.Namespace), client.MatchingLabels{ScaleRunLabel: input.NamePrefix}); err != nil {
+			return false, pkgerrors.Wrap(err, "failed to list Clusters")
+		}
+		return len(clusters.Items) == 0, nil
+	}); err != nil {
+		if wait.Interrupted(err) {
+			return pkgerrors.New("timed out waiting for the Clusters of the scale test run to be deleted")
+		}
+		return err
+	}
+	return nil
+}
+
+// apiServerRequests returns the total number of requests served by the API server of the management cluster,
+// as reported by the apiserver_request_total metric.
+func apiServerRequests(ctx context.Context, proxy cluster.Proxy) (int64, error) {
+	config, err := proxy.GetConfig()
+	if err != nil {
+		return 0, err
+	}
+	if config == nil {
+		return 0, pkgerrors.New("failed to get the config for the management cluster")
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return 0, err
+	}
+	metrics, err := clientset.RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to read the metrics of the API server")
+	}
+	return sumMetric(metrics, "apiserver_request_total")
+}
+
+// sumMetric sums all the samples of a metric in the Prometheus text format.
+func sumMetric(metrics []byte, name string) (int64, error) {
+	var total float64
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, name+"{") && !strings.HasPrefix(line, name+" ") {
+			continue
+		}
+		sample := strings.TrimPrefix(line, name)
+		if strings.HasPrefix(sample, "{") {
+			sample = sample[strings.LastIndex(sample, "}")+1:]
+		}
+		fields := strings.Fields(sample)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, pkgerrors.Wrapf(err, "failed to parse sample of metric %s", name)
+		}
+		total += value
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, pkgerrors.Errorf("metric %s not found", name)
+	}
+	return int64(total), nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_scale_Run(t *testing.T) {
+	input := ScaleInput{
+		Namespace:              "default",
+		NamePrefix:             "scale",
+		ClusterClassName:       "in-memory",
+		KubernetesVersion:      "v1.35.0",
+		Clusters:               3,
+		ControlPlaneMachines:   1,
+		WorkerMachines:         2,
+		MachineDeploymentClass: "default-worker",
+		Concurrency:            2,
+		Timeout:                time.Second,
+		SkipCleanup:            true,
+	}
+
+	t.Run("fails if the input is not valid", func(t *testing.T) {
+		g := NewWithT(t)
+
+		invalidInput := input
+		invalidInput.Clusters = 0
+		_, err := newScaleClient().Run(context.Background(), test.NewFakeProxy(), invalidInput)
+		g.Expect(err).To(MatchError(ContainSubstring("number of Clusters must be greater than zero")))
+	})
+
+	t.Run("fails if the ClusterClass does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newScaleClient().Run(context.Background(), test.NewFakeProxy(), input)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to get ClusterClass default/in-memory")))
+	})
+
+	t.Run("fails if Clusters of the scale test run already exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		proxy := test.NewFakeProxy().WithObjs(
+			&clusterv1.ClusterClass{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "in-memory"}},
+			newScaleCluster(input, 0),
+		)
+		_, err := newScaleClient().Run(context.Background(), proxy, input)
+		g.Expect(err).To(MatchError(ContainSubstring("Clusters of the scale test run scale already exist")))
+	})
+
+	t.Run("generates Clusters and reports the Clusters that did not become available", func(t *testing.T) {
+		g := NewWithT(t)
+
+		scalePollInterval = 100 * time.Millisecond
+		defer func() { scalePollInterval = 10 * time.Second }()
+
+		proxy := test.NewFakeProxy().WithObjs(
+			&clusterv1.ClusterClass{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "in-memory"}},
+		)
+		report, err := newScaleClient().Run(context.Background(), proxy, input)
+		g.Expect(err).To(MatchError(ContainSubstring("timed out waiting for the Clusters and Machines of the scale test run to become available")))
+		g.Expect(report).ToNot(BeNil())
+		g.Expect(report.Clusters).To(Equal(3))
+		g.Expect(report.Machines).To(Equal(9))
+		g.Expect(report.AvailableClusters).To(Equal(0))
+		g.Expect(report.NotAvailableClusters).To(ConsistOf("scale-0", "scale-1", "scale-2"))
+		// Note: the fake proxy does not provide access to the metrics of the API server.
+		g.Expect(report.APIServerRequests).To(BeNil())
+
+		c, err := proxy.NewClient(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		clusters := &clusterv1.ClusterList{}
+		g.Expect(c.List(context.Background(), clusters)).To(Succeed())
+		g.Expect(clusters.Items).To(HaveLen(3))
+		for _, cluster := range clusters.Items {
+			g.Expect(cluster.Labels).To(HaveKeyWithValue(ScaleRunLabel, "scale"))
+			g.Expect(cluster.Spec.Topology.ClassRef.Name).To(Equal("in-memory"))
+			g.Expect(*cluster.Spec.Topology.ControlPlane.Replicas).To(Equal(int32(1)))
+			g.Expect(cluster.Spec.Topology.Workers.MachineDeployments).To(HaveLen(1))
+			g.Expect(*cluster.Spec.Topology.Workers.MachineDeployments[0].Replicas).To(Equal(int32(2)))
+		}
+	})
+}
+
+func Test_getScaleReport(t *testing.T) {
+	g := NewWithT(t)
+
+	input := ScaleInput{
+		Namespace:            "default",
+		NamePrefix:           "scale",
+		Clusters:             2,
+		ControlPlaneMachines: 1,
+	}
+	created := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	available := func(after time.Duration) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:               clusterv1.AvailableCondition,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(created.Add(after)),
+		}}
+	}
+
+	cluster0 := newScaleCluster(input, 0)
+	cluster0.CreationTimestamp = created
+	cluster0.Status.Conditions = available(2 * time.Minute)
+	cluster1 := newScaleCluster(input, 1)
+	cluster1.CreationTimestamp = created
+	machine0 := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "scale-0-machine",
+			Labels:            map[string]string{clusterv1.ClusterNameLabel: "scale-0"},
+			CreationTimestamp: created,
+		},
+		Status: clusterv1.MachineStatus{Conditions: available(time.Minute)},
+	}
+
+	c, err := test.NewFakeProxy().WithObjs(cluster0, cluster1, machine0).NewClient(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	report, err := getScaleReport(context.Background(), c, input)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(report.Clusters).To(Equal(2))
+	g.Expect(report.AvailableClusters).To(Equal(1))
+	g.Expect(report.Machines).To(Equal(2))
+	g.Expect(report.AvailableMachines).To(Equal(1))
+	g.Expect(report.NotAvailableClusters).To(ConsistOf("scale-1"))
+	g.Expect(report.ClusterTimeToAvailable.Max).To(Equal(2 * time.Minute))
+	g.Expect(report.MachineTimeToAvailable.Max).To(Equal(time.Minute))
+}
+
+func Test_durationPercentiles(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(durationPercentiles(nil)).To(Equal(ScaleDurations{}))
+
+	durations := []time.Duration{}
+	for i := 100; i > 0; i-- {
+		durations = append(durations, time.Duration(i)*time.Second)
+	}
+	g.Expect(durationPercentiles(durations)).To(Equal(ScaleDurations{
+		P50: 50 * time.Second,
+		P90: 90 * time.Second,
+		P99: 99 * time.Second,
+		Max: 100 * time.Second,
+	}))
+}
+
+func Test_sumMetric(t *testing.T) {
+	g := NewWithT(t)
+
+	metrics := []byte(`# HELP apiserver_request_total [STABLE] Counter of apiserver requests.
+# TYPE apiserver_request_total counter
+apiserver_request_total{code="200",component="apiserver",resource="clusters",verb="GET"} 120
+apiserver_request_total{code="201",component="apiserver",resource="clusters",verb="POST"} 30
+apiserver_request_total_other 1000
+apiserver_request_duration_seconds_count{verb="GET"} 500
+`)
+	total, err := sumMetric(metrics, "apiserver_request_total")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(total).To(Equal(int64(150)))
+
+	_, err = sumMetric(metrics, "not_existing_metric")
+	g.Expect(err).To(HaveOccurred())
+
+	total, err = sumMetric([]byte("apiserver_request_total 42\n"), "apiserver_request_total")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(total).To(Equal(int64(42)))
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// BackupOptions carries the options supported by backup.
+type BackupOptions struct {
+	// FromKubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	FromKubeconfig Kubeconfig
+
+	// Namespace where the objects describing the workload clusters exist. If unspecified, the current
+	// namespace will be used.
+	Namespace string
+
+	// Directory where the Cluster API objects are saved, one file per object.
+	Directory string
+
+	// Archive is the path of a gzipped tarball where the Cluster API objects are saved; it can't be used with Directory.
+	Archive string
+
+	// EncryptionKey is the passphrase used to encrypt the archive. If empty, the archive is not encrypted.
+	EncryptionKey string
+}
+
+// RestoreOptions carries the options supported by restore.
+type RestoreOptions struct {
+	// ToKubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	ToKubeconfig Kubeconfig
+
+	// Directory from where the Cluster API objects are restored.
+	Directory string
+
+	// Archive is the path of a gzipped tarball from where the Cluster API objects are restored; it can't be used with Directory.
+	Archive string
+
+	// EncryptionKey is the passphrase used to decrypt the archive; it is required if the archive is encrypted.
+	EncryptionKey string
+
+	// TargetNamespace is the namespace where the Cluster API objects are restored. If empty, the objects are
+	// restored in the namespace they were saved from.
+	TargetNamespace string
+}
+
+func (c *clusterctlClient) Backup(ctx context.Context, options BackupOptions) error {
+	if (options.Directory == "") == (options.Archive == "") {
+		return pkgerrors.New("exactly one of Directory and Archive must be set")
+	}
+	if options.EncryptionKey != "" && options.Archive == "" {
+		return pkgerrors.New("EncryptionKey can be used only with Archive")
+	}
+
+	directory := options.Directory
+	if options.Archive != "" {
+		tmpDir, err := os.MkdirTemp("", "clusterctl-backup-")
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to create temporary directory")
+		}
+		defer os.RemoveAll(tmpDir)
+		directory = tmpDir
+	}
+
+	if err := c.Move(ctx, MoveOptions{
+		FromKubeconfig: options.FromKubeconfig,
+		Namespace:      options.Namespace,
+		ToDirectory:    directory,
+	}); err != nil {
+		return err
+	}
+
+	if options.Archive == "" {
+		return nil
+	}
+	return writeBackupArchive(directory, options.Archive, options.EncryptionKey)
+}
+
+func (c *clusterctlClient) Restore(ctx context.Context, options RestoreOptions) error {
+	if (options.Directory == "") == (options.Archive == "") {
+		return pkgerrors.New("exactly one of Directory and Archive must be set")
+	}
+	if options.EncryptionKey != "" && options.Archive == "" {
+		return pkgerrors.New("EncryptionKey can be used only with Archive")
+	}
+
+	directory := options.Directory
+	if options.Archive != "" || options.TargetNamespace != "" {
+		// Objects are staged in a temporary directory, so the backup is never modified.
+		tmpDir, err := os.MkdirTemp("", "clusterctl-restore-")
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to create temporary directory")
+		}
+		defer os.RemoveAll(tmpDir)
+
+		if options.Archive != "" {
+			err = readBackupArchive(options.Archive, tmpDir, options.EncryptionKey)
+		} else {
+			err = copyBackupDirectory(options.Directory, tmpDir)
+		}
+		if err != nil {
+			return err
+		}
+		directory = tmpDir
+	}
+
+	if options.TargetNamespace != "" {
+		if err := setBackupNamespace(directory, options.TargetNamespace); err != nil {
+			return err
+		}
+	}
+
+	return c.Move(ctx, MoveOptions{
+		ToKubeconfig:  options.ToKubeconfig,
+		FromDirectory: directory,
+	})
+}
+
+// copyBackupDirectory copies the files of a backup from a directory to another.
+func copyBackupDirectory(from, to string) error {
+	files, err := os.ReadDir(from)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to read directory %s", from)
+	}
+	for _, file := range files {
+		if !file.Type().IsRegular() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Clean(filepath.Join(from, file.Name())))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to read file %s", file.Name())
+		}
+		if err := os.WriteFile(filepath.Join(to, file.Name()), data, 0o600); err != nil {
+			return pkgerrors.Wrapf(err, "failed to write file %s", file.Name())
+		}
+	}
+	return nil
+}
+
+// setBackupNamespace changes the namespace of all the namespaced objects in a backup directory.
+// NOTE: references to ClusterClasses in the same namespace of a Cluster are changed accordingly.
+func setBackupNamespace(directory, namespace string) error {
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to read directory %s", directory)
+	}
+	for _, file := range files {
+		path := filepath.Join(directory, file.Name())
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to read file %s", file.Name())
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &obj.Object); err != nil {
+			return pkgerrors.Wrapf(err, "failed to parse file %s", file.Name())
+		}
+		oldNamespace := obj.GetNamespace()
+		if oldNamespace == "" {
+			continue
+		}
+		obj.SetNamespace(namespace)
+
+		if obj.GetKind() == "Cluster" {
+			classNamespace, _, err := unstructured.NestedString(obj.Object, "spec", "topology", "classRef", "namespace")
+			if err != nil {
+				return pkgerrors.Wrapf(err, "failed to get ClusterClass namespace from file %s", file.Name())
+			}
+			if classNamespace == oldNamespace {
+				if err := unstructured.SetNestedField(obj.Object, namespace, "spec", "topology", "classRef", "namespace"); err != nil {
+					return pkgerrors.Wrapf(err, "failed to set ClusterClass namespace in file %s", file.Name())
+				}
+			}
+		}
+
+		data, err = yaml.Marshal(obj.Object)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to marshal file %s", file.Name())
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			return pkgerrors.Wrapf(err, "failed to write file %s", file.Name())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// backupArchiveEncryptionHeader prefixes encrypted backup archives.
+// The header is followed by the scrypt salt, the AES-GCM nonce and the encrypted gzipped tarball.
+var backupArchiveEncryptionHeader = []byte("clusterctl-backup-aes256gcm-v1\n")
+
+const (
+	backupArchiveSaltSize = 16
+
+	// maxBackupArchiveFileSize limits the size of a single file extracted from a backup archive.
+	maxBackupArchiveFileSize = 64 << 20
+)
+
+// writeBackupArchive writes the files in a directory to a gzipped tarball, encrypting it if a key is provided.
+func writeBackupArchive(directory, archive, key string) error {
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to read directory %s", directory)
+	}
+
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for _, file := range files {
+		if !file.Type().IsRegular() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Clean(filepath.Join(directory, file.Name())))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to read file %s", file.Name())
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     file.Name(),
+			Mode:     0o600,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return pkgerrors.Wrapf(err, "failed to add file %s to archive", file.Name())
+		}
+		if _, err := tw.Write(data); err != nil {
+			return pkgerrors.Wrapf(err, "failed to add file %s to archive", file.Name())
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return pkgerrors.Wrap(err, "failed to write archive")
+	}
+	if err := gzw.Close(); err != nil {
+		return pkgerrors.Wrap(err, "failed to write archive")
+	}
+
+	data := buf.Bytes()
+	if key != "" {
+		if data, err = encryptBackupArchive(data, key); err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(archive, data, 0o600); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write archive %s", archive)
+	}
+	return nil
+}
+
+// readBackupArchive extracts the files in a gzipped tarball to a directory, decrypting it if required.
+func readBackupArchive(archive, directory, key string) error {
+	data, err := os.ReadFile(filepath.Clean(archive))
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to read archive %s", archive)
+	}
+
+	if bytes.HasPrefix(data, backupArchiveEncryptionHeader) {
+		if key == "" {
+			return pkgerrors.Errorf("archive %s is encrypted: an encryption key is required", archive)
+		}
+		if data, err = decryptBackupArchive(data, key); err != nil {
+			return err
+		}
+	}
+
+	gzr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to read archive %s", archive)
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to read archive %s", archive)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Backups are flat; reject any path which could escape the target directory.
+		if header.Name != filepath.Base(header.Name) || strings.HasPrefix(header.Name, ".") {
+			return pkgerrors.Errorf("invalid file name %q in archive %s", header.Name, archive)
+		}
+		if header.Size > maxBackupArchiveFileSize {
+			return pkgerrors.Errorf("file %s in archive %s exceeds the maximum size", header.Name, archive)
+		}
+
+		fileData, err := io.ReadAll(io.LimitReader(tr, maxBackupArchiveFileSize))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to read file %s from archive %s", header.Name, archive)
+		}
+		if err := os.WriteFile(filepath.Join(directory, header.Name), fileData, 0o600); err != nil {
+			return pkgerrors.Wrapf(err, "failed to write file %s", header.Name)
+		}
+	}
+}
+
+// backupArchiveCipher returns an AES-256-GCM cipher using a key derived from the passphrase and the salt.
+func backupArchiveCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to derive encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create cipher")
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptBackupArchive(data []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, backupArchiveSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate salt")
+	}
+	gcm, err := backupArchiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate nonce")
+	}
+
+	out := append([]byte{}, backupArchiveEncryptionHeader...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, data, backupArchiveEncryptionHeader), nil
+}
+
+func decryptBackupArchive(data []byte, passphrase string) ([]byte, error) {
+	data = data[len(backupArchiveEncryptionHeader):]
+	if len(data) < backupArchiveSaltSize {
+		return nil, pkgerrors.New("failed to decrypt archive: invalid format")
+	}
+	salt, data := data[:backupArchiveSaltSize], data[backupArchiveSaltSize:]
+
+	gcm, err := backupArchiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, pkgerrors.New("failed to decrypt archive: invalid format")
+	}
+	nonce, data := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	plain, err := gcm.Open(nil, nonce, data, backupArchiveEncryptionHeader)
+	if err != nil {
+		return nil, pkgerrors.New("failed to decrypt archive: wrong encryption key or corrupted archive")
+	}
+	return plain, nil
+}
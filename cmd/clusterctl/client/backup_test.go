@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func Test_clusterctlClient_Backup(t *testing.T) {
+	tests := []struct {
+		name    string
+		options func(dir string) BackupOptions
+		wantErr bool
+	}{
+		{
+			name: "saves objects to a directory",
+			options: func(dir string) BackupOptions {
+				return BackupOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Directory:      dir,
+				}
+			},
+		},
+		{
+			name: "saves objects to an encrypted archive",
+			options: func(dir string) BackupOptions {
+				return BackupOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Archive:        filepath.Join(dir, "backup.tar.gz"),
+					EncryptionKey:  "passphrase",
+				}
+			},
+		},
+		{
+			name: "returns an error if both Directory and Archive are set",
+			options: func(dir string) BackupOptions {
+				return BackupOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Directory:      dir,
+					Archive:        filepath.Join(dir, "backup.tar.gz"),
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if neither Directory nor Archive are set",
+			options: func(string) BackupOptions {
+				return BackupOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if EncryptionKey is set without Archive",
+			options: func(dir string) BackupOptions {
+				return BackupOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Directory:      dir,
+					EncryptionKey:  "passphrase",
+				}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options := tt.options(t.TempDir())
+			err := fakeClientForMove().Backup(context.Background(), options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			if options.Archive != "" {
+				g.Expect(options.Archive).To(BeAnExistingFile())
+			}
+		})
+	}
+}
+
+func Test_clusterctlClient_Restore(t *testing.T) {
+	tests := []struct {
+		name    string
+		options func(dir string) RestoreOptions
+		wantErr bool
+	}{
+		{
+			name: "restores objects from a directory to a different namespace",
+			options: func(dir string) RestoreOptions {
+				return RestoreOptions{
+					ToKubeconfig:    Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Directory:       dir,
+					TargetNamespace: "ns2",
+				}
+			},
+		},
+		{
+			name: "returns an error if both Directory and Archive are set",
+			options: func(dir string) RestoreOptions {
+				return RestoreOptions{
+					ToKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Directory:    dir,
+					Archive:      filepath.Join(dir, "backup.tar.gz"),
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if the archive does not exist",
+			options: func(dir string) RestoreOptions {
+				return RestoreOptions{
+					ToKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Archive:      filepath.Join(dir, "does-not-exist.tar.gz"),
+				}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dir := t.TempDir()
+			writeTestBackupObject(g, dir, "Cluster", "ns1", "foo")
+
+			err := fakeClientForMove().Restore(context.Background(), tt.options(dir))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// The backup is not modified when restoring to a different namespace.
+			g.Expect(readTestBackupObject(g, filepath.Join(dir, "Cluster_ns1_foo.yaml")).GetNamespace()).To(Equal("ns1"))
+		})
+	}
+}
+
+func Test_backupArchive(t *testing.T) {
+	t.Run("round trips an archive", func(t *testing.T) {
+		g := NewWithT(t)
+
+		from := t.TempDir()
+		writeTestBackupObject(g, from, "Cluster", "ns1", "foo")
+		writeTestBackupObject(g, from, "Secret", "ns1", "foo-kubeconfig")
+
+		archive := filepath.Join(t.TempDir(), "backup.tar.gz")
+		g.Expect(writeBackupArchive(from, archive, "")).To(Succeed())
+
+		to := t.TempDir()
+		g.Expect(readBackupArchive(archive, to, "")).To(Succeed())
+		assertSameFiles(g, from, to)
+	})
+	t.Run("round trips an encrypted archive", func(t *testing.T) {
+		g := NewWithT(t)
+
+		from := t.TempDir()
+		writeTestBackupObject(g, from, "Cluster", "ns1", "foo")
+
+		archive := filepath.Join(t.TempDir(), "backup.tar.gz")
+		g.Expect(writeBackupArchive(from, archive, "passphrase")).To(Succeed())
+
+		// The archive can't be read without the encryption key or with a wrong one.
+		g.Expect(readBackupArchive(archive, t.TempDir(), "")).ToNot(Succeed())
+		g.Expect(readBackupArchive(archive, t.TempDir(), "wrong")).ToNot(Succeed())
+
+		to := t.TempDir()
+		g.Expect(readBackupArchive(archive, to, "passphrase")).To(Succeed())
+		assertSameFiles(g, from, to)
+	})
+	t.Run("rejects files escaping the target directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		archive := filepath.Join(t.TempDir(), "backup.tar.gz")
+		f, err := os.Create(archive)
+		g.Expect(err).ToNot(HaveOccurred())
+		gzw := gzip.NewWriter(f)
+		tw := tar.NewWriter(gzw)
+		g.Expect(tw.WriteHeader(&tar.Header{Name: "../evil.yaml", Mode: 0o600, Size: 1, Typeflag: tar.TypeReg})).To(Succeed())
+		_, err = tw.Write([]byte("x"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tw.Close()).To(Succeed())
+		g.Expect(gzw.Close()).To(Succeed())
+		g.Expect(f.Close()).To(Succeed())
+
+		g.Expect(readBackupArchive(archive, t.TempDir(), "")).ToNot(Succeed())
+	})
+}
+
+func Test_setBackupNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	cluster := &unstructured.Unstructured{}
+	cluster.SetAPIVersion("cluster.x-k8s.io/v1beta2")
+	cluster.SetKind("Cluster")
+	cluster.SetNamespace("ns1")
+	cluster.SetName("foo")
+	g.Expect(unstructured.SetNestedField(cluster.Object, "ns1", "spec", "topology", "classRef", "namespace")).To(Succeed())
+	writeTestBackupFile(g, dir, cluster)
+
+	clusterWithSharedClass := cluster.DeepCopy()
+	clusterWithSharedClass.SetName("bar")
+	g.Expect(unstructured.SetNestedField(clusterWithSharedClass.Object, "shared", "spec", "topology", "classRef", "namespace")).To(Succeed())
+	writeTestBackupFile(g, dir, clusterWithSharedClass)
+
+	g.Expect(setBackupNamespace(dir, "ns2")).To(Succeed())
+
+	got := readTestBackupObject(g, filepath.Join(dir, "Cluster_ns1_foo.yaml"))
+	g.Expect(got.GetNamespace()).To(Equal("ns2"))
+	classNamespace, _, _ := unstructured.NestedString(got.Object, "spec", "topology", "classRef", "namespace")
+	g.Expect(classNamespace).To(Equal("ns2"))
+
+	got = readTestBackupObject(g, filepath.Join(dir, "Cluster_ns1_bar.yaml"))
+	g.Expect(got.GetNamespace()).To(Equal("ns2"))
+	classNamespace, _, _ = unstructured.NestedString(got.Object, "spec", "topology", "classRef", "namespace")
+	g.Expect(classNamespace).To(Equal("shared"))
+}
+
+func writeTestBackupObject(g *WithT, dir, kind, namespace, name string) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	writeTestBackupFile(g, dir, obj)
+}
+
+func writeTestBackupFile(g *WithT, dir string, obj *unstructured.Unstructured) {
+	data, err := obj.MarshalJSON()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(os.WriteFile(filepath.Join(dir, obj.GetKind()+"_"+obj.GetNamespace()+"_"+obj.GetName()+".yaml"), data, 0o600)).To(Succeed())
+}
+
+func readTestBackupObject(g *WithT, path string) *unstructured.Unstructured {
+	data, err := os.ReadFile(path) //nolint:gosec
+	g.Expect(err).ToNot(HaveOccurred())
+	obj := &unstructured.Unstructured{}
+	g.Expect(yaml.Unmarshal(data, &obj.Object)).To(Succeed())
+	return obj
+}
+
+func assertSameFiles(g *WithT, from, to string) {
+	files, err := os.ReadDir(from)
+	g.Expect(err).ToNot(HaveOccurred())
+	got, err := os.ReadDir(to)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(HaveLen(len(files)))
+	for _, file := range files {
+		want, err := os.ReadFile(filepath.Join(from, file.Name())) //nolint:gosec
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(filepath.Join(to, file.Name())).To(BeAnExistingFile())
+		data, err := os.ReadFile(filepath.Join(to, file.Name())) //nolint:gosec
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(data).To(Equal(want))
+	}
+}
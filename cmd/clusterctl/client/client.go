@@ -59,6 +59,12 @@ type Client interface {
 	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
 	Move(ctx context.Context, options MoveOptions) error
 
+	// Backup saves all the Cluster API objects existing in a namespace to a directory or to an archive.
+	Backup(ctx context.Context, options BackupOptions) error
+
+	// Restore restores the Cluster API objects saved in a directory or in an archive to a management cluster.
+	Restore(ctx context.Context, options RestoreOptions) error
+
 	// PlanUpgrade returns a set of suggested Upgrade plans for the cluster.
 	PlanUpgrade(ctx context.Context, options PlanUpgradeOptions) ([]UpgradePlan, error)
 
@@ -91,6 +97,19 @@ type AlphaClient interface {
 	RolloutPause(ctx context.Context, options RolloutPauseOptions) error
 	// RolloutResume provides rollout resume of paused cluster-api resources
 	RolloutResume(ctx context.Context, options RolloutResumeOptions) error
+	// RolloutStatus returns the rollout status of cluster-api resources
+	RolloutStatus(ctx context.Context, options RolloutStatusOptions) ([]RolloutStatus, error)
+	// TopologyPreview returns the desired state of a Cluster topology without creating or changing any object.
+	TopologyPreview(ctx context.Context, options TopologyPreviewOptions) (*TopologyPreviewOutput, error)
+	// TopologyDiff returns the differences between the objects of a Cluster topology and its desired state.
+	TopologyDiff(ctx context.Context, options TopologyDiffOptions) (*TopologyDiffOutput, error)
+	// PauseCluster pauses the reconciliation of a Cluster and of all the objects belonging to it.
+	PauseCluster(ctx context.Context, options PauseClusterOptions) error
+	// ResumeCluster resumes the reconciliation of a Cluster and of all the objects belonging to it.
+	ResumeCluster(ctx context.Context, options ResumeClusterOptions) error
+	// Scale generates Clusters with the given number of Machines, waits for them to become available and
+	// returns a report with the time it took for them to become available and the API server load.
+	Scale(ctx context.Context, options ScaleOptions) (*ScaleReport, error)
 }
 
 // YamlPrinter exposes methods that prints the processed template and
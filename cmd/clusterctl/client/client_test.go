@@ -113,6 +113,14 @@ func (f fakeClient) Move(ctx context.Context, options MoveOptions) error {
 	return f.internalClient.Move(ctx, options)
 }
 
+func (f fakeClient) Backup(ctx context.Context, options BackupOptions) error {
+	return f.internalClient.Backup(ctx, options)
+}
+
+func (f fakeClient) Restore(ctx context.Context, options RestoreOptions) error {
+	return f.internalClient.Restore(ctx, options)
+}
+
 func (f fakeClient) PlanUpgrade(ctx context.Context, options PlanUpgradeOptions) ([]UpgradePlan, error) {
 	return f.internalClient.PlanUpgrade(ctx, options)
 }
@@ -145,6 +153,30 @@ func (f fakeClient) RolloutResume(ctx context.Context, options RolloutResumeOpti
 	return f.internalClient.RolloutResume(ctx, options)
 }
 
+func (f fakeClient) RolloutStatus(ctx context.Context, options RolloutStatusOptions) ([]RolloutStatus, error) {
+	return f.internalClient.RolloutStatus(ctx, options)
+}
+
+func (f fakeClient) TopologyPreview(ctx context.Context, options TopologyPreviewOptions) (*TopologyPreviewOutput, error) {
+	return f.internalClient.TopologyPreview(ctx, options)
+}
+
+func (f fakeClient) TopologyDiff(ctx context.Context, options TopologyDiffOptions) (*TopologyDiffOutput, error) {
+	return f.internalClient.TopologyDiff(ctx, options)
+}
+
+func (f fakeClient) PauseCluster(ctx context.Context, options PauseClusterOptions) error {
+	return f.internalClient.PauseCluster(ctx, options)
+}
+
+func (f fakeClient) ResumeCluster(ctx context.Context, options ResumeClusterOptions) error {
+	return f.internalClient.ResumeCluster(ctx, options)
+}
+
+func (f fakeClient) Scale(ctx context.Context, options ScaleOptions) (*ScaleReport, error) {
+	return f.internalClient.Scale(ctx, options)
+}
+
 func (f fakeClient) Convert(ctx context.Context, options ConvertOptions) (ConvertResult, error) {
 	return f.internalClient.Convert(ctx, options)
 }
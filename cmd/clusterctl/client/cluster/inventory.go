@@ -243,6 +243,11 @@ func checkInventoryCRDs(ctx context.Context, proxy Proxy) (bool, error) {
 
 	for _, version := range crd.Spec.Versions {
 		if version.Name == clusterctlv1.GroupVersion.Version {
+			// Inventory CRDs installed by older versions of clusterctl do not have the status subresource;
+			// consider them as not installed, so they are updated to the current version.
+			if version.Subresources == nil || version.Subresources.Status == nil {
+				return false, nil
+			}
 			return true, nil
 		}
 	}
@@ -263,10 +268,20 @@ func (p *inventoryClient) createObj(ctx context.Context, o unstructured.Unstruct
 	o.SetLabels(labels)
 
 	if err := c.Create(ctx, &o); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			return nil
+		if !apierrors.IsAlreadyExists(err) {
+			return pkgerrors.Wrapf(err, "failed to create clusterctl inventory CRDs component: %s, %s/%s", o.GroupVersionKind(), o.GetNamespace(), o.GetName())
+		}
+
+		// If the object already exists, e.g. an inventory CRD installed by an older version of clusterctl, update it.
+		currentObj := &unstructured.Unstructured{}
+		currentObj.SetGroupVersionKind(o.GroupVersionKind())
+		if err := c.Get(ctx, client.ObjectKeyFromObject(&o), currentObj); err != nil {
+			return pkgerrors.Wrapf(err, "failed to get clusterctl inventory CRDs component: %s, %s/%s", o.GroupVersionKind(), o.GetNamespace(), o.GetName())
+		}
+		o.SetResourceVersion(currentObj.GetResourceVersion())
+		if err := c.Update(ctx, &o); err != nil {
+			return pkgerrors.Wrapf(err, "failed to update clusterctl inventory CRDs component: %s, %s/%s", o.GroupVersionKind(), o.GetNamespace(), o.GetName())
 		}
-		return pkgerrors.Wrapf(err, "failed to create clusterctl inventory CRDs component: %s, %s/%s", o.GroupVersionKind(), o.GetNamespace(), o.GetName())
 	}
 	return nil
 }
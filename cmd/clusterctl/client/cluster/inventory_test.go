@@ -393,3 +393,39 @@ func Test_inventoryClient_CheckSingleProviderInstance(t *testing.T) {
 		})
 	}
 }
+
+func Test_inventoryClient_EnsureCustomResourceDefinitions_UpdatesOutdatedCRD(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	// Inventory CRD as installed by older versions of clusterctl, without the status subresource.
+	outdatedCRD := &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "providers.clusterctl.cluster.x-k8s.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: clusterctlv1.GroupVersion.Group,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: clusterctlv1.GroupVersion.Version, Served: true, Storage: true},
+			},
+		},
+	}
+
+	proxy := test.NewFakeProxy().WithObjs(outdatedCRD)
+	p := newInventoryClient(proxy, fakePollImmediateWaiter, currentContractVersion)
+
+	res, err := checkInventoryCRDs(ctx, proxy)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res).To(BeFalse())
+
+	g.Expect(p.EnsureCustomResourceDefinitions(ctx)).To(Succeed())
+
+	res, err = checkInventoryCRDs(ctx, proxy)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res).To(BeTrue())
+}
@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -50,13 +51,35 @@ import (
 // ResourceMutatorFunc holds the type for mutators to be applied on resources during a move operation.
 type ResourceMutatorFunc func(u *unstructured.Unstructured) error
 
+// MoveFilter restricts a move operation to a subset of the Cluster API objects existing in a namespace.
+// Objects required by the selected objects, e.g. the ClusterClass used by a selected Cluster, are always moved.
+type MoveFilter struct {
+	// Selector restricts the move to the Clusters matching the label selector and their descendants.
+	// When set, other objects moved with their hierarchy, e.g. ClusterResourceSets, are moved only if
+	// required by the selected Clusters.
+	Selector labels.Selector
+
+	// IncludeKinds restricts the move to the objects of the given kinds which are moved with their hierarchy or
+	// labeled for move, e.g. Cluster, ClusterClass, ClusterResourceSet.
+	IncludeKinds []string
+
+	// ExcludeKinds lists the kinds of the objects which must not be moved; objects depending on them are not moved as well.
+	ExcludeKinds []string
+}
+
+// IsEmpty returns true if the filter does not restrict the move operation.
+func (f MoveFilter) IsEmpty() bool {
+	return (f.Selector == nil || f.Selector.Empty()) && len(f.IncludeKinds) == 0 && len(f.ExcludeKinds) == 0
+}
+
 // ObjectMover defines methods for moving Cluster API objects to another management cluster.
 type ObjectMover interface {
-	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
-	Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
+	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) and matching the filter to a target management cluster.
+	// If a checkpoint directory is configured, the progress of the move is tracked so the move can be resumed if it fails mid-way.
+	Move(ctx context.Context, namespace string, filter MoveFilter, checkpoint MoveCheckpointOptions, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
 
-	// ToDirectory writes all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target directory.
-	ToDirectory(ctx context.Context, namespace string, directory string) error
+	// ToDirectory writes all the Cluster API objects existing in a namespace (or from all the namespaces if empty) and matching the filter to a target directory.
+	ToDirectory(ctx context.Context, namespace string, filter MoveFilter, directory string) error
 
 	// FromDirectory reads all the Cluster API objects existing in a configured directory to a target management cluster.
 	FromDirectory(ctx context.Context, toCluster Client, directory string) error
@@ -67,12 +90,13 @@ type objectMover struct {
 	fromProxy             Proxy
 	fromProviderInventory InventoryClient
 	dryRun                bool
+	checkpoint            *moveCheckpoint
 }
 
 // ensure objectMover implements the ObjectMover interface.
 var _ ObjectMover = &objectMover{}
 
-func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
+func (o *objectMover) Move(ctx context.Context, namespace string, filter MoveFilter, checkpoint MoveCheckpointOptions, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 	log.Info("Performing move...")
 	o.dryRun = dryRun
@@ -82,6 +106,14 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 		log.Info("********************************************************")
 	}
 
+	if o.dryRun && checkpoint.Directory != "" {
+		return pkgerrors.New("a checkpoint directory can't be used with a dry-run move")
+	}
+	var err error
+	if o.checkpoint, err = newMoveCheckpoint(checkpoint, namespace); err != nil {
+		return err
+	}
+
 	// checks that all the required providers in place in the target cluster.
 	if !o.dryRun {
 		if err := o.checkTargetProviders(ctx, toCluster.ProviderInventory()); err != nil {
@@ -89,7 +121,7 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 		}
 	}
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	objectGraph, err := o.getObjectGraph(ctx, namespace, filter)
 	if err != nil {
 		return pkgerrors.Wrap(err, "failed to get object graph")
 	}
@@ -103,11 +135,11 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 	return o.move(ctx, objectGraph, proxy, mutators...)
 }
 
-func (o *objectMover) ToDirectory(ctx context.Context, namespace string, directory string) error {
+func (o *objectMover) ToDirectory(ctx context.Context, namespace string, filter MoveFilter, directory string) error {
 	log := logf.Log
 	log.Info("Moving to directory...")
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	objectGraph, err := o.getObjectGraph(ctx, namespace, filter)
 	if err != nil {
 		return pkgerrors.Wrap(err, "failed to get object graph")
 	}
@@ -186,7 +218,7 @@ func (o *objectMover) filesToObjs(dir string) ([]unstructured.Unstructured, erro
 	return objs, nil
 }
 
-func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*objectGraph, error) {
+func (o *objectMover) getObjectGraph(ctx context.Context, namespace string, filter MoveFilter) (*objectGraph, error) {
 	objectGraph := newObjectGraph(o.fromProxy, o.fromProviderInventory)
 
 	// Gets all the types defined by the CRDs installed by clusterctl plus the ConfigMap/Secret core types.
@@ -202,6 +234,13 @@ func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*ob
 		return nil, pkgerrors.Wrap(err, "failed to discover the object graph")
 	}
 
+	// Restrict the object graph to the objects selected by the filter and to their dependencies.
+	if !filter.IsEmpty() {
+		if err := objectGraph.applyMoveFilter(filter); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to filter the object graph")
+		}
+	}
+
 	// Checks if Cluster API has already completed the provisioning of the infrastructure for the objects involved in the move/toDirectory operation.
 	// This is required because if the infrastructure is provisioned, then we can reasonably assume that the objects we are moving/backing up are
 	// not currently waiting for long-running reconciliation loops, and so we can safely rely on the pause field on the Cluster object
@@ -338,47 +377,41 @@ func getMachineObj(ctx context.Context, proxy Proxy, machine *node, machineObj *
 func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Proxy, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 
-	clusters := graph.getClusters()
-	if err := checkClustersNotPaused(ctx, o.fromProxy, clusters); err != nil {
-		return err
-	}
+	// Nb. When resuming a move, the Clusters and the ClusterClasses are read from the checkpoint, because
+	// they might have been already deleted from the source management cluster.
+	clusters, clusterClasses := o.checkpoint.pausedObjects(graph)
 
-	log.Info("Moving Cluster API objects", "Clusters", len(clusters))
+	if !o.checkpoint.isCompleted(MovePhasePause) {
+		if err := checkClustersNotPaused(ctx, o.fromProxy, clusters); err != nil {
+			return err
+		}
 
-	clusterClasses := graph.getClusterClasses()
-	if err := checkClusterClassesNotPaused(ctx, o.fromProxy, clusterClasses); err != nil {
-		return err
-	}
+		log.Info("Moving Cluster API objects", "Clusters", len(clusters))
 
-	log.Info("Moving Cluster API objects", "ClusterClasses", len(clusterClasses))
+		if err := checkClusterClassesNotPaused(ctx, o.fromProxy, clusterClasses); err != nil {
+			return err
+		}
 
-	// Sets the pause field on the Cluster object in the source management cluster, so the controllers stop reconciling it.
-	log.V(1).Info("Pausing the source cluster")
-	if err := setClusterPause(ctx, o.fromProxy, clusters, true, o.dryRun); err != nil {
-		return err
-	}
+		log.Info("Moving Cluster API objects", "ClusterClasses", len(clusterClasses))
 
-	log.V(1).Info("Pausing the source ClusterClasses")
-	if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, true, o.dryRun); err != nil {
-		return pkgerrors.Wrap(err, "error pausing ClusterClasses")
-	}
+		// Sets the pause field on the Cluster object in the source management cluster, so the controllers stop reconciling it.
+		log.V(1).Info("Pausing the source cluster")
+		if err := setClusterPause(ctx, o.fromProxy, clusters, true, o.dryRun); err != nil {
+			return err
+		}
 
-	log.Info("Waiting for all resources to be ready to move")
-	// exponential backoff configuration which returns durations for a total time of ~2m.
-	// Example: 0, 5s, 8s, 11s, 17s, 26s, 38s, 57s, 86s, 128s
-	waitForMoveUnblockedBackoff := wait.Backoff{
-		Duration: 5 * time.Second,
-		Factor:   1.5,
-		Steps:    10,
-		Jitter:   0.1,
-	}
-	if err := waitReadyForMove(ctx, o.fromProxy, graph.getMoveNodes(), o.dryRun, waitForMoveUnblockedBackoff); err != nil {
-		return pkgerrors.Wrap(err, "error waiting for resources to be ready to move")
-	}
+		log.V(1).Info("Pausing the source ClusterClasses")
+		if err := setClusterClassPause(ctx, o.fromProxy, clusterClasses, true, o.dryRun); err != nil {
+			return pkgerrors.Wrap(err, "error pausing ClusterClasses")
+		}
 
-	// Nb. DO NOT call ensureNamespaces at this point because:
-	// - namespace will be ensured to exist before creating the resource.
-	// - If it's done here, we might create a namespace that can end up unused on target cluster (due to mutators).
+		o.checkpoint.setPausedObjects(clusters, clusterClasses)
+		if err := o.checkpoint.complete(MovePhasePause); err != nil {
+			return err
+		}
+	} else {
+		log.Info("Resuming move of Cluster API objects", "Clusters", len(clusters), "ClusterClasses", len(clusterClasses))
+	}
 
 	// Define the move sequence by processing the ownerReference chain, so we ensure that a Kubernetes object is moved only after its owners.
 	// The sequence is bases on object graph nodes, each one representing a Kubernetes object; nodes are grouped, so bulk of nodes can be moved in parallel. e.g.
@@ -387,10 +420,64 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	// - then all the MachineSets, then all the Machines, etc.
 	moveSequence := getMoveSequence(graph)
 
-	// Create all objects group by group, ensuring all the ownerReferences are re-created.
-	log.Info("Creating objects in the target cluster")
-	for groupIndex := range len(moveSequence.groups) {
-		if err := o.createGroup(ctx, moveSequence.getGroup(groupIndex), toProxy, mutators...); err != nil {
+	if !o.checkpoint.isCompleted(MovePhaseCreate) {
+		log.Info("Waiting for all resources to be ready to move")
+		// exponential backoff configuration which returns durations for a total time of ~2m.
+		// Example: 0, 5s, 8s, 11s, 17s, 26s, 38s, 57s, 86s, 128s
+		waitForMoveUnblockedBackoff := wait.Backoff{
+			Duration: 5 * time.Second,
+			Factor:   1.5,
+			Steps:    10,
+			Jitter:   0.1,
+		}
+		if err := waitReadyForMove(ctx, o.fromProxy, graph.getMoveNodes(), o.dryRun, waitForMoveUnblockedBackoff); err != nil {
+			return pkgerrors.Wrap(err, "error waiting for resources to be ready to move")
+		}
+	}
+
+	// Save all objects in the checkpoint directory before changing the target management cluster, so they can be restored
+	// from there in case something goes wrong.
+	if o.checkpoint != nil && !o.checkpoint.isCompleted(MovePhaseBackup) {
+		log.Info(fmt.Sprintf("Saving objects to %s", o.checkpoint.backupDirectory()))
+		for groupIndex := range len(moveSequence.groups) {
+			if err := o.backupGroup(ctx, moveSequence.getGroup(groupIndex), o.checkpoint.backupDirectory()); err != nil {
+				return err
+			}
+		}
+		if err := o.checkpoint.complete(MovePhaseBackup); err != nil {
+			return err
+		}
+	}
+
+	// Nb. DO NOT call ensureNamespaces at this point because:
+	// - namespace will be ensured to exist before creating the resource.
+	// - If it's done here, we might create a namespace that can end up unused on target cluster (due to mutators).
+
+	if !o.checkpoint.isCompleted(MovePhaseCreate) {
+		// Create all objects group by group, ensuring all the ownerReferences are re-created.
+		// Nb. objects already existing in the target cluster, e.g. created by a previous move that failed mid-way, are updated.
+		log.Info("Creating objects in the target cluster")
+		for groupIndex := range len(moveSequence.groups) {
+			if err := o.createGroup(ctx, moveSequence.getGroup(groupIndex), toProxy, mutators...); err != nil {
+				return err
+			}
+			if err := o.checkpoint.setNewUIDs(moveSequence.getGroup(groupIndex)); err != nil {
+				return err
+			}
+		}
+		if err := o.checkpoint.complete(MovePhaseCreate); err != nil {
+			return err
+		}
+	} else {
+		o.checkpoint.restoreNewUIDs(graph)
+	}
+
+	if !o.checkpoint.isCompleted(MovePhaseVerify) {
+		log.Info("Verifying objects in the target cluster")
+		if err := o.verifyMove(ctx, moveSequence, toProxy, mutators...); err != nil {
+			return pkgerrors.Wrap(err, "error verifying objects in the target cluster")
+		}
+		if err := o.checkpoint.complete(MovePhaseVerify); err != nil {
 			return err
 		}
 	}
@@ -399,14 +486,32 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	// using the right namespace to fetch the resource from the target cluster.
 	// mutators affecting non metadata fields are no-op after this point.
 
-	// Delete all objects group by group in reverse order.
-	log.Info("Deleting objects from the source cluster")
-	for groupIndex := len(moveSequence.groups) - 1; groupIndex >= 0; groupIndex-- {
-		if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex)); err != nil {
+	if !o.checkpoint.isCompleted(MovePhaseDelete) {
+		// Delete all objects group by group in reverse order.
+		log.Info("Deleting objects from the source cluster")
+		for groupIndex := len(moveSequence.groups) - 1; groupIndex >= 0; groupIndex-- {
+			if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex)); err != nil {
+				return err
+			}
+		}
+		if err := o.checkpoint.complete(MovePhaseDelete); err != nil {
 			return err
 		}
 	}
 
+	// Resume the ClusterClasses which have not been deleted from the source management cluster, e.g. because
+	// they are still used by Clusters which have not been moved.
+	log.V(1).Info("Resuming the source ClusterClasses which have not been deleted")
+	keptClusterClasses := []*node{}
+	for _, clusterClass := range clusterClasses {
+		if clusterClass.shouldNotDelete {
+			keptClusterClasses = append(keptClusterClasses, clusterClass)
+		}
+	}
+	if err := setClusterClassPause(ctx, o.fromProxy, keptClusterClasses, false, o.dryRun); err != nil {
+		return pkgerrors.Wrap(err, "error resuming source ClusterClasses")
+	}
+
 	// Resume the ClusterClasses in the target management cluster, so the controllers start reconciling it.
 	log.V(1).Info("Resuming the target ClusterClasses")
 	if err := setClusterClassPause(ctx, toProxy, clusterClasses, false, o.dryRun, mutators...); err != nil {
@@ -415,7 +520,68 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 
 	// Reset the pause field on the Cluster object in the target management cluster, so the controllers start reconciling it.
 	log.V(1).Info("Resuming the target cluster")
-	return setClusterPause(ctx, toProxy, clusters, false, o.dryRun, mutators...)
+	if err := setClusterPause(ctx, toProxy, clusters, false, o.dryRun, mutators...); err != nil {
+		return err
+	}
+
+	// The move is completed, so the checkpoint is not required anymore.
+	if err := o.checkpoint.complete(MovePhaseResume); err != nil {
+		return err
+	}
+	return o.checkpoint.remove()
+}
+
+// verifyMove checks that all the objects in the move sequence exist in the target management cluster with the UID recorded when creating them.
+func (o *objectMover) verifyMove(ctx context.Context, moveSequence *moveSequence, toProxy Proxy, mutators ...ResourceMutatorFunc) error {
+	if o.dryRun {
+		return nil
+	}
+
+	log := logf.Log
+
+	cTo, err := toProxy.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	errList := []error{}
+	sourceCount := map[string]int{}
+	targetCount := map[string]int{}
+	for groupIndex := range len(moveSequence.groups) {
+		for _, n := range moveSequence.getGroup(groupIndex) {
+			sourceCount[n.identity.Kind]++
+
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion(n.identity.APIVersion)
+			obj.SetKind(n.identity.Kind)
+			obj.SetName(n.identity.Name)
+			obj.SetNamespace(n.identity.Namespace)
+
+			// Applying mutators MAY change the namespace, so use the mutated object to identify the object in the target cluster.
+			obj, err = applyMutators(obj, mutators...)
+			if err != nil {
+				return err
+			}
+
+			if err := cTo.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				errList = append(errList, pkgerrors.Wrapf(err, "error reading %s in the target cluster", n.identityStr()))
+				continue
+			}
+
+			// Nb. global objects or objects belonging to a global hierarchy are not updated if they already exist
+			// in the target cluster, so their UID is not checked.
+			if !n.isGlobal && !n.isGlobalHierarchy && obj.GetUID() != n.newUID {
+				errList = append(errList, pkgerrors.Errorf("%s has UID %s in the target cluster, expected %s", n.identityStr(), obj.GetUID(), n.newUID))
+				continue
+			}
+			targetCount[n.identity.Kind]++
+		}
+	}
+
+	for kind, count := range sourceCount {
+		log.V(1).Info("Verified objects in the target cluster", "Kind", kind, "expected", count, "found", targetCount[kind])
+	}
+	return kerrors.NewAggregate(errList)
 }
 
 func (o *objectMover) toDirectory(ctx context.Context, graph *objectGraph, directory string) error {
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// MovePhase defines a phase of a move operation.
+type MovePhase string
+
+const (
+	// MovePhasePause is the phase where Clusters and ClusterClasses are paused in the source management cluster.
+	MovePhasePause MovePhase = "Pause"
+
+	// MovePhaseBackup is the phase where the objects to be moved are saved in the checkpoint directory.
+	MovePhaseBackup MovePhase = "Backup"
+
+	// MovePhaseCreate is the phase where the objects are created in the target management cluster, restoring the owner references.
+	MovePhaseCreate MovePhase = "Create"
+
+	// MovePhaseVerify is the phase where the objects created in the target management cluster are checked against the objects being moved.
+	MovePhaseVerify MovePhase = "Verify"
+
+	// MovePhaseDelete is the phase where the objects are deleted from the source management cluster.
+	MovePhaseDelete MovePhase = "Delete"
+
+	// MovePhaseResume is the phase where Clusters and ClusterClasses are resumed in the target management cluster.
+	MovePhaseResume MovePhase = "Resume"
+)
+
+const (
+	// moveCheckpointFileName is the name of the file storing the checkpoint in the checkpoint directory.
+	moveCheckpointFileName = "checkpoint.yaml"
+
+	// moveCheckpointBackupDirectoryName is the name of the directory storing the backup of the moved objects in the checkpoint directory.
+	moveCheckpointBackupDirectoryName = "objects"
+)
+
+// MoveCheckpointOptions defines the options for tracking the progress of a move operation, so it can be resumed if it fails mid-way.
+type MoveCheckpointOptions struct {
+	// Directory where the checkpoint and a backup of the objects being moved are stored.
+	// If empty, the progress of the move operation is not tracked.
+	Directory string
+
+	// Resume resumes the move operation tracked by the checkpoint in Directory, skipping the phases that are already completed.
+	Resume bool
+}
+
+// moveCheckpoint tracks the progress of a move operation.
+type moveCheckpoint struct {
+	// path of the checkpoint file.
+	path string
+
+	// Namespace being moved.
+	Namespace string `json:"namespace,omitempty"`
+
+	// CompletedPhases is the list of completed phases.
+	CompletedPhases []MovePhase `json:"completedPhases,omitempty"`
+
+	// Clusters being moved; they are tracked because after the Delete phase they don't exist anymore in the source management cluster.
+	Clusters []corev1.ObjectReference `json:"clusters,omitempty"`
+
+	// ClusterClasses being moved; they are tracked because after the Delete phase they might not exist anymore in the source management cluster.
+	ClusterClasses []corev1.ObjectReference `json:"clusterClasses,omitempty"`
+
+	// NewUIDs maps the UID of the objects in the source management cluster to the UID of the corresponding objects
+	// created in the target management cluster.
+	NewUIDs map[types.UID]types.UID `json:"newUIDs,omitempty"`
+}
+
+// newMoveCheckpoint returns the checkpoint for a move operation, or nil if the progress of the move operation is not tracked.
+func newMoveCheckpoint(options MoveCheckpointOptions, namespace string) (*moveCheckpoint, error) {
+	if options.Directory == "" {
+		if options.Resume {
+			return nil, pkgerrors.New("a checkpoint directory is required to resume a move")
+		}
+		return nil, nil
+	}
+
+	checkpoint := &moveCheckpoint{
+		path:      filepath.Join(options.Directory, moveCheckpointFileName),
+		Namespace: namespace,
+	}
+
+	data, err := os.ReadFile(checkpoint.path)
+	switch {
+	case os.IsNotExist(err):
+		if options.Resume {
+			return nil, pkgerrors.Errorf("failed to resume move: checkpoint %s does not exist", checkpoint.path)
+		}
+	case err != nil:
+		return nil, pkgerrors.Wrapf(err, "failed to read checkpoint %s", checkpoint.path)
+	default:
+		if !options.Resume {
+			return nil, pkgerrors.Errorf("checkpoint %s already exists: resume the previous move or delete the checkpoint", checkpoint.path)
+		}
+		if err := yaml.Unmarshal(data, checkpoint); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse checkpoint %s", checkpoint.path)
+		}
+		if checkpoint.Namespace != namespace {
+			return nil, pkgerrors.Errorf("failed to resume move: checkpoint %s is for namespace %q, not %q", checkpoint.path, checkpoint.Namespace, namespace)
+		}
+	}
+
+	if err := os.MkdirAll(checkpoint.backupDirectory(), 0o750); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to create directory %s", checkpoint.backupDirectory())
+	}
+	return checkpoint, checkpoint.save()
+}
+
+// backupDirectory returns the directory storing the backup of the objects being moved.
+func (c *moveCheckpoint) backupDirectory() string {
+	return filepath.Join(filepath.Dir(c.path), moveCheckpointBackupDirectoryName)
+}
+
+// isCompleted returns true if the phase has been completed.
+func (c *moveCheckpoint) isCompleted(phase MovePhase) bool {
+	if c == nil {
+		return false
+	}
+	return slices.Contains(c.CompletedPhases, phase)
+}
+
+// complete marks the phase as completed.
+func (c *moveCheckpoint) complete(phase MovePhase) error {
+	if c == nil || c.isCompleted(phase) {
+		return nil
+	}
+	c.CompletedPhases = append(c.CompletedPhases, phase)
+	return c.save()
+}
+
+// setPausedObjects records the Clusters and the ClusterClasses paused by the move.
+func (c *moveCheckpoint) setPausedObjects(clusters, clusterClasses []*node) {
+	if c == nil {
+		return
+	}
+	c.Clusters = nil
+	for _, n := range clusters {
+		c.Clusters = append(c.Clusters, n.identity)
+	}
+	c.ClusterClasses = nil
+	for _, n := range clusterClasses {
+		c.ClusterClasses = append(c.ClusterClasses, n.identity)
+	}
+}
+
+// pausedObjects returns the Clusters and the ClusterClasses paused by the move.
+// Before the Pause phase is completed, the Clusters and the ClusterClasses in the graph are returned.
+func (c *moveCheckpoint) pausedObjects(graph *objectGraph) (clusters, clusterClasses []*node) {
+	if !c.isCompleted(MovePhasePause) {
+		return graph.getClusters(), graph.getClusterClasses()
+	}
+
+	// NOTE: objects still existing in the source management cluster are picked from the graph, so their
+	// attributes e.g. shouldNotDelete are preserved.
+	toNode := func(ref corev1.ObjectReference) *node {
+		if n, ok := graph.uidToNode[ref.UID]; ok {
+			return n
+		}
+		return &node{identity: ref}
+	}
+	for _, ref := range c.Clusters {
+		clusters = append(clusters, toNode(ref))
+	}
+	for _, ref := range c.ClusterClasses {
+		clusterClasses = append(clusterClasses, toNode(ref))
+	}
+	return clusters, clusterClasses
+}
+
+// setNewUIDs records the UIDs of the objects created in the target management cluster.
+func (c *moveCheckpoint) setNewUIDs(group moveGroup) error {
+	if c == nil {
+		return nil
+	}
+	if c.NewUIDs == nil {
+		c.NewUIDs = map[types.UID]types.UID{}
+	}
+	for _, n := range group {
+		c.NewUIDs[n.identity.UID] = n.newUID
+	}
+	return c.save()
+}
+
+// restoreNewUIDs sets the UIDs of the objects created in the target management cluster to the nodes in the graph.
+func (c *moveCheckpoint) restoreNewUIDs(graph *objectGraph) {
+	if c == nil {
+		return
+	}
+	for uid, n := range graph.uidToNode {
+		if newUID, ok := c.NewUIDs[uid]; ok {
+			n.newUID = newUID
+		}
+	}
+}
+
+// save writes the checkpoint file.
+func (c *moveCheckpoint) save() error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to marshal checkpoint")
+	}
+
+	// Write to a temporary file first, so the checkpoint is never left partially written.
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write checkpoint %s", tmpPath)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write checkpoint %s", c.path)
+	}
+	return nil
+}
+
+// remove deletes the checkpoint file; the backup of the moved objects is preserved.
+func (c *moveCheckpoint) remove() error {
+	if c == nil {
+		return nil
+	}
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return pkgerrors.Wrapf(err, "failed to delete checkpoint %s", c.path)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_newMoveCheckpoint(t *testing.T) {
+	t.Run("returns nil if the checkpoint directory is not set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		checkpoint, err := newMoveCheckpoint(MoveCheckpointOptions{}, "ns1")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checkpoint).To(BeNil())
+
+		// All the methods must be no-ops on a nil checkpoint.
+		g.Expect(checkpoint.isCompleted(MovePhasePause)).To(BeFalse())
+		g.Expect(checkpoint.complete(MovePhasePause)).To(Succeed())
+		g.Expect(checkpoint.setNewUIDs(moveGroup{})).To(Succeed())
+		g.Expect(checkpoint.remove()).To(Succeed())
+	})
+	t.Run("returns an error if resume is set without a checkpoint directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newMoveCheckpoint(MoveCheckpointOptions{Resume: true}, "ns1")
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("returns an error if resume is set and the checkpoint does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newMoveCheckpoint(MoveCheckpointOptions{Directory: t.TempDir(), Resume: true}, "ns1")
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("creates a new checkpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		checkpoint, err := newMoveCheckpoint(MoveCheckpointOptions{Directory: dir}, "ns1")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checkpoint).ToNot(BeNil())
+		g.Expect(filepath.Join(dir, moveCheckpointFileName)).To(BeAnExistingFile())
+		g.Expect(filepath.Join(dir, moveCheckpointBackupDirectoryName)).To(BeADirectory())
+
+		// A second move using the same directory must be explicitly resumed.
+		_, err = newMoveCheckpoint(MoveCheckpointOptions{Directory: dir}, "ns1")
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("resumes an existing checkpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		checkpoint, err := newMoveCheckpoint(MoveCheckpointOptions{Directory: dir}, "ns1")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		cluster := &node{identity: corev1.ObjectReference{APIVersion: "cluster.x-k8s.io/v1beta2", Kind: "Cluster", Namespace: "ns1", Name: "foo", UID: "cluster-uid"}}
+		clusterClass := &node{identity: corev1.ObjectReference{APIVersion: "cluster.x-k8s.io/v1beta2", Kind: "ClusterClass", Namespace: "ns1", Name: "bar", UID: "cc-uid"}}
+		checkpoint.setPausedObjects([]*node{cluster}, []*node{clusterClass})
+		g.Expect(checkpoint.complete(MovePhasePause)).To(Succeed())
+
+		cluster.newUID = "new-cluster-uid"
+		g.Expect(checkpoint.setNewUIDs(moveGroup{cluster})).To(Succeed())
+
+		resumed, err := newMoveCheckpoint(MoveCheckpointOptions{Directory: dir, Resume: true}, "ns1")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resumed.isCompleted(MovePhasePause)).To(BeTrue())
+		g.Expect(resumed.isCompleted(MovePhaseCreate)).To(BeFalse())
+		g.Expect(resumed.NewUIDs).To(Equal(map[types.UID]types.UID{"cluster-uid": "new-cluster-uid"}))
+
+		// Paused objects not existing anymore in the source management cluster are restored from the checkpoint.
+		graph := newObjectGraph(nil, nil)
+		clusters, clusterClasses := resumed.pausedObjects(graph)
+		g.Expect(clusters).To(HaveLen(1))
+		g.Expect(clusters[0].identity).To(Equal(cluster.identity))
+		g.Expect(clusterClasses).To(HaveLen(1))
+		g.Expect(clusterClasses[0].identity).To(Equal(clusterClass.identity))
+
+		// Resuming a move for a different namespace is not allowed.
+		_, err = newMoveCheckpoint(MoveCheckpointOptions{Directory: dir, Resume: true}, "ns2")
+		g.Expect(err).To(HaveOccurred())
+
+		g.Expect(resumed.remove()).To(Succeed())
+		_, err = os.Stat(filepath.Join(dir, moveCheckpointFileName))
+		g.Expect(os.IsNotExist(err)).To(BeTrue())
+		g.Expect(filepath.Join(dir, moveCheckpointBackupDirectoryName)).To(BeADirectory())
+	})
+}
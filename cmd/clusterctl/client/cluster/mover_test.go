@@ -1369,6 +1369,51 @@ func Test_objectMover_move(t *testing.T) {
 	}
 }
 
+func Test_objectMover_move_with_Checkpoint(t *testing.T) {
+	for _, tt := range moveTests {
+		if tt.wantErr {
+			continue
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			// Create an objectGraph bound a source cluster with all the CRDs for the types involved in the test.
+			graph := getObjectGraphWithObjs(tt.fields.objs)
+
+			// Get all the types to be considered for discovery
+			g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+
+			// trigger discovery the content of the source cluster
+			g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+			// gets a fakeProxy to an empty cluster with all the required CRDs
+			toProxy := getFakeProxyWithCRDs()
+
+			dir := t.TempDir()
+			checkpoint, err := newMoveCheckpoint(MoveCheckpointOptions{Directory: dir}, "")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// Run move
+			mover := objectMover{
+				fromProxy:  graph.proxy,
+				checkpoint: checkpoint,
+			}
+			g.Expect(mover.move(ctx, graph, toProxy)).To(Succeed())
+
+			// the checkpoint is removed after a successful move, while the backup of the moved objects is preserved.
+			g.Expect(filepath.Join(dir, moveCheckpointFileName)).ToNot(BeAnExistingFile())
+			backup, err := os.ReadDir(filepath.Join(dir, moveCheckpointBackupDirectoryName))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(backup).ToNot(BeEmpty())
+			g.Expect(checkpoint.CompletedPhases).To(Equal([]MovePhase{
+				MovePhasePause, MovePhaseBackup, MovePhaseCreate, MovePhaseVerify, MovePhaseDelete, MovePhaseResume,
+			}))
+		})
+	}
+}
+
 func Test_objectMover_move_with_Mutator(t *testing.T) {
 	// NB. we are testing the move and move sequence using the same set of moveTests, but checking the results at different stages of the move process
 	// we use same mutator function for all tests and validate outcome based on input.
@@ -30,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -108,6 +109,9 @@ type node struct {
 	// blockingMove is true when the object should prevent a move operation from proceeding as indicated by
 	// the presence of the block-move annotation.
 	blockingMove bool
+
+	// labels of the object, used to apply the label selector of a MoveFilter.
+	labels map[string]string
 }
 
 type discoveryTypeInfo struct {
@@ -316,6 +320,7 @@ func (o *objectGraph) objInfoToNode(obj *unstructured.Unstructured, n *node) err
 
 func (o *objectGraph) objMetaToNode(obj *unstructured.Unstructured, n *node) {
 	n.identity.Namespace = obj.GetNamespace()
+	n.labels = obj.GetLabels()
 	if _, ok := obj.GetLabels()[clusterctlv1.ClusterctlMoveLabel]; ok {
 		n.forceMove = true
 	}
@@ -784,6 +789,174 @@ func (o *objectGraph) setTenantHierarchy(node, tenant *node, isGlobalHierarchy b
 	}
 }
 
+// applyMoveFilter restricts the object graph to the objects selected by the filter, their descendants and their dependencies.
+// Objects selected by the filter are the objects moved with their hierarchy or labeled for move (e.g. Clusters, ClusterClasses,
+// ClusterResourceSets) matching the kind filters; when a label selector is set, only the Clusters matching it are selected.
+// Dependencies are computed by following the owner and soft owner references of the selected objects, e.g. the ClusterClass
+// used by a selected Cluster; dependencies which are still used by objects not being moved are not deleted from the source cluster.
+// NOTE: this func must be called after setTenants.
+func (o *objectGraph) applyMoveFilter(filter MoveFilter) error {
+	isExcludedKind := func(n *node) bool {
+		return slices.ContainsFunc(filter.ExcludeKinds, func(kind string) bool { return strings.EqualFold(kind, n.identity.Kind) })
+	}
+	isIncludedKind := func(n *node) bool {
+		return len(filter.IncludeKinds) == 0 || slices.ContainsFunc(filter.IncludeKinds, func(kind string) bool { return strings.EqualFold(kind, n.identity.Kind) })
+	}
+	isCluster := func(n *node) bool {
+		return n.identity.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind()
+	}
+	hasSelector := filter.Selector != nil && !filter.Selector.Empty()
+
+	// Drop the objects of the excluded kinds and all the objects depending on them.
+	dropped := map[*node]empty{}
+	isDropped := func(n *node) bool {
+		_, ok := dropped[n]
+		return ok
+	}
+	dependsOnDropped := func(n *node) bool {
+		for owner := range n.owners {
+			if isDropped(owner) {
+				return true
+			}
+		}
+		for owner := range n.softOwners {
+			if isDropped(owner) {
+				return true
+			}
+		}
+		return false
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, n := range o.getNodes() {
+			if !isDropped(n) && (isExcludedKind(n) || dependsOnDropped(n)) {
+				dropped[n] = empty{}
+				changed = true
+			}
+		}
+	}
+
+	// Select the root objects for the move.
+	selectedClusters := map[*node]empty{}
+	roots := []*node{}
+	for _, n := range o.getNodes() {
+		if n.virtual || !(n.forceMove || n.forceMoveHierarchy) || isDropped(n) || !isIncludedKind(n) {
+			continue
+		}
+		if hasSelector && (!isCluster(n) || !filter.Selector.Matches(labels.Set(n.labels))) {
+			continue
+		}
+		if isCluster(n) {
+			selectedClusters[n] = empty{}
+		}
+		roots = append(roots, n)
+	}
+
+	// belongsToNotSelectedCluster returns true if the node is part of the hierarchy of a Cluster which is not selected.
+	belongsToNotSelectedCluster := func(n *node) bool {
+		for tenant := range n.tenant {
+			if _, ok := selectedClusters[tenant]; isCluster(tenant) && !ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	keep := map[*node]empty{}
+	// addWithHierarchy adds a node and, if the node is moved with its hierarchy, all the nodes in its hierarchy.
+	addWithHierarchy := func(root *node) {
+		keep[root] = empty{}
+		if !root.forceMoveHierarchy {
+			return
+		}
+		for _, n := range o.getNodes() {
+			if _, ok := n.tenant[root]; !ok || isDropped(n) || belongsToNotSelectedCluster(n) {
+				continue
+			}
+			keep[n] = empty{}
+		}
+	}
+	for _, root := range roots {
+		addWithHierarchy(root)
+	}
+
+	// Add the dependencies of the nodes being moved.
+	// NOTE: dependencies can't be dropped, otherwise the nodes depending on them would have been dropped as well.
+	for changed := true; changed; {
+		changed = false
+		for n := range keep {
+			owners := slices.Collect(maps.Keys(n.owners))
+			owners = append(owners, slices.Collect(maps.Keys(n.softOwners))...)
+			for _, owner := range owners {
+				if _, ok := keep[owner]; ok {
+					continue
+				}
+				if isCluster(owner) {
+					return pkgerrors.Errorf("%s depends on %s, which is not selected for move", n.identityStr(), owner.identityStr())
+				}
+				addWithHierarchy(owner)
+				changed = true
+			}
+		}
+	}
+
+	// Dependencies which are still used by objects not being moved must not be deleted from the source cluster;
+	// this applies also to their descendants, except the ones belonging to the selected Clusters.
+	var setShouldNotDeleteHierarchy func(n *node)
+	setShouldNotDeleteHierarchy = func(n *node) {
+		n.shouldNotDelete = true
+		for other := range keep {
+			if other.shouldNotDelete || !other.isOwnedBy(n) {
+				continue
+			}
+			if slices.ContainsFunc(slices.Collect(maps.Keys(other.tenant)), func(tenant *node) bool {
+				_, ok := selectedClusters[tenant]
+				return ok
+			}) {
+				continue
+			}
+			setShouldNotDeleteHierarchy(other)
+		}
+	}
+	// NOTE: dropped objects are not considered, because they are intentionally left in the source cluster.
+	for n := range keep {
+		shared := false
+		for tenant := range n.tenant {
+			if _, ok := keep[tenant]; !ok && !isDropped(tenant) {
+				shared = true
+			}
+		}
+		if n.forceMoveHierarchy {
+			for _, other := range o.getNodes() {
+				if _, ok := keep[other]; ok || isDropped(other) {
+					continue
+				}
+				if _, ok := other.tenant[n]; ok {
+					shared = true
+				}
+			}
+		}
+		if shared {
+			setShouldNotDeleteHierarchy(n)
+		}
+	}
+
+	// Remove all the nodes which are not going to be moved from the graph.
+	for uid, n := range o.uidToNode {
+		if _, ok := keep[n]; !ok {
+			delete(o.uidToNode, uid)
+		}
+	}
+	for n := range keep {
+		for tenant := range n.tenant {
+			if _, ok := keep[tenant]; !ok {
+				delete(n.tenant, tenant)
+			}
+		}
+	}
+	return nil
+}
+
 // checkVirtualNode logs if nodes are still virtual.
 func (o *objectGraph) checkVirtualNode() {
 	log := logf.Log
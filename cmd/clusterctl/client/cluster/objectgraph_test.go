@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"testing"
 
@@ -27,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -2814,3 +2816,120 @@ func newGraphNode(uid string) *node {
 		tenant:     map[*node]empty{},
 	}
 }
+
+func Test_objectGraph_applyMoveFilter(t *testing.T) {
+	objs := func() []client.Object {
+		objs := []client.Object{}
+		objs = append(objs, test.NewFakeClusterClass("ns1", "class1").Objs()...)
+		objs = append(objs, test.NewFakeCluster("ns1", "cluster1").WithTopologyClass("class1").Objs()...)
+		objs = append(objs, test.NewFakeCluster("ns1", "cluster2").WithTopologyClass("class1").Objs()...)
+		objs = append(objs, test.NewFakeClusterResourceSet("ns1", "crs1").
+			WithSecret("resource-s1").
+			ApplyToCluster(test.SelectClusterObj(objs, "ns1", "cluster1")).
+			ApplyToCluster(test.SelectClusterObj(objs, "ns1", "cluster2")).
+			Objs()...)
+
+		for _, o := range objs {
+			if o.GetObjectKind().GroupVersionKind().Kind == "Cluster" && o.GetName() == "cluster1" {
+				o.SetLabels(map[string]string{"env": "prod"})
+			}
+		}
+		return objs
+	}
+
+	cluster1Nodes := []string{
+		clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster1",
+		clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/cluster1",
+		"/v1, Kind=Secret, ns1/cluster1-ca",
+		"/v1, Kind=Secret, ns1/cluster1-kubeconfig",
+	}
+	cluster2Nodes := []string{
+		clusterv1.GroupVersion.String() + ", Kind=Cluster, ns1/cluster2",
+		clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureCluster, ns1/cluster2",
+		"/v1, Kind=Secret, ns1/cluster2-ca",
+		"/v1, Kind=Secret, ns1/cluster2-kubeconfig",
+	}
+	clusterClassNodes := []string{
+		clusterv1.GroupVersion.String() + ", Kind=ClusterClass, ns1/class1",
+		clusterv1.GroupVersionInfrastructure.String() + ", Kind=GenericInfrastructureClusterTemplate, ns1/class1",
+		clusterv1.GroupVersionControlPlane.String() + ", Kind=GenericControlPlaneTemplate, ns1/class1",
+	}
+	crsNodes := []string{
+		addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSet, ns1/crs1",
+		"/v1, Kind=Secret, ns1/resource-s1",
+	}
+
+	tests := []struct {
+		name                string
+		filter              func() MoveFilter
+		wantNodes           []string
+		wantShouldNotDelete []string
+	}{
+		{
+			name: "Selector moves the selected Cluster and its dependencies, shared dependencies are not deleted",
+			filter: func() MoveFilter {
+				selector, err := labels.Parse("env=prod")
+				if err != nil {
+					panic(err)
+				}
+				return MoveFilter{Selector: selector}
+			},
+			wantNodes: slices.Concat(cluster1Nodes, clusterClassNodes, crsNodes, []string{
+				addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSetBinding, ns1/cluster1",
+			}),
+			wantShouldNotDelete: slices.Concat(clusterClassNodes, crsNodes),
+		},
+		{
+			name: "ExcludeKinds drops the objects of the given kinds and the objects depending on them",
+			filter: func() MoveFilter {
+				return MoveFilter{ExcludeKinds: []string{"clusterresourceset"}}
+			},
+			wantNodes: slices.Concat(cluster1Nodes, cluster2Nodes, clusterClassNodes),
+		},
+		{
+			name: "IncludeKinds moves only objects of the given kinds and their dependencies",
+			filter: func() MoveFilter {
+				return MoveFilter{IncludeKinds: []string{"Cluster"}}
+			},
+			wantNodes: slices.Concat(cluster1Nodes, cluster2Nodes, clusterClassNodes, crsNodes, []string{
+				addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSetBinding, ns1/cluster1",
+				addonsv1.GroupVersion.String() + ", Kind=ClusterResourceSetBinding, ns1/cluster2",
+			}),
+		},
+		{
+			name: "Nothing is moved if the selected Clusters depend on excluded kinds",
+			filter: func() MoveFilter {
+				selector, err := labels.Parse("env=prod")
+				if err != nil {
+					panic(err)
+				}
+				return MoveFilter{Selector: selector, ExcludeKinds: []string{"ClusterClass"}}
+			},
+			wantNodes: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			gb, err := getDetachedObjectGraphWihObjs(objs())
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gb.setSoftOwnership()
+			gb.setTenants()
+
+			g.Expect(gb.applyMoveFilter(tt.filter())).To(Succeed())
+
+			gotNodes := []string{}
+			gotShouldNotDelete := []string{}
+			for _, node := range gb.getNodes() {
+				gotNodes = append(gotNodes, string(node.identity.UID))
+				if node.shouldNotDelete {
+					gotShouldNotDelete = append(gotShouldNotDelete, string(node.identity.UID))
+				}
+			}
+			g.Expect(gotNodes).To(ConsistOf(tt.wantNodes))
+			g.Expect(gotShouldNotDelete).To(ConsistOf(tt.wantShouldNotDelete))
+		})
+	}
+}
@@ -55,12 +55,24 @@ type ProviderUpgrader interface {
 type UpgradePlan struct {
 	Contract  string
 	Providers []UpgradeItem
+
+	// IncompatibleRuntimeExtensions lists the Runtime Extensions registered in the management cluster
+	// which are not supported by the target version of the core provider.
+	IncompatibleRuntimeExtensions []IncompatibleRuntimeExtension
 }
 
 // UpgradeOptions defines the options used to upgrade installation.
 type UpgradeOptions struct {
 	WaitProviders       bool
 	WaitProviderTimeout time.Duration
+
+	// IgnoreRuntimeExtensionsCompatibility allows to upgrade the core provider to a version
+	// which does not support the Runtime Extensions registered in the management cluster.
+	IgnoreRuntimeExtensionsCompatibility bool
+
+	// ValidateImages instructs the upgrader to check that the images of the target versions of the providers
+	// exist in their registries before upgrading any provider.
+	ValidateImages bool
 }
 
 // isPartialUpgrade returns true if at least one upgradeItem in the plan does not have a target version.
@@ -149,6 +161,16 @@ func (u *providerUpgrader) Plan(ctx context.Context) ([]UpgradePlan, error) {
 			continue
 		}
 
+		for _, upgradeItem := range upgradePlan.Providers {
+			if upgradeItem.InstanceName() != coreProvider.InstanceName() {
+				continue
+			}
+			upgradePlan.IncompatibleRuntimeExtensions, err = u.getIncompatibleRuntimeExtensions(ctx, coreProvider, upgradeItem.NextVersion)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		ret = append(ret, *upgradePlan)
 	}
 
@@ -374,6 +396,11 @@ func (u *providerUpgrader) doUpgrade(ctx context.Context, upgradePlan *UpgradePl
 		return err
 	}
 
+	// Block upgrades of the core provider to versions not supporting the Runtime Extensions registered in the management cluster.
+	if err := u.checkRuntimeExtensionsCompatibility(ctx, upgradePlan, opts.IgnoreRuntimeExtensionsCompatibility); err != nil {
+		return err
+	}
+
 	// Block unsupported skip upgrades for Core, Kubeadm Bootstrap, Kubeadm ControlPlane.
 	// NOTE: in future we might consider extending the clusterctl contract to support enforcing of skip upgrade
 	// rules for out of tree providers.
@@ -410,6 +437,25 @@ func (u *providerUpgrader) doUpgrade(ctx context.Context, upgradePlan *UpgradePl
 		return providers[a].GetProviderType().Order() < providers[b].GetProviderType().Order()
 	})
 
+	// If required, validate that the images of the target versions of the providers exist before changing anything.
+	if opts.ValidateImages {
+		images := []string{}
+		for _, upgradeItem := range providers {
+			if upgradeItem.NextVersion == "" {
+				continue
+			}
+			components, err := u.getUpgradeComponents(ctx, upgradeItem)
+			if err != nil {
+				return err
+			}
+			images = append(images, components.Images()...)
+		}
+		logf.Log.Info("Validating images", "count", len(images))
+		if err := repository.ValidateImages(ctx, u.configClient.Variables(), images); err != nil {
+			return pkgerrors.Wrap(err, "failed to validate images")
+		}
+	}
+
 	// Scale down all providers.
 	// This is done to ensure all Pods of all "old" provider Deployments have been deleted.
 	// Otherwise it can happen that a provider Pod survives the upgrade because we create
@@ -463,7 +509,10 @@ func (u *providerUpgrader) doUpgrade(ctx context.Context, upgradePlan *UpgradePl
 		}
 	}
 
-	return waitForProvidersReady(ctx, InstallOptions(opts), installQueue, u.proxy)
+	return waitForProvidersReady(ctx, InstallOptions{
+		WaitProviders:       opts.WaitProviders,
+		WaitProviderTimeout: opts.WaitProviderTimeout,
+	}, installQueue, u.proxy)
 }
 
 func (u *providerUpgrader) scaleDownProvider(ctx context.Context, provider clusterctlv1.Provider) error {
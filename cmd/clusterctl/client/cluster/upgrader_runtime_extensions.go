@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+// IncompatibleRuntimeExtension defines a Runtime Extension handler registered in the management cluster
+// which is not supported by the target version of the core provider.
+type IncompatibleRuntimeExtension struct {
+	// ExtensionConfig is the name of the ExtensionConfig registering the handler.
+	ExtensionConfig string
+
+	// Handler is the name of the handler.
+	Handler string
+
+	// APIVersion is the Runtime Hooks API version of the hook served by the handler.
+	APIVersion string
+
+	// Hook is the name of the hook served by the handler.
+	Hook string
+}
+
+func (e IncompatibleRuntimeExtension) String() string {
+	return fmt.Sprintf("ExtensionConfig %s, handler %s (%s, %s)", e.ExtensionConfig, e.Handler, e.APIVersion, e.Hook)
+}
+
+// getIncompatibleRuntimeExtensions returns the Runtime Extension handlers registered in the management cluster
+// which serve a Runtime Hooks API version not supported by the target version of the core provider.
+// If the metadata of the core provider does not define the supported Runtime Hooks API versions, the check is skipped.
+func (u *providerUpgrader) getIncompatibleRuntimeExtensions(ctx context.Context, coreProvider clusterctlv1.Provider, targetVersion string) ([]IncompatibleRuntimeExtension, error) {
+	if targetVersion == "" {
+		return nil, nil
+	}
+	targetSemVersion, err := version.ParseSemantic(targetVersion)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse target version for the %s provider", coreProvider.InstanceName())
+	}
+
+	coreUpgradeInfo, err := u.getUpgradeInfo(ctx, coreProvider)
+	if err != nil {
+		return nil, err
+	}
+	releaseSeries := coreUpgradeInfo.metadata.GetReleaseSeriesForVersion(targetSemVersion)
+	if releaseSeries == nil || len(releaseSeries.RuntimeHooksAPIVersions) == 0 {
+		return nil, nil
+	}
+	supportedAPIVersions := sets.New(releaseSeries.RuntimeHooksAPIVersions...)
+
+	c, err := u.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	extensionConfigs := &runtimev1.ExtensionConfigList{}
+	if err := c.List(ctx, extensionConfigs); err != nil {
+		// If the ExtensionConfig CRD is not installed, there are no Runtime Extensions to check.
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, pkgerrors.Wrap(err, "failed to list ExtensionConfigs")
+	}
+
+	incompatible := []IncompatibleRuntimeExtension{}
+	for _, extensionConfig := range extensionConfigs.Items {
+		for _, handler := range extensionConfig.Status.Handlers {
+			if supportedAPIVersions.Has(handler.RequestHook.APIVersion) {
+				continue
+			}
+			incompatible = append(incompatible, IncompatibleRuntimeExtension{
+				ExtensionConfig: extensionConfig.Name,
+				Handler:         handler.Name,
+				APIVersion:      handler.RequestHook.APIVersion,
+				Hook:            handler.RequestHook.Hook,
+			})
+		}
+	}
+	return incompatible, nil
+}
+
+// checkRuntimeExtensionsCompatibility checks that the Runtime Extensions registered in the management cluster are
+// supported by the core provider version in the upgrade plan; if ignoreIncompatible is set, only warnings are logged.
+func (u *providerUpgrader) checkRuntimeExtensionsCompatibility(ctx context.Context, upgradePlan *UpgradePlan, ignoreIncompatible bool) error {
+	for _, upgradeItem := range upgradePlan.Providers {
+		if upgradeItem.Type != string(clusterctlv1.CoreProviderType) {
+			continue
+		}
+
+		incompatible, err := u.getIncompatibleRuntimeExtensions(ctx, upgradeItem.Provider, upgradeItem.NextVersion)
+		if err != nil {
+			return err
+		}
+		if len(incompatible) == 0 {
+			return nil
+		}
+
+		handlers := make([]string, 0, len(incompatible))
+		for _, e := range incompatible {
+			handlers = append(handlers, e.String())
+		}
+		if ignoreIncompatible {
+			log := logf.Log
+			log.Info(fmt.Sprintf("Warning: the following Runtime Extensions are not supported by %s %s and will stop working after the upgrade: %s",
+				upgradeItem.InstanceName(), upgradeItem.NextVersion, strings.Join(handlers, "; ")))
+			return nil
+		}
+		return pkgerrors.Errorf("unable to perform upgrade: the following Runtime Extensions are not supported by %s %s: %s. "+
+			"Upgrade the Runtime Extensions first, or ignore this check if the Runtime Extensions are not required anymore",
+			upgradeItem.InstanceName(), upgradeItem.NextVersion, strings.Join(handlers, "; "))
+	}
+	return nil
+}
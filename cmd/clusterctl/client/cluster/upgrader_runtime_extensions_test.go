@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_providerUpgrader_checkRuntimeExtensionsCompatibility(t *testing.T) {
+	extensionConfig := &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-extension"},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name:        "before-cluster-upgrade.test-extension",
+					RequestHook: runtimev1.GroupVersionHook{APIVersion: "hooks.runtime.cluster.x-k8s.io/v1alpha1", Hook: "BeforeClusterUpgrade"},
+				},
+				{
+					Name:        "generate-patches.test-extension",
+					RequestHook: runtimev1.GroupVersionHook{APIVersion: "hooks.runtime.cluster.x-k8s.io/v1alpha2", Hook: "GeneratePatches"},
+				},
+			},
+		},
+	}
+	coreRepository := repository.NewMemoryRepository().
+		WithVersions("v1.0.0", "v1.0.1", "v1.1.0").
+		WithMetadata("v1.1.0", &clusterctlv1.Metadata{
+			ReleaseSeries: []clusterctlv1.ReleaseSeries{
+				{Major: 1, Minor: 0, Contract: currentContractVersion},
+				{Major: 1, Minor: 1, Contract: currentContractVersion, RuntimeHooksAPIVersions: []string{"hooks.runtime.cluster.x-k8s.io/v1alpha2"}},
+			},
+		})
+
+	tests := []struct {
+		name               string
+		nextVersion        string
+		ignoreIncompatible bool
+		wantIncompatible   []IncompatibleRuntimeExtension
+		wantErr            bool
+	}{
+		{
+			name:        "no check if the target release series does not define the Runtime Hooks API versions",
+			nextVersion: "v1.0.1",
+		},
+		{
+			name:        "block upgrades breaking registered Runtime Extensions",
+			nextVersion: "v1.1.0",
+			wantIncompatible: []IncompatibleRuntimeExtension{
+				{
+					ExtensionConfig: "test-extension",
+					Handler:         "before-cluster-upgrade.test-extension",
+					APIVersion:      "hooks.runtime.cluster.x-k8s.io/v1alpha1",
+					Hook:            "BeforeClusterUpgrade",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:               "allow upgrades breaking registered Runtime Extensions if ignored",
+			nextVersion:        "v1.1.0",
+			ignoreIncompatible: true,
+			wantIncompatible: []IncompatibleRuntimeExtension{
+				{
+					ExtensionConfig: "test-extension",
+					Handler:         "before-cluster-upgrade.test-extension",
+					APIVersion:      "hooks.runtime.cluster.x-k8s.io/v1alpha1",
+					Hook:            "BeforeClusterUpgrade",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			reader := test.NewFakeReader().WithProvider("cluster-api", clusterctlv1.CoreProviderType, "https://somewhere.com")
+			configClient, _ := config.New(ctx, "", config.InjectReader(reader))
+			proxy := test.NewFakeProxy().
+				WithProviderInventory("cluster-api", clusterctlv1.CoreProviderType, "v1.0.0", "cluster-api-system").
+				WithObjs(extensionConfig)
+
+			u := &providerUpgrader{
+				configClient: configClient,
+				proxy:        proxy,
+				repositoryClientFactory: func(ctx context.Context, provider config.Provider, configClient config.Client, _ ...repository.Option) (repository.Client, error) {
+					return repository.New(ctx, provider, configClient, repository.InjectRepository(coreRepository))
+				},
+			}
+
+			coreProvider := fakeProvider("cluster-api", clusterctlv1.CoreProviderType, "v1.0.0", "cluster-api-system")
+			incompatible, err := u.getIncompatibleRuntimeExtensions(ctx, coreProvider, tt.nextVersion)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tt.wantIncompatible == nil {
+				g.Expect(incompatible).To(BeEmpty())
+			} else {
+				g.Expect(incompatible).To(Equal(tt.wantIncompatible))
+			}
+
+			err = u.checkRuntimeExtensionsCompatibility(ctx, &UpgradePlan{
+				Contract:  currentContractVersion,
+				Providers: []UpgradeItem{{Provider: coreProvider, NextVersion: tt.nextVersion}},
+			}, tt.ignoreIncompatible)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
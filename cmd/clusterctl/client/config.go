@@ -146,6 +146,12 @@ type GetClusterTemplateOptions struct {
 	// It can be set through the cli flag, WORKER_MACHINE_COUNT environment variable or will default to 0
 	WorkerMachineCount *int64
 
+	// ValuesFile is the path to a YAML file providing values for the template variables and for the
+	// ClusterClass variables of the Clusters defined in the template; values are validated against the
+	// variables declared by the template and against the ClusterClass variable schemas.
+	// Values explicitly set using other options take precedence over the ones in the values file.
+	ValuesFile string
+
 	// ListVariablesOnly sets the GetClusterTemplate method to return the list of variables expected by the template
 	// without executing any further processing.
 	ListVariablesOnly bool
@@ -238,12 +244,54 @@ func (c *clusterctlClient) GetClusterTemplate(ctx context.Context, options GetCl
 		options.TargetNamespace = currentNamespace
 	}
 
+	// If a values file is provided, inject its template variables into the configClient before the templateOptions,
+	// so values explicitly set using the templateOptions take precedence.
+	var values *templateValues
+	if options.ValuesFile != "" {
+		values, err = readTemplateValues(options.ValuesFile)
+		if err != nil {
+			return nil, err
+		}
+		values.setVariables(c.configClient.Variables())
+	}
+
 	// Inject some of the templateOptions into the configClient so they can be consumed as a variables from the template.
 	if err := c.templateOptionsToVariables(options); err != nil {
 		return nil, err
 	}
 
-	// Gets the workload cluster template from the selected source
+	if values == nil || options.ListVariablesOnly {
+		return c.getTemplate(ctx, clusterClient, options)
+	}
+
+	// Validate the values file against the variables declared by the template before processing it, so
+	// all the missing or unknown variables are reported at once.
+	listVariablesOptions := options
+	listVariablesOptions.ListVariablesOnly = true
+	templateMetadata, err := c.getTemplate(ctx, clusterClient, listVariablesOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err := values.validateVariables(templateMetadata, c.configClient.Variables()); err != nil {
+		return nil, pkgerrors.Wrapf(err, "invalid values file %q", options.ValuesFile)
+	}
+
+	template, err := c.getTemplate(ctx, clusterClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the ClusterClass variables from the values file into the Clusters defined in the template and
+	// validate them against the ClusterClass variable schemas.
+	if err := values.applyClusterClassVariables(ctx, template, clusterClient); err != nil {
+		return nil, pkgerrors.Wrapf(err, "invalid values file %q", options.ValuesFile)
+	}
+
+	return template, nil
+}
+
+// getTemplate returns a workload cluster template from the source selected in the options.
+func (c *clusterctlClient) getTemplate(ctx context.Context, clusterClient cluster.Client, options GetClusterTemplateOptions) (Template, error) {
 	if options.ProviderRepositorySource != nil {
 		// Ensure this command only runs against management clusters with the current Cluster API contract.
 		// NOTE: This command tolerates also not existing cluster (Kubeconfig.Path=="") or clusters not yet initialized in order to allow
@@ -18,9 +18,11 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	pkgerrors "github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
 
 	"sigs.k8s.io/cluster-api/util/container"
 )
@@ -29,6 +31,11 @@ const (
 	// CertManagerImageComponent define the name of the cert-manager component in image overrides.
 	CertManagerImageComponent = "cert-manager"
 
+	// ImageOverridesConfigKey is the key for the path of the image overrides file. The image overrides file maps
+	// the images used by the providers and by cert-manager to the images to be used instead, e.g. images of a
+	// mirror registry pinned by digest; image overrides take precedence over the images configuration.
+	ImageOverridesConfigKey = "image-overrides"
+
 	imagesConfigKey = "images"
 	allImageConfig  = "all"
 )
@@ -43,6 +50,7 @@ type ImageMetaClient interface {
 type imageMetaClient struct {
 	reader         Reader
 	imageMetaCache map[string]*imageMeta
+	imageOverrides map[string]string
 }
 
 // ensure imageMetaClient implements ImageMetaClient.
@@ -56,6 +64,15 @@ func newImageMetaClient(reader Reader) *imageMetaClient {
 }
 
 func (p *imageMetaClient) AlterImage(component, imageString string) (string, error) {
+	// If there is an image override for the image, it takes precedence over the image meta configurations.
+	overrides, err := p.getImageOverrides()
+	if err != nil {
+		return "", err
+	}
+	if override, ok := overrides[imageString]; ok {
+		return override, nil
+	}
+
 	image, err := container.ImageFromString(imageString)
 	if err != nil {
 		return "", err
@@ -116,6 +133,53 @@ func (p *imageMetaClient) getImageMeta(component, imageName string) (*imageMeta,
 	return m, nil
 }
 
+// getImageOverrides returns the image overrides read from the image overrides file, if any.
+func (p *imageMetaClient) getImageOverrides() (map[string]string, error) {
+	if p.imageOverrides != nil {
+		return p.imageOverrides, nil
+	}
+
+	p.imageOverrides = map[string]string{}
+	path, err := p.reader.Get(ImageOverridesConfigKey)
+	if err != nil || path == "" {
+		return p.imageOverrides, nil //nolint:nilerr // the image overrides file is optional
+	}
+
+	overrides, err := ReadImageOverrides(path)
+	if err != nil {
+		return nil, err
+	}
+	p.imageOverrides = overrides
+	return p.imageOverrides, nil
+}
+
+// ReadImageOverrides reads an image overrides file, mapping images to the images to be used instead, e.g.
+//
+//	registry.k8s.io/cluster-api/cluster-api-controller:v1.11.0: mirror.example.com/cluster-api/cluster-api-controller@sha256:...
+func ReadImageOverrides(path string) (map[string]string, error) {
+	// #nosec G304
+	// path is provided by the user by design.
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to read image overrides file %q", path)
+	}
+
+	overrides := map[string]string{}
+	if err := yaml.UnmarshalStrict(content, &overrides); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse image overrides file %q", path)
+	}
+
+	for image, override := range overrides {
+		if _, err := container.ImageFromString(image); err != nil {
+			return nil, pkgerrors.Wrapf(err, "invalid image %q in image overrides file %q", image, path)
+		}
+		if _, err := container.ImageFromString(override); err != nil {
+			return nil, pkgerrors.Wrapf(err, "invalid override %q for image %q in image overrides file %q", override, image, path)
+		}
+	}
+	return overrides, nil
+}
+
 func imageMetaCacheKey(component, imageName string) string {
 	return fmt.Sprintf("%s/%s", component, imageName)
 }
@@ -18,6 +18,8 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -307,3 +309,64 @@ func Test_imageMetaClient_AlterImage(t *testing.T) {
 		})
 	}
 }
+
+func Test_imageMetaClient_AlterImage_withImageOverrides(t *testing.T) {
+	dir := t.TempDir()
+	overridesFile := filepath.Join(dir, "image-overrides.yaml")
+	overrides := "registry.k8s.io/cluster-api/cluster-api-controller:v1.11.0: mirror.example.com/cluster-api-controller@sha256:2d3b2f6b8c9a3ef3f1fe6e9a5d2a3c0b0e2f4e1c3a5b7d9f1e3c5a7b9d1f3e5a\n"
+	g := NewWithT(t)
+	g.Expect(os.WriteFile(overridesFile, []byte(overrides), 0600)).To(Succeed())
+	invalidOverridesFile := filepath.Join(dir, "invalid-image-overrides.yaml")
+	g.Expect(os.WriteFile(invalidOverridesFile, []byte("registry.k8s.io/cluster-api/cluster-api-controller:v1.11.0: Invalid Image\n"), 0600)).To(Succeed())
+
+	tests := []struct {
+		name    string
+		reader  Reader
+		image   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "image override takes precedence over image meta configurations",
+			reader: test.NewFakeReader().
+				WithVar(ImageOverridesConfigKey, overridesFile).
+				WithImageMeta(allImageConfig, "foo-repository.io", "", "foo-tag"),
+			image: "registry.k8s.io/cluster-api/cluster-api-controller:v1.11.0",
+			want:  "mirror.example.com/cluster-api-controller@sha256:2d3b2f6b8c9a3ef3f1fe6e9a5d2a3c0b0e2f4e1c3a5b7d9f1e3c5a7b9d1f3e5a",
+		},
+		{
+			name: "image meta configurations apply to images without image override",
+			reader: test.NewFakeReader().
+				WithVar(ImageOverridesConfigKey, overridesFile).
+				WithImageMeta(allImageConfig, "foo-repository.io", "", "foo-tag"),
+			image: "registry.k8s.io/cluster-api/kubeadm-bootstrap-controller:v1.11.0",
+			want:  "foo-repository.io/kubeadm-bootstrap-controller:foo-tag",
+		},
+		{
+			name:    "missing image overrides file",
+			reader:  test.NewFakeReader().WithVar(ImageOverridesConfigKey, filepath.Join(dir, "does-not-exist.yaml")),
+			image:   "registry.k8s.io/cluster-api/cluster-api-controller:v1.11.0",
+			wantErr: true,
+		},
+		{
+			name:    "invalid image override",
+			reader:  test.NewFakeReader().WithVar(ImageOverridesConfigKey, invalidOverridesFile),
+			image:   "registry.k8s.io/cluster-api/cluster-api-controller:v1.11.0",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := newImageMetaClient(tt.reader).AlterImage("cluster-api", tt.image)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
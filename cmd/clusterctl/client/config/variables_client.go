@@ -21,6 +21,13 @@ const (
 	GitHubTokenVariable = "github-token"
 	// GitLabAccessTokenVariable defines a variable hosting the GitLab access token. This can be used with Personal and Project access tokens.
 	GitLabAccessTokenVariable = "gitlab-access-token"
+	// OCIUsernameVariable defines a variable hosting the username used to authenticate to OCI registries.
+	OCIUsernameVariable = "oci-username"
+	// OCIPasswordVariable defines a variable hosting the password or the access token used to authenticate to OCI registries.
+	OCIPasswordVariable = "oci-password"
+	// OCICosignPublicKeyVariable defines a variable hosting the cosign public key, or the path to it, used to verify the
+	// signature of providers published in OCI registries. If not set, signatures are not verified.
+	OCICosignPublicKeyVariable = "oci-cosign-public-key"
 )
 
 // VariablesClient has methods to work with environment variables and with variables defined in the clusterctl configuration file.
@@ -58,6 +58,18 @@ type DescribeClusterOptions struct {
 	// have the same Status, Severity and Reason.
 	Grouping bool
 
+	// ShowMachines instructs the discovery process to collect all the Machines in the Cluster,
+	// including the ones hidden by grouping, for showing per-Machine details.
+	ShowMachines bool
+
+	// ShowConditionHistory instructs the discovery process to collect recent condition transitions
+	// and events for the objects in the ObjectTree.
+	ShowConditionHistory bool
+
+	// ShowLogs instructs the discovery process to collect boot and console logs
+	// for the Machines reporting diagnostics.
+	ShowLogs bool
+
 	// V1Beta1 instructs tree to use V1Beta1 conditions.
 	//
 	// Deprecated: This field will be removed when v1beta1 will be dropped.
@@ -101,6 +113,9 @@ func (c *clusterctlClient) DescribeCluster(ctx context.Context, options Describe
 		AddTemplateVirtualNode:  options.AddTemplateVirtualNode,
 		Echo:                    options.Echo,
 		Grouping:                options.Grouping,
+		ShowMachines:            options.ShowMachines,
+		ShowConditionHistory:    options.ShowConditionHistory,
+		ShowLogs:                options.ShowLogs,
 		V1Beta1:                 options.V1Beta1,
 	})
 }
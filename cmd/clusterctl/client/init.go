@@ -84,6 +84,14 @@ type InitOptions struct {
 	// NOTE this should only be used for development
 	IgnoreValidationErrors bool
 
+	// ImageOverridesFile is the path of a file mapping the images of the providers and of cert-manager to the images
+	// to be used instead, e.g. the images of a mirror registry pinned by digest in an air-gapped environment.
+	ImageOverridesFile string
+
+	// ValidateImages instructs the init command to check that all the images to be installed exist in their
+	// registries before installing cert-manager and the providers.
+	ValidateImages bool
+
 	// allowMissingProviderCRD is used to allow for a missing provider CRD when listing images.
 	// It is set to false to enforce that provider CRD is available when performing the standard init operation.
 	allowMissingProviderCRD bool
@@ -99,6 +107,10 @@ func (c *clusterctlClient) Init(ctx context.Context, options InitOptions) ([]Com
 		options.WaitProviderTimeout = time.Duration(5*60) * time.Second
 	}
 
+	if err := c.setImageOverridesFile(options.ImageOverridesFile); err != nil {
+		return nil, err
+	}
+
 	// gets access to the management cluster
 	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
 	if err != nil {
@@ -140,8 +152,21 @@ func (c *clusterctlClient) Init(ctx context.Context, options InitOptions) ([]Com
 		log.Error(err, "Ignoring validation errors")
 	}
 
-	// Before installing the providers, ensure the cert-manager Webhook is in place.
+	// If required, validate that all the images to be installed exist before installing anything.
 	certManager := clusterClient.CertManager()
+	if options.ValidateImages {
+		images, err := certManager.Images(ctx)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, installer.Images()...)
+		log.Info("Validating images", "count", len(images))
+		if err := repository.ValidateImages(ctx, c.configClient.Variables(), images); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to validate images")
+		}
+	}
+
+	// Before installing the providers, ensure the cert-manager Webhook is in place.
 	if err := certManager.EnsureInstalled(ctx); err != nil {
 		return nil, err
 	}
@@ -176,6 +201,10 @@ func (c *clusterctlClient) Init(ctx context.Context, options InitOptions) ([]Com
 
 // InitImages returns the list of images required for init.
 func (c *clusterctlClient) InitImages(ctx context.Context, options InitOptions) ([]string, error) {
+	if err := c.setImageOverridesFile(options.ImageOverridesFile); err != nil {
+		return nil, err
+	}
+
 	// gets access to the management cluster
 	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
 	if err != nil {
@@ -218,6 +247,19 @@ func (c *clusterctlClient) InitImages(ctx context.Context, options InitOptions)
 	return images, nil
 }
 
+// setImageOverridesFile sets the image overrides file to be used when processing the components of the
+// providers and of cert-manager; the file is read immediately, so that errors are surfaced before any change.
+func (c *clusterctlClient) setImageOverridesFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := config.ReadImageOverrides(path); err != nil {
+		return err
+	}
+	c.configClient.Variables().Set(config.ImageOverridesConfigKey, path)
+	return nil
+}
+
 func (c *clusterctlClient) setupInstaller(ctx context.Context, cluster cluster.Client, options InitOptions) (cluster.ProviderInstaller, error) {
 	installer := cluster.ProviderInstaller()
 
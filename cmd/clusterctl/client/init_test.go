@@ -18,6 +18,8 @@ package client
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -68,6 +70,13 @@ func Test_clusterctlClient_InitImages(t *testing.T) {
 		bootstrapProvider      []string
 		controlPlaneProvider   []string
 		infrastructureProvider []string
+		imageOverridesFile     string
+	}
+
+	imageOverridesFile := filepath.Join(t.TempDir(), "image-overrides.yaml")
+	imageOverrides := "registry.k8s.io/cluster-api-aws/cluster-api-aws-controller:v0.5.3: mirror.example.com/cluster-api-aws-controller@sha256:2d3b2f6b8c9a3ef3f1fe6e9a5d2a3c0b0e2f4e1c3a5b7d9f1e3c5a7b9d1f3e5a\n"
+	if err := os.WriteFile(imageOverridesFile, []byte(imageOverrides), 0600); err != nil {
+		t.Fatal(err)
 	}
 
 	tests := []struct {
@@ -106,6 +115,28 @@ func Test_clusterctlClient_InitImages(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "returns list of images with image overrides",
+			args: args{
+				infrastructureProvider: []string{"infra"},
+				kubeconfigContext:      "mgmt-context",
+				imageOverridesFile:     imageOverridesFile,
+			},
+			expectedImages: []string{
+				"mirror.example.com/cluster-api-aws-controller@sha256:2d3b2f6b8c9a3ef3f1fe6e9a5d2a3c0b0e2f4e1c3a5b7d9f1e3c5a7b9d1f3e5a",
+			},
+			wantErr: false,
+		},
+		{
+			name: "returns error when the image overrides file does not exist",
+			args: args{
+				infrastructureProvider: []string{"infra"},
+				kubeconfigContext:      "mgmt-context",
+				imageOverridesFile:     filepath.Join(filepath.Dir(imageOverridesFile), "does-not-exist.yaml"),
+			},
+			wantErr:              true,
+			expectedErrorMessage: "failed to read image overrides file",
+		},
 		{
 			name: "returns error when core provider name is invalid",
 			args: args{
@@ -197,6 +228,7 @@ func Test_clusterctlClient_InitImages(t *testing.T) {
 				BootstrapProviders:      tt.args.bootstrapProvider,
 				ControlPlaneProviders:   tt.args.controlPlaneProvider,
 				InfrastructureProviders: tt.args.infrastructureProvider,
+				ImageOverridesFile:      tt.args.imageOverridesFile,
 			})
 
 			if tt.wantErr {
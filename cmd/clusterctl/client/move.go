@@ -19,8 +19,11 @@ package client
 import (
 	"context"
 	"os"
+	"slices"
+	"strings"
 
 	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 )
@@ -51,6 +54,47 @@ type MoveOptions struct {
 
 	// DryRun means the move action is a dry run, no real action will be performed.
 	DryRun bool
+
+	// LabelSelector restricts the move to the Clusters matching the label selector, their descendants and their dependencies,
+	// e.g. the ClusterClass used by the Clusters.
+	LabelSelector string
+
+	// IncludeKinds restricts the move to the objects of the given kinds which are moved with their hierarchy or
+	// labeled for move, e.g. Cluster, ClusterClass, ClusterResourceSet, and to their dependencies.
+	IncludeKinds []string
+
+	// ExcludeKinds lists the kinds of the objects which must not be moved; objects depending on them are not moved as well.
+	ExcludeKinds []string
+
+	// CheckpointDirectory defines a directory where the progress of the move and a backup of the objects being moved are stored,
+	// so the move can be resumed if it fails mid-way. It can be used only when moving objects to a target management cluster.
+	CheckpointDirectory string
+
+	// Resume resumes the move tracked in CheckpointDirectory, skipping the phases already completed.
+	Resume bool
+}
+
+func (o MoveOptions) moveFilter() (cluster.MoveFilter, error) {
+	filter := cluster.MoveFilter{
+		IncludeKinds: o.IncludeKinds,
+		ExcludeKinds: o.ExcludeKinds,
+	}
+
+	if o.LabelSelector != "" {
+		selector, err := labels.Parse(o.LabelSelector)
+		if err != nil {
+			return cluster.MoveFilter{}, pkgerrors.Wrapf(err, "invalid label selector %q", o.LabelSelector)
+		}
+		filter.Selector = selector
+	}
+
+	for _, kind := range o.IncludeKinds {
+		if slices.ContainsFunc(o.ExcludeKinds, func(k string) bool { return strings.EqualFold(k, kind) }) {
+			return cluster.MoveFilter{}, pkgerrors.Errorf("kind %q can't be both included and excluded", kind)
+		}
+	}
+
+	return filter, nil
 }
 
 func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error {
@@ -66,16 +110,36 @@ func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error
 		return pkgerrors.Errorf("at least one of FromDirectory, ToDirectory and ToKubeconfig must be set")
 	}
 
+	filter, err := options.moveFilter()
+	if err != nil {
+		return err
+	}
+
+	if options.CheckpointDirectory != "" && (options.FromDirectory != "" || options.ToDirectory != "") {
+		return pkgerrors.Errorf("CheckpointDirectory can't be used with FromDirectory or ToDirectory")
+	}
+	if options.CheckpointDirectory != "" && options.DryRun {
+		return pkgerrors.Errorf("CheckpointDirectory can't be used with DryRun")
+	}
+	if options.Resume && options.CheckpointDirectory == "" {
+		return pkgerrors.Errorf("CheckpointDirectory must be set when using Resume")
+	}
+
+	// Objects read from a directory are restored as a whole; filters must be applied when writing the directory.
+	if options.FromDirectory != "" && !filter.IsEmpty() {
+		return pkgerrors.Errorf("LabelSelector, IncludeKinds and ExcludeKinds can't be used with FromDirectory")
+	}
+
 	if options.ToDirectory != "" {
-		return c.toDirectory(ctx, options)
+		return c.toDirectory(ctx, options, filter)
 	} else if options.FromDirectory != "" {
 		return c.fromDirectory(ctx, options)
 	}
 
-	return c.move(ctx, options)
+	return c.move(ctx, options, filter)
 }
 
-func (c *clusterctlClient) move(ctx context.Context, options MoveOptions) error {
+func (c *clusterctlClient) move(ctx context.Context, options MoveOptions, filter cluster.MoveFilter) error {
 	// Get the client for interacting with the source management cluster.
 	fromCluster, err := c.getClusterClient(ctx, options.FromKubeconfig)
 	if err != nil {
@@ -99,7 +163,10 @@ func (c *clusterctlClient) move(ctx context.Context, options MoveOptions) error
 		}
 	}
 
-	return fromCluster.ObjectMover().Move(ctx, options.Namespace, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
+	return fromCluster.ObjectMover().Move(ctx, options.Namespace, filter, cluster.MoveCheckpointOptions{
+		Directory: options.CheckpointDirectory,
+		Resume:    options.Resume,
+	}, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
 }
 
 func (c *clusterctlClient) fromDirectory(ctx context.Context, options MoveOptions) error {
@@ -115,7 +182,7 @@ func (c *clusterctlClient) fromDirectory(ctx context.Context, options MoveOption
 	return toCluster.ObjectMover().FromDirectory(ctx, toCluster, options.FromDirectory)
 }
 
-func (c *clusterctlClient) toDirectory(ctx context.Context, options MoveOptions) error {
+func (c *clusterctlClient) toDirectory(ctx context.Context, options MoveOptions, filter cluster.MoveFilter) error {
 	fromCluster, err := c.getClusterClient(ctx, options.FromKubeconfig)
 	if err != nil {
 		return err
@@ -134,7 +201,7 @@ func (c *clusterctlClient) toDirectory(ctx context.Context, options MoveOptions)
 		return err
 	}
 
-	return fromCluster.ObjectMover().ToDirectory(ctx, options.Namespace, options.ToDirectory)
+	return fromCluster.ObjectMover().ToDirectory(ctx, options.Namespace, filter, options.ToDirectory)
 }
 
 func (c *clusterctlClient) getClusterClient(ctx context.Context, kubeconfig Kubeconfig) (cluster.Client, error) {
@@ -122,6 +122,93 @@ func Test_clusterctlClient_Move(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "does not return an error if label selector and kind filters are set",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					LabelSelector:  "env=prod",
+					IncludeKinds:   []string{"Cluster"},
+					ExcludeKinds:   []string{"ClusterResourceSet"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "returns an error if the label selector is invalid",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					LabelSelector:  "env in (prod",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if a kind is both included and excluded",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					IncludeKinds:   []string{"Cluster"},
+					ExcludeKinds:   []string{"cluster"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if Resume is set without CheckpointDirectory",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Resume:         true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if CheckpointDirectory is set with DryRun",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig:      Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					DryRun:              true,
+					CheckpointDirectory: "/var/cache/checkpoint",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if filters are set with FromDirectory",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					ToKubeconfig:  Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					FromDirectory: "/var/cache/fromDirectory",
+					LabelSelector: "env=prod",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -297,11 +384,11 @@ type fakeObjectMover struct {
 	fromDirectoryErr error
 }
 
-func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.Client, _ bool, _ ...cluster.ResourceMutatorFunc) error {
+func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.MoveFilter, _ cluster.MoveCheckpointOptions, _ cluster.Client, _ bool, _ ...cluster.ResourceMutatorFunc) error {
 	return f.moveErr
 }
 
-func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ string) error {
+func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ cluster.MoveFilter, _ string) error {
 	return f.toDirectoryErr
 }
 
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// PauseClusterOptions carries the options supported by PauseCluster.
+type PauseClusterOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// ClusterName is the name of the Cluster to pause.
+	ClusterName string
+
+	// Namespace of the Cluster. If unspecified, the current namespace will be used.
+	Namespace string
+
+	// Reason documents why the Cluster is paused. It is surfaced in the Paused condition
+	// of the Cluster and of all the objects belonging to the Cluster.
+	Reason string
+}
+
+// ResumeClusterOptions carries the options supported by ResumeCluster.
+type ResumeClusterOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// ClusterName is the name of the Cluster to resume.
+	ClusterName string
+
+	// Namespace of the Cluster. If unspecified, the current namespace will be used.
+	Namespace string
+}
+
+// PauseCluster pauses the reconciliation of a Cluster and of all the objects belonging to it.
+// The Cluster's spec.paused field and the paused reason annotation are set with a single patch,
+// so all the controllers observe the Cluster as paused at the same time.
+func (c *clusterctlClient) PauseCluster(ctx context.Context, options PauseClusterOptions) error {
+	var reason *string
+	if options.Reason != "" {
+		reason = &options.Reason
+	}
+	return c.patchClusterPaused(ctx, options.Kubeconfig, options.Namespace, options.ClusterName, true, reason)
+}
+
+// ResumeCluster resumes the reconciliation of a Cluster and of all the objects belonging to it.
+func (c *clusterctlClient) ResumeCluster(ctx context.Context, options ResumeClusterOptions) error {
+	return c.patchClusterPaused(ctx, options.Kubeconfig, options.Namespace, options.ClusterName, false, nil)
+}
+
+// patchClusterPaused sets spec.paused of a Cluster and the paused reason annotation; a nil reason removes the annotation.
+func (c *clusterctlClient) patchClusterPaused(ctx context.Context, kubeconfig Kubeconfig, namespace, name string, paused bool, reason *string) error {
+	if name == "" {
+		return pkgerrors.New("name of the Cluster must be specified")
+	}
+
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: kubeconfig})
+	if err != nil {
+		return err
+	}
+
+	// If the option specifying the Namespace is empty, try to detect it.
+	if namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return err
+		}
+		namespace = currentNamespace
+	}
+
+	managementClient, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := managementClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cluster); err != nil {
+		return pkgerrors.Wrapf(err, "failed to get Cluster %s/%s", namespace, name)
+	}
+
+	// Note: spec.paused is removed instead of being set to false on resume, so the Cluster looks
+	// like it has never been paused.
+	var pausedValue *bool
+	if paused {
+		pausedValue = &paused
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{
+				clusterv1.PausedReasonAnnotation: reason,
+			},
+		},
+		"spec": map[string]*bool{
+			"paused": pausedValue,
+		},
+	})
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to marshal patch")
+	}
+
+	if err := managementClient.Patch(ctx, cluster, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to patch Cluster %s/%s", namespace, name)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+func Test_clusterctlClient_PauseResumeCluster(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	kubeconfig := cluster.Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"}
+	configClient := newFakeConfig(ctx)
+	clusterClient := newFakeCluster(kubeconfig, configClient).WithObjs(&clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "my-cluster"},
+	})
+	c := newFakeClient(ctx, configClient).WithCluster(clusterClient)
+
+	getCluster := func() *clusterv1.Cluster {
+		proxyClient, err := clusterClient.Proxy().NewClient(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		got := &clusterv1.Cluster{}
+		g.Expect(proxyClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "my-cluster"}, got)).To(Succeed())
+		return got
+	}
+
+	g.Expect(c.PauseCluster(ctx, PauseClusterOptions{
+		Kubeconfig:  Kubeconfig(kubeconfig),
+		ClusterName: "my-cluster",
+		Namespace:   "ns1",
+		Reason:      "etcd maintenance",
+	})).To(Succeed())
+	got := getCluster()
+	g.Expect(ptr.Deref(got.Spec.Paused, false)).To(BeTrue())
+	g.Expect(got.Annotations).To(HaveKeyWithValue(clusterv1.PausedReasonAnnotation, "etcd maintenance"))
+
+	g.Expect(c.ResumeCluster(ctx, ResumeClusterOptions{
+		Kubeconfig:  Kubeconfig(kubeconfig),
+		ClusterName: "my-cluster",
+		Namespace:   "ns1",
+	})).To(Succeed())
+	got = getCluster()
+	g.Expect(got.Spec.Paused).To(BeNil())
+	g.Expect(got.Annotations).ToNot(HaveKey(clusterv1.PausedReasonAnnotation))
+
+	g.Expect(c.PauseCluster(ctx, PauseClusterOptions{
+		Kubeconfig:  Kubeconfig(kubeconfig),
+		ClusterName: "does-not-exist",
+		Namespace:   "ns1",
+	})).ToNot(Succeed())
+}
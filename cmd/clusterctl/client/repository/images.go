@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/distribution/reference"
+	pkgerrors "github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+// dockerHubRegistry is the registry serving images from docker.io.
+const dockerHubRegistry = "registry-1.docker.io"
+
+// ValidateImages checks that the given images exist in their registries, e.g. that all the images required
+// to install providers in an air-gapped environment have been pushed to a mirror registry.
+// Credentials for a registry are read from variables scoped to the registry host, e.g. OCI_USERNAME_MY_REGISTRY_EXAMPLE_COM
+// and OCI_PASSWORD_MY_REGISTRY_EXAMPLE_COM for my-registry.example.com; registries without credentials are accessed anonymously.
+func ValidateImages(ctx context.Context, configVariablesClient config.VariablesClient, images []string) error {
+	return validateImages(ctx, configVariablesClient, images, &http.Client{Timeout: ociRequestTimeout})
+}
+
+func validateImages(ctx context.Context, configVariablesClient config.VariablesClient, images []string, httpClient *http.Client) error {
+	// Note: There is a client for each registry, so credentials are never sent to other registries.
+	// The client caches tokens by repository, so authentication happens only once per repository.
+	clients := map[string]*oci.Client{}
+	var errs []error
+	for _, image := range images {
+		named, err := reference.ParseNormalizedNamed(image)
+		if err != nil {
+			errs = append(errs, pkgerrors.Wrapf(err, "invalid image %q", image))
+			continue
+		}
+
+		domain := reference.Domain(named)
+		client, ok := clients[domain]
+		if !ok {
+			client = oci.NewClient(httpClient, registryCredentials(configVariablesClient, domain))
+			clients[domain] = client
+		}
+
+		registry := domain
+		if registry == "docker.io" {
+			registry = dockerHubRegistry
+		}
+		ref := oci.Reference{Registry: registry, Repository: reference.Path(named), Tag: "latest"}
+		if tagged, ok := named.(reference.Tagged); ok {
+			ref.Tag = tagged.Tag()
+		}
+		if digested, ok := named.(reference.Digested); ok {
+			ref.Digest = digested.Digest().String()
+		}
+
+		if err := client.Exists(ctx, ref); err != nil {
+			if pkgerrors.Is(err, oci.ErrNotFound) {
+				errs = append(errs, pkgerrors.Errorf("image %s not found", image))
+				continue
+			}
+			errs = append(errs, pkgerrors.Wrapf(err, "failed to validate image %s", image))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// registryCredentials returns the credentials for a registry, if any.
+func registryCredentials(configVariablesClient config.VariablesClient, registry string) *oci.Credentials {
+	username, err := configVariablesClient.Get(registryVariable(config.OCIUsernameVariable, registry))
+	if err != nil || username == "" {
+		return nil
+	}
+	credentials := &oci.Credentials{Username: username}
+	if password, err := configVariablesClient.Get(registryVariable(config.OCIPasswordVariable, registry)); err == nil {
+		credentials.Password = password
+	}
+	return credentials
+}
+
+// registryVariable returns the name of a variable scoped to a registry host, e.g. oci-username-my-registry-example-com
+// for the my-registry.example.com registry, which is read from the OCI_USERNAME_MY_REGISTRY_EXAMPLE_COM environment variable.
+func registryVariable(variable, registry string) string {
+	return variable + "-" + strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		if 'A' <= r && r <= 'Z' {
+			return r - 'A' + 'a'
+		}
+		return '-'
+	}, registry)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+func Test_validateImages(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := newFakeOCIRegistry("mirror/cluster-api-controller")
+	digest := registry.pushManifest(g, "v1.11.0", oci.Manifest{SchemaVersion: 2, MediaType: oci.ManifestMediaType})
+
+	server := httptest.NewTLSServer(registry)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	tests := []struct {
+		name    string
+		images  []string
+		wantErr []string
+	}{
+		{
+			name: "images exist",
+			images: []string{
+				host + "/mirror/cluster-api-controller:v1.11.0",
+				host + "/mirror/cluster-api-controller@" + digest,
+			},
+		},
+		{
+			name: "images don't exist",
+			images: []string{
+				host + "/mirror/cluster-api-controller:v1.11.0",
+				host + "/mirror/cluster-api-controller:v1.12.0",
+				host + "/mirror/kubeadm-bootstrap-controller:v1.11.0",
+			},
+			wantErr: []string{
+				"image " + host + "/mirror/cluster-api-controller:v1.12.0 not found",
+				"image " + host + "/mirror/kubeadm-bootstrap-controller:v1.11.0 not found",
+			},
+		},
+		{
+			name:    "invalid image",
+			images:  []string{"Invalid Image"},
+			wantErr: []string{`invalid image "Invalid Image"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := validateImages(context.Background(), test.NewFakeVariableClient(), tt.images, server.Client())
+			if len(tt.wantErr) > 0 {
+				g.Expect(err).To(HaveOccurred())
+				for _, wantErr := range tt.wantErr {
+					g.Expect(err.Error()).To(ContainSubstring(wantErr))
+				}
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func Test_validateImagesCredentials(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := newFakeOCIRegistry("mirror/cluster-api-controller")
+	registry.pushManifest(g, "v1.11.0", oci.Manifest{SchemaVersion: 2, MediaType: oci.ManifestMediaType})
+	server := httptest.NewTLSServer(registry)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	otherRegistry := newFakeOCIRegistry("mirror/cluster-api-controller")
+	otherRegistry.pushManifest(g, "v1.11.0", oci.Manifest{SchemaVersion: 2, MediaType: oci.ManifestMediaType})
+	otherServer := httptest.NewUnstartedServer(otherRegistry)
+	otherServer.TLS = server.TLS
+	otherServer.StartTLS()
+	defer otherServer.Close()
+	otherHost := strings.TrimPrefix(otherServer.URL, "https://")
+
+	variablesClient := test.NewFakeVariableClient().
+		WithVar(registryVariable(config.OCIUsernameVariable, host), "user").
+		WithVar(registryVariable(config.OCIPasswordVariable, host), "password")
+
+	err := validateImages(context.Background(), variablesClient, []string{
+		host + "/mirror/cluster-api-controller:v1.11.0",
+		otherHost + "/mirror/cluster-api-controller:v1.11.0",
+	}, server.Client())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Credentials are only sent to the registry they are scoped to.
+	g.Expect(registry.usernames).To(Equal([]string{"user"}))
+	g.Expect(otherRegistry.usernames).To(BeEmpty())
+}
+
+func Test_registryVariable(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(registryVariable(config.OCIUsernameVariable, "My-Registry.example.com:5000")).To(Equal("oci-username-my-registry-example-com-5000"))
+}
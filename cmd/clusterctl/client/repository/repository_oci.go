@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+const (
+	ociScheme                = "oci"
+	ociLatestVersionTag      = "latest"
+	ociDefaultComponentsPath = "components.yaml"
+
+	// ociTitleAnnotation is the annotation used by OCI artifacts (e.g. pushed with oras) to store the file name of a layer.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+
+	// ociVersionAnnotation is the annotation storing the version of the provider in an OCI artifact;
+	// it is required when the artifact is pinned by digest.
+	ociVersionAnnotation = "org.opencontainers.image.version"
+
+	// ociRequestTimeout is the timeout of each request to the OCI registry.
+	ociRequestTimeout = 30 * time.Second
+)
+
+// ociRepository provides support for providers published as OCI artifacts.
+//
+// The artifact must contain one layer for each file, e.g. metadata.yaml, components.yaml and the cluster templates,
+// with the file name stored in the org.opencontainers.image.title annotation; this is the layout of artifacts pushed with oras.
+// Artifacts must be tagged with the provider version, or pinned by digest.
+type ociRepository struct {
+	providerConfig        config.Provider
+	configVariablesClient config.VariablesClient
+	httpClient            *http.Client
+	client                *oci.Client
+	reference             oci.Reference
+	defaultVersion        string
+	rootPath              string
+	componentsPath        string
+	cosignPublicKey       crypto.PublicKey
+	verifiedDigests       map[string]bool
+}
+
+var _ Repository = &ociRepository{}
+
+type ociRepositoryOption func(*ociRepository)
+
+func injectOCIHTTPClient(c *http.Client) ociRepositoryOption {
+	return func(o *ociRepository) {
+		o.httpClient = c
+	}
+}
+
+// NewOCIRepository returns an ociRepository implementation.
+func NewOCIRepository(ctx context.Context, providerConfig config.Provider, configVariablesClient config.VariablesClient, opts ...ociRepositoryOption) (Repository, error) {
+	if configVariablesClient == nil {
+		return nil, pkgerrors.New("invalid arguments: configVariablesClient can't be nil")
+	}
+
+	rURL, err := url.Parse(providerConfig.URL())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "invalid url")
+	}
+
+	urlSplit := strings.Split(strings.Trim(rURL.Path, "/"), "/")
+	if rURL.Scheme != ociScheme || rURL.Host == "" || urlSplit[0] == "" {
+		return nil, pkgerrors.New("invalid url: an OCI repository url should be in the form oci://{registry}/{repository}[:{version-tag}|@{digest}][/{components.yaml}]")
+	}
+
+	// Use the last element of the path as componentsPath, if it is a yaml file.
+	componentsPath := ociDefaultComponentsPath
+	if last := urlSplit[len(urlSplit)-1]; len(urlSplit) > 1 && strings.HasSuffix(last, ".yaml") {
+		componentsPath = last
+		urlSplit = urlSplit[:len(urlSplit)-1]
+	}
+
+	repo := &ociRepository{
+		providerConfig:        providerConfig,
+		configVariablesClient: configVariablesClient,
+		httpClient:            &http.Client{Timeout: ociRequestTimeout},
+		reference:             oci.Reference{Registry: rURL.Host},
+		defaultVersion:        ociLatestVersionTag,
+		rootPath:              ".",
+		componentsPath:        componentsPath,
+		verifiedDigests:       map[string]bool{},
+	}
+
+	// Extract the version tag or the digest from the last element of the repository.
+	repository := strings.Join(urlSplit, "/")
+	if i := strings.Index(repository, "@"); i >= 0 {
+		repo.reference.Digest = repository[i+1:]
+		repository = repository[:i]
+		if !strings.HasPrefix(repo.reference.Digest, "sha256:") {
+			return nil, pkgerrors.Errorf("invalid url: unsupported digest %q, only sha256 digests are supported", repo.reference.Digest)
+		}
+	} else if i := strings.LastIndex(repository, ":"); i >= 0 {
+		repo.defaultVersion = repository[i+1:]
+		repository = repository[:i]
+	}
+	repo.reference.Repository = repository
+
+	for _, o := range opts {
+		o(repo)
+	}
+
+	var credentials *oci.Credentials
+	if username, err := configVariablesClient.Get(config.OCIUsernameVariable); err == nil && username != "" {
+		credentials = &oci.Credentials{Username: username}
+		if password, err := configVariablesClient.Get(config.OCIPasswordVariable); err == nil {
+			credentials.Password = password
+		}
+	}
+	repo.client = oci.NewClient(repo.httpClient, credentials)
+
+	if publicKey, err := configVariablesClient.Get(config.OCICosignPublicKeyVariable); err == nil && publicKey != "" {
+		if repo.cosignPublicKey, err = loadCosignPublicKey(publicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case repo.reference.Digest != "":
+		// When the artifact is pinned by digest, the version is read from the manifest.
+		manifest, err := repo.getManifest(ctx, repo.reference)
+		if err != nil {
+			return nil, err
+		}
+		repo.defaultVersion = manifest.Annotations[ociVersionAnnotation]
+		if repo.defaultVersion == "" {
+			return nil, pkgerrors.Errorf("failed to get version of %s: the %s annotation is not set", repo.reference, ociVersionAnnotation)
+		}
+	case repo.defaultVersion == ociLatestVersionTag:
+		repo.defaultVersion, err = latestContractRelease(ctx, repo, clusterv1.GroupVersion.Version)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to get latest release")
+		}
+	}
+
+	return repo, nil
+}
+
+// DefaultVersion returns defaultVersion field of ociRepository struct.
+func (o *ociRepository) DefaultVersion() string {
+	return o.defaultVersion
+}
+
+// RootPath returns rootPath field of ociRepository struct.
+func (o *ociRepository) RootPath() string {
+	return o.rootPath
+}
+
+// ComponentsPath returns componentsPath field of ociRepository struct.
+func (o *ociRepository) ComponentsPath() string {
+	return o.componentsPath
+}
+
+// GetVersions returns the list of versions that are available in a provider repository.
+func (o *ociRepository) GetVersions(ctx context.Context) ([]string, error) {
+	// When the artifact is pinned by digest, only the corresponding version is available.
+	if o.reference.Digest != "" {
+		return []string{o.defaultVersion}, nil
+	}
+
+	cacheID := fmt.Sprintf("%s%s/%s/tags", oci.Scheme, o.reference.Registry, o.reference.Repository)
+	if content, ok := cacheFiles[cacheID]; ok {
+		return strings.Split(string(content), "\n"), nil
+	}
+
+	tags, err := o.client.ListTags(ctx, o.reference)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get the list of versions for %s", o.reference.Repository)
+	}
+
+	versions := []string{}
+	for _, tag := range tags {
+		// Skip tags used to store signatures and attestations, e.g. sha256-<digest>.sig.
+		if strings.HasPrefix(tag, "sha256-") {
+			continue
+		}
+		versions = append(versions, tag)
+	}
+
+	cacheFiles[cacheID] = []byte(strings.Join(versions, "\n"))
+	return versions, nil
+}
+
+// GetFile returns a file for a given provider version.
+func (o *ociRepository) GetFile(ctx context.Context, version, path string) ([]byte, error) {
+	ref := o.reference
+	if ref.Digest != "" {
+		if version != o.defaultVersion {
+			return nil, pkgerrors.Errorf("failed to get file %q with version %q: %s is pinned to version %q", path, version, ref.Repository, o.defaultVersion)
+		}
+	} else {
+		ref.Tag = version
+	}
+
+	cacheID := fmt.Sprintf("%s/%s", ref, path)
+	if content, ok := cacheFiles[cacheID]; ok {
+		return content, nil
+	}
+
+	manifest, err := o.getManifest(ctx, ref)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get file %q with version %q", path, version)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.Annotations[ociTitleAnnotation] != path {
+			continue
+		}
+		content, err := o.client.GetBlob(ctx, ref, layer)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get file %q with version %q", path, version)
+		}
+		cacheFiles[cacheID] = content
+		return content, nil
+	}
+
+	return nil, pkgerrors.Errorf("failed to get file %q with version %q: file not found in %s", path, version, ref.Repository)
+}
+
+// getManifest returns the manifest of an artifact, verifying its digest and its signature, if required.
+// If the artifact does not exist errNotFound is returned, so it is possible to skip versions without an artifact.
+func (o *ociRepository) getManifest(ctx context.Context, ref oci.Reference) (*oci.Manifest, error) {
+	manifest, digest, err := o.client.GetManifest(ctx, ref)
+	if err != nil {
+		if pkgerrors.Is(err, oci.ErrNotFound) {
+			return nil, pkgerrors.Wrapf(errNotFound, "failed to get manifest of %s", ref)
+		}
+		return nil, err
+	}
+
+	// If a public key is configured, the artifact must be signed with cosign using the corresponding private key.
+	if o.cosignPublicKey != nil && !o.verifiedDigests[digest] {
+		if err := o.client.VerifySignature(ctx, ref, digest, o.cosignPublicKey); err != nil {
+			return nil, err
+		}
+		o.verifiedDigests[digest] = true
+	}
+	return manifest, nil
+}
+
+// loadCosignPublicKey loads a PEM encoded public key, either inline or from a file.
+func loadCosignPublicKey(value string) (crypto.PublicKey, error) {
+	data := []byte(value)
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+		var err error
+		if data, err = os.ReadFile(value); err != nil { //nolint:gosec
+			return nil, pkgerrors.Wrapf(err, "failed to read cosign public key %s", value)
+		}
+	}
+	return oci.ParsePublicKey(data)
+}
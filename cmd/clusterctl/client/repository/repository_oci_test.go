@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+// fakeOCIRegistry is a minimal OCI registry serving artifacts for a single repository.
+type fakeOCIRegistry struct {
+	repository string
+	manifests  map[string][]byte
+	blobs      map[string][]byte
+	token      string
+	// usernames are the usernames sent when requesting a token.
+	usernames []string
+}
+
+func newFakeOCIRegistry(repository string) *fakeOCIRegistry {
+	return &fakeOCIRegistry{
+		repository: repository,
+		manifests:  map[string][]byte{},
+		blobs:      map[string][]byte{},
+		token:      "secret-token",
+	}
+}
+
+// push adds an artifact with the given files and returns the digest of its manifest.
+func (r *fakeOCIRegistry) push(g *WithT, tag string, annotations map[string]string, files map[string]string) string {
+	manifest := oci.Manifest{SchemaVersion: 2, MediaType: oci.ManifestMediaType, Annotations: annotations}
+	for name, content := range files {
+		digest := sha256Digest([]byte(content))
+		r.blobs[digest] = []byte(content)
+		manifest.Layers = append(manifest.Layers, oci.Descriptor{
+			Digest:      digest,
+			Size:        int64(len(content)),
+			Annotations: map[string]string{ociTitleAnnotation: name},
+		})
+	}
+	return r.pushManifest(g, tag, manifest)
+}
+
+func (r *fakeOCIRegistry) pushManifest(g *WithT, tag string, manifest oci.Manifest) string {
+	data, err := json.Marshal(manifest)
+	g.Expect(err).ToNot(HaveOccurred())
+	digest := sha256Digest(data)
+	r.manifests[digest] = data
+	if tag != "" {
+		r.manifests[tag] = data
+	}
+	return digest
+}
+
+// sign adds a cosign signature for a manifest.
+func (r *fakeOCIRegistry) sign(g *WithT, key *ecdsa.PrivateKey, digest string) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, r.repository, digest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	g.Expect(err).ToNot(HaveOccurred())
+
+	payloadDigest := sha256Digest(payload)
+	r.blobs[payloadDigest] = payload
+	r.pushManifest(g, strings.Replace(digest, ":", "-", 1)+".sig", oci.Manifest{
+		SchemaVersion: 2,
+		MediaType:     oci.ManifestMediaType,
+		Layers: []oci.Descriptor{{
+			MediaType:   oci.CosignSignatureMediaType,
+			Digest:      payloadDigest,
+			Size:        int64(len(payload)),
+			Annotations: map[string]string{oci.CosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)},
+		}},
+	})
+}
+
+func (r *fakeOCIRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if username, _, ok := req.BasicAuth(); ok {
+			r.usernames = append(r.usernames, username)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": r.token})
+		return
+	}
+	if req.Header.Get("Authorization") != "Bearer "+r.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="https://%s/token",service="fake"`, req.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefix := "/v2/" + r.repository + "/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	kind, reference, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, prefix), "/")
+	switch kind {
+	case "tags":
+		tags := []string{}
+		for tag := range r.manifests {
+			if !strings.HasPrefix(tag, "sha256:") {
+				tags = append(tags, tag)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": r.repository, "tags": tags})
+	case "manifests":
+		if data, ok := r.manifests[reference]; ok {
+			w.Header().Set("Content-Type", oci.ManifestMediaType)
+			_, _ = w.Write(data)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case "blobs":
+		if data, ok := r.blobs[reference]; ok {
+			_, _ = w.Write(data)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func sha256Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+var ociTestMetadata = `apiVersion: clusterctl.cluster.x-k8s.io/v1alpha3
+kind: Metadata
+releaseSeries:
+- major: 1
+  minor: 0
+  contract: v1beta2
+`
+
+func Test_ociRepository(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := newFakeOCIRegistry("org/provider")
+	v100 := registry.push(g, "v1.0.0", map[string]string{ociVersionAnnotation: "v1.0.0"}, map[string]string{
+		"metadata.yaml":                  ociTestMetadata,
+		"infrastructure-components.yaml": "components-v1.0.0",
+		"cluster-template-flavor-a.yaml": "template-v1.0.0",
+	})
+	v101 := registry.push(g, "v1.0.1", map[string]string{ociVersionAnnotation: "v1.0.1"}, map[string]string{
+		"metadata.yaml":                  ociTestMetadata,
+		"infrastructure-components.yaml": "components-v1.0.1",
+	})
+	unsigned := registry.push(g, "v1.0.2-rc.0", nil, map[string]string{
+		"metadata.yaml": ociTestMetadata,
+	})
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	registry.sign(g, key, v100)
+	registry.sign(g, key, v101)
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	publicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+
+	server := httptest.NewTLSServer(registry)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	newRepo := func(url string, variables config.VariablesClient) (Repository, error) {
+		cacheFiles = map[string][]byte{}
+		return NewOCIRepository(context.Background(), config.NewProvider("test", url, clusterctlv1.InfrastructureProviderType), variables, injectOCIHTTPClient(server.Client()))
+	}
+
+	t.Run("resolves the latest release and gets files", func(t *testing.T) {
+		g := NewWithT(t)
+
+		repo, err := newRepo(fmt.Sprintf("oci://%s/org/provider/infrastructure-components.yaml", host), test.NewFakeVariableClient())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(repo.DefaultVersion()).To(Equal("v1.0.1"))
+		g.Expect(repo.ComponentsPath()).To(Equal("infrastructure-components.yaml"))
+		g.Expect(repo.RootPath()).To(Equal("."))
+
+		versions, err := repo.GetVersions(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(ConsistOf("v1.0.0", "v1.0.1", "v1.0.2-rc.0"))
+
+		content, err := repo.GetFile(context.Background(), "v1.0.0", "cluster-template-flavor-a.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(content)).To(Equal("template-v1.0.0"))
+
+		_, err = repo.GetFile(context.Background(), "v1.0.1", "cluster-template-flavor-a.yaml")
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("uses the version from the url", func(t *testing.T) {
+		g := NewWithT(t)
+
+		repo, err := newRepo(fmt.Sprintf("oci://%s/org/provider:v1.0.0", host), test.NewFakeVariableClient())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(repo.DefaultVersion()).To(Equal("v1.0.0"))
+		g.Expect(repo.ComponentsPath()).To(Equal(ociDefaultComponentsPath))
+	})
+	t.Run("pins the artifact by digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		repo, err := newRepo(fmt.Sprintf("oci://%s/org/provider@%s/infrastructure-components.yaml", host, v100), test.NewFakeVariableClient())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(repo.DefaultVersion()).To(Equal("v1.0.0"))
+
+		versions, err := repo.GetVersions(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(versions).To(ConsistOf("v1.0.0"))
+
+		content, err := repo.GetFile(context.Background(), "v1.0.0", "infrastructure-components.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(content)).To(Equal("components-v1.0.0"))
+
+		_, err = repo.GetFile(context.Background(), "v1.0.1", "infrastructure-components.yaml")
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("fails if the pinned digest does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newRepo(fmt.Sprintf("oci://%s/org/provider@%s", host, sha256Digest([]byte("does-not-exist"))), test.NewFakeVariableClient())
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("verifies signatures", func(t *testing.T) {
+		g := NewWithT(t)
+
+		variables := test.NewFakeVariableClient().WithVar(config.OCICosignPublicKeyVariable, publicKeyPEM)
+		repo, err := newRepo(fmt.Sprintf("oci://%s/org/provider:v1.0.0", host), variables)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = repo.GetFile(context.Background(), "v1.0.0", "infrastructure-components.yaml")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = repo.GetFile(context.Background(), "v1.0.2-rc.0", metadataFile)
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = newRepo(fmt.Sprintf("oci://%s/org/provider@%s", host, unsigned), variables)
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("rejects signatures from a different key", func(t *testing.T) {
+		g := NewWithT(t)
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		g.Expect(err).ToNot(HaveOccurred())
+		otherPublicKey, err := x509.MarshalPKIXPublicKey(&otherKey.PublicKey)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		variables := test.NewFakeVariableClient().WithVar(config.OCICosignPublicKeyVariable, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: otherPublicKey})))
+		_, err = newRepo(fmt.Sprintf("oci://%s/org/provider@%s", host, v100), variables)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func Test_NewOCIRepository_invalidURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{
+			name: "missing repository",
+			url:  "oci://ghcr.io",
+		},
+		{
+			name: "unsupported digest",
+			url:  "oci://ghcr.io/org/provider@sha512:abc",
+		},
+		{
+			name: "wrong scheme",
+			url:  "https://ghcr.io/org/provider",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := NewOCIRepository(context.Background(), config.NewProvider("test", tt.url, clusterctlv1.CoreProviderType), test.NewFakeVariableClient())
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
@@ -20,8 +20,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/util"
@@ -69,6 +72,30 @@ type RolloutResumeOptions struct {
 	Namespace string
 }
 
+// RolloutStatusOptions carries the options supported by RolloutStatus.
+type RolloutStatusOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Resources for the rollout command
+	Resources []string
+
+	// Namespace where the resource(s) live. If unspecified, the namespace name will be inferred
+	// from the current configuration.
+	Namespace string
+
+	// Wait instructs RolloutStatus to wait until the rollout of all the resources is completed.
+	Wait bool
+
+	// Timeout defines how long to wait for the rollout to complete when Wait is set. If unspecified,
+	// RolloutStatus waits indefinitely.
+	Timeout time.Duration
+}
+
+// rolloutStatusPollInterval is the interval used to check the rollout status while waiting.
+var rolloutStatusPollInterval = 5 * time.Second
+
 func (c *clusterctlClient) RolloutRestart(ctx context.Context, options RolloutRestartOptions) error {
 	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
 	if err != nil {
@@ -120,6 +147,58 @@ func (c *clusterctlClient) RolloutResume(ctx context.Context, options RolloutRes
 	return nil
 }
 
+func (c *clusterctlClient) RolloutStatus(ctx context.Context, options RolloutStatusOptions) ([]RolloutStatus, error) {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+	objRefs, err := getObjectRefs(clusterClient, options.Namespace, options.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	getStatuses := func(ctx context.Context) ([]RolloutStatus, bool, error) {
+		statuses := make([]RolloutStatus, 0, len(objRefs))
+		done := true
+		for _, ref := range objRefs {
+			status, err := c.alphaClient.Rollout().ObjectStatusViewer(ctx, clusterClient.Proxy(), ref)
+			if err != nil {
+				return nil, false, err
+			}
+			statuses = append(statuses, RolloutStatus(*status))
+			done = done && status.Done
+		}
+		return statuses, done, nil
+	}
+
+	if !options.Wait {
+		statuses, _, err := getStatuses(ctx)
+		return statuses, err
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	var lastStatuses []RolloutStatus
+	if err := wait.PollUntilContextCancel(ctx, rolloutStatusPollInterval, true, func(ctx context.Context) (bool, error) {
+		statuses, done, err := getStatuses(ctx)
+		if err != nil {
+			return false, err
+		}
+		lastStatuses = statuses
+		return done, nil
+	}); err != nil {
+		if wait.Interrupted(err) {
+			return lastStatuses, pkgerrors.New("timed out waiting for the rollout to complete")
+		}
+		return lastStatuses, err
+	}
+	return lastStatuses, nil
+}
+
 func getObjectRefs(clusterClient cluster.Client, namespace string, resources []string) ([]corev1.ObjectReference, error) {
 	// If the option specifying the Namespace is empty, try to detect it.
 	if namespace == "" {
@@ -354,3 +354,95 @@ func Test_clusterctlClient_RolloutResume(t *testing.T) {
 		})
 	}
 }
+
+func Test_clusterctlClient_RolloutStatus(t *testing.T) {
+	type fields struct {
+		client *fakeClient
+	}
+	type args struct {
+		options RolloutStatusOptions
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		wantDone []bool
+		wantErr  bool
+	}{
+		{
+			name: "return the status of the machinedeployments",
+			fields: fields{
+				client: fakeClientForRollout(),
+			},
+			args: args{
+				options: RolloutStatusOptions{
+					Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Resources:  []string{"machinedeployment/md-1", "machinedeployment/md-2"},
+					Namespace:  "default",
+				},
+			},
+			wantDone: []bool{true, true},
+		},
+		{
+			name: "wait for the rollout of the machinedeployments to complete",
+			fields: fields{
+				client: fakeClientForRollout(),
+			},
+			args: args{
+				options: RolloutStatusOptions{
+					Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Resources:  []string{"machinedeployment/md-1"},
+					Namespace:  "default",
+					Wait:       true,
+				},
+			},
+			wantDone: []bool{true},
+		},
+		{
+			name: "return error if one of the machinedeployments is not found",
+			fields: fields{
+				client: fakeClientForRollout(),
+			},
+			args: args{
+				options: RolloutStatusOptions{
+					Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Resources:  []string{"machinedeployment/md-1", "machinedeployment/md-does-not-exist"},
+					Namespace:  "default",
+					Wait:       true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "return error if no resource specified",
+			fields: fields{
+				client: fakeClientForRollout(),
+			},
+			args: args{
+				options: RolloutStatusOptions{
+					Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					Namespace:  "default",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			got, err := tt.fields.client.RolloutStatus(ctx, tt.args.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(HaveLen(len(tt.wantDone)))
+			for i := range got {
+				g.Expect(got[i].Done).To(Equal(tt.wantDone[i]))
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/alpha"
+)
+
+// ScaleOptions carries the options supported by Scale.
+type ScaleOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Namespace where the Clusters are generated. If unspecified, the current namespace will be used.
+	Namespace string
+
+	// NamePrefix is the prefix of the names of the generated Clusters; it also identifies the scale test run.
+	NamePrefix string
+
+	// ClusterClassName is the name of the ClusterClass used by the generated Clusters, e.g. a ClusterClass
+	// using the in-memory backend of CAPD. The ClusterClass must exist in Namespace.
+	ClusterClassName string
+
+	// KubernetesVersion is the Kubernetes version of the generated Clusters.
+	KubernetesVersion string
+
+	// Clusters is the number of Clusters to generate.
+	Clusters int
+
+	// ControlPlaneMachines is the number of control plane Machines of each Cluster.
+	ControlPlaneMachines int32
+
+	// WorkerMachines is the number of worker Machines of each Cluster.
+	WorkerMachines int32
+
+	// MachineDeploymentClass is the class of the MachineDeployment hosting the worker Machines.
+	MachineDeploymentClass string
+
+	// Concurrency is the number of Clusters created in parallel.
+	Concurrency int
+
+	// Timeout defines how long to wait for the Clusters and the Machines to become available. If unspecified,
+	// Scale waits indefinitely.
+	Timeout time.Duration
+
+	// SkipCleanup instructs Scale to not delete the generated Clusters at the end of the scale test run.
+	SkipCleanup bool
+}
+
+// Scale generates Clusters with the given number of Machines, waits for them to become available and
+// returns a report with the time it took for them to become available and the API server load.
+func (c *clusterctlClient) Scale(ctx context.Context, options ScaleOptions) (*ScaleReport, error) {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// If the option specifying the Namespace is empty, try to detect it.
+	if options.Namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		options.Namespace = currentNamespace
+	}
+
+	report, err := c.alphaClient.Scale().Run(ctx, clusterClient.Proxy(), alpha.ScaleInput{
+		Namespace:              options.Namespace,
+		NamePrefix:             options.NamePrefix,
+		ClusterClassName:       options.ClusterClassName,
+		KubernetesVersion:      options.KubernetesVersion,
+		Clusters:               options.Clusters,
+		ControlPlaneMachines:   options.ControlPlaneMachines,
+		WorkerMachines:         options.WorkerMachines,
+		MachineDeploymentClass: options.MachineDeploymentClass,
+		Concurrency:            options.Concurrency,
+		Timeout:                options.Timeout,
+		SkipCleanup:            options.SkipCleanup,
+	})
+	return (*ScaleReport)(report), err
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
+	"sigs.k8s.io/cluster-api/internal/topology/variables"
+)
+
+// templateValues defines the content of a values file for clusterctl generate cluster.
+//
+// Example:
+//
+//	variables:
+//	  KUBERNETES_VERSION: v1.34.0
+//	  WORKER_MACHINE_COUNT: 3
+//	clusterClassVariables:
+//	  imageRepository: registry.example.com
+//	  etcd:
+//	    dataDir: /var/lib/etcd
+type templateValues struct {
+	// Variables defines values for the variables used in the template, e.g. ${ WORKER_MACHINE_COUNT }.
+	Variables map[string]interface{} `json:"variables,omitempty"`
+
+	// ClusterClassVariables defines values for the ClusterClass variables to be set
+	// in the topology of the Clusters defined in the template.
+	ClusterClassVariables map[string]apiextensionsv1.JSON `json:"clusterClassVariables,omitempty"`
+}
+
+// readTemplateValues reads a values file.
+func readTemplateValues(path string) (*templateValues, error) {
+	content, err := os.ReadFile(path) //nolint:gosec // The path is provided by the user.
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to read values file %q", path)
+	}
+
+	values := &templateValues{}
+	if err := yaml.UnmarshalStrict(content, values); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse values file %q", path)
+	}
+
+	var errs []error
+	for name, value := range values.Variables {
+		switch value.(type) {
+		case string, bool, float64, nil:
+		default:
+			errs = append(errs, pkgerrors.Errorf("value for variable %q must be a string, a number or a boolean", name))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, pkgerrors.Wrapf(kerrors.NewAggregate(errs), "invalid values file %q", path)
+	}
+	return values, nil
+}
+
+// setVariables injects the template variables from the values file into the variables client.
+func (v *templateValues) setVariables(variablesClient config.VariablesClient) {
+	for name, value := range v.Variables {
+		switch value := value.(type) {
+		case string:
+			variablesClient.Set(name, value)
+		case bool:
+			variablesClient.Set(name, strconv.FormatBool(value))
+		case float64:
+			variablesClient.Set(name, strconv.FormatFloat(value, 'f', -1, 64))
+		case nil:
+			variablesClient.Set(name, "")
+		}
+	}
+}
+
+// validateVariables checks the values file against the variables declared by the template;
+// it reports both variables set in the values file but not used by the template and variables
+// required by the template but not set in the values file nor using any other mechanism.
+func (v *templateValues) validateVariables(template Template, variablesClient config.VariablesClient) error {
+	variableMap := template.VariableMap()
+
+	unknown := []string{}
+	for name := range v.Variables {
+		if _, ok := variableMap[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	missing := []string{}
+	for name, defaultValue := range variableMap {
+		if defaultValue != nil {
+			continue
+		}
+		if _, err := variablesClient.Get(name); err != nil {
+			missing = append(missing, name)
+		}
+	}
+
+	var errs []error
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		errs = append(errs, pkgerrors.Errorf("variables [%s] are not used by the template", strings.Join(unknown, ", ")))
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		errs = append(errs, pkgerrors.Errorf("variables [%s] are required by the template but not set", strings.Join(missing, ", ")))
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// applyClusterClassVariables sets the ClusterClass variables from the values file into the topology of the
+// Clusters defined in the template, and then validates the resulting variables against the ClusterClass variable schemas.
+// Note: The ClusterClass is read from the template, or from the management cluster if not included in the template.
+func (v *templateValues) applyClusterClassVariables(ctx context.Context, template Template, clusterClient cluster.Client) error {
+	if len(v.ClusterClassVariables) == 0 {
+		return nil
+	}
+
+	objs := template.Objs()
+
+	foundCluster := false
+	var errs []error
+	for i := range objs {
+		obj := objs[i]
+		if obj.GroupVersionKind().GroupKind() != clusterv1.GroupVersion.WithKind("Cluster").GroupKind() {
+			continue
+		}
+		if _, ok, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "topology"); !ok {
+			continue
+		}
+		foundCluster = true
+
+		if err := v.setClusterVariables(&obj); err != nil {
+			return pkgerrors.Wrapf(err, "failed to set variables for Cluster %s/%s", obj.GetNamespace(), obj.GetName())
+		}
+
+		c := &clusterv1.Cluster{}
+		if err := scheme.Scheme.Convert(&obj, c, nil); err != nil {
+			return pkgerrors.Wrap(err, "failed to convert object to Cluster")
+		}
+
+		clusterClass, err := getClusterClassForValidation(ctx, objs, clusterClient, c.GetClassKey())
+		if err != nil {
+			return err
+		}
+
+		fldErrs := validateClusterClassVariables(ctx, c.Spec.Topology.Variables, clusterClass)
+		if len(fldErrs) > 0 {
+			errs = append(errs, pkgerrors.Wrapf(fldErrs.ToAggregate(), "variables of Cluster %s/%s are not valid for ClusterClass %s", c.Namespace, c.Name, c.GetClassKey()))
+		}
+	}
+
+	if !foundCluster {
+		return pkgerrors.New("clusterClassVariables are set but the template does not define any Cluster using a ClusterClass")
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// setClusterVariables sets the ClusterClass variables from the values file into the Cluster's spec.topology.variables,
+// overriding values for variables already defined in the template.
+func (v *templateValues) setClusterVariables(obj *unstructured.Unstructured) error {
+	existing, _, err := unstructured.NestedSlice(obj.Object, "spec", "topology", "variables")
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(v.ClusterClassVariables))
+	for name := range v.ClusterClassVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var value interface{}
+		if err := json.Unmarshal(v.ClusterClassVariables[name].Raw, &value); err != nil {
+			return pkgerrors.Wrapf(err, "failed to parse value for variable %q", name)
+		}
+
+		found := false
+		for i := range existing {
+			variable, ok := existing[i].(map[string]interface{})
+			if !ok || variable["name"] != name {
+				continue
+			}
+			variable["value"] = value
+			found = true
+		}
+		if !found {
+			existing = append(existing, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, existing, "spec", "topology", "variables")
+}
+
+// getClusterClassForValidation returns the ClusterClass with the given key, reading it from the template objects or,
+// if not included in the template, from the management cluster.
+func getClusterClassForValidation(ctx context.Context, objs []unstructured.Unstructured, clusterClient cluster.Client, key types.NamespacedName) (*clusterv1.ClusterClass, error) {
+	for i := range objs {
+		obj := objs[i]
+		if obj.GroupVersionKind().GroupKind() != clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind() {
+			continue
+		}
+		if obj.GetName() != key.Name || obj.GetNamespace() != key.Namespace {
+			continue
+		}
+		clusterClass := &clusterv1.ClusterClass{}
+		if err := scheme.Scheme.Convert(&obj, clusterClass, nil); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to convert object to ClusterClass")
+		}
+		return clusterClass, nil
+	}
+
+	notFoundErr := pkgerrors.Errorf("failed to validate variables: ClusterClass %s is not included in the template nor it exists in the management cluster", key)
+	if err := clusterClient.Proxy().CheckClusterAvailable(ctx); err != nil {
+		return nil, notFoundErr
+	}
+	c, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusterClass := &clusterv1.ClusterClass{}
+	if err := c.Get(ctx, key, clusterClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, notFoundErr
+		}
+		return nil, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", key)
+	}
+	return clusterClass, nil
+}
+
+// validateClusterClassVariables validates Cluster variables against the variable definitions of a ClusterClass.
+// NOTE: Variables defined by external patches are known only when reading the ClusterClass from a management cluster
+// where the topology controller already reconciled it; if they are not available, only inline variables are validated.
+func validateClusterClassVariables(ctx context.Context, values []clusterv1.ClusterVariable, clusterClass *clusterv1.ClusterClass) field.ErrorList {
+	fldPath := field.NewPath("spec", "topology", "variables")
+
+	definitions := clusterClass.Status.Variables
+	if len(definitions) == 0 {
+		hasExternalPatches := false
+		for _, patch := range clusterClass.Spec.Patches {
+			if patch.External != nil {
+				hasExternalPatches = true
+			}
+		}
+
+		definitions = make([]clusterv1.ClusterClassStatusVariable, 0, len(clusterClass.Spec.Variables))
+		inline := map[string]bool{}
+		for _, variable := range clusterClass.Spec.Variables {
+			definitions = append(definitions, clusterv1.ClusterClassStatusVariable{
+				Name: variable.Name,
+				Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+					{
+						From:                      clusterv1.VariableDefinitionFromInline,
+						Required:                  ptr.To(ptr.Deref(variable.Required, false)),
+						DeprecatedV1Beta1Metadata: variable.DeprecatedV1Beta1Metadata,
+						Schema:                    variable.Schema,
+					},
+				},
+			})
+			inline[variable.Name] = true
+		}
+
+		if hasExternalPatches {
+			filtered := []clusterv1.ClusterVariable{}
+			for _, value := range values {
+				if inline[value.Name] {
+					filtered = append(filtered, value)
+				}
+			}
+			values = filtered
+		}
+	}
+
+	// Default variables before validation, like the Cluster webhook does.
+	// NOTE: Variables without a definition are excluded from defaulting, so they are reported by validation
+	// together with all the other errors.
+	defined := map[string]bool{}
+	for _, definition := range definitions {
+		defined[definition.Name] = true
+	}
+	var definedValues, undefinedValues []clusterv1.ClusterVariable
+	for _, value := range values {
+		if defined[value.Name] {
+			definedValues = append(definedValues, value)
+			continue
+		}
+		undefinedValues = append(undefinedValues, value)
+	}
+
+	defaulted, errs := variables.DefaultClusterVariables(definedValues, definitions, fldPath)
+	if len(errs) > 0 {
+		return errs
+	}
+	return variables.ValidateClusterVariables(ctx, append(defaulted, undefinedValues...), nil, definitions, fldPath)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+const valuesTestTemplate = `apiVersion: cluster.x-k8s.io/v1beta2
+kind: ClusterClass
+metadata:
+  name: dev
+  namespace: ns1
+spec:
+  variables:
+  - name: imageRepository
+    required: true
+    schema:
+      openAPIV3Schema:
+        type: string
+        minLength: 1
+  - name: etcd
+    required: false
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          dataDir:
+            type: string
+---
+apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: ${CLUSTER_NAME}
+  namespace: ns1
+spec:
+  topology:
+    classRef:
+      name: dev
+    version: ${KUBERNETES_VERSION}
+    controlPlane:
+      replicas: ${CONTROL_PLANE_MACHINE_COUNT}
+    workers:
+      machineDeployments:
+      - class: default-worker
+        name: md-0
+        replicas: ${WORKER_MACHINE_COUNT}
+        metadata:
+          labels:
+            zone: ${WORKER_ZONE}
+`
+
+func Test_readTemplateValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  string
+		want    *templateValues
+		wantErr bool
+	}{
+		{
+			name: "pass with variables and ClusterClass variables",
+			values: "variables:\n" +
+				"  WORKER_ZONE: a\n" +
+				"  WORKER_MACHINE_COUNT: 3\n" +
+				"clusterClassVariables:\n" +
+				"  imageRepository: registry.example.com\n",
+			wantErr: false,
+		},
+		{
+			name:    "fails with unknown fields",
+			values:  "vars:\n  WORKER_ZONE: a\n",
+			wantErr: true,
+		},
+		{
+			name:    "fails with non scalar template variables",
+			values:  "variables:\n  WORKER_ZONE:\n    foo: bar\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			path := filepath.Join(t.TempDir(), "values.yaml")
+			g.Expect(os.WriteFile(path, []byte(tt.values), 0600)).To(Succeed())
+
+			got, err := readTemplateValues(path)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.Variables).To(HaveKeyWithValue("WORKER_ZONE", "a"))
+			g.Expect(got.Variables).To(HaveKeyWithValue("WORKER_MACHINE_COUNT", float64(3)))
+			g.Expect(got.ClusterClassVariables).To(HaveKey("imageRepository"))
+		})
+	}
+}
+
+func Test_clusterctlClient_GetClusterTemplate_withValuesFile(t *testing.T) {
+	ctx := context.Background()
+
+	tmpDir := t.TempDir()
+	templatePath := filepath.Join(tmpDir, "cluster-template.yaml")
+	NewWithT(t).Expect(os.WriteFile(templatePath, []byte(valuesTestTemplate), 0600)).To(Succeed())
+
+	tests := []struct {
+		name        string
+		values      string
+		wantErr     []string
+		wantObjects func(g *WithT, got Template)
+	}{
+		{
+			name: "pass with valid values",
+			values: "variables:\n" +
+				"  KUBERNETES_VERSION: v1.34.0\n" +
+				"  WORKER_MACHINE_COUNT: 3\n" +
+				"  WORKER_ZONE: zone-a\n" +
+				"clusterClassVariables:\n" +
+				"  imageRepository: registry.example.com\n" +
+				"  etcd:\n" +
+				"    dataDir: /var/lib/etcd\n",
+			wantObjects: func(g *WithT, got Template) {
+				yaml, err := got.Yaml()
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(string(yaml)).To(ContainSubstring("version: v1.34.0"))
+				g.Expect(string(yaml)).To(ContainSubstring("replicas: 3"))
+				g.Expect(string(yaml)).To(ContainSubstring("zone: zone-a"))
+				g.Expect(string(yaml)).To(ContainSubstring("name: imageRepository"))
+				g.Expect(string(yaml)).To(ContainSubstring("value: registry.example.com"))
+				g.Expect(string(yaml)).To(ContainSubstring("dataDir: /var/lib/etcd"))
+			},
+		},
+		{
+			name: "fails listing unknown and missing template variables",
+			values: "variables:\n" +
+				"  KUBERNETES_VERSION: v1.34.0\n" +
+				"  WORKER_ZONES: zone-a\n" +
+				"clusterClassVariables:\n" +
+				"  imageRepository: registry.example.com\n",
+			wantErr: []string{
+				"variables [WORKER_ZONES] are not used by the template",
+				"variables [WORKER_ZONE] are required by the template but not set",
+			},
+		},
+		{
+			name: "fails listing invalid and missing ClusterClass variables",
+			values: "variables:\n" +
+				"  KUBERNETES_VERSION: v1.34.0\n" +
+				"  WORKER_ZONE: zone-a\n" +
+				"clusterClassVariables:\n" +
+				"  etcd:\n" +
+				"    dataDir: 1\n" +
+				"  unknown: foo\n",
+			wantErr: []string{
+				"spec.topology.variables[etcd].value.dataDir",
+				"spec.topology.variables[unknown]",
+				"spec.topology.variables: Required value: required variable \"imageRepository\" must be set",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config1 := newFakeConfig(ctx).
+				WithProvider(infraProviderConfig)
+
+			cluster1 := newFakeCluster(cluster.Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"}, config1)
+
+			client := newFakeClient(ctx, config1).
+				WithCluster(cluster1)
+
+			valuesPath := filepath.Join(t.TempDir(), "values.yaml")
+			g.Expect(os.WriteFile(valuesPath, []byte(tt.values), 0600)).To(Succeed())
+
+			got, err := client.GetClusterTemplate(ctx, GetClusterTemplateOptions{
+				Kubeconfig:      Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+				URLSource:       &URLSourceOptions{URL: templatePath},
+				ClusterName:     "test",
+				TargetNamespace: "ns1",
+				ValuesFile:      valuesPath,
+			})
+			if len(tt.wantErr) > 0 {
+				g.Expect(err).To(HaveOccurred())
+				for _, want := range tt.wantErr {
+					g.Expect(err.Error()).To(ContainSubstring(want))
+				}
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			tt.wantObjects(g, got)
+		})
+	}
+}
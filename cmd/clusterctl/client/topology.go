@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
+	"sigs.k8s.io/cluster-api/exp/topology/desiredstate"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+// TopologyPreviewOptions carries the options supported by TopologyPreview.
+type TopologyPreviewOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Input is the YAML content with the Cluster to preview. The Cluster must use the
+	// cluster.x-k8s.io/v1beta2 API version and a managed topology.
+	// The ClusterClass referenced by the Cluster and its templates are read from the management cluster.
+	Input []byte
+
+	// Namespace of the Cluster, if not set in Input. If unspecified, the current namespace will be used.
+	Namespace string
+}
+
+// TopologyPreviewOutput contains the result of TopologyPreview.
+type TopologyPreviewOutput struct {
+	// Objects are the objects of the desired state of the Cluster topology, including the templates
+	// patched by the inline patches of the ClusterClass.
+	Objects []unstructured.Unstructured
+}
+
+// TopologyPreview returns the desired state of a Cluster topology as it would be created from the ClusterClass
+// and the variable values of the Cluster, without creating or changing any object in the management cluster.
+// NOTE: External patches are not supported, given that clusterctl can't call Runtime Extensions.
+func (c *clusterctlClient) TopologyPreview(ctx context.Context, options TopologyPreviewOptions) (*TopologyPreviewOutput, error) {
+	cluster, err := clusterFromYAML(options.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Namespace == "" {
+		// If the option specifying the Namespace is empty, try to detect it.
+		if options.Namespace == "" {
+			currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+			if err != nil {
+				return nil, err
+			}
+			options.Namespace = currentNamespace
+		}
+		cluster.Namespace = options.Namespace
+	}
+
+	managementClient, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClass := &clusterv1.ClusterClass{}
+	if err := managementClient.Get(ctx, cluster.GetClassKey(), clusterClass); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", cluster.GetClassKey())
+	}
+
+	desiredState, err := desiredstate.Preview(ctx, managementClient, desiredstate.PreviewInput{
+		Cluster:      cluster,
+		ClusterClass: clusterClass,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to preview Cluster %s", klog.KObj(cluster))
+	}
+
+	objs, err := desiredStateToUnstructured(desiredState)
+	if err != nil {
+		return nil, err
+	}
+	return &TopologyPreviewOutput{Objects: objs}, nil
+}
+
+// clusterFromYAML returns the Cluster from the YAML content; the YAML content must contain exactly one Cluster.
+func clusterFromYAML(input []byte) (*clusterv1.Cluster, error) {
+	objs, err := utilyaml.ToUnstructured(input)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to parse input")
+	}
+
+	var clusters []unstructured.Unstructured
+	for _, obj := range objs {
+		if obj.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind() {
+			clusters = append(clusters, obj)
+		}
+	}
+	if len(clusters) != 1 {
+		return nil, pkgerrors.Errorf("input must contain exactly one Cluster, got %d", len(clusters))
+	}
+	if clusters[0].GetAPIVersion() != clusterv1.GroupVersion.String() {
+		return nil, pkgerrors.Errorf("Cluster must use the %s API version, got %s; use clusterctl convert to convert it", clusterv1.GroupVersion, clusters[0].GetAPIVersion())
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusters[0].Object, cluster); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to convert Cluster")
+	}
+	if !cluster.Spec.Topology.IsDefined() {
+		return nil, pkgerrors.Errorf("Cluster %s must have a managed topology", cluster.Name)
+	}
+	return cluster, nil
+}
+
+// desiredStateToUnstructured returns all the objects of the desired state, with the Cluster first,
+// followed by the objects of the ControlPlane, the MachineDeployments and the MachinePools sorted by topology name.
+func desiredStateToUnstructured(desiredState *scope.ClusterState) ([]unstructured.Unstructured, error) {
+	objs := []client.Object{desiredState.Cluster, desiredState.InfrastructureCluster, desiredState.ControlPlane.Object}
+	if desiredState.ControlPlane.InfrastructureMachineTemplate != nil {
+		objs = append(objs, desiredState.ControlPlane.InfrastructureMachineTemplate)
+	}
+	if desiredState.ControlPlane.MachineHealthCheck != nil {
+		objs = append(objs, desiredState.ControlPlane.MachineHealthCheck)
+	}
+	for _, name := range slices.Sorted(maps.Keys(desiredState.MachineDeployments)) {
+		md := desiredState.MachineDeployments[name]
+		objs = append(objs, md.Object, md.BootstrapTemplate, md.InfrastructureMachineTemplate)
+		if md.MachineHealthCheck != nil {
+			objs = append(objs, md.MachineHealthCheck)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(desiredState.MachinePools)) {
+		mp := desiredState.MachinePools[name]
+		objs = append(objs, mp.Object, mp.BootstrapObject, mp.InfrastructureMachinePoolObject)
+	}
+
+	result := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			result = append(result, *u)
+			continue
+		}
+
+		gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+		if err != nil {
+			return nil, err
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to convert %s %s", gvk.Kind, klog.KObj(obj))
+		}
+		u := unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(gvk)
+		result = append(result, u)
+	}
+	return result, nil
+}
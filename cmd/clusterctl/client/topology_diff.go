@@ -0,0 +1,539 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
+	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/structuredmerge"
+	"sigs.k8s.io/cluster-api/exp/topology/desiredstate"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// TopologyDiffOptions carries the options supported by TopologyDiff.
+type TopologyDiffOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// ClusterName is the name of the Cluster with a managed topology to compare with its desired state.
+	ClusterName string
+
+	// Namespace of the Cluster. If unspecified, the current namespace will be used.
+	Namespace string
+}
+
+// TopologyDiffOperation is the operation the topology controller would perform on an object
+// to reconcile it to the desired state.
+type TopologyDiffOperation string
+
+const (
+	// TopologyDiffCreate is used for objects of the desired state which don't exist yet.
+	TopologyDiffCreate TopologyDiffOperation = "Create"
+
+	// TopologyDiffUpdate is used for objects that differ from the desired state.
+	TopologyDiffUpdate TopologyDiffOperation = "Update"
+
+	// TopologyDiffDelete is used for MachineDeployments and MachinePools which are not part of the desired state.
+	TopologyDiffDelete TopologyDiffOperation = "Delete"
+)
+
+// TopologyObjectDiff is the difference between an object of a Cluster topology and its desired state.
+type TopologyObjectDiff struct {
+	// Operation is the operation the topology controller would perform on the object.
+	Operation TopologyDiffOperation `json:"operation"`
+
+	// Object is the reference to the object.
+	Object corev1.ObjectReference `json:"object"`
+
+	// Patch is the patch that would be applied to the object; only set for the Update operation.
+	Patch string `json:"patch,omitempty"`
+
+	// Diff is the YAML diff between the object and its desired state; only set for the Update operation.
+	Diff string `json:"diff,omitempty"`
+}
+
+// TopologyDiffOutput contains the result of TopologyDiff.
+type TopologyDiffOutput struct {
+	// Objects are the objects differing from the desired state, with the Cluster first,
+	// followed by the objects of the ControlPlane, the MachineDeployments and the MachinePools sorted by topology name.
+	Objects []TopologyObjectDiff
+}
+
+// HasDrift returns true if at least one object differs from the desired state.
+func (o *TopologyDiffOutput) HasDrift() bool {
+	return len(o.Objects) > 0
+}
+
+// TopologyDiff compares the objects of a Cluster with a managed topology with the desired state computed
+// from the ClusterClass and the variable values of the Cluster. The comparison uses a server side apply
+// dry-run request with the field manager of the topology controller, so only changes to fields managed
+// by the topology controller, e.g. introduced by out-of-band edits, are reported.
+// NOTE: External patches are not supported, given that clusterctl can't call Runtime Extensions.
+// NOTE: Upgrades in progress and lifecycle hooks are not taken into account; e.g. while the Cluster is
+// being upgraded, the version of MachineDeployments not upgraded yet is reported as a difference.
+func (c *clusterctlClient) TopologyDiff(ctx context.Context, options TopologyDiffOptions) (*TopologyDiffOutput, error) {
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// If the option specifying the Namespace is empty, try to detect it.
+	if options.Namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		options.Namespace = currentNamespace
+	}
+
+	managementClient, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := managementClient.Get(ctx, client.ObjectKey{Namespace: options.Namespace, Name: options.ClusterName}, cluster); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get Cluster %s/%s", options.Namespace, options.ClusterName)
+	}
+	if !cluster.Spec.Topology.IsDefined() {
+		return nil, pkgerrors.Errorf("Cluster %s must have a managed topology", klog.KObj(cluster))
+	}
+
+	clusterClass := &clusterv1.ClusterClass{}
+	if err := managementClient.Get(ctx, cluster.GetClassKey(), clusterClass); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get ClusterClass %s", cluster.GetClassKey())
+	}
+
+	desiredState, err := desiredstate.Preview(ctx, managementClient, desiredstate.PreviewInput{
+		Cluster:      cluster,
+		ClusterClass: clusterClass,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to compute the desired state of Cluster %s", klog.KObj(cluster))
+	}
+
+	diffs, err := matchTopologyObjects(ctx, managementClient, cluster, desiredState)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &TopologyDiffOutput{}
+	for _, d := range diffs {
+		objDiff, err := d.compute(ctx, managementClient)
+		if err != nil {
+			return nil, err
+		}
+		if objDiff != nil {
+			out.Objects = append(out.Objects, *objDiff)
+		}
+	}
+	return out, nil
+}
+
+// topologyObjectPair is a pair of a current and a desired object of a Cluster topology.
+type topologyObjectPair struct {
+	// current is the object read from the management cluster; nil if the object doesn't exist.
+	current client.Object
+
+	// desired is the object of the desired state; nil if the current object is not part of the desired state.
+	desired client.Object
+
+	// ignorePaths are the paths ignored when comparing the current and the desired object.
+	ignorePaths []contract.Path
+}
+
+// compute returns the difference between the current and the desired object, or nil if there is no difference.
+func (p topologyObjectPair) compute(ctx context.Context, c client.Client) (*TopologyObjectDiff, error) {
+	switch {
+	case util.IsNil(p.current):
+		ref, err := objectReference(p.desired)
+		if err != nil {
+			return nil, err
+		}
+		return &TopologyObjectDiff{Operation: TopologyDiffCreate, Object: ref}, nil
+	case util.IsNil(p.desired):
+		ref, err := objectReference(p.current)
+		if err != nil {
+			return nil, err
+		}
+		return &TopologyObjectDiff{Operation: TopologyDiffDelete, Object: ref}, nil
+	}
+
+	ref, err := objectReference(p.current)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: The Cluster is not reconciled with server side apply by the topology controller,
+	// so it is compared using a two-way merge patch, the same way reconcileCluster does.
+	if _, ok := p.current.(*clusterv1.Cluster); ok {
+		patch, diff, err := mergePatchDiff(p.current, p.desired)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compare %s %s", ref.Kind, klog.KObj(p.current))
+		}
+		if patch == "{}" {
+			return nil, nil
+		}
+		return &TopologyObjectDiff{Operation: TopologyDiffUpdate, Object: ref, Patch: patch, Diff: diff}, nil
+	}
+
+	var opts []structuredmerge.HelperOption
+	if len(p.ignorePaths) > 0 {
+		opts = append(opts, structuredmerge.IgnorePaths(p.ignorePaths))
+	}
+	patchHelper, err := structuredmerge.NewServerSidePatchHelper(ctx, p.current, p.desired, c, noopSSACache{}, opts...)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to compare %s %s", ref.Kind, klog.KObj(p.current))
+	}
+	if !patchHelper.HasChanges() {
+		return nil, nil
+	}
+	return &TopologyObjectDiff{Operation: TopologyDiffUpdate, Object: ref, Patch: patchHelper.PatchData(), Diff: patchHelper.Diff()}, nil
+}
+
+// matchTopologyObjects matches the objects of the desired state with the current objects of the Cluster topology.
+// Given that the desired state is computed as if the Cluster didn't exist yet, the desired objects are renamed
+// to the current objects, and the references between desired objects are updated accordingly.
+func matchTopologyObjects(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, desiredState *scope.ClusterState) ([]topologyObjectPair, error) {
+	var pairs []topologyObjectPair
+
+	// Cluster.
+	// NOTE: Only the labels enforced by the topology controller are compared; the references to the
+	// InfrastructureCluster and the ControlPlane are not expected to change once set.
+	desiredCluster := cluster.DeepCopy()
+	if desiredCluster.Labels == nil {
+		desiredCluster.Labels = map[string]string{}
+	}
+	maps.Copy(desiredCluster.Labels, desiredState.Cluster.Labels)
+	pairs = append(pairs, topologyObjectPair{current: cluster, desired: desiredCluster})
+
+	// InfrastructureCluster.
+	currentInfrastructureCluster, err := getCurrentTopologyObject(ctx, c, desiredState.InfrastructureCluster, cluster.Spec.InfrastructureRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	ignorePaths, err := contract.InfrastructureCluster().IgnorePaths(desiredState.InfrastructureCluster)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to calculate ignore paths for the InfrastructureCluster")
+	}
+	pairs = append(pairs, topologyObjectPair{current: currentInfrastructureCluster, desired: desiredState.InfrastructureCluster, ignorePaths: ignorePaths})
+
+	// ControlPlane.
+	controlPlanePairs, err := matchControlPlaneObjects(ctx, c, cluster, desiredState.ControlPlane)
+	if err != nil {
+		return nil, err
+	}
+	pairs = append(pairs, controlPlanePairs...)
+
+	// MachineDeployments.
+	mdList := &clusterv1.MachineDeploymentList{}
+	if err := c.List(ctx, mdList, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}, client.HasLabels{clusterv1.ClusterTopologyOwnedLabel}); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to list MachineDeployments for Cluster %s", klog.KObj(cluster))
+	}
+	currentMachineDeployments := map[string]*clusterv1.MachineDeployment{}
+	for i := range mdList.Items {
+		md := &mdList.Items[i]
+		if name, ok := md.Labels[clusterv1.ClusterTopologyMachineDeploymentNameLabel]; ok {
+			currentMachineDeployments[name] = md
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(desiredState.MachineDeployments)) {
+		mdPairs, err := matchMachineDeploymentObjects(ctx, c, currentMachineDeployments[name], desiredState.MachineDeployments[name])
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, mdPairs...)
+	}
+	for _, name := range slices.Sorted(maps.Keys(currentMachineDeployments)) {
+		if _, ok := desiredState.MachineDeployments[name]; !ok {
+			pairs = append(pairs, topologyObjectPair{current: currentMachineDeployments[name]})
+		}
+	}
+
+	// MachinePools.
+	mpList := &clusterv1.MachinePoolList{}
+	if err := c.List(ctx, mpList, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}, client.HasLabels{clusterv1.ClusterTopologyOwnedLabel}); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to list MachinePools for Cluster %s", klog.KObj(cluster))
+	}
+	currentMachinePools := map[string]*clusterv1.MachinePool{}
+	for i := range mpList.Items {
+		mp := &mpList.Items[i]
+		if name, ok := mp.Labels[clusterv1.ClusterTopologyMachinePoolNameLabel]; ok {
+			currentMachinePools[name] = mp
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(desiredState.MachinePools)) {
+		mpPairs, err := matchMachinePoolObjects(ctx, c, currentMachinePools[name], desiredState.MachinePools[name])
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, mpPairs...)
+	}
+	for _, name := range slices.Sorted(maps.Keys(currentMachinePools)) {
+		if _, ok := desiredState.MachinePools[name]; !ok {
+			pairs = append(pairs, topologyObjectPair{current: currentMachinePools[name]})
+		}
+	}
+
+	return pairs, nil
+}
+
+// matchControlPlaneObjects matches the ControlPlane objects of the desired state with the current objects.
+func matchControlPlaneObjects(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, desired *scope.ControlPlaneState) ([]topologyObjectPair, error) {
+	currentControlPlane, err := getCurrentTopologyObject(ctx, c, desired.Object, cluster.Spec.ControlPlaneRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	if currentControlPlane == nil {
+		return controlPlaneObjectPairs(desired, nil, nil, nil)
+	}
+
+	var currentInfrastructureMachineTemplate *unstructured.Unstructured
+	if desired.InfrastructureMachineTemplate != nil {
+		var desiredRefName string
+		contractVersion, err := contract.GetContractVersionForVersion(ctx, c, desired.Object.GroupVersionKind().GroupKind(), desired.Object.GroupVersionKind().Version)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get contract version for the ControlPlane object")
+		}
+		if contractVersion == "v1beta1" {
+			currentRef, err := contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Get(currentControlPlane)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to get InfrastructureMachineTemplate reference for %s %s", currentControlPlane.GetKind(), klog.KObj(currentControlPlane))
+			}
+			desiredRef, err := contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Get(desired.Object)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to get InfrastructureMachineTemplate reference for %s %s", desired.Object.GetKind(), klog.KObj(desired.Object))
+			}
+			desiredRef.Name = currentRef.Name
+			desiredRefName = currentRef.Name
+			if err := contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Set(desired.Object, desiredRef); err != nil {
+				return nil, err
+			}
+		} else {
+			currentRef, err := contract.ControlPlane().MachineTemplate().InfrastructureRef().Get(currentControlPlane)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to get InfrastructureMachineTemplate reference for %s %s", currentControlPlane.GetKind(), klog.KObj(currentControlPlane))
+			}
+			desiredRef, err := contract.ControlPlane().MachineTemplate().InfrastructureRef().Get(desired.Object)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "failed to get InfrastructureMachineTemplate reference for %s %s", desired.Object.GetKind(), klog.KObj(desired.Object))
+			}
+			desiredRef.Name = currentRef.Name
+			desiredRefName = currentRef.Name
+			if err := contract.ControlPlane().MachineTemplate().InfrastructureRef().Set(desired.Object, desiredRef); err != nil {
+				return nil, err
+			}
+		}
+
+		currentInfrastructureMachineTemplate, err = getCurrentTopologyObject(ctx, c, desired.InfrastructureMachineTemplate, desiredRefName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var currentMachineHealthCheck *clusterv1.MachineHealthCheck
+	if desired.MachineHealthCheck != nil {
+		desired.MachineHealthCheck.Name = currentControlPlane.GetName()
+		currentMachineHealthCheck, err = getCurrentMachineHealthCheck(ctx, c, desired.MachineHealthCheck)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return controlPlaneObjectPairs(desired, currentControlPlane, currentInfrastructureMachineTemplate, currentMachineHealthCheck)
+}
+
+func controlPlaneObjectPairs(desired *scope.ControlPlaneState, currentControlPlane, currentInfrastructureMachineTemplate *unstructured.Unstructured, currentMachineHealthCheck *clusterv1.MachineHealthCheck) ([]topologyObjectPair, error) {
+	ignorePaths, err := contract.ControlPlane().IgnorePaths(desired.Object)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to calculate ignore paths for the ControlPlane")
+	}
+	pairs := []topologyObjectPair{{current: currentControlPlane, desired: desired.Object, ignorePaths: ignorePaths}}
+	if desired.InfrastructureMachineTemplate != nil {
+		pairs = append(pairs, topologyObjectPair{current: currentInfrastructureMachineTemplate, desired: desired.InfrastructureMachineTemplate})
+	}
+	if desired.MachineHealthCheck != nil {
+		pairs = append(pairs, topologyObjectPair{current: currentMachineHealthCheck, desired: desired.MachineHealthCheck})
+	}
+	return pairs, nil
+}
+
+// matchMachineDeploymentObjects matches the objects of a MachineDeployment of the desired state with the current objects.
+func matchMachineDeploymentObjects(ctx context.Context, c client.Client, current *clusterv1.MachineDeployment, desired *scope.MachineDeploymentState) ([]topologyObjectPair, error) {
+	if current == nil {
+		pairs := []topologyObjectPair{
+			{desired: desired.Object},
+			{desired: desired.BootstrapTemplate},
+			{desired: desired.InfrastructureMachineTemplate},
+		}
+		if desired.MachineHealthCheck != nil {
+			pairs = append(pairs, topologyObjectPair{desired: desired.MachineHealthCheck})
+		}
+		return pairs, nil
+	}
+
+	desired.Object.Name = current.Name
+	desired.Object.Spec.Template.Spec.Bootstrap.ConfigRef.Name = current.Spec.Template.Spec.Bootstrap.ConfigRef.Name
+	desired.Object.Spec.Template.Spec.InfrastructureRef.Name = current.Spec.Template.Spec.InfrastructureRef.Name
+
+	currentBootstrapTemplate, err := getCurrentTopologyObject(ctx, c, desired.BootstrapTemplate, current.Spec.Template.Spec.Bootstrap.ConfigRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	currentInfrastructureMachineTemplate, err := getCurrentTopologyObject(ctx, c, desired.InfrastructureMachineTemplate, current.Spec.Template.Spec.InfrastructureRef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := []topologyObjectPair{
+		{current: current, desired: desired.Object},
+		{current: currentBootstrapTemplate, desired: desired.BootstrapTemplate},
+		{current: currentInfrastructureMachineTemplate, desired: desired.InfrastructureMachineTemplate},
+	}
+	if desired.MachineHealthCheck != nil {
+		desired.MachineHealthCheck.Name = current.Name
+		currentMachineHealthCheck, err := getCurrentMachineHealthCheck(ctx, c, desired.MachineHealthCheck)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, topologyObjectPair{current: currentMachineHealthCheck, desired: desired.MachineHealthCheck})
+	}
+	return pairs, nil
+}
+
+// matchMachinePoolObjects matches the objects of a MachinePool of the desired state with the current objects.
+func matchMachinePoolObjects(ctx context.Context, c client.Client, current *clusterv1.MachinePool, desired *scope.MachinePoolState) ([]topologyObjectPair, error) {
+	if current == nil {
+		return []topologyObjectPair{
+			{desired: desired.Object},
+			{desired: desired.BootstrapObject},
+			{desired: desired.InfrastructureMachinePoolObject},
+		}, nil
+	}
+
+	desired.Object.Name = current.Name
+	desired.Object.Spec.Template.Spec.Bootstrap.ConfigRef.Name = current.Spec.Template.Spec.Bootstrap.ConfigRef.Name
+	desired.Object.Spec.Template.Spec.InfrastructureRef.Name = current.Spec.Template.Spec.InfrastructureRef.Name
+
+	currentBootstrapObject, err := getCurrentTopologyObject(ctx, c, desired.BootstrapObject, current.Spec.Template.Spec.Bootstrap.ConfigRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	currentInfrastructureMachinePoolObject, err := getCurrentTopologyObject(ctx, c, desired.InfrastructureMachinePoolObject, current.Spec.Template.Spec.InfrastructureRef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return []topologyObjectPair{
+		{current: current, desired: desired.Object},
+		{current: currentBootstrapObject, desired: desired.BootstrapObject},
+		{current: currentInfrastructureMachinePoolObject, desired: desired.InfrastructureMachinePoolObject},
+	}, nil
+}
+
+// getCurrentTopologyObject renames the desired object to name and gets the object with the same apiVersion, kind,
+// namespace and name, so that both objects can be compared; it returns nil if name is empty or the object doesn't exist.
+func getCurrentTopologyObject(ctx context.Context, c client.Client, desired *unstructured.Unstructured, name string) (*unstructured.Unstructured, error) {
+	if name == "" {
+		return nil, nil
+	}
+	desired.SetName(name)
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := c.Get(ctx, client.ObjectKey{Namespace: desired.GetNamespace(), Name: name}, current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, pkgerrors.Wrapf(err, "failed to get %s %s/%s", desired.GetKind(), desired.GetNamespace(), name)
+	}
+	return current, nil
+}
+
+// getCurrentMachineHealthCheck gets the MachineHealthCheck with the same name and namespace of the desired one;
+// it returns nil if the MachineHealthCheck doesn't exist.
+func getCurrentMachineHealthCheck(ctx context.Context, c client.Client, desired *clusterv1.MachineHealthCheck) (*clusterv1.MachineHealthCheck, error) {
+	current := &clusterv1.MachineHealthCheck{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, pkgerrors.Wrapf(err, "failed to get MachineHealthCheck %s", klog.KObj(desired))
+	}
+	return current, nil
+}
+
+// objectReference returns a reference to obj.
+func objectReference(obj client.Object) (corev1.ObjectReference, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+	if err != nil {
+		return corev1.ObjectReference{}, err
+	}
+	return corev1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}, nil
+}
+
+// mergePatchDiff returns the two-way merge patch from current to desired and the YAML diff between them.
+func mergePatchDiff(current, desired client.Object) (string, string, error) {
+	patch, err := client.MergeFrom(current).Data(desired)
+	if err != nil {
+		return "", "", err
+	}
+	currentYAML, err := yaml.Marshal(current)
+	if err != nil {
+		return "", "", err
+	}
+	desiredYAML, err := yaml.Marshal(desired)
+	if err != nil {
+		return "", "", err
+	}
+	return string(patch), cmp.Diff(string(currentYAML), string(desiredYAML)), nil
+}
+
+// noopSSACache is a ssa.Cache which never caches requests; the dry-run requests issued by clusterctl
+// are never repeated, so there is no need to cache them.
+type noopSSACache struct{}
+
+var _ ssa.Cache = noopSSACache{}
+
+// Add adds the given key to the Cache.
+func (noopSSACache) Add(_ string) {}
+
+// Has checks if the given key (still) exists in the Cache.
+func (noopSSACache) Has(_, _ string) bool { return false }
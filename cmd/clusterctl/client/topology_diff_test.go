@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+)
+
+func Test_matchMachineDeploymentObjects(t *testing.T) {
+	bootstrapTemplateGVK := clusterv1.GroupVersionBootstrap.WithKind("GenericBootstrapConfigTemplate")
+	infrastructureTemplateGVK := clusterv1.GroupVersionInfrastructure.WithKind("GenericInfrastructureMachineTemplate")
+	newTemplate := func(gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		u.SetNamespace("ns1")
+		u.SetName(name)
+		return u
+	}
+	newMachineDeployment := func(name, bootstrapTemplate, infrastructureTemplate string) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name},
+			Spec: clusterv1.MachineDeploymentSpec{
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Bootstrap: clusterv1.Bootstrap{
+							ConfigRef: clusterv1.ContractVersionedObjectReference{APIGroup: bootstrapTemplateGVK.Group, Kind: bootstrapTemplateGVK.Kind, Name: bootstrapTemplate},
+						},
+						InfrastructureRef: clusterv1.ContractVersionedObjectReference{APIGroup: infrastructureTemplateGVK.Group, Kind: infrastructureTemplateGVK.Kind, Name: infrastructureTemplate},
+					},
+				},
+			},
+		}
+	}
+	newDesired := func() *scope.MachineDeploymentState {
+		return &scope.MachineDeploymentState{
+			Object:                        newMachineDeployment("md-desired", "bootstrap-desired", "infra-desired"),
+			BootstrapTemplate:             newTemplate(bootstrapTemplateGVK, "bootstrap-desired"),
+			InfrastructureMachineTemplate: newTemplate(infrastructureTemplateGVK, "infra-desired"),
+			MachineHealthCheck:            &clusterv1.MachineHealthCheck{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "md-desired"}},
+		}
+	}
+
+	t.Run("MachineDeployment to be created", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, err := test.NewFakeProxy().NewClient(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		desired := newDesired()
+		pairs, err := matchMachineDeploymentObjects(context.Background(), c, nil, desired)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pairs).To(HaveLen(4))
+		for _, p := range pairs {
+			g.Expect(p.current).To(BeNil())
+		}
+		g.Expect(desired.Object.Name).To(Equal("md-desired"))
+	})
+
+	t.Run("Desired objects are renamed to the current objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		current := newMachineDeployment("md-current", "bootstrap-current", "infra-current")
+		c, err := test.NewFakeProxy().WithObjs(
+			current,
+			newTemplate(bootstrapTemplateGVK, "bootstrap-current"),
+		).NewClient(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		desired := newDesired()
+		pairs, err := matchMachineDeploymentObjects(context.Background(), c, current, desired)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pairs).To(HaveLen(4))
+
+		g.Expect(desired.Object.Name).To(Equal("md-current"))
+		g.Expect(desired.Object.Spec.Template.Spec.Bootstrap.ConfigRef.Name).To(Equal("bootstrap-current"))
+		g.Expect(desired.Object.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("infra-current"))
+		g.Expect(desired.BootstrapTemplate.GetName()).To(Equal("bootstrap-current"))
+		g.Expect(desired.InfrastructureMachineTemplate.GetName()).To(Equal("infra-current"))
+		g.Expect(desired.MachineHealthCheck.Name).To(Equal("md-current"))
+
+		g.Expect(pairs[0].current).To(Equal(current))
+		g.Expect(pairs[1].current.GetName()).To(Equal("bootstrap-current"))
+
+		// The InfrastructureMachineTemplate and the MachineHealthCheck don't exist.
+		diff, err := pairs[2].compute(context.Background(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(diff.Operation).To(Equal(TopologyDiffCreate))
+		g.Expect(diff.Object.Name).To(Equal("infra-current"))
+		diff, err = pairs[3].compute(context.Background(), c)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(diff.Operation).To(Equal(TopologyDiffCreate))
+		g.Expect(diff.Object.Kind).To(Equal("MachineHealthCheck"))
+	})
+}
+
+func Test_topologyObjectPair_compute(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "my-cluster",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "my-cluster"},
+		},
+	}
+
+	t.Run("Cluster without changes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		diff, err := topologyObjectPair{current: cluster, desired: cluster.DeepCopy()}.compute(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(diff).To(BeNil())
+	})
+
+	t.Run("Cluster with a missing topology label", func(t *testing.T) {
+		g := NewWithT(t)
+
+		desired := cluster.DeepCopy()
+		desired.Labels[clusterv1.ClusterTopologyOwnedLabel] = ""
+		diff, err := topologyObjectPair{current: cluster, desired: desired}.compute(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(diff).ToNot(BeNil())
+		g.Expect(diff.Operation).To(Equal(TopologyDiffUpdate))
+		g.Expect(diff.Object.Kind).To(Equal("Cluster"))
+		g.Expect(diff.Patch).To(Equal(`{"metadata":{"labels":{"topology.cluster.x-k8s.io/owned":""}}}`))
+		g.Expect(diff.Diff).To(ContainSubstring("topology.cluster.x-k8s.io/owned"))
+	})
+
+	t.Run("MachineDeployment to be deleted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		md := &clusterv1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "md"}}
+		diff, err := topologyObjectPair{current: md}.compute(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(diff).To(Equal(&TopologyObjectDiff{
+			Operation: TopologyDiffDelete,
+			Object: corev1.ObjectReference{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDeployment",
+				Namespace:  "ns1",
+				Name:       "md",
+			},
+		}))
+	})
+}
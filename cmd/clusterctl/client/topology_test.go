@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_clusterFromYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name: "Cluster with a managed topology",
+			input: `apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: my-cluster
+  namespace: ns1
+spec:
+  topology:
+    classRef:
+      name: my-class
+    version: v1.34.0
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`,
+		},
+		{
+			name: "No Cluster",
+			input: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`,
+			wantErr: "input must contain exactly one Cluster, got 0",
+		},
+		{
+			name: "Cluster with an old API version",
+			input: `apiVersion: cluster.x-k8s.io/v1beta1
+kind: Cluster
+metadata:
+  name: my-cluster
+spec:
+  topology:
+    class: my-class
+    version: v1.34.0
+`,
+			wantErr: "Cluster must use the cluster.x-k8s.io/v1beta2 API version, got cluster.x-k8s.io/v1beta1",
+		},
+		{
+			name: "Cluster without a managed topology",
+			input: `apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: my-cluster
+`,
+			wantErr: "Cluster my-cluster must have a managed topology",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster, err := clusterFromYAML([]byte(tt.input))
+			if tt.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(cluster.Name).To(Equal("my-cluster"))
+			g.Expect(cluster.Namespace).To(Equal("ns1"))
+			g.Expect(cluster.Spec.Topology.ClassRef.Name).To(Equal("my-class"))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package tree
 
 import (
 	"context"
+	"sort"
 
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -58,6 +59,18 @@ type DiscoverOptions struct {
 	// have the same Status, Severity and Reason.
 	Grouping bool
 
+	// ShowMachines instructs the discovery process to collect all the Machines in the Cluster,
+	// including the ones hidden by grouping, so the presentation layer can show per-Machine details.
+	ShowMachines bool
+
+	// ShowConditionHistory instructs the discovery process to collect recent condition transitions
+	// and events for the objects in the ObjectTree.
+	ShowConditionHistory bool
+
+	// ShowLogs instructs the discovery process to collect boot and console logs
+	// for the Machines reporting diagnostics.
+	ShowLogs bool
+
 	// V1Beta1 instructs tree to use V1Beta1 conditions.
 	//
 	// Deprecated: This field will be removed when v1beta1 will be dropped.
@@ -188,6 +201,35 @@ func Discovery(ctx context.Context, c client.Client, namespace, name string, opt
 		}
 	}
 
+	// Collects all the machines, including the ones hidden by grouping.
+	machines := make([]*clusterv1.Machine, 0, len(machinesList.Items))
+	machineObjs := make([]client.Object, 0, len(machinesList.Items))
+	for i := range machinesList.Items {
+		m := &machinesList.Items[i]
+		m.SetGroupVersionKind(clusterv1.GroupVersion.WithKind("Machine"))
+		machines = append(machines, m)
+		machineObjs = append(machineObjs, m)
+	}
+	sort.Slice(machines, func(i, j int) bool {
+		return machines[i].Name < machines[j].Name
+	})
+
+	if options.ShowMachines {
+		tree.machines = machines
+	}
+
+	if options.ShowConditionHistory {
+		if err := addConditionHistory(ctx, c, cluster.Namespace, tree, machineObjs); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.ShowLogs {
+		if err := addMachineLogs(ctx, c, cluster.Namespace, tree, machines); err != nil {
+			return nil, err
+		}
+	}
+
 	return tree, nil
 }
 
@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -875,3 +877,174 @@ func Test_Discovery(t *testing.T) {
 		})
 	}
 }
+
+func Test_Discovery_ShowMachinesAndConditionHistory(t *testing.T) {
+	g := NewWithT(t)
+
+	objs := test.NewFakeCluster("ns1", "cluster1").
+		WithControlPlane(
+			test.NewFakeControlPlane("cp").
+				WithMachines(
+					test.NewFakeMachine("cp1"),
+				),
+		).
+		WithMachineDeployments(
+			test.NewFakeMachineDeployment("md1").
+				WithMachineSets(
+					test.NewFakeMachineSet("ms1").
+						WithMachines(
+							test.NewFakeMachine("m2"),
+							test.NewFakeMachine("m1"),
+						),
+				),
+		).
+		Objs()
+
+	var machine client.Object
+	for _, obj := range objs {
+		if obj.GetObjectKind().GroupVersionKind().Kind == "Machine" && obj.GetName() == "m1" {
+			machine = obj
+		}
+	}
+	g.Expect(machine).ToNot(BeNil())
+
+	now := metav1.Now()
+	objs = append(objs,
+		&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "m1.event"},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Machine",
+				Name: machine.GetName(),
+				UID:  machine.GetUID(),
+			},
+			Type:          corev1.EventTypeWarning,
+			Reason:        "FailedDrain",
+			Message:       "failed to drain node",
+			LastTimestamp: now,
+		},
+		&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "other.event"},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Pod",
+				Name: "other",
+				UID:  types.UID("other"),
+			},
+			Type:          corev1.EventTypeNormal,
+			Reason:        "Created",
+			LastTimestamp: now,
+		},
+	)
+	for _, crd := range test.FakeCRDList() {
+		objs = append(objs, crd)
+	}
+
+	c, err := test.NewFakeProxy().WithObjs(objs...).NewClient(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	tree, err := Discovery(context.TODO(), c, "ns1", "cluster1", DiscoverOptions{
+		Grouping:             true,
+		ShowMachines:         true,
+		ShowConditionHistory: true,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// All the machines are collected, including the ones grouped in the tree.
+	machineNames := []string{}
+	for _, m := range tree.GetMachines() {
+		machineNames = append(machineNames, m.Name)
+	}
+	g.Expect(machineNames).To(Equal([]string{"cp1", "m1", "m2"}))
+
+	// Only events for objects in the tree are collected.
+	events := []ConditionTransition{}
+	for _, transition := range tree.GetConditionHistory() {
+		if transition.Type == EventConditionTransitionType {
+			events = append(events, transition)
+		}
+	}
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Kind).To(Equal("Machine"))
+	g.Expect(events[0].Name).To(Equal("m1"))
+	g.Expect(events[0].Status).To(Equal(corev1.EventTypeWarning))
+	g.Expect(events[0].Reason).To(Equal("FailedDrain"))
+}
+
+func Test_Discovery_ShowLogs(t *testing.T) {
+	g := NewWithT(t)
+
+	objs := test.NewFakeCluster("ns1", "cluster1").
+		WithControlPlane(
+			test.NewFakeControlPlane("cp").
+				WithMachines(
+					test.NewFakeMachine("cp1"),
+				),
+		).
+		WithMachineDeployments(
+			test.NewFakeMachineDeployment("md1").
+				WithMachineSets(
+					test.NewFakeMachineSet("ms1").
+						WithMachines(
+							test.NewFakeMachine("m1"),
+							test.NewFakeMachine("m2"),
+						),
+				),
+		).
+		Objs()
+
+	for _, obj := range objs {
+		m, ok := obj.(*clusterv1.Machine)
+		if !ok {
+			continue
+		}
+		switch m.Name {
+		case "cp1":
+			m.Status.Diagnostics = clusterv1.MachineDiagnosticsStatus{
+				LogsSecretName: "cp1-boot-logs",
+				LogsURL:        "https://example.com/console/cp1",
+			}
+		case "m1":
+			m.Status.Diagnostics = clusterv1.MachineDiagnosticsStatus{
+				LogsSecretName: "m1-boot-logs",
+			}
+		}
+	}
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cp1-boot-logs"},
+			Data: map[string][]byte{
+				"stdout":        []byte("kubeadm init"),
+				"bootstrap.log": []byte("Running bootstrap command"),
+			},
+		},
+	)
+	for _, crd := range test.FakeCRDList() {
+		objs = append(objs, crd)
+	}
+
+	c, err := test.NewFakeProxy().WithObjs(objs...).NewClient(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	tree, err := Discovery(context.TODO(), c, "ns1", "cluster1", DiscoverOptions{
+		Grouping: true,
+		ShowLogs: true,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Only Machines reporting diagnostics are collected; logs are sorted by name.
+	g.Expect(tree.GetMachineLogs()).To(Equal([]MachineLogs{
+		{
+			MachineName: "cp1",
+			URL:         "https://example.com/console/cp1",
+			SecretName:  "cp1-boot-logs",
+			Logs: []MachineLog{
+				{Name: "bootstrap.log", Content: "Running bootstrap command"},
+				{Name: "stdout", Content: "kubeadm init"},
+			},
+		},
+		{
+			MachineName: "m1",
+			SecretName:  "m1-boot-logs",
+			Message:     "Secret m1-boot-logs not found",
+		},
+	}))
+}
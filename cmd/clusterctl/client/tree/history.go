@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"context"
+	"sort"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EventConditionTransitionType is the Type used for ConditionTransition gathered from events.
+const EventConditionTransitionType = "Event"
+
+// ConditionTransition defines a transition of a condition, or an event, for an object in the ObjectTree.
+type ConditionTransition struct {
+	// Time when the transition happened.
+	Time metav1.Time
+
+	// Kind of the object.
+	Kind string
+
+	// Name of the object.
+	Name string
+
+	// Type of the condition, or EventConditionTransitionType for events.
+	Type string
+
+	// Status of the condition, or the type of the event, e.g. Warning.
+	Status string
+
+	// Reason of the condition or of the event.
+	Reason string
+
+	// Message of the condition or of the event.
+	Message string
+}
+
+// addConditionHistory collects the last transition of every condition and the events
+// for all the objects in the ObjectTree, including Machines hidden by grouping.
+func addConditionHistory(ctx context.Context, c client.Client, namespace string, tree *ObjectTree, machines []client.Object) error {
+	objs := []client.Object{tree.root}
+	for _, obj := range tree.items {
+		if IsVirtualObject(obj) || IsGroupObject(obj) {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	objs = append(objs, machines...)
+
+	history := []ConditionTransition{}
+	objsByUID := map[types.UID]client.Object{}
+	for _, obj := range objs {
+		if _, ok := objsByUID[obj.GetUID()]; ok {
+			continue
+		}
+		objsByUID[obj.GetUID()] = obj
+
+		for _, condition := range GetConditions(obj) {
+			if condition.LastTransitionTime.IsZero() {
+				continue
+			}
+			history = append(history, ConditionTransition{
+				Time:    condition.LastTransitionTime,
+				Kind:    obj.GetObjectKind().GroupVersionKind().Kind,
+				Name:    obj.GetName(),
+				Type:    condition.Type,
+				Status:  string(condition.Status),
+				Reason:  condition.Reason,
+				Message: condition.Message,
+			})
+		}
+	}
+
+	events := &corev1.EventList{}
+	if err := c.List(ctx, events, client.InNamespace(namespace)); err != nil {
+		return pkgerrors.Wrap(err, "failed to list events")
+	}
+	for _, event := range events.Items {
+		if _, ok := objsByUID[event.InvolvedObject.UID]; !ok {
+			continue
+		}
+		history = append(history, ConditionTransition{
+			Time:    eventTime(event),
+			Kind:    event.InvolvedObject.Kind,
+			Name:    event.InvolvedObject.Name,
+			Type:    EventConditionTransitionType,
+			Status:  event.Type,
+			Reason:  event.Reason,
+			Message: event.Message,
+		})
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Time.After(history[j].Time.Time)
+	})
+	tree.conditionHistory = history
+	return nil
+}
+
+// eventTime returns the time when an event was last observed.
+func eventTime(event corev1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if !event.EventTime.IsZero() {
+		return metav1.NewTime(event.EventTime.Time)
+	}
+	return event.FirstTimestamp
+}
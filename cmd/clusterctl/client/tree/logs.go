@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// MachineLogs defines the boot and console logs of a Machine, as reported by the infrastructure provider
+// via the Machine's status.diagnostics field.
+type MachineLogs struct {
+	// MachineName is the name of the Machine.
+	MachineName string
+
+	// URL is a link to the logs of the Machine, if any.
+	URL string
+
+	// SecretName is the name of the Secret containing the logs of the Machine, if any.
+	SecretName string
+
+	// Logs of the Machine read from the Secret, sorted by name.
+	Logs []MachineLog
+
+	// Message reports why logs could not be read from the Secret, if any.
+	Message string
+}
+
+// MachineLog defines a single log of a Machine.
+type MachineLog struct {
+	// Name of the log, i.e. the key in the Secret.
+	Name string
+
+	// Content of the log.
+	Content string
+}
+
+// addMachineLogs collects the logs for all the Machines reporting diagnostics.
+func addMachineLogs(ctx context.Context, c client.Client, namespace string, tree *ObjectTree, machines []*clusterv1.Machine) error {
+	machineLogs := []MachineLogs{}
+	for _, m := range machines {
+		if !m.Status.Diagnostics.IsDefined() {
+			continue
+		}
+
+		logs := MachineLogs{
+			MachineName: m.Name,
+			URL:         m.Status.Diagnostics.LogsURL,
+			SecretName:  m.Status.Diagnostics.LogsSecretName,
+		}
+
+		if logs.SecretName != "" {
+			secret := &corev1.Secret{}
+			if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: logs.SecretName}, secret); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return pkgerrors.Wrapf(err, "failed to get logs Secret %s for Machine %s", logs.SecretName, m.Name)
+				}
+				logs.Message = fmt.Sprintf("Secret %s not found", logs.SecretName)
+			}
+			for name, content := range secret.Data {
+				logs.Logs = append(logs.Logs, MachineLog{Name: name, Content: string(content)})
+			}
+			sort.Slice(logs.Logs, func(i, j int) bool {
+				return logs.Logs[i].Name < logs.Logs[j].Name
+			})
+		}
+
+		machineLogs = append(machineLogs, logs)
+	}
+
+	tree.machineLogs = machineLogs
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ObjectTreeNode is a serializable representation of an object in an ObjectTree, e.g. for consumption by web UIs.
+type ObjectTreeNode struct {
+	// APIVersion of the object.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the object.
+	Kind string `json:"kind"`
+
+	// Namespace of the object.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// UID of the object.
+	UID string `json:"uid,omitempty"`
+
+	// MetaName is the name that should be used for the object in the presentation layer, e.g. ControlPlane for KCP.
+	MetaName string `json:"metaName,omitempty"`
+
+	// Virtual is true if the object does not exist in the cluster, but it is used to group other objects.
+	Virtual bool `json:"virtual,omitempty"`
+
+	// Group is set if the object represents a group of sibling objects with the same conditions.
+	Group *ObjectTreeNodeGroup `json:"group,omitempty"`
+
+	// ShowConditions is the filter the presentation layer should use when showing the object's conditions.
+	ShowConditions ConditionFilterType `json:"showConditions,omitempty"`
+
+	// Conditions of the object.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// V1Beta1Conditions of the object; they are set only when the tree has been built using v1beta1 conditions.
+	//
+	// Deprecated: This field will be removed when v1beta1 will be dropped.
+	V1Beta1Conditions clusterv1.Conditions `json:"v1beta1Conditions,omitempty"`
+
+	// Children of the object, ordered in the same way they are shown by clusterctl describe.
+	Children []ObjectTreeNode `json:"children,omitempty"`
+}
+
+// ObjectTreeNodeGroup describes a group of sibling objects.
+type ObjectTreeNodeGroup struct {
+	// Items is the list of names of the objects in the group.
+	Items []string `json:"items"`
+
+	// AvailableCount is the number of available objects in the group.
+	AvailableCount int `json:"availableCount"`
+
+	// ReadyCount is the number of ready objects in the group.
+	ReadyCount int `json:"readyCount"`
+
+	// UpToDateCount is the number of up-to-date objects in the group.
+	UpToDateCount int `json:"upToDateCount"`
+}
+
+// ToObjectTreeNode returns a serializable representation of the ObjectTree, starting from its root.
+func (od ObjectTree) ToObjectTreeNode() ObjectTreeNode {
+	return od.toObjectTreeNode(od.root)
+}
+
+func (od ObjectTree) toObjectTreeNode(obj client.Object) ObjectTreeNode {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	showConditions, _ := getAnnotation(obj, ShowObjectConditionsAnnotation)
+	node := ObjectTreeNode{
+		APIVersion:     gvk.GroupVersion().String(),
+		Kind:           gvk.Kind,
+		Namespace:      obj.GetNamespace(),
+		Name:           obj.GetName(),
+		UID:            string(obj.GetUID()),
+		MetaName:       GetMetaName(obj),
+		Virtual:        IsVirtualObject(obj),
+		ShowConditions: ConditionFilterType(showConditions),
+	}
+
+	if IsGroupObject(obj) {
+		node.Group = &ObjectTreeNodeGroup{
+			Items:          strings.Split(GetGroupItems(obj), GroupItemsSeparator),
+			AvailableCount: GetGroupItemsAvailableCounter(obj),
+			ReadyCount:     GetGroupItemsReadyCounter(obj),
+			UpToDateCount:  GetGroupItemsUpToDateCounter(obj),
+		}
+	}
+
+	if od.options.V1Beta1 {
+		if getter := objToGetter(obj); getter != nil {
+			node.V1Beta1Conditions = getter.GetV1Beta1Conditions()
+		}
+	} else {
+		node.Conditions = GetConditions(obj)
+	}
+
+	children := od.GetObjectsByParent(obj.GetUID())
+	sort.Slice(children, func(i, j int) bool {
+		if GetZOrder(children[i]) != GetZOrder(children[j]) {
+			return GetZOrder(children[i]) > GetZOrder(children[j])
+		}
+		if children[i].GetObjectKind().GroupVersionKind().Kind != children[j].GetObjectKind().GroupVersionKind().Kind {
+			return children[i].GetObjectKind().GroupVersionKind().Kind < children[j].GetObjectKind().GroupVersionKind().Kind
+		}
+		return children[i].GetName() < children[j].GetName()
+	})
+	for _, child := range children {
+		node.Children = append(node.Children, od.toObjectTreeNode(child))
+	}
+	return node
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func Test_ToObjectTreeNode(t *testing.T) {
+	t.Run("serializes the tree with children ordered by z-order, kind and name", func(t *testing.T) {
+		g := NewWithT(t)
+
+		readyCondition := metav1.Condition{Type: clusterv1.ReadyCondition, Status: metav1.ConditionTrue, Reason: "Ready"}
+		root := fakeCluster("my-cluster", withClusterCondition(readyCondition))
+		tree := NewObjectTree(root, ObjectTreeOptions{ShowOtherConditions: "Cluster"})
+
+		workers := VirtualObject("ns", "WorkerGroup", "Workers")
+		tree.Add(root, workers)
+		tree.Add(workers, fakeMachine("machine-b", withMachineCondition(readyCondition)))
+		tree.Add(workers, fakeMachine("machine-a"))
+		tree.Add(root, fakeMachine("control-plane-machine"), ObjectMetaName("ControlPlane"), ZOrder(1))
+
+		node := tree.ToObjectTreeNode()
+		g.Expect(node.Kind).To(Equal("Cluster"))
+		g.Expect(node.Name).To(Equal("my-cluster"))
+		g.Expect(node.Namespace).To(Equal("ns"))
+		g.Expect(node.ShowConditions).To(Equal(ShowAllConditions))
+		g.Expect(node.Conditions).To(HaveLen(1))
+		g.Expect(node.V1Beta1Conditions).To(BeEmpty())
+
+		g.Expect(node.Children).To(HaveLen(2))
+		g.Expect(node.Children[0].Name).To(Equal("control-plane-machine"))
+		g.Expect(node.Children[0].MetaName).To(Equal("ControlPlane"))
+		g.Expect(node.Children[1].Name).To(Equal("Workers"))
+		g.Expect(node.Children[1].Virtual).To(BeTrue())
+
+		g.Expect(node.Children[1].Children).To(HaveLen(2))
+		g.Expect(node.Children[1].Children[0].Name).To(Equal("machine-a"))
+		g.Expect(node.Children[1].Children[1].Name).To(Equal("machine-b"))
+		g.Expect(node.Children[1].Children[1].Conditions).To(HaveLen(1))
+
+		_, err := json.Marshal(node)
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+	t.Run("serializes v1beta1 conditions when the tree uses v1beta1 conditions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		root := fakeCluster("my-cluster", withClusterV1Beta1Condition(&clusterv1.Condition{Type: clusterv1.ReadyV1Beta1Condition, Status: "True"}))
+		tree := NewObjectTree(root, ObjectTreeOptions{V1Beta1: true})
+
+		node := tree.ToObjectTreeNode()
+		g.Expect(node.Conditions).To(BeEmpty())
+		g.Expect(node.V1Beta1Conditions).To(HaveLen(1))
+		g.Expect(node.Children).To(BeEmpty())
+	})
+	t.Run("serializes group objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		readyCondition := metav1.Condition{Type: clusterv1.ReadyCondition, Status: metav1.ConditionTrue, Reason: "Ready"}
+		root := fakeCluster("my-cluster")
+		tree := NewObjectTree(root, ObjectTreeOptions{Grouping: true})
+
+		workers := VirtualObject("ns", "WorkerGroup", "Workers")
+		tree.Add(root, workers, GroupingObject(true))
+		tree.Add(workers, fakeMachine("machine-a", withMachineCondition(readyCondition)))
+		tree.Add(workers, fakeMachine("machine-b", withMachineCondition(readyCondition)))
+
+		node := tree.ToObjectTreeNode()
+		g.Expect(node.Children).To(HaveLen(1))
+		g.Expect(node.Children[0].Children).To(HaveLen(1))
+		group := node.Children[0].Children[0]
+		g.Expect(group.Group).ToNot(BeNil())
+		g.Expect(group.Group.Items).To(ConsistOf("machine-a", "machine-b"))
+		g.Expect(group.Group.ReadyCount).To(Equal(2))
+	})
+}
@@ -57,6 +57,18 @@ type ObjectTreeOptions struct {
 	// have the same Status, Severity and Reason
 	Grouping bool
 
+	// ShowMachines instructs the discovery process to collect all the Machines in the Cluster,
+	// including the ones hidden by grouping, so the presentation layer can show per-Machine details.
+	ShowMachines bool
+
+	// ShowConditionHistory instructs the discovery process to collect recent condition transitions
+	// and events for the objects in the ObjectTree.
+	ShowConditionHistory bool
+
+	// ShowLogs instructs the discovery process to collect boot and console logs
+	// for the Machines reporting diagnostics.
+	ShowLogs bool
+
 	// V1Beta1 instructs tree to use V1Beta1 conditions.
 	//
 	// Deprecated: This field will be removed when v1beta1 will be dropped.
@@ -70,6 +82,10 @@ type ObjectTree struct {
 	items      map[types.UID]client.Object
 	ownership  map[types.UID]map[types.UID]bool
 	parentship map[types.UID]types.UID
+
+	machines         []*clusterv1.Machine
+	conditionHistory []ConditionTransition
+	machineLogs      []MachineLogs
 }
 
 // NewObjectTree creates a new object tree with the given root and options.
@@ -265,6 +281,19 @@ func (od ObjectTree) GetParent(id types.UID) client.Object {
 // GetRoot returns the root of the tree.
 func (od ObjectTree) GetRoot() client.Object { return od.root }
 
+// GetMachines returns all the Machines in the Cluster, including the ones hidden by grouping.
+// NOTE: Machines are collected only if the ShowMachines option is set.
+func (od ObjectTree) GetMachines() []*clusterv1.Machine { return od.machines }
+
+// GetConditionHistory returns recent condition transitions and events for the objects in the ObjectTree,
+// sorted from the most recent to the oldest.
+// NOTE: The condition history is collected only if the ShowConditionHistory option is set.
+func (od ObjectTree) GetConditionHistory() []ConditionTransition { return od.conditionHistory }
+
+// GetMachineLogs returns the logs of the Machines reporting diagnostics, sorted by Machine name.
+// NOTE: The logs are collected only if the ShowLogs option is set.
+func (od ObjectTree) GetMachineLogs() []MachineLogs { return od.machineLogs }
+
 // GetObject returns the object with the given uid.
 func (od ObjectTree) GetObject(id types.UID) client.Object { return od.items[id] }
 
@@ -77,8 +77,9 @@ func (c *clusterctlClient) PlanUpgrade(ctx context.Context, options PlanUpgradeO
 	aliasUpgradePlan := make([]UpgradePlan, len(upgradePlans))
 	for i, plan := range upgradePlans {
 		aliasUpgradePlan[i] = UpgradePlan{
-			Contract:  plan.Contract,
-			Providers: plan.Providers,
+			Contract:                      plan.Contract,
+			Providers:                     plan.Providers,
+			IncompatibleRuntimeExtensions: plan.IncompatibleRuntimeExtensions,
 		}
 	}
 
@@ -125,6 +126,18 @@ type ApplyUpgradeOptions struct {
 
 	// WaitProviderTimeout sets the timeout per provider upgrade.
 	WaitProviderTimeout time.Duration
+
+	// IgnoreRuntimeExtensionsCompatibility allows to upgrade the core provider to a version
+	// which does not support the Runtime Extensions registered in the management cluster.
+	IgnoreRuntimeExtensionsCompatibility bool
+
+	// ImageOverridesFile is the path of a file mapping the images of the providers and of cert-manager to the images
+	// to be used instead, e.g. the images of a mirror registry pinned by digest in an air-gapped environment.
+	ImageOverridesFile string
+
+	// ValidateImages instructs the upgrade apply command to check that the images of the target versions of the providers
+	// exist in their registries before upgrading any provider.
+	ValidateImages bool
 }
 
 func (c *clusterctlClient) ApplyUpgrade(ctx context.Context, options ApplyUpgradeOptions) error {
@@ -138,6 +151,10 @@ func (c *clusterctlClient) ApplyUpgrade(ctx context.Context, options ApplyUpgrad
 		options.WaitProviderTimeout = time.Duration(5*60) * time.Second
 	}
 
+	if err := c.setImageOverridesFile(options.ImageOverridesFile); err != nil {
+		return err
+	}
+
 	// Get the client for interacting with the management cluster.
 	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
 	if err != nil {
@@ -174,8 +191,10 @@ func (c *clusterctlClient) ApplyUpgrade(ctx context.Context, options ApplyUpgrad
 		len(options.AddonProviders) > 0
 
 	opts := cluster.UpgradeOptions{
-		WaitProviders:       options.WaitProviders,
-		WaitProviderTimeout: options.WaitProviderTimeout,
+		WaitProviders:                        options.WaitProviders,
+		WaitProviderTimeout:                  options.WaitProviderTimeout,
+		IgnoreRuntimeExtensionsCompatibility: options.IgnoreRuntimeExtensionsCompatibility,
+		ValidateImages:                       options.ValidateImages,
 	}
 
 	// If we are upgrading a specific set of providers only, process the providers and call ApplyCustomPlan.
@@ -30,6 +30,10 @@ var alphaCmd = &cobra.Command{
 func init() {
 	// Alpha commands should be added here.
 	alphaCmd.AddCommand(rolloutCmd)
+	alphaCmd.AddCommand(topologyCmd)
+	alphaCmd.AddCommand(pauseClusterCmd)
+	alphaCmd.AddCommand(resumeClusterCmd)
+	alphaCmd.AddCommand(scaleCmd)
 
 	RootCmd.AddCommand(alphaCmd)
 }
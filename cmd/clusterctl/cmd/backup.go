@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type backupOptions struct {
+	fromKubeconfig        string
+	fromKubeconfigContext string
+	namespace             string
+	directory             string
+	archive               string
+	encryptionKeyFile     string
+}
+
+var buo = &backupOptions{}
+
+var backupCmd = &cobra.Command{
+	Use:     "backup",
+	GroupID: groupManagement,
+	Short:   "Backup Cluster API objects and all dependencies from a management cluster",
+	Long: templates.LongDesc(`
+		Backup Cluster API objects and all dependencies from a management cluster to a directory or to an archive.
+
+		The backup can be restored using clusterctl restore, e.g. for disaster recovery of the management cluster.`),
+
+	Example: templates.Examples(`
+		Backup Cluster API objects and all dependencies from a management cluster to a directory.
+		clusterctl backup --directory /tmp/backup-directory
+
+		Backup Cluster API objects and all dependencies from a management cluster to an encrypted archive.
+		clusterctl backup --archive /tmp/backup.tar.gz --encryption-key-file /path/to/passphrase`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBackup()
+	},
+}
+
+func init() {
+	backupCmd.Flags().StringVar(&buo.fromKubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file for the management cluster. If unspecified, default discovery rules apply.")
+	backupCmd.Flags().StringVar(&buo.fromKubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file for the management cluster. If empty, current context will be used.")
+	backupCmd.Flags().StringVarP(&buo.namespace, "namespace", "n", "",
+		"The namespace where the workload clusters are hosted. If unspecified, the current context's namespace is used.")
+	backupCmd.Flags().StringVar(&buo.directory, "directory", "",
+		"Directory where Cluster API objects and all dependencies are saved, one file per object.")
+	backupCmd.Flags().StringVar(&buo.archive, "archive", "",
+		"Path of a gzipped tarball where Cluster API objects and all dependencies are saved.")
+	backupCmd.Flags().StringVar(&buo.encryptionKeyFile, "encryption-key-file", "",
+		"Path to a file containing the passphrase used to encrypt the archive. If unspecified, the archive is not encrypted.")
+
+	backupCmd.MarkFlagsMutuallyExclusive("directory", "archive")
+	backupCmd.MarkFlagsOneRequired("directory", "archive")
+
+	RootCmd.AddCommand(backupCmd)
+}
+
+func runBackup() error {
+	ctx := context.Background()
+
+	encryptionKey, err := readEncryptionKey(buo.encryptionKeyFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	return c.Backup(ctx, client.BackupOptions{
+		FromKubeconfig: client.Kubeconfig{Path: buo.fromKubeconfig, Context: buo.fromKubeconfigContext},
+		Namespace:      buo.namespace,
+		Directory:      buo.directory,
+		Archive:        buo.archive,
+		EncryptionKey:  encryptionKey,
+	})
+}
+
+// readEncryptionKey reads the passphrase used to encrypt or decrypt a backup archive from a file.
+func readEncryptionKey(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return "", pkgerrors.Wrapf(err, "failed to read encryption key file %s", path)
+	}
+	key := strings.TrimRight(string(data), "\r\n")
+	if key == "" {
+		return "", pkgerrors.Errorf("encryption key file %s is empty", path)
+	}
+	return key, nil
+}
@@ -43,6 +43,10 @@ type describeClusterOptions struct {
 	showTemplates           bool
 	echo                    bool
 	grouping                bool
+	showMachines            bool
+	showConditionHistory    bool
+	conditionHistoryLimit   int
+	showLogs                bool
 	v1beta2                 bool
 	color                   bool
 }
@@ -73,7 +77,18 @@ var describeClusterClusterCmd = &cobra.Command{
 
 		# Describe the cluster named test-1 showing the MachineInfrastructure and BootstrapConfig objects
 		# also when their status is the same as the status of the corresponding machine object.
-		clusterctl describe cluster test-1 --echo`),
+		clusterctl describe cluster test-1 --echo
+
+		# Describe the cluster named test-1 adding a row for each machine with phase, node, failure domain,
+		# up-to-date status and a summary of the machine's conditions.
+		clusterctl describe cluster test-1 --show-machines
+
+		# Describe the cluster named test-1 showing the 10 most recent condition transitions and events.
+		clusterctl describe cluster test-1 --show-condition-history --condition-history-limit 10
+
+		# Describe the cluster named test-1 showing the boot logs reported by the infrastructure provider
+		# for each machine, e.g. to debug bootstrap failures.
+		clusterctl describe cluster test-1 --show-logs`),
 
 	Args: func(cmd *cobra.Command, args []string) error {
 		if err := exactArgsWithMessage(1, "please specify a cluster name")(cmd, args); err != nil {
@@ -107,6 +122,14 @@ func init() {
 		"Show MachineInfrastructure and BootstrapConfig when ready condition is true or it has the Status, Severity and Reason of the machine's object.")
 	describeClusterClusterCmd.Flags().BoolVar(&dc.grouping, "grouping", true,
 		"Groups machines when ready condition has the same Status, Severity and Reason.")
+	describeClusterClusterCmd.Flags().BoolVar(&dc.showMachines, "show-machines", false,
+		"Show a row for each machine with phase, node, failure domain, up-to-date status and a summary of the machine's conditions.")
+	describeClusterClusterCmd.Flags().BoolVar(&dc.showConditionHistory, "show-condition-history", false,
+		"Show recent condition transitions and events for the objects of the cluster.")
+	describeClusterClusterCmd.Flags().IntVar(&dc.conditionHistoryLimit, "condition-history-limit", 20,
+		"The maximum number of condition transitions and events to show when using --show-condition-history; use 0 to show all.")
+	describeClusterClusterCmd.Flags().BoolVar(&dc.showLogs, "show-logs", false,
+		"Show the boot and console logs reported by the infrastructure provider for each machine.")
 	describeClusterClusterCmd.Flags().BoolVar(&dc.v1beta2, "v1beta2", true,
 		"Use V1Beta2 conditions..")
 	_ = describeClusterClusterCmd.Flags().MarkDeprecated("v1beta2",
@@ -153,6 +176,9 @@ func runDescribeCluster(cmd *cobra.Command, name string) error {
 		AddTemplateVirtualNode:  true,
 		Echo:                    dc.echo,
 		Grouping:                dc.grouping,
+		ShowMachines:            dc.showMachines,
+		ShowConditionHistory:    dc.showConditionHistory,
+		ShowLogs:                dc.showLogs,
 		V1Beta1:                 !dc.v1beta2,
 	})
 	if err != nil {
@@ -174,5 +200,26 @@ func runDescribeCluster(cmd *cobra.Command, name string) error {
 		}
 	}
 
+	if dc.showMachines {
+		fmt.Fprintln(os.Stdout)
+		if err := cmdtree.PrintMachines(tree, os.Stdout); err != nil {
+			return pkgerrors.Wrap(err, "failed to print machines")
+		}
+	}
+
+	if dc.showConditionHistory {
+		fmt.Fprintln(os.Stdout)
+		if err := cmdtree.PrintConditionHistory(tree, os.Stdout, dc.conditionHistoryLimit); err != nil {
+			return pkgerrors.Wrap(err, "failed to print condition history")
+		}
+	}
+
+	if dc.showLogs {
+		fmt.Fprintln(os.Stdout)
+		if err := cmdtree.PrintMachineLogs(tree, os.Stdout); err != nil {
+			return pkgerrors.Wrap(err, "failed to print machine logs")
+		}
+	}
+
 	return nil
 }
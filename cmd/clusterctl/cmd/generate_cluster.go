@@ -36,6 +36,7 @@ type generateClusterOptions struct {
 	kubernetesVersion        string
 	controlPlaneMachineCount int64
 	workerMachineCount       int64
+	valuesFile               string
 
 	url                string
 	configMapNamespace string
@@ -89,6 +90,10 @@ var generateClusterClusterCmd = &cobra.Command{
 		# Generates a yaml file for creating workload clusters using a template stored locally.
 		clusterctl generate cluster my-cluster --from ~/workspace/cluster-template.yaml
 
+		# Generates a yaml file for creating workload clusters using the values for the template variables
+		# and for the ClusterClass variables defined in a values file.
+		clusterctl generate cluster my-cluster --values values.yaml
+
 		# Prints the list of variables required by the yaml file for creating workload cluster.
 		clusterctl generate cluster my-cluster --list-variables`),
 
@@ -114,6 +119,8 @@ func init() {
 	// Remove default from hard coded text if the default is ever changed from 0 since cobra would then add it
 	generateClusterClusterCmd.Flags().Int64Var(&gc.workerMachineCount, "worker-machine-count", 0,
 		"The number of worker machines for the workload cluster. (default 0)")
+	generateClusterClusterCmd.Flags().StringVar(&gc.valuesFile, "values", "",
+		"Path to a YAML file with values for the template variables and for the ClusterClass variables of the workload cluster. Values are validated against the variables declared by the template and the ClusterClass variable schemas.")
 
 	// flags for the repository source
 	generateClusterClusterCmd.Flags().StringVarP(&gc.infrastructureProvider, "infrastructure", "i", "",
@@ -154,6 +161,7 @@ func runGenerateClusterTemplate(cmd *cobra.Command, name string) error {
 		ClusterName:       name,
 		TargetNamespace:   gc.targetNamespace,
 		KubernetesVersion: gc.kubernetesVersion,
+		ValuesFile:        gc.valuesFile,
 		ListVariablesOnly: gc.listVariables,
 	}
 
@@ -40,6 +40,8 @@ type initOptions struct {
 	validate                  bool
 	waitProviders             bool
 	waitProviderTimeout       int
+	imageOverrides            string
+	validateImages            bool
 }
 
 var initOpts = &initOptions{}
@@ -83,7 +85,11 @@ var initCmd = &cobra.Command{
 		clusterctl init --infrastructure=aws,vsphere
 
 		# Initialize a management cluster with a custom target namespace for the provider resources.
-		clusterctl init --infrastructure aws --target-namespace foo`),
+		clusterctl init --infrastructure aws --target-namespace foo
+
+		# Initialize a management cluster in an air-gapped environment using the images of a mirror registry,
+		# after checking that all the images exist.
+		clusterctl init --infrastructure aws --image-overrides image-overrides.yaml --validate-images`),
 	Args: helpOnErrorArgs(cobra.NoArgs),
 	RunE: func(*cobra.Command, []string) error {
 		return runInit()
@@ -109,6 +115,8 @@ func init() {
 		"Runtime extension providers and versions to add to the management cluster; please note that clusterctl doesn't include any default runtime extensions and thus it is required to use custom configuration files to register runtime extensions.")
 	initCmd.PersistentFlags().StringSliceVar(&initOpts.addonProviders, "addon", nil,
 		"Add-on providers and versions (e.g. helm:v0.1.0) to add to the management cluster.")
+	initCmd.PersistentFlags().StringVar(&initOpts.imageOverrides, "image-overrides", "",
+		"Path to a file mapping the images of the providers and of cert-manager to the images to be used instead, e.g. the images of a mirror registry.")
 	initCmd.Flags().StringVarP(&initOpts.targetNamespace, "target-namespace", "n", "",
 		"The target namespace where the providers should be deployed. If unspecified, the provider components' default namespace is used.")
 	initCmd.Flags().BoolVar(&initOpts.waitProviders, "wait-providers", false,
@@ -117,6 +125,8 @@ func init() {
 		"Wait timeout per provider installation in seconds. This value is ignored if --wait-providers is false")
 	initCmd.Flags().BoolVar(&initOpts.validate, "validate", true,
 		"If true, clusterctl will validate that the deployments will succeed on the management cluster.")
+	initCmd.Flags().BoolVar(&initOpts.validateImages, "validate-images", false,
+		"Check that all the images to be installed exist in their registries before installing cert-manager and the providers.")
 
 	initCmd.AddCommand(initListImagesCmd)
 	RootCmd.AddCommand(initCmd)
@@ -144,6 +154,8 @@ func runInit() error {
 		WaitProviders:             initOpts.waitProviders,
 		WaitProviderTimeout:       time.Duration(initOpts.waitProviderTimeout) * time.Second,
 		IgnoreValidationErrors:    !initOpts.validate,
+		ImageOverridesFile:        initOpts.imageOverrides,
+		ValidateImages:            initOpts.validateImages,
 	}
 
 	if _, err := c.Init(ctx, options); err != nil {
@@ -67,6 +67,7 @@ func runInitListImages() error {
 		RuntimeExtensionProviders: initOpts.runtimeExtensionProviders,
 		AddonProviders:            initOpts.addonProviders,
 		LogUsageInstructions:      false,
+		ImageOverridesFile:        initOpts.imageOverrides,
 	}
 
 	images, err := c.InitImages(ctx, options)
@@ -42,6 +42,11 @@ type moveOptions struct {
 	toDirectory           string
 	dryRun                bool
 	hideAPIWarnings       string
+	selector              string
+	includeKinds          []string
+	excludeKinds          []string
+	checkpointDirectory   string
+	resume                bool
 }
 
 var mo = &moveOptions{}
@@ -64,6 +69,16 @@ var moveCmd = &cobra.Command{
 
 		Read Cluster API objects and all dependencies from a directory into a management cluster.
 		clusterctl move --from-directory /tmp/backup-directory
+
+		Move only the Clusters with the env=prod label, their descendants and dependencies (e.g. the ClusterClass in use).
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --selector env=prod
+
+		Move all the Clusters and their dependencies, but not the ClusterResourceSets.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --exclude-kind ClusterResourceSet
+
+		Move Cluster API objects tracking the progress in a checkpoint directory, and resume the move if it fails mid-way.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --checkpoint-dir /tmp/move-checkpoint
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --checkpoint-dir /tmp/move-checkpoint --resume
 	`),
 	Args: helpOnErrorArgs(cobra.NoArgs),
 	RunE: func(*cobra.Command, []string) error {
@@ -91,6 +106,18 @@ func init() {
 	moveCmd.Flags().StringVar(&mo.hideAPIWarnings, "hide-api-warnings", "default",
 		"Set of API server warnings to hide. Valid sets are \"default\" (includes metadata.finalizer warnings), \"all\" , and \"none\".")
 
+	moveCmd.Flags().StringVarP(&mo.selector, "selector", "l", "",
+		"Label selector restricting the move to the matching Clusters, their descendants and their dependencies.")
+	moveCmd.Flags().StringSliceVar(&mo.includeKinds, "include-kind", nil,
+		"Kinds of the objects to move together with their descendants and dependencies, e.g. Cluster, ClusterClass, ClusterResourceSet. If unspecified, all kinds are moved.")
+	moveCmd.Flags().StringSliceVar(&mo.excludeKinds, "exclude-kind", nil,
+		"Kinds of the objects which must not be moved; objects depending on them are not moved as well.")
+
+	moveCmd.Flags().StringVar(&mo.checkpointDirectory, "checkpoint-dir", "",
+		"Directory where the progress of the move and a backup of the objects being moved are stored, so the move can be resumed if it fails mid-way.")
+	moveCmd.Flags().BoolVar(&mo.resume, "resume", false,
+		"Resume the move tracked in the checkpoint directory, skipping the phases already completed.")
+
 	moveCmd.MarkFlagsMutuallyExclusive("to-directory", "to-kubeconfig")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "to-directory")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "kubeconfig")
@@ -168,5 +195,11 @@ func runMove() error {
 		ToDirectory:    mo.toDirectory,
 		Namespace:      mo.namespace,
 		DryRun:         mo.dryRun,
+		LabelSelector:  mo.selector,
+		IncludeKinds:   mo.includeKinds,
+		ExcludeKinds:   mo.excludeKinds,
+
+		CheckpointDirectory: mo.checkpointDirectory,
+		Resume:              mo.resume,
 	})
 }
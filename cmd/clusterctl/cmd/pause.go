@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type pauseClusterOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	reason            string
+}
+
+var pauseClusterOpts = &pauseClusterOptions{}
+
+var pauseClusterCmd = &cobra.Command{
+	Use:   "pause NAME",
+	Short: "Pause the reconciliation of a Cluster and of all the objects belonging to it",
+	Long: templates.LongDesc(`
+		Pause the reconciliation of a Cluster and of all the objects belonging to it.
+
+		The Cluster's spec.paused field is set together with an optional reason in a single change, so all the
+		controllers stop reconciling the Cluster tree at the same time. The reason is surfaced in the Paused
+		condition of the Cluster and of its Machines, MachineSets, MachineDeployments and control plane,
+		which can be inspected with "clusterctl describe cluster --show-conditions all".
+
+		Use "clusterctl alpha resume" to resume the reconciliation.`),
+
+	Example: templates.Examples(`
+		# Pause the Cluster my-cluster and all the objects belonging to it.
+		clusterctl alpha pause my-cluster -n my-namespace --reason "etcd maintenance"`),
+
+	Args: helpOnErrorArgs(cobra.ExactArgs(1)),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runPauseCluster(args[0])
+	},
+}
+
+type resumeClusterOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+}
+
+var resumeClusterOpts = &resumeClusterOptions{}
+
+var resumeClusterCmd = &cobra.Command{
+	Use:   "resume NAME",
+	Short: "Resume the reconciliation of a paused Cluster and of all the objects belonging to it",
+	Long: templates.LongDesc(`
+		Resume the reconciliation of a Cluster paused with "clusterctl alpha pause" and of all the objects belonging to it.
+
+		The Cluster's spec.paused field and the paused reason are removed in a single change.`),
+
+	Example: templates.Examples(`
+		# Resume the Cluster my-cluster and all the objects belonging to it.
+		clusterctl alpha resume my-cluster -n my-namespace`),
+
+	Args: helpOnErrorArgs(cobra.ExactArgs(1)),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runResumeCluster(args[0])
+	},
+}
+
+func init() {
+	pauseClusterCmd.Flags().StringVar(&pauseClusterOpts.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	pauseClusterCmd.Flags().StringVar(&pauseClusterOpts.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	pauseClusterCmd.Flags().StringVarP(&pauseClusterOpts.namespace, "namespace", "n", "",
+		"Namespace of the Cluster. If unspecified, the current namespace will be used.")
+	pauseClusterCmd.Flags().StringVar(&pauseClusterOpts.reason, "reason", "",
+		"Reason why the Cluster is paused, surfaced in the Paused condition of the Cluster and of all the objects belonging to it.")
+
+	resumeClusterCmd.Flags().StringVar(&resumeClusterOpts.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	resumeClusterCmd.Flags().StringVar(&resumeClusterOpts.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	resumeClusterCmd.Flags().StringVarP(&resumeClusterOpts.namespace, "namespace", "n", "",
+		"Namespace of the Cluster. If unspecified, the current namespace will be used.")
+}
+
+func runPauseCluster(name string) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	if err := c.PauseCluster(ctx, client.PauseClusterOptions{
+		Kubeconfig:  client.Kubeconfig{Path: pauseClusterOpts.kubeconfig, Context: pauseClusterOpts.kubeconfigContext},
+		ClusterName: name,
+		Namespace:   pauseClusterOpts.namespace,
+		Reason:      pauseClusterOpts.reason,
+	}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Cluster %s paused\n", name)
+	return nil
+}
+
+func runResumeCluster(name string) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	if err := c.ResumeCluster(ctx, client.ResumeClusterOptions{
+		Kubeconfig:  client.Kubeconfig{Path: resumeClusterOpts.kubeconfig, Context: resumeClusterOpts.kubeconfigContext},
+		ClusterName: name,
+		Namespace:   resumeClusterOpts.namespace,
+	}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Cluster %s resumed\n", name)
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type restoreOptions struct {
+	toKubeconfig        string
+	toKubeconfigContext string
+	directory           string
+	archive             string
+	encryptionKeyFile   string
+	targetNamespace     string
+}
+
+var ro = &restoreOptions{}
+
+var restoreCmd = &cobra.Command{
+	Use:     "restore",
+	GroupID: groupManagement,
+	Short:   "Restore Cluster API objects and all dependencies to a management cluster",
+	Long: templates.LongDesc(`
+		Restore Cluster API objects and all dependencies saved using clusterctl backup to a management cluster.
+
+		Note: The management cluster MUST have the required provider components installed.`),
+
+	Example: templates.Examples(`
+		Restore Cluster API objects and all dependencies from a directory.
+		clusterctl restore --directory /tmp/backup-directory
+
+		Restore Cluster API objects and all dependencies from an encrypted archive to a different namespace.
+		clusterctl restore --archive /tmp/backup.tar.gz --encryption-key-file /path/to/passphrase --target-namespace recovered`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runRestore()
+	},
+}
+
+func init() {
+	restoreCmd.Flags().StringVar(&ro.toKubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file for the management cluster. If unspecified, default discovery rules apply.")
+	restoreCmd.Flags().StringVar(&ro.toKubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file for the management cluster. If empty, current context will be used.")
+	restoreCmd.Flags().StringVar(&ro.directory, "directory", "",
+		"Directory from where Cluster API objects and all dependencies are restored.")
+	restoreCmd.Flags().StringVar(&ro.archive, "archive", "",
+		"Path of a gzipped tarball from where Cluster API objects and all dependencies are restored.")
+	restoreCmd.Flags().StringVar(&ro.encryptionKeyFile, "encryption-key-file", "",
+		"Path to a file containing the passphrase used to decrypt the archive. Required if the archive is encrypted.")
+	restoreCmd.Flags().StringVar(&ro.targetNamespace, "target-namespace", "",
+		"The namespace where Cluster API objects are restored. If unspecified, objects are restored in the namespace they were saved from.")
+
+	restoreCmd.MarkFlagsMutuallyExclusive("directory", "archive")
+	restoreCmd.MarkFlagsOneRequired("directory", "archive")
+
+	RootCmd.AddCommand(restoreCmd)
+}
+
+func runRestore() error {
+	ctx := context.Background()
+
+	encryptionKey, err := readEncryptionKey(ro.encryptionKeyFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	return c.Restore(ctx, client.RestoreOptions{
+		ToKubeconfig:    client.Kubeconfig{Path: ro.toKubeconfig, Context: ro.toKubeconfigContext},
+		Directory:       ro.directory,
+		Archive:         ro.archive,
+		EncryptionKey:   encryptionKey,
+		TargetNamespace: ro.targetNamespace,
+	})
+}
@@ -43,7 +43,11 @@ var (
 
 		# Resume an already paused machinedeployment or kubeadmcontrolplane
 		clusterctl alpha rollout resume machinedeployment/my-md-0
-		clusterctl alpha rollout resume kubeadmcontrolplane/my-kcp`)
+		clusterctl alpha rollout resume kubeadmcontrolplane/my-kcp
+
+		# Wait for the rollout of a machinedeployment or kubeadmcontrolplane to complete
+		clusterctl alpha rollout status machinedeployment/my-md-0
+		clusterctl alpha rollout status kubeadmcontrolplane/my-kcp`)
 
 	rolloutCmd = &cobra.Command{
 		Use:     "rollout SUBCOMMAND",
@@ -58,4 +62,5 @@ func init() {
 	rolloutCmd.AddCommand(rollout.NewCmdRolloutRestart(cfgFile))
 	rolloutCmd.AddCommand(rollout.NewCmdRolloutPause(cfgFile))
 	rolloutCmd.AddCommand(rollout.NewCmdRolloutResume(cfgFile))
+	rolloutCmd.AddCommand(rollout.NewCmdRolloutStatus(cfgFile))
 }
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+// statusOptions is the start of the data required to perform the operation.
+type statusOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	resources         []string
+	namespace         string
+	watch             bool
+	timeout           time.Duration
+}
+
+var statusOpt = &statusOptions{}
+
+var (
+	statusLong = templates.LongDesc(`
+		Show the status of the rollout of cluster-api resources.
+
+	        By default the command waits until the rollout is completed; use --watch=false to return the current status. Currently only MachineDeployments and KubeadmControlPlanes support rollout status.`)
+
+	statusExample = templates.Examples(`
+		# Wait for the rollout of a machinedeployment to complete
+		clusterctl alpha rollout status machinedeployment/my-md-0
+
+		# Show the current rollout status of a kubeadmcontrolplane without waiting
+		clusterctl alpha rollout status kubeadmcontrolplane/my-kcp --watch=false
+
+		# Wait up to 30 minutes for the rollout of a kubeadmcontrolplane to complete
+		clusterctl alpha rollout status kubeadmcontrolplane/my-kcp --timeout 30m`)
+)
+
+// NewCmdRolloutStatus returns a Command instance for 'rollout status' sub command.
+func NewCmdRolloutStatus(cfgFile string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "status RESOURCE",
+		DisableFlagsInUseLine: true,
+		Short:                 "Show the status of the rollout of a cluster-api resource",
+		Long:                  statusLong,
+		Example:               statusExample,
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runStatus(cfgFile, args)
+		},
+	}
+	cmd.Flags().StringVar(&statusOpt.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	cmd.Flags().StringVar(&statusOpt.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	cmd.Flags().StringVarP(&statusOpt.namespace, "namespace", "n", "", "Namespace where the resource(s) reside. If unspecified, the defult namespace will be used.")
+	cmd.Flags().BoolVarP(&statusOpt.watch, "watch", "w", true,
+		"Wait until the rollout is completed.")
+	cmd.Flags().DurationVar(&statusOpt.timeout, "timeout", 0,
+		"The length of time to wait for the rollout to complete when using --watch. Zero means wait indefinitely.")
+
+	return cmd
+}
+
+func runStatus(cfgFile string, args []string) error {
+	statusOpt.resources = args
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := c.RolloutStatus(ctx, client.RolloutStatusOptions{
+		Kubeconfig: client.Kubeconfig{Path: statusOpt.kubeconfig, Context: statusOpt.kubeconfigContext},
+		Namespace:  statusOpt.namespace,
+		Resources:  statusOpt.resources,
+		Wait:       statusOpt.watch,
+		Timeout:    statusOpt.timeout,
+	})
+	for _, status := range statuses {
+		fmt.Println(status.Message)
+	}
+	return err
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type scaleOptions struct {
+	kubeconfig             string
+	kubeconfigContext      string
+	namespace              string
+	namePrefix             string
+	clusterClass           string
+	kubernetesVersion      string
+	clusters               int
+	controlPlaneMachines   int32
+	workerMachines         int32
+	machineDeploymentClass string
+	concurrency            int
+	timeout                time.Duration
+	skipCleanup            bool
+}
+
+var scaleOpts = &scaleOptions{}
+
+var scaleCmd = &cobra.Command{
+	Use:   "scale",
+	Short: "Generate Clusters to benchmark a management cluster",
+	Long: templates.LongDesc(`
+		Generate Clusters to benchmark a management cluster.
+
+		The command creates the given number of Clusters, each one with the given number of control plane and worker Machines,
+		using a ClusterClass that must exist in the target namespace; the in-memory backend of CAPD is recommended, because it
+		allows to generate thousands of Clusters and Machines without real infrastructure.
+
+		Once all the Clusters and Machines are available, or the timeout expires, the command prints a report with the
+		percentiles of the time it took for Clusters and Machines to become available and with the number of requests
+		served by the API server of the management cluster. The generated Clusters are deleted at the end of the run,
+		unless --skip-cleanup is set.`),
+
+	Example: templates.Examples(`
+		# Generate 100 Clusters with 3 control plane Machines and 5 worker Machines each, using the in-memory ClusterClass.
+		clusterctl alpha scale --kubernetes-version v1.35.0 --clusters 100 --control-plane-machines 3 --worker-machines 5
+
+		# Generate 1000 Clusters, creating 50 of them in parallel, and keep them at the end of the run.
+		clusterctl alpha scale --kubernetes-version v1.35.0 --clusters 1000 --concurrency 50 --skip-cleanup`),
+
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runScale(os.Stdout)
+	},
+}
+
+func init() {
+	scaleCmd.Flags().StringVar(&scaleOpts.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	scaleCmd.Flags().StringVar(&scaleOpts.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	scaleCmd.Flags().StringVarP(&scaleOpts.namespace, "namespace", "n", "",
+		"Namespace where the Clusters are generated. If unspecified, the current namespace will be used.")
+	scaleCmd.Flags().StringVar(&scaleOpts.namePrefix, "name-prefix", "scale",
+		"Prefix of the names of the generated Clusters; it also identifies the scale test run.")
+	scaleCmd.Flags().StringVar(&scaleOpts.clusterClass, "cluster-class", "in-memory",
+		"Name of the ClusterClass used by the generated Clusters.")
+	scaleCmd.Flags().StringVar(&scaleOpts.kubernetesVersion, "kubernetes-version", "",
+		"Kubernetes version of the generated Clusters.")
+	scaleCmd.Flags().IntVar(&scaleOpts.clusters, "clusters", 10,
+		"Number of Clusters to generate.")
+	scaleCmd.Flags().Int32Var(&scaleOpts.controlPlaneMachines, "control-plane-machines", 1,
+		"Number of control plane Machines of each Cluster.")
+	scaleCmd.Flags().Int32Var(&scaleOpts.workerMachines, "worker-machines", 1,
+		"Number of worker Machines of each Cluster.")
+	scaleCmd.Flags().StringVar(&scaleOpts.machineDeploymentClass, "machine-deployment-class", "default-worker",
+		"Class of the MachineDeployment hosting the worker Machines.")
+	scaleCmd.Flags().IntVar(&scaleOpts.concurrency, "concurrency", 5,
+		"Number of Clusters created in parallel.")
+	scaleCmd.Flags().DurationVar(&scaleOpts.timeout, "timeout", 30*time.Minute,
+		"The length of time to wait for the Clusters and Machines to become available. Zero means wait indefinitely.")
+	scaleCmd.Flags().BoolVar(&scaleOpts.skipCleanup, "skip-cleanup", false,
+		"Do not delete the generated Clusters at the end of the run.")
+
+	_ = scaleCmd.MarkFlagRequired("kubernetes-version")
+}
+
+func runScale(out io.Writer) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.Scale(ctx, client.ScaleOptions{
+		Kubeconfig:             client.Kubeconfig{Path: scaleOpts.kubeconfig, Context: scaleOpts.kubeconfigContext},
+		Namespace:              scaleOpts.namespace,
+		NamePrefix:             scaleOpts.namePrefix,
+		ClusterClassName:       scaleOpts.clusterClass,
+		KubernetesVersion:      scaleOpts.kubernetesVersion,
+		Clusters:               scaleOpts.clusters,
+		ControlPlaneMachines:   scaleOpts.controlPlaneMachines,
+		WorkerMachines:         scaleOpts.workerMachines,
+		MachineDeploymentClass: scaleOpts.machineDeploymentClass,
+		Concurrency:            scaleOpts.concurrency,
+		Timeout:                scaleOpts.timeout,
+		SkipCleanup:            scaleOpts.skipCleanup,
+	})
+	if report != nil {
+		if printErr := printScaleReport(out, report); printErr != nil && err == nil {
+			err = printErr
+		}
+	}
+	return err
+}
+
+func printScaleReport(out io.Writer, report *client.ScaleReport) error {
+	fmt.Fprintf(out, "Duration: %s\n", report.Duration.Round(time.Second))
+	fmt.Fprintf(out, "Available Clusters: %d/%d\n", report.AvailableClusters, report.Clusters)
+	fmt.Fprintf(out, "Available Machines: %d/%d\n", report.AvailableMachines, report.Machines)
+	if report.APIServerRequests != nil {
+		fmt.Fprintf(out, "API server requests: %d (%.1f/s)\n", *report.APIServerRequests, float64(*report.APIServerRequests)/max(report.Duration.Seconds(), 1))
+	} else {
+		fmt.Fprintln(out, "API server requests: unknown (metrics of the API server cannot be read)")
+	}
+	fmt.Fprintln(out)
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "TIME TO AVAILABLE\tP50\tP90\tP99\tMAX")
+	fmt.Fprintf(w, "Clusters\t%s\t%s\t%s\t%s\n", report.ClusterTimeToAvailable.P50, report.ClusterTimeToAvailable.P90, report.ClusterTimeToAvailable.P99, report.ClusterTimeToAvailable.Max)
+	fmt.Fprintf(w, "Machines\t%s\t%s\t%s\t%s\n", report.MachineTimeToAvailable.P50, report.MachineTimeToAvailable.P90, report.MachineTimeToAvailable.P99, report.MachineTimeToAvailable.Max)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(report.NotAvailableClusters) > 0 {
+		fmt.Fprintf(out, "\nClusters not available: %v\n", report.NotAvailableClusters)
+	}
+	return nil
+}
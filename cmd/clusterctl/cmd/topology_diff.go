@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type topologyDiffOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	exitCode          bool
+}
+
+var topologyDiffOpts = &topologyDiffOptions{}
+
+var topologyDiffCmd = &cobra.Command{
+	Use:   "diff NAME",
+	Short: "Show the differences between the objects of a Cluster with a managed topology and its desired state",
+	Long: templates.LongDesc(`
+		Show the differences between the objects of a Cluster with a managed topology and its desired state.
+
+		The desired state is computed from the ClusterClass referenced by the Cluster and the variable values of the Cluster,
+		and it is compared with the objects in the management cluster using server side apply dry-run requests with the
+		field manager of the topology controller; only fields managed by the topology controller are compared, so the
+		command detects drift introduced e.g. by out-of-band edits. No object is created or changed in the management cluster.
+
+		The ClusterClass must have been reconciled. External patches are not supported, and upgrades in progress
+		are not taken into account.`),
+
+	Example: templates.Examples(`
+		# Show the differences between the objects of the Cluster my-cluster and its desired state.
+		clusterctl alpha topology diff my-cluster -n my-namespace
+
+		# Fail if the objects of the Cluster my-cluster differ from its desired state, e.g. in a GitOps pipeline.
+		clusterctl alpha topology diff my-cluster --exit-code`),
+
+	Args: helpOnErrorArgs(cobra.ExactArgs(1)),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runTopologyDiff(args[0])
+	},
+}
+
+func init() {
+	topologyDiffCmd.Flags().StringVar(&topologyDiffOpts.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	topologyDiffCmd.Flags().StringVar(&topologyDiffOpts.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	topologyDiffCmd.Flags().StringVarP(&topologyDiffOpts.namespace, "namespace", "n", "",
+		"Namespace of the Cluster. If unspecified, the current namespace will be used.")
+	topologyDiffCmd.Flags().BoolVar(&topologyDiffOpts.exitCode, "exit-code", false,
+		"Exit with an error if the objects of the Cluster differ from its desired state.")
+}
+
+func runTopologyDiff(name string) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.TopologyDiff(ctx, client.TopologyDiffOptions{
+		Kubeconfig:  client.Kubeconfig{Path: topologyDiffOpts.kubeconfig, Context: topologyDiffOpts.kubeconfigContext},
+		ClusterName: name,
+		Namespace:   topologyDiffOpts.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !out.HasDrift() {
+		fmt.Printf("No differences found for Cluster %s\n", name)
+		return nil
+	}
+
+	for _, obj := range out.Objects {
+		fmt.Printf("%s %s %s/%s\n", obj.Operation, obj.Object.Kind, obj.Object.Namespace, obj.Object.Name)
+		if obj.Diff != "" {
+			fmt.Println(indent(strings.TrimRight(obj.Diff, "\n"), "  "))
+		}
+	}
+
+	if topologyDiffOpts.exitCode {
+		return pkgerrors.Errorf("objects of Cluster %s differ from the desired state", name)
+	}
+	return nil
+}
+
+// indent adds the prefix to each line of s.
+func indent(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+type topologyPreviewOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	file              string
+	namespace         string
+	output            string
+}
+
+var topologyPreviewOpts = &topologyPreviewOptions{}
+
+var topologyPreviewCmd = &cobra.Command{
+	Use:   "preview",
+	Short: "Preview the objects of a Cluster with a managed topology",
+	Long: templates.LongDesc(`
+		Preview the objects of a Cluster with a managed topology.
+
+		The objects are computed from the ClusterClass referenced by the Cluster and the variable values of the Cluster,
+		including the templates patched by the inline patches of the ClusterClass, as they would be created by the
+		topology controller; no object is created or changed in the management cluster.
+
+		The ClusterClass and its templates are read from the management cluster, and the ClusterClass must have
+		been reconciled. External patches are not supported.`),
+
+	Example: templates.Examples(`
+		# Preview the objects of the Cluster defined in cluster.yaml.
+		clusterctl alpha topology preview -f cluster.yaml
+
+		# Preview the objects of a Cluster read from stdin and write them to a file.
+		cat cluster.yaml | clusterctl alpha topology preview -f - -o objects.yaml`),
+
+	Args: helpOnErrorArgs(cobra.NoArgs),
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runTopologyPreview()
+	},
+}
+
+func init() {
+	topologyPreviewCmd.Flags().StringVar(&topologyPreviewOpts.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	topologyPreviewCmd.Flags().StringVar(&topologyPreviewOpts.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	topologyPreviewCmd.Flags().StringVarP(&topologyPreviewOpts.file, "file", "f", "",
+		"Path to the file with the Cluster to preview; use - to read from stdin.")
+	topologyPreviewCmd.Flags().StringVarP(&topologyPreviewOpts.namespace, "namespace", "n", "",
+		"Namespace of the Cluster, if not set in the file. If unspecified, the current namespace will be used.")
+	topologyPreviewCmd.Flags().StringVarP(&topologyPreviewOpts.output, "output", "o", "",
+		"Output file path (default: stdout)")
+	_ = topologyPreviewCmd.MarkFlagRequired("file")
+}
+
+func runTopologyPreview() error {
+	var input []byte
+	var err error
+	if topologyPreviewOpts.file == "-" {
+		input, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to read from stdin")
+		}
+	} else {
+		// #nosec G304
+		// command accepts user-provided file path by design.
+		input, err = os.ReadFile(topologyPreviewOpts.file)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to read input file %q", topologyPreviewOpts.file)
+		}
+	}
+
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.TopologyPreview(ctx, client.TopologyPreviewOptions{
+		Kubeconfig: client.Kubeconfig{Path: topologyPreviewOpts.kubeconfig, Context: topologyPreviewOpts.kubeconfigContext},
+		Input:      input,
+		Namespace:  topologyPreviewOpts.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	yaml, err := utilyaml.FromUnstructured(out.Objects)
+	if err != nil {
+		return err
+	}
+
+	if topologyPreviewOpts.output == "" {
+		if _, err := os.Stdout.Write(yaml); err != nil {
+			return pkgerrors.Wrap(err, "failed to write to stdout")
+		}
+		return nil
+	}
+	if err := os.WriteFile(topologyPreviewOpts.output, yaml, 0600); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write output file %q", topologyPreviewOpts.output)
+	}
+	return nil
+}
@@ -40,6 +40,11 @@ type upgradeApplyOptions struct {
 	addonProviders            []string
 	waitProviders             bool
 	waitProviderTimeout       int
+
+	ignoreRuntimeExtensionsCompatibility bool
+
+	imageOverrides string
+	validateImages bool
 }
 
 var ua = &upgradeApplyOptions{}
@@ -60,7 +65,10 @@ var upgradeApplyCmd = &cobra.Command{
 		clusterctl upgrade apply --contract v1beta2
 
 		# Upgrades only the aws provider to the v2.0.1 version.
-		clusterctl upgrade apply --infrastructure aws:v2.0.1`),
+		clusterctl upgrade apply --infrastructure aws:v2.0.1
+
+		# Upgrades all the providers using the images of a mirror registry, after checking the images exist.
+		clusterctl upgrade apply --contract v1beta2 --image-overrides image-overrides.yaml --validate-images`),
 	Args: helpOnErrorArgs(cobra.NoArgs),
 	RunE: func(*cobra.Command, []string) error {
 		return runUpgradeApply()
@@ -93,6 +101,12 @@ func init() {
 		"Wait for providers to be upgraded.")
 	upgradeApplyCmd.Flags().IntVar(&ua.waitProviderTimeout, "wait-provider-timeout", 5*60,
 		"Wait timeout per provider upgrade in seconds. This value is ignored if --wait-providers is false")
+	upgradeApplyCmd.Flags().BoolVar(&ua.ignoreRuntimeExtensionsCompatibility, "ignore-runtime-extensions-compatibility", false,
+		"Upgrade the core provider even if it does not support the Runtime Extensions registered in the management cluster.")
+	upgradeApplyCmd.Flags().StringVar(&ua.imageOverrides, "image-overrides", "",
+		"Path to a file mapping the images of the providers and of cert-manager to the images to be used instead, e.g. the images of a mirror registry.")
+	upgradeApplyCmd.Flags().BoolVar(&ua.validateImages, "validate-images", false,
+		"Check that the images of the target versions of the providers exist in their registries before upgrading any provider.")
 }
 
 func runUpgradeApply() error {
@@ -130,5 +144,9 @@ func runUpgradeApply() error {
 		AddonProviders:            ua.addonProviders,
 		WaitProviders:             ua.waitProviders,
 		WaitProviderTimeout:       time.Duration(ua.waitProviderTimeout) * time.Second,
+
+		IgnoreRuntimeExtensionsCompatibility: ua.ignoreRuntimeExtensionsCompatibility,
+		ImageOverridesFile:                   ua.imageOverrides,
+		ValidateImages:                       ua.validateImages,
 	})
 }
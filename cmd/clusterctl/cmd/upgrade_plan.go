@@ -25,8 +25,10 @@ import (
 	"github.com/spf13/cobra"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 type upgradePlanOptions struct {
@@ -109,6 +111,7 @@ func runUpgradePlan() error {
 		sortUpgradeItems(plan)
 
 		upgradeAvailable := false
+		var notReadyProviders []string
 
 		fmt.Println("")
 		fmt.Printf("Latest release available for the %s Cluster API contract version:\n", plan.Contract)
@@ -120,12 +123,43 @@ func runUpgradePlan() error {
 			if upgradeItem.NextVersion != "" {
 				upgradeAvailable = true
 			}
+			// NOTE: Provider conditions are set only if the ProviderInventoryConditions feature gate is enabled in the core provider.
+			if conditions.IsFalse(&upgradeItem.Provider, clusterctlv1.ProviderReadyCondition) {
+				notReadyProviders = append(notReadyProviders, fmt.Sprintf("* %s: %s", upgradeItem.InstanceName(), conditions.GetMessage(&upgradeItem.Provider, clusterctlv1.ProviderReadyCondition)))
+			}
 		}
 		if err := w.Flush(); err != nil {
 			return err
 		}
 		fmt.Println("")
 
+		if len(notReadyProviders) > 0 {
+			fmt.Println("Warning: the following providers are not ready:")
+			fmt.Println("")
+			for _, p := range notReadyProviders {
+				fmt.Println(p)
+			}
+			fmt.Println("")
+			fmt.Println("Fix the provider installations before upgrading.")
+			fmt.Println("")
+		}
+
+		if len(plan.IncompatibleRuntimeExtensions) > 0 {
+			fmt.Println("Warning: the following Runtime Extensions are not supported by the next version of the core provider:")
+			fmt.Println("")
+			w := tabwriter.NewWriter(os.Stdout, 10, 4, 3, ' ', 0)
+			fmt.Fprintln(w, "EXTENSIONCONFIG\tHANDLER\tHOOK\tAPI VERSION")
+			for _, e := range plan.IncompatibleRuntimeExtensions {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.ExtensionConfig, e.Handler, e.Hook, e.APIVersion)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			fmt.Println("")
+			fmt.Println("Upgrade the Runtime Extensions before upgrading the core provider, or use --ignore-runtime-extensions-compatibility with clusterctl upgrade apply.")
+			fmt.Println("")
+		}
+
 		if upgradeAvailable {
 			if plan.Contract == clusterv1.GroupVersion.Version {
 				fmt.Println("You can now apply the upgrade by executing the following command:")
@@ -29,6 +29,7 @@ import (
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 )
 
@@ -48,4 +49,5 @@ func init() {
 	_ = admissionregistrationv1beta1.AddToScheme(Scheme)
 	_ = controlplanev1.AddToScheme(Scheme)
 	_ = addonsv1.AddToScheme(Scheme)
+	_ = runtimev1.AddToScheme(Scheme)
 }
@@ -19,10 +19,12 @@ package clustercache
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"sync"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -126,11 +128,53 @@ type clusterAccessorClientConfig struct {
 	// The rest.Config is also used to create the client and the cache.
 	UserAgent string
 
+	// DisableNodeCaching disables caching of Nodes. If set, the Node indexes are not added to the cache,
+	// Get & List calls for Nodes always result in a live lookup and Node watches are not created.
+	DisableNodeCaching bool
+
+	// ProxyURL is the URL of the proxy used for the rest.Config.
+	// If not set, the proxy-url of the cluster in the kubeconfig Secret is used, if any.
+	ProxyURL *url.URL
+
 	// Cache is the cache config defining how the clients that clusterAccessor creates
 	// should interact with the underlying cache.
 	Cache clusterAccessorClientCacheConfig
 }
 
+// withOverrides returns a copy of the client config with the given overrides applied.
+func (c *clusterAccessorClientConfig) withOverrides(overrides ClientOverrides) *clusterAccessorClientConfig {
+	clientConfig := *c
+	if overrides.Timeout != 0 {
+		clientConfig.Timeout = overrides.Timeout
+	}
+	if overrides.QPS != 0 {
+		clientConfig.QPS = overrides.QPS
+	}
+	if overrides.Burst != 0 {
+		clientConfig.Burst = overrides.Burst
+	}
+	if overrides.DisableNodeCaching {
+		clientConfig.DisableNodeCaching = true
+	}
+	if overrides.ProxyURL != nil {
+		clientConfig.ProxyURL = overrides.ProxyURL
+	}
+	return &clientConfig
+}
+
+// equalConnectionSettings returns true if both client configs would result in the same connection settings.
+func (c *clusterAccessorClientConfig) equalConnectionSettings(other *clusterAccessorClientConfig) bool {
+	return c.Timeout == other.Timeout && c.QPS == other.QPS && c.Burst == other.Burst && c.UserAgent == other.UserAgent &&
+		c.DisableNodeCaching == other.DisableNodeCaching && equalURLs(c.ProxyURL, other.ProxyURL)
+}
+
+func equalURLs(a, b *url.URL) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.String() == b.String()
+}
+
 // clusterAccessorClientCacheConfig is the cache config used for the client that the clusterAccessor creates.
 type clusterAccessorClientCacheConfig struct {
 	// DisableFor is a list of objects that should never be read from the cache.
@@ -149,6 +193,24 @@ type clusterAccessorHealthProbeConfig struct {
 	// FailureThreshold is the number of consecutive failures after which
 	// the health probe is considered failed.
 	FailureThreshold int
+
+	// FailureAction is the action that is taken after FailureThreshold consecutive failures.
+	FailureAction HealthProbeFailureAction
+}
+
+// withPolicy returns a copy of the health probe config with the given policy applied.
+func (c *clusterAccessorHealthProbeConfig) withPolicy(policy HealthProbePolicy) *clusterAccessorHealthProbeConfig {
+	healthProbeConfig := *c
+	if policy.Interval != 0 {
+		healthProbeConfig.Interval = policy.Interval
+	}
+	if policy.FailureThreshold != 0 {
+		healthProbeConfig.FailureThreshold = policy.FailureThreshold
+	}
+	if policy.FailureAction != "" {
+		healthProbeConfig.FailureAction = policy.FailureAction
+	}
+	return &healthProbeConfig
 }
 
 // clusterAccessorLockedState is the state of the clusterAccessor. This includes the connection (e.g. client, cache)
@@ -158,6 +220,14 @@ type clusterAccessorLockedState struct {
 	// lastConnectionCreationErrorTime is the time when connection creation failed the last time.
 	lastConnectionCreationErrorTime time.Time
 
+	// clientConfig is the client config that is used for the next connection creation.
+	// If not set, the client config from clusterAccessorConfig is used.
+	clientConfig *clusterAccessorClientConfig
+
+	// healthProbeConfig is the health probe config that is used for health probes.
+	// If not set, the health probe config from clusterAccessorConfig is used.
+	healthProbeConfig *clusterAccessorHealthProbeConfig
+
 	// connection holds the connection state (e.g. client, cache) of the clusterAccessor.
 	connection *clusterAccessorLockedConnectionState
 
@@ -174,6 +244,9 @@ type RESTClient interface {
 
 // clusterAccessorLockedConnectionState holds the connection state (e.g. client, cache) of the clusterAccessor.
 type clusterAccessorLockedConnectionState struct {
+	// clientConfig is the client config that was used to create the connection.
+	clientConfig *clusterAccessorClientConfig
+
 	// restConfig to communicate with the workload cluster.
 	restConfig *rest.Config
 
@@ -210,6 +283,9 @@ type clusterAccessorLockedHealthCheckingState struct {
 
 	// consecutiveFailures is the number of consecutive health probe failures.
 	consecutiveFailures int
+
+	// lastProbeError is the error of the last health probe, nil if the last health probe succeeded.
+	lastProbeError error
 }
 
 // newClusterAccessor creates a new clusterAccessor.
@@ -247,11 +323,18 @@ func (ca *clusterAccessor) Connect(ctx context.Context) (retErr error) {
 		return nil
 	}
 
+	ca.rLock(ctx)
+	clientConfig := ca.lockedState.clientConfig
+	ca.rUnlock(ctx)
+	if clientConfig == nil {
+		clientConfig = ca.config.Client
+	}
+
 	start := time.Now()
 	log.V(4).Info("Connecting")
 
 	// Creating clients, cache etc. is intentionally done without a lock to avoid blocking other reconcilers.
-	connection, err := ca.createConnection(ctx)
+	connection, err := ca.createConnection(ctx, clientConfig)
 
 	duration := time.Since(start)
 
@@ -267,6 +350,7 @@ func (ca *clusterAccessor) Connect(ctx context.Context) (retErr error) {
 			ca.lockedState.healthChecking.lastProbeTime = time.Now()
 			// Note: Intentionally not modifying lastProbeSuccessTime.
 			ca.lockedState.healthChecking.consecutiveFailures++
+			ca.lockedState.healthChecking.lastProbeError = retErr
 		} else {
 			connectionUp.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(1)
 		}
@@ -286,6 +370,7 @@ func (ca *clusterAccessor) Connect(ctx context.Context) (retErr error) {
 		consecutiveFailures:  0,
 	}
 	ca.lockedState.connection = &clusterAccessorLockedConnectionState{
+		clientConfig:   clientConfig,
 		restConfig:     connection.RESTConfig,
 		restClient:     connection.RESTClient,
 		cachedClient:   connection.CachedClient,
@@ -310,6 +395,8 @@ func (ca *clusterAccessor) Disconnect(ctx context.Context) {
 	defer func() {
 		ca.unlock(ctx)
 		connectionUp.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(0)
+		cacheInformers.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(0)
+		cacheObjects.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(0)
 	}()
 	log.V(4).Info("Disconnecting")
 
@@ -334,17 +421,28 @@ func (ca *clusterAccessor) HealthCheck(ctx context.Context) (bool, bool) {
 
 	ca.rLock(ctx)
 	restClient := ca.lockedState.connection.restClient
+	cache := ca.lockedState.connection.cache
+	healthProbeConfig := ca.getHealthProbeConfig()
 	ca.rUnlock(ctx)
 
 	log.V(6).Info("Run health probe")
 
 	// Executing the health probe is intentionally done without a lock to avoid blocking other reconcilers.
-	_, err := restClient.Get().AbsPath("/").Timeout(ca.config.HealthProbe.Timeout).DoRaw(ctx)
+	_, err := restClient.Get().AbsPath("/").Timeout(healthProbeConfig.Timeout).DoRaw(ctx)
+
+	// Record the cache size together with the health probe, so it is updated periodically.
+	// This is intentionally done without a lock as well, counting objects only reads from the informer stores.
+	if cache != nil {
+		informers, objects := cache.Size(ctx)
+		cacheInformers.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(informers))
+		cacheObjects.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(float64(objects))
+	}
 
 	ca.lock(ctx)
 	defer ca.unlock(ctx)
 
 	ca.lockedState.healthChecking.lastProbeTime = time.Now()
+	ca.lockedState.healthChecking.lastProbeError = err
 
 	unauthorizedErrorOccurred := false
 	switch {
@@ -360,7 +458,7 @@ func (ca *clusterAccessor) HealthCheck(ctx context.Context) (bool, bool) {
 	case err != nil:
 		ca.lockedState.healthChecking.consecutiveFailures++
 		log.V(6).Info(fmt.Sprintf("Health probe failed (%d/%d): %v",
-			ca.lockedState.healthChecking.consecutiveFailures, ca.config.HealthProbe.FailureThreshold, err))
+			ca.lockedState.healthChecking.consecutiveFailures, healthProbeConfig.FailureThreshold, err))
 		healthCheck.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace).Set(0)
 		healthChecksTotal.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace, "error").Inc()
 	default:
@@ -371,7 +469,7 @@ func (ca *clusterAccessor) HealthCheck(ctx context.Context) (bool, bool) {
 		healthChecksTotal.WithLabelValues(ca.cluster.Name, ca.cluster.Namespace, "success").Inc()
 	}
 
-	tooManyConsecutiveFailures := ca.lockedState.healthChecking.consecutiveFailures >= ca.config.HealthProbe.FailureThreshold
+	tooManyConsecutiveFailures := ca.lockedState.healthChecking.consecutiveFailures >= healthProbeConfig.FailureThreshold
 	return tooManyConsecutiveFailures, unauthorizedErrorOccurred
 }
 
@@ -446,6 +544,12 @@ func (ca *clusterAccessor) Watch(ctx context.Context, watcher Watcher) error {
 		return pkgerrors.WithMessagef(ErrClusterNotConnected, "error creating watch %s for %T", watcher.Name(), watcher.Object())
 	}
 
+	// Skip Node watches if caching of Nodes has been disabled, creating the watch would create a Node informer.
+	if _, ok := watcher.Object().(*corev1.Node); ok && ca.lockedState.connection.clientConfig.DisableNodeCaching {
+		log.V(6).Info(fmt.Sprintf("Skip creation of watch %s for %T because caching of Nodes is disabled", watcher.Name(), watcher.Object()))
+		return nil
+	}
+
 	// Return early if the watch was already added.
 	if ca.lockedState.connection.watches.Has(watcher.Name()) {
 		log.V(6).Info(fmt.Sprintf("Skip creation of watch %s for %T because it already exists", watcher.Name(), watcher.Object()))
@@ -465,11 +569,53 @@ func (ca *clusterAccessor) GetHealthCheckingState(ctx context.Context) HealthChe
 	ca.rLock(ctx)
 	defer ca.rUnlock(ctx)
 
+	healthProbeConfig := ca.getHealthProbeConfig()
+	lastProbeError := ""
+	if ca.lockedState.healthChecking.lastProbeError != nil {
+		lastProbeError = ca.lockedState.healthChecking.lastProbeError.Error()
+	}
 	return HealthCheckingState{
 		LastProbeTime:        ca.lockedState.healthChecking.lastProbeTime,
 		LastProbeSuccessTime: ca.lockedState.healthChecking.lastProbeSuccessTime,
 		ConsecutiveFailures:  ca.lockedState.healthChecking.consecutiveFailures,
+		LastProbeError:       lastProbeError,
+		FailureThreshold:     healthProbeConfig.FailureThreshold,
+		Degraded: ca.lockedState.connection != nil &&
+			healthProbeConfig.FailureAction == HealthProbeFailureActionMarkDegraded &&
+			ca.lockedState.healthChecking.consecutiveFailures >= healthProbeConfig.FailureThreshold,
+	}
+}
+
+// SetHealthProbeConfig sets the health probe config that is used for health probes.
+func (ca *clusterAccessor) SetHealthProbeConfig(ctx context.Context, healthProbeConfig *clusterAccessorHealthProbeConfig) {
+	ca.lock(ctx)
+	defer ca.unlock(ctx)
+
+	ca.lockedState.healthProbeConfig = healthProbeConfig
+}
+
+// getHealthProbeConfig returns the health probe config that is used for health probes.
+// Note: lockedStateLock must be held when calling this method.
+func (ca *clusterAccessor) getHealthProbeConfig() *clusterAccessorHealthProbeConfig {
+	if ca.lockedState.healthProbeConfig != nil {
+		return ca.lockedState.healthProbeConfig
+	}
+	return ca.config.HealthProbe
+}
+
+// SetClientConfig sets the client config that is used for the next connection creation.
+// It returns true if there is a connection to the workload cluster that has been created with
+// different connection settings, i.e. if the connection has to be re-created to use the new client config.
+func (ca *clusterAccessor) SetClientConfig(ctx context.Context, clientConfig *clusterAccessorClientConfig) bool {
+	ca.lock(ctx)
+	defer ca.unlock(ctx)
+
+	ca.lockedState.clientConfig = clientConfig
+
+	if ca.lockedState.connection == nil || ca.lockedState.connection.clientConfig == nil {
+		return false
 	}
+	return !ca.lockedState.connection.clientConfig.equalConnectionSettings(clientConfig)
 }
 
 func (ca *clusterAccessor) GetLastConnectionCreationErrorTime(ctx context.Context) time.Time {
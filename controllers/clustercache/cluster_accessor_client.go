@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,10 +30,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -39,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	kcfg "sigs.k8s.io/cluster-api/util/kubeconfig"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 type createConnectionResult struct {
@@ -49,12 +54,12 @@ type createConnectionResult struct {
 	Cache          *stoppableCache
 }
 
-func (ca *clusterAccessor) createConnection(ctx context.Context) (*createConnectionResult, error) {
+func (ca *clusterAccessor) createConnection(ctx context.Context, clientConfig *clusterAccessorClientConfig) (*createConnectionResult, error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.V(6).Info("Creating connection")
 
 	log.V(6).Info("Creating REST config")
-	restConfig, err := createRESTConfig(ctx, ca.config.Client, ca.config.SecretClient, ca.cluster)
+	restConfig, err := createRESTConfig(ctx, clientConfig, ca.config.SecretClient, ca.cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +112,7 @@ func (ca *clusterAccessor) createConnection(ctx context.Context) (*createConnect
 	}
 
 	log.V(6).Info("Creating cached client and cache")
-	cachedClient, cache, err := createCachedClient(ctx, ca.cacheCtx, ca.config, restConfig, httpClient, mapper)
+	cachedClient, cache, err := createCachedClient(ctx, ca.cacheCtx, ca.config, clientConfig, restConfig, httpClient, mapper)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +151,19 @@ func createRESTConfig(ctx context.Context, clientConfig *clusterAccessorClientCo
 	restConfig.Timeout = clientConfig.Timeout
 	restConfig.QPS = clientConfig.QPS
 	restConfig.Burst = clientConfig.Burst
+	if clientConfig.ProxyURL != nil {
+		restConfig.Proxy = http.ProxyURL(clientConfig.ProxyURL)
+	}
+
+	// Record the latency of all requests to the workload cluster.
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &latencyRecordingRoundTripper{
+			delegate: rt,
+			cluster:  cluster,
+		}
+	})
+	// Trace requests to the workload cluster and propagate the trace context, if tracing is enabled.
+	restConfig.Wrap(tracing.WrapTransport)
 
 	return restConfig, nil
 }
@@ -229,7 +247,7 @@ func createUncachedClient(scheme *runtime.Scheme, config *rest.Config, httpClien
 }
 
 // createCachedClient creates a cached client for the given cluster, based on the rest.Config.
-func createCachedClient(ctx, cacheCtx context.Context, clusterAccessorConfig *clusterAccessorConfig, config *rest.Config, httpClient *http.Client, mapper meta.RESTMapper) (client.Client, *stoppableCache, error) {
+func createCachedClient(ctx, cacheCtx context.Context, clusterAccessorConfig *clusterAccessorConfig, clientConfig *clusterAccessorClientConfig, config *rest.Config, httpClient *http.Client, mapper meta.RESTMapper) (client.Client, *stoppableCache, error) {
 	// This config will only be used for List and Watch calls of informers
 	// because we don't want these requests to time out after the regular timeout
 	// of Options.Client.Timeout (default 10s).
@@ -265,10 +283,20 @@ func createCachedClient(ctx, cacheCtx context.Context, clusterAccessorConfig *cl
 	// We need to be able to stop the cache's shared informers, so wrap this in a stoppableCache.
 	cache := &stoppableCache{
 		Cache:      remoteCache,
+		scheme:     clusterAccessorConfig.Scheme,
 		cancelFunc: cacheCtxCancel,
 	}
 
+	disableFor := clusterAccessorConfig.Client.Cache.DisableFor
+	if clientConfig.DisableNodeCaching {
+		disableFor = append(slices.Clone(disableFor), &corev1.Node{})
+	}
+
 	for _, index := range clusterAccessorConfig.Cache.Indexes {
+		// Adding an index creates an informer, so skip Node indexes if caching of Nodes has been disabled.
+		if _, ok := index.Object.(*corev1.Node); ok && clientConfig.DisableNodeCaching {
+			continue
+		}
 		if err := cache.IndexField(ctx, index.Object, index.Field, index.ExtractValue); err != nil {
 			return nil, nil, pkgerrors.WithMessagef(err, "error adding index for field %q to cache", index.Field)
 		}
@@ -281,7 +309,7 @@ func createCachedClient(ctx, cacheCtx context.Context, clusterAccessorConfig *cl
 		HTTPClient: httpClient,
 		Cache: &client.CacheOptions{
 			Reader:       cache,
-			DisableFor:   clusterAccessorConfig.Client.Cache.DisableFor,
+			DisableFor:   disableFor,
 			Unstructured: true,
 		},
 	})
@@ -340,13 +368,148 @@ func (c clientWithTimeout) List(ctx context.Context, list client.ObjectList, opt
 	return c.Client.List(ctx, list, opts...)
 }
 
+// latencyRecordingRoundTripper records the latency of requests to a workload cluster.
+type latencyRecordingRoundTripper struct {
+	delegate http.RoundTripper
+	cluster  client.ObjectKey
+}
+
+func (rt *latencyRecordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rt.delegate.RoundTrip(req)
+
+	// Watch requests are long-running, recording their latency would skew the metric.
+	if req.URL.Query().Get("watch") != "true" {
+		requestDuration.WithLabelValues(rt.cluster.Name, rt.cluster.Namespace, req.Method).Observe(time.Since(start).Seconds())
+	}
+
+	return resp, err
+}
+
 // stoppableCache embeds cache.Cache and combines it with a stop channel.
+// It also tracks the object types for which informers have been requested, so that the size of the cache can be determined.
 type stoppableCache struct {
 	cache.Cache
 
 	lock       sync.Mutex
 	stopped    bool
 	cancelFunc context.CancelCauseFunc
+
+	// scheme is used to determine the object types of Get, List and GetInformer calls.
+	scheme *runtime.Scheme
+
+	// informerObjectsLock is used to synchronize access to informerObjects.
+	informerObjectsLock sync.RWMutex
+	// informerObjects is a map of objects for which informers have been requested.
+	// The key includes the Go type, so that e.g. typed and unstructured informers for the same GVK are tracked separately.
+	informerObjects map[string]client.Object
+}
+
+// Get retrieves an obj for the given object key from the cache and tracks the informer for the object type.
+func (cc *stoppableCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	cc.trackInformer(obj)
+	return cc.Cache.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves a list of objects from the cache and tracks the informer for the item type.
+func (cc *stoppableCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	cc.trackInformer(list)
+	return cc.Cache.List(ctx, list, opts...)
+}
+
+// GetInformer returns the informer for the object type and tracks it.
+func (cc *stoppableCache) GetInformer(ctx context.Context, obj client.Object, opts ...cache.InformerGetOption) (cache.Informer, error) {
+	cc.trackInformer(obj)
+	return cc.Cache.GetInformer(ctx, obj, opts...)
+}
+
+// IndexField adds an index to the cache and tracks the informer for the object type.
+func (cc *stoppableCache) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	cc.trackInformer(obj)
+	return cc.Cache.IndexField(ctx, obj, field, extractValue)
+}
+
+// trackInformer tracks the informer for the type of the given object or list.
+func (cc *stoppableCache) trackInformer(obj runtime.Object) {
+	if cc.scheme == nil {
+		return
+	}
+	gvk, err := apiutil.GVKForObject(obj, cc.scheme)
+	if err != nil {
+		return
+	}
+	if _, isList := obj.(client.ObjectList); isList {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+
+	// Note: The cache uses separate informers for typed, unstructured and metadata-only objects of the same GVK.
+	objectType := "typed"
+	switch obj.(type) {
+	case *unstructured.Unstructured, *unstructured.UnstructuredList:
+		objectType = "unstructured"
+	case *metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList:
+		objectType = "metadata"
+	}
+	key := objectType + "/" + gvk.String()
+
+	cc.informerObjectsLock.RLock()
+	_, ok := cc.informerObjects[key]
+	cc.informerObjectsLock.RUnlock()
+	if ok {
+		return
+	}
+
+	// Only the type of the object is relevant to get the informer later, so we store an empty object.
+	var informerObj client.Object
+	switch objectType {
+	case "unstructured":
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		informerObj = u
+	case "metadata":
+		m := &metav1.PartialObjectMetadata{}
+		m.SetGroupVersionKind(gvk)
+		informerObj = m
+	default:
+		o, err := cc.scheme.New(gvk)
+		if err != nil {
+			return
+		}
+		if informerObj, ok = o.(client.Object); !ok {
+			return
+		}
+	}
+
+	cc.informerObjectsLock.Lock()
+	defer cc.informerObjectsLock.Unlock()
+	if cc.informerObjects == nil {
+		cc.informerObjects = map[string]client.Object{}
+	}
+	cc.informerObjects[key] = informerObj
+}
+
+// Size returns the number of tracked informers and the number of objects stored in them.
+func (cc *stoppableCache) Size(ctx context.Context) (int, int) {
+	cc.informerObjectsLock.RLock()
+	informerObjects := make([]client.Object, 0, len(cc.informerObjects))
+	for _, obj := range cc.informerObjects {
+		informerObjects = append(informerObjects, obj)
+	}
+	cc.informerObjectsLock.RUnlock()
+
+	informers, objects := 0, 0
+	for _, obj := range informerObjects {
+		// Note: The informers already exist, so this neither creates a new informer nor blocks.
+		informer, err := cc.Cache.GetInformer(ctx, obj, cache.BlockUntilSynced(false))
+		if err != nil {
+			continue
+		}
+		informers++
+		if storeInformer, ok := informer.(interface{ GetStore() toolscache.Store }); ok {
+			objects += len(storeInformer.GetStore().ListKeys())
+		}
+	}
+	return informers, objects
 }
 
 // Stop cancels the cache.Cache's context, unless it has already been stopped.
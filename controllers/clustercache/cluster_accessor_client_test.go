@@ -17,14 +17,21 @@ limitations under the License.
 package clustercache
 
 import (
+	"net/http"
+	"net/url"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/kubeconfig"
 )
 
 func TestRunningOnWorkloadCluster(t *testing.T) {
@@ -144,3 +151,64 @@ func TestRunningOnWorkloadCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRESTConfigProxy(t *testing.T) {
+	testCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	kubeconfigBytes, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"test-cluster": {
+				Server:   "https://test-cluster-api:6443",
+				ProxyURL: "http://kubeconfig-proxy:3128",
+			},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"test-cluster-admin": {Token: "token"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"test-cluster": {Cluster: "test-cluster", AuthInfo: "test-cluster-admin"},
+		},
+		CurrentContext: "test-cluster",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithObjects(kubeconfig.GenerateSecret(testCluster, kubeconfigBytes)).Build()
+	req, err := http.NewRequest(http.MethodGet, "https://test-cluster-api:6443/api", http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		proxyURL     *url.URL
+		wantProxyURL string
+	}{
+		{
+			name:         "should use the proxy-url from the kubeconfig",
+			wantProxyURL: "http://kubeconfig-proxy:3128",
+		},
+		{
+			name:         "should use the proxy URL from the client config instead of the proxy-url from the kubeconfig",
+			proxyURL:     &url.URL{Scheme: "socks5", Host: "override-proxy:1080"},
+			wantProxyURL: "socks5://override-proxy:1080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			restConfig, err := createRESTConfig(ctx, &clusterAccessorClientConfig{ProxyURL: tt.proxyURL}, c, client.ObjectKeyFromObject(testCluster))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(restConfig.Proxy).ToNot(BeNil())
+
+			proxyURL, err := restConfig.Proxy(req)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(proxyURL.String()).To(Equal(tt.wantProxyURL))
+		})
+	}
+}
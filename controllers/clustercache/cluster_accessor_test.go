@@ -22,6 +22,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -93,8 +94,10 @@ func TestConnect(t *testing.T) {
 		healthChecking: clusterAccessorLockedHealthCheckingState{
 			lastProbeTime:       accessor.lockedState.healthChecking.lastProbeTime,
 			consecutiveFailures: 1,
+			lastProbeError:      accessor.lockedState.healthChecking.lastProbeError,
 		},
 	}))
+	g.Expect(accessor.GetHealthCheckingState(ctx).LastProbeError).To(Equal("error creating REST config: error getting kubeconfig secret: Secret \"test-cluster-kubeconfig\" not found"))
 
 	// Create invalid kubeconfig Secret
 	kubeconfigBytes := kubeconfig.FromEnvTestConfig(env.Config, testCluster)
@@ -158,6 +161,18 @@ func TestConnect(t *testing.T) {
 	g.Expect(c.List(ctx, nodeList)).To(Succeed())
 	g.Expect(nodeList.Items).To(BeEmpty())
 
+	// Verify the cache tracked the informers for Nodes and Namespaces
+	informers, objects := accessor.lockedState.connection.cache.Size(ctx)
+	g.Expect(informers).To(Equal(2))
+	g.Expect(objects).To(BeNumerically(">", 0))
+
+	// Setting the same client config doesn't require to re-create the connection
+	g.Expect(accessor.SetClientConfig(ctx, config.Client.withOverrides(ClientOverrides{}))).To(BeFalse())
+
+	// Setting a client config with different connection settings requires to re-create the connection
+	g.Expect(accessor.SetClientConfig(ctx, config.Client.withOverrides(ClientOverrides{QPS: 100}))).To(BeTrue())
+	g.Expect(accessor.SetClientConfig(ctx, config.Client.withOverrides(ClientOverrides{ProxyURL: &url.URL{Scheme: "socks5", Host: "proxy:1080"}}))).To(BeTrue())
+
 	// Connect again (no-op)
 	g.Expect(accessor.Connect(ctx)).To(Succeed())
 
@@ -247,9 +262,12 @@ func TestHealthCheck(t *testing.T) {
 		connected                      bool
 		restClientHTTPResponse         *http.Response
 		initialConsecutiveFailures     int
+		failureAction                  HealthProbeFailureAction
 		wantTooManyConsecutiveFailures bool
 		wantUnauthorizedErrorOccurred  bool
 		wantConsecutiveFailures        int
+		wantLastProbeError             bool
+		wantDegraded                   bool
 	}{
 		{
 			name:      "Health probe failed with unauthorized error",
@@ -262,6 +280,7 @@ func TestHealthCheck(t *testing.T) {
 			wantTooManyConsecutiveFailures: false,
 			wantUnauthorizedErrorOccurred:  true,
 			wantConsecutiveFailures:        1,
+			wantLastProbeError:             true,
 		},
 		{
 			name:      "Health probe failed with other error",
@@ -274,6 +293,7 @@ func TestHealthCheck(t *testing.T) {
 			wantTooManyConsecutiveFailures: false,
 			wantUnauthorizedErrorOccurred:  false,
 			wantConsecutiveFailures:        1,
+			wantLastProbeError:             true,
 		},
 		{
 			name:      "Health probe failed with other error (failure threshold met)",
@@ -287,6 +307,23 @@ func TestHealthCheck(t *testing.T) {
 			wantTooManyConsecutiveFailures: true,
 			wantUnauthorizedErrorOccurred:  false,
 			wantConsecutiveFailures:        5,
+			wantLastProbeError:             true,
+		},
+		{
+			name:      "Health probe failed with other error (failure threshold met, failure action MarkDegraded)",
+			connected: true,
+			restClientHTTPResponse: &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Header:     header(),
+				Body:       objBody(&apierrors.NewBadRequest("bad request").ErrStatus),
+			},
+			initialConsecutiveFailures:     4, // failure threshold is 5
+			failureAction:                  HealthProbeFailureActionMarkDegraded,
+			wantTooManyConsecutiveFailures: true,
+			wantUnauthorizedErrorOccurred:  false,
+			wantConsecutiveFailures:        5,
+			wantLastProbeError:             true,
+			wantDegraded:                   true,
 		},
 		{
 			name:      "Health probe succeeded",
@@ -318,6 +355,7 @@ func TestHealthCheck(t *testing.T) {
 				HealthProbe: &clusterAccessorHealthProbeConfig{
 					Timeout:          5 * time.Second,
 					FailureThreshold: 5,
+					FailureAction:    HealthProbeFailureActionDisconnect,
 				},
 			})
 			accessor.lockedState.connection = &clusterAccessorLockedConnectionState{
@@ -335,9 +373,19 @@ func TestHealthCheck(t *testing.T) {
 				accessor.lockedState.connection = nil
 			}
 
+			if tt.failureAction != "" {
+				accessor.SetHealthProbeConfig(ctx, accessor.config.HealthProbe.withPolicy(HealthProbePolicy{
+					FailureAction: tt.failureAction,
+				}))
+			}
+
 			gotTooManyConsecutiveFailures, gotUnauthorizedErrorOccurred := accessor.HealthCheck(ctx)
 			g.Expect(gotTooManyConsecutiveFailures).To(Equal(tt.wantTooManyConsecutiveFailures))
 			g.Expect(gotUnauthorizedErrorOccurred).To(Equal(tt.wantUnauthorizedErrorOccurred))
+
+			healthCheckingState := accessor.GetHealthCheckingState(ctx)
+			g.Expect(healthCheckingState.LastProbeError != "").To(Equal(tt.wantLastProbeError))
+			g.Expect(healthCheckingState.Degraded).To(Equal(tt.wantDegraded))
 		})
 	}
 }
@@ -19,16 +19,20 @@ package clustercache
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/rest"
 	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -71,6 +75,9 @@ type Options struct {
 
 	// Client are the client options for the clients that are created per cluster.
 	Client ClientOptions
+
+	// HealthProbe are the options for the health probe that is periodically run against the apiserver of each cluster.
+	HealthProbe HealthProbeOptions
 }
 
 // ClusterFilter is a function that filters which clusters should be handled by the ClusterCache.
@@ -131,10 +138,102 @@ type ClientOptions struct {
 	// UserAgent is the user agent used for the REST config, client and cache.
 	UserAgent string
 
+	// ClusterOverrides is a function that can be used to override Timeout, QPS and Burst
+	// for individual clusters, e.g. to allow more traffic to large workload clusters.
+	// Fields that are not set in the returned ClientOverrides fall back to the values above.
+	// Overrides set via the clustercache.cluster.x-k8s.io/client-* annotations on the Cluster
+	// take precedence over the overrides returned by this function.
+	// Note: When the overrides of a cluster change, the connection to this cluster is re-created.
+	ClusterOverrides func(cluster *clusterv1.Cluster) ClientOverrides
+
 	// Cache are the cache options defining how clients should interact with the underlying cache.
 	Cache ClientCacheOptions
 }
 
+// ClientOverrides are the client options that can be overridden per cluster.
+// Fields that are not set fall back to the values of ClientOptions.
+type ClientOverrides struct {
+	// Timeout is the timeout used for the REST config, client and cache.
+	Timeout time.Duration
+
+	// QPS is the maximum queries per second from the controller client
+	// to the Kubernetes API server of the workload cluster.
+	QPS float32
+
+	// Burst is the maximum number of queries that should be allowed in
+	// one burst from the controller client to the Kubernetes API server of the workload cluster.
+	Burst int
+
+	// DisableNodeCaching disables caching of Nodes, e.g. for clusters where keeping all Nodes
+	// in memory is too costly. Nodes are then always read from the API server and Node watches are not created.
+	DisableNodeCaching bool
+
+	// ProxyURL is the URL of a HTTP(S) or SOCKS5 proxy used for all the connections to the workload cluster,
+	// e.g. for workload clusters that are only reachable through a proxy or a konnectivity tunnel.
+	// If not set, the proxy-url of the cluster in the kubeconfig Secret is used, if any.
+	ProxyURL *url.URL
+}
+
+// HealthProbeOptions are the options for the health probe that is periodically run against the apiserver of each cluster.
+type HealthProbeOptions struct {
+	// Timeout is the timeout after which a single health probe times out.
+	// Defaults to 5s.
+	Timeout time.Duration
+
+	// Interval is the interval in which the health probe is run.
+	// Defaults to 10s.
+	Interval time.Duration
+
+	// FailureThreshold is the number of consecutive health probe failures after which
+	// the FailureAction is taken.
+	// Defaults to 5.
+	FailureThreshold int
+
+	// FailureAction is the action that is taken after FailureThreshold consecutive health probe failures.
+	// Defaults to Disconnect.
+	FailureAction HealthProbeFailureAction
+
+	// ClusterPolicy is a function that can be used to override Interval, FailureThreshold and FailureAction
+	// for individual clusters, e.g. to keep the connection to clusters with an unreliable network.
+	// Fields that are not set in the returned HealthProbePolicy fall back to the values above.
+	// Note: Consumers which rely on the connection being dropped within a certain amount of time (e.g. the
+	// remote conditions grace period in the core Cluster API controller) have to take the policies into account.
+	ClusterPolicy func(cluster *clusterv1.Cluster) HealthProbePolicy
+}
+
+// HealthProbePolicy is the health probe policy that can be set per cluster.
+// Fields that are not set fall back to the values of HealthProbeOptions.
+type HealthProbePolicy struct {
+	// Interval is the interval in which the health probe is run.
+	Interval time.Duration
+
+	// FailureThreshold is the number of consecutive health probe failures after which
+	// the FailureAction is taken.
+	FailureThreshold int
+
+	// FailureAction is the action that is taken after FailureThreshold consecutive health probe failures.
+	FailureAction HealthProbeFailureAction
+}
+
+// HealthProbeFailureAction is the action that is taken after FailureThreshold consecutive health probe failures.
+type HealthProbeFailureAction string
+
+const (
+	// HealthProbeFailureActionDisconnect disconnects from the cluster after FailureThreshold consecutive
+	// health probe failures. The ClusterCache will then try to re-create the connection.
+	HealthProbeFailureActionDisconnect HealthProbeFailureAction = "Disconnect"
+
+	// HealthProbeFailureActionMarkDegraded keeps the connection to the cluster after FailureThreshold consecutive
+	// health probe failures and only marks it as degraded (see HealthCheckingState.Degraded).
+	// Note: Health probes failing with an unauthorized error always lead to a disconnect, because
+	// the connection has to be re-created with the current kubeconfig.
+	HealthProbeFailureActionMarkDegraded HealthProbeFailureAction = "MarkDegraded"
+)
+
+// DefaultHealthProbeFailureThreshold is the default number of consecutive health probe failures
+// after which the HealthProbeOptions.FailureAction is taken.
+const DefaultHealthProbeFailureThreshold = 5
+
 // ClientCacheOptions are the cache options for the clients that are created per cluster.
 type ClientCacheOptions struct {
 	// DisableFor is a list of objects that should never be read from the cache.
@@ -194,6 +293,18 @@ type HealthCheckingState struct {
 	// ConsecutiveFailures is the number of consecutive health probe failures.
 	// Note: client creations are also counted as probes.
 	ConsecutiveFailures int
+
+	// LastProbeError is the error message of the last health probe, it is empty if the last health probe succeeded.
+	// Note: client creations are also counted as probes.
+	LastProbeError string
+
+	// FailureThreshold is the number of consecutive health probe failures after which
+	// the failure action of the health probe policy of the Cluster is taken.
+	FailureThreshold int
+
+	// Degraded is true if the connection to the Cluster has been kept even though FailureThreshold
+	// consecutive health probes failed, because the health probe policy of the Cluster uses the MarkDegraded failure action.
+	Degraded bool
 }
 
 // ErrClusterNotConnected is returned by the ClusterCache when e.g. a Client cannot be returned
@@ -334,6 +445,9 @@ func SetupWithManager(ctx context.Context, mgr manager.Manager, options Options,
 		clusterAccessors:      make(map[client.ObjectKey]*clusterAccessor),
 		cacheCtx:              cacheCtx,
 		cacheCtxCancel:        cacheCtxCancel,
+		clusterFilter:         options.ClusterFilter,
+		clusterOverrides:      options.Client.ClusterOverrides,
+		healthProbePolicy:     options.HealthProbe.ClusterPolicy,
 	}
 
 	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "clustercache")
@@ -341,6 +455,9 @@ func SetupWithManager(ctx context.Context, mgr manager.Manager, options Options,
 		Named("clustercache").
 		For(&clusterv1.Cluster{}).
 		WithOptions(controllerOptions).
+		// Note: Sharding can be implemented via ClusterFilter, which also disconnects from
+		// Clusters that are moved to another shard.
+		WithoutSharding().
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), log, options.WatchFilterValue)).
 		Complete(ctx, cc)
 	if err != nil {
@@ -378,6 +495,12 @@ type clusterCache struct {
 	// by the ClusterCache. If nil, all clusters will be handled. If set, only clusters for which
 	// the filter returns true will be handled.
 	clusterFilter ClusterFilter
+
+	// clusterOverrides is a function that can be used to override client options per cluster.
+	clusterOverrides func(cluster *clusterv1.Cluster) ClientOverrides
+
+	// healthProbePolicy is a function that can be used to override the health probe policy per cluster.
+	healthProbePolicy func(cluster *clusterv1.Cluster) HealthProbePolicy
 }
 
 // clusterSource stores the necessary information so we can enqueue reconcile.Requests for reconcilers that
@@ -492,6 +615,17 @@ func (cc *clusterCache) Reconcile(ctx context.Context, req reconcile.Request) (r
 
 	requeueAfterDurations := []time.Duration{}
 
+	// Disconnect if the client config of the Cluster changed (e.g. because QPS, Burst or Timeout
+	// overrides have been set on the Cluster), so that the connection is re-created below with the new client config.
+	if accessor.SetClientConfig(ctx, cc.clientConfigForCluster(ctx, cluster)) {
+		log.Info("Client config changed, disconnecting to re-create the connection")
+		accessor.Disconnect(ctx)
+		didDisconnect = true
+	}
+
+	healthProbeConfig := cc.healthProbeConfigForCluster(ctx, cluster)
+	accessor.SetHealthProbeConfig(ctx, healthProbeConfig)
+
 	// Try to connect, if not connected.
 	connected := accessor.Connected(ctx)
 	if !connected {
@@ -521,15 +655,18 @@ func (cc *clusterCache) Reconcile(ctx context.Context, req reconcile.Request) (r
 		healthCheckingState := accessor.GetHealthCheckingState(ctx)
 
 		// Requeue, if health probe was already run within the HealthProbe.Interval.
-		if requeueAfter, requeue := shouldRequeue(time.Now(), healthCheckingState.LastProbeTime, accessor.config.HealthProbe.Interval); requeue {
+		if requeueAfter, requeue := shouldRequeue(time.Now(), healthCheckingState.LastProbeTime, healthProbeConfig.Interval); requeue {
 			log.V(6).Info(fmt.Sprintf("Requeuing after %s as health probe was already run within the last %s",
-				requeueAfter.Truncate(time.Second/10), accessor.config.HealthProbe.Interval))
+				requeueAfter.Truncate(time.Second/10), healthProbeConfig.Interval))
 			requeueAfterDurations = append(requeueAfterDurations, requeueAfter)
 		} else {
 			// Run the health probe
 			tooManyConsecutiveFailures, unauthorizedErrorOccurred := accessor.HealthCheck(ctx)
-			if tooManyConsecutiveFailures || unauthorizedErrorOccurred {
-				// Disconnect if the health probe failed (either with unauthorized or consecutive failures >= HealthProbe.FailureThreshold).
+			markDegraded := tooManyConsecutiveFailures && !unauthorizedErrorOccurred &&
+				healthProbeConfig.FailureAction == HealthProbeFailureActionMarkDegraded
+			if (tooManyConsecutiveFailures || unauthorizedErrorOccurred) && !markDegraded {
+				// Disconnect if the health probe failed (either with unauthorized or consecutive failures >= HealthProbe.FailureThreshold
+				// and the failure action of the health probe policy is Disconnect).
 				accessor.Disconnect(ctx)
 
 				// Store that disconnect was done.
@@ -543,6 +680,11 @@ func (cc *clusterCache) Reconcile(ctx context.Context, req reconcile.Request) (r
 				// and in that case we want to immediately try to create the connection again.
 				log.V(6).Info("Requeuing immediately (disconnected after unauthorized error occurred)")
 				requeueAfterDurations = append(requeueAfterDurations, 1*time.Millisecond)
+			case markDegraded:
+				// Requeue for next health probe, the connection is kept but marked as degraded.
+				log.V(6).Info(fmt.Sprintf("Requeuing after %s (connection marked as degraded after consecutive failure threshold met)",
+					healthProbeConfig.Interval))
+				requeueAfterDurations = append(requeueAfterDurations, healthProbeConfig.Interval)
 			case tooManyConsecutiveFailures:
 				// Requeue for connection creation with the regular ConnectionCreationRetryInterval.
 				log.V(6).Info(fmt.Sprintf("Requeuing after %s (disconnected after consecutive failure threshold met)",
@@ -551,8 +693,8 @@ func (cc *clusterCache) Reconcile(ctx context.Context, req reconcile.Request) (r
 			default:
 				// Requeue for next health probe.
 				log.V(6).Info(fmt.Sprintf("Requeuing after %s (health probe succeeded)",
-					accessor.config.HealthProbe.Interval))
-				requeueAfterDurations = append(requeueAfterDurations, accessor.config.HealthProbe.Interval)
+					healthProbeConfig.Interval))
+				requeueAfterDurations = append(requeueAfterDurations, healthProbeConfig.Interval)
 			}
 		}
 	}
@@ -564,6 +706,144 @@ func (cc *clusterCache) Reconcile(ctx context.Context, req reconcile.Request) (r
 	return reconcile.Result{RequeueAfter: minDurationOrDefault(requeueAfterDurations, defaultRequeueAfter)}, nil
 }
 
+// clientConfigForCluster returns the client config for the given Cluster, i.e. the client config
+// of the ClusterCache with the overrides from ClientOptions.ClusterOverrides and from the
+// Cluster annotations applied on top. Invalid annotation values are logged and ignored.
+func (cc *clusterCache) clientConfigForCluster(ctx context.Context, cluster *clusterv1.Cluster) *clusterAccessorClientConfig {
+	log := ctrl.LoggerFrom(ctx)
+
+	var overrides ClientOverrides
+	if cc.clusterOverrides != nil {
+		overrides = cc.clusterOverrides(cluster)
+	}
+
+	annotationOverrides, err := clientOverridesFromAnnotations(cluster.GetAnnotations())
+	if err != nil {
+		log.Error(err, "Ignoring invalid ClusterCache client override annotations")
+	}
+	if annotationOverrides.Timeout != 0 {
+		overrides.Timeout = annotationOverrides.Timeout
+	}
+	if annotationOverrides.QPS != 0 {
+		overrides.QPS = annotationOverrides.QPS
+	}
+	if annotationOverrides.Burst != 0 {
+		overrides.Burst = annotationOverrides.Burst
+	}
+	if annotationOverrides.DisableNodeCaching {
+		overrides.DisableNodeCaching = true
+	}
+	if annotationOverrides.ProxyURL != nil {
+		overrides.ProxyURL = annotationOverrides.ProxyURL
+	}
+
+	return cc.clusterAccessorConfig.Client.withOverrides(overrides)
+}
+
+// healthProbeConfigForCluster returns the health probe config for the given Cluster, i.e. the health probe config
+// of the ClusterCache with the policy from HealthProbeOptions.ClusterPolicy applied on top.
+// An invalid failure action in the policy is logged and ignored.
+func (cc *clusterCache) healthProbeConfigForCluster(ctx context.Context, cluster *clusterv1.Cluster) *clusterAccessorHealthProbeConfig {
+	if cc.healthProbePolicy == nil {
+		return cc.clusterAccessorConfig.HealthProbe
+	}
+
+	policy := cc.healthProbePolicy(cluster)
+	if policy.FailureAction != "" {
+		if err := validateHealthProbeFailureAction(policy.FailureAction); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Ignoring invalid failure action of health probe policy")
+			policy.FailureAction = ""
+		}
+	}
+
+	return cc.clusterAccessorConfig.HealthProbe.withPolicy(policy)
+}
+
+// NodeCachingDisabled returns true if caching of Nodes has been disabled for the given Cluster
+// via the clustercache.cluster.x-k8s.io/disable-node-caching annotation.
+// Note: Nodes of such a Cluster must be read without relying on cache indexes, e.g. on the Node providerID index.
+func NodeCachingDisabled(cluster *clusterv1.Cluster) bool {
+	disableNodeCaching, err := strconv.ParseBool(cluster.GetAnnotations()[clusterv1.ClusterCacheDisableNodeCachingAnnotation])
+	return err == nil && disableNodeCaching
+}
+
+// clientOverridesFromAnnotations parses the ClientOverrides from the clustercache.cluster.x-k8s.io/* annotations.
+// Valid values are returned even if other annotations have invalid values.
+func clientOverridesFromAnnotations(annotations map[string]string) (ClientOverrides, error) {
+	overrides := ClientOverrides{}
+	var errs []error
+
+	if value, ok := annotations[clusterv1.ClusterCacheClientTimeoutAnnotation]; ok {
+		timeout, err := time.ParseDuration(value)
+		switch {
+		case err != nil:
+			errs = append(errs, pkgerrors.Wrapf(err, "invalid value %q for annotation %s", value, clusterv1.ClusterCacheClientTimeoutAnnotation))
+		case timeout <= 0:
+			errs = append(errs, pkgerrors.Errorf("invalid value %q for annotation %s: must be greater than 0", value, clusterv1.ClusterCacheClientTimeoutAnnotation))
+		default:
+			overrides.Timeout = timeout
+		}
+	}
+	if value, ok := annotations[clusterv1.ClusterCacheClientQPSAnnotation]; ok {
+		qps, err := strconv.ParseFloat(value, 32)
+		switch {
+		case err != nil:
+			errs = append(errs, pkgerrors.Wrapf(err, "invalid value %q for annotation %s", value, clusterv1.ClusterCacheClientQPSAnnotation))
+		case qps <= 0:
+			errs = append(errs, pkgerrors.Errorf("invalid value %q for annotation %s: must be greater than 0", value, clusterv1.ClusterCacheClientQPSAnnotation))
+		default:
+			overrides.QPS = float32(qps)
+		}
+	}
+	if value, ok := annotations[clusterv1.ClusterCacheClientBurstAnnotation]; ok {
+		burst, err := strconv.Atoi(value)
+		switch {
+		case err != nil:
+			errs = append(errs, pkgerrors.Wrapf(err, "invalid value %q for annotation %s", value, clusterv1.ClusterCacheClientBurstAnnotation))
+		case burst <= 0:
+			errs = append(errs, pkgerrors.Errorf("invalid value %q for annotation %s: must be greater than 0", value, clusterv1.ClusterCacheClientBurstAnnotation))
+		default:
+			overrides.Burst = burst
+		}
+	}
+	if value, ok := annotations[clusterv1.ClusterCacheDisableNodeCachingAnnotation]; ok {
+		disableNodeCaching, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, pkgerrors.Wrapf(err, "invalid value %q for annotation %s", value, clusterv1.ClusterCacheDisableNodeCachingAnnotation))
+		} else {
+			overrides.DisableNodeCaching = disableNodeCaching
+		}
+	}
+	if value, ok := annotations[clusterv1.ClusterCacheClientProxyURLAnnotation]; ok {
+		proxyURL, err := parseProxyURL(value)
+		if err != nil {
+			errs = append(errs, pkgerrors.Wrapf(err, "invalid value %q for annotation %s", value, clusterv1.ClusterCacheClientProxyURLAnnotation))
+		} else {
+			overrides.ProxyURL = proxyURL
+		}
+	}
+
+	return overrides, kerrors.NewAggregate(errs)
+}
+
+// parseProxyURL parses the URL of a proxy for the connections to a workload cluster.
+// Only the schemes supported both by the HTTP transport and by the port-forward dialers of client-go are allowed.
+func parseProxyURL(value string) (*url.URL, error) {
+	proxyURL, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, pkgerrors.New("scheme must be one of http, https or socks5")
+	}
+	if proxyURL.Host == "" {
+		return nil, pkgerrors.New("host must be set")
+	}
+	return proxyURL, nil
+}
+
 // getOrCreateClusterAccessor returns a clusterAccessor and creates it if it doesn't exist already.
 // Note: This intentionally does not already create a client and cache. This is later done
 // via clusterAccessor.Connect() by the ClusterCache reconciler.
@@ -640,6 +920,9 @@ func (cc *clusterCache) cleanupClusterSourcesForCluster(cluster client.ObjectKey
 func (cc *clusterCache) cleanupMetricsForCluster(cluster client.ObjectKey) {
 	healthCheck.DeleteLabelValues(cluster.Name, cluster.Namespace)
 	connectionUp.DeleteLabelValues(cluster.Name, cluster.Namespace)
+	cacheInformers.DeleteLabelValues(cluster.Name, cluster.Namespace)
+	cacheObjects.DeleteLabelValues(cluster.Name, cluster.Namespace)
+	requestDuration.DeletePartialMatch(prometheus.Labels{"cluster_name": cluster.Name, "cluster_namespace": cluster.Namespace})
 	healthChecksTotal.DeleteLabelValues(cluster.Name, cluster.Namespace, "success")
 	healthChecksTotal.DeleteLabelValues(cluster.Name, cluster.Namespace, "error")
 }
@@ -751,6 +1034,22 @@ func validateAndDefaultOptions(opts *Options) error {
 		return pkgerrors.New("options.Client.UserAgent must be set")
 	}
 
+	if opts.HealthProbe.Timeout.Nanoseconds() == 0 {
+		opts.HealthProbe.Timeout = 5 * time.Second
+	}
+	if opts.HealthProbe.Interval.Nanoseconds() == 0 {
+		opts.HealthProbe.Interval = 10 * time.Second
+	}
+	if opts.HealthProbe.FailureThreshold == 0 {
+		opts.HealthProbe.FailureThreshold = DefaultHealthProbeFailureThreshold
+	}
+	if opts.HealthProbe.FailureAction == "" {
+		opts.HealthProbe.FailureAction = HealthProbeFailureActionDisconnect
+	}
+	if err := validateHealthProbeFailureAction(opts.HealthProbe.FailureAction); err != nil {
+		return pkgerrors.Wrap(err, "options.HealthProbe.FailureAction is invalid")
+	}
+
 	return nil
 }
 
@@ -777,9 +1076,20 @@ func buildClusterAccessorConfig(scheme *runtime.Scheme, options Options, control
 			},
 		},
 		HealthProbe: &clusterAccessorHealthProbeConfig{
-			Timeout:          5 * time.Second,
-			Interval:         10 * time.Second,
-			FailureThreshold: 5,
+			Timeout:          options.HealthProbe.Timeout,
+			Interval:         options.HealthProbe.Interval,
+			FailureThreshold: options.HealthProbe.FailureThreshold,
+			FailureAction:    options.HealthProbe.FailureAction,
 		},
 	}
 }
+
+func validateHealthProbeFailureAction(action HealthProbeFailureAction) error {
+	switch action {
+	case HealthProbeFailureActionDisconnect, HealthProbeFailureActionMarkDegraded:
+		return nil
+	default:
+		return pkgerrors.Errorf("unknown failure action %q, must be one of %q or %q",
+			action, HealthProbeFailureActionDisconnect, HealthProbeFailureActionMarkDegraded)
+	}
+}
@@ -30,6 +30,12 @@ func NewFakeClusterCache(workloadClient client.Client, clusterKey client.ObjectK
 	}
 
 	testCacheTracker.clusterAccessors[clusterKey] = &clusterAccessor{
+		config: &clusterAccessorConfig{
+			HealthProbe: &clusterAccessorHealthProbeConfig{
+				FailureThreshold: DefaultHealthProbeFailureThreshold,
+				FailureAction:    HealthProbeFailureActionDisconnect,
+			},
+		},
 		lockedState: clusterAccessorLockedState{
 			connection: &clusterAccessorLockedConnectionState{
 				cachedClient:   workloadClient,
@@ -22,6 +22,7 @@ import (
 	"math"
 	"math/rand/v2"
 	"net/http"
+	"net/url"
 	"sync"
 	"testing"
 	"time"
@@ -85,6 +86,7 @@ func TestReconcile(t *testing.T) {
 			Indexes: []CacheOptionsIndex{NodeProviderIDIndex},
 		},
 	}
+	g.Expect(validateAndDefaultOptions(&opts)).To(Succeed())
 	accessorConfig := buildClusterAccessorConfig(env.GetScheme(), opts, nil)
 	cc := &clusterCache{
 		// Use APIReader to avoid cache issues when reading the Cluster object.
@@ -375,6 +377,229 @@ func TestBuildClusterAccessorConfigDefaultTransform(t *testing.T) {
 	}
 }
 
+func TestClientConfigForCluster(t *testing.T) {
+	tests := []struct {
+		name             string
+		clusterOverrides func(cluster *clusterv1.Cluster) ClientOverrides
+		annotations      map[string]string
+		wantTimeout      time.Duration
+		wantQPS          float32
+		wantBurst        int
+
+		wantDisableNodeCaching bool
+		wantProxyURL           string
+	}{
+		{
+			name:        "no overrides",
+			wantTimeout: 10 * time.Second,
+			wantQPS:     20,
+			wantBurst:   30,
+		},
+		{
+			name: "overrides from options",
+			clusterOverrides: func(cluster *clusterv1.Cluster) ClientOverrides {
+				if cluster.Name != "test-cluster" {
+					return ClientOverrides{}
+				}
+				return ClientOverrides{QPS: 100, Burst: 200}
+			},
+			wantTimeout: 10 * time.Second,
+			wantQPS:     100,
+			wantBurst:   200,
+		},
+		{
+			name: "overrides from annotations",
+			annotations: map[string]string{
+				clusterv1.ClusterCacheClientTimeoutAnnotation: "30s",
+				clusterv1.ClusterCacheClientQPSAnnotation:     "12.5",
+				clusterv1.ClusterCacheClientBurstAnnotation:   "50",
+			},
+			wantTimeout: 30 * time.Second,
+			wantQPS:     12.5,
+			wantBurst:   50,
+		},
+		{
+			name: "overrides from annotations take precedence over overrides from options",
+			clusterOverrides: func(_ *clusterv1.Cluster) ClientOverrides {
+				return ClientOverrides{Timeout: time.Minute, QPS: 100, Burst: 200}
+			},
+			annotations: map[string]string{
+				clusterv1.ClusterCacheClientQPSAnnotation: "50",
+			},
+			wantTimeout: time.Minute,
+			wantQPS:     50,
+			wantBurst:   200,
+		},
+		{
+			name: "invalid annotations are ignored",
+			annotations: map[string]string{
+				clusterv1.ClusterCacheClientTimeoutAnnotation: "-5s",
+				clusterv1.ClusterCacheClientQPSAnnotation:     "a lot",
+				clusterv1.ClusterCacheClientBurstAnnotation:   "50",
+			},
+			wantTimeout: 10 * time.Second,
+			wantQPS:     20,
+			wantBurst:   50,
+		},
+		{
+			name: "disable node caching from annotation",
+			annotations: map[string]string{
+				clusterv1.ClusterCacheDisableNodeCachingAnnotation: "true",
+			},
+			wantTimeout:            10 * time.Second,
+			wantQPS:                20,
+			wantBurst:              30,
+			wantDisableNodeCaching: true,
+		},
+		{
+			name: "disable node caching from options",
+			clusterOverrides: func(_ *clusterv1.Cluster) ClientOverrides {
+				return ClientOverrides{DisableNodeCaching: true}
+			},
+			annotations: map[string]string{
+				clusterv1.ClusterCacheDisableNodeCachingAnnotation: "no",
+			},
+			wantTimeout:            10 * time.Second,
+			wantQPS:                20,
+			wantBurst:              30,
+			wantDisableNodeCaching: true,
+		},
+		{
+			name: "proxy URL from annotation takes precedence over proxy URL from options",
+			clusterOverrides: func(_ *clusterv1.Cluster) ClientOverrides {
+				return ClientOverrides{ProxyURL: &url.URL{Scheme: "http", Host: "options-proxy:3128"}}
+			},
+			annotations: map[string]string{
+				clusterv1.ClusterCacheClientProxyURLAnnotation: "socks5://annotation-proxy:1080",
+			},
+			wantTimeout:  10 * time.Second,
+			wantQPS:      20,
+			wantBurst:    30,
+			wantProxyURL: "socks5://annotation-proxy:1080",
+		},
+		{
+			name: "invalid proxy URL annotations are ignored",
+			clusterOverrides: func(_ *clusterv1.Cluster) ClientOverrides {
+				return ClientOverrides{ProxyURL: &url.URL{Scheme: "http", Host: "options-proxy:3128"}}
+			},
+			annotations: map[string]string{
+				clusterv1.ClusterCacheClientProxyURLAnnotation: "ftp://annotation-proxy:21",
+			},
+			wantTimeout:  10 * time.Second,
+			wantQPS:      20,
+			wantBurst:    30,
+			wantProxyURL: "http://options-proxy:3128",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options := Options{
+				Client: ClientOptions{
+					Timeout:   10 * time.Second,
+					QPS:       20,
+					Burst:     30,
+					UserAgent: remote.DefaultClusterAPIUserAgent("test-controller-manager"),
+					Cache: ClientCacheOptions{
+						DisableFor: []client.Object{&corev1.ConfigMap{}},
+					},
+				},
+			}
+			cc := &clusterCache{
+				clusterAccessorConfig: buildClusterAccessorConfig(scheme.Scheme, options, nil),
+				clusterOverrides:      tt.clusterOverrides,
+			}
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-cluster",
+					Namespace:   metav1.NamespaceDefault,
+					Annotations: tt.annotations,
+				},
+			}
+
+			clientConfig := cc.clientConfigForCluster(ctx, cluster)
+			g.Expect(clientConfig.Timeout).To(Equal(tt.wantTimeout))
+			g.Expect(clientConfig.QPS).To(Equal(tt.wantQPS))
+			g.Expect(clientConfig.Burst).To(Equal(tt.wantBurst))
+			g.Expect(clientConfig.UserAgent).To(Equal(options.Client.UserAgent))
+			g.Expect(clientConfig.DisableNodeCaching).To(Equal(tt.wantDisableNodeCaching))
+			if tt.wantProxyURL == "" {
+				g.Expect(clientConfig.ProxyURL).To(BeNil())
+			} else {
+				g.Expect(clientConfig.ProxyURL.String()).To(Equal(tt.wantProxyURL))
+			}
+			g.Expect(clientConfig.Cache.DisableFor).To(Equal(options.Client.Cache.DisableFor))
+			// The client config of the ClusterCache must not be modified.
+			g.Expect(cc.clusterAccessorConfig.Client.QPS).To(Equal(float32(20)))
+		})
+	}
+}
+
+func TestHealthProbeConfigForCluster(t *testing.T) {
+	tests := []struct {
+		name                 string
+		healthProbePolicy    func(cluster *clusterv1.Cluster) HealthProbePolicy
+		wantInterval         time.Duration
+		wantFailureThreshold int
+		wantFailureAction    HealthProbeFailureAction
+	}{
+		{
+			name:                 "no policy",
+			wantInterval:         10 * time.Second,
+			wantFailureThreshold: 5,
+			wantFailureAction:    HealthProbeFailureActionDisconnect,
+		},
+		{
+			name: "policy overrides defaults",
+			healthProbePolicy: func(_ *clusterv1.Cluster) HealthProbePolicy {
+				return HealthProbePolicy{
+					Interval:      30 * time.Second,
+					FailureAction: HealthProbeFailureActionMarkDegraded,
+				}
+			},
+			wantInterval:         30 * time.Second,
+			wantFailureThreshold: 5,
+			wantFailureAction:    HealthProbeFailureActionMarkDegraded,
+		},
+		{
+			name: "invalid failure action is ignored",
+			healthProbePolicy: func(_ *clusterv1.Cluster) HealthProbePolicy {
+				return HealthProbePolicy{
+					FailureThreshold: 10,
+					FailureAction:    "Invalid",
+				}
+			},
+			wantInterval:         10 * time.Second,
+			wantFailureThreshold: 10,
+			wantFailureAction:    HealthProbeFailureActionDisconnect,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options := Options{
+				SecretClient: env.GetClient(),
+				Client: ClientOptions{
+					UserAgent: remote.DefaultClusterAPIUserAgent("test-controller-manager"),
+				},
+			}
+			g.Expect(validateAndDefaultOptions(&options)).To(Succeed())
+			cc := &clusterCache{
+				clusterAccessorConfig: buildClusterAccessorConfig(scheme.Scheme, options, nil),
+				healthProbePolicy:     tt.healthProbePolicy,
+			}
+
+			healthProbeConfig := cc.healthProbeConfigForCluster(ctx, &clusterv1.Cluster{})
+			g.Expect(healthProbeConfig.Timeout).To(Equal(5 * time.Second))
+			g.Expect(healthProbeConfig.Interval).To(Equal(tt.wantInterval))
+			g.Expect(healthProbeConfig.FailureThreshold).To(Equal(tt.wantFailureThreshold))
+			g.Expect(healthProbeConfig.FailureAction).To(Equal(tt.wantFailureAction))
+		})
+	}
+}
+
 func TestSendEventsToClusterSources(t *testing.T) {
 	now := time.Now()
 
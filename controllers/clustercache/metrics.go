@@ -26,6 +26,9 @@ func init() {
 	ctrlmetrics.Registry.MustRegister(healthCheck)
 	ctrlmetrics.Registry.MustRegister(connectionUp)
 	ctrlmetrics.Registry.MustRegister(healthChecksTotal)
+	ctrlmetrics.Registry.MustRegister(requestDuration)
+	ctrlmetrics.Registry.MustRegister(cacheInformers)
+	ctrlmetrics.Registry.MustRegister(cacheObjects)
 }
 
 var (
@@ -53,4 +56,29 @@ var (
 			"cluster_name", "cluster_namespace",
 		},
 	)
+	requestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "capi_cluster_cache_request_duration_seconds",
+			Help:    "Latency of requests to the apiserver of a cluster, excluding watch requests.",
+			Buckets: []float64{0.005, 0.025, 0.1, 0.25, 0.5, 1.0, 2.0, 4.0, 8.0, 15.0, 30.0, 60.0},
+		}, []string{
+			"cluster_name", "cluster_namespace", "verb",
+		},
+	)
+	cacheInformers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_cluster_cache_informers",
+			Help: "Number of informers in the cache of a cluster.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+	cacheObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_cluster_cache_objects",
+			Help: "Number of objects stored in the cache of a cluster.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
 )
@@ -137,6 +137,8 @@ func (r *CRDMigrator) SetupWithManager(ctx context.Context, mgr ctrl.Manager, co
 		).
 		Named("crdmigrator").
 		WithOptions(controllerOptions).
+		// CRDs are cluster-scoped, so they don't belong to the shard of a Cluster.
+		WithoutSharding().
 		Complete(ctx, r)
 	if err != nil {
 		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
@@ -45,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
@@ -68,8 +69,12 @@ import (
 	"sigs.k8s.io/cluster-api/internal/contract"
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+	"sigs.k8s.io/cluster-api/internal/util/statemetrics"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
 	"sigs.k8s.io/cluster-api/util/flags"
+	"sigs.k8s.io/cluster-api/util/tracing"
 	"sigs.k8s.io/cluster-api/version"
 )
 
@@ -97,19 +102,27 @@ var (
 	webhookCertDir              string
 	webhookCertName             string
 	webhookKeyName              string
+	webhookDenyDeprecatedFields bool
 	runtimeExtensionCertFile    string
 	runtimeExtensionKeyFile     string
 	healthAddr                  string
 	managerOptions              = flags.ManagerOptions{}
+	tracingOptions              = tracing.Options{}
 	logOptions                  = logs.NewOptions()
 	// KCP specific flags.
 	remoteConditionsGracePeriod    time.Duration
 	kubeadmControlPlaneConcurrency int
+	clusterReconcileQPS            float64
+	clusterReconcileBurst          int
+	eventDeduplicationWindow       time.Duration
+	enableStateMetrics             bool
 	clusterCacheConcurrency        int
 	skipCRDMigrationPhases         []string
 	etcdDialTimeout                time.Duration
 	etcdCallTimeout                time.Duration
 	etcdLogLevel                   string
+	etcdDBSizeWarningThreshold     int32
+	etcdSnapshotStoreAllowedHosts  []string
 )
 
 func init() {
@@ -160,6 +173,19 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&kubeadmControlPlaneConcurrency, "kubeadmcontrolplane-concurrency", 100,
 		"Number of kubeadm control planes to process simultaneously")
 
+	fs.Float64Var(&clusterReconcileQPS, "cluster-reconcile-qps", 0,
+		"Maximum number of kubeadm control plane reconciles per second per workload cluster. Set to 0 to disable rate-limiting per workload cluster")
+
+	fs.IntVar(&clusterReconcileBurst, "cluster-reconcile-burst", 10,
+		"Maximum burst of kubeadm control plane reconciles per workload cluster. Used only if --cluster-reconcile-qps is set")
+
+	fs.DurationVar(&eventDeduplicationWindow, "event-deduplication-window", 0,
+		"Window within which identical events for the same object are emitted only once by the kubeadm control plane controller. Defaults to 0, which disables event deduplication")
+
+	fs.BoolVar(&enableStateMetrics, "enable-state-metrics", false,
+		"Expose the conditions of KubeadmControlPlanes as capi_kubeadmcontrolplane_status_condition metrics on the diagnostics endpoint, "+
+			"as an alternative to configuring kube-state-metrics with the Cluster API CustomResourceStateMetrics configuration.")
+
 	fs.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
 
@@ -193,6 +219,9 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&webhookKeyName, "webhook-key-name", "tls.key",
 		"Webhook key name.")
 
+	fs.BoolVar(&webhookDenyDeprecatedFields, "webhook-deny-deprecated-fields", false,
+		"If true, validating webhooks reject requests starting to use deprecated fields instead of returning a warning.")
+
 	fs.StringVar(&runtimeExtensionCertFile, "runtime-extension-client-cert-file", "",
 		"Path of the PEM-encoded client certificate to be used when calling runtime extensions.")
 
@@ -208,11 +237,19 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&etcdCallTimeout, "etcd-call-timeout-duration", etcd.DefaultCallTimeout,
 		"Duration that the etcd client waits at most for read and write operations to etcd.")
 
+	fs.Int32Var(&etcdDBSizeWarningThreshold, "etcd-db-size-warning-threshold", 80,
+		"Percentage of the etcd database quota above which KCP surfaces a warning in the EtcdClusterHealthy condition. Set to 0 to disable the warning.")
+
+	fs.StringSliceVar(&etcdSnapshotStoreAllowedHosts, "etcd-snapshot-store-allowed-hosts", []string{},
+		"List of hosts the etcd snapshot of a hibernated KubeadmControlPlane can be uploaded to. If empty, control planes with etcd managed by KCP cannot be hibernated.")
+
 	fs.StringVar(&etcdLogLevel, "etcd-client-log-level", zapcore.InfoLevel.String(),
 		"Logging level for etcd client. Possible values are: debug, info, warn, error, dpanic, panic, fatal.")
 
 	flags.AddManagerOptions(fs, &managerOptions)
 
+	tracing.AddFlags(fs, &tracingOptions)
+
 	feature.MutableGates.AddFlag(fs)
 }
 
@@ -267,6 +304,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	if clusterReconcileQPS > 0 && clusterReconcileBurst <= 0 {
+		// With a burst lower than 1 no reconcile can ever be run, and reconciles would be requeued forever.
+		setupLog.Error(pkgerrors.Errorf("--cluster-reconcile-burst must be greater than 0 if --cluster-reconcile-qps is set"), "Unable to start manager")
+		os.Exit(1)
+	}
+
 	tlsOptions, metricsOptions, err := flags.GetManagerOptions(managerOptions)
 	if err != nil {
 		setupLog.Error(err, "Unable to start manager: invalid flags")
@@ -316,7 +359,14 @@ func main() {
 	// Setup the context that's going to be used in controllers and for the manager.
 	ctx := ctrl.SetupSignalHandler()
 
+	shutdownTracing, err := tracing.Setup(ctx, controllerName, tracingOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	setupChecks(mgr)
+	setupStateMetrics(mgr)
 	setupReconcilers(ctx, mgr)
 	setupWebhooks(ctx, mgr)
 
@@ -325,6 +375,11 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	// Flush spans that have not been exported yet.
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "failed to shut down tracing")
+	}
 }
 
 func setupChecks(mgr ctrl.Manager) {
@@ -390,7 +445,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	}
 
 	var runtimeClient runtimeclient.Client
-	if feature.Gates.Enabled(feature.InPlaceUpdates) {
+	if feature.Gates.Enabled(feature.InPlaceUpdates) || feature.Gates.Enabled(feature.RuntimeSDK) {
 		// This is the creation of the runtimeClient for the controllers, embedding a shared catalog and registry instance.
 		var certWatcher *certwatcher.CertWatcher
 		runtimeClient, certWatcher, err = internalruntimeclient.New(ctx, internalruntimeclient.Options{
@@ -424,17 +479,26 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		}
 	}
 
+	var clusterRateLimiter *capicontrollerutil.ClusterRateLimiter
+	if clusterReconcileQPS > 0 {
+		clusterRateLimiter = capicontrollerutil.NewClusterRateLimiter(clusterReconcileQPS, clusterReconcileBurst)
+	}
+
 	if err := (&kubeadmcontrolplane.Reconciler{
-		Client:                      mgr.GetClient(),
-		APIReader:                   mgr.GetAPIReader(),
-		SecretCachingClient:         secretCachingClient,
-		ClusterCache:                clusterCache,
-		WatchFilterValue:            watchFilterValue,
-		EtcdDialTimeout:             etcdDialTimeout,
-		EtcdCallTimeout:             etcdCallTimeout,
-		EtcdLogger:                  etcdLogger,
-		RemoteConditionsGracePeriod: remoteConditionsGracePeriod,
-		RuntimeClient:               runtimeClient,
+		Client:                        mgr.GetClient(),
+		APIReader:                     mgr.GetAPIReader(),
+		SecretCachingClient:           secretCachingClient,
+		ClusterCache:                  clusterCache,
+		WatchFilterValue:              watchFilterValue,
+		EtcdDialTimeout:               etcdDialTimeout,
+		EtcdCallTimeout:               etcdCallTimeout,
+		EtcdLogger:                    etcdLogger,
+		EtcdDBSizeWarningThreshold:    etcdDBSizeWarningThreshold,
+		EtcdSnapshotStoreAllowedHosts: etcdSnapshotStoreAllowedHosts,
+		RemoteConditionsGracePeriod:   remoteConditionsGracePeriod,
+		RuntimeClient:                 runtimeClient,
+		ClusterRateLimiter:            clusterRateLimiter,
+		EventDeduplicationWindow:      eventDeduplicationWindow,
 	}).SetupWithManager(ctx, mgr, controller.Options{
 		MaxConcurrentReconciles: kubeadmControlPlaneConcurrency,
 		ReconciliationTimeout:   3 * time.Minute, // increase reconciliation timeout because the KubeadmControlPlaneReconciler tries to connect with all the etcd member, and times out if those operations might sum up.
@@ -445,12 +509,18 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 }
 
 func setupWebhooks(_ context.Context, mgr ctrl.Manager) {
+	// Setup how validating webhooks handle the usage of deprecated fields.
+	deprecatedFieldsPolicy := deprecation.WarnPolicy
+	if webhookDenyDeprecatedFields {
+		deprecatedFieldsPolicy = deprecation.DenyPolicy
+	}
+
 	// Setup the func to retrieve apiVersion for a GroupKind for conversion webhooks.
 	conversion.SetAPIVersionGetter(func(ctx context.Context, gk schema.GroupKind) (string, error) {
 		return contract.GetAPIVersion(ctx, mgr.GetClient(), gk)
 	})
 
-	if err := (&controlplaneadmission.KubeadmControlPlane{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&controlplaneadmission.KubeadmControlPlane{DeprecatedFieldsPolicy: deprecatedFieldsPolicy}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "KubeadmControlPlane")
 		os.Exit(1)
 	}
@@ -462,12 +532,22 @@ func setupWebhooks(_ context.Context, mgr ctrl.Manager) {
 		os.Exit(1)
 	}
 
-	if err := (&controlplaneadmission.KubeadmControlPlaneTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&controlplaneadmission.KubeadmControlPlaneTemplate{DeprecatedFieldsPolicy: deprecatedFieldsPolicy}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "KubeadmControlPlaneTemplate")
 		os.Exit(1)
 	}
 }
 
+func setupStateMetrics(mgr ctrl.Manager) {
+	if !enableStateMetrics {
+		return
+	}
+
+	ctrlmetrics.Registry.MustRegister(statemetrics.NewCollector(mgr.GetCache(),
+		statemetrics.Resource{Kind: "KubeadmControlPlane", List: &controlplanev1.KubeadmControlPlaneList{}},
+	))
+}
+
 func concurrency(c int) controller.Options {
 	return controller.Options{MaxConcurrentReconciles: c}
 }
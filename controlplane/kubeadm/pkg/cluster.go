@@ -58,6 +58,10 @@ type Management struct {
 	EtcdCallTimeout     time.Duration
 	EtcdLogger          *zap.Logger
 	ClientCertCache     cache.Cache[ClientCertEntry]
+
+	// EtcdDBSizeWarningThreshold is the percentage of the etcd database quota above which
+	// a warning is surfaced in the EtcdClusterHealthy condition; 0 disables the warning.
+	EtcdDBSizeWarningThreshold int32
 }
 
 // ClientCertEntry is an Entry for the Cache that stores the client cert.
@@ -179,10 +183,11 @@ func (m *Management) GetWorkloadCluster(ctx context.Context, cluster *clusterv1.
 	}
 	tlsConfig.InsecureSkipVerify = true
 	return &Workload{
-		restConfig:          restConfig,
-		Client:              c,
-		CoreDNSMigrator:     &CoreDNSMigrator{},
-		etcdClientGenerator: NewEtcdClientGenerator(restConfig, tlsConfig, m.EtcdDialTimeout, m.EtcdCallTimeout, m.EtcdLogger),
+		restConfig:                 restConfig,
+		Client:                     c,
+		CoreDNSMigrator:            &CoreDNSMigrator{},
+		etcdClientGenerator:        NewEtcdClientGenerator(clusterKey, restConfig, tlsConfig, m.EtcdDialTimeout, m.EtcdCallTimeout, m.EtcdLogger),
+		etcdDBSizeWarningThreshold: m.EtcdDBSizeWarningThreshold,
 	}, nil
 }
 
@@ -275,7 +275,20 @@ func (c *ControlPlane) GetKubeadmConfig(machineName string) (*bootstrapv1.Kubead
 // MachinesNeedingRollout return a list of machines that need to be rolled out.
 func (c *ControlPlane) MachinesNeedingRollout() (collections.Machines, map[string]UpToDateResult) {
 	// Note: Machines already deleted are dropped because they will be replaced by new machines after deletion completes.
-	return c.MachinesNotUpToDate.Filter(collections.Not(collections.HasDeletionTimestamp)), c.machinesUpToDateResults
+	// Note: Machines on hold are dropped because their rollout is managed externally.
+	return c.MachinesNotUpToDate.Filter(
+		collections.Not(collections.HasDeletionTimestamp),
+		collections.Not(collections.HasAnnotationKey(controlplanev1.MachineRolloutHoldAnnotation)),
+	), c.machinesUpToDateResults
+}
+
+// MachinesWithRolloutOnHold returns the list of machines that are not up to date but are skipped during
+// rollouts because they have the rollout hold annotation.
+func (c *ControlPlane) MachinesWithRolloutOnHold() collections.Machines {
+	return c.MachinesNotUpToDate.Filter(
+		collections.Not(collections.HasDeletionTimestamp),
+		collections.HasAnnotationKey(controlplanev1.MachineRolloutHoldAnnotation),
+	)
 }
 
 // NotUpToDateMachines return a list of machines that are not up to date with the control
@@ -149,6 +149,24 @@ func TestControlPlane(t *testing.T) {
 	})
 }
 
+func TestMachinesWithRolloutOnHold(t *testing.T) {
+	g := NewWithT(t)
+
+	notUpToDate := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m1"}}
+	notUpToDateOnHold := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m2", Annotations: map[string]string{controlplanev1.MachineRolloutHoldAnnotation: ""}}}
+	notUpToDateOnHoldDeleting := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m3", Annotations: map[string]string{controlplanev1.MachineRolloutHoldAnnotation: ""}, DeletionTimestamp: ptr.To(metav1.Now())}}
+	upToDateOnHold := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m4", Annotations: map[string]string{controlplanev1.MachineRolloutHoldAnnotation: ""}}}
+
+	controlPlane := &ControlPlane{
+		Machines:            collections.FromMachines(notUpToDate, notUpToDateOnHold, notUpToDateOnHoldDeleting, upToDateOnHold),
+		MachinesNotUpToDate: collections.FromMachines(notUpToDate, notUpToDateOnHold, notUpToDateOnHoldDeleting),
+	}
+
+	machinesNeedingRollout, _ := controlPlane.MachinesNeedingRollout()
+	g.Expect(machinesNeedingRollout.Names()).To(ConsistOf("m1"))
+	g.Expect(controlPlane.MachinesWithRolloutOnHold().Names()).To(ConsistOf("m2"))
+}
+
 func TestHasMachinesToBeRemediated(t *testing.T) {
 	// healthy machine (without MachineHealthCheckSucceded condition)
 	healthyMachineNotProvisioned := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "healthyMachine1"}}
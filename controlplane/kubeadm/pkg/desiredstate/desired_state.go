@@ -177,6 +177,13 @@ func ComputeDesiredMachine(kcp *controlplanev1.KubeadmControlPlane, cluster *clu
 		desiredMachine.Spec.Bootstrap.ConfigRef = existingMachine.Spec.Bootstrap.ConfigRef
 	}
 
+	// Set placement hints; like the failure domain they are only computed on create and never updated.
+	if existingMachine != nil {
+		desiredMachine.Spec.PlacementHints = existingMachine.Spec.PlacementHints
+	} else if spreadConstraints := kcp.Spec.MachineTemplate.Spec.SpreadConstraints; spreadConstraints != (clusterv1.MachineSpreadConstraints{}) {
+		desiredMachine.Spec.PlacementHints = spreadConstraints.PlacementHints(failureDomain, kcp.Name)
+	}
+
 	// Set machines readiness gates
 	allReadinessGates := []clusterv1.MachineReadinessGate{}
 	allReadinessGates = append(allReadinessGates, MandatoryMachineReadinessGates...)
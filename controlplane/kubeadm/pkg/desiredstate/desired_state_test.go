@@ -577,6 +577,46 @@ func Test_ComputeDesiredMachine(t *testing.T) {
 	}
 }
 
+func Test_ComputeDesiredMachinePlacementHints(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "testCluster", Namespace: metav1.NamespaceDefault}}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "testControlPlane", Namespace: metav1.NamespaceDefault},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.30.0",
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				Spec: controlplanev1.KubeadmControlPlaneMachineTemplateSpec{
+					SpreadConstraints: clusterv1.MachineSpreadConstraints{HostGroup: "hg1"},
+				},
+			},
+		},
+	}
+
+	// New Machines get placement hints computed from the failure domain and the spread constraints.
+	desiredMachine, err := ComputeDesiredMachine(kcp, cluster, "fd1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(desiredMachine.Spec.PlacementHints).To(Equal(clusterv1.MachinePlacementHints{
+		Zone:      "fd1",
+		HostGroup: "hg1",
+		SpreadKey: "testControlPlane",
+	}))
+
+	// Existing Machines keep their placement hints.
+	existingMachine := desiredMachine.DeepCopy()
+	existingMachine.Spec.PlacementHints = clusterv1.MachinePlacementHints{Zone: "fd2"}
+	kcp.Spec.MachineTemplate.Spec.SpreadConstraints.SpreadKey = "control-plane"
+	desiredMachine, err = ComputeDesiredMachine(kcp, cluster, "fd2", existingMachine)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(desiredMachine.Spec.PlacementHints).To(Equal(clusterv1.MachinePlacementHints{Zone: "fd2"}))
+
+	// No placement hints are set without spread constraints.
+	kcp.Spec.MachineTemplate.Spec.SpreadConstraints = clusterv1.MachineSpreadConstraints{}
+	desiredMachine, err = ComputeDesiredMachine(kcp, cluster, "fd1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(desiredMachine.Spec.PlacementHints).To(BeZero())
+}
+
 func Test_ComputeDesiredKubeadmConfig(t *testing.T) {
 	g := NewWithT(t)
 
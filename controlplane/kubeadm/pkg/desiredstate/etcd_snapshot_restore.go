@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+const (
+	// EtcdSnapshotHeadersSecretKey is the key of the Secret holding the HTTP headers to send when uploading
+	// and downloading the etcd snapshot, one per line in the "Name: value" format.
+	EtcdSnapshotHeadersSecretKey = "headers"
+
+	etcdSnapshotDir               = "/var/lib/etcd-snapshot"
+	etcdSnapshotFilePath          = etcdSnapshotDir + "/snapshot.db"
+	etcdSnapshotHeadersFilePath   = etcdSnapshotDir + "/headers"
+	etcdSnapshotRestoreScriptPath = etcdSnapshotDir + "/restore.sh"
+	etcdSnapshotRestoreCommand    = "/bin/sh " + etcdSnapshotRestoreScriptPath
+
+	// etcdDataDirPreflightError is the kubeadm preflight check failing when the etcd data dir is not empty,
+	// which is expected after restoring the etcd snapshot.
+	etcdDataDirPreflightError = "DirAvailable--var-lib-etcd"
+)
+
+// etcdSnapshotRestoreScript downloads the etcd snapshot, verifies its digest and restores it into the etcd data dir
+// before kubeadm init starts etcd.
+// Note: etcd member name and peer URL must match the ones kubeadm is going to use for the local etcd member, i.e.
+// the name of the Node and the address the Node advertises, so they are read from the etcd static Pod manifest
+// rendered by kubeadm with the same configuration used by kubeadm init. The manifest and the empty etcd data dir
+// created by kubeadm are then removed, so neither etcdutl nor kubeadm init are affected by them.
+const etcdSnapshotRestoreScript = `#!/bin/sh
+set -e
+kubeadm init phase etcd local --config /run/kubeadm/kubeadm.yaml
+MEMBER_NAME="$(sed -n 's/^ *- --name=\(.*\)$/\1/p' /etc/kubernetes/manifests/etcd.yaml)"
+PEER_URL="$(sed -n 's/^ *- --initial-advertise-peer-urls=\(.*\)$/\1/p' /etc/kubernetes/manifests/etcd.yaml)"
+rm -f /etc/kubernetes/manifests/etcd.yaml
+rmdir /var/lib/etcd 2>/dev/null || true
+if [ -z "${MEMBER_NAME}" ] || [ -z "${PEER_URL}" ]; then
+  echo "failed to read etcd member name and peer URL from the etcd manifest rendered by kubeadm" >&2
+  exit 1
+fi
+curl -fsSL --retry 5 %[1]s-o %[2]s.gz %[3]s
+echo "%[4]s  %[2]s.gz" | sha256sum -c -
+gunzip -f %[2]s.gz
+etcdutl snapshot restore %[2]s \
+  --data-dir /var/lib/etcd \
+  --name "${MEMBER_NAME}" \
+  --initial-cluster "${MEMBER_NAME}=${PEER_URL}" \
+  --initial-advertise-peer-urls "${PEER_URL}"
+rm -f %[2]s %[5]s
+`
+
+// EtcdSnapshot defines the etcd snapshot to restore.
+type EtcdSnapshot struct {
+	// URL is the URL to download the gzip compressed etcd snapshot from.
+	URL string
+
+	// SHA256 is the hex encoded sha256 digest of the gzip compressed etcd snapshot.
+	SHA256 string
+
+	// HeadersSecretName is the name of the Secret with the HTTP headers to send when downloading
+	// the etcd snapshot, if any.
+	HeadersSecretName string
+}
+
+// AddEtcdSnapshotRestore adds to a KubeadmConfigSpec for kubeadm init the files and the commands required
+// to download the given etcd snapshot and to restore etcd from it.
+// Note: The etcd snapshot is downloaded by the machine, so it is not embedded in the bootstrap data.
+func AddEtcdSnapshotRestore(spec *bootstrapv1.KubeadmConfigSpec, snapshot EtcdSnapshot) {
+	headersArg := ""
+	if snapshot.HeadersSecretName != "" {
+		spec.Files = append(spec.Files, bootstrapv1.File{
+			Path:        etcdSnapshotHeadersFilePath,
+			Owner:       "root:root",
+			Permissions: "0600",
+			ContentFrom: bootstrapv1.FileSource{
+				Secret: bootstrapv1.SecretFileSource{
+					Name: snapshot.HeadersSecretName,
+					Key:  EtcdSnapshotHeadersSecretKey,
+				},
+			},
+		})
+		headersArg = "-H @" + etcdSnapshotHeadersFilePath + " "
+	}
+	spec.Files = append(spec.Files, bootstrapv1.File{
+		Path:        etcdSnapshotRestoreScriptPath,
+		Owner:       "root:root",
+		Permissions: "0700",
+		Content: fmt.Sprintf(etcdSnapshotRestoreScript,
+			headersArg,
+			etcdSnapshotFilePath,
+			shellQuote(snapshot.URL),
+			snapshot.SHA256,
+			etcdSnapshotHeadersFilePath),
+	})
+	spec.PreKubeadmCommands = append(spec.PreKubeadmCommands, etcdSnapshotRestoreCommand)
+	if !slices.Contains(spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors, etcdDataDirPreflightError) {
+		spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors = append(spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors, etcdDataDirPreflightError)
+	}
+}
+
+// shellQuote quotes a string so it is passed as a single argument to a shell command.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// HasEtcdSnapshotRestore returns true if the KubeadmConfigSpec restores etcd from a snapshot.
+func HasEtcdSnapshotRestore(spec *bootstrapv1.KubeadmConfigSpec) bool {
+	return slices.Contains(spec.PreKubeadmCommands, etcdSnapshotRestoreCommand)
+}
+
+// RemoveEtcdSnapshotRestore removes from a KubeadmConfigSpec the files and the commands added by AddEtcdSnapshotRestore.
+func RemoveEtcdSnapshotRestore(spec *bootstrapv1.KubeadmConfigSpec) {
+	spec.Files = slices.DeleteFunc(spec.Files, func(f bootstrapv1.File) bool {
+		return f.Path == etcdSnapshotHeadersFilePath || f.Path == etcdSnapshotRestoreScriptPath
+	})
+	if len(spec.Files) == 0 {
+		spec.Files = nil
+	}
+	spec.PreKubeadmCommands = slices.DeleteFunc(spec.PreKubeadmCommands, func(c string) bool {
+		return c == etcdSnapshotRestoreCommand
+	})
+	if len(spec.PreKubeadmCommands) == 0 {
+		spec.PreKubeadmCommands = nil
+	}
+	spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors = slices.DeleteFunc(spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors, func(e string) bool {
+		return e == etcdDataDirPreflightError
+	})
+	if len(spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors) == 0 {
+		spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors = nil
+	}
+}
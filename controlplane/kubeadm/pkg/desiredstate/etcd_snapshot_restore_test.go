@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+func TestEtcdSnapshotRestore(t *testing.T) {
+	t.Run("adds files, commands and preflight errors required to restore etcd", func(t *testing.T) {
+		g := NewWithT(t)
+
+		spec := &bootstrapv1.KubeadmConfigSpec{}
+		AddEtcdSnapshotRestore(spec, EtcdSnapshot{
+			URL:    "https://storage.example.com/foo/etcd-snapshot.db.gz?sig=a'b",
+			SHA256: "0123456789abcdef",
+		})
+
+		g.Expect(HasEtcdSnapshotRestore(spec)).To(BeTrue())
+		g.Expect(spec.Files).To(HaveLen(1))
+		g.Expect(spec.Files[0].Path).To(Equal(etcdSnapshotRestoreScriptPath))
+		g.Expect(spec.Files[0].Content).To(ContainSubstring("kubeadm init phase etcd local --config /run/kubeadm/kubeadm.yaml"))
+		g.Expect(spec.Files[0].Content).To(ContainSubstring(`curl -fsSL --retry 5 -o /var/lib/etcd-snapshot/snapshot.db.gz 'https://storage.example.com/foo/etcd-snapshot.db.gz?sig=a'\''b'`))
+		g.Expect(spec.Files[0].Content).To(ContainSubstring(`echo "0123456789abcdef  /var/lib/etcd-snapshot/snapshot.db.gz" | sha256sum -c -`))
+		g.Expect(spec.Files[0].Content).To(ContainSubstring("etcdutl snapshot restore " + etcdSnapshotFilePath))
+		g.Expect(spec.Files[0].Content).To(ContainSubstring(`--initial-cluster "${MEMBER_NAME}=${PEER_URL}"`))
+		g.Expect(spec.PreKubeadmCommands).To(Equal([]string{etcdSnapshotRestoreCommand}))
+		g.Expect(spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors).To(Equal([]string{etcdDataDirPreflightError}))
+	})
+
+	t.Run("adds the HTTP headers used to download the etcd snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		spec := &bootstrapv1.KubeadmConfigSpec{}
+		AddEtcdSnapshotRestore(spec, EtcdSnapshot{
+			URL:               "https://storage.example.com/foo/etcd-snapshot.db.gz",
+			SHA256:            "0123456789abcdef",
+			HeadersSecretName: "foo-etcd-snapshot-headers",
+		})
+
+		g.Expect(spec.Files).To(HaveLen(2))
+		g.Expect(spec.Files[0].Path).To(Equal(etcdSnapshotHeadersFilePath))
+		g.Expect(spec.Files[0].ContentFrom.Secret).To(Equal(bootstrapv1.SecretFileSource{Name: "foo-etcd-snapshot-headers", Key: EtcdSnapshotHeadersSecretKey}))
+		g.Expect(spec.Files[1].Path).To(Equal(etcdSnapshotRestoreScriptPath))
+		g.Expect(spec.Files[1].Content).To(ContainSubstring("curl -fsSL --retry 5 -H @" + etcdSnapshotHeadersFilePath + " -o "))
+	})
+
+	t.Run("does not add the preflight error twice", func(t *testing.T) {
+		g := NewWithT(t)
+
+		spec := &bootstrapv1.KubeadmConfigSpec{
+			InitConfiguration: bootstrapv1.InitConfiguration{
+				NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+					IgnorePreflightErrors: []string{etcdDataDirPreflightError},
+				},
+			},
+		}
+		AddEtcdSnapshotRestore(spec, EtcdSnapshot{URL: "https://storage.example.com/foo/etcd-snapshot.db.gz", SHA256: "0123456789abcdef", HeadersSecretName: "foo-etcd-snapshot-headers"})
+
+		g.Expect(spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors).To(Equal([]string{etcdDataDirPreflightError}))
+	})
+
+	t.Run("remove drops everything added to restore etcd and preserves the rest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		spec := &bootstrapv1.KubeadmConfigSpec{
+			Files: []bootstrapv1.File{
+				{Path: "/etc/foo", Content: "foo"},
+			},
+			PreKubeadmCommands: []string{"echo foo"},
+			InitConfiguration: bootstrapv1.InitConfiguration{
+				NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+					IgnorePreflightErrors: []string{"foo"},
+				},
+			},
+		}
+		original := spec.DeepCopy()
+
+		AddEtcdSnapshotRestore(spec, EtcdSnapshot{URL: "https://storage.example.com/foo/etcd-snapshot.db.gz", SHA256: "0123456789abcdef", HeadersSecretName: "foo-etcd-snapshot-headers"})
+		RemoveEtcdSnapshotRestore(spec)
+
+		g.Expect(HasEtcdSnapshotRestore(spec)).To(BeFalse())
+		g.Expect(spec).To(Equal(original))
+	})
+}
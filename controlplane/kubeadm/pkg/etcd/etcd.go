@@ -19,6 +19,7 @@ package etcd
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"net"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/proxy"
@@ -44,6 +46,7 @@ type etcd interface {
 	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
 	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
 	MoveLeader(ctx context.Context, id uint64) (*clientv3.MoveLeaderResponse, error)
+	Snapshot(ctx context.Context) (io.ReadCloser, error)
 	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
 }
 
@@ -53,6 +56,19 @@ type Client struct {
 	Endpoint    string
 	LeaderID    uint64
 	CallTimeout time.Duration
+
+	// MemberID is the ID of the member the client is connected to.
+	MemberID uint64
+
+	// DBSize is the size of the backend database of the member the client is connected to, in bytes.
+	DBSize int64
+
+	// DBSizeInUse is the size of the backend database of the member the client is connected to logically in use, in bytes.
+	DBSizeInUse int64
+
+	// DBSizeQuota is the backend database quota of the member the client is connected to, in bytes.
+	// Note: this value is reported only by etcd >= v3.6; it is 0 for older versions.
+	DBSizeQuota int64
 }
 
 // MemberAlarm represents an alarm type association with a cluster member.
@@ -133,6 +149,8 @@ func pbMemberToMember(m *etcdserverpb.Member) *Member {
 
 // ClientConfiguration describes the configuration for an etcd client.
 type ClientConfiguration struct {
+	// Cluster is the Cluster the etcd member belongs to; it is used to label the etcd request metrics.
+	Cluster     types.NamespacedName
 	Endpoint    string
 	Proxy       proxy.Proxy
 	TLSConfig   *tls.Config
@@ -166,7 +184,7 @@ func NewClient(ctx context.Context, config ClientConfiguration) (*Client, error)
 		callTimeout = DefaultCallTimeout
 	}
 
-	client, err := newEtcdClient(ctx, etcdClient, callTimeout)
+	client, err := newEtcdClient(ctx, &instrumentedEtcd{etcd: etcdClient, cluster: config.Cluster, member: config.Endpoint}, callTimeout)
 	if err != nil {
 		closeErr := etcdClient.Close()
 		return nil, kerrors.NewAggregate([]error{err, closeErr})
@@ -196,6 +214,10 @@ func newEtcdClient(ctx context.Context, etcdClient etcd, callTimeout time.Durati
 		EtcdClient:  etcdClient,
 		LeaderID:    status.Leader,
 		CallTimeout: callTimeout,
+		MemberID:    status.Header.GetMemberId(),
+		DBSize:      status.DbSize,
+		DBSizeInUse: status.DbSizeInUse,
+		DBSizeQuota: status.DbSizeQuota,
 	}, nil
 }
 
@@ -243,6 +265,24 @@ func (c *Client) RemoveMember(ctx context.Context, id uint64) error {
 	return pkgerrors.Wrapf(err, "failed to remove etcd member: %v", id)
 }
 
+// Snapshot returns a snapshot of the backend database of the member the client is connected to.
+func (c *Client) Snapshot(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, pkgerrors.New("call timeout expired"))
+	defer cancel()
+
+	snapshot, err := c.EtcdClient.Snapshot(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to get etcd snapshot")
+	}
+	defer snapshot.Close()
+
+	data, err := io.ReadAll(snapshot)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to read etcd snapshot")
+	}
+	return data, nil
+}
+
 // Alarms retrieves all alarms on a cluster.
 func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, pkgerrors.New("call timeout expired"))
@@ -263,3 +303,42 @@ func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 
 	return memberAlarms, nil
 }
+
+// MemberStatus is the status of the backend database of an etcd member.
+type MemberStatus struct {
+	// DBSize is the size of the backend database of the member, in bytes.
+	DBSize int64
+
+	// DBSizeInUse is the size of the backend database of the member logically in use, in bytes.
+	DBSizeInUse int64
+
+	// DBSizeQuota is the backend database quota of the member, in bytes.
+	// Note: this value is reported only by etcd >= v3.6; it is 0 for older versions.
+	DBSizeQuota int64
+}
+
+// MemberStatus retrieves the status of the member served at the given endpoint.
+// Note: the status of the member the client is connected to is read when the client is created, so it is not fetched again.
+func (c *Client) MemberStatus(ctx context.Context, endpoint string) (*MemberStatus, error) {
+	if endpoint == c.Endpoint {
+		return &MemberStatus{
+			DBSize:      c.DBSize,
+			DBSizeInUse: c.DBSizeInUse,
+			DBSizeQuota: c.DBSizeQuota,
+		}, nil
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, pkgerrors.New("call timeout expired"))
+	defer cancel()
+
+	status, err := c.EtcdClient.Status(ctx, endpoint)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get etcd status for %s", endpoint)
+	}
+
+	return &MemberStatus{
+		DBSize:      status.DbSize,
+		DBSizeInUse: status.DbSizeInUse,
+		DBSizeQuota: status.DbSizeQuota,
+	}, nil
+}
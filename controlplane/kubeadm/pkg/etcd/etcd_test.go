@@ -21,8 +21,10 @@ import (
 
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	etcdfake "sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd/fake"
@@ -95,3 +97,109 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 	err = client.RemoveMember(ctx, 1234)
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestEtcdClient_DBSize(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeEtcdClient := &etcdfake.FakeEtcdClient{
+		EtcdEndpoints: []string{"https://etcd-instance:2379"},
+		StatusResponse: &clientv3.StatusResponse{
+			Header:      &etcdserverpb.ResponseHeader{MemberId: 1234},
+			Leader:      1234,
+			DbSize:      2048,
+			DbSizeInUse: 1024,
+			DbSizeQuota: 4096,
+		},
+	}
+
+	client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(client.MemberID).To(Equal(uint64(1234)))
+	g.Expect(client.DBSize).To(Equal(int64(2048)))
+	g.Expect(client.DBSizeInUse).To(Equal(int64(1024)))
+	g.Expect(client.DBSizeQuota).To(Equal(int64(4096)))
+}
+
+func TestEtcdClient_MemberStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeEtcdClient := &etcdfake.FakeEtcdClient{
+		EtcdEndpoints: []string{"etcd-node-1"},
+		StatusResponse: &clientv3.StatusResponse{
+			Header:      &etcdserverpb.ResponseHeader{MemberId: 1234},
+			DbSize:      2048,
+			DbSizeInUse: 1024,
+			DbSizeQuota: 4096,
+		},
+	}
+
+	client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The status of the member the client is connected to is not fetched again.
+	fakeEtcdClient.StatusResponse = &clientv3.StatusResponse{DbSize: 512, DbSizeInUse: 256}
+	status, err := client.MemberStatus(ctx, "etcd-node-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*status).To(Equal(MemberStatus{DBSize: 2048, DBSizeInUse: 1024, DBSizeQuota: 4096}))
+
+	status, err = client.MemberStatus(ctx, "etcd-node-2")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*status).To(Equal(MemberStatus{DBSize: 512, DBSizeInUse: 256}))
+
+	fakeEtcdClient.StatusError = pkgerrors.New("failed to get status")
+	_, err = client.MemberStatus(ctx, "etcd-node-2")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestEtcdClient_Snapshot(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeEtcdClient := &etcdfake.FakeEtcdClient{
+		EtcdEndpoints:    []string{"https://etcd-instance:2379"},
+		StatusResponse:   &clientv3.StatusResponse{},
+		SnapshotResponse: []byte("snapshot"),
+	}
+
+	client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	snapshot, err := client.Snapshot(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshot).To(Equal([]byte("snapshot")))
+
+	fakeEtcdClient.SnapshotError = pkgerrors.New("failed to get snapshot")
+	_, err = client.Snapshot(ctx)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestEtcdClient_Metrics(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := types.NamespacedName{Namespace: "default", Name: "metrics-cluster"}
+	fakeEtcdClient := &etcdfake.FakeEtcdClient{
+		EtcdEndpoints:      []string{"https://etcd-instance:2379"},
+		StatusResponse:     &clientv3.StatusResponse{},
+		MemberListResponse: &clientv3.MemberListResponse{Header: &etcdserverpb.ResponseHeader{}},
+		MoveLeaderError:    pkgerrors.New("something went wrong"),
+	}
+
+	client, err := newEtcdClient(ctx, &instrumentedEtcd{etcd: fakeEtcdClient, cluster: cluster, member: "etcd-node-1"}, DefaultCallTimeout)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = client.Members(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.MoveLeader(ctx, 1)).ToNot(Succeed())
+	_, err = client.MemberStatus(ctx, "etcd-node-2")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Status requests are reported for the member they are sent to.
+	g.Expect(testutil.CollectAndCount(requestDuration)).To(Equal(4))
+	g.Expect(requestDuration.DeleteLabelValues(cluster.Name, cluster.Namespace, "etcd-node-2", "Status")).To(BeTrue())
+	g.Expect(testutil.ToFloat64(requestErrors.WithLabelValues(cluster.Name, cluster.Namespace, "etcd-node-1", "MoveLeader"))).To(Equal(float64(1)))
+	g.Expect(testutil.CollectAndCount(requestErrors)).To(Equal(1))
+
+	DeleteMetricsForCluster(cluster)
+	g.Expect(testutil.CollectAndCount(requestDuration)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(requestErrors)).To(Equal(0))
+}
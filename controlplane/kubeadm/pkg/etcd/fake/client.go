@@ -18,7 +18,9 @@ limitations under the License.
 package fake
 
 import (
+	"bytes"
 	"context"
+	"io"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
@@ -38,6 +40,9 @@ type FakeEtcdClient struct { //nolint:revive
 	MoveLeaderResponse *clientv3.MoveLeaderResponse
 	MoveLeaderError    error
 
+	SnapshotResponse []byte
+	SnapshotError    error
+
 	StatusResponse *clientv3.StatusResponse
 	StatusError    error
 
@@ -69,6 +74,12 @@ func (c *FakeEtcdClient) MemberRemove(_ context.Context, i uint64) (*clientv3.Me
 	c.RemovedMember = i
 	return c.MemberRemoveResponse, c.MemberRemoveError
 }
+func (c *FakeEtcdClient) Snapshot(_ context.Context) (io.ReadCloser, error) {
+	if c.SnapshotError != nil {
+		return nil, c.SnapshotError
+	}
+	return io.NopCloser(bytes.NewReader(c.SnapshotResponse)), nil
+}
 func (c *FakeEtcdClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
 	return c.StatusResponse, c.StatusError
 }
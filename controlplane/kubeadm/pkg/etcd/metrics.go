@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(requestDuration)
+	ctrlmetrics.Registry.MustRegister(requestErrors)
+}
+
+var (
+	requestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "capi_kubeadmcontrolplane_etcd_request_duration_seconds",
+			Help:    "Latency of requests to an etcd member, including requests that failed.",
+			Buckets: []float64{0.005, 0.025, 0.1, 0.25, 0.5, 1.0, 2.0, 4.0, 8.0, 15.0, 30.0, 60.0},
+		}, []string{
+			"cluster_name", "cluster_namespace", "member", "operation",
+		},
+	)
+	requestErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "capi_kubeadmcontrolplane_etcd_request_errors_total",
+			Help: "Total number of failed requests to an etcd member.",
+		}, []string{
+			"cluster_name", "cluster_namespace", "member", "operation",
+		},
+	)
+)
+
+// DeleteMetricsForCluster deletes the etcd request metrics reported for a cluster.
+func DeleteMetricsForCluster(cluster types.NamespacedName) {
+	labels := prometheus.Labels{"cluster_name": cluster.Name, "cluster_namespace": cluster.Namespace}
+	requestDuration.DeletePartialMatch(labels)
+	requestErrors.DeletePartialMatch(labels)
+}
+
+// instrumentedEtcd wraps an etcd client reporting latency and errors of the requests to the etcd member
+// it is connected to.
+type instrumentedEtcd struct {
+	etcd
+
+	cluster types.NamespacedName
+	member  string
+}
+
+var _ etcd = &instrumentedEtcd{}
+
+func (c *instrumentedEtcd) observe(operation string, start time.Time, err error) {
+	c.observeMember(c.member, operation, start, err)
+}
+
+func (c *instrumentedEtcd) observeMember(member, operation string, start time.Time, err error) {
+	requestDuration.WithLabelValues(c.cluster.Name, c.cluster.Namespace, member, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		requestErrors.WithLabelValues(c.cluster.Name, c.cluster.Namespace, member, operation).Inc()
+	}
+}
+
+func (c *instrumentedEtcd) AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error) {
+	start := time.Now()
+	resp, err := c.etcd.AlarmList(ctx)
+	c.observe("AlarmList", start, err)
+	return resp, err
+}
+
+func (c *instrumentedEtcd) MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	start := time.Now()
+	resp, err := c.etcd.MemberList(ctx, opts...)
+	c.observe("MemberList", start, err)
+	return resp, err
+}
+
+func (c *instrumentedEtcd) MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error) {
+	start := time.Now()
+	resp, err := c.etcd.MemberRemove(ctx, id)
+	c.observe("MemberRemove", start, err)
+	return resp, err
+}
+
+func (c *instrumentedEtcd) MoveLeader(ctx context.Context, id uint64) (*clientv3.MoveLeaderResponse, error) {
+	start := time.Now()
+	resp, err := c.etcd.MoveLeader(ctx, id)
+	c.observe("MoveLeader", start, err)
+	return resp, err
+}
+
+// Snapshot reports the latency of opening the snapshot stream; reading the snapshot is not included.
+func (c *instrumentedEtcd) Snapshot(ctx context.Context) (io.ReadCloser, error) {
+	start := time.Now()
+	resp, err := c.etcd.Snapshot(ctx)
+	c.observe("Snapshot", start, err)
+	return resp, err
+}
+
+// Status reports the request for the member served at the given endpoint, which is not necessarily the member
+// the client is connected to.
+func (c *instrumentedEtcd) Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error) {
+	start := time.Now()
+	resp, err := c.etcd.Status(ctx, endpoint)
+	c.observeMember(endpoint, "Status", start, err)
+	return resp, err
+}
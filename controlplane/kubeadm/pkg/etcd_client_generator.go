@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/proxy"
@@ -41,8 +42,8 @@ type EtcdClientGenerator struct {
 
 type clientCreator func(ctx context.Context, endpoint string) (*etcd.Client, error)
 
-// NewEtcdClientGenerator returns a new etcdClientGenerator instance.
-func NewEtcdClientGenerator(restConfig *rest.Config, tlsConfig *tls.Config, etcdDialTimeout, etcdCallTimeout time.Duration, etcdLogger *zap.Logger) *EtcdClientGenerator {
+// NewEtcdClientGenerator returns a new etcdClientGenerator instance for the given Cluster.
+func NewEtcdClientGenerator(cluster client.ObjectKey, restConfig *rest.Config, tlsConfig *tls.Config, etcdDialTimeout, etcdCallTimeout time.Duration, etcdLogger *zap.Logger) *EtcdClientGenerator {
 	ecg := &EtcdClientGenerator{restConfig: restConfig, tlsConfig: tlsConfig}
 
 	ecg.createClient = func(ctx context.Context, endpoint string) (*etcd.Client, error) {
@@ -53,6 +54,7 @@ func NewEtcdClientGenerator(restConfig *rest.Config, tlsConfig *tls.Config, etcd
 			Port:       2379,
 		}
 		return etcd.NewClient(ctx, etcd.ClientConfiguration{
+			Cluster:     cluster,
 			Endpoint:    endpoint,
 			Proxy:       p,
 			TLSConfig:   tlsConfig,
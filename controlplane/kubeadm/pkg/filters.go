@@ -90,6 +90,14 @@ func UpToDate(
 		res.EligibleForInPlaceUpdate = false
 	}
 
+	// Machines created before the current phase of a CA rotation started, and thus not using the CA certificates
+	// of the current phase.
+	if kcp.Status.CARotation.Phase != "" && machine.CreationTimestamp.Before(&kcp.Status.CARotation.PhaseStartTime) {
+		res.LogMessages = append(res.LogMessages, fmt.Sprintf("CA rotation in progress, phase %s", kcp.Status.CARotation.Phase))
+		res.ConditionMessages = append(res.ConditionMessages, "CA rotation in progress")
+		res.EligibleForInPlaceUpdate = false
+	}
+
 	// Machines that do not match with KCP config.
 	// Note: matchesMachineSpec will update res with desired and current objects if necessary.
 	matches, specLogMessages, specConditionMessages, err := matchesMachineSpec(ctx, c, infraMachines, kubeadmConfigs, kcp, cluster, machine, res)
@@ -306,6 +314,13 @@ func PrepareKubeadmConfigsForDiff(desiredKubeadmConfig, currentKubeadmConfig *bo
 	desiredKubeadmConfig = desiredKubeadmConfig.DeepCopy()
 	currentKubeadmConfig = currentKubeadmConfig.DeepCopy()
 
+	// Ignore files and commands added to restore etcd from a snapshot when waking up a hibernated control plane.
+	// Note: Those are only relevant for the kubeadm init of the first Machine and should never trigger a rollout.
+	if desiredstate.HasEtcdSnapshotRestore(&currentKubeadmConfig.Spec) {
+		desiredstate.RemoveEtcdSnapshotRestore(&desiredKubeadmConfig.Spec)
+		desiredstate.RemoveEtcdSnapshotRestore(&currentKubeadmConfig.Spec)
+	}
+
 	if convertCurrentInitConfigurationToJoinConfiguration && isKubeadmConfigForInit(currentKubeadmConfig) {
 		// Convert InitConfiguration to JoinConfiguration
 		currentKubeadmConfig.Spec.JoinConfiguration.Timeouts = currentKubeadmConfig.Spec.InitConfiguration.Timeouts
@@ -328,7 +328,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     InitConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
-    ... // 11 identical fields
+    ... // 15 identical fields
   }`))
 	})
 	t.Run("returns true if InitConfiguration is equal after conversion to JoinConfiguration", func(t *testing.T) {
@@ -426,6 +426,66 @@ func TestMatchesKubeadmConfig(t *testing.T) {
 		g.Expect(match).To(BeTrue())
 		g.Expect(reason).To(BeEmpty())
 	})
+	t.Run("returns true if InitConfiguration is equal apart from the etcd snapshot restore", func(t *testing.T) {
+		g := NewWithT(t)
+		kcp := &controlplanev1.KubeadmControlPlane{
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+					Files: []bootstrapv1.File{
+						{Path: "/etc/foo", Content: "foo"},
+					},
+					JoinConfiguration: bootstrapv1.JoinConfiguration{
+						NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+							Name: "name",
+						},
+					},
+				},
+				Version: "v1.30.0",
+			},
+		}
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test",
+			},
+			Spec: clusterv1.MachineSpec{
+				Bootstrap: clusterv1.Bootstrap{
+					ConfigRef: clusterv1.ContractVersionedObjectReference{
+						Kind:     "KubeadmConfig",
+						Name:     "test",
+						APIGroup: bootstrapv1.GroupVersion.Group,
+					},
+				},
+			},
+		}
+		machineConfig := &bootstrapv1.KubeadmConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test",
+			},
+			Spec: bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{
+					{Path: "/etc/foo", Content: "foo"},
+				},
+				InitConfiguration: bootstrapv1.InitConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+						Name: "name",
+					},
+				},
+			},
+		}
+		desiredstate.AddEtcdSnapshotRestore(&machineConfig.Spec, desiredstate.EtcdSnapshot{
+			URL:    "https://storage.example.com/test/etcd-snapshot.db.gz",
+			SHA256: "0123456789abcdef",
+		})
+		machineConfigs := map[string]*bootstrapv1.KubeadmConfig{
+			m.Name: machineConfig,
+		}
+		reason, _, _, match, err := matchesKubeadmConfig(machineConfigs, kcp, &clusterv1.Cluster{}, m)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(match).To(BeTrue())
+		g.Expect(reason).To(BeEmpty())
+	})
 	t.Run("returns true if JoinConfiguration is not equal, but InitConfiguration is", func(t *testing.T) {
 		g := NewWithT(t)
 		kcp := &controlplanev1.KubeadmControlPlane{
@@ -557,7 +617,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     Files:             nil,
-    ... // 10 identical fields
+    ... // 14 identical fields
   }`))
 	})
 	t.Run("returns true if JoinConfiguration is equal", func(t *testing.T) {
@@ -811,7 +871,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     Files:             nil,
-    ... // 10 identical fields
+    ... // 14 identical fields
   }`))
 	})
 	t.Run("returns false if JoinConfiguration has other differences in ControlPlane", func(t *testing.T) {
@@ -894,7 +954,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     Files:     nil,
     DiskSetup: {},
-    ... // 9 identical fields
+    ... // 13 identical fields
   }`))
 	})
 	t.Run("returns false if JoinConfiguration is NOT equal", func(t *testing.T) {
@@ -967,7 +1027,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     Files:     nil,
     DiskSetup: {},
-    ... // 9 identical fields
+    ... // 13 identical fields
   }`))
 	})
 	t.Run("returns false if JoinConfiguration is NOT equal", func(t *testing.T) {
@@ -1036,7 +1096,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
     },
     Files:     nil,
     DiskSetup: {},
-    ... // 9 identical fields
+    ... // 13 identical fields
   }`))
 	})
 	t.Run("returns true if only omittable configurations are not equal", func(t *testing.T) {
@@ -1222,7 +1282,7 @@ func TestMatchesKubeadmConfig(t *testing.T) {
 +   Files:                []v1beta2.File{{Path: "/tmp/foo"}},
     DiskSetup:            {},
     Mounts:               nil,
-    ... // 8 identical fields
+    ... // 12 identical fields
   }`))
 	})
 	t.Run("should match on labels and annotations", func(t *testing.T) {
@@ -1645,6 +1705,46 @@ func TestUpToDate(t *testing.T) {
 			expectLogMessages:              nil,
 			expectConditionMessages:        nil,
 		},
+		{
+			name: "machine up-to-date with labels and annotations changed on KCP",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.MachineTemplate.ObjectMeta = clusterv1.ObjectMeta{
+					Labels:      map[string]string{"label": "new-value"},
+					Annotations: map[string]string{"annotation": "new-value"},
+				}
+				return kcp
+			}(),
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				machine.Labels = map[string]string{"label": "old-value"}
+				machine.Annotations = map[string]string{"annotation": "old-value"}
+				return machine
+			}(),
+			infraConfigs: func() map[string]*unstructured.Unstructured {
+				infraConfigs := map[string]*unstructured.Unstructured{}
+				for name, infraConfig := range defaultInfraConfigs {
+					infraConfig = infraConfig.DeepCopy()
+					infraConfig.SetLabels(map[string]string{"label": "old-value"})
+					infraConfigs[name] = infraConfig
+				}
+				return infraConfigs
+			}(),
+			machineConfigs: func() map[string]*bootstrapv1.KubeadmConfig {
+				machineConfigs := map[string]*bootstrapv1.KubeadmConfig{}
+				for name, machineConfig := range defaultMachineConfigs {
+					machineConfig = machineConfig.DeepCopy()
+					machineConfig.Labels = map[string]string{"label": "old-value"}
+					machineConfig.Annotations = map[string]string{"annotation": "old-value"}
+					machineConfigs[name] = machineConfig
+				}
+				return machineConfigs
+			}(),
+			expectUptoDate:                 true, // labels and annotations are propagated in-place, so they do not trigger a rollout.
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              nil,
+			expectConditionMessages:        nil,
+		},
 		{
 			name: "certificate are expiring soon",
 			kcp: func() *controlplanev1.KubeadmControlPlane {
@@ -1675,6 +1775,42 @@ func TestUpToDate(t *testing.T) {
 			expectLogMessages:              []string{"rolloutAfter expired"},
 			expectConditionMessages:        []string{"KubeadmControlPlane spec.rolloutAfter expired"},
 		},
+		{
+			name: "CA rotation in progress, machine created before the phase started",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Status.CARotation = controlplanev1.KubeadmControlPlaneCARotationStatus{
+					Phase:          controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase,
+					PhaseStartTime: metav1.Time{Time: reconciliationTime.Add(-1 * 24 * time.Hour)}, // one day ago
+				}
+				return kcp
+			}(),
+			machine:                        defaultMachine, // created two days ago
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 false,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              []string{"CA rotation in progress, phase TrustingNewCA"},
+			expectConditionMessages:        []string{"CA rotation in progress"},
+		},
+		{
+			name: "CA rotation in progress, machine created after the phase started",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Status.CARotation = controlplanev1.KubeadmControlPlaneCARotationStatus{
+					Phase:          controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase,
+					PhaseStartTime: metav1.Time{Time: reconciliationTime.Add(-3 * 24 * time.Hour)}, // three days ago
+				}
+				return kcp
+			}(),
+			machine:                        defaultMachine, // created two days ago
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 true,
+			expectEligibleForInPlaceUpdate: false,
+			expectLogMessages:              nil,
+			expectConditionMessages:        nil,
+		},
 		{
 			name: "kubernetes version does not match",
 			kcp: func() *controlplanev1.KubeadmControlPlane {
@@ -1694,6 +1830,31 @@ func TestUpToDate(t *testing.T) {
 			expectLogMessages:              []string{"Machine version \"v1.30.0\" is not equal to KCP version \"v1.30.2\""},
 			expectConditionMessages:        []string{"Version v1.30.0, v1.30.2 required"},
 		},
+		{
+			name: "kubernetes version does not match + labels and annotations changed on KCP",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.30.2"
+				kcp.Spec.MachineTemplate.ObjectMeta = clusterv1.ObjectMeta{
+					Labels:      map[string]string{"label": "new-value"},
+					Annotations: map[string]string{"annotation": "new-value"},
+				}
+				return kcp
+			}(),
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				machine.Spec.Version = "v1.30.0"
+				machine.Labels = map[string]string{"label": "old-value"}
+				return machine
+			}(),
+			infraConfigs:                   defaultInfraConfigs,
+			machineConfigs:                 defaultMachineConfigs,
+			expectUptoDate:                 false,
+			expectEligibleForInPlaceUpdate: true,
+			// Note: only the version is reported, labels and annotations are propagated in-place.
+			expectLogMessages:       []string{"Machine version \"v1.30.0\" is not equal to KCP version \"v1.30.2\""},
+			expectConditionMessages: []string{"Version v1.30.0, v1.30.2 required"},
+		},
 		{
 			name: "kubernetes version does not match + delete annotation",
 			kcp: func() *controlplanev1.KubeadmControlPlane {
@@ -1763,7 +1924,7 @@ func TestUpToDate(t *testing.T) {
     },
     InitConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
     JoinConfiguration: {NodeRegistration: {ImagePullPolicy: "IfNotPresent"}},
-    ... // 11 identical fields
+    ... // 15 identical fields
   }`},
 			expectConditionMessages: []string{"KubeadmConfig is not up-to-date"},
 		},
@@ -1805,6 +1966,12 @@ func TestUpToDate(t *testing.T) {
 			g.Expect(res.EligibleForInPlaceUpdate).To(Equal(tt.expectEligibleForInPlaceUpdate))
 			g.Expect(res.DesiredMachine).ToNot(BeNil())
 			g.Expect(res.DesiredMachine.Spec.Version).To(Equal(tt.kcp.Spec.Version))
+			for k, v := range tt.kcp.Spec.MachineTemplate.ObjectMeta.Labels {
+				g.Expect(res.DesiredMachine.Labels).To(HaveKeyWithValue(k, v))
+			}
+			for k, v := range tt.kcp.Spec.MachineTemplate.ObjectMeta.Annotations {
+				g.Expect(res.DesiredMachine.Annotations).To(HaveKeyWithValue(k, v))
+			}
 			g.Expect(res.CurrentInfraMachine).ToNot(BeNil())
 			g.Expect(res.DesiredInfraMachine).ToNot(BeNil())
 			g.Expect(res.CurrentKubeadmConfig).ToNot(BeNil())
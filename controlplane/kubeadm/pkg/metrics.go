@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkg
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(etcdMemberDBSize)
+	ctrlmetrics.Registry.MustRegister(etcdMemberDBSizeInUse)
+	ctrlmetrics.Registry.MustRegister(etcdMemberDBSizeQuota)
+}
+
+var (
+	etcdMemberDBSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_kubeadmcontrolplane_etcd_member_db_size_bytes",
+			Help: "Size of the backend database of an etcd member, in bytes.",
+		}, []string{
+			"cluster_name", "cluster_namespace", "member",
+		},
+	)
+	etcdMemberDBSizeInUse = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_kubeadmcontrolplane_etcd_member_db_size_in_use_bytes",
+			Help: "Size of the backend database of an etcd member logically in use, in bytes.",
+		}, []string{
+			"cluster_name", "cluster_namespace", "member",
+		},
+	)
+	etcdMemberDBSizeQuota = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_kubeadmcontrolplane_etcd_member_db_size_quota_bytes",
+			Help: "Backend database quota of an etcd member, in bytes.",
+		}, []string{
+			"cluster_name", "cluster_namespace", "member",
+		},
+	)
+)
+
+var (
+	// etcdMembersWithDBSizeMetricsLock protects etcdMembersWithDBSizeMetrics.
+	etcdMembersWithDBSizeMetricsLock sync.Mutex
+
+	// etcdMembersWithDBSizeMetrics tracks, per cluster, the etcd members for which database size metrics have been reported.
+	etcdMembersWithDBSizeMetrics = map[types.NamespacedName]sets.Set[string]{}
+)
+
+// setEtcdMemberDBSizeMetrics reports the database size metrics for an etcd member of a cluster.
+func setEtcdMemberDBSizeMetrics(cluster types.NamespacedName, member string, dbSize, dbSizeInUse, dbSizeQuota int64) {
+	etcdMembersWithDBSizeMetricsLock.Lock()
+	defer etcdMembersWithDBSizeMetricsLock.Unlock()
+
+	etcdMemberDBSize.WithLabelValues(cluster.Name, cluster.Namespace, member).Set(float64(dbSize))
+	etcdMemberDBSizeInUse.WithLabelValues(cluster.Name, cluster.Namespace, member).Set(float64(dbSizeInUse))
+	etcdMemberDBSizeQuota.WithLabelValues(cluster.Name, cluster.Namespace, member).Set(float64(dbSizeQuota))
+
+	if _, ok := etcdMembersWithDBSizeMetrics[cluster]; !ok {
+		etcdMembersWithDBSizeMetrics[cluster] = sets.New[string]()
+	}
+	etcdMembersWithDBSizeMetrics[cluster].Insert(member)
+}
+
+// deleteEtcdMemberDBSizeMetricsForRemovedMembers deletes the database size metrics reported for etcd members
+// of a cluster which are not in the given list of members anymore.
+func deleteEtcdMemberDBSizeMetricsForRemovedMembers(cluster types.NamespacedName, members sets.Set[string]) {
+	etcdMembersWithDBSizeMetricsLock.Lock()
+	defer etcdMembersWithDBSizeMetricsLock.Unlock()
+
+	reported, ok := etcdMembersWithDBSizeMetrics[cluster]
+	if !ok {
+		return
+	}
+	for _, member := range sets.List(reported.Difference(members)) {
+		labels := prometheus.Labels{"cluster_name": cluster.Name, "cluster_namespace": cluster.Namespace, "member": member}
+		etcdMemberDBSize.DeletePartialMatch(labels)
+		etcdMemberDBSizeInUse.DeletePartialMatch(labels)
+		etcdMemberDBSizeQuota.DeletePartialMatch(labels)
+		reported.Delete(member)
+	}
+}
+
+// DeleteEtcdMemberDBSizeMetricsForCluster deletes the etcd member database size metrics reported for a cluster.
+func DeleteEtcdMemberDBSizeMetricsForCluster(cluster types.NamespacedName) {
+	etcdMembersWithDBSizeMetricsLock.Lock()
+	defer etcdMembersWithDBSizeMetricsLock.Unlock()
+
+	labels := prometheus.Labels{"cluster_name": cluster.Name, "cluster_namespace": cluster.Namespace}
+	etcdMemberDBSize.DeletePartialMatch(labels)
+	etcdMemberDBSizeInUse.DeletePartialMatch(labels)
+	etcdMemberDBSizeQuota.DeletePartialMatch(labels)
+	delete(etcdMembersWithDBSizeMetrics, cluster)
+}
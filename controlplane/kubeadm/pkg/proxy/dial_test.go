@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+)
+
+func TestDialerUsesProxyOfKubeConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	// proxyServer records the targets of CONNECT requests and rejects them.
+	var lock sync.Mutex
+	var connectTargets []string
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodConnect {
+			lock.Lock()
+			connectTargets = append(connectTargets, r.Host)
+			lock.Unlock()
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer proxyServer.Close()
+	proxyURL, err := url.Parse(proxyServer.URL)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	dialer, err := NewDialer(Proxy{
+		Kind:      "pods",
+		Namespace: "kube-system",
+		KubeConfig: &rest.Config{
+			Host:            "https://workload-cluster.example.com:6443",
+			Proxy:           http.ProxyURL(proxyURL),
+			TLSClientConfig: rest.TLSClientConfig{Insecure: true},
+		},
+		Port: 2379,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = dialer.DialContext(context.Background(), "tcp", "etcd-control-plane-1")
+	g.Expect(err).To(HaveOccurred())
+
+	lock.Lock()
+	defer lock.Unlock()
+	g.Expect(connectTargets).ToNot(BeEmpty())
+	g.Expect(connectTargets).To(HaveEach("workload-cluster.example.com:6443"))
+}
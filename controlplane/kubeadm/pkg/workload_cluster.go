@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -81,8 +82,13 @@ type WorkloadCluster interface {
 	UpdateCoreDNS(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error
 	RemoveEtcdMember(ctx context.Context, m *etcd.Member, nodes []*Node) error
 	ForwardEtcdLeadership(ctx context.Context, fromMember, toMember string) error
+	SnapshotEtcd(ctx context.Context, nodeName string) ([]byte, error)
 	EnsureKubeadmPermissions(ctx context.Context, version semver.Version) error
 	UpdateClusterConfiguration(ctx context.Context, version semver.Version, mutators ...func(*bootstrapv1.ClusterConfiguration)) error
+	GetClusterConfigurationDrift(ctx context.Context, version semver.Version, mutators ...func(*bootstrapv1.ClusterConfiguration)) ([]string, error)
+
+	// CA rotation related tasks.
+	UpdateClusterInfoCertificateAuthority(ctx context.Context, caData []byte) error
 }
 
 // Workload defines operations on workload clusters.
@@ -91,6 +97,8 @@ type Workload struct {
 	CoreDNSMigrator     coreDNSMigrator
 	etcdClientGenerator etcdClientFor
 	restConfig          *rest.Config
+
+	etcdDBSizeWarningThreshold int32
 }
 
 var _ WorkloadCluster = &Workload{}
@@ -217,6 +225,48 @@ func (w *Workload) UpdateClusterConfiguration(ctx context.Context, version semve
 	})
 }
 
+// GetClusterConfigurationDrift returns the list of ClusterConfiguration fields in the kubeadm-config ConfigMap which
+// are going to be changed by the given mutators, i.e. the fields that are not consistent with the desired state.
+func (w *Workload) GetClusterConfigurationDrift(ctx context.Context, version semver.Version, mutators ...func(*bootstrapv1.ClusterConfiguration)) ([]string, error) {
+	key := client.ObjectKey{Name: kubeadmConfigKey, Namespace: metav1.NamespaceSystem}
+	configMap, err := w.getConfigMap(ctx, key)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to get kubeadmConfigMap")
+	}
+
+	currentData, ok := configMap.Data[clusterConfigurationKey]
+	if !ok {
+		return nil, pkgerrors.Errorf("unable to find %q in the kubeadm-config ConfigMap", clusterConfigurationKey)
+	}
+
+	currentObj, currentUpstreamData, err := kubeadmtypes.UnmarshalClusterConfiguration(currentData)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "unable to decode %q in the kubeadm-config ConfigMap's from YAML", clusterConfigurationKey)
+	}
+
+	updatedObj := currentObj.DeepCopy()
+	for i := range mutators {
+		mutators[i](updatedObj)
+	}
+
+	drift := []string{}
+	if ptr.Deref(currentUpstreamData.KubernetesVersion, "") != fmt.Sprintf("v%s", version.String()) {
+		drift = append(drift, "kubernetesVersion")
+	}
+
+	// Compare ClusterConfiguration field by field, so it is possible to report which fields are drifting.
+	currentValue := reflect.ValueOf(currentObj).Elem()
+	updatedValue := reflect.ValueOf(updatedObj).Elem()
+	for i := range currentValue.NumField() {
+		if reflect.DeepEqual(currentValue.Field(i).Interface(), updatedValue.Field(i).Interface()) {
+			continue
+		}
+		name, _, _ := strings.Cut(currentValue.Type().Field(i).Tag.Get("json"), ",")
+		drift = append(drift, name)
+	}
+	return drift, nil
+}
+
 // HasKubeadmConfig returns if the cluster has the kubeadm-config ConfigMap.
 func (w *Workload) HasKubeadmConfig(ctx context.Context) (bool, error) {
 	// find the kubeadm conifg
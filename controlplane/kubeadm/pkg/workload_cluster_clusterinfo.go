@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkg
+
+import (
+	"bytes"
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterInfoKey           = "cluster-info"
+	clusterInfoKubeconfigKey = "kubeconfig"
+)
+
+// UpdateClusterInfoCertificateAuthority updates the certificate authority data in the kubeconfig stored in the
+// cluster-info ConfigMap, which is used by kubeadm join for discovery.
+// Note: the signatures of the kubeconfig are re-computed by the bootstrap signer in the kube-controller-manager.
+func (w *Workload) UpdateClusterInfoCertificateAuthority(ctx context.Context, caData []byte) error {
+	key := client.ObjectKey{Name: clusterInfoKey, Namespace: metav1.NamespacePublic}
+	configMap := &corev1.ConfigMap{}
+	if err := w.Client.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			// if cluster-info is missing, e.g. kubeadm init did not complete yet, return without errors
+			return nil
+		}
+		return pkgerrors.Wrap(err, "failed to get cluster-info ConfigMap")
+	}
+
+	data, ok := configMap.Data[clusterInfoKubeconfigKey]
+	if !ok {
+		return pkgerrors.Errorf("failed to get cluster-info ConfigMap: %q key is missing", clusterInfoKubeconfigKey)
+	}
+	config, err := clientcmd.Load([]byte(data))
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to parse kubeconfig from cluster-info ConfigMap")
+	}
+
+	changed := false
+	for _, cluster := range config.Clusters {
+		if !bytes.Equal(cluster.CertificateAuthorityData, caData) {
+			cluster.CertificateAuthorityData = caData
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	out, err := clientcmd.Write(*config)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to serialize kubeconfig for cluster-info ConfigMap")
+	}
+	original := configMap.DeepCopy()
+	configMap.Data[clusterInfoKubeconfigKey] = string(out)
+	if err := w.Client.Patch(ctx, configMap, client.MergeFrom(original)); err != nil {
+		return pkgerrors.Wrap(err, "failed to patch cluster-info ConfigMap")
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkg
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateClusterInfoCertificateAuthority(t *testing.T) {
+	clusterInfo := func(caData string) *corev1.ConfigMap {
+		out, err := clientcmd.Write(api.Config{
+			Clusters: map[string]*api.Cluster{
+				"": {
+					Server:                   "https://127.0.0.1:6443",
+					CertificateAuthorityData: []byte(caData),
+				},
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      clusterInfoKey,
+				Namespace: metav1.NamespacePublic,
+			},
+			Data: map[string]string{
+				clusterInfoKubeconfigKey: string(out),
+				"jws-kubeconfig-abcdef":  "signature",
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		objs         []client.Object
+		caData       string
+		expectErr    bool
+		expectCAData string
+	}{
+		{
+			name:   "returns no error if cluster-info does not exist",
+			caData: "new",
+		},
+		{
+			name:         "updates the certificate authority data",
+			objs:         []client.Object{clusterInfo("old")},
+			caData:       "old-new",
+			expectCAData: "old-new",
+		},
+		{
+			name:         "no-op if the certificate authority data is up-to-date",
+			objs:         []client.Object{clusterInfo("new")},
+			caData:       "new",
+			expectCAData: "new",
+		},
+		{
+			name: "returns an error if cluster-info does not contain a kubeconfig",
+			objs: []client.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterInfoKey,
+					Namespace: metav1.NamespacePublic,
+				},
+			}},
+			caData:    "new",
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := fake.NewClientBuilder().WithObjects(tt.objs...).Build()
+			w := &Workload{
+				Client: fakeClient,
+			}
+			err := w.UpdateClusterInfoCertificateAuthority(ctx, []byte(tt.caData))
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			if tt.expectCAData == "" {
+				return
+			}
+
+			actual := &corev1.ConfigMap{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKey{Name: clusterInfoKey, Namespace: metav1.NamespacePublic}, actual)).To(Succeed())
+			g.Expect(actual.Data).To(HaveKey("jws-kubeconfig-abcdef"))
+			config, err := clientcmd.Load([]byte(actual.Data[clusterInfoKubeconfigKey]))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(config.Clusters[""].CertificateAuthorityData).To(Equal([]byte(tt.expectCAData)))
+		})
+	}
+}
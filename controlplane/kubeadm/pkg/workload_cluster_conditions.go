@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -124,7 +125,7 @@ func (w *Workload) updateManagedEtcdConditions(ctx context.Context, controlPlane
 	// Update etcd member healthy conditions for machines not provisioning or deleting.
 	// This is implemented by reading info about members and alarms from etcd.
 	machinesNotProvisioningOrDeleting := controlPlane.Machines.Filter(collections.And(collections.HasNode(), collections.Not(collections.HasDeletionTimestamp)))
-	currentMembers, etcdLeader, alarms, err := w.getCurrentEtcdMembersAndAlarms(ctx, machinesNotProvisioningOrDeleting, controlPlane.Nodes)
+	currentMembers, etcdLeader, alarms, memberStatuses, err := w.getCurrentEtcdMembersAndAlarms(ctx, machinesNotProvisioningOrDeleting, controlPlane.Nodes)
 	if err == nil {
 		controlPlane.EtcdMembers = currentMembers
 		controlPlane.EtcdMembersAlarms = alarms
@@ -199,6 +200,12 @@ func (w *Workload) updateManagedEtcdConditions(ctx context.Context, controlPlane
 		log.Error(err, "Failed to get current etcd members and alarms")
 	}
 
+	// Collect the database size of etcd members, report it as metrics and surface a warning in case usage is getting close to the quota.
+	var kcpWarnings []string
+	if err == nil {
+		kcpWarnings = w.updateEtcdMembersDBSize(controlPlane, currentMembers, memberStatuses)
+	}
+
 	// Check if the list of etcd members and machines match each other.
 	// In case there are errors that we cannot link to a specific machine, consider them as kcp errors.
 	membersAndMachinesAreMatching, membersAndMachinesCompareErrors := compareMachinesAndMembers(controlPlane)
@@ -224,10 +231,87 @@ func (w *Workload) updateManagedEtcdConditions(ctx context.Context, controlPlane
 		falseReason:       controlplanev1.KubeadmControlPlaneEtcdClusterNotHealthyReason,
 		unknownReason:     controlplanev1.KubeadmControlPlaneEtcdClusterHealthUnknownReason,
 		trueReason:        controlplanev1.KubeadmControlPlaneEtcdClusterHealthyReason,
+		kcpWarnings:       kcpWarnings,
 		note:              "etcd member",
 	})
 }
 
+// defaultEtcdDBSizeQuota is the default etcd backend database quota (2 GiB).
+const defaultEtcdDBSizeQuota int64 = 2 * 1024 * 1024 * 1024
+
+// updateEtcdMembersDBSize reports the backend database size of etcd members as metrics and returns a warning for each member
+// whose database size exceeds the configured percentage of the quota.
+// Note: Metrics for members that have been removed from the etcd cluster are deleted.
+func (w *Workload) updateEtcdMembersDBSize(controlPlane *ControlPlane, members []*etcd.Member, memberStatuses map[uint64]*etcd.MemberStatus) []string {
+	reportMetrics := controlPlane.Cluster != nil
+	if reportMetrics {
+		memberNames := sets.New[string]()
+		for _, member := range members {
+			memberNames.Insert(member.Name)
+		}
+		deleteEtcdMemberDBSizeMetricsForRemovedMembers(ctrlclient.ObjectKeyFromObject(controlPlane.Cluster), memberNames)
+	}
+
+	warnings := []string{}
+	for _, member := range members {
+		status, ok := memberStatuses[member.ID]
+		if !ok {
+			continue
+		}
+
+		// Etcd reports the quota only for etcd >= v3.6; for older versions, fall back to the quota configured in KCP.
+		dbSizeQuota := status.DBSizeQuota
+		if dbSizeQuota <= 0 {
+			dbSizeQuota = etcdDBSizeQuota(controlPlane.KCP)
+		}
+
+		if reportMetrics {
+			setEtcdMemberDBSizeMetrics(ctrlclient.ObjectKeyFromObject(controlPlane.Cluster), member.Name, status.DBSize, status.DBSizeInUse, dbSizeQuota)
+		}
+
+		if w.etcdDBSizeWarningThreshold <= 0 {
+			continue
+		}
+		usage := status.DBSize * 100 / dbSizeQuota
+		if usage >= int64(w.etcdDBSizeWarningThreshold) {
+			warnings = append(warnings, fmt.Sprintf("Etcd member %s database size is %s, %d%% of the %s quota", member.Name, formatBytes(status.DBSize), usage, formatBytes(dbSizeQuota)))
+		}
+	}
+	return warnings
+}
+
+// etcdDBSizeQuota returns the etcd backend database quota set via the quota-backend-bytes etcd extra arg,
+// or the etcd default if the extra arg is not set.
+func etcdDBSizeQuota(kcp *controlplanev1.KubeadmControlPlane) int64 {
+	if kcp == nil {
+		return defaultEtcdDBSizeQuota
+	}
+	for _, arg := range kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.Local.ExtraArgs {
+		if arg.Name != "quota-backend-bytes" || arg.Value == nil {
+			continue
+		}
+		// Note: etcd uses the default quota when quota-backend-bytes is set to 0.
+		if quota, err := strconv.ParseInt(*arg.Value, 10, 64); err == nil && quota > 0 {
+			return quota
+		}
+	}
+	return defaultEtcdDBSizeQuota
+}
+
+// formatBytes returns a human readable representation of a size in bytes.
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
 func unwrapAll(err error) error {
 	for {
 		newErr := pkgerrors.Unwrap(err)
@@ -239,15 +323,15 @@ func unwrapAll(err error) error {
 	return err
 }
 
-// getCurrentEtcdMembersAndAlarms returns the current list of etcd member and alarms.
+// getCurrentEtcdMembersAndAlarms returns the current list of etcd member and alarms, and the status of the etcd members hosted on the given machines.
 // Considering that the underlying etcd SDK calls (MemberList and AlarmList) requires quorum across all etcd members, it is possible
 // to run those calls towards any etcd Pod hosting an etcd member.
-func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines collections.Machines, nodes []*Node) ([]*etcd.Member, *etcd.Member, []etcd.MemberAlarm, error) {
+func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines collections.Machines, nodes []*Node) ([]*etcd.Member, *etcd.Member, []etcd.MemberAlarm, map[uint64]*etcd.MemberStatus, error) {
 	// Get the list of nodes hosting an etcd member sorted by the last known etcd health,
 	// so the client generator in the following line will try to connect first to nodes with higher chance to answer.
 	nodeNames := getNodeNamesSortedByLastKnownEtcdHealth(nodes, machines)
 	if len(nodeNames) == 0 {
-		return nil, nil, nil, nil
+		return nil, nil, nil, nil, nil
 	}
 
 	// Create the etcd Client for one of the etcd Pods running on the given nodes.
@@ -263,7 +347,7 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 				Message: fmt.Sprintf("Failed to connect to etcd: %s", unwrapAll(err)),
 			})
 		}
-		return nil, nil, nil, pkgerrors.Wrapf(err, "failed to get an etcd client for %s Nodes", strings.Join(nodeNames, ","))
+		return nil, nil, nil, nil, pkgerrors.Wrapf(err, "failed to get an etcd client for %s Nodes", strings.Join(nodeNames, ","))
 	}
 	defer etcdClient.Close()
 
@@ -280,7 +364,7 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 				Message: fmt.Sprintf("Failed to get etcd members: %s", unwrapAll(err)),
 			})
 		}
-		return nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd members")
+		return nil, nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd members")
 	}
 
 	var etcdLeader *etcd.Member
@@ -291,7 +375,7 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 		}
 	}
 	if etcdLeader == nil {
-		return nil, nil, nil, pkgerrors.Errorf("failed to get etcd leader")
+		return nil, nil, nil, nil, pkgerrors.Errorf("failed to get etcd leader")
 	}
 
 	// Gets the list of etcd alarms.
@@ -307,10 +391,26 @@ func (w *Workload) getCurrentEtcdMembersAndAlarms(ctx context.Context, machines
 				Message: fmt.Sprintf("Failed to get etcd alarms: %s", unwrapAll(err)),
 			})
 		}
-		return nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd alarms")
+		return nil, nil, nil, nil, pkgerrors.Wrapf(err, "failed to get etcd alarms")
 	}
 
-	return currentMembers, etcdLeader, alarms, nil
+	// Gets the status of the etcd members hosted on the machines using the same client.
+	// Note: This operation is best effort, members that cannot be reached are skipped.
+	memberStatuses := map[uint64]*etcd.MemberStatus{}
+	for _, machine := range machines {
+		member := etcdutil.MemberForName(currentMembers, machine.Status.NodeRef.Name)
+		if member == nil || member.IsLearner {
+			continue
+		}
+		status, err := etcdClient.MemberStatus(ctx, staticPodName("etcd", machine.Status.NodeRef.Name))
+		if err != nil {
+			ctrl.LoggerFrom(ctx).V(4).Info("Failed to get etcd member status", "Machine", klog.KObj(machine), "err", err.Error())
+			continue
+		}
+		memberStatuses[member.ID] = status
+	}
+
+	return currentMembers, etcdLeader, alarms, memberStatuses, nil
 }
 
 // getNodeNamesSortedByLastKnownEtcdHealth return the list of nodes hosting an etcd member sorted by the last known etcd health.
@@ -1073,6 +1173,7 @@ type aggregateConditionsFromMachinesToKCPInput struct {
 	controlPlane      *ControlPlane
 	machineConditions []string
 	kcpErrors         []string
+	kcpWarnings       []string
 	condition         string
 	trueReason        string
 	unknownReason     string
@@ -1175,6 +1276,10 @@ func aggregateConditionsFromMachinesToKCP(input aggregateConditionsFromMachinesT
 			messages = append(messages, fmt.Sprintf("* %s", message))
 		}
 	}
+	// Append warnings impacting KCP as a whole, if any
+	for _, message := range input.kcpWarnings {
+		messages = append(messages, fmt.Sprintf("* %s", message))
+	}
 	message := strings.Join(messages, "\n")
 
 	// In case of at least one machine with errors or KCP level errors (nodes without machines), report false.
@@ -1202,9 +1307,10 @@ func aggregateConditionsFromMachinesToKCP(input aggregateConditionsFromMachinesT
 	// In case of no errors, no unknown, and at least one machine with info, report true.
 	if len(kcpMachinesWithInfo) > 0 {
 		conditions.Set(input.controlPlane.KCP, metav1.Condition{
-			Type:   input.condition,
-			Status: metav1.ConditionTrue,
-			Reason: input.trueReason,
+			Type:    input.condition,
+			Status:  metav1.ConditionTrue,
+			Reason:  input.trueReason,
+			Message: message,
 		})
 		return
 	}
@@ -24,12 +24,14 @@ import (
 
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -114,7 +116,8 @@ func TestUpdateEtcdConditions(t *testing.T) {
 					callCount++
 					return &etcd.Client{
 						EtcdClient: &fake2.FakeEtcdClient{
-							EtcdEndpoints: []string{},
+							EtcdEndpoints:  []string{},
+							StatusResponse: &clientv3.StatusResponse{},
 							MemberListResponse: &clientv3.MemberListResponse{
 								Header: &pb.ResponseHeader{
 									ClusterId: uint64(1),
@@ -297,6 +300,7 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 				client: &etcd.Client{
 					EtcdClient: &fake2.FakeEtcdClient{
 						EtcdEndpoints:   []string{},
+						StatusResponse:  &clientv3.StatusResponse{},
 						MemberListError: pkgerrors.New("something went wrong"),
 					},
 				},
@@ -333,7 +337,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 			injectEtcdClientGenerator: &fakeEtcdClientGenerator{
 				client: &etcd.Client{
 					EtcdClient: &fake2.FakeEtcdClient{
-						EtcdEndpoints: []string{},
+						EtcdEndpoints:  []string{},
+						StatusResponse: &clientv3.StatusResponse{},
 						MemberListResponse: &clientv3.MemberListResponse{
 							Members: []*pb.Member{
 								{Name: "n1", ID: uint64(1), IsLearner: true},
@@ -379,7 +384,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 			injectEtcdClientGenerator: &fakeEtcdClientGenerator{
 				client: &etcd.Client{
 					EtcdClient: &fake2.FakeEtcdClient{
-						EtcdEndpoints: []string{},
+						EtcdEndpoints:  []string{},
+						StatusResponse: &clientv3.StatusResponse{},
 						MemberListResponse: &clientv3.MemberListResponse{
 							Members: []*pb.Member{
 								{Name: "n1", ID: uint64(1)},
@@ -434,7 +440,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 						case "n1":
 							return &etcd.Client{
 								EtcdClient: &fake2.FakeEtcdClient{
-									EtcdEndpoints: []string{},
+									EtcdEndpoints:  []string{},
+									StatusResponse: &clientv3.StatusResponse{},
 									MemberListResponse: &clientv3.MemberListResponse{
 										Header: &pb.ResponseHeader{
 											ClusterId: uint64(1),
@@ -503,7 +510,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 						case "n1":
 							return &etcd.Client{
 								EtcdClient: &fake2.FakeEtcdClient{
-									EtcdEndpoints: []string{},
+									EtcdEndpoints:  []string{},
+									StatusResponse: &clientv3.StatusResponse{},
 									MemberListResponse: &clientv3.MemberListResponse{
 										Header: &pb.ResponseHeader{
 											ClusterId: uint64(1),
@@ -522,7 +530,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 						case "n2":
 							return &etcd.Client{
 								EtcdClient: &fake2.FakeEtcdClient{
-									EtcdEndpoints: []string{},
+									EtcdEndpoints:  []string{},
+									StatusResponse: &clientv3.StatusResponse{},
 									MemberListResponse: &clientv3.MemberListResponse{
 										Header: &pb.ResponseHeader{
 											ClusterId: uint64(1),
@@ -589,7 +598,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 						case "n1":
 							return &etcd.Client{
 								EtcdClient: &fake2.FakeEtcdClient{
-									EtcdEndpoints: []string{},
+									EtcdEndpoints:  []string{},
+									StatusResponse: &clientv3.StatusResponse{},
 									MemberListResponse: &clientv3.MemberListResponse{
 										Header: &pb.ResponseHeader{
 											ClusterId: uint64(1),
@@ -609,7 +619,8 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 						case "n2":
 							return &etcd.Client{
 								EtcdClient: &fake2.FakeEtcdClient{
-									EtcdEndpoints: []string{},
+									EtcdEndpoints:  []string{},
+									StatusResponse: &clientv3.StatusResponse{},
 									MemberListResponse: &clientv3.MemberListResponse{
 										Header: &pb.ResponseHeader{
 											ClusterId: uint64(1),
@@ -705,6 +716,106 @@ func TestUpdateManagedEtcdConditions(t *testing.T) {
 	}
 }
 
+func TestUpdateEtcdMembersDBSize(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "db-size-cluster"}
+	defer DeleteEtcdMemberDBSizeMetricsForCluster(cluster)
+
+	members := []*etcd.Member{
+		{Name: "n1", ID: uint64(1)},
+		{Name: "n2", ID: uint64(2)},
+		{Name: "n3", ID: uint64(3)},
+	}
+	// n3 cannot be reached, so its status is not available.
+	memberStatuses := map[uint64]*etcd.MemberStatus{
+		1: {DBSize: 900 * 1024 * 1024, DBSizeQuota: 1024 * 1024 * 1024},
+		2: {DBSize: 100 * 1024 * 1024},
+	}
+	w := &Workload{
+		etcdDBSizeWarningThreshold: 80,
+	}
+	controlPlane := &ControlPlane{
+		KCP: &controlplanev1.KubeadmControlPlane{
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+					ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+						Etcd: bootstrapv1.Etcd{
+							Local: bootstrapv1.LocalEtcd{
+								ExtraArgs: []bootstrapv1.Arg{{Name: "quota-backend-bytes", Value: ptr.To("4294967296")}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: cluster.Name, Namespace: cluster.Namespace}},
+	}
+
+	warnings := w.updateEtcdMembersDBSize(controlPlane, members, memberStatuses)
+	g.Expect(warnings).To(ConsistOf("Etcd member n1 database size is 900.0 MiB, 87% of the 1.0 GiB quota"))
+
+	// Members without a status are skipped, n2 falls back to the quota set via etcd extra args.
+	g.Expect(testutil.ToFloat64(etcdMemberDBSizeQuota.WithLabelValues(cluster.Name, cluster.Namespace, "n2"))).To(Equal(float64(4 * 1024 * 1024 * 1024)))
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSize)).To(Equal(2))
+
+	// No warnings are returned when the threshold is disabled.
+	w.etcdDBSizeWarningThreshold = 0
+	g.Expect(w.updateEtcdMembersDBSize(controlPlane, members, memberStatuses)).To(BeEmpty())
+
+	// Metrics are kept for members which are still part of the etcd cluster, even if their status is not available.
+	g.Expect(w.updateEtcdMembersDBSize(controlPlane, members, map[uint64]*etcd.MemberStatus{1: memberStatuses[1]})).To(BeEmpty())
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSize)).To(Equal(2))
+
+	// Metrics are deleted for members removed from the etcd cluster.
+	g.Expect(w.updateEtcdMembersDBSize(controlPlane, members[:1], memberStatuses)).To(BeEmpty())
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSize)).To(Equal(1))
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSizeQuota)).To(Equal(1))
+
+	// Metrics are deleted when the cluster is deleted.
+	DeleteEtcdMemberDBSizeMetricsForCluster(cluster)
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSize)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSizeInUse)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(etcdMemberDBSizeQuota)).To(Equal(0))
+}
+
+func TestEtcdDBSizeQuota(t *testing.T) {
+	tests := []struct {
+		name      string
+		extraArgs []bootstrapv1.Arg
+		want      int64
+	}{
+		{
+			name: "default quota if quota-backend-bytes is not set",
+			want: defaultEtcdDBSizeQuota,
+		},
+		{
+			name:      "quota from quota-backend-bytes",
+			extraArgs: []bootstrapv1.Arg{{Name: "quota-backend-bytes", Value: ptr.To("8589934592")}},
+			want:      8 * 1024 * 1024 * 1024,
+		},
+		{
+			name:      "default quota if quota-backend-bytes is 0",
+			extraArgs: []bootstrapv1.Arg{{Name: "quota-backend-bytes", Value: ptr.To("0")}},
+			want:      defaultEtcdDBSizeQuota,
+		},
+		{
+			name:      "default quota if quota-backend-bytes is invalid",
+			extraArgs: []bootstrapv1.Arg{{Name: "quota-backend-bytes", Value: ptr.To("8G")}},
+			want:      defaultEtcdDBSizeQuota,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{}
+			kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.Local.ExtraArgs = tt.extraArgs
+			g.Expect(etcdDBSizeQuota(kcp)).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestUpdateExternalEtcdConditions(t *testing.T) {
 	tests := []struct {
 		name                        string
@@ -122,6 +122,21 @@ func (w *Workload) ForwardEtcdLeadership(ctx context.Context, fromMember, toMemb
 	return nil
 }
 
+// SnapshotEtcd takes a snapshot of the etcd database using the etcd member running on the given node.
+func (w *Workload) SnapshotEtcd(ctx context.Context, nodeName string) ([]byte, error) {
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	snapshot, err := etcdClient.Snapshot(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to take etcd snapshot from Node %s", nodeName)
+	}
+	return snapshot, nil
+}
+
 // EtcdMemberStatus contains status information for a single etcd member.
 type EtcdMemberStatus struct {
 	Name       string
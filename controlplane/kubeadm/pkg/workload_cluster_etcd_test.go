@@ -464,6 +464,48 @@ func TestForwardEtcdLeadership(t *testing.T) {
 	})
 }
 
+func TestSnapshotEtcd(t *testing.T) {
+	t.Run("returns an error if it can't create an etcd client", func(t *testing.T) {
+		g := NewWithT(t)
+		w := &Workload{
+			etcdClientGenerator: &fakeEtcdClientGenerator{err: pkgerrors.New("no etcdClient")},
+		}
+		_, err := w.SnapshotEtcd(ctx, "m1")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("returns an error if it fails to take the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		w := &Workload{
+			etcdClientGenerator: &fakeEtcdClientGenerator{
+				client: &etcd.Client{
+					EtcdClient: &fake2.FakeEtcdClient{
+						SnapshotError: pkgerrors.New("cannot take snapshot"),
+					},
+				},
+			},
+		}
+		_, err := w.SnapshotEtcd(ctx, "m1")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("returns the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		w := &Workload{
+			etcdClientGenerator: &fakeEtcdClientGenerator{
+				client: &etcd.Client{
+					EtcdClient: &fake2.FakeEtcdClient{
+						SnapshotResponse: []byte("snapshot"),
+					},
+				},
+			},
+		}
+		snapshot, err := w.SnapshotEtcd(ctx, "m1")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshot).To(Equal([]byte("snapshot")))
+	})
+}
+
 type fakeEtcdClientGenerator struct {
 	client     *etcd.Client
 	clientFunc func([]string) (*etcd.Client, error)
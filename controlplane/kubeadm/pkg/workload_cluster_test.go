@@ -450,6 +450,71 @@ func TestUpdateUpdateClusterConfigurationInKubeadmConfigMap(t *testing.T) {
 	}
 }
 
+func TestGetClusterConfigurationDrift(t *testing.T) {
+	tests := []struct {
+		name                     string
+		clusterConfigurationData string
+		version                  semver.Version
+		mutators                 func(w *Workload) []func(*bootstrapv1.ClusterConfiguration)
+		wantDrift                []string
+	}{
+		{
+			name: "no drift if the ConfigMap is consistent with the desired state",
+			clusterConfigurationData: utilyaml.Raw(`
+				apiVersion: kubeadm.k8s.io/v1beta3
+				kind: ClusterConfiguration
+				kubernetesVersion: v1.23.1
+				imageRepository: example.com/k8s`),
+			version: semver.MustParse("1.23.1"),
+			mutators: func(w *Workload) []func(*bootstrapv1.ClusterConfiguration) {
+				return []func(*bootstrapv1.ClusterConfiguration){w.UpdateImageRepositoryInKubeadmConfigMap("example.com/k8s")}
+			},
+			wantDrift: []string{},
+		},
+		{
+			name: "report drifting fields",
+			clusterConfigurationData: utilyaml.Raw(`
+				apiVersion: kubeadm.k8s.io/v1beta3
+				kind: ClusterConfiguration
+				kubernetesVersion: v1.23.0
+				imageRepository: foo.bar/baz.io
+				apiServer:
+				  certSANs:
+				  - foo`),
+			version: semver.MustParse("1.23.1"),
+			mutators: func(w *Workload) []func(*bootstrapv1.ClusterConfiguration) {
+				return []func(*bootstrapv1.ClusterConfiguration){
+					w.UpdateImageRepositoryInKubeadmConfigMap("example.com/k8s"),
+					w.UpdateAPIServerInKubeadmConfigMap(bootstrapv1.APIServer{}),
+				}
+			},
+			wantDrift: []string{"kubernetesVersion", "apiServer", "imageRepository"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      kubeadmConfigKey,
+					Namespace: metav1.NamespaceSystem,
+				},
+				Data: map[string]string{
+					clusterConfigurationKey: tt.clusterConfigurationData,
+				},
+			}).Build()
+
+			w := &Workload{
+				Client: fakeClient,
+			}
+			drift, err := w.GetClusterConfigurationDrift(ctx, tt.version, tt.mutators(w)...)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(drift).To(ConsistOf(tt.wantDrift))
+		})
+	}
+}
+
 func TestUpdateImageRepositoryInKubeadmConfigMap(t *testing.T) {
 	tests := []struct {
 		name                     string
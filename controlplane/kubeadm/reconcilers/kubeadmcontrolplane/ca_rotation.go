@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/kubeconfig"
+	clog "sigs.k8s.io/cluster-api/util/log"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+// reconcileCARotation drives the rotation of the certificate authority of the Cluster, i.e. the CA stored in
+// the <cluster-name>-ca Secret, through the following phases:
+//   - TrustingNewCA: the new CA is appended to the CA bundle, certificates are still signed by the old CA.
+//   - SigningWithNewCA: certificates are signed by the new CA, the old CA is still part of the CA bundle.
+//   - RemovingOldCA: the old CA is removed from the CA bundle.
+//
+// At each phase the CA bundle is distributed to the kubeconfig Secret and to the cluster-info ConfigMap
+// in the workload cluster, and the rotation proceeds to the next phase only after all the Machines of the Cluster
+// created before the phase started have been replaced; control plane Machines are rolled out by the
+// KubeadmControlPlane, while all the other Machines must be rolled out by the user.
+//
+// Note: only the cluster CA is rotated; the etcd CA, the front-proxy CA and the service account keys are not changed.
+func (r *Reconciler) reconcileCARotation(ctx context.Context, controlPlane *pkg.ControlPlane) error {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	if kcp.Spec.CARotation.RequestedAt.IsZero() {
+		conditions.Delete(kcp, controlplanev1.KubeadmControlPlaneCARotationCondition)
+		return nil
+	}
+
+	clusterKey := util.ObjectKey(controlPlane.Cluster)
+	caSecret, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, clusterKey, secret.ClusterCA)
+	if err != nil {
+		setCARotationInternalErrorCondition(kcp)
+		return pkgerrors.Wrap(err, "failed to get cluster CA Secret")
+	}
+
+	// Only CAs generated by KCP can be rotated; user provided CAs must be rotated by the user.
+	if !util.IsControlledBy(caSecret, kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind).GroupKind()) {
+		conditions.Set(kcp, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneCARotationCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  controlplanev1.KubeadmControlPlaneCARotationNotSupportedReason,
+			Message: fmt.Sprintf("Secret %s is not controlled by the KubeadmControlPlane, CA rotation is not supported", klog.KObj(caSecret)),
+		})
+		return nil
+	}
+
+	status := &kcp.Status.CARotation
+
+	// Start a new rotation if requested.
+	// Note: the CA rotation Secret created when starting a rotation is used for the rest of this reconcile, because
+	// it cannot be read through the SecretCachingClient yet.
+	var rotationSecret *corev1.Secret
+	if status.Phase == "" && status.RequestedAt.Before(&kcp.Spec.CARotation.RequestedAt) {
+		rotationSecret, err = r.ensureCARotationSecret(ctx, controlPlane, caSecret)
+		if err != nil {
+			setCARotationInternalErrorCondition(kcp)
+			return err
+		}
+		status.RequestedAt = kcp.Spec.CARotation.RequestedAt
+		status.CompletionTime = metav1.Time{}
+		r.startCARotationPhase(ctx, controlPlane, controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase)
+	}
+
+	if status.Phase == "" {
+		conditions.Set(kcp, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneCARotationCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneCARotationCompletedReason,
+		})
+		return nil
+	}
+
+	if rotationSecret == nil {
+		rotationSecret, err = r.ensureCARotationSecret(ctx, controlPlane, caSecret)
+		if err != nil {
+			setCARotationInternalErrorCondition(kcp)
+			return err
+		}
+	}
+
+	// Distribute the CA certificates of the current phase.
+	if err := r.syncCARotationCertificates(ctx, controlPlane, caSecret, rotationSecret); err != nil {
+		setCARotationInternalErrorCondition(kcp)
+		return err
+	}
+
+	// Proceed to the next phase when all the Machines created before the current phase started have been replaced.
+	machines, err := r.managementCluster.GetMachinesForCluster(ctx, controlPlane.Cluster)
+	if err != nil {
+		setCARotationInternalErrorCondition(kcp)
+		return pkgerrors.Wrap(err, "failed to get Machines for Cluster")
+	}
+	outdatedMachines := machines.Filter(func(machine *clusterv1.Machine) bool {
+		return machine != nil && machine.CreationTimestamp.Before(&status.PhaseStartTime)
+	})
+	if outdatedMachines.Len() > 0 {
+		outdatedMachineNames := outdatedMachines.Names()
+		sort.Strings(outdatedMachineNames)
+		message := "Machine"
+		if len(outdatedMachineNames) > 1 {
+			message += "s"
+		}
+		message += " " + clog.ListToString(outdatedMachineNames, func(s string) string { return s }, 3)
+		if len(outdatedMachineNames) == 1 {
+			message += " is "
+		} else {
+			message += " are "
+		}
+		message += "not using the CA certificates of the current phase yet and must be replaced"
+		setCARotationInProgressCondition(kcp, message)
+		return nil
+	}
+
+	switch status.Phase {
+	case controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase:
+		r.startCARotationPhase(ctx, controlPlane, controlplanev1.KubeadmControlPlaneCARotationSigningWithNewCAPhase)
+	case controlplanev1.KubeadmControlPlaneCARotationSigningWithNewCAPhase:
+		r.startCARotationPhase(ctx, controlPlane, controlplanev1.KubeadmControlPlaneCARotationRemovingOldCAPhase)
+	case controlplanev1.KubeadmControlPlaneCARotationRemovingOldCAPhase:
+		// Note: the CA Secret, the kubeconfig Secret and the cluster-info ConfigMap already contain only the new CA.
+		if err := r.Client.Delete(ctx, rotationSecret); err != nil && !apierrors.IsNotFound(err) {
+			setCARotationInternalErrorCondition(kcp)
+			return pkgerrors.Wrapf(err, "failed to delete CA rotation Secret %s", klog.KObj(rotationSecret))
+		}
+		status.Phase = ""
+		status.PhaseStartTime = metav1.Time{}
+		status.CompletionTime = metav1.Now()
+
+		log.Info("CA rotation completed")
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneCARotationCompletedEventReason,
+			"CA rotation of cluster %s completed", klog.KObj(controlPlane.Cluster))
+		conditions.Set(kcp, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneCARotationCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneCARotationCompletedReason,
+		})
+		return nil
+	default:
+		setCARotationInternalErrorCondition(kcp)
+		return pkgerrors.Errorf("unknown CA rotation phase %q", status.Phase)
+	}
+
+	// Immediately distribute the CA certificates of the new phase, so Machines created from now on are using them.
+	if err := r.syncCARotationCertificates(ctx, controlPlane, caSecret, rotationSecret); err != nil {
+		setCARotationInternalErrorCondition(kcp)
+		return err
+	}
+
+	setCARotationInProgressCondition(kcp, "")
+	return nil
+}
+
+// startCARotationPhase sets the given phase as the current phase of the CA rotation.
+func (r *Reconciler) startCARotationPhase(ctx context.Context, controlPlane *pkg.ControlPlane, phase controlplanev1.KubeadmControlPlaneCARotationPhase) {
+	log := ctrl.LoggerFrom(ctx)
+
+	controlPlane.KCP.Status.CARotation.Phase = phase
+	controlPlane.KCP.Status.CARotation.PhaseStartTime = metav1.Now()
+
+	log.Info(fmt.Sprintf("Starting CA rotation phase %s", phase))
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneCARotationPhaseStartedEventReason,
+		"CA rotation of cluster %s: started phase %s", klog.KObj(controlPlane.Cluster), phase)
+}
+
+// ensureCARotationSecret returns the Secret storing the new and the previous CA during a CA rotation, generating
+// a new CA if the Secret does not exist yet.
+func (r *Reconciler) ensureCARotationSecret(ctx context.Context, controlPlane *pkg.ControlPlane, caSecret *corev1.Secret) (*corev1.Secret, error) {
+	clusterKey := util.ObjectKey(controlPlane.Cluster)
+	rotationSecret, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, clusterKey, secret.ClusterCARotation)
+	if err == nil {
+		return rotationSecret, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, pkgerrors.Wrap(err, "failed to get CA rotation Secret")
+	}
+
+	// The CA rotation Secret is created at the beginning of a rotation; if it is missing in a later phase
+	// it is not possible to recover the new or the previous CA.
+	if controlPlane.KCP.Status.CARotation.Phase != "" {
+		return nil, pkgerrors.Errorf("CA rotation Secret %s not found", secret.Name(clusterKey.Name, secret.ClusterCARotation))
+	}
+
+	clusterConfiguration := controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration
+	newCA := &secret.Certificate{
+		Purpose:                secret.ClusterCARotation,
+		ValidityPeriodDays:     clusterConfiguration.CACertificateValidityPeriodDays,
+		KeyEncryptionAlgorithm: clusterConfiguration.EncryptionAlgorithm,
+	}
+	if err := newCA.Generate(); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to generate new CA")
+	}
+
+	controllerRef := metav1.NewControllerRef(controlPlane.KCP, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind))
+	rotationSecret = newCA.AsSecret(clusterKey, *controllerRef)
+	rotationSecret.Data[secret.PreviousTLSCrtDataName] = caSecret.Data[secret.TLSCrtDataName]
+	rotationSecret.Data[secret.PreviousTLSKeyDataName] = caSecret.Data[secret.TLSKeyDataName]
+	if err := r.Client.Create(ctx, rotationSecret); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to create CA rotation Secret %s", klog.KObj(rotationSecret))
+	}
+	return rotationSecret, nil
+}
+
+// syncCARotationCertificates ensures the CA Secret, the kubeconfig Secret and the cluster-info ConfigMap in the
+// workload cluster contain the CA certificates for the current phase of the CA rotation.
+func (r *Reconciler) syncCARotationCertificates(ctx context.Context, controlPlane *pkg.ControlPlane, caSecret, rotationSecret *corev1.Secret) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	caCrt, caKey := desiredCARotationCertificates(controlPlane.KCP.Status.CARotation.Phase, rotationSecret)
+	if !bytes.Equal(caSecret.Data[secret.TLSCrtDataName], caCrt) || !bytes.Equal(caSecret.Data[secret.TLSKeyDataName], caKey) {
+		original := caSecret.DeepCopy()
+		caSecret.Data[secret.TLSCrtDataName] = caCrt
+		caSecret.Data[secret.TLSKeyDataName] = caKey
+		if err := r.Client.Patch(ctx, caSecret, client.MergeFrom(original)); err != nil {
+			return pkgerrors.Wrapf(err, "failed to patch CA Secret %s", klog.KObj(caSecret))
+		}
+	}
+
+	// Note: the kubeconfig Secret is re-generated with a client certificate signed by the current signing CA.
+	configSecret, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, util.ObjectKey(controlPlane.Cluster), secret.Kubeconfig)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return pkgerrors.Wrap(err, "failed to get kubeconfig Secret")
+	}
+	if err == nil && util.IsControlledBy(configSecret, controlPlane.KCP, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind).GroupKind()) {
+		needsUpdate, err := kubeconfig.NeedsCertificateAuthorityUpdate(ctx, r.Client, configSecret)
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to check kubeconfig Secret")
+		}
+		if needsUpdate {
+			log.Info("Updating CA certificates in kubeconfig secret")
+			if err := kubeconfig.RegenerateSecret(ctx, r.Client, configSecret, kubeconfig.KeyEncryptionAlgorithm(controlPlane.GetKeyEncryptionAlgorithm())); err != nil {
+				return pkgerrors.Wrap(err, "failed to regenerate kubeconfig")
+			}
+		}
+	}
+
+	// The cluster-info ConfigMap exists only after the control plane is initialized.
+	if !ptr.Deref(controlPlane.KCP.Status.Initialization.ControlPlaneInitialized, false) {
+		return nil
+	}
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to create client to workload cluster")
+	}
+	if err := workloadCluster.UpdateClusterInfoCertificateAuthority(ctx, caCrt); err != nil {
+		return pkgerrors.Wrap(err, "failed to update CA certificates in cluster-info ConfigMap")
+	}
+	return nil
+}
+
+// desiredCARotationCertificates returns the content of the tls.crt and tls.key keys of the CA Secret for the given
+// phase of a CA rotation. The first certificate in tls.crt is always the one matching the key in tls.key.
+func desiredCARotationCertificates(phase controlplanev1.KubeadmControlPlaneCARotationPhase, rotationSecret *corev1.Secret) ([]byte, []byte) {
+	newCrt, newKey := rotationSecret.Data[secret.TLSCrtDataName], rotationSecret.Data[secret.TLSKeyDataName]
+	previousCrt, previousKey := rotationSecret.Data[secret.PreviousTLSCrtDataName], rotationSecret.Data[secret.PreviousTLSKeyDataName]
+
+	switch phase {
+	case controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase:
+		return concatCertificates(previousCrt, newCrt), previousKey
+	case controlplanev1.KubeadmControlPlaneCARotationSigningWithNewCAPhase:
+		return concatCertificates(newCrt, previousCrt), newKey
+	default:
+		return newCrt, newKey
+	}
+}
+
+func concatCertificates(certificates ...[]byte) []byte {
+	var out []byte
+	for _, c := range certificates {
+		out = append(out, c...)
+		if len(c) > 0 && c[len(c)-1] != '\n' {
+			out = append(out, '\n')
+		}
+	}
+	return out
+}
+
+func setCARotationInProgressCondition(kcp *controlplanev1.KubeadmControlPlane, message string) {
+	msg := fmt.Sprintf("Phase %s in progress", kcp.Status.CARotation.Phase)
+	if message != "" {
+		msg += ", " + message
+	}
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneCARotationCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  controlplanev1.KubeadmControlPlaneCARotationInProgressReason,
+		Message: msg,
+	})
+}
+
+func setCARotationInternalErrorCondition(kcp *controlplanev1.KubeadmControlPlane) {
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneCARotationCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  controlplanev1.KubeadmControlPlaneCARotationInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	})
+}
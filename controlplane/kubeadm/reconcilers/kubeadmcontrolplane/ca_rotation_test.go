@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/kubeconfig"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestDesiredCARotationCertificates(t *testing.T) {
+	rotationSecret := &corev1.Secret{
+		Data: map[string][]byte{
+			secret.TLSCrtDataName:         []byte("new-crt\n"),
+			secret.TLSKeyDataName:         []byte("new-key\n"),
+			secret.PreviousTLSCrtDataName: []byte("old-crt\n"),
+			secret.PreviousTLSKeyDataName: []byte("old-key\n"),
+		},
+	}
+
+	tests := []struct {
+		phase     controlplanev1.KubeadmControlPlaneCARotationPhase
+		expectCrt string
+		expectKey string
+	}{
+		{
+			phase:     controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase,
+			expectCrt: "old-crt\nnew-crt\n",
+			expectKey: "old-key\n",
+		},
+		{
+			phase:     controlplanev1.KubeadmControlPlaneCARotationSigningWithNewCAPhase,
+			expectCrt: "new-crt\nold-crt\n",
+			expectKey: "new-key\n",
+		},
+		{
+			phase:     controlplanev1.KubeadmControlPlaneCARotationRemovingOldCAPhase,
+			expectCrt: "new-crt\n",
+			expectKey: "new-key\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.phase), func(t *testing.T) {
+			g := NewWithT(t)
+
+			crt, key := desiredCARotationCertificates(tt.phase, rotationSecret)
+			g.Expect(string(crt)).To(Equal(tt.expectCrt))
+			g.Expect(string(key)).To(Equal(tt.expectKey))
+		})
+	}
+}
+
+func TestReconcileCARotation(t *testing.T) {
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault}}
+	clusterKey := client.ObjectKeyFromObject(cluster)
+	requestedAt := metav1.NewTime(time.Now().Add(-1 * time.Hour).Truncate(time.Second))
+
+	newKCP := func(status controlplanev1.KubeadmControlPlaneCARotationStatus) *controlplanev1.KubeadmControlPlane {
+		return &controlplanev1.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault, UID: "kcp-uid"},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				CARotation: controlplanev1.KubeadmControlPlaneCARotationSpec{RequestedAt: requestedAt},
+			},
+			Status: controlplanev1.KubeadmControlPlaneStatus{CARotation: status},
+		}
+	}
+	newCASecrets := func(g *WithT, kcp *controlplanev1.KubeadmControlPlane) []client.Object {
+		owner := *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind))
+		ca := &secret.Certificate{Purpose: secret.ClusterCA}
+		g.Expect(ca.Generate()).To(Succeed())
+		caSecret := ca.AsSecret(clusterKey, owner)
+
+		rotationCA := &secret.Certificate{Purpose: secret.ClusterCARotation}
+		g.Expect(rotationCA.Generate()).To(Succeed())
+		rotationSecret := rotationCA.AsSecret(clusterKey, owner)
+		rotationSecret.Data[secret.PreviousTLSCrtDataName] = caSecret.Data[secret.TLSCrtDataName]
+		rotationSecret.Data[secret.PreviousTLSKeyDataName] = caSecret.Data[secret.TLSKeyDataName]
+		return []client.Object{caSecret, rotationSecret}
+	}
+	newMachine := func(name string, creationTimestamp time.Time) *clusterv1.Machine {
+		return &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         metav1.NamespaceDefault,
+			CreationTimestamp: metav1.NewTime(creationTimestamp),
+		}}
+	}
+	newReconciler := func(c client.Client, machines ...*clusterv1.Machine) *Reconciler {
+		return &Reconciler{
+			Client:              c,
+			SecretCachingClient: c,
+			recorder:            record.NewFakeRecorder(32),
+			managementCluster:   &fakeManagementCluster{Machines: collections.FromMachines(machines...)},
+		}
+	}
+
+	t.Run("does not set the CARotation condition if a CA rotation has never been requested", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP(controlplanev1.KubeadmControlPlaneCARotationStatus{})
+		kcp.Spec.CARotation = controlplanev1.KubeadmControlPlaneCARotationSpec{}
+		r := newReconciler(newFakeClient())
+
+		g.Expect(r.reconcileCARotation(ctx, &pkg.ControlPlane{KCP: kcp, Cluster: cluster})).To(Succeed())
+		g.Expect(conditions.Has(kcp, controlplanev1.KubeadmControlPlaneCARotationCondition)).To(BeFalse())
+	})
+
+	t.Run("CA rotation is not supported if the CA Secret is not controlled by the KubeadmControlPlane", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP(controlplanev1.KubeadmControlPlaneCARotationStatus{})
+		caSecret := newCASecrets(g, kcp)[0]
+		caSecret.SetOwnerReferences(nil)
+		r := newReconciler(newFakeClient(caSecret))
+
+		g.Expect(r.reconcileCARotation(ctx, &pkg.ControlPlane{KCP: kcp, Cluster: cluster})).To(Succeed())
+		g.Expect(kcp.Status.CARotation.Phase).To(BeEmpty())
+		condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCARotationCondition)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneCARotationNotSupportedReason))
+	})
+
+	t.Run("starts a CA rotation when requested", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP(controlplanev1.KubeadmControlPlaneCARotationStatus{})
+		caSecret := newCASecrets(g, kcp)[0]
+		oldCrt := caSecret.(*corev1.Secret).Data[secret.TLSCrtDataName]
+		oldKey := caSecret.(*corev1.Secret).Data[secret.TLSKeyDataName]
+		c := newFakeClient(caSecret)
+		owner := *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind))
+		g.Expect(kubeconfig.CreateSecretWithOwner(ctx, c, clusterKey, "localhost:6443", owner)).To(Succeed())
+		r := newReconciler(c, newMachine("m1", time.Now().Add(-2*time.Hour)))
+		// Use a SecretCachingClient which does not get the CA rotation Secret created during the reconcile,
+		// like the cache would.
+		kubeconfigSecret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: secret.Name(cluster.Name, secret.Kubeconfig)}, kubeconfigSecret)).To(Succeed())
+		r.SecretCachingClient = newFakeClient(caSecret.DeepCopyObject().(client.Object), kubeconfigSecret)
+
+		g.Expect(r.reconcileCARotation(ctx, &pkg.ControlPlane{KCP: kcp, Cluster: cluster})).To(Succeed())
+		g.Expect(kcp.Status.CARotation.Phase).To(Equal(controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase))
+		g.Expect(kcp.Status.CARotation.RequestedAt).To(Equal(requestedAt))
+		g.Expect(kcp.Status.CARotation.PhaseStartTime.IsZero()).To(BeFalse())
+
+		rotationSecret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: secret.Name(cluster.Name, secret.ClusterCARotation)}, rotationSecret)).To(Succeed())
+		g.Expect(rotationSecret.Data[secret.PreviousTLSCrtDataName]).To(Equal(oldCrt))
+		g.Expect(rotationSecret.Data[secret.PreviousTLSKeyDataName]).To(Equal(oldKey))
+
+		gotCASecret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(caSecret), gotCASecret)).To(Succeed())
+		g.Expect(gotCASecret.Data[secret.TLSCrtDataName]).To(Equal(append(append([]byte{}, oldCrt...), rotationSecret.Data[secret.TLSCrtDataName]...)))
+		g.Expect(gotCASecret.Data[secret.TLSKeyDataName]).To(Equal(oldKey))
+
+		configSecret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: secret.Name(cluster.Name, secret.Kubeconfig)}, configSecret)).To(Succeed())
+		config, err := clientcmd.Load(configSecret.Data[secret.KubeconfigDataName])
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(config.Clusters[cluster.Name].CertificateAuthorityData).To(Equal(gotCASecret.Data[secret.TLSCrtDataName]))
+
+		condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCARotationCondition)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneCARotationInProgressReason))
+		g.Expect(condition.Message).To(Equal("Phase TrustingNewCA in progress, Machine m1 is not using the CA certificates of the current phase yet and must be replaced"))
+	})
+
+	t.Run("proceeds to the next phase when all the Machines have been replaced", func(t *testing.T) {
+		g := NewWithT(t)
+
+		phaseStartTime := time.Now().Add(-30 * time.Minute)
+		kcp := newKCP(controlplanev1.KubeadmControlPlaneCARotationStatus{
+			RequestedAt:    requestedAt,
+			Phase:          controlplanev1.KubeadmControlPlaneCARotationTrustingNewCAPhase,
+			PhaseStartTime: metav1.NewTime(phaseStartTime),
+		})
+		objs := newCASecrets(g, kcp)
+		rotationSecret := objs[1].(*corev1.Secret)
+		c := newFakeClient(objs...)
+		r := newReconciler(c, newMachine("m1", phaseStartTime.Add(time.Minute)))
+
+		g.Expect(r.reconcileCARotation(ctx, &pkg.ControlPlane{KCP: kcp, Cluster: cluster})).To(Succeed())
+		g.Expect(kcp.Status.CARotation.Phase).To(Equal(controlplanev1.KubeadmControlPlaneCARotationSigningWithNewCAPhase))
+		g.Expect(kcp.Status.CARotation.PhaseStartTime.After(phaseStartTime)).To(BeTrue())
+
+		gotCASecret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(objs[0]), gotCASecret)).To(Succeed())
+		g.Expect(gotCASecret.Data[secret.TLSCrtDataName]).To(Equal(append(append([]byte{}, rotationSecret.Data[secret.TLSCrtDataName]...), rotationSecret.Data[secret.PreviousTLSCrtDataName]...)))
+		g.Expect(gotCASecret.Data[secret.TLSKeyDataName]).To(Equal(rotationSecret.Data[secret.TLSKeyDataName]))
+
+		condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCARotationCondition)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(condition.Message).To(Equal("Phase SigningWithNewCA in progress"))
+	})
+
+	t.Run("completes the CA rotation when all the Machines have been replaced in the last phase", func(t *testing.T) {
+		g := NewWithT(t)
+
+		phaseStartTime := time.Now().Add(-30 * time.Minute)
+		kcp := newKCP(controlplanev1.KubeadmControlPlaneCARotationStatus{
+			RequestedAt:    requestedAt,
+			Phase:          controlplanev1.KubeadmControlPlaneCARotationRemovingOldCAPhase,
+			PhaseStartTime: metav1.NewTime(phaseStartTime),
+		})
+		objs := newCASecrets(g, kcp)
+		rotationSecret := objs[1].(*corev1.Secret)
+		c := newFakeClient(objs...)
+		r := newReconciler(c, newMachine("m1", phaseStartTime.Add(time.Minute)))
+
+		g.Expect(r.reconcileCARotation(ctx, &pkg.ControlPlane{KCP: kcp, Cluster: cluster})).To(Succeed())
+		g.Expect(kcp.Status.CARotation.Phase).To(BeEmpty())
+		g.Expect(kcp.Status.CARotation.PhaseStartTime.IsZero()).To(BeTrue())
+		g.Expect(kcp.Status.CARotation.CompletionTime.IsZero()).To(BeFalse())
+
+		gotCASecret := &corev1.Secret{}
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(objs[0]), gotCASecret)).To(Succeed())
+		g.Expect(gotCASecret.Data[secret.TLSCrtDataName]).To(Equal(rotationSecret.Data[secret.TLSCrtDataName]))
+		g.Expect(gotCASecret.Data[secret.TLSKeyDataName]).To(Equal(rotationSecret.Data[secret.TLSKeyDataName]))
+
+		err := c.Get(ctx, client.ObjectKeyFromObject(rotationSecret), &corev1.Secret{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		condition := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCARotationCondition)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(condition.Reason).To(Equal(controlplanev1.KubeadmControlPlaneCARotationCompletedReason))
+
+		// A completed rotation is not restarted.
+		g.Expect(r.reconcileCARotation(ctx, &pkg.ControlPlane{KCP: kcp, Cluster: cluster})).To(Succeed())
+		g.Expect(kcp.Status.CARotation.Phase).To(BeEmpty())
+	})
+}
@@ -30,4 +30,8 @@ const (
 	// dependentCertRequeueAfter is how long to wait before checking again to see if
 	// dependent certificates have been created.
 	dependentCertRequeueAfter = 30 * time.Second
+
+	// caRotationRequeueAfter is how long to wait before checking again if all the Machines
+	// of a Cluster have been replaced during a CA rotation.
+	caRotationRequeueAfter = 1 * time.Minute
 )
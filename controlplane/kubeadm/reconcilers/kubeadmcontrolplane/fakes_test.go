@@ -78,9 +78,11 @@ type fakeWorkloadCluster struct {
 	KubeadmConfigExist            bool
 	APIServerCertificateExpiry    *time.Time
 	OverrideForwardEtcdLeadership func(context.Context, string, string) error
+	EtcdSnapshot                  []byte
 
 	forwardEtcdLeadershipCalled int
 	removeEtcdMemberCalled      int
+	snapshotEtcdCalled          int
 }
 
 func (f *fakeWorkloadCluster) ForwardEtcdLeadership(ctx context.Context, member, leaderCandidate string) error {
@@ -91,6 +93,11 @@ func (f *fakeWorkloadCluster) ForwardEtcdLeadership(ctx context.Context, member,
 	return nil
 }
 
+func (f *fakeWorkloadCluster) SnapshotEtcd(_ context.Context, _ string) ([]byte, error) {
+	f.snapshotEtcdCalled++
+	return f.EtcdSnapshot, nil
+}
+
 func (f *fakeWorkloadCluster) HasKubeadmConfig(_ context.Context) (bool, error) {
 	return f.KubeadmConfigExist, nil
 }
@@ -36,6 +36,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/certs"
@@ -255,6 +256,13 @@ func (r *Reconciler) createKubeadmConfig(ctx context.Context, kcp *controlplanev
 		return nil, clusterv1.ContractVersionedObjectReference{}, pkgerrors.Wrapf(err, "failed to create KubeadmConfig")
 	}
 
+	// If the control plane is waking up from hibernation, restore etcd from the snapshot on the first Machine.
+	if !isJoin && feature.Gates.Enabled(feature.KubeadmControlPlaneHibernation) {
+		if err := r.addEtcdSnapshotRestore(ctx, kcp, cluster, kubeadmConfig); err != nil {
+			return nil, clusterv1.ContractVersionedObjectReference{}, pkgerrors.Wrapf(err, "failed to create KubeadmConfig")
+		}
+	}
+
 	// Create the full object with capi-kubeadmcontrolplane.
 	// Below ssa.RemoveManagedFieldsForLabelsAndAnnotations will drop ownership for labels and annotations
 	// so that in a subsequent syncMachines call capi-kubeadmcontrolplane-metadata can take ownership for them.
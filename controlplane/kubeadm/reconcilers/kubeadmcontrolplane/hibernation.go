@@ -0,0 +1,356 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// etcdSnapshotUploadTimeout is the timeout for uploading the etcd snapshot to the etcd snapshot store.
+	etcdSnapshotUploadTimeout = 5 * time.Minute
+
+	// etcdSnapshotSecretURLKey is the key of the etcd snapshot Secret holding the URL of the etcd snapshot.
+	etcdSnapshotSecretURLKey = "url"
+
+	// etcdSnapshotSecretSHA256Key is the key of the etcd snapshot Secret holding the hex encoded sha256 digest
+	// of the gzip compressed etcd snapshot.
+	etcdSnapshotSecretSHA256Key = "sha256"
+)
+
+// etcdSnapshotSecretName returns the name of the Secret referencing the etcd snapshot of a hibernated control plane.
+// Note: The etcd snapshot is stored in the etcd snapshot store defined in the KubeadmControlPlane, while the Secret
+// only records its URL and digest; a Secret is used because the URL might contain credentials, e.g. a signed URL.
+func etcdSnapshotSecretName(clusterName string) string {
+	return fmt.Sprintf("%s-etcd-snapshot", clusterName)
+}
+
+// reconcileHibernation sets the Hibernated condition and handles the lifecycle of a control plane that has been
+// scaled down to zero replicas, including waking it up when replicas are increased again.
+// It returns true if the rest of the reconcile should be skipped, e.g. because there is no control plane Machine
+// to connect to.
+func (r *Reconciler) reconcileHibernation(ctx context.Context, controlPlane *pkg.ControlPlane) (bool, error) {
+	if !feature.Gates.Enabled(feature.KubeadmControlPlaneHibernation) {
+		return false, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	// A control plane that has never been initialized cannot be hibernated.
+	if !ptr.Deref(controlPlane.KCP.Status.Initialization.ControlPlaneInitialized, false) {
+		setHibernatedCondition(controlPlane.KCP, metav1.ConditionFalse, controlplanev1.KubeadmControlPlaneNotHibernatedReason, "")
+		return false, nil
+	}
+
+	desiredReplicas := ptr.Deref(controlPlane.KCP.Spec.Replicas, 1)
+	wasHibernated := conditions.IsTrue(controlPlane.KCP, controlplanev1.KubeadmControlPlaneHibernatedCondition) ||
+		conditions.GetReason(controlPlane.KCP, controlplanev1.KubeadmControlPlaneHibernatedCondition) == controlplanev1.KubeadmControlPlaneWakingUpReason
+
+	switch {
+	// The control plane is hibernated, there is nothing to do until replicas are increased.
+	case controlPlane.Machines.Len() == 0 && desiredReplicas == 0:
+		setHibernatedCondition(controlPlane.KCP, metav1.ConditionTrue, controlplanev1.KubeadmControlPlaneHibernatedReason, "")
+		setConditionsToUnknown(setConditionsToUnknownInput{
+			ControlPlane:                        controlPlane,
+			Overwrite:                           true,
+			EtcdClusterHealthyReason:            controlplanev1.KubeadmControlPlaneEtcdClusterInspectionFailedReason,
+			ControlPlaneComponentsHealthyReason: controlplanev1.KubeadmControlPlaneControlPlaneComponentsInspectionFailedReason,
+			NodeReason:                          controlplanev1.KubeadmControlPlaneMachineNodeKubeadmLabelsAndTaintsInspectionFailedReason,
+			StaticPodReason:                     controlplanev1.KubeadmControlPlaneMachinePodInspectionFailedReason,
+			EtcdMemberHealthyReason:             controlplanev1.KubeadmControlPlaneMachineEtcdMemberInspectionFailedReason,
+			Message:                             "Control plane is hibernated",
+		})
+		return true, nil
+
+	// The control plane has been scaled up from zero replicas, create the first Machine.
+	// Note: The etcd snapshot taken when hibernating is restored by the first Machine, if any (see createKubeadmConfig).
+	case controlPlane.Machines.Len() == 0:
+		setHibernatedCondition(controlPlane.KCP, metav1.ConditionFalse, controlplanev1.KubeadmControlPlaneWakingUpReason, "Creating the first control plane Machine")
+		log.Info("Waking up hibernated control plane", "desiredReplicas", desiredReplicas)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneWakingUpEventReason, "Waking up hibernated control plane of cluster %s", klog.KObj(controlPlane.Cluster))
+		if _, err := r.initializeControlPlane(ctx, controlPlane); err != nil {
+			return true, err
+		}
+		return true, nil
+
+	// The first Machine is being provisioned; there is no workload cluster to connect to yet.
+	case wasHibernated && controlPlane.Machines.Filter(collections.HasNode()).Len() == 0:
+		setHibernatedCondition(controlPlane.KCP, metav1.ConditionFalse, controlplanev1.KubeadmControlPlaneWakingUpReason, "Waiting for the first control plane Machine to be provisioned")
+		return true, nil
+	}
+
+	// The control plane is up again, the etcd snapshot is not required anymore.
+	if wasHibernated {
+		if err := r.deleteEtcdSnapshotSecret(ctx, controlPlane); err != nil {
+			return false, err
+		}
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneWokeUpEventReason, "Woke up hibernated control plane of cluster %s", klog.KObj(controlPlane.Cluster))
+	}
+
+	if desiredReplicas == 0 {
+		setHibernatedCondition(controlPlane.KCP, metav1.ConditionFalse, controlplanev1.KubeadmControlPlaneHibernatingReason, fmt.Sprintf("Deleting %d control plane Machines", controlPlane.Machines.Len()))
+		return false, nil
+	}
+	setHibernatedCondition(controlPlane.KCP, metav1.ConditionFalse, controlplanev1.KubeadmControlPlaneNotHibernatedReason, "")
+	return false, nil
+}
+
+// isHibernating returns true if the last control plane Machine is going to be deleted because the control plane
+// has been scaled down to zero replicas.
+func isHibernating(controlPlane *pkg.ControlPlane) bool {
+	return feature.Gates.Enabled(feature.KubeadmControlPlaneHibernation) &&
+		ptr.Deref(controlPlane.KCP.Spec.Replicas, 1) == 0 &&
+		controlPlane.Machines.Len() == 1
+}
+
+func setHibernatedCondition(kcp *controlplanev1.KubeadmControlPlane, status metav1.ConditionStatus, reason, message string) {
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneHibernatedCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// snapshotEtcdForHibernation takes a snapshot of etcd from the last control plane Machine, uploads it to the
+// etcd snapshot store and records its URL and digest in a Secret, so it can be restored when waking up the control plane.
+func (r *Reconciler) snapshotEtcdForHibernation(ctx context.Context, controlPlane *pkg.ControlPlane, workloadCluster pkg.WorkloadCluster, machine *clusterv1.Machine) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	store := controlPlane.KCP.Spec.Hibernation.EtcdSnapshot
+	if store.URL == "" {
+		return pkgerrors.New("failed to take etcd snapshot: spec.hibernation.etcdSnapshot.url must be set")
+	}
+	// Note: The etcd snapshot contains all the Secrets of the workload cluster, so it is only uploaded
+	// via https to the hosts explicitly allowed by the administrator of the management cluster.
+	if err := validateEtcdSnapshotStoreURL(store.URL, r.EtcdSnapshotStoreAllowedHosts); err != nil {
+		return pkgerrors.Wrap(err, "failed to take etcd snapshot")
+	}
+
+	if !machine.Status.NodeRef.IsDefined() {
+		return pkgerrors.Errorf("failed to take etcd snapshot: Machine %s does not have a corresponding Node yet", klog.KObj(machine))
+	}
+
+	headers, err := r.getEtcdSnapshotHeaders(ctx, controlPlane.KCP)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := workloadCluster.SnapshotEtcd(ctx, machine.Status.NodeRef.Name)
+	if err != nil {
+		return err
+	}
+
+	compressedSnapshot, err := compressEtcdSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := r.uploadEtcdSnapshot(ctx, store.URL, headers, compressedSnapshot); err != nil {
+		return err
+	}
+	digest := sha256.Sum256(compressedSnapshot)
+
+	snapshotSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      etcdSnapshotSecretName(controlPlane.Cluster.Name),
+			Namespace: controlPlane.KCP.Namespace,
+		},
+	}
+	snapshotSecretData := map[string][]byte{
+		etcdSnapshotSecretURLKey:    []byte(store.URL),
+		etcdSnapshotSecretSHA256Key: []byte(hex.EncodeToString(digest[:])),
+	}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(snapshotSecret), snapshotSecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return pkgerrors.Wrapf(err, "failed to get etcd snapshot Secret %s", klog.KObj(snapshotSecret))
+		}
+		snapshotSecret.Labels = map[string]string{
+			clusterv1.ClusterNameLabel: controlPlane.Cluster.Name,
+		}
+		snapshotSecret.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(controlPlane.KCP, controlplanev1.GroupVersion.WithKind("KubeadmControlPlane")),
+		}
+		snapshotSecret.Type = clusterv1.ClusterSecretType
+		snapshotSecret.Data = snapshotSecretData
+		if err := r.Client.Create(ctx, snapshotSecret); err != nil {
+			return pkgerrors.Wrapf(err, "failed to create etcd snapshot Secret %s", klog.KObj(snapshotSecret))
+		}
+	} else {
+		snapshotSecret.Data = snapshotSecretData
+		if err := r.Client.Update(ctx, snapshotSecret); err != nil {
+			return pkgerrors.Wrapf(err, "failed to update etcd snapshot Secret %s", klog.KObj(snapshotSecret))
+		}
+	}
+
+	log.Info("Stored etcd snapshot", "Machine", klog.KObj(machine), "Secret", klog.KObj(snapshotSecret), "size", len(compressedSnapshot))
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneEtcdSnapshotTakenEventReason,
+		"Stored etcd snapshot taken from Machine %s, referenced by Secret %s", machine.Name, snapshotSecret.Name)
+	return nil
+}
+
+// getEtcdSnapshotHeaders returns the HTTP headers to send when uploading the etcd snapshot, if any.
+func (r *Reconciler) getEtcdSnapshotHeaders(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) (http.Header, error) {
+	headers := http.Header{}
+	secretName := kcp.Spec.Hibernation.EtcdSnapshot.HeadersSecretName
+	if secretName == "" {
+		return headers, nil
+	}
+
+	headersSecret := &corev1.Secret{}
+	headersSecretKey := client.ObjectKey{Namespace: kcp.Namespace, Name: secretName}
+	if err := r.Client.Get(ctx, headersSecretKey, headersSecret); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get etcd snapshot headers Secret %s", headersSecretKey)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(headersSecret.Data[desiredstate.EtcdSnapshotHeadersSecretKey]))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, pkgerrors.Errorf("failed to parse etcd snapshot headers Secret %s: headers must be in the \"Name: value\" format", headersSecretKey)
+		}
+		headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	return headers, nil
+}
+
+// validateEtcdSnapshotStoreURL returns an error if the URL of the etcd snapshot store is not an https URL
+// of one of the allowed hosts.
+func validateEtcdSnapshotStoreURL(storeURL string, allowedHosts []string) error {
+	u, err := url.Parse(storeURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return pkgerrors.New("spec.hibernation.etcdSnapshot.url must be a valid https URL")
+	}
+	if !slices.ContainsFunc(allowedHosts, func(host string) bool { return strings.EqualFold(host, u.Hostname()) }) {
+		return pkgerrors.Errorf("host %q of spec.hibernation.etcdSnapshot.url is not allowed, it must be added to the --etcd-snapshot-store-allowed-hosts flag of the KubeadmControlPlane controller", u.Hostname())
+	}
+	return nil
+}
+
+// compressEtcdSnapshot compresses the etcd snapshot with gzip.
+func compressEtcdSnapshot(snapshot []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(snapshot); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to compress etcd snapshot")
+	}
+	if err := gz.Close(); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to compress etcd snapshot")
+	}
+	return buf.Bytes(), nil
+}
+
+// uploadEtcdSnapshot uploads the compressed etcd snapshot to the etcd snapshot store with an HTTP PUT request.
+func (r *Reconciler) uploadEtcdSnapshot(ctx context.Context, url string, headers http.Header, compressedSnapshot []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(compressedSnapshot))
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to upload etcd snapshot")
+	}
+	req.Header = headers.Clone()
+	req.Header.Set("Content-Type", "application/gzip")
+
+	httpClient := &http.Client{
+		Transport: r.overrideEtcdSnapshotStoreTransport,
+		Timeout:   etcdSnapshotUploadTimeout,
+		// Redirects are not followed, otherwise the etcd snapshot could be sent to a host that is not allowed.
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to upload etcd snapshot")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return pkgerrors.Errorf("failed to upload etcd snapshot: response status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// addEtcdSnapshotRestore adds to the KubeadmConfig of the first control plane Machine the files and the commands
+// required to download and restore the etcd snapshot taken when hibernating the control plane, if any.
+func (r *Reconciler) addEtcdSnapshotRestore(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane, cluster *clusterv1.Cluster, kubeadmConfig *bootstrapv1.KubeadmConfig) error {
+	snapshotSecret := &corev1.Secret{}
+	snapshotSecretKey := client.ObjectKey{Namespace: kcp.Namespace, Name: etcdSnapshotSecretName(cluster.Name)}
+	if err := r.Client.Get(ctx, snapshotSecretKey, snapshotSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return pkgerrors.Wrapf(err, "failed to get etcd snapshot Secret %s", snapshotSecretKey)
+	}
+
+	snapshot := desiredstate.EtcdSnapshot{
+		URL:               string(snapshotSecret.Data[etcdSnapshotSecretURLKey]),
+		SHA256:            string(snapshotSecret.Data[etcdSnapshotSecretSHA256Key]),
+		HeadersSecretName: kcp.Spec.Hibernation.EtcdSnapshot.HeadersSecretName,
+	}
+	if snapshot.URL == "" || snapshot.SHA256 == "" {
+		return pkgerrors.Errorf("etcd snapshot Secret %s must contain the %s and %s keys", snapshotSecretKey, etcdSnapshotSecretURLKey, etcdSnapshotSecretSHA256Key)
+	}
+
+	desiredstate.AddEtcdSnapshotRestore(&kubeadmConfig.Spec, snapshot)
+	return nil
+}
+
+// deleteEtcdSnapshotSecret deletes the Secret referencing the etcd snapshot of a hibernated control plane.
+// Note: The etcd snapshot is not deleted from the etcd snapshot store, it is overwritten on the next hibernation.
+func (r *Reconciler) deleteEtcdSnapshotSecret(ctx context.Context, controlPlane *pkg.ControlPlane) error {
+	snapshotSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      etcdSnapshotSecretName(controlPlane.Cluster.Name),
+			Namespace: controlPlane.KCP.Namespace,
+		},
+	}
+	if err := r.Client.Delete(ctx, snapshotSecret); err != nil && !apierrors.IsNotFound(err) {
+		return pkgerrors.Wrapf(err, "failed to delete etcd snapshot Secret %s", klog.KObj(snapshotSecret))
+	}
+	return nil
+}
@@ -0,0 +1,413 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileHibernation(t *testing.T) {
+	hibernatedCondition := metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneHibernatedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: controlplanev1.KubeadmControlPlaneHibernatedReason,
+	}
+	wakingUpCondition := metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneHibernatedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: controlplanev1.KubeadmControlPlaneWakingUpReason,
+	}
+	provisionedMachine := machine("one")
+	setMachineHealthy(provisionedMachine)
+
+	tests := []struct {
+		name                 string
+		featureEnabled       bool
+		initialized          bool
+		replicas             int32
+		machines             collections.Machines
+		condition            *metav1.Condition
+		expectStopReconcile  bool
+		expectConditionSet   bool
+		expectStatus         metav1.ConditionStatus
+		expectReason         string
+		expectSecretDeletion bool
+	}{
+		{
+			name:           "no-op if the feature gate is disabled",
+			featureEnabled: false,
+			initialized:    true,
+			replicas:       0,
+			machines:       collections.Machines{},
+		},
+		{
+			name:               "not hibernated if the control plane is not initialized",
+			featureEnabled:     true,
+			initialized:        false,
+			replicas:           1,
+			machines:           collections.Machines{},
+			expectConditionSet: true,
+			expectStatus:       metav1.ConditionFalse,
+			expectReason:       controlplanev1.KubeadmControlPlaneNotHibernatedReason,
+		},
+		{
+			name:                "hibernated if there are no machines and replicas is zero",
+			featureEnabled:      true,
+			initialized:         true,
+			replicas:            0,
+			machines:            collections.Machines{},
+			expectStopReconcile: true,
+			expectConditionSet:  true,
+			expectStatus:        metav1.ConditionTrue,
+			expectReason:        controlplanev1.KubeadmControlPlaneHibernatedReason,
+		},
+		{
+			name:               "hibernating if there are machines and replicas is zero",
+			featureEnabled:     true,
+			initialized:        true,
+			replicas:           0,
+			machines:           collections.FromMachines(provisionedMachine),
+			expectConditionSet: true,
+			expectStatus:       metav1.ConditionFalse,
+			expectReason:       controlplanev1.KubeadmControlPlaneHibernatingReason,
+		},
+		{
+			name:                "waking up if the first machine does not have a node yet",
+			featureEnabled:      true,
+			initialized:         true,
+			replicas:            1,
+			machines:            collections.FromMachines(machine("one")),
+			condition:           &wakingUpCondition,
+			expectStopReconcile: true,
+			expectConditionSet:  true,
+			expectStatus:        metav1.ConditionFalse,
+			expectReason:        controlplanev1.KubeadmControlPlaneWakingUpReason,
+		},
+		{
+			name:                 "woke up if the first machine has a node",
+			featureEnabled:       true,
+			initialized:          true,
+			replicas:             1,
+			machines:             collections.FromMachines(provisionedMachine),
+			condition:            &hibernatedCondition,
+			expectConditionSet:   true,
+			expectStatus:         metav1.ConditionFalse,
+			expectReason:         controlplanev1.KubeadmControlPlaneNotHibernatedReason,
+			expectSecretDeletion: true,
+		},
+		{
+			name:               "not hibernated if machines without a node are not waking up",
+			featureEnabled:     true,
+			initialized:        true,
+			replicas:           1,
+			machines:           collections.FromMachines(machine("one")),
+			expectConditionSet: true,
+			expectStatus:       metav1.ConditionFalse,
+			expectReason:       controlplanev1.KubeadmControlPlaneNotHibernatedReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmControlPlaneHibernation, tt.featureEnabled)
+
+			cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault}}
+			kcp := &controlplanev1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault},
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Replicas: ptr.To(tt.replicas),
+				},
+				Status: controlplanev1.KubeadmControlPlaneStatus{
+					Initialization: controlplanev1.KubeadmControlPlaneInitializationStatus{
+						ControlPlaneInitialized: ptr.To(tt.initialized),
+					},
+				},
+			}
+			if tt.condition != nil {
+				conditions.Set(kcp, *tt.condition)
+			}
+			snapshotSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      etcdSnapshotSecretName(cluster.Name),
+					Namespace: metav1.NamespaceDefault,
+				},
+			}
+			fakeClient := newFakeClient(snapshotSecret)
+
+			r := &Reconciler{
+				Client:   fakeClient,
+				recorder: record.NewFakeRecorder(32),
+			}
+			controlPlane := &pkg.ControlPlane{
+				KCP:      kcp,
+				Cluster:  cluster,
+				Machines: tt.machines,
+			}
+
+			stopReconcile, err := r.reconcileHibernation(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(stopReconcile).To(Equal(tt.expectStopReconcile))
+
+			c := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneHibernatedCondition)
+			if !tt.expectConditionSet {
+				g.Expect(c).To(BeNil())
+			} else {
+				g.Expect(c).ToNot(BeNil())
+				g.Expect(c.Status).To(Equal(tt.expectStatus))
+				g.Expect(c.Reason).To(Equal(tt.expectReason))
+			}
+
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(snapshotSecret), &corev1.Secret{})
+			if tt.expectSecretDeletion {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestSnapshotEtcdForHibernation(t *testing.T) {
+	newControlPlane := func(url string, m *clusterv1.Machine) *pkg.ControlPlane {
+		return &pkg.ControlPlane{
+			KCP: &controlplanev1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault},
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Hibernation: controlplanev1.KubeadmControlPlaneHibernationSpec{
+						EtcdSnapshot: controlplanev1.EtcdSnapshotStore{
+							URL:               url,
+							HeadersSecretName: "foo-etcd-snapshot-headers",
+						},
+					},
+				},
+			},
+			Cluster:  &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault}},
+			Machines: collections.FromMachines(m),
+		}
+	}
+	headersSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-etcd-snapshot-headers", Namespace: metav1.NamespaceDefault},
+		Data: map[string][]byte{
+			desiredstate.EtcdSnapshotHeadersSecretKey: []byte("Authorization: Bearer token\nX-Foo: bar\n"),
+		},
+	}
+
+	t.Run("uploads the etcd snapshot and references it in a Secret", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var uploaded []byte
+		var uploadHeaders http.Header
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPut {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			uploadHeaders = req.Header
+			uploaded, _ = io.ReadAll(req.Body)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		m := machine("one")
+		setMachineHealthy(m)
+		fakeClient := newFakeClient(m, headersSecret.DeepCopy())
+		recorder := record.NewFakeRecorder(32)
+		workloadCluster := &fakeWorkloadCluster{EtcdSnapshot: []byte("snapshot")}
+
+		r := &Reconciler{
+			Client:                             fakeClient,
+			recorder:                           recorder,
+			EtcdSnapshotStoreAllowedHosts:      []string{"127.0.0.1"},
+			overrideEtcdSnapshotStoreTransport: server.Client().Transport,
+		}
+		controlPlane := newControlPlane(server.URL+"/foo-etcd-snapshot.db.gz", m)
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, controlPlane, workloadCluster, m)).To(Succeed())
+		g.Expect(workloadCluster.snapshotEtcdCalled).To(Equal(1))
+		g.Expect(recorder.Events).To(Receive(Equal("Normal EtcdSnapshotTaken Stored etcd snapshot taken from Machine one, referenced by Secret foo-etcd-snapshot")))
+
+		g.Expect(uploadHeaders.Get("Authorization")).To(Equal("Bearer token"))
+		g.Expect(uploadHeaders.Get("X-Foo")).To(Equal("bar"))
+		gz, err := gzip.NewReader(bytes.NewReader(uploaded))
+		g.Expect(err).ToNot(HaveOccurred())
+		snapshot, err := io.ReadAll(gz)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshot).To(Equal([]byte("snapshot")))
+
+		snapshotSecret := &corev1.Secret{}
+		g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "foo-etcd-snapshot"}, snapshotSecret)).To(Succeed())
+		g.Expect(snapshotSecret.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "foo"))
+		g.Expect(snapshotSecret.OwnerReferences).To(HaveLen(1))
+		g.Expect(snapshotSecret.OwnerReferences[0].Kind).To(Equal("KubeadmControlPlane"))
+		digest := sha256.Sum256(uploaded)
+		g.Expect(snapshotSecret.Data).To(Equal(map[string][]byte{
+			etcdSnapshotSecretURLKey:    []byte(server.URL + "/foo-etcd-snapshot.db.gz"),
+			etcdSnapshotSecretSHA256Key: []byte(hex.EncodeToString(digest[:])),
+		}))
+
+		// The KubeadmConfig of the first Machine downloads the etcd snapshot referenced by the Secret.
+		kubeadmConfig := &bootstrapv1.KubeadmConfig{}
+		g.Expect(r.addEtcdSnapshotRestore(ctx, controlPlane.KCP, controlPlane.Cluster, kubeadmConfig)).To(Succeed())
+		g.Expect(desiredstate.HasEtcdSnapshotRestore(&kubeadmConfig.Spec)).To(BeTrue())
+		g.Expect(kubeadmConfig.Spec.Files).To(ContainElement(HaveField("Content", ContainSubstring(hex.EncodeToString(digest[:])))))
+		g.Expect(kubeadmConfig.Spec.Files).To(ContainElement(HaveField("ContentFrom.Secret.Name", "foo-etcd-snapshot-headers")))
+	})
+
+	t.Run("fails if the etcd snapshot upload fails", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		m := machine("one")
+		setMachineHealthy(m)
+		fakeClient := newFakeClient(m, headersSecret.DeepCopy())
+		r := &Reconciler{
+			Client:                             fakeClient,
+			recorder:                           record.NewFakeRecorder(32),
+			EtcdSnapshotStoreAllowedHosts:      []string{"127.0.0.1"},
+			overrideEtcdSnapshotStoreTransport: server.Client().Transport,
+		}
+		controlPlane := newControlPlane(server.URL+"/foo-etcd-snapshot.db.gz", m)
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, controlPlane, &fakeWorkloadCluster{EtcdSnapshot: []byte("snapshot")}, m)).
+			To(MatchError(ContainSubstring("response status code 403")))
+		err := fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "foo-etcd-snapshot"}, &corev1.Secret{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("fails if the etcd snapshot store redirects the upload", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var redirected bool
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/other" {
+				redirected = true
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			http.Redirect(w, req, "/other", http.StatusTemporaryRedirect)
+		}))
+		defer server.Close()
+
+		m := machine("one")
+		setMachineHealthy(m)
+		fakeClient := newFakeClient(m, headersSecret.DeepCopy())
+		r := &Reconciler{
+			Client:                             fakeClient,
+			recorder:                           record.NewFakeRecorder(32),
+			EtcdSnapshotStoreAllowedHosts:      []string{"127.0.0.1"},
+			overrideEtcdSnapshotStoreTransport: server.Client().Transport,
+		}
+		controlPlane := newControlPlane(server.URL+"/foo-etcd-snapshot.db.gz", m)
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, controlPlane, &fakeWorkloadCluster{EtcdSnapshot: []byte("snapshot")}, m)).
+			To(MatchError(ContainSubstring("response status code 307")))
+		g.Expect(redirected).To(BeFalse())
+	})
+
+	t.Run("fails if the etcd snapshot store is not an https URL", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m := machine("one")
+		setMachineHealthy(m)
+		workloadCluster := &fakeWorkloadCluster{}
+		r := &Reconciler{
+			Client:                        newFakeClient(m),
+			recorder:                      record.NewFakeRecorder(32),
+			EtcdSnapshotStoreAllowedHosts: []string{"storage.example.com"},
+		}
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, newControlPlane("http://storage.example.com/foo-etcd-snapshot.db.gz", m), workloadCluster, m)).
+			To(MatchError(ContainSubstring("must be a valid https URL")))
+		g.Expect(workloadCluster.snapshotEtcdCalled).To(Equal(0))
+	})
+
+	t.Run("fails if the host of the etcd snapshot store is not allowed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m := machine("one")
+		setMachineHealthy(m)
+		workloadCluster := &fakeWorkloadCluster{}
+		r := &Reconciler{
+			Client:                        newFakeClient(m),
+			recorder:                      record.NewFakeRecorder(32),
+			EtcdSnapshotStoreAllowedHosts: []string{"storage.example.com"},
+		}
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, newControlPlane("https://169.254.169.254/foo-etcd-snapshot.db.gz", m), workloadCluster, m)).
+			To(MatchError(ContainSubstring("is not allowed")))
+		g.Expect(workloadCluster.snapshotEtcdCalled).To(Equal(0))
+	})
+
+	t.Run("fails if the etcd snapshot store is not defined", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m := machine("one")
+		setMachineHealthy(m)
+		workloadCluster := &fakeWorkloadCluster{}
+		r := &Reconciler{
+			Client:   newFakeClient(m),
+			recorder: record.NewFakeRecorder(32),
+		}
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, newControlPlane("", m), workloadCluster, m)).ToNot(Succeed())
+		g.Expect(workloadCluster.snapshotEtcdCalled).To(Equal(0))
+	})
+
+	t.Run("fails if the Machine does not have a Node", func(t *testing.T) {
+		g := NewWithT(t)
+
+		m := machine("one")
+		workloadCluster := &fakeWorkloadCluster{}
+		r := &Reconciler{
+			Client:                        newFakeClient(m),
+			recorder:                      record.NewFakeRecorder(32),
+			EtcdSnapshotStoreAllowedHosts: []string{"storage.example.com"},
+		}
+
+		g.Expect(r.snapshotEtcdForHibernation(ctx, newControlPlane("https://storage.example.com/foo-etcd-snapshot.db.gz", m), workloadCluster, m)).ToNot(Succeed())
+		g.Expect(workloadCluster.snapshotEtcdCalled).To(Equal(0))
+	})
+}
@@ -27,7 +27,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
 	"sigs.k8s.io/cluster-api/internal/hooks"
@@ -128,13 +130,19 @@ func (r *Reconciler) triggerInPlaceUpdate(ctx context.Context, controlPlane *pkg
 	//       have to ensure we preserve PendingHooksAnnotation on existing Machines in KCP and that would lead to race
 	//       conditions when the Machine controller tries to remove the annotation and KCP adds it back.
 	// Note: This call will update the resourceVersion on desiredMachine, so that WaitForCacheToBeUpToDate also considers this change.
-	if err := hooks.MarkAsPending(ctx, r.Client, desiredMachine, true, runtimehooksv1.UpdateMachine); err != nil {
+	// Note: If the in-place update is a Kubernetes version upgrade, also track the intent to call the AfterControlPlaneMachineUpgrade
+	//       hook once the Machine completes the upgrade.
+	pendingHooks := []runtimecatalog.Hook{runtimehooksv1.UpdateMachine}
+	if isControlPlaneMachineUpgrade(desiredMachine.Spec.Version, machine) {
+		pendingHooks = append(pendingHooks, afterControlPlaneMachineUpgradeHooks()...)
+	}
+	if err := hooks.MarkAsPending(ctx, r.Client, desiredMachine, true, pendingHooks...); err != nil {
 		return pkgerrors.Wrapf(err, "failed to complete triggering in-place update for Machine %s", klog.KObj(machine))
 	}
 	r.controller.DeferNextReconcileUntilCacheUpToDate(controlPlane.KCP, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "Machine"), desiredMachine.ResourceVersion)
 
 	log.Info(fmt.Sprintf("Completed triggering in-place update for Machine %s", klog.KObj(machine)))
-	r.recorder.Event(machine, corev1.EventTypeNormal, "SuccessfulStartInPlaceUpdate", "Machine starting in-place update")
+	r.recorder.Event(machine, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneSuccessfulStartInPlaceUpdateEventReason, "Machine starting in-place update")
 
 	return nil
 }
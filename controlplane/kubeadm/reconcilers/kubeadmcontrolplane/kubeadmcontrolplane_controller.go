@@ -19,6 +19,7 @@ package kubeadmcontrolplane
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"slices"
 	"sort"
@@ -31,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -65,7 +67,9 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	capirecord "sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/cluster-api/util/secret"
+	"sigs.k8s.io/cluster-api/util/tracing"
 	"sigs.k8s.io/cluster-api/util/version"
 )
 
@@ -90,6 +94,27 @@ var (
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
+// kcpEventReasons are the reasons of the events emitted by the KubeadmControlPlane controller.
+var kcpEventReasons = []string{
+	controlplanev1.KubeadmControlPlaneAdoptionFailedEventReason,
+	controlplanev1.KubeadmControlPlaneCARotationCompletedEventReason,
+	controlplanev1.KubeadmControlPlaneCARotationPhaseStartedEventReason,
+	controlplanev1.KubeadmControlPlaneEtcdLeadershipForwardedEventReason,
+	controlplanev1.KubeadmControlPlaneEtcdMemberRemovedEventReason,
+	controlplanev1.KubeadmControlPlaneEtcdSnapshotTakenEventReason,
+	controlplanev1.KubeadmControlPlaneFailedDeleteEventReason,
+	controlplanev1.KubeadmControlPlaneFailedInitializationEventReason,
+	controlplanev1.KubeadmControlPlaneFailedScaleDownEventReason,
+	controlplanev1.KubeadmControlPlaneFailedScaleUpEventReason,
+	controlplanev1.KubeadmControlPlaneMachineLabeledForAdoptionEventReason,
+	controlplanev1.KubeadmControlPlaneRolloutMachineCreatedEventReason,
+	controlplanev1.KubeadmControlPlaneRolloutMachineDeletedEventReason,
+	controlplanev1.KubeadmControlPlaneSuccessfulStartInPlaceUpdateEventReason,
+	controlplanev1.KubeadmControlPlaneUnhealthyEventReason,
+	controlplanev1.KubeadmControlPlaneWakingUpEventReason,
+	controlplanev1.KubeadmControlPlaneWokeUpEventReason,
+}
+
 // Reconciler reconciles a KubeadmControlPlane object.
 type Reconciler struct {
 	Client                          client.Client
@@ -105,9 +130,25 @@ type Reconciler struct {
 	EtcdCallTimeout time.Duration
 	EtcdLogger      *zap.Logger
 
+	// EtcdDBSizeWarningThreshold is the percentage of the etcd database quota above which
+	// a warning is surfaced in the EtcdClusterHealthy condition; 0 disables the warning.
+	EtcdDBSizeWarningThreshold int32
+
+	// EtcdSnapshotStoreAllowedHosts are the hosts the etcd snapshot of a hibernated control plane can be uploaded to;
+	// if empty, control planes with etcd managed by the KubeadmControlPlane cannot be hibernated.
+	EtcdSnapshotStoreAllowedHosts []string
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// ClusterRateLimiter limits the reconciles per second for the objects of a single Cluster.
+	// If nil, reconciles are not rate-limited per Cluster.
+	ClusterRateLimiter *capicontrollerutil.ClusterRateLimiter
+
+	// EventDeduplicationWindow is the window within which identical events for the same object are only emitted once.
+	// If zero, events are not deduplicated.
+	EventDeduplicationWindow time.Duration
+
 	RemoteConditionsGracePeriod time.Duration
 
 	managementCluster pkg.ManagementCluster
@@ -121,6 +162,7 @@ type Reconciler struct {
 	overrideCanUpdateMachineFunc       func(ctx context.Context, machine *clusterv1.Machine, machineUpToDateResult pkg.UpToDateResult) (bool, error)
 	overrideCanExtensionsUpdateMachine func(ctx context.Context, machine *clusterv1.Machine, machineUpToDateResult pkg.UpToDateResult, extensionHandlers []string) (bool, []string, error)
 	overrideTriggerInPlaceUpdate       func(ctx context.Context, machine *clusterv1.Machine, machineUpToDateResult pkg.UpToDateResult) error
+	overrideEtcdSnapshotStoreTransport http.RoundTripper
 	// Note: This field is only used for unit tests that use fake client because the fake client does not properly set resourceVersion
 	//       on BootstrapConfig/InfraMachine after ssa.Patch and then ssa.RemoveManagedFieldsForLabelsAndAnnotations would fail.
 	disableRemoveManagedFieldsForLabelsAndAnnotations bool
@@ -129,6 +171,7 @@ type Reconciler struct {
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	if r.Client == nil || r.SecretCachingClient == nil || r.ClusterCache == nil ||
 		r.EtcdDialTimeout == time.Duration(0) || r.EtcdCallTimeout == time.Duration(0) ||
+		r.EtcdDBSizeWarningThreshold < 0 || r.EtcdDBSizeWarningThreshold > 100 ||
 		r.RemoteConditionsGracePeriod < 2*time.Minute {
 		// A minimum of 2m is enforced to ensure the ClusterCache always drops the connection before the grace period is reached.
 		// In the worst case the ClusterCache will take FailureThreshold x (Interval + Timeout) = 5x(10s+5s) = 75s to drop a
@@ -136,10 +179,11 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		// to have some buffer.
 		return pkgerrors.New("Client, SecretCachingClient and ClusterCache must not be nil and " +
 			"EtcdDialTimeout and EtcdCallTimeout must not be 0 and " +
+			"EtcdDBSizeWarningThreshold must be between 0 and 100 and " +
 			"RemoteConditionsGracePeriod must not be < 2m")
 	}
-	if feature.Gates.Enabled(feature.InPlaceUpdates) && r.RuntimeClient == nil {
-		return pkgerrors.New("RuntimeClient must not be nil when InPlaceUpdates feature gate is enabled")
+	if (feature.Gates.Enabled(feature.InPlaceUpdates) || feature.Gates.Enabled(feature.RuntimeSDK)) && r.RuntimeClient == nil {
+		return pkgerrors.New("RuntimeClient must not be nil when InPlaceUpdates or RuntimeSDK feature gate is enabled")
 	}
 
 	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "kubeadmcontrolplane")
@@ -147,6 +191,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		For(&controlplanev1.KubeadmControlPlane{}).
 		Owns(&clusterv1.Machine{}).
 		WithOptions(options).
+		WithPriority(kubeadmControlPlanePriority).
+		WithClusterRateLimiter(r.ClusterRateLimiter).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
@@ -170,7 +216,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
 	}
 	r.controller = c
-	r.recorder = mgr.GetEventRecorderFor("kubeadmcontrolplane-controller")
+	r.recorder = capirecord.NewDeduplicatingRecorder(mgr.GetEventRecorderFor("kubeadmcontrolplane-controller"), "kubeadmcontrolplane", r.EventDeduplicationWindow)
+	capirecord.RegisterReasons(kcpEventReasons...)
 	r.ssaCache = ssa.NewCache("kubeadmcontrolplane")
 
 	if r.managementCluster == nil {
@@ -182,12 +229,27 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			EtcdCallTimeout:     r.EtcdCallTimeout,
 			EtcdLogger:          r.EtcdLogger,
 			ClientCertCache:     cache.New[pkg.ClientCertEntry](ctx, 24*time.Hour),
+
+			EtcdDBSizeWarningThreshold: r.EtcdDBSizeWarningThreshold,
 		}
 	}
 
 	return nil
 }
 
+// kubeadmControlPlanePriority returns a high priority for KubeadmControlPlanes that are being deleted or
+// that have an unhealthy etcd cluster, so they are reconciled before routine reconciles, e.g. status refreshes.
+func kubeadmControlPlanePriority(obj client.Object) *int {
+	kcp, ok := obj.(*controlplanev1.KubeadmControlPlane)
+	if !ok {
+		return nil
+	}
+	if !kcp.DeletionTimestamp.IsZero() || conditions.IsFalse(kcp, controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition) {
+		return ptr.To(capicontrollerutil.HighPriority)
+	}
+	return nil
+}
+
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -215,6 +277,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.
 
 	log = log.WithValues("Cluster", klog.KObj(cluster))
 	ctx = ctrl.LoggerInto(ctx, log)
+	tracing.SetClusterName(ctx, cluster.Name)
 
 	// Add finalizer first if not set to avoid the race condition between init and delete.
 	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, kcp, controlplanev1.KubeadmControlPlaneFinalizer); err != nil || finalizerAdded {
@@ -234,15 +297,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.
 	// Initialize the control plane scope; this includes also checking for orphan machines and
 	// adopt them if necessary.
 	controlPlane, adoptableMachineFound, err := r.initControlPlaneScope(ctx, cluster, kcp)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
 	if adoptableMachineFound {
-		// if there are no errors but at least one CP machine has been adopted, then requeue and
+		// if at least one CP machine has been adopted, then requeue and
 		// wait for the update event for the ownership to be set.
-		return ctrl.Result{}, nil
+		// Note: Only the Adopting condition is patched, also if adoption failed, the rest of the status is updated
+		// after adoption completes.
+		if patchErr := patchHelper.Patch(ctx, kcp, patch.WithOwnedConditions{Conditions: []string{
+			controlplanev1.KubeadmControlPlaneAdoptingCondition,
+		}}); patchErr != nil {
+			err = kerrors.NewAggregate([]error{err, pkgerrors.Wrap(patchErr, "failed to patch KubeadmControlPlane")})
+		}
+		return ctrl.Result{}, err
+	}
+	if err != nil {
+		return ctrl.Result{}, err
 	}
 
+	// Keep a copy of the KubeadmControlPlane to detect completed rollouts for metrics.
+	originalKCP := kcp.DeepCopy()
+
 	defer func() {
 		// Always attempt to update status.
 		if err := r.updateStatus(ctx, controlPlane); err != nil {
@@ -270,6 +343,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.
 		}
 		if err := patchKubeadmControlPlane(ctx, patchHelper, kcp, patchOpts...); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, pkgerrors.Wrap(err, "failed to patch KubeadmControlPlane")})
+		} else {
+			// Observe the rollout metric only once the status has been persisted, so rollouts are not observed twice.
+			observeRolloutDurationMetric(cluster, originalKCP, kcp)
 		}
 
 		// Only requeue if there is no error, Requeue or RequeueAfter and the object does not have a deletion timestamp.
@@ -325,12 +401,45 @@ func (r *Reconciler) initControlPlaneScope(ctx context.Context, cluster *cluster
 		return nil, false, err
 	}
 
+	// If we are not deleting the CP and it is requested, prepare stand alone CP machines with mismatched labels for adoption.
+	if _, ok := kcp.Annotations[controlplanev1.AdoptMachinesAnnotation]; ok && kcp.DeletionTimestamp.IsZero() {
+		preparedMachines, err := r.prepareMachinesForAdoption(ctx, cluster, kcp)
+		if err != nil {
+			conditions.Set(kcp, metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneAdoptingCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  controlplanev1.KubeadmControlPlaneAdoptingInternalErrorReason,
+				Message: "Please check controller logs for errors",
+			})
+			return nil, true, err
+		}
+		if len(preparedMachines) > 0 {
+			setAdoptingCondition(kcp, preparedMachines)
+			return nil, true, nil
+		}
+	}
+
 	// If we are not deleting the CP, adopt stand alone CP machines if any
 	adoptableMachines := controlPlaneMachines.Filter(collections.AdoptableControlPlaneMachines(cluster.Name))
 	if kcp.DeletionTimestamp.IsZero() && len(adoptableMachines) > 0 {
+		setAdoptingCondition(kcp, adoptableMachines.Names())
 		return nil, true, r.adoptMachines(ctx, kcp, adoptableMachines, cluster)
 	}
 
+	// Report adoption completed if adoption has been previously reported or requested.
+	if _, ok := kcp.Annotations[controlplanev1.AdoptMachinesAnnotation]; ok || conditions.Has(kcp, controlplanev1.KubeadmControlPlaneAdoptingCondition) {
+		conditions.Set(kcp, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneAdoptingCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneNotAdoptingReason,
+		})
+	}
+	// Remove the annotation once adoption completes, so Machines are not listed for adoption on every reconcile.
+	// Note: The annotation removal is persisted when patching the KubeadmControlPlane at the end of the reconcile.
+	if _, ok := kcp.Annotations[controlplanev1.AdoptMachinesAnnotation]; ok && kcp.DeletionTimestamp.IsZero() {
+		delete(kcp.Annotations, controlplanev1.AdoptMachinesAnnotation)
+	}
+
 	ownedMachines := controlPlaneMachines.Filter(collections.OwnedMachines(kcp, controlplanev1.GroupVersion.WithKind("KubeadmControlPlane").GroupKind()))
 	if kcp.DeletionTimestamp.IsZero() && len(ownedMachines) != len(controlPlaneMachines) {
 		err := pkgerrors.New("not all control plane machines are owned by this KubeadmControlPlane, refusing to operate in mixed management mode")
@@ -374,11 +483,13 @@ func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kc
 		}},
 		patch.WithOwnedConditions{Conditions: []string{
 			clusterv1.PausedCondition,
+			controlplanev1.KubeadmControlPlaneAdoptingCondition,
 			controlplanev1.KubeadmControlPlaneAvailableCondition,
 			controlplanev1.KubeadmControlPlaneInitializedCondition,
 			controlplanev1.KubeadmControlPlaneCertificatesAvailableCondition,
 			controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition,
 			controlplanev1.KubeadmControlPlaneControlPlaneComponentsHealthyCondition,
+			controlplanev1.KubeadmControlPlaneConfigurationUpToDateCondition,
 			controlplanev1.KubeadmControlPlaneMachinesReadyCondition,
 			controlplanev1.KubeadmControlPlaneMachinesUpToDateCondition,
 			controlplanev1.KubeadmControlPlaneRollingOutCondition,
@@ -386,6 +497,9 @@ func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kc
 			controlplanev1.KubeadmControlPlaneScalingDownCondition,
 			controlplanev1.KubeadmControlPlaneRemediatingCondition,
 			controlplanev1.KubeadmControlPlaneDeletingCondition,
+			controlplanev1.KubeadmControlPlaneHibernatedCondition,
+			controlplanev1.KubeadmControlPlaneCARotationCondition,
+			controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition,
 		}},
 	)
 
@@ -473,6 +587,13 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 		return ctrl.Result{RequeueAfter: 1 * time.Second}, nil // Explicitly requeue as we are not watching for changes to BootstrapConfig and InfraMachine objects.
 	}
 
+	// Handle control planes scaled down to zero replicas; when the control plane is hibernated there are no
+	// control plane Machines and no workload cluster to connect to, so the rest of the reconcile is skipped.
+	stopReconcile, err = r.reconcileHibernation(ctx, controlPlane)
+	if err != nil || stopReconcile {
+		return ctrl.Result{}, err
+	}
+
 	// Aggregate the operational state of all the machines; while aggregating we are adding the
 	// source ref (reason@machine/name) so the problem can be easily tracked down to its source machine.
 	v1beta1conditions.SetAggregate(controlPlane.KCP, controlplanev1.MachinesReadyV1Beta1Condition, controlPlane.Machines.ConditionGetters(), v1beta1conditions.AddSourceRef())
@@ -483,6 +604,15 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 		return ctrl.Result{}, err
 	}
 
+	// Updates the condition reporting if the kubeadm-config ConfigMap is consistent with the KCP spec.
+	r.reconcileConfigurationUpToDateCondition(ctx, controlPlane)
+
+	// Reconcile the rotation of the certificate authority of the Cluster, if requested.
+	// Note: this must happen before Machines are created, so new Machines are using the CA certificates of the current phase.
+	if err := r.reconcileCARotation(ctx, controlPlane); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Ensures the number of etcd members is in sync with the number of machines/nodes.
 	if result, err := r.reconcileEtcdMembers(ctx, controlPlane); err != nil || !result.IsZero() {
 		return result, err
@@ -523,6 +653,22 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 		return ctrl.Result{}, nil // Note: Changes to Machines trigger another reconcile.
 	}
 
+	// Call the AfterControlPlaneMachineUpgrade hook for Machines that completed an upgrade; this blocks
+	// the upgrade of the next Machine until the Machine is healthy and the extensions allow to proceed.
+	if result, err := r.reconcileAfterControlPlaneMachineUpgradeHook(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// Machines with the rollout hold annotation are skipped during rollout, surface this in logs.
+	if machinesOnHold := controlPlane.MachinesWithRolloutOnHold(); machinesOnHold.Len() > 0 {
+		machinesOnHoldNames := make([]string, 0, machinesOnHold.Len())
+		for _, m := range machinesOnHold {
+			machinesOnHoldNames = append(machinesOnHoldNames, klog.KObj(m).String())
+		}
+		slices.Sort(machinesOnHoldNames)
+		log.V(4).Info(fmt.Sprintf("Skipping rollout of Machines with the %s annotation: %s", controlplanev1.MachineRolloutHoldAnnotation, strings.Join(machinesOnHoldNames, ",")))
+	}
+
 	// Control plane machines rollout due to configuration changes (e.g. upgrades) takes precedence over other operations.
 	machinesNeedingRollout, machinesUpToDateResults := controlPlane.MachinesNeedingRollout()
 	switch {
@@ -600,6 +746,12 @@ func (r *Reconciler) reconcile(ctx context.Context, controlPlane *pkg.ControlPla
 	if err := r.reconcileCertificateExpiries(ctx, controlPlane); err != nil {
 		return ctrl.Result{}, err
 	}
+
+	// Changes to Machines not controlled by KCP do not trigger a reconcile, so requeue to check if all
+	// the Machines of the Cluster have been replaced during a CA rotation.
+	if controlPlane.KCP.Status.CARotation.Phase != "" {
+		return ctrl.Result{RequeueAfter: caRotationRequeueAfter}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -697,6 +849,8 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, controlPlane *pkg.Cont
 		controlPlane.DeletingMessage = "Deletion completed"
 
 		r.controller.ClearConsistencyStore(client.ObjectKeyFromObject(controlPlane.KCP), controlPlane.KCP.UID)
+		etcd.DeleteMetricsForCluster(client.ObjectKeyFromObject(controlPlane.Cluster))
+		pkg.DeleteEtcdMemberDBSizeMetricsForCluster(client.ObjectKeyFromObject(controlPlane.Cluster))
 		controllerutil.RemoveFinalizer(controlPlane.KCP, controlplanev1.KubeadmControlPlaneFinalizer)
 		return ctrl.Result{}, nil
 	}
@@ -779,7 +933,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, controlPlane *pkg.Cont
 	}
 	if len(errs) > 0 {
 		err := kerrors.NewAggregate(errs)
-		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "FailedDelete",
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneFailedDeleteEventReason,
 			"Failed to delete control plane Machines for cluster %s control plane: %v", klog.KObj(controlPlane.Cluster), err)
 
 		controlPlane.DeletingReason = controlplanev1.KubeadmControlPlaneDeletingInternalErrorReason
@@ -1519,6 +1673,8 @@ func (r *Reconciler) reconcilePreTerminateHook(ctx context.Context, controlPlane
 		if err := workloadCluster.RemoveEtcdMember(ctx, etcdMemberToBeDeleted, controlPlane.Nodes); err != nil {
 			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to remove etcd member for deleting Machine %s", klog.KObj(deletingMachine))
 		}
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneEtcdMemberRemovedEventReason,
+			"Removed etcd member %s of deleting control plane Machine %s", etcdMemberToBeDeleted.Name, deletingMachine.Name)
 	}
 
 	if err := r.removePreTerminateHookAnnotationFromMachine(ctx, deletingMachine); err != nil {
@@ -1547,7 +1703,15 @@ func (r *Reconciler) forwardEtcdLeadership(ctx context.Context, workloadCluster
 	}
 
 	// Get candidate machines
-	candidateMachines := getCandidatesForEtcdLeadership(controlPlane.Machines, deletingMachine)
+	// Note: An invalid strategy must not block scale down or remediation, so the default strategy is used instead.
+	strategy, err := etcdLeadershipTransferStrategy(controlPlane.KCP, deletingMachine)
+	if err != nil {
+		log.Error(err, "Using the default etcd leadership transfer strategy", "strategy", controlplanev1.EtcdLeadershipTransferStrategyNewest)
+		r.recorder.Eventf(deletingMachine, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneInvalidEtcdLeadershipTransferStrategyEventReason,
+			"%v; using the %s strategy", err, controlplanev1.EtcdLeadershipTransferStrategyNewest)
+		strategy = controlplanev1.EtcdLeadershipTransferStrategyNewest
+	}
+	candidateMachines := getCandidatesForEtcdLeadership(controlPlane.Machines, deletingMachine, strategy)
 	if len(candidateMachines) == 0 {
 		return pkgerrors.New("unable to move etcd leadership, no candidate machines for etcd leadership found")
 	}
@@ -1555,16 +1719,52 @@ func (r *Reconciler) forwardEtcdLeadership(ctx context.Context, workloadCluster
 	// Try to move leadership to one of the candidateMachines.
 	for _, m := range candidateMachines {
 		if err := workloadCluster.ForwardEtcdLeadership(ctx, deletingMachine.Status.NodeRef.Name, m.Status.NodeRef.Name); err != nil {
-			log.Error(err, "Failed to move etcd leadership", "currentLeaderMachine", klog.KObj(deletingMachine), "candidateLeaderMachine", klog.KObj(m))
+			log.Error(err, "Failed to move etcd leadership", "currentLeaderMachine", klog.KObj(deletingMachine), "candidateLeaderMachine", klog.KObj(m), "strategy", strategy)
 			continue
 		}
-		log.Info("Moved etcd leadership", "previousLeaderMachine", klog.KObj(deletingMachine), "newLeaderMachine", klog.KObj(m))
+		log.Info("Moved etcd leadership", "previousLeaderMachine", klog.KObj(deletingMachine), "newLeaderMachine", klog.KObj(m), "strategy", strategy)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneEtcdLeadershipForwardedEventReason,
+			"Moved etcd leadership from Machine %s to Machine %s (strategy: %s)", deletingMachine.Name, m.Name, strategy)
+		r.recorder.Eventf(deletingMachine, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneEtcdLeadershipForwardedEventReason,
+			"Moved etcd leadership to Machine %s (strategy: %s)", m.Name, strategy)
+
+		// Surface where etcd leadership has been forwarded to in the Machine's Deleting condition message.
+		deletingMachineOriginal := deletingMachine.DeepCopy()
+		if deletingMachine.Annotations == nil {
+			deletingMachine.Annotations = map[string]string{}
+		}
+		deletingMachine.Annotations[clusterv1.MachineDeletingMessageAnnotation] = fmt.Sprintf("etcd leadership forwarded to Machine %s (strategy: %s)", m.Name, strategy)
+		if err := r.Client.Patch(ctx, deletingMachine, client.MergeFrom(deletingMachineOriginal)); err != nil {
+			return pkgerrors.Wrapf(err, "failed to set %s annotation on control plane Machine %s", clusterv1.MachineDeletingMessageAnnotation, klog.KObj(deletingMachine))
+		}
 		return nil
 	}
 	return pkgerrors.New("failed to move etcd leadership")
 }
 
-func getCandidatesForEtcdLeadership(machines collections.Machines, deletingMachine *clusterv1.Machine) []*clusterv1.Machine {
+// etcdLeadershipTransferStrategy returns the strategy to be used when forwarding etcd leadership from the deletingMachine.
+// The annotation on the deletingMachine takes precedence over the annotation on the KubeadmControlPlane.
+func etcdLeadershipTransferStrategy(kcp *controlplanev1.KubeadmControlPlane, deletingMachine *clusterv1.Machine) (string, error) {
+	strategy, ok := deletingMachine.GetAnnotations()[controlplanev1.EtcdLeadershipTransferStrategyAnnotation]
+	if !ok && kcp != nil {
+		strategy, ok = kcp.GetAnnotations()[controlplanev1.EtcdLeadershipTransferStrategyAnnotation]
+	}
+	if !ok {
+		return controlplanev1.EtcdLeadershipTransferStrategyNewest, nil
+	}
+
+	switch strategy {
+	case controlplanev1.EtcdLeadershipTransferStrategyOldest,
+		controlplanev1.EtcdLeadershipTransferStrategyNewest,
+		controlplanev1.EtcdLeadershipTransferStrategySameFailureDomainPreferred:
+		return strategy, nil
+	default:
+		return "", pkgerrors.Errorf("invalid value %q for the %s annotation, supported values are %s, %s and %s", strategy, controlplanev1.EtcdLeadershipTransferStrategyAnnotation,
+			controlplanev1.EtcdLeadershipTransferStrategyOldest, controlplanev1.EtcdLeadershipTransferStrategyNewest, controlplanev1.EtcdLeadershipTransferStrategySameFailureDomainPreferred)
+	}
+}
+
+func getCandidatesForEtcdLeadership(machines collections.Machines, deletingMachine *clusterv1.Machine, strategy string) []*clusterv1.Machine {
 	// Pick candidate machines to be used as a target to forward leadership to.
 	candidateMachines := machines.Filter(collections.And(
 		// Machines with a Node (core requirement to reach etcd)
@@ -1614,7 +1814,23 @@ func getCandidatesForEtcdLeadership(machines collections.Machines, deletingMachi
 			return false
 		}
 
-		// If both machine's etcd member, unhealthy by MHC and UpToDate conditions are in the same state, pick the newest machine first.
+		// If both machine's etcd member, unhealthy by MHC and UpToDate conditions are in the same state, if the strategy
+		// prefers machines in the same failure domain of the deleting machine, machines in other failure domains should go last.
+		if strategy == controlplanev1.EtcdLeadershipTransferStrategySameFailureDomainPreferred {
+			sameFailureDomainI := candidateMachines[i].Spec.FailureDomain == deletingMachine.Spec.FailureDomain
+			sameFailureDomainJ := candidateMachines[j].Spec.FailureDomain == deletingMachine.Spec.FailureDomain
+			if sameFailureDomainI && !sameFailureDomainJ {
+				return true
+			}
+			if !sameFailureDomainI && sameFailureDomainJ {
+				return false
+			}
+		}
+
+		// Otherwise pick the oldest machine first if required by the strategy, the newest machine first in all the other cases.
+		if strategy == controlplanev1.EtcdLeadershipTransferStrategyOldest {
+			return candidateMachines[i].CreationTimestamp.Before(&candidateMachines[j].CreationTimestamp)
+		}
 		return candidateMachines[j].CreationTimestamp.Before(&candidateMachines[i].CreationTimestamp)
 	})
 	return candidateMachines
@@ -1731,7 +1947,7 @@ func (r *Reconciler) adoptMachines(ctx context.Context, kcp *controlplanev1.Kube
 		}
 
 		if !util.IsSupportedVersionSkew(kcpVersion, machineVersion) {
-			r.recorder.Eventf(kcp, corev1.EventTypeWarning, "AdoptionFailed", "Could not adopt Machine %s/%s: its version (%q) is outside supported +/- one minor version skew from KCP's (%q)", m.Namespace, m.Name, m.Spec.Version, kcp.Spec.Version)
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneAdoptionFailedEventReason, "Could not adopt Machine %s/%s: its version (%q) is outside supported +/- one minor version skew from KCP's (%q)", m.Namespace, m.Name, m.Spec.Version, kcp.Spec.Version)
 			// avoid returning an error here so we don't cause the KCP controller to spin until the operator clarifies their intent
 			return nil
 		}
@@ -1767,6 +1983,103 @@ func (r *Reconciler) adoptMachines(ctx context.Context, kcp *controlplanev1.Kube
 	return nil
 }
 
+// prepareMachinesForAdoption adds the labels required for adoption to stand alone control plane Machines matching
+// the label selector from the AdoptMachinesAnnotation, and to their KubeadmConfigs.
+// The func returns the names of the Machines that have been labeled.
+// Note: Machines and KubeadmConfigs without the control plane label are read with the APIReader, because those objects
+// are not cached (or their spec is dropped from the cache).
+func (r *Reconciler) prepareMachinesForAdoption(ctx context.Context, cluster *clusterv1.Cluster, kcp *controlplanev1.KubeadmControlPlane) ([]string, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	selector, err := labels.Parse(kcp.Annotations[controlplanev1.AdoptMachinesAnnotation])
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse %s annotation", controlplanev1.AdoptMachinesAnnotation)
+	}
+	// Note: An empty selector matches all the Machines in the namespace, including worker Machines.
+	if selector.Empty() {
+		return nil, pkgerrors.Errorf("failed to parse %s annotation: label selector must not be empty", controlplanev1.AdoptMachinesAnnotation)
+	}
+
+	machineList := &clusterv1.MachineList{}
+	if err := r.APIReader.List(ctx, machineList, client.InNamespace(kcp.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list Machines for adoption")
+	}
+
+	preparedMachines := []string{}
+	for i := range machineList.Items {
+		m := &machineList.Items[i]
+		if m.Spec.ClusterName != cluster.Name || collections.HasControllerRef(m) || !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if collections.ControlPlaneMachines(cluster.Name)(m) {
+			continue
+		}
+
+		ref := m.Spec.Bootstrap.ConfigRef
+		if !ref.IsDefined() || ref.Kind != "KubeadmConfig" {
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneAdoptionFailedEventReason, "Could not adopt Machine %s/%s: expected a ConfigRef of kind KubeadmConfig but instead found %v", m.Namespace, m.Name, ref)
+			continue
+		}
+
+		cfg := &bootstrapv1.KubeadmConfig{}
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: m.Namespace}, cfg); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get KubeadmConfig for Machine %s", klog.KObj(m))
+		}
+		// Note: Machines matching the selector are adopted only if their KubeadmConfig is for a control plane Machine,
+		// so worker Machines are never adopted.
+		if !cfg.Spec.InitConfiguration.IsDefined() && !cfg.Spec.ClusterConfiguration.IsDefined() && cfg.Spec.JoinConfiguration.ControlPlane == nil {
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneAdoptionFailedEventReason, "Could not adopt Machine %s/%s: KubeadmConfig %s is not for a control plane Machine", m.Namespace, m.Name, cfg.Name)
+			continue
+		}
+		if err := addAdoptionLabels(ctx, r.Client, cfg, cluster.Name); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to add labels to KubeadmConfig %s", klog.KObj(cfg))
+		}
+		if err := addAdoptionLabels(ctx, r.Client, m, cluster.Name); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to add labels to Machine %s", klog.KObj(m))
+		}
+
+		log.Info(fmt.Sprintf("Machine %s labeled for adoption", klog.KObj(m)), "Machine", klog.KObj(m))
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneMachineLabeledForAdoptionEventReason, "Machine %s labeled for adoption", m.Name)
+		preparedMachines = append(preparedMachines, m.Name)
+	}
+	return preparedMachines, nil
+}
+
+// addAdoptionLabels adds the cluster name and control plane labels to an object, if missing.
+func addAdoptionLabels(ctx context.Context, c client.Client, obj client.Object, clusterName string) error {
+	objLabels := obj.GetLabels()
+	if _, ok := objLabels[clusterv1.MachineControlPlaneLabel]; ok && objLabels[clusterv1.ClusterNameLabel] == clusterName {
+		return nil
+	}
+
+	patchHelper, err := patch.NewHelper(obj, c)
+	if err != nil {
+		return err
+	}
+	if objLabels == nil {
+		objLabels = map[string]string{}
+	}
+	objLabels[clusterv1.ClusterNameLabel] = clusterName
+	objLabels[clusterv1.MachineControlPlaneLabel] = ""
+	obj.SetLabels(objLabels)
+	return patchHelper.Patch(ctx, obj)
+}
+
+// setAdoptingCondition reports the Machines being adopted in the Adopting condition.
+func setAdoptingCondition(kcp *controlplanev1.KubeadmControlPlane, machineNames []string) {
+	sort.Strings(machineNames)
+	machinesMessage := "Machine"
+	if len(machineNames) > 1 {
+		machinesMessage += "s"
+	}
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneAdoptingCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  controlplanev1.KubeadmControlPlaneAdoptingReason,
+		Message: fmt.Sprintf("Adopting %s %s", machinesMessage, clog.ListToString(machineNames, func(s string) string { return s }, 3)),
+	})
+}
+
 func (r *Reconciler) adoptOwnedSecrets(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane, currentOwner *bootstrapv1.KubeadmConfig, clusterName string) error {
 	secrets := corev1.SecretList{}
 	if err := r.Client.List(ctx, &secrets, client.InNamespace(kcp.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}); err != nil {
@@ -578,6 +578,161 @@ func TestKubeadmControlPlaneReconciler_adoption(t *testing.T) {
 		}
 	})
 
+	t.Run("labels existing Machines with mismatched labels for adoption", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster, kcp, tmpl := createClusterWithControlPlane(metav1.NamespaceDefault)
+		cluster.Spec.ControlPlaneEndpoint.Host = "bar"
+		cluster.Spec.ControlPlaneEndpoint.Port = 6443
+		cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+		kcp.Spec.Version = version
+		kcp.Annotations = map[string]string{controlplanev1.AdoptMachinesAnnotation: "legacy-role=master"}
+
+		fmc := &fakeManagementCluster{
+			Machines: collections.Machines{},
+			Workload: &fakeWorkloadCluster{},
+		}
+		objs := []client.Object{builder.GenericInfrastructureMachineTemplateCRD, cluster.DeepCopy(), kcp.DeepCopy(), tmpl.DeepCopy()}
+		for i := range 4 {
+			name := fmt.Sprintf("test-%d", i)
+			clusterName := cluster.Name
+			if i == 2 {
+				// Machines belonging to other clusters should be ignored.
+				clusterName = "another-cluster"
+			}
+			joinConfiguration := bootstrapv1.JoinConfiguration{ControlPlane: &bootstrapv1.JoinControlPlane{}}
+			if i == 3 {
+				// Worker Machines should be ignored.
+				joinConfiguration = bootstrapv1.JoinConfiguration{NodeRegistration: bootstrapv1.NodeRegistrationOptions{Name: name}}
+			}
+			m := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: cluster.Namespace,
+					Name:      name,
+					Labels:    map[string]string{"legacy-role": "master"},
+				},
+				Spec: clusterv1.MachineSpec{
+					ClusterName: clusterName,
+					Bootstrap: clusterv1.Bootstrap{
+						ConfigRef: clusterv1.ContractVersionedObjectReference{
+							APIGroup: bootstrapv1.GroupVersion.Group,
+							Kind:     "KubeadmConfig",
+							Name:     name,
+						},
+					},
+					Version: version,
+				},
+			}
+			cfg := &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: cluster.Namespace,
+					Name:      name,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					JoinConfiguration: joinConfiguration,
+				},
+			}
+			objs = append(objs, m, cfg)
+		}
+
+		fakeClient := newFakeClient(objs...)
+		fmc.Reader = fakeClient
+		r := &Reconciler{
+			Client:              fakeClient,
+			APIReader:           fakeClient,
+			SecretCachingClient: fakeClient,
+			managementCluster:   fmc,
+			recorder:            record.NewFakeRecorder(32),
+		}
+
+		_, adoptableMachineFound, err := r.initControlPlaneScope(ctx, cluster, kcp)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(adoptableMachineFound).To(BeTrue())
+
+		for i := range 4 {
+			name := fmt.Sprintf("test-%d", i)
+			machine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name}, machine)).To(Succeed())
+			cfg := &bootstrapv1.KubeadmConfig{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name}, cfg)).To(Succeed())
+			if i >= 2 {
+				g.Expect(machine.Labels).ToNot(HaveKey(clusterv1.MachineControlPlaneLabel))
+				g.Expect(cfg.Labels).ToNot(HaveKey(clusterv1.MachineControlPlaneLabel))
+				continue
+			}
+			g.Expect(machine.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, cluster.Name))
+			g.Expect(machine.Labels).To(HaveKey(clusterv1.MachineControlPlaneLabel))
+			g.Expect(cfg.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, cluster.Name))
+			g.Expect(cfg.Labels).To(HaveKey(clusterv1.MachineControlPlaneLabel))
+		}
+
+		g.Expect(*conditions.Get(kcp, controlplanev1.KubeadmControlPlaneAdoptingCondition)).To(conditions.MatchCondition(metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneAdoptingCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  controlplanev1.KubeadmControlPlaneAdoptingReason,
+			Message: "Adopting Machines test-0, test-1",
+		}, conditions.IgnoreLastTransitionTime(true)))
+	})
+
+	t.Run("does not label Machines for adoption if the label selector is empty", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster, kcp, tmpl := createClusterWithControlPlane(metav1.NamespaceDefault)
+		cluster.Spec.ControlPlaneEndpoint.Host = "bar"
+		cluster.Spec.ControlPlaneEndpoint.Port = 6443
+		cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+		kcp.Spec.Version = version
+		kcp.Annotations = map[string]string{controlplanev1.AdoptMachinesAnnotation: ""}
+
+		fakeClient := newFakeClient(builder.GenericInfrastructureMachineTemplateCRD, cluster.DeepCopy(), kcp.DeepCopy(), tmpl.DeepCopy())
+		r := &Reconciler{
+			Client:              fakeClient,
+			APIReader:           fakeClient,
+			SecretCachingClient: fakeClient,
+			managementCluster: &fakeManagementCluster{
+				Machines: collections.Machines{},
+				Workload: &fakeWorkloadCluster{},
+				Reader:   fakeClient,
+			},
+			recorder: record.NewFakeRecorder(32),
+		}
+
+		_, adoptableMachineFound, err := r.initControlPlaneScope(ctx, cluster, kcp)
+		g.Expect(err).To(MatchError(ContainSubstring("label selector must not be empty")))
+		g.Expect(adoptableMachineFound).To(BeTrue())
+		g.Expect(conditions.IsUnknown(kcp, controlplanev1.KubeadmControlPlaneAdoptingCondition)).To(BeTrue())
+	})
+
+	t.Run("removes the adoption annotation once adoption completes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster, kcp, tmpl := createClusterWithControlPlane(metav1.NamespaceDefault)
+		cluster.Spec.ControlPlaneEndpoint.Host = "bar"
+		cluster.Spec.ControlPlaneEndpoint.Port = 6443
+		cluster.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+		kcp.Spec.Version = version
+		kcp.Annotations = map[string]string{controlplanev1.AdoptMachinesAnnotation: "legacy-role=master"}
+
+		fakeClient := newFakeClient(builder.GenericInfrastructureMachineTemplateCRD, cluster.DeepCopy(), kcp.DeepCopy(), tmpl.DeepCopy())
+		r := &Reconciler{
+			Client:              fakeClient,
+			APIReader:           fakeClient,
+			SecretCachingClient: fakeClient,
+			managementCluster: &fakeManagementCluster{
+				Machines: collections.Machines{},
+				Workload: &fakeWorkloadCluster{},
+				Reader:   fakeClient,
+			},
+			recorder: record.NewFakeRecorder(32),
+		}
+
+		_, adoptableMachineFound, err := r.initControlPlaneScope(ctx, cluster, kcp)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(adoptableMachineFound).To(BeFalse())
+		g.Expect(kcp.Annotations).ToNot(HaveKey(controlplanev1.AdoptMachinesAnnotation))
+		g.Expect(conditions.IsFalse(kcp, controlplanev1.KubeadmControlPlaneAdoptingCondition)).To(BeTrue())
+	})
+
 	t.Run("adopts v1alpha2 cluster secrets", func(t *testing.T) {
 		g := NewWithT(t)
 
@@ -3416,15 +3571,23 @@ func TestKubeadmControlPlaneReconciler_forwardLeaderShip(t *testing.T) {
 		},
 	}
 
-	r := &Reconciler{}
+	mWithOldestStrategy := m.DeepCopy()
+	mWithOldestStrategy.Annotations = map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: controlplanev1.EtcdLeadershipTransferStrategyOldest}
+	mWithInvalidStrategy := m.DeepCopy()
+	mWithInvalidStrategy.Annotations = map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: "invalid"}
+	mInFailureDomain := m.DeepCopy()
+	mInFailureDomain.Spec.FailureDomain = "fd1"
+	mOldestInFailureDomain := mOldest.DeepCopy()
+	mOldestInFailureDomain.Spec.FailureDomain = "fd1"
 
 	tests := []struct {
-		name              string
-		controlPlane      *pkg.ControlPlane
-		deletingMachine   *clusterv1.Machine
-		moveLeaderError   error
-		wantErrMessage    string
-		wantTryCandidates []string
+		name                string
+		controlPlane        *pkg.ControlPlane
+		deletingMachine     *clusterv1.Machine
+		moveLeaderError     error
+		wantErrMessage      string
+		wantTryCandidates   []string
+		wantDeletingMessage string
 	}{
 		{
 			name: "fails to forward leadership if etcd leader is not set",
@@ -3501,6 +3664,92 @@ func TestKubeadmControlPlaneReconciler_forwardLeaderShip(t *testing.T) {
 			wantTryCandidates: []string{
 				"m-newest", // etcd heathy, mhc healthy, up-to-date, newest machine
 			},
+			wantDeletingMessage: "etcd leadership forwarded to Machine m-newest (strategy: newest)",
+		},
+		{
+			name: "forward leadership with the strategy from the KCP",
+			controlPlane: &pkg.ControlPlane{
+				KCP: &controlplanev1.KubeadmControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: controlplanev1.EtcdLeadershipTransferStrategyOldest},
+					},
+				},
+				Machines: collections.FromMachines(
+					m,
+					mNewest,
+					mOldest,
+				),
+				EtcdLeader: &etcd.Member{Name: m.Status.NodeRef.Name},
+			},
+			deletingMachine: m,
+			wantTryCandidates: []string{
+				"m-oldest", // etcd heathy, mhc healthy, up-to-date, oldest machine
+			},
+			wantDeletingMessage: "etcd leadership forwarded to Machine m-oldest (strategy: oldest)",
+		},
+		{
+			name: "strategy from the deleting machine takes precedence over the strategy from the KCP",
+			controlPlane: &pkg.ControlPlane{
+				KCP: &controlplanev1.KubeadmControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: controlplanev1.EtcdLeadershipTransferStrategyNewest},
+					},
+				},
+				Machines: collections.FromMachines(
+					mWithOldestStrategy,
+					mNewest,
+					mOldest,
+					mNotUpToDate,
+				),
+				EtcdLeader: &etcd.Member{Name: m.Status.NodeRef.Name},
+			},
+			moveLeaderError: pkgerrors.New("failed to forward leadership"),
+			deletingMachine: mWithOldestStrategy,
+			wantTryCandidates: []string{
+				"m-oldest",         // etcd heathy, mhc healthy, up-to-date, oldest machine
+				"m-newest",         // etcd heathy, mhc healthy, up-to-date, newest machine
+				"m-not-up-to-date", // etcd heathy, mhc healthy, not up-to-date
+			},
+			wantErrMessage: "failed to move etcd leadership",
+		},
+		{
+			name: "same-failure-domain-preferred strategy prefers machines in the failure domain of the deleting machine",
+			controlPlane: &pkg.ControlPlane{
+				KCP: &controlplanev1.KubeadmControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: controlplanev1.EtcdLeadershipTransferStrategySameFailureDomainPreferred},
+					},
+				},
+				Machines: collections.FromMachines(
+					mInFailureDomain,
+					mNewest,
+					mOldestInFailureDomain,
+				),
+				EtcdLeader: &etcd.Member{Name: m.Status.NodeRef.Name},
+			},
+			moveLeaderError: pkgerrors.New("failed to forward leadership"),
+			deletingMachine: mInFailureDomain,
+			wantTryCandidates: []string{
+				"m-oldest", // etcd heathy, mhc healthy, up-to-date, same failure domain
+				"m-newest", // etcd heathy, mhc healthy, up-to-date, other failure domain
+			},
+			wantErrMessage: "failed to move etcd leadership",
+		},
+		{
+			name: "falls back to the default strategy if the strategy is invalid",
+			controlPlane: &pkg.ControlPlane{
+				Machines: collections.FromMachines(
+					mWithInvalidStrategy,
+					mNewest,
+					mOldest,
+				),
+				EtcdLeader: &etcd.Member{Name: m.Status.NodeRef.Name},
+			},
+			deletingMachine: mWithInvalidStrategy,
+			wantTryCandidates: []string{
+				"m-newest", // etcd heathy, mhc healthy, up-to-date, newest machine
+			},
+			wantDeletingMessage: "etcd leadership forwarded to Machine m-newest (strategy: newest)",
 		},
 	}
 
@@ -3508,6 +3757,12 @@ func TestKubeadmControlPlaneReconciler_forwardLeaderShip(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
+			deletingMachine := tt.deletingMachine.DeepCopy()
+			r := &Reconciler{
+				Client:   fake.NewClientBuilder().WithObjects(deletingMachine.DeepCopy()).Build(),
+				recorder: record.NewFakeRecorder(32),
+			}
+
 			var tryCandidates []string
 			w := &fakeWorkloadCluster{
 				OverrideForwardEtcdLeadership: func(_ context.Context, _, leaderCandidate string) error {
@@ -3515,7 +3770,7 @@ func TestKubeadmControlPlaneReconciler_forwardLeaderShip(t *testing.T) {
 					return tt.moveLeaderError
 				},
 			}
-			err := r.forwardEtcdLeadership(t.Context(), w, tt.controlPlane, tt.deletingMachine)
+			err := r.forwardEtcdLeadership(t.Context(), w, tt.controlPlane, deletingMachine)
 			g.Expect(tryCandidates).To(Equal(tt.wantTryCandidates))
 			if tt.wantErrMessage != "" {
 				g.Expect(err).To(HaveOccurred())
@@ -3523,6 +3778,10 @@ func TestKubeadmControlPlaneReconciler_forwardLeaderShip(t *testing.T) {
 				return
 			}
 			g.Expect(err).ToNot(HaveOccurred())
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(r.Client.Get(t.Context(), client.ObjectKeyFromObject(deletingMachine), gotMachine)).To(Succeed())
+			g.Expect(gotMachine.Annotations[clusterv1.MachineDeletingMessageAnnotation]).To(Equal(tt.wantDeletingMessage))
 		})
 	}
 }
@@ -5080,3 +5339,40 @@ func getTestCACert(key *rsa.PrivateKey) (*x509.Certificate, error) {
 	c, err := x509.ParseCertificate(b)
 	return c, err
 }
+
+func TestKubeadmControlPlanePriority(t *testing.T) {
+	tests := []struct {
+		name string
+		kcp  *controlplanev1.KubeadmControlPlane
+		want *int
+	}{
+		{
+			name: "Healthy KubeadmControlPlane keeps the priority of the event handler",
+			kcp: &controlplanev1.KubeadmControlPlane{Status: controlplanev1.KubeadmControlPlaneStatus{
+				Conditions: []metav1.Condition{{Type: controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition, Status: metav1.ConditionTrue}},
+			}},
+			want: nil,
+		},
+		{
+			name: "Deleting KubeadmControlPlane has high priority",
+			kcp: &controlplanev1.KubeadmControlPlane{ObjectMeta: metav1.ObjectMeta{
+				DeletionTimestamp: ptr.To(metav1.Now()),
+			}},
+			want: ptr.To(capicontrollerutil.HighPriority),
+		},
+		{
+			name: "KubeadmControlPlane with unhealthy etcd has high priority",
+			kcp: &controlplanev1.KubeadmControlPlane{Status: controlplanev1.KubeadmControlPlaneStatus{
+				Conditions: []metav1.Condition{{Type: controlplanev1.KubeadmControlPlaneEtcdClusterHealthyCondition, Status: metav1.ConditionFalse}},
+			}},
+			want: ptr.To(capicontrollerutil.HighPriority),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(kubeadmControlPlanePriority(tt.kcp)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+// isControlPlaneMachineUpgrade returns true if a Machine with the given Kubernetes version is replacing or updating
+// in-place at least one of the given Machines with a different Kubernetes version.
+// Note: The version of the Node is checked too, so an upgrade is detected also when the Machine spec has already been
+// updated, e.g. when completing to trigger an in-place update.
+func isControlPlaneMachineUpgrade(version string, machines ...*clusterv1.Machine) bool {
+	for _, m := range machines {
+		if m.Spec.Version != version {
+			return true
+		}
+		if m.Status.NodeInfo != nil && m.Status.NodeInfo.KubeletVersion != "" && m.Status.NodeInfo.KubeletVersion != version {
+			return true
+		}
+	}
+	return false
+}
+
+// afterControlPlaneMachineUpgradeHooks returns the hooks to be marked as pending on a control plane Machine
+// that is performing a Kubernetes version upgrade.
+func afterControlPlaneMachineUpgradeHooks() []runtimecatalog.Hook {
+	if !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return nil
+	}
+	return []runtimecatalog.Hook{runtimehooksv1.AfterControlPlaneMachineUpgrade}
+}
+
+// reconcileAfterControlPlaneMachineUpgradeHook calls the AfterControlPlaneMachineUpgrade hook for control plane Machines
+// that completed a Kubernetes version upgrade.
+// A control plane Machine completes the upgrade when it has a Node and all the Kubernetes control plane components
+// and the etcd member hosted on it are healthy.
+// Until the Machine completes the upgrade and all the registered extensions allow to proceed, the upgrade of the
+// next control plane Machine is blocked.
+func (r *Reconciler) reconcileAfterControlPlaneMachineUpgradeHook(ctx context.Context, controlPlane *pkg.ControlPlane) (ctrl.Result, error) {
+	if !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	machines := controlPlane.Machines.Filter(collections.Not(collections.HasDeletionTimestamp), func(machine *clusterv1.Machine) bool {
+		return hooks.IsPending(runtimehooksv1.AfterControlPlaneMachineUpgrade, machine)
+	})
+	for _, machine := range machines.SortedByCreationTimestamp() {
+		if err := checkMachineUpgradeCompleted(controlPlane, machine); err != nil {
+			log.Info(fmt.Sprintf("Waiting for Machine %s to complete the upgrade before calling the %s hook", klog.KObj(machine), runtimecatalog.HookName(runtimehooksv1.AfterControlPlaneMachineUpgrade)),
+				"Machine", klog.KObj(machine), "reason", err.Error())
+			// Slow down reconcile frequency, it takes some time before control plane components stabilize after an upgrade.
+			r.controller.DeferNextReconcileForObject(controlPlane.KCP, time.Now().Add(5*time.Second))
+			return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+		}
+
+		// Call all the registered extensions for the hook.
+		hookRequest := &runtimehooksv1.AfterControlPlaneMachineUpgradeRequest{
+			Cluster:           *cleanupCluster(controlPlane.Cluster),
+			Machine:           *cleanupMachineWithStatus(machine),
+			KubernetesVersion: machine.Spec.Version,
+		}
+		hookResponse := &runtimehooksv1.AfterControlPlaneMachineUpgradeResponse{}
+		if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.AfterControlPlaneMachineUpgrade, machine, hookRequest, hookResponse); err != nil {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to call %s hook for Machine %s", runtimecatalog.HookName(runtimehooksv1.AfterControlPlaneMachineUpgrade), klog.KObj(machine))
+		}
+		if hookResponse.RetryAfterSeconds != 0 {
+			msg := fmt.Sprintf("Upgrade of Machine %s to version %s completed but next steps are blocked by %s hook, retry after %ds",
+				klog.KObj(machine), hookRequest.KubernetesVersion, runtimecatalog.HookName(runtimehooksv1.AfterControlPlaneMachineUpgrade), hookResponse.RetryAfterSeconds)
+			if message := strings.TrimSpace(hookResponse.GetMessage()); message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, message)
+			}
+			log.Info(msg, "Machine", klog.KObj(machine))
+			return ctrl.Result{RequeueAfter: time.Duration(hookResponse.RetryAfterSeconds) * time.Second}, nil
+		}
+
+		if err := hooks.MarkAsDone(ctx, r.Client, machine, true, runtimehooksv1.AfterControlPlaneMachineUpgrade); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info(fmt.Sprintf("Upgrade of Machine %s to version %s and %s hook completed", klog.KObj(machine), hookRequest.KubernetesVersion, runtimecatalog.HookName(runtimehooksv1.AfterControlPlaneMachineUpgrade)),
+			"Machine", klog.KObj(machine))
+	}
+	return ctrl.Result{}, nil
+}
+
+// checkMachineUpgradeCompleted returns an error if the Machine does not have a Node yet or if any of the
+// Kubernetes control plane components or the etcd member hosted on it are not healthy.
+func checkMachineUpgradeCompleted(controlPlane *pkg.ControlPlane, machine *clusterv1.Machine) error {
+	if !machine.Status.NodeRef.IsDefined() {
+		return pkgerrors.Errorf("Machine %s does not have a corresponding Node yet (Machine.status.nodeRef not set)", machine.Name)
+	}
+	for _, condition := range machineHealthConditions(controlPlane) {
+		if err := preflightCheckCondition("Machine", machine, condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupCluster returns a copy of the Cluster without managedFields and status, to optimize the size of hook requests.
+func cleanupCluster(cluster *clusterv1.Cluster) *clusterv1.Cluster {
+	cluster = cluster.DeepCopy()
+	// Set GVK because object is later marshalled with json.Marshal.
+	cluster.SetGroupVersionKind(clusterv1.GroupVersion.WithKind("Cluster"))
+	cluster.SetManagedFields(nil)
+	cluster.Status = clusterv1.ClusterStatus{}
+	return cluster
+}
+
+// cleanupMachineWithStatus returns a copy of the Machine like cleanupMachine, but preserving the status
+// so extensions can e.g. use status.nodeRef to validate the Node of the Machine.
+func cleanupMachineWithStatus(machine *clusterv1.Machine) *clusterv1.Machine {
+	m := cleanupMachine(machine)
+	m.Status = *machine.Status.DeepCopy()
+	return m
+}
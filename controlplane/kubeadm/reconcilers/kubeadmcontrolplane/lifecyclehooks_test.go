@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+	"sigs.k8s.io/cluster-api/util/collections"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+)
+
+func TestIsControlPlaneMachineUpgrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		machines []*clusterv1.Machine
+		want     bool
+	}{
+		{
+			name:    "not an upgrade if there are no machines",
+			version: "v1.31.0",
+			want:    false,
+		},
+		{
+			name:    "not an upgrade if machines have the same version",
+			version: "v1.31.0",
+			machines: []*clusterv1.Machine{
+				machine("m1", withMachineVersion("v1.31.0")),
+			},
+			want: false,
+		},
+		{
+			name:    "upgrade if a machine has a different version",
+			version: "v1.31.0",
+			machines: []*clusterv1.Machine{
+				machine("m1", withMachineVersion("v1.31.0")),
+				machine("m2", withMachineVersion("v1.30.0")),
+			},
+			want: true,
+		},
+		{
+			name:    "upgrade if the node of a machine has a different version",
+			version: "v1.31.0",
+			machines: []*clusterv1.Machine{
+				machine("m1", withMachineVersion("v1.31.0"), withKubeletVersion("v1.30.0")),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(isControlPlaneMachineUpgrade(tt.version, tt.machines...)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileAfterControlPlaneMachineUpgradeHook(t *testing.T) {
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+	afterControlPlaneMachineUpgradeGVH, err := catalog.GroupVersionHook(runtimehooksv1.AfterControlPlaneMachineUpgrade)
+	if err != nil {
+		panic("unable to compute GVH")
+	}
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+
+	healthyMachine := machine("healthy", withMachineVersion("v1.31.0"), withPendingHooks(runtimehooksv1.AfterControlPlaneMachineUpgrade))
+	setMachineHealthy(healthyMachine)
+	notHealthyMachine := machine("not-healthy", withMachineVersion("v1.31.0"), withPendingHooks(runtimehooksv1.AfterControlPlaneMachineUpgrade))
+
+	successResponse := &runtimehooksv1.AfterControlPlaneMachineUpgradeResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+		},
+	}
+	blockingResponse := &runtimehooksv1.AfterControlPlaneMachineUpgradeResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status:  runtimehooksv1.ResponseStatusSuccess,
+				Message: "etcd consistency check in progress",
+			},
+			RetryAfterSeconds: 30,
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		featureEnabled       bool
+		machine              *clusterv1.Machine
+		hookResponse         *runtimehooksv1.AfterControlPlaneMachineUpgradeResponse
+		wantResult           ctrl.Result
+		wantHookCalled       bool
+		wantHookStillPending bool
+	}{
+		{
+			name:                 "no-op if the feature gate is disabled",
+			featureEnabled:       false,
+			machine:              healthyMachine,
+			wantResult:           ctrl.Result{},
+			wantHookCalled:       false,
+			wantHookStillPending: true,
+		},
+		{
+			name:                 "wait for the machine to complete the upgrade",
+			featureEnabled:       true,
+			machine:              notHealthyMachine,
+			wantResult:           ctrl.Result{RequeueAfter: preflightFailedRequeueAfter},
+			wantHookCalled:       false,
+			wantHookStillPending: true,
+		},
+		{
+			name:                 "block if the hook asks to retry",
+			featureEnabled:       true,
+			machine:              healthyMachine,
+			hookResponse:         blockingResponse,
+			wantResult:           ctrl.Result{RequeueAfter: 30 * time.Second},
+			wantHookCalled:       true,
+			wantHookStillPending: true,
+		},
+		{
+			name:                 "mark the hook as done if the hook succeeds",
+			featureEnabled:       true,
+			machine:              healthyMachine,
+			hookResponse:         successResponse,
+			wantResult:           ctrl.Result{},
+			wantHookCalled:       true,
+			wantHookStillPending: false,
+		},
+		{
+			name:                 "ignore machines without the hook pending",
+			featureEnabled:       true,
+			machine:              machine("not-pending", withMachineVersion("v1.31.0")),
+			wantResult:           ctrl.Result{},
+			wantHookCalled:       false,
+			wantHookStillPending: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, tt.featureEnabled)
+
+			m := tt.machine.DeepCopy()
+			fakeClient := fake.NewClientBuilder().WithObjects(m).Build()
+
+			callAllExtensionResponses := map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{}
+			if tt.hookResponse != nil {
+				callAllExtensionResponses[afterControlPlaneMachineUpgradeGVH] = tt.hookResponse
+			}
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithCallAllExtensionResponses(callAllExtensionResponses).
+				WithCallAllExtensionValidations(func(req runtimehooksv1.RequestObject) error {
+					g.Expect(req).To(BeAssignableToTypeOf(&runtimehooksv1.AfterControlPlaneMachineUpgradeRequest{}))
+					hookRequest := req.(*runtimehooksv1.AfterControlPlaneMachineUpgradeRequest)
+					g.Expect(hookRequest.Cluster.Name).To(Equal(cluster.Name))
+					g.Expect(hookRequest.Machine.Name).To(Equal(m.Name))
+					g.Expect(hookRequest.KubernetesVersion).To(Equal(m.Spec.Version))
+					return nil
+				}).
+				Build()
+
+			r := &Reconciler{
+				Client:        fakeClient,
+				RuntimeClient: runtimeClient,
+				controller:    capicontrollerutil.NewFakeController(),
+			}
+			controlPlane := &pkg.ControlPlane{
+				KCP:      &controlplanev1.KubeadmControlPlane{},
+				Cluster:  cluster,
+				Machines: collections.FromMachines(m),
+			}
+
+			result, err := r.reconcileAfterControlPlaneMachineUpgradeHook(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(Equal(tt.wantResult))
+			if tt.wantHookCalled {
+				g.Expect(runtimeClient.CallAllCount(runtimehooksv1.AfterControlPlaneMachineUpgrade)).To(Equal(1))
+			} else {
+				g.Expect(runtimeClient.CallAllCount(runtimehooksv1.AfterControlPlaneMachineUpgrade)).To(Equal(0))
+			}
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(m), gotMachine)).To(Succeed())
+			g.Expect(hooks.IsPending(runtimehooksv1.AfterControlPlaneMachineUpgrade, gotMachine)).To(Equal(tt.wantHookStillPending))
+		})
+	}
+}
+
+func withMachineVersion(version string) machineOpt {
+	return func(m *clusterv1.Machine) {
+		m.Spec.Version = version
+	}
+}
+
+func withKubeletVersion(version string) machineOpt {
+	return func(m *clusterv1.Machine) {
+		m.Status.NodeInfo = &corev1.NodeSystemInfo{KubeletVersion: version}
+	}
+}
+
+func withPendingHooks(pendingHooks ...runtimecatalog.Hook) machineOpt {
+	return func(m *clusterv1.Machine) {
+		hooks.MarkObjectAsPending(m, pendingHooks...)
+	}
+}
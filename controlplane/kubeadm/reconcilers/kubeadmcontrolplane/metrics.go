@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(rolloutDuration)
+}
+
+var rolloutDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "capi_kcp_rollout_duration_seconds",
+		Help:    "Duration of a rollout of a KubeadmControlPlane, i.e. from the first Machine becoming outdated until all Machines are up-to-date.",
+		Buckets: prometheus.ExponentialBuckets(60, 2, 10),
+	}, []string{
+		"cluster_name", "cluster_namespace",
+	},
+)
+
+// observeRolloutDurationMetric observes the duration of a rollout if it completed during the current reconcile,
+// which is detected by comparing the RollingOut condition before and after the reconcile.
+// The duration is computed from the transition timestamps of the RollingOut condition.
+func observeRolloutDurationMetric(cluster *clusterv1.Cluster, original, kcp *controlplanev1.KubeadmControlPlane) {
+	rollingOutBefore := conditions.Get(original, controlplanev1.KubeadmControlPlaneRollingOutCondition)
+	if rollingOutBefore == nil || rollingOutBefore.Status != metav1.ConditionTrue {
+		return
+	}
+	rollingOut := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneRollingOutCondition)
+	if rollingOut == nil || rollingOut.Status != metav1.ConditionFalse {
+		return
+	}
+
+	rolloutDuration.WithLabelValues(cluster.Name, cluster.Namespace).
+		Observe(rollingOut.LastTransitionTime.Sub(rollingOutBefore.LastTransitionTime.Time).Seconds())
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadmcontrolplane
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestObserveRolloutDurationMetric(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now().Truncate(time.Second)
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-rollout-metrics", Namespace: metav1.NamespaceDefault}}
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	kcp.Status.Conditions = []metav1.Condition{
+		{Type: controlplanev1.KubeadmControlPlaneRollingOutCondition, Status: metav1.ConditionFalse, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+	}
+
+	// A rollout starts.
+	original := kcp.DeepCopy()
+	kcp.Status.Conditions[0].Status = metav1.ConditionTrue
+	kcp.Status.Conditions[0].LastTransitionTime = metav1.NewTime(now.Add(-15 * time.Minute))
+	observeRolloutDurationMetric(cluster, original, kcp)
+	g.Expect(rolloutSampleCount(g, cluster)).To(Equal(uint64(0)))
+
+	// The rollout is still in progress.
+	observeRolloutDurationMetric(cluster, kcp.DeepCopy(), kcp)
+	g.Expect(rolloutSampleCount(g, cluster)).To(Equal(uint64(0)))
+
+	// The rollout completes.
+	original = kcp.DeepCopy()
+	kcp.Status.Conditions[0].Status = metav1.ConditionFalse
+	kcp.Status.Conditions[0].LastTransitionTime = metav1.NewTime(now)
+	observeRolloutDurationMetric(cluster, original, kcp)
+	g.Expect(rolloutSampleCount(g, cluster)).To(Equal(uint64(1)))
+
+	m := &dto.Metric{}
+	g.Expect(rolloutDuration.WithLabelValues(cluster.Name, cluster.Namespace).(prometheus.Metric).Write(m)).To(Succeed())
+	g.Expect(m.GetHistogram().GetSampleSum()).To(Equal(900.0))
+}
+
+func rolloutSampleCount(g *WithT, cluster *clusterv1.Cluster) uint64 {
+	m := &dto.Metric{}
+	g.Expect(rolloutDuration.WithLabelValues(cluster.Name, cluster.Namespace).(prometheus.Metric).Write(m)).To(Succeed())
+	return m.GetHistogram().GetSampleCount()
+}
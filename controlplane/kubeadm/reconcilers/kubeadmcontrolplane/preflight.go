@@ -125,7 +125,7 @@ func (r *Reconciler) preflightChecks(ctx context.Context, controlPlane *pkg.Cont
 	}
 
 	if err != nil {
-		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "ControlPlaneUnhealthy",
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneUnhealthyEventReason,
 			"Waiting for control plane to pass preflight checks to continue reconciliation: %v", err)
 		log.Info("Waiting for control plane to pass preflight checks", "failures", err.Error())
 		// Slow down reconcile frequency, it takes some time before control plane components stabilize
@@ -142,17 +142,7 @@ func (r *Reconciler) preflightChecks(ctx context.Context, controlPlane *pkg.Cont
 // When performing a scale down operation, the deleting machine is ignored.
 func (r *Reconciler) checkHealthiness(_ context.Context, controlPlane *pkg.ControlPlane, excludeFor []*clusterv1.Machine) error {
 	// Check machine health conditions; if there are conditions with False or Unknown, then wait.
-	allMachineHealthConditions := []string{
-		controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition,
-		controlplanev1.KubeadmControlPlaneMachineControllerManagerPodHealthyCondition,
-		controlplanev1.KubeadmControlPlaneMachineSchedulerPodHealthyCondition,
-	}
-	if controlPlane.IsEtcdManaged() {
-		allMachineHealthConditions = append(allMachineHealthConditions,
-			controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
-			controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition,
-		)
-	}
+	allMachineHealthConditions := machineHealthConditions(controlPlane)
 	machineErrors := []error{}
 
 loopmachines:
@@ -234,6 +224,23 @@ func (r *Reconciler) checkHealthinessWhileRemediationInProgress(ctx context.Cont
 	return kerrors.NewAggregate(allErrors)
 }
 
+// machineHealthConditions returns the conditions reporting the health of the Kubernetes control plane components
+// and of the etcd member hosted on a control plane Machine.
+func machineHealthConditions(controlPlane *pkg.ControlPlane) []string {
+	allMachineHealthConditions := []string{
+		controlplanev1.KubeadmControlPlaneMachineAPIServerPodHealthyCondition,
+		controlplanev1.KubeadmControlPlaneMachineControllerManagerPodHealthyCondition,
+		controlplanev1.KubeadmControlPlaneMachineSchedulerPodHealthyCondition,
+	}
+	if controlPlane.IsEtcdManaged() {
+		allMachineHealthConditions = append(allMachineHealthConditions,
+			controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
+			controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition,
+		)
+	}
+	return allMachineHealthConditions
+}
+
 func preflightCheckCondition(kind string, obj *clusterv1.Machine, conditionType string) error {
 	c := conditions.Get(obj, conditionType)
 	if c == nil {
@@ -19,6 +19,8 @@ package kubeadmcontrolplane
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -27,8 +29,10 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
+	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/util/collections"
 	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
 )
@@ -44,7 +48,7 @@ func (r *Reconciler) initializeControlPlane(ctx context.Context, controlPlane *p
 	newMachine, err := r.cloneConfigsAndGenerateMachine(ctx, controlPlane.Cluster, controlPlane.KCP, false, fd)
 	if err != nil {
 		log.Error(err, "Failed to create initial control plane Machine")
-		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "FailedInitialization", "Failed to create initial control plane Machine for cluster %s control plane: %v", klog.KObj(controlPlane.Cluster), err)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneFailedInitializationEventReason, "Failed to create initial control plane Machine for cluster %s control plane: %v", klog.KObj(controlPlane.Cluster), err)
 		return ctrl.Result{}, err
 	}
 
@@ -83,7 +87,7 @@ func (r *Reconciler) scaleUpControlPlane(ctx context.Context, controlPlane *pkg.
 	newMachine, err := r.cloneConfigsAndGenerateMachine(ctx, controlPlane.Cluster, controlPlane.KCP, true, fd)
 	if err != nil {
 		log.Error(err, "Failed to create additional control plane Machine")
-		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "FailedScaleUp", "Failed to create additional control plane Machine for cluster %s: %v", klog.KObj(controlPlane.Cluster), err)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneFailedScaleUpEventReason, "Failed to create additional control plane Machine for cluster %s: %v", klog.KObj(controlPlane.Cluster), err)
 		return ctrl.Result{}, err
 	}
 
@@ -92,6 +96,21 @@ func (r *Reconciler) scaleUpControlPlane(ctx context.Context, controlPlane *pkg.
 			"Machine", klog.KObj(newMachine),
 			newMachine.Spec.InfrastructureRef.Kind, klog.KRef(newMachine.Namespace, newMachine.Spec.InfrastructureRef.Name),
 			newMachine.Spec.Bootstrap.ConfigRef.Kind, klog.KRef(newMachine.Namespace, newMachine.Spec.Bootstrap.ConfigRef.Name), "desiredReplicas", ptr.Deref(controlPlane.KCP.Spec.Replicas, 0), "replicas", len(controlPlane.Machines))
+	if machinesNeedingRollout, _ := controlPlane.MachinesNeedingRollout(); machinesNeedingRollout.Len() > 0 {
+		outdatedMachineNames := machinesNeedingRollout.Names()
+		sort.Strings(outdatedMachineNames)
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneRolloutMachineCreatedEventReason,
+			"Created control plane Machine %s to replace outdated Machines %s", newMachine.Name, strings.Join(outdatedMachineNames, ", "))
+
+		// Track the intent to call the AfterControlPlaneMachineUpgrade hook once the new Machine completes the upgrade.
+		// Note: Intentionally using client.Patch (via hooks.MarkAsPending) instead of SSA, so the annotation is preserved
+		// when KCP applies the Machine again.
+		if upgradeHooks := afterControlPlaneMachineUpgradeHooks(); len(upgradeHooks) > 0 && isControlPlaneMachineUpgrade(newMachine.Spec.Version, machinesNeedingRollout.UnsortedList()...) {
+			if err := hooks.MarkAsPending(ctx, r.Client, newMachine, true, upgradeHooks...); err != nil {
+				return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to track upgrade of Machine %s", klog.KObj(newMachine))
+			}
+		}
+	}
 
 	return ctrl.Result{}, nil // No need to requeue here. Machine creation above triggers reconciliation.
 }
@@ -138,7 +157,13 @@ func (r *Reconciler) scaleDownControlPlane(
 			return ctrl.Result{}, pkgerrors.Wrap(controlPlane.NodeListError, "unable to forward etcd leadership")
 		}
 
-		if err := r.forwardEtcdLeadership(ctx, workloadCluster, controlPlane, machineToDelete); err != nil {
+		// When hibernating, there is no other Machine to forward etcd leadership to; instead, take an etcd snapshot
+		// so etcd can be restored when waking up the control plane.
+		if isHibernating(controlPlane) {
+			if err := r.snapshotEtcdForHibernation(ctx, controlPlane, workloadCluster, machineToDelete); err != nil {
+				return ctrl.Result{}, err
+			}
+		} else if err := r.forwardEtcdLeadership(ctx, workloadCluster, controlPlane, machineToDelete); err != nil {
 			return ctrl.Result{}, err
 		}
 
@@ -148,7 +173,7 @@ func (r *Reconciler) scaleDownControlPlane(
 	if deletedMachine, err := r.machineClientWithDeleteResponse.Delete(ctx, machineToDelete); err != nil {
 		if !apierrors.IsNotFound(err) {
 			log.Error(err, "Failed to delete control plane machine")
-			r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "FailedScaleDown",
+			r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, controlplanev1.KubeadmControlPlaneFailedScaleDownEventReason,
 				"Failed to delete control plane Machine %s for cluster %s control plane: %v", machineToDelete.Name, klog.KObj(controlPlane.Cluster), err)
 			return ctrl.Result{}, err
 		}
@@ -160,6 +185,10 @@ func (r *Reconciler) scaleDownControlPlane(
 	// Also, setting DeletionTimestamp doesn't mean the Machine is actually deleted (deletion takes some time).
 	log.WithValues(controlPlane.StatusToLogKeyAndValues(nil, machineToDelete)...).
 		Info(fmt.Sprintf("Machine %s deleting (scale down)", klog.KObj(machineToDelete)), "Machine", klog.KObj(machineToDelete), "desiredReplicas", ptr.Deref(controlPlane.KCP.Spec.Replicas, 0), "replicas", len(controlPlane.Machines))
+	if machinesNeedingRollout, _ := controlPlane.MachinesNeedingRollout(); machinesNeedingRollout.Has(machineToDelete) {
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, controlplanev1.KubeadmControlPlaneRolloutMachineDeletedEventReason,
+			"Deleted outdated control plane Machine %s", machineToDelete.Name)
+	}
 
 	return ctrl.Result{}, nil // No need to requeue here. Machine deletion above triggers reconciliation.
 }
@@ -19,6 +19,8 @@ package kubeadmcontrolplane
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/etcd"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/collections"
 	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
@@ -307,6 +311,111 @@ func TestKubeadmControlPlaneReconciler_scaleDownControlPlane_NoError(t *testing.
 		g.Expect(fakeClient.List(context.Background(), &controlPlaneMachines)).To(Succeed())
 		g.Expect(controlPlaneMachines.Items).To(HaveLen(1))
 	})
+	t.Run("emits an event when deleting an outdated control plane Machine during a rollout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		machines := map[string]*clusterv1.Machine{
+			"one": machine("one"),
+			"two": machine("two"),
+		}
+		setMachineHealthy(machines["one"])
+		setMachineHealthy(machines["two"])
+		fakeClient := newFakeClient(machines["one"], machines["two"])
+		recorder := record.NewFakeRecorder(32)
+
+		r := &Reconciler{
+			controller:                      capicontrollerutil.NewFakeController(),
+			recorder:                        recorder,
+			Client:                          fakeClient,
+			SecretCachingClient:             fakeClient,
+			machineClientWithDeleteResponse: capicontrollerutil.NewClientWithDeleteResponseFromClient(fakeClient),
+			managementCluster: &fakeManagementCluster{
+				Workload: &fakeWorkloadCluster{},
+			},
+		}
+
+		cluster := &clusterv1.Cluster{}
+		kcp := &controlplanev1.KubeadmControlPlane{
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				Version: "v1.19.1",
+			},
+		}
+		setKCPHealthy(kcp)
+		controlPlane := &pkg.ControlPlane{
+			KCP:                 kcp,
+			Cluster:             cluster,
+			Machines:            machines,
+			MachinesNotUpToDate: collections.FromMachines(machines["one"]),
+			EtcdLeader:          &etcd.Member{Name: "two"},
+		}
+		controlPlane.InjectTestManagementCluster(r.managementCluster)
+
+		result, err := r.scaleDownControlPlane(context.Background(), controlPlane, machines["one"])
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(recorder.Events).To(Receive(Equal("Normal RolloutMachineDeleted Deleted outdated control plane Machine one")))
+	})
+	t.Run("takes an etcd snapshot instead of forwarding etcd leadership when deleting the last control plane Machine to hibernate", func(t *testing.T) {
+		g := NewWithT(t)
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmControlPlaneHibernation, true)
+
+		machines := map[string]*clusterv1.Machine{
+			"one": machine("one"),
+		}
+		setMachineHealthy(machines["one"])
+		fakeClient := newFakeClient(machines["one"])
+		workloadCluster := &fakeWorkloadCluster{EtcdSnapshot: []byte("snapshot")}
+		etcdSnapshotStore := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer etcdSnapshotStore.Close()
+
+		r := &Reconciler{
+			controller:                      capicontrollerutil.NewFakeController(),
+			recorder:                        record.NewFakeRecorder(32),
+			Client:                          fakeClient,
+			SecretCachingClient:             fakeClient,
+			machineClientWithDeleteResponse: capicontrollerutil.NewClientWithDeleteResponseFromClient(fakeClient),
+			managementCluster: &fakeManagementCluster{
+				Workload: workloadCluster,
+			},
+			EtcdSnapshotStoreAllowedHosts:      []string{"127.0.0.1"},
+			overrideEtcdSnapshotStoreTransport: etcdSnapshotStore.Client().Transport,
+		}
+
+		cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault}}
+		kcp := &controlplanev1.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				Version:  "v1.19.1",
+				Replicas: ptr.To[int32](0),
+				Hibernation: controlplanev1.KubeadmControlPlaneHibernationSpec{
+					EtcdSnapshot: controlplanev1.EtcdSnapshotStore{
+						URL: etcdSnapshotStore.URL + "/foo-etcd-snapshot.db.gz",
+					},
+				},
+			},
+		}
+		setKCPHealthy(kcp)
+		controlPlane := &pkg.ControlPlane{
+			KCP:        kcp,
+			Cluster:    cluster,
+			Machines:   machines,
+			EtcdLeader: &etcd.Member{Name: "node-1"},
+		}
+		controlPlane.InjectTestManagementCluster(r.managementCluster)
+
+		result, err := r.scaleDownControlPlane(context.Background(), controlPlane, machines["one"])
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(workloadCluster.snapshotEtcdCalled).To(Equal(1))
+		g.Expect(workloadCluster.forwardEtcdLeadershipCalled).To(Equal(0))
+
+		g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "foo-etcd-snapshot"}, &corev1.Secret{})).To(Succeed())
+		controlPlaneMachines := clusterv1.MachineList{}
+		g.Expect(fakeClient.List(context.Background(), &controlPlaneMachines)).To(Succeed())
+		g.Expect(controlPlaneMachines.Items).To(BeEmpty())
+	})
 	t.Run("deletes the oldest control plane Machine even if preflight checks fails", func(t *testing.T) {
 		g := NewWithT(t)
 
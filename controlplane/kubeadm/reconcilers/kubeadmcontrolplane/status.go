@@ -28,8 +28,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -40,6 +42,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	clog "sigs.k8s.io/cluster-api/util/log"
+	"sigs.k8s.io/cluster-api/util/ssa"
 )
 
 // updateV1Beta1Status is called after every reconciliation loop in a defer statement to always make sure we have the
@@ -166,6 +169,7 @@ func (r *Reconciler) updateStatus(ctx context.Context, controlPlane *pkg.Control
 	setMachinesUpToDateCondition(ctx, controlPlane.KCP, controlPlane.Machines)
 	setRemediatingCondition(ctx, controlPlane.KCP, controlPlane.MachinesToBeRemediatedByKCP(), controlPlane.UnhealthyMachines())
 	setDeletingCondition(ctx, controlPlane.KCP, controlPlane.DeletingReason, controlPlane.DeletingMessage)
+	setMachinesFieldsCoOwnedCondition(ctx, r.recorder, controlPlane)
 	setAvailableCondition(ctx, controlPlane.KCP, controlPlane.IsEtcdManaged(), controlPlane.EtcdMembers, controlPlane.EtcdMembersAndMachinesAreMatching, controlPlane.Machines)
 	if err := setLastRemediation(ctx, controlPlane); err != nil {
 		allErrors = append(allErrors, err)
@@ -210,23 +214,10 @@ func setControlPlaneInitialized(ctx context.Context, controlPlane *pkg.ControlPl
 }
 
 func setReplicas(_ context.Context, kcp *controlplanev1.KubeadmControlPlane, machines collections.Machines) {
-	var readyReplicas, availableReplicas, upToDateReplicas int32
-	for _, machine := range machines {
-		if conditions.IsTrue(machine, clusterv1.MachineReadyCondition) {
-			readyReplicas++
-		}
-		if conditions.IsTrue(machine, clusterv1.MachineAvailableCondition) {
-			availableReplicas++
-		}
-		if conditions.IsTrue(machine, clusterv1.MachineUpToDateCondition) {
-			upToDateReplicas++
-		}
-	}
-
 	kcp.Status.Replicas = ptr.To(int32(len(machines)))
-	kcp.Status.ReadyReplicas = ptr.To(readyReplicas)
-	kcp.Status.AvailableReplicas = ptr.To(availableReplicas)
-	kcp.Status.UpToDateReplicas = ptr.To(upToDateReplicas)
+	kcp.Status.ReadyReplicas = ptr.To(int32(machines.Filter(collections.MatchesCondition(clusterv1.MachineReadyCondition, metav1.ConditionTrue)).Len()))
+	kcp.Status.AvailableReplicas = ptr.To(int32(machines.Filter(collections.MatchesCondition(clusterv1.MachineAvailableCondition, metav1.ConditionTrue)).Len()))
+	kcp.Status.UpToDateReplicas = ptr.To(int32(machines.Filter(collections.MatchesCondition(clusterv1.MachineUpToDateCondition, metav1.ConditionTrue)).Len()))
 }
 
 func setInitializedCondition(_ context.Context, kcp *controlplanev1.KubeadmControlPlane) {
@@ -252,26 +243,42 @@ func setRollingOutCondition(_ context.Context, kcp *controlplanev1.KubeadmContro
 	// all the machines are rolling out for the same reasons, however, in case of changes to KCP
 	// before a previous changes is not fully rolled out, there could be machines rolling out for
 	// different reasons.
+	// Note: Machines with the rollout hold annotation are not rolled out by KCP, so they are not counted
+	// as rolling out but surfaced in the condition message.
 	rollingOutReplicas := 0
 	rolloutReasons := sets.Set[string]{}
+	onHoldMachines := []string{}
 	for _, machine := range machines {
 		upToDateCondition := conditions.Get(machine, clusterv1.MachineUpToDateCondition)
 		if upToDateCondition == nil || upToDateCondition.Status != metav1.ConditionFalse {
 			continue
 		}
+		if _, ok := machine.Annotations[controlplanev1.MachineRolloutHoldAnnotation]; ok && machine.DeletionTimestamp.IsZero() {
+			onHoldMachines = append(onHoldMachines, machine.Name)
+			continue
+		}
 		rollingOutReplicas++
 		if upToDateCondition.Message != "" {
 			rolloutReasons.Insert(strings.Split(upToDateCondition.Message, "\n")...)
 		}
 	}
 
+	var onHoldMessage string
+	if len(onHoldMachines) > 0 {
+		sort.Strings(onHoldMachines)
+		machinesMessage := "Machine"
+		if len(onHoldMachines) > 1 {
+			machinesMessage += "s"
+		}
+		onHoldMessage = fmt.Sprintf("* %s %s not up-to-date but skipped due to the %s annotation", machinesMessage, clog.ListToString(onHoldMachines, func(s string) string { return s }, 3), controlplanev1.MachineRolloutHoldAnnotation)
+	}
+
 	if rollingOutReplicas == 0 {
-		var message string
 		conditions.Set(kcp, metav1.Condition{
 			Type:    controlplanev1.KubeadmControlPlaneRollingOutCondition,
 			Status:  metav1.ConditionFalse,
 			Reason:  controlplanev1.KubeadmControlPlaneNotRollingOutReason,
-			Message: message,
+			Message: onHoldMessage,
 		})
 		return
 	}
@@ -292,6 +299,9 @@ func setRollingOutCondition(_ context.Context, kcp *controlplanev1.KubeadmContro
 		})
 		message += fmt.Sprintf("\n%s", strings.Join(reasons, "\n"))
 	}
+	if onHoldMessage != "" {
+		message += fmt.Sprintf("\n%s", onHoldMessage)
+	}
 	conditions.Set(kcp, metav1.Condition{
 		Type:    controlplanev1.KubeadmControlPlaneRollingOutCondition,
 		Status:  metav1.ConditionTrue,
@@ -548,6 +558,66 @@ func setDeletingCondition(_ context.Context, kcp *controlplanev1.KubeadmControlP
 	})
 }
 
+// setMachinesFieldsCoOwnedCondition sets the MachinesFieldsCoOwned condition and emits an event on every Machine, KubeadmConfig
+// and InfraMachine with fields managed by KCP that are also owned by other field managers, e.g. because a user edited them with kubectl.
+func setMachinesFieldsCoOwnedCondition(ctx context.Context, recorder record.EventRecorder, controlPlane *pkg.ControlPlane) {
+	log := ctrl.LoggerFrom(ctx)
+
+	messages := []string{}
+	detect := func(kind string, obj client.Object) error {
+		coOwned, err := ssa.DetectCoOwnedFields(obj, kcpManagerName, kcpMetadataManagerName)
+		if err != nil {
+			return err
+		}
+		if len(coOwned) == 0 {
+			return nil
+		}
+		ssa.ReportCoOwnedFields(recorder, obj, kcpManagerName, coOwned)
+		messages = append(messages, fmt.Sprintf("* %s %s: %s", kind, obj.GetName(), ssa.CoOwnedFieldsMessage(coOwned)))
+		return nil
+	}
+
+	var errs []error
+	for _, machine := range controlPlane.Machines.SortedByCreationTimestamp() {
+		errs = append(errs, detect("Machine", machine))
+		if kubeadmConfig, ok := controlPlane.KubeadmConfigs[machine.Name]; ok {
+			errs = append(errs, detect("KubeadmConfig", kubeadmConfig))
+		}
+		if infraMachine, ok := controlPlane.InfraResources[machine.Name]; ok {
+			errs = append(errs, detect(infraMachine.GetKind(), infraMachine))
+		}
+	}
+	if err := kerrors.NewAggregate(errs); err != nil {
+		log.Error(err, "Failed to set MachinesFieldsCoOwned condition")
+		conditions.Set(controlPlane.KCP, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return
+	}
+
+	if len(messages) == 0 {
+		conditions.Set(controlPlane.KCP, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneMachinesFieldsNotCoOwnedReason,
+		})
+		return
+	}
+
+	if len(messages) > 3 {
+		messages = append(messages[:3], fmt.Sprintf("And %d more objects with co-owned fields", len(messages)-3))
+	}
+	conditions.Set(controlPlane.KCP, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedReason,
+		Message: strings.Join(messages, "\n"),
+	})
+}
+
 func setAvailableCondition(_ context.Context, kcp *controlplanev1.KubeadmControlPlane, etcdIsManaged bool, etcdMembers []*etcd.Member, etcdMembersAndMachinesAreMatching bool, machines collections.Machines) {
 	if !ptr.Deref(kcp.Status.Initialization.ControlPlaneInitialized, false) {
 		conditions.Set(kcp, metav1.Condition{
@@ -338,6 +338,65 @@ func Test_setRollingOutCondition(t *testing.T) {
 					"* InfrastructureMachine is not up-to-date",
 			},
 		},
+		{
+			name: "not up-to-date machines on hold are not rolled out",
+			kcp:  &controlplanev1.KubeadmControlPlane{},
+			machines: []*clusterv1.Machine{
+				{ObjectMeta: metav1.ObjectMeta{Name: "m1"}, Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{upToDateCondition}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "m2", Annotations: map[string]string{controlplanev1.MachineRolloutHoldAnnotation: ""}}, Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{
+					{
+						Type:    clusterv1.MachineUpToDateCondition,
+						Status:  metav1.ConditionFalse,
+						Reason:  clusterv1.MachineNotUpToDateReason,
+						Message: "* Version v1.25.0, v1.26.0 required",
+					},
+				}}},
+			},
+			expectCondition: metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneRollingOutCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  controlplanev1.KubeadmControlPlaneNotRollingOutReason,
+				Message: "* Machine m2 not up-to-date but skipped due to the controlplane.cluster.x-k8s.io/rollout-hold annotation",
+			},
+		},
+		{
+			name: "not up-to-date machines on hold are reported while rolling out other machines",
+			kcp:  &controlplanev1.KubeadmControlPlane{},
+			machines: []*clusterv1.Machine{
+				{ObjectMeta: metav1.ObjectMeta{Name: "m1"}, Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{
+					{
+						Type:    clusterv1.MachineUpToDateCondition,
+						Status:  metav1.ConditionFalse,
+						Reason:  clusterv1.MachineNotUpToDateReason,
+						Message: "* Version v1.25.0, v1.26.0 required",
+					},
+				}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "m2", Annotations: map[string]string{controlplanev1.MachineRolloutHoldAnnotation: ""}}, Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{
+					{
+						Type:    clusterv1.MachineUpToDateCondition,
+						Status:  metav1.ConditionFalse,
+						Reason:  clusterv1.MachineNotUpToDateReason,
+						Message: "* Version v1.25.0, v1.26.0 required",
+					},
+				}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "m3", Annotations: map[string]string{controlplanev1.MachineRolloutHoldAnnotation: ""}}, Status: clusterv1.MachineStatus{Conditions: []metav1.Condition{
+					{
+						Type:    clusterv1.MachineUpToDateCondition,
+						Status:  metav1.ConditionFalse,
+						Reason:  clusterv1.MachineNotUpToDateReason,
+						Message: "* Version v1.25.0, v1.26.0 required",
+					},
+				}}},
+			},
+			expectCondition: metav1.Condition{
+				Type:   controlplanev1.KubeadmControlPlaneRollingOutCondition,
+				Status: metav1.ConditionTrue,
+				Reason: controlplanev1.KubeadmControlPlaneRollingOutReason,
+				Message: "Rolling out 1 not up-to-date replicas\n" +
+					"* Version v1.25.0, v1.26.0 required\n" +
+					"* Machines m2, m3 not up-to-date but skipped due to the controlplane.cluster.x-k8s.io/rollout-hold annotation",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -963,6 +1022,79 @@ func TestDeletingCondition(t *testing.T) {
 	}
 }
 
+func Test_setMachinesFieldsCoOwnedCondition(t *testing.T) {
+	managedFields := func(otherManager string) []metav1.ManagedFieldsEntry {
+		entries := []metav1.ManagedFieldsEntry{
+			{
+				Manager:    kcpManagerName,
+				Operation:  metav1.ManagedFieldsOperationApply,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:version":{},"f:readinessGates":{}}}`)},
+			},
+		}
+		if otherManager != "" {
+			entries = append(entries, metav1.ManagedFieldsEntry{
+				Manager:    otherManager,
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:readinessGates":{}}}`)},
+			})
+		}
+		return entries
+	}
+	machine := func(name, otherManager string) *clusterv1.Machine {
+		m := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		m.SetManagedFields(managedFields(otherManager))
+		return m
+	}
+
+	testCases := []struct {
+		name            string
+		machines        []*clusterv1.Machine
+		expectCondition metav1.Condition
+		expectEvents    int
+	}{
+		{
+			name:     "No co-owned fields",
+			machines: []*clusterv1.Machine{machine("m1", ""), machine("m2", kcpMetadataManagerName)},
+			expectCondition: metav1.Condition{
+				Type:   controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: controlplanev1.KubeadmControlPlaneMachinesFieldsNotCoOwnedReason,
+			},
+		},
+		{
+			name:     "Co-owned fields",
+			machines: []*clusterv1.Machine{machine("m1", "kubectl-edit"), machine("m2", "")},
+			expectCondition: metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedReason,
+				Message: "* Machine m1: fields also owned by \"kubectl-edit\" (Update): .spec.readinessGates",
+			},
+			expectEvents: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			controlPlane := &pkg.ControlPlane{
+				KCP:      &controlplanev1.KubeadmControlPlane{},
+				Machines: collections.FromMachines(tc.machines...),
+			}
+			recorder := record.NewFakeRecorder(10)
+			setMachinesFieldsCoOwnedCondition(ctx, recorder, controlPlane)
+
+			condition := conditions.Get(controlPlane.KCP, controlplanev1.KubeadmControlPlaneMachinesFieldsCoOwnedCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(tc.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+			g.Expect(recorder.Events).To(HaveLen(tc.expectEvents))
+		})
+	}
+}
+
 func Test_shouldSurfaceWhenAvailableTrue(t *testing.T) {
 	reconcileTime := time.Now()
 
@@ -18,10 +18,14 @@ package kubeadmcontrolplane
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/blang/semver/v4"
 	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
@@ -29,6 +33,7 @@ import (
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func (r *Reconciler) updateControlPlane(
@@ -57,32 +62,8 @@ func (r *Reconciler) updateControlPlane(
 		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to update control plane: failed to set cluster-admin ClusterRoleBinding for kubeadm")
 	}
 
-	kubeadmCMMutators := make([]func(*bootstrapv1.ClusterConfiguration), 0)
-
-	if controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.IsDefined() {
-		// Get the imageRepository or the correct value if nothing is set and a migration is necessary.
-		imageRepository := pkg.ImageRepositoryFromClusterConfig(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration)
-
-		kubeadmCMMutators = append(kubeadmCMMutators,
-			workloadCluster.UpdateImageRepositoryInKubeadmConfigMap(imageRepository),
-			workloadCluster.UpdateFeatureGatesInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec, parsedVersion),
-			workloadCluster.UpdateAPIServerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer),
-			workloadCluster.UpdateControllerManagerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.ControllerManager),
-			workloadCluster.UpdateSchedulerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Scheduler),
-			workloadCluster.UpdateCertificateValidityPeriodDays(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificateValidityPeriodDays),
-			workloadCluster.UpdateEncryptionAlgorithm(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.EncryptionAlgorithm))
-
-		// Etcd local and external are mutually exclusive and they cannot be switched, once set.
-		if controlPlane.IsEtcdManaged() {
-			kubeadmCMMutators = append(kubeadmCMMutators,
-				workloadCluster.UpdateEtcdLocalInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.Local))
-		} else {
-			kubeadmCMMutators = append(kubeadmCMMutators,
-				workloadCluster.UpdateEtcdExternalInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External))
-		}
-	}
-
 	// collectively update Kubeadm config map
+	kubeadmCMMutators := kubeadmConfigMapMutators(controlPlane, workloadCluster, parsedVersion)
 	if err = workloadCluster.UpdateClusterConfiguration(ctx, parsedVersion, kubeadmCMMutators...); err != nil {
 		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to update control plane")
 	}
@@ -157,3 +138,92 @@ func (r *Reconciler) rollingUpdate(
 	}
 	return r.scaleDownControlPlane(ctx, controlPlane, machineToInPlaceUpdateOrScaleDown)
 }
+
+// kubeadmConfigMapMutators returns the mutators that align the ClusterConfiguration in the kubeadm-config ConfigMap
+// to the KubeadmControlPlane spec.
+func kubeadmConfigMapMutators(controlPlane *pkg.ControlPlane, workloadCluster pkg.WorkloadCluster, parsedVersion semver.Version) []func(*bootstrapv1.ClusterConfiguration) {
+	kubeadmCMMutators := make([]func(*bootstrapv1.ClusterConfiguration), 0)
+
+	if controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.IsDefined() {
+		// Get the imageRepository or the correct value if nothing is set and a migration is necessary.
+		imageRepository := pkg.ImageRepositoryFromClusterConfig(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration)
+
+		kubeadmCMMutators = append(kubeadmCMMutators,
+			workloadCluster.UpdateImageRepositoryInKubeadmConfigMap(imageRepository),
+			workloadCluster.UpdateFeatureGatesInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec, parsedVersion),
+			workloadCluster.UpdateAPIServerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer),
+			workloadCluster.UpdateControllerManagerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.ControllerManager),
+			workloadCluster.UpdateSchedulerInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Scheduler),
+			workloadCluster.UpdateCertificateValidityPeriodDays(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificateValidityPeriodDays),
+			workloadCluster.UpdateEncryptionAlgorithm(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.EncryptionAlgorithm))
+
+		// Etcd local and external are mutually exclusive and they cannot be switched, once set.
+		if controlPlane.IsEtcdManaged() {
+			kubeadmCMMutators = append(kubeadmCMMutators,
+				workloadCluster.UpdateEtcdLocalInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.Local))
+		} else {
+			kubeadmCMMutators = append(kubeadmCMMutators,
+				workloadCluster.UpdateEtcdExternalInKubeadmConfigMap(controlPlane.KCP.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External))
+		}
+	}
+	return kubeadmCMMutators
+}
+
+// reconcileConfigurationUpToDateCondition sets the ConfigurationUpToDate condition by comparing the ClusterConfiguration
+// in the kubeadm-config ConfigMap with the one KCP would write according to its spec.
+// This allows to detect out-of-band changes to the kubeadm-config ConfigMap that will be overwritten at the next rollout.
+// Note: This operation is best effort, in case of errors the condition is set to Unknown.
+func (r *Reconciler) reconcileConfigurationUpToDateCondition(ctx context.Context, controlPlane *pkg.ControlPlane) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// The kubeadm-config ConfigMap exists only after the control plane is initialized.
+	if !ptr.Deref(controlPlane.KCP.Status.Initialization.ControlPlaneInitialized, false) {
+		return
+	}
+
+	parsedVersion, err := semver.ParseTolerant(controlPlane.KCP.Spec.Version)
+	if err != nil {
+		log.Error(err, "Failed to parse Kubernetes version", "version", controlPlane.KCP.Spec.Version)
+		setConfigurationUpToDateConditionToUnknown(controlPlane.KCP)
+		return
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		log.Error(err, "Failed to get remote client for workload cluster", "Cluster", klog.KObj(controlPlane.Cluster))
+		setConfigurationUpToDateConditionToUnknown(controlPlane.KCP)
+		return
+	}
+
+	drift, err := workloadCluster.GetClusterConfigurationDrift(ctx, parsedVersion, kubeadmConfigMapMutators(controlPlane, workloadCluster, parsedVersion)...)
+	if err != nil {
+		log.Error(err, "Failed to compare the kubeadm-config ConfigMap with the KubeadmControlPlane spec")
+		setConfigurationUpToDateConditionToUnknown(controlPlane.KCP)
+		return
+	}
+
+	if len(drift) > 0 {
+		conditions.Set(controlPlane.KCP, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneConfigurationUpToDateCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  controlplanev1.KubeadmControlPlaneConfigurationNotUpToDateReason,
+			Message: fmt.Sprintf("ClusterConfiguration in the kubeadm-config ConfigMap differs from spec.kubeadmConfigSpec for: %s", strings.Join(drift, ", ")),
+		})
+		return
+	}
+
+	conditions.Set(controlPlane.KCP, metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneConfigurationUpToDateCondition,
+		Status: metav1.ConditionTrue,
+		Reason: controlplanev1.KubeadmControlPlaneConfigurationUpToDateReason,
+	})
+}
+
+func setConfigurationUpToDateConditionToUnknown(kcp *controlplanev1.KubeadmControlPlane) {
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneConfigurationUpToDateCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  controlplanev1.KubeadmControlPlaneConfigurationUpToDateInspectionFailedReason,
+		Message: "Please check controller logs for errors",
+	})
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -28,6 +30,7 @@ import (
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	pkgerrors "github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -39,8 +42,10 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	bootstrapadmission "sigs.k8s.io/cluster-api/bootstrap/kubeadm/webhooks/admission"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/webhooks/conversion"
+	"sigs.k8s.io/cluster-api/feature"
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/container"
 	"sigs.k8s.io/cluster-api/util/secret"
 	"sigs.k8s.io/cluster-api/util/version"
@@ -58,7 +63,10 @@ func (webhook *KubeadmControlPlane) SetupWebhookWithManager(mgr ctrl.Manager) er
 // +kubebuilder:webhook:verbs=create;update,path=/validate-controlplane-cluster-x-k8s-io-v1beta2-kubeadmcontrolplane,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanes,versions=v1beta2,name=validation.kubeadmcontrolplane.controlplane.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
 
 // KubeadmControlPlane implements a validation and defaulting webhook for KubeadmControlPlane.
-type KubeadmControlPlane struct{}
+type KubeadmControlPlane struct {
+	// DeprecatedFieldsPolicy defines how the usage of deprecated fields is handled.
+	DeprecatedFieldsPolicy deprecation.Policy
+}
 
 var _ admission.Validator[*controlplanev1.KubeadmControlPlane] = &KubeadmControlPlane{}
 var _ admission.Defaulter[*controlplanev1.KubeadmControlPlane] = &KubeadmControlPlane{}
@@ -84,12 +92,28 @@ func (webhook *KubeadmControlPlane) Default(_ context.Context, k *controlplanev1
 func (webhook *KubeadmControlPlane) ValidateCreate(_ context.Context, k *controlplanev1.KubeadmControlPlane) (admission.Warnings, error) {
 	spec := k.Spec
 	allErrs := validateKubeadmControlPlaneSpec(spec, field.NewPath("spec"))
+	if spec.Replicas != nil && isHibernationReplicas(*spec.Replicas) {
+		// Scaling to zero replicas is only supported for hibernating an existing control plane.
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				field.NewPath("spec", "replicas"),
+				"cannot be 0 when creating a KubeadmControlPlane",
+			),
+		)
+	}
 	allErrs = append(allErrs, validateClusterConfiguration(nil, &spec.KubeadmConfigSpec.ClusterConfiguration, field.NewPath("spec", "kubeadmConfigSpec", "clusterConfiguration"))...)
 	allErrs = append(allErrs, bootstrapadmission.Validate(&spec.KubeadmConfigSpec, true, field.NewPath("spec", "kubeadmConfigSpec"))...)
+	allErrs = append(allErrs, validateKubeadmConfigSpecForVersion(nil, k)...)
+	allErrs = append(allErrs, validateAnnotations(k.Annotations)...)
+
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(nil, bootstrapadmission.DeprecatedFields(&spec.KubeadmConfigSpec, field.NewPath("spec", "kubeadmConfigSpec")))
+	allErrs = append(allErrs, deprecationErrs...)
+
 	if len(allErrs) > 0 {
-		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlane").GroupKind(), k.Name, allErrs)
+		return allWarnings, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlane").GroupKind(), k.Name, allErrs)
 	}
-	return nil, nil
+	return allWarnings, nil
 }
 
 const (
@@ -183,6 +207,12 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldK, newK
 		{spec, kubeadmConfigSpec, diskSetup, "*"},
 		{spec, kubeadmConfigSpec, "format"},
 		{spec, kubeadmConfigSpec, "mounts"},
+		{spec, kubeadmConfigSpec, "encryption"},
+		{spec, kubeadmConfigSpec, "encryption", "*"},
+		{spec, kubeadmConfigSpec, "bootstrapTokenPolicy"},
+		{spec, kubeadmConfigSpec, "bootstrapTokenPolicy", "*"},
+		{spec, kubeadmConfigSpec, "generatedFiles"},
+		{spec, kubeadmConfigSpec, "generatedFiles", "*"},
 		// spec.machineTemplate
 		{spec, "machineTemplate", "metadata"},
 		{spec, "machineTemplate", "metadata", "*"},
@@ -197,6 +227,10 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldK, newK
 		{spec, "machineNaming", "*"},
 		{spec, "rollout"},
 		{spec, "rollout", "*"},
+		{spec, "caRotation"},
+		{spec, "caRotation", "*"},
+		{spec, "hibernation"},
+		{spec, "hibernation", "*"},
 	}
 
 	allErrs := validateKubeadmControlPlaneSpec(newK.Spec, field.NewPath("spec"))
@@ -239,12 +273,78 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldK, newK
 	allErrs = append(allErrs, validateClusterConfiguration(&oldK.Spec.KubeadmConfigSpec.ClusterConfiguration, &newK.Spec.KubeadmConfigSpec.ClusterConfiguration, field.NewPath("spec", "kubeadmConfigSpec", "clusterConfiguration"))...)
 	allErrs = append(allErrs, webhook.validateCoreDNSVersion(oldK, newK)...)
 	allErrs = append(allErrs, bootstrapadmission.Validate(&newK.Spec.KubeadmConfigSpec, true, field.NewPath("spec", "kubeadmConfigSpec"))...)
+	allErrs = append(allErrs, validateKubeadmConfigSpecForVersion(oldK, newK)...)
+	allErrs = append(allErrs, validateAnnotations(newK.Annotations)...)
+
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(
+		bootstrapadmission.DeprecatedFields(&oldK.Spec.KubeadmConfigSpec, field.NewPath("spec", "kubeadmConfigSpec")),
+		bootstrapadmission.DeprecatedFields(&newK.Spec.KubeadmConfigSpec, field.NewPath("spec", "kubeadmConfigSpec")),
+	)
+	allErrs = append(allErrs, deprecationErrs...)
 
 	if len(allErrs) > 0 {
-		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlane").GroupKind(), newK.Name, allErrs)
+		return allWarnings, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlane").GroupKind(), newK.Name, allErrs)
 	}
 
-	return nil, nil
+	return allWarnings, nil
+}
+
+// isHibernationReplicas returns true if replicas is zero and scaling KubeadmControlPlane to zero
+// replicas is allowed by the KubeadmControlPlaneHibernation feature gate.
+func isHibernationReplicas(replicas int32) bool {
+	return replicas == 0 && feature.Gates.Enabled(feature.KubeadmControlPlaneHibernation)
+}
+
+// validateAnnotations validates the annotations of a KubeadmControlPlane.
+func validateAnnotations(annotations map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+	if value, ok := annotations[controlplanev1.AdoptMachinesAnnotation]; ok {
+		// Note: An empty selector matches all the Machines in the namespace, including worker Machines.
+		if selector, err := labels.Parse(value); err != nil || selector.Empty() {
+			allErrs = append(allErrs,
+				field.Invalid(
+					field.NewPath("metadata", "annotations", controlplanev1.AdoptMachinesAnnotation),
+					value,
+					"must be a non-empty label selector",
+				),
+			)
+		}
+	}
+	if value, ok := annotations[controlplanev1.EtcdLeadershipTransferStrategyAnnotation]; ok {
+		supportedStrategies := []string{
+			controlplanev1.EtcdLeadershipTransferStrategyOldest,
+			controlplanev1.EtcdLeadershipTransferStrategyNewest,
+			controlplanev1.EtcdLeadershipTransferStrategySameFailureDomainPreferred,
+		}
+		if !slices.Contains(supportedStrategies, value) {
+			allErrs = append(allErrs,
+				field.NotSupported(
+					field.NewPath("metadata", "annotations", controlplanev1.EtcdLeadershipTransferStrategyAnnotation),
+					value,
+					supportedStrategies,
+				),
+			)
+		}
+	}
+	return allErrs
+}
+
+// validateEtcdSnapshotStore validates the store for the etcd snapshot taken when hibernating the control plane.
+func validateEtcdSnapshotStore(store controlplanev1.EtcdSnapshotStore, pathPrefix *field.Path) field.ErrorList {
+	if store.URL == "" {
+		return nil
+	}
+	// Note: The etcd snapshot contains all the Secrets of the workload cluster, so it must not be sent in clear text.
+	if u, err := url.Parse(store.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		return field.ErrorList{
+			field.Invalid(
+				pathPrefix.Child("url"),
+				store.URL,
+				"must be a valid https URL",
+			),
+		}
+	}
+	return nil
 }
 
 func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, pathPrefix *field.Path) field.ErrorList {
@@ -258,7 +358,7 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, p
 				"is required",
 			),
 		)
-	} else if *s.Replicas <= 0 {
+	} else if *s.Replicas <= 0 && !isHibernationReplicas(*s.Replicas) {
 		// The use of the scale subresource should provide a guarantee that negative values
 		// should not be accepted for this field, but since we have to validate that Replicas != 0
 		// it doesn't hurt to also additionally validate for negative numbers here as well.
@@ -273,7 +373,7 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, p
 
 	externalEtcd := s.KubeadmConfigSpec.ClusterConfiguration.Etcd.External.IsDefined()
 	if !externalEtcd {
-		if s.Replicas != nil && *s.Replicas%2 == 0 {
+		if s.Replicas != nil && *s.Replicas%2 == 0 && !isHibernationReplicas(*s.Replicas) {
 			allErrs = append(
 				allErrs,
 				field.Forbidden(
@@ -282,8 +382,19 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, p
 				),
 			)
 		}
+		if s.Replicas != nil && isHibernationReplicas(*s.Replicas) && s.Hibernation.EtcdSnapshot.URL == "" {
+			allErrs = append(
+				allErrs,
+				field.Required(
+					pathPrefix.Child("hibernation", "etcdSnapshot", "url"),
+					"is required to scale to 0 replicas when etcd is stacked",
+				),
+			)
+		}
 	}
 
+	allErrs = append(allErrs, validateEtcdSnapshotStore(s.Hibernation.EtcdSnapshot, pathPrefix.Child("hibernation", "etcdSnapshot"))...)
+
 	if s.MachineTemplate.Spec.InfrastructureRef.APIGroup == "" {
 		allErrs = append(
 			allErrs,
@@ -63,6 +63,13 @@ func (v *ScaleValidator) Handle(ctx context.Context, req admission.Request) admi
 		return admission.Errored(http.StatusInternalServerError, pkgerrors.Wrapf(err, "failed to get KubeadmControlPlane %s/%s", scale.Namespace, scale.Name))
 	}
 
+	if isHibernationReplicas(scale.Spec.Replicas) {
+		if !kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External.IsDefined() && kcp.Spec.Hibernation.EtcdSnapshot.URL == "" {
+			return admission.Denied("replicas cannot be 0 when etcd is stacked and spec.hibernation.etcdSnapshot.url is not set")
+		}
+		return admission.Allowed("")
+	}
+
 	if scale.Spec.Replicas <= 0 {
 		return admission.Denied("replicas should be greater than zero")
 	}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -33,6 +34,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
 )
 
 func init() {
@@ -130,8 +132,13 @@ func TestKubeadmControlPlaneValidateScale(t *testing.T) {
 		Endpoints: []string{"1.2.3.4"},
 	}
 
+	kcpManagedEtcdWithEtcdSnapshotStore := kcpManagedEtcd.DeepCopy()
+	kcpManagedEtcdWithEtcdSnapshotStore.Name = "kcp-managed-etcd-with-etcd-snapshot-store"
+	kcpManagedEtcdWithEtcdSnapshotStore.Spec.Hibernation.EtcdSnapshot.URL = "https://storage.example.com/foo-etcd-snapshot.db.gz"
+
 	tests := []struct {
 		name              string
+		enableHibernation bool
 		admissionRequest  admission.Request
 		expectRespAllowed bool
 		expectRespMessage string
@@ -147,6 +154,42 @@ func TestKubeadmControlPlaneValidateScale(t *testing.T) {
 				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-managed-etcd","namespace":"foo"},"spec":{"replicas":0}}`)},
 			}},
 		},
+		{
+			name:              "should allow to scale to zero when KubeadmControlPlaneHibernation is enabled",
+			enableHibernation: true,
+			expectRespAllowed: true,
+			expectRespMessage: "",
+			admissionRequest: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       uuid.NewUUID(),
+				Kind:      metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-managed-etcd-with-etcd-snapshot-store","namespace":"foo"},"spec":{"replicas":0}}`)},
+			}},
+		},
+		{
+			name:              "should return error when trying to scale to zero without an etcd snapshot store when KubeadmControlPlaneHibernation is enabled",
+			enableHibernation: true,
+			expectRespAllowed: false,
+			expectRespMessage: "replicas cannot be 0 when etcd is stacked and spec.hibernation.etcdSnapshot.url is not set",
+			admissionRequest: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       uuid.NewUUID(),
+				Kind:      metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-managed-etcd","namespace":"foo"},"spec":{"replicas":0}}`)},
+			}},
+		},
+		{
+			name:              "should return error when trying to scale to a negative number when KubeadmControlPlaneHibernation is enabled",
+			enableHibernation: true,
+			expectRespAllowed: false,
+			expectRespMessage: "replicas should be greater than zero",
+			admissionRequest: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       uuid.NewUUID(),
+				Kind:      metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-managed-etcd","namespace":"foo"},"spec":{"replicas":-1}}`)},
+			}},
+		},
 		{
 			name:              "should return error when trying to scale to a negative number",
 			expectRespAllowed: false,
@@ -207,7 +250,11 @@ func TestKubeadmControlPlaneValidateScale(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kcpManagedEtcd, kcpExternalEtcd).Build()
+			if tt.enableHibernation {
+				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmControlPlaneHibernation, true)
+			}
+
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kcpManagedEtcd, kcpManagedEtcdWithEtcdSnapshotStore, kcpExternalEtcd).Build()
 
 			// Create the webhook and add the fakeClient as its client.
 			scaleHandler := ScaleValidator{
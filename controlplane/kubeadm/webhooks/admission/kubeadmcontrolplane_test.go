@@ -137,6 +137,25 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 		},
 	}
 
+	validAdoptMachines := valid.DeepCopy()
+	validAdoptMachines.Annotations = map[string]string{controlplanev1.AdoptMachinesAnnotation: "legacy-role=master"}
+
+	emptyAdoptMachines := valid.DeepCopy()
+	emptyAdoptMachines.Annotations = map[string]string{controlplanev1.AdoptMachinesAnnotation: ""}
+
+	validEtcdLeadershipTransferStrategy := valid.DeepCopy()
+	validEtcdLeadershipTransferStrategy.Annotations = map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: controlplanev1.EtcdLeadershipTransferStrategyOldest}
+
+	invalidEtcdLeadershipTransferStrategy := valid.DeepCopy()
+	invalidEtcdLeadershipTransferStrategy.Annotations = map[string]string{controlplanev1.EtcdLeadershipTransferStrategyAnnotation: "random"}
+
+	invalidAdoptMachines := valid.DeepCopy()
+	invalidAdoptMachines.Annotations = map[string]string{controlplanev1.AdoptMachinesAnnotation: "legacy-role in (master"}
+
+	unsupportedFeatureGateForVersion := valid.DeepCopy()
+	unsupportedFeatureGateForVersion.Spec.Version = "v1.36.0"
+	unsupportedFeatureGateForVersion.Spec.KubeadmConfigSpec.ClusterConfiguration.FeatureGates = map[string]bool{"ControlPlaneKubeletLocalMode": true}
+
 	validVersion := valid.DeepCopy()
 	validVersion.Spec.Version = "v1.16.6"
 
@@ -193,10 +212,11 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 	invalidRolloutBeforeCertificatesExpiryDays.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificateValidityPeriodDays = 7
 
 	tests := []struct {
-		name                  string
-		enableIgnitionFeature bool
-		expectErr             bool
-		kcp                   *controlplanev1.KubeadmControlPlane
+		name                     string
+		enableIgnitionFeature    bool
+		enableHibernationFeature bool
+		expectErr                bool
+		kcp                      *controlplanev1.KubeadmControlPlane
 	}{
 		{
 			name:      "should succeed when given a valid config",
@@ -213,6 +233,12 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 			expectErr: true,
 			kcp:       zeroReplicas,
 		},
+		{
+			name:                     "should return error when replicas is zero and KubeadmControlPlaneHibernation is enabled",
+			enableHibernationFeature: true,
+			expectErr:                true,
+			kcp:                      zeroReplicas,
+		},
 		{
 			name:      "should return error when replicas is even",
 			expectErr: true,
@@ -300,6 +326,36 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 			expectErr: true,
 			kcp:       invalidRolloutBeforeCertificatesExpiryDays,
 		},
+		{
+			name:      "should return error when using a kubeadm feature gate not supported by the Kubernetes version",
+			expectErr: true,
+			kcp:       unsupportedFeatureGateForVersion,
+		},
+		{
+			name:      "should succeed when the adopt-machines annotation is a valid label selector",
+			expectErr: false,
+			kcp:       validAdoptMachines,
+		},
+		{
+			name:      "should return error when the adopt-machines annotation is an empty label selector",
+			expectErr: true,
+			kcp:       emptyAdoptMachines,
+		},
+		{
+			name:      "should return error when the adopt-machines annotation is an invalid label selector",
+			expectErr: true,
+			kcp:       invalidAdoptMachines,
+		},
+		{
+			name:      "should succeed when the etcd-leadership-transfer-strategy annotation is a supported strategy",
+			expectErr: false,
+			kcp:       validEtcdLeadershipTransferStrategy,
+		},
+		{
+			name:      "should return error when the etcd-leadership-transfer-strategy annotation is not a supported strategy",
+			expectErr: true,
+			kcp:       invalidEtcdLeadershipTransferStrategy,
+		},
 	}
 
 	for _, tt := range tests {
@@ -309,6 +365,11 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 				// Enabling the feature flag temporarily for this test.
 				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmBootstrapFormatIgnition, true)
 			}
+			if tt.enableHibernationFeature {
+				// NOTE: KubeadmControlPlaneHibernation feature flag is disabled by default.
+				// Enabling the feature flag temporarily for this test.
+				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmControlPlaneHibernation, true)
+			}
 
 			g := NewWithT(t)
 
@@ -480,6 +541,7 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	validUpdate.Spec.Replicas = ptr.To[int32](5)
 	now := metav1.NewTime(time.Now())
 	validUpdate.Spec.Rollout.After = now
+	validUpdate.Spec.CARotation.RequestedAt = now
 	validUpdate.Spec.Rollout.Before.CertificatesExpiryDays = 14
 	validUpdate.Spec.Remediation = controlplanev1.KubeadmControlPlaneRemediationSpec{
 		MaxRetry:                ptr.To[int32](50),
@@ -491,6 +553,15 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	scaleToZero := before.DeepCopy()
 	scaleToZero.Spec.Replicas = ptr.To[int32](0)
 
+	scaleToZeroWithEtcdSnapshotStore := scaleToZero.DeepCopy()
+	scaleToZeroWithEtcdSnapshotStore.Spec.Hibernation.EtcdSnapshot.URL = "https://storage.example.com/foo-etcd-snapshot.db.gz"
+
+	invalidEtcdSnapshotStore := scaleToZero.DeepCopy()
+	invalidEtcdSnapshotStore.Spec.Hibernation.EtcdSnapshot.URL = "ftp://storage.example.com/foo-etcd-snapshot.db.gz"
+
+	insecureEtcdSnapshotStore := scaleToZero.DeepCopy()
+	insecureEtcdSnapshotStore.Spec.Hibernation.EtcdSnapshot.URL = "http://storage.example.com/foo-etcd-snapshot.db.gz"
+
 	scaleToEven := before.DeepCopy()
 	scaleToEven.Spec.Replicas = ptr.To[int32](2)
 
@@ -679,6 +750,18 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	updateNTPServers := before.DeepCopy()
 	updateNTPServers.Spec.KubeadmConfigSpec.NTP.Servers = []string{"new-server"}
 
+	updateEncryption := before.DeepCopy()
+	updateEncryption.Spec.KubeadmConfigSpec.Encryption.Provider = "my-key"
+
+	updateBootstrapTokenPolicy := before.DeepCopy()
+	updateBootstrapTokenPolicy.Spec.KubeadmConfigSpec.BootstrapTokenPolicy = bootstrapv1.BootstrapTokenPolicy{
+		TTLSeconds: ptr.To[int32](3600),
+		OneTimeUse: ptr.To(true),
+	}
+
+	updateGeneratedFiles := before.DeepCopy()
+	updateGeneratedFiles.Spec.KubeadmConfigSpec.GeneratedFiles.Profile = bootstrapv1.CISGeneratedFilesProfile
+
 	disableNTPServers := before.DeepCopy()
 	disableNTPServers.Spec.KubeadmConfigSpec.NTP.Enabled = ptr.To(false)
 
@@ -784,12 +867,14 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	})
 
 	tests := []struct {
-		name                  string
-		enableIgnitionFeature bool
-		enableTaintsFeature   bool
-		expectErr             bool
-		before                *controlplanev1.KubeadmControlPlane
-		kcp                   *controlplanev1.KubeadmControlPlane
+		name                     string
+		enableIgnitionFeature    bool
+		enableTaintsFeature      bool
+		enableHibernationFeature bool
+		enableEncryptionFeature  bool
+		expectErr                bool
+		before                   *controlplanev1.KubeadmControlPlane
+		kcp                      *controlplanev1.KubeadmControlPlane
 	}{
 		{
 			name:      "should succeed when given a valid config",
@@ -827,6 +912,34 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before:    before,
 			kcp:       scaleToZero,
 		},
+		{
+			name:                     "should return error when trying to scale to zero without an etcd snapshot store and KubeadmControlPlaneHibernation is enabled",
+			enableHibernationFeature: true,
+			expectErr:                true,
+			before:                   before,
+			kcp:                      scaleToZero,
+		},
+		{
+			name:                     "should succeed when trying to scale to zero and KubeadmControlPlaneHibernation is enabled",
+			enableHibernationFeature: true,
+			expectErr:                false,
+			before:                   before,
+			kcp:                      scaleToZeroWithEtcdSnapshotStore,
+		},
+		{
+			name:                     "should return error when the etcd snapshot store URL is not an https URL",
+			enableHibernationFeature: true,
+			expectErr:                true,
+			before:                   before,
+			kcp:                      invalidEtcdSnapshotStore,
+		},
+		{
+			name:                     "should return error when the etcd snapshot store URL is an http URL",
+			enableHibernationFeature: true,
+			expectErr:                true,
+			before:                   before,
+			kcp:                      insecureEtcdSnapshotStore,
+		},
 		{
 			name:      "should return error when trying to scale to an even number",
 			expectErr: true,
@@ -1047,6 +1160,25 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before:    before,
 			kcp:       updateNTPServers,
 		},
+		{
+			name:                    "should allow changes to encryption",
+			enableEncryptionFeature: true,
+			expectErr:               false,
+			before:                  before,
+			kcp:                     updateEncryption,
+		},
+		{
+			name:      "should allow changes to bootstrapTokenPolicy",
+			expectErr: false,
+			before:    before,
+			kcp:       updateBootstrapTokenPolicy,
+		},
+		{
+			name:      "should allow changes to generatedFiles",
+			expectErr: false,
+			before:    before,
+			kcp:       updateGeneratedFiles,
+		},
 		{
 			name:      "should pass if NTP servers is disabled during update",
 			expectErr: false,
@@ -1179,6 +1311,16 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 				// Enabling the feature flag temporarily for this test.
 				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineTaintPropagation, true)
 			}
+			if tt.enableHibernationFeature {
+				// NOTE: KubeadmControlPlaneHibernation feature flag is disabled by default.
+				// Enabling the feature flag temporarily for this test.
+				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmControlPlaneHibernation, true)
+			}
+			if tt.enableEncryptionFeature {
+				// NOTE: KubeadmBootstrapDataEncryption feature flag is disabled by default.
+				// Enabling the feature flag temporarily for this test.
+				utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.KubeadmBootstrapDataEncryption, true)
+			}
 
 			g := NewWithT(t)
 
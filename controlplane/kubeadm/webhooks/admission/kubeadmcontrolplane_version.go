@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/types"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/types/upstreamv1beta3"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/pkg/desiredstate"
+	"sigs.k8s.io/cluster-api/util/version"
+)
+
+// kubeadmFeatureGate documents the range of Kubernetes versions in which a kubeadm feature gate exists.
+// kubeadm fails when it is invoked with a feature gate it does not know, so setting a feature gate outside
+// of this range breaks the rollout of control plane machines.
+type kubeadmFeatureGate struct {
+	// introduced is the first Kubernetes version supporting the feature gate; zero if the feature gate exists in
+	// all the Kubernetes versions supported by Cluster API.
+	introduced semver.Version
+	// removed is the first Kubernetes version not supporting the feature gate anymore; zero if the feature gate has not been removed.
+	removed semver.Version
+	// hint suggests how to fix the KubeadmControlPlane when the feature gate is set for a version which does not support it.
+	hint string
+}
+
+// kubeadmFeatureGates lists the kubeadm feature gates that are not supported by all the Kubernetes versions
+// supported by Cluster API.
+var kubeadmFeatureGates = map[string]kubeadmFeatureGate{
+	// NOTE: Keep in sync with the versions used by desiredstate.DefaultFeatureGates.
+	desiredstate.ControlPlaneKubeletLocalMode: {
+		introduced: semver.MustParse("1.31.0"),
+		removed:    semver.MustParse("1.36.0"),
+		hint:       "the feature is always enabled starting from Kubernetes v1.36.0, remove the feature gate",
+	},
+	"PublicKeysECDSA": {
+		removed: semver.MustParse("1.33.0"),
+		hint:    "use clusterConfiguration.encryptionAlgorithm instead",
+	},
+}
+
+// validateKubeadmConfigSpecForVersion validates the kubeadm configuration of a KubeadmControlPlane against the
+// kubeadm API version used for its Kubernetes version, so configurations that kubeadm would reject or silently
+// ignore are surfaced before a rollout starts.
+// On update, errors for fields that are already invalid in the old KubeadmControlPlane are not reported again, so existing
+// KubeadmControlPlanes can still be changed without fixing pre-existing issues first.
+func validateKubeadmConfigSpecForVersion(oldK, newK *controlplanev1.KubeadmControlPlane) field.ErrorList {
+	allErrs := kubeadmConfigSpecForVersionErrors(newK.Spec)
+	if oldK == nil || len(allErrs) == 0 {
+		return allErrs
+	}
+
+	type errorKey struct {
+		errorType field.ErrorType
+		field     string
+	}
+	oldErrs := map[errorKey]bool{}
+	for _, err := range kubeadmConfigSpecForVersionErrors(oldK.Spec) {
+		oldErrs[errorKey{errorType: err.Type, field: err.Field}] = true
+	}
+	newErrs := field.ErrorList{}
+	for _, err := range allErrs {
+		if !oldErrs[errorKey{errorType: err.Type, field: err.Field}] {
+			newErrs = append(newErrs, err)
+		}
+	}
+	return newErrs
+}
+
+func kubeadmConfigSpecForVersionErrors(s controlplanev1.KubeadmControlPlaneSpec) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Note: an invalid version is already reported by validateKubeadmControlPlaneSpec.
+	kubernetesVersion, err := semver.ParseTolerant(s.Version)
+	if err != nil {
+		return allErrs
+	}
+
+	// Note: Kubernetes versions not supported by the kubeadm types are reported by the KubeadmConfig controller
+	// when generating the bootstrap data; there is nothing to validate against for them.
+	kubeadmAPIVersion, err := kubeadmtypes.KubeVersionToKubeadmAPIGroupVersion(kubernetesVersion)
+	if err != nil {
+		return allErrs
+	}
+
+	specPath := field.NewPath("spec", "kubeadmConfigSpec")
+	clusterConfigurationPath := specPath.Child("clusterConfiguration")
+
+	// Validate that the kubeadm configuration can be converted to the kubeadm API version used for the target Kubernetes version.
+	if _, err := kubeadmtypes.MarshalClusterConfigurationForVersion(&s.KubeadmConfigSpec.ClusterConfiguration, kubernetesVersion, nil); err != nil {
+		allErrs = append(allErrs, field.Invalid(clusterConfigurationPath, "", fmt.Sprintf("cannot be converted to kubeadm %s: %v", kubeadmAPIVersion, err)))
+	}
+	if _, err := kubeadmtypes.MarshalInitConfigurationForVersion(&s.KubeadmConfigSpec.InitConfiguration, kubernetesVersion); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("initConfiguration"), "", fmt.Sprintf("cannot be converted to kubeadm %s: %v", kubeadmAPIVersion, err)))
+	}
+	if _, err := kubeadmtypes.MarshalJoinConfigurationForVersion(&s.KubeadmConfigSpec.JoinConfiguration, kubernetesVersion); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("joinConfiguration"), "", fmt.Sprintf("cannot be converted to kubeadm %s: %v", kubeadmAPIVersion, err)))
+	}
+
+	// kubeadm v1beta3 does not support some of the fields of the KubeadmConfigSpec; the conversion drops them,
+	// which means they are silently ignored.
+	if kubeadmAPIVersion == upstreamv1beta3.GroupVersion {
+		unsupported := fmt.Sprintf("is not supported by kubeadm %s, which is used for Kubernetes version %s; remove this field or use Kubernetes v1.31.0 or above", kubeadmAPIVersion, s.Version)
+		clusterConfiguration := s.KubeadmConfigSpec.ClusterConfiguration
+		if clusterConfiguration.CertificateValidityPeriodDays != 0 {
+			allErrs = append(allErrs, field.Forbidden(clusterConfigurationPath.Child("certificateValidityPeriodDays"), unsupported))
+		}
+		if clusterConfiguration.APIServer.ExtraEnvs != nil {
+			allErrs = append(allErrs, field.Forbidden(clusterConfigurationPath.Child("apiServer", "extraEnvs"), unsupported))
+		}
+		if clusterConfiguration.ControllerManager.ExtraEnvs != nil {
+			allErrs = append(allErrs, field.Forbidden(clusterConfigurationPath.Child("controllerManager", "extraEnvs"), unsupported))
+		}
+		if clusterConfiguration.Scheduler.ExtraEnvs != nil {
+			allErrs = append(allErrs, field.Forbidden(clusterConfigurationPath.Child("scheduler", "extraEnvs"), unsupported))
+		}
+		if clusterConfiguration.Etcd.Local.ExtraEnvs != nil {
+			allErrs = append(allErrs, field.Forbidden(clusterConfigurationPath.Child("etcd", "local", "extraEnvs"), unsupported))
+		}
+		allErrs = append(allErrs, validateNodeRegistrationForV1Beta3(s.KubeadmConfigSpec.InitConfiguration.NodeRegistration, specPath.Child("initConfiguration", "nodeRegistration"), unsupported)...)
+		allErrs = append(allErrs, validateNodeRegistrationForV1Beta3(s.KubeadmConfigSpec.JoinConfiguration.NodeRegistration, specPath.Child("joinConfiguration", "nodeRegistration"), unsupported)...)
+	}
+
+	allErrs = append(allErrs, validateKubeadmFeatureGatesForVersion(s.KubeadmConfigSpec.ClusterConfiguration.FeatureGates, s.Version, kubernetesVersion, clusterConfigurationPath.Child(featureGates))...)
+
+	return allErrs
+}
+
+func validateNodeRegistrationForV1Beta3(nodeRegistration bootstrapv1.NodeRegistrationOptions, pathPrefix *field.Path, unsupported string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if nodeRegistration.ImagePullSerial != nil {
+		allErrs = append(allErrs, field.Forbidden(pathPrefix.Child("imagePullSerial"), unsupported))
+	}
+	return allErrs
+}
+
+func validateKubeadmFeatureGatesForVersion(featureGates map[string]bool, rawVersion string, kubernetesVersion semver.Version, pathPrefix *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, name := range slices.Sorted(maps.Keys(featureGates)) {
+		gate, ok := kubeadmFeatureGates[name]
+		if !ok {
+			continue
+		}
+		if !gate.introduced.Equals(semver.Version{}) && version.Compare(kubernetesVersion, gate.introduced, version.WithoutPreReleases()) < 0 {
+			allErrs = append(allErrs, field.Forbidden(pathPrefix.Key(name),
+				fmt.Sprintf("kubeadm feature gate %s is not supported by Kubernetes version %s, it has been introduced in Kubernetes v%s; remove the feature gate", name, rawVersion, gate.introduced)))
+			continue
+		}
+		if !gate.removed.Equals(semver.Version{}) && version.Compare(kubernetesVersion, gate.removed, version.WithoutPreReleases()) >= 0 {
+			allErrs = append(allErrs, field.Forbidden(pathPrefix.Key(name),
+				fmt.Sprintf("kubeadm feature gate %s is not supported by Kubernetes version %s, it has been removed in Kubernetes v%s; %s", name, rawVersion, gate.removed, gate.hint)))
+		}
+	}
+	return allErrs
+}
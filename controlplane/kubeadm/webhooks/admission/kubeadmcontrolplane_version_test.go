@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+)
+
+func TestValidateKubeadmConfigSpecForVersion(t *testing.T) {
+	extraEnvs := &[]bootstrapv1.EnvVar{{EnvVar: corev1.EnvVar{Name: "foo", Value: "bar"}}}
+
+	tests := []struct {
+		name              string
+		oldVersion        string
+		oldConfigSpec     *bootstrapv1.KubeadmConfigSpec
+		newVersion        string
+		newConfigSpec     bootstrapv1.KubeadmConfigSpec
+		expectErrorFields []string
+	}{
+		{
+			name:       "pass when using kubeadm v1beta4 fields with Kubernetes >= v1.31",
+			newVersion: "v1.31.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 100,
+					APIServer:                     bootstrapv1.APIServer{ExtraEnvs: extraEnvs},
+					Etcd:                          bootstrapv1.Etcd{Local: bootstrapv1.LocalEtcd{ExtraEnvs: extraEnvs}},
+				},
+				InitConfiguration: bootstrapv1.InitConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{ImagePullSerial: ptr.To(true)},
+				},
+			},
+		},
+		{
+			name:       "error when using kubeadm v1beta4 fields with Kubernetes < v1.31",
+			newVersion: "v1.30.5",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 100,
+					APIServer:                     bootstrapv1.APIServer{ExtraEnvs: extraEnvs},
+					ControllerManager:             bootstrapv1.ControllerManager{ExtraEnvs: extraEnvs},
+					Scheduler:                     bootstrapv1.Scheduler{ExtraEnvs: extraEnvs},
+					Etcd:                          bootstrapv1.Etcd{Local: bootstrapv1.LocalEtcd{ExtraEnvs: extraEnvs}},
+				},
+				InitConfiguration: bootstrapv1.InitConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{ImagePullSerial: ptr.To(true)},
+				},
+				JoinConfiguration: bootstrapv1.JoinConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{ImagePullSerial: ptr.To(false)},
+				},
+			},
+			expectErrorFields: []string{
+				"spec.kubeadmConfigSpec.clusterConfiguration.certificateValidityPeriodDays",
+				"spec.kubeadmConfigSpec.clusterConfiguration.apiServer.extraEnvs",
+				"spec.kubeadmConfigSpec.clusterConfiguration.controllerManager.extraEnvs",
+				"spec.kubeadmConfigSpec.clusterConfiguration.scheduler.extraEnvs",
+				"spec.kubeadmConfigSpec.clusterConfiguration.etcd.local.extraEnvs",
+				"spec.kubeadmConfigSpec.initConfiguration.nodeRegistration.imagePullSerial",
+				"spec.kubeadmConfigSpec.joinConfiguration.nodeRegistration.imagePullSerial",
+			},
+		},
+		{
+			name:       "error when upgrading to Kubernetes v1.36 with the ControlPlaneKubeletLocalMode feature gate",
+			oldVersion: "v1.35.2",
+			oldConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{"ControlPlaneKubeletLocalMode": true},
+				},
+			},
+			newVersion: "v1.36.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{"ControlPlaneKubeletLocalMode": true},
+				},
+			},
+			expectErrorFields: []string{
+				"spec.kubeadmConfigSpec.clusterConfiguration.featureGates[ControlPlaneKubeletLocalMode]",
+			},
+		},
+		{
+			name:       "error when using the ControlPlaneKubeletLocalMode feature gate with Kubernetes < v1.31",
+			newVersion: "v1.30.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{"ControlPlaneKubeletLocalMode": false},
+				},
+			},
+			expectErrorFields: []string{
+				"spec.kubeadmConfigSpec.clusterConfiguration.featureGates[ControlPlaneKubeletLocalMode]",
+			},
+		},
+		{
+			name:       "error when using the PublicKeysECDSA feature gate with Kubernetes >= v1.33",
+			newVersion: "v1.33.1",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{"PublicKeysECDSA": true, "RootlessControlPlane": true},
+				},
+			},
+			expectErrorFields: []string{
+				"spec.kubeadmConfigSpec.clusterConfiguration.featureGates[PublicKeysECDSA]",
+			},
+		},
+		{
+			name:       "pass when the ControlPlaneKubeletLocalMode feature gate is removed while upgrading to Kubernetes v1.36",
+			oldVersion: "v1.35.2",
+			oldConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					FeatureGates: map[string]bool{"ControlPlaneKubeletLocalMode": true},
+				},
+			},
+			newVersion:    "v1.36.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{},
+		},
+		{
+			name:       "pass when an existing KubeadmControlPlane already uses fields not supported by its Kubernetes version",
+			oldVersion: "v1.29.0",
+			oldConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 100,
+				},
+			},
+			newVersion: "v1.30.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 200,
+				},
+			},
+		},
+		{
+			name:       "error when an existing KubeadmControlPlane starts using fields not supported by its Kubernetes version",
+			oldVersion: "v1.30.0",
+			oldConfigSpec: &bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 100,
+				},
+			},
+			newVersion: "v1.30.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 100,
+					Etcd:                          bootstrapv1.Etcd{Local: bootstrapv1.LocalEtcd{ExtraEnvs: extraEnvs}},
+				},
+			},
+			expectErrorFields: []string{
+				"spec.kubeadmConfigSpec.clusterConfiguration.etcd.local.extraEnvs",
+			},
+		},
+		{
+			name:       "pass when the Kubernetes version is not supported by the kubeadm types",
+			newVersion: "v1.19.0",
+			newConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: bootstrapv1.ClusterConfiguration{
+					CertificateValidityPeriodDays: 100,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			newKCP := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Version:           tt.newVersion,
+					KubeadmConfigSpec: tt.newConfigSpec,
+				},
+			}
+			var oldKCP *controlplanev1.KubeadmControlPlane
+			if tt.oldConfigSpec != nil {
+				oldKCP = &controlplanev1.KubeadmControlPlane{
+					Spec: controlplanev1.KubeadmControlPlaneSpec{
+						Version:           tt.oldVersion,
+						KubeadmConfigSpec: *tt.oldConfigSpec,
+					},
+				}
+			}
+
+			errorFields := []string{}
+			for _, err := range validateKubeadmConfigSpecForVersion(oldKCP, newKCP) {
+				errorFields = append(errorFields, err.Field)
+			}
+			g.Expect(errorFields).To(ConsistOf(tt.expectErrorFields))
+		})
+	}
+}
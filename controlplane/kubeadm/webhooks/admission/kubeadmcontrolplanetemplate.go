@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/util/compare"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 )
 
 func (webhook *KubeadmControlPlaneTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
@@ -46,7 +47,10 @@ func (webhook *KubeadmControlPlaneTemplate) SetupWebhookWithManager(mgr ctrl.Man
 // +kubebuilder:webhook:verbs=create;update,path=/validate-controlplane-cluster-x-k8s-io-v1beta2-kubeadmcontrolplanetemplate,mutating=false,failurePolicy=fail,groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanetemplates,versions=v1beta2,name=validation.kubeadmcontrolplanetemplate.controlplane.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
 
 // KubeadmControlPlaneTemplate implements a validation and defaulting webhook for KubeadmControlPlaneTemplate.
-type KubeadmControlPlaneTemplate struct{}
+type KubeadmControlPlaneTemplate struct {
+	// DeprecatedFieldsPolicy defines how the usage of deprecated fields is handled.
+	DeprecatedFieldsPolicy deprecation.Policy
+}
 
 var _ admission.Validator[*controlplanev1.KubeadmControlPlaneTemplate] = &KubeadmControlPlaneTemplate{}
 
@@ -67,10 +71,14 @@ func (webhook *KubeadmControlPlaneTemplate) ValidateCreate(_ context.Context, k
 	allErrs = append(allErrs, bootstrapadmission.Validate(&spec.KubeadmConfigSpec, true, field.NewPath("spec", "template", "spec", "kubeadmConfigSpec"))...)
 	// Validate the metadata of the KubeadmControlPlaneTemplateResource
 	allErrs = append(allErrs, k.Spec.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))...)
+
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(nil, bootstrapadmission.DeprecatedFields(&spec.KubeadmConfigSpec, field.NewPath("spec", "template", "spec", "kubeadmConfigSpec")))
+	allErrs = append(allErrs, deprecationErrs...)
+
 	if len(allErrs) > 0 {
-		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlaneTemplate").GroupKind(), k.Name, allErrs)
+		return allWarnings, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlaneTemplate").GroupKind(), k.Name, allErrs)
 	}
-	return nil, nil
+	return allWarnings, nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
@@ -103,10 +111,17 @@ func (webhook *KubeadmControlPlaneTemplate) ValidateUpdate(_ context.Context, ol
 		)
 	}
 
+	// Note: spec.template.spec is immutable, so deprecated fields can only be surfaced as warnings on update.
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(
+		bootstrapadmission.DeprecatedFields(&oldK.Spec.Template.Spec.KubeadmConfigSpec, field.NewPath("spec", "template", "spec", "kubeadmConfigSpec")),
+		bootstrapadmission.DeprecatedFields(&newK.Spec.Template.Spec.KubeadmConfigSpec, field.NewPath("spec", "template", "spec", "kubeadmConfigSpec")),
+	)
+	allErrs = append(allErrs, deprecationErrs...)
+
 	if len(allErrs) == 0 {
-		return nil, nil
+		return allWarnings, nil
 	}
-	return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlaneTemplate").GroupKind(), newK.Name, allErrs)
+	return allWarnings, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("KubeadmControlPlaneTemplate").GroupKind(), newK.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -72,6 +72,10 @@ func ConvertKubeadmControlPlaneV1Beta1ToHub(ctx context.Context, src *controlpla
 	// Recover other values
 	if ok {
 		bootstrapconversion.RestoreKubeadmConfigSpec(&restored.Spec.KubeadmConfigSpec, &dst.Spec.KubeadmConfigSpec)
+		dst.Spec.MachineTemplate.Spec.SpreadConstraints = restored.Spec.MachineTemplate.Spec.SpreadConstraints
+		dst.Spec.CARotation = restored.Spec.CARotation
+		dst.Spec.Hibernation = restored.Spec.Hibernation
+		dst.Status.CARotation = restored.Status.CARotation
 	}
 
 	if src.Spec.RemediationStrategy != nil {
@@ -50,6 +50,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	addonsv1beta1 "sigs.k8s.io/cluster-api/api/addons/v1beta1"
@@ -63,6 +64,7 @@ import (
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimev1alpha1 "sigs.k8s.io/cluster-api/api/runtime/v1alpha1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/crdmigrator"
 	"sigs.k8s.io/cluster-api/controllers/remote"
@@ -71,12 +73,18 @@ import (
 	"sigs.k8s.io/cluster-api/core/reconcilers/clusterresourceset"
 	"sigs.k8s.io/cluster-api/core/reconcilers/clusterresourcesetbinding"
 	"sigs.k8s.io/cluster-api/core/reconcilers/extensionconfig"
+	"sigs.k8s.io/cluster-api/core/reconcilers/inclusterippool"
+	"sigs.k8s.io/cluster-api/core/reconcilers/ipaddressleak"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machine"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machinedeployment"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machinehealthcheck"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machinepool"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machineset"
+	"sigs.k8s.io/cluster-api/core/reconcilers/providerinventory"
+	"sigs.k8s.io/cluster-api/core/reconcilers/shardassignment"
 	topologycluster "sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster"
+	topologyclusterclasschannel "sigs.k8s.io/cluster-api/core/reconcilers/topology/clusterclasschannel"
+	topologyclusterclassoci "sigs.k8s.io/cluster-api/core/reconcilers/topology/clusterclassoci"
 	topologymachinedeployment "sigs.k8s.io/cluster-api/core/reconcilers/topology/machinedeployment"
 	topologymachineset "sigs.k8s.io/cluster-api/core/reconcilers/topology/machineset"
 	"sigs.k8s.io/cluster-api/core/setup"
@@ -87,9 +95,15 @@ import (
 	"sigs.k8s.io/cluster-api/internal/contract"
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+	"sigs.k8s.io/cluster-api/internal/util/statemetrics"
+	objecttree "sigs.k8s.io/cluster-api/internal/util/tree"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
 	"sigs.k8s.io/cluster-api/util/flags"
 	"sigs.k8s.io/cluster-api/util/index"
+	"sigs.k8s.io/cluster-api/util/shard"
+	"sigs.k8s.io/cluster-api/util/tracing"
 	"sigs.k8s.io/cluster-api/version"
 )
 
@@ -106,6 +120,9 @@ var (
 	leaderElectionRetryPeriod   time.Duration
 	watchFilterValue            string
 	watchNamespace              string
+	shardName                   string
+	shardNames                  []string
+	shardKey                    string
 	profilerAddress             string
 	enableContentionProfiling   bool
 	syncPeriod                  time.Duration
@@ -117,20 +134,30 @@ var (
 	webhookCertDir              string
 	webhookCertName             string
 	webhookKeyName              string
+	webhookDenyDeprecatedFields bool
 	runtimeExtensionCertFile    string
 	runtimeExtensionKeyFile     string
 	healthAddr                  string
 	managerOptions              = flags.ManagerOptions{}
+	tracingOptions              = tracing.Options{}
 	logOptions                  = logs.NewOptions()
 	// core Cluster API specific flags.
 	remoteConnectionGracePeriod      time.Duration
 	remoteConditionsGracePeriod      time.Duration
+	machineDeletionBlockedThreshold  time.Duration
 	clusterTopologyConcurrency       int
+	orphanedTemplateGracePeriod      time.Duration
+	orphanedTemplateDryRun           bool
+	ipAddressLeakGCGracePeriod       time.Duration
 	clusterCacheConcurrency          int
 	clusterClassConcurrency          int
 	clusterConcurrency               int
 	extensionConfigConcurrency       int
 	machineConcurrency               int
+	clusterReconcileQPS              float64
+	clusterReconcileBurst            int
+	eventDeduplicationWindow         time.Duration
+	enableStateMetrics               bool
 	machineSetConcurrency            int
 	machineDeploymentConcurrency     int
 	machinePoolConcurrency           int
@@ -160,6 +187,8 @@ func init() {
 	_ = ipamv1beta1.AddToScheme(scheme)
 	_ = ipamv1.AddToScheme(scheme)
 
+	_ = clusterctlv1.AddToScheme(scheme)
+
 	// Register the RuntimeHook types into the catalog.
 	_ = runtimehooksv1.AddToCatalog(catalog)
 }
@@ -186,6 +215,16 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&watchFilterValue, "watch-filter", "",
 		fmt.Sprintf("Label value that the controller watches to reconcile cluster-api objects. Label key is always %s. If unspecified, the controller watches for all cluster-api objects.", clusterv1.WatchLabel))
 
+	fs.StringVar(&shardName, "shard", "",
+		"Shard reconciled by this replica; must be one of --shards. Used only if the ControllerSharding feature gate is enabled")
+
+	fs.StringSliceVar(&shardNames, "shards", []string{},
+		"List of all shards; all replicas must use the same list. Used only if the ControllerSharding feature gate is enabled")
+
+	fs.StringVar(&shardKey, "shard-key", string(shard.NamespaceKey),
+		fmt.Sprintf("Key used to assign Clusters without the %s label to shards, one of %q or %q. Used only if the ControllerSharding feature gate is enabled",
+			clusterv1.ShardLabel, shard.NamespaceKey, shard.ClusterKey))
+
 	fs.StringVar(&profilerAddress, "profiler-address", "",
 		"Bind address to expose the pprof profiler (e.g. localhost:6060)")
 
@@ -200,9 +239,24 @@ func InitFlags(fs *pflag.FlagSet) {
 		"Grace period after which remote conditions (e.g. `NodeHealthy`) are set to `Unknown`, "+
 			"the grace period starts from the last successful health probe to the workload cluster")
 
+	fs.DurationVar(&machineDeletionBlockedThreshold, "machine-deletion-blocked-threshold", 1*time.Hour,
+		"Duration after which the DeletionBlocked condition on a Machine which is still deleting goes to `True`, "+
+			"surfacing what is blocking the deletion. Set to 0 to disable the detection of stuck deletions")
+
 	fs.IntVar(&clusterTopologyConcurrency, "clustertopology-concurrency", 50,
 		"Number of clusters to process simultaneously")
 
+	fs.DurationVar(&orphanedTemplateGracePeriod, "clustertopology-orphaned-template-grace-period", 0,
+		"Duration after which templates owned by a Cluster topology which are not referenced anymore are deleted. "+
+			"Defaults to 0, which disables the garbage collection of orphaned templates")
+
+	fs.BoolVar(&orphanedTemplateDryRun, "clustertopology-orphaned-template-dry-run", false,
+		"If true, orphaned templates owned by a Cluster topology are only reported, but not deleted")
+
+	fs.DurationVar(&ipAddressLeakGCGracePeriod, "ipaddress-leak-gc-grace-period", 0,
+		"Duration after which leaked IPAddressClaims and IPAddresses are deleted. "+
+			"Set to 0 to only report leaked objects without deleting them. Used only if the IPAddressLeakDetection feature gate is enabled")
+
 	fs.IntVar(&clusterClassConcurrency, "clusterclass-concurrency", 10,
 		"Number of ClusterClasses to process simultaneously")
 
@@ -218,6 +272,19 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&machineConcurrency, "machine-concurrency", 100,
 		"Number of machines to process simultaneously")
 
+	fs.Float64Var(&clusterReconcileQPS, "cluster-reconcile-qps", 0,
+		"Maximum number of Machine and MachineDeployment reconciles per second per workload cluster. Set to 0 to disable rate-limiting per workload cluster")
+
+	fs.IntVar(&clusterReconcileBurst, "cluster-reconcile-burst", 10,
+		"Maximum burst of Machine and MachineDeployment reconciles per workload cluster. Used only if --cluster-reconcile-qps is set")
+
+	fs.DurationVar(&eventDeduplicationWindow, "event-deduplication-window", 0,
+		"Window within which identical events for the same object are emitted only once by the Machine and Cluster topology controllers. Defaults to 0, which disables event deduplication")
+
+	fs.BoolVar(&enableStateMetrics, "enable-state-metrics", false,
+		"Expose the conditions of Cluster API objects as capi_<kind>_status_condition metrics on the diagnostics endpoint, "+
+			"as an alternative to configuring kube-state-metrics with the Cluster API CustomResourceStateMetrics configuration.")
+
 	fs.IntVar(&machineSetConcurrency, "machineset-concurrency", 50,
 		"Number of machine sets to process simultaneously")
 
@@ -238,7 +305,7 @@ func InitFlags(fs *pflag.FlagSet) {
 		"List of MachineSet preflight checks that should be run. Per default all of them are enabled."+
 			"Set this flag to only enable a subset of them. The MachineSet preflight checks can be then also disabled"+
 			"on MachineSets via the 'machineset.cluster.x-k8s.io/skip-preflight-checks' annotation."+
-			"Valid values are: All or a list of KubeadmVersionSkew, KubernetesVersionSkew, ControlPlaneIsStable, ControlPlaneVersionSkew")
+			"Valid values are: All or a list of KubeadmVersionSkew, KubernetesVersionSkew, ControlPlaneIsStable, ControlPlaneVersionSkew, CanCreateMachine")
 
 	fs.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
@@ -270,6 +337,9 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&webhookKeyName, "webhook-key-name", "tls.key",
 		"Name of the file for webhook's server key; the file must be placed under webhook-cert-dir.")
 
+	fs.BoolVar(&webhookDenyDeprecatedFields, "webhook-deny-deprecated-fields", false,
+		"If true, validating webhooks reject requests starting to use deprecated fields instead of returning a warning.")
+
 	fs.StringVar(&runtimeExtensionCertFile, "runtime-extension-client-cert-file", "",
 		"Path of the PEM-encoded client certificate to be used when calling runtime extensions.")
 
@@ -287,6 +357,8 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	flags.AddManagerOptions(fs, &managerOptions)
 
+	tracing.AddFlags(fs, &tracingOptions)
+
 	feature.MutableGates.AddFlag(fs)
 }
 
@@ -351,6 +423,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	if clusterReconcileQPS > 0 && clusterReconcileBurst <= 0 {
+		// With a burst lower than 1 no reconcile can ever be run, and reconciles would be requeued forever.
+		setupLog.Error(pkgerrors.Errorf("--cluster-reconcile-burst must be greater than 0 if --cluster-reconcile-qps is set"), "Unable to start manager")
+		os.Exit(1)
+	}
+
 	if err := version.CheckKubernetesVersion(restConfig, minVer); err != nil {
 		setupLog.Error(err, "Unable to start manager")
 		os.Exit(1)
@@ -366,6 +444,12 @@ func main() {
 		goruntime.SetBlockProfileRate(1)
 	}
 
+	// Use a separate leader election per shard, so there is an active replica for every shard.
+	leaderElectionID := "controller-leader-election-capi"
+	if feature.Gates.Enabled(feature.ControllerSharding) {
+		leaderElectionID = fmt.Sprintf("%s-%s", leaderElectionID, shardName)
+	}
+
 	ctrlOptions := ctrl.Options{
 		Controller: config.Controller{
 			UsePriorityQueue: ptr.To[bool](feature.Gates.Enabled(feature.PriorityQueue)),
@@ -375,7 +459,7 @@ func main() {
 		},
 		Scheme:                     scheme,
 		LeaderElection:             enableLeaderElection,
-		LeaderElectionID:           "controller-leader-election-capi",
+		LeaderElectionID:           leaderElectionID,
 		LeaseDuration:              &leaderElectionLeaseDuration,
 		RenewDeadline:              &leaderElectionRenewDeadline,
 		RetryPeriod:                &leaderElectionRetryPeriod,
@@ -405,16 +489,30 @@ func main() {
 	// Setup the context that's going to be used in controllers and for the manager.
 	ctx := ctrl.SetupSignalHandler()
 
+	shutdownTracing, err := tracing.Setup(ctx, controllerName, tracingOptions)
+	if err != nil {
+		setupLog.Error(err, "Unable to set up tracing")
+		os.Exit(1)
+	}
+
 	setupChecks(mgr)
 	setupIndexes(ctx, mgr)
-	clusterCache := setupReconcilers(ctx, mgr, watchNamespace, &syncPeriod)
+	sharder := setupSharding(ctx, mgr)
+	setupStateMetrics(mgr)
+	clusterCache := setupReconcilers(ctx, mgr, watchNamespace, &syncPeriod, sharder)
 	setupWebhooks(ctx, mgr, clusterCache)
+	setupObjectTreeEndpoint(mgr)
 
 	setupLog.Info("Starting manager", "version", version.Get().String())
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "Problem running manager")
 		os.Exit(1)
 	}
+
+	// Flush spans that have not been exported yet.
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "Failed to shut down tracing")
+	}
 }
 
 func setupChecks(mgr ctrl.Manager) {
@@ -429,6 +527,17 @@ func setupChecks(mgr ctrl.Manager) {
 	}
 }
 
+func setupObjectTreeEndpoint(mgr ctrl.Manager) {
+	if !feature.Gates.Enabled(feature.ObjectTreeEndpoint) {
+		return
+	}
+
+	if err := mgr.AddMetricsServerExtraHandler(objecttree.HandlerPath, objecttree.NewHandler(mgr.GetClient())); err != nil {
+		setupLog.Error(err, "Unable to add object tree endpoint to the metrics server")
+		os.Exit(1)
+	}
+}
+
 func setupIndexes(ctx context.Context, mgr ctrl.Manager) {
 	if err := index.AddDefaultIndexes(ctx, mgr); err != nil {
 		setupLog.Error(err, "Unable to setup indexes")
@@ -436,18 +545,72 @@ func setupIndexes(ctx context.Context, mgr ctrl.Manager) {
 	}
 }
 
-func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace string, syncPeriod *time.Duration) clustercache.ClusterCache {
+// setupSharding returns the Sharder used by controllers to only reconcile objects of the shard of this replica;
+// it returns nil if sharding is disabled.
+func setupSharding(ctx context.Context, mgr ctrl.Manager) *shard.Sharder {
+	if !feature.Gates.Enabled(feature.ControllerSharding) {
+		return nil
+	}
+
+	sharder, err := shard.New(mgr.GetClient(), mgr.GetScheme(), shard.Options{
+		Shard:  shardName,
+		Shards: shardNames,
+		Key:    shard.Key(shardKey),
+	})
+	if err != nil {
+		setupLog.Error(err, "Unable to setup sharding: invalid flags")
+		os.Exit(1)
+	}
+	if err := (&shardassignment.Reconciler{
+		Client:           mgr.GetClient(),
+		Sharder:          sharder,
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+		setupLog.Error(err, "Unable to create controller", "controller", "ShardAssignment")
+		os.Exit(1)
+	}
+	return sharder
+}
+
+func setupStateMetrics(mgr ctrl.Manager) {
+	if !enableStateMetrics {
+		return
+	}
+
+	resources := []statemetrics.Resource{
+		{Kind: "Cluster", List: &clusterv1.ClusterList{}},
+		{Kind: "ClusterClass", List: &clusterv1.ClusterClassList{}},
+		{Kind: "Machine", List: &clusterv1.MachineList{}},
+		{Kind: "MachineSet", List: &clusterv1.MachineSetList{}},
+		{Kind: "MachineDeployment", List: &clusterv1.MachineDeploymentList{}},
+		{Kind: "MachineHealthCheck", List: &clusterv1.MachineHealthCheckList{}},
+	}
+	if feature.Gates.Enabled(feature.MachinePool) {
+		resources = append(resources, statemetrics.Resource{Kind: "MachinePool", List: &clusterv1.MachinePoolList{}})
+	}
+	ctrlmetrics.Registry.MustRegister(statemetrics.NewCollector(mgr.GetCache(), resources...))
+}
+
+func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace string, syncPeriod *time.Duration, sharder *shard.Sharder) clustercache.ClusterCache {
 	secretCachingClient, err := setup.CreateSecretCachingClient(mgr)
 	if err != nil {
 		setupLog.Error(err, "Unable to create secret caching client")
 		os.Exit(1)
 	}
 
+	// Only connect to Clusters of the shard of this replica, if sharding is enabled.
+	var clusterFilter clustercache.ClusterFilter
+	if sharder != nil {
+		clusterFilter = func(cluster *clusterv1.Cluster) bool {
+			return sharder.ClusterShard(cluster) == sharder.Shard()
+		}
+	}
 	clusterCache, err := clustercache.SetupWithManager(ctx, mgr, clustercache.Options{
 		SecretClient:     secretCachingClient,
 		Cache:            setup.ClusterCacheCacheOptions(),
 		Client:           setup.ClusterCacheClientOptions(controllerName, clusterCacheClientQPS, clusterCacheClientBurst),
 		WatchFilterValue: watchFilterValue,
+		ClusterFilter:    clusterFilter,
 	}, concurrency(clusterCacheConcurrency))
 	if err != nil {
 		setupLog.Error(err, "Unable to create ClusterCache")
@@ -558,17 +721,22 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 			Client:           mgr.GetClient(),
 			RuntimeClient:    runtimeClient,
 			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
 		}).SetupWithManager(ctx, mgr, concurrency(clusterClassConcurrency)); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "ClusterClass")
 			os.Exit(1)
 		}
 
 		if err := (&topologycluster.Reconciler{
-			Client:           mgr.GetClient(),
-			APIReader:        mgr.GetAPIReader(),
-			RuntimeClient:    runtimeClient,
-			ClusterCache:     clusterCache,
-			WatchFilterValue: watchFilterValue,
+			Client:                      mgr.GetClient(),
+			APIReader:                   mgr.GetAPIReader(),
+			RuntimeClient:               runtimeClient,
+			ClusterCache:                clusterCache,
+			WatchFilterValue:            watchFilterValue,
+			Sharder:                     sharder,
+			OrphanedTemplateGracePeriod: orphanedTemplateGracePeriod,
+			OrphanedTemplateDryRun:      orphanedTemplateDryRun,
+			EventDeduplicationWindow:    eventDeduplicationWindow,
 		}).SetupWithManager(ctx, mgr, concurrency(clusterTopologyConcurrency)); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "ClusterTopology")
 			os.Exit(1)
@@ -578,6 +746,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 			Client:           mgr.GetClient(),
 			APIReader:        mgr.GetAPIReader(),
 			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
 		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "MachineDeploymentTopology")
 			os.Exit(1)
@@ -587,10 +756,33 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 			Client:           mgr.GetClient(),
 			APIReader:        mgr.GetAPIReader(),
 			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
 		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "MachineSetTopology")
 			os.Exit(1)
 		}
+
+		if feature.Gates.Enabled(feature.ClusterClassOCISource) {
+			if err := (&topologyclusterclassoci.Reconciler{
+				Client:           mgr.GetClient(),
+				WatchFilterValue: watchFilterValue,
+				Sharder:          sharder,
+			}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+				setupLog.Error(err, "Unable to create controller", "controller", "ClusterClassOCISource")
+				os.Exit(1)
+			}
+		}
+
+		if feature.Gates.Enabled(feature.ClusterClassChannels) {
+			if err := (&topologyclusterclasschannel.Reconciler{
+				Client:           mgr.GetClient(),
+				WatchFilterValue: watchFilterValue,
+				Sharder:          sharder,
+			}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+				setupLog.Error(err, "Unable to create controller", "controller", "ClusterClassChannels")
+				os.Exit(1)
+			}
+		}
 	}
 
 	if feature.Gates.Enabled(feature.RuntimeSDK) {
@@ -611,6 +803,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 		APIReader:                   mgr.GetAPIReader(),
 		ClusterCache:                clusterCache,
 		WatchFilterValue:            watchFilterValue,
+		Sharder:                     sharder,
 		RemoteConnectionGracePeriod: remoteConnectionGracePeriod,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "Cluster")
@@ -643,15 +836,26 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 		setupLog.Error(fmt.Errorf("at least one of --additional-sync-machine-annotations regexes is invalid: %w", kerrors.NewAggregate(errs)), "Unable to start manager")
 		os.Exit(1)
 	}
+	// Note: The same ClusterRateLimiter is used for Machines and MachineDeployments, so the limit applies
+	// to the sum of their reconciles for a workload cluster.
+	var clusterRateLimiter *capicontrollerutil.ClusterRateLimiter
+	if clusterReconcileQPS > 0 {
+		clusterRateLimiter = capicontrollerutil.NewClusterRateLimiter(clusterReconcileQPS, clusterReconcileBurst)
+	}
+
 	if err := (&machine.Reconciler{
 		Client:                           mgr.GetClient(),
 		APIReader:                        mgr.GetAPIReader(),
 		ClusterCache:                     clusterCache,
 		RuntimeClient:                    runtimeClient,
 		WatchFilterValue:                 watchFilterValue,
+		Sharder:                          sharder,
 		RemoteConditionsGracePeriod:      remoteConditionsGracePeriod,
+		DeletionBlockedThreshold:         machineDeletionBlockedThreshold,
 		AdditionalSyncMachineLabels:      additionalSyncMachineLabelRegexes,
 		AdditionalSyncMachineAnnotations: additionalSyncMachineAnnotationRegexes,
+		ClusterRateLimiter:               clusterRateLimiter,
+		EventDeduplicationWindow:         eventDeduplicationWindow,
 	}).SetupWithManager(ctx, mgr, concurrency(machineConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "Machine")
 		os.Exit(1)
@@ -663,6 +867,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 		clusterv1.MachineSetPreflightCheckKubernetesVersionSkew,
 		clusterv1.MachineSetPreflightCheckControlPlaneIsStable,
 		clusterv1.MachineSetPreflightCheckControlPlaneVersionSkew,
+		clusterv1.MachineSetPreflightCheckCanCreateMachine,
 	)
 	for _, c := range machineSetPreflightChecks {
 		if c == "" {
@@ -679,17 +884,21 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 		Client:           mgr.GetClient(),
 		APIReader:        mgr.GetAPIReader(),
 		ClusterCache:     clusterCache,
+		RuntimeClient:    runtimeClient,
 		PreflightChecks:  machineSetPreflightChecksSet,
 		WatchFilterValue: watchFilterValue,
+		Sharder:          sharder,
 	}).SetupWithManager(ctx, mgr, concurrency(machineSetConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineSet")
 		os.Exit(1)
 	}
 	if err := (&machinedeployment.Reconciler{
-		Client:           mgr.GetClient(),
-		APIReader:        mgr.GetAPIReader(),
-		RuntimeClient:    runtimeClient,
-		WatchFilterValue: watchFilterValue,
+		Client:             mgr.GetClient(),
+		APIReader:          mgr.GetAPIReader(),
+		RuntimeClient:      runtimeClient,
+		WatchFilterValue:   watchFilterValue,
+		Sharder:            sharder,
+		ClusterRateLimiter: clusterRateLimiter,
 	}).SetupWithManager(ctx, mgr, concurrency(machineDeploymentConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineDeployment")
 		os.Exit(1)
@@ -701,6 +910,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 			APIReader:        mgr.GetAPIReader(),
 			ClusterCache:     clusterCache,
 			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
 		}).SetupWithManager(ctx, mgr, concurrency(machinePoolConcurrency)); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "MachinePool")
 			os.Exit(1)
@@ -711,6 +921,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 		Client:           mgr.GetClient(),
 		ClusterCache:     clusterCache,
 		WatchFilterValue: watchFilterValue,
+		Sharder:          sharder,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterResourceSetConcurrency), partialSecretCache); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "ClusterResourceSet")
 		os.Exit(1)
@@ -718,6 +929,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 	if err := (&clusterresourcesetbinding.Reconciler{
 		Client:           mgr.GetClient(),
 		WatchFilterValue: watchFilterValue,
+		Sharder:          sharder,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterResourceSetConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "ClusterResourceSetBinding")
 		os.Exit(1)
@@ -727,15 +939,59 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespace stri
 		Client:           mgr.GetClient(),
 		ClusterCache:     clusterCache,
 		WatchFilterValue: watchFilterValue,
+		Sharder:          sharder,
 	}).SetupWithManager(ctx, mgr, concurrency(machineHealthCheckConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineHealthCheck")
 		os.Exit(1)
 	}
 
+	if feature.Gates.Enabled(feature.ProviderInventoryConditions) {
+		if err := (&providerinventory.Reconciler{
+			Client:           mgr.GetClient(),
+			APIReader:        mgr.GetAPIReader(),
+			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
+		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "ProviderInventory")
+			os.Exit(1)
+		}
+	}
+
+	if feature.Gates.Enabled(feature.InClusterIPAM) {
+		if err := (&inclusterippool.Reconciler{
+			Client:           mgr.GetClient(),
+			APIReader:        mgr.GetAPIReader(),
+			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
+		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "InClusterIPPool")
+			os.Exit(1)
+		}
+	}
+
+	if feature.Gates.Enabled(feature.IPAddressLeakDetection) {
+		if err := (&ipaddressleak.Reconciler{
+			Client:           mgr.GetClient(),
+			APIReader:        mgr.GetAPIReader(),
+			WatchFilterValue: watchFilterValue,
+			Sharder:          sharder,
+			GCGracePeriod:    ipAddressLeakGCGracePeriod,
+		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "IPAddressLeak")
+			os.Exit(1)
+		}
+	}
+
 	return clusterCache
 }
 
 func setupWebhooks(_ context.Context, mgr ctrl.Manager, clusterCacheReader coreadmission.ClusterCacheReader) {
+	// Setup how validating webhooks handle the usage of deprecated fields.
+	deprecatedFieldsPolicy := deprecation.WarnPolicy
+	if webhookDenyDeprecatedFields {
+		deprecatedFieldsPolicy = deprecation.DenyPolicy
+	}
+
 	// Setup the func to retrieve apiVersion for a GroupKind for conversion webhooks.
 	conversion.SetAPIVersionGetter(func(ctx context.Context, gk schema.GroupKind) (string, error) {
 		return contract.GetAPIVersion(ctx, mgr.GetClient(), gk)
@@ -743,7 +999,7 @@ func setupWebhooks(_ context.Context, mgr ctrl.Manager, clusterCacheReader corea
 
 	// NOTE: ClusterClass and managed topologies are behind ClusterTopology feature gate flag; the webhook
 	// is going to prevent creating or updating new objects in case the feature flag is disabled.
-	if err := (&coreadmission.ClusterClass{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&coreadmission.ClusterClass{Client: mgr.GetClient(), DeprecatedFieldsPolicy: deprecatedFieldsPolicy}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create webhook", "webhook", "ClusterClass")
 		os.Exit(1)
 	}
@@ -770,11 +1026,23 @@ func setupWebhooks(_ context.Context, mgr ctrl.Manager, clusterCacheReader corea
 		os.Exit(1)
 	}
 
+	if err := (&coreadmission.MachineDeploymentScaleValidator{
+		Client: mgr.GetClient(),
+	}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Unable to create webhook", "webhook", "MachineDeployment scale")
+		os.Exit(1)
+	}
+
 	if err := (&coreadmission.MachineDrainRule{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create webhook", "webhook", "MachineDrainRule")
 		os.Exit(1)
 	}
 
+	if err := (&coreadmission.ClusterMachineDrainRule{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Unable to create webhook", "webhook", "ClusterMachineDrainRule")
+		os.Exit(1)
+	}
+
 	// NOTE: MachinePool is behind MachinePool feature gate flag; the webhook
 	// is going to prevent creating or updating new objects in case the feature flag is disabled
 	if err := (&coreadmission.MachinePool{}).SetupWebhookWithManager(mgr); err != nil {
@@ -818,6 +1086,17 @@ func setupWebhooks(_ context.Context, mgr ctrl.Manager, clusterCacheReader corea
 		setupLog.Error(err, "Unable to create webhook", "webhook", "IPAddressClaim")
 		os.Exit(1)
 	}
+
+	// NOTE: InClusterIPPool and GlobalInClusterIPPool are behind the InClusterIPAM feature gate flag; the webhook
+	// is going to prevent creating or updating new objects in case the feature flag is disabled.
+	if err := (&coreadmission.InClusterIPPool{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Unable to create webhook", "webhook", "InClusterIPPool")
+		os.Exit(1)
+	}
+	if err := (&coreadmission.GlobalInClusterIPPool{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Unable to create webhook", "webhook", "GlobalInClusterIPPool")
+		os.Exit(1)
+	}
 }
 
 func concurrency(c int) controller.Options {
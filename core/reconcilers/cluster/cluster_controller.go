@@ -18,7 +18,9 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -27,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,6 +45,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/feature"
@@ -56,6 +61,8 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 const (
@@ -82,6 +89,10 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
 	RemoteConnectionGracePeriod time.Duration
 
 	recorder        record.EventRecorder
@@ -116,6 +127,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 
 	c, err := b.
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Build(ctx, r)
 
@@ -148,6 +160,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 		// Error reading the object - requeue the request.
 		return ctrl.Result{}, err
 	}
+	tracing.SetClusterName(ctx, cluster.Name)
 
 	// Add finalizer first if not set to avoid the race condition between init and delete.
 	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, cluster, clusterv1.ClusterFinalizer); err != nil || finalizerAdded {
@@ -187,7 +200,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 		if reterr == nil {
 			patchOpts = append(patchOpts, patch.WithStatusObservedGeneration{})
 		}
-		if err := patchCluster(ctx, patchHelper, cluster, patchOpts...); err != nil {
+		if err := patchCluster(ctx, patchHelper, cluster, append(slices.Clone(s.rollupConditionTypes), s.staleRollupConditionTypes...), patchOpts...); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, err})
 		}
 	}()
@@ -196,6 +209,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 		r.reconcileInfrastructure,
 		r.reconcileControlPlane,
 		r.getDescendants,
+		r.reconcileRollupConditions,
 	}
 
 	// Handle deletion reconciliation loop.
@@ -225,7 +239,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retRes ct
 	return doReconcile(ctx, reconcileNormal, s)
 }
 
-func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clusterv1.Cluster, options ...patch.Option) error {
+func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clusterv1.Cluster, rollupConditionTypes []string, options ...patch.Option) error {
 	// Always update the readyCondition by summarizing the state of other conditions.
 	v1beta1conditions.SetSummary(cluster,
 		v1beta1conditions.WithConditions(
@@ -243,7 +257,7 @@ func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clust
 			clusterv1.ControlPlaneReadyV1Beta1Condition,
 			clusterv1.InfrastructureReadyV1Beta1Condition,
 		}},
-		patch.WithOwnedConditions{Conditions: []string{
+		patch.WithOwnedConditions{Conditions: append([]string{
 			clusterv1.PausedCondition,
 			clusterv1.ClusterInfrastructureReadyCondition,
 			clusterv1.ClusterControlPlaneAvailableCondition,
@@ -260,7 +274,7 @@ func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clust
 			clusterv1.ClusterRemediatingCondition,
 			clusterv1.ClusterDeletingCondition,
 			clusterv1.ClusterAvailableCondition,
-		}},
+		}, rollupConditionTypes...)},
 	)
 	return patchHelper.Patch(ctx, cluster, options...)
 }
@@ -324,6 +338,18 @@ type scope struct {
 
 	// deletingMessage is the message that should be used when setting the Deleting condition.
 	deletingMessage string
+
+	// rollupConditionTypes is the list of Cluster condition types rolled up from provider objects
+	// into the Available condition. It is set after reconcileRollupConditions is called.
+	rollupConditionTypes []string
+
+	// rollupConditions is the list of Cluster conditions rolled up from provider objects.
+	// It is set after reconcileRollupConditions is called.
+	rollupConditions []metav1.Condition
+
+	// staleRollupConditionTypes is the list of Cluster condition types rolled up by previous reconciles
+	// which are not rolled up anymore. It is set after reconcileRollupConditions is called.
+	staleRollupConditionTypes []string
 }
 
 // reconcileDelete handles cluster deletion.
@@ -337,6 +363,9 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 		if cluster.Spec.Topology.IsDefined() && !hooks.IsOkToDelete(cluster) {
 			s.deletingReason = clusterv1.ClusterDeletingWaitingForBeforeDeleteHookReason
 			s.deletingMessage = "Waiting for BeforeClusterDelete hook"
+			if blockingReasons := beforeClusterDeleteBlockingReasons(ctx, cluster); len(blockingReasons) > 0 {
+				s.deletingMessage += ":\n" + strings.Join(blockingReasons, "\n")
+			}
 			return ctrl.Result{}, nil
 		}
 	}
@@ -492,6 +521,31 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (reconcile.R
 	return ctrl.Result{}, nil
 }
 
+// beforeClusterDeleteBlockingReasons returns the blocking reasons of the BeforeClusterDelete hook, as recorded by the
+// topology controller in the BeforeClusterDeleteBlockingReasonsAnnotation, formatted as a list for the Deleting condition.
+func beforeClusterDeleteBlockingReasons(ctx context.Context, cluster *clusterv1.Cluster) []string {
+	value, ok := cluster.GetAnnotations()[runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation]
+	if !ok || value == "" {
+		return nil
+	}
+
+	var blockingReasons []runtimehooksv1.BlockingReason
+	if err := json.Unmarshal([]byte(value), &blockingReasons); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, fmt.Sprintf("Failed to parse the %s annotation", runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation))
+		return nil
+	}
+
+	messages := make([]string, 0, len(blockingReasons))
+	for _, blockingReason := range blockingReasons {
+		if blockingReason.Object.Kind != "" && blockingReason.Object.Name != "" {
+			messages = append(messages, fmt.Sprintf("* %s %s: %s", blockingReason.Object.Kind, blockingReason.Object.Name, blockingReason.Message))
+			continue
+		}
+		messages = append(messages, "* "+blockingReason.Message)
+	}
+	return messages
+}
+
 type clusterDescendants struct {
 	machineDeployments     clusterv1.MachineDeploymentList
 	machineSets            clusterv1.MachineSetList
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// rollupSource is a kind whose objects contribute conditions to the Available condition of the Cluster.
+type rollupSource struct {
+	gvk            schema.GroupVersionKind
+	conditionTypes []string
+}
+
+// reconcileRollupConditions computes the conditions of provider objects that should be rolled up into the
+// Cluster's Available condition, as declared by CRDs annotated with the AvailabilityConditionsAnnotation.
+// For each declared condition type, the corresponding conditions of all the objects of that kind belonging to the
+// Cluster are aggregated into a Cluster condition named <Kind><ConditionType>, e.g. CNIInstallationReady.
+// Note: Failures to compute rollup conditions do not fail the reconcile of the Cluster; they are logged and surfaced
+// in the corresponding rollup condition.
+func (r *Reconciler) reconcileRollupConditions(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	previousRollupConditionTypes := parseRollupConditionTypes(s.cluster.Annotations[clusterv1.AvailabilityRollupConditionsAnnotation])
+	defer func() {
+		// Keep track of the rollup condition types, so the corresponding conditions can be removed
+		// when they are not rolled up anymore.
+		for _, t := range previousRollupConditionTypes {
+			if !slices.Contains(s.rollupConditionTypes, t) {
+				s.staleRollupConditionTypes = append(s.staleRollupConditionTypes, t)
+			}
+		}
+		if len(s.rollupConditionTypes) == 0 {
+			delete(s.cluster.Annotations, clusterv1.AvailabilityRollupConditionsAnnotation)
+			return
+		}
+		annotations.AddAnnotations(s.cluster, map[string]string{
+			clusterv1.AvailabilityRollupConditionsAnnotation: strings.Join(s.rollupConditionTypes, ","),
+		})
+	}()
+
+	if !feature.Gates.Enabled(feature.ClusterAvailabilityRollup) {
+		return ctrl.Result{}, nil
+	}
+
+	sources, err := r.getRollupSources(ctx)
+	if err != nil {
+		// If it is not possible to determine which conditions should be rolled up, preserve the rollup conditions
+		// computed by previous reconciles.
+		log.Error(err, "Failed to get kinds to roll up conditions from, preserving existing rollup conditions")
+		s.rollupConditionTypes = previousRollupConditionTypes
+		s.rollupConditions = []metav1.Condition{}
+		for _, t := range previousRollupConditionTypes {
+			if c := conditions.Get(s.cluster, t); c != nil {
+				s.rollupConditions = append(s.rollupConditions, *c)
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	rollupConditions := []metav1.Condition{}
+	for _, source := range sources {
+		objs, err := r.getRollupObjects(ctx, s.cluster, source)
+
+		for _, conditionType := range source.conditionTypes {
+			targetConditionType := source.gvk.Kind + conditionType
+			s.rollupConditionTypes = append(s.rollupConditionTypes, targetConditionType)
+
+			if err != nil {
+				log.Error(err, fmt.Sprintf("Failed to roll up %s conditions of %s objects", conditionType, source.gvk.Kind))
+				rollupConditions = append(rollupConditions, rollupInternalErrorCondition(targetConditionType))
+				continue
+			}
+
+			// If there are no objects of this kind for the Cluster, the condition is not rolled up.
+			if len(objs) == 0 {
+				continue
+			}
+
+			c, err := conditions.NewAggregateConditionFromUnstructured(objs, conditionType, conditions.TargetConditionType(targetConditionType))
+			if err != nil {
+				log.Error(err, fmt.Sprintf("Failed to aggregate %s conditions of %s objects", conditionType, source.gvk.Kind))
+				rollupConditions = append(rollupConditions, rollupInternalErrorCondition(targetConditionType))
+				continue
+			}
+			rollupConditions = append(rollupConditions, *c)
+		}
+	}
+	s.rollupConditions = rollupConditions
+
+	return ctrl.Result{}, nil
+}
+
+// getRollupObjects returns the objects of a rollup source kind belonging to the Cluster.
+func (r *Reconciler) getRollupObjects(ctx context.Context, cluster *clusterv1.Cluster, source rollupSource) ([]runtime.Unstructured, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Ensure we add a watch to the kind, so the Cluster is reconciled when one of its objects changes.
+	if r.externalTracker.Controller != nil {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(source.gvk)
+		if err := r.externalTracker.Watch(log, obj, handler.EnqueueRequestsFromMapFunc(clusterNameLabelToCluster), predicates.ResourceIsChanged(r.Client.Scheme(), *r.externalTracker.PredicateLogger)); err != nil {
+			return nil, err
+		}
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(source.gvk.GroupVersion().WithKind(source.gvk.Kind + "List"))
+	if err := r.Client.List(ctx, list, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to list %s objects", source.gvk.Kind)
+	}
+
+	objs := make([]runtime.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		objs = append(objs, &list.Items[i])
+	}
+	return objs, nil
+}
+
+// rollupInternalErrorCondition returns the condition surfacing a failure to compute a rollup condition.
+func rollupInternalErrorCondition(conditionType string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionUnknown,
+		Reason:  clusterv1.ClusterAvailabilityRollupInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	}
+}
+
+// getRollupSources returns the kinds, and the corresponding condition types, declared via the
+// AvailabilityConditionsAnnotation on CustomResourceDefinitions.
+func (r *Reconciler) getRollupSources(ctx context.Context) ([]rollupSource, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	crds := &metav1.PartialObjectMetadataList{}
+	crds.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinitionList"))
+	if err := r.Client.List(ctx, crds); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list CustomResourceDefinitions")
+	}
+
+	sources := []rollupSource{}
+	for _, crd := range crds.Items {
+		value, ok := crd.Annotations[clusterv1.AvailabilityConditionsAnnotation]
+		if !ok {
+			continue
+		}
+		conditionTypes := parseRollupConditionTypes(value)
+		if len(conditionTypes) == 0 {
+			continue
+		}
+
+		// CRD names are in the form <plural>.<group>.
+		resource, group, ok := strings.Cut(crd.Name, ".")
+		if !ok {
+			continue
+		}
+		gvk, err := r.Client.RESTMapper().KindFor(schema.GroupVersionResource{Group: group, Resource: resource})
+		if err != nil {
+			// Skip CRDs which are not yet served, e.g. because they have just been created.
+			log.V(4).Info(fmt.Sprintf("Skipping CustomResourceDefinition %s for availability rollup: %v", crd.Name, err))
+			continue
+		}
+		sources = append(sources, rollupSource{gvk: gvk, conditionTypes: conditionTypes})
+	}
+
+	// Sort sources to get a stable order of conditions.
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].gvk.Kind < sources[j].gvk.Kind
+	})
+	return sources, nil
+}
+
+// parseRollupConditionTypes parses the value of the AvailabilityConditionsAnnotation.
+func parseRollupConditionTypes(value string) []string {
+	conditionTypes := []string{}
+	for _, t := range strings.Split(value, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			conditionTypes = append(conditionTypes, t)
+		}
+	}
+	return conditionTypes
+}
+
+// setRollupConditions sets the rolled up conditions on the Cluster, and deletes rolled up conditions
+// for which there are no objects as well as stale rolled up conditions.
+func setRollupConditions(cluster *clusterv1.Cluster, rollupConditionTypes, staleRollupConditionTypes []string, rollupConditions []metav1.Condition) {
+	set := map[string]bool{}
+	for _, c := range rollupConditions {
+		conditions.Set(cluster, c)
+		set[c.Type] = true
+	}
+	for _, conditionType := range append(slices.Clone(rollupConditionTypes), staleRollupConditionTypes...) {
+		if !set[conditionType] {
+			conditions.Delete(cluster, conditionType)
+		}
+	}
+}
+
+// clusterNameLabelToCluster maps an object with the cluster-name label to the corresponding Cluster.
+func clusterNameLabelToCluster(_ context.Context, o client.Object) []ctrl.Request {
+	name, ok := o.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok || name == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: o.GetNamespace(), Name: name}}}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var cniInstallationGVK = schema.GroupVersionKind{Group: "addons.example.com", Version: "v1alpha1", Kind: "CNIInstallation"}
+
+func TestReconcileRollupConditions(t *testing.T) {
+	malformedCNIInstallation := newCNIInstallation("cni-malformed", "c1", metav1.ConditionTrue)
+	malformedCNIInstallation.Object["status"].(map[string]interface{})["conditions"].([]interface{})[0].(map[string]interface{})["lastTransitionTime"] = "not-a-time"
+
+	tests := []struct {
+		name                          string
+		featureGateDisabled           bool
+		clusterAnnotations            map[string]string
+		crdAnnotations                map[string]string
+		objs                          []client.Object
+		listErr                       error
+		wantRollupConditionTypes      []string
+		wantRollupConditions          []metav1.Condition
+		wantStaleRollupConditionTypes []string
+		wantClusterAnnotations        map[string]string
+	}{
+		{
+			name:                     "No rollup conditions if the CRD is not annotated",
+			objs:                     []client.Object{newCNIInstallation("cni", "c1", metav1.ConditionTrue)},
+			wantRollupConditionTypes: nil,
+			wantRollupConditions:     []metav1.Condition{},
+		},
+		{
+			name:                     "No rollup conditions if there are no objects for the Cluster",
+			crdAnnotations:           map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready"},
+			objs:                     []client.Object{newCNIInstallation("cni", "another-cluster", metav1.ConditionTrue)},
+			wantRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantRollupConditions:     []metav1.Condition{},
+			wantClusterAnnotations:   map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+		},
+		{
+			name:                     "Rollup condition is true if all objects report the condition as true",
+			crdAnnotations:           map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready"},
+			objs:                     []client.Object{newCNIInstallation("cni-1", "c1", metav1.ConditionTrue), newCNIInstallation("cni-2", "c1", metav1.ConditionTrue)},
+			wantRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantRollupConditions: []metav1.Condition{
+				{Type: "CNIInstallationReady", Status: metav1.ConditionTrue, Reason: "InfoReported"},
+			},
+			wantClusterAnnotations: map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+		},
+		{
+			name:                     "Rollup condition is false if one object reports the condition as false",
+			crdAnnotations:           map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready, NetworkReady"},
+			objs:                     []client.Object{newCNIInstallation("cni-1", "c1", metav1.ConditionTrue), newCNIInstallation("cni-2", "c1", metav1.ConditionFalse)},
+			wantRollupConditionTypes: []string{"CNIInstallationReady", "CNIInstallationNetworkReady"},
+			wantRollupConditions: []metav1.Condition{
+				{Type: "CNIInstallationReady", Status: metav1.ConditionFalse, Reason: "IssuesReported", Message: "* CNIInstallation cni-2: Not ready"},
+				{Type: "CNIInstallationNetworkReady", Status: metav1.ConditionUnknown, Reason: "UnknownReported", Message: "* CNIInstallations cni-1, cni-2: Condition NetworkReady not yet reported"},
+			},
+			wantClusterAnnotations: map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady,CNIInstallationNetworkReady"},
+		},
+		{
+			name:                     "Objects with malformed conditions are skipped",
+			crdAnnotations:           map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready"},
+			objs:                     []client.Object{newCNIInstallation("cni-1", "c1", metav1.ConditionTrue), malformedCNIInstallation},
+			wantRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantRollupConditions: []metav1.Condition{
+				{Type: "CNIInstallationReady", Status: metav1.ConditionTrue, Reason: "InfoReported"},
+			},
+			wantClusterAnnotations: map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+		},
+		{
+			name:                     "Rollup condition surfaces failures to read the conditions of all the objects",
+			crdAnnotations:           map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready"},
+			objs:                     []client.Object{malformedCNIInstallation},
+			wantRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantRollupConditions: []metav1.Condition{
+				{Type: "CNIInstallationReady", Status: metav1.ConditionUnknown, Reason: clusterv1.ClusterAvailabilityRollupInternalErrorReason, Message: "Please check controller logs for errors"},
+			},
+			wantClusterAnnotations: map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+		},
+		{
+			name:                     "Rollup condition surfaces failures to list objects",
+			crdAnnotations:           map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready"},
+			objs:                     []client.Object{newCNIInstallation("cni-1", "c1", metav1.ConditionTrue)},
+			listErr:                  apierrors.NewForbidden(schema.GroupResource{Group: cniInstallationGVK.Group, Resource: "cniinstallations"}, "", errors.New("forbidden")),
+			wantRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantRollupConditions: []metav1.Condition{
+				{Type: "CNIInstallationReady", Status: metav1.ConditionUnknown, Reason: clusterv1.ClusterAvailabilityRollupInternalErrorReason, Message: "Please check controller logs for errors"},
+			},
+			wantClusterAnnotations: map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+		},
+		{
+			name:                          "Rollup conditions are stale if the CRD is not annotated anymore",
+			clusterAnnotations:            map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+			objs:                          []client.Object{newCNIInstallation("cni", "c1", metav1.ConditionTrue)},
+			wantRollupConditionTypes:      nil,
+			wantRollupConditions:          []metav1.Condition{},
+			wantStaleRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantClusterAnnotations:        map[string]string{},
+		},
+		{
+			name:                          "Rollup conditions are stale if the feature gate is disabled",
+			featureGateDisabled:           true,
+			clusterAnnotations:            map[string]string{clusterv1.AvailabilityRollupConditionsAnnotation: "CNIInstallationReady"},
+			crdAnnotations:                map[string]string{clusterv1.AvailabilityConditionsAnnotation: "Ready"},
+			objs:                          []client.Object{newCNIInstallation("cni", "c1", metav1.ConditionTrue)},
+			wantRollupConditionTypes:      nil,
+			wantRollupConditions:          nil,
+			wantStaleRollupConditionTypes: []string{"CNIInstallationReady"},
+			wantClusterAnnotations:        map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterAvailabilityRollup, !tt.featureGateDisabled)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(cniInstallationGVK, meta.RESTScopeNamespace)
+
+			crd := &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cniinstallations.addons.example.com",
+					Annotations: tt.crdAnnotations,
+				},
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(append(tt.objs, crd)...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*unstructured.UnstructuredList); ok && tt.listErr != nil {
+							return tt.listErr
+						}
+						return c.List(ctx, list, opts...)
+					},
+				}).
+				Build()
+
+			r := &Reconciler{Client: c}
+			s := &scope{cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "c1", Annotations: tt.clusterAnnotations}}}
+			_, err := r.reconcileRollupConditions(ctx, s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(s.rollupConditionTypes).To(Equal(tt.wantRollupConditionTypes))
+			g.Expect(s.rollupConditions).To(Equal(tt.wantRollupConditions))
+			g.Expect(s.staleRollupConditionTypes).To(Equal(tt.wantStaleRollupConditionTypes))
+			g.Expect(s.cluster.Annotations).To(Equal(tt.wantClusterAnnotations))
+		})
+	}
+}
+
+func TestSetRollupConditions(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{}
+	conditions.Set(cluster, metav1.Condition{Type: "CNIInstallationNetworkReady", Status: metav1.ConditionTrue, Reason: "InfoReported"})
+	conditions.Set(cluster, metav1.Condition{Type: "StorageInstallationReady", Status: metav1.ConditionTrue, Reason: "InfoReported"})
+
+	setRollupConditions(cluster, []string{"CNIInstallationReady", "CNIInstallationNetworkReady"}, []string{"StorageInstallationReady"}, []metav1.Condition{
+		{Type: "CNIInstallationReady", Status: metav1.ConditionFalse, Reason: "IssuesReported", Message: "* CNIInstallation cni-2: Not ready"},
+	})
+	g.Expect(conditions.Has(cluster, "CNIInstallationReady")).To(BeTrue())
+	g.Expect(conditions.Has(cluster, "CNIInstallationNetworkReady")).To(BeFalse())
+	g.Expect(conditions.Has(cluster, "StorageInstallationReady")).To(BeFalse())
+
+	setAvailableCondition(ctx, cluster, nil, []string{"CNIInstallationReady", "CNIInstallationNetworkReady"})
+	available := conditions.Get(cluster, clusterv1.ClusterAvailableCondition)
+	g.Expect(available).ToNot(BeNil())
+	g.Expect(available.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(available.Message).To(ContainSubstring("CNIInstallationReady"))
+	g.Expect(available.Message).ToNot(ContainSubstring("CNIInstallationNetworkReady"))
+}
+
+func newCNIInstallation(name, clusterName string, ready metav1.ConditionStatus) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(cniInstallationGVK)
+	u.SetNamespace(metav1.NamespaceDefault)
+	u.SetName(name)
+	u.SetLabels(map[string]string{clusterv1.ClusterNameLabel: clusterName})
+	message := ""
+	if ready == metav1.ConditionFalse {
+		message = "Not ready"
+	}
+	_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+		map[string]interface{}{
+			"type":               "Ready",
+			"status":             string(ready),
+			"reason":             "Reason",
+			"message":            message,
+			"lastTransitionTime": "2026-01-01T00:00:00Z",
+		},
+	}, "status", "conditions")
+	return u
+}
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -89,7 +90,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	setScalingDownCondition(ctx, s.cluster, s.controlPlane, clusterv1.MachinePoolList{}, s.descendants.machineDeployments, s.descendants.machineSets, s.controlPlaneIsNotFound, s.getDescendantsSucceeded)
 	setRemediatingCondition(ctx, s.cluster, machinesToBeRemediated, unhealthyMachines, s.getDescendantsSucceeded)
 	setDeletingCondition(ctx, s.cluster, s.deletingReason, s.deletingMessage)
-	setAvailableCondition(ctx, s.cluster, s.clusterClass)
+	setRollupConditions(s.cluster, s.rollupConditionTypes, s.staleRollupConditionTypes, s.rollupConditions)
+	setAvailableCondition(ctx, s.cluster, s.clusterClass, s.rollupConditionTypes)
 
 	return nil
 }
@@ -309,21 +311,34 @@ func setWorkersReplicas(_ context.Context, cluster *clusterv1.Cluster, machinePo
 }
 
 func setRemoteConnectionProbeCondition(_ context.Context, cluster *clusterv1.Cluster, healthCheckingState clustercache.HealthCheckingState, remoteConnectionGracePeriod time.Duration) {
+	failureThreshold := healthCheckingState.FailureThreshold
+	if failureThreshold == 0 {
+		failureThreshold = clustercache.DefaultHealthProbeFailureThreshold
+	}
+
+	// lastProbeErrorMessage surfaces when the last probe was executed and with which error it failed, if any.
+	lastProbeErrorMessage := ""
+	if healthCheckingState.LastProbeError != "" {
+		lastProbeErrorMessage = fmt.Sprintf(", last probe at %s failed: %s", healthCheckingState.LastProbeTime.Format(time.RFC3339), healthCheckingState.LastProbeError)
+	}
+
 	// ClusterCache did not try to connect often enough yet, either during controller startup or when a new Cluster is created.
-	if healthCheckingState.LastProbeSuccessTime.IsZero() && healthCheckingState.ConsecutiveFailures < 5 {
+	if healthCheckingState.LastProbeSuccessTime.IsZero() && healthCheckingState.ConsecutiveFailures < failureThreshold {
 		// If condition is not set, set it.
 		if !conditions.Has(cluster, clusterv1.ClusterRemoteConnectionProbeCondition) {
 			conditions.Set(cluster, metav1.Condition{
 				Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
 				Status:  metav1.ConditionFalse,
 				Reason:  clusterv1.ClusterRemoteConnectionProbeFailedReason,
-				Message: "Remote connection not established yet",
+				Message: "Remote connection not established yet" + lastProbeErrorMessage,
 			})
 		}
 		return
 	}
 
-	if time.Since(healthCheckingState.LastProbeSuccessTime) > remoteConnectionGracePeriod {
+	// Note: If the connection is degraded the ClusterCache keeps the connection even if probes are failing,
+	// so the condition is set to false without waiting for the grace period.
+	if healthCheckingState.Degraded || time.Since(healthCheckingState.LastProbeSuccessTime) > remoteConnectionGracePeriod {
 		var msg string
 		if healthCheckingState.LastProbeSuccessTime.IsZero() {
 			msg = "Remote connection probe failed"
@@ -334,7 +349,7 @@ func setRemoteConnectionProbeCondition(_ context.Context, cluster *clusterv1.Clu
 			Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
 			Status:  metav1.ConditionFalse,
 			Reason:  clusterv1.ClusterRemoteConnectionProbeFailedReason,
-			Message: msg,
+			Message: msg + lastProbeErrorMessage,
 		})
 		return
 	}
@@ -1164,7 +1179,7 @@ func (c clusterConditionCustomMergeStrategy) Merge(operation conditions.MergeOpe
 	).Merge(operation, mergeConditions, conditionTypes)
 }
 
-func setAvailableCondition(ctx context.Context, cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) {
+func setAvailableCondition(ctx context.Context, cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass, rollupConditionTypes []string) {
 	log := ctrl.LoggerFrom(ctx)
 
 	defaultAvailabilityGates := []string{
@@ -1175,7 +1190,7 @@ func setAvailableCondition(ctx context.Context, cluster *clusterv1.Cluster, clus
 		clusterv1.ClusterWorkersAvailableCondition,
 		clusterv1.ClusterTopologyReconciledCondition,
 	}
-	forConditionTypes := make(conditions.ForConditionTypes, 0, len(defaultAvailabilityGates)+len(cluster.Spec.AvailabilityGates))
+	forConditionTypes := make(conditions.ForConditionTypes, 0, len(defaultAvailabilityGates)+len(cluster.Spec.AvailabilityGates)+len(rollupConditionTypes))
 	forConditionTypes = append(forConditionTypes, defaultAvailabilityGates...)
 	negativePolarityConditionTypes := []string{clusterv1.ClusterDeletingCondition}
 	availabilityGates := cluster.Spec.AvailabilityGates
@@ -1188,6 +1203,16 @@ func setAvailableCondition(ctx context.Context, cluster *clusterv1.Cluster, clus
 			negativePolarityConditionTypes = append(negativePolarityConditionTypes, g.ConditionType)
 		}
 	}
+	// Conditions rolled up from provider objects have positive polarity; they are ignored if there are no objects
+	// to roll up conditions from, or if they are already considered as availability gates.
+	rollupConditionTypesToIgnoreIfMissing := conditions.IgnoreTypesIfMissing{}
+	for _, t := range rollupConditionTypes {
+		if slices.Contains(forConditionTypes, t) {
+			continue
+		}
+		forConditionTypes = append(forConditionTypes, t)
+		rollupConditionTypesToIgnoreIfMissing = append(rollupConditionTypesToIgnoreIfMissing, t)
+	}
 
 	summaryOpts := []conditions.SummaryOption{
 		forConditionTypes,
@@ -1203,8 +1228,12 @@ func setAvailableCondition(ctx context.Context, cluster *clusterv1.Cluster, clus
 			},
 		},
 	}
+	ignoreTypesIfMissing := rollupConditionTypesToIgnoreIfMissing
 	if !cluster.Spec.Topology.IsDefined() {
-		summaryOpts = append(summaryOpts, conditions.IgnoreTypesIfMissing{clusterv1.ClusterTopologyReconciledCondition})
+		ignoreTypesIfMissing = append(ignoreTypesIfMissing, clusterv1.ClusterTopologyReconciledCondition)
+	}
+	if len(ignoreTypesIfMissing) > 0 {
+		summaryOpts = append(summaryOpts, ignoreTypesIfMissing)
 	}
 
 	availableCondition, err := conditions.NewSummaryCondition(cluster, clusterv1.ClusterAvailableCondition, summaryOpts...)
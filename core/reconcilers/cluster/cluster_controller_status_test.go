@@ -436,6 +436,58 @@ func TestSetRemoteConnectionProbeCondition(t *testing.T) {
 				Message: fmt.Sprintf("Remote connection probe failed, probe last succeeded at %s", (now.Add(-remoteConnectionGracePeriod - time.Second)).Format(time.RFC3339)),
 			},
 		},
+		{
+			name:    "connection down, tried to connect, but failed (with last probe error)",
+			cluster: fakeCluster("c"),
+			healthCheckingState: clustercache.HealthCheckingState{
+				LastProbeTime:        now,
+				LastProbeSuccessTime: time.Time{},
+				ConsecutiveFailures:  2,
+				LastProbeError:       "cluster is not reachable",
+			},
+			expectCondition: metav1.Condition{
+				Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.ClusterRemoteConnectionProbeFailedReason,
+				Message: fmt.Sprintf("Remote connection not established yet, last probe at %s failed: cluster is not reachable", now.Format(time.RFC3339)),
+			},
+		},
+		{
+			name:    "connection down, tried to connect, but failed >= failure threshold times",
+			cluster: fakeCluster("c"),
+			healthCheckingState: clustercache.HealthCheckingState{
+				LastProbeTime:        now,
+				LastProbeSuccessTime: time.Time{},
+				ConsecutiveFailures:  2,
+				FailureThreshold:     2,
+				LastProbeError:       "cluster is not reachable",
+			},
+			expectCondition: metav1.Condition{
+				Type:    clusterv1.ClusterRemoteConnectionProbeCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.ClusterRemoteConnectionProbeFailedReason,
+				Message: fmt.Sprintf("Remote connection probe failed, last probe at %s failed: cluster is not reachable", now.Format(time.RFC3339)),
+			},
+		},
+		{
+			name:    "connection degraded, last probe success within remote connection grace period",
+			cluster: fakeCluster("c"),
+			healthCheckingState: clustercache.HealthCheckingState{
+				LastProbeTime:        now,
+				LastProbeSuccessTime: now.Add(-remoteConnectionGracePeriod + time.Second),
+				ConsecutiveFailures:  5,
+				FailureThreshold:     5,
+				LastProbeError:       "context deadline exceeded",
+				Degraded:             true,
+			},
+			expectCondition: metav1.Condition{
+				Type:   clusterv1.ClusterRemoteConnectionProbeCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.ClusterRemoteConnectionProbeFailedReason,
+				Message: fmt.Sprintf("Remote connection probe failed, probe last succeeded at %s, last probe at %s failed: context deadline exceeded",
+					now.Add(-remoteConnectionGracePeriod+time.Second).Format(time.RFC3339), now.Format(time.RFC3339)),
+			},
+		},
 		{
 			name:    "connection up, last probe succeeded within remote connection grace period",
 			cluster: fakeCluster("c"),
@@ -3056,7 +3108,7 @@ func TestSetAvailableCondition(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			setAvailableCondition(ctx, tc.cluster, tc.clusterClass)
+			setAvailableCondition(ctx, tc.cluster, tc.clusterClass, nil)
 
 			condition := conditions.Get(tc.cluster, clusterv1.ClusterAvailableCondition)
 			g.Expect(condition).ToNot(BeNil())
@@ -951,6 +951,46 @@ func TestObjectsPendingDelete(t *testing.T) {
 	})
 }
 
+func TestBeforeClusterDeleteBlockingReasons(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+	}{
+		{
+			name:        "no annotation",
+			annotations: nil,
+			want:        nil,
+		},
+		{
+			name: "invalid annotation",
+			annotations: map[string]string{
+				runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation: "not-a-json",
+			},
+			want: nil,
+		},
+		{
+			name: "blocking reasons with and without objects",
+			annotations: map[string]string{
+				runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation: `[{"message":"Backup in progress"},{"reason":"VolumesAttached","message":"Volumes still attached","object":{"apiVersion":"cluster.x-k8s.io/v1beta2","kind":"MachineDeployment","name":"md1"}}]`,
+			},
+			want: []string{
+				"* Backup in progress",
+				"* MachineDeployment md1: Volumes still attached",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			g.Expect(beforeClusterDeleteBlockingReasons(ctx, cluster)).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestReconcileV1Beta1ControlPlaneInitializedControlPlaneRef(t *testing.T) {
 	g := NewWithT(t)
 
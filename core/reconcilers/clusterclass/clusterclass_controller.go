@@ -53,13 +53,16 @@ import (
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/cache"
 	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/index"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses;clusterclasses/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
 // Reconciler reconciles the ClusterClass object.
@@ -69,11 +72,15 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
 	// RuntimeClient is a client for calling runtime extensions.
 	RuntimeClient runtimeclient.Client
 
 	// discoverVariablesCache is used to temporarily store the response of a DiscoveryVariables call for
-	// a specific runtime extension/settings combination.
+	// a specific runtime extension version/settings combination.
 	discoverVariablesCache cache.Cache[runtimeclient.CallExtensionCacheEntry]
 }
 
@@ -89,6 +96,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
 		For(&clusterv1.ClusterClass{}).
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		Watches(
 			&runtimev1.ExtensionConfig{},
 			handler.EnqueueRequestsFromMapFunc(r.extensionConfigToClusterClass),
@@ -281,6 +289,7 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 
 	errs := []error{}
 	allVariableDefinitions := map[string]*clusterv1.ClusterClassStatusVariable{}
+	discoveredVariables := []clusterv1.ClusterClassStatusDiscoveredVariables{}
 	// Add inline variable definitions to the ClusterClass status.
 	for _, variable := range clusterClass.Spec.Variables {
 		allVariableDefinitions[variable.Name] = addNewStatusVariable(variable, clusterv1.VariableDefinitionFromInline)
@@ -319,6 +328,17 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 					continue
 				}
 
+				variableNames := []string{}
+				for _, variable := range resp.Variables {
+					variableNames = append(variableNames, variable.Name)
+				}
+				sort.Strings(variableNames)
+				discoveredVariables = append(discoveredVariables, clusterv1.ClusterClassStatusDiscoveredVariables{
+					PatchName: patch.Name,
+					Extension: patch.External.DiscoverVariablesExtension,
+					Variables: variableNames,
+				})
+
 				for _, variable := range resp.Variables {
 					// If a variable of the same name already exists in allVariableDefinitions add the new definition to the existing list.
 					if _, ok := allVariableDefinitions[variable.Name]; ok {
@@ -332,6 +352,14 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 			}
 		}
 	}
+	if len(errs) == 0 {
+		// Refuse to drop variables which are still in use by Clusters, e.g. when a new version of a Runtime Extension
+		// doesn't return some of the variables returned by the previous version anymore.
+		// In this case the previously discovered variables are preserved until the Clusters stop using them.
+		if err := r.validateRemovedDiscoveredVariablesAreNotUsed(ctx, clusterClass, allVariableDefinitions); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	if len(errs) > 0 {
 		err := kerrors.NewAggregate(errs)
 		s.variableDiscoveryError = pkgerrors.Wrapf(err, "VariableDiscovery failed")
@@ -350,6 +378,10 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 		return statusVarList[i].Name < statusVarList[j].Name
 	})
 	clusterClass.Status.Variables = statusVarList
+	clusterClass.Status.DiscoveredVariables = nil
+	if len(discoveredVariables) > 0 {
+		clusterClass.Status.DiscoveredVariables = discoveredVariables
+	}
 
 	variablesWithConflict := []string{}
 	for _, v := range clusterClass.Status.Variables {
@@ -367,6 +399,70 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 	return ctrl.Result{}, nil
 }
 
+// validateRemovedDiscoveredVariablesAreNotUsed returns an error if variables previously discovered via the DiscoverVariables
+// hook are not defined anymore, but are still set by Clusters using the ClusterClass.
+func (r *Reconciler) validateRemovedDiscoveredVariablesAreNotUsed(ctx context.Context, clusterClass *clusterv1.ClusterClass, allVariableDefinitions map[string]*clusterv1.ClusterClassStatusVariable) error {
+	removedVariables := sets.Set[string]{}
+	for _, discovered := range clusterClass.Status.DiscoveredVariables {
+		for _, name := range discovered.Variables {
+			if _, ok := allVariableDefinitions[name]; !ok {
+				removedVariables.Insert(name)
+			}
+		}
+	}
+	if removedVariables.Len() == 0 {
+		return nil
+	}
+
+	clusters := &clusterv1.ClusterList{}
+	if err := r.Client.List(ctx, clusters, client.MatchingFields{
+		index.ClusterClassRefPath: index.ClusterClassRef(clusterClass),
+	}); err != nil {
+		return pkgerrors.Wrapf(err, "failed to list Clusters using ClusterClass %s", clusterClass.Name)
+	}
+
+	usedVariables := sets.Set[string]{}
+	clustersUsingVariables := []string{}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		clusterVariables := getVariableNamesUsedByCluster(cluster).Intersection(removedVariables)
+		if clusterVariables.Len() == 0 {
+			continue
+		}
+		usedVariables = usedVariables.Union(clusterVariables)
+		clustersUsingVariables = append(clustersUsingVariables, klog.KObj(cluster).String())
+	}
+	if usedVariables.Len() == 0 {
+		return nil
+	}
+	sort.Strings(clustersUsingVariables)
+	return pkgerrors.Errorf("variables %s are not discovered anymore but are still used by Clusters %s",
+		strings.Join(sets.List(usedVariables), ","), strings.Join(clustersUsingVariables, ","))
+}
+
+// getVariableNamesUsedByCluster returns the names of the variables set in the topology of a Cluster,
+// including variable overrides.
+func getVariableNamesUsedByCluster(cluster *clusterv1.Cluster) sets.Set[string] {
+	names := sets.Set[string]{}
+	for _, variable := range cluster.Spec.Topology.Variables {
+		names.Insert(variable.Name)
+	}
+	for _, variable := range cluster.Spec.Topology.ControlPlane.Variables.Overrides {
+		names.Insert(variable.Name)
+	}
+	for _, md := range cluster.Spec.Topology.Workers.MachineDeployments {
+		for _, variable := range md.Variables.Overrides {
+			names.Insert(variable.Name)
+		}
+	}
+	for _, mp := range cluster.Spec.Topology.Workers.MachinePools {
+		for _, variable := range mp.Variables.Overrides {
+			names.Insert(variable.Name)
+		}
+	}
+	return names
+}
+
 func addNewStatusVariable(variable clusterv1.ClusterClassVariable, from string) *clusterv1.ClusterClassStatusVariable {
 	return &clusterv1.ClusterClassStatusVariable{
 		Name:                variable.Name,
@@ -536,11 +632,16 @@ func matchNamespace(ctx context.Context, c client.Client, selector labels.Select
 	return selector.Matches(labels.Set(ns.GetLabels()))
 }
 
+// cacheKeyFunc returns the key used to cache the response of a DiscoverVariables call.
+// The key includes the resourceVersion of the ExtensionConfig, so that a new version of the Runtime Extension,
+// which is picked up by refreshing the ExtensionConfig, is always called instead of using cached responses.
 func cacheKeyFunc(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string {
 	// Note: registration.Name is identical to the value of the patch.External.DiscoverVariablesExtension field in the ClusterClass.
 	s := fmt.Sprintf("%s-%s", extensionName, extensionConfigResourceVersion)
-	for k, v := range request.GetSettings() {
-		s += fmt.Sprintf(",%s=%s", k, v)
+	settings := request.GetSettings()
+	// Note: Settings are sorted by key, so the same settings always result in the same key.
+	for _, k := range sets.List(sets.KeySet(settings)) {
+		s += fmt.Sprintf(",%s=%s", k, settings[k])
 	}
 	return s
 }
@@ -46,6 +46,7 @@ import (
 	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/internal/topology/variables"
 	"sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/index"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
 
@@ -1212,6 +1213,158 @@ func TestReconciler_reconcileVariables(t *testing.T) {
 	}
 }
 
+func TestReconciler_reconcileVariablesRemovedDiscoveredVariables(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+
+	patchResponse := &runtimehooksv1.DiscoverVariablesResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{
+			Status: runtimehooksv1.ResponseStatusSuccess,
+		},
+		Variables: []clusterv1.ClusterClassVariable{
+			{
+				Name:     "memory",
+				Required: ptr.To(false),
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type: "string",
+					},
+				},
+			},
+		},
+	}
+	clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").
+		WithPatches([]clusterv1.ClusterClassPatch{
+			{
+				Name: "patch1",
+				External: &clusterv1.ExternalPatchDefinition{
+					DiscoverVariablesExtension: "variables-one",
+				},
+			},
+		}).
+		Build()
+	// The previous version of the extension also returned the cpu variable.
+	clusterClass.Status.DiscoveredVariables = []clusterv1.ClusterClassStatusDiscoveredVariables{
+		{
+			PatchName: "patch1",
+			Extension: "variables-one",
+			Variables: []string{"cpu", "memory"},
+		},
+	}
+
+	clusterUsingCPU := builder.Cluster(metav1.NamespaceDefault, "cluster1").
+		WithTopology(builder.ClusterTopology().
+			WithClass("class1").
+			WithMachineDeployment(clusterv1.MachineDeploymentTopology{
+				Class: "default-worker",
+				Name:  "md1",
+				Variables: clusterv1.MachineDeploymentVariables{
+					Overrides: []clusterv1.ClusterVariable{
+						{
+							Name:  "cpu",
+							Value: apiextensionsv1.JSON{Raw: []byte(`1`)},
+						},
+					},
+				},
+			}).
+			Build()).
+		Build()
+	clusterNotUsingCPU := builder.Cluster(metav1.NamespaceDefault, "cluster2").
+		WithTopology(builder.ClusterTopology().
+			WithClass("class1").
+			WithVariables(clusterv1.ClusterVariable{
+				Name:  "memory",
+				Value: apiextensionsv1.JSON{Raw: []byte(`"8Gi"`)},
+			}).
+			Build()).
+		Build()
+
+	tests := []struct {
+		name                         string
+		clusters                     []client.Object
+		wantErrMessage               string
+		wantDiscoveredVariables      []clusterv1.ClusterClassStatusDiscoveredVariables
+		wantStatusVariablesUnchanged bool
+	}{
+		{
+			name:     "Drop variables which are not used by Clusters",
+			clusters: []client.Object{clusterNotUsingCPU},
+			wantDiscoveredVariables: []clusterv1.ClusterClassStatusDiscoveredVariables{
+				{
+					PatchName: "patch1",
+					Extension: "variables-one",
+					Variables: []string{"memory"},
+				},
+			},
+		},
+		{
+			name:                         "Refuse to drop variables which are still used by Clusters",
+			clusters:                     []client.Object{clusterUsingCPU, clusterNotUsingCPU},
+			wantErrMessage:               "failed to discover variables for ClusterClass class1: variables cpu are not discovered anymore but are still used by Clusters default/cluster1",
+			wantDiscoveredVariables:      clusterClass.Status.DiscoveredVariables,
+			wantStatusVariablesUnchanged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(fakeScheme).
+				WithObjects(tt.clusters...).
+				WithIndex(&clusterv1.Cluster{}, index.ClusterClassRefPath, index.ClusterByClusterClassRef).
+				Build()
+			fakeRuntimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCallExtensionResponses(
+					map[string]runtimehooksv1.ResponseObject{
+						"variables-one": patchResponse,
+					}).
+				WithCatalog(catalog).
+				Build()
+
+			r := &Reconciler{
+				Client:                 fakeClient,
+				RuntimeClient:          fakeRuntimeClient,
+				discoverVariablesCache: cache.New[runtimeclient.CallExtensionCacheEntry](ctx, cache.DefaultTTL),
+			}
+
+			s := &scope{
+				clusterClass: clusterClass.DeepCopy(),
+			}
+			_, err := r.reconcileVariables(ctx, s)
+			if tt.wantErrMessage != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(Equal(tt.wantErrMessage))
+				g.Expect(s.variableDiscoveryError).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(s.variableDiscoveryError).ToNot(HaveOccurred())
+			}
+			g.Expect(s.clusterClass.Status.DiscoveredVariables).To(BeComparableTo(tt.wantDiscoveredVariables))
+			if tt.wantStatusVariablesUnchanged {
+				g.Expect(s.clusterClass.Status.Variables).To(BeComparableTo(clusterClass.Status.Variables))
+			}
+		})
+	}
+}
+
+func TestCacheKeyFunc(t *testing.T) {
+	g := NewWithT(t)
+
+	request := &runtimehooksv1.DiscoverVariablesRequest{}
+	request.Settings = map[string]string{
+		"b": "2",
+		"a": "1",
+		"c": "3",
+	}
+	for range 10 {
+		g.Expect(cacheKeyFunc("variables-one", "42", request)).To(Equal("variables-one-42,a=1,b=2,c=3"))
+	}
+	g.Expect(cacheKeyFunc("variables-one", "43", request)).ToNot(Equal(cacheKeyFunc("variables-one", "42", request)))
+}
+
 func TestReconciler_extensionConfigToClusterClass(t *testing.T) {
 	firstExtConfig := &runtimev1.ExtensionConfig{
 		ObjectMeta: metav1.ObjectMeta{
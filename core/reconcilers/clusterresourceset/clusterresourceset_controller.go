@@ -19,6 +19,7 @@ package clusterresourceset
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
@@ -52,6 +53,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // ErrSecretTypeNotSupported signals that a Secret is not supported.
@@ -69,6 +71,10 @@ type Reconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options, partialSecretCache cache.Cache) error {
@@ -84,6 +90,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			handler.EnqueueRequestsFromMapFunc(r.clusterToClusterResourceSet),
 		).
 		WatchesRawSource(r.ClusterCache.GetClusterSource("clusterresourceset", r.clusterToClusterResourceSet)).
+		Watches(
+			&addonsv1.ClusterResourceSetBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterResourceSetBindingToClusterResourceSet),
+		).
 		WatchesMetadata(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(
@@ -108,6 +118,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			),
 		)).
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Complete(ctx, r)
 	if err != nil {
@@ -185,8 +196,44 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, r.reconcileDelete(ctx, clusters, clusterResourceSet)
 	}
 
+	dependencies, dependencyCycle, err := r.getDependencies(ctx, clusterResourceSet)
+	if err != nil {
+		v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.RetrievingResourceFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetResourcesAppliedInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return ctrl.Result{}, err
+	}
+	if len(dependencyCycle) > 0 {
+		message := fmt.Sprintf("ClusterResourceSet is part of a dependency cycle: %s", strings.Join(dependencyCycle, " -> "))
+		v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.DependencyCycleV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", message)
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetResourcesAppliedDependencyCycleReason,
+			Message: message,
+		})
+		return ctrl.Result{}, nil
+	}
+
 	errs := []error{}
+	waitingMessages := []string{}
 	for _, cluster := range clusters {
+		// Apply the ClusterResourceSet to a Cluster only after all the ClusterResourceSets it depends on
+		// have been applied to the same Cluster.
+		pendingDependencies, err := r.getPendingDependencies(ctx, cluster, clusterResourceSet, dependencies)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(pendingDependencies) > 0 {
+			waitingMessages = append(waitingMessages, fmt.Sprintf("* Cluster %s: waiting for ClusterResourceSets %s", cluster.Name, strings.Join(pendingDependencies, ", ")))
+			continue
+		}
+
 		if err := r.ApplyClusterResourceSet(ctx, cluster, clusterResourceSet); err != nil {
 			errs = append(errs, err)
 		}
@@ -213,6 +260,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, kerrors.NewAggregate(errs)
 	}
 
+	// NOTE: ClusterResourceSets waiting for dependencies are reconciled again when the ClusterResourceSetBinding
+	// of the Cluster is updated after applying one of their dependencies.
+	if len(waitingMessages) > 0 {
+		log.V(4).Info("Waiting for dependencies to be applied", "dependsOn", clusterResourceSet.Spec.DependsOn)
+		v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.WaitingForDependenciesV1Beta1Reason, clusterv1.ConditionSeverityInfo, "Waiting for ClusterResourceSets %s to be applied", strings.Join(clusterResourceSet.Spec.DependsOn, ", "))
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetResourcesAppliedWaitingForDependenciesReason,
+			Message: strings.Join(waitingMessages, "\n"),
+		})
+	}
+
+	// Reconcile again after the drift correction interval, so resources are re-applied to Clusters.
+	if interval := driftCorrectionInterval(clusterResourceSet); interval > 0 {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -236,6 +300,10 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, clusters []*clusterv1.
 
 		original := clusterResourceSetBinding.DeepCopy()
 		clusterResourceSetBinding.RemoveBinding(crs)
+		// NOTE: Objects applied to the Cluster are not deleted when the ClusterResourceSet is deleted.
+		if err := removeFromInventory(clusterResourceSetBinding, crs); err != nil {
+			return err
+		}
 		clusterResourceSetBinding.OwnerReferences = util.RemoveOwnerRef(clusterResourceSetBinding.GetOwnerReferences(), metav1.OwnerReference{
 			APIVersion: addonsv1.GroupVersion.String(),
 			Kind:       "ClusterResourceSet",
@@ -292,7 +360,9 @@ func (r *Reconciler) getClustersByClusterResourceSetSelector(ctx context.Context
 // In ApplyOnce strategy, resources are applied only once to a particular cluster. ClusterResourceSetBinding is used to check if a resource is applied before.
 // It applies resources best effort and continue on scenarios like: unsupported resource types, failure during creation, missing resources.
 // In Reconcile strategy, resources are re-applied to a particular cluster when their definition changes. The hash in ClusterResourceSetBinding is used to check
-// if a resource has changed or not.
+// if a resource has changed or not; if a drift correction interval is set, resources are also re-applied once the interval expires.
+// If prune is enabled, objects removed from the ClusterResourceSet are deleted from the cluster; applied objects are tracked in the
+// inventory annotation of the cluster's ClusterResourceSetBinding.
 // TODO: If a resource already exists in the cluster but not applied by ClusterResourceSet, the resource will be updated ?
 func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clusterv1.Cluster, clusterResourceSet *addonsv1.ClusterResourceSet) (rerr error) {
 	log := ctrl.LoggerFrom(ctx, "Cluster", klog.KObj(cluster))
@@ -384,25 +454,32 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 	}
 
 	// Iterate all resources and apply them to the cluster and update the resource status in the ClusterResourceSetBinding object.
+	// NOTE: Objects defined by all the resources are collected to track them in the inventory when prune is enabled;
+	// objects are deleted from the cluster only if all the resources are successfully retrieved and applied.
+	canPrune := true
+	appliedObjs := []unstructured.Unstructured{}
 	for i, resource := range clusterResourceSet.Spec.Resources {
 		unstructuredObj := objList[i]
 		if unstructuredObj == nil {
 			// Continue without adding the error to the aggregate if we can't find the resource.
+			canPrune = false
 			continue
 		}
 
-		resourceScope, err := reconcileScopeForResource(clusterResourceSet, resource, resourceSetBinding, unstructuredObj)
+		resourceScope, err := reconcileScopeForResource(clusterResourceSet, cluster, resource, resourceSetBinding, unstructuredObj)
 		if err != nil {
 			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
 				ResourceRef:     resource,
 				Hash:            "",
 				Applied:         ptr.To(false),
 				LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+				LastApplyError:  lastApplyError(err),
 			})
 
 			errList = append(errList, err)
 			continue
 		}
+		appliedObjs = append(appliedObjs, resourceScope.objs()...)
 
 		if !resourceScope.needsApply() {
 			continue
@@ -420,8 +497,10 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 		// Apply all values in the key-value pair of the resource to the cluster.
 		// As there can be multiple key-value pairs in a resource, each value may have multiple objects in it.
 		isSuccessful := true
+		var applyErr error
 		if err := resourceScope.apply(ctx, remoteClient); err != nil {
 			isSuccessful = false
+			applyErr = err
 			log.Error(err, "Failed to apply ClusterResourceSet resource", resource.Kind, klog.KRef(clusterResourceSet.Namespace, resource.Name))
 			v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.ApplyFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 			conditions.Set(clusterResourceSet, metav1.Condition{
@@ -438,8 +517,23 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 			Hash:            resourceScope.hash(),
 			Applied:         ptr.To(isSuccessful),
 			LastAppliedTime: metav1.Time{Time: time.Now().UTC()},
+			LastApplyError:  lastApplyError(applyErr),
 		})
 	}
+
+	if isPruneEnabled(clusterResourceSet) {
+		if err := reconcileInventory(ctx, remoteClient, clusterResourceSetBinding, clusterResourceSet, inventoryObjectsFor(appliedObjs), canPrune && len(errList) == 0); err != nil {
+			log.Error(err, "Failed to prune objects removed from ClusterResourceSet")
+			v1beta1conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition, addonsv1.ApplyFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+			conditions.Set(clusterResourceSet, metav1.Condition{
+				Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  addonsv1.ClusterResourceSetResourcesNotAppliedReason,
+				Message: "Failed to delete objects removed from ClusterResourceSet from Cluster",
+			})
+			errList = append(errList, err)
+		}
+	}
 	if len(errList) > 0 {
 		return kerrors.NewAggregate(errList)
 	}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// maxLastApplyErrorLength is the max length of ResourceBinding.lastApplyError.
+const maxLastApplyErrorLength = 10240
+
+// getDependencies returns the ClusterResourceSets in spec.dependsOn of the given ClusterResourceSet, indexed by name;
+// ClusterResourceSets which do not exist are not included.
+// If the ClusterResourceSet is part of a dependency cycle, the names of the ClusterResourceSets forming the cycle are returned.
+func (r *Reconciler) getDependencies(ctx context.Context, clusterResourceSet *addonsv1.ClusterResourceSet) (map[string]*addonsv1.ClusterResourceSet, []string, error) {
+	if len(clusterResourceSet.Spec.DependsOn) == 0 {
+		return nil, nil, nil
+	}
+
+	crsList := &addonsv1.ClusterResourceSetList{}
+	if err := r.Client.List(ctx, crsList, client.InNamespace(clusterResourceSet.Namespace)); err != nil {
+		return nil, nil, pkgerrors.Wrap(err, "failed to list ClusterResourceSets")
+	}
+	all := map[string]*addonsv1.ClusterResourceSet{}
+	for i := range crsList.Items {
+		all[crsList.Items[i].Name] = &crsList.Items[i]
+	}
+	// Use the ClusterResourceSet being reconciled, which could be more recent than the one in the list.
+	all[clusterResourceSet.Name] = clusterResourceSet
+
+	if cycle := findDependencyCycle(clusterResourceSet.Name, all); len(cycle) > 0 {
+		return nil, cycle, nil
+	}
+
+	dependencies := map[string]*addonsv1.ClusterResourceSet{}
+	for _, name := range clusterResourceSet.Spec.DependsOn {
+		if dependency, ok := all[name]; ok {
+			dependencies[name] = dependency
+		}
+	}
+	return dependencies, nil, nil
+}
+
+// findDependencyCycle returns the names of the ClusterResourceSets forming a dependency cycle starting and ending
+// with the ClusterResourceSet with the given name, if any.
+func findDependencyCycle(name string, all map[string]*addonsv1.ClusterResourceSet) []string {
+	path := []string{}
+	visited := sets.Set[string]{}
+
+	var visit func(current string) bool
+	visit = func(current string) bool {
+		crs, ok := all[current]
+		if !ok {
+			return false
+		}
+		path = append(path, current)
+		for _, dependency := range crs.Spec.DependsOn {
+			if dependency == name {
+				return true
+			}
+			if visited.Has(dependency) {
+				continue
+			}
+			visited.Insert(dependency)
+			if visit(dependency) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+
+	if visit(name) {
+		return append(path, name)
+	}
+	return nil
+}
+
+// getPendingDependencies returns the names of the ClusterResourceSets in spec.dependsOn of the given ClusterResourceSet
+// which are not yet applied to the Cluster, according to the ClusterResourceSetBinding of the Cluster.
+// NOTE: ClusterResourceSets which do not exist or which do not match the Cluster are never applied to the Cluster,
+// and thus they are always pending.
+func (r *Reconciler) getPendingDependencies(ctx context.Context, cluster *clusterv1.Cluster, clusterResourceSet *addonsv1.ClusterResourceSet, dependencies map[string]*addonsv1.ClusterResourceSet) ([]string, error) {
+	if len(clusterResourceSet.Spec.DependsOn) == 0 {
+		return nil, nil
+	}
+
+	clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name}, clusterResourceSetBinding); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, pkgerrors.Wrapf(err, "failed to get ClusterResourceSetBinding for Cluster %s", cluster.Name)
+		}
+	}
+
+	pending := []string{}
+	for _, name := range clusterResourceSet.Spec.DependsOn {
+		dependency, ok := dependencies[name]
+		if !ok || !isAppliedToCluster(clusterResourceSetBinding, dependency) {
+			pending = append(pending, name)
+		}
+	}
+	return pending, nil
+}
+
+// isAppliedToCluster returns true if all the resources of the ClusterResourceSet are applied according to the
+// ClusterResourceSetBinding of a Cluster.
+func isAppliedToCluster(clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding, clusterResourceSet *addonsv1.ClusterResourceSet) bool {
+	for i := range clusterResourceSetBinding.Spec.Bindings {
+		resourceSetBinding := &clusterResourceSetBinding.Spec.Bindings[i]
+		if resourceSetBinding.ClusterResourceSetName != clusterResourceSet.Name {
+			continue
+		}
+		for _, resource := range clusterResourceSet.Spec.Resources {
+			if !resourceSetBinding.IsApplied(resource) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// clusterResourceSetBindingToClusterResourceSet is mapper function that maps a ClusterResourceSetBinding to the
+// ClusterResourceSets depending on any of the ClusterResourceSets in the binding, so they are reconciled
+// when their dependencies are applied.
+func (r *Reconciler) clusterResourceSetBindingToClusterResourceSet(ctx context.Context, o client.Object) []ctrl.Request {
+	clusterResourceSetBinding, ok := o.(*addonsv1.ClusterResourceSetBinding)
+	if !ok {
+		panic(fmt.Sprintf("Expected a ClusterResourceSetBinding but got a %T", o))
+	}
+
+	boundClusterResourceSets := sets.Set[string]{}
+	for _, binding := range clusterResourceSetBinding.Spec.Bindings {
+		boundClusterResourceSets.Insert(binding.ClusterResourceSetName)
+	}
+	if boundClusterResourceSets.Len() == 0 {
+		return nil
+	}
+
+	crsList := &addonsv1.ClusterResourceSetList{}
+	if err := r.Client.List(ctx, crsList, client.InNamespace(clusterResourceSetBinding.Namespace)); err != nil {
+		return nil
+	}
+
+	result := []ctrl.Request{}
+	for _, crs := range crsList.Items {
+		for _, dependency := range crs.Spec.DependsOn {
+			if boundClusterResourceSets.Has(dependency) {
+				result = append(result, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: crs.Namespace, Name: crs.Name}})
+				break
+			}
+		}
+	}
+	return result
+}
+
+// lastApplyError returns the message to be recorded as lastApplyError of a resource in the ClusterResourceSetBinding.
+func lastApplyError(err error) string {
+	if err == nil {
+		return ""
+	}
+	message := err.Error()
+	if len(message) > maxLastApplyErrorLength {
+		message = message[:maxLastApplyErrorLength-3] + "..."
+	}
+	return message
+}
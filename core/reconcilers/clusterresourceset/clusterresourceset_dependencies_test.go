@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestFindDependencyCycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		dependsOn map[string][]string
+		crs       string
+		want      []string
+	}{
+		{
+			name:      "no dependencies",
+			dependsOn: map[string][]string{"a": nil},
+			crs:       "a",
+			want:      nil,
+		},
+		{
+			name:      "chain without cycle",
+			dependsOn: map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+			crs:       "a",
+			want:      nil,
+		},
+		{
+			name:      "missing dependency",
+			dependsOn: map[string][]string{"a": {"b"}},
+			crs:       "a",
+			want:      nil,
+		},
+		{
+			name:      "diamond without cycle",
+			dependsOn: map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}, "d": nil},
+			crs:       "a",
+			want:      nil,
+		},
+		{
+			name:      "direct cycle",
+			dependsOn: map[string][]string{"a": {"b"}, "b": {"a"}},
+			crs:       "a",
+			want:      []string{"a", "b", "a"},
+		},
+		{
+			name:      "indirect cycle",
+			dependsOn: map[string][]string{"a": {"d", "b"}, "b": {"c"}, "c": {"a"}, "d": nil},
+			crs:       "a",
+			want:      []string{"a", "b", "c", "a"},
+		},
+		{
+			name:      "cycle not including the ClusterResourceSet",
+			dependsOn: map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}},
+			crs:       "a",
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			all := map[string]*addonsv1.ClusterResourceSet{}
+			for name, dependsOn := range tt.dependsOn {
+				all[name] = &addonsv1.ClusterResourceSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       addonsv1.ClusterResourceSetSpec{DependsOn: dependsOn},
+				}
+			}
+
+			g.Expect(findDependencyCycle(tt.crs, all)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetPendingDependencies(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	cni := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "cni", Namespace: metav1.NamespaceDefault},
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Resources: []addonsv1.ResourceRef{{Name: "calico", Kind: "ConfigMap"}, {Name: "calico-secret", Kind: "Secret"}},
+		},
+	}
+	csi := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "csi", Namespace: metav1.NamespaceDefault},
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Resources: []addonsv1.ResourceRef{{Name: "csi", Kind: "ConfigMap"}},
+		},
+	}
+	metricsServer := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-server", Namespace: metav1.NamespaceDefault},
+		Spec: addonsv1.ClusterResourceSetSpec{
+			DependsOn: []string{"cni", "csi", "does-not-exist"},
+			Resources: []addonsv1.ResourceRef{{Name: "metrics-server", Kind: "ConfigMap"}},
+		},
+	}
+	binding := &addonsv1.ClusterResourceSetBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: cluster.Name, Namespace: cluster.Namespace},
+		Spec: addonsv1.ClusterResourceSetBindingSpec{
+			ClusterName: cluster.Name,
+			Bindings: []addonsv1.ResourceSetBinding{
+				{
+					ClusterResourceSetName: "cni",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: addonsv1.ResourceRef{Name: "calico", Kind: "ConfigMap"}, Applied: ptr.To(true)},
+						{ResourceRef: addonsv1.ResourceRef{Name: "calico-secret", Kind: "Secret"}, Applied: ptr.To(true)},
+					},
+				},
+				{
+					ClusterResourceSetName: "csi",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: addonsv1.ResourceRef{Name: "csi", Kind: "ConfigMap"}, Applied: ptr.To(false), LastApplyError: "failed to apply"},
+					},
+				},
+			},
+		},
+	}
+	dependencies := map[string]*addonsv1.ClusterResourceSet{"cni": cni, "csi": csi}
+
+	t.Run("all the dependencies are pending if there is no ClusterResourceSetBinding", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := &Reconciler{Client: fake.NewClientBuilder().Build()}
+
+		pending, err := r.getPendingDependencies(ctx, cluster, metricsServer, dependencies)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(Equal([]string{"cni", "csi", "does-not-exist"}))
+	})
+
+	t.Run("only dependencies not applied are pending", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := &Reconciler{Client: fake.NewClientBuilder().WithObjects(binding).Build()}
+
+		pending, err := r.getPendingDependencies(ctx, cluster, metricsServer, dependencies)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(Equal([]string{"csi", "does-not-exist"}))
+	})
+
+	t.Run("a dependency with resources missing in the ClusterResourceSetBinding is pending", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := &Reconciler{Client: fake.NewClientBuilder().WithObjects(binding).Build()}
+
+		cniWithNewResource := cni.DeepCopy()
+		cniWithNewResource.Spec.Resources = append(cniWithNewResource.Spec.Resources, addonsv1.ResourceRef{Name: "calico-crds", Kind: "ConfigMap"})
+		crs := metricsServer.DeepCopy()
+		crs.Spec.DependsOn = []string{"cni"}
+
+		pending, err := r.getPendingDependencies(ctx, cluster, crs, map[string]*addonsv1.ClusterResourceSet{"cni": cniWithNewResource})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(Equal([]string{"cni"}))
+	})
+
+	t.Run("nothing is pending without dependencies", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := &Reconciler{Client: fake.NewClientBuilder().Build()}
+
+		pending, err := r.getPendingDependencies(ctx, cluster, cni, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(pending).To(BeEmpty())
+	})
+}
+
+func TestClusterResourceSetBindingToClusterResourceSet(t *testing.T) {
+	g := NewWithT(t)
+
+	newClusterResourceSet := func(namespace, name string, dependsOn ...string) *addonsv1.ClusterResourceSet {
+		return &addonsv1.ClusterResourceSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       addonsv1.ClusterResourceSetSpec{DependsOn: dependsOn},
+		}
+	}
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithObjects(
+			newClusterResourceSet(metav1.NamespaceDefault, "cni"),
+			newClusterResourceSet(metav1.NamespaceDefault, "metrics-server", "cni"),
+			newClusterResourceSet(metav1.NamespaceDefault, "monitoring", "metrics-server"),
+			newClusterResourceSet(notDefaultNamespace, "metrics-server", "cni"),
+		).Build(),
+	}
+
+	binding := &addonsv1.ClusterResourceSetBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+		Spec: addonsv1.ClusterResourceSetBindingSpec{
+			ClusterName: "test-cluster",
+			Bindings: []addonsv1.ResourceSetBinding{
+				{ClusterResourceSetName: "cni"},
+			},
+		},
+	}
+
+	g.Expect(r.clusterResourceSetBindingToClusterResourceSet(ctx, binding)).To(ConsistOf(
+		ctrl.Request{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "metrics-server"}},
+	))
+
+	binding.Spec.Bindings = nil
+	g.Expect(r.clusterResourceSetBindingToClusterResourceSet(ctx, binding)).To(BeEmpty())
+}
+
+func TestLastApplyError(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(lastApplyError(nil)).To(BeEmpty())
+	g.Expect(lastApplyError(pkgerrors.New("failed to apply"))).To(Equal("failed to apply"))
+
+	message := lastApplyError(pkgerrors.New(strings.Repeat("a", maxLastApplyErrorLength+1)))
+	g.Expect(message).To(HaveLen(maxLastApplyErrorLength))
+	g.Expect(message).To(HaveSuffix("..."))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+)
+
+// inventoryObject identifies an object applied to a Cluster by a ClusterResourceSet.
+type inventoryObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// key returns a key identifying the object independently of its API version.
+func (o inventoryObject) key() string {
+	gv, _ := schema.ParseGroupVersion(o.APIVersion)
+	return gv.Group + "/" + o.Kind + "/" + o.Namespace + "/" + o.Name
+}
+
+// inventory is the list of objects applied to a Cluster by each ClusterResourceSet, indexed by ClusterResourceSet name.
+type inventory map[string][]inventoryObject
+
+// isPruneEnabled returns true if objects removed from the ClusterResourceSet must be deleted from Clusters.
+func isPruneEnabled(clusterResourceSet *addonsv1.ClusterResourceSet) bool {
+	return clusterResourceSet.Spec.Strategy == string(addonsv1.ClusterResourceSetStrategyReconcile) &&
+		ptr.Deref(clusterResourceSet.Spec.Reconcile.Prune, false)
+}
+
+// driftCorrectionInterval returns the interval after which resources are re-applied to Clusters even if they did not change;
+// 0 means resources are re-applied only when they change.
+func driftCorrectionInterval(clusterResourceSet *addonsv1.ClusterResourceSet) time.Duration {
+	if clusterResourceSet.Spec.Strategy != string(addonsv1.ClusterResourceSetStrategyReconcile) {
+		return 0
+	}
+	return time.Duration(ptr.Deref(clusterResourceSet.Spec.Reconcile.DriftCorrectionIntervalSeconds, 0)) * time.Second
+}
+
+// getInventory returns the inventory stored in the ClusterResourceSetBinding.
+func getInventory(clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding) (inventory, error) {
+	inv := inventory{}
+	value, ok := clusterResourceSetBinding.GetAnnotations()[addonsv1.ClusterResourceSetBindingInventoryAnnotation]
+	if !ok || value == "" {
+		return inv, nil
+	}
+	if err := json.Unmarshal([]byte(value), &inv); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse %s annotation", addonsv1.ClusterResourceSetBindingInventoryAnnotation)
+	}
+	return inv, nil
+}
+
+// setInventory stores the inventory in the ClusterResourceSetBinding; the annotation is removed if the inventory is empty.
+func setInventory(clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding, inv inventory) error {
+	annotations := clusterResourceSetBinding.GetAnnotations()
+	for name, objs := range inv {
+		if len(objs) == 0 {
+			delete(inv, name)
+		}
+	}
+	if len(inv) == 0 {
+		delete(annotations, addonsv1.ClusterResourceSetBindingInventoryAnnotation)
+		clusterResourceSetBinding.SetAnnotations(annotations)
+		return nil
+	}
+
+	value, err := json.Marshal(inv)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to marshal %s annotation", addonsv1.ClusterResourceSetBindingInventoryAnnotation)
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[addonsv1.ClusterResourceSetBindingInventoryAnnotation] = string(value)
+	clusterResourceSetBinding.SetAnnotations(annotations)
+	return nil
+}
+
+// removeFromInventory removes the objects of the ClusterResourceSet from the inventory stored in the ClusterResourceSetBinding.
+func removeFromInventory(clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding, clusterResourceSet *addonsv1.ClusterResourceSet) error {
+	inv, err := getInventory(clusterResourceSetBinding)
+	if err != nil {
+		return err
+	}
+	if _, ok := inv[clusterResourceSet.Name]; !ok {
+		return nil
+	}
+	delete(inv, clusterResourceSet.Name)
+	return setInventory(clusterResourceSetBinding, inv)
+}
+
+// inventoryObjectsFor returns the sorted list of inventory objects for the given objects, without duplicates.
+func inventoryObjectsFor(objs []unstructured.Unstructured) []inventoryObject {
+	seen := map[string]bool{}
+	result := []inventoryObject{}
+	for i := range objs {
+		o := inventoryObject{
+			APIVersion: objs[i].GetAPIVersion(),
+			Kind:       objs[i].GetKind(),
+			Namespace:  objs[i].GetNamespace(),
+			Name:       objs[i].GetName(),
+		}
+		if seen[o.key()] {
+			continue
+		}
+		seen[o.key()] = true
+		result = append(result, o)
+	}
+	sortInventoryObjects(result)
+	return result
+}
+
+func sortInventoryObjects(objs []inventoryObject) {
+	sort.Slice(objs, func(i, j int) bool {
+		return objs[i].key() < objs[j].key()
+	})
+}
+
+// reconcileInventory updates the inventory of the ClusterResourceSet in the ClusterResourceSetBinding with the objects
+// currently defined by the ClusterResourceSet.
+// If prune is true, objects in the inventory which are no longer defined by the ClusterResourceSet are deleted from the Cluster,
+// unless they are in the inventory of other ClusterResourceSets; if prune is false (e.g. because applying resources failed),
+// objects are only added to the inventory.
+func reconcileInventory(ctx context.Context, c client.Client, clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding, clusterResourceSet *addonsv1.ClusterResourceSet, objs []inventoryObject, prune bool) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	inv, err := getInventory(clusterResourceSetBinding)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]bool{}
+	for _, o := range objs {
+		current[o.key()] = true
+	}
+	inUseByOthers := map[string]bool{}
+	for name, otherObjs := range inv {
+		if name == clusterResourceSet.Name {
+			continue
+		}
+		for _, o := range otherObjs {
+			inUseByOthers[o.key()] = true
+		}
+	}
+
+	newObjs := append([]inventoryObject{}, objs...)
+	errList := []error{}
+	for _, o := range inv[clusterResourceSet.Name] {
+		if current[o.key()] {
+			continue
+		}
+		if !prune {
+			newObjs = append(newObjs, o)
+			continue
+		}
+		if inUseByOthers[o.key()] {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(o.APIVersion)
+		obj.SetKind(o.Kind)
+		obj.SetNamespace(o.Namespace)
+		obj.SetName(o.Name)
+		if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			errList = append(errList, pkgerrors.Wrapf(err, "deleting object %s %s", obj.GroupVersionKind(), klog.KObj(obj)))
+			// Keep the object in the inventory so deletion is retried.
+			newObjs = append(newObjs, o)
+			continue
+		}
+		log.Info("Deleted object removed from ClusterResourceSet", o.Kind, klog.KObj(obj))
+	}
+
+	sortInventoryObjects(newObjs)
+	inv[clusterResourceSet.Name] = newObjs
+	if err := setInventory(clusterResourceSetBinding, inv); err != nil {
+		errList = append(errList, err)
+	}
+	return kerrors.NewAggregate(errList)
+}
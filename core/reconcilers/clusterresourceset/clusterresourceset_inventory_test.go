@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+)
+
+func TestInventoryObjectsFor(t *testing.T) {
+	g := NewWithT(t)
+
+	newObj := func(apiVersion, kind, namespace, name string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+
+	g.Expect(inventoryObjectsFor([]unstructured.Unstructured{
+		newObj("v1", "ConfigMap", "kube-system", "b"),
+		newObj("apps/v1", "Deployment", "kube-system", "a"),
+		newObj("v1", "ConfigMap", "kube-system", "b"),
+		newObj("rbac.authorization.k8s.io/v1", "ClusterRole", "", "a"),
+	})).To(Equal([]inventoryObject{
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "b"},
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "kube-system", Name: "a"},
+		{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "a"},
+	}))
+}
+
+func TestReconcileInventory(t *testing.T) {
+	crs := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "crs", Namespace: metav1.NamespaceDefault},
+	}
+	cm := func(name string) inventoryObject {
+		return inventoryObject{APIVersion: "v1", Kind: "ConfigMap", Namespace: metav1.NamespaceDefault, Name: name}
+	}
+	remoteConfigMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+	}
+	newBinding := func(g *WithT, inv inventory) *addonsv1.ClusterResourceSetBinding {
+		binding := &addonsv1.ClusterResourceSetBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+		}
+		g.Expect(setInventory(binding, inv)).To(Succeed())
+		return binding
+	}
+
+	t.Run("objects removed from the ClusterResourceSet are deleted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		remoteClient := fake.NewClientBuilder().WithObjects(remoteConfigMap("a"), remoteConfigMap("b")).Build()
+		binding := newBinding(g, inventory{crs.Name: {cm("a"), cm("b")}})
+
+		g.Expect(reconcileInventory(ctx, remoteClient, binding, crs, []inventoryObject{cm("a"), cm("c")}, true)).To(Succeed())
+
+		err := remoteClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "b"}, &corev1.ConfigMap{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		g.Expect(remoteClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "a"}, &corev1.ConfigMap{})).To(Succeed())
+
+		inv, err := getInventory(binding)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(inv).To(Equal(inventory{crs.Name: {cm("a"), cm("c")}}))
+	})
+
+	t.Run("objects in the inventory of other ClusterResourceSets are not deleted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		remoteClient := fake.NewClientBuilder().WithObjects(remoteConfigMap("a"), remoteConfigMap("b")).Build()
+		binding := newBinding(g, inventory{crs.Name: {cm("a"), cm("b")}, "other": {cm("b")}})
+
+		g.Expect(reconcileInventory(ctx, remoteClient, binding, crs, []inventoryObject{cm("a")}, true)).To(Succeed())
+
+		g.Expect(remoteClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "b"}, &corev1.ConfigMap{})).To(Succeed())
+
+		inv, err := getInventory(binding)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(inv).To(Equal(inventory{crs.Name: {cm("a")}, "other": {cm("b")}}))
+	})
+
+	t.Run("objects are only added to the inventory when prune is not possible", func(t *testing.T) {
+		g := NewWithT(t)
+
+		remoteClient := fake.NewClientBuilder().WithObjects(remoteConfigMap("a"), remoteConfigMap("b")).Build()
+		binding := newBinding(g, inventory{crs.Name: {cm("a"), cm("b")}})
+
+		g.Expect(reconcileInventory(ctx, remoteClient, binding, crs, []inventoryObject{cm("a"), cm("c")}, false)).To(Succeed())
+
+		g.Expect(remoteClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "b"}, &corev1.ConfigMap{})).To(Succeed())
+
+		inv, err := getInventory(binding)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(inv).To(Equal(inventory{crs.Name: {cm("a"), cm("b"), cm("c")}}))
+	})
+
+	t.Run("objects already deleted from the Cluster are removed from the inventory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		remoteClient := fake.NewClientBuilder().Build()
+		binding := newBinding(g, inventory{crs.Name: {cm("a")}})
+
+		g.Expect(reconcileInventory(ctx, remoteClient, binding, crs, nil, true)).To(Succeed())
+
+		g.Expect(binding.GetAnnotations()).ToNot(HaveKey(addonsv1.ClusterResourceSetBindingInventoryAnnotation))
+	})
+}
+
+func TestRemoveFromInventory(t *testing.T) {
+	g := NewWithT(t)
+
+	binding := &addonsv1.ClusterResourceSetBinding{}
+	g.Expect(setInventory(binding, inventory{
+		"crs":   {{APIVersion: "v1", Kind: "ConfigMap", Namespace: metav1.NamespaceDefault, Name: "a"}},
+		"other": {{APIVersion: "v1", Kind: "ConfigMap", Namespace: metav1.NamespaceDefault, Name: "b"}},
+	})).To(Succeed())
+
+	g.Expect(removeFromInventory(binding, &addonsv1.ClusterResourceSet{ObjectMeta: metav1.ObjectMeta{Name: "crs"}})).To(Succeed())
+	inv, err := getInventory(binding)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(inv).To(Equal(inventory{
+		"other": {{APIVersion: "v1", Kind: "ConfigMap", Namespace: metav1.NamespaceDefault, Name: "b"}},
+	}))
+
+	g.Expect(removeFromInventory(binding, &addonsv1.ClusterResourceSet{ObjectMeta: metav1.ObjectMeta{Name: "other"}})).To(Succeed())
+	g.Expect(binding.GetAnnotations()).ToNot(HaveKey(addonsv1.ClusterResourceSetBindingInventoryAnnotation))
+}
@@ -18,6 +18,7 @@ package clusterresourceset
 
 import (
 	"context"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -28,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
 // resourceReconcileScope contains the scope for a CRS's resource
@@ -41,10 +43,13 @@ type resourceReconcileScope interface {
 	// hash returns a computed hash of the defined objects in the resource. It is consistent
 	// between runs.
 	hash() string
+	// objs returns the objects defined by the resource.
+	objs() []unstructured.Unstructured
 }
 
 func reconcileScopeForResource(
 	crs *addonsv1.ClusterResourceSet,
+	cluster *clusterv1.Cluster,
 	resourceRef addonsv1.ResourceRef,
 	resourceSetBinding *addonsv1.ResourceSetBinding,
 	resource *unstructured.Unstructured,
@@ -54,6 +59,13 @@ func reconcileScopeForResource(
 		return nil, err
 	}
 
+	// NOTE: The hash is computed on the rendered data, so with the Reconcile strategy resources are re-applied
+	// to a Cluster also when the Cluster data used by templates changes.
+	normalizedData, err = renderData(crs, cluster, normalizedData)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to render resource %s %s", resourceRef.Kind, resourceRef.Name)
+	}
+
 	objs, err := objsFromYamlData(normalizedData)
 	if err != nil {
 		return nil, err
@@ -82,7 +94,10 @@ func newResourceReconcileScope(
 	case addonsv1.ClusterResourceSetStrategyApplyOnce:
 		return &reconcileApplyOnceScope{base}, nil
 	case addonsv1.ClusterResourceSetStrategyReconcile:
-		return &reconcileStrategyScope{base}, nil
+		return &reconcileStrategyScope{
+			baseResourceReconcileScope: base,
+			driftCorrectionInterval:    driftCorrectionInterval(clusterResourceSet),
+		}, nil
 	default:
 		return nil, pkgerrors.Errorf("unsupported or empty resource strategy: %q", clusterResourceSet.Spec.Strategy)
 	}
@@ -107,12 +122,24 @@ func (b baseResourceReconcileScope) hash() string {
 
 type reconcileStrategyScope struct {
 	baseResourceReconcileScope
+
+	// driftCorrectionInterval is the interval after which resources are re-applied even if they did not change.
+	driftCorrectionInterval time.Duration
 }
 
 func (r *reconcileStrategyScope) needsApply() bool {
 	resourceBinding := r.resourceSetBinding.GetResource(r.resourceRef)
 
-	return resourceBinding == nil || !ptr.Deref(resourceBinding.Applied, false) || resourceBinding.Hash != r.computedHash
+	if resourceBinding == nil || !ptr.Deref(resourceBinding.Applied, false) || resourceBinding.Hash != r.computedHash {
+		return true
+	}
+
+	// Re-apply resources once the drift correction interval expires, so changes applied to the objects
+	// in the Cluster by other actors are reverted.
+	if r.driftCorrectionInterval > 0 {
+		return time.Since(resourceBinding.LastAppliedTime.Time) >= r.driftCorrectionInterval
+	}
+	return false
 }
 
 func (r *reconcileStrategyScope) apply(ctx context.Context, c client.Client) error {
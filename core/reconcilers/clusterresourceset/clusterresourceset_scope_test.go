@@ -19,6 +19,7 @@ package clusterresourceset
 import (
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -123,6 +124,60 @@ func TestReconcileStrategyScopeNeedsApply(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "applied ResourceBinding and same hash, drift correction interval not expired",
+			scope: &reconcileStrategyScope{
+				baseResourceReconcileScope: baseResourceReconcileScope{
+					resourceSetBinding: &addonsv1.ResourceSetBinding{
+						Resources: []addonsv1.ResourceBinding{
+							{
+								ResourceRef: addonsv1.ResourceRef{
+									Name: "cp",
+									Kind: "ConfigMap",
+								},
+								Applied:         ptr.To(true),
+								Hash:            "111",
+								LastAppliedTime: metav1.Time{Time: time.Now().Add(-1 * time.Minute)},
+							},
+						},
+					},
+					resourceRef: addonsv1.ResourceRef{
+						Name: "cp",
+						Kind: "ConfigMap",
+					},
+					computedHash: "111",
+				},
+				driftCorrectionInterval: 5 * time.Minute,
+			},
+			want: false,
+		},
+		{
+			name: "applied ResourceBinding and same hash, drift correction interval expired",
+			scope: &reconcileStrategyScope{
+				baseResourceReconcileScope: baseResourceReconcileScope{
+					resourceSetBinding: &addonsv1.ResourceSetBinding{
+						Resources: []addonsv1.ResourceBinding{
+							{
+								ResourceRef: addonsv1.ResourceRef{
+									Name: "cp",
+									Kind: "ConfigMap",
+								},
+								Applied:         ptr.To(true),
+								Hash:            "111",
+								LastAppliedTime: metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+							},
+						},
+					},
+					resourceRef: addonsv1.ResourceRef{
+						Name: "cp",
+						Kind: "ConfigMap",
+					},
+					computedHash: "111",
+				},
+				driftCorrectionInterval: 5 * time.Minute,
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	pkgerrors "github.com/pkg/errors"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// templateData is the data available to templates in the content of ClusterResourceSet resources.
+type templateData struct {
+	Cluster templateClusterData
+}
+
+// templateClusterData is the Cluster data available to templates in the content of ClusterResourceSet resources.
+type templateClusterData struct {
+	Name               string
+	Namespace          string
+	InfrastructureKind string
+	Labels             map[string]string
+}
+
+// newTemplateData returns the data available to templates for a Cluster.
+// NOTE: Only the Cluster labels listed in templating.clusterLabels are made available to templates.
+func newTemplateData(clusterResourceSet *addonsv1.ClusterResourceSet, cluster *clusterv1.Cluster) templateData {
+	labels := map[string]string{}
+	for _, key := range clusterResourceSet.Spec.Templating.ClusterLabels {
+		if value, ok := cluster.Labels[key]; ok {
+			labels[key] = value
+		}
+	}
+	return templateData{
+		Cluster: templateClusterData{
+			Name:               cluster.Name,
+			Namespace:          cluster.Namespace,
+			InfrastructureKind: cluster.Spec.InfrastructureRef.Kind,
+			Labels:             labels,
+		},
+	}
+}
+
+// renderData renders the content of a resource for a Cluster according to templating.format of the ClusterResourceSet.
+// If templating is not set, the content is returned as is.
+func renderData(clusterResourceSet *addonsv1.ClusterResourceSet, cluster *clusterv1.Cluster, dataList [][]byte) ([][]byte, error) {
+	switch clusterResourceSet.Spec.Templating.Format {
+	case "":
+		return dataList, nil
+	case addonsv1.ClusterResourceSetTemplatingFormatGoTemplate:
+		data := newTemplateData(clusterResourceSet, cluster)
+		renderedList := make([][]byte, 0, len(dataList))
+		for i := range dataList {
+			tpl, err := template.New("resource").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=error").Parse(string(dataList[i]))
+			if err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to parse template")
+			}
+			var rendered bytes.Buffer
+			if err := tpl.Execute(&rendered, data); err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to render template")
+			}
+			renderedList = append(renderedList, rendered.Bytes())
+		}
+		return renderedList, nil
+	default:
+		return nil, pkgerrors.Errorf("unsupported templating format: %q", clusterResourceSet.Spec.Templating.Format)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestRenderData(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				"region": "eu-west-1",
+				"secret": "not-exposed",
+			},
+		},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: "infrastructure.cluster.x-k8s.io",
+				Kind:     "DockerCluster",
+				Name:     "test-cluster",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		templating addonsv1.ClusterResourceSetTemplating
+		data       []string
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name: "data is returned as is without templating",
+			data: []string{"name: {{ .Cluster.Name }}"},
+			want: []string{"name: {{ .Cluster.Name }}"},
+		},
+		{
+			name: "data is rendered with Cluster metadata",
+			templating: addonsv1.ClusterResourceSetTemplating{
+				Format:        addonsv1.ClusterResourceSetTemplatingFormatGoTemplate,
+				ClusterLabels: []string{"region"},
+			},
+			data: []string{
+				"name: {{ .Cluster.Name }}\nnamespace: {{ .Cluster.Namespace }}",
+				"infra: {{ .Cluster.InfrastructureKind | lower }}\nregion: {{ index .Cluster.Labels \"region\" }}",
+			},
+			want: []string{
+				"name: test-cluster\nnamespace: default",
+				"infra: dockercluster\nregion: eu-west-1",
+			},
+		},
+		{
+			name: "labels not listed in clusterLabels are not available",
+			templating: addonsv1.ClusterResourceSetTemplating{
+				Format: addonsv1.ClusterResourceSetTemplatingFormatGoTemplate,
+			},
+			data:    []string{"secret: {{ .Cluster.Labels.secret }}"},
+			wantErr: true,
+		},
+		{
+			name: "invalid template",
+			templating: addonsv1.ClusterResourceSetTemplating{
+				Format: addonsv1.ClusterResourceSetTemplatingFormatGoTemplate,
+			},
+			data:    []string{"name: {{ .Cluster.Name "},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			crs := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					Templating: tt.templating,
+				},
+			}
+			dataList := [][]byte{}
+			for _, d := range tt.data {
+				dataList = append(dataList, []byte(d))
+			}
+
+			got, err := renderData(crs, cluster, dataList)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			gotStrings := []string{}
+			for _, d := range got {
+				gotStrings = append(gotStrings, string(d))
+			}
+			g.Expect(gotStrings).To(Equal(tt.want))
+		})
+	}
+}
@@ -27,6 +27,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
@@ -52,10 +54,21 @@ import (
 const (
 	// tlsCAKey is used as a data key in Secret resources to store a CA certificate.
 	tlsCAKey = "ca.crt"
+
+	// degradedRequeueAfter is how long to wait before checking again if an ExtensionConfig is still degraded.
+	degradedRequeueAfter = 1 * time.Minute
+
+	// certManagerCertificateNameAnnotation is the annotation cert-manager sets on the Secrets
+	// written for a Certificate; the value is the name of the Certificate.
+	certManagerCertificateNameAnnotation = "cert-manager.io/certificate-name"
 )
 
+// certificateGVK is the GroupVersionKind of cert-manager Certificates.
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
 // +kubebuilder:rbac:groups=runtime.cluster.x-k8s.io,resources=extensionconfigs;extensionconfigs/status,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch
 
 // Reconciler reconciles an ExtensionConfig object.
 type Reconciler struct {
@@ -86,6 +99,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	b := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
 		For(&runtimev1.ExtensionConfig{}).
 		WithOptions(options).
+		// ExtensionConfigs are cluster-scoped and every replica needs all extensions in its registry.
+		WithoutSharding().
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue))
 
 	if !r.ReadOnly {
@@ -103,6 +118,15 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			),
 			predicates.TypedResourceIsChanged[*metav1.PartialObjectMetadata](mgr.GetScheme(), predicateLog),
 		))
+
+		// Reconcile ExtensionConfigs when calls to their ExtensionHandlers start or stop being bypassed,
+		// so the Degraded condition is updated immediately.
+		if degradedExtensionsGetter, ok := r.RuntimeClient.(runtimeclient.DegradedExtensionsGetter); ok {
+			b.WatchesRawSource(source.Channel(
+				degradedExtensionsGetter.DegradedExtensionsEvents(),
+				&handler.EnqueueRequestForObject{},
+			))
+		}
 	}
 
 	if err := b.Complete(ctx, r); err != nil {
@@ -113,6 +137,10 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
 	}
 
+	if err := indexByExtensionInjectCAFromCertificateName(ctx, mgr); err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
 	// warmupRunnable will attempt to sync the RuntimeSDK registry with existing ExtensionConfig objects to ensure extensions
 	// are discovered before controllers begin reconciling.
 	err := mgr.Add(&warmupRunnable{
@@ -189,6 +217,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if err = r.RuntimeClient.Register(extensionConfig); err != nil {
 			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to register ExtensionConfig %s/%s", extensionConfig.Namespace, extensionConfig.Name)
 		}
+
+		// Requeue to update the Degraded condition after calls to failing ExtensionHandlers are no longer bypassed.
+		if conditions.IsTrue(extensionConfig, runtimev1.ExtensionConfigDegradedCondition) {
+			return ctrl.Result{RequeueAfter: degradedRequeueAfter}, nil
+		}
 	}
 
 	return ctrl.Result{}, nil
@@ -207,6 +240,7 @@ func patchExtensionConfig(ctx context.Context, client client.Client, original, m
 		patch.WithOwnedConditions{Conditions: []string{
 			clusterv1.PausedCondition,
 			runtimev1.ExtensionConfigDiscoveredCondition,
+			runtimev1.ExtensionConfigDegradedCondition,
 		}},
 	)
 	return patchHelper.Patch(ctx, modified, options...)
@@ -223,8 +257,8 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, extensionConfig *runti
 	return ctrl.Result{}, nil
 }
 
-// secretToExtensionConfig maps a secret to ExtensionConfigs with the corresponding InjectCAFromSecretAnnotation
-// to reconcile them on updates of the secrets.
+// secretToExtensionConfig maps a secret to ExtensionConfigs with the corresponding InjectCAFromSecretAnnotation,
+// CertManagerInjectCAFromSecretAnnotation or CertManagerInjectCAFromAnnotation to reconcile them on updates of the secrets.
 func (r *Reconciler) secretToExtensionConfig(ctx context.Context, secret *metav1.PartialObjectMetadata) []reconcile.Request {
 	result := []ctrl.Request{}
 
@@ -239,6 +273,19 @@ func (r *Reconciler) secretToExtensionConfig(ctx context.Context, secret *metav1
 		return nil
 	}
 
+	// Secrets written by cert-manager for a Certificate are mapped to the ExtensionConfigs referencing the Certificate.
+	if certificateName, ok := secret.GetAnnotations()[certManagerCertificateNameAnnotation]; ok {
+		certificateExtensionConfigs := runtimev1.ExtensionConfigList{}
+		if err := r.Client.List(
+			ctx,
+			&certificateExtensionConfigs,
+			client.MatchingFields{injectCAFromCertificateAnnotationField: secret.GetNamespace() + "/" + certificateName},
+		); err != nil {
+			return nil
+		}
+		extensionConfigs.Items = append(extensionConfigs.Items, certificateExtensionConfigs.Items...)
+	}
+
 	for _, ext := range extensionConfigs.Items {
 		result = append(result, ctrl.Request{NamespacedName: client.ObjectKey{Name: ext.Name}})
 	}
@@ -272,39 +319,109 @@ func discoverExtensionConfig(ctx context.Context, runtimeClient runtimeclient.Cl
 	return discoveredExtension, nil
 }
 
+// setDegradedCondition sets the Degraded condition on the ExtensionConfig, surfacing the ExtensionHandlers
+// which are temporarily bypassed by the runtime client because they are failing repeatedly.
+// The Degraded condition is not set if the runtime client does not implement DegradedExtensionsGetter.
+func setDegradedCondition(runtimeClient runtimeclient.Client, extensionConfig *runtimev1.ExtensionConfig) {
+	degradedExtensionsGetter, ok := runtimeClient.(runtimeclient.DegradedExtensionsGetter)
+	if !ok {
+		conditions.Delete(extensionConfig, runtimev1.ExtensionConfigDegradedCondition)
+		return
+	}
+
+	degradedExtensions := degradedExtensionsGetter.GetDegradedExtensions(extensionConfig.Name)
+	if len(degradedExtensions) == 0 {
+		conditions.Set(extensionConfig, metav1.Condition{
+			Type:   runtimev1.ExtensionConfigDegradedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: runtimev1.ExtensionConfigNotDegradedReason,
+		})
+		return
+	}
+
+	conditions.Set(extensionConfig, metav1.Condition{
+		Type:    runtimev1.ExtensionConfigDegradedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  runtimev1.ExtensionConfigDegradedReason,
+		Message: "Calls to the following ExtensionHandlers are temporarily bypassed because they are failing:\n* " + strings.Join(degradedExtensions, "\n* "),
+	})
+}
+
 // reconcileCABundle reconciles the CA bundle for the ExtensionConfig.
 // Note: This was implemented to behave similar to the cert-manager cainjector.
 // We couldn't use the cert-manager cainjector because it doesn't work with CustomResources.
 func reconcileCABundle(ctx context.Context, client client.Client, config *runtimev1.ExtensionConfig) error {
 	log := ctrl.LoggerFrom(ctx)
 
-	secretNameRaw, ok := config.Annotations[runtimev1.InjectCAFromSecretAnnotation]
-	if !ok {
+	secretName, err := caInjectionSecretName(ctx, client, config)
+	if err != nil {
+		return err
+	}
+	if secretName == nil {
 		return nil
 	}
-	secretName := splitNamespacedName(secretNameRaw)
-
-	log.V(4).Info(fmt.Sprintf("Injecting CA Bundle into ExtensionConfig from secret %q", secretNameRaw))
 
-	if secretName.Namespace == "" || secretName.Name == "" {
-		return pkgerrors.Errorf("failed to reconcile caBundle: secret name %q must be in the form <namespace>/<name>", secretNameRaw)
-	}
+	log.V(4).Info(fmt.Sprintf("Injecting CA Bundle into ExtensionConfig from secret %q", secretName))
 
 	var secret corev1.Secret
 	// Note: this is an expensive API call because secrets are explicitly not cached.
-	if err := client.Get(ctx, secretName, &secret); err != nil {
-		return pkgerrors.Wrapf(err, "failed to reconcile caBundle: failed to get secret %q", secretNameRaw)
+	if err := client.Get(ctx, *secretName, &secret); err != nil {
+		return pkgerrors.Wrapf(err, "failed to reconcile caBundle: failed to get secret %q", secretName)
 	}
 
 	caData, hasCAData := secret.Data[tlsCAKey]
 	if !hasCAData {
-		return pkgerrors.Errorf("failed to reconcile caBundle: secret %s does not contain a %q entry", secretNameRaw, tlsCAKey)
+		return pkgerrors.Errorf("failed to reconcile caBundle: secret %s does not contain a %q entry", secretName, tlsCAKey)
 	}
 
 	config.Spec.ClientConfig.CABundle = caData
 	return nil
 }
 
+// caInjectionSecretName returns the name of the Secret the CA bundle should be injected from, or nil if
+// CA injection is not configured for the ExtensionConfig.
+// The Secret is read from the InjectCAFromSecretAnnotation or the CertManagerInjectCAFromSecretAnnotation;
+// if only the CertManagerInjectCAFromAnnotation is set, the Secret is the one written by the cert-manager Certificate.
+func caInjectionSecretName(ctx context.Context, c client.Client, config *runtimev1.ExtensionConfig) (*types.NamespacedName, error) {
+	for _, annotation := range []string{runtimev1.InjectCAFromSecretAnnotation, runtimev1.CertManagerInjectCAFromSecretAnnotation} {
+		secretNameRaw, ok := config.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		secretName := splitNamespacedName(secretNameRaw)
+		if secretName.Namespace == "" || secretName.Name == "" {
+			return nil, pkgerrors.Errorf("failed to reconcile caBundle: secret name %q must be in the form <namespace>/<name>", secretNameRaw)
+		}
+		return &secretName, nil
+	}
+
+	certificateNameRaw, ok := config.Annotations[runtimev1.CertManagerInjectCAFromAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	certificateName := splitNamespacedName(certificateNameRaw)
+	if certificateName.Namespace == "" || certificateName.Name == "" {
+		return nil, pkgerrors.Errorf("failed to reconcile caBundle: certificate name %q must be in the form <namespace>/<name>", certificateNameRaw)
+	}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+	if err := c.Get(ctx, certificateName, certificate); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to reconcile caBundle: failed to get certificate %q", certificateNameRaw)
+	}
+
+	secretName, _, err := unstructured.NestedString(certificate.Object, "spec", "secretName")
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to reconcile caBundle: failed to get spec.secretName from certificate %q", certificateNameRaw)
+	}
+	if secretName == "" {
+		return nil, pkgerrors.Errorf("failed to reconcile caBundle: certificate %q does not have spec.secretName set", certificateNameRaw)
+	}
+
+	// Note: cert-manager always writes the Secret into the namespace of the Certificate.
+	return &types.NamespacedName{Namespace: certificateName.Namespace, Name: secretName}, nil
+}
+
 // splitNamespacedName turns the string form of a namespaced name
 // (<namespace>/<name>) into a types.NamespacedName.
 func splitNamespacedName(nameStr string) types.NamespacedName {
@@ -360,6 +477,7 @@ func reconcileExtensionConfig(ctx context.Context, c client.Client, runtimeClien
 	if err != nil {
 		errs = append(errs, err)
 	}
+	setDegradedCondition(runtimeClient, extensionConfig)
 
 	// Note: Intentionally always patching ExtensionConfig even if discoverExtensionConfig failed.
 	if err := patchExtensionConfig(ctx, c, original, extensionConfig); err != nil {
@@ -29,6 +29,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
 	utilfeature "k8s.io/component-base/featuregate/testing"
@@ -40,8 +41,10 @@ import (
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	fakev1alpha1 "sigs.k8s.io/cluster-api/internal/runtime/test/v1alpha1"
 	"sigs.k8s.io/cluster-api/util"
@@ -198,10 +201,13 @@ func TestExtensionReconciler_Reconcile(t *testing.T) {
 		g.Expect(conditions[0].Type).To(Equal(runtimev1.RuntimeExtensionDiscoveredV1Beta1Condition))
 
 		v1beta2Conditions := config.GetConditions()
-		g.Expect(v1beta2Conditions).To(HaveLen(2)) // Second condition is paused.
-		g.Expect(v1beta2Conditions[0].Type).To(Equal(runtimev1.ExtensionConfigDiscoveredCondition))
-		g.Expect(v1beta2Conditions[0].Status).To(Equal(metav1.ConditionTrue))
-		g.Expect(v1beta2Conditions[0].Reason).To(Equal(runtimev1.ExtensionConfigDiscoveredReason))
+		g.Expect(v1beta2Conditions).To(HaveLen(3)) // Third condition is paused.
+		g.Expect(v1beta2Conditions[0].Type).To(Equal(runtimev1.ExtensionConfigDegradedCondition))
+		g.Expect(v1beta2Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(v1beta2Conditions[0].Reason).To(Equal(runtimev1.ExtensionConfigNotDegradedReason))
+		g.Expect(v1beta2Conditions[1].Type).To(Equal(runtimev1.ExtensionConfigDiscoveredCondition))
+		g.Expect(v1beta2Conditions[1].Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(v1beta2Conditions[1].Reason).To(Equal(runtimev1.ExtensionConfigDiscoveredReason))
 	})
 
 	t.Run("Successful reconcile and discovery on Extension update", func(*testing.T) {
@@ -263,10 +269,13 @@ func TestExtensionReconciler_Reconcile(t *testing.T) {
 		g.Expect(conditions[0].Type).To(Equal(runtimev1.RuntimeExtensionDiscoveredV1Beta1Condition))
 
 		v1beta2Conditions := config.GetConditions()
-		g.Expect(v1beta2Conditions).To(HaveLen(2)) // Second condition is paused.
-		g.Expect(v1beta2Conditions[0].Type).To(Equal(runtimev1.ExtensionConfigDiscoveredCondition))
-		g.Expect(v1beta2Conditions[0].Status).To(Equal(metav1.ConditionTrue))
-		g.Expect(v1beta2Conditions[0].Reason).To(Equal(runtimev1.ExtensionConfigDiscoveredReason))
+		g.Expect(v1beta2Conditions).To(HaveLen(3)) // Third condition is paused.
+		g.Expect(v1beta2Conditions[0].Type).To(Equal(runtimev1.ExtensionConfigDegradedCondition))
+		g.Expect(v1beta2Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(v1beta2Conditions[0].Reason).To(Equal(runtimev1.ExtensionConfigNotDegradedReason))
+		g.Expect(v1beta2Conditions[1].Type).To(Equal(runtimev1.ExtensionConfigDiscoveredCondition))
+		g.Expect(v1beta2Conditions[1].Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(v1beta2Conditions[1].Reason).To(Equal(runtimev1.ExtensionConfigDiscoveredReason))
 	})
 	t.Run("Successful reconcile and deregister on ExtensionConfig delete", func(*testing.T) {
 		g.Expect(env.CleanupAndWait(ctx, extensionConfig)).To(Succeed())
@@ -375,6 +384,66 @@ func TestExtensionReconciler_discoverExtensionConfig(t *testing.T) {
 	})
 }
 
+func Test_setDegradedCondition(t *testing.T) {
+	tests := []struct {
+		name               string
+		degradedExtensions map[string][]string
+		wantCondition      metav1.Condition
+	}{
+		{
+			name:               "not degraded if no ExtensionHandler is bypassed",
+			degradedExtensions: map[string][]string{"another-extension": {"first.another-extension"}},
+			wantCondition: metav1.Condition{
+				Type:   runtimev1.ExtensionConfigDegradedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: runtimev1.ExtensionConfigNotDegradedReason,
+			},
+		},
+		{
+			name:               "degraded if ExtensionHandlers are bypassed",
+			degradedExtensions: map[string][]string{"ext1": {"first.ext1", "second.ext1"}},
+			wantCondition: metav1.Condition{
+				Type:    runtimev1.ExtensionConfigDegradedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  runtimev1.ExtensionConfigDegradedReason,
+				Message: "Calls to the following ExtensionHandlers are temporarily bypassed because they are failing:\n* first.ext1\n* second.ext1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			extensionConfig := &runtimev1.ExtensionConfig{ObjectMeta: metav1.ObjectMeta{Name: "ext1"}}
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithDegradedExtensions(tt.degradedExtensions).
+				Build()
+
+			setDegradedCondition(runtimeClient, extensionConfig)
+
+			condition := conditions.Get(extensionConfig, runtimev1.ExtensionConfigDegradedCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(tt.wantCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+
+	t.Run("not set if the runtime client does not implement DegradedExtensionsGetter", func(t *testing.T) {
+		g := NewWithT(t)
+
+		extensionConfig := &runtimev1.ExtensionConfig{ObjectMeta: metav1.ObjectMeta{Name: "ext1"}}
+		conditions.Set(extensionConfig, metav1.Condition{
+			Type:   runtimev1.ExtensionConfigDegradedCondition,
+			Status: metav1.ConditionTrue,
+			Reason: runtimev1.ExtensionConfigDegradedReason,
+		})
+		runtimeClient := struct{ runtimeclient.Client }{fakeruntimeclient.NewRuntimeClientBuilder().Build()}
+
+		setDegradedCondition(runtimeClient, extensionConfig)
+
+		g.Expect(conditions.Has(extensionConfig, runtimev1.ExtensionConfigDegradedCondition)).To(BeFalse())
+	})
+}
+
 func Test_reconcileCABundle(t *testing.T) {
 	g := NewWithT(t)
 
@@ -418,6 +487,43 @@ func Test_reconcileCABundle(t *testing.T) {
 			config:  fakeCAInjectionRuntimeExtensionConfig("some-namespace", "some-extension-config", "some-namespace/some-ca-secret", ""),
 			wantErr: true,
 		},
+		{
+			name: "Inject ca-bundle from cert-manager inject-ca-from-secret annotation",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				fakeCASecret("some-namespace", "some-ca-secret", []byte("some-ca-data")),
+			).Build(),
+			config: func() *runtimev1.ExtensionConfig {
+				ext := fakeCAInjectionRuntimeExtensionConfig("some-namespace", "some-extension-config", "", "")
+				ext.Annotations[runtimev1.CertManagerInjectCAFromSecretAnnotation] = "some-namespace/some-ca-secret"
+				return ext
+			}(),
+			wantCABundle: []byte(`some-ca-data`),
+			wantErr:      false,
+		},
+		{
+			name: "Inject ca-bundle from cert-manager inject-ca-from annotation",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				fakeCertificate("some-namespace", "some-certificate", "some-ca-secret"),
+				fakeCASecret("some-namespace", "some-ca-secret", []byte("some-ca-data")),
+			).Build(),
+			config: func() *runtimev1.ExtensionConfig {
+				ext := fakeCAInjectionRuntimeExtensionConfig("some-namespace", "some-extension-config", "", "")
+				ext.Annotations[runtimev1.CertManagerInjectCAFromAnnotation] = "some-namespace/some-certificate"
+				return ext
+			}(),
+			wantCABundle: []byte(`some-ca-data`),
+			wantErr:      false,
+		},
+		{
+			name:   "Fail because certificate does not exist",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			config: func() *runtimev1.ExtensionConfig {
+				ext := fakeCAInjectionRuntimeExtensionConfig("some-namespace", "some-extension-config", "", "")
+				ext.Annotations[runtimev1.CertManagerInjectCAFromAnnotation] = "some-namespace/some-certificate"
+				return ext
+			}(),
+			wantErr: true,
+		},
 		{
 			name: "Fail because secret does not contain a ca.crt",
 			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
@@ -582,6 +688,20 @@ func fakeCASecret(namespace, name string, caData []byte) *corev1.Secret {
 	return secret
 }
 
+func fakeCertificate(namespace, name, secretName string) *unstructured.Unstructured {
+	certificate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"secretName": secretName,
+			},
+		},
+	}
+	certificate.SetGroupVersionKind(certificateGVK)
+	certificate.SetNamespace(namespace)
+	certificate.SetName(name)
+	return certificate
+}
+
 func fakeCAInjectionRuntimeExtensionConfig(namespace, name, annotationString, caBundleData string) *runtimev1.ExtensionConfig {
 	ext := &runtimev1.ExtensionConfig{
 		ObjectMeta: metav1.ObjectMeta{
@@ -19,6 +19,7 @@ package extensionconfig
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	pkgerrors "github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,8 +30,12 @@ import (
 
 const (
 	// injectCAFromSecretAnnotationField is used by the Extension controller for indexing ExtensionConfigs
-	// which have the InjectCAFromSecretAnnotation set.
+	// which have the InjectCAFromSecretAnnotation or the CertManagerInjectCAFromSecretAnnotation set.
 	injectCAFromSecretAnnotationField = "metadata.annotations[" + runtimev1.InjectCAFromSecretAnnotation + "]"
+
+	// injectCAFromCertificateAnnotationField is used by the Extension controller for indexing ExtensionConfigs
+	// which have the CertManagerInjectCAFromAnnotation set.
+	injectCAFromCertificateAnnotationField = "metadata.annotations[" + runtimev1.CertManagerInjectCAFromAnnotation + "]"
 )
 
 // indexByExtensionInjectCAFromSecretName adds the index by InjectCAFromSecretAnnotation to the
@@ -50,7 +55,33 @@ func extensionConfigByInjectCAFromSecretName(o client.Object) []string {
 	if !ok {
 		panic(fmt.Sprintf("Expected ExtensionConfig but got a %T", o))
 	}
-	if value, ok := extensionConfig.Annotations[runtimev1.InjectCAFromSecretAnnotation]; ok {
+	var values []string
+	for _, annotation := range []string{runtimev1.InjectCAFromSecretAnnotation, runtimev1.CertManagerInjectCAFromSecretAnnotation} {
+		if value, ok := extensionConfig.Annotations[annotation]; ok && !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// indexByExtensionInjectCAFromCertificateName adds the index by CertManagerInjectCAFromAnnotation to the
+// managers cache.
+func indexByExtensionInjectCAFromCertificateName(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetCache().IndexField(ctx, &runtimev1.ExtensionConfig{},
+		injectCAFromCertificateAnnotationField,
+		extensionConfigByInjectCAFromCertificateName,
+	); err != nil {
+		return pkgerrors.Wrap(err, "error setting index field for CertManagerInjectCAFromAnnotation")
+	}
+	return nil
+}
+
+func extensionConfigByInjectCAFromCertificateName(o client.Object) []string {
+	extensionConfig, ok := o.(*runtimev1.ExtensionConfig)
+	if !ok {
+		panic(fmt.Sprintf("Expected ExtensionConfig but got a %T", o))
+	}
+	if value, ok := extensionConfig.Annotations[runtimev1.CertManagerInjectCAFromAnnotation]; ok {
 		return []string{value}
 	}
 	return nil
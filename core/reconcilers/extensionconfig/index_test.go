@@ -48,6 +48,29 @@ func TestExtensionConfigByInjectCAFromSecretName(t *testing.T) {
 			},
 			expected: []string{"foo/bar"},
 		},
+		{
+			name: "when extensionConfig has the cert-manager inject annotation",
+			object: &runtimev1.ExtensionConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						runtimev1.CertManagerInjectCAFromSecretAnnotation: "foo/bar",
+					},
+				},
+			},
+			expected: []string{"foo/bar"},
+		},
+		{
+			name: "when extensionConfig has both inject annotations",
+			object: &runtimev1.ExtensionConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						runtimev1.InjectCAFromSecretAnnotation:            "foo/bar",
+						runtimev1.CertManagerInjectCAFromSecretAnnotation: "foo/baz",
+					},
+				},
+			},
+			expected: []string{"foo/bar", "foo/baz"},
+		},
 	}
 
 	for _, test := range testCases {
@@ -58,3 +81,36 @@ func TestExtensionConfigByInjectCAFromSecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestExtensionConfigByInjectCAFromCertificateName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		object   client.Object
+		expected []string
+	}{
+		{
+			name:     "when extensionConfig has no inject annotation",
+			object:   &runtimev1.ExtensionConfig{},
+			expected: nil,
+		},
+		{
+			name: "when extensionConfig has the cert-manager inject from certificate annotation",
+			object: &runtimev1.ExtensionConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						runtimev1.CertManagerInjectCAFromAnnotation: "foo/bar",
+					},
+				},
+			},
+			expected: []string{"foo/bar"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := extensionConfigByInjectCAFromCertificateName(test.object)
+			g.Expect(got).To(Equal(test.expected))
+		})
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inclusterippool
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/ippool"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=inclusterippools;globalinclusterippools,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=inclusterippools/status;globalinclusterippools/status,verbs=patch;update
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims/status,verbs=patch;update
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch;create;patch;update
+
+// Reconciler allocates IP addresses to IPAddressClaims referencing InClusterIPPools and GlobalInClusterIPPools,
+// and reconciles the finalizer and the status of the pools.
+type Reconciler struct {
+	Client client.Client
+
+	// APIReader is used to list IPAddresses when allocating addresses, so allocations
+	// are never computed on a stale cache.
+	APIReader client.Reader
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	// poolLocks serializes allocations from the same pool.
+	poolLocks sync.Map
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil || r.APIReader == nil {
+		return pkgerrors.New("Client and APIReader must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "inclusterippool")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&ipamv1.IPAddressClaim{}).
+		Named("inclusterippool-ipaddressclaim").
+		Watches(
+			&ipamv1.InClusterIPPool{},
+			handler.EnqueueRequestsFromMapFunc(r.poolToIPAddressClaims),
+		).
+		Watches(
+			&ipamv1.GlobalInClusterIPPool{},
+			handler.EnqueueRequestsFromMapFunc(r.poolToIPAddressClaims),
+		).
+		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, reconcile.Func(r.reconcileIPAddressClaim))
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
+	for _, pool := range []client.Object{&ipamv1.InClusterIPPool{}, &ipamv1.GlobalInClusterIPPool{}} {
+		kind := poolKind(pool)
+		b := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+			For(pool).
+			Named(strings.ToLower(kind)).
+			Watches(
+				&ipamv1.IPAddress{},
+				handler.EnqueueRequestsFromMapFunc(ipAddressToPool(kind)),
+			).
+			WithOptions(options).
+			WithSharder(r.Sharder).
+			WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue))
+		if _, ok := pool.(*ipamv1.GlobalInClusterIPPool); ok {
+			// GlobalInClusterIPPools are cluster-scoped, so they don't belong to the shard of a Cluster.
+			b = b.WithoutSharding()
+		}
+		err := b.Complete(ctx, reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+			return r.reconcilePool(ctx, req, kind)
+		}))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed setting up with a controller manager for %s", kind)
+		}
+	}
+
+	return nil
+}
+
+// reconcilePool reconciles the finalizer and the status of an InClusterIPPool or GlobalInClusterIPPool.
+func (r *Reconciler) reconcilePool(ctx context.Context, req ctrl.Request, kind string) (_ ctrl.Result, reterr error) {
+	pool := newPoolObject(kind)
+	if err := r.Client.Get(ctx, req.NamespacedName, pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			deletePoolMetrics(req.NamespacedName, kind)
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, pool, ipamv1.InClusterIPPoolFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	addresses, err := listPoolAddresses(ctx, r.Client, pool)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to list IPAddresses allocated by %s %s", kind, req.NamespacedName)
+	}
+
+	if !pool.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, r.reconcilePoolDelete(ctx, pool, addresses)
+	}
+
+	patchHelper, err := patch.NewHelper(pool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, pool); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	p, err := ippool.NewPool(poolSpec(pool), pool.GetNamespace())
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "invalid spec for %s %s", kind, req.NamespacedName)
+	}
+
+	var used, outOfRange int64
+	for _, address := range addresses {
+		if p.Contains(address.Spec.Address) {
+			used++
+			continue
+		}
+		outOfRange++
+	}
+	total := p.Len()
+	free := max(total-used, 0)
+
+	poolStatus(pool).Addresses = ipamv1.InClusterIPPoolAddressesStatus{
+		Total:      ptr.To(total),
+		Used:       ptr.To(used),
+		Free:       ptr.To(free),
+		OutOfRange: ptr.To(outOfRange),
+	}
+
+	poolAddressesTotal.WithLabelValues(pool.GetName(), pool.GetNamespace(), kind).Set(float64(total))
+	poolAddressesUsed.WithLabelValues(pool.GetName(), pool.GetNamespace(), kind).Set(float64(used))
+	poolAddressesFree.WithLabelValues(pool.GetName(), pool.GetNamespace(), kind).Set(float64(free))
+
+	return ctrl.Result{}, nil
+}
+
+// reconcilePoolDelete removes the finalizer from a pool being deleted once all the IPAddresses allocated from it are gone.
+func (r *Reconciler) reconcilePoolDelete(ctx context.Context, pool client.Object, addresses []ipamv1.IPAddress) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if len(addresses) > 0 {
+		log.Info("Waiting for IPAddresses allocated from the pool to be deleted", "count", len(addresses))
+		return nil
+	}
+
+	original := pool.DeepCopyObject().(client.Object)
+	controllerutil.RemoveFinalizer(pool, ipamv1.InClusterIPPoolFinalizer)
+	if err := r.Client.Patch(ctx, pool, client.MergeFrom(original)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to remove finalizer from %s %s", poolKind(pool), klog.KObj(pool))
+	}
+
+	deletePoolMetrics(client.ObjectKeyFromObject(pool), poolKind(pool))
+	return nil
+}
+
+// deletePoolMetrics deletes the metrics of a pool.
+func deletePoolMetrics(key types.NamespacedName, kind string) {
+	poolAddressesTotal.DeleteLabelValues(key.Name, key.Namespace, kind)
+	poolAddressesUsed.DeleteLabelValues(key.Name, key.Namespace, kind)
+	poolAddressesFree.DeleteLabelValues(key.Name, key.Namespace, kind)
+}
+
+// ipAddressToPool returns a mapper function mapping IPAddresses to the pool of the given kind they are allocated from.
+func ipAddressToPool(kind string) handler.MapFunc {
+	return func(_ context.Context, o client.Object) []ctrl.Request {
+		address, ok := o.(*ipamv1.IPAddress)
+		if !ok {
+			return nil
+		}
+		poolRef := address.Spec.PoolRef
+		if poolRef.APIGroup != ipamv1.GroupVersion.Group || poolRef.Kind != kind {
+			return nil
+		}
+		return []ctrl.Request{{NamespacedName: poolKey(address.Namespace, poolRef)}}
+	}
+}
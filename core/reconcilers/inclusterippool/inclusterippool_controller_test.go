@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inclusterippool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileIPAddressClaim(t *testing.T) {
+	g := NewWithT(t)
+
+	pool := newInClusterIPPool("pool", []string{"10.0.0.10-10.0.0.12"})
+	pool.Spec.Reservations = []ipamv1.InClusterIPPoolReservation{
+		{Address: "10.0.0.12", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "reserved"}},
+	}
+	globalPool := newGlobalInClusterIPPool("global-pool", []string{"10.1.0.10"})
+
+	c := newFakeClient(pool, globalPool,
+		newIPAddressClaim("claim-1", ipamv1.InClusterIPPoolKind, "pool"),
+		newIPAddressClaim("claim-2", ipamv1.InClusterIPPoolKind, "pool"),
+		newIPAddressClaim("reserved", ipamv1.InClusterIPPoolKind, "pool"),
+		newIPAddressClaim("global-claim-1", ipamv1.GlobalInClusterIPPoolKind, "global-pool"),
+		newIPAddressClaim("global-claim-2", ipamv1.GlobalInClusterIPPoolKind, "global-pool"),
+		newIPAddressClaim("missing-pool-claim", ipamv1.InClusterIPPoolKind, "missing-pool"),
+		newIPAddressClaim("other-provider-claim", "OtherIPPool", "pool"),
+	)
+	r := &Reconciler{Client: c, APIReader: c}
+
+	reconcileClaim := func(name string) *ipamv1.IPAddressClaim {
+		_, err := r.reconcileIPAddressClaim(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: name}})
+		g.Expect(err).ToNot(HaveOccurred())
+		claim := &ipamv1.IPAddressClaim{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: name}, claim)).To(Succeed())
+		return claim
+	}
+	expectAddress := func(claim *ipamv1.IPAddressClaim, want string) {
+		g.Expect(conditions.IsTrue(claim, ipamv1.IPAddressClaimReadyCondition)).To(BeTrue())
+		g.Expect(claim.Status.AddressRef.Name).To(Equal(claim.Name))
+		address := &ipamv1.IPAddress{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Status.AddressRef.Name}, address)).To(Succeed())
+		g.Expect(address.Spec.Address).To(Equal(want))
+		g.Expect(address.Spec.Prefix).To(Equal(ptr.To[int32](24)))
+		g.Expect(address.Spec.Gateway).To(Equal("10.0.0.1"))
+		g.Expect(address.Spec.PoolRef).To(Equal(claim.Spec.PoolRef))
+		g.Expect(address.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "cluster"))
+		g.Expect(metav1.GetControllerOf(address).Name).To(Equal(claim.Name))
+	}
+
+	// Addresses are allocated in order, skipping addresses reserved for other claims.
+	expectAddress(reconcileClaim("claim-1"), "10.0.0.10")
+	expectAddress(reconcileClaim("claim-2"), "10.0.0.11")
+	expectAddress(reconcileClaim("reserved"), "10.0.0.12")
+
+	// Reconciling again returns the same address.
+	expectAddress(reconcileClaim("claim-1"), "10.0.0.10")
+
+	// Pools are exhausted when there are no free addresses.
+	expectAddress(reconcileClaim("global-claim-1"), "10.1.0.10")
+	claim := reconcileClaim("global-claim-2")
+	g.Expect(conditions.GetReason(claim, ipamv1.IPAddressClaimReadyCondition)).To(Equal(ipamv1.IPAddressClaimReadyPoolExhaustedReason))
+	g.Expect(claim.Status.AddressRef.Name).To(BeEmpty())
+
+	// Claims referencing a missing pool are not ready.
+	claim = reconcileClaim("missing-pool-claim")
+	g.Expect(conditions.GetReason(claim, ipamv1.IPAddressClaimReadyCondition)).To(Equal(ipamv1.IPAddressClaimReadyPoolNotReadyReason))
+
+	// Claims referencing pools of other providers are ignored.
+	claim = reconcileClaim("other-provider-claim")
+	g.Expect(conditions.Has(claim, ipamv1.IPAddressClaimReadyCondition)).To(BeFalse())
+}
+
+func TestReconcileIPAddressClaimPaused(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster"},
+		Spec:       clusterv1.ClusterSpec{Paused: ptr.To(true)},
+	}
+	c := newFakeClient(cluster, newInClusterIPPool("pool", []string{"10.0.0.10"}), newIPAddressClaim("claim", ipamv1.InClusterIPPoolKind, "pool"))
+	r := &Reconciler{Client: c, APIReader: c}
+
+	_, err := r.reconcileIPAddressClaim(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "claim"}})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	addresses := &ipamv1.IPAddressList{}
+	g.Expect(c.List(ctx, addresses)).To(Succeed())
+	g.Expect(addresses.Items).To(BeEmpty())
+}
+
+func TestReconcilePool(t *testing.T) {
+	g := NewWithT(t)
+
+	pool := newInClusterIPPool("pool", []string{"10.0.0.0/28"})
+	pool.Spec.ExcludedAddresses = []string{"10.0.0.2-10.0.0.5"}
+	c := newFakeClient(pool,
+		newIPAddress("address-1", ipamv1.InClusterIPPoolKind, "pool", "10.0.0.6"),
+		newIPAddress("address-2", ipamv1.InClusterIPPoolKind, "pool", "10.0.0.7"),
+		newIPAddress("address-3", ipamv1.InClusterIPPoolKind, "pool", "10.0.1.1"),
+		newIPAddress("address-4", ipamv1.InClusterIPPoolKind, "other-pool", "10.0.0.8"),
+	)
+	r := &Reconciler{Client: c, APIReader: c}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pool)}
+
+	// The first reconcile adds the finalizer.
+	_, err := r.reconcilePool(ctx, req, ipamv1.InClusterIPPoolKind)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(c.Get(ctx, req.NamespacedName, pool)).To(Succeed())
+	g.Expect(pool.Finalizers).To(ContainElement(ipamv1.InClusterIPPoolFinalizer))
+
+	// The second reconcile computes the status.
+	_, err = r.reconcilePool(ctx, req, ipamv1.InClusterIPPoolKind)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(c.Get(ctx, req.NamespacedName, pool)).To(Succeed())
+	g.Expect(pool.Status.Addresses).To(Equal(ipamv1.InClusterIPPoolAddressesStatus{
+		// 16 addresses, excluding the network, broadcast, gateway and 4 excluded addresses.
+		Total:      ptr.To[int64](9),
+		Used:       ptr.To[int64](2),
+		Free:       ptr.To[int64](7),
+		OutOfRange: ptr.To[int64](1),
+	}))
+
+	// The finalizer is not removed while IPAddresses allocated from the pool exist.
+	g.Expect(c.Delete(ctx, pool)).To(Succeed())
+	_, err = r.reconcilePool(ctx, req, ipamv1.InClusterIPPoolKind)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(c.Get(ctx, req.NamespacedName, pool)).To(Succeed())
+	g.Expect(pool.Finalizers).To(ContainElement(ipamv1.InClusterIPPoolFinalizer))
+
+	// The finalizer is removed once all the IPAddresses allocated from the pool are gone.
+	g.Expect(c.DeleteAllOf(ctx, &ipamv1.IPAddress{}, client.InNamespace(metav1.NamespaceDefault))).To(Succeed())
+	_, err = r.reconcilePool(ctx, req, ipamv1.InClusterIPPoolKind)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(c.Get(ctx, req.NamespacedName, pool)).ToNot(Succeed())
+}
+
+var ctx = ctrl.SetupSignalHandler()
+
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = ipamv1.AddToScheme(scheme)
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ipamv1.IPAddressClaim{}, &ipamv1.InClusterIPPool{}, &ipamv1.GlobalInClusterIPPool{}).
+		Build()
+}
+
+func newInClusterIPPool(name string, addresses []string) *ipamv1.InClusterIPPool {
+	return &ipamv1.InClusterIPPool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: ipamv1.InClusterIPPoolSpec{
+			Addresses: addresses,
+			Prefix:    ptr.To[int32](24),
+			Gateway:   "10.0.0.1",
+		},
+	}
+}
+
+func newGlobalInClusterIPPool(name string, addresses []string) *ipamv1.GlobalInClusterIPPool {
+	return &ipamv1.GlobalInClusterIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: ipamv1.InClusterIPPoolSpec{
+			Addresses: addresses,
+			Prefix:    ptr.To[int32](24),
+			Gateway:   "10.0.0.1",
+		},
+	}
+}
+
+func newIPAddressClaim(name, poolKind, poolName string) *ipamv1.IPAddressClaim {
+	return &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: ipamv1.IPAddressClaimSpec{
+			ClusterName: "cluster",
+			PoolRef: ipamv1.IPPoolReference{
+				APIGroup: ipamv1.GroupVersion.Group,
+				Kind:     poolKind,
+				Name:     poolName,
+			},
+		},
+	}
+}
+
+func newIPAddress(name, poolKind, poolName, address string) *ipamv1.IPAddress {
+	return &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: ipamv1.IPAddressClaimReference{Name: name},
+			PoolRef: ipamv1.IPPoolReference{
+				APIGroup: ipamv1.GroupVersion.Group,
+				Kind:     poolKind,
+				Name:     poolName,
+			},
+			Address: address,
+			Prefix:  ptr.To[int32](24),
+		},
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inclusterippool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/ippool"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// reconcileIPAddressClaim allocates an IP address to an IPAddressClaim referencing an InClusterIPPool or GlobalInClusterIPPool.
+func (r *Reconciler) reconcileIPAddressClaim(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	claim := &ipamv1.IPAddressClaim{}
+	if err := r.Client.Get(ctx, req.NamespacedName, claim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Ignore IPAddressClaims for other IPAM providers.
+	if !isInClusterIPPoolRef(claim.Spec.PoolRef) {
+		return ctrl.Result{}, nil
+	}
+
+	// Return early if the IPAddressClaim is deleted; the IPAddress is garbage collected
+	// because it is owned by the IPAddressClaim.
+	if !claim.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Return early if the IPAddressClaim or the Cluster it belongs to is paused.
+	paused := annotations.HasPaused(claim)
+	if claim.Spec.ClusterName != "" {
+		cluster := &clusterv1.Cluster{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Namespace: claim.Namespace, Name: claim.Spec.ClusterName}, cluster); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get Cluster %s", klog.KRef(claim.Namespace, claim.Spec.ClusterName))
+			}
+		} else {
+			paused = annotations.IsPaused(cluster, claim)
+		}
+	}
+	if paused {
+		log.V(4).Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(claim, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, claim, patch.WithOwnedConditions{Conditions: []string{
+			ipamv1.IPAddressClaimReadyCondition,
+		}}); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	poolRef := claim.Spec.PoolRef
+	pool := newPoolObject(poolRef.Kind)
+	if err := r.Client.Get(ctx, poolKey(claim.Namespace, poolRef), pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			setReadyFalse(claim, ipamv1.IPAddressClaimReadyPoolNotReadyReason, fmt.Sprintf("%s %s does not exist", poolRef.Kind, poolRef.Name))
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get %s %s", poolRef.Kind, poolRef.Name)
+	}
+	if !pool.GetDeletionTimestamp().IsZero() {
+		setReadyFalse(claim, ipamv1.IPAddressClaimReadyPoolNotReadyReason, fmt.Sprintf("%s %s is being deleted", poolRef.Kind, poolRef.Name))
+		return ctrl.Result{}, nil
+	}
+
+	p, err := ippool.NewPool(poolSpec(pool), pool.GetNamespace())
+	if err != nil {
+		setReadyFalse(claim, ipamv1.IPAddressClaimReadyPoolNotReadyReason, fmt.Sprintf("%s %s is invalid: %v", poolRef.Kind, poolRef.Name, err))
+		return ctrl.Result{}, nil
+	}
+
+	address, err := r.ensureIPAddress(ctx, claim, pool, p)
+	if err != nil {
+		if pkgerrors.Is(err, ippool.ErrPoolExhausted) {
+			setReadyFalse(claim, ipamv1.IPAddressClaimReadyPoolExhaustedReason, fmt.Sprintf("%s %s has no free addresses", poolRef.Kind, poolRef.Name))
+			return ctrl.Result{}, nil
+		}
+		setReadyFalse(claim, ipamv1.IPAddressClaimReadyAllocationFailedReason, fmt.Sprintf("Failed to allocate an address from %s %s: %v", poolRef.Kind, poolRef.Name, err))
+		return ctrl.Result{}, err
+	}
+
+	claim.Status.AddressRef = ipamv1.IPAddressReference{Name: address.Name}
+	conditions.Set(claim, metav1.Condition{
+		Type:   ipamv1.IPAddressClaimReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: ipamv1.IPAddressClaimReadyReason,
+	})
+	return ctrl.Result{}, nil
+}
+
+// ensureIPAddress returns the IPAddress allocated to an IPAddressClaim, creating it if it does not exist yet.
+func (r *Reconciler) ensureIPAddress(ctx context.Context, claim *ipamv1.IPAddressClaim, pool client.Object, p *ippool.Pool) (*ipamv1.IPAddress, error) {
+	// Allocations from the same pool are serialized, so concurrent reconciles never allocate the same address twice.
+	lock := r.poolLock(pool)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// NOTE: IPAddresses are read with the APIReader, because addresses created by previous reconciles
+	// might not be in the cache yet.
+	addresses, err := listPoolAddresses(ctx, r.APIReader, pool)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list IPAddresses")
+	}
+
+	addressesInUse := make([]string, 0, len(addresses))
+	for i := range addresses {
+		if addresses[i].Namespace == claim.Namespace && addresses[i].Spec.ClaimRef.Name == claim.Name {
+			return &addresses[i], nil
+		}
+		addressesInUse = append(addressesInUse, addresses[i].Spec.Address)
+	}
+
+	allocated, err := p.Allocate(client.ObjectKeyFromObject(claim), addressesInUse)
+	if err != nil {
+		return nil, err
+	}
+
+	address := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claim.Name,
+			Namespace: claim.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: ipamv1.GroupVersion.String(),
+					Kind:       poolKind(pool),
+					Name:       pool.GetName(),
+					UID:        pool.GetUID(),
+				},
+			},
+		},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: ipamv1.IPAddressClaimReference{Name: claim.Name},
+			PoolRef:  claim.Spec.PoolRef,
+			Address:  allocated,
+			Prefix:   ptr.To(p.Prefix),
+			Gateway:  p.Gateway,
+		},
+	}
+	if claim.Spec.ClusterName != "" {
+		address.Labels = map[string]string{clusterv1.ClusterNameLabel: claim.Spec.ClusterName}
+	}
+	if err := controllerutil.SetControllerReference(claim, address, r.Client.Scheme()); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to set the IPAddressClaim as controller of the IPAddress")
+	}
+
+	if err := r.Client.Create(ctx, address); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to create IPAddress %s", klog.KObj(address))
+	}
+	ctrl.LoggerFrom(ctx).Info("Allocated IP address", "IPAddress", klog.KObj(address), "address", allocated)
+	return address, nil
+}
+
+// poolLock returns the lock serializing allocations from a pool.
+func (r *Reconciler) poolLock(pool client.Object) *sync.Mutex {
+	key := fmt.Sprintf("%s/%s/%s", poolKind(pool), pool.GetNamespace(), pool.GetName())
+	lock, _ := r.poolLocks.LoadOrStore(key, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// poolToIPAddressClaims maps a pool to the IPAddressClaims referencing it which have no address allocated yet.
+func (r *Reconciler) poolToIPAddressClaims(ctx context.Context, o client.Object) []ctrl.Request {
+	claimList := &ipamv1.IPAddressClaimList{}
+	if err := r.Client.List(ctx, claimList, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	poolRef := poolRefFor(o)
+	requests := []ctrl.Request{}
+	for _, claim := range claimList.Items {
+		if claim.Spec.PoolRef != poolRef || claim.Status.AddressRef.Name != "" {
+			continue
+		}
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&claim)})
+	}
+	return requests
+}
+
+func setReadyFalse(claim *ipamv1.IPAddressClaim, reason, message string) {
+	conditions.Set(claim, metav1.Condition{
+		Type:    ipamv1.IPAddressClaimReadyCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+}
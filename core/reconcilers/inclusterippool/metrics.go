@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inclusterippool
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(poolAddressesTotal)
+	ctrlmetrics.Registry.MustRegister(poolAddressesUsed)
+	ctrlmetrics.Registry.MustRegister(poolAddressesFree)
+}
+
+var (
+	poolAddressesTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_in_cluster_ip_pool_addresses_total",
+			Help: "Number of addresses that can be allocated by an in-cluster IP pool.",
+		}, []string{
+			"pool_name", "pool_namespace", "pool_kind",
+		},
+	)
+	poolAddressesUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_in_cluster_ip_pool_addresses_used",
+			Help: "Number of addresses allocated by an in-cluster IP pool.",
+		}, []string{
+			"pool_name", "pool_namespace", "pool_kind",
+		},
+	)
+	poolAddressesFree = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_in_cluster_ip_pool_addresses_free",
+			Help: "Number of addresses that are still free in an in-cluster IP pool.",
+		}, []string{
+			"pool_name", "pool_namespace", "pool_kind",
+		},
+	)
+)
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inclusterippool
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
+
+// isInClusterIPPoolRef returns true if poolRef references an InClusterIPPool or a GlobalInClusterIPPool.
+func isInClusterIPPoolRef(poolRef ipamv1.IPPoolReference) bool {
+	if poolRef.APIGroup != ipamv1.GroupVersion.Group {
+		return false
+	}
+	return poolRef.Kind == ipamv1.InClusterIPPoolKind || poolRef.Kind == ipamv1.GlobalInClusterIPPoolKind
+}
+
+// newPoolObject returns an empty InClusterIPPool or GlobalInClusterIPPool for the given kind.
+func newPoolObject(kind string) client.Object {
+	if kind == ipamv1.GlobalInClusterIPPoolKind {
+		return &ipamv1.GlobalInClusterIPPool{}
+	}
+	return &ipamv1.InClusterIPPool{}
+}
+
+// poolKey returns the key of the pool referenced by poolRef from an IPAddressClaim or an IPAddress in namespace.
+// GlobalInClusterIPPools are cluster-scoped, while InClusterIPPools are in the same namespace of the referencing object.
+func poolKey(namespace string, poolRef ipamv1.IPPoolReference) types.NamespacedName {
+	if poolRef.Kind == ipamv1.GlobalInClusterIPPoolKind {
+		return types.NamespacedName{Name: poolRef.Name}
+	}
+	return types.NamespacedName{Namespace: namespace, Name: poolRef.Name}
+}
+
+// poolKind returns the kind of an InClusterIPPool or GlobalInClusterIPPool.
+func poolKind(pool client.Object) string {
+	if _, ok := pool.(*ipamv1.GlobalInClusterIPPool); ok {
+		return ipamv1.GlobalInClusterIPPoolKind
+	}
+	return ipamv1.InClusterIPPoolKind
+}
+
+// poolSpec returns the spec of an InClusterIPPool or GlobalInClusterIPPool.
+func poolSpec(pool client.Object) *ipamv1.InClusterIPPoolSpec {
+	switch p := pool.(type) {
+	case *ipamv1.InClusterIPPool:
+		return &p.Spec
+	case *ipamv1.GlobalInClusterIPPool:
+		return &p.Spec
+	}
+	return nil
+}
+
+// poolStatus returns the status of an InClusterIPPool or GlobalInClusterIPPool.
+func poolStatus(pool client.Object) *ipamv1.InClusterIPPoolStatus {
+	switch p := pool.(type) {
+	case *ipamv1.InClusterIPPool:
+		return &p.Status
+	case *ipamv1.GlobalInClusterIPPool:
+		return &p.Status
+	}
+	return nil
+}
+
+// poolRefFor returns the IPPoolReference of an InClusterIPPool or GlobalInClusterIPPool.
+func poolRefFor(pool client.Object) ipamv1.IPPoolReference {
+	return ipamv1.IPPoolReference{
+		APIGroup: ipamv1.GroupVersion.Group,
+		Kind:     poolKind(pool),
+		Name:     pool.GetName(),
+	}
+}
+
+// listPoolAddresses returns the IPAddresses allocated from an InClusterIPPool or GlobalInClusterIPPool.
+func listPoolAddresses(ctx context.Context, c client.Reader, pool client.Object) ([]ipamv1.IPAddress, error) {
+	addressList := &ipamv1.IPAddressList{}
+	if err := c.List(ctx, addressList, client.InNamespace(pool.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	poolRef := poolRefFor(pool)
+	addresses := []ipamv1.IPAddress{}
+	for _, address := range addressList.Items {
+		if address.Spec.PoolRef == poolRef {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses, nil
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipaddressleak
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+const (
+	ipAddressClaimKind = "IPAddressClaim"
+	ipAddressKind      = "IPAddress"
+)
+
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims;ipaddresses,verbs=get;list;watch;patch;update;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims/status,verbs=patch;update
+
+// Reconciler detects leaked IPAddressClaims and IPAddresses, reports them with the Orphaned condition
+// and metrics, and optionally garbage collects them.
+//
+// An IPAddressClaim is leaked if the Cluster it belongs to no longer exists, or if it has owners and none of
+// them exists anymore, e.g. because an infrastructure provider failed to delete the IPAddressClaims of a
+// Machine being deleted. An IPAddress is leaked if the IPAddressClaim it was created for no longer exists.
+type Reconciler struct {
+	Client client.Client
+
+	// APIReader is used to check whether the owners of IPAddressClaims exist, without caching
+	// all the kinds of owners in the manager cache.
+	APIReader client.Reader
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	// GCGracePeriod is the duration after which leaked IPAddressClaims and IPAddresses are deleted.
+	// If 0, leaked objects are only reported.
+	GCGracePeriod time.Duration
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil || r.APIReader == nil {
+		return pkgerrors.New("Client and APIReader must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "ipaddressleak")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&ipamv1.IPAddressClaim{}).
+		Named("ipaddressclaimleak").
+		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, reconcile.Func(r.reconcileIPAddressClaim))
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
+	err = capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&ipamv1.IPAddress{}).
+		Named("ipaddressleak").
+		Watches(
+			&ipamv1.IPAddressClaim{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressClaimToIPAddresses),
+		).
+		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, reconcile.Func(r.reconcileIPAddress))
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
+	return nil
+}
+
+// reconcileIPAddressClaim detects if an IPAddressClaim is leaked and surfaces it with the Orphaned condition.
+// The IPAddressClaim is deleted once it has been leaked for longer than GCGracePeriod.
+func (r *Reconciler) reconcileIPAddressClaim(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	claim := &ipamv1.IPAddressClaim{}
+	if err := r.Client.Get(ctx, req.NamespacedName, claim); err != nil {
+		if apierrors.IsNotFound(err) {
+			setLeakedObjectMetric(ipAddressClaimKind, req.Namespace, req.Name, false)
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Return early if the IPAddressClaim is deleted.
+	if !claim.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	var cluster *clusterv1.Cluster
+	if claim.Spec.ClusterName != "" {
+		cluster = &clusterv1.Cluster{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Spec.ClusterName}, cluster); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get Cluster %s", klog.KRef(claim.Namespace, claim.Spec.ClusterName))
+			}
+			cluster = nil
+		}
+	}
+
+	// Return early if the IPAddressClaim or the Cluster it belongs to is paused.
+	if (cluster != nil && annotations.IsPaused(cluster, claim)) || annotations.HasPaused(claim) {
+		log.V(4).Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(claim, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	deleted := false
+	defer func() {
+		// Skip patching the IPAddressClaim if it has been garbage collected.
+		if deleted {
+			return
+		}
+		if err := patchHelper.Patch(ctx, claim, patch.WithOwnedConditions{Conditions: []string{
+			ipamv1.IPAddressClaimOrphanedCondition,
+		}}); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	var orphanedMessage string
+	switch {
+	case claim.Spec.ClusterName != "" && cluster == nil:
+		orphanedMessage = fmt.Sprintf("Cluster %s does not exist", claim.Spec.ClusterName)
+	case len(claim.OwnerReferences) > 0:
+		missingOwners, err := r.getMissingOwners(ctx, claim)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(missingOwners) == len(claim.OwnerReferences) {
+			orphanedMessage = fmt.Sprintf("Owners %s do not exist", strings.Join(missingOwners, ", "))
+		}
+	}
+
+	if orphanedMessage == "" {
+		conditions.Set(claim, metav1.Condition{
+			Type:   ipamv1.IPAddressClaimOrphanedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: ipamv1.IPAddressClaimNotOrphanedReason,
+		})
+		setLeakedObjectMetric(ipAddressClaimKind, claim.Namespace, claim.Name, false)
+		return ctrl.Result{}, nil
+	}
+
+	if !conditions.IsTrue(claim, ipamv1.IPAddressClaimOrphanedCondition) {
+		log.Info(fmt.Sprintf("IPAddressClaim is leaked: %s", orphanedMessage))
+	}
+	conditions.Set(claim, metav1.Condition{
+		Type:    ipamv1.IPAddressClaimOrphanedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  ipamv1.IPAddressClaimOrphanedReason,
+		Message: orphanedMessage,
+	})
+	setLeakedObjectMetric(ipAddressClaimKind, claim.Namespace, claim.Name, true)
+
+	orphanedSince := conditions.Get(claim, ipamv1.IPAddressClaimOrphanedCondition).LastTransitionTime.Time
+	deleted, requeueAfter, err := r.garbageCollect(ctx, claim, ipAddressClaimKind, orphanedSince)
+	return ctrl.Result{RequeueAfter: requeueAfter}, err
+}
+
+// reconcileIPAddress detects if an IPAddress is leaked and marks it with the orphaned since annotation.
+// The IPAddress is deleted once it has been leaked for longer than GCGracePeriod.
+func (r *Reconciler) reconcileIPAddress(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	address := &ipamv1.IPAddress{}
+	if err := r.Client.Get(ctx, req.NamespacedName, address); err != nil {
+		if apierrors.IsNotFound(err) {
+			setLeakedObjectMetric(ipAddressKind, req.Namespace, req.Name, false)
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Return early if the IPAddress is deleted or paused.
+	if !address.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if annotations.HasPaused(address) {
+		log.V(4).Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	claim := &ipamv1.IPAddressClaim{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: address.Namespace, Name: address.Spec.ClaimRef.Name}, claim)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get IPAddressClaim %s", klog.KRef(address.Namespace, address.Spec.ClaimRef.Name))
+	}
+
+	orphanedSinceValue, marked := address.Annotations[ipamv1.OrphanedSinceAnnotation]
+
+	// If the IPAddressClaim exists, drop the orphaned mark, if any.
+	if err == nil {
+		if marked {
+			log.Info("IPAddressClaim of the IPAddress exists again, removing the orphaned mark")
+			if err := r.setOrphanedSince(ctx, address, ""); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		setLeakedObjectMetric(ipAddressKind, address.Namespace, address.Name, false)
+		return ctrl.Result{}, nil
+	}
+
+	setLeakedObjectMetric(ipAddressKind, address.Namespace, address.Name, true)
+
+	// If the IPAddress has been just detected as leaked, mark it.
+	orphanedSince, err := time.Parse(time.RFC3339, orphanedSinceValue)
+	if !marked || err != nil {
+		log.Info(fmt.Sprintf("IPAddress is leaked: IPAddressClaim %s does not exist, marking it as orphaned", address.Spec.ClaimRef.Name))
+		orphanedSince = time.Now().UTC()
+		if err := r.setOrphanedSince(ctx, address, orphanedSince.Format(time.RFC3339)); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	_, requeueAfter, err := r.garbageCollect(ctx, address, ipAddressKind, orphanedSince)
+	return ctrl.Result{RequeueAfter: requeueAfter}, err
+}
+
+// garbageCollect deletes a leaked object once it has been leaked for longer than GCGracePeriod, and returns
+// true if the object has been deleted. If the grace period is not elapsed yet, the returned duration is the
+// time after which it is going to elapse.
+func (r *Reconciler) garbageCollect(ctx context.Context, obj client.Object, kind string, orphanedSince time.Time) (bool, time.Duration, error) {
+	if r.GCGracePeriod <= 0 {
+		return false, 0, nil
+	}
+
+	if remaining := time.Until(orphanedSince.Add(r.GCGracePeriod)); remaining > 0 {
+		return false, remaining, nil
+	}
+
+	ctrl.LoggerFrom(ctx).Info(fmt.Sprintf("Deleting %s leaked since %s", kind, orphanedSince.UTC().Format(time.RFC3339)))
+	if err := r.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return false, 0, pkgerrors.Wrapf(err, "failed to delete leaked %s %s", kind, klog.KObj(obj))
+	}
+	leakedObjectsDeletedTotal.WithLabelValues(kind, obj.GetNamespace()).Inc()
+	setLeakedObjectMetric(kind, obj.GetNamespace(), obj.GetName(), false)
+	return true, 0, nil
+}
+
+// getMissingOwners returns the owners of an IPAddressClaim which no longer exist, in the format kind/name.
+// NOTE: Owners are read directly from the API server, so the owners are never considered missing because
+// of an outdated cache, and arbitrary kinds of owners are not cached.
+func (r *Reconciler) getMissingOwners(ctx context.Context, claim *ipamv1.IPAddressClaim) ([]string, error) {
+	missingOwners := []string{}
+	for _, ownerRef := range claim.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ownerRef.APIVersion)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse apiVersion of owner %s %s", ownerRef.Kind, ownerRef.Name)
+		}
+
+		owner := &metav1.PartialObjectMetadata{}
+		owner.SetGroupVersionKind(gv.WithKind(ownerRef.Kind))
+		err = r.APIReader.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: ownerRef.Name}, owner)
+		switch {
+		case apierrors.IsNotFound(err) || meta.IsNoMatchError(err):
+			missingOwners = append(missingOwners, fmt.Sprintf("%s/%s", ownerRef.Kind, ownerRef.Name))
+		case err != nil:
+			return nil, pkgerrors.Wrapf(err, "failed to get owner %s %s", ownerRef.Kind, klog.KRef(claim.Namespace, ownerRef.Name))
+		case owner.UID != ownerRef.UID:
+			// The owner has been deleted and recreated with the same name.
+			missingOwners = append(missingOwners, fmt.Sprintf("%s/%s", ownerRef.Kind, ownerRef.Name))
+		}
+	}
+	return missingOwners, nil
+}
+
+// setOrphanedSince sets the orphaned since annotation on an IPAddress; if value is empty the annotation is removed.
+func (r *Reconciler) setOrphanedSince(ctx context.Context, address *ipamv1.IPAddress, value string) error {
+	original := address.DeepCopy()
+	if value == "" {
+		delete(address.Annotations, ipamv1.OrphanedSinceAnnotation)
+	} else {
+		if address.Annotations == nil {
+			address.Annotations = map[string]string{}
+		}
+		address.Annotations[ipamv1.OrphanedSinceAnnotation] = value
+	}
+	if err := r.Client.Patch(ctx, address, client.MergeFrom(original)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to patch IPAddress %s", klog.KObj(address))
+	}
+	return nil
+}
+
+// ipAddressClaimToIPAddresses maps an IPAddressClaim to the IPAddresses created for it.
+func (r *Reconciler) ipAddressClaimToIPAddresses(ctx context.Context, o client.Object) []ctrl.Request {
+	addressList := &ipamv1.IPAddressList{}
+	if err := r.Client.List(ctx, addressList, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := []ctrl.Request{}
+	for _, address := range addressList.Items {
+		if address.Spec.ClaimRef.Name == o.GetName() {
+			requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&address)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipaddressleak
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var ctx = ctrl.SetupSignalHandler()
+
+func TestReconcileIPAddressClaim(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cluster"},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine", UID: "machine-uid"},
+	}
+
+	tests := []struct {
+		name           string
+		claim          *ipamv1.IPAddressClaim
+		gcGracePeriod  time.Duration
+		wantOrphaned   bool
+		wantDeleted    bool
+		wantRequeue    bool
+		wantMessageSub string
+	}{
+		{
+			name:         "IPAddressClaim is not leaked if the Cluster and the owners exist",
+			claim:        newIPAddressClaim("cluster", ownerRef("Machine", "machine", "machine-uid")),
+			wantOrphaned: false,
+		},
+		{
+			name:         "IPAddressClaim without owners is not leaked if the Cluster exists",
+			claim:        newIPAddressClaim("cluster"),
+			wantOrphaned: false,
+		},
+		{
+			name:           "IPAddressClaim is leaked if the Cluster does not exist",
+			claim:          newIPAddressClaim("other-cluster", ownerRef("Machine", "machine", "machine-uid")),
+			wantOrphaned:   true,
+			wantMessageSub: "Cluster other-cluster does not exist",
+		},
+		{
+			name:           "IPAddressClaim is leaked if none of the owners exist",
+			claim:          newIPAddressClaim("cluster", ownerRef("Machine", "other-machine", "other-uid")),
+			wantOrphaned:   true,
+			wantMessageSub: "Owners Machine/other-machine do not exist",
+		},
+		{
+			name:           "IPAddressClaim is leaked if the owner has been recreated",
+			claim:          newIPAddressClaim("cluster", ownerRef("Machine", "machine", "old-machine-uid")),
+			wantOrphaned:   true,
+			wantMessageSub: "Owners Machine/machine do not exist",
+		},
+		{
+			name:         "IPAddressClaim is not leaked if at least one of the owners exists",
+			claim:        newIPAddressClaim("cluster", ownerRef("Machine", "machine", "machine-uid"), ownerRef("Machine", "other-machine", "other-uid")),
+			wantOrphaned: false,
+		},
+		{
+			name:          "Leaked IPAddressClaim is not deleted before the grace period",
+			claim:         newIPAddressClaim("other-cluster"),
+			gcGracePeriod: time.Hour,
+			wantOrphaned:  true,
+			wantRequeue:   true,
+		},
+		{
+			name: "Leaked IPAddressClaim is deleted after the grace period",
+			claim: func() *ipamv1.IPAddressClaim {
+				claim := newIPAddressClaim("other-cluster")
+				conditions.Set(claim, metav1.Condition{
+					Type:               ipamv1.IPAddressClaimOrphanedCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             ipamv1.IPAddressClaimOrphanedReason,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				})
+				return claim
+			}(),
+			gcGracePeriod: time.Hour,
+			wantDeleted:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := newFakeClient(cluster, machine, tt.claim)
+			r := &Reconciler{Client: c, APIReader: c, GCGracePeriod: tt.gcGracePeriod}
+
+			res, err := r.reconcileIPAddressClaim(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tt.claim)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			claim := &ipamv1.IPAddressClaim{}
+			err = c.Get(ctx, client.ObjectKeyFromObject(tt.claim), claim)
+			if tt.wantDeleted {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(conditions.IsTrue(claim, ipamv1.IPAddressClaimOrphanedCondition)).To(Equal(tt.wantOrphaned))
+			if tt.wantMessageSub != "" {
+				g.Expect(conditions.Get(claim, ipamv1.IPAddressClaimOrphanedCondition).Message).To(ContainSubstring(tt.wantMessageSub))
+			}
+		})
+	}
+}
+
+func TestReconcileIPAddress(t *testing.T) {
+	g := NewWithT(t)
+
+	claim := newIPAddressClaim("cluster")
+	address := newIPAddress("address", claim.Name)
+	leakedAddress := newIPAddress("leaked-address", "missing-claim")
+	expiredAddress := newIPAddress("expired-address", "missing-claim")
+	expiredAddress.Annotations = map[string]string{
+		ipamv1.OrphanedSinceAnnotation: time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+	}
+	c := newFakeClient(claim, address, leakedAddress, expiredAddress)
+	r := &Reconciler{Client: c, APIReader: c, GCGracePeriod: time.Hour}
+
+	reconcileAddress := func(name string) ctrl.Result {
+		res, err := r.reconcileIPAddress(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: name}})
+		g.Expect(err).ToNot(HaveOccurred())
+		return res
+	}
+
+	// IPAddresses whose IPAddressClaim exists are not leaked.
+	g.Expect(reconcileAddress("address").RequeueAfter).To(BeZero())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(address), address)).To(Succeed())
+	g.Expect(address.Annotations).ToNot(HaveKey(ipamv1.OrphanedSinceAnnotation))
+
+	// IPAddresses whose IPAddressClaim does not exist are marked as orphaned.
+	g.Expect(reconcileAddress("leaked-address").RequeueAfter).To(BeNumerically(">", 0))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(leakedAddress), leakedAddress)).To(Succeed())
+	g.Expect(leakedAddress.Annotations).To(HaveKey(ipamv1.OrphanedSinceAnnotation))
+
+	// Leaked IPAddresses are deleted after the grace period.
+	reconcileAddress("expired-address")
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(expiredAddress), expiredAddress))).To(BeTrue())
+
+	// The orphaned mark is removed if the IPAddressClaim exists again.
+	g.Expect(c.Create(ctx, newIPAddressClaimWithName("missing-claim"))).To(Succeed())
+	g.Expect(reconcileAddress("leaked-address").RequeueAfter).To(BeZero())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(leakedAddress), leakedAddress)).To(Succeed())
+	g.Expect(leakedAddress.Annotations).ToNot(HaveKey(ipamv1.OrphanedSinceAnnotation))
+}
+
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = ipamv1.AddToScheme(scheme)
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&ipamv1.IPAddressClaim{}).
+		Build()
+}
+
+func ownerRef(kind, name string, uid types.UID) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: clusterv1.GroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+		UID:        uid,
+		Controller: ptr.To(true),
+	}
+}
+
+func newIPAddressClaim(clusterName string, owners ...metav1.OwnerReference) *ipamv1.IPAddressClaim {
+	claim := newIPAddressClaimWithName("claim")
+	claim.Spec.ClusterName = clusterName
+	claim.OwnerReferences = owners
+	return claim
+}
+
+func newIPAddressClaimWithName(name string) *ipamv1.IPAddressClaim {
+	return &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: ipamv1.IPAddressClaimSpec{
+			PoolRef: ipamv1.IPPoolReference{
+				APIGroup: "ipam.example.com",
+				Kind:     "IPPool",
+				Name:     "pool",
+			},
+		},
+	}
+}
+
+func newIPAddress(name, claimName string) *ipamv1.IPAddress {
+	return &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: ipamv1.IPAddressClaimReference{Name: claimName},
+			PoolRef: ipamv1.IPPoolReference{
+				APIGroup: "ipam.example.com",
+				Kind:     "IPPool",
+				Name:     "pool",
+			},
+			Address: "10.0.0.10",
+			Prefix:  ptr.To[int32](24),
+		},
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipaddressleak
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(leakedObjects, leakedObjectsDeletedTotal)
+}
+
+var (
+	leakedObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_ipam_leaked_objects",
+			Help: "Leaked IPAddressClaims and IPAddresses which are not yet deleted; the value is 1 for each leaked object.",
+		}, []string{
+			"kind", "name", "namespace",
+		},
+	)
+
+	leakedObjectsDeletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "capi_ipam_leaked_objects_deleted_total",
+			Help: "Total number of leaked IPAddressClaims and IPAddresses which have been deleted after the grace period.",
+		}, []string{
+			"kind", "namespace",
+		},
+	)
+)
+
+// setLeakedObjectMetric records whether an IPAddressClaim or IPAddress is leaked.
+func setLeakedObjectMetric(kind, namespace, name string, leaked bool) {
+	if !leaked {
+		leakedObjects.DeleteLabelValues(kind, name, namespace)
+		return
+	}
+	leakedObjects.WithLabelValues(kind, name, namespace).Set(1)
+}
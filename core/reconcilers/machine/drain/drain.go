@@ -165,6 +165,10 @@ func (d *Helper) GetPodsForEviction(ctx context.Context, cluster *clusterv1.Clus
 	return list, nil
 }
 
+// getMatchingMachineDrainRules returns the MachineDrainRules in the Namespace of the Machine and the
+// ClusterMachineDrainRules matching the Machine and Cluster, sorted by the order in which they have to be evaluated.
+// Note: ClusterMachineDrainRules are returned as MachineDrainRules with kind ClusterMachineDrainRule and without
+// Namespace, so they can be evaluated together with MachineDrainRules.
 func (d *Helper) getMatchingMachineDrainRules(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine) ([]*clusterv1.MachineDrainRule, error) {
 	// List all MachineDrainRules.
 	machineDrainRuleList := &clusterv1.MachineDrainRuleList{}
@@ -172,11 +176,34 @@ func (d *Helper) getMatchingMachineDrainRules(ctx context.Context, cluster *clus
 		return nil, pkgerrors.Wrapf(err, "failed to list MachineDrainRules")
 	}
 
+	// List all ClusterMachineDrainRules.
+	clusterMachineDrainRuleList := &clusterv1.ClusterMachineDrainRuleList{}
+	if err := d.Client.List(ctx, clusterMachineDrainRuleList); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to list ClusterMachineDrainRules")
+	}
+
+	machineDrainRules := make([]*clusterv1.MachineDrainRule, 0, len(machineDrainRuleList.Items)+len(clusterMachineDrainRuleList.Items))
+	for _, mdr := range machineDrainRuleList.Items {
+		machineDrainRules = append(machineDrainRules, &mdr)
+	}
+	for _, cmdr := range clusterMachineDrainRuleList.Items {
+		machineDrainRules = append(machineDrainRules, &clusterv1.MachineDrainRule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       clusterMachineDrainRuleKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: cmdr.Name,
+			},
+			Spec: cmdr.Spec,
+		})
+	}
+
 	// Validate selectors of all MachineDrainRules (so we don't have to do it later for every Pod in machineDrainRulesFilter).
 	errs := []error{}
-	for _, mdr := range machineDrainRuleList.Items {
-		if validationErrs := coreadmission.ValidateMachineDrainRulesSelectors(&mdr); len(validationErrs) > 0 {
-			errs = append(errs, pkgerrors.Wrapf(validationErrs.ToAggregate(), "invalid selectors in MachineDrainRule %s", mdr.Name))
+	for _, mdr := range machineDrainRules {
+		if validationErrs := coreadmission.ValidateMachineDrainRulesSelectors(&mdr.Spec); len(validationErrs) > 0 {
+			errs = append(errs, pkgerrors.Wrapf(validationErrs.ToAggregate(), "invalid selectors in %s %s", machineDrainRuleKind(mdr), mdr.Name))
 		}
 	}
 	if len(errs) > 0 {
@@ -185,20 +212,42 @@ func (d *Helper) getMatchingMachineDrainRules(ctx context.Context, cluster *clus
 
 	// Collect all MachineDrainRules that match the Machine and Cluster.
 	matchingMachineDrainRules := []*clusterv1.MachineDrainRule{}
-	for _, mdr := range machineDrainRuleList.Items {
-		if !machineDrainRuleAppliesToMachine(&mdr, machine, cluster) {
+	for _, mdr := range machineDrainRules {
+		if !machineDrainRuleAppliesToMachine(mdr, machine, cluster) {
 			continue
 		}
-		matchingMachineDrainRules = append(matchingMachineDrainRules, &mdr)
+		matchingMachineDrainRules = append(matchingMachineDrainRules, mdr)
 	}
 
-	// Sort MachineDrainRules alphabetically (so we don't have to do it later for every Pod in machineDrainRulesFilter).
+	// Sort MachineDrainRules (so we don't have to do it later for every Pod in machineDrainRulesFilter):
+	// * by decreasing precedence
+	// * MachineDrainRules before ClusterMachineDrainRules
+	// * alphabetically
 	sort.Slice(matchingMachineDrainRules, func(i, j int) bool {
-		return matchingMachineDrainRules[i].Name < matchingMachineDrainRules[j].Name
+		a, b := matchingMachineDrainRules[i], matchingMachineDrainRules[j]
+		if precedenceA, precedenceB := ptr.Deref(a.Spec.Precedence, 0), ptr.Deref(b.Spec.Precedence, 0); precedenceA != precedenceB {
+			return precedenceA > precedenceB
+		}
+		if isClusterA, isClusterB := machineDrainRuleKind(a) == clusterMachineDrainRuleKind, machineDrainRuleKind(b) == clusterMachineDrainRuleKind; isClusterA != isClusterB {
+			return isClusterB
+		}
+		return a.Name < b.Name
 	})
 	return matchingMachineDrainRules, nil
 }
 
+// clusterMachineDrainRuleKind is the kind of ClusterMachineDrainRules.
+const clusterMachineDrainRuleKind = "ClusterMachineDrainRule"
+
+// machineDrainRuleKind returns the kind of the rule returned by getMatchingMachineDrainRules, i.e.
+// MachineDrainRule or ClusterMachineDrainRule.
+func machineDrainRuleKind(mdr *clusterv1.MachineDrainRule) string {
+	if mdr.Kind == clusterMachineDrainRuleKind {
+		return clusterMachineDrainRuleKind
+	}
+	return "MachineDrainRule"
+}
+
 // machineDrainRuleAppliesToMachine evaluates if a MachineDrainRule applies to a Machine.
 func machineDrainRuleAppliesToMachine(mdr *clusterv1.MachineDrainRule, machine *clusterv1.Machine, cluster *clusterv1.Cluster) bool {
 	// If machines is empty, the MachineDrainRule applies to all Machines.
@@ -1048,12 +1048,90 @@ func Test_getMatchingMachineDrainRules(t *testing.T) {
 			Pods: nil, // Match all Pods
 		},
 	}
+	matchingMDRLowPrecedence := &clusterv1.MachineDrainRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mdr-low-precedence",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1.MachineDrainRuleSpec{
+			Drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+			},
+			Machines:   nil, // Match all machines
+			Pods:       nil, // Match all Pods
+			Precedence: ptr.To[int32](-1),
+		},
+	}
+	matchingCMDRHighPrecedence := &clusterv1.ClusterMachineDrainRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cmdr-high-precedence",
+		},
+		Spec: clusterv1.MachineDrainRuleSpec{
+			Drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+			},
+			Machines:   nil, // Match all machines
+			Pods:       nil, // Match all Pods
+			Precedence: ptr.To[int32](100),
+		},
+	}
+	matchingCMDRBehaviorDrain := &clusterv1.ClusterMachineDrainRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cmdr-behavior-drain",
+		},
+		Spec: clusterv1.MachineDrainRuleSpec{
+			Drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
+			},
+			Machines: nil, // Match all machines
+			Pods:     nil, // Match all Pods
+		},
+	}
+	notMatchingCMDRNotMatchingSelector := &clusterv1.ClusterMachineDrainRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cmdr-not-matching-not-matching-selector",
+		},
+		Spec: clusterv1.MachineDrainRuleSpec{
+			Drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+			},
+			Machines: []clusterv1.MachineDrainRuleMachineSelector{
+				{
+					ClusterSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"stage": "does-not-match",
+						},
+					},
+				},
+			},
+			Pods: nil, // Match all Pods
+		},
+	}
+	cmdrInvalidSelector := &clusterv1.ClusterMachineDrainRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cmdr-invalid-selector",
+		},
+		Spec: *mdrInvalidSelector.Spec.DeepCopy(),
+	}
+	asMachineDrainRule := func(cmdr *clusterv1.ClusterMachineDrainRule) *clusterv1.MachineDrainRule {
+		return &clusterv1.MachineDrainRule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "ClusterMachineDrainRule",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: cmdr.Name,
+			},
+			Spec: cmdr.Spec,
+		}
+	}
 
 	tests := []struct {
-		name                  string
-		machineDrainRules     []*clusterv1.MachineDrainRule
-		wantMachineDrainRules []*clusterv1.MachineDrainRule
-		wantErr               string
+		name                     string
+		machineDrainRules        []*clusterv1.MachineDrainRule
+		clusterMachineDrainRules []*clusterv1.ClusterMachineDrainRule
+		wantMachineDrainRules    []*clusterv1.MachineDrainRule
+		wantErr                  string
 	}{
 		{
 			name: "Return error for MachineDrainRules with invalid selector",
@@ -1082,6 +1160,37 @@ func Test_getMatchingMachineDrainRules(t *testing.T) {
 				matchingMDRBehaviorWaitCompleted,
 			},
 		},
+		{
+			name: "Return error for ClusterMachineDrainRules with invalid selector",
+			clusterMachineDrainRules: []*clusterv1.ClusterMachineDrainRule{
+				cmdrInvalidSelector,
+			},
+			wantErr: "failed to get matching MachineDrainRules: invalid selectors in ClusterMachineDrainRule cmdr-invalid-selector",
+		},
+		{
+			name: "Return matching MachineDrainRules and ClusterMachineDrainRules in correct order",
+			machineDrainRules: []*clusterv1.MachineDrainRule{
+				matchingMDRLowPrecedence,
+				matchingMDRBehaviorDrainB,
+				matchingMDRBehaviorDrainA,
+				notMatchingMDRDifferentNamespace,
+			},
+			clusterMachineDrainRules: []*clusterv1.ClusterMachineDrainRule{
+				matchingCMDRBehaviorDrain,
+				matchingCMDRHighPrecedence,
+				notMatchingCMDRNotMatchingSelector,
+			},
+			wantMachineDrainRules: []*clusterv1.MachineDrainRule{
+				// Highest precedence first.
+				asMachineDrainRule(matchingCMDRHighPrecedence),
+				// MachineDrainRules before ClusterMachineDrainRules with the same precedence.
+				matchingMDRBehaviorDrainA,
+				matchingMDRBehaviorDrainB,
+				asMachineDrainRule(matchingCMDRBehaviorDrain),
+				// Lowest precedence last.
+				matchingMDRLowPrecedence,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1092,6 +1201,9 @@ func Test_getMatchingMachineDrainRules(t *testing.T) {
 			for _, o := range tt.machineDrainRules {
 				objects = append(objects, o)
 			}
+			for _, o := range tt.clusterMachineDrainRules {
+				objects = append(objects, o)
+			}
 			scheme := runtime.NewScheme()
 			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
 			fakeClient := fake.NewClientBuilder().
@@ -306,7 +306,7 @@ func (d *Helper) machineDrainRulesFilter(machineDrainRules []*clusterv1.MachineD
 			return MakePodDeleteStatusWithError("Pod Namespace does not exist")
 		}
 
-		// Iterate through the MachineDrainRules (they are already alphabetically sorted).
+		// Iterate through the MachineDrainRules (they are already sorted by precedence and name).
 		for _, mdr := range machineDrainRules {
 			if !machineDrainRuleAppliesToPod(mdr, pod, namespace) {
 				continue
@@ -318,15 +318,15 @@ func (d *Helper) machineDrainRulesFilter(machineDrainRules []*clusterv1.MachineD
 			case clusterv1.MachineDrainRuleDrainBehaviorDrain:
 				return MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
 			case clusterv1.MachineDrainRuleDrainBehaviorSkip:
-				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because MachineDrainRule %s with behavior %s applies to the Pod", mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorSkip))
+				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because %s %s with behavior %s applies to the Pod", machineDrainRuleKind(mdr), mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorSkip))
 				return MakePodDeleteStatusSkip()
 			case clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted:
-				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because MachineDrainRule %s with behavior %s applies to the Pod", mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted))
+				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because %s %s with behavior %s applies to the Pod", machineDrainRuleKind(mdr), mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted))
 				return MakePodDeleteStatusWaitCompleted()
 			default:
 				return MakePodDeleteStatusWithError(
-					fmt.Sprintf("MachineDrainRule %q has unknown spec.drain.behavior: %q",
-						mdr.Name, mdr.Spec.Drain.Behavior))
+					fmt.Sprintf("%s %q has unknown spec.drain.behavior: %q",
+						machineDrainRuleKind(mdr), mdr.Name, mdr.Spec.Drain.Behavior))
 			}
 		}
 
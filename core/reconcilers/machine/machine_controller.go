@@ -67,6 +67,9 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	capirecord "sigs.k8s.io/cluster-api/util/record"
+	"sigs.k8s.io/cluster-api/util/shard"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 const (
@@ -89,9 +92,27 @@ var (
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status;machines/finalizers,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedrainrules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedrainrules;clustermachinedrainrules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
+// machineEventReasons are the reasons of the events emitted by the Machine controller.
+var machineEventReasons = []string{
+	clusterv1.MachineBootstrapConfigSwappedEventReason,
+	clusterv1.MachineDrainNodeStartedEventReason,
+	clusterv1.MachineFailedDeleteNodeEventReason,
+	clusterv1.MachineFailedDrainNodeEventReason,
+	clusterv1.MachineFailedGetNodeEventReason,
+	clusterv1.MachineFailedWaitForVolumeDetachEventReason,
+	clusterv1.MachineForceDetachNodeVolumesEventReason,
+	clusterv1.MachineNodeVolumesDetachedEventReason,
+	clusterv1.MachinePreDrainHookTimeoutEventReason,
+	clusterv1.MachinePreTerminateHookTimeoutEventReason,
+	clusterv1.MachineSuccessfulDrainNodeEventReason,
+	clusterv1.MachineSuccessfulSetInterruptibleNodeLabelEventReason,
+	clusterv1.MachineSuccessfulSetNodeRefEventReason,
+}
+
 // Reconciler reconciles a Machine object.
 type Reconciler struct {
 	Client        client.Client
@@ -102,8 +123,24 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	// ClusterRateLimiter limits the reconciles per second for the objects of a single Cluster.
+	// If nil, reconciles are not rate-limited per Cluster.
+	ClusterRateLimiter *capicontrollerutil.ClusterRateLimiter
+
+	// EventDeduplicationWindow is the window within which identical events for the same object are only emitted once.
+	// If zero, events are not deduplicated.
+	EventDeduplicationWindow time.Duration
+
 	RemoteConditionsGracePeriod time.Duration
 
+	// DeletionBlockedThreshold is the duration after which a Machine which is still deleting is reported
+	// with the DeletionBlocked condition. If 0, stuck deletions are not detected.
+	DeletionBlockedThreshold time.Duration
+
 	AdditionalSyncMachineLabels      []*regexp.Regexp
 	AdditionalSyncMachineAnnotations []*regexp.Regexp
 
@@ -153,6 +190,9 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	c, err := capicontrollerutil.NewControllerManagedBy(mgr, *r.predicateLog).
 		For(&clusterv1.Machine{}).
 		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithPriority(machinePriority).
+		WithClusterRateLimiter(r.ClusterRateLimiter).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
@@ -177,7 +217,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 
 	r.hookCache = cache.New[cache.HookEntry](ctx, cache.HookCacheDefaultTTL)
 	r.controller = c
-	r.recorder = mgr.GetEventRecorderFor("machine-controller")
+	r.recorder = capirecord.NewDeduplicatingRecorder(mgr.GetEventRecorderFor("machine-controller"), "machine", r.EventDeduplicationWindow)
+	capirecord.RegisterReasons(machineEventReasons...)
 	r.externalTracker = external.ObjectTracker{
 		Controller:      c,
 		Cache:           mgr.GetCache(),
@@ -187,6 +228,19 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	return nil
 }
 
+// machinePriority returns a high priority for Machines that are being deleted or that failed,
+// so they are reconciled before routine reconciles, e.g. status refreshes.
+func machinePriority(obj client.Object) *int {
+	m, ok := obj.(*clusterv1.Machine)
+	if !ok {
+		return nil
+	}
+	if !m.DeletionTimestamp.IsZero() || m.Status.Phase == string(clusterv1.MachinePhaseFailed) {
+		return ptr.To(capicontrollerutil.HighPriority)
+	}
+	return nil
+}
+
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ctrl.Result, reterr error) {
 	// Fetch the Machine instance
 	m := &clusterv1.Machine{}
@@ -194,6 +248,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		if apierrors.IsNotFound(err) {
 			// Object not found, return.  Created objects are automatically garbage collected.
 			// For additional cleanup logic use finalizers.
+			deleteMachineStuckDeletingMetric(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 
@@ -202,6 +257,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 	}
 
 	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("Cluster", klog.KRef(m.Namespace, m.Spec.ClusterName)))
+	tracing.SetClusterName(ctx, m.Spec.ClusterName)
 
 	// AddOwners adds the owners of Machine as k/v pairs to the logger.
 	// Specifically, it will add KubeadmControlPlane, MachineSet and MachineDeployment.
@@ -247,6 +303,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		return ctrl.Result{}, err
 	}
 
+	// Keep a copy of the Machine to detect completed lifecycle phases for metrics.
+	original := m.DeepCopy()
+
 	defer func() {
 		updateRes := r.updateStatus(ctx, s)
 		retres = util.LowestNonZeroResult(retres, updateRes)
@@ -259,7 +318,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		}
 		if err := patchMachine(ctx, patchHelper, m, patchOpts...); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, err})
+			return
 		}
+
+		// Observe lifecycle metrics only once the status has been persisted, so phases are not observed twice.
+		observeLifecycleDurationMetrics(cluster, original, m, time.Now())
 	}()
 
 	alwaysReconcile := []machineReconcileFunc{
@@ -359,6 +422,7 @@ func patchMachine(ctx context.Context, patchHelper *patch.Helper, machine *clust
 			clusterv1.MachineNodeReadyCondition,
 			clusterv1.MachineNodeHealthyCondition,
 			clusterv1.MachineDeletingCondition,
+			clusterv1.MachineDeletionBlockedCondition,
 			clusterv1.MachineUpdatingCondition,
 		}},
 	)
@@ -491,6 +555,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 	if isDeleteNodeAllowed {
 		// pre-drain.delete lifecycle hook
 		// Return early without error, will requeue if/when the hook owner removes the annotation.
+		// If a timeout is defined for pre-drain hooks, the hooks are ignored after it expires depending on the timeout policy.
 		if annotations.HasWithPrefix(clusterv1.PreDrainDeleteHookAnnotationPrefix, m.Annotations) {
 			var hooks []string
 			for key := range m.Annotations {
@@ -499,17 +564,25 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 				}
 			}
 			slices.Sort(hooks)
-			log.Info("Waiting for pre-drain hooks to succeed", "hooks", strings.Join(hooks, ","))
 			if m.Status.Deletion == nil {
 				m.Status.Deletion = &clusterv1.MachineDeletionStatus{}
 			}
 			if m.Status.Deletion.WaitForPreDrainHookStartTime.IsZero() {
 				m.Status.Deletion.WaitForPreDrainHookStartTime = metav1.Now()
 			}
-			v1beta1conditions.MarkFalse(m, clusterv1.PreDrainDeleteHookSucceededV1Beta1Condition, clusterv1.WaitingExternalHookV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
-			s.deletingReason = clusterv1.MachineDeletingWaitingForPreDrainHookReason
-			s.deletingMessage = fmt.Sprintf("Waiting for pre-drain hooks to succeed (hooks: %s)", strings.Join(hooks, ","))
-			return ctrl.Result{}, nil
+			hookTimeout, err := getDeleteHookTimeout(m, clusterv1.PreDrainDeleteHookTimeoutAnnotation, m.Status.Deletion.WaitForPreDrainHookStartTime)
+			if err != nil {
+				log.Error(err, "Ignoring timeout for pre-drain hooks")
+			}
+			if !hookTimeout.proceed() {
+				log.Info("Waiting for pre-drain hooks to succeed", "hooks", strings.Join(hooks, ","))
+				v1beta1conditions.MarkFalse(m, clusterv1.PreDrainDeleteHookSucceededV1Beta1Condition, clusterv1.WaitingExternalHookV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
+				s.deletingReason = clusterv1.MachineDeletingWaitingForPreDrainHookReason
+				s.deletingMessage = fmt.Sprintf("Waiting for pre-drain hooks to succeed (hooks: %s)", strings.Join(hooks, ",")) + hookTimeout.message()
+				return hookTimeout.result(), nil
+			}
+			log.Info("Timeout waiting for pre-drain hooks expired, proceeding with Machine deletion", "hooks", strings.Join(hooks, ","))
+			r.recorder.Eventf(m, corev1.EventTypeWarning, clusterv1.MachinePreDrainHookTimeoutEventReason, "timeout waiting for pre-drain hooks %s expired, proceeding with Machine deletion", strings.Join(hooks, ","))
 		}
 		v1beta1conditions.MarkTrue(m, clusterv1.PreDrainDeleteHookSucceededV1Beta1Condition)
 
@@ -528,6 +601,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 			}
 			if m.Status.Deletion.NodeDrainStartTime.IsZero() {
 				m.Status.Deletion.NodeDrainStartTime = metav1.Now()
+				r.recorder.Eventf(m, corev1.EventTypeNormal, clusterv1.MachineDrainNodeStartedEventReason, "started draining Machine's node %q", m.Status.NodeRef.Name)
 			}
 
 			// The DrainingSucceededCondition never exists before the node is drained for the first time.
@@ -548,7 +622,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 				v1beta1conditions.MarkFalse(m, clusterv1.DrainingSucceededV1Beta1Condition, clusterv1.DrainingFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
 				s.deletingReason = clusterv1.MachineDeletingDrainingNodeReason
 				s.deletingMessage = "Error draining Node, please check controller logs for errors"
-				r.recorder.Eventf(m, corev1.EventTypeWarning, "FailedDrainNode", "error draining Machine's node %q: %v", m.Status.NodeRef.Name, err)
+				r.recorder.Eventf(m, corev1.EventTypeWarning, clusterv1.MachineFailedDrainNodeEventReason, "error draining Machine's node %q: %v", m.Status.NodeRef.Name, err)
 				return ctrl.Result{}, err
 			}
 			if !result.IsZero() {
@@ -557,7 +631,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 			}
 
 			v1beta1conditions.MarkTrue(m, clusterv1.DrainingSucceededV1Beta1Condition)
-			r.recorder.Eventf(m, corev1.EventTypeNormal, "SuccessfulDrainNode", "success draining Machine's node %q", m.Status.NodeRef.Name)
+			r.recorder.Eventf(m, corev1.EventTypeNormal, clusterv1.MachineSuccessfulDrainNodeEventReason, "success draining Machine's node %q", m.Status.NodeRef.Name)
 		}
 
 		// After node draining is completed, and if isNodeVolumeDetachingAllowed returns True, make sure all
@@ -582,19 +656,21 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 			if err != nil {
 				s.deletingReason = clusterv1.MachineDeletingWaitingForVolumeDetachReason
 				s.deletingMessage = "Error waiting for volumes to be detached from Node, please check controller logs for errors"
-				r.recorder.Eventf(m, corev1.EventTypeWarning, "FailedWaitForVolumeDetach", "error waiting for node volumes detaching, Machine's node %q: %v", m.Status.NodeRef.Name, err)
+				r.recorder.Eventf(m, corev1.EventTypeWarning, clusterv1.MachineFailedWaitForVolumeDetachEventReason, "error waiting for node volumes detaching, Machine's node %q: %v", m.Status.NodeRef.Name, err)
 				return ctrl.Result{}, err
 			}
 			if !result.IsZero() {
 				return result, nil
 			}
+			deleteVolumesWaitingForDetachMetric(m)
 			v1beta1conditions.MarkTrue(m, clusterv1.VolumeDetachSucceededV1Beta1Condition)
-			r.recorder.Eventf(m, corev1.EventTypeNormal, "NodeVolumesDetached", "success waiting for node volumes detaching Machine's node %q", m.Status.NodeRef.Name)
+			r.recorder.Eventf(m, corev1.EventTypeNormal, clusterv1.MachineNodeVolumesDetachedEventReason, "success waiting for node volumes detaching Machine's node %q", m.Status.NodeRef.Name)
 		}
 	}
 
 	// pre-term.delete lifecycle hook
 	// Return early without error, will requeue if/when the hook owner removes the annotation.
+	// If a timeout is defined for pre-terminate hooks, the hooks are ignored after it expires depending on the timeout policy.
 	if annotations.HasWithPrefix(clusterv1.PreTerminateDeleteHookAnnotationPrefix, m.Annotations) {
 		var hooks []string
 		for key := range m.Annotations {
@@ -603,17 +679,33 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 			}
 		}
 		slices.Sort(hooks)
-		log.Info("Waiting for pre-terminate hooks to succeed", "hooks", strings.Join(hooks, ","))
 		if m.Status.Deletion == nil {
 			m.Status.Deletion = &clusterv1.MachineDeletionStatus{}
 		}
 		if m.Status.Deletion.WaitForPreTerminateHookStartTime.IsZero() {
 			m.Status.Deletion.WaitForPreTerminateHookStartTime = metav1.Now()
 		}
-		v1beta1conditions.MarkFalse(m, clusterv1.PreTerminateDeleteHookSucceededV1Beta1Condition, clusterv1.WaitingExternalHookV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
-		s.deletingReason = clusterv1.MachineDeletingWaitingForPreTerminateHookReason
-		s.deletingMessage = fmt.Sprintf("Waiting for pre-terminate hooks to succeed (hooks: %s)", strings.Join(hooks, ","))
-		return ctrl.Result{}, nil
+		hookTimeout, err := getDeleteHookTimeout(m, clusterv1.PreTerminateDeleteHookTimeoutAnnotation, m.Status.Deletion.WaitForPreTerminateHookStartTime)
+		if err != nil {
+			log.Error(err, "Ignoring timeout for pre-terminate hooks")
+		}
+		// Note: Pre-terminate hooks of control plane Machines are never skipped, because e.g. the KCP hook removes the
+		// etcd member of the Machine, and deleting the Machine with its etcd member still registered risks etcd quorum.
+		if util.IsControlPlaneMachine(m) {
+			hookTimeout.policy = clusterv1.DeleteHookTimeoutPolicyWait
+		}
+		if !hookTimeout.proceed() {
+			log.Info("Waiting for pre-terminate hooks to succeed", "hooks", strings.Join(hooks, ","))
+			v1beta1conditions.MarkFalse(m, clusterv1.PreTerminateDeleteHookSucceededV1Beta1Condition, clusterv1.WaitingExternalHookV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
+			s.deletingReason = clusterv1.MachineDeletingWaitingForPreTerminateHookReason
+			s.deletingMessage = fmt.Sprintf("Waiting for pre-terminate hooks to succeed (hooks: %s)", strings.Join(hooks, ",")) + hookTimeout.message()
+			if msg := m.Annotations[clusterv1.MachineDeletingMessageAnnotation]; msg != "" {
+				s.deletingMessage += "; " + msg
+			}
+			return hookTimeout.result(), nil
+		}
+		log.Info("Timeout waiting for pre-terminate hooks expired, proceeding with Machine deletion", "hooks", strings.Join(hooks, ","))
+		r.recorder.Eventf(m, corev1.EventTypeWarning, clusterv1.MachinePreTerminateHookTimeoutEventReason, "timeout waiting for pre-terminate hooks %s expired, proceeding with Machine deletion", strings.Join(hooks, ","))
 	}
 	v1beta1conditions.MarkTrue(m, clusterv1.PreTerminateDeleteHookSucceededV1Beta1Condition)
 
@@ -660,7 +752,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 		if waitErr != nil {
 			log.Error(deleteNodeErr, "Timed out deleting Node", "Node", klog.KRef("", m.Status.NodeRef.Name))
 			v1beta1conditions.MarkFalse(m, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.DeletionFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "")
-			r.recorder.Eventf(m, corev1.EventTypeWarning, "FailedDeleteNode", "error deleting Machine's Node: %v", deleteNodeErr)
+			r.recorder.Eventf(m, corev1.EventTypeWarning, clusterv1.MachineFailedDeleteNodeEventReason, "error deleting Machine's Node: %v", deleteNodeErr)
 
 			// If the node deletion timeout is not expired yet, requeue the Machine for reconciliation.
 			if m.Spec.Deletion.NodeDeletionTimeoutSeconds == nil || *m.Spec.Deletion.NodeDeletionTimeoutSeconds == 0 || m.DeletionTimestamp.Add(time.Duration(*m.Spec.Deletion.NodeDeletionTimeoutSeconds)*time.Second).After(time.Now()) {
@@ -675,6 +767,7 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 	s.deletingReason = clusterv1.MachineDeletingDeletionCompletedReason
 	s.deletingMessage = "Deletion completed"
 
+	deleteVolumesWaitingForDetachMetric(m)
 	controllerutil.RemoveFinalizer(m, clusterv1.MachineFinalizer)
 	return ctrl.Result{}, nil
 }
@@ -756,6 +849,21 @@ func (r *Reconciler) nodeVolumeDetachTimeoutExceeded(machine *clusterv1.Machine)
 	return diff.Seconds() >= float64(*machine.Spec.Deletion.NodeVolumeDetachTimeoutSeconds)
 }
 
+// nodeVolumeForceDetachDeadline returns the time after which volumes still attached to the Node are force-detached.
+// It returns a zero time if either NodeVolumeForceDetachTimeoutSeconds is set to nil or <=0 OR
+// WaitForNodeVolumeDetachStartTime is not set on the Machine.
+func nodeVolumeForceDetachDeadline(machine *clusterv1.Machine) time.Time {
+	if machine.Status.Deletion == nil || machine.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds == nil || *machine.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds <= 0 {
+		return time.Time{}
+	}
+
+	if machine.Status.Deletion.WaitForNodeVolumeDetachStartTime.IsZero() {
+		return time.Time{}
+	}
+
+	return machine.Status.Deletion.WaitForNodeVolumeDetachStartTime.Add(time.Duration(*machine.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds) * time.Second)
+}
+
 // isDeleteNodeAllowed returns nil only if the Machine's NodeRef is not nil
 // and if the Machine is not the last control plane node in the cluster.
 func (r *Reconciler) isDeleteNodeAllowed(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, infraMachine *unstructured.Unstructured) error {
@@ -786,7 +894,7 @@ func (r *Reconciler) isDeleteNodeAllowed(ctx context.Context, cluster *clusterv1
 		if err != nil {
 			log.Error(err, "Failed to get cluster client while deleting Machine and checking for nodes")
 		} else {
-			node, err := r.getNode(ctx, remoteClient, providerID)
+			node, err := r.getNodeForCluster(ctx, cluster, remoteClient, providerID, "")
 			if err != nil && err != ErrNodeNotFound {
 				log.Error(err, "Failed to get node while deleting Machine")
 			} else if err == nil {
@@ -1013,6 +1121,29 @@ func (r *Reconciler) shouldWaitForNodeVolumes(ctx context.Context, s *scope) (ct
 		return ctrl.Result{}, nil
 	}
 
+	// Get the VolumeAttachments for the Node, so the PersistentVolumes we are waiting for can be reported together with
+	// the corresponding VolumeAttachments, and force-detached if required.
+	volumeAttachments, err := getVolumeAttachmentForNode(ctx, remoteClient, nodeName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	attachedVolumeInformation.setVolumeAttachments(volumeAttachments)
+
+	// If the timeout for force-detaching volumes expired, delete the VolumeAttachments of the PersistentVolumes
+	// we are waiting for, so the CSI drivers detach the volumes from the Node.
+	if deadline := nodeVolumeForceDetachDeadline(machine); !deadline.IsZero() && !time.Now().Before(deadline) {
+		forceDetached, err := forceDetachVolumes(ctx, remoteClient, attachedVolumeInformation, volumeAttachments)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(forceDetached) > 0 {
+			log.Info("Timeout waiting for Node volumes to be detached expired, force-detaching volumes", "VolumeAttachments", clog.StringListToString(forceDetached))
+			r.recorder.Eventf(machine, corev1.EventTypeWarning, clusterv1.MachineForceDetachNodeVolumesEventReason, "timeout waiting for node volumes detaching expired, deleted VolumeAttachments %s", strings.Join(forceDetached, ","))
+		}
+	}
+
+	setVolumesWaitingForDetachMetric(cluster, machine, attachedVolumeInformation)
+
 	// Slow down the reconcile process, because volume detach is a slow process.
 	r.controller.DeferNextReconcileForObject(machine, time.Now().Add(waitForVolumeDetachRetryInterval))
 
@@ -1066,6 +1197,26 @@ func (r *Reconciler) reconcileDeleteInfrastructure(ctx context.Context, s *scope
 		return true, nil
 	}
 
+	// The InfraMachinePool referenced by Machines synthesized by the MachinePool controller must not be deleted;
+	// instead, the MachinePool is scaled down and the providerID of the Machine is removed from the InfraMachinePool,
+	// so the infrastructure provider deletes a replica.
+	if isMachinePoolSynthesizedMachine(s.machine) {
+		if s.infraMachine == nil {
+			return false, nil
+		}
+		var providerIDList []string
+		if err := util.UnstructuredUnmarshalField(s.infraMachine, &providerIDList, "spec", "providerIDList"); err != nil && !pkgerrors.Is(err, util.ErrUnstructuredFieldNotFound) {
+			return false, pkgerrors.Wrapf(err, "failed to retrieve providerIDList from %s %s", s.infraMachine.GetKind(), klog.KObj(s.infraMachine))
+		}
+		if slices.Contains(providerIDList, s.machine.Spec.ProviderID) {
+			if err := r.scaleDownMachinePoolForSynthesizedMachine(ctx, s, providerIDList); err != nil {
+				return false, err
+			}
+		}
+		v1beta1conditions.MarkFalse(s.machine, clusterv1.InfrastructureReadyV1Beta1Condition, clusterv1.DeletedV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
+		return true, nil
+	}
+
 	if s.infraMachine != nil && s.infraMachine.GetDeletionTimestamp().IsZero() {
 		if err := r.Client.Delete(ctx, s.infraMachine); err != nil && !apierrors.IsNotFound(err) {
 			return false, pkgerrors.Wrapf(err,
@@ -1077,6 +1228,57 @@ func (r *Reconciler) reconcileDeleteInfrastructure(ctx context.Context, s *scope
 	return false, nil
 }
 
+// scaleDownMachinePoolForSynthesizedMachine scales down the MachinePool of a deleting Machine synthesized by the MachinePool
+// controller, and removes the providerID of the Machine from the InfraMachinePool, so the infrastructure provider, which
+// is the only one that can delete the replica of the Machine, deletes a replica.
+// Note: Infrastructure providers that recompute the providerIDList might delete another replica than the one of the
+// Machine; in this case the providerID is added back to the InfraMachinePool and the MachinePool controller synthesizes
+// a new Machine for it.
+func (r *Reconciler) scaleDownMachinePoolForSynthesizedMachine(ctx context.Context, s *scope, providerIDList []string) error {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machine
+
+	// Note: The MachinePool is scaled down only once, also if removing the providerID fails and it is retried.
+	// The MachinePool is not scaled down if it is being deleted.
+	if _, ok := m.Annotations[clusterv1.MachinePoolScaledDownAnnotation]; !ok {
+		mp, err := util.GetOwnerMachinePool(ctx, r.Client, m.ObjectMeta)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return pkgerrors.Wrapf(err, "failed to get MachinePool for Machine %s", klog.KObj(m))
+		}
+		if mp != nil && mp.DeletionTimestamp.IsZero() && ptr.Deref(mp.Spec.Replicas, 0) > 0 {
+			replicas := *mp.Spec.Replicas
+			mpPatch := client.MergeFromWithOptions(mp.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			mp.Spec.Replicas = ptr.To(replicas - 1)
+			if err := r.Client.Patch(ctx, mp, mpPatch); err != nil {
+				return pkgerrors.Wrapf(err, "failed to scale down MachinePool %s", klog.KObj(mp))
+			}
+			log.Info(fmt.Sprintf("Scaled down MachinePool %s to %d replicas for deleting Machine", klog.KObj(mp), replicas-1), "MachinePool", klog.KObj(mp))
+		}
+		if m.Annotations == nil {
+			m.Annotations = map[string]string{}
+		}
+		m.Annotations[clusterv1.MachinePoolScaledDownAnnotation] = ""
+	}
+
+	infraPatch := client.MergeFromWithOptions(s.infraMachine.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if err := unstructured.SetNestedStringSlice(s.infraMachine.Object, slices.DeleteFunc(slices.Clone(providerIDList), func(providerID string) bool {
+		return providerID == m.Spec.ProviderID
+	}), "spec", "providerIDList"); err != nil {
+		return pkgerrors.Wrapf(err, "failed to remove providerID from %s %s", s.infraMachine.GetKind(), klog.KObj(s.infraMachine))
+	}
+	if err := r.Client.Patch(ctx, s.infraMachine, infraPatch); err != nil {
+		return pkgerrors.Wrapf(err, "failed to remove providerID from %s %s", s.infraMachine.GetKind(), klog.KObj(s.infraMachine))
+	}
+	return nil
+}
+
+// isMachinePoolSynthesizedMachine returns true if the Machine has been synthesized by the MachinePool controller
+// for a replica of a MachinePool whose infrastructure provider does not implement MachinePool Machines.
+func isMachinePoolSynthesizedMachine(m *clusterv1.Machine) bool {
+	_, ok := m.Annotations[clusterv1.MachinePoolSynthesizedMachineAnnotation]
+	return ok
+}
+
 // shouldAdopt returns true if the Machine should be adopted as a stand-alone Machine directly owned by the Cluster.
 func (r *Reconciler) shouldAdopt(m *clusterv1.Machine) bool {
 	// if the machine is controlled by something (MS or KCP), or if it is a stand-alone machine directly owned by the Cluster, then no-op.
@@ -1281,6 +1483,60 @@ type attachedVolumeInformation struct {
 	nodeStatusVolumeNamesWithoutPV []string
 	// Names of PersistentVolumes from VolumeAttachments which don't have a corresponding PersistentVolume.
 	persistentVolumeNamesWithoutPV []string
+
+	// Attached PersistentVolumes we are waiting for, i.e. the ones referenced by persistentVolumeClaims and
+	// persistentVolumesWithoutPVCClaimRef, with their CSI driver and VolumeAttachment.
+	persistentVolumes []attachedPersistentVolume
+}
+
+// attachedPersistentVolume is a PersistentVolume attached to a Node.
+type attachedPersistentVolume struct {
+	name string
+	// driver is the name of the CSI driver of the PersistentVolume, if any.
+	driver string
+	// volumeAttachment is the name of the VolumeAttachment for the PersistentVolume and the Node, if any.
+	volumeAttachment string
+}
+
+func (v attachedPersistentVolume) String() string {
+	var details []string
+	if v.driver != "" {
+		details = append(details, fmt.Sprintf("driver: %s", v.driver))
+	}
+	if v.volumeAttachment != "" {
+		details = append(details, fmt.Sprintf("VolumeAttachment: %s", v.volumeAttachment))
+	}
+	if len(details) == 0 {
+		return v.name
+	}
+	return fmt.Sprintf("%s (%s)", v.name, strings.Join(details, ", "))
+}
+
+// setVolumeAttachments sets the names of the VolumeAttachments of the attached PersistentVolumes.
+func (a *attachedVolumeInformation) setVolumeAttachments(volumeAttachments []*storagev1.VolumeAttachment) {
+	volumeAttachmentNames := map[string]string{}
+	for _, va := range volumeAttachments {
+		if va.Spec.Source.PersistentVolumeName != nil {
+			volumeAttachmentNames[*va.Spec.Source.PersistentVolumeName] = va.Name
+		}
+	}
+	for i := range a.persistentVolumes {
+		a.persistentVolumes[i].volumeAttachment = volumeAttachmentNames[a.persistentVolumes[i].name]
+	}
+}
+
+// volumeCount returns the number of volumes we are waiting for.
+func (a *attachedVolumeInformation) volumeCount() int {
+	return len(a.persistentVolumes) + len(a.nodeStatusVolumeNamesWithoutPV) + len(a.persistentVolumeNamesWithoutPV)
+}
+
+func (a *attachedVolumeInformation) persistentVolumeList() []string {
+	persistentVolumes := make([]string, 0, len(a.persistentVolumes))
+	for _, pv := range a.persistentVolumes {
+		persistentVolumes = append(persistentVolumes, pv.String())
+	}
+	slices.Sort(persistentVolumes)
+	return persistentVolumes
 }
 
 func (a *attachedVolumeInformation) isEmpty() bool {
@@ -1312,6 +1568,10 @@ func (a *attachedVolumeInformation) logKeys() []any {
 		logKeys = append(logKeys, "PersistentVolumeNamesWithoutPV", clog.StringListToString(a.persistentVolumeNamesWithoutPV))
 	}
 
+	if len(a.persistentVolumes) > 0 {
+		logKeys = append(logKeys, "PersistentVolumes", clog.StringListToString(a.persistentVolumeList()))
+	}
+
 	return logKeys
 }
 
@@ -1321,6 +1581,13 @@ func (a *attachedVolumeInformation) conditionMessage(machine *clusterv1.Machine)
 	}
 
 	conditionMessage := fmt.Sprintf("Waiting for Node volumes to be detached (started at %s)", machine.Status.Deletion.WaitForNodeVolumeDetachStartTime.Format(time.RFC3339))
+	if deadline := nodeVolumeForceDetachDeadline(machine); !deadline.IsZero() {
+		if time.Now().Before(deadline) {
+			conditionMessage = fmt.Sprintf("%s; volumes will be force-detached at %s", conditionMessage, deadline.Format(time.RFC3339))
+		} else {
+			conditionMessage = fmt.Sprintf("%s; force-detaching volumes since %s", conditionMessage, deadline.Format(time.RFC3339))
+		}
+	}
 
 	if len(a.persistentVolumeClaims) > 0 {
 		slices.Sort(a.persistentVolumeClaims)
@@ -1342,6 +1609,10 @@ func (a *attachedVolumeInformation) conditionMessage(machine *clusterv1.Machine)
 		conditionMessage = fmt.Sprintf("%s\n* VolumeAttachment with .spec.source.persistentVolumeName not matching a PersistentVolume: %s", conditionMessage, clog.StringListToString(a.persistentVolumeNamesWithoutPV))
 	}
 
+	if len(a.persistentVolumes) > 0 {
+		conditionMessage = fmt.Sprintf("%s\n* PersistentVolumes: %s", conditionMessage, clog.StringListToString(a.persistentVolumeList()))
+	}
+
 	return conditionMessage
 }
 
@@ -1351,6 +1622,7 @@ func (a *attachedVolumeInformation) conditionMessage(machine *clusterv1.Machine)
 func getPersistentVolumesWaitingForDetach(ctx context.Context, c client.Client, attachedNodeVolumeNames, attachedPVNames, pvcsToIgnore sets.Set[string]) (*attachedVolumeInformation, error) {
 	attachedPVCs := sets.Set[string]{}
 	attachedPVsWithoutPVCClaimRef := []string{}
+	attachedPVs := []attachedPersistentVolume{}
 	foundAttachedNodeVolumeNames := sets.Set[string]{}
 	foundAttachedPVNames := sets.Set[string]{}
 
@@ -1390,14 +1662,23 @@ func getPersistentVolumesWaitingForDetach(ctx context.Context, c client.Client,
 				continue
 			}
 
+			attachedPV := attachedPersistentVolume{name: persistentVolume.Name}
+			if persistentVolume.Spec.CSI != nil {
+				attachedPV.driver = persistentVolume.Spec.CSI.Driver
+			}
+
 			// The ClaimRef should only be nil for unbound volumes and these should not be able to be attached.
 			// Also we're unable to map references which are not of Kind PersistentVolumeClaim so we record the PersistentVolume instead.
 			if persistentVolume.Spec.ClaimRef == nil || persistentVolume.Spec.ClaimRef.Kind != "PersistentVolumeClaim" {
 				attachedPVsWithoutPVCClaimRef = append(attachedPVsWithoutPVCClaimRef, persistentVolume.Name)
+				attachedPVs = append(attachedPVs, attachedPV)
 				continue
 			}
 
 			key := types.NamespacedName{Namespace: persistentVolume.Spec.ClaimRef.Namespace, Name: persistentVolume.Spec.ClaimRef.Name}.String()
+			if !pvcsToIgnore.Has(key) {
+				attachedPVs = append(attachedPVs, attachedPV)
+			}
 			// Add the PersistentVolumeClaim namespaced name to the list we are waiting for being detached.
 			attachedPVCs.Insert(key)
 		}
@@ -1412,5 +1693,35 @@ func getPersistentVolumesWaitingForDetach(ctx context.Context, c client.Client,
 		persistentVolumesWithoutPVCClaimRef: attachedPVsWithoutPVCClaimRef,
 		nodeStatusVolumeNamesWithoutPV:      attachedNodeVolumeNames.Difference(foundAttachedNodeVolumeNames).UnsortedList(),
 		persistentVolumeNamesWithoutPV:      attachedPVNames.Difference(foundAttachedPVNames).UnsortedList(),
+		persistentVolumes:                   attachedPVs,
 	}, nil
 }
+
+// forceDetachVolumes deletes the VolumeAttachments of the attached PersistentVolumes, so the CSI drivers
+// detach the corresponding volumes from the Node. It returns the names of the deleted VolumeAttachments.
+// VolumeAttachments which are already being deleted are skipped.
+func forceDetachVolumes(ctx context.Context, c client.Client, a *attachedVolumeInformation, volumeAttachments []*storagev1.VolumeAttachment) ([]string, error) {
+	attachedPVNames := sets.Set[string]{}
+	for _, pv := range a.persistentVolumes {
+		attachedPVNames.Insert(pv.name)
+	}
+
+	deleted := []string{}
+	for _, va := range volumeAttachments {
+		if va.Spec.Source.PersistentVolumeName == nil || !attachedPVNames.Has(*va.Spec.Source.PersistentVolumeName) {
+			continue
+		}
+		if !va.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := c.Delete(ctx, va); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, pkgerrors.Wrapf(err, "failed to delete VolumeAttachment %s", va.Name)
+		}
+		deleted = append(deleted, va.Name)
+	}
+	slices.Sort(deleted)
+	return deleted, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// deleteHookTimeout is the timeout of the wait for deletion lifecycle hooks of a Machine.
+type deleteHookTimeout struct {
+	// deadline is the time the timeout expires; it is zero if no timeout is defined.
+	deadline time.Time
+	policy   clusterv1.DeleteHookTimeoutPolicy
+}
+
+// getDeleteHookTimeout returns the timeout defined by the given annotation for the wait for deletion lifecycle hooks
+// started at startTime.
+func getDeleteHookTimeout(machine *clusterv1.Machine, timeoutAnnotation string, startTime metav1.Time) (deleteHookTimeout, error) {
+	value, ok := machine.Annotations[timeoutAnnotation]
+	if !ok || startTime.IsZero() {
+		return deleteHookTimeout{}, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return deleteHookTimeout{}, pkgerrors.Errorf("invalid value %q for annotation %s, it must be a positive duration, e.g. \"30m\"", value, timeoutAnnotation)
+	}
+
+	policy := clusterv1.DeleteHookTimeoutPolicy(machine.Annotations[clusterv1.DeleteHookTimeoutPolicyAnnotation])
+	switch policy {
+	case "":
+		policy = clusterv1.DeleteHookTimeoutPolicyProceed
+	case clusterv1.DeleteHookTimeoutPolicyProceed, clusterv1.DeleteHookTimeoutPolicyWait:
+	default:
+		return deleteHookTimeout{}, pkgerrors.Errorf("invalid value %q for annotation %s, it must be %s or %s", policy,
+			clusterv1.DeleteHookTimeoutPolicyAnnotation, clusterv1.DeleteHookTimeoutPolicyProceed, clusterv1.DeleteHookTimeoutPolicyWait)
+	}
+
+	return deleteHookTimeout{
+		deadline: startTime.Add(timeout),
+		policy:   policy,
+	}, nil
+}
+
+// expired returns true if the timeout is defined and expired.
+func (t deleteHookTimeout) expired() bool {
+	return !t.deadline.IsZero() && !time.Now().Before(t.deadline)
+}
+
+// proceed returns true if the Machine controller must stop waiting for the hooks and proceed with Machine deletion.
+func (t deleteHookTimeout) proceed() bool {
+	return t.expired() && t.policy == clusterv1.DeleteHookTimeoutPolicyProceed
+}
+
+// message returns the details about the timeout to be surfaced in the Deleting condition message.
+func (t deleteHookTimeout) message() string {
+	switch {
+	case t.deadline.IsZero():
+		return ""
+	case t.expired():
+		return fmt.Sprintf("; timeout expired at %s", t.deadline.Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("; timeout expires at %s", t.deadline.Format(time.RFC3339))
+	}
+}
+
+// result returns the result for the reconcile waiting for the hooks, so the Machine is reconciled again when the timeout expires.
+func (t deleteHookTimeout) result() ctrl.Result {
+	if t.deadline.IsZero() || t.expired() {
+		return ctrl.Result{}
+	}
+	return ctrl.Result{RequeueAfter: time.Until(t.deadline)}
+}
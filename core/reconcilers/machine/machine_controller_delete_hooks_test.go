@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestGetDeleteHookTimeout(t *testing.T) {
+	startedRecently := metav1.NewTime(time.Now().Add(-1 * time.Minute))
+	startedLongAgo := metav1.NewTime(time.Now().Add(-1 * time.Hour))
+
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		startTime       metav1.Time
+		expectErr       bool
+		expectProceed   bool
+		expectRequeue   bool
+		expectedMessage string
+	}{
+		{
+			name:      "no timeout",
+			startTime: startedLongAgo,
+		},
+		{
+			name: "no timeout if the wait for hooks did not start yet",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "10m",
+			},
+		},
+		{
+			name: "timeout not expired",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "10m",
+			},
+			startTime:       startedRecently,
+			expectRequeue:   true,
+			expectedMessage: "; timeout expires at " + startedRecently.Add(10*time.Minute).Format(time.RFC3339),
+		},
+		{
+			name: "timeout expired with the default policy",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "10m",
+			},
+			startTime:       startedLongAgo,
+			expectProceed:   true,
+			expectedMessage: "; timeout expired at " + startedLongAgo.Add(10*time.Minute).Format(time.RFC3339),
+		},
+		{
+			name: "timeout expired with the Wait policy",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "10m",
+				clusterv1.DeleteHookTimeoutPolicyAnnotation:   string(clusterv1.DeleteHookTimeoutPolicyWait),
+			},
+			startTime:       startedLongAgo,
+			expectedMessage: "; timeout expired at " + startedLongAgo.Add(10*time.Minute).Format(time.RFC3339),
+		},
+		{
+			name: "invalid timeout",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "ten minutes",
+			},
+			startTime: startedLongAgo,
+			expectErr: true,
+		},
+		{
+			name: "negative timeout",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "-10m",
+			},
+			startTime: startedLongAgo,
+			expectErr: true,
+		},
+		{
+			name: "invalid policy",
+			annotations: map[string]string{
+				clusterv1.PreDrainDeleteHookTimeoutAnnotation: "10m",
+				clusterv1.DeleteHookTimeoutPolicyAnnotation:   "Skip",
+			},
+			startTime: startedLongAgo,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tt.annotations,
+				},
+			}
+
+			hookTimeout, err := getDeleteHookTimeout(machine, clusterv1.PreDrainDeleteHookTimeoutAnnotation, tt.startTime)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(hookTimeout.proceed()).To(Equal(tt.expectProceed))
+			g.Expect(hookTimeout.result().RequeueAfter > 0).To(Equal(tt.expectRequeue))
+			g.Expect(hookTimeout.message()).To(Equal(tt.expectedMessage))
+		})
+	}
+}
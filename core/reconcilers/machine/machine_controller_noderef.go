@@ -19,20 +19,26 @@ package machine
 import (
 	"context"
 	"fmt"
+	"maps"
+	"math"
 	"slices"
 	"strings"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machinedeployment/mdutil"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
@@ -43,6 +49,15 @@ import (
 	"sigs.k8s.io/cluster-api/util/labels"
 )
 
+const (
+	// uncachedNodeResyncInterval is the interval in which Machines are resynced with their Node
+	// if caching of Nodes has been disabled for the Cluster.
+	uncachedNodeResyncInterval = 1 * time.Minute
+
+	// uncachedNodeListPageSize is the page size used when listing Nodes from the API server.
+	uncachedNodeListPageSize = 500
+)
+
 var (
 	// ErrNodeNotFound signals that a corev1.Node could not be found for the given provider id.
 	ErrNodeNotFound = pkgerrors.New("cannot find node with matching ProviderID")
@@ -80,8 +95,15 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 		return ctrl.Result{}, err
 	}
 
+	// If caching of Nodes has been disabled for the Cluster, Node changes do not trigger reconciles
+	// because there is no Node watch, so resync periodically instead.
+	res := ctrl.Result{}
+	if clustercache.NodeCachingDisabled(cluster) {
+		res = ctrl.Result{RequeueAfter: uncachedNodeResyncInterval}
+	}
+
 	// Even if Status.NodeRef exists, continue to do the following checks to make sure Node is healthy
-	node, err := r.getNode(ctx, remoteClient, machine.Spec.ProviderID)
+	node, err := r.getNodeForCluster(ctx, cluster, remoteClient, machine.Spec.ProviderID, machine.Status.NodeRef.Name)
 	if err != nil {
 		if pkgerrors.Is(err, ErrNodeNotFound) {
 			if !s.machine.DeletionTimestamp.IsZero() {
@@ -96,11 +118,11 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 			}
 			v1beta1conditions.MarkFalse(machine, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.NodeProvisioningV1Beta1Reason, clusterv1.ConditionSeverityWarning, "Waiting for a node with matching ProviderID to exist")
 			log.Info("Infrastructure provider reporting spec.providerID, matching Kubernetes Node is not yet available", machine.Spec.InfrastructureRef.Kind, klog.KRef(machine.Namespace, machine.Spec.InfrastructureRef.Name), "providerID", machine.Spec.ProviderID)
-			// No need to requeue here. Nodes emit an event that triggers reconciliation.
-			return ctrl.Result{}, nil
+			// No need to requeue here (unless caching of Nodes is disabled). Nodes emit an event that triggers reconciliation.
+			return res, nil
 		}
 		s.nodeGetError = err
-		r.recorder.Event(machine, corev1.EventTypeWarning, "Failed to retrieve Node by ProviderID", err.Error())
+		r.recorder.Event(machine, corev1.EventTypeWarning, clusterv1.MachineFailedGetNodeEventReason, err.Error())
 		v1beta1conditions.MarkUnknown(machine, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.NodeInspectionFailedV1Beta1Reason, "Failed to get the Node for this Machine by ProviderID")
 		return ctrl.Result{}, err
 	}
@@ -112,12 +134,15 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 			Name: s.node.Name,
 		}
 		log.Info("Infrastructure provider reporting spec.providerID, Kubernetes Node is now available", machine.Spec.InfrastructureRef.Kind, klog.KRef(machine.Namespace, machine.Spec.InfrastructureRef.Name), "providerID", machine.Spec.ProviderID, "Node", klog.KRef("", machine.Status.NodeRef.Name))
-		r.recorder.Event(machine, corev1.EventTypeNormal, "SuccessfulSetNodeRef", machine.Status.NodeRef.Name)
+		r.recorder.Event(machine, corev1.EventTypeNormal, clusterv1.MachineSuccessfulSetNodeRefEventReason, machine.Status.NodeRef.Name)
 	}
 
 	// Set the NodeSystemInfo.
 	machine.Status.NodeInfo = &s.node.Status.NodeInfo
 
+	// Set the capabilities of the Node.
+	machine.Status.NodeCapabilities = getNodeCapabilities(s.node)
+
 	// Compute all the annotations that CAPI is setting on nodes;
 	nodeAnnotations := annotations.GetManagedAnnotations(machine, r.AdditionalSyncMachineAnnotations...)
 
@@ -150,27 +175,27 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 		// If the interruptible label is added to the node then record the event.
 		// Nb. Only record the event if the node previously did not have the label to avoid recording
 		// the event during every reconcile.
-		r.recorder.Event(machine, corev1.EventTypeNormal, "SuccessfulSetInterruptibleNodeLabel", s.node.Name)
+		r.recorder.Event(machine, corev1.EventTypeNormal, clusterv1.MachineSuccessfulSetInterruptibleNodeLabelEventReason, s.node.Name)
 	}
 
 	if s.infraMachine == nil || !s.infraMachine.GetDeletionTimestamp().IsZero() {
 		v1beta1conditions.MarkFalse(s.machine, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.DeletingV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
-		return ctrl.Result{}, nil
+		return res, nil
 	}
 
 	// Do the remaining node health checks, then set the node health to true if all checks pass.
 	status, message := summarizeNodeV1beta1Conditions(s.node)
 	if status == corev1.ConditionFalse {
 		v1beta1conditions.MarkFalse(machine, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.NodeConditionsFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", message)
-		return ctrl.Result{}, nil
+		return res, nil
 	}
 	if status == corev1.ConditionUnknown {
 		v1beta1conditions.MarkUnknown(machine, clusterv1.MachineNodeHealthyV1Beta1Condition, clusterv1.NodeConditionsFailedV1Beta1Reason, "%s", message)
-		return ctrl.Result{}, nil
+		return res, nil
 	}
 
 	v1beta1conditions.MarkTrue(machine, clusterv1.MachineNodeHealthyV1Beta1Condition)
-	return ctrl.Result{}, nil
+	return res, nil
 }
 
 // summarizeNodeV1beta1Conditions summarizes a Node's conditions and returns the summary of condition statuses and concatenate failed condition messages:
@@ -215,6 +240,78 @@ func summarizeNodeV1beta1Conditions(node *corev1.Node) (corev1.ConditionStatus,
 	return corev1.ConditionUnknown, message
 }
 
+// getNodeCapabilities returns the capabilities of a Node, computed from the NodeSystemInfo and the capacity of the Node.
+// GPUs are detected by looking at extended resources named <vendor>/gpu, e.g. nvidia.com/gpu; if a Node has more than one
+// type of GPUs, the one with the highest count is reported (in case of ties the first resource name in alphabetical order).
+func getNodeCapabilities(node *corev1.Node) clusterv1.MachineNodeCapabilities {
+	capabilities := clusterv1.MachineNodeCapabilities{
+		Architecture:    node.Status.NodeInfo.Architecture,
+		OperatingSystem: node.Status.NodeInfo.OperatingSystem,
+		OSImage:         node.Status.NodeInfo.OSImage,
+	}
+
+	// Do not report GPUs until the Node reports its capacity.
+	if len(node.Status.Capacity) == 0 {
+		return capabilities
+	}
+
+	gpuCount := int64(0)
+	for _, name := range slices.Sorted(maps.Keys(node.Status.Capacity)) {
+		if !strings.HasSuffix(string(name), "/gpu") {
+			continue
+		}
+		quantity := node.Status.Capacity[name]
+		if count := quantity.Value(); count > gpuCount {
+			gpuCount = count
+			capabilities.GPUType = string(name)
+		}
+	}
+	capabilities.GPUCount = ptr.To(int32(min(gpuCount, math.MaxInt32)))
+	return capabilities
+}
+
+// getNodeForCluster returns the Node with the given providerID.
+// If caching of Nodes has been disabled for the Cluster, the Node is read from the API server
+// without relying on the providerID index; nodeName is used to get the Node directly if it is already known.
+func (r *Reconciler) getNodeForCluster(ctx context.Context, cluster *clusterv1.Cluster, c client.Reader, providerID, nodeName string) (*corev1.Node, error) {
+	if !clustercache.NodeCachingDisabled(cluster) {
+		return r.getNode(ctx, c, providerID)
+	}
+	return getNodeUncached(ctx, c, providerID, nodeName)
+}
+
+// getNodeUncached returns the Node with the given providerID by reading from the API server.
+func getNodeUncached(ctx context.Context, c client.Reader, providerID, nodeName string) (*corev1.Node, error) {
+	if nodeName != "" {
+		node := &corev1.Node{}
+		err := c.Get(ctx, client.ObjectKey{Name: nodeName}, node)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil && node.Spec.ProviderID == providerID {
+			return node, nil
+		}
+	}
+
+	// Note: The API server does not support field selectors on spec.providerID, so we have to page through all Nodes.
+	nodeList := &corev1.NodeList{}
+	listOpts := []client.ListOption{client.Limit(uncachedNodeListPageSize)}
+	for {
+		if err := c.List(ctx, nodeList, listOpts...); err != nil {
+			return nil, err
+		}
+		for i := range nodeList.Items {
+			if nodeList.Items[i].Spec.ProviderID == providerID {
+				return &nodeList.Items[i], nil
+			}
+		}
+		if nodeList.Continue == "" {
+			return nil, ErrNodeNotFound
+		}
+		listOpts = []client.ListOption{client.Limit(uncachedNodeListPageSize), client.Continue(nodeList.Continue)}
+	}
+}
+
 func (r *Reconciler) getNode(ctx context.Context, c client.Reader, providerID string) (*corev1.Node, error) {
 	nodeList := corev1.NodeList{}
 	if err := c.List(ctx, &nodeList, client.MatchingFields{index.NodeProviderIDField: providerID}); err != nil {
@@ -23,6 +23,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/record"
@@ -380,6 +381,73 @@ func TestGetNode(t *testing.T) {
 	}
 }
 
+func TestGetNodeUncached(t *testing.T) {
+	nodes := []client.Object{
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws://us-east-1/node-1"},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws://us-east-1/node-2"},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		providerIDInput string
+		nodeNameInput   string
+		wantNodeName    string
+		wantErr         error
+	}{
+		{
+			name:            "Node is found by name",
+			providerIDInput: "aws://us-east-1/node-2",
+			nodeNameInput:   "node-2",
+			wantNodeName:    "node-2",
+		},
+		{
+			name:            "Node is found by providerID if the name is not known",
+			providerIDInput: "aws://us-east-1/node-2",
+			wantNodeName:    "node-2",
+		},
+		{
+			name:            "Node is found by providerID if the Node with the given name has a different providerID",
+			providerIDInput: "aws://us-east-1/node-2",
+			nodeNameInput:   "node-1",
+			wantNodeName:    "node-2",
+		},
+		{
+			name:            "Node is found by providerID if the Node with the given name does not exist",
+			providerIDInput: "aws://us-east-1/node-1",
+			nodeNameInput:   "deleted-node",
+			wantNodeName:    "node-1",
+		},
+		{
+			name:            "Node is not found",
+			providerIDInput: "aws://us-east-1/node-3",
+			wantErr:         ErrNodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			// Note: The index is intentionally not registered, Nodes must be found without it.
+			remoteClient := fake.NewClientBuilder().WithObjects(nodes...).Build()
+
+			node, err := getNodeUncached(ctx, remoteClient, tc.providerIDInput, tc.nodeNameInput)
+			if tc.wantErr != nil {
+				g.Expect(err).To(Equal(tc.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(node.Name).To(Equal(tc.wantNodeName))
+		})
+	}
+}
+
 func TestNodeLabelSync(t *testing.T) {
 	defaultCluster := &clusterv1.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -704,6 +772,86 @@ func TestSummarizeNodeConditions(t *testing.T) {
 	}
 }
 
+func TestGetNodeCapabilities(t *testing.T) {
+	nodeInfo := corev1.NodeSystemInfo{
+		Architecture:    "arm64",
+		OperatingSystem: "linux",
+		OSImage:         "Ubuntu 24.04 LTS",
+	}
+
+	testCases := []struct {
+		name     string
+		capacity corev1.ResourceList
+		expected clusterv1.MachineNodeCapabilities
+	}{
+		{
+			name: "Node without capacity",
+			expected: clusterv1.MachineNodeCapabilities{
+				Architecture:    "arm64",
+				OperatingSystem: "linux",
+				OSImage:         "Ubuntu 24.04 LTS",
+			},
+		},
+		{
+			name: "Node without GPUs",
+			capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			expected: clusterv1.MachineNodeCapabilities{
+				Architecture:    "arm64",
+				OperatingSystem: "linux",
+				OSImage:         "Ubuntu 24.04 LTS",
+				GPUCount:        ptr.To[int32](0),
+			},
+		},
+		{
+			name: "Node with GPUs",
+			capacity: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("4"),
+				"nvidia.com/gpu":   resource.MustParse("2"),
+			},
+			expected: clusterv1.MachineNodeCapabilities{
+				Architecture:    "arm64",
+				OperatingSystem: "linux",
+				OSImage:         "Ubuntu 24.04 LTS",
+				GPUCount:        ptr.To[int32](2),
+				GPUType:         "nvidia.com/gpu",
+			},
+		},
+		{
+			name: "Node with different types of GPUs",
+			capacity: corev1.ResourceList{
+				"amd.com/gpu":    resource.MustParse("1"),
+				"intel.com/gpu":  resource.MustParse("4"),
+				"nvidia.com/gpu": resource.MustParse("4"),
+			},
+			expected: clusterv1.MachineNodeCapabilities{
+				Architecture:    "arm64",
+				OperatingSystem: "linux",
+				OSImage:         "Ubuntu 24.04 LTS",
+				GPUCount:        ptr.To[int32](4),
+				GPUType:         "intel.com/gpu",
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+				Status: corev1.NodeStatus{
+					NodeInfo: nodeInfo,
+					Capacity: test.capacity,
+				},
+			}
+			g.Expect(getNodeCapabilities(node)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestPatchNode(t *testing.T) {
 	clusterName := "test-cluster"
 
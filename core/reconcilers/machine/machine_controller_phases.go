@@ -22,6 +22,7 @@ import (
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -36,6 +37,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	capierrors "sigs.k8s.io/cluster-api/api/deprecated/errors"
 	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -178,6 +180,11 @@ func (r *Reconciler) reconcileBootstrap(ctx context.Context, s *scope) (ctrl.Res
 	if m.Spec.Bootstrap.DataSecretName != nil {
 		m.Status.Initialization.BootstrapDataSecretCreated = ptr.To(true)
 		v1beta1conditions.MarkTrue(m, clusterv1.BootstrapReadyV1Beta1Condition)
+
+		// If the bootstrap config of the Machine has been swapped, re-bootstrap the Machine with the new bootstrap data.
+		if feature.Gates.Enabled(feature.MachineBootstrapConfigSwap) {
+			return r.reconcileBootstrapConfigSwap(ctx, s)
+		}
 		return ctrl.Result{}, nil
 	}
 
@@ -240,12 +247,58 @@ func (r *Reconciler) reconcileBootstrap(ctx context.Context, s *scope) (ctrl.Res
 	return ctrl.Result{}, nil
 }
 
+// reconcileBootstrapConfigSwap sets spec.bootstrap.dataSecretName to the data secret of the bootstrap config of the Machine,
+// if it differs from the one the Machine has been bootstrapped with, i.e. if spec.bootstrap.configRef has been changed.
+// The new data secret is only used if the InfrastructureMachine supports re-bootstrapping, which is signalled by
+// the BootstrapConfigSwapSupportedAnnotation.
+func (r *Reconciler) reconcileBootstrapConfigSwap(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machine
+
+	secretName, err := contract.Bootstrap().DataSecretName().Get(s.bootstrapConfig)
+	if err != nil {
+		if pkgerrors.Is(err, contract.ErrFieldNotFound) {
+			// The data secret of the bootstrap config was not created yet.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to read dataSecretName from %s %s",
+			s.bootstrapConfig.GetKind(), klog.KObj(s.bootstrapConfig))
+	}
+	if *secretName == "" || *secretName == *m.Spec.Bootstrap.DataSecretName {
+		return ctrl.Result{}, nil
+	}
+
+	infraMachine, err := external.GetObjectFromContractVersionedRef(ctx, r.Client, m.Spec.InfrastructureRef, m.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if _, ok := infraMachine.GetAnnotations()[clusterv1.BootstrapConfigSwapSupportedAnnotation]; !ok {
+		log.Info(fmt.Sprintf("Ignoring new bootstrap data secret, %s does not support re-bootstrapping the Machine (annotation %s is not set)",
+			infraMachine.GetKind(), clusterv1.BootstrapConfigSwapSupportedAnnotation),
+			s.bootstrapConfig.GetKind(), klog.KObj(s.bootstrapConfig), "Secret", klog.KRef(m.Namespace, *secretName))
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Bootstrap config has been changed, re-bootstrapping the Machine with the new bootstrap data secret",
+		s.bootstrapConfig.GetKind(), klog.KObj(s.bootstrapConfig), "Secret", klog.KRef(m.Namespace, *secretName))
+	r.recorder.Eventf(m, corev1.EventTypeNormal, clusterv1.MachineBootstrapConfigSwappedEventReason, "Bootstrap data secret changed from %s to %s", *m.Spec.Bootstrap.DataSecretName, *secretName)
+	m.Spec.Bootstrap.DataSecretName = secretName
+	return ctrl.Result{}, nil
+}
+
 // reconcileInfrastructure reconciles the InfrastructureMachine of a Machine.
 func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	cluster := s.cluster
 	m := s.machine
 
+	if isMachinePoolSynthesizedMachine(m) {
+		return r.reconcileSynthesizedMachineInfrastructure(ctx, s)
+	}
+
 	// Call generic external reconciler.
 	obj, err := r.reconcileExternal(ctx, cluster, m, m.Spec.InfrastructureRef)
 	if err != nil {
@@ -304,6 +357,15 @@ func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctr
 	}
 	v1beta1conditions.SetMirror(m, clusterv1.InfrastructureReadyV1Beta1Condition, v1beta1conditions.UnstructuredGetter(s.infraMachine), fallBack)
 
+	// Get diagnostics from the InfrastructureMachine.
+	// Note: diagnostics are surfaced also before the InfrastructureMachine is provisioned, because
+	// boot and console logs are most useful to debug Machines failing to provision or bootstrap.
+	diagnostics, err := getInfraMachineDiagnostics(s.infraMachine)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	m.Status.Diagnostics = diagnostics
+
 	if !s.infraMachine.GetDeletionTimestamp().IsZero() {
 		return ctrl.Result{}, nil
 	}
@@ -376,6 +438,34 @@ func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctr
 	return ctrl.Result{}, nil
 }
 
+// reconcileSynthesizedMachineInfrastructure reconciles the infrastructure of a Machine synthesized by the MachinePool controller.
+// The infrastructureRef of those Machines points to the InfraMachinePool, which is owned by the MachinePool; so it is only read,
+// and the providerID is set on the Machine by the MachinePool controller.
+func (r *Reconciler) reconcileSynthesizedMachineInfrastructure(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machine
+
+	obj, err := external.GetObjectFromContractVersionedRef(ctx, r.Client, m.Spec.InfrastructureRef, m.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(pkgerrors.Cause(err)) {
+			s.infraMachineIsNotFound = true
+			if !m.DeletionTimestamp.IsZero() {
+				return ctrl.Result{}, nil
+			}
+			log.Info("Could not find InfrastructureMachinePool, requeuing", m.Spec.InfrastructureRef.Kind, klog.KRef(m.Namespace, m.Spec.InfrastructureRef.Name))
+			return ctrl.Result{RequeueAfter: externalReadyWait}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	s.infraMachine = obj
+
+	provisioned := m.Spec.ProviderID != ""
+	v1beta1conditions.SetMirror(m, clusterv1.InfrastructureReadyV1Beta1Condition, v1beta1conditions.UnstructuredGetter(s.infraMachine),
+		v1beta1conditions.WithFallbackValue(provisioned, clusterv1.WaitingForInfrastructureFallbackV1Beta1Reason, clusterv1.ConditionSeverityInfo, ""))
+	m.Status.Initialization.InfrastructureProvisioned = ptr.To(provisioned)
+	return ctrl.Result{}, nil
+}
+
 func (r *Reconciler) reconcileCertificateExpiry(_ context.Context, s *scope) (ctrl.Result, error) {
 	m := s.machine
 	var annotations map[string]string
@@ -467,3 +557,32 @@ func getControlPlaneGKForMachine(cluster *clusterv1.Cluster, machine *clusterv1.
 	}
 	return nil
 }
+
+// getInfraMachineDiagnostics returns the diagnostics reported by an InfrastructureMachine.
+func getInfraMachineDiagnostics(infraMachine *unstructured.Unstructured) (clusterv1.MachineDiagnosticsStatus, error) {
+	diagnostics := clusterv1.MachineDiagnosticsStatus{}
+
+	logsSecretName, err := contract.InfrastructureMachine().DiagnosticsLogsSecretName().Get(infraMachine)
+	switch {
+	case pkgerrors.Is(err, contract.ErrFieldNotFound): // no-op
+	case err != nil:
+		return diagnostics, pkgerrors.Wrapf(err, "failed to read %s from %s %s",
+			contract.InfrastructureMachine().DiagnosticsLogsSecretName().Path().String(),
+			infraMachine.GetKind(), klog.KObj(infraMachine))
+	default:
+		diagnostics.LogsSecretName = *logsSecretName
+	}
+
+	logsURL, err := contract.InfrastructureMachine().DiagnosticsLogsURL().Get(infraMachine)
+	switch {
+	case pkgerrors.Is(err, contract.ErrFieldNotFound): // no-op
+	case err != nil:
+		return diagnostics, pkgerrors.Wrapf(err, "failed to read %s from %s %s",
+			contract.InfrastructureMachine().DiagnosticsLogsURL().Path().String(),
+			infraMachine.GetKind(), klog.KObj(infraMachine))
+	default:
+		diagnostics.LogsURL = *logsURL
+	}
+
+	return diagnostics, nil
+}
@@ -24,9 +24,12 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
@@ -38,6 +41,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	externalfake "sigs.k8s.io/cluster-api/controllers/external/fake"
+	"sigs.k8s.io/cluster-api/feature"
 	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
@@ -381,6 +385,125 @@ func TestReconcileBootstrap(t *testing.T) {
 	}
 }
 
+func TestReconcileBootstrapConfigSwap(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineBootstrapConfigSwap, true)
+
+	defaultCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		bootstrapDataSecretName string
+		infraMachineAnnotations map[string]interface{}
+		expectedDataSecretName  string
+	}{
+		{
+			name:                    "data secret is not changed if the bootstrap config was not swapped",
+			bootstrapDataSecretName: "secret-data",
+			infraMachineAnnotations: map[string]interface{}{
+				clusterv1.BootstrapConfigSwapSupportedAnnotation: "",
+			},
+			expectedDataSecretName: "secret-data",
+		},
+		{
+			name:                    "data secret is changed if the bootstrap config was swapped and the InfrastructureMachine supports it",
+			bootstrapDataSecretName: "new-secret-data",
+			infraMachineAnnotations: map[string]interface{}{
+				clusterv1.BootstrapConfigSwapSupportedAnnotation: "",
+			},
+			expectedDataSecretName: "new-secret-data",
+		},
+		{
+			name:                    "data secret is not changed if the bootstrap config was swapped but the InfrastructureMachine does not support it",
+			bootstrapDataSecretName: "new-secret-data",
+			expectedDataSecretName:  "secret-data",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-test",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						ConfigRef: clusterv1.ContractVersionedObjectReference{
+							APIGroup: clusterv1.GroupVersionBootstrap.Group,
+							Kind:     "GenericBootstrapConfig",
+							Name:     "bootstrap-config2",
+						},
+						DataSecretName: ptr.To("secret-data"),
+					},
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						APIGroup: clusterv1.GroupVersionInfrastructure.Group,
+						Kind:     "GenericInfrastructureMachine",
+						Name:     "infra-config1",
+					},
+				},
+			}
+			bootstrapConfig := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind":       "GenericBootstrapConfig",
+				"apiVersion": clusterv1.GroupVersionBootstrap.String(),
+				"metadata": map[string]interface{}{
+					"name":      "bootstrap-config2",
+					"namespace": metav1.NamespaceDefault,
+				},
+				"spec": map[string]interface{}{},
+				"status": map[string]interface{}{
+					"initialization": map[string]interface{}{
+						"dataSecretCreated": true,
+					},
+					"dataSecretName": tc.bootstrapDataSecretName,
+				},
+			}}
+			infraMachine := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind":       "GenericInfrastructureMachine",
+				"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+				"metadata": map[string]interface{}{
+					"name":        "infra-config1",
+					"namespace":   metav1.NamespaceDefault,
+					"annotations": tc.infraMachineAnnotations,
+				},
+			}}
+
+			c := fake.NewClientBuilder().WithObjects(machine).Build()
+			for _, crd := range []*apiextensionsv1.CustomResourceDefinition{builder.GenericBootstrapConfigCRD.DeepCopy(), builder.GenericInfrastructureMachineCRD.DeepCopy()} {
+				crd.Labels = map[string]string{
+					fmt.Sprintf("%s/v1beta2", clusterv1.GroupVersion.Group): clusterv1.GroupVersionBootstrap.Version,
+				}
+				g.Expect(c.Create(ctx, crd)).To(Succeed())
+			}
+			g.Expect(c.Create(ctx, bootstrapConfig)).To(Succeed())
+			g.Expect(c.Create(ctx, infraMachine)).To(Succeed())
+
+			r := &Reconciler{
+				Client:   c,
+				recorder: record.NewFakeRecorder(10),
+				externalTracker: external.ObjectTracker{
+					Controller:      externalfake.Controller{},
+					Cache:           &informertest.FakeInformers{},
+					Scheme:          runtime.NewScheme(),
+					PredicateLogger: ptr.To(logr.New(log.NullLogSink{})),
+				},
+			}
+			s := &scope{cluster: defaultCluster, machine: machine}
+			res, err := r.reconcileBootstrap(ctx, s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res).To(BeComparableTo(ctrl.Result{}))
+			g.Expect(ptr.Deref(machine.Status.Initialization.BootstrapDataSecretCreated, false)).To(BeTrue())
+			g.Expect(*machine.Spec.Bootstrap.DataSecretName).To(Equal(tc.expectedDataSecretName))
+		})
+	}
+}
+
 func TestReconcileInfrastructure(t *testing.T) {
 	defaultMachine := clusterv1.Machine{
 		ObjectMeta: metav1.ObjectMeta{
@@ -477,6 +600,37 @@ func TestReconcileInfrastructure(t *testing.T) {
 				g.Expect(m.Status.Addresses).To(BeNil())
 			},
 		},
+		{
+			name:     "infra machine not ready with diagnostics, it should reconcile and diagnostics should surface on the machine",
+			contract: "v1beta2",
+			machine:  defaultMachine.DeepCopy(),
+			infraMachine: map[string]interface{}{
+				"kind":       "GenericInfrastructureMachine",
+				"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+				"metadata": map[string]interface{}{
+					"name":      "infra-config1",
+					"namespace": metav1.NamespaceDefault,
+				},
+				"spec": map[string]interface{}{},
+				"status": map[string]interface{}{
+					"diagnostics": map[string]interface{}{
+						"logsSecretName": "infra-config1-logs",
+						"logsURL":        "https://example.com/console/infra-config1",
+					},
+				},
+			},
+			infraMachineGetError: nil,
+			expectResult:         ctrl.Result{},
+			expectError:          false,
+			expected: func(g *WithT, m *clusterv1.Machine) {
+				g.Expect(ptr.Deref(m.Status.Initialization.InfrastructureProvisioned, false)).To(BeFalse())
+				g.Expect(m.Spec.ProviderID).To(BeEmpty())
+				g.Expect(m.Status.Diagnostics).To(Equal(clusterv1.MachineDiagnosticsStatus{
+					LogsSecretName: "infra-config1-logs",
+					LogsURL:        "https://example.com/console/infra-config1",
+				}))
+			},
+		},
 		{
 			name:     "infra machine ready and without optional fields, it should reconcile and data should surface on the machine",
 			contract: "v1beta1",
@@ -19,6 +19,7 @@ package machine
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api/core/reconcilers/machinedeployment/mdutil"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/inplace"
+	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
@@ -67,12 +69,13 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) ctrl.Result {
 	// Note: also other controllers adds conditions to the machine object (machine's owner controller sets the UpToDate condition,
 	// MHC controller sets HealthCheckSucceeded and OwnerRemediated conditions, KCP sets conditions about etcd and control plane pods).
 	setDeletingCondition(ctx, s.machine, s.deletingReason, s.deletingMessage)
+	deletionBlockedRes := setDeletionBlockedCondition(ctx, s.cluster, s.machine, s.infraMachine, s.bootstrapConfig, r.DeletionBlockedThreshold)
 	setUpdatingCondition(ctx, s.machine, s.updatingReason, s.updatingMessage)
 	setUpToDateCondition(ctx, s.machine, s.owningMachineSet, s.owningMachineDeployment)
 	setReadyCondition(ctx, s.machine)
 	setMachinePhaseAndLastUpdated(ctx, s.machine)
 
-	return setAvailableCondition(ctx, s.machine)
+	return util.LowestNonZeroResult(deletionBlockedRes, setAvailableCondition(ctx, s.machine))
 }
 
 func setBootstrapReadyCondition(_ context.Context, machine *clusterv1.Machine, bootstrapConfig *unstructured.Unstructured, bootstrapConfigIsNotFound bool) {
@@ -631,6 +634,71 @@ func setDeletingCondition(_ context.Context, machine *clusterv1.Machine, deletin
 	})
 }
 
+// setDeletionBlockedCondition sets the DeletionBlocked condition if the Machine has been deleting for longer than
+// the given threshold, surfacing what is blocking the deletion. The condition is set only on deleting Machines,
+// and it is not set if threshold is 0.
+// It returns a result that requeues the Machine when the threshold expires.
+func setDeletionBlockedCondition(_ context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, infraMachine, bootstrapConfig *unstructured.Unstructured, threshold time.Duration) ctrl.Result {
+	if threshold <= 0 || machine.DeletionTimestamp.IsZero() {
+		conditions.Delete(machine, clusterv1.MachineDeletionBlockedCondition)
+		deleteMachineStuckDeletingMetric(machine.Namespace, machine.Name)
+		return ctrl.Result{}
+	}
+
+	if time.Since(machine.DeletionTimestamp.Time) < threshold {
+		conditions.Set(machine, metav1.Condition{
+			Type:   clusterv1.MachineDeletionBlockedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachineDeletionNotBlockedReason,
+		})
+		deleteMachineStuckDeletingMetric(machine.Namespace, machine.Name)
+		return ctrl.Result{RequeueAfter: time.Until(machine.DeletionTimestamp.Add(threshold))}
+	}
+
+	deletingReason := clusterv1.MachineDeletingReason
+	message := fmt.Sprintf("Machine deletion started at %s and did not complete within %s", machine.DeletionTimestamp.Format(time.RFC3339), threshold)
+	if deletingCondition := conditions.Get(machine, clusterv1.MachineDeletingCondition); deletingCondition != nil && deletingCondition.Reason != "" {
+		deletingReason = deletingCondition.Reason
+		message += fmt.Sprintf("\n* Deleting: %s", deletingReason)
+	}
+	if hooks := annotationsWithPrefix(machine, clusterv1.PreDrainDeleteHookAnnotationPrefix); len(hooks) > 0 {
+		message += fmt.Sprintf("\n* Pre-drain hooks: %s", strings.Join(hooks, ", "))
+	}
+	if hooks := annotationsWithPrefix(machine, clusterv1.PreTerminateDeleteHookAnnotationPrefix); len(hooks) > 0 {
+		message += fmt.Sprintf("\n* Pre-terminate hooks: %s", strings.Join(hooks, ", "))
+	}
+	if finalizers := machine.GetFinalizers(); len(finalizers) > 0 {
+		message += fmt.Sprintf("\n* Finalizers: %s", strings.Join(finalizers, ", "))
+	}
+	for _, obj := range []*unstructured.Unstructured{infraMachine, bootstrapConfig} {
+		if obj == nil || obj.GetDeletionTimestamp().IsZero() || len(obj.GetFinalizers()) == 0 {
+			continue
+		}
+		message += fmt.Sprintf("\n* %s %s finalizers: %s", obj.GetKind(), obj.GetName(), strings.Join(obj.GetFinalizers(), ", "))
+	}
+
+	conditions.Set(machine, metav1.Condition{
+		Type:    clusterv1.MachineDeletionBlockedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.MachineDeletionBlockedReason,
+		Message: message,
+	})
+	setMachineStuckDeletingMetric(cluster, machine, deletingReason)
+	return ctrl.Result{}
+}
+
+// annotationsWithPrefix returns the sorted keys of the annotations of the Machine with the given prefix.
+func annotationsWithPrefix(machine *clusterv1.Machine, prefix string) []string {
+	var keys []string
+	for key := range machine.Annotations {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
 func setUpdatingCondition(_ context.Context, machine *clusterv1.Machine, updatingReason, updatingMessage string) {
 	if inplace.IsUpdateInProgress(machine) {
 		if updatingReason == "" {
@@ -1260,6 +1260,135 @@ func TestDeletingCondition(t *testing.T) {
 	}
 }
 
+func TestSetDeletionBlockedCondition(t *testing.T) {
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-test", Namespace: metav1.NamespaceDefault}}
+	deletedLongAgo := metav1.NewTime(time.Now().Add(-2 * time.Hour).Truncate(time.Second))
+
+	infraMachine := &unstructured.Unstructured{}
+	infraMachine.SetKind("GenericInfrastructureMachine")
+	infraMachine.SetName("infra-machine-test")
+	infraMachine.SetDeletionTimestamp(&deletedLongAgo)
+	infraMachine.SetFinalizers([]string{"infrastructure.cluster.x-k8s.io/cleanup"})
+
+	testCases := []struct {
+		name            string
+		machine         *clusterv1.Machine
+		infraMachine    *unstructured.Unstructured
+		threshold       time.Duration
+		expectCondition *metav1.Condition
+		expectRequeue   bool
+	}{
+		{
+			name: "condition not set if threshold is 0",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "machine-test",
+					Namespace:         metav1.NamespaceDefault,
+					DeletionTimestamp: &deletedLongAgo,
+				},
+			},
+		},
+		{
+			name: "condition not set if deletionTimestamp is not set",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-test",
+					Namespace: metav1.NamespaceDefault,
+				},
+			},
+			threshold: time.Hour,
+		},
+		{
+			name: "condition removed if deletionTimestamp is not set",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-test",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Status: clusterv1.MachineStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   clusterv1.MachineDeletionBlockedCondition,
+							Status: metav1.ConditionFalse,
+							Reason: clusterv1.MachineDeletionNotBlockedReason,
+						},
+					},
+				},
+			},
+			threshold: time.Hour,
+		},
+		{
+			name: "deleting for less than the threshold",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "machine-test",
+					Namespace:         metav1.NamespaceDefault,
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				},
+			},
+			threshold: time.Hour,
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineDeletionBlockedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.MachineDeletionNotBlockedReason,
+			},
+			expectRequeue: true,
+		},
+		{
+			name: "deleting for longer than the threshold",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "machine-test",
+					Namespace:         metav1.NamespaceDefault,
+					DeletionTimestamp: &deletedLongAgo,
+					Finalizers:        []string{clusterv1.MachineFinalizer},
+					Annotations: map[string]string{
+						clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/test-hook": "",
+					},
+				},
+				Status: clusterv1.MachineStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   clusterv1.MachineDeletingCondition,
+							Status: metav1.ConditionTrue,
+							Reason: clusterv1.MachineDeletingWaitingForPreTerminateHookReason,
+						},
+					},
+				},
+			},
+			infraMachine: infraMachine,
+			threshold:    time.Hour,
+			expectCondition: &metav1.Condition{
+				Type:   clusterv1.MachineDeletionBlockedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineDeletionBlockedReason,
+				Message: fmt.Sprintf("Machine deletion started at %s and did not complete within 1h0m0s\n", deletedLongAgo.Format(time.RFC3339)) +
+					"* Deleting: " + clusterv1.MachineDeletingWaitingForPreTerminateHookReason + "\n" +
+					"* Pre-terminate hooks: " + clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/test-hook\n" +
+					"* Finalizers: " + clusterv1.MachineFinalizer + "\n" +
+					"* GenericInfrastructureMachine infra-machine-test finalizers: infrastructure.cluster.x-k8s.io/cleanup",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res := setDeletionBlockedCondition(ctx, cluster, tc.machine, tc.infraMachine, nil, tc.threshold)
+			g.Expect(res.RequeueAfter > 0).To(Equal(tc.expectRequeue))
+
+			deletionBlockedCondition := conditions.Get(tc.machine, clusterv1.MachineDeletionBlockedCondition)
+			if tc.expectCondition == nil {
+				g.Expect(deletionBlockedCondition).To(BeNil())
+				return
+			}
+			g.Expect(deletionBlockedCondition).ToNot(BeNil())
+			g.Expect(*deletionBlockedCondition).To(conditions.MatchCondition(*tc.expectCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
 func TestTransformControlPlaneAndEtcdConditions(t *testing.T) {
 	testCases := []struct {
 		name           string
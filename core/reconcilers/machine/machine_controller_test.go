@@ -2278,6 +2278,9 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 		},
 	}
 
+	// Force detach timeout expiring one hour from now.
+	forceDetachTimeoutNotExpired := int32(time.Since(waitForNodeVolumeDetachStartTime).Seconds()) + 3600
+
 	attachedVolumes := []corev1.AttachedVolume{
 		{
 			Name:       corev1.UniqueVolumeName(fmt.Sprintf("kubernetes.io/csi/%s^%s", persistentVolume.Spec.CSI.Driver, persistentVolume.Spec.CSI.VolumeHandle)),
@@ -2289,11 +2292,13 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 		name                     string
 		node                     *corev1.Node
 		remoteObjects            []client.Object
+		machineDeletion          clusterv1.MachineDeletionSpec
 		featureGateDisabled      bool
 		expected                 ctrl.Result
 		expectedDeletingReason   string
 		expectedDeletingMessage  string
 		expectDeferNextReconcile time.Duration
+		expectVolumeAttachments  []string
 	}{
 		{
 			name: "Node has volumes attached according to node status",
@@ -2317,7 +2322,8 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			expected:               ctrl.Result{RequeueAfter: waitForVolumeDetachRetryInterval},
 			expectedDeletingReason: clusterv1.MachineDeletingWaitingForVolumeDetachReason,
 			expectedDeletingMessage: `Waiting for Node volumes to be detached (started at 2024-10-09T16:13:59Z)
-* PersistentVolumeClaims: default/test-pvc`,
+* PersistentVolumeClaims: default/test-pvc
+* PersistentVolumes: test-pv (driver: dummy)`,
 			expectDeferNextReconcile: waitForVolumeDetachRetryInterval,
 		},
 		{
@@ -2342,7 +2348,8 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			expected:               ctrl.Result{RequeueAfter: waitForVolumeDetachRetryInterval},
 			expectedDeletingReason: clusterv1.MachineDeletingWaitingForVolumeDetachReason,
 			expectedDeletingMessage: `Waiting for Node volumes to be detached (started at 2024-10-09T16:13:59Z)
-* PersistentVolumes without a .spec.claimRef to a PersistentVolumeClaim: test-pv`,
+* PersistentVolumes without a .spec.claimRef to a PersistentVolumeClaim: test-pv
+* PersistentVolumes: test-pv (driver: dummy)`,
 			expectDeferNextReconcile: waitForVolumeDetachRetryInterval,
 		},
 		{
@@ -2443,7 +2450,8 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			expected:               ctrl.Result{RequeueAfter: waitForVolumeDetachRetryInterval},
 			expectedDeletingReason: clusterv1.MachineDeletingWaitingForVolumeDetachReason,
 			expectedDeletingMessage: `Waiting for Node volumes to be detached (started at 2024-10-09T16:13:59Z)
-* PersistentVolumeClaims: default/test-pvc`,
+* PersistentVolumeClaims: default/test-pvc
+* PersistentVolumes: test-pv (driver: dummy, VolumeAttachment: test-va)`,
 			expectDeferNextReconcile: waitForVolumeDetachRetryInterval,
 		},
 		{
@@ -2468,6 +2476,67 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			featureGateDisabled: true,
 			expected:            ctrl.Result{},
 		},
+		{
+			name: "Node has volumes attached according to volumeattachments and the force detach timeout is not expired",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+			},
+			remoteObjects: []client.Object{
+				volumeAttachment,
+				persistentVolume,
+			},
+			machineDeletion: clusterv1.MachineDeletionSpec{
+				NodeVolumeForceDetachTimeoutSeconds: ptr.To(forceDetachTimeoutNotExpired),
+			},
+			expected:               ctrl.Result{RequeueAfter: waitForVolumeDetachRetryInterval},
+			expectedDeletingReason: clusterv1.MachineDeletingWaitingForVolumeDetachReason,
+			expectedDeletingMessage: `Waiting for Node volumes to be detached (started at 2024-10-09T16:13:59Z); volumes will be force-detached at ` +
+				waitForNodeVolumeDetachStartTime.Add(time.Duration(forceDetachTimeoutNotExpired)*time.Second).Format(time.RFC3339) + `
+* PersistentVolumeClaims: default/test-pvc
+* PersistentVolumes: test-pv (driver: dummy, VolumeAttachment: test-va)`,
+			expectDeferNextReconcile: waitForVolumeDetachRetryInterval,
+			expectVolumeAttachments:  []string{"test-va"},
+		},
+		{
+			name: "Node has volumes attached according to volumeattachments and the force detach timeout is expired",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+			},
+			remoteObjects: []client.Object{
+				volumeAttachment,
+				persistentVolume,
+			},
+			machineDeletion: clusterv1.MachineDeletionSpec{
+				NodeVolumeForceDetachTimeoutSeconds: ptr.To(int32(60)),
+			},
+			expected:               ctrl.Result{RequeueAfter: waitForVolumeDetachRetryInterval},
+			expectedDeletingReason: clusterv1.MachineDeletingWaitingForVolumeDetachReason,
+			expectedDeletingMessage: `Waiting for Node volumes to be detached (started at 2024-10-09T16:13:59Z); force-detaching volumes since 2024-10-09T16:14:59Z
+* PersistentVolumeClaims: default/test-pvc
+* PersistentVolumes: test-pv (driver: dummy, VolumeAttachment: test-va)`,
+			expectDeferNextReconcile: waitForVolumeDetachRetryInterval,
+			expectVolumeAttachments:  []string{},
+		},
 		{
 			name: "Node has volumes attached according to volumeattachments but without a pv",
 			node: &corev1.Node{
@@ -2591,7 +2660,8 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			expected:               ctrl.Result{RequeueAfter: waitForVolumeDetachRetryInterval},
 			expectedDeletingReason: clusterv1.MachineDeletingWaitingForVolumeDetachReason,
 			expectedDeletingMessage: `Waiting for Node volumes to be detached (started at 2024-10-09T16:13:59Z)
-* PersistentVolumeClaims: default/test-pvc`,
+* PersistentVolumeClaims: default/test-pvc
+* PersistentVolumes: test-pv (driver: dummy, VolumeAttachment: test-va)`,
 			expectDeferNextReconcile: waitForVolumeDetachRetryInterval,
 		},
 		{
@@ -2677,8 +2747,10 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 				Client:       fakeClient,
 				ClusterCache: clustercache.NewFakeClusterCache(remoteFakeClient, client.ObjectKeyFromObject(testCluster)),
 				controller:   fc,
+				recorder:     record.NewFakeRecorder(10),
 			}
 
+			testMachine.Spec.Deletion = tt.machineDeletion
 			testMachine.Status.NodeRef = clusterv1.MachineNodeReference{
 				Name: tt.node.GetName(),
 			}
@@ -2694,6 +2766,16 @@ func TestShouldWaitForNodeVolumes(t *testing.T) {
 			g.Expect(s.deletingReason).To(BeEquivalentTo(tt.expectedDeletingReason))
 			g.Expect(s.deletingMessage).To(BeEquivalentTo(tt.expectedDeletingMessage))
 
+			if tt.expectVolumeAttachments != nil {
+				volumeAttachments := &storagev1.VolumeAttachmentList{}
+				g.Expect(remoteFakeClient.List(ctx, volumeAttachments)).To(Succeed())
+				volumeAttachmentNames := []string{}
+				for _, va := range volumeAttachments.Items {
+					volumeAttachmentNames = append(volumeAttachmentNames, va.Name)
+				}
+				g.Expect(volumeAttachmentNames).To(Equal(tt.expectVolumeAttachments))
+			}
+
 			if tt.expectDeferNextReconcile == 0 {
 				g.Expect(fc.Deferrals).To(BeEmpty())
 			} else {
@@ -3790,6 +3872,119 @@ func assertV1Beta1Condition(t *testing.T, from v1beta1conditions.Getter, conditi
 	}
 }
 
+func TestReconcileDeleteInfrastructureForMachinePoolSynthesizedMachine(t *testing.T) {
+	newInfraMachinePool := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":       "GenericInfrastructureMachinePool",
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"metadata": map[string]interface{}{
+				"name":      "mp-infra",
+				"namespace": metav1.NamespaceDefault,
+			},
+			"spec": map[string]interface{}{
+				"providerIDList": []interface{}{"test://id-1", "test://id-3"},
+			},
+		}}
+	}
+	newMachinePool := func() *clusterv1.MachinePool {
+		return &clusterv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mp",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: clusterv1.MachinePoolSpec{
+				Replicas: ptr.To[int32](2),
+			},
+		}
+	}
+
+	tests := []struct {
+		name                       string
+		providerID                 string
+		scaledDown                 bool
+		infraMachineIsNotFound     bool
+		expectScaledDownAnnotation bool
+		expectReplicas             int32
+		expectProviderIDList       []string
+	}{
+		{
+			name:                       "scales down the MachinePool and removes the providerID from the InfraMachinePool",
+			providerID:                 "test://id-1",
+			expectScaledDownAnnotation: true,
+			expectReplicas:             1,
+			expectProviderIDList:       []string{"test://id-3"},
+		},
+		{
+			name:                       "does not scale down the MachinePool twice",
+			providerID:                 "test://id-1",
+			scaledDown:                 true,
+			expectScaledDownAnnotation: true,
+			expectReplicas:             2,
+			expectProviderIDList:       []string{"test://id-3"},
+		},
+		{
+			name:                 "deleted when the providerID has been removed from the InfraMachinePool",
+			providerID:           "test://id-2",
+			expectReplicas:       2,
+			expectProviderIDList: []string{"test://id-1", "test://id-3"},
+		},
+		{
+			name:                   "deleted when the InfraMachinePool does not exist",
+			providerID:             "test://id-1",
+			infraMachineIsNotFound: true,
+			expectReplicas:         2,
+			expectProviderIDList:   []string{"test://id-1", "test://id-3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mp := newMachinePool()
+			infraMachinePool := newInfraMachinePool()
+			c := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(mp, infraMachinePool.DeepCopy()).Build()
+			r := &Reconciler{Client: c}
+			s := &scope{
+				machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "machine",
+						Namespace:       metav1.NamespaceDefault,
+						Annotations:     map[string]string{clusterv1.MachinePoolSynthesizedMachineAnnotation: ""},
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(mp, clusterv1.GroupVersion.WithKind("MachinePool"))},
+					},
+					Spec: clusterv1.MachineSpec{ProviderID: tt.providerID},
+				},
+				infraMachineIsNotFound: tt.infraMachineIsNotFound,
+			}
+			if tt.scaledDown {
+				s.machine.Annotations[clusterv1.MachinePoolScaledDownAnnotation] = ""
+			}
+			if !tt.infraMachineIsNotFound {
+				g.Expect(c.Get(ctx, client.ObjectKeyFromObject(infraMachinePool), infraMachinePool)).To(Succeed())
+				s.infraMachine = infraMachinePool
+			}
+
+			deleted, err := r.reconcileDeleteInfrastructure(ctx, s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(deleted).To(BeTrue())
+			if tt.expectScaledDownAnnotation {
+				g.Expect(s.machine.Annotations).To(HaveKey(clusterv1.MachinePoolScaledDownAnnotation))
+			} else {
+				g.Expect(s.machine.Annotations).ToNot(HaveKey(clusterv1.MachinePoolScaledDownAnnotation))
+			}
+
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(mp), mp)).To(Succeed())
+			g.Expect(mp.Spec.Replicas).To(Equal(ptr.To(tt.expectReplicas)))
+
+			// The InfraMachinePool is never deleted.
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(infraMachinePool), infraMachinePool)).To(Succeed())
+			providerIDList, _, err := unstructured.NestedStringSlice(infraMachinePool.Object, "spec", "providerIDList")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(providerIDList).To(Equal(tt.expectProviderIDList))
+		})
+	}
+}
+
 func podByNodeName(o client.Object) []string {
 	pod, ok := o.(*corev1.Pod)
 	if !ok {
@@ -3811,3 +4006,38 @@ func toUnstructured(obj client.Object) *unstructured.Unstructured {
 	unstructuredObj.SetDeletionTimestamp(obj.GetDeletionTimestamp())
 	return unstructuredObj
 }
+
+func TestMachinePriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine *clusterv1.Machine
+		want    *int
+	}{
+		{
+			name:    "Routine Machine keeps the priority of the event handler",
+			machine: &clusterv1.Machine{},
+			want:    nil,
+		},
+		{
+			name: "Deleting Machine has high priority",
+			machine: &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+				DeletionTimestamp: ptr.To(metav1.Now()),
+			}},
+			want: ptr.To(capicontrollerutil.HighPriority),
+		},
+		{
+			name: "Failed Machine has high priority",
+			machine: &clusterv1.Machine{Status: clusterv1.MachineStatus{
+				Phase: string(clusterv1.MachinePhaseFailed),
+			}},
+			want: ptr.To(capicontrollerutil.HighPriority),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(machinePriority(tt.machine)).To(Equal(tt.want))
+		})
+	}
+}
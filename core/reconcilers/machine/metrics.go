@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(volumesWaitingForDetach, machineStuckDeleting)
+	ctrlmetrics.Registry.MustRegister(machineProvisionDuration, machineBootstrapDuration, machineDrainDuration)
+}
+
+var (
+	volumesWaitingForDetach = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_machine_volumes_waiting_for_detach",
+			Help: "Number of volumes the deletion of a Machine is waiting to be detached from the Node.",
+		}, []string{
+			"cluster_name", "machine_name", "machine_namespace",
+		},
+	)
+
+	machineStuckDeleting = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_machine_stuck_deleting",
+			Help: "Whether a Machine has been deleting for longer than the configured threshold; the reason label reports the current deletion step.",
+		}, []string{
+			"cluster_name", "machine_name", "machine_namespace", "reason",
+		},
+	)
+
+	machineProvisionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "capi_machine_provision_duration_seconds",
+			Help:    "Duration from the creation of a Machine until its infrastructure has been provisioned.",
+			Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+
+	machineBootstrapDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "capi_machine_bootstrap_duration_seconds",
+			Help:    "Duration from the infrastructure of a Machine being provisioned until its Node joined the Cluster.",
+			Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+
+	machineDrainDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "capi_machine_drain_duration_seconds",
+			Help:    "Duration of the drain of the Node of a deleting Machine.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 14),
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+)
+
+// setVolumesWaitingForDetachMetric records the number of volumes the deletion of the Machine is waiting for.
+func setVolumesWaitingForDetachMetric(cluster *clusterv1.Cluster, machine *clusterv1.Machine, a *attachedVolumeInformation) {
+	volumesWaitingForDetach.WithLabelValues(cluster.Name, machine.Name, machine.Namespace).Set(float64(a.volumeCount()))
+}
+
+// deleteVolumesWaitingForDetachMetric deletes the metric for the Machine, e.g. once volumes are detached.
+func deleteVolumesWaitingForDetachMetric(machine *clusterv1.Machine) {
+	volumesWaitingForDetach.DeletePartialMatch(prometheus.Labels{
+		"machine_name":      machine.Name,
+		"machine_namespace": machine.Namespace,
+	})
+}
+
+// setMachineStuckDeletingMetric records that the deletion of the Machine is stuck at the step identified by reason.
+func setMachineStuckDeletingMetric(cluster *clusterv1.Cluster, machine *clusterv1.Machine, reason string) {
+	deleteMachineStuckDeletingMetric(machine.Namespace, machine.Name)
+	machineStuckDeleting.WithLabelValues(cluster.Name, machine.Name, machine.Namespace, reason).Set(1)
+}
+
+// deleteMachineStuckDeletingMetric deletes the metric for the Machine, e.g. once the Machine is gone.
+func deleteMachineStuckDeletingMetric(namespace, name string) {
+	machineStuckDeleting.DeletePartialMatch(prometheus.Labels{
+		"machine_name":      name,
+		"machine_namespace": namespace,
+	})
+}
+
+// observeLifecycleDurationMetrics observes the durations of the lifecycle phases the Machine completed during
+// the current reconcile, which are detected by comparing the Machine before and after the reconcile.
+// Durations are computed from the condition transition timestamps, so they are independent of reconcile delays.
+func observeLifecycleDurationMetrics(cluster *clusterv1.Cluster, original, machine *clusterv1.Machine, now time.Time) {
+	infrastructureReady := conditions.Get(machine, clusterv1.MachineInfrastructureReadyCondition)
+	if infrastructureReady != nil && infrastructureReady.Status != metav1.ConditionTrue {
+		infrastructureReady = nil
+	}
+
+	// The infrastructure has been provisioned for the first time.
+	if !ptr.Deref(original.Status.Initialization.InfrastructureProvisioned, false) &&
+		ptr.Deref(machine.Status.Initialization.InfrastructureProvisioned, false) {
+		provisionedTime := now
+		if infrastructureReady != nil {
+			provisionedTime = infrastructureReady.LastTransitionTime.Time
+		}
+		machineProvisionDuration.WithLabelValues(cluster.Name, cluster.Namespace).
+			Observe(provisionedTime.Sub(machine.CreationTimestamp.Time).Seconds())
+	}
+
+	// The Node joined the Cluster.
+	if !original.Status.NodeRef.IsDefined() && machine.Status.NodeRef.IsDefined() && infrastructureReady != nil {
+		machineBootstrapDuration.WithLabelValues(cluster.Name, cluster.Namespace).
+			Observe(now.Sub(infrastructureReady.LastTransitionTime.Time).Seconds())
+	}
+
+	// The drain of the Node completed.
+	if !v1beta1conditions.IsTrue(original, clusterv1.DrainingSucceededV1Beta1Condition) &&
+		v1beta1conditions.IsTrue(machine, clusterv1.DrainingSucceededV1Beta1Condition) &&
+		machine.Status.Deletion != nil && !machine.Status.Deletion.NodeDrainStartTime.IsZero() {
+		drainSucceeded := v1beta1conditions.Get(machine, clusterv1.DrainingSucceededV1Beta1Condition)
+		machineDrainDuration.WithLabelValues(cluster.Name, cluster.Namespace).
+			Observe(drainSucceeded.LastTransitionTime.Sub(machine.Status.Deletion.NodeDrainStartTime.Time).Seconds())
+	}
+}
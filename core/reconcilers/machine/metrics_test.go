@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestObserveLifecycleDurationMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now().Truncate(time.Second)
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-lifecycle-metrics", Namespace: metav1.NamespaceDefault}}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "machine",
+			Namespace:         metav1.NamespaceDefault,
+			CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+		},
+	}
+
+	// The infrastructure is provisioned.
+	original := machine.DeepCopy()
+	machine.Status.Initialization.InfrastructureProvisioned = ptr.To(true)
+	machine.Status.Conditions = []metav1.Condition{
+		{Type: clusterv1.MachineInfrastructureReadyCondition, Status: metav1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-4 * time.Minute))},
+	}
+	observeLifecycleDurationMetrics(cluster, original, machine, now)
+	g.Expect(histogramSample(g, machineProvisionDuration, cluster)).To(Equal(sample{count: 1, sum: 360}))
+	g.Expect(histogramSample(g, machineBootstrapDuration, cluster)).To(Equal(sample{}))
+
+	// Reconciling again doesn't observe the provisioning again.
+	observeLifecycleDurationMetrics(cluster, machine.DeepCopy(), machine, now)
+	g.Expect(histogramSample(g, machineProvisionDuration, cluster)).To(Equal(sample{count: 1, sum: 360}))
+
+	// The Node joins the Cluster.
+	original = machine.DeepCopy()
+	machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "node"}
+	observeLifecycleDurationMetrics(cluster, original, machine, now)
+	g.Expect(histogramSample(g, machineBootstrapDuration, cluster)).To(Equal(sample{count: 1, sum: 240}))
+
+	// The Node is drained.
+	machine.Status.Deletion = &clusterv1.MachineDeletionStatus{NodeDrainStartTime: metav1.NewTime(now.Add(-90 * time.Second))}
+	original = machine.DeepCopy()
+	machine.Status.Deprecated = &clusterv1.MachineDeprecatedStatus{V1Beta1: &clusterv1.MachineV1Beta1DeprecatedStatus{
+		Conditions: clusterv1.Conditions{
+			{Type: clusterv1.DrainingSucceededV1Beta1Condition, Status: "True", LastTransitionTime: metav1.NewTime(now)},
+		},
+	}}
+	observeLifecycleDurationMetrics(cluster, original, machine, now)
+	g.Expect(histogramSample(g, machineDrainDuration, cluster)).To(Equal(sample{count: 1, sum: 90}))
+}
+
+type sample struct {
+	count uint64
+	sum   float64
+}
+
+func histogramSample(g *WithT, h *prometheus.HistogramVec, cluster *clusterv1.Cluster) sample {
+	m := &dto.Metric{}
+	g.Expect(h.WithLabelValues(cluster.Name, cluster.Namespace).(prometheus.Metric).Write(m)).To(Succeed())
+	return sample{count: m.GetHistogram().GetSampleCount(), sum: m.GetHistogram().GetSampleSum()}
+}
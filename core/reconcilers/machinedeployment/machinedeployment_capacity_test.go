@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileCapacity(t *testing.T) {
+	infrastructureTemplate := func(capacity map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		u.SetKind("GenericInfrastructureMachineTemplate")
+		u.SetName("infra-template")
+		if capacity != nil {
+			u.Object["status"] = map[string]interface{}{"capacity": capacity}
+		}
+		return u
+	}
+
+	tests := []struct {
+		name                   string
+		annotations            map[string]string
+		capacity               corev1.ResourceList
+		infrastructureTemplate *unstructured.Unstructured
+		expectedAnnotations    map[string]string
+		expectedCapacity       corev1.ResourceList
+	}{
+		{
+			name:                   "capacity annotations are computed from the InfraMachineTemplate",
+			infrastructureTemplate: infrastructureTemplate(map[string]interface{}{"cpu": "2", "memory": "4Gi", "nvidia.com/gpu": "1"}),
+			expectedAnnotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation:      "2",
+				clusterv1.AutoscalerCapacityMemoryAnnotation:   "4Gi",
+				clusterv1.AutoscalerCapacityGPUTypeAnnotation:  "nvidia.com/gpu",
+				clusterv1.AutoscalerCapacityGPUCountAnnotation: "1",
+			},
+			expectedCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+				"nvidia.com/gpu":      resource.MustParse("1"),
+			},
+		},
+		{
+			name: "capacity annotations are updated when the capacity changes, preserving annotations set by the user",
+			annotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation:    "2",
+				clusterv1.AutoscalerCapacityMemoryAnnotation: "16Gi",
+			},
+			capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			infrastructureTemplate: infrastructureTemplate(map[string]interface{}{"cpu": "4", "memory": "8Gi"}),
+			expectedAnnotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation:    "4",
+				clusterv1.AutoscalerCapacityMemoryAnnotation: "16Gi",
+			},
+			expectedCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+		{
+			name: "the last known capacity is preserved when the InfraMachineTemplate does not report capacity",
+			annotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation: "2",
+			},
+			capacity:               corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			infrastructureTemplate: infrastructureTemplate(nil),
+			expectedAnnotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation: "2",
+			},
+			expectedCapacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+		{
+			name:                   "no-op if the InfraMachineTemplate has not been found",
+			infrastructureTemplate: nil,
+			expectedAnnotations:    nil,
+			expectedCapacity:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			md := &clusterv1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: metav1.NamespaceDefault, Annotations: tt.annotations},
+				Status:     clusterv1.MachineDeploymentStatus{Capacity: tt.capacity},
+			}
+
+			g.Expect(reconcileCapacity(ctx, md, tt.infrastructureTemplate)).To(Succeed())
+			g.Expect(md.Annotations).To(Equal(tt.expectedAnnotations))
+			g.Expect(md.Status.Capacity).To(BeComparableTo(tt.expectedCapacity))
+		})
+	}
+}
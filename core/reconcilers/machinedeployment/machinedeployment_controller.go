@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -53,6 +55,8 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 var (
@@ -86,6 +90,14 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	// ClusterRateLimiter limits the reconciles per second for the objects of a single Cluster.
+	// If nil, reconciles are not rate-limited per Cluster.
+	ClusterRateLimiter *capicontrollerutil.ClusterRateLimiter
+
 	controller capicontrollerutil.Controller
 	recorder   record.EventRecorder
 	ssaCache   ssa.Cache
@@ -117,6 +129,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			handler.EnqueueRequestsFromMapFunc(r.MachineSetToDeployments),
 		).
 		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithClusterRateLimiter(r.ClusterRateLimiter).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
@@ -158,6 +172,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 
 	log = log.WithValues("Cluster", klog.KRef(deployment.Namespace, deployment.Spec.ClusterName))
 	ctx = ctrl.LoggerInto(ctx, log)
+	tracing.SetClusterName(ctx, deployment.Spec.ClusterName)
 
 	cluster, err := util.GetClusterByName(ctx, r.Client, deployment.Namespace, deployment.Spec.ClusterName)
 	if err != nil {
@@ -216,7 +231,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		return ctrl.Result{}, r.reconcileDelete(ctx, s)
 	}
 
-	return ctrl.Result{}, r.reconcile(ctx, s)
+	if err := r.reconcile(ctx, s); err != nil {
+		return ctrl.Result{}, err
+	}
+	return rolloutAfterResult(deployment, time.Now()), nil
+}
+
+// rolloutAfterResult returns a result requeuing the MachineDeployment when spec.rollout.after expires, so the rollout
+// is triggered at the time defined by the user even if no other event triggers a reconcile in the meantime.
+func rolloutAfterResult(md *clusterv1.MachineDeployment, now time.Time) ctrl.Result {
+	if md.Spec.Rollout.After.IsZero() || !now.Before(md.Spec.Rollout.After.Time) {
+		return ctrl.Result{}
+	}
+	return ctrl.Result{RequeueAfter: md.Spec.Rollout.After.Sub(now)}
 }
 
 type scope struct {
@@ -228,6 +255,7 @@ type scope struct {
 	bootstrapTemplateExists                      bool
 	infrastructureTemplateNotFound               bool
 	infrastructureTemplateExists                 bool
+	infrastructureTemplate                       *unstructured.Unstructured
 	getAndAdoptMachineSetsForDeploymentSucceeded bool
 }
 
@@ -292,6 +320,10 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
 		return err
 	}
 
+	if err := reconcileCapacity(ctx, md, s.infrastructureTemplate); err != nil {
+		return err
+	}
+
 	if err := r.getAndAdoptMachineSetsForDeployment(ctx, s); err != nil {
 		return err
 	}
@@ -635,17 +667,19 @@ func (r *Reconciler) getTemplatesAndSetOwner(ctx context.Context, s *scope) erro
 	cluster := s.cluster
 
 	// Make sure to reconcile the external infrastructure reference.
-	if err := reconcileExternalTemplateReference(ctx, r.Client, cluster, md.Spec.Template.Spec.InfrastructureRef); err != nil {
+	infrastructureTemplate, err := reconcileExternalTemplateReference(ctx, r.Client, cluster, md.Spec.Template.Spec.InfrastructureRef)
+	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return err
 		}
 		s.infrastructureTemplateNotFound = true
 	} else {
 		s.infrastructureTemplateExists = true
+		s.infrastructureTemplate = infrastructureTemplate
 	}
 	// Make sure to reconcile the external bootstrap reference, if any.
 	if md.Spec.Template.Spec.Bootstrap.ConfigRef.IsDefined() {
-		if err := reconcileExternalTemplateReference(ctx, r.Client, cluster, md.Spec.Template.Spec.Bootstrap.ConfigRef); err != nil {
+		if _, err := reconcileExternalTemplateReference(ctx, r.Client, cluster, md.Spec.Template.Spec.Bootstrap.ConfigRef); err != nil {
 			if !apierrors.IsNotFound(err) {
 				return err
 			}
@@ -657,14 +691,14 @@ func (r *Reconciler) getTemplatesAndSetOwner(ctx context.Context, s *scope) erro
 	return nil
 }
 
-func reconcileExternalTemplateReference(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, ref clusterv1.ContractVersionedObjectReference) error {
+func reconcileExternalTemplateReference(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, ref clusterv1.ContractVersionedObjectReference) (*unstructured.Unstructured, error) {
 	if !strings.HasSuffix(ref.Kind, clusterv1.TemplateSuffix) {
-		return nil
+		return nil, nil
 	}
 
 	obj, err := external.GetObjectFromContractVersionedRef(ctx, c, ref, cluster.Namespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	desiredOwnerRef := metav1.OwnerReference{
@@ -675,10 +709,13 @@ func reconcileExternalTemplateReference(ctx context.Context, c client.Client, cl
 	}
 
 	if util.HasExactOwnerRef(obj.GetOwnerReferences(), desiredOwnerRef) {
-		return nil
+		return obj, nil
 	}
 
 	original := obj.DeepCopyObject().(client.Object)
 	obj.SetOwnerReferences(util.EnsureOwnerRef(obj.GetOwnerReferences(), desiredOwnerRef))
-	return c.Patch(ctx, obj, client.MergeFrom(original))
+	if err := c.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return nil, err
+	}
+	return obj, nil
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/client-go/util/retry"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -968,3 +970,42 @@ func TestReconciler_reconcileDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestRolloutAfterResult(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		rolloutAfter metav1.Time
+		want         ctrl.Result
+	}{
+		{
+			name: "no requeue if spec.rollout.after is not set",
+			want: ctrl.Result{},
+		},
+		{
+			name:         "no requeue if spec.rollout.after is expired",
+			rolloutAfter: metav1.NewTime(now.Add(-1 * time.Hour)),
+			want:         ctrl.Result{},
+		},
+		{
+			name:         "requeue when spec.rollout.after expires",
+			rolloutAfter: metav1.NewTime(now.Add(1 * time.Hour)),
+			want:         ctrl.Result{RequeueAfter: 1 * time.Hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			md := &clusterv1.MachineDeployment{
+				Spec: clusterv1.MachineDeploymentSpec{
+					Rollout: clusterv1.MachineDeploymentRolloutSpec{
+						After: tt.rolloutAfter,
+					},
+				},
+			}
+			g.Expect(rolloutAfterResult(md, now)).To(Equal(tt.want))
+		})
+	}
+}
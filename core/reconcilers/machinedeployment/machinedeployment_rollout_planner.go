@@ -326,11 +326,13 @@ func computeDesiredMS(ctx context.Context, deployment *clusterv1.MachineDeployme
 	// Set all other in-place mutable fields.
 	desiredMS.Spec.Deletion.Order = deployment.Spec.Deletion.Order
 	desiredMS.Spec.MachineNaming = deployment.Spec.MachineNaming
+	desiredMS.Spec.Placement = deployment.Spec.Placement
 	desiredMS.Spec.Template.Spec.MinReadySeconds = deployment.Spec.Template.Spec.MinReadySeconds
 	desiredMS.Spec.Template.Spec.ReadinessGates = deployment.Spec.Template.Spec.ReadinessGates
 	desiredMS.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds
 	desiredMS.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 	desiredMS.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
+	desiredMS.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = deployment.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
 	desiredMS.Spec.Template.Spec.Taints = deployment.Spec.Template.Spec.Taints
 
 	return desiredMS, nil
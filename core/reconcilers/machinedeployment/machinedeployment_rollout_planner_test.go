@@ -261,6 +261,9 @@ func TestComputeDesiredMS(t *testing.T) {
 			MachineNaming: clusterv1.MachineNamingSpec{
 				Template: "{{ .machineSet.name }}" + namingTemplateKey + "-{{ .random }}",
 			},
+			Placement: clusterv1.MachinePlacementSpec{
+				FailureDomains: []string{"fd1", "fd2"},
+			},
 			Selector: metav1.LabelSelector{
 				MatchLabels: map[string]string{"k1": "v1"},
 			},
@@ -312,6 +315,7 @@ func TestComputeDesiredMS(t *testing.T) {
 			Selector:      deployment.Spec.Selector,
 			Template:      *deployment.Spec.Template.DeepCopy(),
 			MachineNaming: deployment.Spec.MachineNaming,
+			Placement:     deployment.Spec.Placement,
 		},
 	}
 
@@ -395,6 +399,7 @@ func TestComputeDesiredMS(t *testing.T) {
 		// Fields that must be taken from the MD
 		expectedMS.Spec.Deletion.Order = deployment.Spec.Deletion.Order
 		expectedMS.Spec.MachineNaming = deployment.Spec.MachineNaming
+		expectedMS.Spec.Placement = deployment.Spec.Placement
 		expectedMS.Spec.Template.Labels = mdutil.CloneAndAddLabel(deployment.Spec.Template.Labels, clusterv1.MachineDeploymentUniqueLabel, uniqueLabelValue)
 		expectedMS.Spec.Template.Annotations = cloneStringMap(deployment.Spec.Template.Annotations)
 		expectedMS.Spec.Template.Spec.MinReadySeconds = deployment.Spec.Template.Spec.MinReadySeconds
@@ -478,6 +483,9 @@ func assertDesiredMS(g *WithT, md *clusterv1.MachineDeployment, actualMS *cluste
 	// Check Order
 	g.Expect(actualMS.Spec.Deletion.Order).Should(Equal(expectedMS.Spec.Deletion.Order))
 
+	// Check Placement
+	g.Expect(actualMS.Spec.Placement).Should(BeComparableTo(expectedMS.Spec.Placement))
+
 	// Check MachineTemplateSpec
 	g.Expect(actualMS.Spec.Template.Spec).Should(BeComparableTo(expectedMS.Spec.Template.Spec))
 
@@ -198,6 +198,14 @@ func (p *rolloutPlanner) reconcileNewMachineSet(ctx context.Context) error {
 		return err
 	}
 
+	// If the rollout is waiting for the canary to be approved, do not scale up the newMS above the canary replicas.
+	if canaryReplicas, waiting := p.waitingForCanaryApproval(); waiting {
+		if maxReplicasCount := max(canaryReplicas, *(p.newMS.Spec.Replicas)); newReplicasCount > maxReplicasCount {
+			newReplicasCount = maxReplicasCount
+			note = fmt.Sprintf("%d canary replicas, waiting for approval of revision %s", canaryReplicas, p.revision)
+		}
+	}
+
 	if newReplicasCount < *(p.newMS.Spec.Replicas) {
 		scaleDownCount := *(p.newMS.Spec.Replicas) - newReplicasCount
 		p.addNotef(p.newMS, "%s", note)
@@ -259,6 +267,12 @@ func (p *rolloutPlanner) reconcileOldMachineSetsRollingUpdate(ctx context.Contex
 	// will make additional checks to ensure scale down actually happens without breaching MaxUnavailable, and
 	// if necessary, it will reduce the extent of the scale down accordingly.
 	totalScaleDownCount := max(totalSpecReplicas-totalPendingScaleDown-minAvailable-newMSUnavailableMachineCount, 0)
+
+	// If the rollout is waiting for the canary to be approved, scale down oldMSs only to make room for the canary replicas.
+	if canaryReplicas, waiting := p.waitingForCanaryApproval(); waiting {
+		totalScaleDownCount = min(totalScaleDownCount, max(mdutil.GetReplicaCountForMachineSets(p.oldMSs)-(ptr.Deref(p.md.Spec.Replicas, 0)-canaryReplicas), 0))
+	}
+
 	if totalScaleDownCount <= 0 {
 		return nil
 	}
@@ -293,6 +307,25 @@ func (p *rolloutPlanner) reconcileOldMachineSetsRollingUpdate(ctx context.Contex
 	return nil
 }
 
+// waitingForCanaryApproval returns true if the MachineDeployment is configured with a canary and the revision being
+// rolled out is not yet approved; in this case the rollout must not progress after creating the canary replicas,
+// which are returned as well.
+func (p *rolloutPlanner) waitingForCanaryApproval() (int32, bool) {
+	if p.md.Spec.Rollout.Canary.Replicas == nil {
+		return 0, false
+	}
+
+	// No op if there is no rollout in progress, e.g. when the MachineDeployment is created.
+	if mdutil.GetReplicaCountForMachineSets(p.oldMSs) == 0 {
+		return 0, false
+	}
+
+	if p.md.Annotations[clusterv1.MachineDeploymentCanaryApprovedRevisionAnnotation] == p.revision {
+		return 0, false
+	}
+	return min(*p.md.Spec.Rollout.Canary.Replicas, ptr.Deref(p.md.Spec.Replicas, 0)), true
+}
+
 func (p *rolloutPlanner) scaleDownOldMSs(ctx context.Context, totalScaleDownCount, totalAvailableReplicas, minAvailable int32, scaleDownOnlyUnavailableReplicas bool) (int32, int32) {
 	log := ctrl.LoggerFrom(ctx)
 
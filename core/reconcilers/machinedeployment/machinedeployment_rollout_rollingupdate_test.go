@@ -146,9 +146,56 @@ func TestReconcileReplicasPendingAcknowledgeMove(t *testing.T) {
 }
 
 func TestReconcileNewMachineSet(t *testing.T) {
+	canaryMachineDeployment := func(approvedRevision string) *clusterv1.MachineDeployment {
+		md := &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+			},
+			Spec: clusterv1.MachineDeploymentSpec{
+				Rollout: clusterv1.MachineDeploymentRolloutSpec{
+					Strategy: clusterv1.MachineDeploymentRolloutStrategy{
+						Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+						RollingUpdate: clusterv1.MachineDeploymentRolloutStrategyRollingUpdate{
+							MaxUnavailable: intOrStrPtr(0),
+							MaxSurge:       intOrStrPtr(2),
+						},
+					},
+					Canary: clusterv1.MachineDeploymentRolloutCanary{
+						Replicas: ptr.To[int32](1),
+					},
+				},
+				Replicas: ptr.To[int32](3),
+			},
+		}
+		if approvedRevision != "" {
+			md.Annotations = map[string]string{
+				clusterv1.MachineDeploymentCanaryApprovedRevisionAnnotation: approvedRevision,
+			}
+		}
+		return md
+	}
+	canaryOldMachineSets := func() []*clusterv1.MachineSet {
+		return []*clusterv1.MachineSet{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo",
+					Name:      "3replicas",
+				},
+				Spec: clusterv1.MachineSetSpec{
+					Replicas: ptr.To[int32](3),
+				},
+				Status: clusterv1.MachineSetStatus{
+					Replicas: ptr.To[int32](3),
+				},
+			},
+		}
+	}
+
 	testCases := []struct {
 		name                          string
 		machineDeployment             *clusterv1.MachineDeployment
+		revision                      string
 		newMachineSet                 *clusterv1.MachineSet
 		oldMachineSets                []*clusterv1.MachineSet
 		expectedNewMachineSetReplicas int
@@ -266,6 +313,40 @@ func TestReconcileNewMachineSet(t *testing.T) {
 			error:         nil,
 			expectedNotes: []string{"4 current Machines < 3 MachineDeployment spec.replicas + 2 maxSurge"},
 		},
+		{
+			name:              "RollingUpdate strategy: Scale up does not go above canary replicas if the revision is not approved",
+			machineDeployment: canaryMachineDeployment("1"),
+			revision:          "2",
+			newMachineSet: &clusterv1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo",
+					Name:      "bar",
+				},
+				Spec: clusterv1.MachineSetSpec{
+					Replicas: ptr.To[int32](0),
+				},
+			},
+			oldMachineSets:                canaryOldMachineSets(),
+			expectedNewMachineSetReplicas: 1,
+			expectedNotes:                 []string{"1 canary replicas, waiting for approval of revision 2"},
+		},
+		{
+			name:              "RollingUpdate strategy: Scale up goes above canary replicas if the revision is approved",
+			machineDeployment: canaryMachineDeployment("2"),
+			revision:          "2",
+			newMachineSet: &clusterv1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo",
+					Name:      "bar",
+				},
+				Spec: clusterv1.MachineSetSpec{
+					Replicas: ptr.To[int32](1),
+				},
+			},
+			oldMachineSets:                canaryOldMachineSets(),
+			expectedNewMachineSetReplicas: 2,
+			expectedNotes:                 []string{"4 current Machines < 3 MachineDeployment spec.replicas + 2 maxSurge"},
+		},
 		{
 			name: "RollingUpdate strategy: Scale up accounts for deleting Machines to honour maxSurge",
 			machineDeployment: &clusterv1.MachineDeployment{
@@ -357,6 +438,7 @@ func TestReconcileNewMachineSet(t *testing.T) {
 
 			planner := newRolloutPlanner(nil, nil, nil)
 			planner.md = tc.machineDeployment
+			planner.revision = tc.revision
 			planner.newMS = tc.newMachineSet
 			planner.oldMSs = tc.oldMachineSets
 
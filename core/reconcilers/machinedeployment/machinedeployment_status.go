@@ -19,6 +19,8 @@ package machinedeployment
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -33,6 +35,8 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machinedeployment/mdutil"
 	internalversion "sigs.k8s.io/cluster-api/internal/util/version"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	clog "sigs.k8s.io/cluster-api/util/log"
@@ -56,9 +60,11 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) (retErr error)
 		setReplicas(s.machineDeployment, s.machineSets)
 	}
 	setPhase(ctx, s.machineDeployment, s.machineSets, s.getAndAdoptMachineSetsForDeploymentSucceeded)
+	setAutoscaling(s.machineDeployment)
 
 	setAvailableCondition(ctx, s.machineDeployment, s.getAndAdoptMachineSetsForDeploymentSucceeded)
 
+	setRolloutStatus(ctx, s.machineDeployment, s.machineSets, s.machines, s.getAndAdoptMachineSetsForDeploymentSucceeded)
 	setRollingOutCondition(ctx, s.machineDeployment, s.machines)
 	setScalingUpCondition(ctx, s.machineDeployment, s.machineSets, s.bootstrapTemplateNotFound, s.infrastructureTemplateNotFound, s.getAndAdoptMachineSetsForDeploymentSucceeded)
 	setScalingDownCondition(ctx, s.machineDeployment, s.machineSets, s.machines, s.getAndAdoptMachineSetsForDeploymentSucceeded)
@@ -85,6 +91,17 @@ func setReplicas(machineDeployment *clusterv1.MachineDeployment, machineSets []*
 	machineDeployment.Status.AvailableReplicas = mdutil.GetAvailableReplicaCountForMachineSets(machineSets)
 	machineDeployment.Status.UpToDateReplicas = mdutil.GetUptoDateReplicaCountForMachineSets(machineSets)
 	machineDeployment.Status.Versions = versionsFromMachineSets(machineSets)
+	machineDeployment.Status.FailureDomains = failureDomainsFromMachineSets(machineSets)
+	// Note: Node capabilities are preserved when there are no MachineSets with node capabilities, so they can still be used
+	// e.g. for autoscaling from zero.
+	if nodeCapabilities, ok := nodeCapabilitiesFromMachineSets(machineSets); ok {
+		machineDeployment.Status.NodeCapabilities = nodeCapabilities
+	}
+}
+
+// setAutoscaling sets the status of the cluster autoscaler node group of the MachineDeployment.
+func setAutoscaling(machineDeployment *clusterv1.MachineDeployment) {
+	machineDeployment.Status.Autoscaling = annotations.GetAutoscalerNodeGroupStatus(machineDeployment, machineDeployment.Spec.Replicas)
 }
 
 func versionsFromMachineSets(machineSets []*clusterv1.MachineSet) []clusterv1.StatusVersion {
@@ -99,6 +116,155 @@ func versionsFromMachineSets(machineSets []*clusterv1.MachineSet) []clusterv1.St
 	return internalversion.AggregateStatusVersions(versions)
 }
 
+// failureDomainsFromMachineSets returns the number of replicas in each failure domain aggregated from the MachineSets,
+// sorted by failure domain name.
+func failureDomainsFromMachineSets(machineSets []*clusterv1.MachineSet) []clusterv1.StatusFailureDomain {
+	counts := map[string]int32{}
+	for _, ms := range machineSets {
+		if ms == nil {
+			continue
+		}
+		for _, failureDomain := range ms.Status.FailureDomains {
+			counts[failureDomain.Name] += failureDomain.Replicas
+		}
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+
+	failureDomains := make([]clusterv1.StatusFailureDomain, 0, len(counts))
+	for _, name := range slices.Sorted(maps.Keys(counts)) {
+		failureDomains = append(failureDomains, clusterv1.StatusFailureDomain{
+			Name:     name,
+			Replicas: counts[name],
+		})
+	}
+	return failureDomains
+}
+
+// nodeCapabilitiesFromMachineSets returns the node capabilities of the most recently created MachineSet which reports
+// node capabilities; it returns false if there are no such MachineSets.
+// Note: MachineSets preserve node capabilities when scaled to zero, so the node capabilities of old MachineSets are
+// used until the new MachineSet has at least one Machine with a Node.
+func nodeCapabilitiesFromMachineSets(machineSets []*clusterv1.MachineSet) (clusterv1.MachineNodeCapabilities, bool) {
+	var newest *clusterv1.MachineSet
+	for _, ms := range machineSets {
+		if ms == nil || ms.Status.NodeCapabilities == (clusterv1.MachineNodeCapabilities{}) {
+			continue
+		}
+		if newest == nil || newest.CreationTimestamp.Before(&ms.CreationTimestamp) {
+			newest = ms
+		}
+	}
+	if newest == nil {
+		return clusterv1.MachineNodeCapabilities{}, false
+	}
+	return *newest.Status.NodeCapabilities.DeepCopy(), true
+}
+
+// setRolloutStatus surfaces the progress of the rollout, i.e. the replicas of the new MachineSet and of the old MachineSets
+// and the estimated completion time.
+func setRolloutStatus(_ context.Context, machineDeployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet, machines collections.Machines, getAndAdoptMachineSetsForDeploymentSucceeded bool) {
+	// If we got unexpected errors in listing the machine sets (this should never happen), keep the current rollout status.
+	if !getAndAdoptMachineSetsForDeploymentSucceeded {
+		return
+	}
+
+	msList := make([]*clusterv1.MachineSet, 0, len(machineSets))
+	for _, ms := range machineSets {
+		if ms != nil {
+			msList = append(msList, ms)
+		}
+	}
+	newMS, oldMSs, _, _ := mdutil.FindNewAndOldMachineSets(machineDeployment, msList, metav1.Now())
+
+	desiredReplicas := ptr.Deref(machineDeployment.Spec.Replicas, 0)
+	rollout := clusterv1.MachineDeploymentRolloutStatus{
+		NewReplicas: ptr.To[int32](0),
+		OldReplicas: ptr.To[int32](0),
+	}
+	if newMS != nil {
+		replicas := ptr.Deref(newMS.Status.Replicas, 0)
+		phase := clusterv1.MachineDeploymentRolloutMachineSetPhaseStable
+		switch {
+		case replicas < desiredReplicas:
+			phase = clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingUp
+		case replicas > desiredReplicas:
+			phase = clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingDown
+		}
+		rollout.NewReplicas = ptr.To(replicas)
+		rollout.MachineSets = append(rollout.MachineSets, clusterv1.MachineDeploymentRolloutMachineSetStatus{
+			Name:              newMS.Name,
+			Phase:             phase,
+			Replicas:          newMS.Status.Replicas,
+			AvailableReplicas: newMS.Status.AvailableReplicas,
+		})
+	}
+	for _, ms := range oldMSs {
+		replicas := ptr.Deref(ms.Status.Replicas, 0)
+		if replicas == 0 && ptr.Deref(ms.Spec.Replicas, 0) == 0 {
+			continue
+		}
+		*rollout.OldReplicas += replicas
+		rollout.MachineSets = append(rollout.MachineSets, clusterv1.MachineDeploymentRolloutMachineSetStatus{
+			Name:              ms.Name,
+			Phase:             clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingDown,
+			Replicas:          ms.Status.Replicas,
+			AvailableReplicas: ms.Status.AvailableReplicas,
+		})
+	}
+	// Note: this should never happen, because old MachineSets without replicas are not surfaced.
+	if len(rollout.MachineSets) > 100 {
+		rollout.MachineSets = rollout.MachineSets[:100]
+	}
+
+	if newMS != nil && *rollout.OldReplicas > 0 {
+		rollout.EstimatedCompletionTime = estimateRolloutCompletionTime(machineDeployment, newMS, machines, desiredReplicas)
+	}
+
+	machineDeployment.Status.Rollout = rollout
+}
+
+// estimateRolloutCompletionTime estimates the time a rollout completes based on the pace at which Machines of the new
+// MachineSet became available since the rollout started, i.e. since the RollingOut condition became true.
+// Note: the estimate only depends on timestamps stored in conditions, so it does not change at every reconcile.
+func estimateRolloutCompletionTime(machineDeployment *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet, machines collections.Machines, desiredReplicas int32) metav1.Time {
+	rollingOutCondition := conditions.Get(machineDeployment, clusterv1.MachineDeploymentRollingOutCondition)
+	if rollingOutCondition == nil || rollingOutCondition.Status != metav1.ConditionTrue {
+		return metav1.Time{}
+	}
+	start := rollingOutCondition.LastTransitionTime.Time
+
+	// Count Machines of the new MachineSet which became available since the rollout started, and
+	// Machines which were already available before (e.g. canary Machines of a previous reconcile).
+	var availableBefore, availableSince int32
+	var lastAvailable time.Time
+	for _, machine := range machines {
+		if !util.IsControlledBy(machine, newMS, clusterv1.GroupVersion.WithKind("MachineSet").GroupKind()) {
+			continue
+		}
+		availableCondition := conditions.Get(machine, clusterv1.MachineAvailableCondition)
+		if availableCondition == nil || availableCondition.Status != metav1.ConditionTrue {
+			continue
+		}
+		if availableCondition.LastTransitionTime.Time.Before(start) {
+			availableBefore++
+			continue
+		}
+		availableSince++
+		if availableCondition.LastTransitionTime.Time.After(lastAvailable) {
+			lastAvailable = availableCondition.LastTransitionTime.Time
+		}
+	}
+
+	replicasToRollOut := desiredReplicas - availableBefore
+	if availableSince == 0 || replicasToRollOut <= 0 {
+		return metav1.Time{}
+	}
+	pace := lastAvailable.Sub(start) / time.Duration(availableSince)
+	return metav1.NewTime(start.Add(pace * time.Duration(replicasToRollOut)).Truncate(time.Second))
+}
+
 func setPhase(_ context.Context, machineDeployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet, getAndAdoptMachineSetsForDeploymentSucceeded bool) {
 	if !getAndAdoptMachineSetsForDeploymentSucceeded || machineDeployment.Spec.Replicas == nil {
 		machineDeployment.Status.Phase = string(clusterv1.MachineDeploymentPhaseUnknown)
@@ -233,10 +399,27 @@ func setRollingOutCondition(_ context.Context, machineDeployment *clusterv1.Mach
 		})
 		message += fmt.Sprintf("\n%s", strings.Join(reasons, "\n"))
 	}
+
+	// Surface the progress of the rollout.
+	if rollout := machineDeployment.Status.Rollout; rollout.NewReplicas != nil && rollout.OldReplicas != nil {
+		message += fmt.Sprintf("\n* New MachineSet has %d replicas, old MachineSets have %d replicas", *rollout.NewReplicas, *rollout.OldReplicas)
+		if !rollout.EstimatedCompletionTime.IsZero() {
+			message += fmt.Sprintf(", estimated completion at %s", rollout.EstimatedCompletionTime.UTC().Format(time.RFC3339))
+		}
+	}
+
+	// Surface if the rollout is waiting for the canary to be approved.
+	reason := clusterv1.MachineDeploymentRollingOutReason
+	if revision := machineDeployment.Annotations[clusterv1.RevisionAnnotation]; machineDeployment.Spec.Rollout.Canary.Replicas != nil &&
+		machineDeployment.Annotations[clusterv1.MachineDeploymentCanaryApprovedRevisionAnnotation] != revision {
+		reason = clusterv1.MachineDeploymentRollingOutWaitingForCanaryApprovalReason
+		message += fmt.Sprintf("\n* Waiting for approval of canary revision %s, set the %s annotation to %q to resume the rollout",
+			revision, clusterv1.MachineDeploymentCanaryApprovedRevisionAnnotation, revision)
+	}
 	conditions.Set(machineDeployment, metav1.Condition{
 		Type:    clusterv1.MachineDeploymentRollingOutCondition,
 		Status:  metav1.ConditionTrue,
-		Reason:  clusterv1.MachineDeploymentRollingOutReason,
+		Reason:  reason,
 		Message: message,
 	})
 }
@@ -39,6 +39,8 @@ func Test_setReplicas(t *testing.T) {
 		expectAvailableReplicas *int32
 		expectUpToDateReplicas  *int32
 		expectVersions          []clusterv1.StatusVersion
+		expectFailureDomains    []clusterv1.StatusFailureDomain
+		expectNodeCapabilities  clusterv1.MachineNodeCapabilities
 	}{
 		{
 			name:                    "No MachineSets",
@@ -88,6 +90,35 @@ func Test_setReplicas(t *testing.T) {
 				{Version: "v1.32.0", Replicas: 5},
 			},
 		},
+		{
+			name: "MachineSets with failure domains",
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms1", withStatusReplicas(3), withStatusFailureDomains(clusterv1.StatusFailureDomain{Name: "fd1", Replicas: 2}, clusterv1.StatusFailureDomain{Name: "fd2", Replicas: 1})),
+				fakeMachineSet("ms2", withStatusReplicas(2), withStatusFailureDomains(clusterv1.StatusFailureDomain{Name: "fd2", Replicas: 1}, clusterv1.StatusFailureDomain{Name: "fd0", Replicas: 1})),
+			},
+			expectReplicas:          5,
+			expectReadyReplicas:     nil,
+			expectAvailableReplicas: nil,
+			expectUpToDateReplicas:  nil,
+			expectFailureDomains: []clusterv1.StatusFailureDomain{
+				{Name: "fd0", Replicas: 1},
+				{Name: "fd1", Replicas: 2},
+				{Name: "fd2", Replicas: 2},
+			},
+		},
+		{
+			name: "MachineSets with node capabilities",
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms1", withMSCreationTimestamp(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)), withStatusNodeCapabilities(clusterv1.MachineNodeCapabilities{Architecture: "amd64"})),
+				fakeMachineSet("ms2", withMSCreationTimestamp(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)), withStatusNodeCapabilities(clusterv1.MachineNodeCapabilities{Architecture: "arm64"})),
+				fakeMachineSet("ms3", withMSCreationTimestamp(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC))),
+			},
+			expectReplicas:          0,
+			expectReadyReplicas:     nil,
+			expectAvailableReplicas: nil,
+			expectUpToDateReplicas:  nil,
+			expectNodeCapabilities:  clusterv1.MachineNodeCapabilities{Architecture: "arm64"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -100,6 +131,8 @@ func Test_setReplicas(t *testing.T) {
 			g.Expect(md.Status.AvailableReplicas).To(Equal(tt.expectAvailableReplicas))
 			g.Expect(md.Status.UpToDateReplicas).To(Equal(tt.expectUpToDateReplicas))
 			g.Expect(md.Status.Versions).To(Equal(tt.expectVersions))
+			g.Expect(md.Status.FailureDomains).To(Equal(tt.expectFailureDomains))
+			g.Expect(md.Status.NodeCapabilities).To(Equal(tt.expectNodeCapabilities))
 		})
 	}
 }
@@ -331,6 +364,70 @@ func Test_setRollingOutCondition(t *testing.T) {
 					"* InfrastructureMachine is not up-to-date",
 			},
 		},
+		{
+			name: "not up-to-date machines, waiting for canary approval",
+			machineDeployment: &clusterv1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						clusterv1.RevisionAnnotation:                                "2",
+						clusterv1.MachineDeploymentCanaryApprovedRevisionAnnotation: "1",
+					},
+				},
+				Spec: clusterv1.MachineDeploymentSpec{
+					Rollout: clusterv1.MachineDeploymentRolloutSpec{
+						Canary: clusterv1.MachineDeploymentRolloutCanary{
+							Replicas: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+			machines: []*clusterv1.Machine{
+				fakeMachine("machine-1", withCondition(upToDateCondition)),
+				fakeMachine("machine-2", withCondition(metav1.Condition{
+					Type:    clusterv1.MachineUpToDateCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  clusterv1.MachineNotUpToDateReason,
+					Message: "* Version v1.25.0, v1.26.0 required",
+				})),
+			},
+			expectCondition: metav1.Condition{
+				Type:   clusterv1.MachineDeploymentRollingOutCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineDeploymentRollingOutWaitingForCanaryApprovalReason,
+				Message: "Rolling out 1 not up-to-date replicas\n" +
+					"* Version v1.25.0, v1.26.0 required\n" +
+					"* Waiting for approval of canary revision 2, set the machinedeployment.cluster.x-k8s.io/canary-approved-revision annotation to \"2\" to resume the rollout",
+			},
+		},
+		{
+			name: "not up-to-date machines, rollout progress reported",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Status: clusterv1.MachineDeploymentStatus{
+					Rollout: clusterv1.MachineDeploymentRolloutStatus{
+						NewReplicas:             ptr.To[int32](2),
+						OldReplicas:             ptr.To[int32](1),
+						EstimatedCompletionTime: metav1.NewTime(time.Date(2026, time.January, 1, 10, 15, 0, 0, time.UTC)),
+					},
+				},
+			},
+			machines: []*clusterv1.Machine{
+				fakeMachine("machine-1", withCondition(upToDateCondition)),
+				fakeMachine("machine-2", withCondition(metav1.Condition{
+					Type:    clusterv1.MachineUpToDateCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  clusterv1.MachineNotUpToDateReason,
+					Message: "* Version v1.25.0, v1.26.0 required",
+				})),
+			},
+			expectCondition: metav1.Condition{
+				Type:   clusterv1.MachineDeploymentRollingOutCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineDeploymentRollingOutReason,
+				Message: "Rolling out 1 not up-to-date replicas\n" +
+					"* Version v1.25.0, v1.26.0 required\n" +
+					"* New MachineSet has 2 replicas, old MachineSets have 1 replicas, estimated completion at 2026-01-01T10:15:00Z",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -349,6 +446,155 @@ func Test_setRollingOutCondition(t *testing.T) {
 	}
 }
 
+func Test_setRolloutStatus(t *testing.T) {
+	rolloutStart := time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC)
+	rollingOutCondition := metav1.Condition{
+		Type:               clusterv1.MachineDeploymentRollingOutCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             clusterv1.MachineDeploymentRollingOutReason,
+		LastTransitionTime: metav1.NewTime(rolloutStart),
+	}
+	availableAt := func(t time.Time) fakeMachinesOption {
+		return withCondition(metav1.Condition{
+			Type:               clusterv1.MachineAvailableCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             clusterv1.MachineAvailableReason,
+			LastTransitionTime: metav1.NewTime(t),
+		})
+	}
+
+	tests := []struct {
+		name                                         string
+		machineDeploymentConditions                  []metav1.Condition
+		machineSets                                  []*clusterv1.MachineSet
+		machines                                     []*clusterv1.Machine
+		getAndAdoptMachineSetsForDeploymentSucceeded bool
+		expectRollout                                clusterv1.MachineDeploymentRolloutStatus
+	}{
+		{
+			name: "get machine sets failed",
+			getAndAdoptMachineSetsForDeploymentSucceeded: false,
+			expectRollout: clusterv1.MachineDeploymentRolloutStatus{},
+		},
+		{
+			name: "no machine sets",
+			getAndAdoptMachineSetsForDeploymentSucceeded: true,
+			expectRollout: clusterv1.MachineDeploymentRolloutStatus{
+				NewReplicas: ptr.To[int32](0),
+				OldReplicas: ptr.To[int32](0),
+			},
+		},
+		{
+			name: "no rollout in progress",
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms-new", withSpecVersion("v1.32.0"), withSpecReplicas(3), withStatusReplicas(3), withStatusV1beta2AvailableReplicas(3)),
+				fakeMachineSet("ms-old", withSpecVersion("v1.31.0"), withSpecReplicas(0), withStatusReplicas(0)),
+			},
+			getAndAdoptMachineSetsForDeploymentSucceeded: true,
+			expectRollout: clusterv1.MachineDeploymentRolloutStatus{
+				NewReplicas: ptr.To[int32](3),
+				OldReplicas: ptr.To[int32](0),
+				MachineSets: []clusterv1.MachineDeploymentRolloutMachineSetStatus{
+					{Name: "ms-new", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseStable, Replicas: ptr.To[int32](3), AvailableReplicas: ptr.To[int32](3)},
+				},
+			},
+		},
+		{
+			name:                        "rollout in progress, rollout just started",
+			machineDeploymentConditions: []metav1.Condition{rollingOutCondition},
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms-new", withSpecVersion("v1.32.0"), withSpecReplicas(1), withStatusReplicas(1), withStatusV1beta2AvailableReplicas(0)),
+				fakeMachineSet("ms-old", withSpecVersion("v1.31.0"), withSpecReplicas(3), withStatusReplicas(3), withStatusV1beta2AvailableReplicas(3)),
+			},
+			machines: []*clusterv1.Machine{
+				fakeMachine("ms-new-1", withOwnerMachineSet("ms-new")),
+			},
+			getAndAdoptMachineSetsForDeploymentSucceeded: true,
+			expectRollout: clusterv1.MachineDeploymentRolloutStatus{
+				NewReplicas: ptr.To[int32](1),
+				OldReplicas: ptr.To[int32](3),
+				MachineSets: []clusterv1.MachineDeploymentRolloutMachineSetStatus{
+					{Name: "ms-new", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingUp, Replicas: ptr.To[int32](1), AvailableReplicas: ptr.To[int32](0)},
+					{Name: "ms-old", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingDown, Replicas: ptr.To[int32](3), AvailableReplicas: ptr.To[int32](3)},
+				},
+			},
+		},
+		{
+			name:                        "rollout in progress, completion time estimated from the pace of new Machines becoming available",
+			machineDeploymentConditions: []metav1.Condition{rollingOutCondition},
+			machineSets: []*clusterv1.MachineSet{
+				nil,
+				fakeMachineSet("ms-new", withSpecVersion("v1.32.0"), withSpecReplicas(3), withStatusReplicas(3), withStatusV1beta2AvailableReplicas(2)),
+				fakeMachineSet("ms-old", withSpecVersion("v1.31.0"), withSpecReplicas(1), withStatusReplicas(1), withStatusV1beta2AvailableReplicas(1)),
+			},
+			machines: []*clusterv1.Machine{
+				fakeMachine("ms-new-1", withOwnerMachineSet("ms-new"), availableAt(rolloutStart.Add(5*time.Minute))),
+				fakeMachine("ms-new-2", withOwnerMachineSet("ms-new"), availableAt(rolloutStart.Add(10*time.Minute))),
+				fakeMachine("ms-new-3", withOwnerMachineSet("ms-new")),
+				fakeMachine("ms-old-1", withOwnerMachineSet("ms-old"), availableAt(rolloutStart.Add(-1*time.Hour))),
+			},
+			getAndAdoptMachineSetsForDeploymentSucceeded: true,
+			expectRollout: clusterv1.MachineDeploymentRolloutStatus{
+				NewReplicas: ptr.To[int32](3),
+				OldReplicas: ptr.To[int32](1),
+				MachineSets: []clusterv1.MachineDeploymentRolloutMachineSetStatus{
+					{Name: "ms-new", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseStable, Replicas: ptr.To[int32](3), AvailableReplicas: ptr.To[int32](2)},
+					{Name: "ms-old", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingDown, Replicas: ptr.To[int32](1), AvailableReplicas: ptr.To[int32](1)},
+				},
+				EstimatedCompletionTime: metav1.NewTime(rolloutStart.Add(15 * time.Minute)),
+			},
+		},
+		{
+			name:                        "rollout in progress, Machines available before the rollout started are not considered for the pace",
+			machineDeploymentConditions: []metav1.Condition{rollingOutCondition},
+			machineSets: []*clusterv1.MachineSet{
+				fakeMachineSet("ms-new", withSpecVersion("v1.32.0"), withSpecReplicas(2), withStatusReplicas(2), withStatusV1beta2AvailableReplicas(2)),
+				fakeMachineSet("ms-old", withSpecVersion("v1.31.0"), withSpecReplicas(2), withStatusReplicas(2), withStatusV1beta2AvailableReplicas(2)),
+			},
+			machines: []*clusterv1.Machine{
+				fakeMachine("ms-new-1", withOwnerMachineSet("ms-new"), availableAt(rolloutStart.Add(-1*time.Hour))),
+				fakeMachine("ms-new-2", withOwnerMachineSet("ms-new"), availableAt(rolloutStart.Add(20*time.Minute))),
+			},
+			getAndAdoptMachineSetsForDeploymentSucceeded: true,
+			expectRollout: clusterv1.MachineDeploymentRolloutStatus{
+				NewReplicas: ptr.To[int32](2),
+				OldReplicas: ptr.To[int32](2),
+				MachineSets: []clusterv1.MachineDeploymentRolloutMachineSetStatus{
+					{Name: "ms-new", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingUp, Replicas: ptr.To[int32](2), AvailableReplicas: ptr.To[int32](2)},
+					{Name: "ms-old", Phase: clusterv1.MachineDeploymentRolloutMachineSetPhaseScalingDown, Replicas: ptr.To[int32](2), AvailableReplicas: ptr.To[int32](2)},
+				},
+				EstimatedCompletionTime: metav1.NewTime(rolloutStart.Add(40 * time.Minute)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			md := &clusterv1.MachineDeployment{
+				Spec: clusterv1.MachineDeploymentSpec{
+					Replicas: ptr.To[int32](3),
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Version: "v1.32.0",
+						},
+					},
+				},
+				Status: clusterv1.MachineDeploymentStatus{
+					Conditions: tt.machineDeploymentConditions,
+				},
+			}
+			var machines collections.Machines
+			if tt.machines != nil {
+				machines = collections.FromMachines(tt.machines...)
+			}
+			setRolloutStatus(ctx, md, tt.machineSets, machines, tt.getAndAdoptMachineSetsForDeploymentSucceeded)
+
+			g.Expect(md.Status.Rollout).To(Equal(tt.expectRollout))
+		})
+	}
+}
+
 func Test_setScalingUpCondition(t *testing.T) {
 	machineDeploymentWith0Replicas := &clusterv1.MachineDeployment{
 		Spec: clusterv1.MachineDeploymentSpec{
@@ -1248,6 +1494,18 @@ func fakeMachineSet(name string, options ...fakeMachineSetOption) *clusterv1.Mac
 	return p
 }
 
+func withSpecReplicas(n int32) fakeMachineSetOption {
+	return func(ms *clusterv1.MachineSet) {
+		ms.Spec.Replicas = ptr.To(n)
+	}
+}
+
+func withSpecVersion(version string) fakeMachineSetOption {
+	return func(ms *clusterv1.MachineSet) {
+		ms.Spec.Template.Spec.Version = version
+	}
+}
+
 func withStatusReplicas(n int32) fakeMachineSetOption {
 	return func(ms *clusterv1.MachineSet) {
 		ms.Status.Replicas = ptr.To(n)
@@ -1278,6 +1536,24 @@ func withStatusV1beta2Versions(versions ...clusterv1.StatusVersion) fakeMachineS
 	}
 }
 
+func withStatusFailureDomains(failureDomains ...clusterv1.StatusFailureDomain) fakeMachineSetOption {
+	return func(ms *clusterv1.MachineSet) {
+		ms.Status.FailureDomains = failureDomains
+	}
+}
+
+func withStatusNodeCapabilities(nodeCapabilities clusterv1.MachineNodeCapabilities) fakeMachineSetOption {
+	return func(ms *clusterv1.MachineSet) {
+		ms.Status.NodeCapabilities = nodeCapabilities
+	}
+}
+
+func withMSCreationTimestamp(t time.Time) fakeMachineSetOption {
+	return func(ms *clusterv1.MachineSet) {
+		ms.CreationTimestamp = metav1.NewTime(t)
+	}
+}
+
 type fakeMachinesOption func(m *clusterv1.Machine)
 
 func fakeMachine(name string, options ...fakeMachinesOption) *clusterv1.Machine {
@@ -1298,6 +1574,17 @@ func withCreationTimestamp(t time.Time) fakeMachinesOption {
 	}
 }
 
+func withOwnerMachineSet(name string) fakeMachinesOption {
+	return func(m *clusterv1.Machine) {
+		m.OwnerReferences = append(m.OwnerReferences, metav1.OwnerReference{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       "MachineSet",
+			Name:       name,
+			Controller: ptr.To(true),
+		})
+	}
+}
+
 func withStaleDeletion() fakeMachinesOption {
 	return func(m *clusterv1.Machine) {
 		m.DeletionTimestamp = ptr.To(metav1.Time{Time: time.Now().Add(-1 * time.Hour)})
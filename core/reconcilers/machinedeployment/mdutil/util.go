@@ -439,6 +439,7 @@ func MachineTemplateDeepCopyRolloutFields(template *clusterv1.MachineTemplateSpe
 	spec.ReadinessGates = nil
 	spec.Deletion.NodeDrainTimeoutSeconds = nil
 	spec.Deletion.NodeVolumeDetachTimeoutSeconds = nil
+	spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = nil
 	spec.Deletion.NodeDeletionTimeoutSeconds = nil
 	spec.Taints = nil
 
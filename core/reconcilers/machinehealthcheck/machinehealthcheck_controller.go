@@ -32,6 +32,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -50,15 +52,18 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machine"
+	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
 	"sigs.k8s.io/cluster-api/util/index"
+	"sigs.k8s.io/cluster-api/util/labels/format"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -72,6 +77,10 @@ const (
 	unhealthyTargetsKeyLog = "unhealthyTargets"
 	unhealthyRangeKeyLog   = "unhealthyRange"
 	totalTargetKeyLog      = "totalTarget"
+
+	// podChecksResyncPeriod is the maximum time between two reconciles of a MachineHealthCheck with checks on Pods;
+	// this is required because Pods in the workload cluster are not watched.
+	podChecksResyncPeriod = 1 * time.Minute
 )
 
 var (
@@ -81,6 +90,7 @@ var (
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinesets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinehealthchecks;machinehealthchecks/status;machinehealthchecks/finalizers,verbs=get;list;watch;update;patch
 
 // Reconciler reconciles a MachineHealthCheck object.
@@ -91,8 +101,13 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
 	controller        controller.Controller
 	recorder          record.EventRecorder
+	externalTracker   external.ObjectTracker
 	overrideRateLimit time.Duration
 
 	predicateLog *logr.Logger
@@ -117,6 +132,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			machineIsChangedPredicate(),
 		).
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
@@ -137,6 +153,12 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 
 	r.controller = c
 	r.recorder = mgr.GetEventRecorderFor("machinehealthcheck-controller")
+	r.externalTracker = external.ObjectTracker{
+		Controller:      c,
+		Cache:           mgr.GetCache(),
+		Scheme:          mgr.GetScheme(),
+		PredicateLogger: r.predicateLog,
+	}
 	return nil
 }
 
@@ -217,6 +239,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 			patch.WithOwnedConditions{Conditions: []string{
 				clusterv1.PausedCondition,
 				clusterv1.MachineHealthCheckRemediationAllowedCondition,
+				clusterv1.MachineHealthCheckRemediationThrottledCondition,
 			}},
 		}
 		if reterr == nil {
@@ -265,6 +288,18 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	if err != nil {
 		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to fetch targets from MachineHealthCheck")
 	}
+
+	// If there are checks on Pods, read the Pods running on the nodes of the targets.
+	// Note: Pods are read with an uncached client to avoid caching all the Pods of the workload cluster.
+	if len(m.Spec.Checks.UnhealthyPodConditions) > 0 && remoteClient != nil {
+		uncachedClient, err := r.ClusterCache.GetUncachedClient(ctx, util.ObjectKey(cluster))
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.setPodsForTargets(ctx, uncachedClient, m, targets); err != nil {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to get Pods for targets from MachineHealthCheck")
+		}
+	}
 	totalTargets := len(targets)
 	m.Status.ExpectedMachines = ptr.To(int32(totalTargets))
 	m.Status.Targets = make([]string, totalTargets)
@@ -274,6 +309,16 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	// do sort to avoid keep changing m.Status as the returned machines are not in order
 	sort.Strings(m.Status.Targets)
 
+	// If percentages must be computed against desired Machines, compute the desired number of Machines.
+	m.Status.DesiredMachines = nil
+	if m.Spec.Remediation.TriggerIf.PercentageOf == clusterv1.MachineHealthCheckPercentageOfDesiredMachines {
+		desiredMachines, err := r.getDesiredMachines(ctx, cluster, m, targets)
+		if err != nil {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to compute desired Machines for MachineHealthCheck")
+		}
+		m.Status.DesiredMachines = ptr.To(desiredMachines)
+	}
+
 	nodeStartupTimeout := m.Spec.Checks.NodeStartupTimeoutSeconds
 	if nodeStartupTimeout == nil {
 		nodeStartupTimeout = &clusterv1.DefaultNodeStartupTimeoutSeconds
@@ -282,6 +327,9 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	// health check all targets and reconcile mhc status
 	reconciliationTime := time.Now()
 	healthy, unhealthy, nextCheckTimes := r.healthCheckTargets(targets, logger, reconciliationTime, metav1.Duration{Duration: time.Duration(*nodeStartupTimeout) * time.Second})
+	if len(m.Spec.Checks.UnhealthyPodConditions) > 0 && len(targets) > 0 {
+		nextCheckTimes = append(nextCheckTimes, podChecksResyncPeriod)
+	}
 	m.Status.CurrentHealthy = ptr.To(int32(len(healthy)))
 
 	// check MHC current health against UnhealthyLessThanOrEqualTo
@@ -289,6 +337,9 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	if err != nil {
 		return ctrl.Result{}, pkgerrors.Wrapf(err, "error checking if remediation is allowed")
 	}
+	if err := setRemediationThrottledCondition(m, remediationAllowed); err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "error setting RemediationThrottled condition")
+	}
 
 	if !remediationAllowed {
 		var message string
@@ -424,11 +475,9 @@ func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger
 				if !apierrors.IsNotFound(pkgerrors.Cause(err)) {
 					wrappedErr := pkgerrors.Wrapf(err, "failed to fetch remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName)
 					errList = append(errList, wrappedErr)
+					continue
 				}
-				continue
-			}
-			// Check that obj has no DeletionTimestamp to avoid hot loop
-			if obj.GetDeletionTimestamp() == nil {
+			} else if obj.GetDeletionTimestamp() == nil { // Check that obj has no DeletionTimestamp to avoid hot loop
 				// Issue a delete for remediation request.
 				if err := r.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
 					errList = append(errList, pkgerrors.Wrapf(err, "failed to delete %v %q for Machine %q", obj.GroupVersionKind(), obj.GetName(), t.Machine.Name))
@@ -437,6 +486,9 @@ func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger
 			}
 		}
 
+		// Reset the number of failed external remediation requests when the Machine is healthy again.
+		delete(t.Machine.Annotations, clusterv1.ExternalRemediationFailuresAnnotation)
+
 		patchOpts := []patch.Option{
 			patch.WithOwnedV1Beta1Conditions{Conditions: []clusterv1.ConditionType{
 				clusterv1.MachineHealthCheckSucceededV1Beta1Condition,
@@ -467,76 +519,16 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 		if annotations.IsPaused(cluster, t.Machine) {
 			logger.Info("Machine has failed health check, but machine is paused so skipping remediation", "reason", condition.Reason, "message", condition.Message)
 		} else {
-			if m.Spec.Remediation.TemplateRef.IsDefined() {
-				// If external remediation request already exists,
-				// return early
-				if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
-					return errList
-				}
-
-				cloneOwnerRef := &metav1.OwnerReference{
-					APIVersion: clusterv1.GroupVersion.String(),
-					Kind:       "Machine",
-					Name:       t.Machine.Name,
-					UID:        t.Machine.UID,
-				}
-
-				from, err := external.Get(ctx, r.Client, m.Spec.Remediation.TemplateRef.ToObjectReference(m.Namespace))
-				if err != nil {
-					v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationTemplateAvailableV1Beta1Condition, clusterv1.ExternalRemediationTemplateNotFoundV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
-
-					conditions.Set(t.Machine, metav1.Condition{
-						Type:    clusterv1.MachineExternallyRemediatedCondition,
-						Status:  metav1.ConditionFalse,
-						Reason:  clusterv1.MachineExternallyRemediatedRemediationTemplateNotFoundReason,
-						Message: fmt.Sprintf("Error retrieving remediation template %s %s", m.Spec.Remediation.TemplateRef.Kind, klog.KRef(m.Namespace, m.Spec.Remediation.TemplateRef.Name)),
-					})
-					errList = append(errList, pkgerrors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", m.Spec.Remediation.TemplateRef.GroupVersionKind(), m.Spec.Remediation.TemplateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
-				}
-
-				generateTemplateInput := &external.GenerateTemplateInput{
-					Template:    from,
-					TemplateRef: m.Spec.Remediation.TemplateRef.ToObjectReference(m.Namespace),
-					Namespace:   t.Machine.Namespace,
-					ClusterName: t.Machine.Spec.ClusterName,
-					OwnerRef:    cloneOwnerRef,
-				}
-				to, err := external.GenerateTemplate(generateTemplateInput)
+			externalRemediation := m.Spec.Remediation.TemplateRef.IsDefined()
+			if externalRemediation {
+				escalated, err := r.reconcileExternalRemediation(ctx, logger, t, m)
 				if err != nil {
-					errList = append(errList, pkgerrors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", m.Spec.Remediation.TemplateRef.GroupVersionKind(), m.Spec.Remediation.TemplateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
-				}
-
-				// Set the Remediation Request to match the Machine name, the name is used to
-				// guarantee uniqueness between runs. A Machine should only ever have a single
-				// remediation object of a specific GVK created.
-				//
-				// NOTE: This doesn't guarantee uniqueness across different MHC objects watching
-				// the same Machine, users are in charge of setting health checks and remediation properly.
-				to.SetName(t.Machine.Name)
-
-				logger.Info("Machine has failed health check, creating an external remediation request", "remediation request name", to.GetName(), "reason", condition.Reason, "message", condition.Message)
-				// Create the external clone.
-				if err := r.Client.Create(ctx, to); err != nil {
-					v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationRequestAvailableV1Beta1Condition, clusterv1.ExternalRemediationRequestCreationFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
-
-					conditions.Set(t.Machine, metav1.Condition{
-						Type:    clusterv1.MachineExternallyRemediatedCondition,
-						Status:  metav1.ConditionFalse,
-						Reason:  clusterv1.MachineExternallyRemediatedRemediationRequestCreationFailedReason,
-						Message: "Please check controller logs for errors",
-					})
-					errList = append(errList, pkgerrors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName))
+					errList = append(errList, err)
 					return errList
 				}
-
-				conditions.Set(t.Machine, metav1.Condition{
-					Type:   clusterv1.MachineExternallyRemediatedCondition,
-					Status: metav1.ConditionFalse,
-					Reason: clusterv1.MachineExternallyRemediatedWaitingForRemediationReason,
-				})
-			} else if t.Machine.DeletionTimestamp.IsZero() { // Only setting the OwnerRemediated conditions when machine is not already in deletion.
+				externalRemediation = !escalated
+			}
+			if !externalRemediation && t.Machine.DeletionTimestamp.IsZero() { // Only setting the OwnerRemediated conditions when machine is not already in deletion.
 				logger.Info("Machine has failed health check, marking for remediation", "reason", condition.Reason, "message", condition.Message)
 				// NOTE: MHC is responsible for creating MachineOwnerRemediatedCondition if missing or to trigger another remediation if the previous one is completed;
 				// instead, if a remediation is in already progress, the remediation owner is responsible for completing the process and MHC should not overwrite the condition.
@@ -545,11 +537,15 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 				}
 
 				if ownerRemediatedCondition := conditions.Get(t.Machine, clusterv1.MachineOwnerRemediatedCondition); ownerRemediatedCondition == nil || ownerRemediatedCondition.Status == metav1.ConditionTrue {
+					message := "Waiting for remediation"
+					if m.Spec.Remediation.TemplateRef.IsDefined() {
+						message = fmt.Sprintf("Waiting for remediation after %d failed external remediations", externalRemediationFailures(t.Machine))
+					}
 					conditions.Set(t.Machine, metav1.Condition{
 						Type:    clusterv1.MachineOwnerRemediatedCondition,
 						Status:  metav1.ConditionFalse,
 						Reason:  clusterv1.MachineOwnerRemediatedWaitingForRemediationReason,
-						Message: "Waiting for remediation",
+						Message: message,
 					})
 				}
 			}
@@ -582,6 +578,190 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 	return errList
 }
 
+// reconcileExternalRemediation creates the external remediation request for an unhealthy Machine and reflects
+// the progress of an existing request, as surfaced by its Remediated condition, to the ExternallyRemediated condition
+// of the Machine. Failed requests are deleted, so a new one is created; once the number of failed requests reaches
+// maxExternalRemediationFailures, remediation is escalated to the owner of the Machine and this func returns true.
+func (r *Reconciler) reconcileExternalRemediation(ctx context.Context, logger logr.Logger, t healthCheckTarget, m *clusterv1.MachineHealthCheck) (bool, error) {
+	if isExternalRemediationEscalated(m, t.Machine) {
+		return true, nil
+	}
+
+	condition := conditions.Get(t.Machine, clusterv1.MachineHealthCheckSucceededCondition)
+
+	remediationReq, err := r.getExternalRemediationRequest(ctx, m, t.Machine.Name)
+	if err != nil && !apierrors.IsNotFound(pkgerrors.Cause(err)) {
+		return false, pkgerrors.Wrapf(err, "failed to fetch remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName)
+	}
+
+	// If the external remediation request already exists, reflect its progress to the Machine.
+	if err == nil {
+		if err := r.watchExternalRemediationRequest(logger, remediationReq); err != nil {
+			return false, err
+		}
+		return r.reconcileExternalRemediationProgress(ctx, logger, t, m, remediationReq)
+	}
+
+	cloneOwnerRef := &metav1.OwnerReference{
+		APIVersion: clusterv1.GroupVersion.String(),
+		Kind:       "Machine",
+		Name:       t.Machine.Name,
+		UID:        t.Machine.UID,
+	}
+
+	from, err := external.Get(ctx, r.Client, m.Spec.Remediation.TemplateRef.ToObjectReference(m.Namespace))
+	if err != nil {
+		v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationTemplateAvailableV1Beta1Condition, clusterv1.ExternalRemediationTemplateNotFoundV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+
+		conditions.Set(t.Machine, metav1.Condition{
+			Type:    clusterv1.MachineExternallyRemediatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineExternallyRemediatedRemediationTemplateNotFoundReason,
+			Message: fmt.Sprintf("Error retrieving remediation template %s %s", m.Spec.Remediation.TemplateRef.Kind, klog.KRef(m.Namespace, m.Spec.Remediation.TemplateRef.Name)),
+		})
+		return false, pkgerrors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", m.Spec.Remediation.TemplateRef.GroupVersionKind(), m.Spec.Remediation.TemplateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName)
+	}
+
+	generateTemplateInput := &external.GenerateTemplateInput{
+		Template:    from,
+		TemplateRef: m.Spec.Remediation.TemplateRef.ToObjectReference(m.Namespace),
+		Namespace:   t.Machine.Namespace,
+		ClusterName: t.Machine.Spec.ClusterName,
+		OwnerRef:    cloneOwnerRef,
+	}
+	to, err := external.GenerateTemplate(generateTemplateInput)
+	if err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", m.Spec.Remediation.TemplateRef.GroupVersionKind(), m.Spec.Remediation.TemplateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName)
+	}
+
+	// Set the Remediation Request to match the Machine name, the name is used to
+	// guarantee uniqueness between runs. A Machine should only ever have a single
+	// remediation object of a specific GVK created.
+	//
+	// NOTE: This doesn't guarantee uniqueness across different MHC objects watching
+	// the same Machine, users are in charge of setting health checks and remediation properly.
+	to.SetName(t.Machine.Name)
+
+	logger.Info("Machine has failed health check, creating an external remediation request", "remediation request name", to.GetName(), "reason", condition.Reason, "message", condition.Message)
+	// Create the external clone.
+	if err := r.Client.Create(ctx, to); err != nil {
+		v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationRequestAvailableV1Beta1Condition, clusterv1.ExternalRemediationRequestCreationFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+
+		conditions.Set(t.Machine, metav1.Condition{
+			Type:    clusterv1.MachineExternallyRemediatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineExternallyRemediatedRemediationRequestCreationFailedReason,
+			Message: "Please check controller logs for errors",
+		})
+		return false, pkgerrors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName)
+	}
+
+	// Watch external remediation requests, so the MachineHealthCheck is reconciled when they report progress.
+	if err := r.watchExternalRemediationRequest(logger, to); err != nil {
+		return false, err
+	}
+
+	conditions.Set(t.Machine, metav1.Condition{
+		Type:   clusterv1.MachineExternallyRemediatedCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.MachineExternallyRemediatedWaitingForRemediationReason,
+	})
+	return false, nil
+}
+
+// reconcileExternalRemediationProgress reflects the Remediated condition of an existing external remediation request
+// to the ExternallyRemediated condition of the Machine, and handles failed requests.
+func (r *Reconciler) reconcileExternalRemediationProgress(ctx context.Context, logger logr.Logger, t healthCheckTarget, m *clusterv1.MachineHealthCheck, remediationReq *unstructured.Unstructured) (bool, error) {
+	// If the external remediation request is being deleted, e.g. after a failure, wait for the deletion to complete
+	// before creating a new one.
+	if !remediationReq.GetDeletionTimestamp().IsZero() {
+		return false, nil
+	}
+
+	remediatedCondition, err := conditions.UnstructuredGet(remediationReq, contract.RemediationRequest().RemediatedConditionType())
+	if err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to get %s condition from %s %s", contract.RemediationRequest().RemediatedConditionType(), remediationReq.GetKind(), klog.KObj(remediationReq))
+	}
+
+	// Note: The Remediated condition is optional; if it is not reported, the external remediation controller
+	// is responsible for updating the ExternallyRemediated condition.
+	if remediatedCondition == nil {
+		return false, nil
+	}
+
+	switch {
+	case remediatedCondition.Status == metav1.ConditionTrue:
+		conditions.Set(t.Machine, metav1.Condition{
+			Type:    clusterv1.MachineExternallyRemediatedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  clusterv1.MachineExternallyRemediatedRemediationSucceededReason,
+			Message: remediatedCondition.Message,
+		})
+		return false, nil
+	case remediatedCondition.Status == metav1.ConditionFalse && remediatedCondition.Reason == contract.RemediationRequest().RemediationFailedReason():
+		failures := externalRemediationFailures(t.Machine) + 1
+		logger.Info("External remediation request failed, deleting it", "remediation request name", remediationReq.GetName(), "failures", failures, "message", remediatedCondition.Message)
+		if err := r.Client.Delete(ctx, remediationReq); err != nil && !apierrors.IsNotFound(err) {
+			return false, pkgerrors.Wrapf(err, "failed to delete %v %q for Machine %q", remediationReq.GroupVersionKind(), remediationReq.GetName(), t.Machine.Name)
+		}
+		annotations.AddAnnotations(t.Machine, map[string]string{clusterv1.ExternalRemediationFailuresAnnotation: strconv.Itoa(failures)})
+
+		if isExternalRemediationEscalated(m, t.Machine) {
+			logger.Info("Escalating remediation to the owner of the Machine", "failures", failures)
+			conditions.Set(t.Machine, metav1.Condition{
+				Type:    clusterv1.MachineExternallyRemediatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineExternallyRemediatedRemediationEscalatedReason,
+				Message: fmt.Sprintf("Remediation escalated to the owner of the Machine after %d failed external remediations", failures),
+			})
+			return true, nil
+		}
+
+		message := fmt.Sprintf("External remediation failed (failures: %d)", failures)
+		if remediatedCondition.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, remediatedCondition.Message)
+		}
+		conditions.Set(t.Machine, metav1.Condition{
+			Type:    clusterv1.MachineExternallyRemediatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineExternallyRemediatedRemediationFailedReason,
+			Message: message,
+		})
+		return false, nil
+	default:
+		conditions.Set(t.Machine, metav1.Condition{
+			Type:    clusterv1.MachineExternallyRemediatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.MachineExternallyRemediatedRemediationInProgressReason,
+			Message: remediatedCondition.Message,
+		})
+		return false, nil
+	}
+}
+
+// watchExternalRemediationRequest adds a watch for the kind of the external remediation request, if not already there.
+func (r *Reconciler) watchExternalRemediationRequest(logger logr.Logger, remediationReq *unstructured.Unstructured) error {
+	return r.externalTracker.Watch(logger, remediationReq, handler.EnqueueRequestsFromMapFunc(r.remediationRequestToMachineHealthCheck), predicates.ResourceIsChanged(r.Client.Scheme(), *r.externalTracker.PredicateLogger))
+}
+
+// externalRemediationFailures returns the number of failed external remediation requests for a Machine.
+func externalRemediationFailures(machine *clusterv1.Machine) int {
+	failures, err := strconv.Atoi(machine.GetAnnotations()[clusterv1.ExternalRemediationFailuresAnnotation])
+	if err != nil {
+		return 0
+	}
+	return failures
+}
+
+// isExternalRemediationEscalated returns true if the number of failed external remediation requests for a Machine
+// reached the maxExternalRemediationFailures of the MachineHealthCheck.
+func isExternalRemediationEscalated(m *clusterv1.MachineHealthCheck, machine *clusterv1.Machine) bool {
+	if m.Spec.Remediation.MaxExternalRemediationFailures == nil {
+		return false
+	}
+	return externalRemediationFailures(machine) >= int(*m.Spec.Remediation.MaxExternalRemediationFailures)
+}
+
 // clusterToMachineHealthCheck maps events from Cluster objects to
 // MachineHealthCheck objects that belong to the Cluster.
 func (r *Reconciler) clusterToMachineHealthCheck(ctx context.Context, o client.Object) []reconcile.Request {
@@ -638,6 +818,24 @@ func (r *Reconciler) machineToMachineHealthCheck(ctx context.Context, o client.O
 	return requests
 }
 
+// remediationRequestToMachineHealthCheck maps events from external remediation requests to
+// MachineHealthCheck objects that monitor the Machine owning the request.
+func (r *Reconciler) remediationRequestToMachineHealthCheck(ctx context.Context, o client.Object) []reconcile.Request {
+	for _, ref := range o.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || gv.Group != clusterv1.GroupVersion.Group || ref.Kind != "Machine" {
+			continue
+		}
+
+		m := &clusterv1.Machine{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: o.GetNamespace(), Name: ref.Name}, m); err != nil {
+			return nil
+		}
+		return r.machineToMachineHealthCheck(ctx, m)
+	}
+	return nil
+}
+
 func (r *Reconciler) nodeToMachineHealthCheck(ctx context.Context, o client.Object) []reconcile.Request {
 	node, ok := o.(*corev1.Node)
 	if !ok {
@@ -780,13 +978,139 @@ func getUnhealthyRange(mhc *clusterv1.MachineHealthCheck) (int, int, error) {
 }
 
 func getMaxUnhealthy(mhc *clusterv1.MachineHealthCheck) (int, error) {
-	maxUnhealthy, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(mhc.Spec.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo, defaultMaxUnhealthy)), int(ptr.Deref[int32](mhc.Status.ExpectedMachines, 0)), false)
+	maxUnhealthy, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(mhc.Spec.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo, defaultMaxUnhealthy)), maxUnhealthyBase(mhc), false)
 	if err != nil {
 		return 0, err
 	}
 	return maxUnhealthy, nil
 }
 
+// maxUnhealthyBase returns the number of Machines a percentage in unhealthyLessThanOrEqualTo is computed against.
+// When percentageOf is DesiredMachines, the greater of expected and desired Machines is used, so remediation
+// is not blocked when Machines have been deleted and not yet replaced.
+func maxUnhealthyBase(mhc *clusterv1.MachineHealthCheck) int {
+	expectedMachines := ptr.Deref(mhc.Status.ExpectedMachines, 0)
+	if mhc.Spec.Remediation.TriggerIf.PercentageOf != clusterv1.MachineHealthCheckPercentageOfDesiredMachines {
+		return int(expectedMachines)
+	}
+	return int(max(expectedMachines, ptr.Deref(mhc.Status.DesiredMachines, 0)))
+}
+
+// setRemediationThrottledCondition sets the RemediationThrottled condition surfacing the numbers used
+// to determine if remediation is allowed.
+func setRemediationThrottledCondition(mhc *clusterv1.MachineHealthCheck, remediationAllowed bool) error {
+	messages := []string{
+		fmt.Sprintf("* Unhealthy Machines: %d", unhealthyMachineCount(mhc)),
+		fmt.Sprintf("* Targeted Machines: %d", ptr.Deref(mhc.Status.ExpectedMachines, 0)),
+	}
+	if mhc.Status.DesiredMachines != nil {
+		messages = append(messages, fmt.Sprintf("* Desired Machines: %d", *mhc.Status.DesiredMachines))
+	}
+	if mhc.Spec.Remediation.TriggerIf.UnhealthyInRange != "" {
+		minVal, maxVal, err := getUnhealthyRange(mhc)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, fmt.Sprintf("* Allowed unhealthy Machines: from %d to %d (unhealthyInRange: %s)", minVal, maxVal, mhc.Spec.Remediation.TriggerIf.UnhealthyInRange))
+	} else {
+		maxUnhealthy, err := getMaxUnhealthy(mhc)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, fmt.Sprintf("* Allowed unhealthy Machines: at most %d (unhealthyLessThanOrEqualTo: %s of %d Machines)",
+			maxUnhealthy, ptr.To(ptr.Deref(mhc.Spec.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo, defaultMaxUnhealthy)).String(), maxUnhealthyBase(mhc)))
+	}
+
+	if !remediationAllowed {
+		conditions.Set(mhc, metav1.Condition{
+			Type:    clusterv1.MachineHealthCheckRemediationThrottledCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  clusterv1.MachineHealthCheckRemediationThrottledReason,
+			Message: strings.Join(messages, "\n"),
+		})
+		return nil
+	}
+
+	conditions.Set(mhc, metav1.Condition{
+		Type:    clusterv1.MachineHealthCheckRemediationThrottledCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  clusterv1.MachineHealthCheckRemediationNotThrottledReason,
+		Message: strings.Join(messages, "\n"),
+	})
+	return nil
+}
+
+// getDesiredMachines returns the desired number of Machines targeted by the MachineHealthCheck, computed as the sum of:
+// - the replicas of the MachineSets creating Machines selected by the MachineHealthCheck.
+// - the replicas of the control plane, if control plane Machines are targeted.
+// - the number of other targeted Machines, e.g. Machines without an owner.
+func (r *Reconciler) getDesiredMachines(ctx context.Context, cluster *clusterv1.Cluster, mhc *clusterv1.MachineHealthCheck, targets []healthCheckTarget) (int32, error) {
+	selector, err := metav1.LabelSelectorAsSelector(metav1.CloneSelectorAndAddLabel(
+		&mhc.Spec.Selector, clusterv1.ClusterNameLabel, mhc.Spec.ClusterName,
+	))
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to build selector")
+	}
+
+	machineSetList := &clusterv1.MachineSetList{}
+	if err := r.Client.List(ctx, machineSetList,
+		client.InNamespace(mhc.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: mhc.Spec.ClusterName},
+	); err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to list MachineSets")
+	}
+
+	var desiredMachines int32
+	machineSetNames := map[string]bool{}
+	for i := range machineSetList.Items {
+		ms := &machineSetList.Items[i]
+		if !ms.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		// Note: Machines created by a MachineSet have the labels of the MachineSet template, plus the MachineSet and MachineDeployment name labels.
+		machineLabels := labels.Set{}
+		maps.Copy(machineLabels, ms.Spec.Template.Labels)
+		machineLabels[clusterv1.MachineSetNameLabel] = format.MustFormatValue(ms.Name)
+		if mdName, ok := ms.Labels[clusterv1.MachineDeploymentNameLabel]; ok {
+			machineLabels[clusterv1.MachineDeploymentNameLabel] = mdName
+		}
+		if !selector.Matches(machineLabels) {
+			continue
+		}
+		desiredMachines += ptr.Deref(ms.Spec.Replicas, 0)
+		machineSetNames[format.MustFormatValue(ms.Name)] = true
+	}
+
+	controlPlaneMachines := int32(0)
+	for _, t := range targets {
+		if util.IsControlPlaneMachine(t.Machine) {
+			controlPlaneMachines++
+			continue
+		}
+		if machineSetNames[t.Machine.Labels[clusterv1.MachineSetNameLabel]] {
+			continue
+		}
+		desiredMachines++
+	}
+
+	if controlPlaneMachines > 0 {
+		controlPlaneReplicas := controlPlaneMachines
+		if cluster.Spec.ControlPlaneRef.IsDefined() {
+			controlPlane, err := external.GetObjectFromContractVersionedRef(ctx, r.Client, cluster.Spec.ControlPlaneRef, cluster.Namespace)
+			if err != nil {
+				return 0, pkgerrors.Wrapf(err, "failed to get control plane %s", cluster.Spec.ControlPlaneRef.Name)
+			}
+			if replicas, err := contract.ControlPlane().Replicas().Get(controlPlane); err == nil && replicas != nil {
+				controlPlaneReplicas = int32(*replicas)
+			}
+		}
+		desiredMachines += controlPlaneReplicas
+	}
+
+	return desiredMachines, nil
+}
+
 // unhealthyMachineCount calculates the number of presently unhealthy or missing machines
 // ie the delta between the expected number of machines and the current number deemed healthy.
 func unhealthyMachineCount(mhc *clusterv1.MachineHealthCheck) int {
@@ -2487,6 +2487,8 @@ func TestGetMaxUnhealthy(t *testing.T) {
 		maxUnhealthy         *intstr.IntOrString
 		expectedMaxUnhealthy int
 		actualMachineCount   int32
+		percentageOf         clusterv1.MachineHealthCheckPercentageOf
+		desiredMachineCount  *int32
 		expectedErr          error
 	}{
 		{
@@ -2523,6 +2525,32 @@ func TestGetMaxUnhealthy(t *testing.T) {
 			expectedMaxUnhealthy: 4,
 			expectedErr:          nil,
 		},
+		{
+			name:                 "when maxUnhealthy is a 40% (of 5) and desired Machines are ignored",
+			maxUnhealthy:         &intstr.IntOrString{Type: intstr.String, StrVal: "40%"},
+			actualMachineCount:   5,
+			desiredMachineCount:  ptr.To[int32](10),
+			expectedMaxUnhealthy: 2,
+			expectedErr:          nil,
+		},
+		{
+			name:                 "when maxUnhealthy is a 40% of desired Machines (of 10)",
+			maxUnhealthy:         &intstr.IntOrString{Type: intstr.String, StrVal: "40%"},
+			actualMachineCount:   5,
+			percentageOf:         clusterv1.MachineHealthCheckPercentageOfDesiredMachines,
+			desiredMachineCount:  ptr.To[int32](10),
+			expectedMaxUnhealthy: 4,
+			expectedErr:          nil,
+		},
+		{
+			name:                 "when maxUnhealthy is a 40% of desired Machines and there are more expected Machines (of 5)",
+			maxUnhealthy:         &intstr.IntOrString{Type: intstr.String, StrVal: "40%"},
+			actualMachineCount:   5,
+			percentageOf:         clusterv1.MachineHealthCheckPercentageOfDesiredMachines,
+			desiredMachineCount:  ptr.To[int32](3),
+			expectedMaxUnhealthy: 2,
+			expectedErr:          nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2534,11 +2562,13 @@ func TestGetMaxUnhealthy(t *testing.T) {
 					Remediation: clusterv1.MachineHealthCheckRemediation{
 						TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
 							UnhealthyLessThanOrEqualTo: tc.maxUnhealthy,
+							PercentageOf:               tc.percentageOf,
 						},
 					},
 				},
 				Status: clusterv1.MachineHealthCheckStatus{
 					ExpectedMachines: ptr.To(tc.actualMachineCount),
+					DesiredMachines:  tc.desiredMachineCount,
 				},
 			}
 
@@ -2553,6 +2583,270 @@ func TestGetMaxUnhealthy(t *testing.T) {
 	}
 }
 
+func TestSetRemediationThrottledCondition(t *testing.T) {
+	testCases := []struct {
+		name               string
+		triggerIf          clusterv1.MachineHealthCheckRemediationTriggerIf
+		status             clusterv1.MachineHealthCheckStatus
+		remediationAllowed bool
+		expectedCondition  metav1.Condition
+	}{
+		{
+			name: "remediation not throttled with unhealthyLessThanOrEqualTo",
+			triggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+				UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("40%")),
+			},
+			status: clusterv1.MachineHealthCheckStatus{
+				ExpectedMachines: ptr.To[int32](5),
+				CurrentHealthy:   ptr.To[int32](4),
+			},
+			remediationAllowed: true,
+			expectedCondition: metav1.Condition{
+				Type:   clusterv1.MachineHealthCheckRemediationThrottledCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.MachineHealthCheckRemediationNotThrottledReason,
+				Message: "* Unhealthy Machines: 1\n" +
+					"* Targeted Machines: 5\n" +
+					"* Allowed unhealthy Machines: at most 2 (unhealthyLessThanOrEqualTo: 40% of 5 Machines)",
+			},
+		},
+		{
+			name: "remediation throttled with unhealthyLessThanOrEqualTo of desired Machines",
+			triggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+				UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("40%")),
+				PercentageOf:               clusterv1.MachineHealthCheckPercentageOfDesiredMachines,
+			},
+			status: clusterv1.MachineHealthCheckStatus{
+				ExpectedMachines: ptr.To[int32](5),
+				DesiredMachines:  ptr.To[int32](10),
+				CurrentHealthy:   ptr.To[int32](0),
+			},
+			remediationAllowed: false,
+			expectedCondition: metav1.Condition{
+				Type:   clusterv1.MachineHealthCheckRemediationThrottledCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineHealthCheckRemediationThrottledReason,
+				Message: "* Unhealthy Machines: 5\n" +
+					"* Targeted Machines: 5\n" +
+					"* Desired Machines: 10\n" +
+					"* Allowed unhealthy Machines: at most 4 (unhealthyLessThanOrEqualTo: 40% of 10 Machines)",
+			},
+		},
+		{
+			name: "remediation throttled with unhealthyInRange",
+			triggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+				UnhealthyInRange: "[2-3]",
+			},
+			status: clusterv1.MachineHealthCheckStatus{
+				ExpectedMachines: ptr.To[int32](5),
+				CurrentHealthy:   ptr.To[int32](4),
+			},
+			remediationAllowed: false,
+			expectedCondition: metav1.Condition{
+				Type:   clusterv1.MachineHealthCheckRemediationThrottledCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineHealthCheckRemediationThrottledReason,
+				Message: "* Unhealthy Machines: 1\n" +
+					"* Targeted Machines: 5\n" +
+					"* Allowed unhealthy Machines: from 2 to 3 (unhealthyInRange: [2-3])",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := &clusterv1.MachineHealthCheck{
+				Spec: clusterv1.MachineHealthCheckSpec{
+					Remediation: clusterv1.MachineHealthCheckRemediation{
+						TriggerIf: tc.triggerIf,
+					},
+				},
+				Status: tc.status,
+			}
+
+			g.Expect(setRemediationThrottledCondition(mhc, tc.remediationAllowed)).To(Succeed())
+
+			condition := conditions.Get(mhc, clusterv1.MachineHealthCheckRemediationThrottledCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(tc.expectedCondition, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
+func TestGetDesiredMachines(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+	}
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-mhc", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: cluster.Name,
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"pool": "workers"},
+			},
+		},
+	}
+	newMachineSet := func(name string, replicas int32, templateLabels map[string]string) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: cluster.Name},
+			},
+			Spec: clusterv1.MachineSetSpec{
+				ClusterName: cluster.Name,
+				Replicas:    ptr.To(replicas),
+				Template: clusterv1.MachineTemplateSpec{
+					ObjectMeta: clusterv1.ObjectMeta{
+						Labels: templateLabels,
+					},
+				},
+			},
+		}
+	}
+	selectedMS := newMachineSet("ms-selected", 5, map[string]string{clusterv1.ClusterNameLabel: cluster.Name, "pool": "workers"})
+	notSelectedMS := newMachineSet("ms-not-selected", 3, map[string]string{clusterv1.ClusterNameLabel: cluster.Name, "pool": "others"})
+
+	// Only two Machines of the selected MachineSet are left, plus a Machine without an owner.
+	targets := []healthCheckTarget{
+		{Machine: &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m1", Labels: map[string]string{clusterv1.MachineSetNameLabel: selectedMS.Name, "pool": "workers"}}}},
+		{Machine: &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m2", Labels: map[string]string{clusterv1.MachineSetNameLabel: selectedMS.Name, "pool": "workers"}}}},
+		{Machine: &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m3", Labels: map[string]string{"pool": "workers"}}}},
+	}
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithObjects(selectedMS, notSelectedMS).Build(),
+	}
+	desiredMachines, err := r.getDesiredMachines(ctx, cluster, mhc, targets)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(desiredMachines).To(Equal(int32(6)))
+}
+
+func TestReconcileExternalRemediationProgress(t *testing.T) {
+	namespace := metav1.NamespaceDefault
+
+	newRemediationRequest := func(remediatedCondition *metav1.Condition) *unstructured.Unstructured {
+		remediationReq := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"kind":       "GenericExternalRemediation",
+			"metadata": map[string]interface{}{
+				"name":      "machine1",
+				"namespace": namespace,
+			},
+		}}
+		if remediatedCondition != nil {
+			g := NewWithT(t)
+			g.Expect(unstructured.SetNestedSlice(remediationReq.Object, []interface{}{
+				map[string]interface{}{
+					"type":               remediatedCondition.Type,
+					"status":             string(remediatedCondition.Status),
+					"reason":             remediatedCondition.Reason,
+					"message":            remediatedCondition.Message,
+					"lastTransitionTime": metav1.Now().UTC().Format(time.RFC3339),
+				},
+			}, "status", "conditions")).To(Succeed())
+		}
+		return remediationReq
+	}
+
+	tests := []struct {
+		name                       string
+		remediatedCondition        *metav1.Condition
+		failures                   string
+		expectEscalated            bool
+		expectRequestDeleted       bool
+		expectFailures             string
+		expectExternallyRemediated *metav1.Condition
+	}{
+		{
+			name: "Remediated condition not reported",
+		},
+		{
+			name:                "Remediation in progress",
+			remediatedCondition: &metav1.Condition{Type: "Remediated", Status: metav1.ConditionFalse, Reason: "Rebooting", Message: "Rebooting the host"},
+			expectExternallyRemediated: &metav1.Condition{
+				Type:    clusterv1.MachineExternallyRemediatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineExternallyRemediatedRemediationInProgressReason,
+				Message: "Rebooting the host",
+			},
+		},
+		{
+			name:                "Remediation succeeded",
+			remediatedCondition: &metav1.Condition{Type: "Remediated", Status: metav1.ConditionTrue, Reason: "Rebooted"},
+			expectExternallyRemediated: &metav1.Condition{
+				Type:   clusterv1.MachineExternallyRemediatedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineExternallyRemediatedRemediationSucceededReason,
+			},
+		},
+		{
+			name:                 "Remediation failed, request is deleted",
+			remediatedCondition:  &metav1.Condition{Type: "Remediated", Status: metav1.ConditionFalse, Reason: "RemediationFailed", Message: "Host not responding"},
+			expectRequestDeleted: true,
+			expectFailures:       "1",
+			expectExternallyRemediated: &metav1.Condition{
+				Type:    clusterv1.MachineExternallyRemediatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineExternallyRemediatedRemediationFailedReason,
+				Message: "External remediation failed (failures: 1): Host not responding",
+			},
+		},
+		{
+			name:                 "Remediation failed too many times, remediation is escalated",
+			remediatedCondition:  &metav1.Condition{Type: "Remediated", Status: metav1.ConditionFalse, Reason: "RemediationFailed", Message: "Host not responding"},
+			failures:             "1",
+			expectEscalated:      true,
+			expectRequestDeleted: true,
+			expectFailures:       "2",
+			expectExternallyRemediated: &metav1.Condition{
+				Type:    clusterv1.MachineExternallyRemediatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineExternallyRemediatedRemediationEscalatedReason,
+				Message: "Remediation escalated to the owner of the Machine after 2 failed external remediations",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := newMachineHealthCheck(namespace, testClusterName)
+			mhc.Spec.Remediation.MaxExternalRemediationFailures = ptr.To[int32](2)
+			machine := newTestMachine("machine1", namespace, testClusterName, "node1", nil)
+			if tt.failures != "" {
+				machine.Annotations = map[string]string{clusterv1.ExternalRemediationFailuresAnnotation: tt.failures}
+			}
+			remediationReq := newRemediationRequest(tt.remediatedCondition)
+
+			c := fake.NewClientBuilder().WithObjects(remediationReq).Build()
+			r := &Reconciler{
+				Client: c,
+			}
+
+			escalated, err := r.reconcileExternalRemediationProgress(ctx, logr.New(log.NullLogSink{}), healthCheckTarget{Machine: machine, MHC: mhc}, mhc, remediationReq)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(escalated).To(Equal(tt.expectEscalated))
+			g.Expect(machine.Annotations[clusterv1.ExternalRemediationFailuresAnnotation]).To(Equal(tt.expectFailures))
+
+			err = c.Get(ctx, client.ObjectKeyFromObject(remediationReq), remediationReq.DeepCopy())
+			g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.expectRequestDeleted))
+
+			condition := conditions.Get(machine, clusterv1.MachineExternallyRemediatedCondition)
+			if tt.expectExternallyRemediated == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectExternallyRemediated, conditions.IgnoreLastTransitionTime(true)))
+		})
+	}
+}
+
 func ownerReferenceForCluster(ctx context.Context, g *WithT, c *clusterv1.Cluster) metav1.OwnerReference {
 	// Fetch the cluster to populate the UID
 	cc := &clusterv1.Cluster{}
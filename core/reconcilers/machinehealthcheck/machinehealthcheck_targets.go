@@ -27,7 +27,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -58,6 +60,7 @@ type healthCheckTarget struct {
 	Cluster     *clusterv1.Cluster
 	Machine     *clusterv1.Machine
 	Node        *corev1.Node
+	Pods        []*corev1.Pod
 	MHC         *clusterv1.MachineHealthCheck
 	patchHelper *patch.Helper
 	nodeMissing bool
@@ -70,6 +73,7 @@ type healthCheckTarget struct {
 // - The Machine did not get a node before `timeoutForMachineToHaveNode` elapses
 // - The Node has been deleted but the Machine still references it
 // - Any condition on the node matches the configured checks and exceeds the timeout
+// - Any condition on the Pods running on the node matches the configured checks and exceeds the timeout
 //
 // Machine conditions are always evaluated first and consistently across all scenarios
 // (node missing, node startup timeout, node exists) to ensure comprehensive health checking.
@@ -112,8 +116,11 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, reconciliationT
 	// Check node conditions
 	nodeConditionReason, nodeV1beta1ConditionReason, unhealthyNodeMessages, nextNodeCheck := t.nodeChecks(logger, reconciliationTime, timeoutForMachineToHaveNode)
 
+	// Check pod conditions
+	unhealthyPodMessages, nextPodCheck := t.podChecks(logger, reconciliationTime)
+
 	// Combine results
-	if len(unhealthyMachineMessages) == 0 && len(unhealthyNodeMessages) == 0 {
+	if len(unhealthyMachineMessages) == 0 && len(unhealthyNodeMessages) == 0 && len(unhealthyPodMessages) == 0 {
 		var nextCheckTimes []time.Duration
 		if nextMachineCheck > 0 {
 			nextCheckTimes = append(nextCheckTimes, nextMachineCheck)
@@ -121,12 +128,20 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, reconciliationT
 		if nextNodeCheck > 0 {
 			nextCheckTimes = append(nextCheckTimes, nextNodeCheck)
 		}
+		if nextPodCheck > 0 {
+			nextCheckTimes = append(nextCheckTimes, nextPodCheck)
+		}
 		result := minDuration(nextCheckTimes)
 		return false, result
 	}
 
 	reason := nodeConditionReason
 	v1beta1Reason := nodeV1beta1ConditionReason
+	if reason == "" && len(unhealthyPodMessages) > 0 {
+		reason = clusterv1.MachineHealthCheckUnhealthyPodReason
+		// Note: there is no dedicated v1beta1 reason for Pods, Pods running on the node are considered part of the node health.
+		v1beta1Reason = clusterv1.UnhealthyNodeConditionV1Beta1Reason
+	}
 	if len(unhealthyMachineMessages) > 0 {
 		reason = clusterv1.MachineHealthCheckUnhealthyMachineReason
 		v1beta1Reason = clusterv1.UnhealthyMachineConditionV1Beta1Reason
@@ -134,6 +149,7 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, reconciliationT
 
 	// Combine all messages into a single comprehensive message
 	allMessages := append(unhealthyMachineMessages, unhealthyNodeMessages...)
+	allMessages = append(allMessages, unhealthyPodMessages...)
 
 	conditionMessage := "Health check failed:\n"
 	for i, m := range allMessages {
@@ -258,7 +274,14 @@ func (t *healthCheckTarget) nodeChecks(logger logr.Logger, reconciliationTime ti
 
 	// check node conditions (only when node is available)
 	var unhealthyNodeMessages []string
+	ignoredNodeConditions := getIgnoredNodeConditions(t.MHC, t.Node)
 	for _, c := range t.MHC.Spec.Checks.UnhealthyNodeConditions {
+		// Skip node conditions which are not evaluated while the node has a startup taint.
+		if ignoredNodeConditions.Has(c.Type) {
+			logger.V(3).Info("Skipping Node condition check while the Node has a startup taint", "condition", c.Type)
+			continue
+		}
+
 		nodeCondition := getNodeCondition(t.Node, c.Type)
 
 		// Skip when current node condition is different from the one reported
@@ -292,6 +315,57 @@ func (t *healthCheckTarget) nodeChecks(logger logr.Logger, reconciliationTime ti
 	return "", "", nil, minDuration(nextCheckTimes)
 }
 
+func (t *healthCheckTarget) podChecks(logger logr.Logger, reconciliationTime time.Time) ([]string, time.Duration) {
+	var unhealthyPodMessages []string
+	var nextCheckTimes []time.Duration
+
+	for _, c := range t.MHC.Spec.Checks.UnhealthyPodConditions {
+		// Note: errors are already surfaced when reading Pods for the targets.
+		selector, err := metav1.LabelSelectorAsSelector(&c.PodSelector)
+		if err != nil {
+			continue
+		}
+
+		for _, pod := range t.Pods {
+			// Skip Pods not selected by the check, Pods being deleted and Pods that completed successfully.
+			if pod.Namespace != c.Namespace || !selector.Matches(labels.Set(pod.Labels)) ||
+				!pod.DeletionTimestamp.IsZero() || pod.Status.Phase == corev1.PodSucceeded {
+				continue
+			}
+
+			// Skip when current pod condition is different from the one reported
+			// in the MachineHealthCheck.
+			podCondition := getPodCondition(pod, c.Type)
+			if podCondition == nil || podCondition.Status != c.Status {
+				continue
+			}
+
+			// If the pod condition has been in the unhealthy state for longer than the
+			// timeout, mark as unhealthy and collect the message.
+			timeoutSecondsDuration := time.Duration(ptr.Deref(c.TimeoutSeconds, 0)) * time.Second
+
+			if podCondition.LastTransitionTime.Add(timeoutSecondsDuration).Before(reconciliationTime) {
+				unhealthyPodMessages = append(unhealthyPodMessages, fmt.Sprintf("Condition %s on Pod %s is reporting status %s with reason %s for more than %s",
+					c.Type, klog.KObj(pod), c.Status, podCondition.Reason, timeoutSecondsDuration.String()))
+				logger.V(3).Info(fmt.Sprintf("Target is unhealthy: Pod condition is in unhealthy state more than %s", timeoutSecondsDuration.String()),
+					"Pod", klog.KObj(pod), "condition", c.Type, "state", c.Status, "reason", podCondition.Reason, "message", podCondition.Message)
+				continue
+			}
+
+			durationUnhealthy := reconciliationTime.Sub(podCondition.LastTransitionTime.Time)
+			nextCheck := timeoutSecondsDuration - durationUnhealthy + time.Second
+			if nextCheck > 0 {
+				nextCheckTimes = append(nextCheckTimes, nextCheck)
+			}
+		}
+	}
+
+	if len(unhealthyPodMessages) > 0 {
+		return unhealthyPodMessages, time.Duration(0)
+	}
+	return nil, minDuration(nextCheckTimes)
+}
+
 // getTargetsFromMHC uses the MachineHealthCheck's selector to fetch machines
 // and their nodes targeted by the health check, ready for health checking.
 func (r *Reconciler) getTargetsFromMHC(ctx context.Context, logger logr.Logger, clusterClient client.Reader, cluster *clusterv1.Cluster, mhc *clusterv1.MachineHealthCheck) ([]healthCheckTarget, error) {
@@ -361,6 +435,40 @@ func (r *Reconciler) getMachinesFromMHC(ctx context.Context, mhc *clusterv1.Mach
 	return machineList.Items, nil
 }
 
+// setPodsForTargets reads the Pods selected by the unhealthyPodConditions of the MachineHealthCheck
+// from the workload cluster and sets the Pods running on the node of each target.
+func (r *Reconciler) setPodsForTargets(ctx context.Context, clusterClient client.Reader, mhc *clusterv1.MachineHealthCheck, targets []healthCheckTarget) error {
+	podsByNode := map[string][]*corev1.Pod{}
+	seen := sets.Set[types.NamespacedName]{}
+	for _, c := range mhc.Spec.Checks.UnhealthyPodConditions {
+		selector, err := metav1.LabelSelectorAsSelector(&c.PodSelector)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to build selector for Pods in namespace %s", c.Namespace)
+		}
+
+		podList := &corev1.PodList{}
+		if err := clusterClient.List(ctx, podList, client.InNamespace(c.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return pkgerrors.Wrapf(err, "failed to list Pods in namespace %s", c.Namespace)
+		}
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			// Note: the same Pod can be selected by more than one check.
+			if pod.Spec.NodeName == "" || seen.Has(client.ObjectKeyFromObject(pod)) {
+				continue
+			}
+			seen.Insert(client.ObjectKeyFromObject(pod))
+			podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+		}
+	}
+
+	for i := range targets {
+		if targets[i].Node != nil {
+			targets[i].Pods = podsByNode[targets[i].Node.Name]
+		}
+	}
+	return nil
+}
+
 // getNodeFromMachine fetches the node from a local or remote cluster for a
 // given machine.
 func (r *Reconciler) getNodeFromMachine(ctx context.Context, clusterClient client.Reader, machine *clusterv1.Machine) (*corev1.Node, error) {
@@ -390,7 +498,7 @@ func (r *Reconciler) healthCheckTargets(targets []healthCheckTarget, logger logr
 	for _, t := range targets {
 		logger := logger.WithValues("Machine", klog.KObj(t.Machine), "Node", klog.KObj(t.Node))
 		logger.V(3).Info("Health checking target")
-		needsRemediation, nextCheck := t.needsRemediation(logger, reconciliationTime, timeoutForMachineToHaveNode)
+		needsRemediation, nextCheck := t.needsRemediation(logger, reconciliationTime, nodeStartupTimeoutForMachine(t.MHC, t.Machine, timeoutForMachineToHaveNode))
 
 		if needsRemediation {
 			unhealthy = append(unhealthy, t)
@@ -417,6 +525,42 @@ func (r *Reconciler) healthCheckTargets(targets []healthCheckTarget, logger logr
 	return healthy, unhealthy, nextCheckTimes
 }
 
+// nodeStartupTimeoutForMachine returns the node startup timeout for a Machine, which is the
+// override for the failure domain of the Machine if any, or the given default timeout otherwise.
+// Note: The failure domain in the Machine status is used if the failure domain is not set in spec,
+// e.g. if it has been picked by the infrastructure provider.
+func nodeStartupTimeoutForMachine(mhc *clusterv1.MachineHealthCheck, machine *clusterv1.Machine, defaultTimeout metav1.Duration) metav1.Duration {
+	failureDomain := machine.Spec.FailureDomain
+	if failureDomain == "" {
+		failureDomain = machine.Status.FailureDomain
+	}
+	if failureDomain == "" {
+		return defaultTimeout
+	}
+	for _, o := range mhc.Spec.Checks.NodeStartupTimeoutOverrides {
+		if o.FailureDomain == failureDomain {
+			return metav1.Duration{Duration: time.Duration(ptr.Deref(o.NodeStartupTimeoutSeconds, 0)) * time.Second}
+		}
+	}
+	return defaultTimeout
+}
+
+// getIgnoredNodeConditions returns the node condition types which are not evaluated because
+// one of the startup taints of the MachineHealthCheck is present on the node.
+func getIgnoredNodeConditions(mhc *clusterv1.MachineHealthCheck, node *corev1.Node) sets.Set[corev1.NodeConditionType] {
+	ignored := sets.Set[corev1.NodeConditionType]{}
+	for _, startupTaint := range mhc.Spec.Checks.NodeStartupTaints {
+		for _, taint := range node.Spec.Taints {
+			if taint.Key != startupTaint.Key || (startupTaint.Effect != "" && taint.Effect != startupTaint.Effect) {
+				continue
+			}
+			ignored.Insert(startupTaint.IgnoredNodeConditions...)
+			break
+		}
+	}
+	return ignored
+}
+
 // getNodeCondition returns node condition by type.
 func getNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType) *corev1.NodeCondition {
 	for _, cond := range node.Status.Conditions {
@@ -427,6 +571,16 @@ func getNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType)
 	return nil
 }
 
+// getPodCondition returns pod condition by type.
+func getPodCondition(pod *corev1.Pod, conditionType corev1.PodConditionType) *corev1.PodCondition {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == conditionType {
+			return &cond
+		}
+	}
+	return nil
+}
+
 // getMachineCondition returns machine condition by type.
 func getMachineCondition(node *clusterv1.Machine, conditionType string) *metav1.Condition {
 	for _, cond := range node.Status.Conditions {
@@ -633,6 +633,308 @@ func TestHealthCheckTargets(t *testing.T) {
 	}
 }
 
+func TestSetPodsForTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	mhc := &clusterv1.MachineHealthCheck{
+		Spec: clusterv1.MachineHealthCheckSpec{
+			Checks: clusterv1.MachineHealthCheckChecks{
+				UnhealthyPodConditions: []clusterv1.UnhealthyPodCondition{
+					{
+						Namespace:      "kube-system",
+						PodSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"app": "critical"}},
+						Type:           corev1.PodReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To[int32](300),
+					},
+					{
+						Namespace:      "kube-system",
+						PodSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"app": "critical"}},
+						Type:           corev1.PodReady,
+						Status:         corev1.ConditionUnknown,
+						TimeoutSeconds: ptr.To[int32](300),
+					},
+				},
+			},
+		},
+	}
+
+	podOnNode1 := newTestPod("critical-1", "kube-system", "node1", map[string]string{"app": "critical"})
+	podOnNode2 := newTestPod("critical-2", "kube-system", "node2", map[string]string{"app": "critical"})
+	podNotScheduled := newTestPod("critical-3", "kube-system", "", map[string]string{"app": "critical"})
+	podNotSelected := newTestPod("other", "kube-system", "node1", map[string]string{"app": "other"})
+	podInOtherNamespace := newTestPod("critical-1", "default", "node1", map[string]string{"app": "critical"})
+
+	clusterClient := fake.NewClientBuilder().WithObjects(podOnNode1, podOnNode2, podNotScheduled, podNotSelected, podInOtherNamespace).Build()
+
+	targets := []healthCheckTarget{
+		{Machine: newTestMachine("machine1", "default", "cluster", "node1", nil), Node: newTestNode("node1")},
+		{Machine: newTestMachine("machine3", "default", "cluster", "node3", nil), Node: newTestNode("node3")},
+		{Machine: newTestMachine("machine4", "default", "cluster", "", nil)},
+	}
+
+	r := &Reconciler{}
+	g.Expect(r.setPodsForTargets(ctx, clusterClient, mhc, targets)).To(Succeed())
+
+	g.Expect(targets[0].Pods).To(HaveLen(1))
+	g.Expect(targets[0].Pods[0].Name).To(Equal("critical-1"))
+	g.Expect(targets[0].Pods[0].Namespace).To(Equal("kube-system"))
+	g.Expect(targets[1].Pods).To(BeEmpty())
+	g.Expect(targets[2].Pods).To(BeEmpty())
+}
+
+func TestHealthCheckTargetsWithUnhealthyPodConditions(t *testing.T) {
+	namespace := "test-mhc"
+	clusterName := "test-cluster"
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      clusterName,
+		},
+	}
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterInfrastructureReadyCondition, Status: metav1.ConditionTrue})
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue})
+
+	timeoutForUnhealthyPodConditions := int32(5 * 60)
+	testMHC := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-mhc",
+			Namespace: namespace,
+		},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: clusterName,
+			Checks: clusterv1.MachineHealthCheckChecks{
+				UnhealthyPodConditions: []clusterv1.UnhealthyPodCondition{
+					{
+						Namespace:      "kube-system",
+						PodSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"app": "critical"}},
+						Type:           corev1.PodReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To(timeoutForUnhealthyPodConditions),
+					},
+				},
+			},
+		},
+	}
+
+	// Truncating with 1s because e.g. conditions.Set also truncates to 1s.
+	now := time.Now().Truncate(1 * time.Second)
+
+	healthyPod := newTestPod("critical-1", "kube-system", "node1", map[string]string{"app": "critical"})
+	healthyPod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+
+	podNotReadyFor100s := healthyPod.DeepCopy()
+	podNotReadyFor100s.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse, LastTransitionTime: metav1.NewTime(now.Add(-100 * time.Second))}}
+
+	podNotReadyFor400s := healthyPod.DeepCopy()
+	podNotReadyFor400s.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "ContainersNotReady", LastTransitionTime: metav1.NewTime(now.Add(-400 * time.Second))}}
+
+	podNotSelectedNotReadyFor400s := podNotReadyFor400s.DeepCopy()
+	podNotSelectedNotReadyFor400s.Labels = map[string]string{"app": "other"}
+
+	podSucceededNotReadyFor400s := podNotReadyFor400s.DeepCopy()
+	podSucceededNotReadyFor400s.Status.Phase = corev1.PodSucceeded
+
+	testCases := []struct {
+		desc                   string
+		pods                   []*corev1.Pod
+		expectUnhealthy        bool
+		expectNextCheck        time.Duration
+		expectConditionMessage string
+	}{
+		{
+			desc: "healthy Pods",
+			pods: []*corev1.Pod{healthyPod},
+		},
+		{
+			desc:            "Pod not Ready for less than the timeout",
+			pods:            []*corev1.Pod{podNotReadyFor100s},
+			expectNextCheck: 201 * time.Second,
+		},
+		{
+			desc:                   "Pod not Ready for more than the timeout",
+			pods:                   []*corev1.Pod{healthyPod, podNotReadyFor400s},
+			expectUnhealthy:        true,
+			expectConditionMessage: "Health check failed:\n  * Condition Ready on Pod kube-system/critical-1 is reporting status False with reason ContainersNotReady for more than 5m0s",
+		},
+		{
+			desc: "Pods not selected and Pods which are completed are ignored",
+			pods: []*corev1.Pod{podNotSelectedNotReadyFor400s, podSucceededNotReadyFor400s},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := healthCheckTarget{
+				Cluster: cluster,
+				MHC:     testMHC,
+				Machine: newTestMachine("machine1", namespace, clusterName, "node1", nil),
+				Node:    newTestNode("node1"),
+				Pods:    tc.pods,
+			}
+
+			needsRemediation, nextCheck := target.needsRemediation(ctrl.LoggerFrom(ctx), now, metav1.Duration{Duration: 10 * time.Minute})
+			g.Expect(needsRemediation).To(Equal(tc.expectUnhealthy))
+			g.Expect(nextCheck).To(Equal(tc.expectNextCheck))
+			if tc.expectUnhealthy {
+				condition := conditions.Get(target.Machine, clusterv1.MachineHealthCheckSucceededCondition)
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Reason).To(Equal(clusterv1.MachineHealthCheckUnhealthyPodReason))
+				g.Expect(condition.Message).To(Equal(tc.expectConditionMessage))
+			}
+		})
+	}
+}
+
+func TestHealthCheckTargetsWithNodeStartupTaints(t *testing.T) {
+	namespace := "test-mhc"
+	clusterName := "test-cluster"
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      clusterName,
+		},
+	}
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterInfrastructureReadyCondition, Status: metav1.ConditionTrue})
+	conditions.Set(cluster, metav1.Condition{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue})
+
+	testMHC := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-mhc",
+			Namespace: namespace,
+		},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName: clusterName,
+			Checks: clusterv1.MachineHealthCheckChecks{
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionUnknown,
+						TimeoutSeconds: ptr.To(int32(5 * 60)),
+					},
+				},
+				NodeStartupTaints: []clusterv1.MachineHealthCheckNodeStartupTaint{
+					{
+						Key:                   "node.example.com/provisioning",
+						Effect:                corev1.TaintEffectNoSchedule,
+						IgnoredNodeConditions: []corev1.NodeConditionType{corev1.NodeReady},
+					},
+				},
+			},
+		},
+	}
+
+	// Truncating with 1s because e.g. conditions.Set also truncates to 1s.
+	now := time.Now().Truncate(1 * time.Second)
+
+	testCases := []struct {
+		desc            string
+		taints          []corev1.Taint
+		expectUnhealthy bool
+	}{
+		{
+			desc:            "Node without startup taint is unhealthy",
+			expectUnhealthy: true,
+		},
+		{
+			desc:   "Node with startup taint is not unhealthy",
+			taints: []corev1.Taint{{Key: "node.example.com/provisioning", Effect: corev1.TaintEffectNoSchedule}},
+		},
+		{
+			desc:            "Node with startup taint key but different effect is unhealthy",
+			taints:          []corev1.Taint{{Key: "node.example.com/provisioning", Effect: corev1.TaintEffectNoExecute}},
+			expectUnhealthy: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			node := newTestUnhealthyNode("node1", corev1.NodeReady, corev1.ConditionUnknown, "NodeStatusUnknown", now, 10*time.Minute)
+			node.Spec.Taints = tc.taints
+			target := healthCheckTarget{
+				Cluster: cluster,
+				MHC:     testMHC,
+				Machine: newTestMachine("machine1", namespace, clusterName, "node1", nil),
+				Node:    node,
+			}
+
+			needsRemediation, nextCheck := target.needsRemediation(ctrl.LoggerFrom(ctx), now, metav1.Duration{Duration: 10 * time.Minute})
+			g.Expect(needsRemediation).To(Equal(tc.expectUnhealthy))
+			g.Expect(nextCheck).To(Equal(time.Duration(0)))
+		})
+	}
+}
+
+func TestNodeStartupTimeoutForMachine(t *testing.T) {
+	defaultTimeout := metav1.Duration{Duration: 10 * time.Minute}
+	mhc := &clusterv1.MachineHealthCheck{
+		Spec: clusterv1.MachineHealthCheckSpec{
+			Checks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutOverrides: []clusterv1.MachineHealthCheckNodeStartupTimeoutOverride{
+					{FailureDomain: "edge", NodeStartupTimeoutSeconds: ptr.To(int32(60 * 60))},
+					{FailureDomain: "disabled", NodeStartupTimeoutSeconds: ptr.To(int32(0))},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc                string
+		failureDomain       string
+		statusFailureDomain string
+		expected            metav1.Duration
+	}{
+		{
+			desc:     "Machine without failure domain uses the default timeout",
+			expected: defaultTimeout,
+		},
+		{
+			desc:          "Machine in a failure domain without override uses the default timeout",
+			failureDomain: "core",
+			expected:      defaultTimeout,
+		},
+		{
+			desc:          "Machine in a failure domain with override uses the override",
+			failureDomain: "edge",
+			expected:      metav1.Duration{Duration: time.Hour},
+		},
+		{
+			desc:          "Machine in a failure domain with override set to 0 disables the timeout",
+			failureDomain: "disabled",
+			expected:      disabledNodeStartupTimeout,
+		},
+		{
+			desc:                "Machine with the failure domain only in status uses the override",
+			statusFailureDomain: "edge",
+			expected:            metav1.Duration{Duration: time.Hour},
+		},
+		{
+			desc:                "Machine failure domain in spec takes precedence over the one in status",
+			failureDomain:       "core",
+			statusFailureDomain: "edge",
+			expected:            defaultTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := &clusterv1.Machine{
+				Spec:   clusterv1.MachineSpec{FailureDomain: tc.failureDomain},
+				Status: clusterv1.MachineStatus{FailureDomain: tc.statusFailureDomain},
+			}
+			g.Expect(nodeStartupTimeoutForMachine(mhc, machine, defaultTimeout)).To(Equal(tc.expected))
+		})
+	}
+}
+
 func newTestMachine(name, namespace, clusterName, nodeName string, labels map[string]string) *clusterv1.Machine {
 	// Copy the labels so that the map is unique to each test Machine
 	l := make(map[string]string)
@@ -675,6 +977,19 @@ func newTestNode(name string) *corev1.Node {
 	}
 }
 
+func newTestPod(name, namespace, nodeName string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+		},
+	}
+}
+
 func newTestUnhealthyNode(name string, condition corev1.NodeConditionType, status corev1.ConditionStatus, reason string, now time.Time, unhealthyDuration time.Duration) *corev1.Node {
 	return &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
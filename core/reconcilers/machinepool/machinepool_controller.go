@@ -53,6 +53,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // Update permissions on /finalizers subresrouce is required on management clusters with 'OwnerReferencesPermissionEnforcement' plugin enabled.
@@ -80,6 +81,10 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
 	controller      controller.Controller
 	ssaCache        ssa.Cache
 	recorder        record.EventRecorder
@@ -102,6 +107,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	c, err := capicontrollerutil.NewControllerManagedBy(mgr, *r.predicateLog).
 		For(&clusterv1.MachinePool{}).
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), *r.predicateLog, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
@@ -202,6 +208,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 			}},
 			patch.WithOwnedConditions{Conditions: []string{
 				clusterv1.PausedCondition,
+				clusterv1.MachinePoolRollingOutCondition,
 			}},
 		}
 		if reterr == nil {
@@ -230,6 +237,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		wrapErrMachinePoolReconcileFunc(r.reconcileInfrastructure, "failed to reconcile infrastructure"),
 		wrapErrMachinePoolReconcileFunc(r.getMachinesForMachinePool, "failed to get Machines for MachinePool"),
 		wrapErrMachinePoolReconcileFunc(r.reconcileNodeRefs, "failed to reconcile nodeRefs"),
+		wrapErrMachinePoolReconcileFunc(r.reconcileResources, "failed to reconcile resources"),
+		wrapErrMachinePoolReconcileFunc(r.reconcileRollout, "failed to reconcile rollout"),
 		wrapErrMachinePoolReconcileFunc(r.setMachinesUptoDate, "failed to set machines up to date"),
 	)
 
@@ -458,13 +467,18 @@ func (r *Reconciler) setMachinesUptoDate(ctx context.Context, s *scope) (ctrl.Re
 			Type: clusterv1.MachineUpToDateCondition,
 		}
 
-		if machine.DeletionTimestamp.IsZero() {
-			upToDateCondition.Status = metav1.ConditionTrue
-			upToDateCondition.Reason = clusterv1.MachineUpToDateReason
-		} else {
+		switch {
+		case !machine.DeletionTimestamp.IsZero():
 			upToDateCondition.Status = metav1.ConditionFalse
 			upToDateCondition.Reason = clusterv1.MachineNotUpToDateReason
 			upToDateCondition.Message = "Machine is being deleted"
+		case s.machinePool.Spec.Rollout.Strategy.Type == clusterv1.RollingUpdateMachinePoolRolloutStrategyType && isMachineOutdated(s.machinePool, machine):
+			upToDateCondition.Status = metav1.ConditionFalse
+			upToDateCondition.Reason = clusterv1.MachineNotUpToDateReason
+			upToDateCondition.Message = outdatedMachineMessage(s.machinePool, machine)
+		default:
+			upToDateCondition.Status = metav1.ConditionTrue
+			upToDateCondition.Reason = clusterv1.MachineUpToDateReason
 		}
 		conditions.Set(machine, *upToDateCondition)
 
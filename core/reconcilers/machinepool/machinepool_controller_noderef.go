@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -124,6 +125,8 @@ func (r *Reconciler) reconcileNodeRefs(ctx context.Context, s *scope) (ctrl.Resu
 	if err != nil {
 		if pkgerrors.Is(err, errNoAvailableNodes) {
 			log.Info("Cannot assign NodeRefs to MachinePool, no matching Nodes")
+			// None of the Nodes in NodeRefs match the ProviderIDList anymore.
+			mp.Status.NodeRefs = nil
 			// No need to requeue here. Nodes emit an event that triggers reconciliation.
 			return ctrl.Result{}, nil
 		}
@@ -203,12 +206,18 @@ func (r *Reconciler) getNodeReferences(ctx context.Context, providerIDList []str
 	var ready, available int
 
 	var nodeRefs []corev1.ObjectReference
+	seenNodes := sets.Set[string]{}
 	for _, providerID := range providerIDList {
 		if providerID == "" {
 			log.V(2).Info("No ProviderID detected, skipping", "providerID", providerID)
 			continue
 		}
 		if node, ok := nodeRefsMap[providerID]; ok {
+			// Skip duplicate ProviderIDs in the ProviderIDList, so every Node is only referenced once.
+			if seenNodes.Has(node.Name) {
+				continue
+			}
+			seenNodes.Insert(node.Name)
 			if noderefutil.IsNodeReady(node) {
 				ready++
 				if noderefutil.IsNodeAvailable(node, minReadySeconds, metav1.Now()) {
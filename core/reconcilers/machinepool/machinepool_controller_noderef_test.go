@@ -215,6 +215,17 @@ func TestMachinePoolGetNodeReference(t *testing.T) {
 				ready:     2,
 			},
 		},
+		{
+			name:           "duplicate provider ids, valid aws node",
+			providerIDList: []string{"aws://us-east-1/id-node-1", "aws://us-east-1/id-node-1"},
+			expected: &getNodeReferencesResult{
+				references: []corev1.ObjectReference{
+					{Name: "node-1"},
+				},
+				available: 1,
+				ready:     1,
+			},
+		},
 		{
 			name:           "valid provider id, no node found",
 			providerIDList: []string{"aws:///id-node-100"},
@@ -39,6 +39,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	capierrors "sigs.k8s.io/cluster-api/api/deprecated/errors"
 	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
@@ -356,6 +357,8 @@ func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctr
 // infrastructure is created accordingly.
 // Note: When supported by the cloud provider implementation of the MachinePool, machines will provide a means to interact
 // with the corresponding infrastructure (e.g. delete a specific machine in case MachineHealthCheck detects it is unhealthy).
+// Note: When not supported by the infrastructure provider, Machines are synthesized from the providerIDList of the
+// InfraMachinePool if the MachinePoolMachines feature gate is enabled, see reconcileSynthesizedMachines.
 func (r *Reconciler) reconcileMachines(ctx context.Context, s *scope, infraMachinePool *unstructured.Unstructured) error {
 	log := ctrl.LoggerFrom(ctx)
 	mp := s.machinePool
@@ -363,6 +366,10 @@ func (r *Reconciler) reconcileMachines(ctx context.Context, s *scope, infraMachi
 	var infraMachineKind string
 	if err := util.UnstructuredUnmarshalField(infraMachinePool, &infraMachineKind, "status", "infrastructureMachineKind"); err != nil {
 		if pkgerrors.Is(err, util.ErrUnstructuredFieldNotFound) {
+			if feature.Gates.Enabled(feature.MachinePoolMachines) {
+				log.V(4).Info("MachinePool Machines not supported by the infrastructure provider, no infraMachineKind found, synthesizing Machines")
+				return r.reconcileSynthesizedMachines(ctx, s, infraMachinePool)
+			}
 			log.V(4).Info("MachinePool Machines not supported, no infraMachineKind found")
 			return nil
 		}
@@ -2333,6 +2333,8 @@ func reconcileNormalFuncsForTest(mpr *Reconciler) []machinePoolReconcileFunc {
 		mpr.reconcileInfrastructure,
 		mpr.getMachinesForMachinePool,
 		mpr.reconcileNodeRefs,
+		mpr.reconcileResources,
+		mpr.reconcileRollout,
 		mpr.setMachinesUptoDate,
 	}
 }
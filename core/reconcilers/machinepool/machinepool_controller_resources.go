@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+)
+
+// reconcileResources surfaces the resources of a single replica of the MachinePool in status.resources, and
+// computes the capacity annotations used by the cluster autoscaler to scale the MachinePool from zero.
+//
+// Resources are read from status.capacity and status.nodeInfo of the InfraMachinePool if reported by the
+// infrastructure provider, otherwise from the Nodes of the MachinePool. If neither is available, e.g. because the
+// MachinePool is scaled to zero, the last known value is preserved.
+func (r *Reconciler) reconcileResources(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	mp := s.machinePool
+
+	if s.infraMachinePool == nil {
+		return ctrl.Result{}, nil
+	}
+
+	resources, err := getResourcesFromInfraMachinePool(s.infraMachinePool)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to retrieve resources from infrastructure provider for MachinePool %s", klog.KObj(mp))
+	}
+	if resources == nil {
+		resources = getResourcesFromNodes(mp.Spec.ProviderIDList, s.nodeRefMap)
+	}
+	if resources == nil || equality.Semantic.DeepEqual(*resources, mp.Status.Resources) {
+		return ctrl.Result{}, nil
+	}
+
+	log.V(4).Info("Updating MachinePool resources", "capacity", resources.Capacity, "nodeInfo", resources.NodeInfo)
+	mp.SetAnnotations(annotations.MergeAutoscalerCapacityAnnotations(mp.GetAnnotations(), computeCapacityAnnotations(mp.Status.Resources), computeCapacityAnnotations(*resources)))
+	mp.Status.Resources = *resources
+	return ctrl.Result{}, nil
+}
+
+// getResourcesFromInfraMachinePool returns the resources reported in status.capacity and status.nodeInfo
+// of the InfraMachinePool, or nil if the infrastructure provider doesn't report capacity.
+func getResourcesFromInfraMachinePool(infraMachinePool *unstructured.Unstructured) (*clusterv1.MachinePoolResources, error) {
+	resources := &clusterv1.MachinePoolResources{}
+	if err := util.UnstructuredUnmarshalField(infraMachinePool, &resources.Capacity, "status", "capacity"); err != nil {
+		if pkgerrors.Is(err, util.ErrUnstructuredFieldNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(resources.Capacity) == 0 {
+		return nil, nil
+	}
+	if err := util.UnstructuredUnmarshalField(infraMachinePool, &resources.NodeInfo, "status", "nodeInfo"); err != nil && !pkgerrors.Is(err, util.ErrUnstructuredFieldNotFound) {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// getResourcesFromNodes returns the resources of the first existing Node of the MachinePool in the
+// order of the providerIDList, or nil if there are no Nodes.
+func getResourcesFromNodes(providerIDList []string, nodeRefMap map[string]*corev1.Node) *clusterv1.MachinePoolResources {
+	for _, providerID := range providerIDList {
+		node, ok := nodeRefMap[providerID]
+		if !ok || len(node.Status.Capacity) == 0 {
+			continue
+		}
+		return &clusterv1.MachinePoolResources{
+			Capacity: node.Status.Capacity.DeepCopy(),
+			NodeInfo: clusterv1.MachinePoolNodeInfo{
+				Architecture:    node.Status.NodeInfo.Architecture,
+				OperatingSystem: node.Status.NodeInfo.OperatingSystem,
+			},
+		}
+	}
+	return nil
+}
+
+// computeCapacityAnnotations computes the autoscaler capacity annotations for resources.
+func computeCapacityAnnotations(resources clusterv1.MachinePoolResources) map[string]string {
+	return annotations.AutoscalerCapacityAnnotations(resources.Capacity)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestGetResourcesFromInfraMachinePool(t *testing.T) {
+	t.Run("capacity and nodeInfo are reported", func(t *testing.T) {
+		g := NewWithT(t)
+
+		infraMachinePool := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"capacity": map[string]interface{}{
+					"cpu":    "2",
+					"memory": "4Gi",
+				},
+				"nodeInfo": map[string]interface{}{
+					"architecture":    "arm64",
+					"operatingSystem": "linux",
+				},
+			},
+		}}
+		resources, err := getResourcesFromInfraMachinePool(infraMachinePool)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resources).ToNot(BeNil())
+		g.Expect(resources.Capacity.Cpu().Equal(resource.MustParse("2"))).To(BeTrue())
+		g.Expect(resources.Capacity.Memory().Equal(resource.MustParse("4Gi"))).To(BeTrue())
+		g.Expect(resources.NodeInfo).To(Equal(clusterv1.MachinePoolNodeInfo{Architecture: "arm64", OperatingSystem: "linux"}))
+	})
+
+	t.Run("capacity is not reported", func(t *testing.T) {
+		g := NewWithT(t)
+
+		infraMachinePool := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"nodeInfo": map[string]interface{}{
+					"architecture": "arm64",
+				},
+			},
+		}}
+		resources, err := getResourcesFromInfraMachinePool(infraMachinePool)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resources).To(BeNil())
+	})
+}
+
+func TestGetResourcesFromNodes(t *testing.T) {
+	g := NewWithT(t)
+
+	nodeRefMap := map[string]*corev1.Node{
+		"test://id-1": {
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+				NodeInfo: corev1.NodeSystemInfo{Architecture: "amd64", OperatingSystem: "linux"},
+			},
+		},
+	}
+
+	g.Expect(getResourcesFromNodes([]string{"test://id-1"}, nodeRefMap)).To(Equal(&clusterv1.MachinePoolResources{
+		Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+		NodeInfo: clusterv1.MachinePoolNodeInfo{Architecture: "amd64", OperatingSystem: "linux"},
+	}))
+	g.Expect(getResourcesFromNodes([]string{"test://id-2"}, nodeRefMap)).To(BeNil())
+	g.Expect(getResourcesFromNodes(nil, nodeRefMap)).To(BeNil())
+}
+
+func TestComputeCapacityAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(computeCapacityAnnotations(clusterv1.MachinePoolResources{})).To(BeEmpty())
+	g.Expect(computeCapacityAnnotations(clusterv1.MachinePoolResources{
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("2"),
+			corev1.ResourceMemory:           resource.MustParse("4Gi"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			corev1.ResourcePods:             resource.MustParse("110"),
+			"nvidia.com/gpu":                resource.MustParse("2"),
+			"amd.com/gpu":                   resource.MustParse("0"),
+		},
+	})).To(Equal(map[string]string{
+		clusterv1.AutoscalerCapacityCPUAnnotation:           "2",
+		clusterv1.AutoscalerCapacityMemoryAnnotation:        "4Gi",
+		clusterv1.AutoscalerCapacityEphemeralDiskAnnotation: "100Gi",
+		clusterv1.AutoscalerCapacityMaxPodsAnnotation:       "110",
+		clusterv1.AutoscalerCapacityGPUTypeAnnotation:       "nvidia.com/gpu",
+		clusterv1.AutoscalerCapacityGPUCountAnnotation:      "2",
+	}))
+}
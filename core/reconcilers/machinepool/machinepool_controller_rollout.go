@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/blang/semver/v4"
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/version"
+)
+
+// reconcileRollout replaces outdated MachinePool Machines when using the RollingUpdate rollout strategy.
+//
+// The MachinePool controller does not create instances; while a rollout is in progress it surfaces the number of
+// surge replicas to the infrastructure provider via the MachinePoolSurgeReplicasAnnotation on the InfraMachinePool
+// and deletes outdated Machines within the maxUnavailable budget. Deleting a Machine drains its Node and deletes the
+// corresponding InfraMachine, and the infrastructure provider is then expected to replace the instance with an
+// up-to-date one.
+// The progress of the rollout is surfaced on the RollingOut condition of the MachinePool.
+func (r *Reconciler) reconcileRollout(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	mp := s.machinePool
+
+	if s.infraMachinePool == nil {
+		return ctrl.Result{}, nil
+	}
+
+	if mp.Spec.Rollout.Strategy.Type != clusterv1.RollingUpdateMachinePoolRolloutStrategyType {
+		conditions.Delete(mp, clusterv1.MachinePoolRollingOutCondition)
+		return ctrl.Result{}, r.reconcileSurgeReplicasAnnotation(ctx, s, nil)
+	}
+
+	hasMachinePoolMachines, err := s.hasMachinePoolMachines()
+	if err != nil {
+		setRollingOutInternalErrorCondition(mp)
+		return ctrl.Result{}, err
+	}
+	if !hasMachinePoolMachines {
+		// Deleting synthesized Machines does not delete the corresponding instances, so the rollout
+		// can only be performed by the infrastructure provider.
+		log.V(4).Info("Skipping RollingUpdate rollout, MachinePool Machines are not supported by the infrastructure provider")
+		conditions.Delete(mp, clusterv1.MachinePoolRollingOutCondition)
+		return ctrl.Result{}, r.reconcileSurgeReplicasAnnotation(ctx, s, nil)
+	}
+
+	outdatedMachines := getOutdatedMachines(mp, s.machines)
+	if len(outdatedMachines) == 0 {
+		conditions.Set(mp, metav1.Condition{
+			Type:   clusterv1.MachinePoolRollingOutCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.MachinePoolNotRollingOutReason,
+		})
+		return ctrl.Result{}, r.reconcileSurgeReplicasAnnotation(ctx, s, nil)
+	}
+
+	desiredReplicas := int(ptr.Deref(mp.Spec.Replicas, 0))
+	maxSurge, maxUnavailable, err := resolveRollingUpdateParameters(mp.Spec.Rollout.Strategy.RollingUpdate, desiredReplicas)
+	if err != nil {
+		setRollingOutInternalErrorCondition(mp)
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileSurgeReplicasAnnotation(ctx, s, ptr.To(maxSurge)); err != nil {
+		setRollingOutInternalErrorCondition(mp)
+		return ctrl.Result{}, err
+	}
+
+	machinesToRollout := getMachinesToRollout(s.machines, outdatedMachines, desiredReplicas, maxUnavailable)
+	setRollingOutCondition(mp, s.machines, outdatedMachines, machinesToRollout, desiredReplicas, maxSurge)
+
+	var errs []error
+	for _, machine := range machinesToRollout {
+		log.Info("Deleting outdated MachinePool Machine", "Machine", klog.KObj(machine), "version", machine.Spec.Version)
+		if err := r.Client.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, pkgerrors.Wrapf(err, "failed to delete Machine %s", klog.KObj(machine)))
+		}
+	}
+	return ctrl.Result{}, kerrors.NewAggregate(errs)
+}
+
+// setRollingOutCondition sets the RollingOut condition of a MachinePool with outdated Machines.
+// The rollout is not progressing if no outdated Machine can be deleted and the infrastructure provider
+// did not create surge replicas, e.g. because it does not honor the MachinePoolSurgeReplicasAnnotation.
+func setRollingOutCondition(mp *clusterv1.MachinePool, machines, outdatedMachines, machinesToRollout []*clusterv1.Machine, desiredReplicas, maxSurge int) {
+	currentMachines := 0
+	for _, machine := range machines {
+		if machine.DeletionTimestamp.IsZero() {
+			currentMachines++
+		}
+	}
+
+	if len(machinesToRollout) == 0 && maxSurge > 0 && currentMachines <= desiredReplicas {
+		conditions.Set(mp, metav1.Condition{
+			Type:   clusterv1.MachinePoolRollingOutCondition,
+			Status: metav1.ConditionTrue,
+			Reason: clusterv1.MachinePoolRollingOutWaitingForSurgeReplicasReason,
+			Message: fmt.Sprintf("Rolling out %d not up-to-date replicas, waiting for the infrastructure provider to create up to %d surge replicas; "+
+				"if the infrastructure provider does not honor the %s annotation, set maxUnavailable to allow the rollout to progress",
+				len(outdatedMachines), maxSurge, clusterv1.MachinePoolSurgeReplicasAnnotation),
+		})
+		return
+	}
+
+	conditions.Set(mp, metav1.Condition{
+		Type:    clusterv1.MachinePoolRollingOutCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.MachinePoolRollingOutReason,
+		Message: fmt.Sprintf("Rolling out %d not up-to-date replicas", len(outdatedMachines)),
+	})
+}
+
+func setRollingOutInternalErrorCondition(mp *clusterv1.MachinePool) {
+	conditions.Set(mp, metav1.Condition{
+		Type:    clusterv1.MachinePoolRollingOutCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  clusterv1.MachinePoolRollingOutInternalErrorReason,
+		Message: "Please check controller logs for errors",
+	})
+}
+
+// reconcileSurgeReplicasAnnotation sets the MachinePoolSurgeReplicasAnnotation on the InfraMachinePool
+// to surgeReplicas, or removes it if surgeReplicas is nil.
+func (r *Reconciler) reconcileSurgeReplicasAnnotation(ctx context.Context, s *scope, surgeReplicas *int) error {
+	infraMachinePool := s.infraMachinePool
+	currentValue, hasAnnotation := infraMachinePool.GetAnnotations()[clusterv1.MachinePoolSurgeReplicasAnnotation]
+	if surgeReplicas == nil && !hasAnnotation {
+		return nil
+	}
+	if surgeReplicas != nil && hasAnnotation && currentValue == strconv.Itoa(*surgeReplicas) {
+		return nil
+	}
+
+	patchHelper, err := patch.NewHelper(infraMachinePool, r.Client)
+	if err != nil {
+		return err
+	}
+
+	annotations := infraMachinePool.GetAnnotations()
+	if surgeReplicas == nil {
+		delete(annotations, clusterv1.MachinePoolSurgeReplicasAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[clusterv1.MachinePoolSurgeReplicasAnnotation] = strconv.Itoa(*surgeReplicas)
+	}
+	infraMachinePool.SetAnnotations(annotations)
+
+	if err := patchHelper.Patch(ctx, infraMachinePool); err != nil {
+		return pkgerrors.Wrapf(err, "failed to patch %s %s", infraMachinePool.GetKind(), klog.KObj(infraMachinePool))
+	}
+	return nil
+}
+
+// resolveRollingUpdateParameters returns the absolute values of maxSurge and maxUnavailable,
+// rounding up maxSurge and rounding down maxUnavailable like for MachineDeployments.
+func resolveRollingUpdateParameters(rollingUpdate clusterv1.MachinePoolRolloutStrategyRollingUpdate, desiredReplicas int) (int, int, error) {
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(rollingUpdate.MaxSurge, intstr.FromInt32(1))), desiredReplicas, true)
+	if err != nil {
+		return 0, 0, pkgerrors.Wrap(err, "failed to resolve maxSurge")
+	}
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(rollingUpdate.MaxUnavailable, intstr.FromInt32(0))), desiredReplicas, false)
+	if err != nil {
+		return 0, 0, pkgerrors.Wrap(err, "failed to resolve maxUnavailable")
+	}
+
+	// Validation doesn't allow maxSurge and maxUnavailable to both be 0, but they can both
+	// resolve to 0 when using percentages; in this case make sure the rollout can progress.
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+	return maxSurge, maxUnavailable, nil
+}
+
+// getOutdatedMachines returns the Machines which are not being deleted and whose version
+// is not the version of the MachinePool.
+func getOutdatedMachines(mp *clusterv1.MachinePool, machines []*clusterv1.Machine) []*clusterv1.Machine {
+	var outdatedMachines []*clusterv1.Machine
+	for _, machine := range machines {
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if isMachineOutdated(mp, machine) {
+			outdatedMachines = append(outdatedMachines, machine)
+		}
+	}
+	return outdatedMachines
+}
+
+// isMachineOutdated returns true if the version of the Machine is not the version of the MachinePool.
+// Pre-release and build metadata are ignored, because the version of MachinePool Machines
+// is the kubelet version of the corresponding Node, which might include a provider specific suffix.
+// Machines without a version, e.g. because the Node doesn't exist yet, are never considered outdated.
+func isMachineOutdated(mp *clusterv1.MachinePool, machine *clusterv1.Machine) bool {
+	if mp.Spec.Template.Spec.Version == "" || machine.Spec.Version == "" {
+		return false
+	}
+
+	desiredVersion, err := semver.ParseTolerant(mp.Spec.Template.Spec.Version)
+	if err != nil {
+		return false
+	}
+	machineVersion, err := semver.ParseTolerant(machine.Spec.Version)
+	if err != nil {
+		return false
+	}
+	return version.Compare(version.MajorMinorPatch(desiredVersion), version.MajorMinorPatch(machineVersion)) != 0
+}
+
+// outdatedMachineMessage returns the message surfaced on the UpToDate condition of outdated Machines.
+func outdatedMachineMessage(mp *clusterv1.MachinePool, machine *clusterv1.Machine) string {
+	return fmt.Sprintf("* Version %s, %s required", machine.Spec.Version, mp.Spec.Template.Spec.Version)
+}
+
+// getMachinesToRollout returns the outdated Machines which can be deleted without having more than
+// maxUnavailable of the desired replicas unavailable.
+// Outdated Machines which are not available are deleted first, and they can always be deleted
+// given that deleting them does not reduce the number of available Machines.
+func getMachinesToRollout(machines, outdatedMachines []*clusterv1.Machine, desiredReplicas, maxUnavailable int) []*clusterv1.Machine {
+	availableMachines := 0
+	for _, machine := range machines {
+		if machine.DeletionTimestamp.IsZero() && conditions.IsTrue(machine, clusterv1.MachineAvailableCondition) {
+			availableMachines++
+		}
+	}
+	budget := availableMachines - (desiredReplicas - maxUnavailable)
+
+	candidates := append([]*clusterv1.Machine(nil), outdatedMachines...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		iAvailable := conditions.IsTrue(candidates[i], clusterv1.MachineAvailableCondition)
+		jAvailable := conditions.IsTrue(candidates[j], clusterv1.MachineAvailableCondition)
+		if iAvailable != jAvailable {
+			return !iAvailable
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	var machinesToRollout []*clusterv1.Machine
+	for _, machine := range candidates {
+		if !conditions.IsTrue(machine, clusterv1.MachineAvailableCondition) {
+			machinesToRollout = append(machinesToRollout, machine)
+			continue
+		}
+		if budget <= 0 {
+			break
+		}
+		machinesToRollout = append(machinesToRollout, machine)
+		budget--
+	}
+	return machinesToRollout
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestIsMachineOutdated(t *testing.T) {
+	tests := []struct {
+		name           string
+		mpVersion      string
+		machineVersion string
+		expected       bool
+	}{
+		{
+			name:           "Machine with the MachinePool version is not outdated",
+			mpVersion:      "v1.34.0",
+			machineVersion: "v1.34.0",
+			expected:       false,
+		},
+		{
+			name:           "Machine with a provider specific suffix is not outdated",
+			mpVersion:      "v1.34.0",
+			machineVersion: "v1.34.0-eks-1552ad0",
+			expected:       false,
+		},
+		{
+			name:           "Machine without a version is not outdated",
+			mpVersion:      "v1.34.0",
+			machineVersion: "",
+			expected:       false,
+		},
+		{
+			name:           "Machine is not outdated if the MachinePool has no version",
+			mpVersion:      "",
+			machineVersion: "v1.33.0",
+			expected:       false,
+		},
+		{
+			name:           "Machine with another version is outdated",
+			mpVersion:      "v1.34.0",
+			machineVersion: "v1.33.2",
+			expected:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mp := &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{Version: tt.mpVersion},
+			}}}
+			machine := &clusterv1.Machine{Spec: clusterv1.MachineSpec{Version: tt.machineVersion}}
+			g.Expect(isMachineOutdated(mp, machine)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestResolveRollingUpdateParameters(t *testing.T) {
+	tests := []struct {
+		name                   string
+		rollingUpdate          clusterv1.MachinePoolRolloutStrategyRollingUpdate
+		desiredReplicas        int
+		expectedMaxSurge       int
+		expectedMaxUnavailable int
+	}{
+		{
+			name:                   "Defaults are used if not set",
+			desiredReplicas:        3,
+			expectedMaxSurge:       1,
+			expectedMaxUnavailable: 0,
+		},
+		{
+			name: "Percentages are rounded up for maxSurge and down for maxUnavailable",
+			rollingUpdate: clusterv1.MachinePoolRolloutStrategyRollingUpdate{
+				MaxSurge:       ptr.To(intstr.FromString("25%")),
+				MaxUnavailable: ptr.To(intstr.FromString("25%")),
+			},
+			desiredReplicas:        10,
+			expectedMaxSurge:       3,
+			expectedMaxUnavailable: 2,
+		},
+		{
+			name: "maxUnavailable is 1 if both resolve to 0",
+			rollingUpdate: clusterv1.MachinePoolRolloutStrategyRollingUpdate{
+				MaxSurge:       ptr.To(intstr.FromString("0%")),
+				MaxUnavailable: ptr.To(intstr.FromString("10%")),
+			},
+			desiredReplicas:        3,
+			expectedMaxSurge:       0,
+			expectedMaxUnavailable: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			maxSurge, maxUnavailable, err := resolveRollingUpdateParameters(tt.rollingUpdate, tt.desiredReplicas)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(maxSurge).To(Equal(tt.expectedMaxSurge))
+			g.Expect(maxUnavailable).To(Equal(tt.expectedMaxUnavailable))
+		})
+	}
+}
+
+func TestGetMachinesToRollout(t *testing.T) {
+	now := metav1.Now()
+
+	upToDate1 := rolloutTestMachine("up-to-date-1", true)
+	upToDate2 := rolloutTestMachine("up-to-date-2", true)
+	outdated1 := rolloutTestMachine("outdated-1", true)
+	outdated2 := rolloutTestMachine("outdated-2", true)
+	outdated3 := rolloutTestMachine("outdated-3", true)
+	outdatedUnavailable := rolloutTestMachine("outdated-unavailable", false)
+	deleting := rolloutTestMachine("deleting", true)
+	deleting.DeletionTimestamp = &now
+
+	tests := []struct {
+		name             string
+		machines         []*clusterv1.Machine
+		outdatedMachines []*clusterv1.Machine
+		desiredReplicas  int
+		maxUnavailable   int
+		expected         []*clusterv1.Machine
+	}{
+		{
+			name:             "No Machines are deleted before surge Machines are available",
+			machines:         []*clusterv1.Machine{outdated1, outdated2, outdated3},
+			outdatedMachines: []*clusterv1.Machine{outdated1, outdated2, outdated3},
+			desiredReplicas:  3,
+			maxUnavailable:   0,
+			expected:         nil,
+		},
+		{
+			name:             "Outdated Machines are deleted when surge Machines are available",
+			machines:         []*clusterv1.Machine{outdated1, outdated2, outdated3, upToDate1},
+			outdatedMachines: []*clusterv1.Machine{outdated3, outdated2, outdated1},
+			desiredReplicas:  3,
+			maxUnavailable:   0,
+			expected:         []*clusterv1.Machine{outdated1},
+		},
+		{
+			name:             "Outdated Machines are deleted within maxUnavailable",
+			machines:         []*clusterv1.Machine{outdated1, outdated2, outdated3},
+			outdatedMachines: []*clusterv1.Machine{outdated1, outdated2, outdated3},
+			desiredReplicas:  3,
+			maxUnavailable:   2,
+			expected:         []*clusterv1.Machine{outdated1, outdated2},
+		},
+		{
+			name:             "Deleting Machines are not counted as available",
+			machines:         []*clusterv1.Machine{deleting, outdated1, outdated2, upToDate1},
+			outdatedMachines: []*clusterv1.Machine{outdated1, outdated2},
+			desiredReplicas:  3,
+			maxUnavailable:   0,
+			expected:         nil,
+		},
+		{
+			name:             "Unavailable outdated Machines are deleted first and do not count against maxUnavailable",
+			machines:         []*clusterv1.Machine{outdated1, outdatedUnavailable, upToDate1, upToDate2},
+			outdatedMachines: []*clusterv1.Machine{outdated1, outdatedUnavailable},
+			desiredReplicas:  3,
+			maxUnavailable:   0,
+			expected:         []*clusterv1.Machine{outdatedUnavailable},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			outdatedMachines := append([]*clusterv1.Machine(nil), tt.outdatedMachines...)
+			g.Expect(getMachinesToRollout(tt.machines, outdatedMachines, tt.desiredReplicas, tt.maxUnavailable)).To(Equal(tt.expected))
+			// The slice of the caller must not be changed.
+			g.Expect(outdatedMachines).To(Equal(tt.outdatedMachines))
+		})
+	}
+}
+
+func TestSetRollingOutCondition(t *testing.T) {
+	upToDate := rolloutTestMachine("up-to-date", false)
+	outdated1 := rolloutTestMachine("outdated-1", true)
+	outdated2 := rolloutTestMachine("outdated-2", true)
+
+	tests := []struct {
+		name              string
+		machines          []*clusterv1.Machine
+		outdatedMachines  []*clusterv1.Machine
+		machinesToRollout []*clusterv1.Machine
+		maxSurge          int
+		expectedReason    string
+		expectedMessage   string
+	}{
+		{
+			name:              "Rolling out when outdated Machines are deleted",
+			machines:          []*clusterv1.Machine{outdated1, outdated2},
+			outdatedMachines:  []*clusterv1.Machine{outdated1, outdated2},
+			machinesToRollout: []*clusterv1.Machine{outdated1},
+			maxSurge:          0,
+			expectedReason:    clusterv1.MachinePoolRollingOutReason,
+			expectedMessage:   "Rolling out 2 not up-to-date replicas",
+		},
+		{
+			name:              "Rolling out when the infrastructure provider created surge replicas",
+			machines:          []*clusterv1.Machine{outdated1, outdated2, upToDate},
+			outdatedMachines:  []*clusterv1.Machine{outdated1, outdated2},
+			machinesToRollout: nil,
+			maxSurge:          1,
+			expectedReason:    clusterv1.MachinePoolRollingOutReason,
+			expectedMessage:   "Rolling out 2 not up-to-date replicas",
+		},
+		{
+			name:              "Waiting for surge replicas when no outdated Machine can be deleted",
+			machines:          []*clusterv1.Machine{outdated1, outdated2},
+			outdatedMachines:  []*clusterv1.Machine{outdated1, outdated2},
+			machinesToRollout: nil,
+			maxSurge:          1,
+			expectedReason:    clusterv1.MachinePoolRollingOutWaitingForSurgeReplicasReason,
+			expectedMessage: "Rolling out 2 not up-to-date replicas, waiting for the infrastructure provider to create up to 1 surge replicas; " +
+				"if the infrastructure provider does not honor the machinepool.cluster.x-k8s.io/surge-replicas annotation, set maxUnavailable to allow the rollout to progress",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mp := &clusterv1.MachinePool{}
+			setRollingOutCondition(mp, tt.machines, tt.outdatedMachines, tt.machinesToRollout, 2, tt.maxSurge)
+
+			condition := conditions.Get(mp, clusterv1.MachinePoolRollingOutCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			g.Expect(condition.Reason).To(Equal(tt.expectedReason))
+			g.Expect(condition.Message).To(Equal(tt.expectedMessage))
+		})
+	}
+}
+
+func rolloutTestMachine(name string, available bool) *clusterv1.Machine {
+	status := metav1.ConditionFalse
+	if available {
+		status = metav1.ConditionTrue
+	}
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1.MachineStatus{
+			Conditions: []metav1.Condition{{Type: clusterv1.MachineAvailableCondition, Status: status}},
+		},
+	}
+}
@@ -26,12 +26,15 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	internalversion "sigs.k8s.io/cluster-api/internal/util/version"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	log := ctrl.LoggerFrom(ctx)
 
+	setAutoscaling(s.machinePool)
+
 	if s.infraMachinePool == nil {
 		log.V(4).Info("infra machine pool isn't set, skipping setting status")
 		return nil
@@ -48,6 +51,11 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 	return nil
 }
 
+// setAutoscaling sets the status of the cluster autoscaler node group of the MachinePool.
+func setAutoscaling(mp *clusterv1.MachinePool) {
+	mp.Status.Autoscaling = annotations.GetAutoscalerNodeGroupStatus(mp, mp.Spec.Replicas)
+}
+
 func setReplicas(mp *clusterv1.MachinePool, hasMachinePoolMachines bool, machines []*clusterv1.Machine, nodeRefMap map[string]*corev1.Node) {
 	if !hasMachinePoolMachines {
 		// If we don't have machinepool machine then calculate the values differently
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/labels/format"
+)
+
+// maxSynthesizedMachineNamePrefixLength is the maximum length of the MachinePool name used as a prefix
+// for the name of synthesized Machines, so that the prefix and the providerID hash fit into 253 characters.
+const maxSynthesizedMachineNamePrefixLength = 243
+
+// reconcileSynthesizedMachines reconciles Machines for a MachinePool whose infrastructure provider does not implement
+// MachinePool Machines, i.e. the InfraMachinePool does not set status.infrastructureMachineKind.
+//
+// In this case there are no InfraMachines, and the MachinePool controller synthesizes a Machine for each providerID
+// in spec.providerIDList of the InfraMachinePool. Synthesized Machines reference the InfraMachinePool as infrastructureRef,
+// and they are marked with the MachinePoolSynthesizedMachineAnnotation so the Machine controller never deletes it.
+// Synthesized Machines for providerIDs which are not in spec.providerIDList anymore are deleted.
+func (r *Reconciler) reconcileSynthesizedMachines(ctx context.Context, s *scope, infraMachinePool *unstructured.Unstructured) error {
+	log := ctrl.LoggerFrom(ctx)
+	mp := s.machinePool
+
+	var providerIDList []string
+	if err := util.UnstructuredUnmarshalField(infraMachinePool, &providerIDList, "spec", "providerIDList"); err != nil && !pkgerrors.Is(err, util.ErrUnstructuredFieldNotFound) {
+		return pkgerrors.Wrapf(err, "failed to retrieve providerIDList from infrastructure provider for MachinePool %s", klog.KObj(mp))
+	}
+
+	machineList := &clusterv1.MachineList{}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(mp.Namespace), client.MatchingLabels{
+		clusterv1.MachinePoolNameLabel: format.MustFormatValue(mp.Name),
+		clusterv1.ClusterNameLabel:     mp.Spec.ClusterName,
+	}); err != nil {
+		return err
+	}
+
+	providerIDToMachine := map[string]*clusterv1.Machine{}
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		if !isSynthesizedMachine(machine) || shouldExcludeMachine(mp, machine) {
+			continue
+		}
+		providerIDToMachine[machine.Spec.ProviderID] = machine
+	}
+
+	createdMachines := []clusterv1.Machine{}
+	var errs []error
+	for _, providerID := range providerIDList {
+		if providerID == "" {
+			continue
+		}
+
+		existingMachine := providerIDToMachine[providerID]
+		if existingMachine != nil && !existingMachine.DeletionTimestamp.IsZero() {
+			// Wait for the deletion to complete before synthesizing a new Machine for the providerID.
+			continue
+		}
+
+		desiredMachine := computeDesiredSynthesizedMachine(mp, providerID, existingMachine, s.nodeRefMap[providerID])
+		if existingMachine != nil {
+			if err := ssa.Patch(ctx, r.Client, MachinePoolControllerName, desiredMachine, ssa.WithCachingProxy{Cache: r.ssaCache, Original: existingMachine}); err != nil {
+				errs = append(errs, pkgerrors.Wrapf(err, "failed to update Machine %s", klog.KObj(desiredMachine)))
+			}
+			continue
+		}
+
+		log.Info("Creating new Machine for MachinePool replica", "Machine", klog.KObj(desiredMachine), "providerID", providerID)
+		if err := ssa.Patch(ctx, r.Client, MachinePoolControllerName, desiredMachine); err != nil {
+			errs = append(errs, pkgerrors.Wrapf(err, "failed to create new Machine for providerID %q", providerID))
+			continue
+		}
+		createdMachines = append(createdMachines, *desiredMachine)
+	}
+
+	// Delete synthesized Machines for replicas which have been removed from the InfraMachinePool.
+	providerIDs := sets.New(providerIDList...)
+	for providerID, machine := range providerIDToMachine {
+		if providerIDs.Has(providerID) || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		log.Info("Deleting Machine for removed MachinePool replica", "Machine", klog.KObj(machine), "providerID", providerID)
+		if err := r.Client.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, pkgerrors.Wrapf(err, "failed to delete Machine %s", klog.KObj(machine)))
+		}
+	}
+
+	if err := r.waitForMachineCreation(ctx, createdMachines); err != nil {
+		errs = append(errs, pkgerrors.Wrapf(err, "failed to wait for machines to be created"))
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// computeDesiredSynthesizedMachine constructs the desired synthesized Machine for a providerID of a MachinePool.
+func computeDesiredSynthesizedMachine(mp *clusterv1.MachinePool, providerID string, existingMachine *clusterv1.Machine, existingNode *corev1.Node) *clusterv1.Machine {
+	var kubernetesVersion string
+	if existingNode != nil && existingNode.Status.NodeInfo.KubeletVersion != "" {
+		kubernetesVersion = existingNode.Status.NodeInfo.KubeletVersion
+	}
+
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: synthesizedMachineName(mp, providerID),
+			// Note: by setting the ownerRef on creation we signal to the Machine controller that this is not a stand-alone Machine.
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(mp, machinePoolKind)},
+			Namespace:       mp.Namespace,
+			Labels:          make(map[string]string),
+			Annotations:     make(map[string]string),
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: mp.Spec.ClusterName,
+			Bootstrap: clusterv1.Bootstrap{
+				DataSecretName: ptr.To(""),
+			},
+			InfrastructureRef: mp.Spec.Template.Spec.InfrastructureRef,
+			ProviderID:        providerID,
+			Version:           kubernetesVersion,
+		},
+	}
+
+	if existingMachine != nil {
+		machine.SetName(existingMachine.Name)
+		machine.SetUID(existingMachine.UID)
+	}
+
+	for k, v := range mp.Spec.Template.Annotations {
+		machine.Annotations[k] = v
+	}
+	machine.Annotations[clusterv1.MachinePoolSynthesizedMachineAnnotation] = ""
+
+	// Note: We can't just set `machinePool.Spec.Template.Labels` directly, see computeDesiredMachine.
+	for k, v := range mp.Spec.Template.Labels {
+		machine.Labels[k] = v
+	}
+
+	// Enforce that the MachinePoolNameLabel and ClusterNameLabel are present on the Machine.
+	machine.Labels[clusterv1.MachinePoolNameLabel] = format.MustFormatValue(mp.Name)
+	machine.Labels[clusterv1.ClusterNameLabel] = mp.Spec.ClusterName
+
+	return machine
+}
+
+// synthesizedMachineName returns a deterministic name for the synthesized Machine of a providerID of a MachinePool.
+func synthesizedMachineName(mp *clusterv1.MachinePool, providerID string) string {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(providerID))
+
+	prefix := mp.Name
+	if len(prefix) > maxSynthesizedMachineNamePrefixLength {
+		prefix = strings.TrimRight(prefix[:maxSynthesizedMachineNamePrefixLength], "-.")
+	}
+	return fmt.Sprintf("%s-%08x", prefix, hasher.Sum32())
+}
+
+// isSynthesizedMachine returns true if the Machine has been synthesized by the MachinePool controller.
+func isSynthesizedMachine(machine *clusterv1.Machine) bool {
+	_, ok := machine.Annotations[clusterv1.MachinePoolSynthesizedMachineAnnotation]
+	return ok
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/labels/format"
+)
+
+func TestComputeDesiredSynthesizedMachine(t *testing.T) {
+	mp := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mp",
+			Namespace: "default",
+			UID:       "mp-uid",
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			ClusterName: "cluster",
+			Template: clusterv1.MachineTemplateSpec{
+				ObjectMeta: clusterv1.ObjectMeta{
+					Labels:      map[string]string{"foo": "bar"},
+					Annotations: map[string]string{"baz": "qux"},
+				},
+				Spec: clusterv1.MachineSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						APIGroup: clusterv1.GroupVersionInfrastructure.Group,
+						Kind:     "GenericInfrastructureMachinePool",
+						Name:     "mp-infra",
+					},
+				},
+			},
+		},
+	}
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.34.0"}},
+	}
+
+	t.Run("new Machine", func(t *testing.T) {
+		g := NewWithT(t)
+
+		machine := computeDesiredSynthesizedMachine(mp, "aws:///us-east-1a/i-1", nil, node)
+		g.Expect(machine.Name).To(Equal(synthesizedMachineName(mp, "aws:///us-east-1a/i-1")))
+		g.Expect(machine.Namespace).To(Equal(mp.Namespace))
+		g.Expect(metav1.IsControlledBy(machine, mp)).To(BeTrue())
+		g.Expect(machine.Labels).To(Equal(map[string]string{
+			"foo":                          "bar",
+			clusterv1.MachinePoolNameLabel: format.MustFormatValue(mp.Name),
+			clusterv1.ClusterNameLabel:     mp.Spec.ClusterName,
+		}))
+		g.Expect(machine.Annotations).To(Equal(map[string]string{
+			"baz": "qux",
+			clusterv1.MachinePoolSynthesizedMachineAnnotation: "",
+		}))
+		g.Expect(machine.Spec.InfrastructureRef).To(Equal(mp.Spec.Template.Spec.InfrastructureRef))
+		g.Expect(machine.Spec.ProviderID).To(Equal("aws:///us-east-1a/i-1"))
+		g.Expect(machine.Spec.Bootstrap.DataSecretName).To(Equal(ptr.To("")))
+		g.Expect(machine.Spec.Version).To(Equal("v1.34.0"))
+		g.Expect(isSynthesizedMachine(machine)).To(BeTrue())
+		// Changing the Machine labels must not change the MachinePool.
+		g.Expect(mp.Spec.Template.Labels).To(Equal(map[string]string{"foo": "bar"}))
+	})
+
+	t.Run("existing Machine", func(t *testing.T) {
+		g := NewWithT(t)
+
+		existingMachine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "existing",
+				UID:  types.UID("existing-uid"),
+			},
+		}
+		machine := computeDesiredSynthesizedMachine(mp, "aws:///us-east-1a/i-1", existingMachine, nil)
+		g.Expect(machine.Name).To(Equal("existing"))
+		g.Expect(machine.UID).To(Equal(types.UID("existing-uid")))
+		g.Expect(machine.Spec.Version).To(BeEmpty())
+	})
+}
+
+func TestSynthesizedMachineName(t *testing.T) {
+	g := NewWithT(t)
+
+	mp := &clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: "mp"}}
+	g.Expect(synthesizedMachineName(mp, "id-1")).To(Equal(synthesizedMachineName(mp, "id-1")))
+	g.Expect(synthesizedMachineName(mp, "id-1")).ToNot(Equal(synthesizedMachineName(mp, "id-2")))
+	g.Expect(synthesizedMachineName(mp, "id-1")).To(HavePrefix("mp-"))
+
+	longMP := &clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 253)}}
+	g.Expect(len(synthesizedMachineName(longMP, "id-1"))).To(BeNumerically("<=", 253))
+}
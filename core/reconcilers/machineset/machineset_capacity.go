@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+)
+
+// reconcileCapacity surfaces the capacity of a single replica of the MachineSet in status.capacity, and
+// computes the capacity annotations used by the cluster autoscaler to scale the MachineSet from zero.
+//
+// The capacity is read from status.capacity of the InfraMachineTemplate if reported by the infrastructure provider;
+// otherwise the last known value is preserved.
+// Note: MachineSets which are part of a MachineDeployment are skipped, because in this case the node group of the
+// cluster autoscaler is the MachineDeployment.
+func (r *Reconciler) reconcileCapacity(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ms := s.machineSet
+	infrastructureTemplate := s.infrastructureTemplate
+
+	if s.owningMachineDeployment != nil || infrastructureTemplate == nil {
+		return ctrl.Result{}, nil
+	}
+
+	capacity, err := getCapacityFromInfraMachineTemplate(infrastructureTemplate)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to retrieve capacity from %s %s", infrastructureTemplate.GetKind(), klog.KObj(infrastructureTemplate))
+	}
+	if len(capacity) == 0 || equality.Semantic.DeepEqual(capacity, ms.Status.Capacity) {
+		return ctrl.Result{}, nil
+	}
+
+	log.V(4).Info("Updating MachineSet capacity", "capacity", capacity)
+	ms.SetAnnotations(annotations.MergeAutoscalerCapacityAnnotations(ms.GetAnnotations(), annotations.AutoscalerCapacityAnnotations(ms.Status.Capacity), annotations.AutoscalerCapacityAnnotations(capacity)))
+	ms.Status.Capacity = capacity
+	return ctrl.Result{}, nil
+}
+
+// getCapacityFromInfraMachineTemplate returns the capacity reported in status.capacity of the InfraMachineTemplate,
+// or nil if the infrastructure provider doesn't report capacity.
+func getCapacityFromInfraMachineTemplate(infrastructureTemplate *unstructured.Unstructured) (corev1.ResourceList, error) {
+	capacity := corev1.ResourceList{}
+	if err := util.UnstructuredUnmarshalField(infrastructureTemplate, &capacity, "status", "capacity"); err != nil {
+		if pkgerrors.Is(err, util.ErrUnstructuredFieldNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return capacity, nil
+}
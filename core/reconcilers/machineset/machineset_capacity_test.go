@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileCapacity(t *testing.T) {
+	infrastructureTemplate := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"capacity": map[string]interface{}{
+				"cpu":    "2",
+				"memory": "4Gi",
+			},
+		},
+	}}
+
+	t.Run("capacity annotations are computed for MachineSets not part of a MachineDeployment", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := &scope{
+			machineSet:             &clusterv1.MachineSet{ObjectMeta: metav1.ObjectMeta{Name: "ms", Namespace: metav1.NamespaceDefault}},
+			infrastructureTemplate: infrastructureTemplate,
+		}
+
+		r := &Reconciler{}
+		_, err := r.reconcileCapacity(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.machineSet.Annotations).To(Equal(map[string]string{
+			clusterv1.AutoscalerCapacityCPUAnnotation:    "2",
+			clusterv1.AutoscalerCapacityMemoryAnnotation: "4Gi",
+		}))
+		g.Expect(s.machineSet.Status.Capacity).To(BeComparableTo(corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		}))
+	})
+
+	t.Run("MachineSets part of a MachineDeployment are skipped", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := &scope{
+			machineSet:              &clusterv1.MachineSet{ObjectMeta: metav1.ObjectMeta{Name: "ms", Namespace: metav1.NamespaceDefault}},
+			owningMachineDeployment: &clusterv1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: metav1.NamespaceDefault}},
+			infrastructureTemplate:  infrastructureTemplate,
+		}
+
+		r := &Reconciler{}
+		_, err := r.reconcileCapacity(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(s.machineSet.Annotations).To(BeNil())
+		g.Expect(s.machineSet.Status.Capacity).To(BeNil())
+	})
+}
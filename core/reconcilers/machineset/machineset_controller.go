@@ -51,6 +51,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	"sigs.k8s.io/cluster-api/core/reconcilers/machine"
 	coreadmission "sigs.k8s.io/cluster-api/core/webhooks/admission"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
@@ -68,6 +69,8 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/paused"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 var (
@@ -100,11 +103,18 @@ type Reconciler struct {
 	ClusterCache                    clustercache.ClusterCache
 	machineClientWithDeleteResponse capicontrollerutil.ClientWithDeleteResponse
 
+	// RuntimeClient is used to call the CanCreateMachine hook as part of the preflight checks.
+	RuntimeClient runtimeclient.Client
+
 	PreflightChecks sets.Set[clusterv1.MachineSetPreflightCheck]
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
 	ssaCache   ssa.Cache
 	controller capicontrollerutil.Controller
 	recorder   record.EventRecorder
@@ -148,6 +158,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			handler.EnqueueRequestsFromMapFunc(mdToMachineSets),
 		).
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Watches(
 			&clusterv1.Cluster{},
@@ -184,6 +195,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 	}
 
 	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("Cluster", klog.KRef(machineSet.Namespace, machineSet.Spec.ClusterName)))
+	tracing.SetClusterName(ctx, machineSet.Spec.ClusterName)
 
 	// AddOwners adds the owners of MachineSet as k/v pairs to the logger.
 	// Specifically, it will add MachineDeployment.
@@ -267,6 +279,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 	}
 
 	reconcileNormal := append(alwaysReconcile,
+		wrapErrMachineSetReconcileFunc(r.reconcileCapacity, "failed to reconcile capacity"),
 		wrapErrMachineSetReconcileFunc(r.reconcileUnhealthyMachines, "failed to reconcile unhealthy machines"),
 		wrapErrMachineSetReconcileBlockingFunc(r.syncMachines, "failed to sync Machines"),
 		wrapErrMachineSetReconcileFunc(r.triggerInPlaceUpdate, "failed to trigger in-place update"),
@@ -282,6 +295,7 @@ type scope struct {
 	machines                                  []*clusterv1.Machine
 	bootstrapObjectNotFound                   bool
 	infrastructureObjectNotFound              bool
+	infrastructureTemplate                    *unstructured.Unstructured
 	getAndAdoptMachinesForMachineSetSucceeded bool
 	owningMachineDeployment                   *clusterv1.MachineDeployment
 	scaleUpPreflightCheckErrMessages          []string
@@ -441,8 +455,12 @@ func (r *Reconciler) completeMoveMachine(ctx context.Context, s *scope, currentM
 	//       not updated by r.computeDesiredMachine, so we have to update them here.
 	// Note: for MachineSets we have to explicitly also set spec.failureDomain (this is a difference from what happens in KCP
 	//       where the field is set only on create and never updated)
+	// Note: if the MachineSet spreads Machines across failure domains, moved Machines keep their failure domain.
 	desiredMachine.Spec.Version = s.machineSet.Spec.Template.Spec.Version
 	desiredMachine.Spec.FailureDomain = s.machineSet.Spec.Template.Spec.FailureDomain
+	if len(s.machineSet.Spec.Placement.FailureDomains) > 0 {
+		desiredMachine.Spec.FailureDomain = currentMachine.Spec.FailureDomain
+	}
 
 	// Compute desiredInfraMachine.
 	currentInfraMachine, err := external.GetObjectFromContractVersionedRef(ctx, r.Client, currentMachine.Spec.InfrastructureRef, currentMachine.Namespace)
@@ -549,7 +567,7 @@ func (r *Reconciler) reconcileInfrastructure(ctx context.Context, s *scope) (ctr
 	machineSet := s.machineSet
 	// Make sure to reconcile the external infrastructure reference.
 	var err error
-	s.infrastructureObjectNotFound, err = r.reconcileExternalTemplateReference(ctx, cluster, machineSet, s.owningMachineDeployment, machineSet.Spec.Template.Spec.InfrastructureRef)
+	s.infrastructureTemplate, s.infrastructureObjectNotFound, err = r.reconcileExternalTemplateReference(ctx, cluster, machineSet, s.owningMachineDeployment, machineSet.Spec.Template.Spec.InfrastructureRef)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -562,7 +580,7 @@ func (r *Reconciler) reconcileBootstrapConfig(ctx context.Context, s *scope) (ct
 	// Make sure to reconcile the external bootstrap reference, if any.
 	if s.machineSet.Spec.Template.Spec.Bootstrap.ConfigRef.IsDefined() {
 		var err error
-		s.bootstrapObjectNotFound, err = r.reconcileExternalTemplateReference(ctx, cluster, machineSet, s.owningMachineDeployment, machineSet.Spec.Template.Spec.Bootstrap.ConfigRef)
+		_, s.bootstrapObjectNotFound, err = r.reconcileExternalTemplateReference(ctx, cluster, machineSet, s.owningMachineDeployment, machineSet.Spec.Template.Spec.Bootstrap.ConfigRef)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -672,6 +690,7 @@ func (r *Reconciler) syncMachines(ctx context.Context, s *scope) (ctrl.Result, b
 			m.Spec.Deletion.NodeDeletionTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 			coreadmission.DefaultMachineNodeDeletionTimeoutSeconds(m) // Default to avoid unnecessary patch calls if field is not set on MS.
 			m.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
+			m.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
 			m.Spec.MinReadySeconds = machineSet.Spec.Template.Spec.MinReadySeconds
 			m.Spec.Taints = machineSet.Spec.Template.Spec.Taints
 
@@ -935,7 +954,7 @@ func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd
 	ms := s.machineSet
 	cluster := s.cluster
 
-	preflightCheckErrMessages, err := r.runPreflightChecks(ctx, cluster, ms, "Scale up")
+	preflightCheckErrMessages, err := r.runPreflightChecks(ctx, cluster, ms, scaleUpAction)
 	if err != nil || len(preflightCheckErrMessages) > 0 {
 		if err != nil {
 			// If err is not nil use that as the preflightCheckErrMessage
@@ -952,6 +971,13 @@ func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd
 
 	log.V(4).Info(fmt.Sprintf("MachineSet is scaling up to %d replicas by creating %d Machines", *(ms.Spec.Replicas), machinesToAdd), "desiredReplicas", *(ms.Spec.Replicas), "replicas", len(s.machines))
 
+	// If the MachineSet spreads Machines across failure domains, keep track of the number of Machines in each failure domain,
+	// so every new Machine is created in the failure domain with the fewest Machines.
+	var failureDomainCounts map[string]int
+	if len(ms.Spec.Placement.FailureDomains) > 0 {
+		failureDomainCounts = countMachinesByFailureDomain(ms.Spec.Placement.FailureDomains, s.machines)
+	}
+
 	for i := range machinesToAdd {
 		// Create a new logger so the global logger is not modified.
 		log := log
@@ -961,6 +987,13 @@ func (r *Reconciler) createMachines(ctx context.Context, s *scope, machinesToAdd
 				clusterv1.ConditionSeverityError, "%s", computeMachineErr.Error())
 			return ctrl.Result{}, pkgerrors.Wrap(computeMachineErr, "failed to create Machine: failed to compute desired Machine")
 		}
+		if failureDomainCounts != nil {
+			machine.Spec.FailureDomain = leastPopulatedFailureDomain(ms.Spec.Placement.FailureDomains, failureDomainCounts)
+			failureDomainCounts[machine.Spec.FailureDomain]++
+		}
+		if spreadConstraints := ms.Spec.Placement.SpreadConstraints; spreadConstraints != (clusterv1.MachineSpreadConstraints{}) {
+			machine.Spec.PlacementHints = spreadConstraints.PlacementHints(machine.Spec.FailureDomain, defaultSpreadKey(ms))
+		}
 
 		var (
 			infraRef, bootstrapRef        clusterv1.ContractVersionedObjectReference
@@ -1048,11 +1081,30 @@ func (r *Reconciler) deleteMachines(ctx context.Context, s *scope, machinesToDel
 	//   - Move old machines (m1, m2, m3)
 	// - Resulting new MS at this point has 4 replicas m1, m2, m3 (updating in place) and (m4).
 	// - The system scales down MS, and the system does this getting rid of m3 - the last replica that started in place.
-	deletePriorityFunc, err := getDeletePriorityFunc(ms)
+	var nodeUtilization map[string]float64
+	fallbackToRandomDeletionOrder := false
+	if ms.Spec.Deletion.Order == clusterv1.LeastUtilizedMachineSetDeletionOrder {
+		var err error
+		if nodeUtilization, err = r.getNodeUtilization(ctx, s.cluster, machines); err != nil {
+			// Note: Scale down must not be blocked if the utilization of the Nodes cannot be computed,
+			// e.g. because the workload cluster is not reachable.
+			log.Error(err, "Failed to compute the utilization of Nodes, falling back to the Random deletion order")
+			fallbackToRandomDeletionOrder = true
+		}
+	}
+	deletePriorityFunc, err := getDeletePriorityFunc(ms, nodeUtilization)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	machinesToDeleteByPriority := getMachinesToDeletePrioritized(machines, machinesToDelete, deletePriorityFunc)
+	if fallbackToRandomDeletionOrder {
+		deletePriorityFunc = withDeleteLast(randomDeletionOrder)
+	}
+	var machinesToDeleteByPriority []*clusterv1.Machine
+	if len(ms.Spec.Placement.FailureDomains) > 0 {
+		machinesToDeleteByPriority = getMachinesToDeleteSpread(ms.Spec.Placement.FailureDomains, machines, machinesToDelete, deletePriorityFunc)
+	} else {
+		machinesToDeleteByPriority = getMachinesToDeletePrioritized(machines, machinesToDelete, deletePriorityFunc)
+	}
 
 	var errs []error
 	for i, machine := range machinesToDeleteByPriority {
@@ -1106,8 +1158,9 @@ func (r *Reconciler) startMoveMachines(ctx context.Context, s *scope, targetMSNa
 	log.Info(fmt.Sprintf("MachineSet is scaling down to %d replicas by moving %d Machines to %s", *(ms.Spec.Replicas), machinesToMove, targetMSName), "replicas", *(ms.Spec.Replicas), "machineCount", len(machines), "order", cmp.Or(ms.Spec.Deletion.Order, clusterv1.RandomMachineSetDeletionOrder))
 
 	// Sort to Move machine in deterministic and predictable order.
-	// Note: For convenience we sort machine using the ordering criteria defined in ms.Spec.Deletion.Order.
-	deletePriorityFunc, err := getDeletePriorityFunc(ms)
+	// Note: For convenience we sort machine using the ordering criteria defined in ms.Spec.Deletion.Order;
+	// the utilization of Nodes is not considered when moving Machines.
+	deletePriorityFunc, err := getDeletePriorityFunc(ms, nil)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -1282,6 +1335,7 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 		desiredMachine.Spec.InfrastructureRef = existingMachine.Spec.InfrastructureRef
 		desiredMachine.Spec.Version = existingMachine.Spec.Version
 		desiredMachine.Spec.FailureDomain = existingMachine.Spec.FailureDomain
+		desiredMachine.Spec.PlacementHints = existingMachine.Spec.PlacementHints
 	}
 	// Set the in-place mutable fields.
 	// When we create a new Machine we will just create the Machine with those fields.
@@ -1298,6 +1352,7 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 	desiredMachine.Spec.Deletion.NodeDrainTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDrainTimeoutSeconds
 	desiredMachine.Spec.Deletion.NodeDeletionTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds
 	desiredMachine.Spec.Deletion.NodeVolumeDetachTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeVolumeDetachTimeoutSeconds
+	desiredMachine.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = machineSet.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
 	desiredMachine.Spec.MinReadySeconds = machineSet.Spec.Template.Spec.MinReadySeconds
 	desiredMachine.Spec.Taints = machineSet.Spec.Template.Spec.Taints
 
@@ -1609,6 +1664,39 @@ func (r *Reconciler) getMachineNode(ctx context.Context, cluster *clusterv1.Clus
 	return node, nil
 }
 
+// getNodeUtilization returns the utilization of the Nodes of the given Machines, indexed by Node name.
+func (r *Reconciler) getNodeUtilization(ctx context.Context, cluster *clusterv1.Cluster, machines []*clusterv1.Machine) (map[string]float64, error) {
+	remoteClient, err := r.ClusterCache.GetClient(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to compute the utilization of Nodes")
+	}
+	// Note: Using an uncached client to list Pods, so the controller does not have to cache all the Pods of the workload cluster.
+	uncachedRemoteClient, err := r.ClusterCache.GetUncachedClient(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to compute the utilization of Nodes")
+	}
+
+	utilization := map[string]float64{}
+	for _, machine := range machines {
+		if !machine.DeletionTimestamp.IsZero() || !machine.Status.NodeRef.IsDefined() {
+			continue
+		}
+		node := &corev1.Node{}
+		if err := remoteClient.Get(ctx, client.ObjectKey{Name: machine.Status.NodeRef.Name}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, pkgerrors.Wrapf(err, "failed to compute the utilization of Node %s", machine.Status.NodeRef.Name)
+		}
+		pods := &corev1.PodList{}
+		if err := uncachedRemoteClient.List(ctx, pods, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute the utilization of Node %s", node.Name)
+		}
+		utilization[node.Name] = nodeUtilization(node, pods.Items)
+	}
+	return utilization, nil
+}
+
 func (r *Reconciler) reconcileUnhealthyMachines(ctx context.Context, s *scope) (ctrl.Result, error) {
 	cluster := s.cluster
 	ms := s.machineSet
@@ -1851,9 +1939,9 @@ func patchMachineConditions(ctx context.Context, c client.Client, machines []*cl
 	return nil
 }
 
-func (r *Reconciler) reconcileExternalTemplateReference(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, owner *clusterv1.MachineDeployment, ref clusterv1.ContractVersionedObjectReference) (objectNotFound bool, err error) {
+func (r *Reconciler) reconcileExternalTemplateReference(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, owner *clusterv1.MachineDeployment, ref clusterv1.ContractVersionedObjectReference) (template *unstructured.Unstructured, objectNotFound bool, err error) {
 	if !strings.HasSuffix(ref.Kind, clusterv1.TemplateSuffix) {
-		return false, nil
+		return nil, false, nil
 	}
 
 	obj, err := external.GetObjectFromContractVersionedRef(ctx, r.Client, ref, ms.Namespace)
@@ -1861,21 +1949,21 @@ func (r *Reconciler) reconcileExternalTemplateReference(ctx context.Context, clu
 		if apierrors.IsNotFound(err) {
 			if !ms.DeletionTimestamp.IsZero() {
 				// Tolerate object not found when the machineSet is being deleted.
-				return true, nil
+				return nil, true, nil
 			}
 
 			if owner == nil {
 				// If the MachineSet is not in a MachineDeployment, return the error immediately.
-				return true, err
+				return nil, true, err
 			}
 			// When the MachineSet is part of a MachineDeployment but isn't the current revision, we should
 			// ignore the not found references and allow the controller to proceed.
 			if !isCurrentMachineSet(ms, owner) {
-				return true, nil
+				return nil, true, nil
 			}
-			return true, err
+			return nil, true, err
 		}
-		return false, err
+		return nil, false, err
 	}
 
 	desiredOwnerRef := metav1.OwnerReference{
@@ -1886,12 +1974,15 @@ func (r *Reconciler) reconcileExternalTemplateReference(ctx context.Context, clu
 	}
 
 	if util.HasExactOwnerRef(obj.GetOwnerReferences(), desiredOwnerRef) {
-		return false, nil
+		return obj, false, nil
 	}
 
 	original := obj.DeepCopyObject().(client.Object)
 	obj.SetOwnerReferences(util.EnsureOwnerRef(obj.GetOwnerReferences(), desiredOwnerRef))
-	return false, r.Client.Patch(ctx, obj, client.MergeFrom(original))
+	if err := r.Client.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return nil, false, err
+	}
+	return obj, false, nil
 }
 
 func (r *Reconciler) createBootstrapConfig(ctx context.Context, ms *clusterv1.MachineSet, machine *clusterv1.Machine) (*unstructured.Unstructured, clusterv1.ContractVersionedObjectReference, error) {
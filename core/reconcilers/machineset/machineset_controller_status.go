@@ -19,6 +19,8 @@ package machineset
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -106,6 +108,53 @@ func setReplicas(_ context.Context, ms *clusterv1.MachineSet, machines []*cluste
 	ms.Status.AvailableReplicas = ptr.To(availableReplicas)
 	ms.Status.UpToDateReplicas = ptr.To(upToDateReplicas)
 	ms.Status.Versions = internalversion.VersionsFromMachines(machines)
+	ms.Status.FailureDomains = failureDomainsFromMachines(machines)
+	// Note: Node capabilities are preserved when there are no Machines with a Node, e.g. when the MachineSet is scaled to zero,
+	// so they can still be used e.g. for autoscaling from zero.
+	if nodeCapabilities, ok := nodeCapabilitiesFromMachines(machines); ok {
+		ms.Status.NodeCapabilities = nodeCapabilities
+	}
+}
+
+// nodeCapabilitiesFromMachines returns the node capabilities of the most recently created Machine not being deleted
+// which reports node capabilities; it returns false if there are no such Machines.
+func nodeCapabilitiesFromMachines(machines []*clusterv1.Machine) (clusterv1.MachineNodeCapabilities, bool) {
+	var newest *clusterv1.Machine
+	for _, machine := range machines {
+		if !machine.DeletionTimestamp.IsZero() || machine.Status.NodeCapabilities == (clusterv1.MachineNodeCapabilities{}) {
+			continue
+		}
+		if newest == nil || newest.CreationTimestamp.Before(&machine.CreationTimestamp) {
+			newest = machine
+		}
+	}
+	if newest == nil {
+		return clusterv1.MachineNodeCapabilities{}, false
+	}
+	return *newest.Status.NodeCapabilities.DeepCopy(), true
+}
+
+// failureDomainsFromMachines returns the number of Machines in each failure domain, sorted by failure domain name.
+// Note: Machines without a failure domain are not counted.
+func failureDomainsFromMachines(machines []*clusterv1.Machine) []clusterv1.StatusFailureDomain {
+	counts := map[string]int32{}
+	for _, machine := range machines {
+		if machine.Spec.FailureDomain != "" {
+			counts[machine.Spec.FailureDomain]++
+		}
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+
+	failureDomains := make([]clusterv1.StatusFailureDomain, 0, len(counts))
+	for _, name := range slices.Sorted(maps.Keys(counts)) {
+		failureDomains = append(failureDomains, clusterv1.StatusFailureDomain{
+			Name:     name,
+			Replicas: counts[name],
+		})
+	}
+	return failureDomains
 }
 
 func setScalingUpCondition(_ context.Context, ms *clusterv1.MachineSet, machines []*clusterv1.Machine, bootstrapObjectNotFound, infrastructureObjectNotFound, getAndAdoptMachinesForMachineSetSucceeded bool, scaleUpPreflightCheckErrMessages []string) {
@@ -175,6 +175,47 @@ func Test_setReplicas(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should aggregate machine failure domains",
+			machines: []*clusterv1.Machine{
+				{Spec: clusterv1.MachineSpec{FailureDomain: "fd2"}},
+				{Spec: clusterv1.MachineSpec{FailureDomain: "fd1"}},
+				{Spec: clusterv1.MachineSpec{FailureDomain: "fd2"}},
+				{Spec: clusterv1.MachineSpec{}},
+			},
+			getAndAdoptMachinesForMachineSetSucceeded: true,
+			expectedStatus: clusterv1.MachineSetStatus{
+				Replicas:          ptr.To[int32](4),
+				ReadyReplicas:     ptr.To[int32](0),
+				AvailableReplicas: ptr.To[int32](0),
+				UpToDateReplicas:  ptr.To[int32](0),
+				FailureDomains: []clusterv1.StatusFailureDomain{
+					{Name: "fd1", Replicas: 1},
+					{Name: "fd2", Replicas: 2},
+				},
+			},
+		},
+		{
+			name: "should report node capabilities of the newest machine",
+			machines: []*clusterv1.Machine{
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))},
+					Status:     clusterv1.MachineStatus{NodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "amd64"}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))},
+					Status:     clusterv1.MachineStatus{NodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "arm64"}},
+				},
+			},
+			getAndAdoptMachinesForMachineSetSucceeded: true,
+			expectedStatus: clusterv1.MachineSetStatus{
+				Replicas:          ptr.To[int32](2),
+				ReadyReplicas:     ptr.To[int32](0),
+				AvailableReplicas: ptr.To[int32](0),
+				UpToDateReplicas:  ptr.To[int32](0),
+				NodeCapabilities:  clusterv1.MachineNodeCapabilities{Architecture: "arm64"},
+			},
+		},
 		{
 			name: "In-place updating machines should not be counted",
 			machines: []*clusterv1.Machine{
@@ -268,6 +309,69 @@ func Test_setReplicas(t *testing.T) {
 	}
 }
 
+func Test_nodeCapabilitiesFromMachines(t *testing.T) {
+	now := metav1.Now()
+	older := metav1.NewTime(now.Add(-1 * time.Hour))
+
+	tests := []struct {
+		name                     string
+		machines                 []*clusterv1.Machine
+		expectedNodeCapabilities clusterv1.MachineNodeCapabilities
+		expectedOK               bool
+	}{
+		{
+			name:       "no machines",
+			expectedOK: false,
+		},
+		{
+			name: "machines without node capabilities",
+			machines: []*clusterv1.Machine{
+				{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: now}},
+			},
+			expectedOK: false,
+		},
+		{
+			name: "the newest machine with node capabilities is used",
+			machines: []*clusterv1.Machine{
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: older},
+					Status:     clusterv1.MachineStatus{NodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "amd64", GPUCount: ptr.To[int32](0)}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: now},
+					Status:     clusterv1.MachineStatus{NodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "arm64", GPUCount: ptr.To[int32](1), GPUType: "nvidia.com/gpu"}},
+				},
+				{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: now}},
+			},
+			expectedNodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "arm64", GPUCount: ptr.To[int32](1), GPUType: "nvidia.com/gpu"},
+			expectedOK:               true,
+		},
+		{
+			name: "deleting machines are ignored",
+			machines: []*clusterv1.Machine{
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: older},
+					Status:     clusterv1.MachineStatus{NodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "amd64"}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: now, DeletionTimestamp: &now},
+					Status:     clusterv1.MachineStatus{NodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "arm64"}},
+				},
+			},
+			expectedNodeCapabilities: clusterv1.MachineNodeCapabilities{Architecture: "amd64"},
+			expectedOK:               true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			nodeCapabilities, ok := nodeCapabilitiesFromMachines(tt.machines)
+			g.Expect(ok).To(Equal(tt.expectedOK))
+			g.Expect(nodeCapabilities).To(Equal(tt.expectedNodeCapabilities))
+		})
+	}
+}
+
 func Test_setScalingUpCondition(t *testing.T) {
 	defaultMachineSet := &clusterv1.MachineSet{
 		Spec: clusterv1.MachineSetSpec{
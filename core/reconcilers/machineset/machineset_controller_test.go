@@ -51,6 +51,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
@@ -3129,6 +3130,20 @@ func TestMachineSetReconciler_deleteMachines(t *testing.T) {
 			wantErr:          true,
 			wantErrorMessage: "error when deleting m1",
 		},
+		{
+			name: "should fall back to the default deletion order when the utilization of Nodes cannot be computed",
+			ms:   newMachineSet("ms1", "cluster1", 2, withDeletionOrder(clusterv1.LeastUtilizedMachineSetDeletionOrder)),
+			machines: []*clusterv1.Machine{
+				fakeMachine("m1", withMachineFinalizer(), withCreationTimestamp(time.Now().Add(-4*time.Minute)), withHealthyNode()),
+				fakeMachine("m2", withMachineFinalizer(), withCreationTimestamp(time.Now().Add(-3*time.Minute)), withHealthyNode()),
+				fakeMachine("m3", withMachineFinalizer(), withCreationTimestamp(time.Now().Add(-2*time.Minute)), withHealthyNode()),
+				fakeMachine("m4", withMachineFinalizer(), withCreationTimestamp(time.Now().Add(-1*time.Minute)), withHealthyNode()),
+			},
+			machinesToDelete: 2,
+			interceptorFuncs: interceptor.Funcs{},
+			wantMachines:     []string{"m3", "m4"}, // m1 and m2 deleted, because the workload cluster is not reachable
+			wantErr:          false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3142,10 +3157,12 @@ func TestMachineSetReconciler_deleteMachines(t *testing.T) {
 			r := &Reconciler{
 				Client:                          fakeClient,
 				machineClientWithDeleteResponse: capicontrollerutil.NewClientWithDeleteResponseFromClient(fakeClient),
+				ClusterCache:                    clustercache.NewFakeEmptyClusterCache(),
 				controller:                      capicontrollerutil.NewFakeController(),
 				recorder:                        record.NewFakeRecorder(32),
 			}
 			s := &scope{
+				cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: tt.ms.Namespace}},
 				machineSet: tt.ms,
 				machines:   tt.machines,
 			}
@@ -21,6 +21,7 @@ import (
 	"sort"
 
 	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -98,6 +99,38 @@ func newestDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	return betterDelete - oldestDeletionOrder(machine)
 }
 
+// leastUtilizedDeletionOrder maps the utilization of the Node of a Machine onto the 0-50 priority range, so Machines
+// whose Node has the lowest utilization are deleted first.
+// Machines for which the utilization of the Node is not known are considered fully utilized.
+func leastUtilizedDeletionOrder(nodeUtilization map[string]float64) deletePriorityFunc {
+	return func(machine *clusterv1.Machine) deletePriority {
+		// Deleting machines must go first, otherwise deletion code will delete more machines while previously deleted machines
+		// are still deleting.
+		if !machine.DeletionTimestamp.IsZero() {
+			return mustDelete
+		}
+		// If user expressed the intent to delete a machines, respect it by deleting this machine first when scaling down.
+		if _, ok := machine.Annotations[clusterv1.DeleteMachineAnnotation]; ok {
+			return shouldDeleteFirst
+		}
+		// If there is machine still updating in progress and the MS is scaling down, consider this machine next
+		// so the system avoids to complete unnecessary in-place updates (drop machines not at the desired state first).
+		if inplace.IsUpdateInProgress(machine) {
+			return shouldDelete
+		}
+		// If there are machines not healthy, get rid of them next, because this will unblock the rollout
+		// while respecting the maxUnhealthy requirement.
+		if !isMachineHealthy(machine) {
+			return betterDelete
+		}
+		utilization, ok := nodeUtilization[machine.Status.NodeRef.Name]
+		if !ok {
+			return mustNotDelete
+		}
+		return deletePriority(float64(betterDelete) * (1.0 - min(max(utilization, 0), 1)))
+	}
+}
+
 func randomDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	// Deleting machines must go first, otherwise deletion code will delete more machines while previously deleted machines
 	// are still deleting.
@@ -121,6 +154,43 @@ func randomDeletionOrder(machine *clusterv1.Machine) deletePriority {
 	return couldDelete
 }
 
+// withDeleteLast wraps a deletePriorityFunc so Machines with the delete-machine-last annotation are deleted after
+// all the other Machines, unless they are already deleting.
+// Note: the relative order between Machines with the annotation is preserved by shifting their priority below the 0-100 range.
+func withDeleteLast(fun deletePriorityFunc) deletePriorityFunc {
+	return func(machine *clusterv1.Machine) deletePriority {
+		priority := fun(machine)
+		if _, ok := machine.Annotations[clusterv1.DeleteMachineLastAnnotation]; ok && priority < mustDelete {
+			return priority - mustDelete
+		}
+		return priority
+	}
+}
+
+// nodeUtilization returns the utilization of a Node, i.e. the highest ratio between the CPU or memory requested
+// by the Pods running on the Node and the allocatable CPU or memory of the Node.
+func nodeUtilization(node *corev1.Node, pods []corev1.Pod) float64 {
+	var cpuRequests, memoryRequests int64
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			cpuRequests += container.Resources.Requests.Cpu().MilliValue()
+			memoryRequests += container.Resources.Requests.Memory().Value()
+		}
+	}
+
+	utilization := 0.0
+	if allocatable := node.Status.Allocatable.Cpu().MilliValue(); allocatable > 0 {
+		utilization = max(utilization, float64(cpuRequests)/float64(allocatable))
+	}
+	if allocatable := node.Status.Allocatable.Memory().Value(); allocatable > 0 {
+		utilization = max(utilization, float64(memoryRequests)/float64(allocatable))
+	}
+	return utilization
+}
+
 type sortableMachines struct {
 	machines []*clusterv1.Machine
 	priority deletePriorityFunc
@@ -159,19 +229,23 @@ func getMachinesToDeletePrioritized(filteredMachines []*clusterv1.Machine, diff
 	return sortable.machines[:diff]
 }
 
-func getDeletePriorityFunc(ms *clusterv1.MachineSet) (deletePriorityFunc, error) {
+// getDeletePriorityFunc returns the delete priority function for the deletion order of the MachineSet.
+// nodeUtilization, indexed by Node name, is only used by the LeastUtilized deletion order.
+func getDeletePriorityFunc(ms *clusterv1.MachineSet, nodeUtilization map[string]float64) (deletePriorityFunc, error) {
 	// Map the Spec.Order value to the appropriate delete priority function
 	switch ms.Spec.Deletion.Order {
 	case clusterv1.RandomMachineSetDeletionOrder:
-		return randomDeletionOrder, nil
+		return withDeleteLast(randomDeletionOrder), nil
 	case clusterv1.NewestMachineSetDeletionOrder:
-		return newestDeletionOrder, nil
+		return withDeleteLast(newestDeletionOrder), nil
 	case clusterv1.OldestMachineSetDeletionOrder:
-		return oldestDeletionOrder, nil
+		return withDeleteLast(oldestDeletionOrder), nil
+	case clusterv1.LeastUtilizedMachineSetDeletionOrder:
+		return withDeleteLast(leastUtilizedDeletionOrder(nodeUtilization)), nil
 	case "":
-		return randomDeletionOrder, nil
+		return withDeleteLast(randomDeletionOrder), nil
 	default:
-		return nil, pkgerrors.Errorf("Unsupported deletion order %s. Must be one of 'Random', 'Newest', or 'Oldest'", ms.Spec.Deletion.Order)
+		return nil, pkgerrors.Errorf("Unsupported deletion order %s. Must be one of 'Random', 'Newest', 'Oldest' or 'LeastUtilized'", ms.Spec.Deletion.Order)
 	}
 }
 
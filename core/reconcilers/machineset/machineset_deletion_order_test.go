@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 
@@ -831,3 +833,189 @@ func TestIsMachineHealthy(t *testing.T) {
 		})
 	}
 }
+
+func TestMachineLeastUtilizedDelete(t *testing.T) {
+	now := metav1.Now()
+	machine := func(name, nodeName string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: nodeName}},
+		}
+	}
+	mostUtilized := machine("most-utilized", "node-1")
+	leastUtilized := machine("least-utilized", "node-2")
+	utilized := machine("utilized", "node-3")
+	unknownUtilization := machine("unknown-utilization", "node-4")
+	noNodeRef := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "no-node-ref"}}
+	deleting := machine("deleting", "node-5")
+	deleting.DeletionTimestamp = &now
+
+	nodeUtilization := map[string]float64{
+		"node-1": 0.9,
+		"node-2": 0.1,
+		"node-3": 0.5,
+		"node-5": 0.1,
+	}
+
+	tests := []struct {
+		desc     string
+		machines []*clusterv1.Machine
+		diff     int
+		expect   []*clusterv1.Machine
+	}{
+		{
+			desc:     "func=leastUtilizedDeletionOrder, diff=1",
+			diff:     1,
+			machines: []*clusterv1.Machine{mostUtilized, leastUtilized, utilized, unknownUtilization},
+			expect:   []*clusterv1.Machine{leastUtilized},
+		},
+		{
+			desc:     "func=leastUtilizedDeletionOrder, diff=3",
+			diff:     3,
+			machines: []*clusterv1.Machine{mostUtilized, leastUtilized, utilized, unknownUtilization},
+			expect:   []*clusterv1.Machine{leastUtilized, utilized, mostUtilized},
+		},
+		{
+			desc:     "func=leastUtilizedDeletionOrder, diff=1 (unhealthy)",
+			diff:     1,
+			machines: []*clusterv1.Machine{mostUtilized, leastUtilized, noNodeRef},
+			expect:   []*clusterv1.Machine{noNodeRef},
+		},
+		{
+			desc:     "func=leastUtilizedDeletionOrder, diff=1 (deleting)",
+			diff:     1,
+			machines: []*clusterv1.Machine{leastUtilized, deleting},
+			expect:   []*clusterv1.Machine{deleting},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := getMachinesToDeletePrioritized(test.machines, test.diff, leastUtilizedDeletionOrder(nodeUtilization))
+			g.Expect(result).To(Equal(test.expect))
+		})
+	}
+}
+
+func TestMachineDeleteLast(t *testing.T) {
+	now := metav1.Now()
+	nodeRef := clusterv1.MachineNodeReference{Name: "some-node"}
+	healthyMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "healthy"},
+		Status:     clusterv1.MachineStatus{NodeRef: nodeRef},
+	}
+	deleteLastMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "delete-last", Annotations: map[string]string{clusterv1.DeleteMachineLastAnnotation: ""}},
+		Status:     clusterv1.MachineStatus{NodeRef: nodeRef},
+	}
+	unhealthyDeleteLastMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "unhealthy-delete-last", Annotations: map[string]string{clusterv1.DeleteMachineLastAnnotation: ""}},
+	}
+	deletingDeleteLastMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "deleting-delete-last", DeletionTimestamp: &now, Annotations: map[string]string{clusterv1.DeleteMachineLastAnnotation: ""}},
+		Status:     clusterv1.MachineStatus{NodeRef: nodeRef},
+	}
+
+	tests := []struct {
+		desc     string
+		machines []*clusterv1.Machine
+		diff     int
+		expect   []*clusterv1.Machine
+	}{
+		{
+			desc:     "Machine with delete-machine-last annotation is deleted last",
+			diff:     1,
+			machines: []*clusterv1.Machine{deleteLastMachine, healthyMachine},
+			expect:   []*clusterv1.Machine{healthyMachine},
+		},
+		{
+			desc:     "Machine with delete-machine-last annotation is deleted last, even if unhealthy",
+			diff:     2,
+			machines: []*clusterv1.Machine{deleteLastMachine, unhealthyDeleteLastMachine, healthyMachine},
+			expect:   []*clusterv1.Machine{healthyMachine, unhealthyDeleteLastMachine},
+		},
+		{
+			desc:     "Deleting Machine with delete-machine-last annotation goes first",
+			diff:     1,
+			machines: []*clusterv1.Machine{healthyMachine, deletingDeleteLastMachine},
+			expect:   []*clusterv1.Machine{deletingDeleteLastMachine},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ms := &clusterv1.MachineSet{}
+			deletePriorityFunc, err := getDeletePriorityFunc(ms, nil)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			result := getMachinesToDeletePrioritized(test.machines, test.diff, deletePriorityFunc)
+			g.Expect(result).To(Equal(test.expect))
+		})
+	}
+}
+
+func TestNodeUtilization(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+	pod := func(cpu, memory string, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(cpu),
+								corev1.ResourceMemory: resource.MustParse(memory),
+							},
+						},
+					},
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		desc   string
+		pods   []corev1.Pod
+		expect float64
+	}{
+		{
+			desc:   "no Pods",
+			expect: 0,
+		},
+		{
+			desc:   "CPU is the most utilized resource",
+			pods:   []corev1.Pod{pod("1", "1Gi", corev1.PodRunning), pod("1", "1Gi", corev1.PodPending)},
+			expect: 0.5,
+		},
+		{
+			desc:   "memory is the most utilized resource",
+			pods:   []corev1.Pod{pod("1", "6Gi", corev1.PodRunning)},
+			expect: 0.75,
+		},
+		{
+			desc:   "terminated Pods are ignored",
+			pods:   []corev1.Pod{pod("1", "1Gi", corev1.PodRunning), pod("3", "1Gi", corev1.PodSucceeded), pod("3", "1Gi", corev1.PodFailed)},
+			expect: 0.25,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(nodeUtilization(node, test.pods)).To(Equal(test.expect))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"slices"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+// countMachinesByFailureDomain returns the number of Machines not being deleted in each of the given failure domains.
+// Note: Machines which are not in any of the given failure domains are not counted.
+func countMachinesByFailureDomain(failureDomains []string, machines []*clusterv1.Machine) map[string]int {
+	machinesByFailureDomain := collections.FromMachines(machines...).Filter(collections.Not(collections.HasDeletionTimestamp)).GroupBy(collections.ByFailureDomain)
+	counts := make(map[string]int, len(failureDomains))
+	for _, failureDomain := range failureDomains {
+		counts[failureDomain] = machinesByFailureDomain[failureDomain].Len()
+	}
+	return counts
+}
+
+// leastPopulatedFailureDomain returns the failure domain with the fewest Machines.
+// In case of ties the failure domain which comes first in failureDomains is returned.
+func leastPopulatedFailureDomain(failureDomains []string, counts map[string]int) string {
+	var leastPopulated string
+	for _, failureDomain := range failureDomains {
+		if leastPopulated == "" || counts[failureDomain] < counts[leastPopulated] {
+			leastPopulated = failureDomain
+		}
+	}
+	return leastPopulated
+}
+
+// mostPopulatedFailureDomain returns the failure domain with the most Machines.
+// In case of ties the failure domain which comes last in failureDomains is returned, so scaling down
+// removes Machines in the reverse order they have been added when scaling up.
+func mostPopulatedFailureDomain(failureDomains []string, counts map[string]int) string {
+	var mostPopulated string
+	for _, failureDomain := range failureDomains {
+		if mostPopulated == "" || counts[failureDomain] >= counts[mostPopulated] {
+			mostPopulated = failureDomain
+		}
+	}
+	return mostPopulated
+}
+
+// getMachinesToDeleteSpread returns the Machines to be deleted for a MachineSet spreading Machines across failure domains.
+// Machines that must go first according to the delete priority, i.e. Machines already deleting, marked for deletion or
+// updating in place, and Machines which are not in any of the failure domains are picked first; then Machines are picked
+// one at a time from the failure domain with the most Machines, using the delete priority to pick within the failure domain.
+// Machines with the delete-machine-last annotation are picked only when there are no other Machines left.
+func getMachinesToDeleteSpread(failureDomains []string, machines []*clusterv1.Machine, diff int, fun deletePriorityFunc) []*clusterv1.Machine {
+	if diff <= 0 {
+		return []*clusterv1.Machine{}
+	}
+
+	// Note: clone machines so sorting and picking Machines does not change the slice of the caller.
+	remaining := getMachinesToMovePrioritized(slices.Clone(machines), fun)
+	counts := countMachinesByFailureDomain(failureDomains, remaining)
+
+	machinesToDelete := make([]*clusterv1.Machine, 0, min(diff, len(remaining)))
+	for len(machinesToDelete) < diff && len(remaining) > 0 {
+		i := slices.IndexFunc(remaining, func(machine *clusterv1.Machine) bool {
+			_, ok := counts[machine.Spec.FailureDomain]
+			return fun(machine) > betterDelete || (!ok && fun(machine) >= mustNotDelete)
+		})
+		if i < 0 {
+			failureDomain := mostPopulatedFailureDomain(failureDomains, counts)
+			i = slices.IndexFunc(remaining, func(machine *clusterv1.Machine) bool {
+				return machine.Spec.FailureDomain == failureDomain && fun(machine) >= mustNotDelete
+			})
+		}
+		if i < 0 {
+			i = 0
+		}
+
+		machine := remaining[i]
+		machinesToDelete = append(machinesToDelete, machine)
+		remaining = slices.Delete(remaining, i, i+1)
+		if _, ok := counts[machine.Spec.FailureDomain]; ok && machine.DeletionTimestamp.IsZero() {
+			counts[machine.Spec.FailureDomain]--
+		}
+	}
+	return machinesToDelete
+}
+
+// defaultSpreadKey returns the spread key used for the placement hints of Machines if spreadConstraints.spreadKey is not set.
+// Note: The name of the MachineDeployment is preferred over the name of the MachineSet, so that Machines
+// are spread across hosts together with the Machines of the other MachineSets of the same MachineDeployment.
+func defaultSpreadKey(ms *clusterv1.MachineSet) string {
+	if mdName, ok := ms.Labels[clusterv1.MachineDeploymentNameLabel]; ok && mdName != "" {
+		return mdName
+	}
+	return ms.Name
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestLeastPopulatedFailureDomain(t *testing.T) {
+	now := metav1.Now()
+	failureDomains := []string{"fd1", "fd2", "fd3"}
+
+	tests := []struct {
+		name     string
+		machines []*clusterv1.Machine
+		expected []string
+	}{
+		{
+			name:     "Machines are spread across failure domains in order when there are no Machines",
+			expected: []string{"fd1", "fd2", "fd3", "fd1"},
+		},
+		{
+			name: "Machines are created in the failure domains with the fewest Machines",
+			machines: []*clusterv1.Machine{
+				machineInFailureDomain("m1", "fd1"),
+				machineInFailureDomain("m2", "fd1"),
+				machineInFailureDomain("m3", "fd3"),
+			},
+			expected: []string{"fd2", "fd2", "fd3", "fd1"},
+		},
+		{
+			name: "Deleting Machines and Machines not in any of the failure domains are not counted",
+			machines: []*clusterv1.Machine{
+				machineInFailureDomain("m1", "fd0"),
+				machineInFailureDomain("m2", ""),
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "m3", DeletionTimestamp: &now},
+					Spec:       clusterv1.MachineSpec{FailureDomain: "fd1"},
+				},
+			},
+			expected: []string{"fd1", "fd2", "fd3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			counts := countMachinesByFailureDomain(failureDomains, tt.machines)
+			var actual []string
+			for range tt.expected {
+				failureDomain := leastPopulatedFailureDomain(failureDomains, counts)
+				counts[failureDomain]++
+				actual = append(actual, failureDomain)
+			}
+			g.Expect(actual).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestGetMachinesToDeleteSpread(t *testing.T) {
+	now := metav1.Now()
+	failureDomains := []string{"fd1", "fd2", "fd3"}
+
+	fd1m1 := machineInFailureDomain("fd1-m1", "fd1")
+	fd1m2 := machineInFailureDomain("fd1-m2", "fd1")
+	fd1m3 := machineInFailureDomain("fd1-m3", "fd1")
+	fd2m1 := machineInFailureDomain("fd2-m1", "fd2")
+	fd3m1 := machineInFailureDomain("fd3-m1", "fd3")
+	fd3m2 := machineInFailureDomain("fd3-m2", "fd3")
+	noFailureDomain := machineInFailureDomain("no-fd", "")
+	deleting := machineInFailureDomain("deleting", "fd2")
+	deleting.DeletionTimestamp = &now
+	deleteLast := machineInFailureDomain("delete-last", "fd1")
+	deleteLast.Annotations = map[string]string{clusterv1.DeleteMachineLastAnnotation: ""}
+
+	tests := []struct {
+		name     string
+		machines []*clusterv1.Machine
+		diff     int
+		expected []*clusterv1.Machine
+	}{
+		{
+			name:     "Machines are deleted from the failure domains with the most Machines",
+			machines: []*clusterv1.Machine{fd1m1, fd1m2, fd1m3, fd2m1, fd3m1, fd3m2},
+			diff:     3,
+			expected: []*clusterv1.Machine{fd1m1, fd3m1, fd1m2},
+		},
+		{
+			name:     "Deleting Machines and Machines not in any of the failure domains are deleted first",
+			machines: []*clusterv1.Machine{fd1m1, fd1m2, noFailureDomain, deleting, fd3m1},
+			diff:     3,
+			expected: []*clusterv1.Machine{deleting, noFailureDomain, fd1m1},
+		},
+		{
+			name:     "Machines with the delete-machine-last annotation are deleted last",
+			machines: []*clusterv1.Machine{deleteLast, fd1m1, fd2m1},
+			diff:     3,
+			expected: []*clusterv1.Machine{fd1m1, fd2m1, deleteLast},
+		},
+		{
+			name:     "All Machines are deleted if diff is greater than the number of Machines",
+			machines: []*clusterv1.Machine{fd1m1, fd2m1},
+			diff:     3,
+			expected: []*clusterv1.Machine{fd2m1, fd1m1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ms := &clusterv1.MachineSet{}
+			deletePriorityFunc, err := getDeletePriorityFunc(ms, nil)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			machines := append([]*clusterv1.Machine(nil), tt.machines...)
+			result := getMachinesToDeleteSpread(failureDomains, machines, tt.diff, deletePriorityFunc)
+			g.Expect(result).To(Equal(tt.expected))
+			// The slice of the caller must not be changed.
+			g.Expect(machines).To(Equal(tt.machines))
+		})
+	}
+}
+
+func TestDefaultSpreadKey(t *testing.T) {
+	g := NewWithT(t)
+
+	ms := &clusterv1.MachineSet{ObjectMeta: metav1.ObjectMeta{Name: "ms-1"}}
+	g.Expect(defaultSpreadKey(ms)).To(Equal("ms-1"))
+
+	ms.Labels = map[string]string{clusterv1.MachineDeploymentNameLabel: "md-1"}
+	g.Expect(defaultSpreadKey(ms)).To(Equal("md-1"))
+}
+
+func machineInFailureDomain(name, failureDomain string) *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       clusterv1.MachineSpec{FailureDomain: failureDomain},
+		Status:     clusterv1.MachineStatus{NodeRef: clusterv1.MachineNodeReference{Name: name}},
+	}
+}
@@ -34,6 +34,7 @@ import (
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
@@ -45,6 +46,9 @@ type preflightCheckErrorMessage *string
 // the preflight checks fail.
 const preflightFailedRequeueAfter = 15 * time.Second
 
+// scaleUpAction is the action passed to runPreflightChecks when the MachineSet is creating Machines.
+const scaleUpAction = "Scale up"
+
 func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, action string) ([]string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	// If the MachineSetPreflightChecks feature gate is disabled return early.
@@ -62,6 +66,33 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 		return nil, nil
 	}
 
+	preflightCheckErrs, err := r.controlPlanePreflightChecks(ctx, cluster, ms, skipped, action)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the can-create-machine preflight check; this check only applies when creating Machines.
+	if action == scaleUpAction && shouldRun(r.PreflightChecks, skipped, clusterv1.MachineSetPreflightCheckCanCreateMachine) {
+		canCreateMachineErrs, err := r.canCreateMachinePreflightCheck(ctx, cluster, ms)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to perform %q: failed to perform preflight checks", action)
+		}
+		preflightCheckErrs = append(preflightCheckErrs, canCreateMachineErrs...)
+	}
+
+	if len(preflightCheckErrs) > 0 {
+		preflightCheckErrStrings := []string{}
+		for _, v := range preflightCheckErrs {
+			preflightCheckErrStrings = append(preflightCheckErrStrings, *v)
+		}
+		log.Info(fmt.Sprintf("%s on hold because %s. The operation will continue after the preflight check(s) pass", action, strings.Join(preflightCheckErrStrings, "; ")))
+		return preflightCheckErrStrings, nil
+	}
+	return nil, nil
+}
+
+// controlPlanePreflightChecks runs the preflight checks comparing the MachineSet with the ControlPlane of the Cluster.
+func (r *Reconciler) controlPlanePreflightChecks(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, skipped sets.Set[clusterv1.MachineSetPreflightCheck], action string) ([]preflightCheckErrorMessage, error) {
 	// If the cluster does not have a control plane reference then there is nothing to do. Return early.
 	if !cluster.Spec.ControlPlaneRef.IsDefined() {
 		return nil, nil
@@ -135,15 +166,7 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 	if len(errList) > 0 {
 		return nil, pkgerrors.Wrapf(kerrors.NewAggregate(errList), "failed to perform %q: failed to perform preflight checks", action)
 	}
-	if len(preflightCheckErrs) > 0 {
-		preflightCheckErrStrings := []string{}
-		for _, v := range preflightCheckErrs {
-			preflightCheckErrStrings = append(preflightCheckErrStrings, *v)
-		}
-		log.Info(fmt.Sprintf("%s on hold because %s. The operation will continue after the preflight check(s) pass", action, strings.Join(preflightCheckErrStrings, "; ")))
-		return preflightCheckErrStrings, nil
-	}
-	return nil, nil
+	return preflightCheckErrs, nil
 }
 
 func shouldRun(preflightChecks, skippedPreflightChecks sets.Set[clusterv1.MachineSetPreflightCheck], preflightCheck clusterv1.MachineSetPreflightCheck) bool {
@@ -238,6 +261,51 @@ func (r *Reconciler) controlPlaneVersionPreflightCheck(cluster *clusterv1.Cluste
 	return nil
 }
 
+// canCreateMachinePreflightCheck calls the CanCreateMachine hook of all the registered Runtime Extensions, so
+// extensions can veto the creation of Machines for the MachineSet. A preflight check error message is returned
+// for every extension blocking the creation of Machines.
+func (r *Reconciler) canCreateMachinePreflightCheck(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet) ([]preflightCheckErrorMessage, error) {
+	if !feature.Gates.Enabled(feature.RuntimeSDK) || r.RuntimeClient == nil {
+		return nil, nil
+	}
+
+	extensionHandlers, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.CanCreateMachine, ms)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to perform %q preflight check", clusterv1.MachineSetPreflightCheckCanCreateMachine)
+	}
+	if len(extensionHandlers) == 0 {
+		return nil, nil
+	}
+
+	// Optimize size of the request by not sending managedFields.
+	// Note: Set GVK because objects are later marshalled with json.Marshal.
+	req := &runtimehooksv1.CanCreateMachineRequest{
+		Cluster:    *cluster.DeepCopy(),
+		MachineSet: *ms.DeepCopy(),
+	}
+	req.Cluster.SetGroupVersionKind(clusterv1.GroupVersion.WithKind("Cluster"))
+	req.Cluster.SetManagedFields(nil)
+	req.MachineSet.SetGroupVersionKind(machineSetKind)
+	req.MachineSet.SetManagedFields(nil)
+
+	preflightCheckErrs := []preflightCheckErrorMessage{}
+	for _, extensionHandler := range extensionHandlers {
+		resp := &runtimehooksv1.CanCreateMachineResponse{}
+		if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.CanCreateMachine, ms, extensionHandler, req, resp); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to perform %q preflight check", clusterv1.MachineSetPreflightCheckCanCreateMachine)
+		}
+		if resp.RetryAfterSeconds == 0 {
+			continue
+		}
+		message := fmt.Sprintf("Runtime Extension %s is blocking Machine creation", extensionHandler)
+		if resp.Message != "" {
+			message += ": " + resp.Message
+		}
+		preflightCheckErrs = append(preflightCheckErrs, ptr.To(fmt.Sprintf("%s (%q preflight check failed)", message, clusterv1.MachineSetPreflightCheckCanCreateMachine)))
+	}
+	return preflightCheckErrs, nil
+}
+
 func (r *Reconciler) skippedPreflightChecks(ctx context.Context, ms *clusterv1.MachineSet) (sets.Set[clusterv1.MachineSetPreflightCheck], error) {
 	skipped := sets.Set[clusterv1.MachineSetPreflightCheck]{}
 	if ms == nil {
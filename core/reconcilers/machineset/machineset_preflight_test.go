@@ -17,6 +17,7 @@ limitations under the License.
 package machineset
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -25,13 +26,17 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
 
@@ -854,6 +859,154 @@ func TestMachineSetReconciler_runPreflightChecks(t *testing.T) {
 	})
 }
 
+func TestMachineSetReconciler_canCreateMachinePreflightCheck(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+	canCreateMachineGVH, err := catalog.GroupVersionHook(runtimehooksv1.CanCreateMachine)
+	if err != nil {
+		panic("unable to compute GVH")
+	}
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: "ns1",
+		},
+	}
+	machineSet := &clusterv1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ms1",
+			Namespace: "ns1",
+		},
+		Spec: clusterv1.MachineSetSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+
+	allowResponse := &runtimehooksv1.CanCreateMachineResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+		},
+	}
+	blockResponse := &runtimehooksv1.CanCreateMachineResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status:  runtimehooksv1.ResponseStatusSuccess,
+				Message: "quota exceeded",
+			},
+			RetryAfterSeconds: 30,
+		},
+	}
+	failureResponse := &runtimehooksv1.CanCreateMachineResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusFailure,
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		preflightChecks   sets.Set[clusterv1.MachineSetPreflightCheck]
+		action            string
+		extensions        []string
+		responses         map[string]runtimehooksv1.ResponseObject
+		wantMessages      []string
+		wantErr           bool
+		wantExtensionCall int
+	}{
+		{
+			name:            "should pass if there are no extensions",
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckAll),
+			action:          scaleUpAction,
+		},
+		{
+			name:              "should pass if all the extensions allow Machine creation",
+			preflightChecks:   sets.New(clusterv1.MachineSetPreflightCheckAll),
+			action:            scaleUpAction,
+			extensions:        []string{"ext1", "ext2"},
+			responses:         map[string]runtimehooksv1.ResponseObject{"ext1": allowResponse, "ext2": allowResponse},
+			wantExtensionCall: 2,
+		},
+		{
+			name:            "should fail with a message for every extension blocking Machine creation",
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckAll),
+			action:          scaleUpAction,
+			extensions:      []string{"ext1", "ext2", "ext3"},
+			responses:       map[string]runtimehooksv1.ResponseObject{"ext1": blockResponse, "ext2": allowResponse, "ext3": blockResponse},
+			wantMessages: []string{
+				"Runtime Extension ext1 is blocking Machine creation: quota exceeded (\"CanCreateMachine\" preflight check failed)",
+				"Runtime Extension ext3 is blocking Machine creation: quota exceeded (\"CanCreateMachine\" preflight check failed)",
+			},
+			wantExtensionCall: 3,
+		},
+		{
+			name:              "should return an error if an extension fails",
+			preflightChecks:   sets.New(clusterv1.MachineSetPreflightCheckAll),
+			action:            scaleUpAction,
+			extensions:        []string{"ext1"},
+			responses:         map[string]runtimehooksv1.ResponseObject{"ext1": failureResponse},
+			wantErr:           true,
+			wantExtensionCall: 1,
+		},
+		{
+			name:            "should not call extensions if the preflight check is not enabled",
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckControlPlaneIsStable),
+			action:          scaleUpAction,
+			extensions:      []string{"ext1"},
+			responses:       map[string]runtimehooksv1.ResponseObject{"ext1": blockResponse},
+		},
+		{
+			name:            "should not call extensions if the MachineSet is not scaling up",
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckAll),
+			action:          "Machine remediation",
+			extensions:      []string{"ext1"},
+			responses:       map[string]runtimehooksv1.ResponseObject{"ext1": blockResponse},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{
+					canCreateMachineGVH: tt.extensions,
+				}).
+				WithCallExtensionResponses(tt.responses).
+				WithCallExtensionValidations(func(_ string, object runtimehooksv1.RequestObject) error {
+					req, ok := object.(*runtimehooksv1.CanCreateMachineRequest)
+					if !ok {
+						return errors.New("unexpected request type")
+					}
+					if req.Cluster.Name != cluster.Name || req.MachineSet.Name != machineSet.Name {
+						return errors.New("unexpected request content")
+					}
+					return nil
+				}).
+				Build()
+
+			r := &Reconciler{
+				Client:          fake.NewClientBuilder().Build(),
+				RuntimeClient:   runtimeClient,
+				PreflightChecks: tt.preflightChecks,
+			}
+			messages, err := r.runPreflightChecks(ctx, cluster, machineSet, tt.action)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(messages).To(Equal(tt.wantMessages))
+			g.Expect(runtimeClient.CallCount(runtimehooksv1.CanCreateMachine)).To(Equal(tt.wantExtensionCall))
+		})
+	}
+}
+
 func TestMachineSetReconciler_shouldRun(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -0,0 +1,375 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerinventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/container"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+// managerContainerName is the name of the container running the provider controller in the provider Deployments.
+const managerContainerName = "manager"
+
+// resyncInterval is the interval after which a Provider is reconciled again; Deployments and
+// CustomResourceDefinitions are not watched, so changes to them are surfaced at the next resync.
+var resyncInterval = 1 * time.Minute
+
+// +kubebuilder:rbac:groups=clusterctl.cluster.x-k8s.io,resources=providers;providers/status,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+
+// Reconciler reconciles the Provider objects of the clusterctl inventory with the Deployments and the
+// CustomResourceDefinitions of the corresponding provider installation, and surfaces the result as conditions.
+type Reconciler struct {
+	Client client.Client
+
+	// APIReader is used to read Deployments and CustomResourceDefinitions without caching
+	// all of them in the manager cache.
+	APIReader client.Reader
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil || r.APIReader == nil {
+		return pkgerrors.New("Client and APIReader must not be nil")
+	}
+
+	// Fail fast with a meaningful error if the clusterctl inventory CRD is not installed, e.g. when
+	// Cluster API has not been installed using clusterctl.
+	providerGVK := clusterctlv1.GroupVersion.WithKind("Provider")
+	if _, err := mgr.GetRESTMapper().RESTMapping(providerGVK.GroupKind(), providerGVK.Version); err != nil {
+		return pkgerrors.Wrap(err, "failed to get the clusterctl inventory CRD; the clusterctl inventory CRD is required when the ProviderInventoryConditions feature gate is enabled")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "providerinventory")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&clusterctlv1.Provider{}).
+		Named("providerinventory").
+		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, r)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+	return nil
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	provider := &clusterctlv1.Provider{}
+	if err := r.Client.Get(ctx, req.NamespacedName, provider); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Return early if the Provider is deleted.
+	if !provider.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(provider, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, provider, patch.WithOwnedConditions{Conditions: []string{
+			clusterctlv1.ProviderReadyCondition,
+			clusterctlv1.ProviderDeploymentsAvailableCondition,
+			clusterctlv1.ProviderImagesUpToDateCondition,
+			clusterctlv1.ProviderCRDsEstablishedCondition,
+		}}); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, pkgerrors.Wrapf(err, "failed to patch Provider %s", klog.KObj(provider))})
+		}
+	}()
+
+	var errs []error
+	if err := r.reconcileDeployments(ctx, provider); err != nil {
+		errs = append(errs, err)
+	}
+	if err := r.reconcileCustomResourceDefinitions(ctx, provider); err != nil {
+		errs = append(errs, err)
+	}
+	setReadyCondition(provider)
+
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+	return ctrl.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// reconcileDeployments sets the DeploymentsAvailable and the ImagesUpToDate conditions by looking at the
+// Deployments of the provider installation.
+func (r *Reconciler) reconcileDeployments(ctx context.Context, provider *clusterctlv1.Provider) error {
+	deployments := &appsv1.DeploymentList{}
+	if err := r.APIReader.List(ctx, deployments,
+		client.InNamespace(provider.Namespace),
+		client.MatchingLabels{clusterv1.ProviderNameLabel: provider.ManifestLabel()},
+	); err != nil {
+		for _, conditionType := range []string{clusterctlv1.ProviderDeploymentsAvailableCondition, clusterctlv1.ProviderImagesUpToDateCondition} {
+			conditions.Set(provider, metav1.Condition{
+				Type:    conditionType,
+				Status:  metav1.ConditionUnknown,
+				Reason:  clusterctlv1.ProviderInternalErrorReason,
+				Message: "Please check controller logs for errors",
+			})
+		}
+		return pkgerrors.Wrapf(err, "failed to list Deployments for Provider %s", klog.KObj(provider))
+	}
+
+	if len(deployments.Items) == 0 {
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderDeploymentsAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterctlv1.ProviderNoDeploymentsReason,
+			Message: fmt.Sprintf("No Deployments with label %s=%s exist in namespace %s", clusterv1.ProviderNameLabel, provider.ManifestLabel(), provider.Namespace),
+		})
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderImagesUpToDateCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterctlv1.ProviderImagesVersionUnknownReason,
+			Message: "No Deployments exist for this provider",
+		})
+		return nil
+	}
+
+	setDeploymentsAvailableCondition(provider, deployments.Items)
+	setImagesUpToDateCondition(provider, deployments.Items)
+	return nil
+}
+
+func setDeploymentsAvailableCondition(provider *clusterctlv1.Provider, deployments []appsv1.Deployment) {
+	var messages []string
+	for _, d := range deployments {
+		desired := ptr.Deref(d.Spec.Replicas, 1)
+		switch {
+		case d.Status.ObservedGeneration < d.Generation:
+			messages = append(messages, fmt.Sprintf("* Deployment %s: rollout in progress", d.Name))
+		case d.Status.UpdatedReplicas < desired:
+			messages = append(messages, fmt.Sprintf("* Deployment %s: %d of %d replicas up to date", d.Name, d.Status.UpdatedReplicas, desired))
+		case d.Status.AvailableReplicas < desired:
+			messages = append(messages, fmt.Sprintf("* Deployment %s: %d of %d replicas available", d.Name, d.Status.AvailableReplicas, desired))
+		}
+	}
+
+	if len(messages) > 0 {
+		sort.Strings(messages)
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderDeploymentsAvailableCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterctlv1.ProviderDeploymentsNotAvailableReason,
+			Message: strings.Join(messages, "\n"),
+		})
+		return
+	}
+
+	conditions.Set(provider, metav1.Condition{
+		Type:   clusterctlv1.ProviderDeploymentsAvailableCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterctlv1.ProviderDeploymentsAvailableReason,
+	})
+}
+
+// setImagesUpToDateCondition compares the tag of the image used by the manager container of each Deployment
+// with the provider version.
+// NOTE: Other containers, e.g. sidecars, are ignored because their versions are not related to the provider version.
+func setImagesUpToDateCondition(provider *clusterctlv1.Provider, deployments []appsv1.Deployment) {
+	var notUpToDateMessages, unknownMessages []string
+	for _, d := range deployments {
+		for _, c := range d.Spec.Template.Spec.Containers {
+			if c.Name != managerContainerName {
+				continue
+			}
+
+			image, err := container.ImageFromString(c.Image)
+			if err != nil {
+				unknownMessages = append(unknownMessages, fmt.Sprintf("* Deployment %s: failed to parse image %s", d.Name, c.Image))
+				continue
+			}
+			if image.Tag == "" {
+				unknownMessages = append(unknownMessages, fmt.Sprintf("* Deployment %s: image %s has no tag", d.Name, c.Image))
+				continue
+			}
+			if image.Tag != provider.Version {
+				notUpToDateMessages = append(notUpToDateMessages, fmt.Sprintf("* Deployment %s: image %s does not match provider version %s", d.Name, c.Image, provider.Version))
+			}
+		}
+	}
+
+	switch {
+	case len(notUpToDateMessages) > 0:
+		sort.Strings(notUpToDateMessages)
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderImagesUpToDateCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterctlv1.ProviderImagesNotUpToDateReason,
+			Message: strings.Join(notUpToDateMessages, "\n"),
+		})
+	case len(unknownMessages) > 0:
+		sort.Strings(unknownMessages)
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderImagesUpToDateCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterctlv1.ProviderImagesVersionUnknownReason,
+			Message: strings.Join(unknownMessages, "\n"),
+		})
+	default:
+		conditions.Set(provider, metav1.Condition{
+			Type:   clusterctlv1.ProviderImagesUpToDateCondition,
+			Status: metav1.ConditionTrue,
+			Reason: clusterctlv1.ProviderImagesUpToDateReason,
+		})
+	}
+}
+
+// reconcileCustomResourceDefinitions sets the CustomResourceDefinitionsEstablished condition by looking at the
+// CustomResourceDefinitions of the provider installation.
+// NOTE: CustomResourceDefinitions are cluster-wide, so they are shared by all the instances of the same provider.
+func (r *Reconciler) reconcileCustomResourceDefinitions(ctx context.Context, provider *clusterctlv1.Provider) error {
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.APIReader.List(ctx, crds, client.MatchingLabels{clusterv1.ProviderNameLabel: provider.ManifestLabel()}); err != nil {
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderCRDsEstablishedCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  clusterctlv1.ProviderInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return pkgerrors.Wrapf(err, "failed to list CustomResourceDefinitions for Provider %s", klog.KObj(provider))
+	}
+
+	var messages []string
+	for _, crd := range crds.Items {
+		if !isEstablished(&crd) {
+			messages = append(messages, fmt.Sprintf("* CustomResourceDefinition %s: not established", crd.Name))
+			continue
+		}
+		if version := storageVersion(&crd); version == "" || !isServed(&crd, version) {
+			messages = append(messages, fmt.Sprintf("* CustomResourceDefinition %s: storage version is not served", crd.Name))
+		}
+	}
+
+	if len(messages) > 0 {
+		sort.Strings(messages)
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderCRDsEstablishedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterctlv1.ProviderCRDsNotEstablishedReason,
+			Message: strings.Join(messages, "\n"),
+		})
+		return nil
+	}
+
+	// NOTE: Some providers, e.g. runtime extension providers, do not have CustomResourceDefinitions.
+	conditions.Set(provider, metav1.Condition{
+		Type:   clusterctlv1.ProviderCRDsEstablishedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterctlv1.ProviderCRDsEstablishedReason,
+	})
+	return nil
+}
+
+func isEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	for _, c := range crd.Status.Conditions {
+		if c.Type == apiextensionsv1.Established {
+			return c.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+func isServed(crd *apiextensionsv1.CustomResourceDefinition, version string) bool {
+	for _, v := range crd.Spec.Versions {
+		if v.Name == version {
+			return v.Served
+		}
+	}
+	return false
+}
+
+// setReadyCondition sets the Ready condition, which is true if Deployments are available and
+// CustomResourceDefinitions are established.
+// NOTE: ImagesUpToDate is not considered, because images could have been intentionally overridden.
+func setReadyCondition(provider *clusterctlv1.Provider) {
+	var messages []string
+	for _, conditionType := range []string{clusterctlv1.ProviderDeploymentsAvailableCondition, clusterctlv1.ProviderCRDsEstablishedCondition} {
+		if conditions.IsTrue(provider, conditionType) {
+			continue
+		}
+		message := conditions.GetMessage(provider, conditionType)
+		if message == "" {
+			message = fmt.Sprintf("%s is not True", conditionType)
+		}
+		messages = append(messages, message)
+	}
+
+	if len(messages) > 0 {
+		conditions.Set(provider, metav1.Condition{
+			Type:    clusterctlv1.ProviderReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterctlv1.ProviderNotReadyReason,
+			Message: strings.Join(messages, "\n"),
+		})
+		return
+	}
+
+	conditions.Set(provider, metav1.Condition{
+		Type:   clusterctlv1.ProviderReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterctlv1.ProviderReadyReason,
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerinventory
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		wantStatus map[string]metav1.ConditionStatus
+	}{
+		{
+			name: "Provider is ready when Deployments are available and CRDs are established",
+			objs: []client.Object{
+				newDeployment("capi-controller-manager", "registry.k8s.io/cluster-api/cluster-api-controller:v1.14.0", 1, 1),
+				newCRD("clusters.cluster.x-k8s.io", true),
+			},
+			wantStatus: map[string]metav1.ConditionStatus{
+				clusterctlv1.ProviderReadyCondition:                metav1.ConditionTrue,
+				clusterctlv1.ProviderDeploymentsAvailableCondition: metav1.ConditionTrue,
+				clusterctlv1.ProviderImagesUpToDateCondition:       metav1.ConditionTrue,
+				clusterctlv1.ProviderCRDsEstablishedCondition:      metav1.ConditionTrue,
+			},
+		},
+		{
+			name: "Provider is not ready when Deployments are not available",
+			objs: []client.Object{
+				newDeployment("capi-controller-manager", "registry.k8s.io/cluster-api/cluster-api-controller:v1.14.0", 1, 0),
+				newCRD("clusters.cluster.x-k8s.io", true),
+			},
+			wantStatus: map[string]metav1.ConditionStatus{
+				clusterctlv1.ProviderReadyCondition:                metav1.ConditionFalse,
+				clusterctlv1.ProviderDeploymentsAvailableCondition: metav1.ConditionFalse,
+				clusterctlv1.ProviderImagesUpToDateCondition:       metav1.ConditionTrue,
+				clusterctlv1.ProviderCRDsEstablishedCondition:      metav1.ConditionTrue,
+			},
+		},
+		{
+			name: "Provider is not ready when no Deployments exist",
+			objs: []client.Object{
+				newCRD("clusters.cluster.x-k8s.io", true),
+			},
+			wantStatus: map[string]metav1.ConditionStatus{
+				clusterctlv1.ProviderReadyCondition:                metav1.ConditionFalse,
+				clusterctlv1.ProviderDeploymentsAvailableCondition: metav1.ConditionFalse,
+				clusterctlv1.ProviderImagesUpToDateCondition:       metav1.ConditionUnknown,
+				clusterctlv1.ProviderCRDsEstablishedCondition:      metav1.ConditionTrue,
+			},
+		},
+		{
+			name: "Provider is not ready when CRDs are not established",
+			objs: []client.Object{
+				newDeployment("capi-controller-manager", "registry.k8s.io/cluster-api/cluster-api-controller:v1.14.0", 1, 1),
+				newCRD("clusters.cluster.x-k8s.io", false),
+			},
+			wantStatus: map[string]metav1.ConditionStatus{
+				clusterctlv1.ProviderReadyCondition:                metav1.ConditionFalse,
+				clusterctlv1.ProviderDeploymentsAvailableCondition: metav1.ConditionTrue,
+				clusterctlv1.ProviderImagesUpToDateCondition:       metav1.ConditionTrue,
+				clusterctlv1.ProviderCRDsEstablishedCondition:      metav1.ConditionFalse,
+			},
+		},
+		{
+			name: "Provider is ready but images are not up to date when the image tag does not match the provider version",
+			objs: []client.Object{
+				newDeployment("capi-controller-manager", "registry.k8s.io/cluster-api/cluster-api-controller:v1.13.0", 1, 1),
+			},
+			wantStatus: map[string]metav1.ConditionStatus{
+				clusterctlv1.ProviderReadyCondition:                metav1.ConditionTrue,
+				clusterctlv1.ProviderDeploymentsAvailableCondition: metav1.ConditionTrue,
+				clusterctlv1.ProviderImagesUpToDateCondition:       metav1.ConditionFalse,
+				clusterctlv1.ProviderCRDsEstablishedCondition:      metav1.ConditionTrue,
+			},
+		},
+		{
+			name: "Images version is unknown when images are referenced by digest",
+			objs: []client.Object{
+				newDeployment("capi-controller-manager", "registry.k8s.io/cluster-api/cluster-api-controller@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", 1, 1),
+			},
+			wantStatus: map[string]metav1.ConditionStatus{
+				clusterctlv1.ProviderReadyCondition:                metav1.ConditionTrue,
+				clusterctlv1.ProviderDeploymentsAvailableCondition: metav1.ConditionTrue,
+				clusterctlv1.ProviderImagesUpToDateCondition:       metav1.ConditionUnknown,
+				clusterctlv1.ProviderCRDsEstablishedCondition:      metav1.ConditionTrue,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterctlv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+
+			provider := &clusterctlv1.Provider{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "capi-system",
+					Name:      "cluster-api",
+				},
+				ProviderName: "cluster-api",
+				Type:         string(clusterctlv1.CoreProviderType),
+				Version:      "v1.14.0",
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objs, provider)...).
+				WithStatusSubresource(&clusterctlv1.Provider{}).
+				Build()
+
+			r := &Reconciler{
+				Client:    c,
+				APIReader: c,
+			}
+			res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(provider)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter).To(Equal(resyncInterval))
+
+			got := &clusterctlv1.Provider{}
+			g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(provider), got)).To(Succeed())
+			for conditionType, status := range tt.wantStatus {
+				condition := conditions.Get(got, conditionType)
+				g.Expect(condition).ToNot(BeNil(), "condition %s not set", conditionType)
+				g.Expect(condition.Status).To(Equal(status), "condition %s: %s", conditionType, condition.Message)
+			}
+		})
+	}
+}
+
+func newDeployment(name, image string, replicas, availableReplicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "capi-system",
+			Name:      name,
+			Labels:    map[string]string{clusterv1.ProviderNameLabel: "cluster-api"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: managerContainerName, Image: image},
+						{Name: "kube-rbac-proxy", Image: "gcr.io/kubebuilder/kube-rbac-proxy:v0.15.0"},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			UpdatedReplicas:   replicas,
+			AvailableReplicas: availableReplicas,
+		},
+	}
+}
+
+func newCRD(name string, established bool) *apiextensionsv1.CustomResourceDefinition {
+	status := apiextensionsv1.ConditionFalse
+	if established {
+		status = apiextensionsv1.ConditionTrue
+	}
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{clusterv1.ProviderNameLabel: "cluster-api"},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1beta2", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: status},
+			},
+		},
+	}
+}
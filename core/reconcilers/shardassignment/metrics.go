@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardassignment
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(shardInfo, shardClusters, shardAssignmentsTotal)
+}
+
+var (
+	shardInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_shard_info",
+			Help: "Shard reconciled by this replica of the core manager; the value is always 1.",
+		}, []string{
+			"shard",
+		},
+	)
+
+	shardClusters = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_shard_clusters",
+			Help: "Number of Clusters assigned to a shard.",
+		}, []string{
+			"shard",
+		},
+	)
+
+	shardAssignmentsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "capi_shard_assignments_total",
+			Help: "Total number of Clusters (re-)assigned to a shard by setting the shard label.",
+		}, []string{
+			"shard",
+		},
+	)
+)
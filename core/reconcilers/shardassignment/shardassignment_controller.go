@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardassignment
+
+import (
+	"context"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;patch
+
+// Reconciler assigns Clusters to shards by setting the shard label, so that the shard of a Cluster
+// is visible to users and doesn't change when the list of shards changes.
+// Note: The controller reconciles all Clusters independent of the shard of this replica; all replicas
+// compute the same shard for a Cluster as long as they use the same list of shards.
+type Reconciler struct {
+	Client client.Client
+
+	// Sharder computes the shard of Clusters.
+	Sharder *shard.Sharder
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// clusterShards tracks the shard of all Clusters to compute the shard metrics.
+	clusterShardsLock sync.Mutex
+	clusterShards     map[types.NamespacedName]string
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil || r.Sharder == nil {
+		return pkgerrors.New("Client and Sharder must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "shardassignment")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&clusterv1.Cluster{}).
+		Named("shardassignment").
+		WithOptions(options).
+		WithoutSharding().
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, r)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
+	r.clusterShards = map[types.NamespacedName]string{}
+	shardInfo.WithLabelValues(r.Sharder.Shard()).Set(1)
+	for _, s := range r.Sharder.Shards() {
+		shardClusters.WithLabelValues(s).Set(0)
+	}
+	return nil
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	cluster := &clusterv1.Cluster{}
+	if err := r.Client.Get(ctx, req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.setClusterShard(req.NamespacedName, "")
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	desiredShard := r.Sharder.ClusterShard(cluster)
+	r.setClusterShard(req.NamespacedName, desiredShard)
+
+	// Do not change the shard label of deleting Clusters.
+	if !cluster.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if cluster.Labels[clusterv1.ShardLabel] == desiredShard {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Assigning Cluster to shard", "shard", desiredShard)
+	original := cluster.DeepCopy()
+	if cluster.Labels == nil {
+		cluster.Labels = map[string]string{}
+	}
+	cluster.Labels[clusterv1.ShardLabel] = desiredShard
+	if err := r.Client.Patch(ctx, cluster, client.MergeFrom(original)); err != nil {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to assign Cluster to shard %q", desiredShard)
+	}
+	shardAssignmentsTotal.WithLabelValues(desiredShard).Inc()
+
+	return ctrl.Result{}, nil
+}
+
+// setClusterShard records the shard of a Cluster and updates the shard metrics;
+// an empty shard removes the Cluster.
+func (r *Reconciler) setClusterShard(cluster types.NamespacedName, clusterShard string) {
+	r.clusterShardsLock.Lock()
+	defer r.clusterShardsLock.Unlock()
+
+	if currentShard, ok := r.clusterShards[cluster]; ok {
+		if currentShard == clusterShard {
+			return
+		}
+		shardClusters.WithLabelValues(currentShard).Dec()
+		delete(r.clusterShards, cluster)
+	}
+	if clusterShard != "" {
+		r.clusterShards[cluster] = clusterShard
+		shardClusters.WithLabelValues(clusterShard).Inc()
+	}
+}
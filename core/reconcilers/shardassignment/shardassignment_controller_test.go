@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardassignment
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+func TestReconcile(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	unlabeled := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		Name:      "unlabeled",
+	}}
+	labeled := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		Name:      "labeled",
+		Labels:    map[string]string{clusterv1.ShardLabel: "shard-b"},
+	}}
+	unknownShard := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		Name:      "unknown-shard",
+		Labels:    map[string]string{clusterv1.ShardLabel: "shard-z"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(unlabeled, labeled, unknownShard).Build()
+
+	sharder, err := shard.New(c, scheme, shard.Options{Shard: "shard-a", Shards: []string{"shard-a", "shard-b"}, Key: shard.ClusterKey})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	r := &Reconciler{
+		Client:        c,
+		Sharder:       sharder,
+		clusterShards: map[types.NamespacedName]string{},
+	}
+	for _, s := range sharder.Shards() {
+		shardClusters.WithLabelValues(s).Set(0)
+	}
+
+	for _, cluster := range []*clusterv1.Cluster{unlabeled, labeled, unknownShard} {
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cluster)})
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	// Clusters without a valid shard label are assigned to the computed shard.
+	got := &clusterv1.Cluster{}
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(unlabeled), got)).To(Succeed())
+	g.Expect(got.Labels).To(HaveKeyWithValue(clusterv1.ShardLabel, sharder.Assign(metav1.NamespaceDefault, "unlabeled")))
+
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(unknownShard), got)).To(Succeed())
+	g.Expect(got.Labels).To(HaveKeyWithValue(clusterv1.ShardLabel, sharder.Assign(metav1.NamespaceDefault, "unknown-shard")))
+
+	// Clusters with a valid shard label keep their shard.
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(labeled), got)).To(Succeed())
+	g.Expect(got.Labels).To(HaveKeyWithValue(clusterv1.ShardLabel, "shard-b"))
+
+	total := testutil.ToFloat64(shardClusters.WithLabelValues("shard-a")) + testutil.ToFloat64(shardClusters.WithLabelValues("shard-b"))
+	g.Expect(total).To(Equal(3.0))
+
+	// Deleted Clusters are removed from the metrics.
+	g.Expect(c.Delete(context.Background(), labeled)).To(Succeed())
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(labeled)})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	total = testutil.ToFloat64(shardClusters.WithLabelValues("shard-a")) + testutil.ToFloat64(shardClusters.WithLabelValues("shard-b"))
+	g.Expect(total).To(Equal(2.0))
+}
@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"reflect"
@@ -27,6 +28,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -46,6 +48,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	coreadmission "sigs.k8s.io/cluster-api/core/webhooks/admission"
@@ -64,6 +67,9 @@ import (
 	"sigs.k8s.io/cluster-api/util/index"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	capirecord "sigs.k8s.io/cluster-api/util/record"
+	"sigs.k8s.io/cluster-api/util/shard"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
@@ -72,9 +78,17 @@ import (
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinehealthchecks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinesets;machines,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;create;delete
 
+// topologyEventReasons are the reasons of the events emitted by the Cluster topology controller.
+var topologyEventReasons = []string{
+	clusterv1.TopologyCreateEventReason,
+	clusterv1.TopologyDeleteEventReason,
+	clusterv1.TopologyUpdateEventReason,
+}
+
 // Reconciler reconciles a managed topology for a Cluster object.
 type Reconciler struct {
 	Client       client.Client
@@ -88,6 +102,21 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	// OrphanedTemplateGracePeriod is the time templates owned by the Cluster topology which are not referenced
+	// anymore are kept before being deleted. If zero, orphaned templates are not garbage collected.
+	OrphanedTemplateGracePeriod time.Duration
+
+	// EventDeduplicationWindow is the window within which identical events for the same object are only emitted once.
+	// If zero, events are not deduplicated.
+	EventDeduplicationWindow time.Duration
+
+	// OrphanedTemplateDryRun, if true, only reports orphaned templates without deleting them.
+	OrphanedTemplateDryRun bool
+
 	externalTracker external.ObjectTracker
 	controller      capicontrollerutil.Controller
 	recorder        record.EventRecorder
@@ -138,6 +167,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 			predicates.ResourceIsTopologyOwned(mgr.GetScheme(), predicateLog),
 		).
 		WithOptions(options).
+		WithSharder(r.Sharder).
 		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
 		Build(ctx, r)
 
@@ -166,7 +196,8 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	}
 
 	r.controller = c
-	r.recorder = mgr.GetEventRecorderFor("topology/cluster-controller")
+	r.recorder = capirecord.NewDeduplicatingRecorder(mgr.GetEventRecorderFor("topology/cluster-controller"), "topology/cluster", r.EventDeduplicationWindow)
+	capirecord.RegisterReasons(topologyEventReasons...)
 	r.ssaCache = ssa.NewCache("topology/cluster")
 	return nil
 }
@@ -268,10 +299,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	if err := r.Client.Get(ctx, req.NamespacedName, cluster); err != nil {
 		if apierrors.IsNotFound(err) {
 			r.controller.ClearConsistencyStore(req.NamespacedName, "")
+			deleteOrphanedTemplatesMetrics(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
 	}
+	tracing.SetClusterName(ctx, cluster.Name)
 
 	// Return early, if the Cluster does not use a managed topology.
 	// NOTE: We're already filtering events, but this is a safeguard for cases like e.g. when
@@ -291,7 +324,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	s := scope.New(cluster)
 
 	defer func() {
-		if err := r.reconcileStatus(s, cluster, reterr); err != nil {
+		if err := r.reconcileStatus(ctx, s, cluster, reterr); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, pkgerrors.Wrap(err, "failed to reconcile cluster topology conditions")})
 			return
 		}
@@ -302,6 +335,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 			patch.WithOwnedV1Beta1Conditions{Conditions: []clusterv1.ConditionType{
 				clusterv1.ClusterTopologyReconciledCondition,
 			}},
+			patch.WithOwnedConditions{Conditions: []string{
+				clusterv1.ClusterTopologyFieldsCoOwnedCondition,
+			}},
 		}
 		if err := patchHelper.Patch(ctx, cluster, options...); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, err})
@@ -397,23 +433,41 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 	}
 
 	// Computes the desired state of the Cluster and store it in the request scope.
-	s.Desired, err = r.desiredStateGenerator.Generate(ctx, s)
+	generateCtx, span := tracing.Start(ctx, "topology.GenerateDesiredState")
+	s.Desired, err = r.desiredStateGenerator.Generate(generateCtx, s)
+	tracing.End(span, err)
 	if err != nil {
 		return ctrl.Result{}, pkgerrors.Wrap(err, "error computing the desired state of the Cluster topology")
 	}
 
+	// Checks that all the objects in the desired state are compatible with the current state before changing any object,
+	// so the Cluster topology is not partially reconciled, e.g. after it has been rebased to an incompatible ClusterClass.
+	if err := checkDesiredStateIsCompatible(s); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Reconciles current and desired state of the Cluster
-	if err := r.reconcileState(ctx, s); err != nil {
+	reconcileStateCtx, span := tracing.Start(ctx, "topology.ReconcileState")
+	err = r.reconcileState(reconcileStateCtx, s)
+	tracing.End(span, err)
+	if err != nil {
 		return ctrl.Result{}, pkgerrors.Wrap(err, "error reconciling the Cluster topology")
 	}
 
+	// Garbage collects templates owned by the Cluster topology which are not referenced anymore.
+	orphanedTemplatesRequeueAfter, err := r.reconcileOrphanedTemplates(ctx, s)
+	if err != nil {
+		return ctrl.Result{}, pkgerrors.Wrap(err, "error garbage collecting orphaned templates")
+	}
+
 	// requeueAfter will not be 0 if any of the runtime hooks returns a blocking response.
 	requeueAfter := s.HookResponseTracker.AggregateRetryAfter()
 	if requeueAfter != 0 {
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue when the next orphaned template reaches the end of its grace period.
+	return ctrl.Result{RequeueAfter: orphanedTemplatesRequeueAfter}, nil
 }
 
 // setupDynamicWatches create watches for InfrastructureCluster and ControlPlane CRs when they exist.
@@ -553,6 +607,7 @@ func (r *Reconciler) machinePoolToCluster(_ context.Context, o client.Object) []
 
 func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope.Scope) (ctrl.Result, error) {
 	cluster := s.Current.Cluster
+	deleteOrphanedTemplatesMetrics(cluster.Namespace, cluster.Name)
 
 	// Call the BeforeClusterDelete hook if the 'ok-to-delete' annotation is not set
 	// and add the annotation to the cluster after receiving a successful non-blocking response.
@@ -579,8 +634,14 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope.Scope) (ctrl.
 				}
 			}
 
+			descendants, err := r.getDescendants(ctx, cluster)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
 			hookRequest := &runtimehooksv1.BeforeClusterDeleteRequest{
-				Cluster: *cleanupCluster(cluster),
+				Cluster:     *cleanupCluster(cluster),
+				Descendants: descendants,
 			}
 			hookResponse := &runtimehooksv1.BeforeClusterDeleteResponse{}
 			if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeClusterDelete, cluster, hookRequest, hookResponse); err != nil {
@@ -590,6 +651,11 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope.Scope) (ctrl.
 			s.HookResponseTracker.Add(runtimehooksv1.BeforeClusterDelete, hookResponse)
 
 			if hookResponse.RetryAfterSeconds != 0 {
+				// Surface the blocking reasons to the Cluster controller, which is responsible for the Deleting condition.
+				// Note: The annotation is persisted when patching the Cluster at the end of the reconcile.
+				if err := setBlockingReasonsAnnotation(cluster, hookResponse.BlockingReasons); err != nil {
+					return ctrl.Result{}, err
+				}
 				r.hookCache.Add(cache.NewHookEntry(s.Current.Cluster, runtimehooksv1.BeforeClusterDelete, time.Now().Add(time.Duration(hookResponse.RetryAfterSeconds)*time.Second), hookResponse.GetMessage()))
 				log.Info(fmt.Sprintf("Cluster deletion is blocked by %q hook, retry after %ds", runtimecatalog.HookName(runtimehooksv1.BeforeClusterDelete), hookResponse.RetryAfterSeconds))
 				return ctrl.Result{RequeueAfter: time.Duration(hookResponse.RetryAfterSeconds) * time.Second}, nil
@@ -605,6 +671,83 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope.Scope) (ctrl.
 	return ctrl.Result{}, nil
 }
 
+// getDescendants returns the inventory of the objects belonging to the Cluster that will be deleted as part of the Cluster deletion.
+func (r *Reconciler) getDescendants(ctx context.Context, cluster *clusterv1.Cluster) ([]runtimehooksv1.ClusterDescendant, error) {
+	descendants := []runtimehooksv1.ClusterDescendant{}
+
+	for _, ref := range []clusterv1.ContractVersionedObjectReference{cluster.Spec.InfrastructureRef, cluster.Spec.ControlPlaneRef} {
+		if !ref.IsDefined() {
+			continue
+		}
+		obj, err := external.GetObjectFromContractVersionedRef(ctx, r.Client, ref, cluster.Namespace)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, pkgerrors.Wrapf(err, "failed to get %s for Cluster %s", ref.Kind, klog.KObj(cluster))
+		}
+		descendants = append(descendants, runtimehooksv1.ClusterDescendant{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+		})
+	}
+
+	listOptions := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	}
+	lists := []client.ObjectList{
+		&clusterv1.MachineDeploymentList{},
+		&clusterv1.MachineSetList{},
+		&clusterv1.MachineList{},
+	}
+	if feature.Gates.Enabled(feature.MachinePool) {
+		lists = append(lists, &clusterv1.MachinePoolList{})
+	}
+	for _, list := range lists {
+		if err := r.Client.List(ctx, list, listOptions...); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to list %T for Cluster %s", list, klog.KObj(cluster))
+		}
+		if err := meta.EachListItem(list, func(o runtime.Object) error {
+			obj := o.(client.Object)
+			gvk, err := apiutil.GVKForObject(obj, r.Client.Scheme())
+			if err != nil {
+				return err
+			}
+			descendants = append(descendants, runtimehooksv1.ClusterDescendant{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Name:       obj.GetName(),
+			})
+			return nil
+		}); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get descendants for Cluster %s", klog.KObj(cluster))
+		}
+	}
+
+	return descendants, nil
+}
+
+// setBlockingReasonsAnnotation sets the BeforeClusterDeleteBlockingReasonsAnnotation to the JSON encoded blocking reasons,
+// or removes the annotation if there are no blocking reasons.
+func setBlockingReasonsAnnotation(cluster *clusterv1.Cluster, blockingReasons []runtimehooksv1.BlockingReason) error {
+	if len(blockingReasons) == 0 {
+		delete(cluster.Annotations, runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation)
+		return nil
+	}
+
+	value, err := json.Marshal(blockingReasons)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to marshal blocking reasons of the %s hook", runtimecatalog.HookName(runtimehooksv1.BeforeClusterDelete))
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation] = string(value)
+	return nil
+}
+
 func cleanupCluster(cluster *clusterv1.Cluster) *clusterv1.Cluster {
 	cluster = cluster.DeepCopy()
 
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"reflect"
 	"testing"
 	"time"
 
@@ -518,6 +519,25 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 			},
 		},
 	}
+	blockingResponseWithBlockingReasons := &runtimehooksv1.BeforeClusterDeleteResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			RetryAfterSeconds: int32(10),
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status:  runtimehooksv1.ResponseStatusSuccess,
+				Message: "hook is blocking",
+			},
+		},
+		BlockingReasons: []runtimehooksv1.BlockingReason{
+			{
+				Message: "MachineDeployment is still in use",
+				Object: runtimehooksv1.ClusterDescendant{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "MachineDeployment",
+					Name:       "md1",
+				},
+			},
+		},
+	}
 	failureResponse := &runtimehooksv1.BeforeClusterDeleteResponse{
 		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
 			CommonResponse: runtimehooksv1.CommonResponse{
@@ -527,14 +547,17 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 	}
 
 	tests := []struct {
-		name               string
-		cluster            *clusterv1.Cluster
-		hookResponse       *runtimehooksv1.BeforeClusterDeleteResponse
-		wantHookToBeCalled bool
-		wantResult         ctrl.Result
-		wantOkToDelete     bool
-		wantErr            bool
-		wantHookCacheEntry *cache.HookEntry
+		name                          string
+		cluster                       *clusterv1.Cluster
+		hookResponse                  *runtimehooksv1.BeforeClusterDeleteResponse
+		wantHookToBeCalled            bool
+		wantResult                    ctrl.Result
+		wantOkToDelete                bool
+		wantErr                       bool
+		wantHookCacheEntry            *cache.HookEntry
+		objs                          []client.Object
+		wantDescendants               []runtimehooksv1.ClusterDescendant
+		wantBlockingReasonsAnnotation string
 	}{
 		{
 			name: "should apply the ok-to-delete annotation if the BeforeClusterDelete hook returns a non-blocking response",
@@ -577,6 +600,49 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 			}, runtimehooksv1.BeforeClusterDelete,
 				time.Now().Add(time.Duration(blockingResponse.RetryAfterSeconds)*time.Second), blockingResponse.Message)),
 		},
+		{
+			name: "should send descendants and record blocking reasons if the BeforeClusterDelete hook returns a blocking response with blocking reasons",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "test-ns",
+				},
+				Spec: clusterv1.ClusterSpec{
+					Topology: clusterv1.Topology{},
+				},
+			},
+			objs: []client.Object{
+				builder.MachineDeployment("test-ns", "md1").
+					WithClusterName("test-cluster").
+					WithLabels(map[string]string{clusterv1.ClusterNameLabel: "test-cluster"}).
+					Build(),
+				// MachineDeployment of another Cluster, should not be included in the descendants.
+				builder.MachineDeployment("test-ns", "md2").
+					WithClusterName("another-cluster").
+					WithLabels(map[string]string{clusterv1.ClusterNameLabel: "another-cluster"}).
+					Build(),
+			},
+			hookResponse:       blockingResponseWithBlockingReasons,
+			wantResult:         ctrl.Result{RequeueAfter: time.Duration(10) * time.Second},
+			wantHookToBeCalled: true,
+			wantOkToDelete:     false,
+			wantErr:            false,
+			wantHookCacheEntry: ptr.To(cache.NewHookEntry(&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-cluster",
+				},
+			}, runtimehooksv1.BeforeClusterDelete,
+				time.Now().Add(time.Duration(blockingResponseWithBlockingReasons.RetryAfterSeconds)*time.Second), blockingResponseWithBlockingReasons.Message)),
+			wantDescendants: []runtimehooksv1.ClusterDescendant{
+				{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "MachineDeployment",
+					Name:       "md1",
+				},
+			},
+			wantBlockingReasonsAnnotation: `[{"message":"MachineDeployment is still in use","object":{"apiVersion":"cluster.x-k8s.io/v1beta2","kind":"MachineDeployment","name":"md1"}}]`,
+		},
 		{
 			name: "should fail if the BeforeClusterDelete hook returns a failure response",
 			cluster: &clusterv1.Cluster{
@@ -639,7 +705,7 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 			tt.cluster.Annotations[corev1.LastAppliedConfigAnnotation] = "should be cleaned up"
 			tt.cluster.Annotations[conversionutil.DataAnnotation] = "should be cleaned up"
 
-			fakeClient := fake.NewClientBuilder().WithObjects(tt.cluster).Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(append(tt.objs, tt.cluster)...).Build()
 			fakeRuntimeClient := (fakeruntimeclient.NewRuntimeClientBuilder().
 				WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{
 					beforeClusterDeleteGVH: {"foo"},
@@ -647,7 +713,17 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 				WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
 					beforeClusterDeleteGVH: tt.hookResponse,
 				}).
-				WithCallAllExtensionValidations(validateClusterParameter(tt.cluster)).
+				WithCallAllExtensionValidations(func(req runtimehooksv1.RequestObject) error {
+					if err := validateClusterParameter(tt.cluster)(req); err != nil {
+						return err
+					}
+					// check if the descendants of the Cluster are included in the payload of the BeforeClusterDelete hook.
+					if descendants := req.(*runtimehooksv1.BeforeClusterDeleteRequest).Descendants; len(descendants) != len(tt.wantDescendants) ||
+						(len(descendants) > 0 && !reflect.DeepEqual(descendants, tt.wantDescendants)) {
+						return fmt.Errorf("unexpected descendants %v, expected %v", descendants, tt.wantDescendants)
+					}
+					return nil
+				}).
 				WithCatalog(catalog).
 				Build()
 
@@ -667,6 +743,11 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 				g.Expect(res).To(BeComparableTo(tt.wantResult))
 				g.Expect(hooks.IsOkToDelete(tt.cluster)).To(Equal(tt.wantOkToDelete))
+				if tt.wantBlockingReasonsAnnotation != "" {
+					g.Expect(tt.cluster.Annotations).To(HaveKeyWithValue(runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation, tt.wantBlockingReasonsAnnotation))
+				} else {
+					g.Expect(tt.cluster.Annotations).ToNot(HaveKey(runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation))
+				}
 
 				if tt.wantHookToBeCalled {
 					g.Expect(fakeRuntimeClient.CallAllCount(runtimehooksv1.BeforeClusterDelete)).To(Equal(1), "Expected hook to be called once")
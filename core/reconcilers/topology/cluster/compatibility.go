@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/internal/topology/check"
+)
+
+// clusterClassNotCompatibleError is returned when the objects of the desired state computed from the ClusterClass
+// are not compatible with the objects of the current state, e.g. after changing Cluster.spec.topology.classRef
+// to a ClusterClass using templates of a different kind.
+type clusterClassNotCompatibleError struct {
+	clusterClass client.ObjectKey
+	errs         field.ErrorList
+}
+
+func (e *clusterClassNotCompatibleError) Error() string {
+	details := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		details = append(details, fmt.Sprintf("\n  * %s", err.Detail))
+	}
+	return fmt.Sprintf("ClusterClass %s is not compatible with the current Cluster topology:%s", e.clusterClass, strings.Join(details, ""))
+}
+
+// checkDesiredStateIsCompatible checks if all the objects in the desired state are compatible with the
+// corresponding objects in the current state.
+// NOTE: The same checks are performed when reconciling every single object; performing all of them before reconciling
+// any object ensures that a Cluster topology is not partially reconciled when it is rebased to an incompatible ClusterClass.
+func checkDesiredStateIsCompatible(s *scope.Scope) error {
+	var allErrs field.ErrorList
+
+	if s.Current.InfrastructureCluster != nil && s.Desired.InfrastructureCluster != nil {
+		allErrs = append(allErrs, check.ObjectsAreStrictlyCompatible(s.Current.InfrastructureCluster, s.Desired.InfrastructureCluster)...)
+	}
+
+	if s.Current.ControlPlane != nil && s.Current.ControlPlane.Object != nil && s.Desired.ControlPlane != nil && s.Desired.ControlPlane.Object != nil {
+		allErrs = append(allErrs, check.ObjectsAreStrictlyCompatible(s.Current.ControlPlane.Object, s.Desired.ControlPlane.Object)...)
+		if s.Current.ControlPlane.InfrastructureMachineTemplate != nil && s.Desired.ControlPlane.InfrastructureMachineTemplate != nil {
+			allErrs = append(allErrs, check.ObjectsAreCompatible(s.Current.ControlPlane.InfrastructureMachineTemplate, s.Desired.ControlPlane.InfrastructureMachineTemplate)...)
+		}
+	}
+
+	for _, mdTopologyName := range slices.Sorted(maps.Keys(s.Current.MachineDeployments)) {
+		currentMD := s.Current.MachineDeployments[mdTopologyName]
+		desiredMD, ok := s.Desired.MachineDeployments[mdTopologyName]
+		if !ok || currentMD.InfrastructureMachineTemplate == nil || desiredMD.InfrastructureMachineTemplate == nil {
+			continue
+		}
+		allErrs = append(allErrs, check.ObjectsAreCompatible(currentMD.InfrastructureMachineTemplate, desiredMD.InfrastructureMachineTemplate)...)
+	}
+
+	for _, mpTopologyName := range slices.Sorted(maps.Keys(s.Current.MachinePools)) {
+		currentMP := s.Current.MachinePools[mpTopologyName]
+		desiredMP, ok := s.Desired.MachinePools[mpTopologyName]
+		if !ok {
+			continue
+		}
+		if currentMP.InfrastructureMachinePoolObject != nil && desiredMP.InfrastructureMachinePoolObject != nil {
+			allErrs = append(allErrs, check.ObjectsAreStrictlyCompatible(currentMP.InfrastructureMachinePoolObject, desiredMP.InfrastructureMachinePoolObject)...)
+		}
+		if currentMP.BootstrapObject != nil && desiredMP.BootstrapObject != nil {
+			allErrs = append(allErrs, check.ObjectsAreStrictlyCompatible(currentMP.BootstrapObject, desiredMP.BootstrapObject)...)
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return &clusterClassNotCompatibleError{
+			clusterClass: s.Current.Cluster.GetClassKey(),
+			errs:         allErrs,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestCheckDesiredStateIsCompatible(t *testing.T) {
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").
+		WithTopology(builder.ClusterTopology().
+			WithClass("class2").
+			Build()).
+		Build()
+
+	infrastructureCluster := builder.TestInfrastructureCluster(metav1.NamespaceDefault, "infra1").Build()
+	controlPlane := builder.ControlPlane(metav1.NamespaceDefault, "cp1").Build()
+	mdInfrastructureMachineTemplate := builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "md1-infra1").Build()
+
+	// A rotated template has a different name, which is a compatible change.
+	rotatedMDInfrastructureMachineTemplate := mdInfrastructureMachineTemplate.DeepCopy()
+	rotatedMDInfrastructureMachineTemplate.SetName("md1-infra2")
+
+	// Objects of a different kind are computed after rebasing to an incompatible ClusterClass.
+	withKind := func(obj *unstructured.Unstructured, kind string) *unstructured.Unstructured {
+		obj = obj.DeepCopy()
+		obj.SetKind(kind)
+		return obj
+	}
+
+	clusterState := func(infrastructureCluster, controlPlane, mdInfrastructureMachineTemplate *unstructured.Unstructured) *scope.ClusterState {
+		return &scope.ClusterState{
+			Cluster:               cluster,
+			InfrastructureCluster: infrastructureCluster,
+			ControlPlane: &scope.ControlPlaneState{
+				Object: controlPlane,
+			},
+			MachineDeployments: scope.MachineDeploymentsStateMap{
+				"md1": &scope.MachineDeploymentState{
+					Object:                        builder.MachineDeployment(metav1.NamespaceDefault, "md1").Build(),
+					InfrastructureMachineTemplate: mdInfrastructureMachineTemplate,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		current     *scope.ClusterState
+		desired     *scope.ClusterState
+		wantErr     bool
+		wantDetails []string
+	}{
+		{
+			name:    "Compatible if desired objects are equal to the current objects",
+			current: clusterState(infrastructureCluster, controlPlane, mdInfrastructureMachineTemplate),
+			desired: clusterState(infrastructureCluster, controlPlane, mdInfrastructureMachineTemplate),
+			wantErr: false,
+		},
+		{
+			name:    "Compatible if templates are rotated",
+			current: clusterState(infrastructureCluster, controlPlane, mdInfrastructureMachineTemplate),
+			desired: clusterState(infrastructureCluster, controlPlane, rotatedMDInfrastructureMachineTemplate),
+			wantErr: false,
+		},
+		{
+			name:    "Not compatible if the kind of objects change",
+			current: clusterState(infrastructureCluster, controlPlane, mdInfrastructureMachineTemplate),
+			desired: clusterState(withKind(infrastructureCluster, "OtherInfrastructureCluster"), controlPlane, withKind(mdInfrastructureMachineTemplate, "OtherInfrastructureMachineTemplate")),
+			wantErr: true,
+			wantDetails: []string{
+				"ClusterClass default/class2 is not compatible with the current Cluster topology:",
+				"\n  * apiVersion.kind of TestInfrastructureCluster.infrastructure.cluster.x-k8s.io/infra1 cannot be changed from \"TestInfrastructureCluster\" to \"OtherInfrastructureCluster\"",
+				"\n  * apiVersion.kind of GenericInfrastructureMachineTemplate.infrastructure.cluster.x-k8s.io/md1-infra1 cannot be changed from \"GenericInfrastructureMachineTemplate\" to \"OtherInfrastructureMachineTemplate\"",
+			},
+		},
+		{
+			name:    "Not compatible if the name of the ControlPlane changes",
+			current: clusterState(infrastructureCluster, controlPlane, mdInfrastructureMachineTemplate),
+			desired: clusterState(infrastructureCluster, builder.ControlPlane(metav1.NamespaceDefault, "cp2").Build(), mdInfrastructureMachineTemplate),
+			wantErr: true,
+			wantDetails: []string{
+				"\n  * metadata.name of GenericControlPlane.controlplane.cluster.x-k8s.io/cp1 cannot be changed from \"cp1\" to \"cp2\"",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := scope.New(cluster)
+			s.Current = tt.current
+			s.Desired = tt.desired
+
+			err := checkDesiredStateIsCompatible(s)
+			if !tt.wantErr {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			var notCompatibleErr *clusterClassNotCompatibleError
+			g.Expect(errors.As(err, &notCompatibleErr)).To(BeTrue())
+			for _, detail := range tt.wantDetails {
+				g.Expect(err.Error()).To(ContainSubstring(detail))
+			}
+		})
+	}
+}
@@ -54,7 +54,7 @@ func (r *Reconciler) getCurrentState(ctx context.Context, s *scope.Scope) (*scop
 	// should still be allowed to continue.
 	currentState.ControlPlane = &scope.ControlPlaneState{}
 	if currentState.Cluster.Spec.ControlPlaneRef.IsDefined() {
-		cp, err := r.getCurrentControlPlaneState(ctx, s.Blueprint.ControlPlane, s.Blueprint.HasControlPlaneInfrastructureMachine(), currentState.Cluster)
+		cp, err := r.getCurrentControlPlaneState(ctx, s.Blueprint.ControlPlane, s.Blueprint.HasControlPlaneInfrastructureMachine(), s.Blueprint.IsControlPlaneExternallyManaged(), currentState.Cluster)
 		if err != nil {
 			return nil, err
 		}
@@ -103,7 +103,8 @@ func (r *Reconciler) getCurrentInfrastructureClusterState(ctx context.Context, b
 // getCurrentControlPlaneState returns information on the ControlPlane being used by the Cluster. If a reference is not found,
 // an error is thrown. If the ControlPlane requires MachineInfrastructure according to its ClusterClass an error will be
 // thrown if the ControlPlane has no MachineTemplates.
-func (r *Reconciler) getCurrentControlPlaneState(ctx context.Context, blueprintControlPlane *scope.ControlPlaneBlueprint, blueprintHasControlPlaneInfrastructureMachine bool, cluster *clusterv1.Cluster) (*scope.ControlPlaneState, error) {
+// If the ControlPlane is externally managed, only the ControlPlane object is read, and it is not required to be topology owned.
+func (r *Reconciler) getCurrentControlPlaneState(ctx context.Context, blueprintControlPlane *scope.ControlPlaneBlueprint, blueprintHasControlPlaneInfrastructureMachine, externallyManaged bool, cluster *clusterv1.Cluster) (*scope.ControlPlaneState, error) {
 	var err error
 	res := &scope.ControlPlaneState{}
 
@@ -116,6 +117,10 @@ func (r *Reconciler) getCurrentControlPlaneState(ctx context.Context, blueprintC
 	if err != nil {
 		return nil, pkgerrors.Wrapf(err, "failed to read %s %s", cluster.Spec.ControlPlaneRef.Kind, klog.KRef(cluster.Namespace, cluster.Spec.ControlPlaneRef.Name))
 	}
+	// If the ControlPlane is externally managed, return; the topology controller doesn't manage any of its objects.
+	if externallyManaged {
+		return res, nil
+	}
 	// check that the referenced object has the ClusterTopologyOwnedLabel label.
 	// Nb. This is to make sure that a managed topology cluster does not have a reference to an object that is not
 	// owned by the topology.
@@ -312,6 +312,34 @@ func testGetCurrentState(t *testing.T, controlPlaneContractVersion string) {
 			},
 			wantErr: true, // this test fails as partial reconcile is undefined.
 		},
+		{
+			name: "Should read an externally managed Control Plane that is not topology owned",
+			cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").
+				WithControlPlane(controlPlaneNotTopologyOwned).
+				Build(),
+			blueprint: &scope.ClusterBlueprint{
+				Topology: *builder.ClusterTopology().
+					WithControlPlaneExternallyManaged(true).
+					Build(),
+				ClusterClass: clusterClassWithControlPlaneInfra,
+				ControlPlane: &scope.ControlPlaneBlueprint{
+					Template: controlPlaneTemplateWithInfrastructureMachine,
+				},
+			},
+			objects: []client.Object{
+				controlPlaneNotTopologyOwned,
+			},
+			// Expecting valid return with the ControlPlane state, but without ControlPlane Infrastructure or MachineHealthCheck.
+			want: &scope.ClusterState{
+				Cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").
+					WithControlPlane(controlPlaneNotTopologyOwned).
+					Build(),
+				ControlPlane:          &scope.ControlPlaneState{Object: controlPlaneNotTopologyOwned},
+				InfrastructureCluster: nil,
+				MachineDeployments:    emptyMachineDeployments,
+				MachinePools:          emptyMachinePools,
+			},
+		},
 		{
 			name: "Should read  a partial Cluster (with InfrastructureCluster only)",
 			cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").
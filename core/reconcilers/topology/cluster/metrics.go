@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(orphanedTemplates, orphanedTemplatesDeletedTotal)
+}
+
+var (
+	orphanedTemplates = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capi_topology_orphaned_templates",
+			Help: "Number of templates owned by a Cluster topology which are not referenced anymore and not yet deleted.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+
+	orphanedTemplatesDeletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "capi_topology_orphaned_templates_deleted_total",
+			Help: "Total number of orphaned templates owned by a Cluster topology which have been deleted after the grace period.",
+		}, []string{
+			"cluster_name", "cluster_namespace",
+		},
+	)
+)
+
+// setOrphanedTemplatesMetric records the number of orphaned templates of the Cluster.
+func setOrphanedTemplatesMetric(namespace, name string, count int) {
+	orphanedTemplates.WithLabelValues(name, namespace).Set(float64(count))
+}
+
+// deleteOrphanedTemplatesMetrics deletes the metrics for the Cluster, e.g. once the Cluster is gone.
+func deleteOrphanedTemplatesMetrics(namespace, name string) {
+	labels := prometheus.Labels{
+		"cluster_name":      name,
+		"cluster_namespace": namespace,
+	}
+	orphanedTemplates.DeletePartialMatch(labels)
+	orphanedTemplatesDeletedTotal.DeletePartialMatch(labels)
+}
@@ -21,7 +21,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
 	"runtime/debug"
+	"slices"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -293,22 +295,26 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 	req.Items = append(req.Items, *t)
 
 	// Add the ControlPlaneTemplate.
-	// Syncing labels/annotations added (during desired state computation) to the desired state back into the template, so the patch engine can consider them.
-	if err := patchUnstructured(ctx, blueprint.ControlPlane.Template, desired.ControlPlane.Object, []patchUnstructuredFields{
-		{Src: []string{"metadata", "labels"}, Dest: []string{"spec", "template", "metadata", "labels"}},
-		{Src: []string{"metadata", "annotations"}, Dest: []string{"spec", "template", "metadata", "annotations"}},
-	}); err != nil {
-		return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for patching",
-			blueprint.ControlPlane.Template.GetKind(), klog.KObj(blueprint.ControlPlane.Template))
-	}
-	t, err = newRequestItemBuilder(blueprint.ControlPlane.Template).
-		WithHolder(desired.Cluster, clusterv1.GroupVersion.WithKind("Cluster"), "spec.controlPlaneRef").
-		Build()
-	if err != nil {
-		return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for patching",
-			blueprint.ControlPlane.Template.GetKind(), klog.KObj(blueprint.ControlPlane.Template))
+	// NOTE: If the ControlPlane is externally managed, the ControlPlaneTemplate is not added, because
+	// the topology controller does not update the ControlPlane.
+	if !blueprint.IsControlPlaneExternallyManaged() {
+		// Syncing labels/annotations added (during desired state computation) to the desired state back into the template, so the patch engine can consider them.
+		if err := patchUnstructured(ctx, blueprint.ControlPlane.Template, desired.ControlPlane.Object, []patchUnstructuredFields{
+			{Src: []string{"metadata", "labels"}, Dest: []string{"spec", "template", "metadata", "labels"}},
+			{Src: []string{"metadata", "annotations"}, Dest: []string{"spec", "template", "metadata", "annotations"}},
+		}); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for patching",
+				blueprint.ControlPlane.Template.GetKind(), klog.KObj(blueprint.ControlPlane.Template))
+		}
+		t, err = newRequestItemBuilder(blueprint.ControlPlane.Template).
+			WithHolder(desired.Cluster, clusterv1.GroupVersion.WithKind("Cluster"), "spec.controlPlaneRef").
+			Build()
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for patching",
+				blueprint.ControlPlane.Template.GetKind(), klog.KObj(blueprint.ControlPlane.Template))
+		}
+		req.Items = append(req.Items, *t)
 	}
-	req.Items = append(req.Items, *t)
 
 	// If the clusterClass mandates the controlPlane has infrastructureMachines,
 	// add the InfrastructureMachineTemplate for control plane machines.
@@ -337,7 +343,9 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 	// MachineDeploymentClasses in the ClusterClass because each MachineDeployment in a topology
 	// has its own state, e.g. version or replicas. This state is used to calculate builtin variables,
 	// which can then be used e.g. to compute the machine image for a specific Kubernetes version.
-	for mdTopologyName, md := range desired.MachineDeployments {
+	// NOTE: MachineDeployments are sorted by topology name, so the GeneratePatchesRequest is deterministic.
+	for _, mdTopologyName := range slices.Sorted(maps.Keys(desired.MachineDeployments)) {
+		md := desired.MachineDeployments[mdTopologyName]
 		// Lookup MachineDeploymentTopology definition from cluster.spec.topology.
 		mdTopology, err := lookupMDTopology(blueprint.Topology, mdTopologyName)
 		if err != nil {
@@ -360,7 +368,7 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 				mdClass.BootstrapTemplate.GetKind(), klog.KObj(mdClass.BootstrapTemplate), mdTopologyName)
 		}
 		t, err := newRequestItemBuilder(mdClass.BootstrapTemplate).
-			WithHolder(md.Object, clusterv1.GroupVersion.WithKind("MachineDeployment"), "spec.template.spec.bootstrap.configRef").
+			WithHolder(md.Object, clusterv1.GroupVersion.WithKind("MachineDeployment"), runtimehooksv1.BootstrapConfigRefHolderFieldPath).
 			Build()
 		if err != nil {
 			return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for MachineDeployment topology %s for patching",
@@ -378,7 +386,7 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 				mdClass.InfrastructureMachineTemplate.GetKind(), klog.KObj(mdClass.InfrastructureMachineTemplate), mdTopologyName)
 		}
 		t, err = newRequestItemBuilder(mdClass.InfrastructureMachineTemplate).
-			WithHolder(md.Object, clusterv1.GroupVersion.WithKind("MachineDeployment"), "spec.template.spec.infrastructureRef").
+			WithHolder(md.Object, clusterv1.GroupVersion.WithKind("MachineDeployment"), runtimehooksv1.InfrastructureRefHolderFieldPath).
 			Build()
 		if err != nil {
 			return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for MachineDeployment topology %s for patching",
@@ -393,7 +401,9 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 	// MachinePoolClasses in the ClusterClass because each MachinePool in a topology
 	// has its own state, e.g. version or replicas. This state is used to calculate builtin variables,
 	// which can then be used e.g. to compute the machine image for a specific Kubernetes version.
-	for mpTopologyName, mp := range desired.MachinePools {
+	// NOTE: MachinePools are sorted by topology name, so the GeneratePatchesRequest is deterministic.
+	for _, mpTopologyName := range slices.Sorted(maps.Keys(desired.MachinePools)) {
+		mp := desired.MachinePools[mpTopologyName]
 		// Lookup MachinePoolTopology definition from cluster.spec.topology.
 		mpTopology, err := lookupMPTopology(blueprint.Topology, mpTopologyName)
 		if err != nil {
@@ -416,7 +426,7 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 				mpClass.BootstrapTemplate.GetKind(), klog.KObj(mpClass.BootstrapTemplate), mpTopologyName)
 		}
 		t, err := newRequestItemBuilder(mpClass.BootstrapTemplate).
-			WithHolder(mp.Object, clusterv1.GroupVersion.WithKind("MachinePool"), "spec.template.spec.bootstrap.configRef").
+			WithHolder(mp.Object, clusterv1.GroupVersion.WithKind("MachinePool"), runtimehooksv1.BootstrapConfigRefHolderFieldPath).
 			Build()
 		if err != nil {
 			return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for MachinePool topology %s for patching",
@@ -434,7 +444,7 @@ func createRequest(ctx context.Context, blueprint *scope.ClusterBlueprint, desir
 				mpClass.InfrastructureMachinePoolTemplate.GetKind(), klog.KObj(mpClass.InfrastructureMachinePoolTemplate), mpTopologyName)
 		}
 		t, err = newRequestItemBuilder(mpClass.InfrastructureMachinePoolTemplate).
-			WithHolder(mp.Object, clusterv1.GroupVersion.WithKind("MachinePool"), "spec.template.spec.infrastructureRef").
+			WithHolder(mp.Object, clusterv1.GroupVersion.WithKind("MachinePool"), runtimehooksv1.InfrastructureRefHolderFieldPath).
 			Build()
 		if err != nil {
 			return nil, pkgerrors.Wrapf(err, "failed to prepare %s %s for MachinePool topology %s for patching",
@@ -612,30 +622,33 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 	}
 
 	// Update the ControlPlane.
-	controlPlaneTemplate, err := getTemplateAsUnstructured(req, "Cluster", "spec.controlPlaneRef", requestTopologyName{})
-	if err != nil {
-		return err
-	}
-	if err := patchObject(ctx, desired.ControlPlane.Object, controlPlaneTemplate, append(PreserveFields{
-		contract.ControlPlane().MachineTemplate().Metadata().Path(),
-		// Note: For simplicity we don't allow patching for the fields of both contracts to avoid
-		// requiring a client here to retrieve the contract version of the ControlPlane object.
-		contract.ControlPlane().MachineTemplate().ReadinessGates("v1beta1").Path(),
-		contract.ControlPlane().MachineTemplate().ReadinessGates("v1beta2").Path(),
-		contract.ControlPlane().MachineTemplate().Taints().Path(),
-		contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Path(),
-		contract.ControlPlane().MachineTemplate().InfrastructureRef().Path(),
-		contract.ControlPlane().MachineTemplate().NodeDrainTimeout().Path(),
-		contract.ControlPlane().MachineTemplate().NodeVolumeDetachTimeout().Path(),
-		contract.ControlPlane().MachineTemplate().NodeDeletionTimeout().Path(),
-		contract.ControlPlane().MachineTemplate().NodeDrainTimeoutSeconds().Path(),
-		contract.ControlPlane().MachineTemplate().NodeVolumeDetachTimeoutSeconds().Path(),
-		contract.ControlPlane().MachineTemplate().NodeDeletionTimeoutSeconds().Path(),
-		contract.ControlPlane().Replicas().Path(),
-		contract.ControlPlane().Version().Path(),
-		contract.ControlPlane().RolloutAfter().Path(),
-	}, alwaysPreserveLabelsAndAnnotations...)); err != nil {
-		return err
+	// NOTE: If the ControlPlane is externally managed, it is not part of the request, see createRequest.
+	if !blueprint.IsControlPlaneExternallyManaged() {
+		controlPlaneTemplate, err := getTemplateAsUnstructured(req, "Cluster", "spec.controlPlaneRef", requestTopologyName{})
+		if err != nil {
+			return err
+		}
+		if err := patchObject(ctx, desired.ControlPlane.Object, controlPlaneTemplate, append(PreserveFields{
+			contract.ControlPlane().MachineTemplate().Metadata().Path(),
+			// Note: For simplicity we don't allow patching for the fields of both contracts to avoid
+			// requiring a client here to retrieve the contract version of the ControlPlane object.
+			contract.ControlPlane().MachineTemplate().ReadinessGates("v1beta1").Path(),
+			contract.ControlPlane().MachineTemplate().ReadinessGates("v1beta2").Path(),
+			contract.ControlPlane().MachineTemplate().Taints().Path(),
+			contract.ControlPlane().MachineTemplate().InfrastructureV1Beta1Ref().Path(),
+			contract.ControlPlane().MachineTemplate().InfrastructureRef().Path(),
+			contract.ControlPlane().MachineTemplate().NodeDrainTimeout().Path(),
+			contract.ControlPlane().MachineTemplate().NodeVolumeDetachTimeout().Path(),
+			contract.ControlPlane().MachineTemplate().NodeDeletionTimeout().Path(),
+			contract.ControlPlane().MachineTemplate().NodeDrainTimeoutSeconds().Path(),
+			contract.ControlPlane().MachineTemplate().NodeVolumeDetachTimeoutSeconds().Path(),
+			contract.ControlPlane().MachineTemplate().NodeDeletionTimeoutSeconds().Path(),
+			contract.ControlPlane().Replicas().Path(),
+			contract.ControlPlane().Version().Path(),
+			contract.ControlPlane().RolloutAfter().Path(),
+		}, alwaysPreserveLabelsAndAnnotations...)); err != nil {
+			return err
+		}
 	}
 
 	// If the ClusterClass mandates the ControlPlane has InfrastructureMachines,
@@ -654,7 +667,7 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 	for mdTopologyName, md := range desired.MachineDeployments {
 		topologyName := requestTopologyName{mdTopologyName: mdTopologyName}
 		// Update the BootstrapConfigTemplate.
-		bootstrapTemplate, err := getTemplateAsUnstructured(req, "MachineDeployment", "spec.template.spec.bootstrap.configRef", topologyName)
+		bootstrapTemplate, err := getTemplateAsUnstructured(req, "MachineDeployment", runtimehooksv1.BootstrapConfigRefHolderFieldPath, topologyName)
 		if err != nil {
 			return err
 		}
@@ -663,7 +676,7 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 		}
 
 		// Update the InfrastructureMachineTemplate.
-		infrastructureMachineTemplate, err := getTemplateAsUnstructured(req, "MachineDeployment", "spec.template.spec.infrastructureRef", topologyName)
+		infrastructureMachineTemplate, err := getTemplateAsUnstructured(req, "MachineDeployment", runtimehooksv1.InfrastructureRefHolderFieldPath, topologyName)
 		if err != nil {
 			return err
 		}
@@ -676,7 +689,7 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 	for mpTopologyName, mp := range desired.MachinePools {
 		topologyName := requestTopologyName{mpTopologyName: mpTopologyName}
 		// Update the BootstrapConfig.
-		bootstrapTemplate, err := getTemplateAsUnstructured(req, "MachinePool", "spec.template.spec.bootstrap.configRef", topologyName)
+		bootstrapTemplate, err := getTemplateAsUnstructured(req, "MachinePool", runtimehooksv1.BootstrapConfigRefHolderFieldPath, topologyName)
 		if err != nil {
 			return err
 		}
@@ -685,7 +698,7 @@ func updateDesiredState(ctx context.Context, req *runtimehooksv1.GeneratePatches
 		}
 
 		// Update the InfrastructureMachinePool.
-		infrastructureMachinePoolTemplate, err := getTemplateAsUnstructured(req, "MachinePool", "spec.template.spec.infrastructureRef", topologyName)
+		infrastructureMachinePoolTemplate, err := getTemplateAsUnstructured(req, "MachinePool", runtimehooksv1.InfrastructureRefHolderFieldPath, topologyName)
 		if err != nil {
 			return err
 		}
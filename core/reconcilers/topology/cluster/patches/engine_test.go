@@ -59,12 +59,13 @@ func TestApply(t *testing.T) {
 	}
 
 	tests := []struct {
-		name                   string
-		patches                []clusterv1.ClusterClassPatch
-		varDefinitions         []clusterv1.ClusterClassStatusVariable
-		externalPatchResponses map[string]runtimehooksv1.ResponseObject
-		expectedFields         expectedFields
-		wantErr                bool
+		name                          string
+		patches                       []clusterv1.ClusterClassPatch
+		varDefinitions                []clusterv1.ClusterClassStatusVariable
+		externalPatchResponses        map[string]runtimehooksv1.ResponseObject
+		controlPlaneExternallyManaged bool
+		expectedFields                expectedFields
+		wantErr                       bool
 	}{
 		{
 			name: "Should preserve desired state, if there are no patches",
@@ -203,6 +204,127 @@ func TestApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Should not apply JSON patches to an externally managed ControlPlane and its InfrastructureMachineTemplate",
+			patches: []clusterv1.ClusterClassPatch{
+				{
+					Name: "fake-patch1",
+					Definitions: []clusterv1.PatchDefinition{
+						{
+							Selector: clusterv1.PatchSelector{
+								APIVersion: builder.InfrastructureGroupVersion.String(),
+								Kind:       builder.GenericInfrastructureClusterTemplateKind,
+								MatchResources: clusterv1.PatchSelectorMatch{
+									InfrastructureCluster: ptr.To(true),
+								},
+							},
+							JSONPatches: []clusterv1.JSONPatch{
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata/labels",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"top-level-label-1": "top-level-label-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata/annotations",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"top-level-annotation-1": "top-level-annotation-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/spec/resource",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`"infraCluster"`)},
+								},
+							},
+						},
+						{
+							Selector: clusterv1.PatchSelector{
+								APIVersion: builder.ControlPlaneGroupVersion.String(),
+								Kind:       builder.GenericControlPlaneTemplateKind,
+								MatchResources: clusterv1.PatchSelectorMatch{
+									ControlPlane: ptr.To(true),
+								},
+							},
+							JSONPatches: []clusterv1.JSONPatch{
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata/labels",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"top-level-label-1": "top-level-label-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata/annotations",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"top-level-annotation-1": "top-level-annotation-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/spec/resource",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`"controlPlane"`)},
+								},
+							},
+						},
+						{
+							Selector: clusterv1.PatchSelector{
+								APIVersion: builder.InfrastructureGroupVersion.String(),
+								Kind:       builder.GenericInfrastructureMachineTemplateKind,
+								MatchResources: clusterv1.PatchSelectorMatch{
+									ControlPlane: ptr.To(true),
+								},
+							},
+							JSONPatches: []clusterv1.JSONPatch{
+								{
+									Op:    "add",
+									Path:  "/metadata/labels",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"top-level-label-1": "top-level-label-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/metadata/annotations",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"top-level-annotation-1": "top-level-annotation-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata/labels",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"nested-label-1": "nested-label-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/metadata/annotations",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"nested-annotation-1": "nested-annotation-value-1"}`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/template/spec/resource",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`"controlPlaneInfrastructureMachineTemplate"`)},
+								},
+							},
+						},
+					},
+				},
+			},
+			controlPlaneExternallyManaged: true,
+			expectedFields: expectedFields{
+				infrastructureCluster: map[string]interface{}{
+					"metadata.labels.top-level-label-1":           "top-level-label-value-1",
+					"metadata.annotations.top-level-annotation-1": "top-level-annotation-value-1",
+					"spec.resource": "infraCluster",
+				},
+			},
+		},
 		{
 			name: "Should apply JSON patches to MachineDeployment and MachinePool templates",
 			patches: []clusterv1.ClusterClassPatch{
@@ -770,6 +892,55 @@ func TestApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Successfully apply external patches to MachinePool templates",
+			patches: []clusterv1.ClusterClassPatch{
+				{
+					Name: "fake-patch1",
+					External: &clusterv1.ExternalPatchDefinition{
+						GeneratePatchesExtension: "patch-machinePools",
+					},
+				},
+			},
+			externalPatchResponses: map[string]runtimehooksv1.ResponseObject{
+				// NOTE: Request items are sorted, i.e. uid 8 and 9 are the BootstrapTemplate and InfrastructureMachinePoolTemplate
+				// of default-mp-worker-topo1, uid 10 and 11 are the ones of default-mp-worker-topo2.
+				"patch-machinePools": &runtimehooksv1.GeneratePatchesResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{
+						Status: runtimehooksv1.ResponseStatusSuccess,
+					},
+					Items: []runtimehooksv1.GeneratePatchesResponseItem{
+						{
+							UID:       "8",
+							PatchType: runtimehooksv1.JSONMergePatchType,
+							Patch:     []byte(`{"spec":{"template":{"metadata":{"labels":{"top-level-label-1":"top-level-label-value-1"}},"spec":{"resource":"default-mp-worker-topo1-bootstrap"}}}}`),
+						},
+						{
+							UID:       "11",
+							PatchType: runtimehooksv1.JSONPatchType,
+							Patch: bytesPatch([]jsonPatchRFC6902{{
+								Op:    "add",
+								Path:  "/spec/template/spec/resource",
+								Value: &apiextensionsv1.JSON{Raw: []byte(`"default-mp-worker-topo2-infra"`)},
+							}}),
+						},
+					},
+				},
+			},
+			expectedFields: expectedFields{
+				machinePoolBootstrapConfig: map[string]map[string]interface{}{
+					"default-mp-worker-topo1": {
+						"metadata.labels.top-level-label-1": "top-level-label-value-1",
+						"spec.resource":                     "default-mp-worker-topo1-bootstrap",
+					},
+				},
+				machinePoolInfrastructureMachinePool: map[string]map[string]interface{}{
+					"default-mp-worker-topo2": {
+						"spec.resource": "default-mp-worker-topo2-infra",
+					},
+				},
+			},
+		},
 		{
 			name: "Should correctly apply patches with builtin variables",
 			patches: []clusterv1.ClusterClassPatch{
@@ -1171,6 +1342,9 @@ func TestApply(t *testing.T) {
 				//     * with a "default-mp-worker-topo2" MachinePoolTopology with 3 replicas (based on "default-mp-worker")
 				// * desired: essentially the corresponding desired objects.
 				blueprint, desired := setupTestObjects()
+				if tt.controlPlaneExternallyManaged {
+					blueprint.Topology.ControlPlane.ExternallyManaged = ptr.To(true)
+				}
 
 				scheme := runtime.NewScheme()
 				g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
@@ -1204,16 +1378,26 @@ func TestApply(t *testing.T) {
 						WithCallExtensionValidations(func(_ string, req runtimehooksv1.RequestObject) error {
 							switch req := req.(type) {
 							case *runtimehooksv1.GeneratePatchesRequest:
+								foundControlPlaneItem := false
 								for _, item := range req.Items {
-									if item.HolderReference.Kind != builder.GenericControlPlaneKind {
-										continue
+									switch item.HolderReference.Kind {
+									case builder.GenericControlPlaneKind:
+										if item.HolderReference.FieldPath != getControlPlaneHolderFieldPath(controlPlaneContractVersion) {
+											return fmt.Errorf("unexpected field path %s, should be %s", item.HolderReference.FieldPath, getControlPlaneHolderFieldPath(controlPlaneContractVersion))
+										}
+										foundControlPlaneItem = true
+									case "MachineDeployment", "MachinePool":
+										// MachineDeployments and MachinePools must use the same field paths.
+										if item.HolderReference.FieldPath != runtimehooksv1.BootstrapConfigRefHolderFieldPath &&
+											item.HolderReference.FieldPath != runtimehooksv1.InfrastructureRefHolderFieldPath {
+											return fmt.Errorf("unexpected field path %s for %s", item.HolderReference.FieldPath, item.HolderReference.Kind)
+										}
 									}
-									if item.HolderReference.FieldPath != getControlPlaneHolderFieldPath(controlPlaneContractVersion) {
-										return fmt.Errorf("unexpected field path %s, should be %s", item.HolderReference.FieldPath, getControlPlaneHolderFieldPath(controlPlaneContractVersion))
-									}
-									return nil
 								}
-								return fmt.Errorf("could not find request item for ControlPlane InfrastructureMachineTemplate")
+								if !foundControlPlaneItem {
+									return fmt.Errorf("could not find request item for ControlPlane InfrastructureMachineTemplate")
+								}
+								return nil
 							case *runtimehooksv1.ValidateTopologyRequest:
 								return nil // Nothing to validate.
 							default:
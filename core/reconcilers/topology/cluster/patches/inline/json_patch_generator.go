@@ -168,8 +168,8 @@ func matchesSelector(req *runtimehooksv1.GeneratePatchesRequestItem, templateVar
 		// MachineDeployment.spec.template.spec.infrastructureRef holds the BootstrapConfigTemplate or
 		// InfrastructureMachineTemplate.
 		if req.HolderReference.Kind == "MachineDeployment" &&
-			(req.HolderReference.FieldPath == "spec.template.spec.bootstrap.configRef" ||
-				req.HolderReference.FieldPath == "spec.template.spec.infrastructureRef") {
+			(req.HolderReference.FieldPath == runtimehooksv1.BootstrapConfigRefHolderFieldPath ||
+				req.HolderReference.FieldPath == runtimehooksv1.InfrastructureRefHolderFieldPath) {
 			// Read the builtin.machineDeployment.class variable.
 			templateMDClassJSON, err := patchvariables.GetVariableValue(templateVariables, "builtin.machineDeployment.class")
 
@@ -197,8 +197,8 @@ func matchesSelector(req *runtimehooksv1.GeneratePatchesRequestItem, templateVar
 	// of one of the configured MachinePoolClasses.
 	if selector.MatchResources.MachinePoolClass != nil {
 		if req.HolderReference.Kind == "MachinePool" &&
-			(req.HolderReference.FieldPath == "spec.template.spec.bootstrap.configRef" ||
-				req.HolderReference.FieldPath == "spec.template.spec.infrastructureRef") {
+			(req.HolderReference.FieldPath == runtimehooksv1.BootstrapConfigRefHolderFieldPath ||
+				req.HolderReference.FieldPath == runtimehooksv1.InfrastructureRefHolderFieldPath) {
 			// Read the builtin.machinePool.class variable.
 			templateMPClassJSON, err := patchvariables.GetVariableValue(templateVariables, "builtin.machinePool.class")
 
@@ -54,12 +54,6 @@ import (
 	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
 )
 
-const (
-	createEventReason = "TopologyCreate"
-	updateEventReason = "TopologyUpdate"
-	deleteEventReason = "TopologyDelete"
-)
-
 // reconcileState reconciles the current and desired state of the managed Cluster topology.
 // NOTE: We are assuming all the required objects are provided as input; also, in case of any error,
 // the entire reconcile operation will fail. This might be improved in the future if support for reconciling
@@ -313,6 +307,12 @@ func (r *Reconciler) reconcileInfrastructureCluster(ctx context.Context, s *scop
 // reconcileControlPlane works to bring the current state of a managed topology in line with the desired state. This involves
 // updating the cluster where needed.
 func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope) (bool, error) {
+	// Return early if the control plane is externally managed.
+	// The topology controller does not create or update externally managed control planes.
+	if s.Blueprint.IsControlPlaneExternallyManaged() {
+		return false, nil
+	}
+
 	// If the ControlPlane has defined a current or desired MachineHealthCheck attempt to reconcile it.
 	// MHC changes are not Kubernetes version dependent, therefore proceed with MHC reconciliation
 	// even if the Control Plane is pending an upgrade.
@@ -452,7 +452,7 @@ func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, d
 		if _, err := helper.Patch(ctx); err != nil {
 			return pkgerrors.Wrapf(err, "failed to create MachineHealthCheck %s", klog.KObj(desired))
 		}
-		r.recorder.Eventf(desired, corev1.EventTypeNormal, createEventReason, "Created MachineHealthCheck %q", klog.KObj(desired))
+		r.recorder.Eventf(desired, corev1.EventTypeNormal, clusterv1.TopologyCreateEventReason, "Created MachineHealthCheck %q", klog.KObj(desired))
 		return nil
 	}
 
@@ -465,7 +465,7 @@ func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, d
 				return pkgerrors.Wrapf(err, "failed to delete MachineHealthCheck %s", klog.KObj(current))
 			}
 		}
-		r.recorder.Eventf(current, corev1.EventTypeNormal, deleteEventReason, "Deleted MachineHealthCheck %q", klog.KObj(current))
+		r.recorder.Eventf(current, corev1.EventTypeNormal, clusterv1.TopologyDeleteEventReason, "Deleted MachineHealthCheck %q", klog.KObj(current))
 		return nil
 	}
 
@@ -488,7 +488,7 @@ func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, d
 	if _, err := patchHelper.Patch(ctx); err != nil {
 		return pkgerrors.Wrapf(err, "failed to patch MachineHealthCheck %s", klog.KObj(current))
 	}
-	r.recorder.Eventf(current, corev1.EventTypeNormal, updateEventReason, "Updated MachineHealthCheck %q", klog.KObj(current))
+	r.recorder.Eventf(current, corev1.EventTypeNormal, clusterv1.TopologyUpdateEventReason, "Updated MachineHealthCheck %q", klog.KObj(current))
 	return nil
 }
 
@@ -521,7 +521,7 @@ func (r *Reconciler) reconcileCluster(ctx context.Context, s *scope.Scope) error
 	if err := r.Client.Patch(ctx, desiredCluster, client.RawPatch(types.MergePatchType, patchData)); err != nil {
 		return pkgerrors.Wrapf(err, "failed to patch Cluster %s", klog.KObj(s.Current.Cluster))
 	}
-	r.recorder.Eventf(s.Current.Cluster, corev1.EventTypeNormal, updateEventReason, "Updated Cluster %q", klog.KObj(s.Current.Cluster))
+	r.recorder.Eventf(s.Current.Cluster, corev1.EventTypeNormal, clusterv1.TopologyUpdateEventReason, "Updated Cluster %q", klog.KObj(s.Current.Cluster))
 
 	r.controller.DeferNextReconcileUntilCacheUpToDate(s.Current.Cluster, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "Cluster"), desiredCluster.ResourceVersion)
 	return nil
@@ -682,7 +682,7 @@ func (r *Reconciler) createMachineDeployment(ctx context.Context, s *scope.Scope
 		bootstrapCleanupFunc()
 		return createErrorWithoutObjectName(ctx, err, md.Object)
 	}
-	r.recorder.Eventf(cluster, corev1.EventTypeNormal, createEventReason, "Created MachineDeployment %q", klog.KObj(md.Object))
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, clusterv1.TopologyCreateEventReason, "Created MachineDeployment %q", klog.KObj(md.Object))
 
 	r.controller.DeferNextReconcileUntilCacheUpToDate(cluster, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "MachineDeployment"), modifiedResourceVersion)
 
@@ -800,7 +800,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 		bootstrapCleanupFunc()
 		return pkgerrors.Wrapf(err, "failed to patch MachineDeployment %s", klog.KObj(currentMD.Object))
 	}
-	r.recorder.Eventf(cluster, corev1.EventTypeNormal, updateEventReason, "Updated MachineDeployment %q%s", klog.KObj(currentMD.Object), logMachineDeploymentVersionChange(currentMD.Object, desiredMD.Object))
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, clusterv1.TopologyUpdateEventReason, "Updated MachineDeployment %q%s", klog.KObj(currentMD.Object), logMachineDeploymentVersionChange(currentMD.Object, desiredMD.Object))
 
 	r.controller.DeferNextReconcileUntilCacheUpToDate(cluster, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "MachineDeployment"), modifiedResourceVersion)
 
@@ -836,7 +836,7 @@ func (r *Reconciler) deleteMachineDeployment(ctx context.Context, cluster *clust
 		if err := r.Client.Delete(ctx, md.Object); err != nil && !apierrors.IsNotFound(err) {
 			return pkgerrors.Wrapf(err, "failed to delete MachineDeployment %s", klog.KObj(md.Object))
 		}
-		r.recorder.Eventf(cluster, corev1.EventTypeNormal, deleteEventReason, "Deleted MachineDeployment %q", klog.KObj(md.Object))
+		r.recorder.Eventf(cluster, corev1.EventTypeNormal, clusterv1.TopologyDeleteEventReason, "Deleted MachineDeployment %q", klog.KObj(md.Object))
 	}
 	return nil
 }
@@ -995,7 +995,7 @@ func (r *Reconciler) createMachinePool(ctx context.Context, s *scope.Scope, mp *
 		bootstrapCleanupFunc()
 		return createErrorWithoutObjectName(ctx, err, mp.Object)
 	}
-	r.recorder.Eventf(cluster, corev1.EventTypeNormal, createEventReason, "Created MachinePool %q", klog.KObj(mp.Object))
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, clusterv1.TopologyCreateEventReason, "Created MachinePool %q", klog.KObj(mp.Object))
 
 	r.controller.DeferNextReconcileUntilCacheUpToDate(cluster, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "MachinePool"), modifiedResourceVersion)
 
@@ -1054,7 +1054,7 @@ func (r *Reconciler) updateMachinePool(ctx context.Context, s *scope.Scope, mpTo
 	if err != nil {
 		return pkgerrors.Wrapf(err, "failed to patch MachinePool %s", klog.KObj(currentMP.Object))
 	}
-	r.recorder.Eventf(cluster, corev1.EventTypeNormal, updateEventReason, "Updated MachinePool %q%s", klog.KObj(currentMP.Object), logMachinePoolVersionChange(currentMP.Object, desiredMP.Object))
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, clusterv1.TopologyUpdateEventReason, "Updated MachinePool %q%s", klog.KObj(currentMP.Object), logMachinePoolVersionChange(currentMP.Object, desiredMP.Object))
 
 	r.controller.DeferNextReconcileUntilCacheUpToDate(cluster, capicontrollerutil.StructuredObject(clusterv1.GroupVersion, "MachinePool"), modifiedResourceVersion)
 
@@ -1083,7 +1083,7 @@ func (r *Reconciler) deleteMachinePool(ctx context.Context, cluster *clusterv1.C
 	if err := r.Client.Delete(ctx, mp.Object); err != nil && !apierrors.IsNotFound(err) {
 		return pkgerrors.Wrapf(err, "failed to delete MachinePool %s", klog.KObj(mp.Object))
 	}
-	r.recorder.Eventf(cluster, corev1.EventTypeNormal, deleteEventReason, "Deleted MachinePool %q", klog.KObj(mp.Object))
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, clusterv1.TopologyDeleteEventReason, "Deleted MachinePool %q", klog.KObj(mp.Object))
 	return nil
 }
 
@@ -1162,7 +1162,7 @@ func (r *Reconciler) reconcileReferencedObject(ctx context.Context, in reconcile
 		if _, err := helper.Patch(ctx); err != nil {
 			return false, createErrorWithoutObjectName(ctx, err, in.desired)
 		}
-		r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, createEventReason, "Created %s %q", in.desired.GetKind(), klog.KObj(in.desired))
+		r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, clusterv1.TopologyCreateEventReason, "Created %s %q", in.desired.GetKind(), klog.KObj(in.desired))
 		return true, nil
 	}
 
@@ -1194,7 +1194,7 @@ func (r *Reconciler) reconcileReferencedObject(ctx context.Context, in reconcile
 	if _, err := patchHelper.Patch(ctx); err != nil {
 		return false, pkgerrors.Wrapf(err, "failed to patch %s %s", in.current.GetKind(), klog.KObj(in.current))
 	}
-	r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, updateEventReason, "Updated %s %q%s", in.desired.GetKind(), klog.KObj(in.desired), logUnstructuredVersionChange(in.current, in.desired, in.versionGetter))
+	r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, clusterv1.TopologyUpdateEventReason, "Updated %s %q%s", in.desired.GetKind(), klog.KObj(in.desired), logUnstructuredVersionChange(in.current, in.desired, in.versionGetter))
 	return false, nil
 }
 
@@ -1249,7 +1249,7 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 		if _, err := helper.Patch(ctx); err != nil {
 			return false, createErrorWithoutObjectName(ctx, err, in.desired)
 		}
-		r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, createEventReason, "Created %s %q", in.desired.GetKind(), klog.KObj(in.desired))
+		r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, clusterv1.TopologyCreateEventReason, "Created %s %q", in.desired.GetKind(), klog.KObj(in.desired))
 		return true, nil
 	}
 
@@ -1290,7 +1290,7 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 		if _, err := patchHelper.Patch(ctx); err != nil {
 			return false, pkgerrors.Wrapf(err, "failed to patch %s %s", in.desired.GetKind(), klog.KObj(in.desired))
 		}
-		r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, updateEventReason, "Updated %s %q (metadata changes)", in.desired.GetKind(), klog.KObj(in.desired))
+		r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, clusterv1.TopologyUpdateEventReason, "Updated %s %q (metadata changes)", in.desired.GetKind(), klog.KObj(in.desired))
 		return false, nil
 	}
 
@@ -1316,7 +1316,7 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 	if _, err := helper.Patch(ctx); err != nil {
 		return false, createErrorWithoutObjectName(ctx, err, in.desired)
 	}
-	r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, createEventReason, "Created %s %q as a replacement for %q (template rotation)", in.desired.GetKind(), klog.KObj(in.desired), in.ref.Name)
+	r.recorder.Eventf(in.cluster, corev1.EventTypeNormal, clusterv1.TopologyCreateEventReason, "Created %s %q as a replacement for %q (template rotation)", in.desired.GetKind(), klog.KObj(in.desired), in.ref.Name)
 
 	// Update the reference with the new name.
 	// NOTE: Updating the object hosting reference to the template is executed outside this func.
@@ -17,7 +17,11 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 
@@ -25,22 +29,91 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/structuredmerge"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+	"sigs.k8s.io/cluster-api/util/ssa"
 )
 
-func (r *Reconciler) reconcileStatus(s *scope.Scope, cluster *clusterv1.Cluster, reconcileErr error) error {
+func (r *Reconciler) reconcileStatus(ctx context.Context, s *scope.Scope, cluster *clusterv1.Cluster, reconcileErr error) error {
 	r.reconcileUpgradePlan(s, cluster)
+	r.reconcileTopologyFieldsCoOwnedCondition(ctx, s, cluster)
 	return r.reconcileTopologyReconciledCondition(s, cluster, reconcileErr)
 }
 
+// reconcileTopologyFieldsCoOwnedCondition sets the TopologyFieldsCoOwned condition on the cluster and emits an event
+// on every object of the managed topology with fields managed by the topology controller that are also owned by
+// other field managers, e.g. because a user edited them with kubectl.
+// Note: The condition is preserved if the current state of the Cluster topology has not been read, e.g. when the Cluster is paused.
+func (r *Reconciler) reconcileTopologyFieldsCoOwnedCondition(ctx context.Context, s *scope.Scope, cluster *clusterv1.Cluster) {
+	if s.Current == nil || s.Current.ControlPlane == nil {
+		return
+	}
+	log := ctrl.LoggerFrom(ctx)
+
+	objs := []client.Object{s.Current.InfrastructureCluster, s.Current.ControlPlane.Object, s.Current.ControlPlane.MachineHealthCheck}
+	for _, name := range slices.Sorted(maps.Keys(s.Current.MachineDeployments)) {
+		objs = append(objs, s.Current.MachineDeployments[name].Object, s.Current.MachineDeployments[name].MachineHealthCheck)
+	}
+	for _, name := range slices.Sorted(maps.Keys(s.Current.MachinePools)) {
+		objs = append(objs, s.Current.MachinePools[name].Object)
+	}
+
+	messages := []string{}
+	for _, obj := range objs {
+		coOwned, err := ssa.DetectCoOwnedFields(obj, structuredmerge.TopologyManagerName)
+		if err != nil {
+			log.Error(err, "Failed to set TopologyFieldsCoOwned condition")
+			conditions.Set(cluster, metav1.Condition{
+				Type:    clusterv1.ClusterTopologyFieldsCoOwnedCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  clusterv1.ClusterTopologyFieldsCoOwnedInternalErrorReason,
+				Message: "Please check controller logs for errors",
+			})
+			return
+		}
+		if len(coOwned) == 0 {
+			continue
+		}
+		ssa.ReportCoOwnedFields(r.recorder, obj, structuredmerge.TopologyManagerName, coOwned)
+
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		if gvk, err := apiutil.GVKForObject(obj, r.Client.Scheme()); err == nil {
+			kind = gvk.Kind
+		}
+		messages = append(messages, fmt.Sprintf("* %s %s: %s", kind, obj.GetName(), ssa.CoOwnedFieldsMessage(coOwned)))
+	}
+
+	if len(messages) == 0 {
+		conditions.Set(cluster, metav1.Condition{
+			Type:   clusterv1.ClusterTopologyFieldsCoOwnedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: clusterv1.ClusterTopologyFieldsNotCoOwnedReason,
+		})
+		return
+	}
+
+	if len(messages) > 3 {
+		messages = append(messages[:3], fmt.Sprintf("And %d more objects with co-owned fields", len(messages)-3))
+	}
+	conditions.Set(cluster, metav1.Condition{
+		Type:    clusterv1.ClusterTopologyFieldsCoOwnedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  clusterv1.ClusterTopologyFieldsCoOwnedReason,
+		Message: strings.Join(messages, "\n"),
+	})
+}
+
 // reconcileUpgradePlan sets the upgradePlan for control plane and workers in Cluster.status.
 // Those fields are updated only if the upgradePlan has been successfully computed; if not, the current value is preserved.
 func (r *Reconciler) reconcileUpgradePlan(s *scope.Scope, cluster *clusterv1.Cluster) {
@@ -87,7 +160,11 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 	if ptr.Deref(cluster.Spec.Paused, false) || annotations.HasPaused(cluster) {
 		var messages []string
 		if ptr.Deref(cluster.Spec.Paused, false) {
-			messages = append(messages, "Cluster spec.paused is set to true")
+			message := "Cluster spec.paused is set to true"
+			if reason := cluster.Annotations[clusterv1.PausedReasonAnnotation]; reason != "" {
+				message = fmt.Sprintf("%s (reason: %s)", message, reason)
+			}
+			messages = append(messages, message)
 		}
 		if annotations.HasPaused(cluster) {
 			messages = append(messages, "Cluster has the cluster.x-k8s.io/paused annotation")
@@ -112,10 +189,18 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 	// If an error occurred during reconciliation set the TopologyReconciled condition to false.
 	// Add the error message from the reconcile function to the message of the condition.
 	if reconcileErr != nil {
+		v1Beta1Reason := clusterv1.TopologyReconcileFailedV1Beta1Reason
+		reason := clusterv1.ClusterTopologyReconciledFailedReason
+		// If the ClusterClass is not compatible with the current Cluster topology, surface it with a dedicated reason.
+		var notCompatibleErr *clusterClassNotCompatibleError
+		if errors.As(reconcileErr, &notCompatibleErr) {
+			v1Beta1Reason = clusterv1.TopologyReconciledClusterClassNotCompatibleV1Beta1Reason
+			reason = clusterv1.ClusterTopologyReconciledClusterClassNotCompatibleReason
+		}
 		v1beta1conditions.Set(cluster,
 			v1beta1conditions.FalseCondition(
 				clusterv1.TopologyReconciledV1Beta1Condition,
-				clusterv1.TopologyReconcileFailedV1Beta1Reason,
+				v1Beta1Reason,
 				clusterv1.ConditionSeverityError,
 				// TODO: Add a protection for messages continuously changing leading to Cluster object changes/reconcile.
 				"%s", reconcileErr.Error(),
@@ -124,7 +209,7 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 		conditions.Set(cluster, metav1.Condition{
 			Type:   clusterv1.ClusterTopologyReconciledCondition,
 			Status: metav1.ConditionFalse,
-			Reason: clusterv1.ClusterTopologyReconciledFailedReason,
+			Reason: reason,
 			// TODO: Add a protection for messages continuously changing leading to Cluster object changes/reconcile.
 			Message: reconcileErr.Error(),
 		})
@@ -243,14 +328,27 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 		}
 
 		// If control plane is upgrading surface it, otherwise surface the pending upgrade plan.
-		if s.UpgradeTracker.ControlPlane.IsStartingUpgrade || s.UpgradeTracker.ControlPlane.IsUpgrading {
+		// NOTE: If the control plane is externally managed, surface that the control plane has to be upgraded out-of-band.
+		if cluster.Spec.Topology.ControlPlane.IsExternallyManaged() {
+			if s.UpgradeTracker.ControlPlane.IsUpgrading {
+				fmt.Fprintf(msgBuilder, "\n  * %s (externally managed) upgrading to version %s", s.Current.ControlPlane.Object.GetKind(), *cpVersion)
+			} else if s.UpgradeTracker.ControlPlane.IsPendingUpgrade {
+				fmt.Fprintf(msgBuilder, "\n  * %s is externally managed, waiting for it to be upgraded to version %s", s.Current.ControlPlane.Object.GetKind(), cluster.Spec.Topology.Version)
+
+				// If nothing else is progressing, surface that the upgrade is waiting for the externally managed control plane.
+				if !s.UpgradeTracker.MachineDeployments.IsAnyUpgrading() && !s.UpgradeTracker.MachinePools.IsAnyUpgrading() {
+					reason = clusterv1.ClusterTopologyReconciledExternalControlPlaneUpgradePendingReason
+					v1Beta1Reason = clusterv1.TopologyReconciledExternalControlPlaneUpgradePendingV1Beta1Reason
+				}
+			}
+		} else if s.UpgradeTracker.ControlPlane.IsStartingUpgrade || s.UpgradeTracker.ControlPlane.IsUpgrading {
 			fmt.Fprintf(msgBuilder, "\n  * %s upgrading to version %s%s", s.Current.ControlPlane.Object.GetKind(), *cpVersion, pendingVersions(s.UpgradeTracker.ControlPlane.UpgradePlan, *cpVersion))
 		} else if len(s.UpgradeTracker.ControlPlane.UpgradePlan) > 0 {
 			fmt.Fprintf(msgBuilder, "\n  * %s pending upgrade to version %s", s.Current.ControlPlane.Object.GetKind(), strings.Join(s.UpgradeTracker.ControlPlane.UpgradePlan, ", "))
 		}
 
 		// If MachineDeployments are upgrading surface it, if MachineDeployments are pending upgrades then surface the upgrade plans.
-		upgradingMachineDeploymentNames, pendingMachineDeploymentNames, deferredMachineDeploymentNames := dedupNames(s.UpgradeTracker.MachineDeployments)
+		upgradingMachineDeploymentNames, pendingMachineDeploymentNames, waitingMachineDeploymentNames, deferredMachineDeploymentNames := dedupNames(s.UpgradeTracker.MachineDeployments)
 		if len(upgradingMachineDeploymentNames) > 0 {
 			fmt.Fprintf(msgBuilder, "\n  * %s upgrading to version %s%s", nameList("MachineDeployment", "MachineDeployments", upgradingMachineDeploymentNames), *cpVersion, pendingVersions(s.UpgradeTracker.MachineDeployments.UpgradePlan, *cpVersion))
 		}
@@ -259,6 +357,11 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			fmt.Fprintf(msgBuilder, "\n  * %s pending upgrade to version %s", nameList("MachineDeployment", "MachineDeployments", pendingMachineDeploymentNames), strings.Join(s.UpgradeTracker.MachineDeployments.UpgradePlan, ", "))
 		}
 
+		// If MachineDeployments are waiting for other MachineDeployments to complete the upgrade due to the maximum upgrade concurrency, surface it.
+		if len(waitingMachineDeploymentNames) > 0 && len(s.UpgradeTracker.MachineDeployments.UpgradePlan) > 0 {
+			fmt.Fprintf(msgBuilder, "\n  * %s waiting to upgrade to version %s, maximum upgrade concurrency of %d reached", nameList("MachineDeployment", "MachineDeployments", waitingMachineDeploymentNames), strings.Join(s.UpgradeTracker.MachineDeployments.UpgradePlan, ", "), s.UpgradeTracker.MachineDeployments.MaxUpgradeConcurrency())
+		}
+
 		// If MachineDeployments has been deferred or put on hold, surface it.
 		if len(deferredMachineDeploymentNames) > 0 {
 			fmt.Fprintf(msgBuilder, "\n  * %s upgrade to version %s deferred using defer-upgrade or hold-upgrade-sequence annotations", nameList("MachineDeployment", "MachineDeployments", deferredMachineDeploymentNames), *cpVersion)
@@ -266,7 +369,7 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			// Note: Hook blocking takes the precedence on this signal.
 			if !s.HookResponseTracker.IsAnyBlocking() &&
 				(!s.UpgradeTracker.ControlPlane.IsStartingUpgrade && !s.UpgradeTracker.ControlPlane.IsUpgrading) &&
-				!s.UpgradeTracker.MachineDeployments.IsAnyUpgrading() && len(pendingMachineDeploymentNames) == 0 {
+				!s.UpgradeTracker.MachineDeployments.IsAnyUpgrading() && len(pendingMachineDeploymentNames) == 0 && len(waitingMachineDeploymentNames) == 0 {
 				reason = clusterv1.ClusterTopologyReconciledMachineDeploymentsUpgradeDeferredReason
 				v1Beta1Reason = clusterv1.TopologyReconciledMachineDeploymentsUpgradeDeferredV1Beta1Reason
 			}
@@ -278,7 +381,7 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 		}
 
 		// If MachinePools are upgrading surface it, if MachinePools are pending upgrades then surface the upgrade plans.
-		upgradingMachinePoolNames, pendingMachinePoolNames, deferredMachinePoolNames := dedupNames(s.UpgradeTracker.MachinePools)
+		upgradingMachinePoolNames, pendingMachinePoolNames, waitingMachinePoolNames, deferredMachinePoolNames := dedupNames(s.UpgradeTracker.MachinePools)
 		if len(upgradingMachinePoolNames) > 0 {
 			fmt.Fprintf(msgBuilder, "\n  * %s upgrading to version %s%s", nameList("MachinePool", "MachinePools", upgradingMachinePoolNames), *cpVersion, pendingVersions(s.UpgradeTracker.MachinePools.UpgradePlan, *cpVersion))
 		}
@@ -287,6 +390,11 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			fmt.Fprintf(msgBuilder, "\n  * %s pending upgrade to version %s", nameList("MachinePool", "MachinePools", pendingMachinePoolNames), strings.Join(s.UpgradeTracker.MachinePools.UpgradePlan, ", "))
 		}
 
+		// If MachinePools are waiting for other MachinePools to complete the upgrade due to the maximum upgrade concurrency, surface it.
+		if len(waitingMachinePoolNames) > 0 && len(s.UpgradeTracker.MachinePools.UpgradePlan) > 0 {
+			fmt.Fprintf(msgBuilder, "\n  * %s waiting to upgrade to version %s, maximum upgrade concurrency of %d reached", nameList("MachinePool", "MachinePools", waitingMachinePoolNames), strings.Join(s.UpgradeTracker.MachinePools.UpgradePlan, ", "), s.UpgradeTracker.MachinePools.MaxUpgradeConcurrency())
+		}
+
 		// If MachinePools has been deferred or put on hold, surface it.
 		if len(deferredMachinePoolNames) > 0 {
 			fmt.Fprintf(msgBuilder, "\n  * %s upgrade to version %s deferred using topology.cluster.x-k8s.io/defer-upgrade or hold-upgrade-sequence annotations", nameList("MachinePool", "MachinePools", deferredMachinePoolNames), *cpVersion)
@@ -294,7 +402,7 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			// Note: Hook blocking takes the precedence on this signal.
 			if !s.HookResponseTracker.IsAnyBlocking() &&
 				(!s.UpgradeTracker.ControlPlane.IsStartingUpgrade && !s.UpgradeTracker.ControlPlane.IsUpgrading) &&
-				!s.UpgradeTracker.MachinePools.IsAnyUpgrading() && len(pendingMachinePoolNames) == 0 &&
+				!s.UpgradeTracker.MachinePools.IsAnyUpgrading() && len(pendingMachinePoolNames) == 0 && len(waitingMachinePoolNames) == 0 &&
 				reason != clusterv1.ClusterTopologyReconciledMachineDeploymentsUpgradeDeferredReason {
 				reason = clusterv1.ClusterTopologyReconciledMachinePoolsUpgradeDeferredReason
 				v1Beta1Reason = clusterv1.TopologyReconciledMachinePoolsUpgradeDeferredV1Beta1Reason
@@ -353,14 +461,17 @@ func pendingVersions(plan []string, version string) string {
 }
 
 // dedupNames take care of names that might exist in multiple lists.
-func dedupNames(t scope.WorkerUpgradeTracker) ([]string, []string, []string) {
+// It returns upgrading, pending, waiting for upgrade concurrency and deferred names.
+func dedupNames(t scope.WorkerUpgradeTracker) ([]string, []string, []string, []string) {
 	// upgrading names are preserved
 	upgradingSet := sets.Set[string]{}.Insert(t.UpgradingNames()...)
 	// upgrading names are removed from deferred names (give precedence to the fact that it is upgrading now)
 	deferredSet := sets.Set[string]{}.Insert(t.DeferredUpgradeNames()...).Difference(upgradingSet)
-	// upgrading and deferred names are removed from pending names (it is pending if not upgrading or deferred)
-	pendingSet := sets.Set[string]{}.Insert(t.PendingUpgradeNames()...).Difference(upgradingSet).Difference(deferredSet)
-	return upgradingSet.UnsortedList(), pendingSet.UnsortedList(), deferredSet.UnsortedList()
+	// upgrading and deferred names are removed from waiting for upgrade concurrency names
+	waitingSet := sets.Set[string]{}.Insert(t.WaitingForUpgradeConcurrencyNames()...).Difference(upgradingSet).Difference(deferredSet)
+	// upgrading, deferred and waiting names are removed from pending names (it is pending if not upgrading, deferred or waiting)
+	pendingSet := sets.Set[string]{}.Insert(t.PendingUpgradeNames()...).Difference(upgradingSet).Difference(deferredSet).Difference(waitingSet)
+	return upgradingSet.UnsortedList(), pendingSet.UnsortedList(), waitingSet.UnsortedList(), deferredSet.UnsortedList()
 }
 
 // computeNameList computes list of names from the given list to be shown in conditions.
@@ -25,6 +25,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +36,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/structuredmerge"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -77,6 +81,29 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 			wantConditionMessage:        "reconcile error",
 			wantErr:                     false,
 		},
+		{
+			name: "should set the condition to false if the ClusterClass is not compatible with the current Cluster topology",
+			reconcileErr: &clusterClassNotCompatibleError{
+				clusterClass: types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "class2"},
+				errs: field.ErrorList{
+					field.Forbidden(field.NewPath("metadata", "kind"), "apiVersion.kind of Foo.infrastructure.cluster.x-k8s.io/infra1 cannot be changed from \"Foo\" to \"Bar\""),
+				},
+			},
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{},
+				},
+			},
+			wantV1Beta1ConditionStatus: corev1.ConditionFalse,
+			wantV1Beta1ConditionReason: clusterv1.TopologyReconciledClusterClassNotCompatibleV1Beta1Reason,
+			wantV1Beta1ConditionMessage: "ClusterClass default/class2 is not compatible with the current Cluster topology:\n" +
+				"  * apiVersion.kind of Foo.infrastructure.cluster.x-k8s.io/infra1 cannot be changed from \"Foo\" to \"Bar\"",
+			wantConditionStatus: metav1.ConditionFalse,
+			wantConditionReason: clusterv1.ClusterTopologyReconciledClusterClassNotCompatibleReason,
+			wantConditionMessage: "ClusterClass default/class2 is not compatible with the current Cluster topology:\n" +
+				"  * apiVersion.kind of Foo.infrastructure.cluster.x-k8s.io/infra1 cannot be changed from \"Foo\" to \"Bar\"",
+			wantErr: false,
+		},
 
 		// Paused
 
@@ -868,6 +895,90 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 				"  * MachineDeployment md1 upgrading to version v1.22.0\n" +
 				"  * MachineDeployments md2, md3, md4 pending upgrade to version v1.22.0",
 		},
+		{
+			name:         "should set the condition to false if an externally managed control plane is pending upgrade",
+			reconcileErr: nil,
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{
+						Spec: clusterv1.ClusterSpec{
+							ControlPlaneRef:   clusterv1.ContractVersionedObjectReference{Name: "controlplane1"},
+							InfrastructureRef: clusterv1.ContractVersionedObjectReference{Name: "infra1"},
+							Topology: clusterv1.Topology{
+								Version: "v1.22.0",
+								ControlPlane: clusterv1.ControlPlaneTopology{
+									ExternallyManaged: ptr.To(true),
+								},
+							},
+						},
+					},
+					ControlPlane: &scope.ControlPlaneState{
+						Object: builder.ControlPlane("ns1", "controlplane1").WithVersion("v1.21.2").Build(),
+					},
+				},
+				UpgradeTracker: func() *scope.UpgradeTracker {
+					ut := scope.NewUpgradeTracker()
+					ut.ControlPlane.IsPendingUpgrade = true
+					ut.ControlPlane.UpgradePlan = []string{"v1.22.0"}
+					ut.MachineDeployments.UpgradePlan = []string{"v1.22.0"}
+					ut.MachineDeployments.MarkPendingUpgrade("md1")
+					return ut
+				}(),
+				HookResponseTracker: scope.NewHookResponseTracker(),
+			},
+			wantV1Beta1ConditionStatus: corev1.ConditionFalse,
+			wantV1Beta1ConditionReason: clusterv1.TopologyReconciledExternalControlPlaneUpgradePendingV1Beta1Reason,
+			wantV1Beta1ConditionMessage: "Cluster is upgrading to v1.22.0\n" +
+				"  * GenericControlPlane is externally managed, waiting for it to be upgraded to version v1.22.0\n" +
+				"  * MachineDeployment md1 pending upgrade to version v1.22.0",
+			wantConditionStatus: metav1.ConditionFalse,
+			wantConditionReason: clusterv1.ClusterTopologyReconciledExternalControlPlaneUpgradePendingReason,
+			wantConditionMessage: "Cluster is upgrading to v1.22.0\n" +
+				"  * GenericControlPlane is externally managed, waiting for it to be upgraded to version v1.22.0\n" +
+				"  * MachineDeployment md1 pending upgrade to version v1.22.0",
+		},
+		{
+			name:         "should set the condition to false if MachineDeployments are waiting for upgrade concurrency (second upgrade step)",
+			reconcileErr: nil,
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{
+						Spec: clusterv1.ClusterSpec{
+							ControlPlaneRef:   clusterv1.ContractVersionedObjectReference{Name: "controlplane1"},
+							InfrastructureRef: clusterv1.ContractVersionedObjectReference{Name: "infra1"},
+							Topology: clusterv1.Topology{
+								Version: "v1.22.0",
+							},
+						},
+					},
+					ControlPlane: &scope.ControlPlaneState{
+						Object: builder.ControlPlane("ns1", "controlplane1").WithVersion("v1.22.0").Build(),
+					},
+				},
+				UpgradeTracker: func() *scope.UpgradeTracker {
+					ut := scope.NewUpgradeTracker(scope.MaxMDUpgradeConcurrency(2))
+					ut.ControlPlane.UpgradePlan = []string{}
+					ut.MachineDeployments.UpgradePlan = []string{"v1.22.0"}
+					ut.MachineDeployments.MarkUpgrading("md1", "md2")
+					ut.MachineDeployments.MarkPendingUpgrade("md3")
+					ut.MachineDeployments.MarkWaitingForUpgradeConcurrency("md3")
+					ut.MachineDeployments.MarkPendingUpgrade("md4")
+					ut.MachineDeployments.MarkWaitingForUpgradeConcurrency("md4")
+					return ut
+				}(),
+				HookResponseTracker: scope.NewHookResponseTracker(),
+			},
+			wantV1Beta1ConditionStatus: corev1.ConditionFalse,
+			wantV1Beta1ConditionReason: clusterv1.TopologyReconciledClusterUpgradingV1Beta1Reason,
+			wantV1Beta1ConditionMessage: "Cluster is upgrading to v1.22.0\n" +
+				"  * MachineDeployments md1, md2 upgrading to version v1.22.0\n" +
+				"  * MachineDeployments md3, md4 waiting to upgrade to version v1.22.0, maximum upgrade concurrency of 2 reached",
+			wantConditionStatus: metav1.ConditionFalse,
+			wantConditionReason: clusterv1.ClusterTopologyReconciledClusterUpgradingReason,
+			wantConditionMessage: "Cluster is upgrading to v1.22.0\n" +
+				"  * MachineDeployments md1, md2 upgrading to version v1.22.0\n" +
+				"  * MachineDeployments md3, md4 waiting to upgrade to version v1.22.0, maximum upgrade concurrency of 2 reached",
+		},
 		{
 			name:         "should set the condition to false if MachineDeployments are upgraded and AfterWorkersUpgrade hook is blocking (second upgrade step)",
 			reconcileErr: nil,
@@ -1214,6 +1325,114 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 	}
 }
 
+func TestReconcileTopologyFieldsCoOwnedCondition(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	managedFields := func(otherManager string) []metav1.ManagedFieldsEntry {
+		entries := []metav1.ManagedFieldsEntry{
+			{
+				Manager:    structuredmerge.TopologyManagerName,
+				Operation:  metav1.ManagedFieldsOperationApply,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:paused":{}}}`)},
+			},
+		}
+		if otherManager != "" {
+			entries = append(entries, metav1.ManagedFieldsEntry{
+				Manager:    otherManager,
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+			})
+		}
+		return entries
+	}
+	machineDeployment := func(name, otherManager string) *scope.MachineDeploymentState {
+		md := builder.MachineDeployment(metav1.NamespaceDefault, name).Build()
+		md.SetManagedFields(managedFields(otherManager))
+		return &scope.MachineDeploymentState{Object: md}
+	}
+
+	tests := []struct {
+		name          string
+		s             *scope.Scope
+		wantCondition *metav1.Condition
+		wantEvents    int
+	}{
+		{
+			name: "Condition is not set if the current state has not been read",
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster: builder.Cluster(metav1.NamespaceDefault, "cluster1").Build(),
+				},
+			},
+			wantCondition: nil,
+		},
+		{
+			name: "Condition is false if there are no co-owned fields",
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster:      builder.Cluster(metav1.NamespaceDefault, "cluster1").Build(),
+					ControlPlane: &scope.ControlPlaneState{},
+					MachineDeployments: scope.MachineDeploymentsStateMap{
+						"md1": machineDeployment("md1", ""),
+					},
+				},
+			},
+			wantCondition: &metav1.Condition{
+				Type:   clusterv1.ClusterTopologyFieldsCoOwnedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: clusterv1.ClusterTopologyFieldsNotCoOwnedReason,
+			},
+		},
+		{
+			name: "Condition is true if there are co-owned fields",
+			s: &scope.Scope{
+				Current: &scope.ClusterState{
+					Cluster:      builder.Cluster(metav1.NamespaceDefault, "cluster1").Build(),
+					ControlPlane: &scope.ControlPlaneState{},
+					MachineDeployments: scope.MachineDeploymentsStateMap{
+						"md1": machineDeployment("md1", "kubectl-edit"),
+						"md2": machineDeployment("md2", ""),
+						"md3": machineDeployment("md3", "kubectl-edit"),
+					},
+				},
+			},
+			wantCondition: &metav1.Condition{
+				Type:   clusterv1.ClusterTopologyFieldsCoOwnedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.ClusterTopologyFieldsCoOwnedReason,
+				Message: "* MachineDeployment md1: fields also owned by \"kubectl-edit\" (Update): .spec.replicas\n" +
+					"* MachineDeployment md3: fields also owned by \"kubectl-edit\" (Update): .spec.replicas",
+			},
+			wantEvents: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).Build(),
+				recorder: recorder,
+			}
+			r.reconcileTopologyFieldsCoOwnedCondition(ctx, tt.s, tt.s.Current.Cluster)
+
+			condition := conditions.Get(tt.s.Current.Cluster, clusterv1.ClusterTopologyFieldsCoOwnedCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(*condition).To(conditions.MatchCondition(*tt.wantCondition, conditions.IgnoreLastTransitionTime(true)))
+			}
+			g.Expect(recorder.Events).To(HaveLen(tt.wantEvents))
+		})
+	}
+}
+
 func TestComputeNameList(t *testing.T) {
 	tests := []struct {
 		name     string
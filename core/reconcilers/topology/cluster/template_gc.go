@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+)
+
+// reconcileOrphanedTemplates garbage collects the templates owned by the Cluster topology which are not
+// referenced anymore, e.g. templates leaked by a template rotation whose cleanup failed.
+// A template is first marked as orphaned, and it is deleted only after OrphanedTemplateGracePeriod has
+// elapsed; if the template is referenced again before, the mark is removed.
+// In dry-run mode orphaned templates are marked and reported, but never deleted.
+// The returned duration is the time after which the next orphaned template reaches the end of its grace period.
+func (r *Reconciler) reconcileOrphanedTemplates(ctx context.Context, s *scope.Scope) (time.Duration, error) {
+	if r.OrphanedTemplateGracePeriod <= 0 {
+		return 0, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	cluster := s.Current.Cluster
+
+	templatesInUse, err := r.getTemplatesInUse(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	orphaned := 0
+	var requeueAfter time.Duration
+	for _, gvk := range templateGVKs(s) {
+		templateList := &unstructured.UnstructuredList{}
+		templateList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.Client.List(ctx, templateList,
+			client.InNamespace(cluster.Namespace),
+			client.MatchingLabels{
+				clusterv1.ClusterNameLabel:          cluster.Name,
+				clusterv1.ClusterTopologyOwnedLabel: "",
+			},
+		); err != nil {
+			return 0, pkgerrors.Wrapf(err, "failed to list %s", gvk.Kind)
+		}
+
+		for i := range templateList.Items {
+			template := &templateList.Items[i]
+			if !template.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			templateLog := log.WithValues(template.GetKind(), klog.KObj(template))
+
+			orphanedSince, marked := template.GetAnnotations()[clusterv1.ClusterTopologyOrphanedSinceAnnotation]
+
+			// If the template is referenced, drop the orphaned mark, if any.
+			if templatesInUse[templateID(template.GroupVersionKind().GroupKind(), template.GetName())] {
+				if marked {
+					templateLog.Info(fmt.Sprintf("%s is referenced again, removing the orphaned mark", template.GetKind()))
+					if err := r.setOrphanedSince(ctx, template, ""); err != nil {
+						return 0, err
+					}
+				}
+				continue
+			}
+
+			orphaned++
+
+			// If the template has been just detected as orphaned, mark it and wait for the grace period.
+			since, err := time.Parse(time.RFC3339, orphanedSince)
+			if !marked || err != nil {
+				templateLog.Info(fmt.Sprintf("%s is not referenced anymore, marking it as orphaned", template.GetKind()))
+				if err := r.setOrphanedSince(ctx, template, now.UTC().Format(time.RFC3339)); err != nil {
+					return 0, err
+				}
+				requeueAfter = lowestNonZeroDuration(requeueAfter, r.OrphanedTemplateGracePeriod)
+				continue
+			}
+
+			// If the grace period is not yet elapsed, wait.
+			if remaining := since.Add(r.OrphanedTemplateGracePeriod).Sub(now); remaining > 0 {
+				requeueAfter = lowestNonZeroDuration(requeueAfter, remaining)
+				continue
+			}
+
+			if r.OrphanedTemplateDryRun {
+				templateLog.Info(fmt.Sprintf("Dry-run: %s orphaned since %s would be deleted", template.GetKind(), orphanedSince))
+				continue
+			}
+
+			templateLog.Info(fmt.Sprintf("Deleting %s orphaned since %s", template.GetKind(), orphanedSince))
+			if err := r.Client.Delete(ctx, template); err != nil && !apierrors.IsNotFound(err) {
+				return 0, pkgerrors.Wrapf(err, "failed to delete orphaned %s %s", template.GetKind(), klog.KObj(template))
+			}
+			orphanedTemplatesDeletedTotal.WithLabelValues(cluster.Name, cluster.Namespace).Inc()
+			orphaned--
+		}
+	}
+
+	setOrphanedTemplatesMetric(cluster.Namespace, cluster.Name, orphaned)
+	return requeueAfter, nil
+}
+
+// getTemplatesInUse returns the templates referenced by the current and the desired state of the Cluster topology,
+// as well as the templates referenced by any MachineDeployment or MachineSet of the Cluster.
+// NOTE: Templates referenced by MachineSets are still in use, e.g. during a rollout.
+func (r *Reconciler) getTemplatesInUse(ctx context.Context, s *scope.Scope) (map[string]bool, error) {
+	cluster := s.Current.Cluster
+	templatesInUse := map[string]bool{}
+
+	addTemplate := func(template *unstructured.Unstructured) {
+		if template != nil {
+			templatesInUse[templateID(template.GroupVersionKind().GroupKind(), template.GetName())] = true
+		}
+	}
+	addRef := func(ref clusterv1.ContractVersionedObjectReference) {
+		if ref.IsDefined() {
+			templatesInUse[templateID(ref.GroupKind(), ref.Name)] = true
+		}
+	}
+
+	for _, state := range []*scope.ClusterState{s.Current, s.Desired} {
+		if state == nil {
+			continue
+		}
+		if state.ControlPlane != nil {
+			addTemplate(state.ControlPlane.InfrastructureMachineTemplate)
+		}
+		for _, md := range state.MachineDeployments {
+			addTemplate(md.BootstrapTemplate)
+			addTemplate(md.InfrastructureMachineTemplate)
+		}
+	}
+
+	mdList := &clusterv1.MachineDeploymentList{}
+	if err := r.Client.List(ctx, mdList,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list MachineDeployments")
+	}
+	for _, md := range mdList.Items {
+		addRef(md.Spec.Template.Spec.Bootstrap.ConfigRef)
+		addRef(md.Spec.Template.Spec.InfrastructureRef)
+	}
+
+	// NOTE: MachineSets are read from the cache to avoid hitting the API server on every reconcile; a template
+	// considered as orphaned because of an outdated cache is only marked, and it is checked again before deletion.
+	msList := &clusterv1.MachineSetList{}
+	if err := r.Client.List(ctx, msList,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to list MachineSets")
+	}
+	for _, ms := range msList.Items {
+		addRef(ms.Spec.Template.Spec.Bootstrap.ConfigRef)
+		addRef(ms.Spec.Template.Spec.InfrastructureRef)
+	}
+
+	return templatesInUse, nil
+}
+
+// setOrphanedSince sets the orphaned since annotation on a template; if value is empty the annotation is removed.
+func (r *Reconciler) setOrphanedSince(ctx context.Context, template *unstructured.Unstructured, value string) error {
+	original := template.DeepCopy()
+	annotations := template.GetAnnotations()
+	if value == "" {
+		delete(annotations, clusterv1.ClusterTopologyOrphanedSinceAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[clusterv1.ClusterTopologyOrphanedSinceAnnotation] = value
+	}
+	template.SetAnnotations(annotations)
+	if err := r.Client.Patch(ctx, template, client.MergeFrom(original)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to patch %s %s", template.GetKind(), klog.KObj(template))
+	}
+	return nil
+}
+
+// templateGVKs returns the GroupVersionKinds of the templates generated by the Cluster topology, both for the
+// templates of the current ClusterClass and for the templates in the current state, e.g. before a ClusterClass rebase.
+func templateGVKs(s *scope.Scope) []schema.GroupVersionKind {
+	gvks := map[schema.GroupKind]schema.GroupVersionKind{}
+	add := func(template *unstructured.Unstructured) {
+		if template != nil {
+			gvks[template.GroupVersionKind().GroupKind()] = template.GroupVersionKind()
+		}
+	}
+
+	if s.Current != nil {
+		if s.Current.ControlPlane != nil {
+			add(s.Current.ControlPlane.InfrastructureMachineTemplate)
+		}
+		for _, md := range s.Current.MachineDeployments {
+			add(md.BootstrapTemplate)
+			add(md.InfrastructureMachineTemplate)
+		}
+	}
+	if s.Blueprint.ControlPlane != nil {
+		add(s.Blueprint.ControlPlane.InfrastructureMachineTemplate)
+	}
+	for _, md := range s.Blueprint.MachineDeployments {
+		add(md.BootstrapTemplate)
+		add(md.InfrastructureMachineTemplate)
+	}
+
+	res := make([]schema.GroupVersionKind, 0, len(gvks))
+	for _, gvk := range gvks {
+		res = append(res, gvk)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GroupKind().String() < res[j].GroupKind().String()
+	})
+	return res
+}
+
+// templateID returns the ID of a template in the format: group.kind/name.
+// NOTE: The version is not included as references with different versions should be treated as equal.
+func templateID(gk schema.GroupKind, name string) string {
+	return fmt.Sprintf("%s/%s", gk, name)
+}
+
+func lowestNonZeroDuration(i, j time.Duration) time.Duration {
+	if i == 0 || (j != 0 && j < i) {
+		return j
+	}
+	return i
+}
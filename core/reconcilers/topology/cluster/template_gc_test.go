@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestReconcileOrphanedTemplates(t *testing.T) {
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").Build()
+
+	classInfrastructureMachineTemplate := builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "class-infra").Build()
+	classBootstrapTemplate := builder.BootstrapTemplate(metav1.NamespaceDefault, "class-bootstrap").Build()
+
+	// topologyOwned returns a template owned by the topology of a Cluster, optionally marked as orphaned.
+	topologyOwned := func(template *unstructured.Unstructured, clusterName string, orphanedSince *time.Time) *unstructured.Unstructured {
+		template.SetLabels(map[string]string{
+			clusterv1.ClusterNameLabel:          clusterName,
+			clusterv1.ClusterTopologyOwnedLabel: "",
+		})
+		if orphanedSince != nil {
+			template.SetAnnotations(map[string]string{
+				clusterv1.ClusterTopologyOrphanedSinceAnnotation: orphanedSince.UTC().Format(time.RFC3339),
+			})
+		}
+		return template
+	}
+
+	now := time.Now()
+	tenMinutesAgo := now.Add(-10 * time.Minute)
+	twoHoursAgo := now.Add(-2 * time.Hour)
+
+	mdInfrastructureMachineTemplate := topologyOwned(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "md1-infra").Build(), cluster.Name, nil)
+	mdBootstrapTemplate := topologyOwned(builder.BootstrapTemplate(metav1.NamespaceDefault, "md1-bootstrap").Build(), cluster.Name, nil)
+	msInfrastructureMachineTemplate := topologyOwned(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "ms1-infra").Build(), cluster.Name, &twoHoursAgo)
+	newlyOrphanedTemplate := topologyOwned(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "newly-orphaned").Build(), cluster.Name, nil)
+	pendingOrphanedTemplate := topologyOwned(builder.BootstrapTemplate(metav1.NamespaceDefault, "pending-orphaned").Build(), cluster.Name, &tenMinutesAgo)
+	expiredOrphanedTemplate := topologyOwned(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "expired-orphaned").Build(), cluster.Name, &twoHoursAgo)
+	otherClusterTemplate := topologyOwned(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "other-cluster").Build(), "cluster2", &twoHoursAgo)
+	notTopologyOwnedTemplate := builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "not-topology-owned").Build()
+
+	md := builder.MachineDeployment(metav1.NamespaceDefault, "md1").
+		WithLabels(map[string]string{clusterv1.ClusterNameLabel: cluster.Name}).
+		WithInfrastructureTemplate(mdInfrastructureMachineTemplate).
+		WithBootstrapTemplate(mdBootstrapTemplate).
+		Build()
+	ms := builder.MachineSet(metav1.NamespaceDefault, "ms1").
+		WithLabels(map[string]string{clusterv1.ClusterNameLabel: cluster.Name}).
+		WithInfrastructureTemplate(msInfrastructureMachineTemplate).
+		WithBootstrapTemplate(mdBootstrapTemplate).
+		Build()
+
+	tests := []struct {
+		name             string
+		dryRun           bool
+		wantRequeueAfter time.Duration
+		wantDeleted      []*unstructured.Unstructured
+		wantOrphaned     []*unstructured.Unstructured
+		wantNotOrphaned  []*unstructured.Unstructured
+	}{
+		{
+			name:             "Marks and deletes orphaned templates",
+			dryRun:           false,
+			wantRequeueAfter: 50 * time.Minute,
+			wantDeleted:      []*unstructured.Unstructured{expiredOrphanedTemplate},
+			wantOrphaned:     []*unstructured.Unstructured{newlyOrphanedTemplate, pendingOrphanedTemplate, otherClusterTemplate},
+			wantNotOrphaned:  []*unstructured.Unstructured{mdInfrastructureMachineTemplate, mdBootstrapTemplate, msInfrastructureMachineTemplate, notTopologyOwnedTemplate},
+		},
+		{
+			name:             "Marks but does not delete orphaned templates in dry-run mode",
+			dryRun:           true,
+			wantRequeueAfter: 50 * time.Minute,
+			wantOrphaned:     []*unstructured.Unstructured{newlyOrphanedTemplate, pendingOrphanedTemplate, expiredOrphanedTemplate, otherClusterTemplate},
+			wantNotOrphaned:  []*unstructured.Unstructured{mdInfrastructureMachineTemplate, mdBootstrapTemplate, msInfrastructureMachineTemplate, notTopologyOwnedTemplate},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(fakeScheme).
+				WithObjects(
+					cluster.DeepCopy(),
+					md.DeepCopy(),
+					ms.DeepCopy(),
+					mdInfrastructureMachineTemplate.DeepCopy(),
+					mdBootstrapTemplate.DeepCopy(),
+					msInfrastructureMachineTemplate.DeepCopy(),
+					newlyOrphanedTemplate.DeepCopy(),
+					pendingOrphanedTemplate.DeepCopy(),
+					expiredOrphanedTemplate.DeepCopy(),
+					otherClusterTemplate.DeepCopy(),
+					notTopologyOwnedTemplate.DeepCopy(),
+				).
+				Build()
+
+			s := scope.New(cluster)
+			s.Blueprint = &scope.ClusterBlueprint{
+				MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{
+					"class1": {
+						InfrastructureMachineTemplate: classInfrastructureMachineTemplate,
+						BootstrapTemplate:             classBootstrapTemplate,
+					},
+				},
+			}
+
+			r := &Reconciler{
+				Client:                      fakeClient,
+				APIReader:                   fakeClient,
+				OrphanedTemplateGracePeriod: time.Hour,
+				OrphanedTemplateDryRun:      tt.dryRun,
+			}
+			requeueAfter, err := r.reconcileOrphanedTemplates(t.Context(), s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(requeueAfter).To(BeNumerically("~", tt.wantRequeueAfter, time.Minute))
+
+			for _, template := range tt.wantDeleted {
+				got := template.DeepCopy()
+				err := fakeClient.Get(t.Context(), client.ObjectKeyFromObject(template), got)
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "%s should have been deleted", template.GetName())
+			}
+			for _, template := range tt.wantOrphaned {
+				got := template.DeepCopy()
+				g.Expect(fakeClient.Get(t.Context(), client.ObjectKeyFromObject(template), got)).To(Succeed())
+				g.Expect(got.GetAnnotations()).To(HaveKey(clusterv1.ClusterTopologyOrphanedSinceAnnotation), "%s should be marked as orphaned", template.GetName())
+			}
+			for _, template := range tt.wantNotOrphaned {
+				got := template.DeepCopy()
+				g.Expect(fakeClient.Get(t.Context(), client.ObjectKeyFromObject(template), got)).To(Succeed())
+				g.Expect(got.GetAnnotations()).ToNot(HaveKey(clusterv1.ClusterTopologyOrphanedSinceAnnotation), "%s should not be marked as orphaned", template.GetName())
+			}
+		})
+	}
+}
+
+func TestReconcileOrphanedTemplatesDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").Build()
+	template := builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "orphaned").Build()
+	template.SetLabels(map[string]string{
+		clusterv1.ClusterNameLabel:          cluster.Name,
+		clusterv1.ClusterTopologyOwnedLabel: "",
+	})
+
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(cluster, template).Build()
+
+	s := scope.New(cluster)
+	s.Blueprint = &scope.ClusterBlueprint{
+		MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{
+			"class1": {
+				InfrastructureMachineTemplate: builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "class-infra").Build(),
+			},
+		},
+	}
+
+	r := &Reconciler{
+		Client:    fakeClient,
+		APIReader: fakeClient,
+	}
+	requeueAfter, err := r.reconcileOrphanedTemplates(t.Context(), s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(requeueAfter).To(BeZero())
+
+	got := template.DeepCopy()
+	g.Expect(fakeClient.Get(t.Context(), client.ObjectKeyFromObject(template), got)).To(Succeed())
+	g.Expect(got.GetAnnotations()).ToNot(HaveKey(clusterv1.ClusterTopologyOrphanedSinceAnnotation))
+}
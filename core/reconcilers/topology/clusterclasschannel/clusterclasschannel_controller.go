@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclasschannel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/index"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconciler progressively updates Clusters following a release channel, i.e. Clusters with
+// spec.topology.classRef.channel set, to the newest ClusterClass release published to the channel.
+type Reconciler struct {
+	Client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	recorder record.EventRecorder
+}
+
+// release is a ClusterClass with a release, with the parsed version of the release.
+type release struct {
+	clusterClass *clusterv1.ClusterClass
+	version      semver.Version
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil {
+		return pkgerrors.New("Client must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "topology/clusterclasschannel")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&clusterv1.ClusterClass{}).
+		Named("topology/clusterclasschannel").
+		WithOptions(options).
+		WithSharder(r.Sharder).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(clusterToClusterClass),
+			predicates.ClusterHasTopology(mgr.GetScheme(), predicateLog),
+		).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, r)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
+	r.recorder = mgr.GetEventRecorderFor("topology/clusterclasschannel-controller")
+	return nil
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	clusterClass := &clusterv1.ClusterClass{}
+	if err := r.Client.Get(ctx, req.NamespacedName, clusterClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Return early if the ClusterClass is not a release or if it is deleted.
+	if clusterClass.Spec.Release.Family == "" || !clusterClass.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	releases, err := r.getReleases(ctx, clusterClass.Namespace, clusterClass.Spec.Release.Family)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile all the channels of the family, because Clusters using this ClusterClass can
+	// follow a channel this release is not published to.
+	channels := sets.Set[string]{}
+	for _, rel := range releases {
+		channels.Insert(rel.clusterClass.Spec.Release.Channels...)
+	}
+
+	result := ctrl.Result{}
+	errs := []error{}
+	for _, channel := range sets.List(channels) {
+		res, err := r.reconcileChannel(ctx, releases, channel)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = util.LowestNonZeroResult(result, res)
+	}
+	return result, kerrors.NewAggregate(errs)
+}
+
+// reconcileChannel updates the Clusters following a channel to the newest release published to the channel,
+// while respecting the maxConcurrency and soakTimeSeconds of the release.
+func (r *Reconciler) reconcileChannel(ctx context.Context, releases []release, channel string) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("channel", channel)
+
+	var target *release
+	for i := range releases {
+		if slices.Contains(releases[i].clusterClass.Spec.Release.Channels, channel) {
+			target = &releases[i]
+		}
+	}
+	if target == nil {
+		return ctrl.Result{}, nil
+	}
+
+	rollout := target.clusterClass.Spec.Release.Rollout
+	soakTime := time.Duration(ptr.Deref(rollout.SoakTimeSeconds, 0)) * time.Second
+	now := time.Now()
+
+	updating := 0
+	requeueAfter := time.Duration(0)
+	var candidates []*clusterv1.Cluster
+	for _, rel := range releases {
+		// Note: Clusters on a newer release than the target, e.g. because they have been updated
+		// manually, are left alone.
+		if rel.version.GT(target.version) {
+			continue
+		}
+
+		clusters := &clusterv1.ClusterList{}
+		if err := r.Client.List(ctx, clusters, client.MatchingFields{
+			index.ClusterClassRefPath: index.ClusterClassRef(rel.clusterClass),
+		}); err != nil {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to list Clusters using ClusterClass %s", rel.clusterClass.Name)
+		}
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if cluster.Spec.Topology.ClassRef.Channel != channel || !cluster.DeletionTimestamp.IsZero() {
+				continue
+			}
+
+			if rel.clusterClass != target.clusterClass {
+				candidates = append(candidates, cluster)
+				continue
+			}
+
+			if isUpdating, remaining := isUpdating(cluster, soakTime, now); isUpdating {
+				updating++
+				if remaining > 0 && (requeueAfter == 0 || remaining < requeueAfter) {
+					requeueAfter = remaining
+				}
+			}
+		}
+	}
+
+	// Update Clusters in a deterministic order, so the same Clusters are picked across reconciles.
+	slices.SortFunc(candidates, func(a, b *clusterv1.Cluster) int {
+		return strings.Compare(klog.KObj(a).String(), klog.KObj(b).String())
+	})
+
+	maxConcurrency := int(ptr.Deref(rollout.MaxConcurrency, 1))
+	errs := []error{}
+	for _, cluster := range candidates {
+		if updating >= maxConcurrency {
+			log.V(4).Info(fmt.Sprintf("Waiting for %d Clusters being updated to ClusterClass %s", updating, target.clusterClass.Name))
+			break
+		}
+		// Note: Paused Clusters are not updated, so they don't count against maxConcurrency.
+		if annotations.IsPaused(cluster, cluster) {
+			continue
+		}
+
+		if err := r.updateCluster(ctx, cluster, target.clusterClass, now); err != nil {
+			r.recorder.Eventf(cluster, corev1.EventTypeWarning, "ClusterClassChannelUpdateFailed", "Failed to update Cluster to ClusterClass %s, release %s of channel %s: %v",
+				target.clusterClass.Name, target.clusterClass.Spec.Release.Version, channel, err)
+			errs = append(errs, err)
+			continue
+		}
+		r.recorder.Eventf(cluster, corev1.EventTypeNormal, "ClusterClassChannelUpdated", "Updated Cluster to ClusterClass %s, release %s of channel %s",
+			target.clusterClass.Name, target.clusterClass.Spec.Release.Version, channel)
+		log.Info(fmt.Sprintf("Updated Cluster to ClusterClass %s", target.clusterClass.Name), "Cluster", klog.KObj(cluster))
+		updating++
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// updateCluster sets the classRef of a Cluster to a ClusterClass release.
+// NOTE: The Cluster webhook checks that the Cluster can be rebased to the ClusterClass and migrates variables.
+func (r *Reconciler) updateCluster(ctx context.Context, cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass, now time.Time) error {
+	original := cluster.DeepCopy()
+	// Note: All the releases of a family are in the same namespace, so classRef.namespace doesn't change.
+	cluster.Spec.Topology.ClassRef.Name = clusterClass.Name
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[clusterv1.ClusterClassChannelUpdatedAtAnnotation] = now.UTC().Format(time.RFC3339)
+
+	if err := r.Client.Patch(ctx, cluster, client.MergeFrom(original)); err != nil {
+		return pkgerrors.Wrapf(err, "failed to patch Cluster %s", klog.KObj(cluster))
+	}
+	return nil
+}
+
+// getReleases returns the ClusterClasses of a release family in a namespace, ordered by version.
+// ClusterClasses with an invalid version are ignored.
+func (r *Reconciler) getReleases(ctx context.Context, namespace, family string) ([]release, error) {
+	clusterClasses := &clusterv1.ClusterClassList{}
+	if err := r.Client.List(ctx, clusterClasses, client.InNamespace(namespace)); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to list ClusterClasses in namespace %s", namespace)
+	}
+
+	releases := []release{}
+	for i := range clusterClasses.Items {
+		clusterClass := &clusterClasses.Items[i]
+		if clusterClass.Spec.Release.Family != family || !clusterClass.DeletionTimestamp.IsZero() {
+			continue
+		}
+		version, err := semver.ParseTolerant(clusterClass.Spec.Release.Version)
+		if err != nil {
+			continue
+		}
+		releases = append(releases, release{clusterClass: clusterClass, version: version})
+	}
+	slices.SortStableFunc(releases, func(a, b release) int {
+		return a.version.Compare(b.version)
+	})
+	return releases, nil
+}
+
+// isUpdating returns true if a Cluster is still being updated to the ClusterClass it is using, i.e. its topology is
+// not reconciled, it is not available, it is rolling out or it has not been stable for soakTime yet.
+// If the Cluster is stable but soakTime has not passed yet, the remaining time is returned.
+func isUpdating(cluster *clusterv1.Cluster, soakTime time.Duration, now time.Time) (bool, time.Duration) {
+	topologyReconciled := conditions.Get(cluster, clusterv1.ClusterTopologyReconciledCondition)
+	if topologyReconciled == nil || topologyReconciled.Status != metav1.ConditionTrue || topologyReconciled.ObservedGeneration < cluster.Generation {
+		return true, 0
+	}
+	available := conditions.Get(cluster, clusterv1.ClusterAvailableCondition)
+	if available == nil || available.Status != metav1.ConditionTrue {
+		return true, 0
+	}
+	rollingOut := conditions.Get(cluster, clusterv1.ClusterRollingOutCondition)
+	if rollingOut != nil && rollingOut.Status == metav1.ConditionTrue {
+		return true, 0
+	}
+
+	stableSince := topologyReconciled.LastTransitionTime.Time
+	for _, c := range []*metav1.Condition{available, rollingOut} {
+		if c != nil && c.LastTransitionTime.After(stableSince) {
+			stableSince = c.LastTransitionTime.Time
+		}
+	}
+	if updatedAt, err := time.Parse(time.RFC3339, cluster.Annotations[clusterv1.ClusterClassChannelUpdatedAtAnnotation]); err == nil && updatedAt.After(stableSince) {
+		stableSince = updatedAt
+	}
+
+	if remaining := stableSince.Add(soakTime).Sub(now); remaining > 0 {
+		return true, remaining
+	}
+	return false, 0
+}
+
+// clusterToClusterClass maps a Cluster following a channel to the ClusterClass it is using.
+func clusterToClusterClass(_ context.Context, o client.Object) []ctrl.Request {
+	cluster, ok := o.(*clusterv1.Cluster)
+	if !ok {
+		panic(fmt.Sprintf("Expected a Cluster but got a %T", o))
+	}
+	if !cluster.Spec.Topology.IsDefined() || cluster.Spec.Topology.ClassRef.Channel == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: cluster.GetClassKey()}}
+}
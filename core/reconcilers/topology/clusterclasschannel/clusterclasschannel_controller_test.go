@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclasschannel
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/index"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+
+	clusterClass := func(name, version string, channels []string, rollout clusterv1.ClusterClassReleaseRollout) *clusterv1.ClusterClass {
+		return &clusterv1.ClusterClass{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+			Spec: clusterv1.ClusterClassSpec{
+				Release: clusterv1.ClusterClassRelease{
+					Family:   "quick-start",
+					Version:  version,
+					Channels: channels,
+					Rollout:  rollout,
+				},
+			},
+		}
+	}
+	cluster := func(name, class, channel string, conditions []metav1.Condition) *clusterv1.Cluster {
+		return &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name, Generation: 1},
+			Spec: clusterv1.ClusterSpec{
+				Topology: clusterv1.Topology{
+					ClassRef: clusterv1.ClusterClassRef{Name: class, Channel: channel},
+					Version:  "v1.34.0",
+				},
+			},
+			Status: clusterv1.ClusterStatus{Conditions: conditions},
+		}
+	}
+	paused := func(c *clusterv1.Cluster) *clusterv1.Cluster {
+		c.Spec.Paused = ptr.To(true)
+		return c
+	}
+
+	tests := []struct {
+		name             string
+		objs             []client.Object
+		wantClassNames   map[string]string
+		wantRequeueAfter bool
+	}{
+		{
+			name: "Updates one Cluster at a time by default",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				cluster("cluster1", "class-v1", "stable", stableConditions(now)),
+				cluster("cluster2", "class-v1", "stable", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v2",
+				"cluster2": "class-v1",
+			},
+		},
+		{
+			name: "Updates up to maxConcurrency Clusters",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{MaxConcurrency: ptr.To[int32](2)}),
+				cluster("cluster1", "class-v1", "stable", stableConditions(now)),
+				cluster("cluster2", "class-v1", "stable", stableConditions(now)),
+				cluster("cluster3", "class-v1", "stable", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v2",
+				"cluster2": "class-v2",
+				"cluster3": "class-v1",
+			},
+		},
+		{
+			name: "Updates Clusters to the newest release of the channel they follow",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable", "fast"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable", "fast"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v3", "v3.0.0", []string{"fast"}, clusterv1.ClusterClassReleaseRollout{}),
+				cluster("cluster1", "class-v1", "stable", stableConditions(now)),
+				cluster("cluster2", "class-v1", "fast", stableConditions(now)),
+				cluster("cluster3", "class-v1", "", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v2",
+				"cluster2": "class-v3",
+				"cluster3": "class-v1",
+			},
+		},
+		{
+			name: "Does not update Clusters while Clusters on the newest release are soaking",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{SoakTimeSeconds: ptr.To[int32](600)}),
+				cluster("cluster1", "class-v2", "stable", stableConditions(now.Add(-5*time.Minute))),
+				cluster("cluster2", "class-v1", "stable", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v2",
+				"cluster2": "class-v1",
+			},
+			wantRequeueAfter: true,
+		},
+		{
+			name: "Updates Clusters once Clusters on the newest release have soaked",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{SoakTimeSeconds: ptr.To[int32](600)}),
+				cluster("cluster1", "class-v2", "stable", stableConditions(now.Add(-15*time.Minute))),
+				cluster("cluster2", "class-v1", "stable", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v2",
+				"cluster2": "class-v2",
+			},
+		},
+		{
+			name: "Does not update Clusters while Clusters on the newest release are not available",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				cluster("cluster1", "class-v2", "stable", nil),
+				cluster("cluster2", "class-v1", "stable", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v2",
+				"cluster2": "class-v1",
+			},
+		},
+		{
+			name: "Does not update paused Clusters and Clusters on a newer release",
+			objs: []client.Object{
+				clusterClass("class-v1", "v1.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v2", "v2.0.0", []string{"stable"}, clusterv1.ClusterClassReleaseRollout{}),
+				clusterClass("class-v3", "v3.0.0", nil, clusterv1.ClusterClassReleaseRollout{}),
+				paused(cluster("cluster1", "class-v1", "stable", stableConditions(now))),
+				cluster("cluster2", "class-v3", "stable", nil),
+				cluster("cluster3", "class-v1", "stable", stableConditions(now)),
+			},
+			wantClassNames: map[string]string{
+				"cluster1": "class-v1",
+				"cluster2": "class-v3",
+				"cluster3": "class-v2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objs...).
+				WithIndex(&clusterv1.Cluster{}, index.ClusterClassRefPath, index.ClusterByClusterClassRef).
+				Build()
+
+			r := &Reconciler{
+				Client:   fakeClient,
+				recorder: record.NewFakeRecorder(32),
+			}
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "class-v1"}})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.RequeueAfter > 0).To(Equal(tt.wantRequeueAfter))
+
+			for name, wantClassName := range tt.wantClassNames {
+				cluster := &clusterv1.Cluster{}
+				g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: name}, cluster)).To(Succeed())
+				g.Expect(cluster.Spec.Topology.ClassRef.Name).To(Equal(wantClassName), "Cluster %s", name)
+			}
+		})
+	}
+}
+
+func Test_isUpdating(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		generation    int64
+		conditions    []metav1.Condition
+		annotations   map[string]string
+		wantUpdating  bool
+		wantRemaining time.Duration
+	}{
+		{
+			name:         "Updating if the topology has not been reconciled with the current generation",
+			generation:   2,
+			conditions:   stableConditions(now.Add(-time.Hour)),
+			wantUpdating: true,
+		},
+		{
+			name:       "Updating if the Cluster is not available",
+			generation: 1,
+			conditions: []metav1.Condition{
+				{Type: clusterv1.ClusterTopologyReconciledCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+				{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionFalse, ObservedGeneration: 1},
+			},
+			wantUpdating: true,
+		},
+		{
+			name:       "Updating if the Cluster is rolling out",
+			generation: 1,
+			conditions: []metav1.Condition{
+				{Type: clusterv1.ClusterTopologyReconciledCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+				{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+				{Type: clusterv1.ClusterRollingOutCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			},
+			wantUpdating: true,
+		},
+		{
+			name:          "Updating if the Cluster has not been stable for the soak time",
+			generation:    1,
+			conditions:    stableConditions(now.Add(-4 * time.Minute)),
+			wantUpdating:  true,
+			wantRemaining: time.Minute,
+		},
+		{
+			name:          "Updating if the Cluster has been updated within the soak time",
+			generation:    1,
+			conditions:    stableConditions(now.Add(-time.Hour)),
+			annotations:   map[string]string{clusterv1.ClusterClassChannelUpdatedAtAnnotation: now.Add(-3 * time.Minute).UTC().Format(time.RFC3339)},
+			wantUpdating:  true,
+			wantRemaining: 2 * time.Minute,
+		},
+		{
+			name:         "Not updating if the Cluster has been stable for the soak time",
+			generation:   1,
+			conditions:   stableConditions(now.Add(-time.Hour)),
+			wantUpdating: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Generation: tt.generation, Annotations: tt.annotations},
+				Status:     clusterv1.ClusterStatus{Conditions: tt.conditions},
+			}
+			updating, remaining := isUpdating(cluster, 5*time.Minute, now)
+			g.Expect(updating).To(Equal(tt.wantUpdating))
+			g.Expect(remaining).To(BeNumerically("~", tt.wantRemaining, time.Second))
+		})
+	}
+}
+
+func stableConditions(since time.Time) []metav1.Condition {
+	return []metav1.Condition{
+		{Type: clusterv1.ClusterTopologyReconciledCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1, LastTransitionTime: metav1.NewTime(since)},
+		{Type: clusterv1.ClusterAvailableCondition, Status: metav1.ConditionTrue, ObservedGeneration: 1, LastTransitionTime: metav1.NewTime(since)},
+		{Type: clusterv1.ClusterRollingOutCondition, Status: metav1.ConditionFalse, ObservedGeneration: 1, LastTransitionTime: metav1.NewTime(since)},
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclassoci
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/oci"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	capicontrollerutil "sigs.k8s.io/cluster-api/util/controller"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+const (
+	// ClusterClassLayerMediaType is the media type of the layers of an OCI artifact containing the YAML
+	// of a ClusterClass and of the templates it references.
+	ClusterClassLayerMediaType = "application/vnd.cluster.x-k8s.io.clusterclass.layer.v1+yaml"
+
+	// publicKeySecretKey is the key of the Secret referenced by classRef.oci.publicKeySecretName
+	// containing the cosign public key.
+	publicKeySecretKey = "cosign.pub"
+
+	// usernameSecretKey and passwordSecretKey are the keys of the Secret referenced by
+	// classRef.oci.credentialsSecretName containing the registry credentials.
+	usernameSecretKey = "username"
+	passwordSecretKey = "password"
+
+	// clusterClassOCIManagerName is the manager name used when applying the objects pulled from an OCI artifact.
+	clusterClassOCIManagerName = "capi-topology-clusterclass-oci"
+)
+
+// resyncInterval is the interval after which the OCI artifact is resolved again, so that
+// ClusterClasses referenced by tag are updated when the tag points to a different digest.
+var resyncInterval = 10 * time.Minute
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// puller pulls and verifies OCI artifacts.
+type puller interface {
+	Resolve(ctx context.Context, ref oci.Reference) (string, error)
+	Pull(ctx context.Context, ref oci.Reference, layerMediaType string) (*oci.Artifact, error)
+	VerifySignature(ctx context.Context, ref oci.Reference, digest string, publicKey crypto.PublicKey) error
+}
+
+// Reconciler pulls the ClusterClass and the templates it references from the OCI artifact
+// defined in Cluster.spec.topology.classRef.oci, verifies the cosign signature of the artifact and
+// creates or updates the objects in the namespace of the Cluster.
+type Reconciler struct {
+	Client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// Sharder only reconciles the objects of the shard of this replica.
+	// If nil, all objects are reconciled.
+	Sharder *shard.Sharder
+
+	recorder record.EventRecorder
+
+	// newPuller returns the puller used to pull OCI artifacts; it is overridden in tests.
+	newPuller func(credentials *oci.Credentials) puller
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.Client == nil {
+		return pkgerrors.New("Client must not be nil")
+	}
+
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "topology/clusterclassoci")
+	err := capicontrollerutil.NewControllerManagedBy(mgr, predicateLog).
+		For(&clusterv1.Cluster{},
+			builder.WithPredicates(
+				predicates.ClusterHasTopology(mgr.GetScheme(), predicateLog),
+				predicates.ClusterUnpaused(mgr.GetScheme(), predicateLog),
+			),
+		).
+		Named("topology/clusterclassoci").
+		WithOptions(options).
+		WithSharder(r.Sharder).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
+		Complete(ctx, r)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed setting up with a controller manager")
+	}
+
+	r.recorder = mgr.GetEventRecorderFor("topology/clusterclassoci-controller")
+	r.newPuller = func(credentials *oci.Credentials) puller {
+		return oci.NewClient(nil, credentials)
+	}
+	return nil
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	cluster := &clusterv1.Cluster{}
+	if err := r.Client.Get(ctx, req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	// Return early if the Cluster doesn't get the ClusterClass from an OCI artifact.
+	if !cluster.Spec.Topology.IsDefined() || !cluster.Spec.Topology.ClassRef.OCI.IsDefined() {
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	// Return early if the Cluster is paused or deleted.
+	if annotations.IsPaused(cluster, cluster) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.reconcileClusterClass(ctx, cluster); err != nil {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "ClusterClassOCISourceFailed", "Failed to get ClusterClass %s from %s: %v",
+			cluster.Spec.Topology.ClassRef.Name, cluster.Spec.Topology.ClassRef.OCI.URL, err)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// reconcileClusterClass pulls the OCI artifact, verifies its signature and applies the objects it contains,
+// unless the ClusterClass has already been pulled from the same digest.
+func (r *Reconciler) reconcileClusterClass(ctx context.Context, cluster *clusterv1.Cluster) error {
+	log := ctrl.LoggerFrom(ctx)
+	source := cluster.Spec.Topology.ClassRef.OCI
+
+	ref, err := oci.ParseReference(source.URL)
+	if err != nil {
+		return err
+	}
+	publicKey, err := r.getPublicKey(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	credentials, err := r.getCredentials(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	p := r.newPuller(credentials)
+
+	digest, err := p.Resolve(ctx, ref)
+	if err != nil {
+		return err
+	}
+	ociSource := fmt.Sprintf("%s@%s", source.URL, digest)
+
+	// Return early if the ClusterClass has already been pulled from the same digest.
+	clusterClass := &clusterv1.ClusterClass{}
+	clusterClassKey := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.Topology.ClassRef.Name}
+	if err := r.Client.Get(ctx, clusterClassKey, clusterClass); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return pkgerrors.Wrapf(err, "failed to get ClusterClass %s", clusterClassKey)
+		}
+	} else if clusterClass.Annotations[clusterv1.ClusterClassOCISourceAnnotation] == ociSource {
+		return nil
+	}
+
+	// Verify the signature before pulling the content, and then pull by digest to ensure
+	// the content is the one that has been signed.
+	if err := p.VerifySignature(ctx, ref, digest, publicKey); err != nil {
+		return err
+	}
+	ref.Digest = digest
+	artifact, err := p.Pull(ctx, ref, ClusterClassLayerMediaType)
+	if err != nil {
+		return err
+	}
+
+	objs, err := objectsFromArtifact(artifact, cluster.Namespace, clusterClassKey.Name)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "invalid OCI artifact %s", ociSource)
+	}
+
+	// Check all the objects before applying any of them, so objects are not partially applied.
+	for _, obj := range objs {
+		if err := r.checkObjectSource(ctx, obj, source.URL); err != nil {
+			return err
+		}
+	}
+	for _, obj := range objs {
+		if err := r.applyObject(ctx, obj, ociSource); err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("ClusterClass %s pulled from %s", clusterClassKey.Name, ociSource), "ClusterClass", klog.KRef(clusterClassKey.Namespace, clusterClassKey.Name))
+	return nil
+}
+
+// checkObjectSource checks that an object, if it already exists, has been pulled from an OCI artifact with the given URL.
+// NOTE: Objects not pulled from an OCI artifact, or pulled from an OCI artifact with another URL, must not be changed
+// to prevent different Clusters from overriding each other's ClusterClass.
+func (r *Reconciler) checkObjectSource(ctx context.Context, obj *unstructured.Unstructured, url string) error {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return pkgerrors.Wrapf(err, "failed to get %s %s", obj.GetKind(), klog.KObj(obj))
+	}
+
+	currentSource, ok := current.GetAnnotations()[clusterv1.ClusterClassOCISourceAnnotation]
+	if !ok {
+		return pkgerrors.Errorf("%s %s already exists and it has not been pulled from an OCI artifact", obj.GetKind(), klog.KObj(obj))
+	}
+	if currentURL := urlFromOCISource(currentSource); currentURL != url {
+		return pkgerrors.Errorf("%s %s has been pulled from another OCI artifact %s", obj.GetKind(), klog.KObj(obj), currentURL)
+	}
+	return nil
+}
+
+// applyObject creates or updates an object pulled from an OCI artifact.
+func (r *Reconciler) applyObject(ctx context.Context, obj *unstructured.Unstructured, ociSource string) error {
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = map[string]string{}
+	}
+	objAnnotations[clusterv1.ClusterClassOCISourceAnnotation] = ociSource
+	obj.SetAnnotations(objAnnotations)
+
+	if err := ssa.Patch(ctx, r.Client, clusterClassOCIManagerName, obj); err != nil {
+		return pkgerrors.Wrapf(err, "failed to apply %s %s", obj.GetKind(), klog.KObj(obj))
+	}
+	return nil
+}
+
+// getPublicKey returns the public key used to verify the signature of the OCI artifact.
+func (r *Reconciler) getPublicKey(ctx context.Context, cluster *clusterv1.Cluster) (crypto.PublicKey, error) {
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.Topology.ClassRef.OCI.PublicKeySecretName}
+	// Note: this is an expensive API call because secrets are explicitly not cached.
+	if err := r.Client.Get(ctx, secretKey, secret); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get public key Secret %s", secretKey)
+	}
+	data, ok := secret.Data[publicKeySecretKey]
+	if !ok {
+		return nil, pkgerrors.Errorf("public key Secret %s does not contain a %q entry", secretKey, publicKeySecretKey)
+	}
+	publicKey, err := oci.ParsePublicKey(data)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "invalid public key Secret %s", secretKey)
+	}
+	return publicKey, nil
+}
+
+// getCredentials returns the credentials used to pull the OCI artifact, if any.
+func (r *Reconciler) getCredentials(ctx context.Context, cluster *clusterv1.Cluster) (*oci.Credentials, error) {
+	if cluster.Spec.Topology.ClassRef.OCI.CredentialsSecretName == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.Topology.ClassRef.OCI.CredentialsSecretName}
+	// Note: this is an expensive API call because secrets are explicitly not cached.
+	if err := r.Client.Get(ctx, secretKey, secret); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get credentials Secret %s", secretKey)
+	}
+	username, hasUsername := secret.Data[usernameSecretKey]
+	password, hasPassword := secret.Data[passwordSecretKey]
+	if !hasUsername || !hasPassword {
+		return nil, pkgerrors.Errorf("credentials Secret %s must contain %q and %q entries", secretKey, usernameSecretKey, passwordSecretKey)
+	}
+	return &oci.Credentials{Username: string(username), Password: string(password)}, nil
+}
+
+// objectsFromArtifact returns the objects contained in the layers of an OCI artifact, with the namespace set.
+// The artifact must contain exactly one ClusterClass with the given name, and only templates otherwise;
+// templates are returned first so they exist when the ClusterClass is created.
+func objectsFromArtifact(artifact *oci.Artifact, namespace, clusterClassName string) ([]*unstructured.Unstructured, error) {
+	var templates []*unstructured.Unstructured
+	var clusterClasses []*unstructured.Unstructured
+	for _, layer := range artifact.Layers {
+		objs, err := utilyaml.ToUnstructured(layer.Data)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to parse layer %s", layer.Digest)
+		}
+		for i := range objs {
+			obj := &objs[i]
+			if obj.GetNamespace() != "" && obj.GetNamespace() != namespace {
+				return nil, pkgerrors.Errorf("%s %s must not define a namespace different from %s", obj.GetKind(), klog.KObj(obj), namespace)
+			}
+			obj.SetNamespace(namespace)
+
+			gvk := obj.GroupVersionKind()
+			switch {
+			case gvk.GroupKind() == clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind():
+				clusterClasses = append(clusterClasses, obj)
+			case isTemplate(gvk.Group, gvk.Kind):
+				templates = append(templates, obj)
+			default:
+				return nil, pkgerrors.Errorf("%s %s is not supported: only a ClusterClass and infrastructure, bootstrap and control plane templates are allowed", gvk.Kind, klog.KObj(obj))
+			}
+		}
+	}
+
+	if len(clusterClasses) != 1 {
+		return nil, pkgerrors.Errorf("must contain exactly one ClusterClass, got %d", len(clusterClasses))
+	}
+	if clusterClasses[0].GetName() != clusterClassName {
+		return nil, pkgerrors.Errorf("must contain ClusterClass %s, got %s", clusterClassName, clusterClasses[0].GetName())
+	}
+	return append(templates, clusterClasses[0]), nil
+}
+
+// isTemplate returns true if the kind is a template of an infrastructure, bootstrap or control plane provider.
+func isTemplate(group, kind string) bool {
+	switch group {
+	case clusterv1.GroupVersionInfrastructure.Group, clusterv1.GroupVersionBootstrap.Group, clusterv1.GroupVersionControlPlane.Group:
+		return strings.HasSuffix(kind, clusterv1.TemplateSuffix)
+	default:
+		return false
+	}
+}
+
+// urlFromOCISource returns the URL from a value of the ClusterClassOCISourceAnnotation in the form <url>@<digest>.
+func urlFromOCISource(ociSource string) string {
+	if i := strings.LastIndex(ociSource, "@"); i >= 0 {
+		return ociSource[:i]
+	}
+	return ociSource
+}
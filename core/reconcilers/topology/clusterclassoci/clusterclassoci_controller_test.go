@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterclassoci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/oci"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+const (
+	testURL    = "oci://registry.example.com/classes/class1:v1.0.0"
+	testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	testClusterClassYAML = `apiVersion: cluster.x-k8s.io/v1beta2
+kind: ClusterClass
+metadata:
+  name: class1
+spec:
+  infrastructure:
+    templateRef:
+      apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+      kind: GenericInfrastructureClusterTemplate
+      name: infra-cluster-template
+`
+	testTemplateYAML = `apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+kind: GenericInfrastructureClusterTemplate
+metadata:
+  name: infra-cluster-template
+spec:
+  template:
+    spec: {}
+`
+)
+
+func Test_objectsFromArtifact(t *testing.T) {
+	tests := []struct {
+		name      string
+		layers    []string
+		wantKinds []string
+		wantErr   string
+	}{
+		{
+			name:      "Returns templates before the ClusterClass",
+			layers:    []string{testClusterClassYAML + "---\n" + testTemplateYAML},
+			wantKinds: []string{"GenericInfrastructureClusterTemplate", "ClusterClass"},
+		},
+		{
+			name:      "Reads objects from all the layers",
+			layers:    []string{testClusterClassYAML, testTemplateYAML},
+			wantKinds: []string{"GenericInfrastructureClusterTemplate", "ClusterClass"},
+		},
+		{
+			name:    "Fails without a ClusterClass",
+			layers:  []string{testTemplateYAML},
+			wantErr: "must contain exactly one ClusterClass, got 0",
+		},
+		{
+			name:    "Fails with more than one ClusterClass",
+			layers:  []string{testClusterClassYAML, testClusterClassYAML},
+			wantErr: "must contain exactly one ClusterClass, got 2",
+		},
+		{
+			name: "Fails if the ClusterClass has a different name",
+			layers: []string{`apiVersion: cluster.x-k8s.io/v1beta2
+kind: ClusterClass
+metadata:
+  name: class2
+`},
+			wantErr: "must contain ClusterClass class1, got class2",
+		},
+		{
+			name: "Fails if an object has a different namespace",
+			layers: []string{testClusterClassYAML, `apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+kind: GenericInfrastructureClusterTemplate
+metadata:
+  name: infra-cluster-template
+  namespace: other
+`},
+			wantErr: "must not define a namespace different from default",
+		},
+		{
+			name: "Fails with objects which are not templates",
+			layers: []string{testClusterClassYAML, `apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+`},
+			wantErr: "Secret default/secret is not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			artifact := &oci.Artifact{Digest: testDigest}
+			for _, layer := range tt.layers {
+				artifact.Layers = append(artifact.Layers, oci.Layer{Data: []byte(layer)})
+			}
+
+			objs, err := objectsFromArtifact(artifact, metav1.NamespaceDefault, "class1")
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			kinds := []string{}
+			for _, obj := range objs {
+				g.Expect(obj.GetNamespace()).To(Equal(metav1.NamespaceDefault))
+				kinds = append(kinds, obj.GetKind())
+			}
+			g.Expect(kinds).To(Equal(tt.wantKinds))
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").
+		WithTopology(builder.ClusterTopology().
+			WithClass("class1").
+			WithVersion("v1.34.0").
+			Build()).
+		Build()
+	cluster.Spec.Topology.ClassRef.OCI = clusterv1.ClusterClassOCISource{
+		URL:                 testURL,
+		PublicKeySecretName: "cosign-key",
+	}
+
+	publicKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "cosign-key"},
+		Data:       map[string][]byte{publicKeySecretKey: testPublicKeyPEM(t)},
+	}
+
+	artifact := &oci.Artifact{
+		Digest: testDigest,
+		Layers: []oci.Layer{{Data: []byte(testClusterClassYAML + "---\n" + testTemplateYAML)}},
+	}
+
+	clusterClassWithAnnotation := func(ociSource string) *clusterv1.ClusterClass {
+		clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").Build()
+		if ociSource != "" {
+			clusterClass.Annotations = map[string]string{clusterv1.ClusterClassOCISourceAnnotation: ociSource}
+		}
+		return clusterClass
+	}
+
+	tests := []struct {
+		name            string
+		objs            []client.Object
+		puller          *fakePuller
+		wantErr         string
+		wantPulled      bool
+		wantOCISource   string
+		wantTemplateSet bool
+	}{
+		{
+			name:            "Pulls the ClusterClass and the templates",
+			objs:            []client.Object{publicKeySecret},
+			puller:          &fakePuller{artifact: artifact},
+			wantPulled:      true,
+			wantOCISource:   testURL + "@" + testDigest,
+			wantTemplateSet: true,
+		},
+		{
+			name:            "Pulls the ClusterClass again if the digest changed",
+			objs:            []client.Object{publicKeySecret, clusterClassWithAnnotation(testURL + "@sha256:0000000000000000000000000000000000000000000000000000000000000000")},
+			puller:          &fakePuller{artifact: artifact},
+			wantPulled:      true,
+			wantOCISource:   testURL + "@" + testDigest,
+			wantTemplateSet: true,
+		},
+		{
+			name:          "Does not pull the ClusterClass if the digest did not change",
+			objs:          []client.Object{publicKeySecret, clusterClassWithAnnotation(testURL + "@" + testDigest)},
+			puller:        &fakePuller{artifact: artifact},
+			wantPulled:    false,
+			wantOCISource: testURL + "@" + testDigest,
+		},
+		{
+			name:          "Fails if the signature is not valid",
+			objs:          []client.Object{publicKeySecret},
+			puller:        &fakePuller{artifact: artifact, verifyErr: pkgerrors.New("invalid signature")},
+			wantErr:       "invalid signature",
+			wantPulled:    false,
+			wantOCISource: "",
+		},
+		{
+			name:          "Fails if the public key Secret does not exist",
+			objs:          []client.Object{},
+			puller:        &fakePuller{artifact: artifact},
+			wantErr:       "failed to get public key Secret default/cosign-key",
+			wantOCISource: "",
+		},
+		{
+			name:          "Fails if the ClusterClass has not been pulled from an OCI artifact",
+			objs:          []client.Object{publicKeySecret, clusterClassWithAnnotation("")},
+			puller:        &fakePuller{artifact: artifact},
+			wantErr:       "ClusterClass default/class1 already exists and it has not been pulled from an OCI artifact",
+			wantPulled:    true,
+			wantOCISource: "",
+		},
+		{
+			name:          "Fails if the ClusterClass has been pulled from another OCI artifact",
+			objs:          []client.Object{publicKeySecret, clusterClassWithAnnotation("oci://registry.example.com/classes/other:v1.0.0@" + testDigest)},
+			puller:        &fakePuller{artifact: artifact},
+			wantErr:       "has been pulled from another OCI artifact oci://registry.example.com/classes/other:v1.0.0",
+			wantPulled:    true,
+			wantOCISource: "oci://registry.example.com/classes/other:v1.0.0@" + testDigest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			scheme := runtime.NewScheme()
+			g.Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objs, cluster.DeepCopy(), builder.GenericInfrastructureClusterTemplateCRD)...).
+				Build()
+
+			r := &Reconciler{
+				Client:   fakeClient,
+				recorder: record.NewFakeRecorder(32),
+				newPuller: func(*oci.Credentials) puller {
+					return tt.puller
+				},
+			}
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cluster)})
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(res.RequeueAfter).To(Equal(resyncInterval))
+			}
+			g.Expect(tt.puller.pulled).To(Equal(tt.wantPulled))
+
+			clusterClass := &clusterv1.ClusterClass{}
+			if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "class1"}, clusterClass); err != nil {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+			g.Expect(clusterClass.Annotations[clusterv1.ClusterClassOCISourceAnnotation]).To(Equal(tt.wantOCISource))
+
+			template := &unstructured.Unstructured{}
+			template.SetGroupVersionKind(builder.InfrastructureGroupVersion.WithKind(builder.GenericInfrastructureClusterTemplateKind))
+			err = fakeClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "infra-cluster-template"}, template)
+			if tt.wantTemplateSet {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(template.GetAnnotations()).To(HaveKeyWithValue(clusterv1.ClusterClassOCISourceAnnotation, tt.wantOCISource))
+			} else {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+		})
+	}
+}
+
+type fakePuller struct {
+	artifact  *oci.Artifact
+	verifyErr error
+	pulled    bool
+}
+
+func (p *fakePuller) Resolve(_ context.Context, _ oci.Reference) (string, error) {
+	return p.artifact.Digest, nil
+}
+
+func (p *fakePuller) Pull(_ context.Context, ref oci.Reference, _ string) (*oci.Artifact, error) {
+	if ref.Digest != p.artifact.Digest {
+		return nil, pkgerrors.Errorf("unexpected digest %s", ref.Digest)
+	}
+	p.pulled = true
+	return p.artifact, nil
+}
+
+func (p *fakePuller) VerifySignature(_ context.Context, _ oci.Reference, _ string, _ crypto.PublicKey) error {
+	return p.verifyErr
+}
+
+func testPublicKeyPEM(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
@@ -43,6 +43,7 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/hooks"
+	"sigs.k8s.io/cluster-api/internal/oci"
 	"sigs.k8s.io/cluster-api/internal/topology/check"
 	"sigs.k8s.io/cluster-api/internal/topology/variables"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
@@ -105,25 +106,46 @@ func (webhook *Cluster) Default(ctx context.Context, cluster *clusterv1.Cluster)
 			return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), cluster.Name, allErrs)
 		}
 
+		// Validate cluster class variables transitions that may be enforced by CEL validation rules on variables.
+		// If no request found in context, then this has not come via a webhook request, so skip validation of old cluster.
+		var oldCluster *clusterv1.Cluster
+		req, err := admission.RequestFromContext(ctx)
+
+		if err == nil && len(req.OldObject.Raw) > 0 {
+			oldCluster = &clusterv1.Cluster{}
+			if err := webhook.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
+				return apierrors.NewBadRequest(pkgerrors.Wrap(err, "failed to decode old cluster object").Error())
+			}
+		}
+		classRefChanged := oldCluster != nil && oldCluster.Spec.Topology.IsDefined() && oldCluster.GetClassKey() != cluster.GetClassKey()
+
 		clusterClass, clusterClassNotReconciled, clusterClassNotFound, err := webhook.pollClusterClassForCluster(ctx, cluster)
 		if err != nil {
 			return apierrors.NewInternalError(pkgerrors.Wrapf(err, "Cluster %s can't be defaulted. ClusterClass %s can not be retrieved", cluster.Name, cluster.GetClassKey().Name))
 		}
 		if clusterClassNotReconciled || clusterClassNotFound {
+			// If the classRef has been changed, reject the change because variables can only be migrated
+			// according to the migrations defined in the new ClusterClass once it is reconciled.
+			if classRefChanged {
+				allErrs = append(
+					allErrs,
+					field.Forbidden(
+						field.NewPath("spec", "topology", "classRef"),
+						fmt.Sprintf("cannot be changed to ClusterClass %s until the ClusterClass exists and is successfully reconciled", cluster.GetClassKey()),
+					),
+				)
+				return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), cluster.Name, allErrs)
+			}
 			// If the ClusterClass can't be found or is not reconciled, return as we shouldn't
 			// default and validate variables in that case.
 			return nil
 		}
 
-		// Validate cluster class variables transitions that may be enforced by CEL validation rules on variables.
-		// If no request found in context, then this has not come via a webhook request, so skip validation of old cluster.
-		var oldCluster *clusterv1.Cluster
-		req, err := admission.RequestFromContext(ctx)
-
-		if err == nil && len(req.OldObject.Raw) > 0 {
-			oldCluster = &clusterv1.Cluster{}
-			if err := webhook.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
-				return apierrors.NewBadRequest(pkgerrors.Wrap(err, "failed to decode old cluster object").Error())
+		// If the classRef has been changed, migrate variables according to the migrations defined in the new ClusterClass
+		// before defaulting and validating them; values which cannot be migrated are reported as errors.
+		if classRefChanged {
+			if errs := MigrateVariables(cluster, clusterClass); len(errs) > 0 {
+				return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), cluster.Name, errs)
 			}
 		}
 
@@ -291,6 +313,14 @@ func (webhook *Cluster) validateTopology(ctx context.Context, oldCluster, newClu
 
 	allErrs = append(allErrs, validateTopologyTaints(newCluster.Spec.Topology, fldPath)...)
 
+	allErrs = append(allErrs, validateTopologyAutoscaling(newCluster.Spec.Topology, fldPath)...)
+
+	allErrs = append(allErrs, validateTopologyClassRefOCI(newCluster, fldPath)...)
+
+	allErrs = append(allErrs, validateTopologyClassRefChannel(newCluster, fldPath)...)
+
+	allErrs = append(allErrs, validateTopologyControlPlaneExternallyManaged(oldCluster, newCluster, fldPath)...)
+
 	// upgrade concurrency should be a numeric value.
 	if concurrency, ok := newCluster.Annotations[clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation]; ok {
 		concurrencyAnnotationField := field.NewPath("metadata", "annotations", clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation)
@@ -422,6 +452,10 @@ func (webhook *Cluster) validateTopology(ctx context.Context, oldCluster, newClu
 
 			// Check if the new and old ClusterClasses are compatible with one another.
 			allErrs = append(allErrs, check.ClusterClassesAreCompatible(oldClusterClass, clusterClass)...)
+
+			// Surface changes that are allowed but might be unexpected when rebasing a Cluster, e.g. naming strategies.
+			// NOTE: Warnings are returned also for dry-run requests, so they can be checked before rebasing a Cluster.
+			allWarnings = append(allWarnings, check.ClusterClassNamingStrategiesChanges(newCluster, oldClusterClass, clusterClass)...)
 		}
 	}
 
@@ -470,8 +504,12 @@ func (webhook *Cluster) validateTopologyVersionUpdate(ctx context.Context, fldPa
 
 	allErrs := []error{}
 	// minor version cannot be increased if control plane is upgrading or not yet on the current version
-	if err := validateTopologyControlPlaneVersion(ctx, webhook.Client, oldCluster, oldVersion); err != nil {
-		allErrs = append(allErrs, err)
+	// Note: An externally managed control plane is upgraded out-of-band after spec.topology.version has been
+	// changed, so it is expected that it is not yet on the current version.
+	if !oldCluster.Spec.Topology.ControlPlane.IsExternallyManaged() {
+		if err := validateTopologyControlPlaneVersion(ctx, webhook.Client, oldCluster, oldVersion); err != nil {
+			allErrs = append(allErrs, err)
+		}
 	}
 
 	// minor version cannot be increased if MachineDeployments are upgrading or not yet on the current version
@@ -672,6 +710,155 @@ func validateTopologyTaints(topology clusterv1.Topology, fldPath *field.Path) fi
 	return allErrs
 }
 
+func validateTopologyAutoscaling(topology clusterv1.Topology, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList //nolint:prealloc // Not all paths append
+
+	for _, md := range topology.Workers.MachineDeployments {
+		fldPath := fldPath.Child("workers", "machineDeployments").Key(md.Name)
+		allErrs = append(allErrs, validateNodeGroupAutoscaling(md.Autoscaling, md.Replicas, fldPath)...)
+	}
+
+	for _, mp := range topology.Workers.MachinePools {
+		fldPath := fldPath.Child("workers", "machinePools").Key(mp.Name)
+		allErrs = append(allErrs, validateNodeGroupAutoscaling(mp.Autoscaling, mp.Replicas, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// validateNodeGroupAutoscaling validates the autoscaling configuration of a MachineDeployment or MachinePool topology.
+// Replicas cannot be set when autoscaling is configured, because they are managed by the cluster autoscaler.
+func validateNodeGroupAutoscaling(autoscaling clusterv1.NodeGroupAutoscaling, replicas *int32, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if autoscaling.MinSize == nil && autoscaling.MaxSize == nil && len(autoscaling.Capacity) == 0 {
+		return allErrs
+	}
+
+	if replicas != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("replicas"), "cannot be set if autoscaling is set"))
+	}
+	if autoscaling.MinSize == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("autoscaling", "minSize"), "must be set if autoscaling is set"))
+	}
+	if autoscaling.MaxSize == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("autoscaling", "maxSize"), "must be set if autoscaling is set"))
+	}
+	if autoscaling.MinSize != nil && autoscaling.MaxSize != nil && *autoscaling.MinSize > *autoscaling.MaxSize {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("autoscaling", "minSize"), *autoscaling.MinSize, "must be less than or equal to maxSize"))
+	}
+	for resourceName, quantity := range autoscaling.Capacity {
+		if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("autoscaling", "capacity").Key(string(resourceName)), quantity.String(), "must be a non-negative quantity"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateTopologyClassRefOCI validates classRef.oci.
+// NOTE: The ClusterClass pulled from the OCI artifact is created in the namespace of the Cluster,
+// so it is not possible to reference a ClusterClass in another namespace.
+func validateTopologyClassRefOCI(cluster *clusterv1.Cluster, fldPath *field.Path) field.ErrorList {
+	if !cluster.Spec.Topology.ClassRef.OCI.IsDefined() {
+		return nil
+	}
+
+	fldPath = fldPath.Child("classRef")
+	if !feature.Gates.Enabled(feature.ClusterClassOCISource) {
+		return field.ErrorList{
+			field.Forbidden(
+				fldPath.Child("oci"),
+				"can be set only if the ClusterClassOCISource feature flag is enabled",
+			),
+		}
+	}
+
+	var allErrs field.ErrorList
+	if namespace := cluster.Spec.Topology.ClassRef.Namespace; namespace != "" && namespace != cluster.Namespace {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("namespace"),
+			namespace,
+			"must be empty or equal to the namespace of the Cluster when oci is set",
+		))
+	}
+	if _, err := oci.ParseReference(cluster.Spec.Topology.ClassRef.OCI.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("oci", "url"),
+			cluster.Spec.Topology.ClassRef.OCI.URL,
+			err.Error(),
+		))
+	}
+	return allErrs
+}
+
+// validateTopologyClassRefChannel validates classRef.channel.
+// NOTE: ClusterClasses pulled from an OCI artifact are versioned by the OCI artifact, so they cannot follow a channel.
+func validateTopologyClassRefChannel(cluster *clusterv1.Cluster, fldPath *field.Path) field.ErrorList {
+	if cluster.Spec.Topology.ClassRef.Channel == "" {
+		return nil
+	}
+
+	fldPath = fldPath.Child("classRef", "channel")
+	if !feature.Gates.Enabled(feature.ClusterClassChannels) {
+		return field.ErrorList{
+			field.Forbidden(
+				fldPath,
+				"can be set only if the ClusterClassChannels feature flag is enabled",
+			),
+		}
+	}
+
+	if cluster.Spec.Topology.ClassRef.OCI.IsDefined() {
+		return field.ErrorList{
+			field.Forbidden(
+				fldPath,
+				"cannot be set together with oci",
+			),
+		}
+	}
+	return nil
+}
+
+func validateTopologyControlPlaneExternallyManaged(oldCluster, newCluster *clusterv1.Cluster, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	fldPath = fldPath.Child("controlPlane")
+	externallyManaged := newCluster.Spec.Topology.ControlPlane.IsExternallyManaged()
+
+	// externallyManaged cannot be changed after creation, because the topology controller would otherwise
+	// take over or abandon an existing ControlPlane.
+	if oldCluster != nil && oldCluster.Spec.Topology.IsDefined() &&
+		oldCluster.Spec.Topology.ControlPlane.IsExternallyManaged() != externallyManaged {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("externallyManaged"),
+			externallyManaged,
+			"field is immutable",
+		))
+	}
+
+	if !externallyManaged {
+		return allErrs
+	}
+
+	// An externally managed ControlPlane is never created by the topology controller, so it must be referenced.
+	if !newCluster.Spec.ControlPlaneRef.IsDefined() {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec", "controlPlaneRef"),
+			"must be set if spec.topology.controlPlane.externallyManaged is true",
+		))
+	}
+
+	// The topology controller does not manage the MachineHealthCheck of an externally managed ControlPlane.
+	if newCluster.Spec.Topology.ControlPlane.HealthCheck.Enabled != nil && *newCluster.Spec.Topology.ControlPlane.HealthCheck.Enabled {
+		allErrs = append(allErrs, field.Forbidden(
+			fldPath.Child("healthCheck", "enabled"),
+			"cannot be set to true if spec.topology.controlPlane.externallyManaged is true",
+		))
+	}
+	return allErrs
+}
+
 func validateMachineHealthChecks(cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -912,6 +1099,70 @@ func DefaultVariables(cluster *clusterv1.Cluster, clusterClass *clusterv1.Cluste
 	return allErrs
 }
 
+// MigrateVariables migrates variables in the Cluster according to the migrations defined in the ClusterClass variables.
+// NOTE: This must be called only when the classRef of the Cluster is changed to the ClusterClass.
+func MigrateVariables(cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) field.ErrorList {
+	var allErrs field.ErrorList
+	if cluster == nil {
+		return field.ErrorList{field.InternalError(field.NewPath(""), pkgerrors.New("Cluster can not be nil"))}
+	}
+	if clusterClass == nil {
+		return field.ErrorList{field.InternalError(field.NewPath(""), pkgerrors.New("ClusterClass can not be nil"))}
+	}
+
+	// Migrate cluster-wide variables.
+	migratedVariables, errs := variables.MigrateClusterVariables(cluster.Spec.Topology.Variables, clusterClass.Spec.Variables,
+		field.NewPath("spec", "topology", "variables"))
+	if len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	} else {
+		cluster.Spec.Topology.Variables = migratedVariables
+	}
+
+	// Migrate ControlPlane variable overrides.
+	if len(cluster.Spec.Topology.ControlPlane.Variables.Overrides) > 0 {
+		migratedVariables, errs := variables.MigrateClusterVariables(cluster.Spec.Topology.ControlPlane.Variables.Overrides, clusterClass.Spec.Variables,
+			field.NewPath("spec", "topology", "controlPlane", "variables", "overrides"))
+		if len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else {
+			cluster.Spec.Topology.ControlPlane.Variables.Overrides = migratedVariables
+		}
+	}
+
+	// Migrate MachineDeployment variable overrides.
+	for i, md := range cluster.Spec.Topology.Workers.MachineDeployments {
+		// Continue if there are no variable overrides.
+		if len(md.Variables.Overrides) == 0 {
+			continue
+		}
+		migratedVariables, errs := variables.MigrateClusterVariables(md.Variables.Overrides, clusterClass.Spec.Variables,
+			field.NewPath("spec", "topology", "workers", "machineDeployments").Key(md.Name).Child("variables", "overrides"))
+		if len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else {
+			cluster.Spec.Topology.Workers.MachineDeployments[i].Variables.Overrides = migratedVariables
+		}
+	}
+
+	// Migrate MachinePool variable overrides.
+	for i, mp := range cluster.Spec.Topology.Workers.MachinePools {
+		// Continue if there are no variable overrides.
+		if len(mp.Variables.Overrides) == 0 {
+			continue
+		}
+		migratedVariables, errs := variables.MigrateClusterVariables(mp.Variables.Overrides, clusterClass.Spec.Variables,
+			field.NewPath("spec", "topology", "workers", "machinePools").Key(mp.Name).Child("variables", "overrides"))
+		if len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else {
+			cluster.Spec.Topology.Workers.MachinePools[i].Variables.Overrides = migratedVariables
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateClusterForClusterClass uses information in the ClusterClass to validate the Cluster.
 func ValidateClusterForClusterClass(cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) field.ErrorList {
 	var allErrs field.ErrorList
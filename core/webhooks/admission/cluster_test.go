@@ -27,11 +27,13 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -1333,6 +1335,129 @@ func TestClusterDefaultAndValidateVariables(t *testing.T) {
 	}
 }
 
+func TestClusterDefaultMigratesVariablesOnClassRefChange(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterTopology, true)
+
+	regionVariable := clusterv1.ClusterClassVariable{
+		Name:     "region",
+		Required: ptr.To(true),
+		Schema: clusterv1.VariableSchema{
+			OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string"},
+		},
+		Migration: clusterv1.ClusterClassVariableMigration{RenamedFrom: "location"},
+	}
+	clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class2").
+		WithVariables(regionVariable).
+		WithStatusVariables(clusterv1.ClusterClassStatusVariable{
+			Name: "region",
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+				{
+					Required: regionVariable.Required,
+					From:     clusterv1.VariableDefinitionFromInline,
+					Schema:   regionVariable.Schema,
+				},
+			},
+		}).
+		Build()
+	conditions.Set(clusterClass, metav1.Condition{
+		Type:   clusterv1.ClusterClassVariablesReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: clusterv1.ClusterClassVariablesReadyReason,
+	})
+
+	tests := []struct {
+		name                      string
+		oldClassName              string
+		clusterClassNotReconciled bool
+		variables                 []clusterv1.ClusterVariable
+		expect                    []clusterv1.ClusterVariable
+		wantErrMessage            string
+	}{
+		{
+			name:         "migrate variables when the classRef is changed",
+			oldClassName: "class1",
+			variables: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+			expect: []clusterv1.ClusterVariable{
+				{Name: "region", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+		},
+		{
+			name:         "reject variables which cannot be migrated",
+			oldClassName: "class1",
+			variables: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+				{Name: "region", Value: apiextensionsv1.JSON{Raw: []byte(`"us-west"`)}},
+			},
+			wantErrMessage: "spec.topology.variables[location]: Invalid value: \"\\\"us-east\\\"\": variable \"location\" has been renamed to \"region\" in the ClusterClass, it cannot be migrated because variable \"region\" is set as well",
+		},
+		{
+			name:         "do not migrate variables when the classRef is not changed",
+			oldClassName: "class2",
+			variables: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+			wantErrMessage: "spec.topology.variables[location]: Invalid value: \"\\\"us-east\\\"\": variable is not defined",
+		},
+		{
+			name:                      "reject the classRef change when the new ClusterClass is not reconciled",
+			oldClassName:              "class1",
+			clusterClassNotReconciled: true,
+			variables: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+			wantErrMessage: "spec.topology.classRef: Forbidden: cannot be changed to ClusterClass default/class2 until the ClusterClass exists and is successfully reconciled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			oldCluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").
+				WithTopology(&clusterv1.Topology{
+					ClassRef:  clusterv1.ClusterClassRef{Name: tt.oldClassName},
+					Version:   "v1.22.2",
+					Variables: tt.variables,
+				}).
+				Build()
+			cluster := oldCluster.DeepCopy()
+			cluster.Spec.Topology.ClassRef.Name = "class2"
+
+			jsonObj, err := json.Marshal(oldCluster)
+			g.Expect(err).ToNot(HaveOccurred())
+			webhookCtx := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					OldObject: runtime.RawExtension{
+						Raw:    jsonObj,
+						Object: oldCluster,
+					},
+				},
+			})
+
+			clusterClass := clusterClass.DeepCopy()
+			if tt.clusterClassNotReconciled {
+				conditions.Delete(clusterClass, clusterv1.ClusterClassVariablesReadyCondition)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(clusterClass).
+				WithScheme(fakeScheme).
+				Build()
+			webhook := &Cluster{Client: fakeClient, decoder: admission.NewDecoder(fakeScheme)}
+
+			err = webhook.Default(webhookCtx, cluster)
+			if tt.wantErrMessage != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErrMessage))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(cluster.Spec.Topology.Variables).To(BeComparableTo(tt.expect))
+		})
+	}
+}
+
 func TestClusterDefaultTopologyVersion(t *testing.T) {
 	// NOTE: ClusterTopology feature flag is disabled by default, thus preventing to set Cluster.Topologies.
 	// Enabling the feature flag temporarily for this test.
@@ -2771,11 +2896,12 @@ func TestClusterTopologyValidationForTopologyClassChange(t *testing.T) {
 	}
 
 	tests := []struct {
-		name        string
-		cluster     *clusterv1.Cluster
-		firstClass  *clusterv1.ClusterClass
-		secondClass *clusterv1.ClusterClass
-		wantErr     bool
+		name         string
+		cluster      *clusterv1.Cluster
+		firstClass   *clusterv1.ClusterClass
+		secondClass  *clusterv1.ClusterClass
+		wantErr      bool
+		wantWarnings []string
 	}{
 		// InfrastructureCluster changes.
 		{
@@ -3256,6 +3382,26 @@ func TestClusterTopologyValidationForTopologyClassChange(t *testing.T) {
 				Build(),
 			wantErr: true,
 		},
+
+		// Naming strategy changes.
+		{
+			name: "Accept cluster.topology.class change with a naming strategy change and return a warning",
+			firstClass: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(refToUnstructured(ref)).
+				WithControlPlaneTemplate(refToUnstructured(ref)).
+				WithControlPlaneInfrastructureMachineTemplate(refToUnstructured(ref)).
+				WithControlPlaneNaming(&clusterv1.ControlPlaneClassNamingSpec{Template: "{{ .cluster.name }}-cp-{{ .random }}"}).
+				Build(),
+			secondClass: builder.ClusterClass(metav1.NamespaceDefault, "class2").
+				WithInfrastructureClusterTemplate(refToUnstructured(ref)).
+				WithControlPlaneTemplate(refToUnstructured(ref)).
+				WithControlPlaneInfrastructureMachineTemplate(refToUnstructured(ref)).
+				Build(),
+			wantErr: false,
+			wantWarnings: []string{
+				"naming strategy of the ControlPlane changes from \"{{ .cluster.name }}-cp-{{ .random }}\" to \"\"; it will only be used for new objects and existing objects won't be renamed",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(*testing.T) {
@@ -3291,7 +3437,11 @@ func TestClusterTopologyValidationForTopologyClassChange(t *testing.T) {
 			} else {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
-			g.Expect(warnings).To(BeEmpty())
+			if len(tt.wantWarnings) > 0 {
+				g.Expect(warnings).To(ConsistOf(tt.wantWarnings))
+			} else {
+				g.Expect(warnings).To(BeEmpty())
+			}
 		})
 	}
 }
@@ -3701,6 +3851,215 @@ func Test_validateTopologyControlPlaneVersion(t *testing.T) {
 	}
 }
 
+func Test_validateTopologyClassRefOCI(t *testing.T) {
+	ociSource := clusterv1.ClusterClassOCISource{
+		URL:                 "oci://registry.example.com/classes/quick-start:v1.0.0",
+		PublicKeySecretName: "cosign-key",
+	}
+
+	tests := []struct {
+		name           string
+		featureEnabled bool
+		classRef       clusterv1.ClusterClassRef
+		wantErrs       []string
+	}{
+		{
+			name:           "Valid if oci is not set",
+			featureEnabled: false,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1"},
+		},
+		{
+			name:           "Valid if oci is set and the feature gate is enabled",
+			featureEnabled: true,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1", Namespace: "fooboo", OCI: ociSource},
+		},
+		{
+			name:           "Invalid if oci is set and the feature gate is disabled",
+			featureEnabled: false,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1", OCI: ociSource},
+			wantErrs:       []string{"spec.topology.classRef.oci: Forbidden"},
+		},
+		{
+			name:           "Invalid if oci is set and the ClusterClass is in another namespace",
+			featureEnabled: true,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1", Namespace: "other", OCI: ociSource},
+			wantErrs:       []string{"spec.topology.classRef.namespace: Invalid value"},
+		},
+		{
+			name:           "Invalid if the url does not contain a tag or a digest",
+			featureEnabled: true,
+			classRef: clusterv1.ClusterClassRef{Name: "class1", OCI: clusterv1.ClusterClassOCISource{
+				URL:                 "oci://registry.example.com/classes/quick-start",
+				PublicKeySecretName: "cosign-key",
+			}},
+			wantErrs: []string{"spec.topology.classRef.oci.url: Invalid value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterClassOCISource, tt.featureEnabled)
+
+			cluster := builder.Cluster("fooboo", "cluster1").
+				WithTopology(builder.ClusterTopology().
+					WithClass(tt.classRef.Name).
+					WithVersion("v1.19.1").
+					Build()).
+				Build()
+			cluster.Spec.Topology.ClassRef = tt.classRef
+
+			errs := validateTopologyClassRefOCI(cluster, field.NewPath("spec", "topology"))
+			g.Expect(errs).To(HaveLen(len(tt.wantErrs)))
+			for i, wantErr := range tt.wantErrs {
+				g.Expect(errs[i].Error()).To(ContainSubstring(wantErr))
+			}
+		})
+	}
+}
+
+func Test_validateTopologyClassRefChannel(t *testing.T) {
+	tests := []struct {
+		name           string
+		featureEnabled bool
+		classRef       clusterv1.ClusterClassRef
+		wantErrs       []string
+	}{
+		{
+			name:           "Valid if channel is not set",
+			featureEnabled: false,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1"},
+		},
+		{
+			name:           "Valid if channel is set and the feature gate is enabled",
+			featureEnabled: true,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1", Channel: "stable"},
+		},
+		{
+			name:           "Invalid if channel is set and the feature gate is disabled",
+			featureEnabled: false,
+			classRef:       clusterv1.ClusterClassRef{Name: "class1", Channel: "stable"},
+			wantErrs:       []string{"spec.topology.classRef.channel: Forbidden: can be set only if the ClusterClassChannels feature flag is enabled"},
+		},
+		{
+			name:           "Invalid if channel is set together with oci",
+			featureEnabled: true,
+			classRef: clusterv1.ClusterClassRef{Name: "class1", Channel: "stable", OCI: clusterv1.ClusterClassOCISource{
+				URL:                 "oci://registry.example.com/classes/quick-start:v1.0.0",
+				PublicKeySecretName: "cosign-key",
+			}},
+			wantErrs: []string{"spec.topology.classRef.channel: Forbidden: cannot be set together with oci"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterClassChannels, tt.featureEnabled)
+
+			cluster := builder.Cluster("fooboo", "cluster1").
+				WithTopology(builder.ClusterTopology().
+					WithClass(tt.classRef.Name).
+					WithVersion("v1.19.1").
+					Build()).
+				Build()
+			cluster.Spec.Topology.ClassRef = tt.classRef
+
+			errs := validateTopologyClassRefChannel(cluster, field.NewPath("spec", "topology"))
+			g.Expect(errs).To(HaveLen(len(tt.wantErrs)))
+			for i, wantErr := range tt.wantErrs {
+				g.Expect(errs[i].Error()).To(ContainSubstring(wantErr))
+			}
+		})
+	}
+}
+
+func Test_validateTopologyControlPlaneExternallyManaged(t *testing.T) {
+	controlPlaneRef := clusterv1.ContractVersionedObjectReference{
+		APIGroup: "controlplane.cluster.x-k8s.io",
+		Kind:     "ControlPlane",
+		Name:     "cp1",
+	}
+
+	tests := []struct {
+		name                 string
+		oldExternallyManaged *bool
+		externallyManaged    bool
+		controlPlaneRef      clusterv1.ContractVersionedObjectReference
+		healthCheckEnabled   *bool
+		wantErrs             []string
+	}{
+		{
+			name:              "Valid if externallyManaged is not set",
+			externallyManaged: false,
+		},
+		{
+			name:              "Valid if externallyManaged is set and controlPlaneRef is set",
+			externallyManaged: true,
+			controlPlaneRef:   controlPlaneRef,
+		},
+		{
+			name:                 "Valid if externallyManaged is not changed on update",
+			oldExternallyManaged: ptr.To(true),
+			externallyManaged:    true,
+			controlPlaneRef:      controlPlaneRef,
+		},
+		{
+			name:              "Invalid if externallyManaged is set and controlPlaneRef is not set",
+			externallyManaged: true,
+			wantErrs:          []string{"spec.controlPlaneRef: Required value: must be set if spec.topology.controlPlane.externallyManaged is true"},
+		},
+		{
+			name:               "Invalid if externallyManaged is set and healthCheck is enabled",
+			externallyManaged:  true,
+			controlPlaneRef:    controlPlaneRef,
+			healthCheckEnabled: ptr.To(true),
+			wantErrs:           []string{"spec.topology.controlPlane.healthCheck.enabled: Forbidden: cannot be set to true if spec.topology.controlPlane.externallyManaged is true"},
+		},
+		{
+			name:                 "Invalid if externallyManaged is set on update",
+			oldExternallyManaged: ptr.To(false),
+			externallyManaged:    true,
+			controlPlaneRef:      controlPlaneRef,
+			wantErrs:             []string{"spec.topology.controlPlane.externallyManaged: Invalid value: true: field is immutable"},
+		},
+		{
+			name:                 "Invalid if externallyManaged is unset on update",
+			oldExternallyManaged: ptr.To(true),
+			externallyManaged:    false,
+			controlPlaneRef:      controlPlaneRef,
+			wantErrs:             []string{"spec.topology.controlPlane.externallyManaged: Invalid value: false: field is immutable"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := builder.Cluster("fooboo", "cluster1").
+				WithTopology(builder.ClusterTopology().
+					WithClass("class1").
+					WithVersion("v1.19.1").
+					WithControlPlaneExternallyManaged(tt.externallyManaged).
+					Build()).
+				Build()
+			cluster.Spec.ControlPlaneRef = tt.controlPlaneRef
+			cluster.Spec.Topology.ControlPlane.HealthCheck.Enabled = tt.healthCheckEnabled
+
+			var oldCluster *clusterv1.Cluster
+			if tt.oldExternallyManaged != nil {
+				oldCluster = cluster.DeepCopy()
+				oldCluster.Spec.Topology.ControlPlane.ExternallyManaged = tt.oldExternallyManaged
+			}
+
+			errs := validateTopologyControlPlaneExternallyManaged(oldCluster, cluster, field.NewPath("spec", "topology"))
+			g.Expect(errs).To(HaveLen(len(tt.wantErrs)))
+			for i, wantErr := range tt.wantErrs {
+				g.Expect(errs[i].Error()).To(ContainSubstring(wantErr))
+			}
+		})
+	}
+}
+
 func Test_validateTopologyMachineDeploymentVersions(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -3952,6 +4311,81 @@ func Test_validateTopologyMachinePoolVersions(t *testing.T) {
 	}
 }
 
+func Test_validateNodeGroupAutoscaling(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling clusterv1.NodeGroupAutoscaling
+		replicas    *int32
+		wantErr     bool
+	}{
+		{
+			name: "autoscaling not set",
+		},
+		{
+			name:     "replicas set without autoscaling",
+			replicas: ptr.To[int32](3),
+		},
+		{
+			name: "valid autoscaling",
+			autoscaling: clusterv1.NodeGroupAutoscaling{
+				MinSize: ptr.To[int32](0),
+				MaxSize: ptr.To[int32](5),
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+			},
+		},
+		{
+			name: "replicas set with autoscaling",
+			autoscaling: clusterv1.NodeGroupAutoscaling{
+				MinSize: ptr.To[int32](1),
+				MaxSize: ptr.To[int32](5),
+			},
+			replicas: ptr.To[int32](3),
+			wantErr:  true,
+		},
+		{
+			name: "minSize greater than maxSize",
+			autoscaling: clusterv1.NodeGroupAutoscaling{
+				MinSize: ptr.To[int32](5),
+				MaxSize: ptr.To[int32](1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "maxSize not set",
+			autoscaling: clusterv1.NodeGroupAutoscaling{
+				MinSize: ptr.To[int32](1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative capacity",
+			autoscaling: clusterv1.NodeGroupAutoscaling{
+				MinSize: ptr.To[int32](0),
+				MaxSize: ptr.To[int32](5),
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("-1"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := validateNodeGroupAutoscaling(tt.autoscaling, tt.replicas, field.NewPath("spec", "topology", "workers", "machineDeployments").Key("md1"))
+			if tt.wantErr {
+				g.Expect(errs).ToNot(BeEmpty())
+				return
+			}
+			g.Expect(errs).To(BeEmpty())
+		})
+	}
+}
+
 func TestValidateAutoscalerAnnotationsForCluster(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -19,6 +19,7 @@ package admission
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -39,6 +40,7 @@ import (
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
 	"sigs.k8s.io/cluster-api/internal/topology/variables"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/index"
 	clog "sigs.k8s.io/cluster-api/util/log"
 	"sigs.k8s.io/cluster-api/util/version"
@@ -56,18 +58,21 @@ func (webhook *ClusterClass) SetupWebhookWithManager(mgr ctrl.Manager) error {
 // ClusterClass implements a validation and defaulting webhook for ClusterClass.
 type ClusterClass struct {
 	Client client.Reader
+
+	// DeprecatedFieldsPolicy defines how the usage of deprecated fields is handled.
+	DeprecatedFieldsPolicy deprecation.Policy
 }
 
 var _ admission.Validator[*clusterv1.ClusterClass] = &ClusterClass{}
 
 // ValidateCreate implements validation for ClusterClass create.
 func (webhook *ClusterClass) ValidateCreate(ctx context.Context, in *clusterv1.ClusterClass) (admission.Warnings, error) {
-	return nil, webhook.validate(ctx, nil, in)
+	return webhook.validate(ctx, nil, in)
 }
 
 // ValidateUpdate implements validation for ClusterClass update.
 func (webhook *ClusterClass) ValidateUpdate(ctx context.Context, oldClusterClass, newClusterClass *clusterv1.ClusterClass) (admission.Warnings, error) {
-	return nil, webhook.validate(ctx, oldClusterClass, newClusterClass)
+	return webhook.validate(ctx, oldClusterClass, newClusterClass)
 }
 
 // ValidateDelete implements validation for ClusterClass delete.
@@ -87,17 +92,25 @@ func (webhook *ClusterClass) ValidateDelete(ctx context.Context, clusterClass *c
 	return nil, nil
 }
 
-func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newClusterClass *clusterv1.ClusterClass) error {
+func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newClusterClass *clusterv1.ClusterClass) (admission.Warnings, error) {
 	// NOTE: ClusterClass and managed topologies are behind ClusterTopology feature gate flag; the web hook
 	// must prevent creating new objects when the feature flag is disabled.
 	if !feature.Gates.Enabled(feature.ClusterTopology) {
-		return field.Forbidden(
+		return nil, field.Forbidden(
 			field.NewPath("spec"),
 			"can be set only if the ClusterTopology feature flag is enabled",
 		)
 	}
 	var allErrs field.ErrorList
 
+	// Surface the usage of deprecated fields.
+	var oldDeprecatedFields []deprecation.Field
+	if oldClusterClass != nil {
+		oldDeprecatedFields = deprecatedClusterClassFields(oldClusterClass)
+	}
+	allWarnings, deprecationErrs := webhook.DeprecatedFieldsPolicy.Validate(oldDeprecatedFields, deprecatedClusterClassFields(newClusterClass))
+	allErrs = append(allErrs, deprecationErrs...)
+
 	// Ensure all template references are valid.
 	allErrs = append(allErrs, check.ClusterClassTemplatesAreValid(newClusterClass)...)
 
@@ -136,6 +149,9 @@ func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newC
 	// Ensure all kubernetes versions are valid.
 	allErrs = append(allErrs, validateKubernetesVersions(newClusterClass.Spec.KubernetesVersions)...)
 
+	// Ensure the release is valid.
+	allErrs = append(allErrs, validateRelease(newClusterClass.Spec.Release)...)
+
 	// If this is an update run additional validation.
 	if oldClusterClass != nil {
 		// Ensure spec changes are compatible.
@@ -146,7 +162,7 @@ func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newC
 		if err != nil {
 			allErrs = append(allErrs, field.InternalError(field.NewPath(""),
 				pkgerrors.Wrapf(err, "Clusters using ClusterClass %v can not be retrieved", oldClusterClass.Name)))
-			return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind(), newClusterClass.Name, allErrs)
+			return allWarnings, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind(), newClusterClass.Name, allErrs)
 		}
 
 		// Ensure New ClusterClass contains Kubernetes versions of all the Clusters of ClusterClass.
@@ -170,9 +186,23 @@ func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newC
 	}
 
 	if len(allErrs) > 0 {
-		return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind(), newClusterClass.Name, allErrs)
+		return allWarnings, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind(), newClusterClass.Name, allErrs)
 	}
-	return nil
+	return allWarnings, nil
+}
+
+// deprecatedClusterClassFields returns the deprecated fields used by a ClusterClass.
+func deprecatedClusterClassFields(clusterClass *clusterv1.ClusterClass) []deprecation.Field {
+	var fields []deprecation.Field
+	for _, variable := range clusterClass.Spec.Variables {
+		if variable.DeprecatedV1Beta1Metadata.Labels != nil || variable.DeprecatedV1Beta1Metadata.Annotations != nil {
+			fields = append(fields, deprecation.Field{
+				Path:   field.NewPath("spec", "variables").Key(variable.Name).Child("deprecatedV1Beta1Metadata"),
+				Detail: "use schema.openAPIV3Schema.x-metadata instead",
+			})
+		}
+	}
+	return fields
 }
 
 // validateUpdatesToMachineHealthCheckClasses checks if the updates made to MachineHealthChecks are valid.
@@ -577,3 +607,29 @@ func validateKubernetesVersions(versions []string) field.ErrorList {
 	}
 	return allErrs
 }
+
+func validateRelease(release clusterv1.ClusterClassRelease) field.ErrorList {
+	if reflect.DeepEqual(release, clusterv1.ClusterClassRelease{}) {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "release")
+	if !feature.Gates.Enabled(feature.ClusterClassChannels) {
+		return field.ErrorList{
+			field.Forbidden(
+				fldPath,
+				"can be set only if the ClusterClassChannels feature flag is enabled",
+			),
+		}
+	}
+
+	var allErrs field.ErrorList
+	if _, err := semver.ParseTolerant(release.Version); err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("version"),
+			release.Version,
+			"version must be a valid semantic version",
+		))
+	}
+	return allErrs
+}
@@ -31,11 +31,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/topology/variables"
+	"sigs.k8s.io/cluster-api/internal/webhooks/deprecation"
 	"sigs.k8s.io/cluster-api/util/index"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
@@ -145,7 +147,7 @@ func TestClusterClassValidationFeatureGated(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 			webhook := &ClusterClass{}
-			err := webhook.validate(ctx, tt.old, tt.in)
+			_, err := webhook.validate(ctx, tt.old, tt.in)
 			if tt.expectErr {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -1838,7 +1840,7 @@ func TestClusterClassValidation(t *testing.T) {
 
 			// Create the webhook and add the fakeClient as its client.
 			webhook := &ClusterClass{Client: fakeClient}
-			err := webhook.validate(ctx, tt.old, tt.in)
+			_, err := webhook.validate(ctx, tt.old, tt.in)
 			if tt.expectErr {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -2678,7 +2680,7 @@ func TestClusterClassValidationWithClusterAwareChecks(t *testing.T) {
 
 			// Create the webhook and add the fakeClient as its client.
 			webhook := &ClusterClass{Client: fakeClient}
-			err := webhook.validate(ctx, tt.oldClusterClass, tt.newClusterClass)
+			_, err := webhook.validate(ctx, tt.oldClusterClass, tt.newClusterClass)
 			if tt.expectErr {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -2833,6 +2835,106 @@ func TestValidateAutoscalerAnnotationsForClusterClass(t *testing.T) {
 	}
 }
 
+func TestClusterClassValidationWithDeprecatedFields(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterTopology, true)
+
+	clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").
+		WithInfrastructureClusterTemplate(
+			builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+		WithControlPlaneTemplate(
+			builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+				Build()).
+		WithVariables(clusterv1.ClusterClassVariable{
+			Name: "location",
+			DeprecatedV1Beta1Metadata: clusterv1.ClusterClassVariableMetadata{
+				Labels: map[string]string{"foo": "bar"},
+			},
+			Schema: clusterv1.VariableSchema{
+				OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+					Type: "string",
+				},
+			},
+		}).
+		Build()
+
+	tests := []struct {
+		name         string
+		policy       deprecation.Policy
+		wantWarnings []string
+		wantErr      string
+	}{
+		{
+			name:         "Return a warning if a deprecated field is set with the Warn policy",
+			policy:       deprecation.WarnPolicy,
+			wantWarnings: []string{"spec.variables[location].deprecatedV1Beta1Metadata is deprecated: use schema.openAPIV3Schema.x-metadata instead"},
+		},
+		{
+			name:    "Return an error if a deprecated field is set with the Deny policy",
+			policy:  deprecation.DenyPolicy,
+			wantErr: "spec.variables[location].deprecatedV1Beta1Metadata: Forbidden: is deprecated: use schema.openAPIV3Schema.x-metadata instead",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			webhook := &ClusterClass{DeprecatedFieldsPolicy: tt.policy}
+			warnings, err := webhook.ValidateCreate(ctx, clusterClass)
+			g.Expect(warnings).To(Equal(admission.Warnings(tt.wantWarnings)))
+			if tt.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestValidateRelease(t *testing.T) {
+	tests := []struct {
+		name           string
+		featureEnabled bool
+		release        clusterv1.ClusterClassRelease
+		wantErrs       []string
+	}{
+		{
+			name:           "Valid if release is not set",
+			featureEnabled: false,
+		},
+		{
+			name:           "Valid if release is set and the feature gate is enabled",
+			featureEnabled: true,
+			release:        clusterv1.ClusterClassRelease{Family: "quick-start", Version: "v1.2.0", Channels: []string{"stable"}},
+		},
+		{
+			name:           "Invalid if release is set and the feature gate is disabled",
+			featureEnabled: false,
+			release:        clusterv1.ClusterClassRelease{Family: "quick-start", Version: "v1.2.0"},
+			wantErrs:       []string{"spec.release: Forbidden: can be set only if the ClusterClassChannels feature flag is enabled"},
+		},
+		{
+			name:           "Invalid if version is not a semantic version",
+			featureEnabled: true,
+			release:        clusterv1.ClusterClassRelease{Family: "quick-start", Version: "latest"},
+			wantErrs:       []string{"spec.release.version: Invalid value: \"latest\": version must be a valid semantic version"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ClusterClassChannels, tt.featureEnabled)
+
+			errs := validateRelease(tt.release)
+			g.Expect(errs).To(HaveLen(len(tt.wantErrs)))
+			for i, wantErr := range tt.wantErrs {
+				g.Expect(errs[i].Error()).To(ContainSubstring(wantErr))
+			}
+		})
+	}
+}
+
 func invalidLabels() map[string]string {
 	return map[string]string{
 		"foo":          "$invalid-key",
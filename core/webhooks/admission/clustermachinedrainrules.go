@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func (webhook *ClusterMachineDrainRule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &clusterv1.ClusterMachineDrainRule{}).
+		WithValidator(webhook).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-cluster-x-k8s-io-v1beta2-clustermachinedrainrule,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=cluster.x-k8s.io,resources=clustermachinedrainrules,versions=v1beta2,name=validation.clustermachinedrainrule.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
+
+// ClusterMachineDrainRule implements a validation webhook for ClusterMachineDrainRule.
+type ClusterMachineDrainRule struct{}
+
+var _ admission.Validator[*clusterv1.ClusterMachineDrainRule] = &ClusterMachineDrainRule{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterMachineDrainRule) ValidateCreate(_ context.Context, cmdr *clusterv1.ClusterMachineDrainRule) (admission.Warnings, error) {
+	return nil, webhook.validate(cmdr)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterMachineDrainRule) ValidateUpdate(_ context.Context, _, newCMDR *clusterv1.ClusterMachineDrainRule) (admission.Warnings, error) {
+	return nil, webhook.validate(newCMDR)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (webhook *ClusterMachineDrainRule) ValidateDelete(_ context.Context, _ *clusterv1.ClusterMachineDrainRule) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (webhook *ClusterMachineDrainRule) validate(newCMDR *clusterv1.ClusterMachineDrainRule) error {
+	allErrs := validateMachineDrainRuleSpec(&newCMDR.Spec)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("ClusterMachineDrainRule").GroupKind(), newCMDR.Name, allErrs)
+}
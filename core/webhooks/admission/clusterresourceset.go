@@ -22,6 +22,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -91,6 +92,32 @@ func (webhook *ClusterResourceSet) validate(oldCRS, newCRS *addonsv1.ClusterReso
 		)
 	}
 
+	for i, dependency := range newCRS.Spec.DependsOn {
+		if dependency == newCRS.Name {
+			allErrs = append(
+				allErrs,
+				field.Invalid(field.NewPath("spec", "dependsOn").Index(i), dependency, "a ClusterResourceSet cannot depend on itself"),
+			)
+		}
+	}
+
+	if !reflect.DeepEqual(newCRS.Spec.Reconcile, addonsv1.ClusterResourceSetReconcile{}) &&
+		newCRS.Spec.Strategy != string(addonsv1.ClusterResourceSetStrategyReconcile) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(field.NewPath("spec", "reconcile"), "field can only be set when strategy is Reconcile"),
+		)
+	}
+
+	for i, key := range newCRS.Spec.Templating.ClusterLabels {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(field.NewPath("spec", "templating", "clusterLabels").Index(i), key, msg),
+			)
+		}
+	}
+
 	if oldCRS != nil && oldCRS.Spec.Strategy != "" && oldCRS.Spec.Strategy != newCRS.Spec.Strategy {
 		allErrs = append(
 			allErrs,
@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
 	"sigs.k8s.io/cluster-api/core/webhooks/admission/testutil"
@@ -210,3 +211,156 @@ func TestClusterResourceSetSelectorNotEmptyValidation(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("selector must not be empty"))
 }
+
+func TestClusterResourceSetDependsOnValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		dependsOn []string
+		expectErr bool
+	}{
+		{
+			name:      "when the ClusterResourceSet depends on another ClusterResourceSet",
+			dependsOn: []string{"cni"},
+			expectErr: false,
+		},
+		{
+			name:      "when the ClusterResourceSet depends on itself",
+			dependsOn: []string{"cni", "metrics-server"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "metrics-server",
+				},
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"test": "test",
+						},
+					},
+					DependsOn: tt.dependsOn,
+				},
+			}
+			webhook := ClusterResourceSet{}
+
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestClusterResourceSetReconcileValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  addonsv1.ClusterResourceSetStrategy
+		reconcile addonsv1.ClusterResourceSetReconcile
+		expectErr bool
+	}{
+		{
+			name:      "when reconcile is not set with the ApplyOnce strategy",
+			strategy:  addonsv1.ClusterResourceSetStrategyApplyOnce,
+			expectErr: false,
+		},
+		{
+			name:      "when reconcile is set with the Reconcile strategy",
+			strategy:  addonsv1.ClusterResourceSetStrategyReconcile,
+			reconcile: addonsv1.ClusterResourceSetReconcile{Prune: ptr.To(true), DriftCorrectionIntervalSeconds: ptr.To[int32](300)},
+			expectErr: false,
+		},
+		{
+			name:      "when reconcile is set with the ApplyOnce strategy",
+			strategy:  addonsv1.ClusterResourceSetStrategyApplyOnce,
+			reconcile: addonsv1.ClusterResourceSetReconcile{Prune: ptr.To(true)},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"test": "test",
+						},
+					},
+					Strategy:  string(tt.strategy),
+					Reconcile: tt.reconcile,
+				},
+			}
+			webhook := ClusterResourceSet{}
+
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestClusterResourceSetTemplatingValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterLabels []string
+		expectErr     bool
+	}{
+		{
+			name:          "when clusterLabels are valid label keys",
+			clusterLabels: []string{"region", "cluster.x-k8s.io/cluster-name"},
+			expectErr:     false,
+		},
+		{
+			name:          "when clusterLabels contains an invalid label key",
+			clusterLabels: []string{"region", "not a label"},
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"test": "test",
+						},
+					},
+					Templating: addonsv1.ClusterResourceSetTemplating{
+						Format:        addonsv1.ClusterResourceSetTemplatingFormatGoTemplate,
+						ClusterLabels: tt.clusterLabels,
+					},
+				},
+			}
+			webhook := ClusterResourceSet{}
+
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
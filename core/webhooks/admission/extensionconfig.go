@@ -204,6 +204,26 @@ func validateExtensionConfigSpec(e *runtimev1.ExtensionConfig) field.ErrorList {
 			}
 		}
 	}
+
+	// Validate ClientCertificateSecretRef if defined
+	if e.Spec.ClientConfig.ClientCertificateSecretRef.IsDefined() {
+		secretRefPath := specPath.Child("clientConfig", "clientCertificateSecretRef")
+		for _, msg := range validation.IsDNS1123Subdomain(e.Spec.ClientConfig.ClientCertificateSecretRef.Name) {
+			allErrs = append(allErrs, field.Invalid(
+				secretRefPath.Child("name"),
+				e.Spec.ClientConfig.ClientCertificateSecretRef.Name,
+				msg,
+			))
+		}
+		for _, msg := range validation.IsDNS1123Label(e.Spec.ClientConfig.ClientCertificateSecretRef.Namespace) {
+			allErrs = append(allErrs, field.Invalid(
+				secretRefPath.Child("namespace"),
+				e.Spec.ClientConfig.ClientCertificateSecretRef.Namespace,
+				msg,
+			))
+		}
+	}
+
 	if e.Spec.NamespaceSelector == nil {
 		allErrs = append(allErrs, field.Required(
 			specPath.Child("namespaceSelector"),
@@ -190,6 +190,18 @@ func TestExtensionConfigValidate(t *testing.T) {
 	extensionWithInvalidServicePort := extensionWithService.DeepCopy()
 	extensionWithInvalidServicePort.Spec.ClientConfig.Service.Port = ptr.To[int32](90000)
 
+	extensionWithClientCertificate := extensionWithService.DeepCopy()
+	extensionWithClientCertificate.Spec.ClientConfig.ClientCertificateSecretRef = runtimev1.SecretReference{
+		Namespace: "default",
+		Name:      "extension-client-cert",
+	}
+
+	extensionWithBadClientCertificateName := extensionWithClientCertificate.DeepCopy()
+	extensionWithBadClientCertificateName.Spec.ClientConfig.ClientCertificateSecretRef.Name = "NOT_ALLOWED"
+
+	extensionWithNoClientCertificateNamespace := extensionWithClientCertificate.DeepCopy()
+	extensionWithNoClientCertificateNamespace.Spec.ClientConfig.ClientCertificateSecretRef.Namespace = ""
+
 	extensionWithInvalidNamespaceSelector := extensionWithService.DeepCopy()
 	extensionWithInvalidNamespaceSelector.Spec.NamespaceSelector = &metav1.LabelSelector{
 		MatchExpressions: []metav1.LabelSelectorRequirement{
@@ -315,6 +327,27 @@ func TestExtensionConfigValidate(t *testing.T) {
 			featureGate: true,
 			expectErr:   true,
 		},
+		{
+			name:        "update should pass if client certificate Secret is valid",
+			old:         extensionWithService,
+			in:          extensionWithClientCertificate,
+			featureGate: true,
+			expectErr:   false,
+		},
+		{
+			name:        "update should fail if client certificate Secret name violates Kubernetes naming rules",
+			old:         extensionWithService,
+			in:          extensionWithBadClientCertificateName,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "update should fail if client certificate Secret namespace is not defined",
+			old:         extensionWithService,
+			in:          extensionWithNoClientCertificateNamespace,
+			featureGate: true,
+			expectErr:   true,
+		},
 		{
 			name:        "update should pass if updated Extension is valid",
 			old:         extensionWithService,
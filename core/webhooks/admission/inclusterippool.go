@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"context"
+	"net/netip"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/ippool"
+)
+
+// SetupWebhookWithManager sets up InClusterIPPool webhooks.
+func (webhook *InClusterIPPool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &ipamv1.InClusterIPPool{}).
+		WithValidator(webhook).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ipam-cluster-x-k8s-io-v1beta2-inclusterippool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=ipam.cluster.x-k8s.io,resources=inclusterippools,versions=v1beta2,name=validation.inclusterippool.ipam.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
+
+// InClusterIPPool implements a validating webhook for InClusterIPPool.
+type InClusterIPPool struct{}
+
+var _ admission.Validator[*ipamv1.InClusterIPPool] = &InClusterIPPool{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (webhook *InClusterIPPool) ValidateCreate(_ context.Context, pool *ipamv1.InClusterIPPool) (admission.Warnings, error) {
+	return nil, validateInClusterIPPool(ipamv1.InClusterIPPoolKind, pool.Name, pool.Namespace, &pool.Spec)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (webhook *InClusterIPPool) ValidateUpdate(_ context.Context, _, newPool *ipamv1.InClusterIPPool) (admission.Warnings, error) {
+	return nil, validateInClusterIPPool(ipamv1.InClusterIPPoolKind, newPool.Name, newPool.Namespace, &newPool.Spec)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (webhook *InClusterIPPool) ValidateDelete(_ context.Context, _ *ipamv1.InClusterIPPool) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWebhookWithManager sets up GlobalInClusterIPPool webhooks.
+func (webhook *GlobalInClusterIPPool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &ipamv1.GlobalInClusterIPPool{}).
+		WithValidator(webhook).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-ipam-cluster-x-k8s-io-v1beta2-globalinclusterippool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=ipam.cluster.x-k8s.io,resources=globalinclusterippools,versions=v1beta2,name=validation.globalinclusterippool.ipam.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
+
+// GlobalInClusterIPPool implements a validating webhook for GlobalInClusterIPPool.
+type GlobalInClusterIPPool struct{}
+
+var _ admission.Validator[*ipamv1.GlobalInClusterIPPool] = &GlobalInClusterIPPool{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (webhook *GlobalInClusterIPPool) ValidateCreate(_ context.Context, pool *ipamv1.GlobalInClusterIPPool) (admission.Warnings, error) {
+	return nil, validateInClusterIPPool(ipamv1.GlobalInClusterIPPoolKind, pool.Name, "", &pool.Spec)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (webhook *GlobalInClusterIPPool) ValidateUpdate(_ context.Context, _, newPool *ipamv1.GlobalInClusterIPPool) (admission.Warnings, error) {
+	return nil, validateInClusterIPPool(ipamv1.GlobalInClusterIPPoolKind, newPool.Name, "", &newPool.Spec)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (webhook *GlobalInClusterIPPool) ValidateDelete(_ context.Context, _ *ipamv1.GlobalInClusterIPPool) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateInClusterIPPool validates the spec of an InClusterIPPool or, if namespace is empty, of a GlobalInClusterIPPool.
+func validateInClusterIPPool(kind, name, namespace string, spec *ipamv1.InClusterIPPoolSpec) error {
+	// NOTE: InClusterIPPool and GlobalInClusterIPPool are behind the InClusterIPAM feature gate flag;
+	// the webhook must prevent creating new objects when the feature flag is disabled.
+	specPath := field.NewPath("spec")
+	if !feature.Gates.Enabled(feature.InClusterIPAM) {
+		return field.Forbidden(
+			specPath,
+			"can be set only if the InClusterIPAM feature flag is enabled",
+		)
+	}
+
+	var allErrs field.ErrorList
+	for i, address := range spec.Addresses {
+		if _, err := ippool.ParseAddresses([]string{address}); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("addresses").Index(i), address, err.Error()))
+		}
+	}
+	for i, address := range spec.ExcludedAddresses {
+		if _, err := ippool.ParseAddresses([]string{address}); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("excludedAddresses").Index(i), address, err.Error()))
+		}
+	}
+	if spec.Prefix == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("prefix"), "prefix must be set"))
+	}
+	if spec.Gateway != "" {
+		if _, err := netip.ParseAddr(spec.Gateway); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("gateway"), spec.Gateway, "not a valid IP address"))
+		}
+	}
+
+	reservedClaims := map[types.NamespacedName]bool{}
+	for i, reservation := range spec.Reservations {
+		reservationPath := specPath.Child("reservations").Index(i)
+		if _, err := netip.ParseAddr(reservation.Address); err != nil {
+			allErrs = append(allErrs, field.Invalid(reservationPath.Child("address"), reservation.Address, "not a valid IP address"))
+		}
+
+		claimNamespace := reservation.ClaimRef.Namespace
+		switch {
+		case namespace == "" && claimNamespace == "":
+			allErrs = append(allErrs, field.Required(reservationPath.Child("claimRef", "namespace"), "namespace must be set for reservations of GlobalInClusterIPPools"))
+		case namespace != "" && claimNamespace != "" && claimNamespace != namespace:
+			allErrs = append(allErrs, field.Invalid(reservationPath.Child("claimRef", "namespace"), claimNamespace,
+				"namespace must be empty or equal to the namespace of the InClusterIPPool"))
+		}
+		if claimNamespace == "" {
+			claimNamespace = namespace
+		}
+		claim := types.NamespacedName{Namespace: claimNamespace, Name: reservation.ClaimRef.Name}
+		if reservedClaims[claim] {
+			allErrs = append(allErrs, field.Duplicate(reservationPath.Child("claimRef"), claim.String()))
+		}
+		reservedClaims[claim] = true
+	}
+
+	// Validate the pool as a whole only if all the entries are valid.
+	if len(allErrs) == 0 {
+		allErrs = append(allErrs, validateInClusterIPPoolAddresses(specPath, namespace, spec)...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(ipamv1.GroupVersion.WithKind(kind).GroupKind(), name, allErrs)
+}
+
+// validateInClusterIPPoolAddresses validates that the addresses, the prefix, the gateway and the reservations
+// of an InClusterIPPool or GlobalInClusterIPPool are consistent with each other.
+func validateInClusterIPPoolAddresses(specPath *field.Path, namespace string, spec *ipamv1.InClusterIPPoolSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	addresses, err := ippool.ParseAddresses(spec.Addresses)
+	if err != nil {
+		return append(allErrs, field.Invalid(specPath.Child("addresses"), spec.Addresses, err.Error()))
+	}
+	if !addresses.Is4() && !addresses.Is6() {
+		return append(allErrs, field.Invalid(specPath.Child("addresses"), spec.Addresses, "addresses must be of the same IP family"))
+	}
+
+	if addresses.Is4() && ptr.Deref(spec.Prefix, 0) > 32 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("prefix"), ptr.Deref(spec.Prefix, 0), "prefix is too large for IPv4 addresses"))
+	}
+	if spec.Gateway != "" {
+		gateway := netip.MustParseAddr(spec.Gateway).Unmap()
+		if gateway.Is4() != addresses.Is4() {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("gateway"), spec.Gateway, "gateway must be of the same IP family of the addresses"))
+		}
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	// Reserved addresses must be addresses that can be allocated by the pool.
+	specWithoutReservations := spec.DeepCopy()
+	specWithoutReservations.Reservations = nil
+	pool, err := ippool.NewPool(specWithoutReservations, namespace)
+	if err != nil {
+		return append(allErrs, field.Invalid(specPath, "", err.Error()))
+	}
+	for i, reservation := range spec.Reservations {
+		if !pool.Contains(reservation.Address) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("reservations").Index(i).Child("address"), reservation.Address,
+				"address must be one of the addresses that can be allocated by the pool, excluding the gateway and the excluded addresses"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/feature"
+)
+
+func TestInClusterIPPoolValidation(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InClusterIPAM, true)
+
+	validSpec := func() ipamv1.InClusterIPPoolSpec {
+		return ipamv1.InClusterIPPoolSpec{
+			Addresses:         []string{"10.0.0.0/24", "10.0.1.10-10.0.1.20"},
+			Prefix:            ptr.To[int32](23),
+			Gateway:           "10.0.0.1",
+			ExcludedAddresses: []string{"10.0.0.2"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(spec *ipamv1.InClusterIPPoolSpec)
+		expectErr bool
+	}{
+		{
+			name:      "valid pool",
+			mutate:    func(*ipamv1.InClusterIPPoolSpec) {},
+			expectErr: false,
+		},
+		{
+			name: "valid pool with reservations",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Reservations = []ipamv1.InClusterIPPoolReservation{
+					{Address: "10.0.0.10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim-1"}},
+					{Address: "10.0.1.10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim-2", Namespace: "foo"}},
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid address",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Addresses = append(spec.Addresses, "10.0.0.300")
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid excluded address",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.ExcludedAddresses = append(spec.ExcludedAddresses, "10.0.0.20-10.0.0.10")
+			},
+			expectErr: true,
+		},
+		{
+			name: "addresses of different IP families",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Addresses = append(spec.Addresses, "fd00::1")
+			},
+			expectErr: true,
+		},
+		{
+			name: "prefix too large for IPv4 addresses",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Prefix = ptr.To[int32](64)
+			},
+			expectErr: true,
+		},
+		{
+			name: "gateway of a different IP family",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Gateway = "fd00::1"
+			},
+			expectErr: true,
+		},
+		{
+			name: "reserved address not in the pool",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Reservations = []ipamv1.InClusterIPPoolReservation{
+					{Address: "10.0.2.10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim"}},
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "reserved address excluded from the pool",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Reservations = []ipamv1.InClusterIPPoolReservation{
+					{Address: "10.0.0.2", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim"}},
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "reservation for a claim in another namespace",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Reservations = []ipamv1.InClusterIPPoolReservation{
+					{Address: "10.0.0.10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim", Namespace: "bar"}},
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "multiple reservations for the same claim",
+			mutate: func(spec *ipamv1.InClusterIPPoolSpec) {
+				spec.Reservations = []ipamv1.InClusterIPPoolReservation{
+					{Address: "10.0.0.10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim"}},
+					{Address: "10.0.0.11", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim", Namespace: "foo"}},
+				}
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &ipamv1.InClusterIPPool{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "pool"},
+				Spec:       validSpec(),
+			}
+			tt.mutate(&pool.Spec)
+
+			webhook := &InClusterIPPool{}
+			_, err := webhook.ValidateCreate(ctx, pool)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			_, err = webhook.ValidateUpdate(ctx, pool, pool)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestGlobalInClusterIPPoolValidation(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InClusterIPAM, true)
+
+	tests := []struct {
+		name         string
+		reservations []ipamv1.InClusterIPPoolReservation
+		expectErr    bool
+	}{
+		{
+			name: "reservation with namespace",
+			reservations: []ipamv1.InClusterIPPoolReservation{
+				{Address: "fd00::10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim", Namespace: "foo"}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "reservation without namespace",
+			reservations: []ipamv1.InClusterIPPoolReservation{
+				{Address: "fd00::10", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &ipamv1.GlobalInClusterIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec: ipamv1.InClusterIPPoolSpec{
+					Addresses:    []string{"fd00::/120"},
+					Prefix:       ptr.To[int32](64),
+					Gateway:      "fd00::1",
+					Reservations: tt.reservations,
+				},
+			}
+
+			webhook := &GlobalInClusterIPPool{}
+			_, err := webhook.ValidateCreate(ctx, pool)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestInClusterIPPoolFeatureGateDisabled(t *testing.T) {
+	// NOTE: InClusterIPPool and GlobalInClusterIPPool are behind the InClusterIPAM feature gate flag;
+	// the webhook must prevent creating new objects when the feature flag is disabled.
+	g := NewWithT(t)
+
+	spec := ipamv1.InClusterIPPoolSpec{
+		Addresses: []string{"10.0.0.0/24"},
+		Prefix:    ptr.To[int32](24),
+	}
+
+	_, err := (&InClusterIPPool{}).ValidateCreate(ctx, &ipamv1.InClusterIPPool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "pool"},
+		Spec:       spec,
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = (&GlobalInClusterIPPool{}).ValidateCreate(ctx, &ipamv1.GlobalInClusterIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec:       spec,
+	})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -115,6 +115,15 @@ func (webhook *Machine) validate(oldM, newM *clusterv1.Machine) error {
 		)
 	}
 
+	// The bootstrap config of existing Machines can only be changed if the MachineBootstrapConfigSwap feature gate is enabled.
+	if oldM != nil && oldM.Spec.Bootstrap.ConfigRef.IsDefined() && oldM.Spec.Bootstrap.ConfigRef != newM.Spec.Bootstrap.ConfigRef &&
+		!feature.Gates.Enabled(feature.MachineBootstrapConfigSwap) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(specPath.Child("bootstrap", "configRef"), "field is immutable unless the MachineBootstrapConfigSwap feature gate is enabled"),
+		)
+	}
+
 	if newM.Spec.Version != "" {
 		if !strings.HasPrefix(newM.Spec.Version, "v") {
 			allErrs = append(allErrs, field.Invalid(specPath.Child("version"), newM.Spec.Version, "must start with v"))
@@ -126,6 +135,7 @@ func (webhook *Machine) validate(oldM, newM *clusterv1.Machine) error {
 
 	allErrs = append(allErrs, taints.ValidateMachineTaints(newM.Spec.Taints, specPath.Child("taints"))...)
 	allErrs = append(allErrs, validateMachineTaintsForWorkers(newM.Spec.Taints, newM, specPath.Child("taints"))...)
+	allErrs = append(allErrs, validatePreTerminateDeleteHookTimeout(oldM, newM)...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -133,6 +143,30 @@ func (webhook *Machine) validate(oldM, newM *clusterv1.Machine) error {
 	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Machine").GroupKind(), newM.Name, allErrs)
 }
 
+// validatePreTerminateDeleteHookTimeout rejects setting the pre-terminate hook timeout on control plane Machines,
+// because their pre-terminate hooks are never skipped, e.g. the KCP hook removing the etcd member of the Machine.
+// Note: Existing annotations are allowed, so Machines that already have the annotation can still be updated.
+func validatePreTerminateDeleteHookTimeout(oldM, newM *clusterv1.Machine) field.ErrorList {
+	if _, ok := newM.Labels[clusterv1.MachineControlPlaneLabel]; !ok {
+		return nil
+	}
+	value, ok := newM.Annotations[clusterv1.PreTerminateDeleteHookTimeoutAnnotation]
+	if !ok {
+		return nil
+	}
+	if oldM != nil {
+		if oldValue, ok := oldM.Annotations[clusterv1.PreTerminateDeleteHookTimeoutAnnotation]; ok && oldValue == value {
+			return nil
+		}
+	}
+	return field.ErrorList{
+		field.Forbidden(
+			field.NewPath("metadata", "annotations", clusterv1.PreTerminateDeleteHookTimeoutAnnotation),
+			"cannot be set on control plane Machines",
+		),
+	}
+}
+
 func validateMachineTaintsForWorkers(taints []clusterv1.MachineTaint, machine *clusterv1.Machine, taintsPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
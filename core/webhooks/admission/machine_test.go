@@ -21,10 +21,12 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/core/webhooks/admission/testutil"
+	"sigs.k8s.io/cluster-api/feature"
 )
 
 func TestMachineDefault(t *testing.T) {
@@ -161,6 +163,68 @@ func TestMachineClusterNameImmutable(t *testing.T) {
 	}
 }
 
+func TestMachineBootstrapConfigRefImmutable(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldConfigRef   clusterv1.ContractVersionedObjectReference
+		newConfigRef   clusterv1.ContractVersionedObjectReference
+		featureEnabled bool
+		expectErr      bool
+	}{
+		{
+			name:         "when the bootstrap config ref has not changed",
+			oldConfigRef: clusterv1.ContractVersionedObjectReference{Name: "bootstrap1"},
+			newConfigRef: clusterv1.ContractVersionedObjectReference{Name: "bootstrap1"},
+			expectErr:    false,
+		},
+		{
+			name:         "when the bootstrap config ref is set for the first time",
+			newConfigRef: clusterv1.ContractVersionedObjectReference{Name: "bootstrap1"},
+			expectErr:    false,
+		},
+		{
+			name:         "when the bootstrap config ref has changed",
+			oldConfigRef: clusterv1.ContractVersionedObjectReference{Name: "bootstrap1"},
+			newConfigRef: clusterv1.ContractVersionedObjectReference{Name: "bootstrap2"},
+			expectErr:    true,
+		},
+		{
+			name:           "when the bootstrap config ref has changed and the MachineBootstrapConfigSwap feature gate is enabled",
+			oldConfigRef:   clusterv1.ContractVersionedObjectReference{Name: "bootstrap1"},
+			newConfigRef:   clusterv1.ContractVersionedObjectReference{Name: "bootstrap2"},
+			featureEnabled: true,
+			expectErr:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineBootstrapConfigSwap, tt.featureEnabled)
+
+			newMachine := &clusterv1.Machine{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{ConfigRef: tt.newConfigRef},
+				},
+			}
+			oldMachine := &clusterv1.Machine{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{ConfigRef: tt.oldConfigRef, DataSecretName: ptr.To("data")},
+				},
+			}
+
+			warnings, err := (&Machine{}).ValidateUpdate(ctx, oldMachine, newMachine)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
 func TestMachineVersionValidation(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -225,3 +289,79 @@ func TestMachineVersionValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMachinePreTerminateDeleteHookTimeoutValidation(t *testing.T) {
+	newMachine := func(controlPlane bool, timeout string) *clusterv1.Machine {
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{},
+				Annotations: map[string]string{},
+			},
+			Spec: clusterv1.MachineSpec{
+				Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To("test")},
+			},
+		}
+		if controlPlane {
+			m.Labels[clusterv1.MachineControlPlaneLabel] = ""
+		}
+		if timeout != "" {
+			m.Annotations[clusterv1.PreTerminateDeleteHookTimeoutAnnotation] = timeout
+		}
+		return m
+	}
+
+	tests := []struct {
+		name       string
+		oldMachine *clusterv1.Machine
+		newMachine *clusterv1.Machine
+		expectErr  bool
+	}{
+		{
+			name:       "allows the timeout on worker Machines",
+			newMachine: newMachine(false, "10m"),
+			expectErr:  false,
+		},
+		{
+			name:       "rejects the timeout on control plane Machines",
+			newMachine: newMachine(true, "10m"),
+			expectErr:  true,
+		},
+		{
+			name:       "rejects adding the timeout to existing control plane Machines",
+			oldMachine: newMachine(true, ""),
+			newMachine: newMachine(true, "10m"),
+			expectErr:  true,
+		},
+		{
+			name:       "rejects changing the timeout of existing control plane Machines",
+			oldMachine: newMachine(true, "10m"),
+			newMachine: newMachine(true, "20m"),
+			expectErr:  true,
+		},
+		{
+			name:       "allows updating control plane Machines that already have the timeout",
+			oldMachine: newMachine(true, "10m"),
+			newMachine: newMachine(true, "10m"),
+			expectErr:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			webhook := &Machine{}
+			var err error
+			if tt.oldMachine == nil {
+				_, err = webhook.ValidateCreate(ctx, tt.newMachine)
+			} else {
+				_, err = webhook.ValidateUpdate(ctx, tt.oldMachine, tt.newMachine)
+			}
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
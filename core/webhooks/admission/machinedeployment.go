@@ -225,6 +225,15 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 
 	allErrs = append(allErrs, validateRolloutStrategy(specPath.Child("rollout", "strategy"), newMD.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable, newMD.Spec.Rollout.Strategy.RollingUpdate.MaxSurge)...)
 	allErrs = append(allErrs, validateRemediationMaxInFlight(specPath.Child("remediation"), newMD.Spec.Remediation.MaxInFlight)...)
+	if newMD.Spec.Rollout.Canary.Replicas != nil && newMD.Spec.Rollout.Strategy.Type == clusterv1.OnDeleteMachineDeploymentStrategyType {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				specPath.Child("rollout", "canary"),
+				fmt.Sprintf("canary can only be set when using the %s rollout strategy", clusterv1.RollingUpdateMachineDeploymentStrategyType),
+			),
+		)
+	}
 
 	if newMD.Spec.Template.Spec.Version != "" {
 		if !strings.HasPrefix(newMD.Spec.Template.Spec.Version, "v") {
@@ -236,6 +245,7 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 	}
 
 	allErrs = append(allErrs, validateMDMachineNaming(newMD.Spec.MachineNaming, specPath.Child("machineNaming"))...)
+	allErrs = append(allErrs, validateMachinePlacement(newMD.Spec.Placement, newMD.Spec.Template.Spec.FailureDomain, specPath.Child("placement"))...)
 
 	allErrs = append(allErrs, taints.ValidateMachineTaints(newMD.Spec.Template.Spec.Taints, specPath.Child("template", "spec", "taints"))...)
 	allErrs = append(allErrs, validateMachineTaintsForWorkers(newMD.Spec.Template.Spec.Taints, nil, specPath.Child("template", "spec", "taints"))...)
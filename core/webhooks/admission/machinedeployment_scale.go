@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	pkgerrors "github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func (v *MachineDeploymentScaleValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	v.decoder = admission.NewDecoder(mgr.GetScheme())
+
+	mgr.GetWebhookServer().Register("/validate-scale-cluster-x-k8s-io-v1beta2-machinedeployment", &webhook.Admission{
+		Handler: v,
+	})
+	return nil
+}
+
+// +kubebuilder:webhook:verbs=update,path=/validate-scale-cluster-x-k8s-io-v1beta2-machinedeployment,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=cluster.x-k8s.io,resources=machinedeployments/scale,versions=v1beta2,name=validation-scale.machinedeployment.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1
+
+// MachineDeploymentScaleValidator validates scale updates of MachineDeployments.
+type MachineDeploymentScaleValidator struct {
+	Client  client.Reader
+	decoder admission.Decoder
+}
+
+// Handle validates that replicas set via the scale subresource are within the range defined by the
+// autoscaler min size and max size annotations of the MachineDeployment, if any.
+// The goal of this check is to prevent the autoscaler and other tools scaling the MachineDeployment,
+// e.g. GitOps tools, from fighting over the replicas field.
+func (v *MachineDeploymentScaleValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	scale := &autoscalingv1.Scale{}
+
+	err := v.decoder.Decode(req, scale)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, pkgerrors.Wrapf(err, "failed to decode Scale resource"))
+	}
+
+	md := &clusterv1.MachineDeployment{}
+	mdKey := types.NamespacedName{Namespace: scale.Namespace, Name: scale.Name}
+	if err = v.Client.Get(ctx, mdKey, md); err != nil {
+		return admission.Errored(http.StatusInternalServerError, pkgerrors.Wrapf(err, "failed to get MachineDeployment %s/%s", scale.Namespace, scale.Name))
+	}
+
+	minSizeString, hasMinSizeAnnotation := md.Annotations[clusterv1.AutoscalerMinSizeAnnotation]
+	maxSizeString, hasMaxSizeAnnotation := md.Annotations[clusterv1.AutoscalerMaxSizeAnnotation]
+	if !hasMinSizeAnnotation || !hasMaxSizeAnnotation {
+		return admission.Allowed("")
+	}
+
+	minSize, err := strconv.ParseInt(minSizeString, 10, 32)
+	if err != nil {
+		return admission.Denied(fmt.Sprintf("could not parse the value of the %q annotation", clusterv1.AutoscalerMinSizeAnnotation))
+	}
+	maxSize, err := strconv.ParseInt(maxSizeString, 10, 32)
+	if err != nil {
+		return admission.Denied(fmt.Sprintf("could not parse the value of the %q annotation", clusterv1.AutoscalerMaxSizeAnnotation))
+	}
+
+	if scale.Spec.Replicas < int32(minSize) || scale.Spec.Replicas > int32(maxSize) {
+		return admission.Denied(fmt.Sprintf("replicas must be between %d and %d as defined by the %q and %q annotations",
+			minSize, maxSize, clusterv1.AutoscalerMinSizeAnnotation, clusterv1.AutoscalerMaxSizeAnnotation))
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestMachineDeploymentValidateScale(t *testing.T) {
+	mdWithoutAutoscaler := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "md-without-autoscaler",
+			Namespace: "foo",
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+	mdWithAutoscaler := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "md-with-autoscaler",
+			Namespace: "foo",
+			Annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "2",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+	mdWithInvalidAutoscaler := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "md-with-invalid-autoscaler",
+			Namespace: "foo",
+			Annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "two",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+
+	scaleRequest := func(name string, replicas int32) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UID:       uuid.NewUUID(),
+			Kind:      metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+			Operation: admissionv1.Update,
+			Object:    runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"metadata":{"name":%q,"namespace":"foo"},"spec":{"replicas":%d}}`, name, replicas))},
+		}}
+	}
+
+	tests := []struct {
+		name              string
+		admissionRequest  admission.Request
+		expectRespAllowed bool
+		expectRespMessage string
+	}{
+		{
+			name:              "should allow any number of replicas without autoscaler annotations",
+			admissionRequest:  scaleRequest("md-without-autoscaler", 10),
+			expectRespAllowed: true,
+		},
+		{
+			name:              "should allow replicas within the autoscaler min and max size",
+			admissionRequest:  scaleRequest("md-with-autoscaler", 2),
+			expectRespAllowed: true,
+		},
+		{
+			name:              "should return error when trying to scale below the autoscaler min size",
+			admissionRequest:  scaleRequest("md-with-autoscaler", 1),
+			expectRespAllowed: false,
+			expectRespMessage: "replicas must be between 2 and 5 as defined by the \"cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size\" and \"cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size\" annotations",
+		},
+		{
+			name:              "should return error when trying to scale above the autoscaler max size",
+			admissionRequest:  scaleRequest("md-with-autoscaler", 6),
+			expectRespAllowed: false,
+			expectRespMessage: "replicas must be between 2 and 5 as defined by the \"cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size\" and \"cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size\" annotations",
+		},
+		{
+			name:              "should return error when the autoscaler annotations are invalid",
+			admissionRequest:  scaleRequest("md-with-invalid-autoscaler", 3),
+			expectRespAllowed: false,
+			expectRespMessage: "could not parse the value of the \"cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size\" annotation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(mdWithoutAutoscaler, mdWithAutoscaler, mdWithInvalidAutoscaler).Build()
+
+			// Create the webhook and add the fakeClient as its client.
+			scaleHandler := MachineDeploymentScaleValidator{
+				Client:  fakeClient,
+				decoder: admission.NewDecoder(fakeScheme),
+			}
+
+			resp := scaleHandler.Handle(ctx, tt.admissionRequest)
+			g.Expect(resp.Allowed).Should(Equal(tt.expectRespAllowed))
+			g.Expect(resp.Result.Message).Should(Equal(tt.expectRespMessage))
+		})
+	}
+}
@@ -368,9 +368,12 @@ func TestMachineDeploymentValidation(t *testing.T) {
 		selectors     map[string]string
 		labels        map[string]string
 		strategy      clusterv1.MachineDeploymentRolloutStrategy
+		canary        clusterv1.MachineDeploymentRolloutCanary
 		remediation   clusterv1.MachineDeploymentRemediationSpec
 		expectErr     bool
 		machineNaming clusterv1.MachineNamingSpec
+		placement     clusterv1.MachinePlacementSpec
+		failureDomain string
 	}{
 		{
 			name:      "pass with name of under 63 characters",
@@ -511,6 +514,26 @@ func TestMachineDeploymentValidation(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "should not return error for canary with RollingUpdate strategy",
+			strategy: clusterv1.MachineDeploymentRolloutStrategy{
+				Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+			},
+			canary: clusterv1.MachineDeploymentRolloutCanary{
+				Replicas: ptr.To[int32](1),
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for canary with OnDelete strategy",
+			strategy: clusterv1.MachineDeploymentRolloutStrategy{
+				Type: clusterv1.OnDeleteMachineDeploymentStrategyType,
+			},
+			canary: clusterv1.MachineDeploymentRolloutCanary{
+				Replicas: ptr.To[int32](1),
+			},
+			expectErr: true,
+		},
 		{
 			name: "should not return error when MachineNamingSpec have {{ .random }}",
 			machineNaming: clusterv1.MachineNamingSpec{
@@ -532,6 +555,21 @@ func TestMachineDeploymentValidation(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "should not return error for placement failureDomains",
+			placement: clusterv1.MachinePlacementSpec{
+				FailureDomains: []string{"fd1", "fd2"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for placement failureDomains when spec.template.spec.failureDomain is set",
+			placement: clusterv1.MachinePlacementSpec{
+				FailureDomains: []string{"fd1", "fd2"},
+			},
+			failureDomain: "fd1",
+			expectErr:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -544,6 +582,7 @@ func TestMachineDeploymentValidation(t *testing.T) {
 				Spec: clusterv1.MachineDeploymentSpec{
 					Rollout: clusterv1.MachineDeploymentRolloutSpec{
 						Strategy: tt.strategy,
+						Canary:   tt.canary,
 					},
 					Selector: metav1.LabelSelector{
 						MatchLabels: tt.selectors,
@@ -556,10 +595,12 @@ func TestMachineDeploymentValidation(t *testing.T) {
 							Bootstrap: clusterv1.Bootstrap{
 								DataSecretName: ptr.To("data-secret"),
 							},
+							FailureDomain: tt.failureDomain,
 						},
 					},
 					Remediation:   tt.remediation,
 					MachineNaming: tt.machineNaming,
+					Placement:     tt.placement,
 				},
 			}
 
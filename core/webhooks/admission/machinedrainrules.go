@@ -60,14 +60,24 @@ func (webhook *MachineDrainRule) ValidateDelete(_ context.Context, _ *clusterv1.
 }
 
 func (webhook *MachineDrainRule) validate(newMDR *clusterv1.MachineDrainRule) error {
+	allErrs := validateMachineDrainRuleSpec(&newMDR.Spec)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineDrainRule").GroupKind(), newMDR.Name, allErrs)
+}
+
+// validateMachineDrainRuleSpec validates the spec of a MachineDrainRule or ClusterMachineDrainRule.
+func validateMachineDrainRuleSpec(spec *clusterv1.MachineDrainRuleSpec) field.ErrorList {
 	var allErrs field.ErrorList
 
-	if newMDR.Spec.Drain.Behavior == clusterv1.MachineDrainRuleDrainBehaviorSkip ||
-		newMDR.Spec.Drain.Behavior == clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted {
-		if newMDR.Spec.Drain.Order != nil {
+	if spec.Drain.Behavior == clusterv1.MachineDrainRuleDrainBehaviorSkip ||
+		spec.Drain.Behavior == clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted {
+		if spec.Drain.Order != nil {
 			allErrs = append(allErrs,
 				field.Invalid(field.NewPath("spec", "drain", "order"),
-					*newMDR.Spec.Drain.Order,
+					*spec.Drain.Order,
 					fmt.Sprintf("order must not be set if drain behavior is %q or %q",
 						clusterv1.MachineDrainRuleDrainBehaviorSkip, clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted),
 				),
@@ -75,21 +85,17 @@ func (webhook *MachineDrainRule) validate(newMDR *clusterv1.MachineDrainRule) er
 		}
 	}
 
-	allErrs = append(allErrs, ValidateMachineDrainRulesSelectors(newMDR)...)
+	allErrs = append(allErrs, ValidateMachineDrainRulesSelectors(spec)...)
 
-	if len(allErrs) == 0 {
-		return nil
-	}
-
-	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineDrainRule").GroupKind(), newMDR.Name, allErrs)
+	return allErrs
 }
 
-// ValidateMachineDrainRulesSelectors validate the selectors of a MachineDrainRule.
+// ValidateMachineDrainRulesSelectors validate the selectors of a MachineDrainRule or ClusterMachineDrainRule.
 // Note: This func is exported so it can be also used to validate selectors in the Machine controller.
-func ValidateMachineDrainRulesSelectors(machineDrainRule *clusterv1.MachineDrainRule) field.ErrorList {
+func ValidateMachineDrainRulesSelectors(spec *clusterv1.MachineDrainRuleSpec) field.ErrorList {
 	var allErrs field.ErrorList
 
-	for i, machineSelector := range machineDrainRule.Spec.Machines {
+	for i, machineSelector := range spec.Machines {
 		if machineSelector.Selector != nil {
 			if _, err := metav1.LabelSelectorAsSelector(machineSelector.Selector); err != nil {
 				allErrs = append(allErrs,
@@ -106,7 +112,7 @@ func ValidateMachineDrainRulesSelectors(machineDrainRule *clusterv1.MachineDrain
 		}
 	}
 
-	for i, podSelector := range machineDrainRule.Spec.Pods {
+	for i, podSelector := range spec.Pods {
 		if podSelector.Selector != nil {
 			if _, err := metav1.LabelSelectorAsSelector(podSelector.Selector); err != nil {
 				allErrs = append(allErrs,
@@ -128,6 +128,7 @@ func (webhook *MachineHealthCheck) validate(oldMHC, newMHC *clusterv1.MachineHea
 	}
 
 	allErrs = append(allErrs, validateMachineHealthCheckNodeStartupTimeoutSeconds(specPath, newMHC.Spec.Checks.NodeStartupTimeoutSeconds)...)
+	allErrs = append(allErrs, validateMachineHealthCheckUnhealthyPodConditions(specPath, newMHC.Spec.Checks.UnhealthyPodConditions)...)
 	allErrs = append(allErrs, validateMachineHealthCheckUnhealthyLessThanOrEqualTo(specPath, newMHC.Spec.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo)...)
 
 	if len(allErrs) == 0 {
@@ -149,6 +150,19 @@ func validateMachineHealthCheckNodeStartupTimeoutSeconds(fldPath *field.Path, no
 	return allErrs
 }
 
+func validateMachineHealthCheckUnhealthyPodConditions(fldPath *field.Path, unhealthyPodConditions []clusterv1.UnhealthyPodCondition) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, c := range unhealthyPodConditions {
+		if _, err := metav1.LabelSelectorAsSelector(&c.PodSelector); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fldPath.Child("checks", "unhealthyPodConditions").Index(i).Child("podSelector"), c.PodSelector, err.Error()),
+			)
+		}
+	}
+	return allErrs
+}
+
 func validateMachineHealthCheckUnhealthyLessThanOrEqualTo(fldPath *field.Path, unhealthyLessThanOrEqualTo *intstr.IntOrString) field.ErrorList {
 	var allErrs field.ErrorList
 	if unhealthyLessThanOrEqualTo != nil {
@@ -129,6 +129,60 @@ func TestMachineHealthCheckLabelSelectorAsSelectorValidation(t *testing.T) {
 	}
 }
 
+func TestMachineHealthCheckUnhealthyPodConditionsPodSelectorValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectors map[string]string
+		expectErr bool
+	}{
+		{
+			name:      "should not return error for valid pod selector",
+			selectors: map[string]string{"app": "critical"},
+			expectErr: false,
+		},
+		{
+			name:      "should return error for invalid pod selector",
+			selectors: map[string]string{"-123-app": "critical"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mhc := &clusterv1.MachineHealthCheck{
+				Spec: clusterv1.MachineHealthCheckSpec{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Checks: clusterv1.MachineHealthCheckChecks{
+						UnhealthyPodConditions: []clusterv1.UnhealthyPodCondition{
+							{
+								Namespace: "kube-system",
+								PodSelector: metav1.LabelSelector{
+									MatchLabels: tt.selectors,
+								},
+								Type:           corev1.PodReady,
+								Status:         corev1.ConditionFalse,
+								TimeoutSeconds: ptr.To[int32](300),
+							},
+						},
+					},
+				},
+			}
+			webhook := &MachineHealthCheck{}
+
+			warnings, err := webhook.ValidateCreate(ctx, mhc)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
 func TestMachineHealthCheckClusterNameImmutable(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -19,6 +19,7 @@ package admission
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -26,6 +27,8 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	v1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -100,6 +103,16 @@ func (webhook *MachinePool) Default(ctx context.Context, m *clusterv1.MachinePoo
 		m.Spec.Template.Spec.Version = normalizedVersion
 	}
 
+	// Default RollingUpdate strategy only if strategy type is RollingUpdate.
+	if m.Spec.Rollout.Strategy.Type == clusterv1.RollingUpdateMachinePoolRolloutStrategyType {
+		if m.Spec.Rollout.Strategy.RollingUpdate.MaxSurge == nil {
+			m.Spec.Rollout.Strategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(1))
+		}
+		if m.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable == nil {
+			m.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(0))
+		}
+	}
+
 	return nil
 }
 
@@ -175,6 +188,24 @@ func (webhook *MachinePool) validate(oldObj, newObj *clusterv1.MachinePool) erro
 		}
 	}
 
+	if newObj.Spec.Rollout.Strategy.Type != clusterv1.RollingUpdateMachinePoolRolloutStrategyType &&
+		!reflect.DeepEqual(newObj.Spec.Rollout.Strategy.RollingUpdate, clusterv1.MachinePoolRolloutStrategyRollingUpdate{}) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				specPath.Child("rollout", "strategy", "rollingUpdate"),
+				fmt.Sprintf("rollingUpdate can only be set when using the %s rollout strategy", clusterv1.RollingUpdateMachinePoolRolloutStrategyType),
+			),
+		)
+	}
+	allErrs = append(allErrs, validateRolloutStrategy(specPath.Child("rollout", "strategy"), newObj.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable, newObj.Spec.Rollout.Strategy.RollingUpdate.MaxSurge)...)
+
+	var oldAnnotations map[string]string
+	if oldObj != nil {
+		oldAnnotations = oldObj.Annotations
+	}
+	allErrs = append(allErrs, validateAutoscalerCapacityAnnotations(oldAnnotations, newObj.Annotations, field.NewPath("metadata", "annotations"))...)
+
 	// Validate the metadata of the MachinePool template.
 	allErrs = append(allErrs, newObj.Spec.Template.Validate(specPath.Child("template", "metadata"))...)
 
@@ -184,6 +215,39 @@ func (webhook *MachinePool) validate(oldObj, newObj *clusterv1.MachinePool) erro
 	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachinePool").GroupKind(), newObj.Name, allErrs)
 }
 
+// validateAutoscalerCapacityAnnotations validates the autoscaler capacity annotations used to scale from zero.
+// Only annotations which have been added or changed compared to oldAnnotations are validated.
+func validateAutoscalerCapacityAnnotations(oldAnnotations, newAnnotations map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, annotation := range []string{
+		clusterv1.AutoscalerCapacityCPUAnnotation,
+		clusterv1.AutoscalerCapacityMemoryAnnotation,
+		clusterv1.AutoscalerCapacityEphemeralDiskAnnotation,
+		clusterv1.AutoscalerCapacityMaxPodsAnnotation,
+		clusterv1.AutoscalerCapacityGPUCountAnnotation,
+	} {
+		value, ok := newAnnotations[annotation]
+		if !ok {
+			continue
+		}
+		if oldValue, ok := oldAnnotations[annotation]; ok && oldValue == value {
+			continue
+		}
+
+		switch annotation {
+		case clusterv1.AutoscalerCapacityMaxPodsAnnotation, clusterv1.AutoscalerCapacityGPUCountAnnotation:
+			if i, err := strconv.ParseInt(value, 10, 32); err != nil || i < 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(annotation), value, "must be a non-negative integer"))
+			}
+		default:
+			if q, err := resource.ParseQuantity(value); err != nil || q.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(annotation), value, "must be a non-negative quantity"))
+			}
+		}
+	}
+	return allErrs
+}
+
 func calculateMachinePoolReplicas(ctx context.Context, oldMP *clusterv1.MachinePool, newMP *clusterv1.MachinePool, dryRun bool) (int32, error) {
 	// If replicas is already set => Keep the current value.
 	if newMP.Spec.Replicas != nil {
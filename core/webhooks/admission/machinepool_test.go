@@ -23,6 +23,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -59,6 +60,33 @@ func TestMachinePoolDefault(t *testing.T) {
 	g.Expect(*mp.Spec.Template.Spec.Deletion.NodeDeletionTimeoutSeconds).To(Equal(defaultNodeDeletionTimeoutSeconds))
 }
 
+func TestMachinePoolDefaultRolloutStrategy(t *testing.T) {
+	g := NewWithT(t)
+
+	mp := &clusterv1.MachinePool{
+		Spec: clusterv1.MachinePoolSpec{
+			Rollout: clusterv1.MachinePoolRolloutSpec{
+				Strategy: clusterv1.MachinePoolRolloutStrategy{
+					Type: clusterv1.RollingUpdateMachinePoolRolloutStrategyType,
+				},
+			},
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{ConfigRef: clusterv1.ContractVersionedObjectReference{
+						Name: "bootstrap",
+					}},
+				},
+			},
+		},
+	}
+	webhook := &MachinePool{}
+	ctx := admission.NewContextWithRequest(ctx, admission.Request{})
+	g.Expect(webhook.Default(ctx, mp)).To(Succeed())
+
+	g.Expect(mp.Spec.Rollout.Strategy.RollingUpdate.MaxSurge).To(Equal(ptr.To(intstr.FromInt32(1))))
+	g.Expect(mp.Spec.Rollout.Strategy.RollingUpdate.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(0))))
+}
+
 func TestCalculateMachinePoolReplicas(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -464,6 +492,174 @@ func TestMachinePoolVersionValidation(t *testing.T) {
 	}
 }
 
+func TestMachinePoolRolloutStrategyValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  clusterv1.MachinePoolRolloutStrategy
+		expectErr bool
+	}{
+		{
+			name:      "should succeed without a rollout strategy",
+			strategy:  clusterv1.MachinePoolRolloutStrategy{},
+			expectErr: false,
+		},
+		{
+			name: "should succeed with the Provider rollout strategy",
+			strategy: clusterv1.MachinePoolRolloutStrategy{
+				Type: clusterv1.ProviderMachinePoolRolloutStrategyType,
+			},
+			expectErr: false,
+		},
+		{
+			name: "should succeed with a valid RollingUpdate rollout strategy",
+			strategy: clusterv1.MachinePoolRolloutStrategy{
+				Type: clusterv1.RollingUpdateMachinePoolRolloutStrategyType,
+				RollingUpdate: clusterv1.MachinePoolRolloutStrategyRollingUpdate{
+					MaxUnavailable: ptr.To(intstr.FromString("10%")),
+					MaxSurge:       ptr.To(intstr.FromInt32(2)),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error if rollingUpdate is set with the Provider rollout strategy",
+			strategy: clusterv1.MachinePoolRolloutStrategy{
+				Type: clusterv1.ProviderMachinePoolRolloutStrategyType,
+				RollingUpdate: clusterv1.MachinePoolRolloutStrategyRollingUpdate{
+					MaxSurge: ptr.To(intstr.FromInt32(1)),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error if maxUnavailable and maxSurge are both 0",
+			strategy: clusterv1.MachinePoolRolloutStrategy{
+				Type: clusterv1.RollingUpdateMachinePoolRolloutStrategyType,
+				RollingUpdate: clusterv1.MachinePoolRolloutStrategyRollingUpdate{
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					MaxSurge:       ptr.To(intstr.FromInt32(0)),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error if maxSurge is not an int or a percentage",
+			strategy: clusterv1.MachinePoolRolloutStrategy{
+				Type: clusterv1.RollingUpdateMachinePoolRolloutStrategyType,
+				RollingUpdate: clusterv1.MachinePoolRolloutStrategyRollingUpdate{
+					MaxSurge: ptr.To(intstr.FromString("foo")),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mp := &clusterv1.MachinePool{
+				Spec: clusterv1.MachinePoolSpec{
+					Rollout: clusterv1.MachinePoolRolloutSpec{
+						Strategy: tt.strategy,
+					},
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{ConfigRef: clusterv1.ContractVersionedObjectReference{
+								Name: "bootstrap",
+							}},
+						},
+					},
+				},
+			}
+			webhook := &MachinePool{}
+
+			warnings, err := webhook.ValidateCreate(ctx, mp)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestMachinePoolAutoscalerCapacityAnnotationsValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldAnnotations map[string]string
+		annotations    map[string]string
+		expectErr      bool
+	}{
+		{
+			name: "should succeed with valid capacity annotations",
+			annotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation:           "2",
+				clusterv1.AutoscalerCapacityMemoryAnnotation:        "4Gi",
+				clusterv1.AutoscalerCapacityEphemeralDiskAnnotation: "100Gi",
+				clusterv1.AutoscalerCapacityMaxPodsAnnotation:       "110",
+				clusterv1.AutoscalerCapacityGPUTypeAnnotation:       "nvidia.com/gpu",
+				clusterv1.AutoscalerCapacityGPUCountAnnotation:      "1",
+			},
+			expectErr: false,
+		},
+		{
+			name:        "should return error if the memory capacity is not a quantity",
+			annotations: map[string]string{clusterv1.AutoscalerCapacityMemoryAnnotation: "4 gigabytes"},
+			expectErr:   true,
+		},
+		{
+			name:        "should return error if the CPU capacity is negative",
+			annotations: map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "-1"},
+			expectErr:   true,
+		},
+		{
+			name:        "should return error if the GPU count is not an integer",
+			annotations: map[string]string{clusterv1.AutoscalerCapacityGPUCountAnnotation: "1.5"},
+			expectErr:   true,
+		},
+		{
+			name:           "should succeed if an invalid annotation is not changed",
+			oldAnnotations: map[string]string{clusterv1.AutoscalerCapacityMaxPodsAnnotation: "many"},
+			annotations:    map[string]string{clusterv1.AutoscalerCapacityMaxPodsAnnotation: "many"},
+			expectErr:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			newMP := &clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tt.annotations,
+				},
+				Spec: clusterv1.MachinePoolSpec{
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{ConfigRef: clusterv1.ContractVersionedObjectReference{
+								Name: "bootstrap",
+							}},
+						},
+					},
+				},
+			}
+			oldMP := newMP.DeepCopy()
+			oldMP.Annotations = tt.oldAnnotations
+			webhook := &MachinePool{}
+
+			warnings, err := webhook.ValidateUpdate(ctx, oldMP, newMP)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
 func TestMachinePoolMetadataValidation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -223,6 +223,7 @@ func (webhook *MachineSet) validate(oldMS, newMS *clusterv1.MachineSet) error {
 	allErrs = append(allErrs, validateMachineTaintsForWorkers(newMS.Spec.Template.Spec.Taints, nil, specPath.Child("template", "spec", "taints"))...)
 
 	allErrs = append(allErrs, validateMSMachineNaming(newMS.Spec.MachineNaming, specPath.Child("machineNaming"))...)
+	allErrs = append(allErrs, validateMachinePlacement(newMS.Spec.Placement, newMS.Spec.Template.Spec.FailureDomain, specPath.Child("placement"))...)
 
 	// Validate the metadata of the template.
 	allErrs = append(allErrs, newMS.Spec.Template.Validate(specPath.Child("template", "metadata"))...)
@@ -269,6 +270,22 @@ func validateMSMachineNaming(machineNaming clusterv1.MachineNamingSpec, pathPref
 	return allErrs
 }
 
+// validateMachinePlacement validates the placement of a MachineSet or a MachineDeployment; failureDomain is the
+// failure domain defined in the machine template.
+func validateMachinePlacement(placement clusterv1.MachinePlacementSpec, failureDomain string, pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(placement.FailureDomains) > 0 && failureDomain != "" {
+		allErrs = append(allErrs,
+			field.Forbidden(
+				pathPrefix.Child("failureDomains"),
+				"cannot be set when spec.template.spec.failureDomain is set",
+			))
+	}
+
+	return allErrs
+}
+
 func validateSkippedMachineSetPreflightChecks(o client.Object) *field.Error {
 	if o == nil {
 		return nil
@@ -284,6 +301,7 @@ func validateSkippedMachineSetPreflightChecks(o client.Object) *field.Error {
 		clusterv1.MachineSetPreflightCheckKubernetesVersionSkew,
 		clusterv1.MachineSetPreflightCheckControlPlaneIsStable,
 		clusterv1.MachineSetPreflightCheckControlPlaneVersionSkew,
+		clusterv1.MachineSetPreflightCheckCanCreateMachine,
 	)
 
 	skippedList := strings.Split(skip, ",")
@@ -747,6 +747,73 @@ func TestMachineSetMachineNamingValidation(t *testing.T) {
 	}
 }
 
+func TestMachineSetPlacementValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		placement     clusterv1.MachinePlacementSpec
+		failureDomain string
+		expectErr     bool
+	}{
+		{
+			name:          "should not return error when only spec.template.spec.failureDomain is set",
+			failureDomain: "fd1",
+			expectErr:     false,
+		},
+		{
+			name: "should not return error when only placement failureDomains are set",
+			placement: clusterv1.MachinePlacementSpec{
+				FailureDomains: []string{"fd1", "fd2"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when both placement failureDomains and spec.template.spec.failureDomain are set",
+			placement: clusterv1.MachinePlacementSpec{
+				FailureDomains: []string{"fd1", "fd2"},
+			},
+			failureDomain: "fd1",
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ms := &clusterv1.MachineSet{
+				Spec: clusterv1.MachineSetSpec{
+					Placement: tt.placement,
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("data-secret"),
+							},
+							FailureDomain: tt.failureDomain,
+						},
+					},
+				},
+			}
+
+			webhook := &MachineSet{}
+
+			if tt.expectErr {
+				warnings, err := webhook.ValidateCreate(ctx, ms)
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				warnings, err = webhook.ValidateUpdate(ctx, ms, ms)
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+			} else {
+				warnings, err := webhook.ValidateCreate(ctx, ms)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				warnings, err = webhook.ValidateUpdate(ctx, ms, ms)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestMachineSetTaintValidation(t *testing.T) {
 	ms := builder.MachineSet("default", "machineset1").
 		WithBootstrapTemplate(builder.BootstrapTemplate("default", "bootstrap-template").Build())
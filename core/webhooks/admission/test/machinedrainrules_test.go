@@ -251,3 +251,83 @@ func Test_validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateClusterMachineDrainRule(t *testing.T) {
+	tests := []struct {
+		name                    string
+		clusterMachineDrainRule *clusterv1.ClusterMachineDrainRule
+		wantErr                 string
+	}{
+		{
+			name: "Return no error if ClusterMachineDrainRule is valid",
+			clusterMachineDrainRule: &clusterv1.ClusterMachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cmdr",
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+					},
+					Pods: []clusterv1.MachineDrainRulePodSelector{
+						{
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"org.example.com/never-evict": "true",
+								},
+							},
+						},
+					},
+					Precedence: ptr.To[int32](1000),
+				},
+			},
+		},
+		{
+			name: "Return error if order is set with drain behavior Skip",
+			clusterMachineDrainRule: &clusterv1.ClusterMachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cmdr",
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+						Order:    ptr.To[int32](5),
+					},
+				},
+			},
+			wantErr: "admission webhook \"validation.clustermachinedrainrule.cluster.x-k8s.io\" denied the request: " +
+				"ClusterMachineDrainRule.cluster.x-k8s.io \"cmdr\" is invalid: " +
+				"spec.drain.order: Invalid value: 5: order must not be set if drain behavior is \"Skip\" or \"WaitCompleted\"",
+		},
+		{
+			name: "Return error if precedence is out of range",
+			clusterMachineDrainRule: &clusterv1.ClusterMachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cmdr",
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior: clusterv1.MachineDrainRuleDrainBehaviorSkip,
+					},
+					Precedence: ptr.To[int32](1001),
+				},
+			},
+			wantErr: "spec.precedence in body should be less than or equal to 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := env.CreateAndWait(ctx, tt.clusterMachineDrainRule)
+
+			if tt.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(env.CleanupAndWait(ctx, tt.clusterMachineDrainRule)).To(Succeed())
+			}
+		})
+	}
+}
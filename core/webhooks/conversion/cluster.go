@@ -60,6 +60,33 @@ func ConvertClusterV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Cluster,
 		return err
 	}
 
+	// Recover other values.
+	if ok && dst.Spec.Topology.IsDefined() {
+		dst.Spec.Topology.ClassRef.Channel = restored.Spec.Topology.ClassRef.Channel
+		dst.Spec.Topology.ClassRef.OCI = restored.Spec.Topology.ClassRef.OCI
+		dst.Spec.Topology.Workers.Upgrade = restored.Spec.Topology.Workers.Upgrade
+		dst.Spec.Topology.ControlPlane.HealthCheck.OverrideStrategy = restored.Spec.Topology.ControlPlane.HealthCheck.OverrideStrategy
+		dst.Spec.Topology.ControlPlane.ExternallyManaged = restored.Spec.Topology.ControlPlane.ExternallyManaged
+		for i, md := range dst.Spec.Topology.Workers.MachineDeployments {
+			for _, restoredMD := range restored.Spec.Topology.Workers.MachineDeployments {
+				if restoredMD.Name == md.Name {
+					dst.Spec.Topology.Workers.MachineDeployments[i].UpgradeGroup = restoredMD.UpgradeGroup
+					dst.Spec.Topology.Workers.MachineDeployments[i].HealthCheck.OverrideStrategy = restoredMD.HealthCheck.OverrideStrategy
+					dst.Spec.Topology.Workers.MachineDeployments[i].Autoscaling = restoredMD.Autoscaling
+					break
+				}
+			}
+		}
+		for i, mp := range dst.Spec.Topology.Workers.MachinePools {
+			for _, restoredMP := range restored.Spec.Topology.Workers.MachinePools {
+				if restoredMP.Name == mp.Name {
+					dst.Spec.Topology.Workers.MachinePools[i].Autoscaling = restoredMP.Autoscaling
+					break
+				}
+			}
+		}
+	}
+
 	// Recover intent for bool values converted to *bool.
 	clusterv1.Convert_bool_To_Pointer_bool(src.Spec.Paused, ok, restored.Spec.Paused, &dst.Spec.Paused)
 
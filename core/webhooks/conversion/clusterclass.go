@@ -44,6 +44,12 @@ func ConvertClusterClassV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Clus
 		return err
 	}
 
+	// Recover other values.
+	if ok {
+		dst.Status.DiscoveredVariables = restored.Status.DiscoveredVariables
+		dst.Spec.Release = restored.Spec.Release
+	}
+
 	// Recover intent for bool values converted to *bool.
 	for i, patch := range dst.Spec.Patches {
 		for j, definition := range patch.Definitions {
@@ -90,6 +96,7 @@ func ConvertClusterClassV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Clus
 		for _, v := range restored.Spec.Variables {
 			if v.Name == variable.Name {
 				restoredVariableOpenAPIV3Schema = &v.Schema.OpenAPIV3Schema
+				variable.Migration = v.Migration
 				break
 			}
 		}
@@ -165,6 +172,15 @@ func restoreBoolIntentJSONSchemaProps(src *clusterv1beta1.JSONSchemaProps, dst *
 	clusterv1.Convert_bool_To_Pointer_bool(src.XPreserveUnknownFields, hasRestored, restoreXPreserveUnknownFields, &dst.XPreserveUnknownFields)
 	clusterv1.Convert_bool_To_Pointer_bool(src.XIntOrString, hasRestored, restoredXIntOrString, &dst.XIntOrString)
 
+	// Restore optionalOldSelf, which does not exist in v1beta1, if the validation rules have not been changed.
+	if restored != nil && len(restored.XValidations) == len(dst.XValidations) {
+		for i := range dst.XValidations {
+			if restored.XValidations[i].Rule == dst.XValidations[i].Rule {
+				dst.XValidations[i].OptionalOldSelf = restored.XValidations[i].OptionalOldSelf
+			}
+		}
+	}
+
 	for name, property := range dst.Properties {
 		srcProperty, ok := src.Properties[name]
 		if !ok {
@@ -23,6 +23,7 @@ import (
 
 	addonsv1beta1 "sigs.k8s.io/cluster-api/api/addons/v1beta1"
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 // ClusterResourceSetBinding is a HubSpokeConverter for the ClusterResourceSetBinding API type.
@@ -32,10 +33,41 @@ var ClusterResourceSetBinding = conversion.NewHubSpokeConverter(&addonsv1.Cluste
 
 // ConvertClusterResourceSetBindingV1Beta1ToHub converts a v1beta1 ClusterResourceSetBinding to a hub ClusterResourceSetBinding.
 func ConvertClusterResourceSetBindingV1Beta1ToHub(_ context.Context, src *addonsv1beta1.ClusterResourceSetBinding, dst *addonsv1.ClusterResourceSetBinding) error {
-	return addonsv1beta1.Convert_v1beta1_ClusterResourceSetBinding_To_v1beta2_ClusterResourceSetBinding(src, dst, nil)
+	if err := addonsv1beta1.Convert_v1beta1_ClusterResourceSetBinding_To_v1beta2_ClusterResourceSetBinding(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &addonsv1.ClusterResourceSetBinding{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		for i := range dst.Spec.Bindings {
+			for _, restoredBinding := range restored.Spec.Bindings {
+				if restoredBinding.ClusterResourceSetName != dst.Spec.Bindings[i].ClusterResourceSetName {
+					continue
+				}
+				for j := range dst.Spec.Bindings[i].Resources {
+					if restoredResource := restoredBinding.GetResource(dst.Spec.Bindings[i].Resources[j].ResourceRef); restoredResource != nil {
+						dst.Spec.Bindings[i].Resources[j].LastApplyError = restoredResource.LastApplyError
+					}
+				}
+				break
+			}
+		}
+	}
+
+	return nil
 }
 
 // ConvertClusterResourceSetBindingHubToV1Beta1 converts a hub ClusterResourceSetBinding to a v1beta1 ClusterResourceSetBinding.
 func ConvertClusterResourceSetBindingHubToV1Beta1(_ context.Context, src *addonsv1.ClusterResourceSetBinding, dst *addonsv1beta1.ClusterResourceSetBinding) error {
-	return addonsv1beta1.Convert_v1beta2_ClusterResourceSetBinding_To_v1beta1_ClusterResourceSetBinding(src, dst, nil)
+	if err := addonsv1beta1.Convert_v1beta2_ClusterResourceSetBinding_To_v1beta1_ClusterResourceSetBinding(src, dst, nil); err != nil {
+		return err
+	}
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
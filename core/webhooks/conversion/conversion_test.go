@@ -384,6 +384,10 @@ func hubClusterClassVariable(in *clusterv1.ClusterClassVariable, c randfill.Cont
 	if in.Required == nil {
 		in.Required = ptr.To(false) // Required is a required field and nil does not round trip
 	}
+	if in.Migration.PreviousDefault != nil {
+		// Not every random byte array is valid JSON, so we're setting a valid value.
+		in.Migration.PreviousDefault = &apiextensionsv1.JSON{Raw: []byte(strconv.FormatBool(c.Bool()))}
+	}
 }
 
 func hubClusterClassStatusVariableDefinition(in *clusterv1.ClusterClassStatusVariableDefinition, c randfill.Continue) {
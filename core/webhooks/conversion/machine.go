@@ -68,6 +68,10 @@ func ConvertMachineV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Machine,
 		// field should be the Machine controller.
 		dst.Status.Phase = restored.Status.Phase
 		dst.Status.FailureDomain = restored.Status.FailureDomain
+		dst.Status.NodeCapabilities = restored.Status.NodeCapabilities
+		dst.Status.Diagnostics = restored.Status.Diagnostics
+		dst.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = restored.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
+		dst.Spec.PlacementHints = restored.Spec.PlacementHints
 	}
 
 	return nil
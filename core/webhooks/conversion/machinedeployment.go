@@ -52,6 +52,19 @@ func ConvertMachineDeploymentV1Beta1ToHub(_ context.Context, src *clusterv1beta1
 	// Recover intent for bool values converted to *bool.
 	clusterv1.Convert_bool_To_Pointer_bool(src.Spec.Paused, ok, restored.Spec.Paused, &dst.Spec.Paused)
 
+	// Recover other values.
+	if ok {
+		dst.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
+		dst.Spec.Template.Spec.PlacementHints = restored.Spec.Template.Spec.PlacementHints
+		dst.Spec.Rollout.Canary = restored.Spec.Rollout.Canary
+		dst.Spec.Placement = restored.Spec.Placement
+		dst.Status.FailureDomains = restored.Status.FailureDomains
+		dst.Status.Rollout = restored.Status.Rollout
+		dst.Status.NodeCapabilities = restored.Status.NodeCapabilities
+		dst.Status.Capacity = restored.Status.Capacity
+		dst.Status.Autoscaling = restored.Status.Autoscaling
+	}
+
 	return nil
 }
 
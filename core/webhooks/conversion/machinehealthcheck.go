@@ -48,6 +48,16 @@ func ConvertMachineHealthCheckV1Beta1ToHub(_ context.Context, src *clusterv1beta
 	clusterv1.Convert_int32_To_Pointer_int32(src.Status.CurrentHealthy, ok, restored.Status.CurrentHealthy, &dst.Status.CurrentHealthy)
 	clusterv1.Convert_int32_To_Pointer_int32(src.Status.RemediationsAllowed, ok, restored.Status.RemediationsAllowed, &dst.Status.RemediationsAllowed)
 
+	// Recover other values.
+	if ok {
+		dst.Spec.Checks.UnhealthyPodConditions = restored.Spec.Checks.UnhealthyPodConditions
+		dst.Spec.Checks.NodeStartupTimeoutOverrides = restored.Spec.Checks.NodeStartupTimeoutOverrides
+		dst.Spec.Checks.NodeStartupTaints = restored.Spec.Checks.NodeStartupTaints
+		dst.Spec.Remediation.TriggerIf.PercentageOf = restored.Spec.Remediation.TriggerIf.PercentageOf
+		dst.Spec.Remediation.MaxExternalRemediationFailures = restored.Spec.Remediation.MaxExternalRemediationFailures
+		dst.Status.DesiredMachines = restored.Status.DesiredMachines
+	}
+
 	return nil
 }
 
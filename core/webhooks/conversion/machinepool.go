@@ -60,6 +60,15 @@ func ConvertMachinePoolV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Machi
 		dst.Status.Initialization = initialization
 	}
 
+	// Recover other values.
+	if ok {
+		dst.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
+		dst.Spec.Template.Spec.PlacementHints = restored.Spec.Template.Spec.PlacementHints
+		dst.Spec.Rollout = restored.Spec.Rollout
+		dst.Status.Resources = restored.Status.Resources
+		dst.Status.Autoscaling = restored.Status.Autoscaling
+	}
+
 	return nil
 }
 
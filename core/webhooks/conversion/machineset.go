@@ -24,6 +24,7 @@ import (
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 // MachineSet is a HubSpokeConverter for the MachineSet API type.
@@ -47,6 +48,22 @@ func ConvertMachineSetV1Beta1ToHub(_ context.Context, src *clusterv1beta1.Machin
 		dst.Spec.Template.Spec.MinReadySeconds = &src.Spec.MinReadySeconds
 	}
 
+	restored := &clusterv1.MachineSet{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	// Recover other values.
+	if ok {
+		dst.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = restored.Spec.Template.Spec.Deletion.NodeVolumeForceDetachTimeoutSeconds
+		dst.Spec.Template.Spec.PlacementHints = restored.Spec.Template.Spec.PlacementHints
+		dst.Spec.Placement = restored.Spec.Placement
+		dst.Status.FailureDomains = restored.Status.FailureDomains
+		dst.Status.NodeCapabilities = restored.Status.NodeCapabilities
+		dst.Status.Capacity = restored.Status.Capacity
+	}
+
 	return nil
 }
 
@@ -64,5 +81,5 @@ func ConvertMachineSetHubToV1Beta1(ctx context.Context, src *clusterv1.MachineSe
 
 	dropEmptyStringsMachineSpec(&dst.Spec.Template.Spec)
 
-	return nil
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
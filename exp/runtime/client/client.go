@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
@@ -95,3 +96,16 @@ type Client interface {
 	// CallExtension calls the ExtensionHandler with the given name.
 	CallExtension(ctx context.Context, hook runtimecatalog.Hook, forObject client.Object, name string, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject, opts ...CallExtensionOption) error
 }
+
+// DegradedExtensionsGetter is implemented by runtime clients which temporarily bypass ExtensionHandlers
+// which are failing repeatedly.
+// Note: This is not part of the Client interface, so existing implementations of Client are not required to implement it.
+type DegradedExtensionsGetter interface {
+	// GetDegradedExtensions gets the ExtensionHandlers of the ExtensionConfig which are temporarily bypassed
+	// because they are failing repeatedly.
+	GetDegradedExtensions(extensionConfigName string) []string
+
+	// DegradedExtensionsEvents returns a channel receiving a GenericEvent for an ExtensionConfig when
+	// the ExtensionHandlers of the ExtensionConfig which are temporarily bypassed change.
+	DegradedExtensionsEvents() <-chan event.GenericEvent
+}
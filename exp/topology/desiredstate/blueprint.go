@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+)
+
+// GetBlueprint gets a ClusterBlueprint with the ClusterClass and the referenced templates to be used for a managed Cluster topology.
+// It also converts and patches all ObjectReferences in ClusterClass and ControlPlane to the latest apiVersion of the current contract.
+// NOTE: This function assumes that cluster.Spec.Topology.Class is set.
+func GetBlueprint(ctx context.Context, c client.Reader, cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) (_ *scope.ClusterBlueprint, reterr error) {
+	blueprint := &scope.ClusterBlueprint{
+		Topology:           cluster.Spec.Topology,
+		ClusterClass:       clusterClass,
+		MachineDeployments: map[string]*scope.MachineDeploymentBlueprint{},
+		MachinePools:       map[string]*scope.MachinePoolBlueprint{},
+	}
+
+	var err error
+	// Get ClusterClass.spec.infrastructure.
+	blueprint.InfrastructureClusterTemplate, err = getReference(ctx, c, blueprint.ClusterClass.Spec.Infrastructure.TemplateRef.ToObjectReference(clusterClass.Namespace))
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get infrastructure cluster template for ClusterClass %s", klog.KObj(blueprint.ClusterClass))
+	}
+
+	// Get ClusterClass.spec.controlPlane.
+	blueprint.ControlPlane = &scope.ControlPlaneBlueprint{}
+	blueprint.ControlPlane.Template, err = getReference(ctx, c, blueprint.ClusterClass.Spec.ControlPlane.TemplateRef.ToObjectReference(clusterClass.Namespace))
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get control plane template for ClusterClass %s", klog.KObj(blueprint.ClusterClass))
+	}
+
+	// If the clusterClass mandates the controlPlane has infrastructureMachines, read it.
+	if blueprint.HasControlPlaneInfrastructureMachine() {
+		blueprint.ControlPlane.InfrastructureMachineTemplate, err = getReference(ctx, c, blueprint.ClusterClass.Spec.ControlPlane.MachineInfrastructure.TemplateRef.ToObjectReference(clusterClass.Namespace))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get control plane's machine template for ClusterClass %s", klog.KObj(blueprint.ClusterClass))
+		}
+	}
+
+	// If the clusterClass defines a valid MachineHealthCheck (including a defined MachineInfrastructure) set the blueprint MachineHealthCheck.
+	if blueprint.HasControlPlaneMachineHealthCheck() {
+		blueprint.ControlPlane.HealthCheck = blueprint.ClusterClass.Spec.ControlPlane.HealthCheck
+	}
+
+	// Loop over the machine deployments classes in ClusterClass
+	// and fetch the related templates.
+	for _, machineDeploymentClass := range blueprint.ClusterClass.Spec.Workers.MachineDeployments {
+		machineDeploymentBlueprint := &scope.MachineDeploymentBlueprint{}
+
+		// Make sure to copy the metadata from the blueprint, which is later layered
+		// with the additional metadata defined in the Cluster's topology section
+		// for the MachineDeployment that is created or updated.
+		machineDeploymentClass.Metadata.DeepCopyInto(&machineDeploymentBlueprint.Metadata)
+
+		// Get the infrastructure machine template.
+		machineDeploymentBlueprint.InfrastructureMachineTemplate, err = getReference(ctx, c, machineDeploymentClass.Infrastructure.TemplateRef.ToObjectReference(clusterClass.Namespace))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get infrastructure machine template for ClusterClass %s, MachineDeployment class %q", klog.KObj(blueprint.ClusterClass), machineDeploymentClass.Class)
+		}
+
+		// Get the bootstrap config template.
+		machineDeploymentBlueprint.BootstrapTemplate, err = getReference(ctx, c, machineDeploymentClass.Bootstrap.TemplateRef.ToObjectReference(clusterClass.Namespace))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get bootstrap config template for ClusterClass %s, MachineDeployment class %q", klog.KObj(blueprint.ClusterClass), machineDeploymentClass.Class)
+		}
+
+		machineDeploymentBlueprint.HealthCheck = machineDeploymentClass.HealthCheck
+		blueprint.MachineDeployments[machineDeploymentClass.Class] = machineDeploymentBlueprint
+	}
+
+	// Loop over the machine pool classes in ClusterClass
+	// and fetch the related templates.
+	for _, machinePoolClass := range blueprint.ClusterClass.Spec.Workers.MachinePools {
+		machinePoolBlueprint := &scope.MachinePoolBlueprint{}
+
+		// Make sure to copy the metadata from the blueprint, which is later layered
+		// with the additional metadata defined in the Cluster's topology section
+		// for the MachinePool that is created or updated.
+		machinePoolClass.Metadata.DeepCopyInto(&machinePoolBlueprint.Metadata)
+
+		// Get the InfrastructureMachinePoolTemplate.
+		machinePoolBlueprint.InfrastructureMachinePoolTemplate, err = getReference(ctx, c, machinePoolClass.Infrastructure.TemplateRef.ToObjectReference(clusterClass.Namespace))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get InfrastructureMachinePoolTemplate for ClusterClass %s, MachinePool class %q", klog.KObj(blueprint.ClusterClass), machinePoolClass.Class)
+		}
+
+		// Get the bootstrap config template.
+		machinePoolBlueprint.BootstrapTemplate, err = getReference(ctx, c, machinePoolClass.Bootstrap.TemplateRef.ToObjectReference(clusterClass.Namespace))
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to get bootstrap config for ClusterClass %s, MachinePool class %q", klog.KObj(blueprint.ClusterClass), machinePoolClass.Class)
+		}
+
+		blueprint.MachinePools[machinePoolClass.Class] = machinePoolBlueprint
+	}
+
+	return blueprint, nil
+}
+
+// getReference gets the object referenced in ref.
+func getReference(ctx context.Context, c client.Reader, ref *corev1.ObjectReference) (*unstructured.Unstructured, error) {
+	if ref == nil {
+		return nil, pkgerrors.New("reference is not set")
+	}
+
+	obj, err := external.Get(ctx, c, ref)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to retrieve %s %s", ref.Kind, klog.KRef(ref.Namespace, ref.Name))
+	}
+	return obj, nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"strconv"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
@@ -51,7 +52,9 @@ import (
 	"sigs.k8s.io/cluster-api/internal/topology/ownerrefs"
 	"sigs.k8s.io/cluster-api/internal/topology/selectors"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	conversionutil "sigs.k8s.io/cluster-api/util/conversion"
 )
 
@@ -179,8 +182,15 @@ func (g *generator) Generate(ctx context.Context, s *scope.Scope) (*scope.Cluste
 
 	// Compute the desired state of the ControlPlane object, eventually adding a reference to the
 	// InfrastructureMachineTemplate generated by the previous step.
-	if desiredState.ControlPlane.Object, err = g.computeControlPlane(ctx, s, desiredState.ControlPlane.InfrastructureMachineTemplate); err != nil {
-		return nil, pkgerrors.Wrapf(err, "failed to compute ControlPlane")
+	// NOTE: If the ControlPlane is externally managed, the desired state is the current state of the ControlPlane.
+	if s.Blueprint.IsControlPlaneExternallyManaged() {
+		if desiredState.ControlPlane.Object, err = g.computeExternallyManagedControlPlane(ctx, s); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute ControlPlane")
+		}
+	} else {
+		if desiredState.ControlPlane.Object, err = g.computeControlPlane(ctx, s, desiredState.ControlPlane.InfrastructureMachineTemplate); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute ControlPlane")
+		}
 	}
 
 	// Compute the desired state of the ControlPlane MachineHealthCheck if defined.
@@ -530,6 +540,20 @@ func (g *generator) computeControlPlane(ctx context.Context, s *scope.Scope, inf
 	return controlPlane, nil
 }
 
+// computeExternallyManagedControlPlane computes the desired state for an externally managed ControlPlane object.
+// The topology controller does not create or update externally managed ControlPlanes, so the desired state is
+// a copy of the current state; the version of the ControlPlane is computed only to track the upgrade status.
+func (g *generator) computeExternallyManagedControlPlane(ctx context.Context, s *scope.Scope) (*unstructured.Unstructured, error) {
+	if s.Current.ControlPlane == nil || s.Current.ControlPlane.Object == nil {
+		return nil, pkgerrors.New("spec.controlPlaneRef must be set when spec.topology.controlPlane.externallyManaged is true")
+	}
+
+	if _, err := g.computeControlPlaneVersion(ctx, s); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to compute version of ControlPlane")
+	}
+	return s.Current.ControlPlane.Object.DeepCopy(), nil
+}
+
 // computeControlPlaneVersion calculates the version of the desired control plane.
 // The version is calculated using the state of the current machine deployments, the current control plane
 // and the version defined in the topology.
@@ -585,6 +609,17 @@ func (g *generator) computeControlPlaneVersion(ctx context.Context, s *scope.Sco
 		return *currentVersion, nil
 	}
 
+	// If the control plane is externally managed, its version is read-only and it is expected to be upgraded out-of-band;
+	// workers are allowed to upgrade to the current version of the control plane.
+	// NOTE: Lifecycle hooks for upgrades are not called, because the topology controller does not drive the control plane upgrade.
+	if s.Blueprint.IsControlPlaneExternallyManaged() {
+		if len(s.UpgradeTracker.MachineDeployments.UpgradePlan) > 0 && s.UpgradeTracker.MachineDeployments.UpgradePlan[0] == *currentVersion ||
+			len(s.UpgradeTracker.MachinePools.UpgradePlan) > 0 && s.UpgradeTracker.MachinePools.UpgradePlan[0] == *currentVersion {
+			s.UpgradeTracker.ControlPlane.IsWaitingForWorkersUpgrade = true
+		}
+		return *currentVersion, nil
+	}
+
 	// if the control plane is not upgrading, before making further considerations about if to pick up another version,
 	// we should call the AfterControlPlaneUpgrade and the BeforeWorkersUpgrade hooks if not already done.
 	if feature.Gates.Enabled(feature.RuntimeSDK) {
@@ -1029,7 +1064,8 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 	// Ensure the annotations used to control the upgrade sequence are never propagated.
 	delete(machineDeploymentAnnotations, clusterv1.ClusterTopologyHoldUpgradeSequenceAnnotation)
 	delete(machineDeploymentAnnotations, clusterv1.ClusterTopologyDeferUpgradeAnnotation)
-	desiredMachineDeploymentObj.SetAnnotations(machineDeploymentAnnotations)
+	// Note: the cluster autoscaler annotations are only set on the MachineDeployment, not on its template.
+	desiredMachineDeploymentObj.SetAnnotations(util.MergeMap(computeAutoscalingAnnotations(machineDeploymentTopology.Autoscaling), machineDeploymentAnnotations))
 	desiredMachineDeploymentObj.Spec.Template.Annotations = machineDeploymentAnnotations
 
 	// Apply Labels
@@ -1125,6 +1161,7 @@ func (g *generator) computeMachineDeploymentVersion(ctx context.Context, s *scop
 	// Return early if the upgrade concurrency is reached.
 	if s.UpgradeTracker.MachineDeployments.UpgradeConcurrencyReached() {
 		s.UpgradeTracker.MachineDeployments.MarkPendingUpgrade(currentMDState.Object.Name)
+		s.UpgradeTracker.MachineDeployments.MarkWaitingForUpgradeConcurrency(currentMDState.Object.Name)
 		return currentVersion, nil
 	}
 
@@ -1150,10 +1187,16 @@ func (g *generator) computeMachineDeploymentVersion(ctx context.Context, s *scop
 		return currentVersion, nil
 	}
 
-	s.UpgradeTracker.MachineDeployments.MarkUpgrading(currentMDState.Object.Name)
-
 	nextVersion := s.UpgradeTracker.MachineDeployments.UpgradePlan[0]
 
+	// Return early if MachineDeployments of a lower upgrade group are not yet upgraded to the next version.
+	if isMachineDeploymentWaitingForLowerUpgradeGroups(s, machineDeploymentTopology, nextVersion) {
+		s.UpgradeTracker.MachineDeployments.MarkPendingUpgrade(currentMDState.Object.Name)
+		return currentVersion, nil
+	}
+
+	s.UpgradeTracker.MachineDeployments.MarkUpgrading(currentMDState.Object.Name)
+
 	log.Info(fmt.Sprintf("MachineDeployment %s upgraded from version %s to version %s", klog.KObj(currentMDState.Object), currentVersion, nextVersion),
 		"ControlPlaneUpgrades", toUpgradeStep(s.UpgradeTracker.ControlPlane.UpgradePlan),
 		"WorkersUpgrades", toUpgradeStep(s.UpgradeTracker.MachineDeployments.UpgradePlan, s.UpgradeTracker.MachinePools.UpgradePlan),
@@ -1197,6 +1240,43 @@ func isMachineDeploymentDeferred(clusterTopology clusterv1.Topology, mdTopology
 	return false
 }
 
+// isMachineDeploymentWaitingForLowerUpgradeGroups returns true if the upgrade of the MachineDeployment of mdTopology
+// to version has to wait for MachineDeployments with a lower upgradeGroup, i.e. if any of them is not yet upgraded
+// to version or not yet available.
+// MachineDeployments which do not exist yet or whose upgrade is deferred are not waited for.
+func isMachineDeploymentWaitingForLowerUpgradeGroups(s *scope.Scope, mdTopology clusterv1.MachineDeploymentTopology, version string) bool {
+	upgradeGroup := ptr.Deref(mdTopology.UpgradeGroup, 0)
+	for _, md := range s.Blueprint.Topology.Workers.MachineDeployments {
+		if ptr.Deref(md.UpgradeGroup, 0) >= upgradeGroup || isMachineDeploymentDeferred(s.Blueprint.Topology, md) {
+			continue
+		}
+
+		mdState, ok := s.Current.MachineDeployments[md.Name]
+		if !ok || mdState.Object == nil {
+			continue
+		}
+		if mdState.Object.Spec.Template.Spec.Version != version ||
+			s.UpgradeTracker.MachineDeployments.IsUpgrading(mdState.Object.Name) ||
+			!conditions.IsTrue(mdState.Object, clusterv1.MachineDeploymentAvailableCondition) {
+			return true
+		}
+	}
+	return false
+}
+
+// computeAutoscalingAnnotations computes the cluster autoscaler annotations for the autoscaling configuration
+// of a MachineDeployment or a MachinePool topology.
+func computeAutoscalingAnnotations(autoscaling clusterv1.NodeGroupAutoscaling) map[string]string {
+	if autoscaling.MinSize == nil || autoscaling.MaxSize == nil {
+		return nil
+	}
+
+	autoscalingAnnotations := annotations.AutoscalerCapacityAnnotations(autoscaling.Capacity)
+	autoscalingAnnotations[clusterv1.AutoscalerMinSizeAnnotation] = strconv.Itoa(int(*autoscaling.MinSize))
+	autoscalingAnnotations[clusterv1.AutoscalerMaxSizeAnnotation] = strconv.Itoa(int(*autoscaling.MaxSize))
+	return autoscalingAnnotations
+}
+
 // computeMachinePools computes the desired state of the list of MachinePools.
 func (g *generator) computeMachinePools(ctx context.Context, s *scope.Scope) (scope.MachinePoolsStateMap, error) {
 	machinePoolsStateMap := make(scope.MachinePoolsStateMap)
@@ -1378,7 +1458,8 @@ func (g *generator) computeMachinePool(ctx context.Context, s *scope.Scope, mach
 	// Ensure the annotations used to control the upgrade sequence are never propagated.
 	delete(machinePoolAnnotations, clusterv1.ClusterTopologyHoldUpgradeSequenceAnnotation)
 	delete(machinePoolAnnotations, clusterv1.ClusterTopologyDeferUpgradeAnnotation)
-	desiredMachinePoolObj.SetAnnotations(machinePoolAnnotations)
+	// Note: the cluster autoscaler annotations are only set on the MachinePool, not on its template.
+	desiredMachinePoolObj.SetAnnotations(util.MergeMap(computeAutoscalingAnnotations(machinePoolTopology.Autoscaling), machinePoolAnnotations))
 	desiredMachinePoolObj.Spec.Template.Annotations = machinePoolAnnotations
 
 	// Apply Labels
@@ -1455,6 +1536,7 @@ func (g *generator) computeMachinePoolVersion(ctx context.Context, s *scope.Scop
 	// Return early if the upgrade concurrency is reached.
 	if s.UpgradeTracker.MachinePools.UpgradeConcurrencyReached() {
 		s.UpgradeTracker.MachinePools.MarkPendingUpgrade(currentMPState.Object.Name)
+		s.UpgradeTracker.MachinePools.MarkWaitingForUpgradeConcurrency(currentMPState.Object.Name)
 		return currentVersion, nil
 	}
 
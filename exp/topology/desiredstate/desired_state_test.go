@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1216,6 +1217,7 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 		topologyVersion                    string
 		clusterModifier                    func(c *clusterv1.Cluster)
 		controlPlaneObj                    *unstructured.Unstructured
+		controlPlaneExternallyManaged      bool
 		controlPlaneUpgradePlan            []string
 		machineDeploymentsUpgradePlan      []string
 		machinePoolsUpgradePlan            []string
@@ -1626,6 +1628,69 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 			controlPlaneUpgradePlan: []string{"v1.2.3"},
 			wantErr:                 true,
 		},
+		{
+			name:                              "should return controlplane.spec.version if the control plane is externally managed and pending upgrade - lifecycle hooks are not called",
+			beforeClusterUpgradeResponse:      nonBlockingBeforeClusterUpgradeResponse,
+			beforeControlPlaneUpgradeResponse: nonBlockingBeforeControlPlaneUpgradeResponse,
+			topologyVersion:                   "v1.2.3",
+			controlPlaneObj: builder.ControlPlane("test1", "cp1").
+				WithSpecFields(map[string]interface{}{
+					"spec.version":  "v1.2.2",
+					"spec.replicas": int64(2),
+				}).
+				WithStatusFields(map[string]interface{}{
+					"status.version":  "v1.2.2",
+					"status.replicas": int64(2),
+				}).
+				Build(),
+			controlPlaneExternallyManaged: true,
+			controlPlaneUpgradePlan:       []string{"v1.2.3"},
+			expectedVersion:               "v1.2.2",
+			expectedIsPendingUpgrade:      true,
+			expectedIsStartingUpgrade:     false,
+		},
+		{
+			name:            "should return controlplane.spec.version if the control plane is externally managed and workers are required to upgrade to the current control plane version",
+			topologyVersion: "v1.3.0",
+			controlPlaneObj: builder.ControlPlane("test1", "cp1").
+				WithSpecFields(map[string]interface{}{
+					"spec.version":  "v1.2.2",
+					"spec.replicas": int64(2),
+				}).
+				WithStatusFields(map[string]interface{}{
+					"status.version":  "v1.2.2",
+					"status.replicas": int64(2),
+				}).
+				Build(),
+			controlPlaneExternallyManaged:      true,
+			controlPlaneUpgradePlan:            []string{"v1.3.0"},
+			machineDeploymentsUpgradePlan:      []string{"v1.2.2", "v1.3.0"},
+			expectedVersion:                    "v1.2.2",
+			expectedIsPendingUpgrade:           true,
+			expectedIsStartingUpgrade:          false,
+			expectedIsWaitingForWorkersUpgrade: true,
+		},
+		{
+			name:            "should return controlplane.spec.version if the control plane is externally managed and already upgraded out-of-band",
+			topologyVersion: "v1.2.3",
+			controlPlaneObj: builder.ControlPlane("test1", "cp1").
+				WithSpecFields(map[string]interface{}{
+					"spec.version":  "v1.2.3",
+					"spec.replicas": int64(2),
+				}).
+				WithStatusFields(map[string]interface{}{
+					"status.version":  "v1.2.3",
+					"status.replicas": int64(2),
+				}).
+				Build(),
+			controlPlaneExternallyManaged: true,
+			machineDeploymentsUpgradePlan: []string{"v1.2.3"},
+			expectedVersion:               "v1.2.3",
+			expectedIsPendingUpgrade:      false,
+			expectedIsStartingUpgrade:     false,
+			// Workers are upgraded to the current version of the control plane.
+			expectedIsWaitingForWorkersUpgrade: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1635,7 +1700,8 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 				Blueprint: &scope.ClusterBlueprint{Topology: clusterv1.Topology{
 					Version: tt.topologyVersion,
 					ControlPlane: clusterv1.ControlPlaneTopology{
-						Replicas: ptr.To[int32](2),
+						Replicas:          ptr.To[int32](2),
+						ExternallyManaged: ptr.To(tt.controlPlaneExternallyManaged),
 					},
 				}},
 				Current: &scope.ClusterState{
@@ -1730,6 +1796,60 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 	}
 }
 
+func TestComputeExternallyManagedControlPlane(t *testing.T) {
+	controlPlane := builder.ControlPlane("test1", "cp1").
+		WithSpecFields(map[string]interface{}{
+			"spec.version":     "v1.2.2",
+			"spec.replicas":    int64(3),
+			"spec.customField": "managed-out-of-band",
+		}).
+		WithStatusFields(map[string]interface{}{
+			"status.version":  "v1.2.2",
+			"status.replicas": int64(3),
+		}).
+		Build()
+
+	t.Run("should return the current ControlPlane", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := scope.New(builder.Cluster("test1", "cluster1").WithControlPlane(controlPlane).Build())
+		s.Blueprint = &scope.ClusterBlueprint{
+			Topology: *builder.ClusterTopology().
+				WithVersion("v1.2.3").
+				WithControlPlaneReplicas(1).
+				WithControlPlaneExternallyManaged(true).
+				Build(),
+		}
+		s.Current.ControlPlane = &scope.ControlPlaneState{Object: controlPlane}
+		s.UpgradeTracker.ControlPlane.UpgradePlan = []string{"v1.2.3"}
+
+		r := &generator{}
+		obj, err := r.computeExternallyManagedControlPlane(ctx, s)
+		g.Expect(err).ToNot(HaveOccurred())
+		// Neither the version nor the replicas from the topology are applied to the ControlPlane.
+		g.Expect(obj).To(BeComparableTo(controlPlane))
+		g.Expect(obj).ToNot(BeIdenticalTo(controlPlane))
+		g.Expect(s.UpgradeTracker.ControlPlane.IsPendingUpgrade).To(BeTrue())
+		g.Expect(s.UpgradeTracker.ControlPlane.IsStartingUpgrade).To(BeFalse())
+	})
+
+	t.Run("should fail if the ControlPlane does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := scope.New(builder.Cluster("test1", "cluster1").Build())
+		s.Blueprint = &scope.ClusterBlueprint{
+			Topology: *builder.ClusterTopology().
+				WithVersion("v1.2.3").
+				WithControlPlaneExternallyManaged(true).
+				Build(),
+		}
+
+		r := &generator{}
+		_, err := r.computeExternallyManagedControlPlane(ctx, s)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
 func TestComputeCluster(t *testing.T) {
 	g := NewWithT(t)
 
@@ -2304,6 +2424,7 @@ func TestComputeMachineDeployment(t *testing.T) {
 			topologyVersion             string
 			upgradePlan                 []string
 			expectedVersion             string
+			expectedWaiting             bool
 		}{
 			{
 				name:                        "use cluster.spec.topology.version if creating a new machine deployment",
@@ -2331,6 +2452,7 @@ func TestComputeMachineDeployment(t *testing.T) {
 				topologyVersion:             "v1.2.3",
 				upgradePlan:                 []string{"v1.2.3"},
 				expectedVersion:             "v1.2.2",
+				expectedWaiting:             true,
 			},
 			{
 				name:                        "use cluster.spec.topology.version if one of the machine deployments is upgrading, concurrency limit not reached",
@@ -2401,6 +2523,11 @@ func TestComputeMachineDeployment(t *testing.T) {
 				obj, err := e.computeMachineDeployment(ctx, s, mdTopology)
 				g.Expect(err).ToNot(HaveOccurred())
 				g.Expect(obj.Object.Spec.Template.Spec.Version).To(Equal(tt.expectedVersion))
+				if tt.expectedWaiting {
+					g.Expect(s.UpgradeTracker.MachineDeployments.WaitingForUpgradeConcurrencyNames()).To(ConsistOf("big-pool-of-machines"))
+				} else {
+					g.Expect(s.UpgradeTracker.MachineDeployments.WaitingForUpgradeConcurrencyNames()).To(BeEmpty())
+				}
 			})
 		}
 	})
@@ -3393,6 +3520,99 @@ func TestIsMachineDeploymentDeferred(t *testing.T) {
 	}
 }
 
+func TestIsMachineDeploymentWaitingForLowerUpgradeGroups(t *testing.T) {
+	availableCondition := metav1.Condition{Type: clusterv1.MachineDeploymentAvailableCondition, Status: metav1.ConditionTrue}
+	notAvailableCondition := metav1.Condition{Type: clusterv1.MachineDeploymentAvailableCondition, Status: metav1.ConditionFalse}
+
+	tests := []struct {
+		name                  string
+		lowerGroupMD          *clusterv1.MachineDeployment
+		lowerGroupAnnotations map[string]string
+		upgradingMDs          []string
+		mdUpgradeGroup        *int32
+		want                  bool
+	}{
+		{
+			name:           "MD in the lowest group does not wait",
+			lowerGroupMD:   builder.MachineDeployment(metav1.NamespaceDefault, "md-group-0").WithVersion("v1.32.0").Build(),
+			mdUpgradeGroup: nil,
+			want:           false,
+		},
+		{
+			name:           "MD waits for MD of a lower group not yet upgraded",
+			lowerGroupMD:   builder.MachineDeployment(metav1.NamespaceDefault, "md-group-0").WithVersion("v1.32.0").Build(),
+			mdUpgradeGroup: ptr.To[int32](1),
+			want:           true,
+		},
+		{
+			name: "MD waits for MD of a lower group still upgrading",
+			lowerGroupMD: builder.MachineDeployment(metav1.NamespaceDefault, "md-group-0").WithVersion("v1.33.0").
+				WithStatus(clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{availableCondition}}).Build(),
+			upgradingMDs:   []string{"md-group-0"},
+			mdUpgradeGroup: ptr.To[int32](1),
+			want:           true,
+		},
+		{
+			name: "MD waits for MD of a lower group not available",
+			lowerGroupMD: builder.MachineDeployment(metav1.NamespaceDefault, "md-group-0").WithVersion("v1.33.0").
+				WithStatus(clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{notAvailableCondition}}).Build(),
+			mdUpgradeGroup: ptr.To[int32](1),
+			want:           true,
+		},
+		{
+			name: "MD does not wait for MD of a lower group upgraded and available",
+			lowerGroupMD: builder.MachineDeployment(metav1.NamespaceDefault, "md-group-0").WithVersion("v1.33.0").
+				WithStatus(clusterv1.MachineDeploymentStatus{Conditions: []metav1.Condition{availableCondition}}).Build(),
+			mdUpgradeGroup: ptr.To[int32](1),
+			want:           false,
+		},
+		{
+			name:                  "MD does not wait for MD of a lower group with deferred upgrade",
+			lowerGroupMD:          builder.MachineDeployment(metav1.NamespaceDefault, "md-group-0").WithVersion("v1.32.0").Build(),
+			lowerGroupAnnotations: map[string]string{clusterv1.ClusterTopologyDeferUpgradeAnnotation: ""},
+			mdUpgradeGroup:        ptr.To[int32](1),
+			want:                  false,
+		},
+		{
+			name:           "MD does not wait for MD of a lower group which does not exist yet",
+			mdUpgradeGroup: ptr.To[int32](1),
+			want:           false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mdTopology := clusterv1.MachineDeploymentTopology{
+				Name:         "md-topology",
+				UpgradeGroup: tt.mdUpgradeGroup,
+			}
+			s := scope.New(builder.Cluster(metav1.NamespaceDefault, "cluster1").Build())
+			s.Blueprint = &scope.ClusterBlueprint{
+				Topology: clusterv1.Topology{
+					Workers: clusterv1.WorkersTopology{
+						MachineDeployments: []clusterv1.MachineDeploymentTopology{
+							{
+								Name:     "lower-group-md-topology",
+								Metadata: clusterv1.ObjectMeta{Annotations: tt.lowerGroupAnnotations},
+							},
+							mdTopology,
+						},
+					},
+				},
+			}
+			s.Current.MachineDeployments = map[string]*scope.MachineDeploymentState{}
+			if tt.lowerGroupMD != nil {
+				s.Current.MachineDeployments["lower-group-md-topology"] = &scope.MachineDeploymentState{Object: tt.lowerGroupMD}
+			}
+			s.UpgradeTracker.MachineDeployments.MarkUpgrading(tt.upgradingMDs...)
+
+			g.Expect(isMachineDeploymentWaitingForLowerUpgradeGroups(s, mdTopology, "v1.33.0")).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestIsMachinePoolDeferred(t *testing.T) {
 	clusterTopology := clusterv1.Topology{
 		Workers: clusterv1.WorkersTopology{
@@ -3744,6 +3964,28 @@ func TestMergeMap(t *testing.T) {
 	})
 }
 
+func Test_computeAutoscalingAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(computeAutoscalingAnnotations(clusterv1.NodeGroupAutoscaling{})).To(BeEmpty())
+	g.Expect(computeAutoscalingAnnotations(clusterv1.NodeGroupAutoscaling{
+		MinSize: ptr.To[int32](0),
+		MaxSize: ptr.To[int32](10),
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:                    resource.MustParse("4"),
+			corev1.ResourceMemory:                 resource.MustParse("16Gi"),
+			corev1.ResourceName("nvidia.com/gpu"): resource.MustParse("2"),
+		},
+	})).To(Equal(map[string]string{
+		clusterv1.AutoscalerMinSizeAnnotation:          "0",
+		clusterv1.AutoscalerMaxSizeAnnotation:          "10",
+		clusterv1.AutoscalerCapacityCPUAnnotation:      "4",
+		clusterv1.AutoscalerCapacityMemoryAnnotation:   "16Gi",
+		clusterv1.AutoscalerCapacityGPUTypeAnnotation:  "nvidia.com/gpu",
+		clusterv1.AutoscalerCapacityGPUCountAnnotation: "2",
+	}))
+}
+
 func Test_computeMachineHealthCheck(t *testing.T) {
 	mhcChecks := clusterv1.MachineHealthCheckChecks{
 		UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/core/reconcilers/topology/cluster/patches"
+	coreadmission "sigs.k8s.io/cluster-api/core/webhooks/admission"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/util/cache"
+)
+
+// PreviewInput is the input for Preview.
+type PreviewInput struct {
+	// Cluster is the Cluster with a managed topology to compute the desired state for.
+	// The Cluster is not modified; its variables are defaulted and validated on a copy.
+	Cluster *clusterv1.Cluster
+
+	// ClusterClass is the ClusterClass referenced by the Cluster.
+	// NOTE: The ClusterClass must have been reconciled, i.e. ClusterClass.status.variables must be set.
+	ClusterClass *clusterv1.ClusterClass
+
+	// RuntimeClient is used to call external patches.
+	// It is only required if the ClusterClass uses external patches.
+	RuntimeClient runtimeclient.Client
+}
+
+// Preview computes the desired state of a Cluster topology as it would be created from the ClusterClass and
+// the variable values of the Cluster, including the templates patched with the inline and external patches
+// of the ClusterClass, without creating or changing any object.
+//
+// The templates referenced by the ClusterClass are read using c. The Cluster is always considered as
+// not existing yet, so the desired state doesn't depend on the current state of the Cluster topology,
+// and upgrades and lifecycle hooks are not taken into account.
+func Preview(ctx context.Context, c client.Client, input PreviewInput) (*scope.ClusterState, error) {
+	if input.Cluster == nil || input.ClusterClass == nil {
+		return nil, pkgerrors.New("Cluster and ClusterClass must not be nil")
+	}
+	if !input.Cluster.Spec.Topology.IsDefined() {
+		return nil, pkgerrors.Errorf("Cluster %s must have a managed topology", input.Cluster.Name)
+	}
+
+	cluster := input.Cluster.DeepCopy()
+	if errs := (&coreadmission.Cluster{}).DefaultAndValidateVariables(ctx, cluster, nil, input.ClusterClass); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), cluster.Name, errs)
+	}
+
+	s := scope.New(cluster)
+	var err error
+	s.Blueprint, err = GetBlueprint(ctx, c, cluster, input.ClusterClass)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error reading the ClusterClass")
+	}
+
+	// Note: ClusterCache is not required given that the Cluster is considered as not existing yet, and thus there
+	// are no MachinePools with Nodes; caches are only used within this call.
+	g := &generator{
+		Client:              c,
+		RuntimeClient:       input.RuntimeClient,
+		hookCache:           cache.New[cache.HookEntry](ctx, cache.HookCacheDefaultTTL),
+		getUpgradePlanCache: cache.New[GenerateUpgradePlanCacheEntry](ctx, cache.DefaultTTL),
+		patchEngine:         patches.NewEngine(c, input.RuntimeClient),
+	}
+	desiredState, err := g.Generate(ctx, s)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error computing the desired state of the Cluster topology")
+	}
+	return desiredState, nil
+}
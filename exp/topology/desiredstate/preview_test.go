@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package desiredstate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func TestPreview(t *testing.T) {
+	infrastructureClusterTemplate := builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra-cluster-template").
+		Build()
+	controlPlaneTemplate := builder.ControlPlaneTemplate(metav1.NamespaceDefault, "control-plane-template").
+		Build()
+	clusterClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").
+		WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
+		WithControlPlaneTemplate(controlPlaneTemplate).
+		WithStatusVariables(clusterv1.ClusterClassStatusVariable{
+			Name: "region",
+			Definitions: []clusterv1.ClusterClassStatusVariableDefinition{
+				{
+					From:     clusterv1.VariableDefinitionFromInline,
+					Required: ptr.To(true),
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+							Type: "string",
+						},
+					},
+				},
+			},
+		}).
+		WithPatches([]clusterv1.ClusterClassPatch{
+			{
+				Name: "region",
+				Definitions: []clusterv1.PatchDefinition{
+					{
+						Selector: clusterv1.PatchSelector{
+							APIVersion: builder.InfrastructureGroupVersion.String(),
+							Kind:       builder.GenericInfrastructureClusterTemplateKind,
+							MatchResources: clusterv1.PatchSelectorMatch{
+								InfrastructureCluster: ptr.To(true),
+							},
+						},
+						JSONPatches: []clusterv1.JSONPatch{
+							{
+								Op:   "add",
+								Path: "/spec/template/spec/region",
+								ValueFrom: &clusterv1.JSONPatchValue{
+									Variable: "region",
+								},
+							},
+						},
+					},
+				},
+			},
+		}).
+		Build()
+
+	objs := []client.Object{
+		builder.GenericInfrastructureClusterTemplateCRD,
+		builder.GenericInfrastructureClusterCRD,
+		builder.GenericControlPlaneTemplateCRD,
+		builder.GenericControlPlaneCRD,
+		infrastructureClusterTemplate,
+		controlPlaneTemplate,
+	}
+
+	newCluster := func(variables ...clusterv1.ClusterVariable) *clusterv1.Cluster {
+		return builder.Cluster(metav1.NamespaceDefault, "cluster1").
+			WithTopology(builder.ClusterTopology().
+				WithClass(clusterClass.Name).
+				WithVersion("v1.34.0").
+				WithVariables(variables...).
+				Build()).
+			Build()
+	}
+
+	t.Run("Returns the desired state with patched templates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(objs...).Build()
+		cluster := newCluster(clusterv1.ClusterVariable{
+			Name:  "region",
+			Value: apiextensionsv1.JSON{Raw: []byte(`"us-east-1"`)},
+		})
+		originalCluster := cluster.DeepCopy()
+
+		desiredState, err := Preview(ctx, fakeClient, PreviewInput{
+			Cluster:      cluster,
+			ClusterClass: clusterClass,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(desiredState.Cluster).ToNot(BeNil())
+		g.Expect(desiredState.ControlPlane.Object).ToNot(BeNil())
+		g.Expect(desiredState.InfrastructureCluster).ToNot(BeNil())
+		region, _, err := unstructured.NestedString(desiredState.InfrastructureCluster.Object, "spec", "region")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(region).To(Equal("us-east-1"))
+
+		// Preview must neither change the Cluster nor create any object.
+		g.Expect(cluster).To(Equal(originalCluster))
+		clusterList := &clusterv1.ClusterList{}
+		g.Expect(fakeClient.List(ctx, clusterList)).To(Succeed())
+		g.Expect(clusterList.Items).To(BeEmpty())
+	})
+
+	t.Run("Fails if variables are not valid", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(objs...).Build()
+
+		_, err := Preview(ctx, fakeClient, PreviewInput{
+			Cluster:      newCluster(),
+			ClusterClass: clusterClass,
+		})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("required variable \"region\" must be set"))
+	})
+
+	t.Run("Fails if the Cluster doesn't have a managed topology", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(objs...).Build()
+
+		_, err := Preview(ctx, fakeClient, PreviewInput{
+			Cluster:      builder.Cluster(metav1.NamespaceDefault, "cluster1").Build(),
+			ClusterClass: clusterClass,
+		})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
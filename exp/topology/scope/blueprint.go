@@ -17,6 +17,8 @@ limitations under the License.
 package scope
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -91,8 +93,19 @@ type MachinePoolBlueprint struct {
 	InfrastructureMachinePoolTemplate *unstructured.Unstructured
 }
 
+// IsControlPlaneExternallyManaged checks whether the ControlPlane is managed out-of-band.
+// In this case the topology controller does not create or update the ControlPlane and its dependent objects.
+func (b *ClusterBlueprint) IsControlPlaneExternallyManaged() bool {
+	return b.Topology.ControlPlane.IsExternallyManaged()
+}
+
 // HasControlPlaneInfrastructureMachine checks whether the clusterClass mandates the controlPlane has infrastructureMachines.
+// NOTE: This returns false if the ControlPlane is externally managed, because the topology controller does not
+// manage the InfrastructureMachineTemplate of the ControlPlane in this case.
 func (b *ClusterBlueprint) HasControlPlaneInfrastructureMachine() bool {
+	if b.IsControlPlaneExternallyManaged() {
+		return false
+	}
 	return b.ClusterClass.Spec.ControlPlane.MachineInfrastructure.TemplateRef.IsDefined()
 }
 
@@ -115,32 +128,42 @@ func (b *ClusterBlueprint) IsControlPlaneMachineHealthCheckEnabled() bool {
 }
 
 // ControlPlaneMachineHealthCheckClass returns the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
+// If the Cluster topology defines a MachineHealthCheck with the Merge override strategy, the MachineHealthCheck from the Cluster topology
+// is merged into the one from the ClusterClass.
 func (b *ClusterBlueprint) ControlPlaneMachineHealthCheckClass() (clusterv1.MachineHealthCheckChecks, clusterv1.MachineHealthCheckRemediation) {
-	if b.Topology.ControlPlane.HealthCheck.IsDefined() {
-		return clusterv1.MachineHealthCheckChecks{
-				NodeStartupTimeoutSeconds:  b.Topology.ControlPlane.HealthCheck.Checks.NodeStartupTimeoutSeconds,
-				UnhealthyNodeConditions:    b.Topology.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions,
-				UnhealthyMachineConditions: b.Topology.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions,
-			}, clusterv1.MachineHealthCheckRemediation{
-				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-					UnhealthyLessThanOrEqualTo: b.Topology.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-					UnhealthyInRange:           b.Topology.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-				},
-				TemplateRef: b.Topology.ControlPlane.HealthCheck.Remediation.TemplateRef,
-			}
+	topologyHealthCheck := b.Topology.ControlPlane.HealthCheck
+	topologyChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  topologyHealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    topologyHealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: topologyHealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	topologyRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: topologyHealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           topologyHealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: topologyHealthCheck.Remediation.TemplateRef,
+	}
+	if topologyHealthCheck.IsDefined() && topologyHealthCheck.OverrideStrategy != clusterv1.MergeHealthCheckOverrideStrategy {
+		return topologyChecks, topologyRemediation
 	}
 
-	return clusterv1.MachineHealthCheckChecks{
-			NodeStartupTimeoutSeconds:  b.ControlPlane.HealthCheck.Checks.NodeStartupTimeoutSeconds,
-			UnhealthyNodeConditions:    b.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions,
-			UnhealthyMachineConditions: b.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions,
-		}, clusterv1.MachineHealthCheckRemediation{
-			TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-				UnhealthyLessThanOrEqualTo: b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-				UnhealthyInRange:           b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-			},
-			TemplateRef: b.ControlPlane.HealthCheck.Remediation.TemplateRef,
-		}
+	classChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  b.ControlPlane.HealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    b.ControlPlane.HealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: b.ControlPlane.HealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	classRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           b.ControlPlane.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: b.ControlPlane.HealthCheck.Remediation.TemplateRef,
+	}
+	if !topologyHealthCheck.IsDefined() {
+		return classChecks, classRemediation
+	}
+	return mergeMachineHealthCheckChecks(classChecks, topologyChecks), mergeMachineHealthCheckRemediation(classRemediation, topologyRemediation)
 }
 
 // HasControlPlaneMachineHealthCheck returns true if the ControlPlaneClass has both MachineInfrastructure and a MachineHealthCheck defined.
@@ -164,32 +187,96 @@ func (b *ClusterBlueprint) IsMachineDeploymentMachineHealthCheckEnabled(md *clus
 }
 
 // MachineDeploymentMachineHealthCheckClass return the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
+// If the MachineDeployment topology defines a MachineHealthCheck with the Merge override strategy, the MachineHealthCheck from the
+// MachineDeployment topology is merged into the one from the MachineDeploymentClass.
 func (b *ClusterBlueprint) MachineDeploymentMachineHealthCheckClass(md *clusterv1.MachineDeploymentTopology) (clusterv1.MachineHealthCheckChecks, clusterv1.MachineHealthCheckRemediation) {
-	if md.HealthCheck.IsDefined() {
-		return clusterv1.MachineHealthCheckChecks{
-				NodeStartupTimeoutSeconds:  md.HealthCheck.Checks.NodeStartupTimeoutSeconds,
-				UnhealthyNodeConditions:    md.HealthCheck.Checks.UnhealthyNodeConditions,
-				UnhealthyMachineConditions: md.HealthCheck.Checks.UnhealthyMachineConditions,
-			}, clusterv1.MachineHealthCheckRemediation{
-				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-					UnhealthyLessThanOrEqualTo: md.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-					UnhealthyInRange:           md.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-				},
-				TemplateRef: md.HealthCheck.Remediation.TemplateRef,
-			}
+	topologyChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  md.HealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    md.HealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: md.HealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	topologyRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: md.HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           md.HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: md.HealthCheck.Remediation.TemplateRef,
+	}
+	if md.HealthCheck.IsDefined() && md.HealthCheck.OverrideStrategy != clusterv1.MergeHealthCheckOverrideStrategy {
+		return topologyChecks, topologyRemediation
+	}
+
+	classChecks := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds:  b.MachineDeployments[md.Class].HealthCheck.Checks.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions:    b.MachineDeployments[md.Class].HealthCheck.Checks.UnhealthyNodeConditions,
+		UnhealthyMachineConditions: b.MachineDeployments[md.Class].HealthCheck.Checks.UnhealthyMachineConditions,
+	}
+	classRemediation := clusterv1.MachineHealthCheckRemediation{
+		TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+			UnhealthyLessThanOrEqualTo: b.MachineDeployments[md.Class].HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
+			UnhealthyInRange:           b.MachineDeployments[md.Class].HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
+		},
+		TemplateRef: b.MachineDeployments[md.Class].HealthCheck.Remediation.TemplateRef,
+	}
+	if !md.HealthCheck.IsDefined() {
+		return classChecks, classRemediation
+	}
+	return mergeMachineHealthCheckChecks(classChecks, topologyChecks), mergeMachineHealthCheckRemediation(classRemediation, topologyRemediation)
+}
+
+// mergeMachineHealthCheckChecks merges checks from a Cluster topology into checks from a ClusterClass.
+// Fields set in the Cluster topology take precedence; unhealthy conditions are merged by type and status.
+func mergeMachineHealthCheckChecks(class, topology clusterv1.MachineHealthCheckChecks) clusterv1.MachineHealthCheckChecks {
+	merged := clusterv1.MachineHealthCheckChecks{
+		NodeStartupTimeoutSeconds: class.NodeStartupTimeoutSeconds,
+		UnhealthyNodeConditions: mergeUnhealthyConditions(class.UnhealthyNodeConditions, topology.UnhealthyNodeConditions, func(c clusterv1.UnhealthyNodeCondition) string {
+			return fmt.Sprintf("%s/%s", c.Type, c.Status)
+		}),
+		UnhealthyMachineConditions: mergeUnhealthyConditions(class.UnhealthyMachineConditions, topology.UnhealthyMachineConditions, func(c clusterv1.UnhealthyMachineCondition) string {
+			return fmt.Sprintf("%s/%s", c.Type, c.Status)
+		}),
+	}
+	if topology.NodeStartupTimeoutSeconds != nil {
+		merged.NodeStartupTimeoutSeconds = topology.NodeStartupTimeoutSeconds
+	}
+	return merged
+}
+
+// mergeMachineHealthCheckRemediation merges remediation from a Cluster topology into remediation from a ClusterClass.
+// NOTE: triggerIf is merged as a whole, because unhealthyInRange takes precedence over unhealthyLessThanOrEqualTo.
+func mergeMachineHealthCheckRemediation(class, topology clusterv1.MachineHealthCheckRemediation) clusterv1.MachineHealthCheckRemediation {
+	merged := class
+	if topology.TriggerIf.UnhealthyLessThanOrEqualTo != nil || topology.TriggerIf.UnhealthyInRange != "" {
+		merged.TriggerIf = topology.TriggerIf
 	}
+	if topology.TemplateRef.IsDefined() {
+		merged.TemplateRef = topology.TemplateRef
+	}
+	return merged
+}
 
-	return clusterv1.MachineHealthCheckChecks{
-			NodeStartupTimeoutSeconds:  b.MachineDeployments[md.Class].HealthCheck.Checks.NodeStartupTimeoutSeconds,
-			UnhealthyNodeConditions:    b.MachineDeployments[md.Class].HealthCheck.Checks.UnhealthyNodeConditions,
-			UnhealthyMachineConditions: b.MachineDeployments[md.Class].HealthCheck.Checks.UnhealthyMachineConditions,
-		}, clusterv1.MachineHealthCheckRemediation{
-			TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
-				UnhealthyLessThanOrEqualTo: b.MachineDeployments[md.Class].HealthCheck.Remediation.TriggerIf.UnhealthyLessThanOrEqualTo,
-				UnhealthyInRange:           b.MachineDeployments[md.Class].HealthCheck.Remediation.TriggerIf.UnhealthyInRange,
-			},
-			TemplateRef: b.MachineDeployments[md.Class].HealthCheck.Remediation.TemplateRef,
+// mergeUnhealthyConditions merges unhealthy conditions from a Cluster topology into unhealthy conditions from a ClusterClass;
+// conditions from the Cluster topology replace conditions from the ClusterClass with the same key, while other conditions are appended.
+func mergeUnhealthyConditions[T any](class, topology []T, key func(T) string) []T {
+	if len(class) == 0 && len(topology) == 0 {
+		return nil
+	}
+	merged := make([]T, 0, len(class)+len(topology))
+	merged = append(merged, class...)
+	for _, t := range topology {
+		replaced := false
+		for i := range merged {
+			if key(merged[i]) == key(t) {
+				merged[i] = t
+				replaced = true
+				break
+			}
 		}
+		if !replaced {
+			merged = append(merged, t)
+		}
+	}
+	return merged
 }
 
 // HasMachineDeployments checks whether the topology has MachineDeployments.
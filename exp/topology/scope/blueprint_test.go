@@ -87,6 +87,37 @@ func TestIsControlPlaneMachineHealthCheckEnabled(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "should return false if the control plane is externally managed",
+			blueprint: &ClusterBlueprint{
+				ClusterClass: builder.ClusterClass(metav1.NamespaceDefault, "cluster-class").
+					WithControlPlaneInfrastructureMachineTemplate(builder.InfrastructureMachineTemplate(metav1.NamespaceDefault, "cpinframachinetemplate").Build()).
+					WithControlPlaneMachineHealthCheck(clusterv1.ControlPlaneClassHealthCheck{
+						Checks: clusterv1.ControlPlaneClassHealthCheckChecks{
+							UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+								{
+									Type:           corev1.NodeReady,
+									Status:         corev1.ConditionUnknown,
+									TimeoutSeconds: ptr.To(int32(5 * 60)),
+								},
+							},
+							UnhealthyMachineConditions: []clusterv1.UnhealthyMachineCondition{
+								{
+									Type:           controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
+									Status:         metav1.ConditionFalse,
+									TimeoutSeconds: ptr.To(int32(5 * 60)),
+								},
+							},
+						},
+					}).
+					Build(),
+				Topology: *builder.ClusterTopology().
+					WithClass("cluster-class").
+					WithControlPlaneExternallyManaged(true).
+					Build(),
+			},
+			want: false,
+		},
 		{
 			name: "should return false if MachineHealthCheck is defined in ClusterClass, not defined in cluster topology and enable is false",
 			blueprint: &ClusterBlueprint{
@@ -378,6 +409,88 @@ func TestControlPlaneMachineHealthCheckClass(t *testing.T) {
 			},
 			wantRemediation: clusterv1.MachineHealthCheckRemediation{},
 		},
+		{
+			name: "should merge the MachineHealthCheck from cluster topology into the MachineHealthCheck from ClusterClass if the override strategy is Merge",
+			blueprint: &ClusterBlueprint{
+				Topology: *builder.ClusterTopology().
+					WithControlPlaneMachineHealthCheck(clusterv1.ControlPlaneTopologyHealthCheck{
+						OverrideStrategy: clusterv1.MergeHealthCheckOverrideStrategy,
+						Checks: clusterv1.ControlPlaneTopologyHealthCheckChecks{
+							UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+								{
+									Type:           corev1.NodeReady,
+									Status:         corev1.ConditionFalse,
+									TimeoutSeconds: ptr.To(int32(20 * 60)),
+								},
+							},
+						},
+						Remediation: clusterv1.ControlPlaneTopologyHealthCheckRemediation{
+							TriggerIf: clusterv1.ControlPlaneTopologyHealthCheckRemediationTriggerIf{
+								UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("50%")),
+							},
+						},
+					}).
+					Build(),
+				ControlPlane: &ControlPlaneBlueprint{
+					HealthCheck: clusterv1.ControlPlaneClassHealthCheck{
+						Checks: clusterv1.ControlPlaneClassHealthCheckChecks{
+							NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+							UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+								{
+									Type:           corev1.NodeReady,
+									Status:         corev1.ConditionFalse,
+									TimeoutSeconds: ptr.To(int32(10 * 60)),
+								},
+								{
+									Type:           corev1.NodeReady,
+									Status:         corev1.ConditionUnknown,
+									TimeoutSeconds: ptr.To(int32(10 * 60)),
+								},
+							},
+							UnhealthyMachineConditions: []clusterv1.UnhealthyMachineCondition{
+								{
+									Type:           controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
+									Status:         metav1.ConditionFalse,
+									TimeoutSeconds: ptr.To(int32(5 * 60)),
+								},
+							},
+						},
+						Remediation: clusterv1.ControlPlaneClassHealthCheckRemediation{
+							TriggerIf: clusterv1.ControlPlaneClassHealthCheckRemediationTriggerIf{
+								UnhealthyInRange: "[1-3]",
+							},
+						},
+					},
+				},
+			},
+			wantChecks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To(int32(20 * 60)),
+					},
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionUnknown,
+						TimeoutSeconds: ptr.To(int32(10 * 60)),
+					},
+				},
+				UnhealthyMachineConditions: []clusterv1.UnhealthyMachineCondition{
+					{
+						Type:           controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
+						Status:         metav1.ConditionFalse,
+						TimeoutSeconds: ptr.To(int32(5 * 60)),
+					},
+				},
+			},
+			wantRemediation: clusterv1.MachineHealthCheckRemediation{
+				TriggerIf: clusterv1.MachineHealthCheckRemediationTriggerIf{
+					UnhealthyLessThanOrEqualTo: ptr.To(intstr.FromString("50%")),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -740,6 +853,74 @@ func TestMachineDeploymentMachineHealthCheckClass(t *testing.T) {
 			},
 			wantRemediation: clusterv1.MachineHealthCheckRemediation{},
 		},
+		{
+			name: "should merge the MachineHealthCheck from cluster topology into the MachineHealthCheck from ClusterClass if the override strategy is Merge",
+			blueprint: &ClusterBlueprint{
+				MachineDeployments: map[string]*MachineDeploymentBlueprint{
+					"worker-class": {
+						HealthCheck: clusterv1.MachineDeploymentClassHealthCheck{
+							Checks: clusterv1.MachineDeploymentClassHealthCheckChecks{
+								NodeStartupTimeoutSeconds: ptr.To(int32(10 * 60)),
+								UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+									{
+										Type:           corev1.NodeReady,
+										Status:         corev1.ConditionFalse,
+										TimeoutSeconds: ptr.To(int32(10 * 60)),
+									},
+								},
+							},
+							Remediation: clusterv1.MachineDeploymentClassHealthCheckRemediation{
+								TemplateRef: clusterv1.MachineHealthCheckRemediationTemplateReference{
+									APIVersion: "infrastructure.cluster.x-k8s.io/v1beta2",
+									Kind:       "GenericRemediationTemplate",
+									Name:       "remediation",
+								},
+							},
+						},
+					},
+				},
+			},
+			mdTopology: &clusterv1.MachineDeploymentTopology{
+				Class: "worker-class",
+				HealthCheck: clusterv1.MachineDeploymentTopologyHealthCheck{
+					OverrideStrategy: clusterv1.MergeHealthCheckOverrideStrategy,
+					Checks: clusterv1.MachineDeploymentTopologyHealthCheckChecks{
+						NodeStartupTimeoutSeconds: ptr.To(int32(5 * 60)),
+						UnhealthyMachineConditions: []clusterv1.UnhealthyMachineCondition{
+							{
+								Type:           controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
+								Status:         metav1.ConditionFalse,
+								TimeoutSeconds: ptr.To(int32(5 * 60)),
+							},
+						},
+					},
+				},
+			},
+			wantChecks: clusterv1.MachineHealthCheckChecks{
+				NodeStartupTimeoutSeconds: ptr.To(int32(5 * 60)),
+				UnhealthyNodeConditions: []clusterv1.UnhealthyNodeCondition{
+					{
+						Type:           corev1.NodeReady,
+						Status:         corev1.ConditionFalse,
+						TimeoutSeconds: ptr.To(int32(10 * 60)),
+					},
+				},
+				UnhealthyMachineConditions: []clusterv1.UnhealthyMachineCondition{
+					{
+						Type:           controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
+						Status:         metav1.ConditionFalse,
+						TimeoutSeconds: ptr.To(int32(5 * 60)),
+					},
+				},
+			},
+			wantRemediation: clusterv1.MachineHealthCheckRemediation{
+				TemplateRef: clusterv1.MachineHealthCheckRemediationTemplateReference{
+					APIVersion: "infrastructure.cluster.x-k8s.io/v1beta2",
+					Kind:       "GenericRemediationTemplate",
+					Name:       "remediation",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
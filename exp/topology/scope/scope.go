@@ -44,10 +44,14 @@ type Scope struct {
 // New returns a new Scope with only the cluster; while processing a request in the topology/ClusterReconciler controller
 // additional information will be added about the Cluster blueprint, current state and desired state.
 func New(cluster *clusterv1.Cluster) *Scope {
-	// Determine the maximum upgrade concurrency from the annotation on the cluster.
+	// Determine the maximum upgrade concurrency from the Cluster topology or from the annotation on the cluster.
+	// Note: The value in the Cluster topology takes precedence over the annotation.
 	maxMDUpgradeConcurrency := 1
 	maxMPUpgradeConcurrency := 1
-	if concurrency, ok := cluster.Annotations[clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation]; ok {
+	if cluster.Spec.Topology.Workers.Upgrade.MaxConcurrency != nil {
+		maxMDUpgradeConcurrency = int(*cluster.Spec.Topology.Workers.Upgrade.MaxConcurrency)
+		maxMPUpgradeConcurrency = int(*cluster.Spec.Topology.Workers.Upgrade.MaxConcurrency)
+	} else if concurrency, ok := cluster.Annotations[clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation]; ok {
 		// The error can be ignored because the webhook ensures that the value is a positive integer.
 		maxMDUpgradeConcurrency, _ = strconv.Atoi(concurrency)
 		maxMPUpgradeConcurrency, _ = strconv.Atoi(concurrency)
@@ -21,12 +21,13 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
 func TestNew(t *testing.T) {
-	t.Run("should set the right maxUpgradeConcurrency in UpgradeTracker from the cluster annotation", func(t *testing.T) {
+	t.Run("should set the right maxUpgradeConcurrency in UpgradeTracker from the cluster", func(t *testing.T) {
 		tests := []struct {
 			name    string
 			cluster *clusterv1.Cluster
@@ -48,6 +49,41 @@ func TestNew(t *testing.T) {
 				},
 				want: 2,
 			},
+			{
+				name: "if the cluster topology has maxConcurrency it should set the upgrade concurrency value",
+				cluster: &clusterv1.Cluster{
+					Spec: clusterv1.ClusterSpec{
+						Topology: clusterv1.Topology{
+							Workers: clusterv1.WorkersTopology{
+								Upgrade: clusterv1.WorkersTopologyUpgrade{
+									MaxConcurrency: ptr.To[int32](3),
+								},
+							},
+						},
+					},
+				},
+				want: 3,
+			},
+			{
+				name: "if the cluster topology has maxConcurrency it should take precedence over the annotation",
+				cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation: "2",
+						},
+					},
+					Spec: clusterv1.ClusterSpec{
+						Topology: clusterv1.Topology{
+							Workers: clusterv1.WorkersTopology{
+								Upgrade: clusterv1.WorkersTopologyUpgrade{
+									MaxConcurrency: ptr.To[int32](5),
+								},
+							},
+						},
+					},
+				},
+				want: 5,
+			},
 		}
 
 		for _, tt := range tests {
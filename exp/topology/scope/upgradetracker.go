@@ -121,6 +121,11 @@ type WorkerUpgradeTracker struct {
 	// - decide if the AfterClusterUpgrade hook can be called.
 	upgradingNames sets.Set[string]
 
+	// waitingForUpgradeConcurrencyNames is the set of MachineDeployment/MachinePool names that are not going to pick up
+	// the new version in the current reconcile loop because the maximum upgrade concurrency has been reached.
+	// Note: If a MachineDeployment/MachinePool is marked as waiting for upgrade concurrency it should also be marked as pendingUpgrade.
+	waitingForUpgradeConcurrencyNames sets.Set[string]
+
 	// maxUpgradeConcurrency defines the maximum number of MachineDeployments/MachinePools that should be in an
 	// upgrading state. This includes the MachineDeployments/MachinePools that are currently upgrading and the
 	// MachineDeployments/MachinePools that will start the upgrade after the current reconcile loop.
@@ -172,18 +177,20 @@ func NewUpgradeTracker(opts ...UpgradeTrackerOption) *UpgradeTracker {
 	}
 	return &UpgradeTracker{
 		MachineDeployments: WorkerUpgradeTracker{
-			pendingCreateTopologyNames: sets.Set[string]{},
-			pendingUpgradeNames:        sets.Set[string]{},
-			deferredNames:              sets.Set[string]{},
-			upgradingNames:             sets.Set[string]{},
-			maxUpgradeConcurrency:      options.maxMDUpgradeConcurrency,
+			pendingCreateTopologyNames:        sets.Set[string]{},
+			pendingUpgradeNames:               sets.Set[string]{},
+			deferredNames:                     sets.Set[string]{},
+			upgradingNames:                    sets.Set[string]{},
+			waitingForUpgradeConcurrencyNames: sets.Set[string]{},
+			maxUpgradeConcurrency:             options.maxMDUpgradeConcurrency,
 		},
 		MachinePools: WorkerUpgradeTracker{
-			pendingCreateTopologyNames: sets.Set[string]{},
-			pendingUpgradeNames:        sets.Set[string]{},
-			deferredNames:              sets.Set[string]{},
-			upgradingNames:             sets.Set[string]{},
-			maxUpgradeConcurrency:      options.maxMPUpgradeConcurrency,
+			pendingCreateTopologyNames:        sets.Set[string]{},
+			pendingUpgradeNames:               sets.Set[string]{},
+			deferredNames:                     sets.Set[string]{},
+			upgradingNames:                    sets.Set[string]{},
+			waitingForUpgradeConcurrencyNames: sets.Set[string]{},
+			maxUpgradeConcurrency:             options.maxMPUpgradeConcurrency,
 		},
 	}
 }
@@ -255,6 +262,11 @@ func (m *WorkerUpgradeTracker) UpgradingNames() []string {
 	return sets.List(m.upgradingNames)
 }
 
+// IsUpgrading returns true if the MachineDeployment/MachinePool is upgrading or about to upgrade.
+func (m *WorkerUpgradeTracker) IsUpgrading(name string) bool {
+	return m.upgradingNames.Has(name)
+}
+
 // IsAnyUpgrading returns true if any of the machine deployments are upgrading.
 // Returns false, otherwise.
 func (m *WorkerUpgradeTracker) IsAnyUpgrading() bool {
@@ -266,6 +278,23 @@ func (m *WorkerUpgradeTracker) UpgradeConcurrencyReached() bool {
 	return m.upgradingNames.Len() >= m.maxUpgradeConcurrency
 }
 
+// MaxUpgradeConcurrency returns the maximum number of MachineDeployments/MachinePools that can upgrade concurrently.
+func (m *WorkerUpgradeTracker) MaxUpgradeConcurrency() int {
+	return m.maxUpgradeConcurrency
+}
+
+// MarkWaitingForUpgradeConcurrency marks a MachineDeployment/MachinePool as waiting to pick up the new version
+// because the maximum upgrade concurrency has been reached.
+func (m *WorkerUpgradeTracker) MarkWaitingForUpgradeConcurrency(name string) {
+	m.waitingForUpgradeConcurrencyNames.Insert(name)
+}
+
+// WaitingForUpgradeConcurrencyNames returns the list of MachineDeployment/MachinePool names that are
+// waiting to pick up the new version because the maximum upgrade concurrency has been reached.
+func (m *WorkerUpgradeTracker) WaitingForUpgradeConcurrencyNames() []string {
+	return sets.List(m.waitingForUpgradeConcurrencyNames)
+}
+
 // MarkPendingCreate marks a machine deployment topology that is pending to be created.
 // This is generally used to capture machine deployments that are yet to be created
 // because the control plane is not yet stable.
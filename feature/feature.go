@@ -35,6 +35,12 @@ const (
 	// beta: v1.7
 	MachinePool featuregate.Feature = "MachinePool"
 
+	// MachinePoolMachines is a feature gate that allows the MachinePool controller to synthesize a Machine
+	// for each replica of a MachinePool whose infrastructure provider does not implement MachinePool Machines.
+	//
+	// alpha: v1.14
+	MachinePoolMachines featuregate.Feature = "MachinePoolMachines"
+
 	// ClusterTopology is a feature gate for the ClusterClass and managed topologies functionality.
 	//
 	// alpha: v0.4
@@ -89,6 +95,73 @@ const (
 	//
 	// alpha: v1.12
 	MachineTaintPropagation featuregate.Feature = "MachineTaintPropagation"
+
+	// KubeadmControlPlaneHibernation is a feature gate that allows to scale a KubeadmControlPlane to zero replicas,
+	// taking an etcd snapshot before deleting the last control plane Machine and restoring it on scale up.
+	//
+	// alpha: v1.14
+	KubeadmControlPlaneHibernation featuregate.Feature = "KubeadmControlPlaneHibernation"
+
+	// KubeadmBootstrapDataEncryption is a feature gate that allows to encrypt the bootstrap data generated
+	// by the kubeadm bootstrap provider using an encryption provider configured on the bootstrap provider.
+	//
+	// alpha: v1.14
+	KubeadmBootstrapDataEncryption featuregate.Feature = "KubeadmBootstrapDataEncryption"
+
+	// MachineBootstrapConfigSwap is a feature gate that allows to change the bootstrap config of existing Machines,
+	// so that Machines are re-bootstrapped with the new bootstrap data if supported by the infrastructure provider.
+	//
+	// alpha: v1.14
+	MachineBootstrapConfigSwap featuregate.Feature = "MachineBootstrapConfigSwap"
+
+	// ClusterClassOCISource is a feature gate that allows Clusters to use a ClusterClass pulled from
+	// a signed OCI artifact.
+	//
+	// alpha: v1.14
+	ClusterClassOCISource featuregate.Feature = "ClusterClassOCISource"
+
+	// ObjectTreeEndpoint is a feature gate that enables an endpoint on the metrics server of the core controller
+	// which serves the object tree computed by clusterctl describe as JSON.
+	//
+	// alpha: v1.14
+	ObjectTreeEndpoint featuregate.Feature = "ObjectTreeEndpoint"
+
+	// ProviderInventoryConditions is a feature gate that enables a controller setting conditions on the
+	// clusterctl Provider inventory objects, surfacing the health of provider installations.
+	//
+	// alpha: v1.14
+	ProviderInventoryConditions featuregate.Feature = "ProviderInventoryConditions"
+
+	// InClusterIPAM is a feature gate that enables the in-cluster IPAM provider, allocating IP addresses to IPAddressClaims
+	// from InClusterIPPools and GlobalInClusterIPPools.
+	//
+	// alpha: v1.14
+	InClusterIPAM featuregate.Feature = "InClusterIPAM"
+
+	// IPAddressLeakDetection is a feature gate that enables the detection of leaked IPAddressClaims and IPAddresses,
+	// e.g. IPAddressClaims whose owning Machines or infrastructure machines no longer exist, and optionally their
+	// garbage collection.
+	//
+	// alpha: v1.14
+	IPAddressLeakDetection featuregate.Feature = "IPAddressLeakDetection"
+
+	// ClusterAvailabilityRollup is a feature gate that allows to roll up conditions of provider objects belonging to a
+	// Cluster into the Cluster's Available condition, for CRDs annotated with the availability-conditions annotation.
+	//
+	// alpha: v1.14
+	ClusterAvailabilityRollup featuregate.Feature = "ClusterAvailabilityRollup"
+
+	// ControllerSharding is a feature gate that allows to run multiple active replicas of the core manager,
+	// each of them reconciling the objects of a disjoint set of Clusters (shard).
+	//
+	// alpha: v1.14
+	ControllerSharding featuregate.Feature = "ControllerSharding"
+
+	// ClusterClassChannels is a feature gate that allows Clusters to follow a release channel of a ClusterClass,
+	// so they are progressively updated to the newest ClusterClass release published to the channel.
+	//
+	// alpha: v1.14
+	ClusterClassChannels featuregate.Feature = "ClusterClassChannels"
 )
 
 func init() {
@@ -109,4 +182,16 @@ var defaultClusterAPIFeatureGates = map[featuregate.Feature]featuregate.FeatureS
 	RuntimeSDK:                     {Default: false, PreRelease: featuregate.Alpha},
 	InPlaceUpdates:                 {Default: false, PreRelease: featuregate.Alpha},
 	MachineTaintPropagation:        {Default: false, PreRelease: featuregate.Alpha},
+	MachinePoolMachines:            {Default: false, PreRelease: featuregate.Alpha},
+	KubeadmControlPlaneHibernation: {Default: false, PreRelease: featuregate.Alpha},
+	KubeadmBootstrapDataEncryption: {Default: false, PreRelease: featuregate.Alpha},
+	MachineBootstrapConfigSwap:     {Default: false, PreRelease: featuregate.Alpha},
+	ClusterClassOCISource:          {Default: false, PreRelease: featuregate.Alpha},
+	ObjectTreeEndpoint:             {Default: false, PreRelease: featuregate.Alpha},
+	ProviderInventoryConditions:    {Default: false, PreRelease: featuregate.Alpha},
+	InClusterIPAM:                  {Default: false, PreRelease: featuregate.Alpha},
+	IPAddressLeakDetection:         {Default: false, PreRelease: featuregate.Alpha},
+	ClusterAvailabilityRollup:      {Default: false, PreRelease: featuregate.Alpha},
+	ControllerSharding:             {Default: false, PreRelease: featuregate.Alpha},
+	ClusterClassChannels:           {Default: false, PreRelease: featuregate.Alpha},
 }
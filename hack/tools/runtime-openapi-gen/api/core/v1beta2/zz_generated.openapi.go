@@ -36,10 +36,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClass":                                             schema_cluster_api_api_core_v1beta2_ClusterClass(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassDeprecatedStatus":                             schema_cluster_api_api_core_v1beta2_ClusterClassDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassList":                                         schema_cluster_api_api_core_v1beta2_ClusterClassList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassOCISource":                                    schema_cluster_api_api_core_v1beta2_ClusterClassOCISource(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassPatch":                                        schema_cluster_api_api_core_v1beta2_ClusterClassPatch(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassRef":                                          schema_cluster_api_api_core_v1beta2_ClusterClassRef(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassRelease":                                      schema_cluster_api_api_core_v1beta2_ClusterClassRelease(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassReleaseRollout":                               schema_cluster_api_api_core_v1beta2_ClusterClassReleaseRollout(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassSpec":                                         schema_cluster_api_api_core_v1beta2_ClusterClassSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatus":                                       schema_cluster_api_api_core_v1beta2_ClusterClassStatus(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusDiscoveredVariables":                    schema_cluster_api_api_core_v1beta2_ClusterClassStatusDiscoveredVariables(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusVariable":                               schema_cluster_api_api_core_v1beta2_ClusterClassStatusVariable(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusVariableDefinition":                     schema_cluster_api_api_core_v1beta2_ClusterClassStatusVariableDefinition(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassTemplateReference":                            schema_cluster_api_api_core_v1beta2_ClusterClassTemplateReference(ref),
@@ -48,6 +52,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassV1Beta1DeprecatedStatus":                      schema_cluster_api_api_core_v1beta2_ClusterClassV1Beta1DeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariable":                                     schema_cluster_api_api_core_v1beta2_ClusterClassVariable(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMetadata":                             schema_cluster_api_api_core_v1beta2_ClusterClassVariableMetadata(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMigration":                            schema_cluster_api_api_core_v1beta2_ClusterClassVariableMigration(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterControlPlaneStatus":                                schema_cluster_api_api_core_v1beta2_ClusterControlPlaneStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterDeprecatedStatus":                                  schema_cluster_api_api_core_v1beta2_ClusterDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterInitializationStatus":                              schema_cluster_api_api_core_v1beta2_ClusterInitializationStatus(ref),
@@ -186,6 +191,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersClass":                                             schema_cluster_api_api_core_v1beta2_WorkersClass(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersStatus":                                            schema_cluster_api_api_core_v1beta2_WorkersStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopology":                                          schema_cluster_api_api_core_v1beta2_WorkersTopology(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopologyUpgrade":                                   schema_cluster_api_api_core_v1beta2_WorkersTopologyUpgrade(ref),
 	}
 }
 
@@ -447,6 +453,41 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassList(ref common.ReferenceCa
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_ClusterClassOCISource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterClassOCISource defines the OCI artifact containing a ClusterClass and the templates it references.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "url is the URL of the OCI artifact, in the form oci://<registry>/<repository>:<tag> or oci://<registry>/<repository>@sha256:<digest>. If a tag is used, the artifact is pulled again when the tag points to a different digest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publicKeySecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "publicKeySecretName is the name of a Secret in the namespace of the Cluster with the public key used to verify the cosign signature of the OCI artifact under the cosign.pub key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "credentialsSecretName is the name of a Secret in the namespace of the Cluster with the username and password keys used to authenticate to the OCI registry. If not set, the OCI artifact is pulled anonymously.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "publicKeySecretName"},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_ClusterClassPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -523,6 +564,13 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassRef(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "channel is the release channel followed by the Cluster, e.g. stable or fast. When set, the Cluster is progressively updated to the newest release published to the channel, i.e. name is set to the name of the ClusterClass with the highest spec.release.version among the ClusterClasses with the same spec.release.family as the ClusterClass currently referenced by name and with the channel in spec.release.channels. channel cannot be set together with oci. This field can only be used if the ClusterClassChannels feature gate is enabled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
 							Description: "namespace is the namespace of the ClusterClass that should be used for the topology. If namespace is empty or not set, it is defaulted to the namespace of the Cluster object. namespace must be a valid namespace name and because of that be at most 63 characters in length and it must consist only of lower case alphanumeric characters or hyphens (-), and must start and end with an alphanumeric character.",
@@ -530,10 +578,103 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassRef(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "oci configures the ClusterClass to be pulled from a versioned bundle stored in an OCI registry. When set, the ClusterClass and the templates it references are pulled from the OCI artifact, the cosign signature of the artifact is verified, and the objects are created in the namespace of the Cluster; namespace must be empty or equal to the namespace of the Cluster. The OCI artifact must contain exactly one ClusterClass with the name defined in name. This field can only be used if the ClusterClassOCISource feature gate is enabled.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassOCISource"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassOCISource"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterClassRelease(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterClassRelease defines the release of a ClusterClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"family": {
+						SchemaProps: spec.SchemaProps{
+							Description: "family is the name shared by all the releases of a ClusterClass, e.g. quick-start. Clusters following a channel are only updated to releases of the same family in the same namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "version is the version of the release, e.g. v1.2.0. version must be a valid semantic version; releases of the same family are ordered by version.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"channels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "channels is the list of channels the release is published to, e.g. stable or fast.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rollout defines how Clusters following a channel are updated to the release.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassReleaseRollout"),
+						},
+					},
+				},
+				Required: []string{"family", "version"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassReleaseRollout"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterClassReleaseRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterClassReleaseRollout defines how Clusters following a channel are updated to a ClusterClass release.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxConcurrency is the maximum number of Clusters being updated to the release at the same time. A Cluster is considered as being updated until its topology is reconciled, it is available, it is not rolling out and soakTimeSeconds have passed. If not set, Clusters are updated one at a time.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"soakTimeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "soakTimeSeconds is the time a Cluster updated to the release must be stable before it is no longer considered as being updated. If not set, a Cluster is no longer considered as being updated as soon as it is stable.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -652,12 +793,19 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"release": {
+						SchemaProps: spec.SchemaProps{
+							Description: "release defines the release of the ClusterClass and the channels the release is published to. Clusters following one of the channels via spec.topology.classRef.channel are progressively updated to the newest release of the same family published to the channel. This field can only be used if the ClusterClassChannels feature gate is enabled.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassRelease"),
+						},
+					},
 				},
 				Required: []string{"infrastructure", "controlPlane"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterAvailabilityGate", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassPatch", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassUpgrade", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariable", "sigs.k8s.io/cluster-api/api/core/v1beta2.ControlPlaneClass", "sigs.k8s.io/cluster-api/api/core/v1beta2.InfrastructureClass", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersClass"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterAvailabilityGate", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassPatch", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassRelease", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassUpgrade", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariable", "sigs.k8s.io/cluster-api/api/core/v1beta2.ControlPlaneClass", "sigs.k8s.io/cluster-api/api/core/v1beta2.InfrastructureClass", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersClass"},
 	}
 }
 
@@ -709,6 +857,28 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassStatus(ref common.Reference
 							},
 						},
 					},
+					"discoveredVariables": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"patchName",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "discoveredVariables lists the variables discovered from Runtime Extensions via the DiscoverVariables hook for each external patch of the ClusterClass.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusDiscoveredVariables"),
+									},
+								},
+							},
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "observedGeneration is the latest generation observed by the controller.",
@@ -726,7 +896,55 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassStatus(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusVariable"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusDiscoveredVariables", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassStatusVariable"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterClassStatusDiscoveredVariables(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterClassStatusDiscoveredVariables defines the variables discovered from a Runtime Extension for an external patch.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patchName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "patchName is the name of the external patch the variables have been discovered for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extension": {
+						SchemaProps: spec.SchemaProps{
+							Description: "extension is the name of the DiscoverVariables extension handler which returned the variables.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"variables": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "variables is the list of the names of the variables returned by the extension.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"patchName", "extension"},
+			},
+		},
 	}
 }
 
@@ -964,12 +1182,54 @@ func schema_cluster_api_api_core_v1beta2_ClusterClassVariable(ref common.Referen
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchema"),
 						},
 					},
+					"migration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "migration defines how the value of this variable is migrated when the classRef of a Cluster is changed to this ClusterClass, e.g. when a Cluster is moved to a new version of a ClusterClass in which the variable has been renamed, its default value has changed or its type has been widened.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMigration"),
+						},
+					},
 				},
 				Required: []string{"name", "required", "schema"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMetadata", "sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchema"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMetadata", "sigs.k8s.io/cluster-api/api/core/v1beta2.ClusterClassVariableMigration", "sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchema"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_ClusterClassVariableMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterClassVariableMigration defines how the value of a variable is migrated when the classRef of a Cluster is changed to the ClusterClass defining the variable. Migrations are applied to Cluster.spec.topology.variables and to all variable overrides.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"renamedFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "renamedFrom is the name of the variable in the ClusterClass previously used by the Cluster. If the Cluster sets a value for renamedFrom, the value is moved to this variable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"previousDefault": {
+						SchemaProps: spec.SchemaProps{
+							Description: "previousDefault is the default value of the variable in the ClusterClass previously used by the Cluster. If the Cluster sets the variable to previousDefault, the value is dropped, so the default value defined in this ClusterClass is applied instead.",
+							Ref:         ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
+						},
+					},
+					"previousType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "previousType is the type of the variable in the ClusterClass previously used by the Cluster. Supported type widenings are from integer to number, and from a scalar type to an array with items of the same type; in the latter case the value is wrapped into an array.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"},
 	}
 }
 
@@ -2011,6 +2271,13 @@ func schema_cluster_api_api_core_v1beta2_ControlPlaneTopology(ref common.Referen
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.ControlPlaneVariables"),
 						},
 					},
+					"externallyManaged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "externallyManaged specifies if the ControlPlane referenced by spec.controlPlaneRef is managed out-of-band, e.g. a hosted control plane managed by a service outside of Cluster API.\n\nIf true, spec.controlPlaneRef must be set, and the topology controller does not create or update the ControlPlane, its InfrastructureMachineTemplate and its MachineHealthCheck; the topology controller only manages the InfrastructureCluster and the workers. The version of the ControlPlane is read-only: the ControlPlane is expected to be upgraded out-of-band to spec.topology.version, and workers are upgraded only after the ControlPlane reached the new version.\n\nThis field cannot be changed after the Cluster has been created.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2033,6 +2300,13 @@ func schema_cluster_api_api_core_v1beta2_ControlPlaneTopologyHealthCheck(ref com
 							Format:      "",
 						},
 					},
+					"overrideStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "overrideStrategy defines how checks and remediation defined in the Cluster topology are combined with the corresponding fields in ClusterClass.\n\nIf Replace (default): checks and remediation from Cluster are used instead of the corresponding fields in ClusterClass.\n\nIf Merge: fields set in checks and remediation from Cluster override the corresponding fields in ClusterClass, while fields which are not set are inherited from ClusterClass. Entries of unhealthyNodeConditions and unhealthyMachineConditions override the entries of the ClusterClass with the same type and status, while other entries are added; triggerIf is overridden as a whole if any of its fields is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"checks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "checks are the checks that are used to evaluate if a Machine is healthy.\n\nIf one of checks and remediation fields are set, the system assumes that an healthCheck override is defined, and as a consequence the checks and remediation fields from Cluster will be used instead of the corresponding fields in ClusterClass.\n\nIndependent of this configuration the MachineHealthCheck controller will always flag Machines with `cluster.x-k8s.io/remediate-machine` annotation and Machines with deleted Nodes as unhealthy.\n\nFurthermore, if checks.nodeStartupTimeoutSeconds is not set it is defaulted to 10 minutes and evaluated accordingly.",
@@ -3943,6 +4217,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentTopology(ref common.Re
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentTopologyRolloutSpec"),
 						},
 					},
+					"upgradeGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "upgradeGroup is the group of the MachineDeployment in the upgrade sequence of the Cluster's MachineDeployments. When the Kubernetes version of the Cluster is upgraded, MachineDeployments are upgraded in ascending order of upgradeGroup; the MachineDeployments of a group are upgraded only after all the MachineDeployments of the lower groups have been upgraded and are available. MachineDeployments without an upgradeGroup belong to group 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"variables": {
 						SchemaProps: spec.SchemaProps{
 							Description: "variables can be used to customize the MachineDeployment through patches.",
@@ -3973,6 +4254,13 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentTopologyHealthCheck(re
 							Format:      "",
 						},
 					},
+					"overrideStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "overrideStrategy defines how checks and remediation defined in the Cluster topology are combined with the corresponding fields in ClusterClass.\n\nIf Replace (default): checks and remediation from Cluster are used instead of the corresponding fields in ClusterClass.\n\nIf Merge: fields set in checks and remediation from Cluster override the corresponding fields in ClusterClass, while fields which are not set are inherited from ClusterClass. Entries of unhealthyNodeConditions and unhealthyMachineConditions override the entries of the ClusterClass with the same type and status, while other entries are added; triggerIf is overridden as a whole if any of its fields is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"checks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "checks are the checks that are used to evaluate if a Machine is healthy.\n\nIf one of checks and remediation fields are set, the system assumes that an healthCheck override is defined, and as a consequence the checks and remediation fields from Cluster will be used instead of the corresponding fields in ClusterClass.\n\nIndependent of this configuration the MachineHealthCheck controller will always flag Machines with `cluster.x-k8s.io/remediate-machine` annotation and Machines with deleted Nodes as unhealthy.\n\nFurthermore, if checks.nodeStartupTimeoutSeconds is not set it is defaulted to 10 minutes and evaluated accordingly.",
@@ -7180,7 +7468,7 @@ func schema_cluster_api_api_core_v1beta2_ValidationRule(ref common.ReferenceCall
 				Properties: map[string]spec.Schema{
 					"rule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "rule represents the expression which will be evaluated by CEL. ref: https://github.com/google/cel-spec The Rule is scoped to the location of the x-kubernetes-validations extension in the schema. The `self` variable in the CEL expression is bound to the scoped value. If the Rule is scoped to an object with properties, the accessible properties of the object are field selectable via `self.field` and field presence can be checked via `has(self.field)`. If the Rule is scoped to an object with additionalProperties (i.e. a map) the value of the map are accessible via `self[mapKey]`, map containment can be checked via `mapKey in self` and all entries of the map are accessible via CEL macros and functions such as `self.all(...)`. If the Rule is scoped to an array, the elements of the array are accessible via `self[i]` and also by macros and functions. If the Rule is scoped to a scalar, `self` is bound to the scalar value. Examples: - Rule scoped to a map of objects: {\"rule\": \"self.components['Widget'].priority < 10\"} - Rule scoped to a list of integers: {\"rule\": \"self.values.all(value, value >= 0 && value < 100)\"} - Rule scoped to a string value: {\"rule\": \"self.startsWith('kube')\"}\n\nUnknown data preserved in custom resources via x-kubernetes-preserve-unknown-fields is not accessible in CEL expressions. This includes: - Unknown field values that are preserved by object schemas with x-kubernetes-preserve-unknown-fields. - Object properties where the property schema is of an \"unknown type\". An \"unknown type\" is recursively defined as:\n  - A schema with no type and x-kubernetes-preserve-unknown-fields set to true\n  - An array where the items schema is of an \"unknown type\"\n  - An object where the additionalProperties schema is of an \"unknown type\"\n\nOnly property names of the form `[a-zA-Z_.-/][a-zA-Z0-9_.-/]*` are accessible. Accessible property names are escaped according to the following rules when accessed in the expression: - '__' escapes to '__underscores__' - '.' escapes to '__dot__' - '-' escapes to '__dash__' - '/' escapes to '__slash__' - Property names that exactly match a CEL RESERVED keyword escape to '__{keyword}__'. The keywords are:\n\t  \"true\", \"false\", \"null\", \"in\", \"as\", \"break\", \"const\", \"continue\", \"else\", \"for\", \"function\", \"if\",\n\t  \"import\", \"let\", \"loop\", \"package\", \"namespace\", \"return\".\nExamples:\n  - Rule accessing a property named \"namespace\": {\"rule\": \"self.__namespace__ > 0\"}\n  - Rule accessing a property named \"x-prop\": {\"rule\": \"self.x__dash__prop > 0\"}\n  - Rule accessing a property named \"redact__d\": {\"rule\": \"self.redact__underscores__d > 0\"}\n\nIf `rule` makes use of the `oldSelf` variable it is implicitly a `transition rule`.\n\nBy default, the `oldSelf` variable is the same type as `self`.\n\nTransition rules by default are applied only on UPDATE requests and are skipped if an old value could not be found.",
+							Description: "rule represents the expression which will be evaluated by CEL. ref: https://github.com/google/cel-spec The Rule is scoped to the location of the x-kubernetes-validations extension in the schema. The `self` variable in the CEL expression is bound to the scoped value. If the Rule is scoped to an object with properties, the accessible properties of the object are field selectable via `self.field` and field presence can be checked via `has(self.field)`. If the Rule is scoped to an object with additionalProperties (i.e. a map) the value of the map are accessible via `self[mapKey]`, map containment can be checked via `mapKey in self` and all entries of the map are accessible via CEL macros and functions such as `self.all(...)`. If the Rule is scoped to an array, the elements of the array are accessible via `self[i]` and also by macros and functions. If the Rule is scoped to a scalar, `self` is bound to the scalar value. Examples: - Rule scoped to a map of objects: {\"rule\": \"self.components['Widget'].priority < 10\"} - Rule scoped to a list of integers: {\"rule\": \"self.values.all(value, value >= 0 && value < 100)\"} - Rule scoped to a string value: {\"rule\": \"self.startsWith('kube')\"}\n\nUnknown data preserved in custom resources via x-kubernetes-preserve-unknown-fields is not accessible in CEL expressions. This includes: - Unknown field values that are preserved by object schemas with x-kubernetes-preserve-unknown-fields. - Object properties where the property schema is of an \"unknown type\". An \"unknown type\" is recursively defined as:\n  - A schema with no type and x-kubernetes-preserve-unknown-fields set to true\n  - An array where the items schema is of an \"unknown type\"\n  - An object where the additionalProperties schema is of an \"unknown type\"\n\nOnly property names of the form `[a-zA-Z_.-/][a-zA-Z0-9_.-/]*` are accessible. Accessible property names are escaped according to the following rules when accessed in the expression: - '__' escapes to '__underscores__' - '.' escapes to '__dot__' - '-' escapes to '__dash__' - '/' escapes to '__slash__' - Property names that exactly match a CEL RESERVED keyword escape to '__{keyword}__'. The keywords are:\n\t  \"true\", \"false\", \"null\", \"in\", \"as\", \"break\", \"const\", \"continue\", \"else\", \"for\", \"function\", \"if\",\n\t  \"import\", \"let\", \"loop\", \"package\", \"namespace\", \"return\".\nExamples:\n  - Rule accessing a property named \"namespace\": {\"rule\": \"self.__namespace__ > 0\"}\n  - Rule accessing a property named \"x-prop\": {\"rule\": \"self.x__dash__prop > 0\"}\n  - Rule accessing a property named \"redact__d\": {\"rule\": \"self.redact__underscores__d > 0\"}\n\nIf `rule` makes use of the `oldSelf` variable it is implicitly a `transition rule`.\n\nBy default, the `oldSelf` variable is the same type as `self`.\n\nTransition rules by default are applied only on UPDATE requests and are skipped if an old value could not be found. You can opt a transition rule into unconditional evaluation by setting `optionalOldSelf` to true.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -7214,6 +7502,13 @@ func schema_cluster_api_api_core_v1beta2_ValidationRule(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"optionalOldSelf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "optionalOldSelf is used to opt a transition rule into evaluation even when the variable value is first set, or if the old value is missing the value.\n\nWhen enabled `oldSelf` will be a CEL optional whose value will be `None` if there is no old value, or when the variable value is initially set.\n\nYou may check for presence of oldSelf using `oldSelf.hasValue()` and unwrap it after checking using `oldSelf.value()`. Check the CEL documentation for Optional types for more information: https://pkg.go.dev/github.com/google/cel-go/cel#OptionalTypes\n\nMay not be set unless `oldSelf` is used in `rule`.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"rule"},
 			},
@@ -7487,10 +7782,37 @@ func schema_cluster_api_api_core_v1beta2_WorkersTopology(ref common.ReferenceCal
 							},
 						},
 					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "upgrade allows to tune the upgrade of MachineDeployments and MachinePools in the cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopologyUpgrade"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentTopology", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolTopology"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentTopology", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolTopology", "sigs.k8s.io/cluster-api/api/core/v1beta2.WorkersTopologyUpgrade"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_WorkersTopologyUpgrade(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkersTopologyUpgrade allows to tune the upgrade of MachineDeployments and MachinePools in the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxConcurrency is the maximum number of MachineDeployments and the maximum number of MachinePools that can be upgraded concurrently; the limit applies separately to MachineDeployments and MachinePools. MachineDeployments and MachinePools waiting for other upgrades to complete are reported in the TopologyReconciled condition. If not set, the value of the topology.cluster.x-k8s.io/upgrade-concurrency annotation is used, or 1 if the annotation is not set either.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
@@ -32,6 +32,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterClusterUpgradeResponse":                          schema_api_runtime_hooks_v1alpha1_AfterClusterUpgradeResponse(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterControlPlaneInitializedRequest":                  schema_api_runtime_hooks_v1alpha1_AfterControlPlaneInitializedRequest(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterControlPlaneInitializedResponse":                 schema_api_runtime_hooks_v1alpha1_AfterControlPlaneInitializedResponse(ref),
+		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterControlPlaneMachineUpgradeRequest":               schema_api_runtime_hooks_v1alpha1_AfterControlPlaneMachineUpgradeRequest(ref),
+		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterControlPlaneMachineUpgradeResponse":              schema_api_runtime_hooks_v1alpha1_AfterControlPlaneMachineUpgradeResponse(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterControlPlaneUpgradeRequest":                      schema_api_runtime_hooks_v1alpha1_AfterControlPlaneUpgradeRequest(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterControlPlaneUpgradeResponse":                     schema_api_runtime_hooks_v1alpha1_AfterControlPlaneUpgradeResponse(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.AfterWorkersUpgradeRequest":                           schema_api_runtime_hooks_v1alpha1_AfterWorkersUpgradeRequest(ref),
@@ -46,7 +48,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.BeforeControlPlaneUpgradeResponse":                    schema_api_runtime_hooks_v1alpha1_BeforeControlPlaneUpgradeResponse(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.BeforeWorkersUpgradeRequest":                          schema_api_runtime_hooks_v1alpha1_BeforeWorkersUpgradeRequest(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.BeforeWorkersUpgradeResponse":                         schema_api_runtime_hooks_v1alpha1_BeforeWorkersUpgradeResponse(ref),
+		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.BlockingReason":                                       schema_api_runtime_hooks_v1alpha1_BlockingReason(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.Builtins":                                             schema_api_runtime_hooks_v1alpha1_Builtins(ref),
+		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanCreateMachineRequest":                              schema_api_runtime_hooks_v1alpha1_CanCreateMachineRequest(ref),
+		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanCreateMachineResponse":                             schema_api_runtime_hooks_v1alpha1_CanCreateMachineResponse(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanUpdateMachineRequest":                              schema_api_runtime_hooks_v1alpha1_CanUpdateMachineRequest(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanUpdateMachineRequestObjects":                       schema_api_runtime_hooks_v1alpha1_CanUpdateMachineRequestObjects(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanUpdateMachineResponse":                             schema_api_runtime_hooks_v1alpha1_CanUpdateMachineResponse(ref),
@@ -54,6 +59,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanUpdateMachineSetRequestObjects":                    schema_api_runtime_hooks_v1alpha1_CanUpdateMachineSetRequestObjects(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.CanUpdateMachineSetResponse":                          schema_api_runtime_hooks_v1alpha1_CanUpdateMachineSetResponse(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterBuiltins":                                      schema_api_runtime_hooks_v1alpha1_ClusterBuiltins(ref),
+		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterDescendant":                                    schema_api_runtime_hooks_v1alpha1_ClusterDescendant(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterNetworkBuiltins":                               schema_api_runtime_hooks_v1alpha1_ClusterNetworkBuiltins(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterTopologyBuiltins":                              schema_api_runtime_hooks_v1alpha1_ClusterTopologyBuiltins(ref),
 		"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterTopologyClusterClassRefBuiltins":               schema_api_runtime_hooks_v1alpha1_ClusterTopologyClusterClassRefBuiltins(ref),
@@ -305,6 +311,126 @@ func schema_api_runtime_hooks_v1alpha1_AfterControlPlaneInitializedResponse(ref
 	}
 }
 
+func schema_api_runtime_hooks_v1alpha1_AfterControlPlaneMachineUpgradeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AfterControlPlaneMachineUpgradeRequest is the request of the AfterControlPlaneMachineUpgrade hook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "settings defines key value pairs to be passed to the call.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cluster is the cluster object the lifecycle hook corresponds to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster"),
+						},
+					},
+					"machine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machine is the control plane machine that completed the upgrade.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.Machine"),
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kubernetesVersion is the Kubernetes version of the control plane machine after the upgrade.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"cluster", "machine", "kubernetesVersion"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster", "sigs.k8s.io/cluster-api/api/core/v1beta2.Machine"},
+	}
+}
+
+func schema_api_runtime_hooks_v1alpha1_AfterControlPlaneMachineUpgradeResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AfterControlPlaneMachineUpgradeResponse is the response of the AfterControlPlaneMachineUpgrade hook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status of the call. One of \"Success\" or \"Failure\".\n\nPossible enum values:\n - `\"Failure\"` represents a failure response.\n - `\"Success\"` represents a success response.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Failure", "Success"},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human-readable description of the status of the call.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "retryAfterSeconds when set to a non-zero value signifies that the hook will be called again at a future time.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"status", "retryAfterSeconds"},
+			},
+		},
+	}
+}
+
 func schema_api_runtime_hooks_v1alpha1_AfterControlPlaneUpgradeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -736,12 +862,26 @@ func schema_api_runtime_hooks_v1alpha1_BeforeClusterDeleteRequest(ref common.Ref
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster"),
 						},
 					},
+					"descendants": {
+						SchemaProps: spec.SchemaProps{
+							Description: "descendants is the inventory of the objects belonging to the Cluster that are going to be deleted together with the Cluster, e.g. the InfrastructureCluster, the ControlPlane, MachineDeployments, MachinePools and Machines.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterDescendant"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"cluster"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster", "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterDescendant"},
 	}
 }
 
@@ -790,10 +930,26 @@ func schema_api_runtime_hooks_v1alpha1_BeforeClusterDeleteResponse(ref common.Re
 							Format:      "int32",
 						},
 					},
+					"blockingReasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "blockingReasons is a structured list of the reasons why the Cluster deletion is blocked. Blocking reasons are considered only when retryAfterSeconds is set to a non-zero value, and they are surfaced in the Deleting condition of the Cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.BlockingReason"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"status", "retryAfterSeconds"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.BlockingReason"},
 	}
 }
 
@@ -1244,6 +1400,43 @@ func schema_api_runtime_hooks_v1alpha1_BeforeWorkersUpgradeResponse(ref common.R
 	}
 }
 
+func schema_api_runtime_hooks_v1alpha1_BlockingReason(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlockingReason describes why a Runtime Extension is blocking an operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "reason is a CamelCase, machine-readable reason for blocking the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human-readable description of why the operation is blocked.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"object": {
+						SchemaProps: spec.SchemaProps{
+							Description: "object is the object blocking the operation, if any.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterDescendant"),
+						},
+					},
+				},
+				Required: []string{"message"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1.ClusterDescendant"},
+	}
+}
+
 func schema_api_runtime_hooks_v1alpha1_Builtins(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1283,6 +1476,118 @@ func schema_api_runtime_hooks_v1alpha1_Builtins(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_api_runtime_hooks_v1alpha1_CanCreateMachineRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanCreateMachineRequest is the request of the CanCreateMachine hook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "settings defines key value pairs to be passed to the call.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cluster is the Cluster object the MachineSet belongs to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster"),
+						},
+					},
+					"machineSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machineSet is the MachineSet object which is going to create Machines.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSet"),
+						},
+					},
+				},
+				Required: []string{"cluster", "machineSet"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.Cluster", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSet"},
+	}
+}
+
+func schema_api_runtime_hooks_v1alpha1_CanCreateMachineResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanCreateMachineResponse is the response of the CanCreateMachine hook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status of the call. One of \"Success\" or \"Failure\".\n\nPossible enum values:\n - `\"Failure\"` represents a failure response.\n - `\"Success\"` represents a success response.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Failure", "Success"},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human-readable description of the status of the call.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "retryAfterSeconds when set to a non-zero value signifies that the hook will be called again at a future time.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"status", "retryAfterSeconds"},
+			},
+		},
+	}
+}
+
 func schema_api_runtime_hooks_v1alpha1_CanUpdateMachineRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1661,6 +1966,41 @@ func schema_api_runtime_hooks_v1alpha1_ClusterBuiltins(ref common.ReferenceCallb
 	}
 }
 
+func schema_api_runtime_hooks_v1alpha1_ClusterDescendant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterDescendant is a reference to an object belonging to a Cluster. Note: descendants always belong to the same namespace of the Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiVersion of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kind of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+		},
+	}
+}
+
 func schema_api_runtime_hooks_v1alpha1_ClusterNetworkBuiltins(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2268,7 +2608,7 @@ func schema_api_runtime_hooks_v1alpha1_GeneratePatchesRequest(ref common.Referen
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "items is the list of templates to generate patches for.",
+							Description: "items is the list of templates to generate patches for. Items are sorted: first the templates of the Cluster and of the ControlPlane, then the templates of the MachineDeployments and eventually the templates of the MachinePools, each sorted by topology name.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2319,7 +2659,7 @@ func schema_api_runtime_hooks_v1alpha1_GeneratePatchesRequestItem(ref common.Ref
 					},
 					"variables": {
 						SchemaProps: spec.SchemaProps{
-							Description: "variables are variables specific for the current template. For example some builtin variables like MachineDeployment replicas and version are context-sensitive and thus are only added to templates for MachineDeployments and with values which correspond to the current MachineDeployment.",
+							Description: "variables are variables specific for the current template. For example some builtin variables like MachineDeployment or MachinePool replicas and version are context-sensitive and thus are only added to templates for MachineDeployments or MachinePools and with values which correspond to the current MachineDeployment or MachinePool.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2619,7 +2959,7 @@ func schema_api_runtime_hooks_v1alpha1_HolderReference(ref common.ReferenceCallb
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HolderReference represents a reference to an object which holds a template.",
+				Description: "HolderReference represents a reference to an object which holds a template. MachineDeployments and MachinePools use the same fieldPaths for their bootstrap and infrastructure templates, see BootstrapConfigRefHolderFieldPath and InfrastructureRefHolderFieldPath. NOTE: Templates of a MachineDeployment are used to generate templates, while templates of a MachinePool are used to generate the BootstrapConfig and the InfrastructureMachinePool objects. Thus patches to the metadata of templates held by MachinePools are dropped, while patches to spec.template.metadata are applied to the metadata of the objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"apiVersion": {
@@ -3216,7 +3556,7 @@ func schema_api_runtime_hooks_v1alpha1_ValidateTopologyRequestItem(ref common.Re
 					},
 					"variables": {
 						SchemaProps: spec.SchemaProps{
-							Description: "variables are variables specific for the current template. For example some builtin variables like MachineDeployment replicas and version are context-sensitive and thus are only added to templates for MachineDeployments and with values which correspond to the current MachineDeployment.",
+							Description: "variables are variables specific for the current template. For example some builtin variables like MachineDeployment or MachinePool replicas and version are context-sensitive and thus are only added to templates for MachineDeployments or MachinePools and with values which correspond to the current MachineDeployment or MachinePool.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
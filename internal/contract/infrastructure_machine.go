@@ -106,6 +106,20 @@ func (m *InfrastructureMachineContract) FailureDomain() *String {
 	}
 }
 
+// DiagnosticsLogsSecretName provides access to the status.diagnostics.logsSecretName field in an InfrastructureMachine object. Note that this field is optional.
+func (m *InfrastructureMachineContract) DiagnosticsLogsSecretName() *String {
+	return &String{
+		path: []string{"status", "diagnostics", "logsSecretName"},
+	}
+}
+
+// DiagnosticsLogsURL provides access to the status.diagnostics.logsURL field in an InfrastructureMachine object. Note that this field is optional.
+func (m *InfrastructureMachineContract) DiagnosticsLogsURL() *String {
+	return &String{
+		path: []string{"status", "diagnostics", "logsURL"},
+	}
+}
+
 // MachineAddresses represents an accessor to a []clusterv1.MachineAddress path value.
 type MachineAddresses struct {
 	path Path
@@ -140,4 +140,30 @@ func TestInfrastructureMachine(t *testing.T) {
 		g.Expect(got).ToNot(BeNil())
 		g.Expect(*got).To(Equal("fake-failure-domain"))
 	})
+	t.Run("Manages optional status.diagnostics.logsSecretName", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachine().DiagnosticsLogsSecretName().Path()).To(Equal(Path{"status", "diagnostics", "logsSecretName"}))
+
+		err := InfrastructureMachine().DiagnosticsLogsSecretName().Set(obj, "fake-logs")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := InfrastructureMachine().DiagnosticsLogsSecretName().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(*got).To(Equal("fake-logs"))
+	})
+	t.Run("Manages optional status.diagnostics.logsURL", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(InfrastructureMachine().DiagnosticsLogsURL().Path()).To(Equal(Path{"status", "diagnostics", "logsURL"}))
+
+		err := InfrastructureMachine().DiagnosticsLogsURL().Set(obj, "https://example.com/console")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := InfrastructureMachine().DiagnosticsLogsURL().Get(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).ToNot(BeNil())
+		g.Expect(*got).To(Equal("https://example.com/console"))
+	})
 }
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contract
+
+import "sync"
+
+// RemediationRequestContract encodes information about the Cluster API contract for external remediation requests,
+// i.e. the objects created by the MachineHealthCheck controller from a remediation template.
+type RemediationRequestContract struct{}
+
+var remediationRequest *RemediationRequestContract
+var onceRemediationRequest sync.Once
+
+// RemediationRequest provide access to the information about the Cluster API contract for external remediation requests.
+func RemediationRequest() *RemediationRequestContract {
+	onceRemediationRequest.Do(func() {
+		remediationRequest = &RemediationRequestContract{}
+	})
+	return remediationRequest
+}
+
+// RemediatedConditionType returns the type of the condition surfacing the result of the remediation.
+// If the condition is True, remediation succeeded; if the condition is False with the reason returned by
+// RemediationFailedReason, remediation failed; in all the other cases remediation is in progress.
+// Note: The condition is optional; if an external remediation request does not report it, remediation
+// is considered in progress until the Machine is healthy again or it is deleted.
+func (r *RemediationRequestContract) RemediatedConditionType() string {
+	return "Remediated"
+}
+
+// RemediationFailedReason returns the reason of the Remediated condition surfacing that remediation failed.
+func (r *RemediationRequestContract) RemediationFailedReason() string {
+	return "RemediationFailed"
+}
@@ -157,6 +157,8 @@ func MarkAsOkToDelete(ctx context.Context, c client.Client, obj client.Object, u
 		annotations = map[string]string{}
 	}
 	annotations[runtimev1.OkToDeleteAnnotation] = ""
+	// Blocking reasons are not relevant anymore once the object is ok to be deleted.
+	delete(annotations, runtimev1.BeforeClusterDeleteBlockingReasonsAnnotation)
 	obj.SetAnnotations(annotations)
 
 	// In some cases it is preferred to not update resourceVersion in the input object,
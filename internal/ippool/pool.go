@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ippool
+
+import (
+	"net/netip"
+
+	pkgerrors "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
+
+// ErrPoolExhausted signals that there are no free addresses in a pool.
+var ErrPoolExhausted = pkgerrors.New("no free addresses in the pool")
+
+// Pool is the set of addresses that can be allocated by an in-cluster IP pool.
+type Pool struct {
+	// addresses are the addresses that can be allocated, i.e. the addresses of the pool
+	// excluding the gateway and the excluded addresses.
+	addresses *Set
+
+	// reservations are the addresses reserved for specific IPAddressClaims.
+	reservations map[netip.Addr]types.NamespacedName
+
+	// Prefix is the network prefix length of the allocated addresses.
+	Prefix int32
+
+	// Gateway is the network gateway of the allocated addresses, if any.
+	Gateway string
+}
+
+// NewPool returns the Pool for the spec of an InClusterIPPool or GlobalInClusterIPPool.
+// namespace is the namespace of an InClusterIPPool, which is used as a default for the namespace of reservations;
+// it must be empty for GlobalInClusterIPPools.
+func NewPool(spec *ipamv1.InClusterIPPoolSpec, namespace string) (*Pool, error) {
+	addresses, err := parseAddresses(spec.Addresses, true)
+	if err != nil {
+		return nil, err
+	}
+	if addresses.IsEmpty() {
+		return nil, pkgerrors.New("pool has no addresses")
+	}
+	if !addresses.Is4() && !addresses.Is6() {
+		return nil, pkgerrors.New("addresses of the pool must be of the same IP family")
+	}
+
+	prefix := ptr.Deref(spec.Prefix, 0)
+	maxPrefix := int32(128)
+	if addresses.Is4() {
+		maxPrefix = 32
+	}
+	if prefix > maxPrefix {
+		return nil, pkgerrors.Errorf("prefix %d is too large for the addresses of the pool", prefix)
+	}
+
+	excluded, err := ParseAddresses(spec.ExcludedAddresses)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Gateway != "" {
+		gateway, err := netip.ParseAddr(spec.Gateway)
+		if err != nil {
+			return nil, pkgerrors.Errorf("gateway %q is not a valid IP address", spec.Gateway)
+		}
+		if gateway.Unmap().Is4() != addresses.Is4() {
+			return nil, pkgerrors.Errorf("gateway %q must be of the same IP family of the addresses of the pool", spec.Gateway)
+		}
+		excluded = newSet(append(excluded.ranges, addressRange{first: gateway.Unmap(), last: gateway.Unmap()}))
+	}
+
+	pool := &Pool{
+		addresses:    addresses.Subtract(excluded),
+		reservations: map[netip.Addr]types.NamespacedName{},
+		Prefix:       prefix,
+		Gateway:      spec.Gateway,
+	}
+
+	for _, reservation := range spec.Reservations {
+		addr, err := netip.ParseAddr(reservation.Address)
+		if err != nil {
+			return nil, pkgerrors.Errorf("reserved address %q is not a valid IP address", reservation.Address)
+		}
+		addr = addr.Unmap()
+		if !pool.addresses.Contains(addr) {
+			return nil, pkgerrors.Errorf("reserved address %q is not one of the addresses that can be allocated by the pool", reservation.Address)
+		}
+		claimNamespace := reservation.ClaimRef.Namespace
+		if claimNamespace == "" {
+			claimNamespace = namespace
+		}
+		pool.reservations[addr] = types.NamespacedName{Namespace: claimNamespace, Name: reservation.ClaimRef.Name}
+	}
+
+	return pool, nil
+}
+
+// Contains returns true if the address can be allocated by the pool.
+func (p *Pool) Contains(address string) bool {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	return p.addresses.Contains(addr)
+}
+
+// Len returns the number of addresses that can be allocated by the pool.
+func (p *Pool) Len() int64 {
+	return p.addresses.Len()
+}
+
+// Allocate returns the address to be allocated to an IPAddressClaim, given the addresses already in use.
+// If an address is reserved for the IPAddressClaim, the reserved address is returned; otherwise the first
+// address which is neither in use nor reserved for another IPAddressClaim is returned.
+// ErrPoolExhausted is returned if there are no free addresses.
+func (p *Pool) Allocate(claim types.NamespacedName, addressesInUse []string) (string, error) {
+	inUse := map[netip.Addr]bool{}
+	for _, address := range addressesInUse {
+		if addr, err := netip.ParseAddr(address); err == nil {
+			inUse[addr.Unmap()] = true
+		}
+	}
+
+	for addr, reservedFor := range p.reservations {
+		if reservedFor != claim {
+			continue
+		}
+		if inUse[addr] {
+			return "", pkgerrors.Errorf("address %s reserved for IPAddressClaim %s is already in use", addr, claim)
+		}
+		return addr.String(), nil
+	}
+
+	addr, ok := p.addresses.FirstMatching(func(addr netip.Addr) bool {
+		if _, reserved := p.reservations[addr]; reserved {
+			return false
+		}
+		return !inUse[addr]
+	})
+	if !ok {
+		return "", ErrPoolExhausted
+	}
+	return addr.String(), nil
+}
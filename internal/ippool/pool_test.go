@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ippool
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
+
+func TestNewPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ipamv1.InClusterIPPoolSpec
+		wantLen int64
+		wantErr bool
+	}{
+		{
+			name: "network and broadcast addresses of IPv4 CIDRs, gateway and excluded addresses are not allocated",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses:         []string{"10.0.0.0/24"},
+				Prefix:            ptr.To[int32](24),
+				Gateway:           "10.0.0.1",
+				ExcludedAddresses: []string{"10.0.0.2-10.0.0.9"},
+			},
+			wantLen: 245,
+		},
+		{
+			name: "network and broadcast addresses of IPv4 /31 CIDRs are allocated",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses: []string{"10.0.0.0/31"},
+				Prefix:    ptr.To[int32](31),
+			},
+			wantLen: 2,
+		},
+		{
+			name: "IPv6",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses: []string{"fd00::/120"},
+				Prefix:    ptr.To[int32](64),
+				Gateway:   "fd00::1",
+			},
+			wantLen: 255,
+		},
+		{
+			name: "mixed IP families",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses: []string{"10.0.0.0/24", "fd00::/120"},
+				Prefix:    ptr.To[int32](24),
+			},
+			wantErr: true,
+		},
+		{
+			name: "prefix too large for IPv4",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses: []string{"10.0.0.0/24"},
+				Prefix:    ptr.To[int32](64),
+			},
+			wantErr: true,
+		},
+		{
+			name: "gateway of a different IP family",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses: []string{"10.0.0.0/24"},
+				Prefix:    ptr.To[int32](24),
+				Gateway:   "fd00::1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved address not in the pool",
+			spec: ipamv1.InClusterIPPoolSpec{
+				Addresses: []string{"10.0.0.0/24"},
+				Prefix:    ptr.To[int32](24),
+				Gateway:   "10.0.0.1",
+				Reservations: []ipamv1.InClusterIPPoolReservation{
+					{Address: "10.0.0.1", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "claim"}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool, err := NewPool(&tt.spec, "default")
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(pool.Len()).To(Equal(tt.wantLen))
+		})
+	}
+}
+
+func TestPoolAllocate(t *testing.T) {
+	g := NewWithT(t)
+
+	pool, err := NewPool(&ipamv1.InClusterIPPoolSpec{
+		Addresses:         []string{"10.0.0.10-10.0.0.14"},
+		Prefix:            ptr.To[int32](24),
+		Gateway:           "10.0.0.1",
+		ExcludedAddresses: []string{"10.0.0.11"},
+		Reservations: []ipamv1.InClusterIPPoolReservation{
+			{Address: "10.0.0.12", ClaimRef: ipamv1.InClusterIPPoolReservationClaimReference{Name: "reserved"}},
+		},
+	}, "default")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	claim := types.NamespacedName{Namespace: "default", Name: "claim"}
+	reservedClaim := types.NamespacedName{Namespace: "default", Name: "reserved"}
+
+	// The first free address is allocated.
+	address, err := pool.Allocate(claim, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(address).To(Equal("10.0.0.10"))
+
+	// Excluded and reserved addresses are skipped.
+	address, err = pool.Allocate(claim, []string{"10.0.0.10"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(address).To(Equal("10.0.0.13"))
+
+	// The reserved address is allocated to the claim it is reserved for.
+	address, err = pool.Allocate(reservedClaim, []string{"10.0.0.10"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(address).To(Equal("10.0.0.12"))
+
+	// The reserved address is not allocated if it is already in use.
+	_, err = pool.Allocate(reservedClaim, []string{"10.0.0.12"})
+	g.Expect(err).To(HaveOccurred())
+
+	// ErrPoolExhausted is returned when there are no free addresses.
+	_, err = pool.Allocate(claim, []string{"10.0.0.10", "10.0.0.13", "10.0.0.14"})
+	g.Expect(err).To(MatchError(ErrPoolExhausted))
+
+	g.Expect(pool.Contains("10.0.0.11")).To(BeFalse())
+	g.Expect(pool.Contains("10.0.0.12")).To(BeTrue())
+}
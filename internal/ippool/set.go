@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ippool
+
+import (
+	"math"
+	"math/big"
+	"net/netip"
+	"sort"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// addressRange is a range of IP addresses, including first and last.
+type addressRange struct {
+	first netip.Addr
+	last  netip.Addr
+}
+
+// Set is a set of IP addresses, stored as a sorted list of non-overlapping ranges.
+type Set struct {
+	ranges []addressRange
+}
+
+// ParseAddresses returns a Set with the given addresses.
+// Single addresses (e.g. 10.0.0.10), ranges (e.g. 10.0.0.10-10.0.0.20) and CIDRs (e.g. 10.0.0.0/24) are supported.
+func ParseAddresses(entries []string) (*Set, error) {
+	return parseAddresses(entries, false)
+}
+
+// parseAddresses returns a Set with the given addresses; if skipNetworkAndBroadcast is true, the network and broadcast
+// addresses of IPv4 CIDRs with prefix length lower than 31 are not included.
+func parseAddresses(entries []string, skipNetworkAndBroadcast bool) (*Set, error) {
+	ranges := make([]addressRange, 0, len(entries))
+	for _, entry := range entries {
+		r, err := parseRange(entry, skipNetworkAndBroadcast)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return newSet(ranges), nil
+}
+
+func parseRange(entry string, skipNetworkAndBroadcast bool) (addressRange, error) {
+	entry = strings.TrimSpace(entry)
+
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return addressRange{}, pkgerrors.Errorf("%q is not a valid CIDR", entry)
+		}
+		prefix = prefix.Masked()
+		r := addressRange{first: prefix.Addr(), last: lastAddr(prefix)}
+		if skipNetworkAndBroadcast && prefix.Addr().Is4() && prefix.Bits() < 31 {
+			r.first = r.first.Next()
+			r.last = r.last.Prev()
+		}
+		return r, nil
+	}
+
+	if first, last, ok := strings.Cut(entry, "-"); ok {
+		firstAddr, err := netip.ParseAddr(strings.TrimSpace(first))
+		if err != nil {
+			return addressRange{}, pkgerrors.Errorf("%q is not a valid IP address range", entry)
+		}
+		lastAddr, err := netip.ParseAddr(strings.TrimSpace(last))
+		if err != nil {
+			return addressRange{}, pkgerrors.Errorf("%q is not a valid IP address range", entry)
+		}
+		if firstAddr.Is4() != lastAddr.Is4() {
+			return addressRange{}, pkgerrors.Errorf("%q is not a valid IP address range: addresses must be of the same IP family", entry)
+		}
+		if lastAddr.Less(firstAddr) {
+			return addressRange{}, pkgerrors.Errorf("%q is not a valid IP address range: the first address must not be greater than the last address", entry)
+		}
+		return addressRange{first: firstAddr.Unmap(), last: lastAddr.Unmap()}, nil
+	}
+
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return addressRange{}, pkgerrors.Errorf("%q is not a valid IP address", entry)
+	}
+	addr = addr.Unmap()
+	return addressRange{first: addr, last: addr}, nil
+}
+
+// lastAddr returns the last address of a prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(bytes)*8; i++ {
+		bytes[i/8] |= 1 << (7 - uint(i%8))
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+// newSet returns a Set with the given ranges, merging overlapping and adjacent ranges.
+func newSet(ranges []addressRange) *Set {
+	sorted := make([]addressRange, 0, len(ranges))
+	for _, r := range ranges {
+		if r.last.Less(r.first) {
+			continue
+		}
+		sorted = append(sorted, r)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].first.Less(sorted[j].first)
+	})
+
+	merged := []addressRange{}
+	for _, r := range sorted {
+		if len(merged) > 0 {
+			current := &merged[len(merged)-1]
+			if current.first.Is4() == r.first.Is4() && (!current.last.Less(r.first) || current.last.Next() == r.first) {
+				if current.last.Less(r.last) {
+					current.last = r.last
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return &Set{ranges: merged}
+}
+
+// Contains returns true if the address is in the Set.
+func (s *Set) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, r := range s.ranges {
+		if !addr.Less(r.first) && !r.last.Less(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Subtract returns a Set with the addresses in s which are not in other.
+func (s *Set) Subtract(other *Set) *Set {
+	result := []addressRange{}
+	for _, r := range s.ranges {
+		remaining := []addressRange{r}
+		for _, o := range other.ranges {
+			next := []addressRange{}
+			for _, rr := range remaining {
+				// No overlap.
+				if rr.first.Is4() != o.first.Is4() || rr.last.Less(o.first) || o.last.Less(rr.first) {
+					next = append(next, rr)
+					continue
+				}
+				if rr.first.Less(o.first) {
+					next = append(next, addressRange{first: rr.first, last: o.first.Prev()})
+				}
+				if o.last.Less(rr.last) {
+					next = append(next, addressRange{first: o.last.Next(), last: rr.last})
+				}
+			}
+			remaining = next
+		}
+		result = append(result, remaining...)
+	}
+	return newSet(result)
+}
+
+// IsEmpty returns true if the Set has no addresses.
+func (s *Set) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Is4 returns true if all the addresses in the Set are IPv4 addresses.
+func (s *Set) Is4() bool {
+	for _, r := range s.ranges {
+		if !r.first.Is4() {
+			return false
+		}
+	}
+	return true
+}
+
+// Is6 returns true if all the addresses in the Set are IPv6 addresses.
+func (s *Set) Is6() bool {
+	for _, r := range s.ranges {
+		if !r.first.Is6() {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of addresses in the Set; if the number doesn't fit an int64, math.MaxInt64 is returned.
+func (s *Set) Len() int64 {
+	total := big.NewInt(0)
+	for _, r := range s.ranges {
+		size := new(big.Int).Sub(new(big.Int).SetBytes(r.last.AsSlice()), new(big.Int).SetBytes(r.first.AsSlice()))
+		total.Add(total, size.Add(size, big.NewInt(1)))
+	}
+	if !total.IsInt64() {
+		return math.MaxInt64
+	}
+	return total.Int64()
+}
+
+// FirstMatching returns the first address in the Set for which the match func returns true.
+// NOTE: Addresses are visited in order, so the cost of this func is proportional to the number of
+// addresses before the first match.
+func (s *Set) FirstMatching(match func(addr netip.Addr) bool) (netip.Addr, bool) {
+	for _, r := range s.ranges {
+		for addr := r.first; ; addr = addr.Next() {
+			if match(addr) {
+				return addr, true
+			}
+			if addr == r.last {
+				break
+			}
+		}
+	}
+	return netip.Addr{}, false
+}
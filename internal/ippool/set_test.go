@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ippool
+
+import (
+	"math"
+	"net/netip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseAddresses(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		wantLen int64
+		wantErr bool
+	}{
+		{
+			name:    "single addresses",
+			entries: []string{"10.0.0.1", "10.0.0.3"},
+			wantLen: 2,
+		},
+		{
+			name:    "range",
+			entries: []string{"10.0.0.10-10.0.0.20"},
+			wantLen: 11,
+		},
+		{
+			name:    "CIDR",
+			entries: []string{"10.0.0.0/24"},
+			wantLen: 256,
+		},
+		{
+			name:    "overlapping entries are counted once",
+			entries: []string{"10.0.0.0/24", "10.0.0.10-10.0.1.9", "10.0.0.1"},
+			wantLen: 266,
+		},
+		{
+			name:    "IPv6",
+			entries: []string{"fd00::1-fd00::ff", "fd00::/120"},
+			wantLen: 256,
+		},
+		{
+			name:    "large IPv6 CIDR",
+			entries: []string{"fd00::/48"},
+			wantLen: math.MaxInt64,
+		},
+		{
+			name:    "invalid address",
+			entries: []string{"10.0.0.256"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid CIDR",
+			entries: []string{"10.0.0.0/33"},
+			wantErr: true,
+		},
+		{
+			name:    "range with mixed IP families",
+			entries: []string{"10.0.0.1-fd00::1"},
+			wantErr: true,
+		},
+		{
+			name:    "range with first address greater than the last address",
+			entries: []string{"10.0.0.20-10.0.0.10"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			set, err := ParseAddresses(tt.entries)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(set.Len()).To(Equal(tt.wantLen))
+		})
+	}
+}
+
+func TestSetSubtract(t *testing.T) {
+	g := NewWithT(t)
+
+	set, err := ParseAddresses([]string{"10.0.0.0/24"})
+	g.Expect(err).ToNot(HaveOccurred())
+	excluded, err := ParseAddresses([]string{"10.0.0.0", "10.0.0.10-10.0.0.19", "10.0.0.255", "10.0.1.0/24"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	result := set.Subtract(excluded)
+	g.Expect(result.Len()).To(Equal(int64(244)))
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.0"))).To(BeFalse())
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.1"))).To(BeTrue())
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.9"))).To(BeTrue())
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.10"))).To(BeFalse())
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.19"))).To(BeFalse())
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.20"))).To(BeTrue())
+	g.Expect(result.Contains(netip.MustParseAddr("10.0.0.255"))).To(BeFalse())
+}
+
+func TestSetFirstMatching(t *testing.T) {
+	g := NewWithT(t)
+
+	set, err := ParseAddresses([]string{"10.0.0.1-10.0.0.2", "10.0.1.1"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	addr, ok := set.FirstMatching(func(addr netip.Addr) bool { return addr != netip.MustParseAddr("10.0.0.1") })
+	g.Expect(ok).To(BeTrue())
+	g.Expect(addr.String()).To(Equal("10.0.0.2"))
+
+	addr, ok = set.FirstMatching(func(addr netip.Addr) bool { return addr.As4()[2] == 1 })
+	g.Expect(ok).To(BeTrue())
+	g.Expect(addr.String()).To(Equal("10.0.1.1"))
+
+	_, ok = set.FirstMatching(func(netip.Addr) bool { return false })
+	g.Expect(ok).To(BeFalse())
+}
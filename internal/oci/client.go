@@ -0,0 +1,441 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// ManifestMediaType is the media type of OCI image manifests.
+	ManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+
+	// DockerManifestMediaType is the media type of Docker image manifests (schema 2), which have the same
+	// layout of OCI image manifests.
+	DockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// IndexMediaType is the media type of OCI image indexes, e.g. of multi platform images.
+	IndexMediaType = "application/vnd.oci.image.index.v1+json"
+
+	// DockerManifestListMediaType is the media type of Docker manifest lists, e.g. of multi platform images.
+	DockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// maxManifestSize is the maximum size of a manifest read from the registry.
+	maxManifestSize = 4 * 1024 * 1024
+
+	// maxBlobSize is the maximum size of a blob read from the registry.
+	maxBlobSize = 16 * 1024 * 1024
+)
+
+// ErrNotFound is returned when a manifest, a blob or a repository does not exist in the registry.
+var ErrNotFound = pkgerrors.New("not found")
+
+// Credentials are the credentials used to authenticate to a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Descriptor describes the content of a blob in a registry.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest.
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType,omitempty"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// Layer is a layer of an artifact.
+type Layer struct {
+	Descriptor
+
+	// Data is the content of the layer.
+	Data []byte
+}
+
+// Artifact is an artifact pulled from a registry.
+type Artifact struct {
+	// Digest is the digest of the manifest of the artifact.
+	Digest string
+
+	// Layers are the layers of the artifact with the media type requested when pulling the artifact.
+	Layers []Layer
+}
+
+// Client is a client to pull artifacts from an OCI registry.
+type Client struct {
+	httpClient  *http.Client
+	credentials *Credentials
+
+	// tokens caches the bearer tokens by repository.
+	tokens map[string]string
+}
+
+// NewClient returns a new Client instance.
+// If credentials is nil anonymous access is used.
+func NewClient(httpClient *http.Client, credentials *Credentials) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		httpClient:  httpClient,
+		credentials: credentials,
+		tokens:      map[string]string{},
+	}
+}
+
+// Resolve returns the digest of the manifest of the artifact.
+func (c *Client) Resolve(ctx context.Context, ref Reference) (string, error) {
+	_, digest, err := c.GetManifest(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+// Pull pulls the manifest of the artifact and all its layers with the given media type.
+// The digests of the manifest and of the layers are verified.
+func (c *Client) Pull(ctx context.Context, ref Reference, layerMediaType string) (*Artifact, error) {
+	manifest, digest, err := c.GetManifest(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact := &Artifact{Digest: digest}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != layerMediaType {
+			continue
+		}
+		data, err := c.GetBlob(ctx, ref, layer)
+		if err != nil {
+			return nil, err
+		}
+		artifact.Layers = append(artifact.Layers, Layer{Descriptor: layer, Data: data})
+	}
+	if len(artifact.Layers) == 0 {
+		return nil, pkgerrors.Errorf("failed to pull %s: no layers with media type %s", ref, layerMediaType)
+	}
+	return artifact, nil
+}
+
+// Exists checks that the artifact exists; both image manifests and image indexes are accepted,
+// so it can be used to check single platform and multi platform images.
+// If the artifact does not exist, the returned error wraps ErrNotFound.
+func (c *Client) Exists(ctx context.Context, ref Reference) error {
+	accept := strings.Join([]string{IndexMediaType, DockerManifestListMediaType, ManifestMediaType, DockerManifestMediaType}, ", ")
+	resp, err := c.do(ctx, ref, "manifests/"+ref.reference(), accept)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to get manifest of %s", ref)
+	}
+	return resp.Body.Close()
+}
+
+// ListTags returns the tags of the repository of the reference.
+func (c *Client) ListTags(ctx context.Context, ref Reference) ([]string, error) {
+	tags := []string{}
+	path := "tags/list"
+	for path != "" {
+		resp, err := c.do(ctx, ref, path, "application/json")
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to list tags of %s", ref.Repository)
+		}
+		data, err := readAll(resp.Body, maxManifestSize)
+		resp.Body.Close()
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to read tags of %s", ref.Repository)
+		}
+		list := struct {
+			Tags []string `json:"tags"`
+		}{}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to unmarshal tags of %s", ref.Repository)
+		}
+		tags = append(tags, list.Tags...)
+		path = nextPage(ref, resp.Header.Get("Link"))
+	}
+	return tags, nil
+}
+
+// GetManifest returns the manifest of the artifact and its digest.
+// If the reference contains a digest, the digest of the manifest is verified.
+func (c *Client) GetManifest(ctx context.Context, ref Reference) (*Manifest, string, error) {
+	resp, err := c.do(ctx, ref, "manifests/"+ref.reference(), ManifestMediaType+", "+DockerManifestMediaType)
+	if err != nil {
+		return nil, "", pkgerrors.Wrapf(err, "failed to get manifest of %s", ref)
+	}
+	defer resp.Body.Close()
+
+	data, err := readAll(resp.Body, maxManifestSize)
+	if err != nil {
+		return nil, "", pkgerrors.Wrapf(err, "failed to read manifest of %s", ref)
+	}
+
+	digest := computeDigest(data)
+	if ref.Digest != "" && ref.Digest != digest {
+		return nil, "", pkgerrors.Errorf("failed to get manifest of %s: digest mismatch, got %s", ref, digest)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, "", pkgerrors.Wrapf(err, "failed to unmarshal manifest of %s", ref)
+	}
+	if manifest.MediaType != "" && manifest.MediaType != ManifestMediaType && manifest.MediaType != DockerManifestMediaType {
+		return nil, "", pkgerrors.Errorf("failed to get manifest of %s: unsupported media type %s", ref, manifest.MediaType)
+	}
+	return manifest, digest, nil
+}
+
+// GetBlob returns the content of a blob; the digest of the content is verified.
+func (c *Client) GetBlob(ctx context.Context, ref Reference, desc Descriptor) ([]byte, error) {
+	if !digestRegex.MatchString(desc.Digest) {
+		return nil, pkgerrors.Errorf("failed to get blob of %s: unsupported digest %q", ref, desc.Digest)
+	}
+	if desc.Size > maxBlobSize {
+		return nil, pkgerrors.Errorf("failed to get blob %s of %s: size %d exceeds the maximum size of %d bytes", desc.Digest, ref, desc.Size, maxBlobSize)
+	}
+
+	resp, err := c.do(ctx, ref, "blobs/"+desc.Digest, "")
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get blob %s of %s", desc.Digest, ref)
+	}
+	defer resp.Body.Close()
+
+	data, err := readAll(resp.Body, maxBlobSize)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to read blob %s of %s", desc.Digest, ref)
+	}
+	if digest := computeDigest(data); digest != desc.Digest {
+		return nil, pkgerrors.Errorf("failed to get blob %s of %s: digest mismatch, got %s", desc.Digest, ref, digest)
+	}
+	return data, nil
+}
+
+// do executes a GET request against the registry API of the repository of the reference;
+// path is relative to the repository and it can include a query.
+// If the registry requires authentication, the request is retried once after getting a token
+// for the repository.
+func (c *Client) do(ctx context.Context, ref Reference, path, accept string) (*http.Response, error) {
+	path, query, _ := strings.Cut(path, "?")
+	u := url.URL{
+		Scheme:   "https",
+		Host:     ref.Registry,
+		Path:     fmt.Sprintf("/v2/%s/%s", ref.Repository, path),
+		RawQuery: query,
+	}
+
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if token, ok := c.tokens[ref.Repository]; ok {
+			req.Header.Set("Authorization", token)
+		}
+		return req, nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		token, err := c.authorize(ctx, ref, challenge)
+		if err != nil {
+			return nil, err
+		}
+		c.tokens[ref.Repository] = token
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, pkgerrors.Wrapf(ErrNotFound, "response status code %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, pkgerrors.Errorf("response status code %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// authorize returns the value of the Authorization header to be used to satisfy the given challenge.
+// Both Basic and Bearer (token) authentication schemes are supported; if the challenge does not
+// define a scope, a token to pull from the repository of the reference is requested.
+func (c *Client) authorize(ctx context.Context, ref Reference, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if c.credentials == nil {
+			return "", pkgerrors.New("registry requires authentication but no credentials are configured")
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(c.credentials.Username+":"+c.credentials.Password)), nil
+	case "bearer":
+		realm, ok := params["realm"]
+		if !ok {
+			return "", pkgerrors.Errorf("invalid authentication challenge %q: missing realm", challenge)
+		}
+		u, err := url.Parse(realm)
+		if err != nil {
+			return "", pkgerrors.Wrapf(err, "invalid authentication challenge %q: invalid realm", challenge)
+		}
+		q := u.Query()
+		if service, ok := params["service"]; ok {
+			q.Set("service", service)
+		}
+		scope, ok := params["scope"]
+		if !ok {
+			scope = fmt.Sprintf("repository:%s:pull", ref.Repository)
+		}
+		q.Set("scope", scope)
+		u.RawQuery = q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+		if err != nil {
+			return "", err
+		}
+		if c.credentials != nil {
+			req.SetBasicAuth(c.credentials.Username, c.credentials.Password)
+		}
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return "", pkgerrors.Wrap(err, "failed to get token")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", pkgerrors.Errorf("failed to get token: response status code %d", resp.StatusCode)
+		}
+
+		data, err := readAll(resp.Body, maxManifestSize)
+		if err != nil {
+			return "", pkgerrors.Wrap(err, "failed to read token")
+		}
+		token := struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}{}
+		if err := json.Unmarshal(data, &token); err != nil {
+			return "", pkgerrors.Wrap(err, "failed to unmarshal token")
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		if token.Token == "" {
+			return "", pkgerrors.New("failed to get token: empty token")
+		}
+		return "Bearer " + token.Token, nil
+	default:
+		return "", pkgerrors.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+// parseChallenge parses a WWW-Authenticate header in the form `<scheme> key1="value1",key2="value2"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.TrimSpace(key); key != "" {
+			params[strings.ToLower(key)] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
+
+// nextPage returns the path, relative to the repository of the reference, of the next page of a paginated
+// response from a Link header, e.g. </v2/<repository>/tags/list?n=100&last=v1.0.0>; rel="next".
+func nextPage(ref Reference, link string) string {
+	if !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	next, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return ""
+	}
+	path, ok := strings.CutPrefix(next.Path, fmt.Sprintf("/v2/%s/", ref.Repository))
+	if !ok {
+		return ""
+	}
+	if next.RawQuery != "" {
+		path += "?" + next.RawQuery
+	}
+	return path
+}
+
+func readAll(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, pkgerrors.Errorf("content exceeds the maximum size of %d bytes", limit)
+	}
+	return data, nil
+}
+
+func computeDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
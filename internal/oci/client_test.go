@@ -0,0 +1,371 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const testLayerMediaType = "application/vnd.example.layer.v1+yaml"
+
+func TestClientPull(t *testing.T) {
+	g := NewWithT(t)
+
+	registry := newFakeRegistry(t)
+	layer := registry.addBlob(testLayerMediaType, []byte("kind: ClusterClass"))
+	otherLayer := registry.addBlob("application/vnd.example.other.v1", []byte("other"))
+	digest := registry.addManifest("classes/quick-start", "v1.0.0", layer, otherLayer)
+	registry.addManifest("classes/quick-start", "no-layers", otherLayer)
+
+	wrongDigest := "sha256:" + strings.Repeat("0", 64)
+	registry.manifests["classes/quick-start:"+wrongDigest] = registry.manifests["classes/quick-start:v1.0.0"]
+
+	// The tampered layer must have its own digest, otherwise overwriting its blob would tamper the layer above too.
+	tamperedLayer := registry.addBlob(testLayerMediaType, []byte("kind: ClusterClass\nmetadata: {}"))
+	registry.blobs[tamperedLayer.Digest] = []byte("kind: Tampered")
+	registry.addManifest("classes/quick-start", "tampered", tamperedLayer)
+
+	t.Run("Pull by tag", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		artifact, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "v1.0.0", ""), testLayerMediaType)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(artifact.Digest).To(Equal(digest))
+		g.Expect(artifact.Layers).To(HaveLen(1))
+		g.Expect(string(artifact.Layers[0].Data)).To(Equal("kind: ClusterClass"))
+	})
+	t.Run("Pull by digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		artifact, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "", digest), testLayerMediaType)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(artifact.Digest).To(Equal(digest))
+	})
+	t.Run("Resolve", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		got, err := c.Resolve(context.Background(), registry.reference("classes/quick-start", "v1.0.0", ""))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(digest))
+	})
+	t.Run("Fails if the digest does not match", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		_, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "v1.0.0", wrongDigest), testLayerMediaType)
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+	t.Run("Fails if the digest of a layer does not match", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		_, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "tampered", ""), testLayerMediaType)
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+	t.Run("Fails if there are no layers with the media type", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		_, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "no-layers", ""), testLayerMediaType)
+		g.Expect(err).To(MatchError(ContainSubstring("no layers with media type")))
+	})
+	t.Run("Fails if the artifact does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		_, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "v2.0.0", ""), testLayerMediaType)
+		g.Expect(err).To(MatchError(ContainSubstring("response status code 404")))
+		g.Expect(err).To(MatchError(ErrNotFound))
+	})
+	t.Run("Exists", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		g.Expect(c.Exists(context.Background(), registry.reference("classes/quick-start", "v1.0.0", ""))).To(Succeed())
+		g.Expect(c.Exists(context.Background(), registry.reference("classes/quick-start", "v2.0.0", ""))).To(MatchError(ErrNotFound))
+	})
+	t.Run("ListTags", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), nil)
+		tags, err := c.ListTags(context.Background(), registry.reference("classes/quick-start", "", ""))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tags).To(Equal([]string{"no-layers", "tampered", "v1.0.0"}))
+	})
+
+	registry.username = "user"
+	registry.password = "password"
+
+	t.Run("Pull with credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), &Credentials{Username: "user", Password: "password"})
+		artifact, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "v1.0.0", ""), testLayerMediaType)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(artifact.Digest).To(Equal(digest))
+	})
+	t.Run("Fails with wrong credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(registry.server.Client(), &Credentials{Username: "user", Password: "wrong"})
+		_, err := c.Pull(context.Background(), registry.reference("classes/quick-start", "v1.0.0", ""), testLayerMediaType)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to get token")))
+	})
+
+	g.Expect(registry.tokenRequests).To(Equal(2))
+}
+
+func TestClientVerifySignature(t *testing.T) {
+	g := NewWithT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	publicKey, err := ParsePublicKey(publicKeyPEM(t, key))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	registry := newFakeRegistry(t)
+	layer := registry.addBlob(testLayerMediaType, []byte("kind: ClusterClass"))
+	signedDigest := registry.addManifest("quick-start", "signed", layer)
+	registry.addSignature("quick-start", signedDigest, signedDigest, key)
+
+	otherLayer := registry.addBlob(testLayerMediaType, []byte("kind: OtherClusterClass"))
+	signedWithOtherKeyDigest := registry.addManifest("quick-start", "signed-with-other-key", otherLayer)
+	registry.addSignature("quick-start", signedWithOtherKeyDigest, signedWithOtherKeyDigest, otherKey)
+
+	anotherLayer := registry.addBlob(testLayerMediaType, []byte("kind: AnotherClusterClass"))
+	copiedSignatureDigest := registry.addManifest("quick-start", "copied-signature", anotherLayer)
+	registry.addSignature("quick-start", copiedSignatureDigest, signedDigest, key)
+
+	unsignedLayer := registry.addBlob(testLayerMediaType, []byte("kind: UnsignedClusterClass"))
+	unsignedDigest := registry.addManifest("quick-start", "unsigned", unsignedLayer)
+
+	tests := []struct {
+		name    string
+		digest  string
+		wantErr string
+	}{
+		{
+			name:   "Valid signature",
+			digest: signedDigest,
+		},
+		{
+			name:    "Fails if signed with another key",
+			digest:  signedWithOtherKeyDigest,
+			wantErr: "invalid signature",
+		},
+		{
+			name:    "Fails if the signature payload refers to another artifact",
+			digest:  copiedSignatureDigest,
+			wantErr: "signature payload refers to digest " + signedDigest,
+		},
+		{
+			name:    "Fails if the artifact is not signed",
+			digest:  unsignedDigest,
+			wantErr: "response status code 404",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := NewClient(registry.server.Client(), nil)
+			err := c.VerifySignature(context.Background(), registry.reference("quick-start", "", tt.digest), tt.digest, publicKey)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	g := NewWithT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	publicKey, err := ParsePublicKey(publicKeyPEM(t, key))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(publicKey).To(Equal(&key.PublicKey))
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+// fakeRegistry is a minimal OCI registry serving manifests and blobs from memory.
+// If username and password are set, the registry requires bearer token authentication.
+type fakeRegistry struct {
+	server    *httptest.Server
+	manifests map[string][]byte
+	blobs     map[string][]byte
+
+	username      string
+	password      string
+	tokenRequests int
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	t.Helper()
+
+	r := &fakeRegistry{
+		manifests: map[string][]byte{},
+		blobs:     map[string][]byte{},
+	}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *fakeRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		r.tokenRequests++
+		if username, password, ok := req.BasicAuth(); !ok || username != r.username || password != r.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"test-token"}`))
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	if r.username != "" && req.Header.Get("Authorization") != "Bearer test-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:%s:pull"`, r.server.URL, path))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if repository, ok := strings.CutSuffix(path, "/tags/list"); ok {
+		r.serveTags(w, repository, req.URL.Query().Get("last"))
+		return
+	}
+	if repository, reference, ok := strings.Cut(path, "/manifests/"); ok {
+		if data, ok := r.manifests[repository+":"+reference]; ok {
+			w.Header().Set("Content-Type", ManifestMediaType)
+			_, _ = w.Write(data)
+			return
+		}
+	}
+	if _, digest, ok := strings.Cut(path, "/blobs/"); ok {
+		if data, ok := r.blobs[digest]; ok {
+			_, _ = w.Write(data)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// serveTags serves the sorted tags of a repository after last, in pages of two tags.
+func (r *fakeRegistry) serveTags(w http.ResponseWriter, repository, last string) {
+	tags := []string{}
+	for key := range r.manifests {
+		if tag, ok := strings.CutPrefix(key, repository+":"); ok && !strings.HasPrefix(tag, "sha256:") && tag > last {
+			tags = append(tags, tag)
+		}
+	}
+	slices.Sort(tags)
+	if len(tags) > 2 {
+		tags = tags[:2]
+		w.Header().Set("Link", fmt.Sprintf(`</v2/%s/tags/list?n=2&last=%s>; rel="next"`, repository, tags[1]))
+	}
+	data, err := json.Marshal(map[string]any{"name": repository, "tags": tags})
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(data)
+}
+
+func (r *fakeRegistry) reference(repository, tag, digest string) Reference {
+	return Reference{
+		Registry:   strings.TrimPrefix(r.server.URL, "https://"),
+		Repository: repository,
+		Tag:        tag,
+		Digest:     digest,
+	}
+}
+
+func (r *fakeRegistry) addBlob(mediaType string, data []byte) Descriptor {
+	digest := computeDigest(data)
+	r.blobs[digest] = data
+	return Descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(data))}
+}
+
+// addManifest adds a manifest with the given layers, and returns its digest;
+// the manifest can be fetched both by tag and by digest.
+func (r *fakeRegistry) addManifest(repository, tag string, layers ...Descriptor) string {
+	data, err := json.Marshal(Manifest{
+		SchemaVersion: 2,
+		MediaType:     ManifestMediaType,
+		Config:        r.addBlob("application/vnd.oci.empty.v1+json", []byte("{}")),
+		Layers:        layers,
+	})
+	if err != nil {
+		panic(err)
+	}
+	digest := computeDigest(data)
+	r.manifests[repository+":"+tag] = data
+	r.manifests[repository+":"+digest] = data
+	return digest
+}
+
+// addSignature adds a cosign signature for the artifact with the given digest, signing a payload referring to payloadDigest.
+func (r *fakeRegistry) addSignature(repository, digest, payloadDigest string, key *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, repository, payloadDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		panic(err)
+	}
+
+	layer := r.addBlob(CosignSignatureMediaType, payload)
+	layer.Annotations = map[string]string{
+		CosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+	}
+	r.addManifest(repository, strings.Replace(digest, ":", "-", 1)+".sig", layer)
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
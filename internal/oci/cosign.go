@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// CosignSignatureAnnotation is the annotation of the layers of a cosign signature manifest
+	// containing the base64 encoded signature of the layer.
+	CosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// CosignSignatureMediaType is the media type of the layers of a cosign signature manifest.
+	CosignSignatureMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+)
+
+// cosignPayload is the simple signing payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// ParsePublicKey parses a PEM encoded public key as generated by `cosign generate-key-pair`.
+// ECDSA, ED25519 and RSA keys are supported.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, pkgerrors.New("failed to parse public key: no PEM data found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to parse public key")
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return key, nil
+	default:
+		return nil, pkgerrors.Errorf("failed to parse public key: unsupported key type %T", key)
+	}
+}
+
+// VerifySignature verifies that the artifact with the given manifest digest has been signed
+// with cosign using the private key corresponding to publicKey.
+// The signature is read from the tag that cosign uses by default to store signatures, i.e. sha256-<hex>.sig.
+// NOTE: Keyless signatures and transparency log verification are not supported.
+func (c *Client) VerifySignature(ctx context.Context, ref Reference, digest string, publicKey crypto.PublicKey) error {
+	if !digestRegex.MatchString(digest) {
+		return pkgerrors.Errorf("failed to verify signature of %s: unsupported digest %q", ref, digest)
+	}
+	sigRef := Reference{
+		Registry:   ref.Registry,
+		Repository: ref.Repository,
+		Tag:        strings.Replace(digest, ":", "-", 1) + ".sig",
+	}
+
+	manifest, _, err := c.GetManifest(ctx, sigRef)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to verify signature of %s", ref)
+	}
+
+	var errs []error
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[CosignSignatureAnnotation]
+		if !ok || layer.MediaType != CosignSignatureMediaType {
+			continue
+		}
+		payload, err := c.GetBlob(ctx, sigRef, layer)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := verifyPayload(payload, signature, digest, publicKey); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return pkgerrors.Errorf("failed to verify signature of %s: no signatures found", ref)
+	}
+	return pkgerrors.Wrapf(kerrors.NewAggregate(errs), "failed to verify signature of %s", ref)
+}
+
+// verifyPayload verifies the signature of a cosign payload, and that the payload refers to the given digest.
+func verifyPayload(payload []byte, signature, digest string, publicKey crypto.PublicKey) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to decode signature")
+	}
+
+	hash := sha256.Sum256(payload)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, hash[:], sig) {
+			return pkgerrors.New("invalid signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, sig) {
+			return pkgerrors.New("invalid signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig); err != nil {
+			return pkgerrors.Wrap(err, "invalid signature")
+		}
+	default:
+		return pkgerrors.Errorf("unsupported key type %T", publicKey)
+	}
+
+	p := &cosignPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return pkgerrors.Wrap(err, "failed to unmarshal signature payload")
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return pkgerrors.Errorf("signature payload refers to digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// Scheme is the scheme of the URLs pointing to an artifact in an OCI registry.
+const Scheme = "oci://"
+
+var (
+	repositoryRegex = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegex        = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	digestRegex     = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Reference is a reference to an artifact in an OCI registry.
+type Reference struct {
+	// Registry is the host, and optionally the port, of the registry.
+	Registry string
+
+	// Repository is the name of the repository in the registry.
+	Repository string
+
+	// Tag is the tag of the artifact; it is ignored when Digest is set.
+	Tag string
+
+	// Digest is the digest of the manifest of the artifact.
+	Digest string
+}
+
+// ParseReference parses a URL in the form oci://<registry>/<repository>:<tag> or
+// oci://<registry>/<repository>@<digest>.
+// NOTE: Only sha256 digests are supported.
+func ParseReference(url string) (Reference, error) {
+	if !strings.HasPrefix(url, Scheme) {
+		return Reference{}, pkgerrors.Errorf("invalid OCI reference %q: must start with %q", url, Scheme)
+	}
+	rest := strings.TrimPrefix(url, Scheme)
+
+	registry, rest, ok := strings.Cut(rest, "/")
+	if !ok || registry == "" {
+		return Reference{}, pkgerrors.Errorf("invalid OCI reference %q: must contain a registry and a repository", url)
+	}
+
+	ref := Reference{Registry: registry}
+	if repository, digest, ok := strings.Cut(rest, "@"); ok {
+		if !digestRegex.MatchString(digest) {
+			return Reference{}, pkgerrors.Errorf("invalid OCI reference %q: digest must be in the form sha256:<hex>", url)
+		}
+		ref.Digest = digest
+		rest = repository
+	}
+	// NOTE: The registry has already been removed, so a colon can only separate the repository from the tag.
+	if repository, tag, ok := strings.Cut(rest, ":"); ok {
+		if !tagRegex.MatchString(tag) {
+			return Reference{}, pkgerrors.Errorf("invalid OCI reference %q: invalid tag %q", url, tag)
+		}
+		ref.Tag = tag
+		rest = repository
+	}
+	if !repositoryRegex.MatchString(rest) {
+		return Reference{}, pkgerrors.Errorf("invalid OCI reference %q: invalid repository %q", url, rest)
+	}
+	ref.Repository = rest
+
+	if ref.Tag == "" && ref.Digest == "" {
+		return Reference{}, pkgerrors.Errorf("invalid OCI reference %q: must contain a tag or a digest", url)
+	}
+	return ref, nil
+}
+
+// String returns the reference in the form oci://<registry>/<repository>[:<tag>][@<digest>].
+func (r Reference) String() string {
+	s := fmt.Sprintf("%s%s/%s", Scheme, r.Registry, r.Repository)
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// reference returns the tag or the digest to be used when fetching the manifest of the artifact.
+func (r Reference) reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	tests := []struct {
+		name    string
+		url     string
+		want    Reference
+		wantErr bool
+	}{
+		{
+			name: "Reference with a tag",
+			url:  "oci://registry.example.com/classes/quick-start:v1.0.0",
+			want: Reference{Registry: "registry.example.com", Repository: "classes/quick-start", Tag: "v1.0.0"},
+		},
+		{
+			name: "Reference with a digest",
+			url:  "oci://registry.example.com:5000/quick-start@" + digest,
+			want: Reference{Registry: "registry.example.com:5000", Repository: "quick-start", Digest: digest},
+		},
+		{
+			name: "Reference with a tag and a digest",
+			url:  "oci://registry.example.com/quick-start:v1.0.0@" + digest,
+			want: Reference{Registry: "registry.example.com", Repository: "quick-start", Tag: "v1.0.0", Digest: digest},
+		},
+		{
+			name:    "Fails without the oci scheme",
+			url:     "https://registry.example.com/quick-start:v1.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "Fails without a repository",
+			url:     "oci://registry.example.com",
+			wantErr: true,
+		},
+		{
+			name:    "Fails without a tag or a digest",
+			url:     "oci://registry.example.com/quick-start",
+			wantErr: true,
+		},
+		{
+			name:    "Fails with an invalid digest",
+			url:     "oci://registry.example.com/quick-start@sha512:0123",
+			wantErr: true,
+		},
+		{
+			name:    "Fails with an invalid repository",
+			url:     "oci://registry.example.com/Quick-Start:v1.0.0",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := ParseReference(tt.url)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+			g.Expect(got.String()).To(Equal(tt.url))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultCircuitBreakerFailureThreshold is the number of consecutive failures after which
+	// calls to an ExtensionHandler are temporarily bypassed.
+	defaultCircuitBreakerFailureThreshold = 3
+
+	// defaultCircuitBreakerOpenDuration is how long calls to an ExtensionHandler are bypassed
+	// before trying to call it again.
+	defaultCircuitBreakerOpenDuration = 1 * time.Minute
+)
+
+// circuitBreaker keeps track of consecutive failures of ExtensionHandlers and temporarily
+// bypasses calls to ExtensionHandlers which are failing repeatedly.
+// Note: The circuitBreaker is only used for ExtensionHandlers with FailurePolicy Ignore, because those
+// are the only ExtensionHandlers which can be bypassed without blocking the operation.
+type circuitBreaker struct {
+	lock             sync.RWMutex
+	failureThreshold int
+	openDuration     time.Duration
+	circuits         map[string]*circuit
+}
+
+// circuit is the state of the circuitBreaker for a single ExtensionHandler.
+type circuit struct {
+	extensionConfigName string
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		circuits:         map[string]*circuit{},
+	}
+}
+
+// IsOpen returns true if calls to the ExtensionHandler must be bypassed.
+func (b *circuitBreaker) IsOpen(name string, now time.Time) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	c, ok := b.circuits[name]
+	if !ok {
+		return false
+	}
+	return now.Before(c.openUntil)
+}
+
+// RecordFailure records a failed call to the ExtensionHandler and returns true if the circuit has been opened.
+// Note: After the circuit has been opened, a single failure is enough to open it again.
+func (b *circuitBreaker) RecordFailure(name, extensionConfigName string, now time.Time) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	c, ok := b.circuits[name]
+	if !ok {
+		c = &circuit{extensionConfigName: extensionConfigName}
+		b.circuits[name] = c
+	}
+	c.consecutiveFailures++
+	if c.consecutiveFailures < b.failureThreshold {
+		return false
+	}
+	c.openUntil = now.Add(b.openDuration)
+	return true
+}
+
+// RecordSuccess records a successful call to the ExtensionHandler, closes the circuit and returns true
+// if the circuit had been opened.
+func (b *circuitBreaker) RecordSuccess(name string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	c, ok := b.circuits[name]
+	if !ok {
+		return false
+	}
+	delete(b.circuits, name)
+	return !c.openUntil.IsZero()
+}
+
+// OpenCircuits returns the sorted names of the ExtensionHandlers of an ExtensionConfig for which calls are bypassed.
+func (b *circuitBreaker) OpenCircuits(extensionConfigName string, now time.Time) []string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	names := []string{}
+	for name, c := range b.circuits {
+		if c.extensionConfigName == extensionConfigName && now.Before(c.openUntil) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Reset closes the circuits of all the ExtensionHandlers of an ExtensionConfig.
+func (b *circuitBreaker) Reset(extensionConfigName string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for name, c := range b.circuits {
+		if c.extensionConfigName == extensionConfigName {
+			delete(b.circuits, name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	b := newCircuitBreaker(2, time.Minute)
+
+	// The circuit is closed for unknown ExtensionHandlers.
+	g.Expect(b.IsOpen("handler-a.config-1", now)).To(BeFalse())
+
+	// The circuit is not opened before reaching the failure threshold.
+	g.Expect(b.RecordFailure("handler-a.config-1", "config-1", now)).To(BeFalse())
+	g.Expect(b.IsOpen("handler-a.config-1", now)).To(BeFalse())
+	g.Expect(b.OpenCircuits("config-1", now)).To(BeEmpty())
+
+	// The circuit is opened when reaching the failure threshold.
+	g.Expect(b.RecordFailure("handler-a.config-1", "config-1", now)).To(BeTrue())
+	g.Expect(b.RecordFailure("handler-b.config-1", "config-1", now)).To(BeFalse())
+	g.Expect(b.RecordFailure("handler-b.config-1", "config-1", now)).To(BeTrue())
+	g.Expect(b.RecordFailure("handler-c.config-2", "config-2", now)).To(BeFalse())
+	g.Expect(b.RecordFailure("handler-c.config-2", "config-2", now)).To(BeTrue())
+	g.Expect(b.IsOpen("handler-a.config-1", now)).To(BeTrue())
+	g.Expect(b.OpenCircuits("config-1", now)).To(Equal([]string{"handler-a.config-1", "handler-b.config-1"}))
+
+	// The circuit is closed again after the open duration, but a single failure opens it again.
+	later := now.Add(2 * time.Minute)
+	g.Expect(b.IsOpen("handler-a.config-1", later)).To(BeFalse())
+	g.Expect(b.OpenCircuits("config-1", later)).To(BeEmpty())
+	g.Expect(b.RecordFailure("handler-a.config-1", "config-1", later)).To(BeTrue())
+	g.Expect(b.IsOpen("handler-a.config-1", later)).To(BeTrue())
+
+	// The circuit is closed after a successful call.
+	g.Expect(b.RecordSuccess("handler-a.config-1")).To(BeTrue())
+	g.Expect(b.IsOpen("handler-a.config-1", later)).To(BeFalse())
+	g.Expect(b.RecordFailure("handler-a.config-1", "config-1", later)).To(BeFalse())
+	// A successful call of an ExtensionHandler whose circuit has not been opened does not close a circuit.
+	g.Expect(b.RecordSuccess("handler-a.config-1")).To(BeFalse())
+
+	// Reset closes all the circuits of an ExtensionConfig.
+	b.Reset("config-1")
+	g.Expect(b.OpenCircuits("config-1", now)).To(BeEmpty())
+	g.Expect(b.OpenCircuits("config-2", now)).To(Equal([]string{"handler-c.config-2"}))
+}
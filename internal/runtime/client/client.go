@@ -20,7 +20,9 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -40,6 +42,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/transport"
 	"k8s.io/klog/v2"
@@ -48,6 +51,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
@@ -57,6 +61,7 @@ import (
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 type errCallingExtensionHandler error
@@ -70,6 +75,22 @@ const defaultDiscoveryTimeout = 10 * time.Second
 // GeneratePatchesResponse carrying patches for the cluster's topology objects).
 const maxExtensionResponseBodyBytes = 20 << 20 // 20 MiB
 
+// defaultResponseCacheTTL is the duration for which responses of idempotent hooks are cached.
+const defaultResponseCacheTTL = 5 * time.Minute
+
+// defaultResponseCacheMaxEntries is the maximum number of responses of idempotent hooks which are cached.
+const defaultResponseCacheMaxEntries = 1000
+
+// degradedExtensionsEventsBufferSize is the size of the buffer of the channel returned by DegradedExtensionsEvents.
+const degradedExtensionsEventsBufferSize = 100
+
+// cacheableHooks are the idempotent hooks for which responses are cached, i.e. hooks that are expected
+// to always return the same response when the same ExtensionHandler is called with the same request.
+var cacheableHooks = sets.New[string](
+	runtimecatalog.HookName(runtimehooksv1.DiscoverVariables),
+	runtimecatalog.HookName(runtimehooksv1.GeneratePatches),
+)
+
 // Options are creation options for a Client.
 type Options struct {
 	CertFile string // Path of the PEM-encoded client certificate.
@@ -101,10 +122,17 @@ func New(ctx context.Context, options Options) (runtimeclient.Client, *certwatch
 		registry:         options.Registry,
 		client:           options.Client,
 		httpClientsCache: httpClientCache,
+		responseCache: &boundedCache[runtimeclient.CallExtensionCacheEntry]{
+			Cache:      cache.New[runtimeclient.CallExtensionCacheEntry](ctx, defaultResponseCacheTTL),
+			maxEntries: defaultResponseCacheMaxEntries,
+		},
+		circuitBreaker:           newCircuitBreaker(defaultCircuitBreakerFailureThreshold, defaultCircuitBreakerOpenDuration),
+		degradedExtensionsEvents: make(chan event.GenericEvent, degradedExtensionsEventsBufferSize),
 	}, certWatcher, nil
 }
 
 var _ runtimeclient.Client = &client{}
+var _ runtimeclient.DegradedExtensionsGetter = &client{}
 
 type client struct {
 	certFile         string
@@ -113,34 +141,63 @@ type client struct {
 	registry         runtimeregistry.ExtensionRegistry
 	client           ctrlclient.Client
 	httpClientsCache cache.Cache[httpClientEntry]
+
+	// responseCache is used to cache responses of idempotent hooks, see cacheableHooks.
+	responseCache cache.Cache[runtimeclient.CallExtensionCacheEntry]
+
+	// circuitBreaker is used to temporarily bypass failing ExtensionHandlers with FailurePolicy Ignore.
+	circuitBreaker *circuitBreaker
+
+	// degradedExtensionsEvents receives an event for an ExtensionConfig when a circuit of one of its
+	// ExtensionHandlers is opened or closed.
+	degradedExtensionsEvents chan event.GenericEvent
+}
+
+// boundedCache is a cache.Cache which holds at most maxEntries entries.
+// Note: When the cache is full, all the entries are deleted before adding a new entry; this is acceptable
+// for caches of responses of idempotent hooks, because entries are re-computed by calling the ExtensionHandler.
+type boundedCache[E cache.Entry] struct {
+	cache.Cache[E]
+	maxEntries int
+}
+
+// Add adds the given entry to the cache, deleting all the entries if the cache is full.
+func (c *boundedCache[E]) Add(entry E) {
+	if c.Len() >= c.maxEntries {
+		c.DeleteAll()
+	}
+	c.Cache.Add(entry)
 }
 
 type httpClientEntry struct {
-	// Note: caData and hostName are the variable parts in the TLSConfig
+	// Note: caData, hostName and clientCertificateSecretRef are the variable parts in the TLSConfig
 	// for an http.Client that is used to call runtime extensions.
-	caData   []byte
-	hostName string
+	caData                     []byte
+	hostName                   string
+	clientCertificateSecretRef runtimev1.SecretReference
 
 	client *http.Client
 }
 
-func newHTTPClientEntry(hostName string, caData []byte, client *http.Client) httpClientEntry {
+func newHTTPClientEntry(hostName string, caData []byte, clientCertificateSecretRef runtimev1.SecretReference, client *http.Client) httpClientEntry {
 	return httpClientEntry{
-		hostName: hostName,
-		caData:   caData,
-		client:   client,
+		hostName:                   hostName,
+		caData:                     caData,
+		clientCertificateSecretRef: clientCertificateSecretRef,
+		client:                     client,
 	}
 }
 
-func newHTTPClientEntryKey(hostName string, caData []byte) string {
+func newHTTPClientEntryKey(hostName string, caData []byte, clientCertificateSecretRef runtimev1.SecretReference) string {
 	return httpClientEntry{
-		hostName: hostName,
-		caData:   caData,
+		hostName:                   hostName,
+		caData:                     caData,
+		clientCertificateSecretRef: clientCertificateSecretRef,
 	}.Key()
 }
 
 func (r httpClientEntry) Key() string {
-	return fmt.Sprintf("%s/%s", r.hostName, string(r.caData))
+	return fmt.Sprintf("%s/%s/%s/%s", r.hostName, r.clientCertificateSecretRef.Namespace, r.clientCertificateSecretRef.Name, string(r.caData))
 }
 
 func (c *client) WarmUp(extensionConfigList *runtimev1.ExtensionConfigList) error {
@@ -226,9 +283,31 @@ func (c *client) Unregister(extensionConfig *runtimev1.ExtensionConfig) error {
 	if err := c.registry.Remove(extensionConfig); err != nil {
 		return pkgerrors.Wrapf(err, "failed to unregister ExtensionConfig %q", extensionConfig.Name)
 	}
+	c.circuitBreaker.Reset(extensionConfig.Name)
 	return nil
 }
 
+// GetDegradedExtensions returns the names of the ExtensionHandlers of the ExtensionConfig which are
+// temporarily bypassed because they are failing repeatedly.
+func (c *client) GetDegradedExtensions(extensionConfigName string) []string {
+	return c.circuitBreaker.OpenCircuits(extensionConfigName, time.Now())
+}
+
+// DegradedExtensionsEvents returns a channel receiving a GenericEvent for an ExtensionConfig when
+// the ExtensionHandlers of the ExtensionConfig which are temporarily bypassed change.
+func (c *client) DegradedExtensionsEvents() <-chan event.GenericEvent {
+	return c.degradedExtensionsEvents
+}
+
+// notifyDegradedExtensionsChanged sends an event for the ExtensionConfig to the DegradedExtensionsEvents channel.
+// Note: The event is dropped if the buffer of the channel is full, e.g. because nobody is reading from the channel.
+func (c *client) notifyDegradedExtensionsChanged(extensionConfigName string) {
+	select {
+	case c.degradedExtensionsEvents <- event.GenericEvent{Object: &runtimev1.ExtensionConfig{ObjectMeta: metav1.ObjectMeta{Name: extensionConfigName}}}:
+	default:
+	}
+}
+
 func (c *client) GetAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject ctrlclient.Object) ([]string, error) {
 	hookName := runtimecatalog.HookName(hook)
 	log := ctrl.LoggerFrom(ctx).WithValues("hook", hookName)
@@ -338,6 +417,13 @@ func aggregateSuccessfulResponses(aggregatedResponse runtimehooksv1.ResponseObje
 		if resp.GetMessage() != "" {
 			messages = append(messages, resp.GetMessage())
 		}
+
+		// Note: Blocking reasons are aggregated only for responses blocking the operation.
+		if aggregatedDeleteResponse, ok := aggregatedResponse.(*runtimehooksv1.BeforeClusterDeleteResponse); ok {
+			if deleteResponse := resp.(*runtimehooksv1.BeforeClusterDeleteResponse); deleteResponse.RetryAfterSeconds != 0 {
+				aggregatedDeleteResponse.BlockingReasons = append(aggregatedDeleteResponse.BlockingReasons, deleteResponse.BlockingReasons...)
+			}
+		}
 	}
 	aggregatedResponse.SetMessage(strings.Join(messages, ", "))
 }
@@ -354,6 +440,10 @@ func aggregateSuccessfulResponses(aggregatedResponse runtimehooksv1.ResponseObje
 // Nb. FailurePolicy does not affect the following kinds of errors:
 // - Internal errors. Examples: hooks is incompatible with ExtensionHandler, ExtensionHandler information is missing.
 // - Error when ExtensionHandler returns a response with `Status` set to `Failure`.
+//
+// ExtensionHandlers with FailurePolicy Ignore which are failing repeatedly are temporarily bypassed and the response
+// object is updated to be the default success response, see circuitBreaker.
+// Responses of idempotent hooks are cached if the caller does not provide its own cache, see cacheableHooks.
 func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, forObject ctrlclient.Object, name string, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject, opts ...runtimeclient.CallExtensionOption) error {
 	// Calculate the options.
 	options := &runtimeclient.CallExtensionOptions{}
@@ -403,6 +493,13 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	// Prepare the request by merging the settings in the registration with the settings in the request.
 	request = cloneAndAddSettings(request, registration.Settings)
 
+	// Cache responses of idempotent hooks if the caller did not provide its own cache.
+	if !options.WithCaching && cacheableHooks.Has(runtimecatalog.HookName(hook)) {
+		options.WithCaching = true
+		options.Cache = c.responseCache
+		options.CacheKeyFunc = requestHashCacheKeyFunc
+	}
+
 	var cacheKey string
 	if options.WithCaching {
 		// Return a cached response if response is cached.
@@ -410,7 +507,7 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		if cacheEntry, ok := options.Cache.Has(cacheKey); ok {
 			// Set response to cacheEntry.Response.
 			outVal := reflect.ValueOf(response)
-			cacheVal := reflect.ValueOf(cacheEntry.Response)
+			cacheVal := reflect.ValueOf(cacheEntry.Response.DeepCopyObject())
 			if !cacheVal.Type().AssignableTo(outVal.Type()) {
 				return fmt.Errorf("failed to call extension handler %q: cached response of type %s instead of type %s", name, cacheVal.Type(), outVal.Type())
 			}
@@ -419,6 +516,15 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		}
 	}
 
+	ignore := registration.FailurePolicy == runtimev1.FailurePolicyIgnore
+	if ignore && c.circuitBreaker.IsOpen(registration.Name, time.Now()) {
+		// Update the response to a default success response and return.
+		log.V(4).Info("Skipping call to extension handler because it is failing repeatedly")
+		response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+		response.SetMessage("")
+		return nil
+	}
+
 	httpClient, err := c.getHTTPClient(registration.ClientConfig)
 	if err != nil {
 		return pkgerrors.Wrapf(err, "failed to call extension handler %q: failed to get http client", name)
@@ -437,10 +543,13 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	if err != nil {
 		// If the error is errCallingExtensionHandler then apply failure policy to calculate
 		// the effective result of the operation.
-		ignore := registration.FailurePolicy == runtimev1.FailurePolicyIgnore
 		if _, ok := err.(errCallingExtensionHandler); ok && ignore {
 			// Update the response to a default success response and return.
 			log.Error(err, fmt.Sprintf("Ignoring error calling extension handler because of FailurePolicy %q", registration.FailurePolicy))
+			if c.circuitBreaker.RecordFailure(registration.Name, registration.ExtensionConfigName, time.Now()) {
+				log.Info(fmt.Sprintf("Extension handler is failing repeatedly, skipping calls for %s", c.circuitBreaker.openDuration))
+				c.notifyDegradedExtensionsChanged(registration.ExtensionConfigName)
+			}
 			response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
 			response.SetMessage("")
 			return nil
@@ -453,6 +562,9 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	if err := validateResponseStatus(response, "call extension handler", name); err != nil {
 		return err
 	}
+	if c.circuitBreaker.RecordSuccess(registration.Name) {
+		c.notifyDegradedExtensionsChanged(registration.ExtensionConfigName)
+	}
 
 	if retryResponse, ok := response.(runtimehooksv1.RetryResponseObject); ok && retryResponse.GetRetryAfterSeconds() != 0 {
 		log.V(4).Info(fmt.Sprintf("Extension handler returned blocking response with retryAfterSeconds of %d", retryResponse.GetRetryAfterSeconds()))
@@ -464,7 +576,7 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		// Add response to the cache.
 		options.Cache.Add(runtimeclient.CallExtensionCacheEntry{
 			CacheKey: cacheKey,
-			Response: response,
+			Response: response.DeepCopyObject().(runtimehooksv1.ResponseObject),
 		})
 	}
 
@@ -473,6 +585,19 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	return nil
 }
 
+// requestHashCacheKeyFunc computes a cache key from the ExtensionHandler, the resourceVersion of the ExtensionConfig
+// and a hash of the request.
+func requestHashCacheKeyFunc(extensionName, extensionConfigResourceVersion string, request runtimehooksv1.RequestObject) string {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		// Note: A request that cannot be marshalled will fail when calling the extension anyway,
+		// returning a key which is never going to be hit avoids using a stale response.
+		return fmt.Sprintf("%s-%s-%s", extensionName, extensionConfigResourceVersion, util.RandomString(16))
+	}
+	hash := sha256.Sum256(requestBytes)
+	return fmt.Sprintf("%s-%s-%s", extensionName, extensionConfigResourceVersion, hex.EncodeToString(hash[:]))
+}
+
 func (c *client) getHTTPClient(config runtimev1.ClientConfig) (*http.Client, error) {
 	// Note: we are passing an empty gvh and "" as name because the only relevant part of the url
 	// for this function is the Hostname, which derives from config (ghv and name are appended to the path).
@@ -481,20 +606,27 @@ func (c *client) getHTTPClient(config runtimev1.ClientConfig) (*http.Client, err
 		return nil, err
 	}
 
-	if cacheEntry, ok := c.httpClientsCache.Has(newHTTPClientEntryKey(extensionURL.Hostname(), config.CABundle)); ok {
+	if cacheEntry, ok := c.httpClientsCache.Has(newHTTPClientEntryKey(extensionURL.Hostname(), config.CABundle, config.ClientCertificateSecretRef)); ok {
 		return cacheEntry.client, nil
 	}
 
-	httpClient, err := createHTTPClient(c.certFile, c.keyFile, config.CABundle, extensionURL.Hostname())
+	// If the ExtensionConfig defines a client certificate, it is used for mutual TLS
+	// instead of the client certificate of the controller.
+	var getClientCertificate func(*tls.CertificateRequestInfo) (*tls.Certificate, error)
+	if config.ClientCertificateSecretRef.IsDefined() {
+		getClientCertificate = newSecretClientCertificateLoader(c.client, config.ClientCertificateSecretRef).GetClientCertificate
+	}
+
+	httpClient, err := createHTTPClient(c.certFile, c.keyFile, config.CABundle, extensionURL.Hostname(), getClientCertificate)
 	if err != nil {
 		return nil, err
 	}
 
-	c.httpClientsCache.Add(newHTTPClientEntry(extensionURL.Hostname(), config.CABundle, httpClient))
+	c.httpClientsCache.Add(newHTTPClientEntry(extensionURL.Hostname(), config.CABundle, config.ClientCertificateSecretRef, httpClient))
 	return httpClient, nil
 }
 
-func createHTTPClient(certFile, keyFile string, caData []byte, hostName string) (*http.Client, error) {
+func createHTTPClient(certFile, keyFile string, caData []byte, hostName string, getClientCertificate func(*tls.CertificateRequestInfo) (*tls.Certificate, error)) (*http.Client, error) {
 	httpClient := &http.Client{}
 	tlsConfig, err := transport.TLSConfigFor(&transport.Config{
 		TLS: transport.TLSConfig{
@@ -507,11 +639,16 @@ func createHTTPClient(certFile, keyFile string, caData []byte, hostName string)
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to create tls config")
 	}
+	// Note: tlsConfig is never nil here because ServerName is always set.
+	if getClientCertificate != nil {
+		tlsConfig.GetClientCertificate = getClientCertificate
+	}
 
 	// This also adds http2
-	httpClient.Transport = utilnet.SetTransportDefaults(&http.Transport{
+	// Trace requests to Runtime Extensions and propagate the trace context, if tracing is enabled.
+	httpClient.Transport = tracing.WrapTransport(utilnet.SetTransportDefaults(&http.Transport{
 		TLSClientConfig: tlsConfig,
-	})
+	}))
 
 	// Runtime extensions are called at the URL registered via the ExtensionConfig.
 	// Do not follow redirects so the extension server cannot reroute the call to a
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+)
+
+const (
+	// defaultClientCertificateRefreshInterval is how long a client certificate read from a Secret is used
+	// before the Secret is read again. This ensures rotated client certificates are picked up.
+	defaultClientCertificateRefreshInterval = 5 * time.Minute
+
+	// clientCertificateReadTimeout is the timeout for reading the Secret containing the client certificate.
+	clientCertificateReadTimeout = 10 * time.Second
+)
+
+// secretClientCertificateLoader loads the client certificate used for mutual TLS with an Extension server
+// from a Secret of type kubernetes.io/tls.
+// Note: Secrets are not cached by the controller-runtime client, so the certificate is kept in memory
+// for refreshInterval to avoid reading the Secret on every TLS handshake.
+type secretClientCertificateLoader struct {
+	client          ctrlclient.Client
+	secretRef       runtimev1.SecretReference
+	refreshInterval time.Duration
+
+	lock        sync.Mutex
+	certificate *tls.Certificate
+	expiresAt   time.Time
+}
+
+func newSecretClientCertificateLoader(client ctrlclient.Client, secretRef runtimev1.SecretReference) *secretClientCertificateLoader {
+	return &secretClientCertificateLoader{
+		client:          client,
+		secretRef:       secretRef,
+		refreshInterval: defaultClientCertificateRefreshInterval,
+	}
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (l *secretClientCertificateLoader) GetClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return l.getCertificate(time.Now())
+}
+
+func (l *secretClientCertificateLoader) getCertificate(now time.Time) (*tls.Certificate, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.certificate != nil && now.Before(l.expiresAt) {
+		return l.certificate, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clientCertificateReadTimeout)
+	defer cancel()
+
+	secret := &corev1.Secret{}
+	if err := l.client.Get(ctx, ctrlclient.ObjectKey{Namespace: l.secretRef.Namespace, Name: l.secretRef.Name}, secret); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get client certificate from Secret %s/%s", l.secretRef.Namespace, l.secretRef.Name)
+	}
+
+	certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse client certificate from Secret %s/%s", l.secretRef.Namespace, l.secretRef.Name)
+	}
+
+	l.certificate = &certificate
+	l.expiresAt = now.Add(l.refreshInterval)
+	return l.certificate, nil
+}
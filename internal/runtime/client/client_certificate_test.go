@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+)
+
+func TestSecretClientCertificateLoader(t *testing.T) {
+	secretRef := runtimev1.SecretReference{
+		Namespace: "default",
+		Name:      "extension-client-cert",
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: secretRef.Namespace,
+			Name:      secretRef.Name,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       testcerts.ClientCert,
+			corev1.TLSPrivateKeyKey: testcerts.ClientKey,
+		},
+	}
+
+	t.Run("fails if the Secret does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		loader := newSecretClientCertificateLoader(fake.NewClientBuilder().Build(), secretRef)
+		_, err := loader.getCertificate(time.Now())
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("fails if the Secret does not contain a valid certificate", func(t *testing.T) {
+		g := NewWithT(t)
+
+		invalidSecret := secret.DeepCopy()
+		invalidSecret.Data[corev1.TLSPrivateKeyKey] = []byte("invalid")
+		loader := newSecretClientCertificateLoader(fake.NewClientBuilder().WithObjects(invalidSecret).Build(), secretRef)
+		_, err := loader.getCertificate(time.Now())
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("loads the certificate and reloads it after the refresh interval", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithObjects(secret.DeepCopy()).Build()
+		loader := newSecretClientCertificateLoader(fakeClient, secretRef)
+
+		now := time.Now()
+		certificate, err := loader.getCertificate(now)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(certificate).ToNot(BeNil())
+
+		// Delete the Secret; the certificate is still returned from memory until the refresh interval expires.
+		g.Expect(fakeClient.Delete(t.Context(), secret.DeepCopy())).To(Succeed())
+		cachedCertificate, err := loader.getCertificate(now.Add(loader.refreshInterval - time.Second))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cachedCertificate).To(BeIdenticalTo(certificate))
+
+		// After the refresh interval the Secret is read again.
+		_, err = loader.getCertificate(now.Add(loader.refreshInterval + time.Second))
+		g.Expect(err).To(HaveOccurred())
+	})
+}
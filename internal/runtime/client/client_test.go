@@ -43,6 +43,7 @@ import (
 	"k8s.io/utils/ptr"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
@@ -243,7 +244,7 @@ func TestClient_httpCall(t *testing.T) {
 				u, err := url.Parse(srv.URL)
 				g.Expect(err).ToNot(HaveOccurred())
 
-				httpClient, err := createHTTPClient("", "", testcerts.CACert, u.Hostname())
+				httpClient, err := createHTTPClient("", "", testcerts.CACert, u.Hostname(), nil)
 				g.Expect(err).ToNot(HaveOccurred())
 				tt.opts.httpClient = httpClient
 			}
@@ -886,6 +887,218 @@ func TestClient_CallExtension(t *testing.T) {
 	}
 }
 
+func TestClient_CallExtensionCircuitBreaker(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+	extensionConfig := runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "extension-config",
+			ResourceVersion: "15",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				// Set a fake URL, the URL will be overridden with the address of the test server.
+				URL:      "https://127.0.0.1/",
+				CABundle: testcerts.CACert,
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "valid-extension",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: fakev1alpha1.GroupVersion.String(),
+						Hook:       "FakeHook",
+					},
+					TimeoutSeconds: 1,
+					FailurePolicy:  runtimev1.FailurePolicyIgnore,
+				},
+			},
+		},
+	}
+
+	var serverCallCount int
+	srv := createSecureTestServer(testServerConfig{
+		start: true,
+		responses: map[string]testServerResponse{
+			"/*": {
+				response:           &fakev1alpha1.FakeResponse{},
+				responseStatusCode: http.StatusInternalServerError,
+			},
+		},
+	}, func() {
+		serverCallCount++
+	})
+	srv.StartTLS()
+	defer srv.Close()
+	extensionConfig.Spec.ClientConfig.URL = fmt.Sprintf("https://%s/", srv.Listener.Addr().String())
+
+	cat := runtimecatalog.New()
+	_ = fakev1alpha1.AddToCatalog(cat)
+	fakeClient := fake.NewClientBuilder().
+		WithObjects(ns).
+		Build()
+
+	c, _, err := New(t.Context(), Options{
+		Catalog:  cat,
+		Registry: registry([]runtimev1.ExtensionConfig{extensionConfig}),
+		Client:   fakeClient,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	obj := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "foo",
+		},
+	}
+
+	// Calls to the failing ExtensionHandler are ignored because of FailurePolicy Ignore.
+	for range defaultCircuitBreakerFailureThreshold {
+		g.Expect(c.CallExtension(context.Background(), fakev1alpha1.FakeHook, obj, "valid-extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})).To(Succeed())
+	}
+	g.Expect(serverCallCount).To(Equal(defaultCircuitBreakerFailureThreshold))
+	degradedExtensionsGetter, ok := c.(runtimeclient.DegradedExtensionsGetter)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(degradedExtensionsGetter.GetDegradedExtensions("extension-config")).To(Equal([]string{"valid-extension"}))
+
+	// An event is sent for the ExtensionConfig when the circuit is opened.
+	var e event.GenericEvent
+	g.Expect(degradedExtensionsGetter.DegradedExtensionsEvents()).To(Receive(&e))
+	g.Expect(e.Object.GetName()).To(Equal("extension-config"))
+
+	// After reaching the failure threshold, calls to the ExtensionHandler are bypassed.
+	response := &fakev1alpha1.FakeResponse{}
+	g.Expect(c.CallExtension(context.Background(), fakev1alpha1.FakeHook, obj, "valid-extension", &fakev1alpha1.FakeRequest{}, response)).To(Succeed())
+	g.Expect(response.GetStatus()).To(Equal(runtimehooksv1.ResponseStatusSuccess))
+	g.Expect(serverCallCount).To(Equal(defaultCircuitBreakerFailureThreshold))
+
+	// Unregistering the ExtensionConfig resets the circuit breaker.
+	g.Expect(c.Unregister(&extensionConfig)).To(Succeed())
+	g.Expect(degradedExtensionsGetter.GetDegradedExtensions("extension-config")).To(BeEmpty())
+}
+
+func TestBoundedCache(t *testing.T) {
+	g := NewWithT(t)
+
+	c := &boundedCache[runtimeclient.CallExtensionCacheEntry]{
+		Cache:      cache.New[runtimeclient.CallExtensionCacheEntry](t.Context(), cache.DefaultTTL),
+		maxEntries: 2,
+	}
+
+	c.Add(runtimeclient.CallExtensionCacheEntry{CacheKey: "1"})
+	c.Add(runtimeclient.CallExtensionCacheEntry{CacheKey: "2"})
+	g.Expect(c.Len()).To(Equal(2))
+
+	// Adding an entry to a full cache deletes all the other entries.
+	c.Add(runtimeclient.CallExtensionCacheEntry{CacheKey: "3"})
+	g.Expect(c.Len()).To(Equal(1))
+	_, ok := c.Has("1")
+	g.Expect(ok).To(BeFalse())
+	_, ok = c.Has("3")
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestClient_CallExtensionResponseCaching(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+	extensionConfig := runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "extension-config",
+			ResourceVersion: "15",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				// Set a fake URL, the URL will be overridden with the address of the test server.
+				URL:      "https://127.0.0.1/",
+				CABundle: testcerts.CACert,
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "generate-patches",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: runtimehooksv1.GroupVersion.String(),
+						Hook:       "GeneratePatches",
+					},
+					TimeoutSeconds: 1,
+					FailurePolicy:  runtimev1.FailurePolicyFail,
+				},
+			},
+		},
+	}
+
+	var serverCallCount int
+	srv := createSecureTestServer(testServerConfig{
+		start: true,
+		responses: map[string]testServerResponse{
+			"/*": {
+				response: &runtimehooksv1.GeneratePatchesResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{
+						Status: runtimehooksv1.ResponseStatusSuccess,
+					},
+				},
+				responseStatusCode: http.StatusOK,
+			},
+		},
+	}, func() {
+		serverCallCount++
+	})
+	srv.StartTLS()
+	defer srv.Close()
+	extensionConfig.Spec.ClientConfig.URL = fmt.Sprintf("https://%s/", srv.Listener.Addr().String())
+
+	cat := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(cat)
+	fakeClient := fake.NewClientBuilder().
+		WithObjects(ns).
+		Build()
+
+	c, _, err := New(t.Context(), Options{
+		Catalog:  cat,
+		Registry: registry([]runtimev1.ExtensionConfig{extensionConfig}),
+		Client:   fakeClient,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	obj := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "foo",
+		},
+	}
+
+	request := &runtimehooksv1.GeneratePatchesRequest{
+		Items: []runtimehooksv1.GeneratePatchesRequestItem{{UID: "1"}},
+	}
+
+	// Calls with the same request are served from the cache.
+	g.Expect(c.CallExtension(context.Background(), runtimehooksv1.GeneratePatches, obj, "generate-patches", request, &runtimehooksv1.GeneratePatchesResponse{})).To(Succeed())
+	g.Expect(serverCallCount).To(Equal(1))
+	response := &runtimehooksv1.GeneratePatchesResponse{}
+	g.Expect(c.CallExtension(context.Background(), runtimehooksv1.GeneratePatches, obj, "generate-patches", request, response)).To(Succeed())
+	g.Expect(response.GetStatus()).To(Equal(runtimehooksv1.ResponseStatusSuccess))
+	g.Expect(serverCallCount).To(Equal(1))
+
+	// Calls with a different request are not served from the cache.
+	request.Items[0].UID = "2"
+	g.Expect(c.CallExtension(context.Background(), runtimehooksv1.GeneratePatches, obj, "generate-patches", request, &runtimehooksv1.GeneratePatchesResponse{})).To(Succeed())
+	g.Expect(serverCallCount).To(Equal(2))
+}
+
 func TestClient_CallExtensionWithClientAuthentication(t *testing.T) {
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1110,7 +1323,7 @@ func TestClient_GetHttpClient(t *testing.T) {
 
 	// Check http client cache have only one item
 	g.Expect(internalClient.httpClientsCache.Len()).To(Equal(1))
-	_, ok := internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension1.Spec.ClientConfig.CABundle))
+	_, ok := internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension1.Spec.ClientConfig.CABundle, runtimev1.SecretReference{}))
 	g.Expect(ok).To(BeTrue())
 
 	// Check http client cache is used for the same extension
@@ -1126,7 +1339,7 @@ func TestClient_GetHttpClient(t *testing.T) {
 
 	// Check http client cache have two items
 	g.Expect(internalClient.httpClientsCache.Len()).To(Equal(1))
-	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension2.Spec.ClientConfig.CABundle))
+	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension2.Spec.ClientConfig.CABundle, runtimev1.SecretReference{}))
 	g.Expect(ok).To(BeTrue())
 
 	// Get http client for extension 3, another server
@@ -1136,16 +1349,29 @@ func TestClient_GetHttpClient(t *testing.T) {
 
 	// Check http client cache have two items
 	g.Expect(internalClient.httpClientsCache.Len()).To(Equal(2))
-	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension1.Spec.ClientConfig.CABundle))
+	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension1.Spec.ClientConfig.CABundle, runtimev1.SecretReference{}))
 	g.Expect(ok).To(BeTrue())
-	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverB.example.com", extension2.Spec.ClientConfig.CABundle))
+	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverB.example.com", extension2.Spec.ClientConfig.CABundle, runtimev1.SecretReference{}))
+	g.Expect(ok).To(BeTrue())
+
+	// Get http client for extension 4, same server as extension 1 but with a client certificate
+	extension4 := extension1.DeepCopy()
+	extension4.Spec.ClientConfig.ClientCertificateSecretRef = runtimev1.SecretReference{Namespace: "default", Name: "extension-client-cert"}
+	gotClientExtension4, err := internalClient.getHTTPClient(extension4.Spec.ClientConfig)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(gotClientExtension4).ToNot(Equal(gotClientExtension1))
+	g.Expect(gotClientExtension4.Transport.(*http.Transport).TLSClientConfig.GetClientCertificate).ToNot(BeNil())
+
+	// Check http client cache have three items
+	g.Expect(internalClient.httpClientsCache.Len()).To(Equal(3))
+	_, ok = internalClient.httpClientsCache.Has(newHTTPClientEntryKey("serverA.example.com", extension4.Spec.ClientConfig.CABundle, extension4.Spec.ClientConfig.ClientCertificateSecretRef))
 	g.Expect(ok).To(BeTrue())
 }
 
 func TestCreateHTTPClient_doesNotFollowRedirects(t *testing.T) {
 	g := NewWithT(t)
 
-	httpClient, err := createHTTPClient("", "", testcerts.CACert, "extension.example.com")
+	httpClient, err := createHTTPClient("", "", testcerts.CACert, "extension.example.com", nil)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(httpClient.CheckRedirect).ToNot(BeNil())
 
@@ -1740,6 +1966,35 @@ func Test_aggregateResponses(t *testing.T) {
 			},
 			want: fakeRetryableSuccessResponse(1, "test1, test2"),
 		},
+		{
+			name:              "Aggregate blocking reasons of blocking BeforeClusterDelete responses",
+			aggregateResponse: &runtimehooksv1.BeforeClusterDeleteResponse{},
+			responses: []runtimehooksv1.ResponseObject{
+				beforeClusterDeleteResponse(0, runtimehooksv1.BlockingReason{Message: "not blocking"}),
+				beforeClusterDeleteResponse(10, runtimehooksv1.BlockingReason{Reason: "BackupInProgress", Message: "backup in progress"}),
+				beforeClusterDeleteResponse(5, runtimehooksv1.BlockingReason{
+					Reason:  "VolumesAttached",
+					Message: "volumes still attached",
+					Object:  runtimehooksv1.ClusterDescendant{APIVersion: "cluster.x-k8s.io/v1beta2", Kind: "MachineDeployment", Name: "md-1"},
+				}),
+			},
+			want: &runtimehooksv1.BeforeClusterDeleteResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{
+						Status: runtimehooksv1.ResponseStatusSuccess,
+					},
+					RetryAfterSeconds: 5,
+				},
+				BlockingReasons: []runtimehooksv1.BlockingReason{
+					{Reason: "BackupInProgress", Message: "backup in progress"},
+					{
+						Reason:  "VolumesAttached",
+						Message: "volumes still attached",
+						Object:  runtimehooksv1.ClusterDescendant{APIVersion: "cluster.x-k8s.io/v1beta2", Kind: "MachineDeployment", Name: "md-1"},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1752,6 +2007,18 @@ func Test_aggregateResponses(t *testing.T) {
 	}
 }
 
+func beforeClusterDeleteResponse(retryAfterSeconds int32, blockingReasons ...runtimehooksv1.BlockingReason) *runtimehooksv1.BeforeClusterDeleteResponse {
+	return &runtimehooksv1.BeforeClusterDeleteResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			RetryAfterSeconds: retryAfterSeconds,
+		},
+		BlockingReasons: blockingReasons,
+	}
+}
+
 type testServerConfig struct {
 	start     bool
 	responses map[string]testServerResponse
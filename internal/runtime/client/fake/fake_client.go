@@ -23,6 +23,7 @@ import (
 
 	pkgerrors "github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	runtimecatalog "sigs.k8s.io/cluster-api/api/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
@@ -39,6 +40,7 @@ type RuntimeClientBuilder struct {
 	callAllValidations func(object runtimehooksv1.RequestObject) error
 	callResponses      map[string]runtimehooksv1.ResponseObject
 	callValidations    func(name string, object runtimehooksv1.RequestObject) error
+	degradedExtensions map[string][]string
 }
 
 // NewRuntimeClientBuilder returns a new builder for the fake runtime client.
@@ -82,6 +84,12 @@ func (f *RuntimeClientBuilder) WithCallExtensionValidations(callValidations func
 	return f
 }
 
+// WithDegradedExtensions can be used to dictate the responses for GetDegradedExtensions.
+func (f *RuntimeClientBuilder) WithDegradedExtensions(degradedExtensions map[string][]string) *RuntimeClientBuilder {
+	f.degradedExtensions = degradedExtensions
+	return f
+}
+
 // MarkReady can be used to mark the fake runtime client as either ready or not ready.
 func (f *RuntimeClientBuilder) MarkReady(ready bool) *RuntimeClientBuilder {
 	f.ready = ready
@@ -97,6 +105,7 @@ func (f *RuntimeClientBuilder) Build() *RuntimeClient {
 		callAllValidations: f.callAllValidations,
 		callResponses:      f.callResponses,
 		callValidations:    f.callValidations,
+		degradedExtensions: f.degradedExtensions,
 		catalog:            f.catalog,
 		callAllTracker:     map[string]int{},
 		callTracker:        map[string]int{},
@@ -104,6 +113,7 @@ func (f *RuntimeClientBuilder) Build() *RuntimeClient {
 }
 
 var _ runtimeclient.Client = &RuntimeClient{}
+var _ runtimeclient.DegradedExtensionsGetter = &RuntimeClient{}
 
 // RuntimeClient is a fake implementation of runtimeclient.Client.
 type RuntimeClient struct {
@@ -114,6 +124,7 @@ type RuntimeClient struct {
 	callAllValidations func(object runtimehooksv1.RequestObject) error
 	callResponses      map[string]runtimehooksv1.ResponseObject
 	callValidations    func(name string, object runtimehooksv1.RequestObject) error
+	degradedExtensions map[string][]string
 
 	callTracker    map[string]int
 	callAllTracker map[string]int
@@ -198,6 +209,16 @@ func (fc *RuntimeClient) CallExtension(ctx context.Context, hook runtimecatalog.
 	return nil
 }
 
+// GetDegradedExtensions implements DegradedExtensionsGetter.
+func (fc *RuntimeClient) GetDegradedExtensions(extensionConfigName string) []string {
+	return fc.degradedExtensions[extensionConfigName]
+}
+
+// DegradedExtensionsEvents implements DegradedExtensionsGetter.
+func (fc *RuntimeClient) DegradedExtensionsEvents() <-chan event.GenericEvent {
+	return make(chan event.GenericEvent)
+}
+
 // Discover implements Client.
 func (fc *RuntimeClient) Discover(context.Context, *runtimev1.ExtensionConfig) (*runtimev1.ExtensionConfig, error) {
 	panic("unimplemented")
@@ -404,6 +404,75 @@ func ClusterClassTemplatesAreValid(clusterClass *clusterv1.ClusterClass) field.E
 	return allErrs
 }
 
+// ClusterClassNamingStrategiesChanges returns a message for each naming strategy used by the Cluster that is
+// different in the desired ClusterClass.
+// NOTE: Naming strategies are only used when creating objects, so a change of naming strategy doesn't rename
+// the existing objects of the Cluster, which can be unexpected when rebasing a Cluster to another ClusterClass.
+func ClusterClassNamingStrategiesChanges(cluster *clusterv1.Cluster, current, desired *clusterv1.ClusterClass) []string {
+	var changes []string
+	if current == nil || desired == nil {
+		return changes
+	}
+
+	namingStrategyChange := func(object, currentTemplate, desiredTemplate string) {
+		if currentTemplate != desiredTemplate {
+			changes = append(changes, fmt.Sprintf("naming strategy of the %s changes from %q to %q; it will only be used for new objects and existing objects won't be renamed",
+				object, currentTemplate, desiredTemplate))
+		}
+	}
+
+	namingStrategyChange("InfrastructureCluster", current.Spec.Infrastructure.Naming.Template, desired.Spec.Infrastructure.Naming.Template)
+	namingStrategyChange("ControlPlane", current.Spec.ControlPlane.Naming.Template, desired.Spec.ControlPlane.Naming.Template)
+
+	mdClasses := sets.Set[string]{}
+	for _, md := range cluster.Spec.Topology.Workers.MachineDeployments {
+		mdClasses.Insert(md.Class)
+	}
+	for _, class := range sets.List(mdClasses) {
+		currentClass := machineDeploymentClass(current, class)
+		desiredClass := machineDeploymentClass(desired, class)
+		if currentClass == nil || desiredClass == nil {
+			continue
+		}
+		namingStrategyChange(fmt.Sprintf("MachineDeployments of class %s", class), currentClass.Naming.Template, desiredClass.Naming.Template)
+	}
+
+	mpClasses := sets.Set[string]{}
+	for _, mp := range cluster.Spec.Topology.Workers.MachinePools {
+		mpClasses.Insert(mp.Class)
+	}
+	for _, class := range sets.List(mpClasses) {
+		currentClass := machinePoolClass(current, class)
+		desiredClass := machinePoolClass(desired, class)
+		if currentClass == nil || desiredClass == nil {
+			continue
+		}
+		namingStrategyChange(fmt.Sprintf("MachinePools of class %s", class), currentClass.Naming.Template, desiredClass.Naming.Template)
+	}
+
+	return changes
+}
+
+// machineDeploymentClass returns the MachineDeploymentClass with the given name, nil if it doesn't exist.
+func machineDeploymentClass(clusterClass *clusterv1.ClusterClass, name string) *clusterv1.MachineDeploymentClass {
+	for i := range clusterClass.Spec.Workers.MachineDeployments {
+		if clusterClass.Spec.Workers.MachineDeployments[i].Class == name {
+			return &clusterClass.Spec.Workers.MachineDeployments[i]
+		}
+	}
+	return nil
+}
+
+// machinePoolClass returns the MachinePoolClass with the given name, nil if it doesn't exist.
+func machinePoolClass(clusterClass *clusterv1.ClusterClass, name string) *clusterv1.MachinePoolClass {
+	for i := range clusterClass.Spec.Workers.MachinePools {
+		if clusterClass.Spec.Workers.MachinePools[i].Class == name {
+			return &clusterClass.Spec.Workers.MachinePools[i]
+		}
+	}
+	return nil
+}
+
 // mdClassNamesFromWorkerClass returns the set of MachineDeployment class names.
 func mdClassNamesFromWorkerClass(w clusterv1.WorkersClass) sets.Set[string] {
 	classes := sets.Set[string]{}
@@ -1675,6 +1675,89 @@ func TestClusterClassTemplatesAreValid(t *testing.T) {
 	}
 }
 
+func TestClusterClassNamingStrategiesChanges(t *testing.T) {
+	clusterClass := func(infraNaming, cpNaming, mdNaming, mpNaming string) *clusterv1.ClusterClass {
+		return &clusterv1.ClusterClass{
+			Spec: clusterv1.ClusterClassSpec{
+				Infrastructure: clusterv1.InfrastructureClass{
+					Naming: clusterv1.InfrastructureClassNamingSpec{Template: infraNaming},
+				},
+				ControlPlane: clusterv1.ControlPlaneClass{
+					Naming: clusterv1.ControlPlaneClassNamingSpec{Template: cpNaming},
+				},
+				Workers: clusterv1.WorkersClass{
+					MachineDeployments: []clusterv1.MachineDeploymentClass{
+						{
+							Class:  "md-class",
+							Naming: clusterv1.MachineDeploymentClassNamingSpec{Template: mdNaming},
+						},
+						{
+							Class:  "unused-md-class",
+							Naming: clusterv1.MachineDeploymentClassNamingSpec{Template: mdNaming},
+						},
+					},
+					MachinePools: []clusterv1.MachinePoolClass{
+						{
+							Class:  "mp-class",
+							Naming: clusterv1.MachinePoolClassNamingSpec{Template: mpNaming},
+						},
+					},
+				},
+			},
+		}
+	}
+	cluster := &clusterv1.Cluster{
+		Spec: clusterv1.ClusterSpec{
+			Topology: clusterv1.Topology{
+				Workers: clusterv1.WorkersTopology{
+					MachineDeployments: []clusterv1.MachineDeploymentTopology{
+						{Class: "md-class", Name: "md1"},
+						{Class: "md-class", Name: "md2"},
+					},
+					MachinePools: []clusterv1.MachinePoolTopology{
+						{Class: "mp-class", Name: "mp1"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		current *clusterv1.ClusterClass
+		desired *clusterv1.ClusterClass
+		want    []string
+	}{
+		{
+			name:    "No changes if naming strategies are equal",
+			current: clusterClass("infra", "cp", "md", "mp"),
+			desired: clusterClass("infra", "cp", "md", "mp"),
+		},
+		{
+			name:    "Report changes of all the naming strategies used by the Cluster",
+			current: clusterClass("infra", "cp", "md", "mp"),
+			desired: clusterClass("new-infra", "", "new-md", "new-mp"),
+			want: []string{
+				"naming strategy of the InfrastructureCluster changes from \"infra\" to \"new-infra\"; it will only be used for new objects and existing objects won't be renamed",
+				"naming strategy of the ControlPlane changes from \"cp\" to \"\"; it will only be used for new objects and existing objects won't be renamed",
+				"naming strategy of the MachineDeployments of class md-class changes from \"md\" to \"new-md\"; it will only be used for new objects and existing objects won't be renamed",
+				"naming strategy of the MachinePools of class mp-class changes from \"mp\" to \"new-mp\"; it will only be used for new objects and existing objects won't be renamed",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			changes := ClusterClassNamingStrategiesChanges(cluster, tt.current, tt.desired)
+			if len(tt.want) == 0 {
+				g.Expect(changes).To(BeEmpty())
+				return
+			}
+			g.Expect(changes).To(Equal(tt.want))
+		})
+	}
+}
+
 func refToUnstructured(ref *clusterv1.ClusterClassTemplateReference) *unstructured.Unstructured {
 	gvk := ref.GroupVersionKind()
 	output := &unstructured.Unstructured{}
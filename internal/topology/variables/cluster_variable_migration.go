@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	pkgerrors "github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// MigrateClusterVariables migrates the values of variables according to the migrations defined in the ClusterClass variables.
+// It must be called only when the classRef of a Cluster is changed to the ClusterClass defining the variables;
+// an error is returned for every value that cannot be migrated.
+func MigrateClusterVariables(values []clusterv1.ClusterVariable, definitions []clusterv1.ClusterClassVariable, fldPath *field.Path) ([]clusterv1.ClusterVariable, field.ErrorList) {
+	var allErrs field.ErrorList
+
+	migrated := slices.Clone(values)
+	for _, definition := range definitions {
+		migration := definition.Migration
+
+		if migration.RenamedFrom != "" {
+			if from := indexOfVariable(migrated, migration.RenamedFrom); from >= 0 {
+				if indexOfVariable(migrated, definition.Name) >= 0 {
+					allErrs = append(allErrs, field.Invalid(fldPath.Key(migration.RenamedFrom), string(migrated[from].Value.Raw),
+						fmt.Sprintf("variable %q has been renamed to %q in the ClusterClass, it cannot be migrated because variable %q is set as well", migration.RenamedFrom, definition.Name, definition.Name)))
+					continue
+				}
+				migrated[from].Name = definition.Name
+			}
+		}
+
+		i := indexOfVariable(migrated, definition.Name)
+		if i < 0 {
+			continue
+		}
+
+		if migration.PreviousDefault != nil {
+			isPreviousDefault, err := jsonValuesAreEqual(migrated[i].Value, *migration.PreviousDefault)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(definition.Name), string(migrated[i].Value.Raw),
+					fmt.Sprintf("variable %q cannot be compared with its previous default value: %v", definition.Name, err)))
+				continue
+			}
+			if isPreviousDefault {
+				// Drop the value, so the default value defined in the ClusterClass is applied.
+				migrated = slices.Delete(migrated, i, i+1)
+				continue
+			}
+		}
+
+		if migration.PreviousType != "" {
+			value, err := widenVariableValue(migrated[i].Value, migration.PreviousType, definition.Schema.OpenAPIV3Schema)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(definition.Name), string(migrated[i].Value.Raw),
+					fmt.Sprintf("variable %q cannot be migrated: %v", definition.Name, err)))
+				continue
+			}
+			migrated[i].Value = value
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return nil, allErrs
+	}
+	return migrated, nil
+}
+
+// isSupportedTypeWidening returns true if values of previousType can be migrated to schema.
+func isSupportedTypeWidening(previousType string, schema clusterv1.JSONSchemaProps) bool {
+	switch {
+	case previousType == schema.Type:
+		return true
+	case previousType == "integer" && schema.Type == "number":
+		return true
+	case schema.Type == "array" && schema.Items != nil && schema.Items.Type == previousType:
+		return true
+	}
+	return false
+}
+
+// widenVariableValue migrates a value set for a variable of previousType to schema.
+func widenVariableValue(value apiextensionsv1.JSON, previousType string, schema clusterv1.JSONSchemaProps) (apiextensionsv1.JSON, error) {
+	if !isSupportedTypeWidening(previousType, schema) {
+		return value, pkgerrors.Errorf("type cannot be widened from %q to %q", previousType, schema.Type)
+	}
+
+	// Integers are valid numbers, so only values of array variables have to be migrated.
+	if schema.Type != "array" {
+		return value, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(value.Raw, &v); err != nil {
+		return value, pkgerrors.Wrap(err, "failed to unmarshal value")
+	}
+	if _, ok := v.([]interface{}); ok {
+		// The value is already an array, e.g. it has been set after the type has been widened.
+		return value, nil
+	}
+	raw, err := json.Marshal([]interface{}{v})
+	if err != nil {
+		return value, pkgerrors.Wrap(err, "failed to marshal value")
+	}
+	return apiextensionsv1.JSON{Raw: raw}, nil
+}
+
+// jsonValuesAreEqual returns true if a and b are semantically equal, e.g. independently of the order of keys.
+func jsonValuesAreEqual(a, b apiextensionsv1.JSON) (bool, error) {
+	var aValue, bValue interface{}
+	if err := json.Unmarshal(a.Raw, &aValue); err != nil {
+		return false, pkgerrors.Wrap(err, "failed to unmarshal value")
+	}
+	if err := json.Unmarshal(b.Raw, &bValue); err != nil {
+		return false, pkgerrors.Wrap(err, "failed to unmarshal previous default value")
+	}
+	return reflect.DeepEqual(aValue, bValue), nil
+}
+
+func indexOfVariable(values []clusterv1.ClusterVariable, name string) int {
+	return slices.IndexFunc(values, func(v clusterv1.ClusterVariable) bool { return v.Name == name })
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variables
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func Test_MigrateClusterVariables(t *testing.T) {
+	tests := []struct {
+		name        string
+		definitions []clusterv1.ClusterClassVariable
+		values      []clusterv1.ClusterVariable
+		want        []clusterv1.ClusterVariable
+		wantErrs    []validationMatch
+	}{
+		{
+			name: "Do nothing if there are no migrations",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name:   "location",
+					Schema: clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string"}},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+			want: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+		},
+		{
+			name: "Rename a variable",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name:      "region",
+					Schema:    clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string"}},
+					Migration: clusterv1.ClusterClassVariableMigration{RenamedFrom: "location"},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "cpu", Value: apiextensionsv1.JSON{Raw: []byte(`1`)}},
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+			want: []clusterv1.ClusterVariable{
+				{Name: "cpu", Value: apiextensionsv1.JSON{Raw: []byte(`1`)}},
+				{Name: "region", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+		},
+		{
+			name: "Error if both the old and the new name of a renamed variable are set",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name:      "region",
+					Schema:    clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "string"}},
+					Migration: clusterv1.ClusterClassVariableMigration{RenamedFrom: "location"},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+				{Name: "region", Value: apiextensionsv1.JSON{Raw: []byte(`"us-west"`)}},
+			},
+			wantErrs: []validationMatch{
+				invalid("variable \"location\" has been renamed to \"region\" in the ClusterClass, it cannot be migrated because variable \"region\" is set as well",
+					"spec.topology.variables[location]"),
+			},
+		},
+		{
+			name: "Drop a value equal to the previous default",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name: "httpProxy",
+					Schema: clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type:    "object",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`{"enabled":true}`)},
+					}},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						PreviousDefault: &apiextensionsv1.JSON{Raw: []byte(`{"enabled": false, "url": ""}`)},
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "httpProxy", Value: apiextensionsv1.JSON{Raw: []byte(`{"url":"","enabled":false}`)}},
+			},
+			want: []clusterv1.ClusterVariable{},
+		},
+		{
+			name: "Keep a value different from the previous default",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name: "location",
+					Schema: clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"us-west"`)},
+					}},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						PreviousDefault: &apiextensionsv1.JSON{Raw: []byte(`"us-east"`)},
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"eu-central"`)}},
+			},
+			want: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"eu-central"`)}},
+			},
+		},
+		{
+			name: "Widen a scalar type to an array",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name: "regions",
+					Schema: clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type:  "array",
+						Items: &clusterv1.JSONSchemaProps{Type: "string"},
+					}},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						RenamedFrom:  "location",
+						PreviousType: "string",
+					},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "location", Value: apiextensionsv1.JSON{Raw: []byte(`"us-east"`)}},
+			},
+			want: []clusterv1.ClusterVariable{
+				{Name: "regions", Value: apiextensionsv1.JSON{Raw: []byte(`["us-east"]`)}},
+			},
+		},
+		{
+			name: "Do not widen a value which already is an array",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name: "regions",
+					Schema: clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type:  "array",
+						Items: &clusterv1.JSONSchemaProps{Type: "string"},
+					}},
+					Migration: clusterv1.ClusterClassVariableMigration{PreviousType: "string"},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "regions", Value: apiextensionsv1.JSON{Raw: []byte(`["us-east","us-west"]`)}},
+			},
+			want: []clusterv1.ClusterVariable{
+				{Name: "regions", Value: apiextensionsv1.JSON{Raw: []byte(`["us-east","us-west"]`)}},
+			},
+		},
+		{
+			name: "Widen integer to number",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name:      "cpu",
+					Schema:    clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "number"}},
+					Migration: clusterv1.ClusterClassVariableMigration{PreviousType: "integer"},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "cpu", Value: apiextensionsv1.JSON{Raw: []byte(`2`)}},
+			},
+			want: []clusterv1.ClusterVariable{
+				{Name: "cpu", Value: apiextensionsv1.JSON{Raw: []byte(`2`)}},
+			},
+		},
+		{
+			name: "Error if a type cannot be widened",
+			definitions: []clusterv1.ClusterClassVariable{
+				{
+					Name:      "cpu",
+					Schema:    clusterv1.VariableSchema{OpenAPIV3Schema: clusterv1.JSONSchemaProps{Type: "integer"}},
+					Migration: clusterv1.ClusterClassVariableMigration{PreviousType: "string"},
+				},
+			},
+			values: []clusterv1.ClusterVariable{
+				{Name: "cpu", Value: apiextensionsv1.JSON{Raw: []byte(`"2"`)}},
+			},
+			wantErrs: []validationMatch{
+				invalid("variable \"cpu\" cannot be migrated: type cannot be widened from \"string\" to \"integer\"",
+					"spec.topology.variables[cpu]"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, gotErrs := MigrateClusterVariables(tt.values, tt.definitions, field.NewPath("spec", "topology", "variables"))
+
+			checkErrors(t, tt.wantErrs, gotErrs)
+			if len(tt.wantErrs) > 0 {
+				return
+			}
+			g.Expect(got).To(BeComparableTo(tt.want))
+		})
+	}
+}
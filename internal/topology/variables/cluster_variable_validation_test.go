@@ -2175,6 +2175,60 @@ func Test_ValidateClusterVariable_CELTransitions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Error if old value is not set and transition rule with optionalOldSelf fails",
+			wantErrs: []validationMatch{
+				invalid("failed rule: oldSelf.hasValue() || self >= 2",
+					"spec.topology.variables[cpu].value"),
+			},
+			clusterClassVariable: &clusterv1.ClusterClassVariable{
+				Name:     "cpu",
+				Required: ptr.To(true),
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type: "integer",
+						XValidations: []clusterv1.ValidationRule{{
+							Rule:            "oldSelf.hasValue() || self >= 2",
+							OptionalOldSelf: ptr.To(true),
+						}},
+					},
+				},
+			},
+			clusterVariable: &clusterv1.ClusterVariable{
+				Name: "cpu",
+				Value: apiextensionsv1.JSON{
+					Raw: []byte(`1`),
+				},
+			},
+		},
+		{
+			name: "Valid transition with optionalOldSelf if old value is set",
+			clusterClassVariable: &clusterv1.ClusterClassVariable{
+				Name:     "cpu",
+				Required: ptr.To(true),
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type: "integer",
+						XValidations: []clusterv1.ValidationRule{{
+							Rule:            "oldSelf.hasValue() || self >= 2",
+							OptionalOldSelf: ptr.To(true),
+						}},
+					},
+				},
+			},
+			clusterVariable: &clusterv1.ClusterVariable{
+				Name: "cpu",
+				Value: apiextensionsv1.JSON{
+					Raw: []byte(`1`),
+				},
+			},
+			oldClusterVariable: &clusterv1.ClusterVariable{
+				Name: "cpu",
+				Value: apiextensionsv1.JSON{
+					Raw: []byte(`1`),
+				},
+			},
+		},
 		{
 			name: "Valid transition if old value is less than new value via CEL expression",
 			clusterClassVariable: &clusterv1.ClusterClassVariable{
@@ -18,6 +18,7 @@ package variables
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"regexp"
@@ -57,6 +58,7 @@ func ValidateClusterClassVariables(ctx context.Context, oldClusterClassVariables
 	var allErrs field.ErrorList //nolint:prealloc // Not all paths append
 
 	allErrs = append(allErrs, validateClusterClassVariableNamesUnique(clusterClassVariables, fldPath)...)
+	allErrs = append(allErrs, validateClusterClassVariableMigrations(clusterClassVariables, fldPath)...)
 
 	oldClusterClassVariablesMap := map[string]clusterv1.ClusterClassVariable{}
 	for _, variable := range oldClusterClassVariables {
@@ -101,6 +103,51 @@ func validateClusterClassVariableNamesUnique(clusterClassVariables []clusterv1.C
 	return allErrs
 }
 
+// validateClusterClassVariableMigrations validates the migrations of ClusterClass variables.
+func validateClusterClassVariableMigrations(clusterClassVariables []clusterv1.ClusterClassVariable, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	variableNames := sets.Set[string]{}
+	for _, clusterClassVariable := range clusterClassVariables {
+		variableNames.Insert(clusterClassVariable.Name)
+	}
+
+	renamedFrom := map[string]string{}
+	for _, clusterClassVariable := range clusterClassVariables {
+		migration := clusterClassVariable.Migration
+		fldPath := fldPath.Key(clusterClassVariable.Name).Child("migration")
+
+		if migration.RenamedFrom != "" {
+			switch {
+			case migration.RenamedFrom == clusterClassVariable.Name:
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("renamedFrom"), migration.RenamedFrom, "must be different from the name of the variable"))
+			case variableNames.Has(migration.RenamedFrom):
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("renamedFrom"), migration.RenamedFrom,
+					fmt.Sprintf("variable %q is defined in the ClusterClass, so it cannot be renamed", migration.RenamedFrom)))
+			case renamedFrom[migration.RenamedFrom] != "":
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("renamedFrom"), migration.RenamedFrom,
+					fmt.Sprintf("variable %q is already renamed to %q", migration.RenamedFrom, renamedFrom[migration.RenamedFrom])))
+			default:
+				renamedFrom[migration.RenamedFrom] = clusterClassVariable.Name
+			}
+		}
+
+		if migration.PreviousDefault != nil {
+			var v interface{}
+			if err := json.Unmarshal(migration.PreviousDefault.Raw, &v); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("previousDefault"), string(migration.PreviousDefault.Raw), fmt.Sprintf("must be valid JSON: %v", err)))
+			}
+		}
+
+		if migration.PreviousType != "" && !isSupportedTypeWidening(migration.PreviousType, clusterClassVariable.Schema.OpenAPIV3Schema) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("previousType"), migration.PreviousType,
+				fmt.Sprintf("type cannot be widened from %q to %q; supported type widenings are from integer to number and from a scalar type to an array with items of the same type", migration.PreviousType, clusterClassVariable.Schema.OpenAPIV3Schema.Type)))
+		}
+	}
+
+	return allErrs
+}
+
 // validateClusterClassVariable validates a ClusterClassVariable.
 func validateClusterClassVariable(ctx context.Context, oldVariable, variable *clusterv1.ClusterClassVariable, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{} //nolint:prealloc // Not all paths append
@@ -489,6 +536,8 @@ func validateSchema(ctx context.Context, schema *apiextensions.JSONSchemaProps,
 							if uncorrelatablePath != nil {
 								allErrs.CELErrors = append(allErrs.CELErrors, field.Invalid(fldPath.Child("x-kubernetes-validations").Index(i).Child("rule"), schema.XValidations[i].Rule, fmt.Sprintf("oldSelf cannot be used on the uncorrelatable portion of the schema within %v", uncorrelatablePath)))
 							}
+						} else if schema.XValidations[i].OptionalOldSelf != nil {
+							allErrs.CELErrors = append(allErrs.CELErrors, field.Invalid(fldPath.Child("x-kubernetes-validations").Index(i).Child("optionalOldSelf"), *schema.XValidations[i].OptionalOldSelf, "may not be set if oldSelf is not used in rule"))
 						}
 					}
 				}
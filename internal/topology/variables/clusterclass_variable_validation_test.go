@@ -64,6 +64,77 @@ func Test_ValidateClusterClassVariables(t *testing.T) {
 					"spec.variables[cpu].name"),
 			},
 		},
+		{
+			name: "Pass with valid variable migrations",
+			clusterClassVariables: []clusterv1.ClusterClassVariable{
+				{
+					Name: "region",
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+							Type: "array",
+							Items: &clusterv1.JSONSchemaProps{
+								Type: "string",
+							},
+						},
+					},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						RenamedFrom:     "location",
+						PreviousDefault: &apiextensionsv1.JSON{Raw: []byte(`"us-east"`)},
+						PreviousType:    "string",
+					},
+				},
+				{
+					Name: "cpu",
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+							Type: "number",
+						},
+					},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						PreviousType: "integer",
+					},
+				},
+			},
+		},
+		{
+			name: "Error if variable migrations are not valid",
+			clusterClassVariables: []clusterv1.ClusterClassVariable{
+				{
+					Name: "region",
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+							Type: "string",
+						},
+					},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						RenamedFrom: "cpu",
+					},
+				},
+				{
+					Name: "cpu",
+					Schema: clusterv1.VariableSchema{
+						OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+							Type: "integer",
+						},
+					},
+					Migration: clusterv1.ClusterClassVariableMigration{
+						RenamedFrom:     "cpu",
+						PreviousDefault: &apiextensionsv1.JSON{Raw: []byte(`{"invalid`)},
+						PreviousType:    "number",
+					},
+				},
+			},
+			wantErrs: []validationMatch{
+				invalid("variable \"cpu\" is defined in the ClusterClass, so it cannot be renamed",
+					"spec.variables[region].migration.renamedFrom"),
+				invalid("must be different from the name of the variable",
+					"spec.variables[cpu].migration.renamedFrom"),
+				invalid("must be valid JSON",
+					"spec.variables[cpu].migration.previousDefault"),
+				invalid("type cannot be widened from \"number\" to \"integer\"",
+					"spec.variables[cpu].migration.previousType"),
+			},
+		},
 		{
 			name: "Pass multiple variable validation",
 			clusterClassVariables: []clusterv1.ClusterClassVariable{
@@ -1786,6 +1857,41 @@ func Test_ValidateClusterClassVariable(t *testing.T) {
 					"spec.variables[cpu].schema.openAPIV3Schema.x-kubernetes-validations[0].rule"),
 			},
 		},
+		// CEL: optionalOldSelf
+		{
+			name: "pass if x-kubernetes-validations has valid transition rule with optionalOldSelf",
+			clusterClassVariable: &clusterv1.ClusterClassVariable{
+				Name: "cpu",
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type: "integer",
+						XValidations: []clusterv1.ValidationRule{{
+							Rule:            "!oldSelf.hasValue() || self >= oldSelf.value()",
+							OptionalOldSelf: ptr.To(true),
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "fail if x-kubernetes-validations has optionalOldSelf set but oldSelf is not used in rule",
+			clusterClassVariable: &clusterv1.ClusterClassVariable{
+				Name: "cpu",
+				Schema: clusterv1.VariableSchema{
+					OpenAPIV3Schema: clusterv1.JSONSchemaProps{
+						Type: "integer",
+						XValidations: []clusterv1.ValidationRule{{
+							Rule:            "self >= 1",
+							OptionalOldSelf: ptr.To(true),
+						}},
+					},
+				},
+			},
+			wantErrs: []validationMatch{
+				invalid("may not be set if oldSelf is not used in rule",
+					"spec.variables[cpu].schema.openAPIV3Schema.x-kubernetes-validations[0].optionalOldSelf"),
+			},
+		},
 		// CEL: uncorrelatable paths (in arrays)
 		{
 			name: "fail if x-kubernetes-validations has invalid rule: oldSef cannot be used in arrays",
@@ -232,6 +232,7 @@ func convertToAPIExtensionsXValidations(validationRules []clusterv1.ValidationRu
 				MessageExpression: validationRule.MessageExpression,
 				Reason:            reason,
 				FieldPath:         validationRule.FieldPath,
+				OptionalOldSelf:   validationRule.OptionalOldSelf,
 			},
 		)
 	}
@@ -350,6 +350,9 @@ func Test_convertToAPIExtensionsJSONSchemaProps(t *testing.T) {
 						MessageExpression: "value must be greater than 0",
 						FieldPath:         "a.field.path",
 						Reason:            clusterv1.FieldValueErrorReason("a reason"),
+					}, {
+						Rule:            "!oldSelf.hasValue() || self >= oldSelf.value()",
+						OptionalOldSelf: ptr.To(true),
 					}},
 				},
 			},
@@ -372,6 +375,9 @@ func Test_convertToAPIExtensionsJSONSchemaProps(t *testing.T) {
 							MessageExpression: "value must be greater than 0",
 							FieldPath:         "a.field.path",
 							Reason:            ptr.To(apiextensions.FieldValueErrorReason("a reason")),
+						}, {
+							Rule:            "!oldSelf.hasValue() || self >= oldSelf.value()",
+							OptionalOldSelf: ptr.To(true),
 						}},
 					},
 				},
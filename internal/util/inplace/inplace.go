@@ -62,6 +62,7 @@ func CleanupMachineSpecForDiff(spec *clusterv1.MachineSpec) *clusterv1.MachineSp
 	spec.ReadinessGates = nil
 	spec.Deletion.NodeDrainTimeoutSeconds = nil
 	spec.Deletion.NodeVolumeDetachTimeoutSeconds = nil
+	spec.Deletion.NodeVolumeForceDetachTimeoutSeconds = nil
 	spec.Deletion.NodeDeletionTimeoutSeconds = nil
 	spec.Taints = nil
 
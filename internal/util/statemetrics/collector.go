@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statemetrics implements a Prometheus collector exposing the conditions of Cluster API objects.
+package statemetrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// listTimeout is the timeout for listing the objects of a Resource when collecting metrics.
+const listTimeout = 10 * time.Second
+
+var (
+	conditionLabels = []string{"name", "namespace", "uid", "cluster_name", "type", "status"}
+
+	conditionStatuses = []metav1.ConditionStatus{metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionUnknown}
+)
+
+// Resource is a resource whose conditions are exposed as metrics.
+type Resource struct {
+	// Kind is the kind of the resource, e.g. Cluster. The metric names are prefixed with capi_<lowercase kind>,
+	// which matches the kube-state-metrics CustomResourceStateMetrics configuration of Cluster API,
+	// so dashboards and alerts work with both.
+	Kind string
+
+	// List is the list type used to list the objects of the resource, e.g. &clusterv1.ClusterList{}.
+	// The items of the list must implement conditions.Getter.
+	List client.ObjectList
+}
+
+// Collector is a Prometheus collector which exposes every condition of the objects of the
+// configured Resources as a <prefix>_status_condition gauge with type and status labels and a
+// <prefix>_status_condition_last_transition_time gauge.
+// Objects are listed at scrape time, so the reader should be backed by a cache.
+type Collector struct {
+	reader    client.Reader
+	resources []collectorResource
+}
+
+type collectorResource struct {
+	Resource
+	conditionDesc               *prometheus.Desc
+	conditionTransitionTimeDesc *prometheus.Desc
+}
+
+var _ prometheus.Collector = &Collector{}
+
+// NewCollector creates a new Collector for the given Resources.
+func NewCollector(reader client.Reader, resources ...Resource) *Collector {
+	c := &Collector{reader: reader}
+	for _, r := range resources {
+		kind := strings.ToLower(r.Kind)
+		metricNamePrefix := "capi_" + kind
+		c.resources = append(c.resources, collectorResource{
+			Resource: r,
+			conditionDesc: prometheus.NewDesc(metricNamePrefix+"_status_condition",
+				fmt.Sprintf("The condition of a %s.", kind), conditionLabels, nil),
+			conditionTransitionTimeDesc: prometheus.NewDesc(metricNamePrefix+"_status_condition_last_transition_time",
+				fmt.Sprintf("The condition's last transition time of a %s.", kind), conditionLabels, nil),
+		})
+	}
+	return c
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, r := range c.resources {
+		ch <- r.conditionDesc
+		ch <- r.conditionTransitionTimeDesc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+
+	for _, r := range c.resources {
+		c.collectResource(ctx, ch, r)
+	}
+}
+
+func (c *Collector) collectResource(ctx context.Context, ch chan<- prometheus.Metric, r collectorResource) {
+	list := r.List.DeepCopyObject().(client.ObjectList)
+	if err := c.reader.List(ctx, list); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list objects for state metrics", "kind", r.Kind)
+		return
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to extract objects for state metrics", "kind", r.Kind)
+		return
+	}
+
+	for _, item := range items {
+		obj, ok := item.(interface {
+			client.Object
+			conditions.Getter
+		})
+		if !ok {
+			continue
+		}
+
+		clusterName := obj.GetLabels()[clusterv1.ClusterNameLabel]
+		if _, isCluster := obj.(*clusterv1.Cluster); isCluster {
+			clusterName = obj.GetName()
+		}
+
+		for _, condition := range obj.GetConditions() {
+			labels := []string{obj.GetName(), obj.GetNamespace(), string(obj.GetUID()), clusterName, condition.Type}
+			for _, status := range conditionStatuses {
+				value := 0.0
+				if condition.Status == status {
+					value = 1
+				}
+				ch <- prometheus.MustNewConstMetric(r.conditionDesc, prometheus.GaugeValue, value, append(labels, string(status))...)
+			}
+			ch <- prometheus.MustNewConstMetric(r.conditionTransitionTimeDesc, prometheus.GaugeValue,
+				float64(condition.LastTransitionTime.Unix()), append(labels, string(condition.Status))...)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statemetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestCollector(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	transitionTime := metav1.NewTime(time.Unix(1700000000, 0))
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-1", Namespace: metav1.NamespaceDefault, UID: "cluster-uid"},
+		Status: clusterv1.ClusterStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.AvailableCondition, Status: metav1.ConditionTrue, LastTransitionTime: transitionTime},
+			},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine-1",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "machine-uid",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster-1"},
+		},
+		Status: clusterv1.MachineStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.MachineNodeReadyCondition, Status: metav1.ConditionUnknown, LastTransitionTime: transitionTime},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, machine).Build()
+
+	collector := NewCollector(c,
+		Resource{Kind: "Cluster", List: &clusterv1.ClusterList{}},
+		Resource{Kind: "Machine", List: &clusterv1.MachineList{}},
+	)
+
+	expected := `
+# HELP capi_cluster_status_condition The condition of a cluster.
+# TYPE capi_cluster_status_condition gauge
+capi_cluster_status_condition{cluster_name="cluster-1",name="cluster-1",namespace="default",status="False",type="Available",uid="cluster-uid"} 0
+capi_cluster_status_condition{cluster_name="cluster-1",name="cluster-1",namespace="default",status="True",type="Available",uid="cluster-uid"} 1
+capi_cluster_status_condition{cluster_name="cluster-1",name="cluster-1",namespace="default",status="Unknown",type="Available",uid="cluster-uid"} 0
+# HELP capi_cluster_status_condition_last_transition_time The condition's last transition time of a cluster.
+# TYPE capi_cluster_status_condition_last_transition_time gauge
+capi_cluster_status_condition_last_transition_time{cluster_name="cluster-1",name="cluster-1",namespace="default",status="True",type="Available",uid="cluster-uid"} 1.7e+09
+# HELP capi_machine_status_condition The condition of a machine.
+# TYPE capi_machine_status_condition gauge
+capi_machine_status_condition{cluster_name="cluster-1",name="machine-1",namespace="default",status="False",type="NodeReady",uid="machine-uid"} 0
+capi_machine_status_condition{cluster_name="cluster-1",name="machine-1",namespace="default",status="True",type="NodeReady",uid="machine-uid"} 0
+capi_machine_status_condition{cluster_name="cluster-1",name="machine-1",namespace="default",status="Unknown",type="NodeReady",uid="machine-uid"} 1
+# HELP capi_machine_status_condition_last_transition_time The condition's last transition time of a machine.
+# TYPE capi_machine_status_condition_last_transition_time gauge
+capi_machine_status_condition_last_transition_time{cluster_name="cluster-1",name="machine-1",namespace="default",status="Unknown",type="NodeReady",uid="machine-uid"} 1.7e+09
+`
+	g.Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected))).To(Succeed())
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/tree"
+)
+
+// HandlerPath is the path under which the object tree handler is served.
+const HandlerPath = "/debug/object-tree/"
+
+// NewHandler returns an http.Handler which serves the object tree of a Cluster, as computed by clusterctl describe, as JSON.
+// The Cluster is identified by the request path, e.g. /debug/object-tree/<namespace>/<name>; the following query parameters
+// can be used to control the discovery process:
+//   - showOtherConditions: list of comma separated kind or kind/name for which conditions should be shown, e.g. "all".
+//   - showMachineSets: include MachineSets in the tree.
+//   - showClusterResourceSets: include ClusterResourceSets in the tree.
+//   - showTemplates: include infrastructure and bootstrap config templates in the tree.
+//   - echo: show objects with the same ready condition of their parent.
+//   - grouping: group sibling objects with the same ready condition; defaults to true.
+//   - v1beta1: use v1beta1 conditions.
+func NewHandler(c ctrlclient.Client) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+HandlerPath+"{namespace}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		log := ctrl.LoggerFrom(r.Context()).WithValues("Cluster", r.PathValue("namespace")+"/"+r.PathValue("name"))
+
+		options, err := discoverOptionsFromQuery(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		objectTree, err := tree.Discovery(r.Context(), c, r.PathValue("namespace"), r.PathValue("name"), options)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			log.Error(err, "Failed to discover object tree")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		responseBody, err := json.Marshal(objectTree.ToObjectTreeNode())
+		if err != nil {
+			log.Error(err, "Failed to marshal object tree")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(responseBody)
+	})
+	return mux
+}
+
+func discoverOptionsFromQuery(r *http.Request) (tree.DiscoverOptions, error) {
+	query := r.URL.Query()
+	options := tree.DiscoverOptions{
+		ShowOtherConditions: query.Get("showOtherConditions"),
+		Grouping:            true,
+	}
+
+	for name, value := range map[string]*bool{
+		"showMachineSets":         &options.ShowMachineSets,
+		"showClusterResourceSets": &options.ShowClusterResourceSets,
+		"showTemplates":           &options.ShowTemplates,
+		"echo":                    &options.Echo,
+		"grouping":                &options.Grouping,
+		"v1beta1":                 &options.V1Beta1,
+	} {
+		if !query.Has(name) {
+			continue
+		}
+		v, err := strconv.ParseBool(query.Get(name))
+		if err != nil {
+			return tree.DiscoverOptions{}, pkgerrors.Wrapf(err, "invalid value for query parameter %q", name)
+		}
+		*value = v
+	}
+	// Templates are always grouped under a virtual node, consistent with clusterctl describe.
+	options.AddTemplateVirtualNode = true
+
+	return options, nil
+}
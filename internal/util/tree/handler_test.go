@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/tree"
+)
+
+func TestHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-cluster",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+	handler := NewHandler(c)
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+	}{
+		{
+			name:       "returns the object tree of an existing Cluster",
+			url:        HandlerPath + "ns/my-cluster?showOtherConditions=all&grouping=false",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "returns not found for a Cluster that does not exist",
+			url:        HandlerPath + "ns/does-not-exist",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "returns bad request for invalid query parameters",
+			url:        HandlerPath + "ns/my-cluster?echo=not-a-bool",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "returns not found for paths without namespace and name",
+			url:        HandlerPath + "ns",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.url, http.NoBody))
+			g.Expect(recorder.Code).To(Equal(tt.wantStatus))
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			g.Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			node := tree.ObjectTreeNode{}
+			g.Expect(json.Unmarshal(recorder.Body.Bytes(), &node)).To(Succeed())
+			g.Expect(node.Kind).To(Equal("Cluster"))
+			g.Expect(node.Namespace).To(Equal("ns"))
+			g.Expect(node.Name).To(Equal("my-cluster"))
+			g.Expect(node.ShowConditions).To(Equal(tree.ShowAllConditions))
+		})
+	}
+}
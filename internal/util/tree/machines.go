@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/tree"
+)
+
+// PrintMachines prints a row for each Machine in the cluster, including Machines hidden by grouping in the object tree.
+// Note: this function is exposed only for usage in clusterctl.
+func PrintMachines(objectTree *tree.ObjectTree, w io.Writer) error {
+	tbl := createObjectTree(w)
+
+	tbl.Header([]string{"MACHINE", "PHASE", "NODE", "FAILURE DOMAIN", "UP TO DATE", "AGE", "CONDITIONS"})
+
+	for _, m := range objectTree.GetMachines() {
+		node := ""
+		if m.Status.NodeRef.IsDefined() {
+			node = m.Status.NodeRef.Name
+		}
+
+		failureDomain := m.Status.FailureDomain
+		if failureDomain == "" {
+			failureDomain = m.Spec.FailureDomain
+		}
+
+		upToDate := ""
+		if c := tree.GetMachineUpToDateCondition(m); c != nil {
+			color, status, _, _, _ := conditionInfo(*c, true)
+			upToDate = color.Sprint(status)
+		}
+
+		if err := tbl.Append([]string{
+			m.Name,
+			m.Status.Phase,
+			node,
+			failureDomain,
+			upToDate,
+			duration.HumanDuration(time.Since(m.CreationTimestamp.Time)),
+			machineConditionsSummary(m),
+		}); err != nil {
+			return pkgerrors.Wrap(err, "failed to append machine row")
+		}
+	}
+
+	if err := tbl.Render(); err != nil {
+		return pkgerrors.Wrap(err, "failed to render table")
+	}
+
+	return nil
+}
+
+// machineConditionsSummary returns the list of conditions of a Machine which are not in their healthy state,
+// e.g. Ready=False for a positive polarity condition, or Deleting=True for a negative polarity condition.
+func machineConditionsSummary(m *clusterv1.Machine) string {
+	unhealthy := []string{}
+	for _, c := range m.GetConditions() {
+		positivePolarity := !negativePolarityConditions.Has(c.Type)
+		if (positivePolarity && c.Status == metav1.ConditionTrue) || (!positivePolarity && c.Status == metav1.ConditionFalse) {
+			continue
+		}
+		color, status, _, _, _ := conditionInfo(c, positivePolarity)
+		unhealthy = append(unhealthy, color.Sprintf("%s=%s", c.Type, status))
+	}
+	if len(unhealthy) == 0 {
+		return green.Sprint("OK")
+	}
+	return strings.Join(unhealthy, ", ")
+}
+
+// PrintConditionHistory prints the most recent condition transitions and events for the objects in the object tree.
+// If limit is greater than zero, only the limit most recent transitions are printed.
+// Note: this function is exposed only for usage in clusterctl.
+func PrintConditionHistory(objectTree *tree.ObjectTree, w io.Writer, limit int) error {
+	tbl := createObjectTree(w)
+
+	tbl.Header([]string{"SINCE", "OBJECT", "TYPE", "STATUS", "REASON", "MESSAGE"})
+
+	history := objectTree.GetConditionHistory()
+	if limit > 0 && len(history) > limit {
+		history = history[:limit]
+	}
+
+	for _, t := range history {
+		var status string
+		switch t.Type {
+		case tree.EventConditionTransitionType:
+			status = gray.Sprint(t.Status)
+			if t.Status == corev1.EventTypeWarning {
+				status = red.Sprint(t.Status)
+			}
+		default:
+			color, s, _, _, _ := conditionInfo(metav1.Condition{Status: metav1.ConditionStatus(t.Status)}, !negativePolarityConditions.Has(t.Type))
+			status = color.Sprint(s)
+		}
+
+		if err := tbl.Append([]string{
+			duration.HumanDuration(time.Since(t.Time.Time)),
+			fmt.Sprintf("%s/%s", t.Kind, t.Name),
+			cyan.Sprint(t.Type),
+			status,
+			t.Reason,
+			strings.TrimSpace(re.ReplaceAllString(t.Message, " ")),
+		}); err != nil {
+			return pkgerrors.Wrap(err, "failed to append condition history row")
+		}
+	}
+
+	if err := tbl.Render(); err != nil {
+		return pkgerrors.Wrap(err, "failed to render table")
+	}
+
+	return nil
+}
+
+// PrintMachineLogs prints the boot and console logs of the Machines reporting diagnostics.
+// Note: this function is exposed only for usage in clusterctl.
+func PrintMachineLogs(objectTree *tree.ObjectTree, w io.Writer) error {
+	machineLogs := objectTree.GetMachineLogs()
+	if len(machineLogs) == 0 {
+		_, err := fmt.Fprintln(w, "No Machine is reporting logs")
+		return err
+	}
+
+	var b strings.Builder
+	for i, logs := range machineLogs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(cyan.Sprintf("Machine/%s", logs.MachineName) + "\n")
+		if logs.URL != "" {
+			fmt.Fprintf(&b, "  URL: %s\n", logs.URL)
+		}
+		if logs.SecretName != "" {
+			fmt.Fprintf(&b, "  Secret: %s\n", logs.SecretName)
+		}
+		if logs.Message != "" {
+			fmt.Fprintf(&b, "  %s\n", yellow.Sprint(logs.Message))
+		}
+		for _, log := range logs.Logs {
+			fmt.Fprintf(&b, "  --- %s ---\n", log.Name)
+			for _, line := range strings.Split(strings.TrimSuffix(log.Content, "\n"), "\n") {
+				fmt.Fprintf(&b, "  %s\n", line)
+			}
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return pkgerrors.Wrap(err, "failed to print machine logs")
+	}
+	return nil
+}
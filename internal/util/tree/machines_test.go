@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func Test_machineConditionsSummary(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		want       string
+	}{
+		{
+			name: "all conditions healthy",
+			conditions: []metav1.Condition{
+				{Type: clusterv1.ReadyCondition, Status: metav1.ConditionTrue},
+				{Type: clusterv1.DeletingCondition, Status: metav1.ConditionFalse},
+			},
+			want: "OK",
+		},
+		{
+			name: "unhealthy conditions are listed taking polarity into account",
+			conditions: []metav1.Condition{
+				{Type: clusterv1.ReadyCondition, Status: metav1.ConditionFalse},
+				{Type: clusterv1.MachineNodeHealthyCondition, Status: metav1.ConditionUnknown},
+				{Type: clusterv1.DeletingCondition, Status: metav1.ConditionTrue},
+				{Type: clusterv1.PausedCondition, Status: metav1.ConditionFalse},
+			},
+			want: "Ready=False, NodeHealthy=Unknown, Deleting=True",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.Machine{}
+			m.SetConditions(tt.conditions)
+			g.Expect(machineConditionsSummary(m)).To(Equal(tt.want))
+		})
+	}
+}
@@ -62,6 +62,17 @@ var (
 	yellow = color.New(color.FgYellow)
 	white  = color.New(color.FgWhite)
 	cyan   = color.New(color.FgCyan)
+
+	// negativePolarityConditions defines the conditions for which Status=True is the bad state.
+	negativePolarityConditions = sets.New[string](
+		clusterv1.PausedCondition,
+		clusterv1.DeletingCondition,
+		clusterv1.RollingOutCondition,
+		clusterv1.ScalingUpCondition,
+		clusterv1.ScalingDownCondition,
+		clusterv1.MachineUpdatingCondition,
+		clusterv1.RemediatingCondition,
+	)
 )
 
 // createObjectTree creates a new tablewriter.Table for the object tree.
@@ -346,16 +357,6 @@ func addOtherConditions(prefix string, tbl *tablewriter.Table, objectTree *tree.
 		childrenPipe = pipe
 	}
 
-	negativePolarityConditions := sets.New[string](
-		clusterv1.PausedCondition,
-		clusterv1.DeletingCondition,
-		clusterv1.RollingOutCondition,
-		clusterv1.ScalingUpCondition,
-		clusterv1.ScalingDownCondition,
-		clusterv1.MachineUpdatingCondition,
-		clusterv1.RemediatingCondition,
-	)
-
 	conditions := tree.GetConditions(obj)
 	showConditions := conditions
 	if conditionFilter == tree.ShowNonZeroConditions {
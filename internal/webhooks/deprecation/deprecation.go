@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deprecation implements utils to surface the usage of deprecated fields in validating webhooks.
+package deprecation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Policy defines how validating webhooks handle the usage of deprecated fields.
+// The zero value behaves like WarnPolicy.
+type Policy string
+
+const (
+	// WarnPolicy returns an admission warning for each deprecated field in use.
+	WarnPolicy Policy = "Warn"
+
+	// DenyPolicy rejects requests starting to use deprecated fields.
+	// Note: Deprecated fields already in use are still returned as warnings, so existing objects
+	// can be updated without removing deprecated fields first.
+	DenyPolicy Policy = "Deny"
+)
+
+// Field is the usage of a deprecated field.
+type Field struct {
+	// Path is the path of the deprecated field.
+	Path *field.Path
+
+	// Detail explains what should be used instead of the deprecated field.
+	Detail string
+}
+
+// Validate surfaces the deprecated fields used by an object according to the Policy.
+// oldFields are the deprecated fields used by the object before an update, they must be nil on create.
+func (p Policy) Validate(oldFields, newFields []Field) (admission.Warnings, field.ErrorList) {
+	var allWarnings admission.Warnings
+	var allErrs field.ErrorList
+
+	oldPaths := sets.Set[string]{}
+	for _, f := range oldFields {
+		oldPaths.Insert(f.Path.String())
+	}
+
+	for _, f := range newFields {
+		if p == DenyPolicy && !oldPaths.Has(f.Path.String()) {
+			allErrs = append(allErrs, field.Forbidden(f.Path, fmt.Sprintf("is deprecated: %s", f.Detail)))
+			continue
+		}
+		allWarnings = append(allWarnings, fmt.Sprintf("%s is deprecated: %s", f.Path, f.Detail))
+	}
+	return allWarnings, allErrs
+}
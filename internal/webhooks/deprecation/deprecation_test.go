@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidate(t *testing.T) {
+	fooField := Field{Path: field.NewPath("spec", "foo"), Detail: "use spec.bar instead"}
+	bazField := Field{Path: field.NewPath("spec", "baz"), Detail: "use spec.qux instead"}
+
+	tests := []struct {
+		name         string
+		policy       Policy
+		oldFields    []Field
+		newFields    []Field
+		wantWarnings []string
+		wantErrs     []string
+	}{
+		{
+			name:   "No warnings if no deprecated fields are used",
+			policy: WarnPolicy,
+		},
+		{
+			name:         "Warnings for deprecated fields if the policy is not set",
+			newFields:    []Field{fooField},
+			wantWarnings: []string{"spec.foo is deprecated: use spec.bar instead"},
+		},
+		{
+			name:      "Warnings for deprecated fields with the Warn policy",
+			policy:    WarnPolicy,
+			newFields: []Field{fooField, bazField},
+			wantWarnings: []string{
+				"spec.foo is deprecated: use spec.bar instead",
+				"spec.baz is deprecated: use spec.qux instead",
+			},
+		},
+		{
+			name:      "Errors for deprecated fields with the Deny policy",
+			policy:    DenyPolicy,
+			newFields: []Field{fooField, bazField},
+			wantErrs: []string{
+				"spec.foo: Forbidden: is deprecated: use spec.bar instead",
+				"spec.baz: Forbidden: is deprecated: use spec.qux instead",
+			},
+		},
+		{
+			name:         "Warnings for deprecated fields already in use with the Deny policy",
+			policy:       DenyPolicy,
+			oldFields:    []Field{fooField},
+			newFields:    []Field{fooField, bazField},
+			wantWarnings: []string{"spec.foo is deprecated: use spec.bar instead"},
+			wantErrs:     []string{"spec.baz: Forbidden: is deprecated: use spec.qux instead"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			warnings, errs := tt.policy.Validate(tt.oldFields, tt.newFields)
+			g.Expect(warnings).To(HaveLen(len(tt.wantWarnings)))
+			for i, wantWarning := range tt.wantWarnings {
+				g.Expect(warnings[i]).To(Equal(wantWarning))
+			}
+			g.Expect(errs).To(HaveLen(len(tt.wantErrs)))
+			for i, wantErr := range tt.wantErrs {
+				g.Expect(errs[i].Error()).To(Equal(wantErr))
+			}
+		})
+	}
+}
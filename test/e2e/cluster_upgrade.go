@@ -43,6 +43,11 @@ type ClusterUpgradeConformanceSpecInput struct {
 	SkipConformanceTests  bool
 	ControlPlaneWaiters   clusterctl.ControlPlaneWaiters
 
+	// AssertionHooks allows to inject provider-specific checks to be run once the control plane and
+	// the workers of the workload cluster are ready, both after the cluster is created and after it is upgraded.
+	// If not specified, this is a no-op.
+	AssertionHooks *framework.AssertionHooks
+
 	// InfrastructureProviders specifies the infrastructure to use for clusterctl
 	// operations (Example: get cluster templates).
 	// Note: In most cases this need not be specified. It only needs to be specified when
@@ -168,12 +173,18 @@ func ClusterUpgradeConformanceSpec(ctx context.Context, inputGetter func() Clust
 				WorkerMachineCount:       ptr.To[int64](workerMachineCount),
 			},
 			ControlPlaneWaiters:          input.ControlPlaneWaiters,
+			AssertionHooks:               input.AssertionHooks,
 			WaitForClusterIntervals:      input.E2EConfig.GetIntervals(specName, "wait-cluster"),
 			WaitForControlPlaneIntervals: input.E2EConfig.GetIntervals(specName, "wait-control-plane"),
 			WaitForMachineDeployments:    input.E2EConfig.GetIntervals(specName, "wait-worker-nodes"),
 			WaitForMachinePools:          input.E2EConfig.GetIntervals(specName, "wait-machine-pool-nodes"),
 		}, clusterResources)
 
+		assertionHookInput := framework.AssertionHookInput{
+			ClusterProxy: input.BootstrapClusterProxy,
+			Cluster:      clusterResources.Cluster,
+		}
+
 		if clusterResources.Cluster.Spec.Topology.IsDefined() {
 			// Cluster is using ClusterClass, upgrade via topology.
 			By("Upgrading the Cluster topology")
@@ -198,6 +209,11 @@ func ClusterUpgradeConformanceSpec(ctx context.Context, inputGetter func() Clust
 					}
 				},
 			})
+
+			// Note: UpgradeClusterTopologyAndWaitForUpgrade upgrades the control plane and the workers at once,
+			// so hooks for both phases are run after the whole Cluster is upgraded.
+			input.AssertionHooks.Run(ctx, framework.AfterControlPlaneReady, assertionHookInput)
+			input.AssertionHooks.Run(ctx, framework.AfterMachineDeploymentsRollout, assertionHookInput)
 		} else {
 			// Cluster is not using ClusterClass, upgrade via individual resources.
 			By("Upgrading the Kubernetes control-plane")
@@ -233,6 +249,8 @@ func ClusterUpgradeConformanceSpec(ctx context.Context, inputGetter func() Clust
 				},
 			})
 
+			input.AssertionHooks.Run(ctx, framework.AfterControlPlaneReady, assertionHookInput)
+
 			if workerMachineCount > 0 {
 				By("Upgrading the machine deployment")
 				framework.UpgradeMachineDeploymentsAndWait(ctx, framework.UpgradeMachineDeploymentsAndWaitInput{
@@ -254,6 +272,8 @@ func ClusterUpgradeConformanceSpec(ctx context.Context, inputGetter func() Clust
 						MachinePools:                   clusterResources.MachinePools,
 					})
 				}
+
+				input.AssertionHooks.Run(ctx, framework.AfterMachineDeploymentsRollout, assertionHookInput)
 			}
 		}
 
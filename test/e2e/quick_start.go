@@ -73,6 +73,11 @@ type QuickStartSpecInput struct {
 	// which unblocks CNI installation, and for the control plane machines to be ready (after CNI installation).
 	ControlPlaneWaiters clusterctl.ControlPlaneWaiters
 
+	// AssertionHooks allows to inject provider-specific checks to be run once the control plane and
+	// the workers of the workload cluster are ready.
+	// If not specified, this is a no-op.
+	AssertionHooks *framework.AssertionHooks
+
 	// ExtensionConfigName is the name of the ExtensionConfig. Defaults to "quick-start".
 	// This value is provided to clusterctl as "EXTENSION_CONFIG_NAME" variable and can be used to template the
 	// name of the ExtensionConfig into the ClusterClass.
@@ -221,6 +226,7 @@ func QuickStartSpec(ctx context.Context, inputGetter func() QuickStartSpecInput)
 				WorkerMachineCount:       workerMachineCount,
 			},
 			ControlPlaneWaiters:          input.ControlPlaneWaiters,
+			AssertionHooks:               input.AssertionHooks,
 			WaitForClusterIntervals:      input.E2EConfig.GetIntervals(specName, "wait-cluster"),
 			WaitForControlPlaneIntervals: input.E2EConfig.GetIntervals(specName, "wait-control-plane"),
 			WaitForMachineDeployments:    input.E2EConfig.GetIntervals(specName, "wait-worker-nodes"),
@@ -47,6 +47,11 @@ type SelfHostedSpecInput struct {
 	ArtifactFolder        string
 	SkipCleanup           bool
 	ControlPlaneWaiters   clusterctl.ControlPlaneWaiters
+
+	// AssertionHooks allows to inject provider-specific checks to be run once the control plane and
+	// the workers of the workload cluster are ready, both after the cluster is created and after it is upgraded.
+	// If not specified, this is a no-op.
+	AssertionHooks *framework.AssertionHooks
 	// PreCleanupSelfHostedCluster hook can be used to run code before the self-hosted cluster is cleaned up.
 	// This is for example used in core Cluster API to dump secrets, which might not be safe in general.
 	PreCleanupSelfHostedCluster func(managementClusterProxy framework.ClusterProxy)
@@ -193,6 +198,7 @@ func SelfHostedSpec(ctx context.Context, inputGetter func() SelfHostedSpecInput)
 				ClusterctlVariables:      clusterctlVariables,
 			},
 			ControlPlaneWaiters:          input.ControlPlaneWaiters,
+			AssertionHooks:               input.AssertionHooks,
 			WaitForClusterIntervals:      input.E2EConfig.GetIntervals(specName, "wait-cluster"),
 			WaitForControlPlaneIntervals: input.E2EConfig.GetIntervals(specName, "wait-control-plane"),
 			WaitForMachineDeployments:    input.E2EConfig.GetIntervals(specName, "wait-worker-nodes"),
@@ -363,6 +369,12 @@ func SelfHostedSpec(ctx context.Context, inputGetter func() SelfHostedSpecInput)
 			ControlPlane: clusterResources.ControlPlane,
 		}, input.E2EConfig.GetIntervals(specName, "wait-control-plane")...)
 
+		// Note: after the move, assertion hooks are run against the self-hosted cluster which now hosts the Cluster.
+		assertionHookInput := framework.AssertionHookInput{
+			ClusterProxy: selfHostedClusterProxy,
+			Cluster:      clusterResources.Cluster,
+		}
+
 		By("Upgrading the self-hosted Cluster")
 		if clusterResources.Cluster.Spec.Topology.IsDefined() {
 			// Cluster is using ClusterClass, upgrade via topology.
@@ -383,6 +395,9 @@ func SelfHostedSpec(ctx context.Context, inputGetter func() SelfHostedSpecInput)
 				WaitForDNSUpgrade:                    input.E2EConfig.GetIntervals(specName, "wait-machine-upgrade"),
 				WaitForEtcdUpgrade:                   input.E2EConfig.GetIntervals(specName, "wait-machine-upgrade"),
 			})
+
+			input.AssertionHooks.Run(ctx, framework.AfterControlPlaneReady, assertionHookInput)
+			input.AssertionHooks.Run(ctx, framework.AfterMachineDeploymentsRollout, assertionHookInput)
 		} else {
 			// Cluster is not using ClusterClass, upgrade via individual resources.
 			By("Upgrading the Kubernetes control-plane")
@@ -413,6 +428,8 @@ func SelfHostedSpec(ctx context.Context, inputGetter func() SelfHostedSpecInput)
 				WaitForEtcdUpgrade:          input.E2EConfig.GetIntervals(specName, "wait-machine-upgrade"),
 			})
 
+			input.AssertionHooks.Run(ctx, framework.AfterControlPlaneReady, assertionHookInput)
+
 			if workerMachineCount > 0 {
 				By("Upgrading the machine deployment")
 				framework.UpgradeMachineDeploymentsAndWait(ctx, framework.UpgradeMachineDeploymentsAndWaitInput{
@@ -434,6 +451,8 @@ func SelfHostedSpec(ctx context.Context, inputGetter func() SelfHostedSpecInput)
 						MachinePools:                   clusterResources.MachinePools,
 					})
 				}
+
+				input.AssertionHooks.Run(ctx, framework.AfterMachineDeploymentsRollout, assertionHookInput)
 			}
 		}
 
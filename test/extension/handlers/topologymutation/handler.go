@@ -112,7 +112,7 @@ func (h *ExtensionHandlers) GeneratePatches(ctx context.Context, req *runtimehoo
 				return pkgerrors.Wrapf(err, "error patching KubeadmControlPlaneTemplate")
 			}
 		case *bootstrapv1beta1.KubeadmConfigTemplate, *bootstrapv1.KubeadmConfigTemplate:
-			// NOTE: KubeadmConfigTemplate could be linked to one or more of the existing MachineDeployment class;
+			// NOTE: KubeadmConfigTemplate could be linked to one or more of the existing MachineDeployment or MachinePool classes;
 			// the patchKubeadmConfigTemplate func shows how to implement patches only for KubeadmConfigTemplates
 			// linked to a specific MachineDeployment class; another option is to check the holderRef value and call
 			// this func or more specialized func conditionally.
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// clusterCacheConnectTimeout is the time to wait for the ClusterCache to connect to a workload cluster.
+const clusterCacheConnectTimeout = 10 * time.Second
+
+// ArtifactSource identifies the cluster artifacts are collected from.
+type ArtifactSource string
+
+const (
+	// ManagementClusterArtifactSource identifies the management cluster hosting the Cluster.
+	ManagementClusterArtifactSource ArtifactSource = "ManagementCluster"
+
+	// WorkloadClusterArtifactSource identifies the workload cluster.
+	WorkloadClusterArtifactSource ArtifactSource = "WorkloadCluster"
+)
+
+// ArtifactResource declares a kind of resources to be collected, e.g. core types or the CRs of a provider.
+type ArtifactResource struct {
+	// Source is the cluster the resources are collected from.
+	Source ArtifactSource
+
+	// GVK of the resources to be collected.
+	GVK schema.GroupVersionKind
+
+	// Namespace of the resources to be collected. If empty, resources are collected from all the namespaces
+	// of the workload cluster or from the namespace of the Cluster in the management cluster.
+	Namespace string
+}
+
+// DefaultArtifactResources are the resources collected for every Cluster.
+var DefaultArtifactResources = []ArtifactResource{
+	{
+		Source: WorkloadClusterArtifactSource,
+		GVK:    corev1.SchemeGroupVersion.WithKind("Pod"),
+	},
+	{
+		Source: WorkloadClusterArtifactSource,
+		GVK:    corev1.SchemeGroupVersion.WithKind("Node"),
+	},
+	{
+		Source: WorkloadClusterArtifactSource,
+		GVK:    corev1.SchemeGroupVersion.WithKind("Event"),
+	},
+}
+
+// ArtifactCollectorInput is the input for ArtifactCollector.
+type ArtifactCollectorInput struct {
+	ManagementClusterProxy ClusterProxy
+
+	// WorkloadClusterClient is a client for the workload cluster; it is nil when the Cluster doesn't exist anymore.
+	WorkloadClusterClient client.Client
+
+	Cluster    *clusterv1.Cluster
+	OutputPath string
+}
+
+// ArtifactCollector defines an object that can collect artifacts for a Cluster, e.g. provider-specific
+// resources or logs of the infrastructure.
+type ArtifactCollector interface {
+	// CollectArtifacts collects artifacts for a Cluster to input.OutputPath.
+	CollectArtifacts(ctx context.Context, input ArtifactCollectorInput) error
+}
+
+// ArtifactCollectorsGetter is an optional interface implemented by ClusterProxy implementations which have
+// additional artifact collectors registered, e.g. via the WithArtifactCollectors option.
+type ArtifactCollectorsGetter interface {
+	// GetArtifactCollectors returns the additional artifact collectors registered for the Kubernetes cluster.
+	GetArtifactCollectors() []ArtifactCollector
+}
+
+// NewResourceArtifactCollector returns an ArtifactCollector dumping the given resources to YAML.
+// Resources from the management cluster are dumped to <OutputPath>/management-resources while
+// resources from the workload cluster are dumped to <OutputPath>/resources.
+func NewResourceArtifactCollector(resources ...ArtifactResource) ArtifactCollector {
+	return &resourceArtifactCollector{resources: resources}
+}
+
+type resourceArtifactCollector struct {
+	resources []ArtifactResource
+}
+
+func (c *resourceArtifactCollector) CollectArtifacts(ctx context.Context, input ArtifactCollectorInput) error {
+	var managementResources, workloadResources []DumpNamespaceAndGVK
+	for _, r := range c.resources {
+		switch r.Source {
+		case ManagementClusterArtifactSource:
+			namespace := r.Namespace
+			if namespace == "" {
+				namespace = input.Cluster.Namespace
+			}
+			managementResources = append(managementResources, DumpNamespaceAndGVK{GVK: r.GVK, Namespace: namespace})
+		case WorkloadClusterArtifactSource:
+			workloadResources = append(workloadResources, DumpNamespaceAndGVK{GVK: r.GVK, Namespace: r.Namespace})
+		default:
+			return pkgerrors.Errorf("invalid source %q for artifact resource %s", r.Source, r.GVK.Kind)
+		}
+	}
+
+	if len(managementResources) > 0 {
+		DumpResourcesForCluster(ctx, DumpResourcesForClusterInput{
+			Lister:    input.ManagementClusterProxy.GetClient(),
+			LogPath:   filepath.Join(input.OutputPath, "management-resources"),
+			Resources: managementResources,
+		})
+	}
+
+	if len(workloadResources) > 0 && input.WorkloadClusterClient != nil {
+		DumpResourcesForCluster(ctx, DumpResourcesForClusterInput{
+			Lister:    input.WorkloadClusterClient,
+			LogPath:   filepath.Join(input.OutputPath, "resources"),
+			Resources: workloadResources,
+		})
+	}
+	return nil
+}
+
+// CollectArtifactsInput is the input for CollectArtifacts.
+type CollectArtifactsInput struct {
+	ClusterProxy   ClusterProxy
+	Cluster        *clusterv1.Cluster
+	ArtifactFolder string
+
+	// Collectors are run in addition to the collector for DefaultArtifactResources and
+	// to the collectors registered on ClusterProxy.
+	Collectors []ArtifactCollector
+}
+
+// CollectArtifacts collects the artifacts for a Cluster from both the management and the workload cluster
+// to <ArtifactFolder>/clusters/<Cluster name>.
+// If the current spec failed, the collected artifacts are also packed into a bundle which is attached
+// to the spec report, and thus to the junit report.
+func CollectArtifacts(ctx context.Context, input CollectArtifactsInput) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for CollectArtifacts")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling CollectArtifacts")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling CollectArtifacts")
+	Expect(input.ArtifactFolder).ToNot(BeEmpty(), "Invalid argument. input.ArtifactFolder can't be empty when calling CollectArtifacts")
+
+	collectorInput := ArtifactCollectorInput{
+		ManagementClusterProxy: input.ClusterProxy,
+		Cluster:                input.Cluster,
+		OutputPath:             filepath.Join(input.ArtifactFolder, "clusters", input.Cluster.Name),
+	}
+
+	// Artifacts can be collected from the workload cluster only if the Cluster still exists.
+	if err := input.ClusterProxy.GetClient().Get(ctx, client.ObjectKeyFromObject(input.Cluster), &clusterv1.Cluster{}); err == nil {
+		var dispose func()
+		collectorInput.WorkloadClusterClient, dispose = getWorkloadClusterClient(ctx, input.ClusterProxy, input.Cluster)
+		defer dispose()
+	}
+
+	collectors := []ArtifactCollector{NewResourceArtifactCollector(DefaultArtifactResources...)}
+	if getter, ok := input.ClusterProxy.(ArtifactCollectorsGetter); ok {
+		collectors = append(collectors, getter.GetArtifactCollectors()...)
+	}
+	collectors = append(collectors, input.Collectors...)
+	for _, c := range collectors {
+		if err := c.CollectArtifacts(ctx, collectorInput); err != nil {
+			// NB. we are treating failures in collecting artifacts as a non-blocking operation (best effort)
+			fmt.Printf("Failed to collect artifacts for Cluster %s: %v\n", klog.KObj(input.Cluster), err)
+		}
+	}
+
+	if !CurrentSpecReport().Failed() {
+		return
+	}
+
+	bundlePath := filepath.Join(input.ArtifactFolder, "clusters", fmt.Sprintf("%s-artifacts.tar.gz", input.Cluster.Name))
+	if err := writeArtifactsBundle(collectorInput.OutputPath, bundlePath); err != nil {
+		fmt.Printf("Failed to create artifacts bundle for Cluster %s: %v\n", klog.KObj(input.Cluster), err)
+		return
+	}
+	AddReportEntry(fmt.Sprintf("Artifacts bundle for Cluster %s", klog.KObj(input.Cluster)), bundlePath)
+}
+
+// getWorkloadClusterClient returns a client for the workload cluster and a func to release it.
+// The client is read from the ClusterCache of the management cluster proxy if available; if the ClusterCache
+// can't connect to the workload cluster, e.g. because the workload cluster is only reachable via port-forwarding,
+// the client of the proxy returned by GetWorkloadCluster is used instead.
+func getWorkloadClusterClient(ctx context.Context, proxy ClusterProxy, cluster *clusterv1.Cluster) (client.Client, func()) {
+	if getter, ok := proxy.(ClusterCacheGetter); ok {
+		clusterCache := getter.GetClusterCache(ctx)
+
+		var c client.Client
+		// Note: The ClusterCache connects to the workload cluster asynchronously.
+		err := wait.PollUntilContextTimeout(ctx, time.Second, clusterCacheConnectTimeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			c, err = clusterCache.GetUncachedClient(ctx, client.ObjectKeyFromObject(cluster))
+			return err == nil, nil
+		})
+		if err == nil {
+			return c, func() {}
+		}
+		fmt.Printf("Failed to get a client for Cluster %s from the ClusterCache, using the workload cluster proxy instead: %v\n", klog.KObj(cluster), err)
+	}
+
+	workloadClusterProxy := proxy.GetWorkloadCluster(ctx, cluster.Namespace, cluster.Name)
+	return workloadClusterProxy.GetClient(), func() { workloadClusterProxy.Dispose(ctx) }
+}
+
+// writeArtifactsBundle packs all the files in dir into a tar.gz archive at bundlePath.
+func writeArtifactsBundle(dir, bundlePath string) error {
+	f, err := os.Create(filepath.Clean(bundlePath))
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to create %s", bundlePath)
+	}
+	defer f.Close()
+
+	gzw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gzw)
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		src, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(tw, src)
+		return err
+	})
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to add files from %s to %s", dir, bundlePath)
+	}
+
+	if err := tw.Close(); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write %s", bundlePath)
+	}
+	if err := gzw.Close(); err != nil {
+		return pkgerrors.Wrapf(err, "failed to write %s", bundlePath)
+	}
+	return nil
+}
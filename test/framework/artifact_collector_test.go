@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func Test_writeArtifactsBundle(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	g.Expect(os.MkdirAll(filepath.Join(dir, "resources", "kube-system", "Pod"), 0750)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "resources", "kube-system", "Pod", "etcd.yaml"), []byte("kind: Pod"), 0600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "infrastructure.log"), []byte("log"), 0600)).To(Succeed())
+
+	bundlePath := filepath.Join(t.TempDir(), "artifacts.tar.gz")
+	g.Expect(writeArtifactsBundle(dir, bundlePath)).To(Succeed())
+
+	f, err := os.Open(bundlePath) //nolint:gosec
+	g.Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	g.Expect(err).ToNot(HaveOccurred())
+	tr := tar.NewReader(gzr)
+
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		g.Expect(err).ToNot(HaveOccurred())
+		content, err := io.ReadAll(tr)
+		g.Expect(err).ToNot(HaveOccurred())
+		files[header.Name] = string(content)
+	}
+	g.Expect(files).To(Equal(map[string]string{
+		"resources/kube-system/Pod/etcd.yaml": "kind: Pod",
+		"infrastructure.log":                  "log",
+	}))
+}
+
+func Test_resourceArtifactCollector_invalidSource(t *testing.T) {
+	g := NewWithT(t)
+
+	collector := NewResourceArtifactCollector(ArtifactResource{
+		Source: "invalid",
+		GVK:    corev1.SchemeGroupVersion.WithKind("Pod"),
+	})
+	err := collector.CollectArtifacts(context.Background(), ArtifactCollectorInput{
+		Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+		OutputPath: t.TempDir(),
+	})
+	g.Expect(err).To(HaveOccurred())
+}
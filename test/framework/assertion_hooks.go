@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/test/framework/internal/log"
+)
+
+// AssertionPhase identifies the point of a spec at which AssertionHooks are run.
+type AssertionPhase string
+
+const (
+	// AfterControlPlaneReady is run once all the control plane machines of a Cluster are ready,
+	// both after the Cluster is created and after the control plane is upgraded.
+	AfterControlPlaneReady AssertionPhase = "AfterControlPlaneReady"
+
+	// AfterMachineDeploymentsRollout is run once all the MachineDeployments and MachinePools of a Cluster
+	// are rolled out, both after the Cluster is created and after the workers are upgraded.
+	AfterMachineDeploymentsRollout AssertionPhase = "AfterMachineDeploymentsRollout"
+)
+
+// AssertionHookInput is the input for an AssertionHook.
+type AssertionHookInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+	Phase        AssertionPhase
+}
+
+// AssertionHook is a func implementing provider-specific checks, e.g. verifying the infrastructure
+// backing a Cluster; checks are expected to be implemented with gomega assertions.
+type AssertionHook func(ctx context.Context, input AssertionHookInput)
+
+type namedAssertionHook struct {
+	name string
+	hook AssertionHook
+}
+
+// AssertionHooks is a set of AssertionHooks grouped by AssertionPhase.
+// AssertionHooks allow providers to reuse the core specs while injecting their own checks.
+type AssertionHooks struct {
+	hooks map[AssertionPhase][]namedAssertionHook
+}
+
+// NewAssertionHooks returns an empty set of AssertionHooks.
+func NewAssertionHooks() *AssertionHooks {
+	return &AssertionHooks{
+		hooks: map[AssertionPhase][]namedAssertionHook{},
+	}
+}
+
+// Register adds an AssertionHook to be run at the given phase.
+// Hooks for the same phase are run in registration order.
+func (h *AssertionHooks) Register(phase AssertionPhase, name string, hook AssertionHook) *AssertionHooks {
+	h.hooks[phase] = append(h.hooks[phase], namedAssertionHook{name: name, hook: hook})
+	return h
+}
+
+// Run runs all the AssertionHooks registered for the given phase.
+// Run is a no-op on a nil AssertionHooks, so specs can call it unconditionally.
+func (h *AssertionHooks) Run(ctx context.Context, phase AssertionPhase, input AssertionHookInput) {
+	if h == nil {
+		return
+	}
+	input.Phase = phase
+	for _, n := range h.hooks[phase] {
+		log.Logf("Running assertion hook %s %s", phase, n.name)
+		n.hook(ctx, input)
+	}
+}
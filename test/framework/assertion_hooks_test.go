@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestAssertionHooks(t *testing.T) {
+	t.Run("Run is a no-op on nil AssertionHooks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var hooks *AssertionHooks
+		g.Expect(func() {
+			hooks.Run(context.Background(), AfterControlPlaneReady, AssertionHookInput{})
+		}).ToNot(Panic())
+	})
+
+	t.Run("Run only runs hooks for the given phase, in registration order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+		var calls []string
+		record := func(name string) AssertionHook {
+			return func(_ context.Context, input AssertionHookInput) {
+				g.Expect(input.Cluster).To(Equal(cluster))
+				calls = append(calls, string(input.Phase)+"/"+name)
+			}
+		}
+
+		hooks := NewAssertionHooks().
+			Register(AfterControlPlaneReady, "cp-1", record("cp-1")).
+			Register(AfterMachineDeploymentsRollout, "md-1", record("md-1")).
+			Register(AfterControlPlaneReady, "cp-2", record("cp-2"))
+
+		hooks.Run(context.Background(), AfterControlPlaneReady, AssertionHookInput{Cluster: cluster})
+		g.Expect(calls).To(Equal([]string{"AfterControlPlaneReady/cp-1", "AfterControlPlaneReady/cp-2"}))
+
+		calls = nil
+		hooks.Run(context.Background(), AfterMachineDeploymentsRollout, AssertionHookInput{Cluster: cluster})
+		g.Expect(calls).To(Equal([]string{"AfterMachineDeploymentsRollout/md-1"}))
+	})
+}
@@ -43,11 +43,17 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/test/framework/internal/log"
 	"sigs.k8s.io/cluster-api/test/infrastructure/container"
 	inmemoryproxy "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/proxy"
@@ -112,6 +118,13 @@ type ClusterProxy interface {
 	Dispose(context.Context)
 }
 
+// ClusterCacheGetter is an optional interface implemented by ClusterProxy implementations which provide a
+// ClusterCache to access the workload clusters defined in the Kubernetes cluster.
+type ClusterCacheGetter interface {
+	// GetClusterCache returns a ClusterCache for the workload clusters defined in the Kubernetes cluster.
+	GetClusterCache(ctx context.Context) clustercache.ClusterCache
+}
+
 // createConfig contains options for use with Create.
 type createConfig struct {
 	labelSelector             labels.Selector
@@ -202,6 +215,14 @@ func WithMachineLogCollector(logCollector ClusterLogCollector) Option {
 	}
 }
 
+// WithArtifactCollectors allows to register additional artifact collectors, e.g. provider-specific collectors,
+// to be run when dumping the artifacts of the workload clusters defined in this Cluster.
+func WithArtifactCollectors(collectors ...ArtifactCollector) Option {
+	return func(c *clusterProxy) {
+		c.artifactCollectors = append(c.artifactCollectors, collectors...)
+	}
+}
+
 // WithRESTConfigModifier allows to modify the rest config in GetRESTConfig.
 // Using this function it is possible to create ClusterProxy that can work with workload clusters hosted in places
 // not directly accessible from the machine where we run the E2E tests, e.g. inside kind.
@@ -225,8 +246,11 @@ type clusterProxy struct {
 	scheme                  *runtime.Scheme
 	shouldCleanupKubeconfig bool
 	logCollector            ClusterLogCollector
+	artifactCollectors      []ArtifactCollector
 	cache                   cache.Cache
 	onceCache               sync.Once
+	clusterCache            clustercache.ClusterCache
+	onceClusterCache        sync.Once
 
 	restConfigModifier   func(*rest.Config)
 	cacheOptionsModifier func(*cache.Options)
@@ -352,6 +376,39 @@ func (p *clusterProxy) GetCache(ctx context.Context) cache.Cache {
 	return p.cache
 }
 
+// GetClusterCache returns a ClusterCache for the workload clusters defined in the Kubernetes cluster.
+// The ClusterCache is started the first time it's requested and it is stopped when ctx is done.
+func (p *clusterProxy) GetClusterCache(ctx context.Context) clustercache.ClusterCache {
+	p.onceClusterCache.Do(func() {
+		mgr, err := ctrl.NewManager(p.GetRESTConfig(), ctrl.Options{
+			Scheme: p.scheme,
+			Metrics: metricsserver.Options{
+				BindAddress: "0",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred(), "Failed to create manager for the ClusterCache")
+
+		p.clusterCache, err = clustercache.SetupWithManager(ctx, mgr, clustercache.Options{
+			SecretClient: mgr.GetAPIReader(),
+			Client: clustercache.ClientOptions{
+				UserAgent: remote.DefaultClusterAPIUserAgent("cluster-api-e2e"),
+			},
+		}, controller.Options{
+			MaxConcurrentReconciles: 10,
+			// Allow a ClusterCache for each ClusterProxy.
+			SkipNameValidation: ptr.To(true),
+		})
+		Expect(err).ToNot(HaveOccurred(), "Failed to create ClusterCache")
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(mgr.Start(ctx)).To(Succeed())
+		}()
+	})
+
+	return p.clusterCache
+}
+
 // Create creates objects using the clusterProxy client.
 // It will return an error if any object already exists.
 // Defaults to use FieldValidation: strict, which can be overwritten with CreateOptions.
@@ -510,6 +567,11 @@ func (p *clusterProxy) GetLogCollector() ClusterLogCollector {
 	return p.logCollector
 }
 
+// GetArtifactCollectors returns the additional artifact collectors registered for the Kubernetes cluster.
+func (p *clusterProxy) GetArtifactCollectors() []ArtifactCollector {
+	return p.artifactCollectors
+}
+
 // GetWorkloadCluster returns ClusterProxy for the workload cluster.
 func (p *clusterProxy) GetWorkloadCluster(ctx context.Context, namespace, name string, options ...Option) ClusterProxy {
 	Expect(ctx).NotTo(BeNil(), "ctx is required for GetWorkloadCluster")
@@ -227,6 +227,7 @@ type ApplyClusterTemplateAndWaitInput struct {
 	CreateOpts                   []framework.CreateOption // options to be passed to Create function config
 	PreWaitForCluster            func()
 	PostMachinesProvisioned      func()
+	AssertionHooks               *framework.AssertionHooks // provider-specific checks run after the control plane and the workers are ready
 	ControlPlaneWaiters
 }
 
@@ -339,6 +340,7 @@ func ApplyClusterTemplateAndWait(ctx context.Context, input ApplyClusterTemplate
 		CreateOpts:                   input.CreateOpts,
 		PreWaitForCluster:            input.PreWaitForCluster,
 		PostMachinesProvisioned:      input.PostMachinesProvisioned,
+		AssertionHooks:               input.AssertionHooks,
 		ControlPlaneWaiters:          input.ControlPlaneWaiters,
 	}, (*ApplyCustomClusterTemplateAndWaitResult)(result))
 }
@@ -358,6 +360,7 @@ type ApplyCustomClusterTemplateAndWaitInput struct {
 	CreateOpts                   []framework.CreateOption // options to be passed to Create function config
 	PreWaitForCluster            func()
 	PostMachinesProvisioned      func()
+	AssertionHooks               *framework.AssertionHooks // provider-specific checks run after the control plane and the workers are ready
 	ControlPlaneWaiters
 }
 
@@ -436,6 +439,11 @@ func ApplyCustomClusterTemplateAndWait(ctx context.Context, input ApplyCustomClu
 	log.Logf("Waiting for control plane of cluster %s to be ready", klog.KRef(input.Namespace, input.ClusterName))
 	input.WaitForControlPlaneMachinesReady(ctx, input, result)
 
+	input.AssertionHooks.Run(ctx, framework.AfterControlPlaneReady, framework.AssertionHookInput{
+		ClusterProxy: input.ClusterProxy,
+		Cluster:      result.Cluster,
+	})
+
 	log.Logf("Waiting for the machine deployments of cluster %s to be provisioned", klog.KRef(input.Namespace, input.ClusterName))
 	result.MachineDeployments = framework.DiscoveryAndWaitForMachineDeployments(ctx, framework.DiscoveryAndWaitForMachineDeploymentsInput{
 		Lister:  input.ClusterProxy.GetClient(),
@@ -449,6 +457,11 @@ func ApplyCustomClusterTemplateAndWait(ctx context.Context, input ApplyCustomClu
 		Cluster: result.Cluster,
 	}, input.WaitForMachinePools...)
 
+	input.AssertionHooks.Run(ctx, framework.AfterMachineDeploymentsRollout, framework.AssertionHookInput{
+		ClusterProxy: input.ClusterProxy,
+		Cluster:      result.Cluster,
+	})
+
 	if input.PostMachinesProvisioned != nil {
 		log.Logf("Calling PostMachinesProvisioned for cluster %s", klog.KRef(input.Namespace, input.ClusterName))
 		input.PostMachinesProvisioned()
@@ -23,9 +23,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/test/framework/internal/log"
@@ -54,7 +52,7 @@ func SetupSpecNamespace(ctx context.Context, specName string, clusterProxy Clust
 	return namespace, cancelWatches
 }
 
-// DumpAllResourcesAndLogs dumps all the resources in the spec namespace and the workload cluster.
+// DumpAllResourcesAndLogs dumps all the resources in the spec namespace and collects the artifacts of the workload cluster.
 func DumpAllResourcesAndLogs(ctx context.Context, clusterProxy ClusterProxy, clusterctlConfigPath, artifactFolder string, namespace *corev1.Namespace, cluster *clusterv1.Cluster) {
 	byf("Dumping logs from the %q workload cluster", cluster.Name)
 
@@ -72,28 +70,15 @@ func DumpAllResourcesAndLogs(ctx context.Context, clusterProxy ClusterProxy, clu
 		LogPath:              filepath.Join(artifactFolder, "clusters", clusterProxy.GetName(), "resources"),
 	})
 
-	// If the cluster still exists, dump pods and nodes of the workload cluster.
-	if err := clusterProxy.GetClient().Get(ctx, client.ObjectKeyFromObject(cluster), &clusterv1.Cluster{}); err == nil {
-		byf("Dumping Pods and Nodes of Cluster %s", klog.KObj(cluster))
-		DumpResourcesForCluster(ctx, DumpResourcesForClusterInput{
-			Lister:  clusterProxy.GetWorkloadCluster(ctx, cluster.Namespace, cluster.Name).GetClient(),
-			LogPath: filepath.Join(artifactFolder, "clusters", cluster.Name, "resources"),
-			Resources: []DumpNamespaceAndGVK{
-				{
-					GVK: schema.GroupVersionKind{
-						Version: corev1.SchemeGroupVersion.Version,
-						Kind:    "Pod",
-					},
-				},
-				{
-					GVK: schema.GroupVersionKind{
-						Version: corev1.SchemeGroupVersion.Version,
-						Kind:    "Node",
-					},
-				},
-			},
-		})
-	}
+	byf("Collecting artifacts of Cluster %s", klog.KObj(cluster))
+
+	// Collect resources from the workload cluster plus the artifacts of all the registered collectors;
+	// if the spec failed, artifacts are also bundled and attached to the spec report.
+	CollectArtifacts(ctx, CollectArtifactsInput{
+		ClusterProxy:   clusterProxy,
+		Cluster:        cluster,
+		ArtifactFolder: artifactFolder,
+	})
 }
 
 // DumpSpecResourcesAndCleanup dumps all the resources in the spec namespace and cleans up the spec namespace.
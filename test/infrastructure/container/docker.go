@@ -319,7 +319,25 @@ func (d *dockerRuntime) KillContainer(ctx context.Context, containerName, signal
 	return nil
 }
 
+// PauseContainer suspends all the processes in a running container.
+func (d *dockerRuntime) PauseContainer(ctx context.Context, containerName string) error {
+	if _, err := d.dockerClient.ContainerPause(ctx, containerName, client.ContainerPauseOptions{}); err != nil {
+		return pkgerrors.Wrap(err, "error pausing a container")
+	}
+	return nil
+}
+
+// UnpauseContainer resumes all the processes in a paused container.
+func (d *dockerRuntime) UnpauseContainer(ctx context.Context, containerName string) error {
+	if _, err := d.dockerClient.ContainerUnpause(ctx, containerName, client.ContainerUnpauseOptions{}); err != nil {
+		return pkgerrors.Wrap(err, "error unpausing a container")
+	}
+	return nil
+}
+
 // GetContainerIPs inspects a container to get its IPv4 and IPv6 IP addresses.
+// If the container is attached to more than one network, the addresses in the network
+// the container has been created with are returned.
 // Will not error if there is no IP address assigned. Calling code will need to
 // determine whether that is an issue or not.
 func (d *dockerRuntime) GetContainerIPs(ctx context.Context, containerName string) (string, string, error) {
@@ -328,6 +346,12 @@ func (d *dockerRuntime) GetContainerIPs(ctx context.Context, containerName strin
 		return "", "", pkgerrors.Wrap(err, "failed to get container details")
 	}
 
+	if containerInfo.Container.HostConfig != nil {
+		if net, ok := containerInfo.Container.NetworkSettings.Networks[string(containerInfo.Container.HostConfig.NetworkMode)]; ok {
+			return net.IPAddress.String(), net.GlobalIPv6Address.String(), nil
+		}
+	}
+
 	for _, net := range containerInfo.Container.NetworkSettings.Networks {
 		return net.IPAddress.String(), net.GlobalIPv6Address.String(), nil
 	}
@@ -335,6 +359,22 @@ func (d *dockerRuntime) GetContainerIPs(ctx context.Context, containerName strin
 	return "", "", nil
 }
 
+// GetContainerNetworkIPs inspects a container to get its IPv4 and IPv6 IP addresses in a network.
+// Will not error if there is no IP address assigned. Calling code will need to
+// determine whether that is an issue or not.
+func (d *dockerRuntime) GetContainerNetworkIPs(ctx context.Context, containerName, networkName string) (string, string, error) {
+	containerInfo, err := d.dockerClient.ContainerInspect(ctx, containerName, client.ContainerInspectOptions{})
+	if err != nil {
+		return "", "", pkgerrors.Wrap(err, "failed to get container details")
+	}
+
+	if net, ok := containerInfo.Container.NetworkSettings.Networks[networkName]; ok {
+		return net.IPAddress.String(), net.GlobalIPv6Address.String(), nil
+	}
+
+	return "", "", nil
+}
+
 // GetContainerLogs gets container logs.
 func (d *dockerRuntime) GetContainerLogs(ctx context.Context, containerName string) (string, error) {
 	logsReader, err := d.dockerClient.ContainerLogs(ctx, containerName, client.ContainerLogsOptions{
@@ -508,6 +548,29 @@ func (d *dockerRuntime) RunContainer(ctx context.Context, runConfig *RunContaine
 		return pkgerrors.Wrapf(err, "error creating container %q", runConfig.Name)
 	}
 
+	// Connect the container to additional networks, if any.
+	for _, network := range runConfig.AdditionalNetworks {
+		endpointSettings := &dockernetwork.EndpointSettings{}
+		if network.IPv4Address != "" {
+			ipv4Address, err := netip.ParseAddr(network.IPv4Address)
+			if err != nil {
+				return pkgerrors.Wrapf(err, "invalid IPv4 address %q for container %q in network %q", network.IPv4Address, runConfig.Name, network.Name)
+			}
+			endpointSettings.IPAMConfig = &dockernetwork.EndpointIPAMConfig{IPv4Address: ipv4Address}
+		}
+		if _, err := d.dockerClient.NetworkConnect(ctx, network.Name, client.NetworkConnectOptions{
+			Container:      resp.ID,
+			EndpointConfig: endpointSettings,
+		}); err != nil {
+			err := pkgerrors.Wrapf(err, "error connecting container %q to network %q", runConfig.Name, network.Name)
+			// Delete the container and retry later on.
+			if _, reterr := d.dockerClient.ContainerRemove(ctx, resp.ID, client.ContainerRemoveOptions{Force: true, RemoveVolumes: true}); reterr != nil {
+				return kerrors.NewAggregate([]error{err, pkgerrors.Wrapf(reterr, "error deleting container")})
+			}
+			return err
+		}
+	}
+
 	var containerOutput client.ContainerAttachResult
 	if output != nil {
 		// Read out any output from the container
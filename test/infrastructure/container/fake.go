@@ -26,6 +26,8 @@ import (
 var runContainerCallLog []RunContainerArgs
 var deleteContainerCallLog []string
 var killContainerCallLog []KillContainerArgs
+var pauseContainerCallLog []string
+var unpauseContainerCallLog []string
 var execContainerCallLog []ExecContainerArgs
 
 // RunContainerArgs contains the arguments passed to calls to RunContainer.
@@ -145,6 +147,38 @@ func (f *FakeRuntime) ResetKillContainerCallLogs() {
 	killContainerCallLog = []KillContainerArgs{}
 }
 
+// PauseContainer suspends all the processes in a running container.
+func (f *FakeRuntime) PauseContainer(_ context.Context, containerName string) error {
+	pauseContainerCallLog = append(pauseContainerCallLog, containerName)
+	return nil
+}
+
+// PauseContainerCalls returns the list of containerName arguments passed to calls to PauseContainer.
+func (f *FakeRuntime) PauseContainerCalls() []string {
+	return pauseContainerCallLog
+}
+
+// ResetPauseContainerCallLogs clears all existing records of any calls to the PauseContainer method.
+func (f *FakeRuntime) ResetPauseContainerCallLogs() {
+	pauseContainerCallLog = []string{}
+}
+
+// UnpauseContainer resumes all the processes in a paused container.
+func (f *FakeRuntime) UnpauseContainer(_ context.Context, containerName string) error {
+	unpauseContainerCallLog = append(unpauseContainerCallLog, containerName)
+	return nil
+}
+
+// UnpauseContainerCalls returns the list of containerName arguments passed to calls to UnpauseContainer.
+func (f *FakeRuntime) UnpauseContainerCalls() []string {
+	return unpauseContainerCallLog
+}
+
+// ResetUnpauseContainerCallLogs clears all existing records of any calls to the UnpauseContainer method.
+func (f *FakeRuntime) ResetUnpauseContainerCallLogs() {
+	unpauseContainerCallLog = []string{}
+}
+
 // GetContainerIPs inspects a container to get its IPv4 and IPv6 IP addresses.
 // Will not error if there is no IP address assigned. Calling code will need to
 // determine whether that is an issue or not.
@@ -152,6 +186,11 @@ func (f *FakeRuntime) GetContainerIPs(_ context.Context, containerName string) (
 	return containerName + "IPv4", containerName + "IPv6", nil
 }
 
+// GetContainerNetworkIPs inspects a container to get its IPv4 and IPv6 IP addresses in a network.
+func (f *FakeRuntime) GetContainerNetworkIPs(_ context.Context, containerName, networkName string) (string, string, error) {
+	return containerName + networkName + "IPv4", containerName + networkName + "IPv6", nil
+}
+
 func (f *FakeRuntime) GetContainerLogs(_ context.Context, _ string) (string, error) {
 	return "", nil
 }
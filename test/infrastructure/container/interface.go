@@ -41,6 +41,7 @@ type Runtime interface {
 	ImageExistsLocally(ctx context.Context, image string) (bool, error)
 	GetHostPort(ctx context.Context, containerName, portAndProtocol string) (string, error)
 	GetContainerIPs(ctx context.Context, containerName string) (string, string, error)
+	GetContainerNetworkIPs(ctx context.Context, containerName, networkName string) (string, string, error)
 	GetContainerLogs(ctx context.Context, containerName string) (string, error)
 	ExecContainer(ctx context.Context, containerName string, config *ExecContainerInput, command string, args ...string) error
 	RunContainer(ctx context.Context, runConfig *RunContainerInput, output io.Writer) error
@@ -48,6 +49,8 @@ type Runtime interface {
 	ContainerDebugInfo(ctx context.Context, containerName string, w io.Writer) error
 	DeleteContainer(ctx context.Context, containerName string) error
 	KillContainer(ctx context.Context, containerName, signal string) error
+	PauseContainer(ctx context.Context, containerName string) error
+	UnpauseContainer(ctx context.Context, containerName string) error
 	GetSystemInfo(ctx context.Context) (dockersystem.Info, error)
 }
 
@@ -73,6 +76,15 @@ type PortMapping struct {
 	Protocol string
 }
 
+// NetworkAttachment contains information about an additional network a container is attached to.
+type NetworkAttachment struct {
+	// Name is the name of the network to connect to.
+	Name string
+	// IPv4Address is the static IPv4 address of the container in the network.
+	// If not set, the address is assigned by the container runtime.
+	IPv4Address string
+}
+
 // RunContainerInput holds the configuration settings for running a container.
 type RunContainerInput struct {
 	// Image is the name of the image to run.
@@ -81,6 +93,8 @@ type RunContainerInput struct {
 	Name string
 	// Network is the name of the network to connect to.
 	Network string
+	// AdditionalNetworks are additional networks to connect to.
+	AdditionalNetworks []NetworkAttachment
 	// User is the user name to run as.
 	User string
 	// Group is the user group to run as.
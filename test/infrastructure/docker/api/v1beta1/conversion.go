@@ -54,6 +54,10 @@ func (src *DockerCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Initialization = initialization
 	}
 
+	if ok {
+		dst.Spec.Networks = restored.Spec.Networks
+	}
+
 	return nil
 }
 
@@ -70,13 +74,32 @@ func (dst *DockerCluster) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *DockerClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1.DockerClusterTemplate)
 
-	return Convert_v1beta1_DockerClusterTemplate_To_v1beta2_DockerClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta1_DockerClusterTemplate_To_v1beta2_DockerClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.DockerClusterTemplate{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		dst.Spec.Template.Spec.Networks = restored.Spec.Template.Spec.Networks
+	}
+
+	return nil
 }
 
 func (dst *DockerClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.DockerClusterTemplate)
 
-	return Convert_v1beta2_DockerClusterTemplate_To_v1beta1_DockerClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta2_DockerClusterTemplate_To_v1beta1_DockerClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
 
 func (src *DockerMachine) ConvertTo(dstRaw conversion.Hub) error {
@@ -102,6 +125,7 @@ func (src *DockerMachine) ConvertTo(dstRaw conversion.Hub) error {
 
 	if ok {
 		dst.Status.FailureDomain = restored.Status.FailureDomain
+		dst.Status.Diagnostics = restored.Status.Diagnostics
 	}
 	return nil
 }
@@ -177,6 +201,14 @@ func (src *DevCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Initialization = initialization
 	}
 
+	if ok && dst.Spec.Backend.Docker != nil && restored.Spec.Backend.Docker != nil {
+		dst.Spec.Backend.Docker.Networks = restored.Spec.Backend.Docker.Networks
+	}
+	if ok && dst.Spec.Backend.InMemory != nil && restored.Spec.Backend.InMemory != nil {
+		dst.Spec.Backend.InMemory.APIServer = restored.Spec.Backend.InMemory.APIServer
+		dst.Spec.Backend.InMemory.Etcd = restored.Spec.Backend.InMemory.Etcd
+	}
+
 	return nil
 }
 
@@ -193,13 +225,36 @@ func (dst *DevCluster) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *DevClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1.DevClusterTemplate)
 
-	return Convert_v1beta1_DevClusterTemplate_To_v1beta2_DevClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta1_DevClusterTemplate_To_v1beta2_DevClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.DevClusterTemplate{}
+	ok, err := conversionutil.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok && dst.Spec.Template.Spec.Backend.Docker != nil && restored.Spec.Template.Spec.Backend.Docker != nil {
+		dst.Spec.Template.Spec.Backend.Docker.Networks = restored.Spec.Template.Spec.Backend.Docker.Networks
+	}
+	if ok && dst.Spec.Template.Spec.Backend.InMemory != nil && restored.Spec.Template.Spec.Backend.InMemory != nil {
+		dst.Spec.Template.Spec.Backend.InMemory.APIServer = restored.Spec.Template.Spec.Backend.InMemory.APIServer
+		dst.Spec.Template.Spec.Backend.InMemory.Etcd = restored.Spec.Template.Spec.Backend.InMemory.Etcd
+	}
+
+	return nil
 }
 
 func (dst *DevClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.DevClusterTemplate)
 
-	return Convert_v1beta2_DevClusterTemplate_To_v1beta1_DevClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta2_DevClusterTemplate_To_v1beta1_DevClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	return conversionutil.MarshalDataUnsafeNoCopy(src, dst)
 }
 
 func (src *DevMachine) ConvertTo(dstRaw conversion.Hub) error {
@@ -226,6 +281,7 @@ func (src *DevMachine) ConvertTo(dstRaw conversion.Hub) error {
 
 	if ok {
 		dst.Status.FailureDomain = restored.Status.FailureDomain
+		dst.Status.Diagnostics = restored.Status.Diagnostics
 	}
 	return nil
 }
@@ -657,6 +713,11 @@ func Convert_v1beta1_DockerClusterBackendSpec_To_v1beta2_DockerClusterBackendSpe
 	return nil
 }
 
+func Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in *infrav1.InMemoryClusterBackendSpec, out *InMemoryClusterBackendSpec, s apiconversion.Scope) error {
+	// APIServer and Etcd do not exist in v1beta1.
+	return autoConvert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in, out, s)
+}
+
 func Convert_v1beta2_DockerMachineTemplate_To_v1beta1_DockerMachineTemplate(in *infrav1.DockerMachineTemplate, out *DockerMachineTemplate, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_DockerMachineTemplate_To_v1beta1_DockerMachineTemplate(in, out, s)
 }
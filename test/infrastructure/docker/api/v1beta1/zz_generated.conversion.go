@@ -480,11 +480,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.InMemoryClusterBackendSpec)(nil), (*InMemoryClusterBackendSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(a.(*v1beta2.InMemoryClusterBackendSpec), b.(*InMemoryClusterBackendSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*InMemoryEtcdSpec)(nil), (*v1beta2.InMemoryEtcdSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_InMemoryEtcdSpec_To_v1beta2_InMemoryEtcdSpec(a.(*InMemoryEtcdSpec), b.(*v1beta2.InMemoryEtcdSpec), scope)
 	}); err != nil {
@@ -625,6 +620,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.InMemoryClusterBackendSpec)(nil), (*InMemoryClusterBackendSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(a.(*v1beta2.InMemoryClusterBackendSpec), b.(*InMemoryClusterBackendSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*corev1beta2.ObjectMeta)(nil), (*corev1beta1.ObjectMeta)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ObjectMeta_To_v1beta1_ObjectMeta(a.(*corev1beta2.ObjectMeta), b.(*corev1beta1.ObjectMeta), scope)
 	}); err != nil {
@@ -719,7 +719,15 @@ func autoConvert_v1beta1_DevClusterBackendSpec_To_v1beta2_DevClusterBackendSpec(
 	} else {
 		out.Docker = nil
 	}
-	out.InMemory = (*v1beta2.InMemoryClusterBackendSpec)(unsafe.Pointer(in.InMemory))
+	if in.InMemory != nil {
+		in, out := &in.InMemory, &out.InMemory
+		*out = new(v1beta2.InMemoryClusterBackendSpec)
+		if err := Convert_v1beta1_InMemoryClusterBackendSpec_To_v1beta2_InMemoryClusterBackendSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InMemory = nil
+	}
 	return nil
 }
 
@@ -738,7 +746,15 @@ func autoConvert_v1beta2_DevClusterBackendSpec_To_v1beta1_DevClusterBackendSpec(
 	} else {
 		out.Docker = nil
 	}
-	out.InMemory = (*InMemoryClusterBackendSpec)(unsafe.Pointer(in.InMemory))
+	if in.InMemory != nil {
+		in, out := &in.InMemory, &out.InMemory
+		*out = new(InMemoryClusterBackendSpec)
+		if err := Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InMemory = nil
+	}
 	return nil
 }
 
@@ -1136,6 +1152,7 @@ func autoConvert_v1beta2_DevMachineStatus_To_v1beta1_DevMachineStatus(in *v1beta
 	// WARNING: in.Initialization requires manual conversion: does not exist in peer-type
 	out.Addresses = *(*[]corev1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
 	// WARNING: in.FailureDomain requires manual conversion: does not exist in peer-type
+	// WARNING: in.Diagnostics requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -1303,6 +1320,7 @@ func autoConvert_v1beta2_DockerClusterBackendSpec_To_v1beta1_DockerClusterBacken
 	if err := Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
 	}
+	// WARNING: in.Networks requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1367,6 +1385,7 @@ func autoConvert_v1beta2_DockerClusterSpec_To_v1beta1_DockerClusterSpec(in *v1be
 	if err := Convert_v1beta2_DockerLoadBalancer_To_v1beta1_DockerLoadBalancer(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
 	}
+	// WARNING: in.Networks requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2045,6 +2064,7 @@ func autoConvert_v1beta2_DockerMachineStatus_To_v1beta1_DockerMachineStatus(in *
 	out.LoadBalancerConfigured = in.LoadBalancerConfigured
 	out.Addresses = *(*[]corev1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
 	// WARNING: in.FailureDomain requires manual conversion: does not exist in peer-type
+	// WARNING: in.Diagnostics requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -2223,14 +2243,11 @@ func Convert_v1beta1_InMemoryClusterBackendSpec_To_v1beta2_InMemoryClusterBacken
 }
 
 func autoConvert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in *v1beta2.InMemoryClusterBackendSpec, out *InMemoryClusterBackendSpec, s conversion.Scope) error {
+	// WARNING: in.APIServer requires manual conversion: does not exist in peer-type
+	// WARNING: in.Etcd requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec is an autogenerated conversion function.
-func Convert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in *v1beta2.InMemoryClusterBackendSpec, out *InMemoryClusterBackendSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_InMemoryClusterBackendSpec_To_v1beta1_InMemoryClusterBackendSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_InMemoryEtcdSpec_To_v1beta2_InMemoryEtcdSpec(in *InMemoryEtcdSpec, out *v1beta2.InMemoryEtcdSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_CommonProvisioningSettings_To_v1beta2_CommonProvisioningSettings(&in.Provisioning, &out.Provisioning, s); err != nil {
 		return err
@@ -106,10 +106,79 @@ type DockerClusterBackendSpec struct {
 	// loadBalancer allows defining configurations for the cluster load balancer.
 	// +optional
 	LoadBalancer DockerLoadBalancer `json:"loadBalancer,omitempty"`
+
+	// networks are additional Docker networks the machines of the cluster are attached to,
+	// in addition to the kind network, e.g. to test IPAM or dual-NIC scenarios.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Networks []DockerNetwork `json:"networks,omitempty"`
 }
 
 // InMemoryClusterBackendSpec defines backend for a DevCluster that runs in memory.
-type InMemoryClusterBackendSpec struct{}
+type InMemoryClusterBackendSpec struct {
+	// apiServer defines the behaviour of the API server of the in memory workload cluster,
+	// e.g. to simulate a slow or flaky control plane.
+	// +optional
+	APIServer *InMemoryClusterAPIServerSpec `json:"apiServer,omitempty"`
+
+	// etcd defines the behaviour of the etcd cluster of the in memory workload cluster,
+	// e.g. to simulate slow or flaky etcd members, members lagging behind the leader or alarms.
+	// +optional
+	Etcd *InMemoryClusterEtcdSpec `json:"etcd,omitempty"`
+}
+
+// InMemoryClusterAPIServerSpec defines the behaviour of the API server of the in memory workload cluster.
+type InMemoryClusterAPIServerSpec struct {
+	// responseDelay is added to every request served by the API server.
+	// +optional
+	ResponseDelay *metav1.Duration `json:"responseDelay,omitempty"`
+
+	// errorPercentage is the percentage of requests served by the API server failing with an internal server error.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ErrorPercentage *int32 `json:"errorPercentage,omitempty"`
+}
+
+// InMemoryClusterEtcdSpec defines the behaviour of the etcd cluster of the in memory workload cluster.
+type InMemoryClusterEtcdSpec struct {
+	// responseDelay is added to every request served by the etcd members.
+	// +optional
+	ResponseDelay *metav1.Duration `json:"responseDelay,omitempty"`
+
+	// errorPercentage is the percentage of requests served by the etcd members failing with an unavailable error.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ErrorPercentage *int32 `json:"errorPercentage,omitempty"`
+
+	// memberLag is the number of raft entries the etcd followers are lagging behind the leader.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MemberLag *int64 `json:"memberLag,omitempty"`
+
+	// alarms are reported by all the etcd members.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Alarms []InMemoryEtcdAlarmType `json:"alarms,omitempty"`
+}
+
+// InMemoryEtcdAlarmType is the type of an alarm reported by the etcd members of an in memory workload cluster.
+// +kubebuilder:validation:Enum=NOSPACE;CORRUPT
+type InMemoryEtcdAlarmType string
+
+const (
+	// InMemoryEtcdNoSpaceAlarm reports that the etcd members ran out of space.
+	InMemoryEtcdNoSpaceAlarm InMemoryEtcdAlarmType = "NOSPACE"
+
+	// InMemoryEtcdCorruptAlarm reports that the etcd members detected a data corruption.
+	InMemoryEtcdCorruptAlarm InMemoryEtcdAlarmType = "CORRUPT"
+)
 
 // DevClusterStatus defines the observed state of the DevCluster.
 type DevClusterStatus struct {
@@ -27,6 +27,28 @@ const (
 	LoadbalancerWeightAnnotation = "devmachine.infrastructure.cluster.x-k8s.io/weight"
 )
 
+// Fault injection annotations for a DevMachine's docker backend.
+// NOTE: Fault injection annotations can be set on both DevMachines and DockerMachines; they are intended to
+// exercise Machine health checks, remediation and control plane quorum loss in e2e tests deterministically.
+const (
+	// PauseContainerFaultAnnotation pauses the container hosting the machine when set, thus simulating an unresponsive machine.
+	// The container is unpaused when the annotation is removed.
+	PauseContainerFaultAnnotation = "devmachine.infrastructure.cluster.x-k8s.io/fault-pause-container"
+
+	// KillContainerFaultAnnotation kills the container hosting the machine when set, thus simulating a machine crash.
+	// NOTE: The container is killed only after the machine is provisioned, and it is not restarted when the annotation is removed.
+	KillContainerFaultAnnotation = "devmachine.infrastructure.cluster.x-k8s.io/fault-kill-container"
+
+	// NetworkPartitionFaultAnnotation drops the traffic between the container hosting the machine and the
+	// control plane endpoint when set, thus simulating a network partition.
+	// The traffic is restored when the annotation is removed.
+	NetworkPartitionFaultAnnotation = "devmachine.infrastructure.cluster.x-k8s.io/fault-network-partition"
+
+	// BootstrapDelayFaultAnnotation delays the execution of the bootstrap script by the given number of seconds,
+	// thus simulating a slow machine.
+	BootstrapDelayFaultAnnotation = "devmachine.infrastructure.cluster.x-k8s.io/fault-bootstrap-delay-seconds"
+)
+
 const (
 	// VMProvisionedCondition documents the status of the provisioning VM implementing the InMemoryMachine.
 	VMProvisionedCondition clusterv1.ConditionType = "VMProvisioned"
@@ -112,6 +134,10 @@ const (
 	// data to be ready.
 	DevMachineDockerContainerWaitingForBootstrapDataReason = clusterv1.WaitingForBootstrapDataReason
 
+	// DevMachineDockerContainerWaitingForIPAddressReason documents a container for a DevMachine's docker backend waiting for
+	// the IP addresses claimed for additional networks to be allocated.
+	DevMachineDockerContainerWaitingForIPAddressReason = "WaitingForIPAddress"
+
 	// DevMachineDockerContainerProvisionedReason documents the container for a DevMachine's docker backend is provisioned.
 	DevMachineDockerContainerProvisionedReason = clusterv1.ProvisionedReason
 
@@ -192,6 +218,10 @@ const (
 	// preloaded images inside the container for a DevMachine's docker backend.
 	DevMachineDockerBootstrapCompletedWaitingForPreloadedImagesReason = "WaitingForPreloadedImages"
 
+	// DevMachineDockerBootstrapCompletedWaitingForBootstrapDelayReason documents when the system is waiting for
+	// the bootstrap delay injected using the BootstrapDelayFaultAnnotation to expire.
+	DevMachineDockerBootstrapCompletedWaitingForBootstrapDelayReason = "WaitingForBootstrapDelay"
+
 	// DevMachineDockerBootstrapCompletedReason documents when preloaded the bootstrap
 	// script executed inside the container for a DevMachine's docker backend is completed.
 	DevMachineDockerBootstrapCompletedReason = "Completed"
@@ -209,6 +239,20 @@ const (
 	DevMachineDockerBootstrapCompletedInternalErrorReason = clusterv1.InternalErrorReason
 )
 
+// FaultInjected condition and corresponding reasons for a DevMachine's docker backend.
+const (
+	// DevMachineDockerFaultInjectedCondition documents faults injected into the container for a DevMachine's docker backend
+	// using fault injection annotations.
+	// NOTE: This condition is surfaced only after a fault has been injected at least once.
+	DevMachineDockerFaultInjectedCondition string = "FaultInjected"
+
+	// DevMachineDockerFaultInjectedReason documents faults are injected into the container for a DevMachine's docker backend.
+	DevMachineDockerFaultInjectedReason = "FaultInjected"
+
+	// DevMachineDockerFaultNotInjectedReason documents no faults are injected into the container for a DevMachine's docker backend.
+	DevMachineDockerFaultNotInjectedReason = "NoFaultInjected"
+)
+
 // DevMachine's conditions that apply to the in memory backend.
 
 // VirtualMachineProvisioned condition and corresponding reasons for a DevMachine's in memory backend.
@@ -434,6 +478,11 @@ type DevMachineStatus struct {
 	// +kubebuilder:validation:MaxLength=256
 	FailureDomain string `json:"failureDomain,omitempty"`
 
+	// diagnostics provides references to the boot logs of the DevMachine, e.g. the output of the bootstrap commands.
+	// NOTE: This field is part of the Cluster API contract and it is copied into the Machine status.
+	// +optional
+	Diagnostics clusterv1.MachineDiagnosticsStatus `json:"diagnostics,omitempty,omitzero"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *DevMachineDeprecatedStatus `json:"deprecated,omitempty"`
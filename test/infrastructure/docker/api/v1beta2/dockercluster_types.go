@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 )
 
 const (
@@ -52,6 +53,31 @@ type DockerClusterSpec struct {
 	// LoadBalancer allows defining configurations for the cluster load balancer.
 	// +optional
 	LoadBalancer DockerLoadBalancer `json:"loadBalancer,omitempty"`
+
+	// networks are additional Docker networks the machines of the cluster are attached to,
+	// in addition to the kind network, e.g. to test IPAM or dual-NIC scenarios.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Networks []DockerNetwork `json:"networks,omitempty"`
+}
+
+// DockerNetwork defines an additional Docker network the machines of a cluster are attached to.
+type DockerNetwork struct {
+	// name of the Docker network; the network must already exist.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty"`
+
+	// addressesFromPool is a reference to an IP pool static IPv4 addresses are claimed from for each machine,
+	// using the IPAddressClaim contract.
+	// If not set, addresses are assigned by Docker.
+	// +optional
+	AddressesFromPool ipamv1.IPPoolReference `json:"addressesFromPool,omitempty,omitzero"`
 }
 
 // DockerLoadBalancer allows defining configurations for the cluster load balancer.
@@ -104,6 +104,11 @@ type DockerMachineStatus struct {
 	// +kubebuilder:validation:MaxLength=256
 	FailureDomain string `json:"failureDomain,omitempty"`
 
+	// diagnostics provides references to the boot logs of the DockerMachine, e.g. the output of the bootstrap commands.
+	// NOTE: This field is part of the Cluster API contract and it is copied into the Machine status.
+	// +optional
+	Diagnostics clusterv1.MachineDiagnosticsStatus `json:"diagnostics,omitempty,omitzero"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *DockerMachineDeprecatedStatus `json:"deprecated,omitempty"`
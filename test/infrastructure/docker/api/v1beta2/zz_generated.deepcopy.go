@@ -96,7 +96,7 @@ func (in *DevClusterBackendSpec) DeepCopyInto(out *DevClusterBackendSpec) {
 	if in.InMemory != nil {
 		in, out := &in.InMemory, &out.InMemory
 		*out = new(InMemoryClusterBackendSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -753,6 +753,7 @@ func (in *DevMachineStatus) DeepCopyInto(out *DevMachineStatus) {
 		*out = make([]corev1beta2.MachineAddress, len(*in))
 		copy(*out, *in)
 	}
+	out.Diagnostics = in.Diagnostics
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(DevMachineDeprecatedStatus)
@@ -944,6 +945,11 @@ func (in *DockerClusterBackendSpec) DeepCopyInto(out *DockerClusterBackendSpec)
 		}
 	}
 	in.LoadBalancer.DeepCopyInto(&out.LoadBalancer)
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]DockerNetwork, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerClusterBackendSpec.
@@ -1040,6 +1046,11 @@ func (in *DockerClusterSpec) DeepCopyInto(out *DockerClusterSpec) {
 		}
 	}
 	in.LoadBalancer.DeepCopyInto(&out.LoadBalancer)
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]DockerNetwork, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerClusterSpec.
@@ -1723,6 +1734,7 @@ func (in *DockerMachineStatus) DeepCopyInto(out *DockerMachineStatus) {
 		*out = make([]corev1beta2.MachineAddress, len(*in))
 		copy(*out, *in)
 	}
+	out.Diagnostics = in.Diagnostics
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(DockerMachineDeprecatedStatus)
@@ -1876,6 +1888,22 @@ func (in *DockerMachineV1Beta1DeprecatedStatus) DeepCopy() *DockerMachineV1Beta1
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerNetwork) DeepCopyInto(out *DockerNetwork) {
+	*out = *in
+	out.AddressesFromPool = in.AddressesFromPool
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerNetwork.
+func (in *DockerNetwork) DeepCopy() *DockerNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageMeta) DeepCopyInto(out *ImageMeta) {
 	*out = *in
@@ -1907,9 +1935,44 @@ func (in *InMemoryAPIServerSpec) DeepCopy() *InMemoryAPIServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryClusterAPIServerSpec) DeepCopyInto(out *InMemoryClusterAPIServerSpec) {
+	*out = *in
+	if in.ResponseDelay != nil {
+		in, out := &in.ResponseDelay, &out.ResponseDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ErrorPercentage != nil {
+		in, out := &in.ErrorPercentage, &out.ErrorPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryClusterAPIServerSpec.
+func (in *InMemoryClusterAPIServerSpec) DeepCopy() *InMemoryClusterAPIServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryClusterAPIServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InMemoryClusterBackendSpec) DeepCopyInto(out *InMemoryClusterBackendSpec) {
 	*out = *in
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(InMemoryClusterAPIServerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(InMemoryClusterEtcdSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryClusterBackendSpec.
@@ -1922,6 +1985,41 @@ func (in *InMemoryClusterBackendSpec) DeepCopy() *InMemoryClusterBackendSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InMemoryClusterEtcdSpec) DeepCopyInto(out *InMemoryClusterEtcdSpec) {
+	*out = *in
+	if in.ResponseDelay != nil {
+		in, out := &in.ResponseDelay, &out.ResponseDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ErrorPercentage != nil {
+		in, out := &in.ErrorPercentage, &out.ErrorPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemberLag != nil {
+		in, out := &in.MemberLag, &out.MemberLag
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = make([]InMemoryEtcdAlarmType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryClusterEtcdSpec.
+func (in *InMemoryClusterEtcdSpec) DeepCopy() *InMemoryClusterEtcdSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InMemoryClusterEtcdSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InMemoryEtcdSpec) DeepCopyInto(out *InMemoryEtcdSpec) {
 	*out = *in
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/test/infrastructure/container"
 	dockercontrollers "sigs.k8s.io/cluster-api/test/infrastructure/docker/internal/controllers"
@@ -40,6 +41,9 @@ type DockerMachineReconciler struct {
 	ContainerRuntime container.Runtime
 	ClusterCache     clustercache.ClusterCache
 
+	// BootstrapDataDecrypter is used to decrypt bootstrap data encrypted by the kubeadm bootstrap provider.
+	BootstrapDataDecrypter *encryption.Decrypter
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -47,10 +51,11 @@ type DockerMachineReconciler struct {
 // SetupWithManager sets up the reconciler with the Manager.
 func (r *DockerMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	return (&dockercontrollers.DockerMachineReconciler{
-		Client:           r.Client,
-		ContainerRuntime: r.ContainerRuntime,
-		ClusterCache:     r.ClusterCache,
-		WatchFilterValue: r.WatchFilterValue,
+		Client:                 r.Client,
+		ContainerRuntime:       r.ContainerRuntime,
+		ClusterCache:           r.ClusterCache,
+		BootstrapDataDecrypter: r.BootstrapDataDecrypter,
+		WatchFilterValue:       r.WatchFilterValue,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -98,6 +103,9 @@ type DevMachineReconciler struct {
 	InMemoryManager  inmemoryruntime.Manager
 	APIServerMux     *inmemoryserver.WorkloadClustersMux
 
+	// BootstrapDataDecrypter is used to decrypt bootstrap data encrypted by the kubeadm bootstrap provider.
+	BootstrapDataDecrypter *encryption.Decrypter
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -105,12 +113,13 @@ type DevMachineReconciler struct {
 // SetupWithManager sets up the reconciler with the Manager.
 func (r *DevMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	return (&dockercontrollers.DevMachineReconciler{
-		Client:           r.Client,
-		WatchFilterValue: r.WatchFilterValue,
-		ContainerRuntime: r.ContainerRuntime,
-		ClusterCache:     r.ClusterCache,
-		InMemoryManager:  r.InMemoryManager,
-		APIServerMux:     r.APIServerMux,
+		Client:                 r.Client,
+		WatchFilterValue:       r.WatchFilterValue,
+		ContainerRuntime:       r.ContainerRuntime,
+		ClusterCache:           r.ClusterCache,
+		InMemoryManager:        r.InMemoryManager,
+		APIServerMux:           r.APIServerMux,
+		BootstrapDataDecrypter: r.BootstrapDataDecrypter,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
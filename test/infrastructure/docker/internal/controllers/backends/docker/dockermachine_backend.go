@@ -27,6 +27,7 @@ import (
 
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
@@ -38,6 +39,8 @@ import (
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/internal/util/taints"
 	"sigs.k8s.io/cluster-api/test/infrastructure/container"
@@ -59,6 +62,9 @@ type MachineBackendReconciler struct {
 	TaskManager      *TaskManager
 
 	DeferNextReconcileForObject func(obj metav1.Object, reconcileAfter time.Time)
+
+	// BootstrapDataDecrypter is used to decrypt bootstrap data encrypted by the kubeadm bootstrap provider.
+	BootstrapDataDecrypter *encryption.Decrypter
 }
 
 // ReconcileNormal handle docker backend for DevMachines not yet deleted.
@@ -99,10 +105,21 @@ func (r *MachineBackendReconciler) ReconcileNormal(ctx context.Context, cluster
 	}
 
 	// Reconcile the docker container for this machine.
-	if result, err := r.reconcileContainer(ctx, cluster, machine, dataSecretName, dockerMachine, externalMachine); err != nil || !result.IsZero() {
+	if result, err := r.reconcileContainer(ctx, cluster, dockerCluster, machine, dataSecretName, dockerMachine, externalMachine); err != nil || !result.IsZero() {
 		return result, err
 	}
 
+	// Reconcile faults injected into the docker container for this machine using fault injection annotations, if any.
+	// Note: Fault injection is specific of the docker backend and it is intended to be used in e2e tests only.
+	unresponsive, err := r.reconcileFaults(ctx, dockerMachine, externalMachine, externalLoadBalancer)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// If the container has been paused or killed, it is not possible to run commands into the container, so return early.
+	if unresponsive {
+		return ctrl.Result{}, nil
+	}
+
 	// If this is a control plane machine, reconcile the load balancer configuration for this machine.
 	if util.IsControlPlaneMachine(machine) {
 		if result, err := r.reconcileLoadBalancer(ctx, cluster, dockerCluster, machine, dockerMachine, externalLoadBalancer); err != nil || !result.IsZero() {
@@ -153,7 +170,7 @@ func (r *MachineBackendReconciler) ReconcileNormal(ctx context.Context, cluster
 	return ctrl.Result{}, nil
 }
 
-func (r *MachineBackendReconciler) reconcileContainer(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, dataSecretName *string, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine) (ctrl.Result, error) { //nolint:unparam
+func (r *MachineBackendReconciler) reconcileContainer(ctx context.Context, cluster *clusterv1.Cluster, dockerCluster *infrav1.DevCluster, machine *clusterv1.Machine, dataSecretName *string, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// if the machine has been already provisioned, but the container not running anymore, surface it
@@ -215,6 +232,22 @@ func (r *MachineBackendReconciler) reconcileContainer(ctx context.Context, clust
 
 	// Create the machine if not existing yet
 	if !externalMachine.Exists() {
+		// Claim static IP addresses for the additional networks using addresses from an IP pool, if any,
+		// and wait for the addresses to be allocated before creating the container.
+		networks, allocated, err := r.reconcileIPAddressClaims(ctx, dockerCluster, machine, dockerMachine)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !allocated {
+			log.Info("Waiting for IP addresses to be allocated")
+			conditions.Set(dockerMachine, metav1.Condition{
+				Type:   infrav1.DevMachineDockerContainerProvisionedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.DevMachineDockerContainerWaitingForIPAddressReason,
+			})
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+
 		role := constants.WorkerNodeRoleValue
 		if util.IsControlPlaneMachine(machine) {
 			role = constants.ControlPlaneNodeRoleValue
@@ -223,7 +256,7 @@ func (r *MachineBackendReconciler) reconcileContainer(ctx context.Context, clust
 		// NOTE: FailureDomains don't mean much in CAPD since it's all local, but we are setting a label on
 		// each container, so we can check placement.
 		log.Info("Creating container")
-		if err := externalMachine.Create(ctx, dockerMachine.Spec.Backend.Docker.CustomImage, role, machine.Spec.Version, docker.FailureDomainLabel(machine.Spec.FailureDomain), dockerMachine.Spec.Backend.Docker.ExtraMounts); err != nil {
+		if err := externalMachine.Create(ctx, dockerMachine.Spec.Backend.Docker.CustomImage, role, machine.Spec.Version, docker.FailureDomainLabel(machine.Spec.FailureDomain), dockerMachine.Spec.Backend.Docker.ExtraMounts, networks); err != nil {
 			return ctrl.Result{}, pkgerrors.Wrap(err, "failed to create worker DockerMachine")
 		}
 
@@ -243,7 +276,7 @@ func (r *MachineBackendReconciler) reconcileContainer(ctx context.Context, clust
 	})
 
 	// Surface machine address.
-	if err := setMachineAddress(ctx, dockerMachine, externalMachine); err != nil {
+	if err := setMachineAddress(ctx, dockerMachine, externalMachine, dockerCluster.Spec.Backend.Docker.Networks); err != nil {
 		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to set the machine address")
 	}
 
@@ -492,7 +525,7 @@ func (r *MachineBackendReconciler) reconcilePreLoadedImages(ctx context.Context,
 	return ctrl.Result{}, nil
 }
 
-func (r *MachineBackendReconciler) reconcileBootstrap(ctx context.Context, machine *clusterv1.Machine, dataSecretName *string, version string, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine) (ctrl.Result, error) { //nolint:unparam
+func (r *MachineBackendReconciler) reconcileBootstrap(ctx context.Context, machine *clusterv1.Machine, dataSecretName *string, version string, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine) (ctrl.Result, error) {
 	const CloudInitOrIgnitionTask = "CloudInitOrIgnition"
 	log := ctrl.LoggerFrom(ctx)
 
@@ -546,6 +579,24 @@ func (r *MachineBackendReconciler) reconcileBootstrap(ctx context.Context, machi
 
 	// run the CloudInitOrIgnitionTask or wait for its completion.
 	taskState := r.TaskManager.GetStatus(dockerMachine, CloudInitOrIgnitionTask)
+
+	// if a bootstrap delay is injected using fault injection annotations, wait for it to expire before running the bootstrap script.
+	if taskState == nil {
+		delay, err := bootstrapDelay(dockerMachine)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if delay > 0 {
+			conditions.Set(dockerMachine, metav1.Condition{
+				Type:    infrav1.DevMachineBootstrapCompletedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.DevMachineDockerBootstrapCompletedWaitingForBootstrapDelayReason,
+				Message: fmt.Sprintf("Waiting %s before running the bootstrap script", delay.Round(time.Second)),
+			})
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
 	switch {
 	case taskState == nil:
 		log.Info("Checking for sentinel file")
@@ -640,6 +691,27 @@ func (r *MachineBackendReconciler) reconcileBootstrap(ctx context.Context, machi
 		// Note: when bootstrap fails on a Machine, there is no retry.
 		cmdErr := &cmdError{}
 		if pkgerrors.As(taskState.Err, &cmdErr) {
+			// Surface the output of the failed command via diagnostics, so it is possible to
+			// debug the failure without accessing the container.
+			if err := r.reconcileBootLogs(ctx, machine, dockerMachine, taskState.CurrentOperationDescription, cmdErr); err != nil {
+				conditions.Set(dockerMachine, metav1.Condition{
+					Type:    infrav1.DevMachineBootstrapCompletedCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  infrav1.DevMachineDockerBootstrapCompletedInternalErrorReason,
+					Message: "Please check controller logs for errors",
+				})
+				return ctrl.Result{}, err
+			}
+			// Report the failure to the kubeadm bootstrap provider, so it is surfaced on the KubeadmConfig too.
+			if err := r.reconcileBootstrapFailureReport(ctx, machine, cmdErr); err != nil {
+				conditions.Set(dockerMachine, metav1.Condition{
+					Type:    infrav1.DevMachineBootstrapCompletedCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  infrav1.DevMachineDockerBootstrapCompletedInternalErrorReason,
+					Message: "Please check controller logs for errors",
+				})
+				return ctrl.Result{}, err
+			}
 			conditions.Set(dockerMachine, metav1.Condition{
 				Type:   infrav1.DevMachineBootstrapCompletedCondition,
 				Status: metav1.ConditionFalse,
@@ -668,6 +740,247 @@ func (r *MachineBackendReconciler) reconcileBootstrap(ctx context.Context, machi
 	return ctrl.Result{}, nil
 }
 
+// reconcileBootLogs stores the output of a failed bootstrap command into a Secret and reports it in the DevMachine diagnostics.
+func (r *MachineBackendReconciler) reconcileBootLogs(ctx context.Context, machine *clusterv1.Machine, dockerMachine *infrav1.DevMachine, operation string, cmdErr *cmdError) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootLogsSecretName(dockerMachine.Name),
+			Namespace: dockerMachine.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: machine.Spec.ClusterName,
+			},
+		},
+		Data: map[string][]byte{
+			"bootstrap.log": []byte(fmt.Sprintf("%s\nERROR: %s\n", operation, cmdErr.Err.Error())),
+			"stdout":        []byte(cmdErr.Stdout),
+			"stderr":        []byte(cmdErr.Stderr),
+		},
+	}
+	if err := r.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return pkgerrors.Wrapf(err, "failed to create boot logs Secret %s", klog.KObj(secret))
+	}
+
+	dockerMachine.Status.Diagnostics.LogsSecretName = secret.Name
+	return nil
+}
+
+// reconcileBootstrapFailureReport reports the output of a failed bootstrap command to the kubeadm bootstrap provider
+// by creating a ConfigMap named after the Machine's KubeadmConfig.
+func (r *MachineBackendReconciler) reconcileBootstrapFailureReport(ctx context.Context, machine *clusterv1.Machine, cmdErr *cmdError) error {
+	reportName, ok := bootstrapFailureReportName(machine)
+	if !ok {
+		return nil
+	}
+
+	report := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      reportName,
+			Namespace: machine.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: machine.Spec.ClusterName,
+			},
+		},
+		Data: map[string]string{
+			bootstrapv1.KubeadmConfigBootstrapFailureReportOutputKey: cmdErr.Stdout + cmdErr.Stderr,
+		},
+	}
+	if err := r.Create(ctx, report); err != nil && !apierrors.IsAlreadyExists(err) {
+		return pkgerrors.Wrapf(err, "failed to create bootstrap failure report ConfigMap %s", klog.KObj(report))
+	}
+	return nil
+}
+
+// reconcileFaults injects faults into the container of a DevMachine according to the fault injection annotations,
+// and removes them when the corresponding annotations are removed. It returns true if the container is unresponsive.
+func (r *MachineBackendReconciler) reconcileFaults(ctx context.Context, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine, externalLoadBalancer *docker.LoadBalancer) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	_, pause := dockerMachine.Annotations[infrav1.PauseContainerFaultAnnotation]
+	_, kill := dockerMachine.Annotations[infrav1.KillContainerFaultAnnotation]
+	_, partition := dockerMachine.Annotations[infrav1.NetworkPartitionFaultAnnotation]
+	_, delay := dockerMachine.Annotations[infrav1.BootstrapDelayFaultAnnotation]
+	faultsInjected := conditions.IsTrue(dockerMachine, infrav1.DevMachineDockerFaultInjectedCondition)
+
+	// no-op if there are no faults to inject or to remove.
+	if !pause && !kill && !partition && !delay && !faultsInjected {
+		return false, nil
+	}
+
+	// no-op if the container is not running, e.g. it is not yet created or it has been killed.
+	if !externalMachine.IsRunning() {
+		return kill, nil
+	}
+
+	paused := externalMachine.IsPaused()
+	if paused && !pause {
+		log.Info("Unpausing container")
+		if err := externalMachine.Unpause(ctx); err != nil {
+			return false, err
+		}
+		paused = false
+	}
+
+	// Note: it is not possible to run commands into a paused container, so the network partition is
+	// reconciled only when the container is not paused.
+	if !paused && (partition || faultsInjected) {
+		lbIP, err := externalLoadBalancer.IP(ctx)
+		if err != nil {
+			return false, pkgerrors.Wrap(err, "failed to get the control plane endpoint address")
+		}
+		if err := externalMachine.SetNetworkPartition(ctx, lbIP, partition); err != nil {
+			return false, err
+		}
+	}
+
+	var messages []string
+	if partition {
+		messages = append(messages, "Network partitioned from the control plane endpoint")
+	}
+	if delay {
+		messages = append(messages, "Bootstrap delayed")
+	}
+
+	unresponsive := false
+	if kill && dockerMachine.Spec.ProviderID != "" {
+		log.Info("Killing container")
+		if err := externalMachine.Kill(ctx); err != nil {
+			return false, err
+		}
+		messages = append(messages, "Container killed")
+		unresponsive = true
+	}
+
+	if pause {
+		if !paused {
+			log.Info("Pausing container")
+			if err := externalMachine.Pause(ctx); err != nil {
+				return false, err
+			}
+		}
+		messages = append(messages, "Container paused")
+		unresponsive = true
+	}
+
+	if len(messages) == 0 {
+		conditions.Set(dockerMachine, metav1.Condition{
+			Type:   infrav1.DevMachineDockerFaultInjectedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.DevMachineDockerFaultNotInjectedReason,
+		})
+		return false, nil
+	}
+
+	conditions.Set(dockerMachine, metav1.Condition{
+		Type:    infrav1.DevMachineDockerFaultInjectedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  infrav1.DevMachineDockerFaultInjectedReason,
+		Message: strings.Join(messages, "; "),
+	})
+	return unresponsive, nil
+}
+
+// bootstrapDelay returns the remaining time before running the bootstrap script of a DevMachine
+// according to the BootstrapDelayFaultAnnotation, if any.
+func bootstrapDelay(dockerMachine *infrav1.DevMachine) (time.Duration, error) {
+	value, ok := dockerMachine.Annotations[infrav1.BootstrapDelayFaultAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, pkgerrors.Errorf("invalid value %q for annotation %s: must be a non-negative number of seconds", value, infrav1.BootstrapDelayFaultAnnotation)
+	}
+
+	// The delay is computed from the time the container became ready to run the bootstrap script.
+	preloadedImagesReady := conditions.Get(dockerMachine, infrav1.DevMachineDockerPreLoadedImagesReadyCondition)
+	if preloadedImagesReady == nil {
+		return 0, nil
+	}
+	return time.Until(preloadedImagesReady.LastTransitionTime.Add(time.Duration(seconds) * time.Second)), nil
+}
+
+// reconcileIPAddressClaims ensures an IPAddressClaim exists for each additional network using addresses from an IP pool,
+// and returns the additional networks to attach the container to once all the claimed addresses are allocated.
+func (r *MachineBackendReconciler) reconcileIPAddressClaims(ctx context.Context, dockerCluster *infrav1.DevCluster, machine *clusterv1.Machine, dockerMachine *infrav1.DevMachine) ([]container.NetworkAttachment, bool, error) {
+	networks := make([]container.NetworkAttachment, 0, len(dockerCluster.Spec.Backend.Docker.Networks))
+	allocated := true
+	for _, network := range dockerCluster.Spec.Backend.Docker.Networks {
+		if network.AddressesFromPool.Name == "" {
+			networks = append(networks, container.NetworkAttachment{Name: network.Name})
+			continue
+		}
+
+		claim := &ipamv1.IPAddressClaim{}
+		claimKey := client.ObjectKey{Namespace: dockerMachine.Namespace, Name: ipAddressClaimName(dockerMachine.Name, network.Name)}
+		if err := r.Get(ctx, claimKey, claim); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, false, pkgerrors.Wrapf(err, "failed to get IPAddressClaim %s", klog.KRef(claimKey.Namespace, claimKey.Name))
+			}
+
+			claim = &ipamv1.IPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      claimKey.Name,
+					Namespace: claimKey.Namespace,
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel: machine.Spec.ClusterName,
+					},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       machine.Name,
+						UID:        machine.UID,
+					}},
+				},
+				Spec: ipamv1.IPAddressClaimSpec{
+					ClusterName: machine.Spec.ClusterName,
+					PoolRef:     network.AddressesFromPool,
+				},
+			}
+			if err := r.Create(ctx, claim); err != nil {
+				return nil, false, pkgerrors.Wrapf(err, "failed to create IPAddressClaim %s", klog.KObj(claim))
+			}
+		}
+
+		if claim.Status.AddressRef.Name == "" {
+			allocated = false
+			continue
+		}
+
+		address := &ipamv1.IPAddress{}
+		addressKey := client.ObjectKey{Namespace: claim.Namespace, Name: claim.Status.AddressRef.Name}
+		if err := r.Get(ctx, addressKey, address); err != nil {
+			return nil, false, pkgerrors.Wrapf(err, "failed to get IPAddress %s", klog.KRef(addressKey.Namespace, addressKey.Name))
+		}
+		networks = append(networks, container.NetworkAttachment{Name: network.Name, IPv4Address: address.Spec.Address})
+	}
+
+	if !allocated {
+		return nil, false, nil
+	}
+	return networks, true, nil
+}
+
+// ipAddressClaimName returns the name of the IPAddressClaim for a DevMachine in an additional network.
+func ipAddressClaimName(devMachineName, networkName string) string {
+	return fmt.Sprintf("%s-%s", devMachineName, networkName)
+}
+
+// bootLogsSecretName returns the name of the Secret containing the boot logs of a DevMachine.
+func bootLogsSecretName(devMachineName string) string {
+	return fmt.Sprintf("%s-boot-logs", devMachineName)
+}
+
+// bootstrapFailureReportName returns the name of the ConfigMap reporting a bootstrap failure for the KubeadmConfig
+// of a Machine; it returns false if the Machine is not bootstrapped using a KubeadmConfig.
+func bootstrapFailureReportName(machine *clusterv1.Machine) (string, bool) {
+	configRef := machine.Spec.Bootstrap.ConfigRef
+	if configRef.APIGroup != bootstrapv1.GroupVersion.Group || configRef.Kind != "KubeadmConfig" || configRef.Name == "" {
+		return "", false
+	}
+	return configRef.Name + bootstrapv1.KubeadmConfigBootstrapFailureReportSuffix, true
+}
+
 func boostrapCommandOperation(externalMachine *docker.Machine, command provisioning.Cmd) Operation {
 	commandMsg := strings.Join(append([]string{command.Cmd}, command.Args...), " ")
 	return Operation{
@@ -877,6 +1190,46 @@ func (r *MachineBackendReconciler) ReconcileDelete(ctx context.Context, cluster
 		}
 	}
 
+	// delete the IPAddressClaims for the additional networks, if any.
+	for _, network := range dockerCluster.Spec.Backend.Docker.Networks {
+		if network.AddressesFromPool.Name == "" {
+			continue
+		}
+		claim := &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ipAddressClaimName(dockerMachine.Name, network.Name),
+				Namespace: dockerMachine.Namespace,
+			},
+		}
+		if err := r.Delete(ctx, claim); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to delete IPAddressClaim %s", klog.KObj(claim))
+		}
+	}
+
+	// delete the boot logs, if any.
+	bootLogsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootLogsSecretName(dockerMachine.Name),
+			Namespace: dockerMachine.Namespace,
+		},
+	}
+	if err := r.Delete(ctx, bootLogsSecret); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to delete boot logs Secret %s", klog.KObj(bootLogsSecret))
+	}
+
+	// delete the bootstrap failure report, if any.
+	if reportName, ok := bootstrapFailureReportName(machine); ok {
+		bootstrapFailureReport := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      reportName,
+				Namespace: machine.Namespace,
+			},
+		}
+		if err := r.Delete(ctx, bootstrapFailureReport); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, pkgerrors.Wrapf(err, "failed to delete bootstrap failure report ConfigMap %s", klog.KObj(bootstrapFailureReport))
+		}
+	}
+
 	// Machine is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(dockerMachine, infrav1.MachineFinalizer)
 	return ctrl.Result{}, nil
@@ -937,6 +1290,7 @@ func (r *MachineBackendReconciler) PatchDevMachine(ctx context.Context, patchHel
 			infrav1.DevMachineDockerContainerProvisionedCondition,
 			infrav1.DevMachineDockerPreLoadedImagesReadyCondition,
 			infrav1.DevMachineBootstrapCompletedCondition,
+			infrav1.DevMachineDockerFaultInjectedCondition,
 		}},
 	)
 }
@@ -977,9 +1331,9 @@ func (r *MachineBackendReconciler) getBootstrapData(ctx context.Context, namespa
 		return "", "", pkgerrors.Wrapf(err, "failed to retrieve bootstrap data secret %s", dataSecretName)
 	}
 
-	value, ok := s.Data["value"]
-	if !ok {
-		return "", "", pkgerrors.New("error retrieving bootstrap data: secret value key is missing")
+	value, err := r.BootstrapDataDecrypter.DecryptSecretData(s.Data)
+	if err != nil {
+		return "", "", pkgerrors.Wrapf(err, "error retrieving bootstrap data from secret %s", dataSecretName)
 	}
 
 	format := s.Data["format"]
@@ -1010,7 +1364,7 @@ func (r *MachineBackendReconciler) getUnsafeLoadBalancerConfigTemplate(ctx conte
 }
 
 // setMachineAddress gets the address from the container corresponding to a docker node and sets it on the Machine object.
-func setMachineAddress(ctx context.Context, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine) error {
+func setMachineAddress(ctx context.Context, dockerMachine *infrav1.DevMachine, externalMachine *docker.Machine, networks []infrav1.DockerNetwork) error {
 	machineAddresses, err := externalMachine.Address(ctx)
 	if err != nil {
 		return err
@@ -1032,6 +1386,24 @@ func setMachineAddress(ctx context.Context, dockerMachine *infrav1.DevMachine, e
 			})
 	}
 
+	// Addresses in the additional networks are only reachable from within those networks, so they are surfaced as internal IPs.
+	for _, network := range networks {
+		networkAddresses, err := externalMachine.NetworkAddress(ctx, network.Name)
+		if err != nil {
+			return err
+		}
+		for _, addr := range networkAddresses {
+			if addr == "" {
+				continue
+			}
+			dockerMachine.Status.Addresses = append(dockerMachine.Status.Addresses,
+				clusterv1.MachineAddress{
+					Type:    clusterv1.MachineInternalIP,
+					Address: addr,
+				})
+		}
+	}
+
 	return nil
 }
 
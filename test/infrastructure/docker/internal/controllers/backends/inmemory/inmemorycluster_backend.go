@@ -25,6 +25,7 @@ import (
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +40,8 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
 	inmemoryserver "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server"
+	inmemoryapi "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/api"
+	inmemoryetcd "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/etcd"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/secret"
 )
@@ -149,6 +152,9 @@ func (r *ClusterBackendReconciler) ReconcileNormal(ctx context.Context, cluster
 	if err := r.APIServerMux.RegisterResourceGroup(listenerName, resourceGroup); err != nil {
 		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to register the resource group for the workload cluster")
 	}
+	if err := r.APIServerMux.SetBehaviorProfile(listenerName, behaviorProfile(inMemoryCluster.Spec.Backend.InMemory)); err != nil {
+		return ctrl.Result{}, pkgerrors.Wrap(err, "failed to set the behavior profile for the workload cluster")
+	}
 
 	// Surface the control plane endpoint
 	if inMemoryCluster.Spec.ControlPlaneEndpoint.Host == "" {
@@ -163,6 +169,37 @@ func (r *ClusterBackendReconciler) ReconcileNormal(ctx context.Context, cluster
 	return ctrl.Result{}, nil
 }
 
+// behaviorProfile returns the BehaviorProfile for the API server and the etcd members of the workload cluster.
+func behaviorProfile(inMemoryBackend *infrav1.InMemoryClusterBackendSpec) inmemoryserver.BehaviorProfile {
+	profile := inmemoryserver.BehaviorProfile{}
+	if apiServer := inMemoryBackend.APIServer; apiServer != nil {
+		profile.APIServer = inmemoryapi.BehaviorProfile{
+			ErrorPercentage: ptr.Deref(apiServer.ErrorPercentage, 0),
+		}
+		if apiServer.ResponseDelay != nil {
+			profile.APIServer.ResponseDelay = apiServer.ResponseDelay.Duration
+		}
+	}
+	if etcd := inMemoryBackend.Etcd; etcd != nil {
+		profile.Etcd = inmemoryetcd.BehaviorProfile{
+			ErrorPercentage: ptr.Deref(etcd.ErrorPercentage, 0),
+			MemberLag:       uint64(max(ptr.Deref(etcd.MemberLag, 0), 0)),
+		}
+		if etcd.ResponseDelay != nil {
+			profile.Etcd.ResponseDelay = etcd.ResponseDelay.Duration
+		}
+		for _, alarm := range etcd.Alarms {
+			switch alarm {
+			case infrav1.InMemoryEtcdNoSpaceAlarm:
+				profile.Etcd.Alarms = append(profile.Etcd.Alarms, pb.AlarmType_NOSPACE)
+			case infrav1.InMemoryEtcdCorruptAlarm:
+				profile.Etcd.Alarms = append(profile.Etcd.Alarms, pb.AlarmType_CORRUPT)
+			}
+		}
+	}
+	return profile
+}
+
 // ReconcileDelete handle in memory backend for deleted DevCluster.
 func (r *ClusterBackendReconciler) ReconcileDelete(_ context.Context, cluster *clusterv1.Cluster, inMemoryCluster *infrav1.DevCluster) (ctrl.Result, error) {
 	if inMemoryCluster.Spec.Backend.InMemory == nil {
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inmemory
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
+	inmemoryserver "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server"
+	inmemoryapi "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/api"
+	inmemoryetcd "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/etcd"
+)
+
+func Test_behaviorProfile(t *testing.T) {
+	tests := []struct {
+		name            string
+		inMemoryBackend *infrav1.InMemoryClusterBackendSpec
+		want            inmemoryserver.BehaviorProfile
+	}{
+		{
+			name:            "empty behavior profile if nothing is set",
+			inMemoryBackend: &infrav1.InMemoryClusterBackendSpec{},
+			want:            inmemoryserver.BehaviorProfile{},
+		},
+		{
+			name: "behavior profile for API server and etcd",
+			inMemoryBackend: &infrav1.InMemoryClusterBackendSpec{
+				APIServer: &infrav1.InMemoryClusterAPIServerSpec{
+					ResponseDelay:   &metav1.Duration{Duration: 500 * time.Millisecond},
+					ErrorPercentage: ptr.To[int32](10),
+				},
+				Etcd: &infrav1.InMemoryClusterEtcdSpec{
+					ResponseDelay:   &metav1.Duration{Duration: time.Second},
+					ErrorPercentage: ptr.To[int32](5),
+					MemberLag:       ptr.To[int64](1000),
+					Alarms:          []infrav1.InMemoryEtcdAlarmType{infrav1.InMemoryEtcdNoSpaceAlarm, infrav1.InMemoryEtcdCorruptAlarm},
+				},
+			},
+			want: inmemoryserver.BehaviorProfile{
+				APIServer: inmemoryapi.BehaviorProfile{
+					ResponseDelay:   500 * time.Millisecond,
+					ErrorPercentage: 10,
+				},
+				Etcd: inmemoryetcd.BehaviorProfile{
+					ResponseDelay:   time.Second,
+					ErrorPercentage: 5,
+					MemberLag:       1000,
+					Alarms:          []pb.AlarmType{pb.AlarmType_NOSPACE, pb.AlarmType_CORRUPT},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(behaviorProfile(tt.inMemoryBackend)).To(Equal(tt.want))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/test/infrastructure/container"
 	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
@@ -61,6 +62,9 @@ type DevMachineReconciler struct {
 	InMemoryManager          inmemoryruntime.Manager
 	APIServerMux             *inmemoryserver.WorkloadClustersMux
 	DockerMachineTaskManager *dockerbackend.TaskManager
+
+	// BootstrapDataDecrypter is used to decrypt bootstrap data encrypted by the kubeadm bootstrap provider.
+	BootstrapDataDecrypter *encryption.Decrypter
 }
 
 // SetupWithManager will add watches for this controller.
@@ -107,8 +111,10 @@ func (r *DevMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=devmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=devmachines/status;devmachines/finalizers,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;machinesets;machines,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch;patch;
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch;patch;create;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
 
 // Reconcile handles DevMachine events.
 func (r *DevMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, rerr error) {
@@ -236,6 +242,7 @@ func (r *DevMachineReconciler) backendReconcilerFactory(_ context.Context, devMa
 		ClusterCache:                r.ClusterCache,
 		TaskManager:                 r.DockerMachineTaskManager,
 		DeferNextReconcileForObject: r.controller.DeferNextReconcileForObject,
+		BootstrapDataDecrypter:      r.BootstrapDataDecrypter,
 	}
 }
 
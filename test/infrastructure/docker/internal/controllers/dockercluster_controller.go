@@ -230,6 +230,7 @@ func dockerClusterToDevCluster(dockerCluster *infrav1.DockerCluster) *infrav1.De
 				Docker: &infrav1.DockerClusterBackendSpec{
 					FailureDomains: dockerCluster.Spec.FailureDomains,
 					LoadBalancer:   dockerCluster.Spec.LoadBalancer,
+					Networks:       dockerCluster.Spec.Networks,
 				},
 			},
 		},
@@ -259,6 +260,7 @@ func devClusterToDockerCluster(devCluster *infrav1.DevCluster, dockerCluster *in
 	dockerCluster.Spec.ControlPlaneEndpoint = devCluster.Spec.ControlPlaneEndpoint
 	dockerCluster.Spec.FailureDomains = devCluster.Spec.Backend.Docker.FailureDomains
 	dockerCluster.Spec.LoadBalancer = devCluster.Spec.Backend.Docker.LoadBalancer
+	dockerCluster.Spec.Networks = devCluster.Spec.Backend.Docker.Networks
 	dockerCluster.Status.Initialization = infrav1.DockerClusterInitializationStatus{
 		Provisioned: devCluster.Status.Initialization.Provisioned,
 	}
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/test/infrastructure/container"
 	infrav1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta2"
@@ -55,6 +56,9 @@ type DockerMachineReconciler struct {
 	backendReconciler        *dockerbackend.MachineBackendReconciler
 	DockerMachineTaskManager *dockerbackend.TaskManager
 
+	// BootstrapDataDecrypter is used to decrypt bootstrap data encrypted by the kubeadm bootstrap provider.
+	BootstrapDataDecrypter *encryption.Decrypter
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -62,8 +66,10 @@ type DockerMachineReconciler struct {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=dockermachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=dockermachines/status;dockermachines/finalizers,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;machinesets;machines,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
 
 // Reconcile handles DockerMachine events.
 func (r *DockerMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -226,6 +232,7 @@ func (r *DockerMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 		ClusterCache:                r.ClusterCache,
 		TaskManager:                 r.DockerMachineTaskManager,
 		DeferNextReconcileForObject: c.DeferNextReconcileForObject,
+		BootstrapDataDecrypter:      r.BootstrapDataDecrypter,
 	}
 	return nil
 }
@@ -347,6 +354,7 @@ func dockerMachineToDevMachine(dockerMachine *infrav1.DockerMachine) *infrav1.De
 			},
 			Addresses:     dockerMachine.Status.Addresses,
 			FailureDomain: dockerMachine.Status.FailureDomain,
+			Diagnostics:   dockerMachine.Status.Diagnostics,
 			Conditions:    dockerMachine.Status.Conditions,
 			Deprecated:    v1Beta1Status,
 		},
@@ -375,6 +383,7 @@ func devMachineToDockerMachine(devMachine *infrav1.DevMachine, dockerMachine *in
 	}
 	dockerMachine.Status.Addresses = devMachine.Status.Addresses
 	dockerMachine.Status.FailureDomain = devMachine.Status.FailureDomain
+	dockerMachine.Status.Diagnostics = devMachine.Status.Diagnostics
 	dockerMachine.Status.Conditions = devMachine.Status.Conditions
 	dockerMachine.Status.Deprecated = v1Beta1Status
 }
@@ -46,8 +46,8 @@ import (
 )
 
 type nodeCreator interface {
-	CreateControlPlaneNode(ctx context.Context, name, clusterName, listenAddress string, port int32, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping) (node *types.Node, err error)
-	CreateWorkerNode(ctx context.Context, name, clusterName string, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping) (node *types.Node, err error)
+	CreateControlPlaneNode(ctx context.Context, name, clusterName, listenAddress string, port int32, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping, networks []container.NetworkAttachment) (node *types.Node, err error)
+	CreateWorkerNode(ctx context.Context, name, clusterName string, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping, networks []container.NetworkAttachment) (node *types.Node, err error)
 }
 
 // Machine implement a service for managing the docker containers hosting a kubernetes nodes.
@@ -196,6 +196,29 @@ func (m *Machine) Address(ctx context.Context) ([]string, error) {
 	return nil, pkgerrors.New("unknown ipFamily")
 }
 
+// NetworkAddress will get the IP address of the machine in an additional network. It can return
+// a single IPv4 address, a single IPv6 address or one of each depending on the machine.ipFamily.
+func (m *Machine) NetworkAddress(ctx context.Context, network string) ([]string, error) {
+	containerRuntime, err := container.RuntimeFrom(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to connect to container runtime")
+	}
+
+	ipv4, ipv6, err := containerRuntime.GetContainerNetworkIPs(ctx, m.ContainerName(), network)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to get machine IPs in network %s", network)
+	}
+	switch m.ipFamily {
+	case container.IPv6IPFamily:
+		return []string{ipv6}, nil
+	case container.IPv4IPFamily:
+		return []string{ipv4}, nil
+	case container.DualStackIPFamily:
+		return []string{ipv4, ipv6}, nil
+	}
+	return nil, pkgerrors.New("unknown ipFamily")
+}
+
 // ContainerImage return the image of the container for this machine
 // or empty string if the container does not exist yet.
 func (m *Machine) ContainerImage() string {
@@ -206,7 +229,8 @@ func (m *Machine) ContainerImage() string {
 }
 
 // Create creates a docker container hosting a Kubernetes node.
-func (m *Machine) Create(ctx context.Context, image string, role string, version string, labels map[string]string, mounts []infrav1.Mount) error {
+// The container is attached to the default network and to the given additional networks, if any.
+func (m *Machine) Create(ctx context.Context, image string, role string, version string, labels map[string]string, mounts []infrav1.Mount, networks []container.NetworkAttachment) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	// Create if not exists.
@@ -241,6 +265,7 @@ func (m *Machine) Create(ctx context.Context, image string, role string, version
 				labels,
 				m.ipFamily,
 				kindMapping,
+				networks,
 			)
 			if err != nil {
 				return pkgerrors.WithStack(err)
@@ -256,6 +281,7 @@ func (m *Machine) Create(ctx context.Context, image string, role string, version
 				labels,
 				m.ipFamily,
 				kindMapping,
+				networks,
 			)
 			if err != nil {
 				return pkgerrors.WithStack(err)
@@ -435,6 +461,75 @@ func (m *Machine) Delete(ctx context.Context) error {
 	return nil
 }
 
+// IsPaused returns true if the container for this machine is paused.
+func (m *Machine) IsPaused() bool {
+	if !m.Exists() {
+		return false
+	}
+
+	return m.container.IsPaused()
+}
+
+// Pause suspends all the processes in the container hosting a Kubernetes node.
+func (m *Machine) Pause(ctx context.Context) error {
+	if m.container == nil {
+		return pkgerrors.New("unable to pause the machine: the container hosting this machine does not exist")
+	}
+	return m.container.Pause(ctx)
+}
+
+// Unpause resumes all the processes in the container hosting a Kubernetes node.
+func (m *Machine) Unpause(ctx context.Context) error {
+	if m.container == nil {
+		return pkgerrors.New("unable to unpause the machine: the container hosting this machine does not exist")
+	}
+	return m.container.Unpause(ctx)
+}
+
+// Kill kills the container hosting a Kubernetes node.
+func (m *Machine) Kill(ctx context.Context) error {
+	if m.container == nil {
+		return pkgerrors.New("unable to kill the machine: the container hosting this machine does not exist")
+	}
+	return m.container.Kill(ctx, "SIGKILL")
+}
+
+// SetNetworkPartition drops the traffic between the container hosting a Kubernetes node and the given address
+// if partitioned is true, otherwise it restores it.
+func (m *Machine) SetNetworkPartition(ctx context.Context, address string, partitioned bool) error {
+	if m.container == nil {
+		return pkgerrors.New("unable to set network partition: the container hosting this machine does not exist")
+	}
+
+	iptables := "iptables"
+	if strings.Contains(address, ":") {
+		iptables = "ip6tables"
+	}
+	rules := []string{
+		fmt.Sprintf("INPUT -s %s -j DROP", address),
+		fmt.Sprintf("OUTPUT -d %s -j DROP", address),
+	}
+
+	script := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		if partitioned {
+			// Add the rule only if it does not exist yet.
+			script = append(script, fmt.Sprintf("%[1]s -C %[2]s 2>/dev/null || %[1]s -I %[2]s", iptables, rule))
+			continue
+		}
+		// Remove all the occurrences of the rule.
+		script = append(script, fmt.Sprintf("while %[1]s -D %[2]s 2>/dev/null; do :; done", iptables, rule))
+	}
+
+	var outErr bytes.Buffer
+	cmd := m.container.Commander.Command("/bin/sh", "-c", strings.Join(script, "; "))
+	cmd.SetStderr(&outErr)
+	if err := cmd.Run(ctx); err != nil {
+		return pkgerrors.Wrapf(err, "failed to set network partition: %s", outErr.String())
+	}
+	return nil
+}
+
 // LogContainerDebugInfo logs additional debug info for the container.
 func (m *Machine) LogContainerDebugInfo(ctx context.Context) {
 	log := ctrl.LoggerFrom(ctx)
@@ -57,12 +57,13 @@ type nodeCreateOpts struct {
 	Labels       map[string]string
 	IPFamily     container.ClusterIPFamily
 	KindMapping  kind.Mapping
+	Networks     []container.NetworkAttachment
 }
 
 // CreateControlPlaneNode will create a new control plane container.
 // NOTE: If port is 0 picking a host port for the control plane is delegated to the container runtime and is not stable across container restarts.
 // This means that connection to a control plane node may take some time to recover if the underlying container is restarted.
-func (m *Manager) CreateControlPlaneNode(ctx context.Context, name, clusterName, listenAddress string, port int32, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping) (*types.Node, error) {
+func (m *Manager) CreateControlPlaneNode(ctx context.Context, name, clusterName, listenAddress string, port int32, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping, networks []container.NetworkAttachment) (*types.Node, error) {
 	// add api server port mapping
 	portMappingsWithAPIServer := append(portMappings, v1alpha4.PortMapping{
 		ListenAddress: listenAddress,
@@ -79,6 +80,7 @@ func (m *Manager) CreateControlPlaneNode(ctx context.Context, name, clusterName,
 		Labels:       labels,
 		IPFamily:     ipFamily,
 		KindMapping:  kindMapping,
+		Networks:     networks,
 	}
 	node, err := createNode(ctx, createOpts)
 	if err != nil {
@@ -89,7 +91,7 @@ func (m *Manager) CreateControlPlaneNode(ctx context.Context, name, clusterName,
 }
 
 // CreateWorkerNode will create a new worker container.
-func (m *Manager) CreateWorkerNode(ctx context.Context, name, clusterName string, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping) (*types.Node, error) {
+func (m *Manager) CreateWorkerNode(ctx context.Context, name, clusterName string, mounts []v1alpha4.Mount, portMappings []v1alpha4.PortMapping, labels map[string]string, ipFamily container.ClusterIPFamily, kindMapping kind.Mapping, networks []container.NetworkAttachment) (*types.Node, error) {
 	createOpts := &nodeCreateOpts{
 		Name:         name,
 		ClusterName:  clusterName,
@@ -99,6 +101,7 @@ func (m *Manager) CreateWorkerNode(ctx context.Context, name, clusterName string
 		Labels:       labels,
 		IPFamily:     ipFamily,
 		KindMapping:  kindMapping,
+		Networks:     networks,
 	}
 	return createNode(ctx, createOpts)
 }
@@ -164,11 +167,12 @@ func createNode(ctx context.Context, opts *nodeCreateOpts) (*types.Node, error)
 		// filesystem, which is not only better for performance, but allows
 		// running kind in kind for "party tricks"
 		// (please don't depend on doing this though!)
-		Entrypoint:   opts.EntryPoint,
-		Volumes:      map[string]string{"/var": ""},
-		Mounts:       generateMountInfo(opts.Mounts),
-		PortMappings: generatePortMappings(opts.PortMappings),
-		Network:      DefaultNetwork,
+		Entrypoint:         opts.EntryPoint,
+		Volumes:            map[string]string{"/var": ""},
+		Mounts:             generateMountInfo(opts.Mounts),
+		PortMappings:       generatePortMappings(opts.PortMappings),
+		Network:            DefaultNetwork,
+		AdditionalNetworks: opts.Networks,
 		Tmpfs: map[string]string{
 			"/tmp": "", // various things depend on working /tmp
 			"/run": "", // systemd wants a writable /run
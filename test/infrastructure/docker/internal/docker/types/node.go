@@ -90,6 +90,11 @@ func (n *Node) IsRunning() bool {
 	return strings.HasPrefix(n.status, "Up")
 }
 
+// IsPaused returns true if the container is paused.
+func (n *Node) IsPaused() bool {
+	return n.IsRunning() && strings.HasSuffix(n.status, "(Paused)")
+}
+
 // Delete removes the container.
 func (n *Node) Delete(ctx context.Context) error {
 	containerRuntime, err := container.RuntimeFrom(ctx)
@@ -163,6 +168,34 @@ func (n *Node) Kill(ctx context.Context, signal string) error {
 	return nil
 }
 
+// Pause suspends all the processes in the container.
+func (n *Node) Pause(ctx context.Context) error {
+	containerRuntime, err := container.RuntimeFrom(ctx)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to connect to container runtime")
+	}
+
+	if err := containerRuntime.PauseContainer(ctx, n.Name); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pause container %q", n.Name)
+	}
+
+	return nil
+}
+
+// Unpause resumes all the processes in the container.
+func (n *Node) Unpause(ctx context.Context) error {
+	containerRuntime, err := container.RuntimeFrom(ctx)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to connect to container runtime")
+	}
+
+	if err := containerRuntime.UnpauseContainer(ctx, n.Name); err != nil {
+		return pkgerrors.Wrapf(err, "failed to unpause container %q", n.Name)
+	}
+
+	return nil
+}
+
 // ContainerCmder is used for running commands within a container.
 type ContainerCmder struct {
 	nameOrID string
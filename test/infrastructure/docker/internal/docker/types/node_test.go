@@ -80,6 +80,32 @@ func TestKillContainer(t *testing.T) {
 	g.Expect(callLog[0].Signal).To(Equal("TestSignal"))
 }
 
+func TestPauseContainer(t *testing.T) {
+	g := NewWithT(t)
+	containerRuntime := &container.FakeRuntime{}
+	ctx := container.RuntimeInto(context.Background(), containerRuntime)
+
+	node := &Node{
+		Name: "TestNode",
+	}
+
+	containerRuntime.ResetPauseContainerCallLogs()
+	containerRuntime.ResetUnpauseContainerCallLogs()
+	g.Expect(node.Pause(ctx)).To(Succeed())
+	g.Expect(node.Unpause(ctx)).To(Succeed())
+
+	g.Expect(containerRuntime.PauseContainerCalls()).To(Equal([]string{"TestNode"}))
+	g.Expect(containerRuntime.UnpauseContainerCalls()).To(Equal([]string{"TestNode"}))
+}
+
+func TestIsPaused(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect((&Node{}).WithStatus("Up 5 minutes").IsPaused()).To(BeFalse())
+	g.Expect((&Node{}).WithStatus("Up 5 minutes (Paused)").IsPaused()).To(BeTrue())
+	g.Expect((&Node{}).WithStatus("Exited (137) 2 minutes ago").IsPaused()).To(BeFalse())
+}
+
 func TestCommandRun(t *testing.T) {
 	g := NewWithT(t)
 	containerRuntime := &container.FakeRuntime{}
@@ -52,6 +52,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	"sigs.k8s.io/cluster-api/bootstrap/kubeadm/pkg/encryption"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/crdmigrator"
 	"sigs.k8s.io/cluster-api/controllers/remote"
@@ -96,16 +98,17 @@ var (
 	managerOptions              = flags.ManagerOptions{}
 	logOptions                  = logs.NewOptions()
 	// CAPD specific flags.
-	devMachineConcurrency            int
-	devClusterConcurrency            int
-	devMachineTemplateConcurrency    int
-	devMachinePoolConcurrency        int
-	dockerMachineConcurrency         int
-	dockerMachineTemplateConcurrency int
-	dockerMachinePoolConcurrency     int
-	dockerClusterConcurrency         int
-	clusterCacheConcurrency          int
-	skipCRDMigrationPhases           []string
+	devMachineConcurrency              int
+	devClusterConcurrency              int
+	devMachineTemplateConcurrency      int
+	devMachinePoolConcurrency          int
+	dockerMachineConcurrency           int
+	dockerMachineTemplateConcurrency   int
+	dockerMachinePoolConcurrency       int
+	dockerClusterConcurrency           int
+	clusterCacheConcurrency            int
+	skipCRDMigrationPhases             []string
+	bootstrapDataDecryptionPrivateKeys []string
 )
 
 func init() {
@@ -114,6 +117,7 @@ func init() {
 	_ = infrav1beta1.AddToScheme(scheme)
 	_ = infrav1.AddToScheme(scheme)
 	_ = clusterv1.AddToScheme(scheme)
+	_ = ipamv1.AddToScheme(scheme)
 
 	// scheme used for operating on the cloud resource.
 	_ = cloudv1.AddToScheme(inmemoryScheme)
@@ -183,6 +187,9 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
 
+	fs.StringSliceVar(&bootstrapDataDecryptionPrivateKeys, "bootstrap-data-decryption-private-key", []string{},
+		"Comma separated list of paths of PEM encoded RSA private keys used to decrypt bootstrap data encrypted by the kubeadm bootstrap provider.")
+
 	fs.DurationVar(&syncPeriod, "sync-period", 10*time.Minute,
 		"The minimum interval at which watched resources are reconciled (e.g. 15m)")
 
@@ -378,6 +385,21 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		os.Exit(1)
 	}
 
+	privateKeysPEM := [][]byte{}
+	for _, path := range bootstrapDataDecryptionPrivateKeys {
+		privateKeyPEM, err := os.ReadFile(path) //nolint:gosec // The path is provided by the operator via a flag.
+		if err != nil {
+			setupLog.Error(err, "Unable to read bootstrap data decryption private key")
+			os.Exit(1)
+		}
+		privateKeysPEM = append(privateKeysPEM, privateKeyPEM)
+	}
+	bootstrapDataDecrypter, err := encryption.NewDecrypter(privateKeysPEM...)
+	if err != nil {
+		setupLog.Error(err, "Unable to create bootstrap data decrypter")
+		os.Exit(1)
+	}
+
 	// Set our runtime client into the context for later use
 	runtimeClient, err := container.NewDockerClient()
 	if err != nil {
@@ -459,10 +481,11 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	}
 
 	if err := (&controllers.DockerMachineReconciler{
-		Client:           mgr.GetClient(),
-		ContainerRuntime: runtimeClient,
-		ClusterCache:     clusterCache,
-		WatchFilterValue: watchFilterValue,
+		Client:                 mgr.GetClient(),
+		ContainerRuntime:       runtimeClient,
+		ClusterCache:           clusterCache,
+		BootstrapDataDecrypter: bootstrapDataDecrypter,
+		WatchFilterValue:       watchFilterValue,
 	}).SetupWithManager(ctx, mgr, controller.Options{
 		MaxConcurrentReconciles: dockerMachineConcurrency,
 		ReconciliationTimeout:   6 * time.Minute, // increase reconciliation timeout because the DockerMachineReconciler performs long operations like kubeadm init/join, image copy, etc.
@@ -505,12 +528,13 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	}
 
 	if err := (&controllers.DevMachineReconciler{
-		Client:           mgr.GetClient(),
-		WatchFilterValue: watchFilterValue,
-		ContainerRuntime: runtimeClient,
-		ClusterCache:     clusterCache,
-		InMemoryManager:  inMemoryManager,
-		APIServerMux:     apiServerMux,
+		Client:                 mgr.GetClient(),
+		WatchFilterValue:       watchFilterValue,
+		ContainerRuntime:       runtimeClient,
+		ClusterCache:           clusterCache,
+		InMemoryManager:        inMemoryManager,
+		APIServerMux:           apiServerMux,
+		BootstrapDataDecrypter: bootstrapDataDecrypter,
 	}).SetupWithManager(ctx, mgr, controller.Options{
 		MaxConcurrentReconciles: devMachineConcurrency,
 		ReconciliationTimeout:   5 * time.Minute, // increase reconciliation timeout because the Docker backend performs long operations like kubeadm init/join, image copy, etc.
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net"
 	"net/http"
 	"net/url"
@@ -58,19 +59,36 @@ import (
 // request targets.
 type ResourceGroupResolver func(host string) (string, error)
 
+// BehaviorProfile defines how a fake API server deviates from a healthy API server, e.g. to
+// simulate a slow or flaky control plane.
+type BehaviorProfile struct {
+	// ResponseDelay is added to every request before it is served.
+	ResponseDelay time.Duration
+
+	// ErrorPercentage is the percentage of requests, between 0 and 100, that fail with
+	// an internal server error.
+	ErrorPercentage int32
+}
+
+// BehaviorProfileResolver defines a func that returns the BehaviorProfile for the
+// workloadCluster/resourceGroup a request targets.
+type BehaviorProfileResolver func(host string) BehaviorProfile
+
 // NewAPIServerHandler returns an http.Handler for a fake API server.
-func NewAPIServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver) http.Handler {
+func NewAPIServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver, behaviorProfileResolver BehaviorProfileResolver) http.Handler {
 	apiServer := &apiServerHandler{
-		container:             restful.NewContainer(),
-		manager:               manager,
-		log:                   log,
-		resourceGroupResolver: resolver,
+		container:               restful.NewContainer(),
+		manager:                 manager,
+		log:                     log,
+		resourceGroupResolver:   resolver,
+		behaviorProfileResolver: behaviorProfileResolver,
 		requestInfoResolver: server.NewRequestInfoResolver(&server.Config{
 			LegacyAPIGroupPrefixes: sets.NewString(server.DefaultLegacyAPIPrefix),
 		}),
 	}
 
 	apiServer.container.Filter(apiServer.globalLogging)
+	apiServer.container.Filter(apiServer.behaviorProfile)
 
 	ws := new(restful.WebService)
 	ws.Consumes(runtime.ContentTypeJSON)
@@ -129,11 +147,12 @@ func NewAPIServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resol
 }
 
 type apiServerHandler struct {
-	container             *restful.Container
-	manager               inmemoryruntime.Manager
-	log                   logr.Logger
-	resourceGroupResolver ResourceGroupResolver
-	requestInfoResolver   *request.RequestInfoFactory
+	container               *restful.Container
+	manager                 inmemoryruntime.Manager
+	log                     logr.Logger
+	resourceGroupResolver   ResourceGroupResolver
+	behaviorProfileResolver BehaviorProfileResolver
+	requestInfoResolver     *request.RequestInfoFactory
 }
 
 func (h *apiServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -175,6 +194,33 @@ func (h *apiServerHandler) globalLogging(req *restful.Request, resp *restful.Res
 	chain.ProcessFilter(req, resp)
 }
 
+// behaviorProfile applies the BehaviorProfile of the workload cluster a request targets.
+// Note: This filter runs after globalLogging, so delayed and failed requests are reflected in metrics.
+func (h *apiServerHandler) behaviorProfile(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if h.behaviorProfileResolver == nil {
+		chain.ProcessFilter(req, resp)
+		return
+	}
+
+	profile := h.behaviorProfileResolver(req.Request.Host)
+	if profile.ResponseDelay > 0 {
+		select {
+		case <-time.After(profile.ResponseDelay):
+		case <-req.Request.Context().Done():
+			_ = resp.WriteErrorString(http.StatusServiceUnavailable, "request canceled while applying response delay")
+			return
+		}
+	}
+
+	if profile.ErrorPercentage > 0 && rand.Int32N(100) < profile.ErrorPercentage { //nolint:gosec // No need for a cryptographically secure random number here.
+		h.log.V(4).Info("Failing request because of behavior profile", "method", req.Request.Method, "url", req.Request.URL)
+		_ = resp.WriteErrorString(http.StatusInternalServerError, "injected error: request failed because of the behavior profile of the workload cluster")
+		return
+	}
+
+	chain.ProcessFilter(req, resp)
+}
+
 // cleanDryRun gets dryrun from a URL.
 // Note: This is a copy of k8s.io/apiserver/pkg/endpoints/metrics.cleanDryRun.
 func cleanDryRun(u *url.URL) string {
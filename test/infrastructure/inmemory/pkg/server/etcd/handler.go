@@ -19,6 +19,7 @@ package etcd
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
 	"net/http"
 	"strconv"
 	"strings"
@@ -28,7 +29,9 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,16 +44,42 @@ import (
 // request targets.
 type ResourceGroupResolver func(host string) (string, error)
 
-// NewEtcdServerHandler returns an http.Handler for fake etcd members.
-func NewEtcdServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver) http.Handler {
-	svr := grpc.NewServer()
+// BehaviorProfile defines how fake etcd members deviate from a healthy etcd cluster, e.g. to
+// simulate slow or flaky members, members lagging behind the leader or alarms.
+type BehaviorProfile struct {
+	// ResponseDelay is added to every request before it is served.
+	ResponseDelay time.Duration
+
+	// ErrorPercentage is the percentage of requests, between 0 and 100, that fail with
+	// an Unavailable error.
+	ErrorPercentage int32
+
+	// MemberLag is the number of raft entries followers are lagging behind the leader.
+	MemberLag uint64
+
+	// Alarms are reported by every etcd member.
+	Alarms []pb.AlarmType
+}
 
+// BehaviorProfileResolver defines a func that returns the BehaviorProfile for the
+// workloadCluster/resourceGroup a request targets.
+type BehaviorProfileResolver func(host string) BehaviorProfile
+
+// leaderRaftIndex is the raft index reported by the etcd leader; followers report this value minus
+// the MemberLag from the BehaviorProfile.
+const leaderRaftIndex = 1_000_000
+
+// NewEtcdServerHandler returns an http.Handler for fake etcd members.
+func NewEtcdServerHandler(manager inmemoryruntime.Manager, log logr.Logger, resolver ResourceGroupResolver, behaviorProfileResolver BehaviorProfileResolver) http.Handler {
 	baseSvr := &baseServer{
-		manager:               manager,
-		log:                   log,
-		resourceGroupResolver: resolver,
+		manager:                 manager,
+		log:                     log,
+		resourceGroupResolver:   resolver,
+		behaviorProfileResolver: behaviorProfileResolver,
 	}
 
+	svr := grpc.NewServer(grpc.UnaryInterceptor(baseSvr.behaviorProfileInterceptor))
+
 	clusterServerSrv := &clusterServerServer{
 		baseServer: baseSvr,
 	}
@@ -85,7 +114,27 @@ func (m *maintenanceServer) Alarm(ctx context.Context, _ *pb.AlarmRequest) (*pb.
 
 	m.log.V(4).Info("Etcd: Alarm", "resourceGroup", resourceGroup, "etcdMember", etcdMember)
 
-	return &pb.AlarmResponse{}, nil
+	profile := m.getBehaviorProfile(ctx)
+	if len(profile.Alarms) == 0 {
+		return &pb.AlarmResponse{}, nil
+	}
+
+	inmemoryClient := m.manager.GetResourceGroup(resourceGroup).GetClient()
+	memberList, _, err := m.inspectEtcd(ctx, inmemoryClient, etcdMember)
+	if err != nil {
+		return nil, err
+	}
+
+	alarmResponse := &pb.AlarmResponse{Header: memberList.Header}
+	for _, member := range memberList.Members {
+		for _, alarm := range profile.Alarms {
+			alarmResponse.Alarms = append(alarmResponse.Alarms, &pb.AlarmMember{
+				MemberID: member.ID,
+				Alarm:    alarm,
+			})
+		}
+	}
+	return alarmResponse, nil
 }
 
 func (m *maintenanceServer) Status(ctx context.Context, _ *pb.StatusRequest) (*pb.StatusResponse, error) {
@@ -104,11 +153,23 @@ func (m *maintenanceServer) Status(ctx context.Context, _ *pb.StatusRequest) (*p
 	inmemoryClient := m.manager.GetResourceGroup(resourceGroup).GetClient()
 
 	m.log.V(4).Info("Etcd: Status", "resourceGroup", resourceGroup, "etcdMember", etcdMember)
-	_, statusResponse, err := m.inspectEtcd(ctx, inmemoryClient, etcdMember)
+	memberList, statusResponse, err := m.inspectEtcd(ctx, inmemoryClient, etcdMember)
 	if err != nil {
 		return nil, err
 	}
 
+	profile := m.getBehaviorProfile(ctx)
+	statusResponse.RaftIndex = leaderRaftIndex
+	for _, member := range memberList.Members {
+		if member.Name == etcdMember && member.ID != statusResponse.Leader {
+			statusResponse.RaftIndex -= min(profile.MemberLag, leaderRaftIndex)
+		}
+	}
+	statusResponse.RaftAppliedIndex = statusResponse.RaftIndex
+	for _, alarm := range profile.Alarms {
+		statusResponse.Errors = append(statusResponse.Errors, fmt.Sprintf("alarm:%s", alarm))
+	}
+
 	return statusResponse, nil
 }
 
@@ -267,9 +328,38 @@ func (c *clusterServerServer) MemberPromote(_ context.Context, _ *pb.MemberPromo
 }
 
 type baseServer struct {
-	manager               inmemoryruntime.Manager
-	log                   logr.Logger
-	resourceGroupResolver ResourceGroupResolver
+	manager                 inmemoryruntime.Manager
+	log                     logr.Logger
+	resourceGroupResolver   ResourceGroupResolver
+	behaviorProfileResolver BehaviorProfileResolver
+}
+
+func (b *baseServer) getBehaviorProfile(ctx context.Context) BehaviorProfile {
+	if b.behaviorProfileResolver == nil {
+		return BehaviorProfile{}
+	}
+	localAddr := ctx.Value(http.LocalAddrContextKey)
+	return b.behaviorProfileResolver(fmt.Sprintf("%s", localAddr))
+}
+
+// behaviorProfileInterceptor applies the ResponseDelay and the ErrorPercentage of the BehaviorProfile
+// of the workload cluster a request targets.
+func (b *baseServer) behaviorProfileInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	profile := b.getBehaviorProfile(ctx)
+	if profile.ResponseDelay > 0 {
+		select {
+		case <-time.After(profile.ResponseDelay):
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	if profile.ErrorPercentage > 0 && rand.Int32N(100) < profile.ErrorPercentage { //nolint:gosec // No need for a cryptographically secure random number here.
+		b.log.V(4).Info("Failing etcd request because of behavior profile", "method", info.FullMethod)
+		return nil, status.Errorf(codes.Unavailable, "injected error: %s failed because of the behavior profile of the workload cluster", info.FullMethod)
+	}
+
+	return handler(ctx, req)
 }
 
 func (b *baseServer) getResourceGroupAndMember(ctx context.Context) (resourceGroup string, etcdMember string, err error) {
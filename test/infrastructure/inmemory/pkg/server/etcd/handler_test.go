@@ -24,7 +24,10 @@ import (
 
 	. "github.com/onsi/gomega"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -119,3 +122,79 @@ func Test_etcd_scalingflow(t *testing.T) {
 		g.Expect(members.GetMembers()).NotTo(ContainElement(fmt.Sprintf("etcd-%d", etcdMemberToRemove)))
 	})
 }
+
+func Test_etcd_behaviorProfile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	g := NewWithT(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{":authority": "etcd-2"}))
+	manager := inmemoryruntime.NewManager(scheme)
+	profile := BehaviorProfile{
+		MemberLag: 10,
+		Alarms:    []pb.AlarmType{pb.AlarmType_NOSPACE},
+	}
+	m := &maintenanceServer{
+		baseServer: &baseServer{
+			log:                     log.FromContext(ctx),
+			manager:                 manager,
+			resourceGroupResolver:   func(string) (string, error) { return "group1", nil },
+			behaviorProfileResolver: func(string) BehaviorProfile { return profile },
+		},
+	}
+	m.manager.AddResourceGroup("group1")
+	inmemoryClient := m.manager.GetResourceGroup("group1").GetClient()
+
+	for i := 1; i <= 3; i++ {
+		etcdPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceSystem,
+				Name:      fmt.Sprintf("etcd-%d", i),
+				Labels: map[string]string{
+					"component": "etcd",
+					"tier":      "control-plane",
+				},
+				Annotations: map[string]string{
+					cloudv1.EtcdMemberIDAnnotationName:  fmt.Sprintf("%d", i),
+					cloudv1.EtcdClusterIDAnnotationName: "15",
+				},
+			},
+		}
+		if i == 1 {
+			etcdPod.Annotations[cloudv1.EtcdLeaderFromAnnotationName] = time.Date(2020, 07, 03, 14, 25, 58, 651387237, time.UTC).Format(time.RFC3339)
+		}
+		g.Expect(inmemoryClient.Create(ctx, etcdPod)).To(Succeed())
+	}
+
+	t.Run("followers are lagging behind the leader", func(*testing.T) {
+		followerStatus, err := m.Status(ctx, &pb.StatusRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(followerStatus.Leader).To(Equal(uint64(1)))
+		g.Expect(followerStatus.RaftIndex).To(Equal(uint64(leaderRaftIndex - 10)))
+
+		leaderCtx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{":authority": "etcd-1"}))
+		leaderStatus, err := m.Status(leaderCtx, &pb.StatusRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(leaderStatus.RaftIndex).To(Equal(uint64(leaderRaftIndex)))
+	})
+
+	t.Run("alarms are reported for all members", func(*testing.T) {
+		alarms, err := m.Alarm(ctx, &pb.AlarmRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(alarms.Alarms).To(ConsistOf(
+			&pb.AlarmMember{MemberID: 1, Alarm: pb.AlarmType_NOSPACE},
+			&pb.AlarmMember{MemberID: 2, Alarm: pb.AlarmType_NOSPACE},
+			&pb.AlarmMember{MemberID: 3, Alarm: pb.AlarmType_NOSPACE},
+		))
+	})
+
+	t.Run("requests fail according to the error percentage", func(*testing.T) {
+		profile.ErrorPercentage = 100
+		defer func() { profile.ErrorPercentage = 0 }()
+
+		handler := func(context.Context, any) (any, error) { return &pb.StatusResponse{}, nil }
+		_, err := m.behaviorProfileInterceptor(ctx, &pb.StatusRequest{}, &grpc.UnaryServerInfo{FullMethod: "/etcdserverpb.Maintenance/Status"}, handler)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(status.Code(err)).To(Equal(codes.Unavailable))
+	})
+}
@@ -31,9 +31,18 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
+	inmemoryapi "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/api"
+	inmemoryetcd "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/etcd"
 	"sigs.k8s.io/cluster-api/util/certs"
 )
 
+// BehaviorProfile defines how the API server and the etcd members of a workload cluster deviate
+// from healthy components, e.g. to allow scale and chaos testing without real infrastructure.
+type BehaviorProfile struct {
+	APIServer inmemoryapi.BehaviorProfile
+	Etcd      inmemoryetcd.BehaviorProfile
+}
+
 // WorkloadClusterListener represents a listener for a workload cluster.
 type WorkloadClusterListener struct {
 	host string
@@ -41,6 +50,8 @@ type WorkloadClusterListener struct {
 
 	resourceGroup string
 
+	behaviorProfile BehaviorProfile
+
 	scheme *runtime.Scheme
 
 	apiServers                  sets.Set[string]
@@ -72,6 +83,11 @@ func (s *WorkloadClusterListener) ResourceGroup() string {
 	return s.resourceGroup
 }
 
+// BehaviorProfile returns the BehaviorProfile applied to requests served by a WorkloadClusterListener.
+func (s *WorkloadClusterListener) BehaviorProfile() BehaviorProfile {
+	return s.behaviorProfile
+}
+
 // Address returns the address of a WorkloadClusterListener.
 func (s *WorkloadClusterListener) Address() string {
 	return fmt.Sprintf("https://%s", s.HostPort())
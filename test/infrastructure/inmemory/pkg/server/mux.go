@@ -201,9 +201,35 @@ func (m *WorkloadClustersMux) mixedHandler() http.Handler {
 		return resourceGroup, nil
 	}
 
+	// build a behaviorProfileResolver func, which returns the BehaviorProfile of the workloadCluster
+	// a request targets; if the workloadCluster cannot be identified, an empty BehaviorProfile is returned
+	// and the request is handled by the resourceGroupResolver as usual.
+	behaviorProfileResolver := func(host string) BehaviorProfile {
+		m.lock.RLock()
+		defer m.lock.RUnlock()
+
+		_, port, err := net.SplitHostPort(host)
+		if err != nil {
+			return BehaviorProfile{}
+		}
+		wclName, ok := m.workloadClusterNameByPort[port]
+		if !ok {
+			return BehaviorProfile{}
+		}
+		wcl, ok := m.workloadClusterListeners[wclName]
+		if !ok {
+			return BehaviorProfile{}
+		}
+		return wcl.BehaviorProfile()
+	}
+
 	// build the handlers for API server and etcd.
-	apiHandler := inmemoryapi.NewAPIServerHandler(m.manager, m.log, resourceGroupResolver)
-	etcdHandler := inmemoryetcd.NewEtcdServerHandler(m.manager, m.log, resourceGroupResolver)
+	apiHandler := inmemoryapi.NewAPIServerHandler(m.manager, m.log, resourceGroupResolver, func(host string) inmemoryapi.BehaviorProfile {
+		return behaviorProfileResolver(host).APIServer
+	})
+	etcdHandler := inmemoryetcd.NewEtcdServerHandler(m.manager, m.log, resourceGroupResolver, func(host string) inmemoryetcd.BehaviorProfile {
+		return behaviorProfileResolver(host).Etcd
+	})
 
 	// Creates the mixed handler combining the two above depending on
 	// the type of request being processed
@@ -308,6 +334,20 @@ func (m *WorkloadClustersMux) RegisterResourceGroup(wclName, resourceGroup strin
 	return nil
 }
 
+// SetBehaviorProfile sets the BehaviorProfile applied to requests served by a WorkloadClusterListener,
+// e.g. to simulate slow or flaky API servers and etcd members.
+func (m *WorkloadClustersMux) SetBehaviorProfile(wclName string, profile BehaviorProfile) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	wcl, ok := m.workloadClusterListeners[wclName]
+	if !ok {
+		return pkgerrors.Errorf("workloadClusterListener with name %s must be initialized before setting a behavior profile", wclName)
+	}
+	wcl.behaviorProfile = profile
+	return nil
+}
+
 // ResourceGroupByWorkloadCluster returns the resource group that host in memory resources for a WorkloadClusterListener.
 func (m *WorkloadClustersMux) ResourceGroupByWorkloadCluster(wclName string) (string, error) {
 	m.lock.Lock()
@@ -51,6 +51,7 @@ import (
 
 	cloudv1 "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/cloud/api/v1alpha1"
 	inmemoryruntime "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/runtime"
+	inmemoryapi "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/api"
 	inmemoryproxy "sigs.k8s.io/cluster-api/test/infrastructure/inmemory/pkg/server/proxy"
 	"sigs.k8s.io/cluster-api/util/certs"
 )
@@ -655,6 +656,41 @@ func TestAPI_unstructured_CRUD(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 }
 
+func TestAPI_BehaviorProfile(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	wcmux, restConfig := setupWorkloadClusterListener(g, getCustomPorts())
+	defer func() { _ = wcmux.Shutdown(ctx) }()
+
+	c, err := getDirectClient(restConfig)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	wcl1 := "workload-cluster1-controlPlaneEndpoint"
+	g.Expect(wcmux.SetBehaviorProfile("not-existing", BehaviorProfile{})).ToNot(Succeed())
+
+	// requests fail when the error percentage is 100.
+	g.Expect(wcmux.SetBehaviorProfile(wcl1, BehaviorProfile{
+		APIServer: inmemoryapi.BehaviorProfile{ErrorPercentage: 100},
+	})).To(Succeed())
+	err = c.List(ctx, &corev1.NodeList{})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(apierrors.IsInternalError(err)).To(BeTrue())
+
+	// requests are delayed by the response delay.
+	responseDelay := 500 * time.Millisecond
+	g.Expect(wcmux.SetBehaviorProfile(wcl1, BehaviorProfile{
+		APIServer: inmemoryapi.BehaviorProfile{ResponseDelay: responseDelay},
+	})).To(Succeed())
+	start := time.Now()
+	g.Expect(c.List(ctx, &corev1.NodeList{})).To(Succeed())
+	g.Expect(time.Since(start)).To(BeNumerically(">=", responseDelay))
+
+	// requests are served as usual when the behavior profile is reset.
+	g.Expect(wcmux.SetBehaviorProfile(wcl1, BehaviorProfile{})).To(Succeed())
+	g.Expect(c.List(ctx, &corev1.NodeList{})).To(Succeed())
+}
+
 func setupWorkloadClusterListener(g Gomega, ports CustomPorts) (*WorkloadClustersMux, *rest.Config) {
 	manager := inmemoryruntime.NewManager(scheme)
 
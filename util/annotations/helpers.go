@@ -19,8 +19,11 @@ package annotations
 
 import (
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -120,6 +123,97 @@ func GetManagedAnnotations(m *clusterv1.Machine, additionalSyncMachineAnnotation
 	return managedAnnotations
 }
 
+// AutoscalerCapacityAnnotations returns the cluster autoscaler annotations used to scale a node group from zero
+// for the resource capacity of a single replica of the node group.
+func AutoscalerCapacityAnnotations(capacity corev1.ResourceList) map[string]string {
+	annotations := map[string]string{}
+	for resourceName, annotation := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:              clusterv1.AutoscalerCapacityCPUAnnotation,
+		corev1.ResourceMemory:           clusterv1.AutoscalerCapacityMemoryAnnotation,
+		corev1.ResourceEphemeralStorage: clusterv1.AutoscalerCapacityEphemeralDiskAnnotation,
+	} {
+		if quantity, ok := capacity[resourceName]; ok {
+			annotations[annotation] = quantity.String()
+		}
+	}
+	if pods, ok := capacity[corev1.ResourcePods]; ok {
+		annotations[clusterv1.AutoscalerCapacityMaxPodsAnnotation] = strconv.FormatInt(pods.Value(), 10)
+	}
+
+	// Note: GPUs are extended resources named <vendor>/gpu, e.g. nvidia.com/gpu; if there are
+	// GPUs of multiple vendors, the first one in alphabetical order is used.
+	gpuResourceNames := []string{}
+	for resourceName, quantity := range capacity {
+		if strings.HasSuffix(string(resourceName), "/gpu") && !quantity.IsZero() {
+			gpuResourceNames = append(gpuResourceNames, string(resourceName))
+		}
+	}
+	if len(gpuResourceNames) > 0 {
+		sort.Strings(gpuResourceNames)
+		gpus := capacity[corev1.ResourceName(gpuResourceNames[0])]
+		annotations[clusterv1.AutoscalerCapacityGPUTypeAnnotation] = gpuResourceNames[0]
+		annotations[clusterv1.AutoscalerCapacityGPUCountAnnotation] = strconv.FormatInt(gpus.Value(), 10)
+	}
+	return annotations
+}
+
+// MergeAutoscalerCapacityAnnotations updates the autoscaler capacity annotations from previousAnnotations computed
+// for the previous capacity to desiredAnnotations computed for the current capacity.
+// Annotations which have been set to a value different from the previously computed one, i.e. by the user,
+// are never changed.
+func MergeAutoscalerCapacityAnnotations(annotations, previousAnnotations, desiredAnnotations map[string]string) map[string]string {
+	keys := []string{
+		clusterv1.AutoscalerCapacityCPUAnnotation,
+		clusterv1.AutoscalerCapacityMemoryAnnotation,
+		clusterv1.AutoscalerCapacityEphemeralDiskAnnotation,
+		clusterv1.AutoscalerCapacityMaxPodsAnnotation,
+		clusterv1.AutoscalerCapacityGPUTypeAnnotation,
+		clusterv1.AutoscalerCapacityGPUCountAnnotation,
+	}
+
+	for _, key := range keys {
+		current, hasCurrent := annotations[key]
+		previous, hasPrevious := previousAnnotations[key]
+		if hasCurrent && (!hasPrevious || current != previous) {
+			continue
+		}
+
+		desired, hasDesired := desiredAnnotations[key]
+		if !hasDesired {
+			delete(annotations, key)
+			continue
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = desired
+	}
+	return annotations
+}
+
+// GetAutoscalerNodeGroupStatus returns the status of the cluster autoscaler node group defined by the min size and
+// max size annotations of the given object, with the given current size; it returns nil if the annotations are not set,
+// if they are not valid or if the current size is not set.
+func GetAutoscalerNodeGroupStatus(o metav1.Object, currentSize *int32) *clusterv1.NodeGroupAutoscalingStatus {
+	if currentSize == nil {
+		return nil
+	}
+	annotations := o.GetAnnotations()
+	minSize, err := strconv.ParseInt(annotations[clusterv1.AutoscalerMinSizeAnnotation], 10, 32)
+	if err != nil || minSize < 0 {
+		return nil
+	}
+	maxSize, err := strconv.ParseInt(annotations[clusterv1.AutoscalerMaxSizeAnnotation], 10, 32)
+	if err != nil || maxSize < minSize {
+		return nil
+	}
+	return &clusterv1.NodeGroupAutoscalingStatus{
+		MinSize:     ptr.To(int32(minSize)),
+		MaxSize:     ptr.To(int32(maxSize)),
+		CurrentSize: ptr.To(*currentSize),
+	}
+}
+
 // hasAnnotation returns true if the object has the specified annotation.
 func hasAnnotation(o metav1.Object, annotation string) bool {
 	annotations := o.GetAnnotations()
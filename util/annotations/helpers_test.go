@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util/test/builder"
@@ -388,6 +389,109 @@ func TestGetManagedAnnotations(t *testing.T) {
 	}
 }
 
+func TestMergeAutoscalerCapacityAnnotations(t *testing.T) {
+	tests := []struct {
+		name                string
+		annotations         map[string]string
+		previousAnnotations map[string]string
+		desiredAnnotations  map[string]string
+		expected            map[string]string
+	}{
+		{
+			name:               "Annotations are added",
+			annotations:        nil,
+			desiredAnnotations: map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "2"},
+			expected:           map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "2"},
+		},
+		{
+			name:                "Previously computed annotations are updated or removed",
+			annotations:         map[string]string{"foo": "bar", clusterv1.AutoscalerCapacityCPUAnnotation: "2", clusterv1.AutoscalerCapacityMemoryAnnotation: "4Gi"},
+			previousAnnotations: map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "2", clusterv1.AutoscalerCapacityMemoryAnnotation: "4Gi"},
+			desiredAnnotations:  map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "4"},
+			expected:            map[string]string{"foo": "bar", clusterv1.AutoscalerCapacityCPUAnnotation: "4"},
+		},
+		{
+			name:                "Annotations set by the user are preserved",
+			annotations:         map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "8", clusterv1.AutoscalerCapacityMemoryAnnotation: "16Gi"},
+			previousAnnotations: map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "2"},
+			desiredAnnotations:  map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "4", clusterv1.AutoscalerCapacityMemoryAnnotation: "8Gi"},
+			expected:            map[string]string{clusterv1.AutoscalerCapacityCPUAnnotation: "8", clusterv1.AutoscalerCapacityMemoryAnnotation: "16Gi"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(MergeAutoscalerCapacityAnnotations(tt.annotations, tt.previousAnnotations, tt.desiredAnnotations)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestGetAutoscalerNodeGroupStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		currentSize *int32
+		want        *clusterv1.NodeGroupAutoscalingStatus
+	}{
+		{
+			name: "min size, max size and current size",
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "1",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+			currentSize: ptr.To[int32](3),
+			want: &clusterv1.NodeGroupAutoscalingStatus{
+				MinSize:     ptr.To[int32](1),
+				MaxSize:     ptr.To[int32](5),
+				CurrentSize: ptr.To[int32](3),
+			},
+		},
+		{
+			name: "no max size annotation",
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "1",
+			},
+			currentSize: ptr.To[int32](3),
+			want:        nil,
+		},
+		{
+			name: "invalid min size annotation",
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "one",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+			currentSize: ptr.To[int32](3),
+			want:        nil,
+		},
+		{
+			name: "max size lower than min size",
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "5",
+				clusterv1.AutoscalerMaxSizeAnnotation: "1",
+			},
+			currentSize: ptr.To[int32](3),
+			want:        nil,
+		},
+		{
+			name: "no current size",
+			annotations: map[string]string{
+				clusterv1.AutoscalerMinSizeAnnotation: "1",
+				clusterv1.AutoscalerMaxSizeAnnotation: "5",
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			md := &clusterv1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			g.Expect(GetAutoscalerNodeGroupStatus(md, tt.currentSize)).To(Equal(tt.want))
+		})
+	}
+}
+
 func newFakeMachineSpec(clusterName string) clusterv1.MachineSpec {
 	return clusterv1.MachineSpec{
 		ClusterName: clusterName,
@@ -40,6 +40,14 @@ import (
 // Machines is a set of Machines.
 type Machines map[string]*clusterv1.Machine
 
+// KeyFunc returns a key for a Machine, e.g. its failure domain; it is used to group Machines.
+type KeyFunc func(machine *clusterv1.Machine) string
+
+// ByFailureDomain is a KeyFunc returning the failure domain of a Machine.
+func ByFailureDomain(machine *clusterv1.Machine) string {
+	return machine.Spec.FailureDomain
+}
+
 // MachinesByVersion sorts the list of Machine by spec.version, using their names as tie breaker.
 // machines with no version are placed lower in the order.
 type machinesByVersion []*clusterv1.Machine
@@ -185,11 +193,11 @@ func (s Machines) Len() int {
 	return len(s)
 }
 
-// newFilteredMachineCollection creates a Machines from a filtered list of values.
-func newFilteredMachineCollection(filter Func, machines ...*clusterv1.Machine) Machines {
-	ss := make(Machines, len(machines))
-	for i := range machines {
-		m := machines[i]
+// filter returns a Machines containing only the Machines that match the given filter.
+// Note: Machines are filtered while iterating the collection, to not allocate an intermediate list of Machines.
+func (s Machines) filter(filter Func) Machines {
+	ss := make(Machines, len(s))
+	for _, m := range s {
 		if filter(m) {
 			ss.Insert(m)
 		}
@@ -199,12 +207,26 @@ func newFilteredMachineCollection(filter Func, machines ...*clusterv1.Machine) M
 
 // Filter returns a Machines containing only the Machines that match all of the given MachineFilters.
 func (s Machines) Filter(filters ...Func) Machines {
-	return newFilteredMachineCollection(And(filters...), s.UnsortedList()...)
+	return s.filter(And(filters...))
 }
 
 // AnyFilter returns a Machines containing only the Machines that match any of the given MachineFilters.
 func (s Machines) AnyFilter(filters ...Func) Machines {
-	return newFilteredMachineCollection(Or(filters...), s.UnsortedList()...)
+	return s.filter(Or(filters...))
+}
+
+// GroupBy groups the Machines in this collection by the key computed by the given KeyFunc.
+// e.g. s.GroupBy(ByFailureDomain) returns the Machines in each failure domain.
+func (s Machines) GroupBy(key KeyFunc) map[string]Machines {
+	groups := map[string]Machines{}
+	for _, m := range s {
+		k := key(m)
+		if groups[k] == nil {
+			groups[k] = New()
+		}
+		groups[k].Insert(m)
+	}
+	return groups
 }
 
 // Oldest returns the Machine with the oldest CreationTimestamp.
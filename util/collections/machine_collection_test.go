@@ -17,6 +17,7 @@ limitations under the License.
 package collections_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -68,6 +69,22 @@ func TestMachineCollection(t *testing.T) {
 			g.Expect(c3.Names()).To(ConsistOf("machine-1"))
 		})
 	})
+	t.Run("GroupBy", func(t *testing.T) {
+		t.Run("should group machines by key", func(t *testing.T) {
+			g := NewWithT(t)
+			collection := collections.FromMachines(
+				machine("machine-1", withFailureDomain("fd-1")),
+				machine("machine-2", withFailureDomain("fd-1")),
+				machine("machine-3", withFailureDomain("fd-2")),
+				machine("machine-4", withFailureDomain("fd-3")),
+			)
+			groups := collection.GroupBy(collections.ByFailureDomain)
+			g.Expect(groups).To(HaveLen(3))
+			g.Expect(groups["fd-1"].Names()).To(ConsistOf("machine-1", "machine-2"))
+			g.Expect(groups["fd-2"].Names()).To(ConsistOf("machine-3"))
+			g.Expect(groups["fd-3"].Names()).To(ConsistOf("machine-4"))
+		})
+	})
 	t.Run("Names", func(t *testing.T) {
 		t.Run("should return a slice of names of each machine in the collection", func(t *testing.T) {
 			g := NewWithT(t)
@@ -169,6 +186,12 @@ func withTimestamps(timestamp metav1.Time) machineOpt {
 	}
 }
 
+func withFailureDomain(failureDomain string) machineOpt {
+	return func(m *clusterv1.Machine) {
+		m.Spec.FailureDomain = failureDomain
+	}
+}
+
 func machine(name string, opts ...machineOpt) *clusterv1.Machine {
 	m := &clusterv1.Machine{
 		ObjectMeta: metav1.ObjectMeta{
@@ -190,3 +213,36 @@ func machines() collections.Machines {
 		"machine-3": machine("machine-3", withTimestamps(metav1.Time{Time: time.Date(2018, 03, 02, 03, 04, 05, 06, time.UTC)})),
 	}
 }
+
+func largeMachineCollection(n int) collections.Machines {
+	collection := make(collections.Machines, n)
+	for i := range n {
+		m := machine(fmt.Sprintf("machine-%d", i), withFailureDomain(fmt.Sprintf("fd-%d", i%3)))
+		if i%2 == 0 {
+			m.Status.Conditions = []metav1.Condition{{Type: clusterv1.MachineReadyCondition, Status: metav1.ConditionTrue}}
+		}
+		collection.Insert(m)
+	}
+	return collection
+}
+
+func BenchmarkMachinesFilter(b *testing.B) {
+	collection := largeMachineCollection(10000)
+	filter := collections.MatchesCondition(clusterv1.MachineReadyCondition, metav1.ConditionTrue)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_ = collection.Filter(filter)
+	}
+}
+
+func BenchmarkMachinesGroupBy(b *testing.B) {
+	collection := largeMachineCollection(10000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_ = collection.GroupBy(collections.ByFailureDomain)
+	}
+}
@@ -310,3 +310,15 @@ func HasNode() Func {
 		return machine.Status.NodeRef.IsDefined()
 	}
 }
+
+// MatchesCondition returns a filter to find all machines with the given condition set to the given status.
+// Note: Machines without the condition never match, not even when looking for the Unknown status.
+func MatchesCondition(conditionType string, status metav1.ConditionStatus) Func {
+	return func(machine *clusterv1.Machine) bool {
+		if machine == nil {
+			return false
+		}
+		c := conditions.Get(machine, conditionType)
+		return c != nil && c.Status == status
+	}
+}
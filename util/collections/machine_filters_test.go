@@ -528,6 +528,29 @@ func TestHasUnhealthyControlPlaneComponentCondition(t *testing.T) {
 	})
 }
 
+func TestMatchesCondition(t *testing.T) {
+	t.Run("nil machine returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(collections.MatchesCondition(clusterv1.MachineReadyCondition, metav1.ConditionTrue)(nil)).To(BeFalse())
+	})
+
+	t.Run("machine without the condition returns false", func(t *testing.T) {
+		g := NewWithT(t)
+		machine := &clusterv1.Machine{}
+		g.Expect(collections.MatchesCondition(clusterv1.MachineReadyCondition, metav1.ConditionUnknown)(machine)).To(BeFalse())
+	})
+
+	t.Run("machine with the condition returns true only for the matching status", func(t *testing.T) {
+		g := NewWithT(t)
+		machine := &clusterv1.Machine{}
+		machine.Status.Conditions = []metav1.Condition{
+			{Type: clusterv1.MachineReadyCondition, Status: metav1.ConditionFalse},
+		}
+		g.Expect(collections.MatchesCondition(clusterv1.MachineReadyCondition, metav1.ConditionFalse)(machine)).To(BeTrue())
+		g.Expect(collections.MatchesCondition(clusterv1.MachineReadyCondition, metav1.ConditionTrue)(machine)).To(BeFalse())
+	})
+}
+
 func testControlPlaneMachine(name string) *clusterv1.Machine {
 	owned := true
 	ownedRef := []metav1.OwnerReference{
@@ -18,9 +18,12 @@ package conditions
 
 import (
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	pkgerrors "github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -35,6 +38,8 @@ type AggregateOptions struct {
 	mergeStrategy                  MergeStrategy
 	targetConditionType            string
 	negativePolarityConditionTypes []string
+	getPriorityFunc                func(condition metav1.Condition) MergePriority
+	maxMessageLength               int
 }
 
 // ApplyOptions applies the given list options on these options,
@@ -56,7 +61,10 @@ func (o *AggregateOptions) ApplyOptions(opts []AggregateOption) *AggregateOption
 // By default, the Aggregate condition has the same type of the source condition, but this can be changed by using
 // the TargetConditionType option.
 //
-// Additionally, it is possible to inject custom merge strategies using the CustomMergeStrategy option.
+// Additionally, it is possible to inject custom merge strategies using the CustomMergeStrategy option, or to customize
+// how the default merge strategy determines the priority of each condition using the GetPriorityFunc option.
+//
+// Use the MaxMessageLength option to cap the length of the message of the aggregate condition.
 func NewAggregateCondition[T Getter](sourceObjs []T, sourceConditionType string, opts ...AggregateOption) (*metav1.Condition, error) {
 	if len(sourceObjs) == 0 {
 		return nil, pkgerrors.New("sourceObjs can't be empty")
@@ -74,7 +82,11 @@ func NewAggregateCondition[T Getter](sourceObjs []T, sourceConditionType string,
 	if aggregateOpt.mergeStrategy == nil {
 		// Note: If mergeStrategy is not explicitly set, target condition has negative polarity if source condition has negative polarity
 		targetConditionHasPositivePolarity := !sets.New[string](aggregateOpt.negativePolarityConditionTypes...).Has(sourceConditionType)
-		aggregateOpt.mergeStrategy = DefaultMergeStrategy(TargetConditionHasPositivePolarity(targetConditionHasPositivePolarity), GetPriorityFunc(GetDefaultMergePriorityFunc(aggregateOpt.negativePolarityConditionTypes...)))
+		getPriorityFunc := aggregateOpt.getPriorityFunc
+		if getPriorityFunc == nil {
+			getPriorityFunc = GetDefaultMergePriorityFunc(aggregateOpt.negativePolarityConditionTypes...)
+		}
+		aggregateOpt.mergeStrategy = DefaultMergeStrategy(TargetConditionHasPositivePolarity(targetConditionHasPositivePolarity), GetPriorityFunc(getPriorityFunc))
 	}
 
 	conditionsInScope := make([]ConditionWithOwnerInfo, 0, len(sourceObjs))
@@ -119,7 +131,7 @@ func NewAggregateCondition[T Getter](sourceObjs []T, sourceConditionType string,
 		Type:    aggregateOpt.targetConditionType,
 		Status:  status,
 		Reason:  reason,
-		Message: message,
+		Message: truncateMessage(message, aggregateOpt.maxMessageLength),
 		// NOTE: LastTransitionTime and ObservedGeneration will be set when this condition is added to an object by calling Set.
 	}
 
@@ -136,3 +148,96 @@ func SetAggregateCondition[T Getter](sourceObjs []T, targetObj Setter, condition
 	Set(targetObj, *aggregateCondition)
 	return nil
 }
+
+// NewAggregateConditionFromUnstructured is a convenience method to aggregate a condition from a list of unstructured objects,
+// e.g. provider objects, with conditions following the metav1.Condition contract.
+// It combines UnstructuredGetAll and NewAggregateCondition; objects with malformed conditions are skipped, so a single
+// misbehaving object does not prevent the aggregation of the others.
+func NewAggregateConditionFromUnstructured(sourceObjs []runtime.Unstructured, sourceConditionType string, opts ...AggregateOption) (*metav1.Condition, error) {
+	getters := make([]unstructuredWithConditions, 0, len(sourceObjs))
+	for _, obj := range sourceObjs {
+		conditions, err := UnstructuredGetAll(obj)
+		if err != nil {
+			continue
+		}
+		getters = append(getters, unstructuredWithConditions{Unstructured: obj, conditions: conditions})
+	}
+	return NewAggregateCondition(getters, sourceConditionType, opts...)
+}
+
+// SetAggregateConditionFromUnstructured is a convenience method that calls NewAggregateConditionFromUnstructured to create
+// an aggregate condition from the unstructured source objects, and then calls Set to add the new condition to the target object.
+func SetAggregateConditionFromUnstructured(sourceObjs []runtime.Unstructured, targetObj Setter, conditionType string, opts ...AggregateOption) error {
+	aggregateCondition, err := NewAggregateConditionFromUnstructured(sourceObjs, conditionType, opts...)
+	if err != nil {
+		return err
+	}
+	Set(targetObj, *aggregateCondition)
+	return nil
+}
+
+// unstructuredWithConditions wraps an unstructured object implementing the Getter interface.
+type unstructuredWithConditions struct {
+	runtime.Unstructured
+	conditions []metav1.Condition
+}
+
+func (u unstructuredWithConditions) GetConditions() []metav1.Condition {
+	return u.conditions
+}
+
+func (u unstructuredWithConditions) GetName() string {
+	if obj, ok := u.Unstructured.(metav1.Object); ok {
+		return obj.GetName()
+	}
+	return ""
+}
+
+func (u unstructuredWithConditions) GetLabels() map[string]string {
+	if obj, ok := u.Unstructured.(metav1.Object); ok {
+		return obj.GetLabels()
+	}
+	return nil
+}
+
+// truncateMessage truncates a message to maxLength, if greater than zero.
+// The message is truncated at line boundaries, keeping indented lines together with the line they belong to,
+// and a final line surfacing the number of lines dropped is added, e.g. "... and 2 more".
+func truncateMessage(message string, maxLength int) string {
+	if maxLength <= 0 || len(message) <= maxLength {
+		return message
+	}
+
+	// Group lines, so indented lines are kept together with the line they belong to.
+	groups := []string{}
+	for _, line := range strings.Split(message, "\n") {
+		if len(groups) > 0 && strings.HasPrefix(line, " ") {
+			groups[len(groups)-1] += "\n" + line
+			continue
+		}
+		groups = append(groups, line)
+	}
+
+	for kept := len(groups) - 1; kept > 0; kept-- {
+		truncated := strings.Join(append(groups[:kept:kept], fmt.Sprintf("... and %d more", len(groups)-kept)), "\n")
+		if len(truncated) <= maxLength {
+			return truncated
+		}
+	}
+
+	// If not even the first line fits, cut the message.
+	const ellipsis = "..."
+	if maxLength <= len(ellipsis) {
+		return message[:runeBoundary(message, maxLength)]
+	}
+	return message[:runeBoundary(message, maxLength-len(ellipsis))] + ellipsis
+}
+
+// runeBoundary returns the greatest index not greater than n at which message can be cut
+// without splitting a multi-byte character.
+func runeBoundary(message string, n int) int {
+	for n > 0 && n < len(message) && !utf8.RuneStart(message[n]) {
+		n--
+	}
+	return n
+}
@@ -22,6 +22,8 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util/test/builder"
@@ -422,6 +424,50 @@ func TestAggregate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Custom priority func",
+			conditions: [][]metav1.Condition{
+				{{Type: clusterv1.AvailableCondition, Status: metav1.ConditionFalse, Reason: "Reason-1", Message: "Message-1"}},  // obj0
+				{{Type: clusterv1.AvailableCondition, Status: metav1.ConditionTrue, Reason: "Degraded", Message: "Message-2"}},   // obj1
+				{{Type: clusterv1.AvailableCondition, Status: metav1.ConditionTrue, Reason: "Reason-99", Message: "Message-99"}}, // obj2
+			},
+			conditionType: clusterv1.AvailableCondition,
+			options: []AggregateOption{GetPriorityFunc(func(condition metav1.Condition) MergePriority {
+				// Consider conditions with reason Degraded as issues, and conditions with status False as info.
+				switch {
+				case condition.Reason == "Degraded":
+					return IssueMergePriority
+				case condition.Status == metav1.ConditionFalse:
+					return InfoMergePriority
+				}
+				return GetDefaultMergePriorityFunc()(condition)
+			})},
+			want: &metav1.Condition{
+				Type:    clusterv1.AvailableCondition,
+				Status:  metav1.ConditionFalse,         // False because there is one issue
+				Reason:  issuesReportedReason,          // Using a generic reason
+				Message: "* Phase3Obj obj1: Message-2", // messages from all the issues & unknown conditions (info dropped)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Message is truncated when exceeding the max message length",
+			conditions: [][]metav1.Condition{
+				{{Type: clusterv1.AvailableCondition, Status: metav1.ConditionFalse, Reason: "Reason-1", Message: "Message-1"}}, // obj0
+				{{Type: clusterv1.AvailableCondition, Status: metav1.ConditionFalse, Reason: "Reason-2", Message: "Message-2"}}, // obj1
+				{{Type: clusterv1.AvailableCondition, Status: metav1.ConditionFalse, Reason: "Reason-3", Message: "Message-3"}}, // obj2
+			},
+			conditionType: clusterv1.AvailableCondition,
+			options:       []AggregateOption{MaxMessageLength(60)},
+			want: &metav1.Condition{
+				Type:   clusterv1.AvailableCondition,
+				Status: metav1.ConditionFalse, // False because there are issues
+				Reason: issuesReportedReason,  // Using a generic reason
+				Message: "* Phase3Obj obj0: Message-1\n" +
+					"... and 2 more", // messages truncated to fit the max message length
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -455,3 +501,102 @@ func TestAggregate(t *testing.T) {
 		g.Expect(err).To(HaveOccurred())
 	})
 }
+
+func TestAggregateFromUnstructured(t *testing.T) {
+	g := NewWithT(t)
+
+	objs := []runtime.Unstructured{
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":     "Phase3Obj",
+			"metadata": map[string]interface{}{"name": "obj0"},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": clusterv1.AvailableCondition, "status": "False", "reason": "Reason-1", "message": "Message-1", "lastTransitionTime": "2026-01-01T00:00:00Z"},
+				},
+			},
+		}},
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":     "Phase3Obj",
+			"metadata": map[string]interface{}{"name": "obj1"},
+		}},
+		// Objects with malformed conditions are skipped.
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":     "Phase3Obj",
+			"metadata": map[string]interface{}{"name": "obj2"},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": clusterv1.AvailableCondition, "status": "False", "reason": "Reason-2", "lastTransitionTime": "not-a-time"},
+				},
+			},
+		}},
+	}
+
+	got, err := NewAggregateConditionFromUnstructured(objs, clusterv1.AvailableCondition)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(Equal(&metav1.Condition{
+		Type:   clusterv1.AvailableCondition,
+		Status: metav1.ConditionFalse,
+		Reason: issuesReportedReason,
+		Message: "* Phase3Obj obj0: Message-1\n" +
+			"* Phase3Obj obj1: Condition Available not yet reported",
+	}))
+}
+
+func TestTruncateMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		maxLength int
+		want      string
+	}{
+		{
+			name:      "No cap",
+			message:   "* Line 1\n* Line 2",
+			maxLength: 0,
+			want:      "* Line 1\n* Line 2",
+		},
+		{
+			name:      "Message shorter than the cap",
+			message:   "* Line 1\n* Line 2",
+			maxLength: 100,
+			want:      "* Line 1\n* Line 2",
+		},
+		{
+			name:      "Drop lines exceeding the cap",
+			message:   "* Line 1\n* Line 2\n* Line 3",
+			maxLength: 25,
+			want:      "* Line 1\n... and 2 more",
+		},
+		{
+			name:      "Indented lines are kept together with the line they belong to",
+			message:   "* Line 1:\n  * Line 1.1\n* Line 2 is a long line",
+			maxLength: 40,
+			want:      "* Line 1:\n  * Line 1.1\n... and 1 more",
+		},
+		{
+			name:      "Cut the message if not even the first line fits",
+			message:   "* A very long line",
+			maxLength: 10,
+			want:      "* A ver...",
+		},
+		{
+			name:      "Cut the message without splitting multi-byte characters",
+			message:   "* Ünïcödé line",
+			maxLength: 10,
+			want:      "* Ünï...",
+		},
+		{
+			name:      "Cut the message without splitting multi-byte characters when the cap is shorter than the ellipsis",
+			message:   "ÄÖÜ",
+			maxLength: 3,
+			want:      "Ä",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(truncateMessage(tt.message, tt.maxLength)).To(Equal(tt.want))
+		})
+	}
+}
@@ -40,6 +40,7 @@ type MirrorOptions struct {
 	fallbackStatus      metav1.ConditionStatus
 	fallbackReason      string
 	fallbackMessage     string
+	maxMessageLength    int
 }
 
 // ApplyOptions applies the given list options on these options,
@@ -76,7 +77,7 @@ func newMirrorCondition(sourceCondition *metav1.Condition, sourceConditionType s
 			// NOTE: we are preserving the original transition time (when the underlying condition changed)
 			LastTransitionTime: sourceCondition.LastTransitionTime,
 			Reason:             sourceCondition.Reason,
-			Message:            sourceCondition.Message,
+			Message:            truncateMessage(sourceCondition.Message, mirrorOpt.maxMessageLength),
 			// NOTE: ObservedGeneration will be set when this condition is added to an object by calling Set
 			// (also preserving ObservedGeneration from the source object will be confusing when the mirror conditions shows up in the target object).
 		}
@@ -87,7 +88,7 @@ func newMirrorCondition(sourceCondition *metav1.Condition, sourceConditionType s
 			Type:    mirrorOpt.targetConditionType,
 			Status:  mirrorOpt.fallbackStatus,
 			Reason:  mirrorOpt.fallbackReason,
-			Message: mirrorOpt.fallbackMessage,
+			Message: truncateMessage(mirrorOpt.fallbackMessage, mirrorOpt.maxMessageLength),
 			// NOTE: LastTransitionTime and ObservedGeneration will be set when this condition is added to an object by calling Set.
 		}
 	}
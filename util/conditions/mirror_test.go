@@ -61,6 +61,15 @@ func TestMirrorStatusCondition(t *testing.T) {
 			options:       []MirrorOption{},
 			want:          metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "AllGood!", Message: "", LastTransitionTime: now},
 		},
+		{
+			name: "Mirror a condition with max message length",
+			conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionFalse, Reason: "NotGood", Message: "* Issue 1\n* Issue 2\n* Issue 3", ObservedGeneration: 10, LastTransitionTime: now},
+			},
+			conditionType: "Ready",
+			options:       []MirrorOption{MaxMessageLength(25)},
+			want:          metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "NotGood", Message: "* Issue 1\n... and 2 more", LastTransitionTime: now},
+		},
 		{
 			name:          "Mirror a condition not yet reported",
 			conditions:    []metav1.Condition{},
@@ -113,6 +113,21 @@ func (t CustomMergeStrategy) ApplyToAggregate(opts *AggregateOptions) {
 	opts.mergeStrategy = t
 }
 
+// MaxMessageLength allows to cap the length of the message of new mirror or aggregate conditions.
+// Messages exceeding the cap are truncated at line boundaries, and a final "... and N more" line is added
+// to surface the number of lines dropped; zero or negative values mean no cap.
+type MaxMessageLength int
+
+// ApplyToMirror applies this configuration to the given mirror options.
+func (m MaxMessageLength) ApplyToMirror(opts *MirrorOptions) {
+	opts.maxMessageLength = int(m)
+}
+
+// ApplyToAggregate applies this configuration to the given aggregate options.
+func (m MaxMessageLength) ApplyToAggregate(opts *AggregateOptions) {
+	opts.maxMessageLength = int(m)
+}
+
 // OwnedConditionTypes allows to define condition types owned by the controller when performing patch apply.
 // In case of conflicts for the owned conditions, the patch helper will always use the value provided by the controller.
 type OwnedConditionTypes []string
@@ -139,6 +154,12 @@ func (f GetPriorityFunc) ApplyToDefaultMergeStrategy(opts *DefaultMergeStrategyO
 	opts.getPriorityFunc = f
 }
 
+// ApplyToAggregate applies this configuration to the given aggregate options.
+// Note: The GetPriorityFunc is ignored if a CustomMergeStrategy is used.
+func (f GetPriorityFunc) ApplyToAggregate(opts *AggregateOptions) {
+	opts.getPriorityFunc = f
+}
+
 // TargetConditionHasPositivePolarity defines the polarity of the condition returned by the DefaultMergeStrategy.
 type TargetConditionHasPositivePolarity bool
 
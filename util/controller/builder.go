@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +43,7 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/cache"
 	predicatesutil "sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -57,8 +59,14 @@ type Builder struct {
 	predicateLog      logr.Logger
 	options           controller.TypedOptions[reconcile.Request]
 	forObject         client.Object
+	forOpts           []builder.ForOption
 	controllerName    string
 	rateLimitInterval time.Duration
+	priorityFunc      PriorityFunc
+	withoutSharding   bool
+
+	clusterRateLimiter *ClusterRateLimiter
+	sharder            *shard.Sharder
 }
 
 // NewControllerManagedBy returns a new controller builder that will be started by the provided Manager.
@@ -73,8 +81,9 @@ func NewControllerManagedBy(m manager.Manager, predicateLog logr.Logger) *Builde
 // For defines the type of Object being *reconciled*, and configures the ControllerManagedBy to respond to create / delete /
 // update events by *reconciling the object*.
 func (blder *Builder) For(object client.Object, opts ...builder.ForOption) *Builder {
+	// Note: The For object is passed to the underlying builder in Build, so a predicate for sharding can be added.
 	blder.forObject = object
-	blder.builder.For(object, opts...)
+	blder.forOpts = opts
 	return blder
 }
 
@@ -130,6 +139,36 @@ func (blder *Builder) WithRateLimitInterval(interval time.Duration) *Builder {
 	return blder
 }
 
+// WithPriority sets a func to compute the priority of requests based on the For object, e.g. to reconcile
+// objects that are being deleted before routine reconciles.
+// Note: The priority is only used if the PriorityQueue feature gate is enabled.
+func (blder *Builder) WithPriority(priorityFunc PriorityFunc) *Builder {
+	blder.priorityFunc = priorityFunc
+	return blder
+}
+
+// WithClusterRateLimiter limits the reconciles per Cluster of the For object with the given ClusterRateLimiter.
+// Note: A nil ClusterRateLimiter disables rate-limiting per Cluster.
+func (blder *Builder) WithClusterRateLimiter(clusterRateLimiter *ClusterRateLimiter) *Builder {
+	blder.clusterRateLimiter = clusterRateLimiter
+	return blder
+}
+
+// WithSharder only reconciles For objects of the shard of this replica, as computed by the given Sharder.
+// Note: A nil Sharder disables sharding.
+func (blder *Builder) WithSharder(sharder *shard.Sharder) *Builder {
+	blder.sharder = sharder
+	return blder
+}
+
+// WithoutSharding marks the controller to reconcile all objects on every replica, e.g. because the For objects
+// are cluster-scoped or every replica needs the state built up while reconciling them.
+// Note: WithoutSharding takes precedence over WithSharder.
+func (blder *Builder) WithoutSharding() *Builder {
+	blder.withoutSharding = true
+	return blder
+}
+
 // WithEventFilter sets the event filters, to filter which create/update/delete/generic events eventually
 // trigger reconciliations. For example, filtering on whether the resource version has changed.
 func (blder *Builder) WithEventFilter(p predicate.Predicate) *Builder {
@@ -238,6 +277,38 @@ func (blder *Builder) Build(ctx context.Context, r reconcile.TypedReconciler[rec
 		blder.options.RateLimiter = queueRateLimiter
 	}
 
+	// Use a priority queue which computes the priority of requests based on the For object.
+	// Note: The priorities are recorded by a predicate of the For watch. The predicate is prepended,
+	// so it observes the events of the For object before other predicates filter them.
+	if blder.priorityFunc != nil && hasGVK && feature.Gates.Enabled(feature.PriorityQueue) && blder.options.NewQueue == nil {
+		queueLog := blder.mgr.GetLogger().WithValues("controller", controllerName)
+		objectPriorities := newObjectPriorities(blder.priorityFunc)
+		blder.forOpts = append([]builder.ForOption{builder.WithPredicates(objectPriorities.Predicate())}, blder.forOpts...)
+		blder.options.NewQueue = func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+			return newPriorityQueue(controllerName, queueLog, rateLimiter, objectPriorities)
+		}
+	}
+
+	// Only reconcile objects of the shard of this replica, if sharding is enabled.
+	// Note: The predicate is only added to the For object, because secondary objects like e.g. ClusterClasses
+	// might belong to a different shard than the For objects they are mapped to. Requests for For objects of
+	// other shards that are enqueued by secondary watches are dropped by the reconcilerWrapper.
+	// Note: The predicate is prepended, so it records the shard of For objects before other predicates filter events.
+	var objectShardFilter *shardFilter
+	if blder.sharder != nil && hasGVK && !blder.withoutSharding {
+		objectShardFilter = newShardFilter(blder.sharder)
+		blder.forOpts = append([]builder.ForOption{builder.WithPredicates(objectShardFilter.Predicate(ctx, blder.predicateLog))}, blder.forOpts...)
+	}
+	if hasGVK {
+		blder.builder.For(blder.forObject, blder.forOpts...)
+	}
+
+	// Rate-limiting per Cluster requires the For object to determine the Cluster of a request.
+	var clusterRateLimiter *ClusterRateLimiter
+	if hasGVK {
+		clusterRateLimiter = blder.clusterRateLimiter
+	}
+
 	// Passing the options to the underlying builder here because we modified them above.
 	blder.builder.WithOptions(blder.options)
 
@@ -248,12 +319,16 @@ func (blder *Builder) Build(ctx context.Context, r reconcile.TypedReconciler[rec
 	consistencyStore := newConsistencyStore(blder.mgr.GetScheme(), blder.mgr.GetCache())
 
 	c, err := blder.builder.Build(&reconcilerWrapper{
-		name:              controllerName,
-		reconciler:        r,
-		reconcileCache:    reconcileCache,
-		rateLimitInterval: rateLimitInterval,
-		queueRateLimiter:  queueRateLimiter,
-		consistencyStore:  consistencyStore,
+		name:               controllerName,
+		reconciler:         r,
+		reconcileCache:     reconcileCache,
+		rateLimitInterval:  rateLimitInterval,
+		queueRateLimiter:   queueRateLimiter,
+		consistencyStore:   consistencyStore,
+		reader:             blder.mgr.GetClient(),
+		forObject:          blder.forObject,
+		shardFilter:        objectShardFilter,
+		clusterRateLimiter: clusterRateLimiter,
 	})
 	if err != nil {
 		return nil, err
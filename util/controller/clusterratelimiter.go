@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// clusterRateLimiterPruneInterval is the interval in which idle rate limiters are dropped.
+const clusterRateLimiterPruneInterval = 10 * time.Minute
+
+// ClusterRateLimiter limits the number of reconciles per second for the objects of a single Cluster
+// with a token bucket per Cluster, e.g. to prevent a flapping Cluster from saturating its ClusterCache
+// connection and starving other Clusters.
+// Note: The same ClusterRateLimiter can be used by multiple controllers, in this case the limit applies
+// to the sum of the reconciles of all controllers for a Cluster.
+type ClusterRateLimiter struct {
+	qps   rate.Limit
+	burst int
+
+	lock      sync.Mutex
+	limiters  map[client.ObjectKey]*rate.Limiter
+	lastPrune time.Time
+
+	// Configurable for testing.
+	now func() time.Time
+}
+
+// NewClusterRateLimiter returns a ClusterRateLimiter which allows qps reconciles per second and
+// bursts of up to burst reconciles per Cluster.
+func NewClusterRateLimiter(qps float64, burst int) *ClusterRateLimiter {
+	return &ClusterRateLimiter{
+		qps:      rate.Limit(qps),
+		burst:    burst,
+		limiters: map[client.ObjectKey]*rate.Limiter{},
+		now:      time.Now,
+	}
+}
+
+// When returns how long a reconcile for an object of the Cluster has to wait, 0 if it can be run now.
+// Note: A token is only taken if the reconcile can be run now, so requests that are requeued after
+// the returned duration don't take tokens twice.
+func (l *ClusterRateLimiter) When(cluster client.ObjectKey) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.pruneLocked(now)
+
+	limiter, ok := l.limiters[cluster]
+	if !ok {
+		limiter = rate.NewLimiter(l.qps, l.burst)
+		l.limiters[cluster] = limiter
+	}
+
+	reservation := limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		// Can only happen if burst is 0, i.e. no reconciles are allowed at all; retry after one second.
+		return time.Second
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// Give the token back, the reconcile is requeued and will try to take a token again.
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// pruneLocked drops rate limiters of Clusters without recent reconciles, i.e. with a full bucket,
+// so rate limiters of deleted Clusters don't accumulate.
+func (l *ClusterRateLimiter) pruneLocked(now time.Time) {
+	if now.Sub(l.lastPrune) < clusterRateLimiterPruneInterval {
+		return
+	}
+	l.lastPrune = now
+	for cluster, limiter := range l.limiters {
+		if limiter.TokensAt(now) >= float64(l.burst) {
+			delete(l.limiters, cluster)
+		}
+	}
+}
+
+// clusterKeyForObject returns the key of the Cluster an object belongs to, based on the cluster-name label
+// or on the owner references of the object.
+func clusterKeyForObject(obj client.Object) (client.ObjectKey, bool) {
+	if clusterName, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]; ok && clusterName != "" {
+		return client.ObjectKey{Namespace: obj.GetNamespace(), Name: clusterName}, true
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		if ref.Kind == "Cluster" && gv.Group == clusterv1.GroupVersion.Group {
+			return client.ObjectKey{Namespace: obj.GetNamespace(), Name: ref.Name}, true
+		}
+	}
+	return client.ObjectKey{}, false
+}
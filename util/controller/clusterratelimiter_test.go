@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestClusterRateLimiter(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	l := NewClusterRateLimiter(1, 2)
+	l.now = func() time.Time { return now }
+
+	cluster1 := client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster1"}
+	cluster2 := client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster2"}
+
+	// The burst is allowed immediately.
+	g.Expect(l.When(cluster1)).To(Equal(time.Duration(0)))
+	g.Expect(l.When(cluster1)).To(Equal(time.Duration(0)))
+
+	// Afterward reconciles are delayed; delayed reconciles don't take tokens.
+	g.Expect(l.When(cluster1)).To(Equal(time.Second))
+	g.Expect(l.When(cluster1)).To(Equal(time.Second))
+
+	// Other Clusters are not affected.
+	g.Expect(l.When(cluster2)).To(Equal(time.Duration(0)))
+
+	// After one second one reconcile is allowed again.
+	now = now.Add(time.Second)
+	g.Expect(l.When(cluster1)).To(Equal(time.Duration(0)))
+	g.Expect(l.When(cluster1)).To(Equal(time.Second))
+
+	// Rate limiters of idle Clusters are dropped.
+	now = now.Add(clusterRateLimiterPruneInterval)
+	g.Expect(l.When(cluster1)).To(Equal(time.Duration(0)))
+	g.Expect(l.limiters).To(HaveLen(1))
+	g.Expect(l.limiters).To(HaveKey(cluster1))
+}
+
+func TestClusterKeyForObject(t *testing.T) {
+	g := NewWithT(t)
+
+	_, ok := clusterKeyForObject(&clusterv1.Machine{})
+	g.Expect(ok).To(BeFalse())
+
+	key, ok := clusterKeyForObject(&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster1"},
+	}})
+	g.Expect(ok).To(BeTrue())
+	g.Expect(key).To(Equal(client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster1"}))
+
+	key, ok = clusterKeyForObject(&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		OwnerReferences: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "Cluster", Name: "not-a-cluster"},
+			{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: "cluster2"},
+		},
+	}})
+	g.Expect(ok).To(BeTrue())
+	g.Expect(key).To(Equal(client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster2"}))
+}
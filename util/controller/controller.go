@@ -33,6 +33,7 @@ import (
 
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/tracing"
 )
 
 const requeueDurationStaleCache = 100 * time.Millisecond
@@ -46,11 +47,23 @@ type reconcilerWrapper struct {
 	rateLimitInterval time.Duration
 	queueRateLimiter  *typedItemExponentialFailureRateLimiter[reconcile.Request]
 	consistencyStore  consistencyStore
+
+	// shardFilter is used to drop requests for objects of other shards.
+	shardFilter *shardFilter
+
+	// clusterRateLimiter, reader and forObject are used to rate-limit reconciles per Cluster.
+	clusterRateLimiter *ClusterRateLimiter
+	reader             client.Reader
+	forObject          client.Object
 }
 
 func (r *reconcilerWrapper) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	if result, ok := r.checkReconcile(ctx, req); !ok {
+		return result, nil
+	}
+
 	if !feature.Gates.Enabled(feature.ReconcilerRateLimiting) {
-		return r.reconciler.Reconcile(ctx, req)
+		return r.reconcile(ctx, req)
 	}
 
 	reconcileStartTime := time.Now()
@@ -95,7 +108,7 @@ func (r *reconcilerWrapper) Reconcile(ctx context.Context, req reconcile.Request
 		reconcileTime.WithLabelValues(r.name).Observe(time.Since(reconcileStartTime).Seconds())
 	}()
 
-	result, err := r.reconciler.Reconcile(ctx, req)
+	result, err := r.reconcile(ctx, req)
 	if err != nil {
 		// Note: controller-runtime logs a warning if an error is returned in combination with
 		// RequeueAfter / Requeue. Dropping RequeueAfter and Requeue here to avoid this warning
@@ -132,6 +145,20 @@ func (r *reconcilerWrapper) Reconcile(ctx context.Context, req reconcile.Request
 	return result, err
 }
 
+// reconcile calls the wrapped reconciler within a tracing span for the request.
+func (r *reconcilerWrapper) reconcile(ctx context.Context, req reconcile.Request) (_ reconcile.Result, reterr error) {
+	ctx, span := tracing.Start(ctx, r.name+".Reconcile",
+		tracing.ControllerKey.String(r.name),
+		tracing.NamespaceKey.String(req.Namespace),
+		tracing.NameKey.String(req.Name),
+	)
+	defer func() {
+		tracing.End(span, reterr)
+	}()
+
+	return r.reconciler.Reconcile(ctx, req)
+}
+
 type controllerWrapper struct {
 	controller.TypedController[reconcile.Request]
 	reconcileCache   cache.Cache[reconcileCacheEntry]
@@ -225,3 +252,28 @@ func (s *atMostEvery) Do(fn func()) bool {
 	fn()
 	return true
 }
+
+// checkReconcile returns false if the request should not be reconciled, either because the For object
+// of the request belongs to another shard or because the reconciles for the Cluster of the object are rate-limited.
+// In the latter case the returned Result requeues the request once it can be reconciled.
+// Note: Requests for objects that can't be read are always reconciled, e.g. to handle not found errors.
+func (r *reconcilerWrapper) checkReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, bool) {
+	if r.shardFilter != nil && !r.shardFilter.InShard(req.NamespacedName) {
+		return reconcile.Result{}, false
+	}
+
+	if r.clusterRateLimiter == nil {
+		return reconcile.Result{}, true
+	}
+	obj := r.forObject.DeepCopyObject().(client.Object)
+	if err := r.reader.Get(ctx, req.NamespacedName, obj); err != nil {
+		return reconcile.Result{}, true
+	}
+	if cluster, ok := clusterKeyForObject(obj); ok {
+		if delay := r.clusterRateLimiter.When(cluster); delay > 0 {
+			reconcileClusterRateLimitedTotal.WithLabelValues(r.name).Inc()
+			return reconcile.Result{RequeueAfter: delay}, false
+		}
+	}
+	return reconcile.Result{}, true
+}
@@ -60,6 +60,33 @@ var (
 		Name: "capi_reconcile_stale_cache_skips_total",
 		Help: "Total number of reconciles skipped due to a stale watch cache.",
 	}, []string{"controller", "cached_kind"})
+
+	// reconcileClusterRateLimitedTotal is a prometheus metric that keeps track of how often
+	// reconcile was skipped because the reconciles for the Cluster of an object were rate-limited.
+	reconcileClusterRateLimitedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "capi_reconcile_cluster_rate_limited_total",
+		Help: "Total number of reconciles delayed due to rate-limiting per Cluster.",
+	}, []string{"controller"})
+
+	// queueDepth is a prometheus metric which keeps track of the number of requests
+	// in the queue of a controller per priority (high, default or low).
+	// Note: The difference to the controller-runtime workqueue_depth metric is that this metric
+	//       also includes requests that are not ready yet, e.g. requests added with RequeueAfter.
+	queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capi_reconcile_queue_depth",
+		Help: "Number of requests in the queue per controller and priority",
+	}, []string{"controller", "priority"})
+
+	// queueAge is a prometheus metric which keeps track of how long requests
+	// are waiting in the queue of a controller per priority (high, default or low) after they became ready.
+	queueAge = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:                            "capi_reconcile_queue_age_seconds",
+		Help:                            "Time requests are waiting in the queue after they became ready per controller and priority",
+		Buckets:                         []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5, 10, 30, 60, 120, 300, 600},
+		NativeHistogramBucketFactor:     1.1,
+		NativeHistogramMaxBucketNumber:  100,
+		NativeHistogramMinResetDuration: 1 * time.Hour,
+	}, []string{"controller", "priority"})
 )
 
 const (
@@ -70,5 +97,5 @@ const (
 )
 
 func init() {
-	metrics.Registry.MustRegister(reconcileTotal, reconcileTime, reconcileStaleCacheSkipsTotal)
+	metrics.Registry.MustRegister(reconcileTotal, reconcileTime, reconcileStaleCacheSkipsTotal, reconcileClusterRateLimitedTotal, queueDepth, queueAge)
 }
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// HighPriority is the priority that should be used for requests that should be reconciled
+	// before routine reconciles, e.g. for objects that are being deleted or that are unhealthy.
+	HighPriority = 100
+
+	// LowPriority is the priority used by controller-runtime for events stemming from the initial
+	// list or from a resync, if the object did not change.
+	LowPriority = handler.LowPriority
+)
+
+// PriorityFunc returns the priority for the object of a request.
+// If nil is returned the priority set by the event handler is used.
+// Note: The returned priority is only used if it is higher than the priority set by the event handler.
+type PriorityFunc func(obj client.Object) *int
+
+// objectPriorities records the priorities computed by a PriorityFunc for the For objects of a controller.
+// Note: The priorities are computed from the objects of the events of the For watch, so the priority queue
+// doesn't have to read the object of every added request. Requests enqueued by secondary watches get the
+// priority computed for the last observed version of the For object.
+type objectPriorities struct {
+	priorityFunc PriorityFunc
+
+	lock       sync.RWMutex
+	priorities map[types.NamespacedName]int
+}
+
+func newObjectPriorities(priorityFunc PriorityFunc) *objectPriorities {
+	return &objectPriorities{
+		priorityFunc: priorityFunc,
+		priorities:   map[types.NamespacedName]int{},
+	}
+}
+
+// Predicate returns a predicate for the For watch which records the priority of the objects of all events.
+// Note: The predicate doesn't filter any event.
+func (p *objectPriorities) Predicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			p.record(e.ObjectNew)
+			return true
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			p.record(e.Object)
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			p.forget(e.Object)
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			p.record(e.Object)
+			return true
+		},
+	}
+}
+
+// Priority returns the priority recorded for the object of a request, nil if no priority has been recorded.
+func (p *objectPriorities) Priority(req types.NamespacedName) *int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if priority, ok := p.priorities[req]; ok {
+		return ptr.To(priority)
+	}
+	return nil
+}
+
+func (p *objectPriorities) record(obj client.Object) {
+	priority := p.priorityFunc(obj)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if priority == nil {
+		delete(p.priorities, client.ObjectKeyFromObject(obj))
+		return
+	}
+	p.priorities[client.ObjectKeyFromObject(obj)] = *priority
+}
+
+func (p *objectPriorities) forget(obj client.Object) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.priorities, client.ObjectKeyFromObject(obj))
+}
+
+// newPriorityQueue returns a priority queue which raises the priority of added requests to the priorities
+// recorded in objectPriorities.
+func newPriorityQueue(controllerName string, log logr.Logger, rateLimiter workqueue.TypedRateLimiter[reconcile.Request],
+	objectPriorities *objectPriorities) priorityqueue.PriorityQueue[reconcile.Request] {
+	return &priorityQueue{
+		PriorityQueue: priorityqueue.New(controllerName, func(o *priorityqueue.Opts[reconcile.Request]) {
+			o.Log = log
+			o.RateLimiter = rateLimiter
+		}),
+		controllerName:   controllerName,
+		objectPriorities: objectPriorities,
+		items:            map[reconcile.Request]queueItem{},
+		now:              time.Now,
+	}
+}
+
+// priorityQueue wraps a controller-runtime priority queue to compute priorities based on
+// the object of a request and to collect per-priority queue metrics.
+type priorityQueue struct {
+	priorityqueue.PriorityQueue[reconcile.Request]
+
+	controllerName   string
+	objectPriorities *objectPriorities
+
+	// items tracks the requests in the queue, it is used to compute the
+	// depth and age metrics.
+	itemsLock sync.Mutex
+	items     map[reconcile.Request]queueItem
+
+	// Configurable for testing.
+	now func() time.Time
+}
+
+type queueItem struct {
+	priority int
+	addedAt  time.Time
+}
+
+// Add adds an item to the queue.
+func (q *priorityQueue) Add(item reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{}, item)
+}
+
+// AddAfter adds an item to the queue after the given duration.
+func (q *priorityQueue) AddAfter(item reconcile.Request, after time.Duration) {
+	q.AddWithOpts(priorityqueue.AddOpts{After: after}, item)
+}
+
+// AddRateLimited adds an item to the queue after the rate limiter says it's ok.
+func (q *priorityQueue) AddRateLimited(item reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{RateLimited: true}, item)
+}
+
+// AddWithOpts adds items to the queue, the priority of each item is raised to the
+// priority recorded for its object if it is higher than the priority in o.
+func (q *priorityQueue) AddWithOpts(o priorityqueue.AddOpts, items ...reconcile.Request) {
+	for _, item := range items {
+		opts := o
+		if priority := q.objectPriorities.Priority(item.NamespacedName); priority != nil && *priority > ptr.Deref(opts.Priority, 0) {
+			opts.Priority = priority
+		}
+		q.trackAdd(item, ptr.Deref(opts.Priority, 0), opts.After)
+		q.PriorityQueue.AddWithOpts(opts, item)
+	}
+}
+
+// Get returns the next item of the queue.
+func (q *priorityQueue) Get() (reconcile.Request, bool) {
+	item, _, shutdown := q.GetWithPriority()
+	return item, shutdown
+}
+
+// GetWithPriority returns the next item of the queue and its priority.
+func (q *priorityQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	item, priority, shutdown := q.PriorityQueue.GetWithPriority()
+	if !shutdown {
+		q.trackGet(item)
+	}
+	return item, priority, shutdown
+}
+
+func (q *priorityQueue) trackAdd(item reconcile.Request, priority int, after time.Duration) {
+	q.itemsLock.Lock()
+	defer q.itemsLock.Unlock()
+
+	addedAt := q.now().Add(after)
+	existing, ok := q.items[item]
+	if !ok {
+		q.items[item] = queueItem{priority: priority, addedAt: addedAt}
+		queueDepth.WithLabelValues(q.controllerName, priorityLabel(priority)).Inc()
+		return
+	}
+
+	// Same as the priority queue, keep the highest priority and the earliest time at which the item is ready.
+	if priority > existing.priority {
+		queueDepth.WithLabelValues(q.controllerName, priorityLabel(existing.priority)).Dec()
+		queueDepth.WithLabelValues(q.controllerName, priorityLabel(priority)).Inc()
+		existing.priority = priority
+	}
+	if addedAt.Before(existing.addedAt) {
+		existing.addedAt = addedAt
+	}
+	q.items[item] = existing
+}
+
+func (q *priorityQueue) trackGet(item reconcile.Request) {
+	q.itemsLock.Lock()
+	defer q.itemsLock.Unlock()
+
+	existing, ok := q.items[item]
+	if !ok {
+		return
+	}
+	delete(q.items, item)
+
+	label := priorityLabel(existing.priority)
+	queueDepth.WithLabelValues(q.controllerName, label).Dec()
+	queueAge.WithLabelValues(q.controllerName, label).Observe(max(q.now().Sub(existing.addedAt), 0).Seconds())
+}
+
+// priorityLabel returns the label used for a priority in metrics.
+// Note: Priorities are grouped to keep the cardinality of the metrics low.
+func priorityLabel(priority int) string {
+	switch {
+	case priority >= HighPriority:
+		return "high"
+	case priority < 0:
+		return "low"
+	default:
+		return "default"
+	}
+}
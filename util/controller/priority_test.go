@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	g := NewWithT(t)
+
+	routine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "routine"}}
+	deleting := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+		Namespace:         metav1.NamespaceDefault,
+		Name:              "deleting",
+		DeletionTimestamp: ptr.To(metav1.Now()),
+		Finalizers:        []string{clusterv1.MachineFinalizer},
+	}}
+	priorityFunc := func(obj client.Object) *int {
+		if !obj.GetDeletionTimestamp().IsZero() {
+			return ptr.To(HighPriority)
+		}
+		return nil
+	}
+
+	// Record the priorities of the objects, as the predicate of the For watch would do.
+	objectPriorities := newObjectPriorities(priorityFunc)
+	p := objectPriorities.Predicate()
+	g.Expect(p.Create(event.CreateEvent{Object: routine})).To(BeTrue())
+	g.Expect(p.Create(event.CreateEvent{Object: deleting})).To(BeTrue())
+
+	controllerName := "test-priority-queue"
+	q := newPriorityQueue(controllerName, ctrl.Log, workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), objectPriorities)
+	defer q.ShutDown()
+
+	routineRequest := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(routine)}
+	deletingRequest := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(deleting)}
+	notFoundRequest := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "not-found"}}
+
+	// Add the routine request first, so it would be returned first without priorities.
+	q.Add(routineRequest)
+	// Priorities set by the event handler are kept if the PriorityFunc doesn't return a higher one.
+	q.AddWithOpts(priorityqueue.AddOpts{Priority: ptr.To(LowPriority)}, notFoundRequest)
+	q.AddWithOpts(priorityqueue.AddOpts{Priority: ptr.To(LowPriority)}, deletingRequest)
+
+	g.Eventually(func() int { return q.Len() }, 5*time.Second).Should(Equal(3))
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues(controllerName, "high"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues(controllerName, "default"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues(controllerName, "low"))).To(Equal(1.0))
+
+	item, priority, _ := q.GetWithPriority()
+	g.Expect(item).To(Equal(deletingRequest))
+	g.Expect(priority).To(Equal(HighPriority))
+	q.Done(item)
+
+	item, priority, _ = q.GetWithPriority()
+	g.Expect(item).To(Equal(routineRequest))
+	g.Expect(priority).To(Equal(0))
+	q.Done(item)
+
+	item, priority, _ = q.GetWithPriority()
+	g.Expect(item).To(Equal(notFoundRequest))
+	g.Expect(priority).To(Equal(LowPriority))
+	q.Done(item)
+
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues(controllerName, "high"))).To(Equal(0.0))
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues(controllerName, "default"))).To(Equal(0.0))
+	g.Expect(testutil.ToFloat64(queueDepth.WithLabelValues(controllerName, "low"))).To(Equal(0.0))
+	g.Expect(testutil.CollectAndCount(queueAge)).To(Equal(3))
+}
+
+func TestObjectPriorities(t *testing.T) {
+	g := NewWithT(t)
+
+	machine := func(deleting bool) client.Object {
+		m := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "machine"}}
+		if deleting {
+			m.DeletionTimestamp = ptr.To(metav1.Now())
+			m.Finalizers = []string{clusterv1.MachineFinalizer}
+		}
+		return m
+	}
+	key := client.ObjectKeyFromObject(machine(false))
+
+	objectPriorities := newObjectPriorities(func(obj client.Object) *int {
+		if !obj.GetDeletionTimestamp().IsZero() {
+			return ptr.To(HighPriority)
+		}
+		return nil
+	})
+	p := objectPriorities.Predicate()
+
+	// No priority is recorded for objects without a priority.
+	g.Expect(p.Create(event.CreateEvent{Object: machine(false)})).To(BeTrue())
+	g.Expect(objectPriorities.Priority(key)).To(BeNil())
+
+	// The priority is updated with the object.
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: machine(false), ObjectNew: machine(true)})).To(BeTrue())
+	g.Expect(objectPriorities.Priority(key)).To(Equal(ptr.To(HighPriority)))
+
+	// Deleted objects are forgotten.
+	g.Expect(p.Delete(event.DeleteEvent{Object: machine(true)})).To(BeTrue())
+	g.Expect(objectPriorities.Priority(key)).To(BeNil())
+}
+
+func TestPriorityLabel(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(priorityLabel(HighPriority)).To(Equal("high"))
+	g.Expect(priorityLabel(HighPriority + 10)).To(Equal("high"))
+	g.Expect(priorityLabel(10)).To(Equal("default"))
+	g.Expect(priorityLabel(0)).To(Equal("default"))
+	g.Expect(priorityLabel(LowPriority)).To(Equal("low"))
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+// shardFilter filters the events and requests of a controller, so that only objects of the shard of this
+// replica are reconciled.
+// Note: The predicate of the For watch records the For objects of other shards, so requests for these objects
+// that are enqueued by secondary watches can be dropped without reading the object and its Cluster again.
+// If the shard of a Cluster changes, the objects of the Cluster are recorded again on their next event,
+// at the latest on the next resync.
+type shardFilter struct {
+	sharder *shard.Sharder
+
+	lock                 sync.RWMutex
+	objectsOfOtherShards sets.Set[types.NamespacedName]
+}
+
+func newShardFilter(sharder *shard.Sharder) *shardFilter {
+	return &shardFilter{
+		sharder:              sharder,
+		objectsOfOtherShards: sets.Set[types.NamespacedName]{},
+	}
+}
+
+// Predicate returns a predicate for the For watch that returns true only if the object belongs to the shard
+// of this replica and records the objects of other shards.
+func (f *shardFilter) Predicate(ctx context.Context, logger logr.Logger) predicate.Funcs {
+	resourceIsInShard := f.sharder.ResourceIsInShard(ctx, logger)
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return f.record(e.ObjectNew, resourceIsInShard.Update(e))
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return f.record(e.Object, resourceIsInShard.Create(e))
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			f.forget(e.Object)
+			return resourceIsInShard.Delete(e)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return f.record(e.Object, resourceIsInShard.Generic(e))
+		},
+	}
+}
+
+// InShard returns false if the object of the request has been recorded as an object of another shard.
+// Note: Requests for objects that have not been recorded are always reconciled, e.g. to handle not found errors.
+func (f *shardFilter) InShard(req types.NamespacedName) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return !f.objectsOfOtherShards.Has(req)
+}
+
+func (f *shardFilter) record(obj client.Object, inShard bool) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if inShard {
+		f.objectsOfOtherShards.Delete(client.ObjectKeyFromObject(obj))
+	} else {
+		f.objectsOfOtherShards.Insert(client.ObjectKeyFromObject(obj))
+	}
+	return inShard
+}
+
+func (f *shardFilter) forget(obj client.Object) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.objectsOfOtherShards.Delete(client.ObjectKeyFromObject(obj))
+}
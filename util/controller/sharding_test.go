@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+func TestShardFilter(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	sharder, err := shard.New(c, scheme, shard.Options{Shard: "a", Shards: []string{"a", "b"}})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	clusterWithShard := func(shard string) client.Object {
+		return &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "cluster",
+			Labels:    map[string]string{clusterv1.ShardLabel: shard},
+		}}
+	}
+	key := client.ObjectKeyFromObject(clusterWithShard("a"))
+
+	f := newShardFilter(sharder)
+	p := f.Predicate(context.Background(), logr.Discard())
+
+	// Objects that have not been observed yet are reconciled.
+	g.Expect(f.InShard(key)).To(BeTrue())
+
+	// Objects of other shards are filtered and their requests are dropped.
+	g.Expect(p.Create(event.CreateEvent{Object: clusterWithShard("b")})).To(BeFalse())
+	g.Expect(f.InShard(key)).To(BeFalse())
+
+	// Objects that are moved to the shard of this replica are reconciled again.
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: clusterWithShard("b"), ObjectNew: clusterWithShard("a")})).To(BeTrue())
+	g.Expect(f.InShard(key)).To(BeTrue())
+
+	g.Expect(p.Generic(event.GenericEvent{Object: clusterWithShard("b")})).To(BeFalse())
+	g.Expect(f.InShard(key)).To(BeFalse())
+
+	// Deleted objects are forgotten.
+	g.Expect(p.Delete(event.DeleteEvent{Object: clusterWithShard("b")})).To(BeFalse())
+	g.Expect(f.InShard(key)).To(BeTrue())
+}
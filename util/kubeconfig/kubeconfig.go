@@ -18,6 +18,7 @@ limitations under the License.
 package kubeconfig
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/x509"
@@ -31,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/cert"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -188,6 +190,43 @@ func NeedsClientCertRotation(configSecret *corev1.Secret, threshold time.Duratio
 	return false, nil
 }
 
+// NeedsCertificateAuthorityUpdate returns whether the certificate authority data of the Kubeconfig secret does not match
+// the certificates stored in the Cluster CA secret, e.g. during a CA rotation.
+func NeedsCertificateAuthorityUpdate(ctx context.Context, c client.Reader, configSecret *corev1.Secret) (bool, error) {
+	clusterName, _, err := secret.ParseSecretName(configSecret.Name)
+	if err != nil {
+		return false, pkgerrors.Wrap(err, "failed to parse secret name")
+	}
+	clusterCA, err := secret.GetFromNamespacedName(ctx, c, client.ObjectKey{Name: clusterName, Namespace: configSecret.Namespace}, secret.ClusterCA)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, ErrDependentCertificateNotFound
+		}
+		return false, err
+	}
+	caData, err := certificateAuthorityData(clusterCA.Data[secret.TLSCrtDataName])
+	if err != nil {
+		return false, err
+	}
+
+	data, err := toKubeconfigBytes(configSecret)
+	if err != nil {
+		return false, err
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return false, pkgerrors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	}
+
+	for _, cluster := range config.Clusters {
+		if !bytes.Equal(cluster.CertificateAuthorityData, caData) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // RegenerateSecret creates and stores a new Kubeconfig in the given secret.
 func RegenerateSecret(ctx context.Context, c client.Client, configSecret *corev1.Secret, options ...KubeConfigOption) error {
 	clusterName, _, err := secret.ParseSecretName(configSecret.Name)
@@ -241,6 +280,13 @@ func generateKubeconfig(ctx context.Context, c client.Client, clusterName client
 		return nil, pkgerrors.Wrap(err, "failed to generate a kubeconfig")
 	}
 
+	// Trust all the certificates in the CA secret, e.g. both the old and the new CA during a CA rotation.
+	caData, err := certificateAuthorityData(clusterCA.Data[secret.TLSCrtDataName])
+	if err != nil {
+		return nil, err
+	}
+	cfg.Clusters[clusterName.Name].CertificateAuthorityData = caData
+
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to serialize config to yaml")
@@ -248,6 +294,19 @@ func generateKubeconfig(ctx context.Context, c client.Client, clusterName client
 	return out, nil
 }
 
+// certificateAuthorityData returns all the certificates in the given PEM data re-encoded in PEM format.
+func certificateAuthorityData(data []byte) ([]byte, error) {
+	caCerts, err := cert.ParseCertsPEM(data)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decode CA Cert")
+	}
+	var out []byte
+	for _, caCert := range caCerts {
+		out = append(out, certs.EncodeCertPEM(caCert)...)
+	}
+	return out, nil
+}
+
 func toKubeconfigBytes(out *corev1.Secret) ([]byte, error) {
 	data, ok := out.Data[secret.KubeconfigDataName]
 	if !ok {
@@ -472,3 +472,47 @@ func TestRegenerateClientCerts(t *testing.T) {
 
 	g.Expect(newCert.NotAfter).To(BeTemporally(">", oldCert.NotAfter))
 }
+
+func TestNeedsCertificateAuthorityUpdate(t *testing.T) {
+	g := NewWithT(t)
+	oldCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	oldCACert, err := getTestCACert(oldCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	newCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	newCACert, err := getTestCACert(newCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test1-ca",
+			Namespace: "test",
+		},
+		Data: map[string][]byte{
+			secret.TLSKeyDataName: certs.EncodePrivateKeyPEM(oldCAKey),
+			secret.TLSCrtDataName: certs.EncodeCertPEM(oldCACert),
+		},
+	}
+	c := fake.NewClientBuilder().WithObjects(caSecret).Build()
+
+	g.Expect(CreateSecretWithOwner(ctx, c, client.ObjectKey{Name: "test1", Namespace: "test"}, "localhost:6443", metav1.OwnerReference{})).To(Succeed())
+	kubeconfigSecret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "test1-kubeconfig", Namespace: "test"}, kubeconfigSecret)).To(Succeed())
+	g.Expect(NeedsCertificateAuthorityUpdate(ctx, c, kubeconfigSecret)).To(BeFalse())
+
+	// Add the new CA to the CA secret, like in the first phase of a CA rotation.
+	caBundle := append(certs.EncodeCertPEM(oldCACert), certs.EncodeCertPEM(newCACert)...)
+	caSecret.Data[secret.TLSCrtDataName] = caBundle
+	g.Expect(c.Update(ctx, caSecret)).To(Succeed())
+	g.Expect(NeedsCertificateAuthorityUpdate(ctx, c, kubeconfigSecret)).To(BeTrue())
+
+	g.Expect(RegenerateSecret(ctx, c, kubeconfigSecret)).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "test1-kubeconfig", Namespace: "test"}, kubeconfigSecret)).To(Succeed())
+	g.Expect(NeedsCertificateAuthorityUpdate(ctx, c, kubeconfigSecret)).To(BeFalse())
+
+	config, err := clientcmd.Load(kubeconfigSecret.Data[secret.KubeconfigDataName])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.Clusters["test1"].CertificateAuthorityData).To(Equal(caBundle))
+}
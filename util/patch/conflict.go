@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConflictError is returned by Helper.Patch, wrapped in an aggregate error (see AsConflictError), when the WithConflictDiff option is set and the patch could not be applied
+// because the object has been modified by a different process in a way that conflicts with the changes of the patch.
+type ConflictError struct {
+	// GroupVersionKind is the GroupVersionKind of the object.
+	GroupVersionKind schema.GroupVersionKind
+
+	// Key is the key of the object.
+	Key client.ObjectKey
+
+	// Fields is the list of fields modified by the patch which have been modified by a different process.
+	Fields []ConflictingField
+
+	// Err is the error which surfaced the conflict.
+	Err error
+}
+
+// ConflictingField is a field modified by the patch which has been modified by a different process.
+// Values are JSON encoded; an empty value means that the field is not set.
+type ConflictingField struct {
+	// Path is the path of the field, e.g. status.conditions[type=Ready].
+	Path string
+
+	// Before is the value of the field when the patch helper has been created.
+	Before string
+
+	// Latest is the value of the field as modified by a different process.
+	Latest string
+
+	// Desired is the value of the field the patch tried to apply.
+	Desired string
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "conflict patching %s %s: %v", e.GroupVersionKind.Kind, klog.KRef(e.Key.Namespace, e.Key.Name), e.Err)
+	for _, f := range e.Fields {
+		fmt.Fprintf(&b, "\n* %s: before %s, latest %s, desired %s", f.Path, orNotSet(f.Before), orNotSet(f.Latest), orNotSet(f.Desired))
+	}
+	return b.String()
+}
+
+// Unwrap returns the error which surfaced the conflict.
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// AsConflictError returns the first ConflictError found in the chain of err, also looking into aggregate errors
+// like the ones returned by Helper.Patch.
+func AsConflictError(err error) (*ConflictError, bool) {
+	var conflictErr *ConflictError
+	if errors.As(err, &conflictErr) {
+		return conflictErr, true
+	}
+	var aggregate kerrors.Aggregate
+	if errors.As(err, &aggregate) {
+		for _, e := range aggregate.Errors() {
+			if conflictErr, ok := AsConflictError(e); ok {
+				return conflictErr, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func orNotSet(v string) string {
+	if v == "" {
+		return "<not set>"
+	}
+	return v
+}
+
+// conditionStateFields are the fields used to determine if two conditions have the same state.
+var conditionStateFields = []string{"status", "reason", "message", "severity"}
+
+// conditionConflicts returns the conditions modified by the patch which have been modified by a different process
+// in the latest object, with a state different from the one the patch tried to apply.
+func conditionConflicts(before, after, latest *unstructured.Unstructured, conditionsFieldPaths ...[]string) []ConflictingField {
+	fields := []ConflictingField{}
+	for _, path := range conditionsFieldPaths {
+		if len(path) == 0 {
+			continue
+		}
+		beforeConditions := conditionsByType(before, path)
+		afterConditions := conditionsByType(after, path)
+		latestConditions := conditionsByType(latest, path)
+
+		conditionTypes := sets.KeySet(beforeConditions).Union(sets.KeySet(afterConditions))
+		for _, conditionType := range sets.List(conditionTypes) {
+			b, a, l := beforeConditions[conditionType], afterConditions[conditionType], latestConditions[conditionType]
+			// Skip conditions not modified by the patch.
+			if sameConditionState(b, a) {
+				continue
+			}
+			// Skip conditions not modified by a different process, or already in the desired state.
+			if sameConditionState(l, b) || sameConditionState(l, a) {
+				continue
+			}
+			fields = append(fields, ConflictingField{
+				Path:    fmt.Sprintf("%s[type=%s]", strings.Join(path, "."), conditionType),
+				Before:  conditionState(b),
+				Latest:  conditionState(l),
+				Desired: conditionState(a),
+			})
+		}
+	}
+	return fields
+}
+
+// conditionsByType returns the conditions at the given path indexed by type.
+func conditionsByType(obj *unstructured.Unstructured, path []string) map[string]map[string]interface{} {
+	res := map[string]map[string]interface{}{}
+	if obj == nil {
+		return res
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, path...)
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, ok := condition["type"].(string)
+		if !ok {
+			continue
+		}
+		res[conditionType] = condition
+	}
+	return res
+}
+
+func sameConditionState(a, b map[string]interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	for _, f := range conditionStateFields {
+		if fmt.Sprint(a[f]) != fmt.Sprint(b[f]) {
+			return false
+		}
+	}
+	return true
+}
+
+func conditionState(c map[string]interface{}) string {
+	if c == nil {
+		return ""
+	}
+	state := map[string]interface{}{}
+	for _, f := range conditionStateFields {
+		if v, ok := c[f]; ok && v != "" {
+			state[f] = v
+		}
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Sprint(state)
+	}
+	return string(data)
+}
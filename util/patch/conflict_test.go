@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestPatchWithConflictDiff(t *testing.T) {
+	g := NewWithT(t)
+
+	machine := newTestMachine()
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(g)).WithObjects(machine).WithStatusSubresource(&clusterv1.Machine{}).Build()
+
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(machine), machine)).To(Succeed())
+	h, err := NewHelper(machine, c)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Change the Ready condition from a different process.
+	other := machine.DeepCopy()
+	conditions.Set(other, metav1.Condition{Type: clusterv1.ReadyCondition, Status: metav1.ConditionFalse, Reason: "OtherReason"})
+	g.Expect(c.Status().Update(context.Background(), other)).To(Succeed())
+
+	// Change the Ready condition in a conflicting way.
+	conditions.Set(machine, metav1.Condition{Type: clusterv1.ReadyCondition, Status: metav1.ConditionTrue, Reason: "MyReason"})
+
+	err = h.Patch(context.Background(), machine, WithConflictDiff{})
+	g.Expect(err).To(HaveOccurred())
+
+	conflictErr, ok := AsConflictError(err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(conflictErr.GroupVersionKind.Kind).To(Equal("Machine"))
+	g.Expect(conflictErr.Key).To(Equal(client.ObjectKeyFromObject(machine)))
+	g.Expect(conflictErr.Fields).To(Equal([]ConflictingField{
+		{
+			Path:    "status.conditions[type=Ready]",
+			Before:  "",
+			Latest:  `{"reason":"OtherReason","status":"False"}`,
+			Desired: `{"reason":"MyReason","status":"True"}`,
+		},
+	}))
+	g.Expect(err.Error()).To(ContainSubstring("status.conditions[type=Ready]: before <not set>"))
+}
+
+func TestPatchWithStatusPatchRetry(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{
+			name:    "Status patch fails on transient errors without retry",
+			opts:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "Status patch is retried on transient errors",
+			opts:    []Option{WithStatusPatchRetry{Backoff: &wait.Backoff{Steps: 3, Duration: time.Millisecond}}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := newTestMachine()
+			failures := 1
+			c := fake.NewClientBuilder().
+				WithScheme(newTestScheme(g)).
+				WithObjects(machine).
+				WithStatusSubresource(&clusterv1.Machine{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						if failures > 0 {
+							failures--
+							return apierrors.NewTooManyRequests("too many requests", 0)
+						}
+						return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+
+			g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(machine), machine)).To(Succeed())
+			h, err := NewHelper(machine, c)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			machine.Status.Phase = string(clusterv1.MachinePhaseRunning)
+			err = h.Patch(context.Background(), machine, tt.opts...)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got := &clusterv1.Machine{}
+			g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(machine), got)).To(Succeed())
+			g.Expect(got.Status.Phase).To(Equal(string(clusterv1.MachinePhaseRunning)))
+		})
+	}
+}
+
+func TestConflictErrorUnwrap(t *testing.T) {
+	g := NewWithT(t)
+
+	err := &ConflictError{
+		GroupVersionKind: schema.GroupVersionKind{Kind: "Machine"},
+		Key:              client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "m1"},
+		Err:              wait.ErrorInterrupted(errors.New("timeout")),
+	}
+	g.Expect(wait.Interrupted(err)).To(BeTrue())
+	g.Expect(err.Error()).To(Equal("conflict patching Machine default/m1: timeout"))
+}
+
+func newTestScheme(g *WithT) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	return scheme
+}
+
+func newTestMachine() *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "m1",
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: "c1",
+		},
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patch
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(patchConflicts)
+}
+
+var (
+	// patchConflicts is a prometheus counter metrics which holds the total number of conflicts
+	// hit by the patch helper, per GroupVersionKind of the patched object and per patch type,
+	// i.e. conditions or status.
+	patchConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "capi_patch_conflicts_total",
+		Help: "Total number of conflicts hit when patching objects",
+	}, []string{"group", "version", "kind", "type"})
+)
+
+func recordConflict(gvk schema.GroupVersionKind, focus string) {
+	patchConflicts.WithLabelValues(gvk.Group, gvk.Version, gvk.Kind, focus).Inc()
+}
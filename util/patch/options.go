@@ -16,7 +16,13 @@ limitations under the License.
 
 package patch
 
-import clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
 
 // Option is some configuration that modifies options for a patch request.
 type Option interface {
@@ -55,6 +61,14 @@ type HelperOptions struct {
 	// is if you pass a wrapper to unstructured.
 	// The override for this option is considered only if the object implements the conditions.Setter interface.
 	Clusterv1ConditionsFieldPath []string
+
+	// ConflictDiff instructs the patch helper to return a ConflictError, reporting a structured diff of the
+	// conflicting fields, when the patch can't be applied because of changes made by a different process.
+	ConflictDiff bool
+
+	// StatusPatchBackoff defines the backoff used to retry status and status conditions patches in case of
+	// conflicts or transient errors; if not set, only the status conditions patch is retried, on conflicts.
+	StatusPatchBackoff *wait.Backoff
 }
 
 // WithForceOverwriteConditions allows the patch helper to overwrite conditions in case of conflicts.
@@ -116,3 +130,36 @@ type Clusterv1ConditionsFieldPath []string
 func (w Clusterv1ConditionsFieldPath) ApplyToHelper(in *HelperOptions) {
 	in.Clusterv1ConditionsFieldPath = w
 }
+
+// WithConflictDiff instructs the patch helper to return a ConflictError, reporting a structured diff of the
+// conflicting fields, when the patch can't be applied because of changes made by a different process.
+type WithConflictDiff struct{}
+
+// ApplyToHelper applies this configuration to the given HelperOptions.
+func (w WithConflictDiff) ApplyToHelper(in *HelperOptions) {
+	in.ConflictDiff = true
+}
+
+// WithStatusPatchRetry instructs the patch helper to retry status and status conditions patches
+// with exponential backoff in case of conflicts or transient errors, e.g. server timeouts.
+// If Backoff is not set, a default backoff is used.
+type WithStatusPatchRetry struct {
+	Backoff *wait.Backoff
+}
+
+// defaultStatusPatchRetryBackoff is the backoff used by WithStatusPatchRetry if no backoff is set.
+var defaultStatusPatchRetryBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// ApplyToHelper applies this configuration to the given HelperOptions.
+func (w WithStatusPatchRetry) ApplyToHelper(in *HelperOptions) {
+	backoff := defaultStatusPatchRetryBackoff
+	if w.Backoff != nil {
+		backoff = *w.Backoff
+	}
+	in.StatusPatchBackoff = &backoff
+}
@@ -36,7 +36,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
-	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
@@ -173,7 +172,7 @@ func (h *Helper) Patch(ctx context.Context, obj client.Object, opts ...Option) e
 	// Given that we pass in metadata.resourceVersion to perform a 3-way-merge conflict resolution,
 	// patching conditions first avoids an extra loop if spec or status patch succeeds first
 	// given that causes the resourceVersion to mutate.
-	if err := h.patchStatusConditions(ctx, obj, options.ForceOverwriteConditions, options.OwnedConditions, options.OwnedV1Beta2Conditions); err != nil {
+	if err := h.patchStatusConditions(ctx, obj, options); err != nil {
 		errs = append(errs, pkgerrors.Wrapf(err, "failed to patch status conditions"))
 	}
 	// Then proceed to patch the rest of the object.
@@ -181,7 +180,7 @@ func (h *Helper) Patch(ctx context.Context, obj client.Object, opts ...Option) e
 		errs = append(errs, pkgerrors.Wrapf(err, "failed to patch spec and metadata"))
 	}
 
-	if err := h.patchStatus(ctx, obj); err != nil {
+	if err := h.patchStatus(ctx, obj, options.StatusPatchBackoff); err != nil {
 		//nolint:staticcheck
 		if !(apierrors.IsNotFound(err) && !obj.GetDeletionTimestamp().IsZero() && len(obj.GetFinalizers()) == 0) {
 			errs = append(errs, pkgerrors.Wrapf(err, "failed to patch status"))
@@ -217,7 +216,8 @@ func (h *Helper) patch(ctx context.Context, obj client.Object) error {
 }
 
 // patchStatus issues a patch if the status has changed.
-func (h *Helper) patchStatus(ctx context.Context, obj client.Object) error {
+// If a backoff is given, the patch is retried in case of conflicts or transient errors.
+func (h *Helper) patchStatus(ctx context.Context, obj client.Object, backoff *wait.Backoff) error {
 	if !h.shouldPatch(statusPatch) {
 		return nil
 	}
@@ -240,7 +240,41 @@ func (h *Helper) patchStatus(ctx context.Context, obj client.Object) error {
 		return nil
 	}
 
-	return h.client.Status().Patch(ctx, afterObject, client.RawPatch(types.MergePatchType, data))
+	if backoff == nil {
+		err := h.client.Status().Patch(ctx, afterObject, client.RawPatch(types.MergePatchType, data))
+		if apierrors.IsConflict(err) {
+			recordConflict(h.gvk, string(statusPatch))
+		}
+		return err
+	}
+
+	var lastErr error
+	err = wait.ExponentialBackoff(*backoff, func() (bool, error) {
+		lastErr = h.client.Status().Patch(ctx, afterObject, client.RawPatch(types.MergePatchType, data))
+		switch {
+		case lastErr == nil:
+			return true, nil
+		case apierrors.IsConflict(lastErr):
+			recordConflict(h.gvk, string(statusPatch))
+			return false, nil
+		case isTransientError(lastErr):
+			return false, nil
+		default:
+			return false, lastErr
+		}
+	})
+	if wait.Interrupted(err) && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// isTransientError returns true for errors that are worth retrying.
+func isTransientError(err error) bool {
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err)
 }
 
 // patchStatusConditions issues a patch if there are any changes to the conditions slice under
@@ -252,7 +286,11 @@ func (h *Helper) patchStatus(ctx context.Context, obj client.Object) error {
 //
 // Condition changes are then applied to the latest version of the object, and if there are
 // no unresolvable conflicts, the patch is sent again.
-func (h *Helper) patchStatusConditions(ctx context.Context, obj client.Object, forceOverwrite bool, ownedConditions []clusterv1.ConditionType, ownedV1beta2Conditions []string) error {
+//
+// If the ConflictDiff option is set, conflicts are returned as a ConflictError reporting the conflicting conditions.
+func (h *Helper) patchStatusConditions(ctx context.Context, obj client.Object, options *HelperOptions) error {
+	forceOverwrite, ownedConditions, ownedV1beta2Conditions := options.ForceOverwriteConditions, options.OwnedConditions, options.OwnedV1Beta2Conditions
+
 	// Nothing to do if the object doesn't have conditions (doesn't have conditions identified as needing a special treatment).
 	if len(h.clusterv1ConditionsFieldPath) == 0 && len(h.metav1ConditionsFieldPath) == 0 {
 		return nil
@@ -346,9 +384,16 @@ func (h *Helper) patchStatusConditions(ctx context.Context, obj client.Object, f
 		Duration: 100 * time.Millisecond,
 		Jitter:   1.0,
 	}
+	if options.StatusPatchBackoff != nil {
+		backoff = *options.StatusPatchBackoff
+	}
+
+	// Track the latest object and if a conflict has been detected, so it is possible to report the conflicting conditions.
+	var latestObj client.Object
+	var mergeConflict, apiConflict bool
 
 	// Start the backoff loop and return errors if any.
-	return wait.ExponentialBackoff(backoff, func() (bool, error) {
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
 		latest, ok := h.beforeObject.DeepCopyObject().(client.Object)
 		if !ok {
 			return false, pkgerrors.Errorf("%s %s doesn't satisfy client.Object, cannot patch", h.gvk.Kind, klog.KObj(h.beforeObject))
@@ -359,17 +404,22 @@ func (h *Helper) patchStatusConditions(ctx context.Context, obj client.Object, f
 			return false, err
 		}
 
+		latestObj = latest.DeepCopyObject().(client.Object)
+
 		// Create the condition patch before merging conditions.
 		conditionsPatch := client.MergeFromWithOptions(latest.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
 
 		// Set the condition patch previously created on the new object.
+		// NOTE: Applying condition patches fails only in case of conflicts.
 		if clusterv1ApplyPatch != nil {
 			if err := clusterv1ApplyPatch(latest); err != nil {
+				mergeConflict = true
 				return false, err
 			}
 		}
 		if metav1ApplyPatch != nil {
 			if err := metav1ApplyPatch(latest); err != nil {
+				mergeConflict = true
 				return false, err
 			}
 		}
@@ -378,6 +428,11 @@ func (h *Helper) patchStatusConditions(ctx context.Context, obj client.Object, f
 		err := h.client.Status().Patch(ctx, latest, conditionsPatch)
 		switch {
 		case apierrors.IsConflict(err):
+			recordConflict(h.gvk, "conditions")
+			apiConflict = true
+			// Requeue.
+			return false, nil
+		case err != nil && options.StatusPatchBackoff != nil && isTransientError(err):
 			// Requeue.
 			return false, nil
 		case err != nil:
@@ -386,6 +441,26 @@ func (h *Helper) patchStatusConditions(ctx context.Context, obj client.Object, f
 			return true, nil
 		}
 	})
+	if err == nil {
+		return nil
+	}
+
+	if mergeConflict {
+		recordConflict(h.gvk, "conditions")
+	}
+	if options.ConflictDiff && latestObj != nil && (mergeConflict || (apiConflict && wait.Interrupted(err))) {
+		latestUnstructured, convertErr := toUnstructured(latestObj, h.gvk)
+		if convertErr != nil {
+			return err
+		}
+		return &ConflictError{
+			GroupVersionKind: h.gvk,
+			Key:              key,
+			Fields:           conditionConflicts(h.before, h.after, latestUnstructured, h.metav1ConditionsFieldPath, h.clusterv1ConditionsFieldPath),
+			Err:              err,
+		}
+	}
+	return err
 }
 
 // calculatePatch returns the before/after objects to be given in a controller-runtime patch, scoped down to the absolute necessary.
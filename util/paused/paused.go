@@ -95,7 +95,11 @@ func pausedCondition(scheme *runtime.Scheme, cluster *clusterv1.Cluster, obj Con
 	if (cluster != nil && ptr.Deref(cluster.Spec.Paused, false)) || annotations.HasPaused(obj) {
 		var messages []string
 		if cluster != nil && ptr.Deref(cluster.Spec.Paused, false) {
-			messages = append(messages, "Cluster spec.paused is set to true")
+			message := "Cluster spec.paused is set to true"
+			if reason := cluster.GetAnnotations()[clusterv1.PausedReasonAnnotation]; reason != "" {
+				message = fmt.Sprintf("%s (reason: %s)", message, reason)
+			}
+			messages = append(messages, message)
 		}
 		if annotations.HasPaused(obj) {
 			kind := "Object"
@@ -148,3 +148,47 @@ func assertCondition(g Gomega, object ConditionSetter, wantIsPaused bool) {
 		g.Expect(condition.Message).To(BeEmpty())
 	}
 }
+
+func TestPausedConditionMessage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(builder.AddTransitionV1Beta2ToScheme(scheme)).To(Succeed())
+
+	tests := []struct {
+		name        string
+		cluster     *clusterv1.Cluster
+		annotations map[string]string
+		wantMessage string
+	}{
+		{
+			name:        "paused cluster",
+			cluster:     &clusterv1.Cluster{Spec: clusterv1.ClusterSpec{Paused: ptr.To(true)}},
+			wantMessage: "Cluster spec.paused is set to true",
+		},
+		{
+			name: "paused cluster with reason",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{clusterv1.PausedReasonAnnotation: "etcd maintenance"}},
+				Spec:       clusterv1.ClusterSpec{Paused: ptr.To(true)},
+			},
+			wantMessage: "Cluster spec.paused is set to true (reason: etcd maintenance)",
+		},
+		{
+			name: "paused reason is ignored if the cluster is not paused",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{clusterv1.PausedReasonAnnotation: "etcd maintenance"}},
+			},
+			annotations: map[string]string{clusterv1.PausedAnnotation: ""},
+			wantMessage: "Phase1Obj has the cluster.x-k8s.io/paused annotation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := &builder.Phase1Obj{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			condition := pausedCondition(scheme, tt.cluster, obj, clusterv1.PausedCondition)
+			g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			g.Expect(condition.Message).To(Equal(tt.wantMessage))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// otherReason is used as value for the reason label of the suppressed events metric
+// for reasons which are not registered, to keep the cardinality of the metric bounded.
+const otherReason = "Other"
+
+var (
+	reasonsLock sync.RWMutex
+	reasons     = sets.Set[string]{}
+
+	suppressedEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "capi_events_suppressed_total",
+			Help: "Total number of events which have not been emitted because an identical event was emitted within the deduplication window.",
+		},
+		[]string{"controller", "reason"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(suppressedEventsTotal)
+}
+
+// RegisterReasons adds reasons to the registry of stable event reasons.
+// Controllers should register all the reasons they emit, so they can be surfaced in metrics.
+func RegisterReasons(r ...string) {
+	reasonsLock.Lock()
+	defer reasonsLock.Unlock()
+	reasons.Insert(r...)
+}
+
+// IsRegisteredReason returns true if the reason has been registered.
+func IsRegisteredReason(reason string) bool {
+	reasonsLock.RLock()
+	defer reasonsLock.RUnlock()
+	return reasons.Has(reason)
+}
+
+// RegisteredReasons returns all registered reasons, sorted.
+func RegisteredReasons() []string {
+	reasonsLock.RLock()
+	defer reasonsLock.RUnlock()
+	return sets.List(reasons)
+}
+
+// NewDeduplicatingRecorder returns an EventRecorder which drops events that are identical to an event
+// emitted for the same object within the given window. Events are identical if they have the same type,
+// reason and message. If window is 0, the delegate is returned as is.
+func NewDeduplicatingRecorder(delegate record.EventRecorder, controllerName string, window time.Duration) record.EventRecorder {
+	if window <= 0 {
+		return delegate
+	}
+	return &deduplicatingRecorder{
+		delegate:       delegate,
+		controllerName: controllerName,
+		window:         window,
+		now:            time.Now,
+		lastEmitted:    map[string]time.Time{},
+	}
+}
+
+type deduplicatingRecorder struct {
+	delegate       record.EventRecorder
+	controllerName string
+	window         time.Duration
+	now            func() time.Time
+
+	lock        sync.Mutex
+	lastEmitted map[string]time.Time
+	lastPrune   time.Time
+}
+
+var _ record.EventRecorder = &deduplicatingRecorder{}
+
+func (r *deduplicatingRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if !r.shouldEmit(object, eventtype, reason, message) {
+		return
+	}
+	r.delegate.Event(object, eventtype, reason, message)
+}
+
+func (r *deduplicatingRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if !r.shouldEmit(object, eventtype, reason, message) {
+		return
+	}
+	r.delegate.Event(object, eventtype, reason, message)
+}
+
+func (r *deduplicatingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if !r.shouldEmit(object, eventtype, reason, message) {
+		return
+	}
+	r.delegate.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+}
+
+// shouldEmit returns true if no identical event has been emitted for the object within the window,
+// and records the event as emitted in that case.
+func (r *deduplicatingRecorder) shouldEmit(object runtime.Object, eventtype, reason, message string) bool {
+	objectKey, ok := keyForObject(object)
+	if !ok {
+		return true
+	}
+	key := strings.Join([]string{objectKey, eventtype, reason, message}, "/")
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	r.pruneLocked(now)
+
+	if last, ok := r.lastEmitted[key]; ok && now.Sub(last) < r.window {
+		metricReason := otherReason
+		if IsRegisteredReason(reason) {
+			metricReason = reason
+		}
+		suppressedEventsTotal.WithLabelValues(r.controllerName, metricReason).Inc()
+		return false
+	}
+	r.lastEmitted[key] = now
+	return true
+}
+
+// pruneLocked drops all entries older than the window, at most once per window.
+func (r *deduplicatingRecorder) pruneLocked(now time.Time) {
+	if now.Sub(r.lastPrune) < r.window {
+		return
+	}
+	for key, last := range r.lastEmitted {
+		if now.Sub(last) >= r.window {
+			delete(r.lastEmitted, key)
+		}
+	}
+	r.lastPrune = now
+}
+
+// keyForObject returns a key identifying the object, preferring the UID so that
+// events for a re-created object with the same name are not suppressed.
+func keyForObject(object runtime.Object) (string, bool) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return "", false
+	}
+	if uid := accessor.GetUID(); uid != "" {
+		return string(uid), true
+	}
+	return accessor.GetNamespace() + "/" + accessor.GetName(), true
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestDeduplicatingRecorder(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewDeduplicatingRecorder(fakeRecorder, "test-controller", time.Minute).(*deduplicatingRecorder)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	obj1 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "obj1", UID: "uid-1"}}
+	obj2 := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "obj1", UID: "uid-2"}}
+
+	// First event is emitted.
+	r.Eventf(obj1, corev1.EventTypeWarning, "FailedScaleUp", "failed: %s", "boom")
+	// Identical event for the same object is suppressed.
+	r.Event(obj1, corev1.EventTypeWarning, "FailedScaleUp", "failed: boom")
+	// Different message, reason or object are emitted.
+	r.Eventf(obj1, corev1.EventTypeWarning, "FailedScaleUp", "failed: %s", "other")
+	r.Event(obj1, corev1.EventTypeNormal, "RolloutMachineCreated", "failed: boom")
+	r.Event(obj2, corev1.EventTypeWarning, "FailedScaleUp", "failed: boom")
+
+	// Identical event is emitted again after the window.
+	now = now.Add(time.Minute)
+	r.Event(obj1, corev1.EventTypeWarning, "FailedScaleUp", "failed: boom")
+
+	close(fakeRecorder.Events)
+	var got []string
+	for e := range fakeRecorder.Events {
+		got = append(got, e)
+	}
+	g.Expect(got).To(Equal([]string{
+		"Warning FailedScaleUp failed: boom",
+		"Warning FailedScaleUp failed: other",
+		"Normal RolloutMachineCreated failed: boom",
+		"Warning FailedScaleUp failed: boom",
+		"Warning FailedScaleUp failed: boom",
+	}))
+	// Entries outside the window have been pruned.
+	g.Expect(r.lastEmitted).To(HaveLen(1))
+}
+
+func TestNewDeduplicatingRecorderWithoutWindow(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeRecorder := record.NewFakeRecorder(10)
+	g.Expect(NewDeduplicatingRecorder(fakeRecorder, "test-controller", 0)).To(BeIdenticalTo(fakeRecorder))
+}
+
+func TestRegisterReasons(t *testing.T) {
+	g := NewWithT(t)
+
+	RegisterReasons("TestReasonB", "TestReasonA")
+	g.Expect(IsRegisteredReason("TestReasonA")).To(BeTrue())
+	g.Expect(IsRegisteredReason("TestReasonC")).To(BeFalse())
+	g.Expect(RegisteredReasons()).To(ContainElements("TestReasonA", "TestReasonB"))
+}
@@ -27,6 +27,14 @@ const (
 
 	// TLSCrtDataName is the key used to store a TLS certificate in the secret's data field.
 	TLSCrtDataName = "tls.crt"
+
+	// PreviousTLSKeyDataName is the key used to store the TLS private key of the previous CA in the secret's data field
+	// of a CA rotation secret.
+	PreviousTLSKeyDataName = "previous-tls.key"
+
+	// PreviousTLSCrtDataName is the key used to store the TLS certificate of the previous CA in the secret's data field
+	// of a CA rotation secret.
+	PreviousTLSCrtDataName = "previous-tls.crt"
 )
 
 // Purpose is the name to append to the secret generated for a cluster.
@@ -50,11 +58,14 @@ const (
 
 	// APIServerEtcdClient is the secret name of user-supplied secret containing the apiserver-etcd-client key/cert.
 	APIServerEtcdClient = Purpose("apiserver-etcd-client")
+
+	// ClusterCARotation is the secret name suffix storing the new and the previous APIServer CA during a CA rotation.
+	ClusterCARotation = Purpose("ca-rotation")
 )
 
 var (
 	// allSecretPurposes defines a lists with all the secret suffix used by Cluster API.
-	allSecretPurposes = []Purpose{Kubeconfig, ClusterCA, EtcdCA, ServiceAccount, FrontProxyCA, APIServerEtcdClient}
+	allSecretPurposes = []Purpose{Kubeconfig, ClusterCA, EtcdCA, ServiceAccount, FrontProxyCA, APIServerEtcdClient, ClusterCARotation}
 )
 
 // HasPurposeSuffix checks if the secretName has one of the purposes as suffix.
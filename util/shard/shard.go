@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard implements helpers to partition the objects reconciled by multiple replicas of a manager.
+package shard
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+
+	"github.com/go-logr/logr"
+	pkgerrors "github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// Key defines which part of the identity of a Cluster is used to compute its shard.
+type Key string
+
+const (
+	// NamespaceKey assigns all Clusters of a namespace to the same shard.
+	NamespaceKey Key = "namespace"
+
+	// ClusterKey assigns Clusters to shards based on their namespace and name.
+	ClusterKey Key = "cluster"
+)
+
+// Options are the options for a Sharder.
+type Options struct {
+	// Shard is the shard reconciled by this replica.
+	Shard string
+
+	// Shards is the list of all shards.
+	// Note: All replicas must use the same list of shards, otherwise Clusters could be assigned
+	// to different shards by different replicas.
+	Shards []string
+
+	// Key defines which part of the identity of a Cluster is used to compute its shard.
+	// Defaults to NamespaceKey.
+	Key Key
+}
+
+// Sharder decides which objects are reconciled by a replica of a manager.
+//
+// All objects belonging to a Cluster, i.e. the Cluster itself and all objects with the
+// cluster-name label, belong to the shard of the Cluster. The shard of a Cluster is defined
+// by the ShardLabel, or if the label is not set or set to an unknown shard, it is computed
+// from a hash of the Cluster namespace (NamespaceKey) or namespace and name (ClusterKey).
+// Objects that don't belong to a Cluster belong to the shard computed from their namespace,
+// which for cluster-scoped objects is the same for all of them.
+type Sharder struct {
+	reader client.Reader
+	scheme *runtime.Scheme
+	shard  string
+	shards []string
+	key    Key
+}
+
+// New returns a new Sharder.
+// Note: reader is used to read the shard of the Cluster of an object; it should be
+// the cache of the manager.
+func New(reader client.Reader, scheme *runtime.Scheme, options Options) (*Sharder, error) {
+	if options.Key == "" {
+		options.Key = NamespaceKey
+	}
+	if options.Key != NamespaceKey && options.Key != ClusterKey {
+		return nil, pkgerrors.Errorf("invalid shard key %q, must be one of %q, %q", options.Key, NamespaceKey, ClusterKey)
+	}
+	if len(options.Shards) == 0 {
+		return nil, pkgerrors.New("shards must not be empty")
+	}
+	if !slices.Contains(options.Shards, options.Shard) {
+		return nil, pkgerrors.Errorf("shard %q must be one of the shards %v", options.Shard, options.Shards)
+	}
+	return &Sharder{
+		reader: reader,
+		scheme: scheme,
+		shard:  options.Shard,
+		shards: slices.Clone(options.Shards),
+		key:    options.Key,
+	}, nil
+}
+
+// Shard returns the shard reconciled by this replica.
+func (s *Sharder) Shard() string {
+	return s.shard
+}
+
+// Shards returns the list of all shards.
+func (s *Sharder) Shards() []string {
+	return slices.Clone(s.shards)
+}
+
+// Assign returns the shard computed for a Cluster with the given namespace and name.
+func (s *Sharder) Assign(namespace, clusterName string) string {
+	key := namespace
+	if s.key == ClusterKey && clusterName != "" {
+		key = namespace + "/" + clusterName
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return s.shards[h.Sum32()%uint32(len(s.shards))]
+}
+
+// ClusterShard returns the shard of a Cluster.
+func (s *Sharder) ClusterShard(cluster metav1.Object) string {
+	if shard, ok := cluster.GetLabels()[clusterv1.ShardLabel]; ok && slices.Contains(s.shards, shard) {
+		return shard
+	}
+	return s.Assign(cluster.GetNamespace(), cluster.GetName())
+}
+
+// ObjectShard returns the shard of an object.
+func (s *Sharder) ObjectShard(ctx context.Context, obj client.Object) (string, error) {
+	if s.isCluster(obj) {
+		return s.ClusterShard(obj), nil
+	}
+
+	clusterName, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok || clusterName == "" {
+		return s.Assign(obj.GetNamespace(), ""), nil
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: clusterName}, cluster); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", pkgerrors.Wrapf(err, "failed to get shard of Cluster %s", klog.KRef(obj.GetNamespace(), clusterName))
+		}
+		// If the Cluster doesn't exist (anymore), fallback to the computed shard.
+		cluster.SetNamespace(obj.GetNamespace())
+		cluster.SetName(clusterName)
+	}
+	return s.ClusterShard(cluster), nil
+}
+
+// InShard returns true if the object belongs to the shard reconciled by this replica.
+// Note: If the shard of the object can't be determined, the object is considered part of the shard,
+// so that it is not silently dropped.
+func (s *Sharder) InShard(ctx context.Context, obj client.Object) bool {
+	shard, err := s.ObjectShard(ctx, obj)
+	if err != nil {
+		return true
+	}
+	return shard == s.shard
+}
+
+func (s *Sharder) isCluster(obj client.Object) bool {
+	if _, ok := obj.(*clusterv1.Cluster); ok {
+		return true
+	}
+	gvk, err := apiutil.GVKForObject(obj, s.scheme)
+	if err != nil {
+		return false
+	}
+	return gvk.GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind()
+}
+
+// ResourceIsInShard returns a predicate that returns true only if the object belongs to the shard
+// reconciled by this replica.
+func (s *Sharder) ResourceIsInShard(ctx context.Context, logger logr.Logger) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return s.processIfInShard(ctx, logger.WithValues("predicate", "ResourceIsInShard", "eventType", "update"), e.ObjectNew)
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return s.processIfInShard(ctx, logger.WithValues("predicate", "ResourceIsInShard", "eventType", "create"), e.Object)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return s.processIfInShard(ctx, logger.WithValues("predicate", "ResourceIsInShard", "eventType", "delete"), e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return s.processIfInShard(ctx, logger.WithValues("predicate", "ResourceIsInShard", "eventType", "generic"), e.Object)
+		},
+	}
+}
+
+func (s *Sharder) processIfInShard(ctx context.Context, logger logr.Logger, obj client.Object) bool {
+	if gvk, err := apiutil.GVKForObject(obj, s.scheme); err == nil {
+		logger = logger.WithValues(gvk.Kind, klog.KObj(obj))
+	}
+	if s.InShard(ctx, obj) {
+		logger.V(6).Info("Resource is in shard, will attempt to map resource", "shard", s.shard)
+		return true
+	}
+	logger.V(6).Info("Resource is not in shard, will not attempt to map resource", "shard", s.shard)
+	return false
+}
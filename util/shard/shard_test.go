@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{
+			name:    "Valid options",
+			options: Options{Shard: "a", Shards: []string{"a", "b"}},
+		},
+		{
+			name:    "Shards must not be empty",
+			options: Options{Shard: "a"},
+			wantErr: true,
+		},
+		{
+			name:    "Shard must be one of the shards",
+			options: Options{Shard: "c", Shards: []string{"a", "b"}},
+			wantErr: true,
+		},
+		{
+			name:    "Key must be valid",
+			options: Options{Shard: "a", Shards: []string{"a", "b"}, Key: "machine"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := New(nil, nil, tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestAssign(t *testing.T) {
+	g := NewWithT(t)
+
+	shards := []string{"a", "b", "c"}
+	namespaceSharder, err := New(nil, nil, Options{Shard: "a", Shards: shards, Key: NamespaceKey})
+	g.Expect(err).ToNot(HaveOccurred())
+	clusterSharder, err := New(nil, nil, Options{Shard: "a", Shards: shards, Key: ClusterKey})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	assigned := map[string]int{}
+	for _, namespace := range []string{"ns1", "ns2", "ns3", "ns4", "ns5", "ns6"} {
+		// With the namespace key all Clusters of a namespace are assigned to the same shard.
+		g.Expect(namespaceSharder.Assign(namespace, "cluster1")).To(Equal(namespaceSharder.Assign(namespace, "cluster2")))
+		g.Expect(shards).To(ContainElement(namespaceSharder.Assign(namespace, "")))
+
+		for _, name := range []string{"cluster1", "cluster2", "cluster3", "cluster4", "cluster5", "cluster6"} {
+			// The assignment is stable.
+			g.Expect(clusterSharder.Assign(namespace, name)).To(Equal(clusterSharder.Assign(namespace, name)))
+			assigned[clusterSharder.Assign(namespace, name)]++
+		}
+	}
+	// With the cluster key Clusters are spread across all shards.
+	g.Expect(assigned).To(HaveLen(len(shards)))
+}
+
+func TestObjectShard(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	labeledCluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		Name:      "labeled",
+		Labels:    map[string]string{clusterv1.ShardLabel: "b"},
+	}}
+	unknownShardCluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Namespace: metav1.NamespaceDefault,
+		Name:      "unknown-shard",
+		Labels:    map[string]string{clusterv1.ShardLabel: "does-not-exist"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(labeledCluster, unknownShardCluster).Build()
+
+	s, err := New(c, scheme, Options{Shard: "a", Shards: []string{"a", "b", "c"}, Key: ClusterKey})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	machineOf := func(clusterName string) *clusterv1.Machine {
+		return &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "machine",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+		}}
+	}
+
+	// The shard label is used if it is set to one of the shards.
+	g.Expect(s.ObjectShard(context.Background(), labeledCluster)).To(Equal("b"))
+	g.Expect(s.ObjectShard(context.Background(), machineOf("labeled"))).To(Equal("b"))
+
+	// Otherwise the shard is computed.
+	g.Expect(s.ObjectShard(context.Background(), unknownShardCluster)).To(Equal(s.Assign(metav1.NamespaceDefault, "unknown-shard")))
+	g.Expect(s.ObjectShard(context.Background(), machineOf("unknown-shard"))).To(Equal(s.Assign(metav1.NamespaceDefault, "unknown-shard")))
+	g.Expect(s.ObjectShard(context.Background(), machineOf("does-not-exist"))).To(Equal(s.Assign(metav1.NamespaceDefault, "does-not-exist")))
+
+	// Objects not belonging to a Cluster are assigned based on their namespace.
+	clusterClass := &clusterv1.ClusterClass{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "class"}}
+	g.Expect(s.ObjectShard(context.Background(), clusterClass)).To(Equal(s.Assign(metav1.NamespaceDefault, "")))
+
+	// Cluster objects are also detected when using PartialObjectMetadata.
+	partialCluster := &metav1.PartialObjectMetadata{ObjectMeta: labeledCluster.ObjectMeta}
+	partialCluster.SetGroupVersionKind(clusterv1.GroupVersion.WithKind("Cluster"))
+	g.Expect(s.ObjectShard(context.Background(), partialCluster)).To(Equal("b"))
+}
+
+func TestResourceIsInShard(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	s, err := New(c, scheme, Options{Shard: "a", Shards: []string{"a", "b"}})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	clusterWithShard := func(shard string) client.Object {
+		return &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "cluster",
+			Labels:    map[string]string{clusterv1.ShardLabel: shard},
+		}}
+	}
+
+	p := s.ResourceIsInShard(context.Background(), logr.Discard())
+	g.Expect(p.Create(event.CreateEvent{Object: clusterWithShard("a")})).To(BeTrue())
+	g.Expect(p.Create(event.CreateEvent{Object: clusterWithShard("b")})).To(BeFalse())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: clusterWithShard("a"), ObjectNew: clusterWithShard("b")})).To(BeFalse())
+	g.Expect(p.Delete(event.DeleteEvent{Object: clusterWithShard("a")})).To(BeTrue())
+	g.Expect(p.Generic(event.GenericEvent{Object: clusterWithShard("b")})).To(BeFalse())
+}
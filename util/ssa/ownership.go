@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// CoOwnedFieldsReason is the reason of the event emitted by ReportCoOwnedFields.
+const CoOwnedFieldsReason = "CoOwnedFields"
+
+// maxReportedCoOwnedFields is the maximum number of fields listed when describing co-owned fields.
+const maxReportedCoOwnedFields = 5
+
+// CoOwnedFields describes fields owned by a field manager that are also owned by another field manager,
+// e.g. because a user applied or edited them with kubectl.
+type CoOwnedFields struct {
+	// Manager is the name of the other field manager.
+	Manager string
+
+	// Operation is the operation of the managedFields entry of the other field manager.
+	Operation metav1.ManagedFieldsOperationType
+
+	// Fields is the set of co-owned fields.
+	Fields *fieldpath.Set
+}
+
+// Paths returns the co-owned fields as sorted list of strings, e.g. `.spec.replicas`.
+func (c CoOwnedFields) Paths() []string {
+	paths := []string{}
+	c.Fields.Iterate(func(p fieldpath.Path) {
+		paths = append(paths, p.String())
+	})
+	slices.Sort(paths)
+	return paths
+}
+
+// String returns a short description of the co-owned fields, e.g. `"kubectl-edit" (Update): .spec.paused, .spec.replicas`.
+func (c CoOwnedFields) String() string {
+	paths := c.Paths()
+	if len(paths) > maxReportedCoOwnedFields {
+		paths = append(paths[:maxReportedCoOwnedFields], fmt.Sprintf("... (%d more)", len(paths)-maxReportedCoOwnedFields))
+	}
+	return fmt.Sprintf("%q (%s): %s", c.Manager, c.Operation, strings.Join(paths, ", "))
+}
+
+// DetectCoOwnedFields returns the fields owned by fieldManager which are also owned by other field managers.
+//
+// Co-ownership prevents fieldManager from removing fields via SSA: if fieldManager drops a field from its
+// intent, the field is not removed from the object as long as another field manager still owns it. This makes
+// drift behavior hard to predict, e.g. when a user applied the same value with kubectl.
+//
+// Entries for subresources (e.g. status) and entries of ignoreManagers are not considered.
+func DetectCoOwnedFields(obj client.Object, fieldManager string, ignoreManagers ...string) ([]CoOwnedFields, error) {
+	if util.IsNil(obj) {
+		return nil, nil
+	}
+
+	managedFields := obj.GetManagedFields()
+
+	ownFields := fieldpath.NewSet()
+	for _, entry := range managedFields {
+		if entry.Manager != fieldManager || entry.Subresource != "" {
+			continue
+		}
+		fields, err := fieldsFromManagedFieldsEntry(entry)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to detect co-owned fields")
+		}
+		ownFields = ownFields.Union(fields)
+	}
+	// Only consider leaves, otherwise parent fields like `.metadata.labels` would be reported as co-owned
+	// as soon as both field managers own a label.
+	ownFields = ownFields.Leaves()
+	if ownFields.Empty() {
+		return nil, nil
+	}
+
+	var coOwned []CoOwnedFields
+	for _, entry := range managedFields {
+		if entry.Manager == fieldManager || entry.Subresource != "" || slices.Contains(ignoreManagers, entry.Manager) {
+			continue
+		}
+		fields, err := fieldsFromManagedFieldsEntry(entry)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to detect co-owned fields")
+		}
+		intersection := ownFields.Intersection(fields.Leaves())
+		if intersection.Empty() {
+			continue
+		}
+		coOwned = append(coOwned, CoOwnedFields{
+			Manager:   entry.Manager,
+			Operation: entry.Operation,
+			Fields:    intersection,
+		})
+	}
+	return coOwned, nil
+}
+
+// CoOwnedFieldsMessage returns a message describing coOwned, e.g. `fields also owned by "kubectl-edit" (Update): .spec.replicas`.
+func CoOwnedFieldsMessage(coOwned []CoOwnedFields) string {
+	messages := make([]string, 0, len(coOwned))
+	for _, c := range coOwned {
+		messages = append(messages, fmt.Sprintf("fields also owned by %s", c))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ReportCoOwnedFields emits a warning event on obj for every other field manager that co-owns fields with fieldManager.
+func ReportCoOwnedFields(recorder record.EventRecorder, obj client.Object, fieldManager string, coOwned []CoOwnedFields) {
+	for _, c := range coOwned {
+		recorder.Eventf(obj, corev1.EventTypeWarning, CoOwnedFieldsReason,
+			"Fields managed by %q are also owned by field manager %s; %q won't be able to remove these fields",
+			fieldManager, c, fieldManager)
+	}
+}
+
+// ReclaimOwnership removes co-owned fields from the managedFields entries of the other field managers,
+// so the field manager that co-owned these fields becomes their sole owner and can remove them via SSA.
+// Entries of other field managers that do not own any field afterward are dropped.
+//
+// It returns true if managedFields have been patched.
+// Note: The patch includes the resourceVersion of obj to avoid race conditions.
+func ReclaimOwnership(ctx context.Context, c client.Client, obj client.Object, coOwned []CoOwnedFields) (bool, error) {
+	if util.IsNil(obj) || len(coOwned) == 0 {
+		return false, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	objGVK, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to reclaim ownership")
+	}
+
+	managedFields, changed, err := removeCoOwnedFields(obj.GetManagedFields(), coOwned)
+	if err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to reclaim ownership of fields of %s %s", objGVK.Kind, klog.KObj(obj))
+	}
+	// Never patch an empty list of managedFields, otherwise the apiserver would create a before-first-apply
+	// entry during the next SSA call, which would act as co-owner for all fields.
+	if !changed || len(managedFields) == 0 {
+		return false, nil
+	}
+
+	// Create a patch to update only managedFields.
+	// Include resourceVersion to avoid race conditions.
+	jsonPatch := []map[string]interface{}{
+		{
+			"op":    "replace",
+			"path":  "/metadata/managedFields",
+			"value": managedFields,
+		},
+		{
+			"op":    "replace",
+			"path":  "/metadata/resourceVersion",
+			"value": obj.GetResourceVersion(),
+		},
+	}
+	patch, err := json.Marshal(jsonPatch)
+	if err != nil {
+		return false, pkgerrors.Wrap(err, "failed to reclaim ownership: failed to marshal patch for managedFields")
+	}
+
+	log.Info("Reclaiming ownership of co-owned fields", objGVK.Kind, klog.KObj(obj))
+	if err := c.Patch(ctx, obj, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to reclaim ownership: failed to patch %s %s", objGVK.Kind, klog.KObj(obj))
+	}
+
+	return true, nil
+}
+
+// removeCoOwnedFields removes co-owned fields from the corresponding managedFields entries.
+func removeCoOwnedFields(managedFields []metav1.ManagedFieldsEntry, coOwned []CoOwnedFields) ([]metav1.ManagedFieldsEntry, bool, error) {
+	changed := false
+	result := make([]metav1.ManagedFieldsEntry, 0, len(managedFields))
+	for _, entry := range managedFields {
+		i := slices.IndexFunc(coOwned, func(c CoOwnedFields) bool {
+			return c.Manager == entry.Manager && c.Operation == entry.Operation
+		})
+		if i < 0 || entry.Subresource != "" {
+			result = append(result, entry)
+			continue
+		}
+
+		fields, err := fieldsFromManagedFieldsEntry(entry)
+		if err != nil {
+			return nil, false, err
+		}
+		remaining := fields.Difference(coOwned[i].Fields)
+		if remaining.Equals(fields) {
+			result = append(result, entry)
+			continue
+		}
+		changed = true
+
+		// Drop the entry if the field manager does not own any field anymore.
+		// Note: Leftover parents of the removed fields, e.g. `.metadata.labels`, are not considered as owned fields.
+		if remaining.Difference(parentsOf(coOwned[i].Fields)).Empty() {
+			continue
+		}
+
+		fieldsV1, err := remaining.ToJSON()
+		if err != nil {
+			return nil, false, pkgerrors.Wrapf(err, "failed to marshal managedFields entry of field manager %q", entry.Manager)
+		}
+		entry.FieldsV1 = &metav1.FieldsV1{Raw: fieldsV1}
+		result = append(result, entry)
+	}
+	return result, changed, nil
+}
+
+// parentsOf returns all the parent paths of the paths in s.
+func parentsOf(s *fieldpath.Set) *fieldpath.Set {
+	parents := fieldpath.NewSet()
+	s.Iterate(func(p fieldpath.Path) {
+		for i := 1; i < len(p); i++ {
+			parents.Insert(p[:i].Copy())
+		}
+	})
+	return parents
+}
+
+func fieldsFromManagedFieldsEntry(entry metav1.ManagedFieldsEntry) (*fieldpath.Set, error) {
+	fields := &fieldpath.Set{}
+	if entry.FieldsV1 == nil {
+		return fields, nil
+	}
+	if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to parse managedFields entry of field manager %q", entry.Manager)
+	}
+	return fields, nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssa
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestDetectCoOwnedFields(t *testing.T) {
+	tests := []struct {
+		name           string
+		managedFields  []metav1.ManagedFieldsEntry
+		ignoreManagers []string
+		want           map[string][]string
+	}{
+		{
+			name: "No co-owned fields if there are no other field managers",
+			managedFields: []metav1.ManagedFieldsEntry{
+				applyEntry("capi-topology", `{"f:spec":{"f:replicas":{}}}`),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "No co-owned fields if field managers own different fields",
+			managedFields: []metav1.ManagedFieldsEntry{
+				applyEntry("capi-topology", `{"f:metadata":{"f:labels":{".":{},"f:a":{}}},"f:spec":{"f:replicas":{}}}`),
+				updateEntry("kubectl-edit", `{"f:metadata":{"f:labels":{".":{},"f:b":{}}}}`),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "Co-owned fields are detected per field manager",
+			managedFields: []metav1.ManagedFieldsEntry{
+				applyEntry("capi-topology", `{"f:metadata":{"f:labels":{".":{},"f:a":{}}},"f:spec":{"f:replicas":{},"f:paused":{}}}`),
+				applyEntry("kubectl", `{"f:metadata":{"f:labels":{".":{},"f:a":{}}},"f:spec":{"f:replicas":{}}}`),
+				updateEntry("kubectl-edit", `{"f:spec":{"f:paused":{}}}`),
+			},
+			want: map[string][]string{
+				"kubectl":      {".metadata.labels.a", ".spec.replicas"},
+				"kubectl-edit": {".spec.paused"},
+			},
+		},
+		{
+			name: "Ignored field managers and status entries are not considered",
+			managedFields: []metav1.ManagedFieldsEntry{
+				applyEntry("capi-topology", `{"f:spec":{"f:replicas":{}}}`),
+				updateEntry("manager", `{"f:spec":{"f:replicas":{}}}`),
+				func() metav1.ManagedFieldsEntry {
+					e := updateEntry("autoscaler", `{"f:spec":{"f:replicas":{}}}`)
+					e.Subresource = "status"
+					return e
+				}(),
+			},
+			ignoreManagers: []string{"manager"},
+			want:           map[string][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := &clusterv1.MachineDeployment{}
+			obj.SetManagedFields(tt.managedFields)
+
+			coOwned, err := DetectCoOwnedFields(obj, "capi-topology", tt.ignoreManagers...)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got := map[string][]string{}
+			for _, c := range coOwned {
+				got[c.Manager] = c.Paths()
+			}
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReportCoOwnedFields(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := &clusterv1.MachineDeployment{}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		applyEntry("capi-topology", `{"f:spec":{"f:replicas":{},"f:paused":{}}}`),
+		applyEntry("kubectl", `{"f:spec":{"f:replicas":{},"f:paused":{}}}`),
+	})
+	coOwned, err := DetectCoOwnedFields(obj, "capi-topology")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	recorder := record.NewFakeRecorder(10)
+	ReportCoOwnedFields(recorder, obj, "capi-topology", coOwned)
+
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(Equal(`Warning CoOwnedFields Fields managed by "capi-topology" are also owned by field manager "kubectl" (Apply): .spec.paused, .spec.replicas; "capi-topology" won't be able to remove these fields`))
+}
+
+func TestCoOwnedFieldsMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := &clusterv1.MachineDeployment{}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		applyEntry("capi-topology", `{"f:spec":{"f:replicas":{},"f:paused":{},"f:minReadySeconds":{},"f:clusterName":{},"f:selector":{},"f:template":{}}}`),
+		applyEntry("kubectl", `{"f:spec":{"f:replicas":{},"f:paused":{},"f:minReadySeconds":{},"f:clusterName":{},"f:selector":{},"f:template":{}}}`),
+		updateEntry("kubectl-edit", `{"f:spec":{"f:replicas":{}}}`),
+	})
+	coOwned, err := DetectCoOwnedFields(obj, "capi-topology")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(CoOwnedFieldsMessage(coOwned)).To(Equal(`fields also owned by "kubectl" (Apply): .spec.clusterName, .spec.minReadySeconds, .spec.paused, .spec.replicas, .spec.selector, ... (1 more); ` +
+		`fields also owned by "kubectl-edit" (Update): .spec.replicas`))
+}
+
+func TestReclaimOwnership(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	obj := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       metav1.NamespaceDefault,
+			Name:            "md",
+			ResourceVersion: "42",
+		},
+	}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		applyEntry("capi-topology", `{"f:metadata":{"f:labels":{".":{},"f:a":{}}},"f:spec":{"f:replicas":{}}}`),
+		applyEntry("kubectl", `{"f:metadata":{"f:labels":{".":{},"f:a":{}}},"f:spec":{"f:replicas":{},"f:paused":{}}}`),
+		updateEntry("kubectl-edit", `{"f:metadata":{"f:labels":{".":{},"f:a":{}}}}`),
+	})
+
+	var patch []map[string]interface{}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, p client.Patch, _ ...client.PatchOption) error {
+			data, err := p.Data(nil)
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(data, &patch)
+		},
+	}).Build()
+
+	coOwned, err := DetectCoOwnedFields(obj, "capi-topology")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(coOwned).To(HaveLen(2))
+
+	reclaimed, err := ReclaimOwnership(context.Background(), c, obj, coOwned)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reclaimed).To(BeTrue())
+
+	g.Expect(patch).To(HaveLen(2))
+	g.Expect(patch[1]).To(HaveKeyWithValue("value", "42"))
+
+	data, err := json.Marshal(patch[0]["value"])
+	g.Expect(err).ToNot(HaveOccurred())
+	var managedFields []metav1.ManagedFieldsEntry
+	g.Expect(json.Unmarshal(data, &managedFields)).To(Succeed())
+
+	// kubectl-edit doesn't own any field anymore, kubectl only owns .spec.paused.
+	g.Expect(managedFields).To(HaveLen(2))
+	g.Expect(managedFields[0].Manager).To(Equal("capi-topology"))
+	g.Expect(string(managedFields[0].FieldsV1.Raw)).To(Equal(`{"f:metadata":{"f:labels":{".":{},"f:a":{}}},"f:spec":{"f:replicas":{}}}`))
+	g.Expect(managedFields[1].Manager).To(Equal("kubectl"))
+	g.Expect(string(managedFields[1].FieldsV1.Raw)).To(Equal(`{"f:metadata":{"f:labels":{}},"f:spec":{"f:paused":{}}}`))
+
+	// Nothing to do when there are no co-owned fields.
+	reclaimed, err = ReclaimOwnership(context.Background(), c, obj, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reclaimed).To(BeFalse())
+}
+
+func applyEntry(manager, fields string) metav1.ManagedFieldsEntry {
+	return metav1.ManagedFieldsEntry{
+		Manager:    manager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		APIVersion: clusterv1.GroupVersion.String(),
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+	}
+}
+
+func updateEntry(manager, fields string) metav1.ManagedFieldsEntry {
+	e := applyEntry(manager, fields)
+	e.Operation = metav1.ManagedFieldsOperationUpdate
+	return e
+}
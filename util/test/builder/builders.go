@@ -120,6 +120,8 @@ type ClusterTopologyBuilder struct {
 	controlPlaneMHC       clusterv1.ControlPlaneTopologyHealthCheck
 	variables             []clusterv1.ClusterVariable
 	controlPlaneVariables []clusterv1.ClusterVariable
+
+	controlPlaneExternallyManaged *bool
 }
 
 // ClusterTopology returns a ClusterTopologyBuilder.
@@ -165,6 +167,12 @@ func (c *ClusterTopologyBuilder) WithControlPlaneMachineHealthCheck(mhc clusterv
 	return c
 }
 
+// WithControlPlaneExternallyManaged sets the externallyManaged value of the ControlPlaneTopology.
+func (c *ClusterTopologyBuilder) WithControlPlaneExternallyManaged(externallyManaged bool) *ClusterTopologyBuilder {
+	c.controlPlaneExternallyManaged = &externallyManaged
+	return c
+}
+
 // WithMachineDeployment passes the full MachineDeploymentTopology and adds it to an existing list in the ClusterTopologyBuilder.
 func (c *ClusterTopologyBuilder) WithMachineDeployment(mdc clusterv1.MachineDeploymentTopology) *ClusterTopologyBuilder {
 	c.workers.MachineDeployments = append(c.workers.MachineDeployments, mdc)
@@ -193,8 +201,9 @@ func (c *ClusterTopologyBuilder) Build() *clusterv1.Topology {
 		Workers: c.workers,
 		Version: c.version,
 		ControlPlane: clusterv1.ControlPlaneTopology{
-			Replicas:    &c.controlPlaneReplicas,
-			HealthCheck: c.controlPlaneMHC,
+			Replicas:          &c.controlPlaneReplicas,
+			HealthCheck:       c.controlPlaneMHC,
+			ExternallyManaged: c.controlPlaneExternallyManaged,
 		},
 		Variables: c.variables,
 	}
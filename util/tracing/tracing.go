@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing implements OpenTelemetry tracing utilities for Cluster API controllers.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "sigs.k8s.io/cluster-api"
+
+const (
+	// ControllerKey is the attribute key for the name of the controller.
+	ControllerKey = attribute.Key("controller")
+
+	// NamespaceKey is the attribute key for the namespace of the reconciled object.
+	NamespaceKey = attribute.Key("k8s.namespace.name")
+
+	// NameKey is the attribute key for the name of the reconciled object.
+	NameKey = attribute.Key("cluster.x-k8s.io/object-name")
+
+	// ClusterNameKey is the attribute key for the name of the Cluster the reconciled object belongs to.
+	ClusterNameKey = attribute.Key("cluster.x-k8s.io/cluster-name")
+)
+
+// enabled is set to true once a TracerProvider exporting spans has been set up.
+var enabled atomic.Bool
+
+// Options are the options to configure tracing.
+type Options struct {
+	// Endpoint is the address of the OTLP gRPC endpoint spans are exported to, e.g. "otel-collector:4317".
+	// Tracing is disabled if Endpoint is empty.
+	Endpoint string
+
+	// Insecure disables TLS when connecting to Endpoint.
+	Insecure bool
+
+	// SamplingRatio is the ratio of reconciles that are traced, between 0 and 1.
+	// Spans are always sampled if their parent is sampled.
+	SamplingRatio float64
+}
+
+// AddFlags adds the tracing flags to the flag set.
+func AddFlags(fs *pflag.FlagSet, options *Options) {
+	fs.StringVar(&options.Endpoint, "tracing-endpoint", "",
+		"The address of the OTLP gRPC endpoint OpenTelemetry spans are exported to, e.g. otel-collector:4317. Tracing is disabled if not set.")
+
+	fs.BoolVar(&options.Insecure, "tracing-insecure", false,
+		"Disable TLS when connecting to the endpoint set via --tracing-endpoint.")
+
+	fs.Float64Var(&options.SamplingRatio, "tracing-sampling-ratio", 0.1,
+		"The ratio of reconciles that are traced, between 0 and 1.")
+}
+
+// Setup sets up the global OpenTelemetry TracerProvider and propagator according to the given Options.
+// The returned func must be called on shutdown to flush spans that have not been exported yet.
+func Setup(ctx context.Context, serviceName string, options Options) (func(context.Context) error, error) {
+	if options.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if options.SamplingRatio < 0 || options.SamplingRatio > 1 {
+		return nil, pkgerrors.Errorf("invalid tracing sampling ratio %v: must be between 0 and 1", options.SamplingRatio)
+	}
+
+	exporterOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(options.Endpoint)}
+	if options.Insecure {
+		exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOptions...)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create OTLP trace exporter")
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create tracing resource")
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SamplingRatio))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// Start starts a span with the given name and attributes.
+// If tracing is not set up, the span is a no-op span.
+func Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// End ends the span and records err on the span, if any.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SetClusterName sets the name of the Cluster the reconciled object belongs to on the current span,
+// so that spans of different controllers can be correlated per Cluster.
+func SetClusterName(ctx context.Context, clusterName string) {
+	trace.SpanFromContext(ctx).SetAttributes(ClusterNameKey.String(clusterName))
+}
+
+// WrapTransport wraps the given RoundTripper so that HTTP requests are traced and the
+// trace context is propagated to the server. If tracing is not set up, rt is returned unchanged.
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if !enabled.Load() {
+		return rt
+	}
+	return otelhttp.NewTransport(rt)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup(t *testing.T) {
+	g := NewWithT(t)
+
+	// Tracing is disabled if no endpoint is set.
+	shutdown, err := Setup(context.Background(), "test", Options{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(shutdown(context.Background())).To(Succeed())
+	g.Expect(enabled.Load()).To(BeFalse())
+	g.Expect(WrapTransport(http.DefaultTransport)).To(BeIdenticalTo(http.DefaultTransport))
+
+	_, err = Setup(context.Background(), "test", Options{Endpoint: "localhost:4317", SamplingRatio: 2})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestSpans(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	previousTracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previousTracerProvider)
+
+	ctx, span := Start(context.Background(), "machine.Reconcile", ControllerKey.String("machine"), NamespaceKey.String("default"), NameKey.String("machine-1"))
+	SetClusterName(ctx, "cluster-1")
+	_, childSpan := Start(ctx, "child")
+	End(childSpan, nil)
+	End(span, errors.New("reconcile failed"))
+
+	spans := recorder.Ended()
+	g.Expect(spans).To(HaveLen(2))
+
+	child, parent := spans[0], spans[1]
+	g.Expect(child.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	g.Expect(child.Status().Code).To(Equal(codes.Unset))
+
+	g.Expect(parent.Name()).To(Equal("machine.Reconcile"))
+	g.Expect(parent.Attributes()).To(ContainElements(
+		ControllerKey.String("machine"),
+		NamespaceKey.String("default"),
+		NameKey.String("machine-1"),
+		ClusterNameKey.String("cluster-1"),
+	))
+	g.Expect(parent.Status().Code).To(Equal(codes.Error))
+	g.Expect(parent.Status().Description).To(Equal("reconcile failed"))
+	g.Expect(parent.Events()).To(HaveLen(1))
+}